@@ -0,0 +1,150 @@
+package chromedebugo
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// Browser is a client for chrome's HTTP endpoints, which list, open, activate
+// and close targets and describe the browser and its protocol.
+type Browser struct {
+	host   string
+	client *http.Client
+}
+
+// NewBrowser returns a client for the HTTP endpoints of the chrome instance
+// at host.  The host may be given with or without a scheme and trailing
+// slash, eg. "localhost:9222" or "http://localhost:9222/".  If client is nil
+// http.DefaultClient is used.
+func NewBrowser(host string, client *http.Client) *Browser {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &Browser{
+		host:   normalizeHost(host),
+		client: client,
+	}
+}
+
+// Host returns the normalized address of chrome's HTTP endpoints, eg.
+// "http://localhost:9222".
+func (b *Browser) Host() string {
+	return b.host
+}
+
+// Version returns the chrome version inforamation from /json/version
+func (b *Browser) Version() (Version, error) {
+	data := Version{}
+	err := b.do("GET", "/json/version", &data)
+	return data, err
+}
+
+// List returns the targets chrome can debug from /json/list
+func (b *Browser) List() ([]Info, error) {
+	data := []Info{}
+	err := b.do("GET", "/json/list", &data)
+	return data, err
+}
+
+// NewTarget opens a new tab at url using /json/new and returns it.  The tab
+// opens at about:blank if url is empty.
+func (b *Browser) NewTarget(u string) (Info, error) {
+	path := "/json/new"
+	if u != "" {
+		path += "?" + url.QueryEscape(u)
+	}
+	data := Info{}
+	// newer versions of chrome refuse GET requests to /json/new
+	err := b.do("PUT", path, &data)
+	return data, err
+}
+
+// ActivateTarget brings the target with the given ID to the foreground
+// using /json/activate.
+func (b *Browser) ActivateTarget(id string) error {
+	return b.do("GET", "/json/activate/"+url.PathEscape(id), nil)
+}
+
+// CloseTarget closes the target with the given ID using /json/close.
+func (b *Browser) CloseTarget(id string) error {
+	return b.do("GET", "/json/close/"+url.PathEscape(id), nil)
+}
+
+// Protocol returns the description of the DevTools protocol spoken by chrome
+// from /json/protocol.
+func (b *Browser) Protocol() (Protocol, error) {
+	data := Protocol{}
+	err := b.do("GET", "/json/protocol", &data)
+	return data, err
+}
+
+// do makes a request to chrome, decoding the JSON response body into v if v
+// is non-nil.
+func (b *Browser) do(method, path string, v interface{}) error {
+	req, err := http.NewRequest(method, b.host+path, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := b.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		body, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 1024))
+		return HTTPError{
+			Method:     method,
+			URL:        req.URL.String(),
+			StatusCode: resp.StatusCode,
+			Body:       strings.TrimSpace(string(body)),
+		}
+	}
+
+	if v == nil {
+		return nil
+	}
+	if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+		return fmt.Errorf("error decoding response from %s: %s", req.URL, err)
+	}
+	return nil
+}
+
+// HTTPError is returned by Browser when chrome responds with a non-2xx
+// status, eg. when closing a target which does not exist.
+type HTTPError struct {
+	Method     string
+	URL        string
+	StatusCode int
+	// Body holds the start of the response, which chrome uses to explain
+	// the error
+	Body string
+}
+
+func (e HTTPError) Error() string {
+	if e.Body == "" {
+		return fmt.Sprintf("%s %s failed with status %d", e.Method, e.URL, e.StatusCode)
+	}
+	return fmt.Sprintf("%s %s failed with status %d: %s", e.Method, e.URL, e.StatusCode, e.Body)
+}
+
+// normalizeHost turns the forms a chrome address is commonly given in into a
+// base URL without a trailing slash.  Websocket schemes are mapped to their
+// HTTP equivalents.
+func normalizeHost(host string) string {
+	host = strings.TrimSpace(host)
+	switch {
+	case strings.HasPrefix(host, "ws://"):
+		host = "http://" + strings.TrimPrefix(host, "ws://")
+	case strings.HasPrefix(host, "wss://"):
+		host = "https://" + strings.TrimPrefix(host, "wss://")
+	case !strings.Contains(host, "://"):
+		host = "http://" + host
+	}
+	return strings.TrimRight(host, "/")
+}
@@ -0,0 +1,179 @@
+package chromedebugo_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/tonyhb/chromedebugo"
+)
+
+// fakeEndpoints serves chrome's HTTP endpoints and records the requests
+// made, as "METHOD path?query".
+type fakeEndpoints struct {
+	*httptest.Server
+	requests []string
+}
+
+func newFakeEndpoints(t *testing.T) *fakeEndpoints {
+	f := &fakeEndpoints{}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/json/version", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{
+			"Browser": "HeadlessChrome/120.0.6099.109",
+			"Protocol-Version": "1.3",
+			"User-Agent": "Mozilla/5.0",
+			"V8-Version": "12.0.267.8",
+			"WebKit-Version": "537.36",
+			"webSocketDebuggerUrl": "ws://127.0.0.1:9222/devtools/browser/abc"
+		}`)
+	})
+	mux.HandleFunc("/json/list", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{
+			"id": "page-1",
+			"type": "page",
+			"title": "Example",
+			"url": "https://example.com/",
+			"webSocketDebuggerUrl": "ws://127.0.0.1:9222/devtools/page/page-1"
+		}]`)
+	})
+	mux.HandleFunc("/json/new", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "PUT" {
+			http.Error(w, "Using unsafe HTTP verb GET to invoke /json/new. This action supports only PUT verb.", http.StatusMethodNotAllowed)
+			return
+		}
+		fmt.Fprintf(w, `{"id": "page-2", "type": "page", "url": %q}`, r.URL.RawQuery)
+	})
+	mux.HandleFunc("/json/activate/", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, "Target activated")
+	})
+	mux.HandleFunc("/json/close/", func(w http.ResponseWriter, r *http.Request) {
+		if id := strings.TrimPrefix(r.URL.Path, "/json/close/"); id != "page-1" {
+			http.Error(w, "No such target id: "+id, http.StatusNotFound)
+			return
+		}
+		fmt.Fprint(w, "Target is closing")
+	})
+	mux.HandleFunc("/json/protocol", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `not json`)
+	})
+	f.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		req := r.Method + " " + r.URL.Path
+		if r.URL.RawQuery != "" {
+			req += "?" + r.URL.RawQuery
+		}
+		f.requests = append(f.requests, req)
+		mux.ServeHTTP(w, r)
+	}))
+	t.Cleanup(f.Close)
+	return f
+}
+
+func TestBrowserHost(t *testing.T) {
+	tests := map[string]string{
+		"localhost:9222":          "http://localhost:9222",
+		"http://localhost:9222/":  "http://localhost:9222",
+		" https://chrome.example": "https://chrome.example",
+		"ws://localhost:9222":     "http://localhost:9222",
+		"wss://chrome.example/":   "https://chrome.example",
+	}
+	for host, want := range tests {
+		if got := chromedebugo.NewBrowser(host, nil).Host(); got != want {
+			t.Errorf("NewBrowser(%q).Host() = %q, want %q", host, got, want)
+		}
+	}
+}
+
+func TestBrowserVersion(t *testing.T) {
+	f := newFakeEndpoints(t)
+	v, err := chromedebugo.NewBrowser(f.URL, f.Client()).Version()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v.Browser != "HeadlessChrome/120.0.6099.109" || v.ProtocolVersion != "1.3" {
+		t.Errorf("got version %+v", v)
+	}
+	if v.WebsocketDebuggerURL != "ws://127.0.0.1:9222/devtools/browser/abc" {
+		t.Errorf("got browser URL %q", v.WebsocketDebuggerURL)
+	}
+}
+
+func TestBrowserList(t *testing.T) {
+	f := newFakeEndpoints(t)
+	targets, err := chromedebugo.NewBrowser(f.URL+"/", f.Client()).List()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(targets) != 1 {
+		t.Fatalf("got targets %+v", targets)
+	}
+	target := targets[0]
+	if target.Id != "page-1" || target.Type != "page" || target.WebsocketDebuggerURL != "ws://127.0.0.1:9222/devtools/page/page-1" {
+		t.Errorf("got target %+v", target)
+	}
+}
+
+func TestBrowserNewTarget(t *testing.T) {
+	f := newFakeEndpoints(t)
+	b := chromedebugo.NewBrowser(f.URL, f.Client())
+	target, err := b.NewTarget("https://example.com/?q=a b")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if target.Id != "page-2" {
+		t.Errorf("got target %+v", target)
+	}
+	if _, err := b.NewTarget(""); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{"PUT /json/new?https%3A%2F%2Fexample.com%2F%3Fq%3Da+b", "PUT /json/new"}
+	if strings.Join(f.requests, ", ") != strings.Join(want, ", ") {
+		t.Errorf("got requests %q, want %q", f.requests, want)
+	}
+}
+
+func TestBrowserActivateAndCloseTarget(t *testing.T) {
+	f := newFakeEndpoints(t)
+	b := chromedebugo.NewBrowser(f.URL, f.Client())
+	if err := b.ActivateTarget("page-1"); err != nil {
+		t.Fatal(err)
+	}
+	if err := b.CloseTarget("page-1"); err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"GET /json/activate/page-1", "GET /json/close/page-1"}
+	if strings.Join(f.requests, ", ") != strings.Join(want, ", ") {
+		t.Errorf("got requests %q, want %q", f.requests, want)
+	}
+}
+
+func TestBrowserHTTPError(t *testing.T) {
+	f := newFakeEndpoints(t)
+	err := chromedebugo.NewBrowser(f.URL, f.Client()).CloseTarget("missing")
+
+	var httpErr chromedebugo.HTTPError
+	if !errors.As(err, &httpErr) {
+		t.Fatalf("got %v, want an HTTPError", err)
+	}
+	if httpErr.Method != "GET" || httpErr.StatusCode != http.StatusNotFound || httpErr.URL != f.URL+"/json/close/missing" {
+		t.Errorf("got %+v", httpErr)
+	}
+	if httpErr.Body != "No such target id: missing" {
+		t.Errorf("got body %q", httpErr.Body)
+	}
+	if !strings.Contains(err.Error(), "failed with status 404: No such target id") {
+		t.Errorf("got message %q", err)
+	}
+}
+
+func TestBrowserDecodeError(t *testing.T) {
+	f := newFakeEndpoints(t)
+	_, err := chromedebugo.NewBrowser(f.URL, f.Client()).Protocol()
+	if err == nil || !strings.Contains(err.Error(), "error decoding response from "+f.URL+"/json/protocol") {
+		t.Errorf("got %v, want a decoding error", err)
+	}
+}
@@ -0,0 +1,173 @@
+// Package capture takes screenshots of pages and prints them to PDF,
+// returning the decoded image or document rather than chrome's base64:
+//
+//	png, err := capture.CaptureScreenshot(ctx, debugger, capture.WithFullPage())
+//
+// Large PDFs can be streamed to an io.Writer with StreamPDF, which reads the
+// document from chrome in chunks.
+package capture
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"math"
+
+	"github.com/tonyhb/chromedebugo"
+	ioproto "github.com/tonyhb/chromedebugo/protocol/io"
+	"github.com/tonyhb/chromedebugo/protocol/page"
+)
+
+// Format is an image format for screenshots.
+type Format string
+
+// Formats chrome can encode screenshots in.  WithQuality only applies to
+// JPEG and WEBP.
+const (
+	PNG  Format = "png"
+	JPEG Format = "jpeg"
+	WEBP Format = "webp"
+)
+
+// DefaultChunkSize is the number of bytes StreamPDF reads from chrome at a
+// time.
+const DefaultChunkSize = 1 << 20
+
+type config struct {
+	params   page.CaptureScreenshotParams
+	fullPage bool
+}
+
+// Option configures a screenshot.
+type Option func(*config)
+
+// WithFormat sets the image format, PNG by default.
+func WithFormat(format Format) Option {
+	return func(c *config) {
+		c.params.Format = string(format)
+	}
+}
+
+// WithQuality sets the compression quality, from 0 to 100, of JPEG and WEBP
+// screenshots.
+func WithQuality(quality int) Option {
+	return func(c *config) {
+		c.params.Quality = int64(quality)
+	}
+}
+
+// WithClip captures only the given rectangle of the page, in CSS pixels.
+func WithClip(x, y, width, height float64) Option {
+	return func(c *config) {
+		c.params.Clip = &page.Viewport{X: x, Y: y, Width: width, Height: height, Scale: 1}
+	}
+}
+
+// WithFullPage captures the whole page rather than the viewport.
+func WithFullPage() Option {
+	return func(c *config) {
+		c.fullPage = true
+	}
+}
+
+// CaptureScreenshot captures the page and returns the encoded image.
+//
+// A full page screenshot captures beyond the viewport, clipped to the size
+// of the page's content unless WithClip is given, and leaves the viewport
+// and any device metrics override as they were.
+func CaptureScreenshot(ctx context.Context, d chromedebugo.SyncDebugger, opts ...Option) ([]byte, error) {
+	c := &config{}
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	if c.fullPage {
+		c.params.CaptureBeyondViewport = true
+		if c.params.Clip == nil {
+			metrics, err := page.GetLayoutMetricsParams{}.Do(ctx, d)
+			if err != nil {
+				return nil, err
+			}
+			size := metrics.CSSContentSize
+			c.params.Clip = &page.Viewport{
+				Width:  math.Ceil(size.Width),
+				Height: math.Ceil(size.Height),
+				Scale:  1,
+			}
+		}
+	}
+
+	ret, err := c.params.Do(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+	return decode(ret.Data)
+}
+
+// PrintToPDF prints the page to PDF and returns the document.  The params
+// are those of Page.printToPDF; the transfer mode is ignored.
+func PrintToPDF(ctx context.Context, d chromedebugo.SyncDebugger, params page.PrintToPDFParams) ([]byte, error) {
+	params.TransferMode = "ReturnAsBase64"
+	ret, err := params.Do(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+	return decode(ret.Data)
+}
+
+// StreamPDF prints the page to PDF as PrintToPDF does, but has chrome return
+// the document as a stream which is copied to w a chunk at a time, so that
+// the whole document is never held in memory.  It returns the number of
+// bytes written.
+func StreamPDF(ctx context.Context, d chromedebugo.SyncDebugger, params page.PrintToPDFParams, w io.Writer) (int64, error) {
+	params.TransferMode = "ReturnAsStream"
+	ret, err := params.Do(ctx, d)
+	if err != nil {
+		return 0, err
+	}
+	if ret.Stream == "" {
+		return 0, fmt.Errorf("chrome returned no stream for the PDF")
+	}
+	return copyStream(ctx, d, ret.Stream, w)
+}
+
+// copyStream reads an IO stream to w and closes it.
+func copyStream(ctx context.Context, d chromedebugo.SyncDebugger, handle ioproto.StreamHandle, w io.Writer) (n int64, err error) {
+	defer func() {
+		// the stream is closed even if ctx is done, so that chrome can
+		// free it
+		if cerr := (ioproto.CloseParams{Handle: handle}).Do(context.Background(), d); cerr != nil && err == nil {
+			err = cerr
+		}
+	}()
+
+	for {
+		ret, err := ioproto.ReadParams{Handle: handle, Size: DefaultChunkSize}.Do(ctx, d)
+		if err != nil {
+			return n, err
+		}
+		data := []byte(ret.Data)
+		if ret.Base64Encoded {
+			if data, err = decode(ret.Data); err != nil {
+				return n, err
+			}
+		}
+		written, err := w.Write(data)
+		n += int64(written)
+		if err != nil {
+			return n, err
+		}
+		if ret.Eof {
+			return n, nil
+		}
+	}
+}
+
+func decode(data string) ([]byte, error) {
+	b, err := base64.StdEncoding.DecodeString(data)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding data from chrome: %s", err)
+	}
+	return b, nil
+}
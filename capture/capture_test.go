@@ -0,0 +1,102 @@
+package capture_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"sync/atomic"
+	"testing"
+
+	"github.com/tonyhb/chromedebugo"
+	"github.com/tonyhb/chromedebugo/capture"
+	"github.com/tonyhb/chromedebugo/cdptest"
+	"github.com/tonyhb/chromedebugo/protocol/page"
+)
+
+func newPage(t *testing.T) (*cdptest.Server, chromedebugo.SyncDebugger) {
+	t.Helper()
+	srv := cdptest.NewServer()
+	t.Cleanup(srv.Close)
+	d, err := chromedebugo.NewSync(srv.URL())
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { d.Close() })
+	return srv, d
+}
+
+func TestCaptureFullPage(t *testing.T) {
+	srv, d := newPage(t)
+	image := []byte("\x89PNG")
+	srv.Handle("Page.getLayoutMetrics", cdptest.Result(map[string]interface{}{
+		"cssContentSize": map[string]interface{}{"x": 0, "y": 0, "width": 800.5, "height": 3000},
+	}))
+	srv.Handle("Page.captureScreenshot", cdptest.Result(map[string]interface{}{
+		"data": base64.StdEncoding.EncodeToString(image),
+	}))
+
+	got, err := capture.CaptureScreenshot(context.Background(), d, capture.WithFullPage())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, image) {
+		t.Errorf("got image %q, want %q", got, image)
+	}
+
+	params := srv.AssertReceived(t, "Page.captureScreenshot").Params
+	if params["captureBeyondViewport"] != true {
+		t.Errorf("captured with params %v, want captureBeyondViewport", params)
+	}
+	clip, _ := params["clip"].(map[string]interface{})
+	if clip["width"] != float64(801) || clip["height"] != float64(3000) || clip["scale"] != float64(1) {
+		t.Errorf("clipped to %v, want the content size", clip)
+	}
+	// the viewport is left alone, so overrides set by the caller survive
+	if cmds := srv.Received("Emulation.*"); len(cmds) != 0 {
+		t.Errorf("sent %v", cmds)
+	}
+}
+
+func TestCaptureFullPageClip(t *testing.T) {
+	srv, d := newPage(t)
+	srv.Handle("Page.captureScreenshot", cdptest.Result(map[string]interface{}{"data": ""}))
+
+	_, err := capture.CaptureScreenshot(context.Background(), d, capture.WithFullPage(), capture.WithClip(0, 2000, 100, 100))
+	if err != nil {
+		t.Fatal(err)
+	}
+	params := srv.AssertReceived(t, "Page.captureScreenshot").Params
+	clip, _ := params["clip"].(map[string]interface{})
+	if params["captureBeyondViewport"] != true || clip["y"] != float64(2000) {
+		t.Errorf("captured with params %v", params)
+	}
+	if cmds := srv.Received("Page.getLayoutMetrics"); len(cmds) != 0 {
+		t.Error("measured the page though the clip was given")
+	}
+}
+
+func TestStreamPDF(t *testing.T) {
+	srv, d := newPage(t)
+	srv.Handle("Page.printToPDF", cdptest.Result(map[string]interface{}{"data": "", "stream": "stream-1"}))
+	chunks := []map[string]interface{}{
+		{"data": "%PDF-", "base64Encoded": false, "eof": false},
+		{"data": base64.StdEncoding.EncodeToString([]byte("1.7")), "base64Encoded": true, "eof": true},
+	}
+	reads := int32(0)
+	srv.Handle("IO.read", func(chromedebugo.Command) cdptest.Response {
+		return cdptest.Response{Result: chunks[atomic.AddInt32(&reads, 1)-1]}
+	})
+	srv.Handle("IO.close", cdptest.Result(nil))
+
+	buf := &bytes.Buffer{}
+	n, err := capture.StreamPDF(context.Background(), d, page.PrintToPDFParams{}, buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if buf.String() != "%PDF-1.7" || n != int64(buf.Len()) {
+		t.Errorf("wrote %d bytes %q", n, buf)
+	}
+	if params := srv.AssertReceived(t, "IO.close").Params; params["handle"] != "stream-1" {
+		t.Errorf("closed %v", params)
+	}
+}
@@ -0,0 +1,184 @@
+package cdptest
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/tonyhb/chromedebugo"
+)
+
+// Mismatch is a command sent to a replay server which differs from the
+// command recorded in its place.
+type Mismatch struct {
+	// Index counts the recording's commands, from 0, up to the one
+	// expected
+	Index int
+	// Expected is the recorded command, and has an empty Method if the
+	// recording had no more commands
+	Expected chromedebugo.Command
+	Got      chromedebugo.Command
+}
+
+func (m Mismatch) String() string {
+	if m.Expected.Method == "" {
+		return fmt.Sprintf("command %d: got %s %v after the recording ended", m.Index, m.Got.Method, m.Got.Params)
+	}
+	return fmt.Sprintf(
+		"command %d: expected %s %v, got %s %v",
+		m.Index,
+		m.Expected.Method,
+		m.Expected.Params,
+		m.Got.Method,
+		m.Got.Params,
+	)
+}
+
+// NewReplayServer starts a fake chrome which plays back a recording made with
+// chromedebugo.WithRecording, so that a program can be rerun offline.
+//
+// Each command received is compared with the next command recorded.  If it
+// matches, the frames chrome sent up to the next recorded command are played
+// back, with response IDs rewritten to the IDs of the commands received.  A
+// command which doesn't match is answered with an error and recorded as a
+// Mismatch, and playback waits for the expected command.  Replay is only
+// deterministic for programs which send their commands in a deterministic
+// order.
+func NewReplayServer(frames []chromedebugo.Frame) *Server {
+	s := NewServer()
+	s.replay = &replayer{
+		frames: frames,
+		ids:    map[int]int{},
+	}
+	return s
+}
+
+// Mismatches returns the commands received by a replay server which differed
+// from the recording.
+func (s *Server) Mismatches() []Mismatch {
+	if s.replay == nil {
+		return nil
+	}
+	s.replay.lock.Lock()
+	defer s.replay.lock.Unlock()
+	return append([]Mismatch(nil), s.replay.mismatches...)
+}
+
+// AssertNoMismatches fails the test if any command received by a replay
+// server differed from the recording.
+func (s *Server) AssertNoMismatches(t testing.TB) {
+	t.Helper()
+	mismatches := s.Mismatches()
+	if len(mismatches) == 0 {
+		return
+	}
+	lines := make([]string, len(mismatches))
+	for i, m := range mismatches {
+		lines[i] = m.String()
+	}
+	t.Fatalf("cdptest: replay mismatches:\n%s", strings.Join(lines, "\n"))
+}
+
+// replayer plays a recording back to the clients of a Server.
+type replayer struct {
+	// lock guards the playback state and orders writes of the frames
+	lock   sync.Mutex
+	frames []chromedebugo.Frame
+	// next is the index of the next frame to play or match
+	next int
+	// sent counts the recorded commands matched so far
+	sent int
+	// ids maps recorded command IDs to the IDs of the commands received
+	ids        map[int]int
+	mismatches []Mismatch
+}
+
+// recordedCommand is a command frame from a recording.
+type recordedCommand struct {
+	ID int `json:"id"`
+	chromedebugo.Command
+}
+
+func (r *replayer) respond(c *conn, id int, cmd chromedebugo.Command) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	// play anything chrome sent before the next command, such as events
+	// from before the recording's first command
+	r.play(c)
+
+	expected := recordedCommand{}
+	if r.next < len(r.frames) {
+		if err := json.Unmarshal(r.frames[r.next].Data, &expected); err != nil {
+			r.fail(c, id, fmt.Sprintf("error decoding recorded frame %d: %s", r.next, err))
+			return
+		}
+	}
+	if !sameCommand(expected.Command, cmd) {
+		r.mismatches = append(r.mismatches, Mismatch{
+			Index:    r.sent,
+			Expected: expected.Command,
+			Got:      cmd,
+		})
+		r.fail(c, id, fmt.Sprintf("replay mismatch: %s", r.mismatches[len(r.mismatches)-1]))
+		return
+	}
+
+	r.ids[expected.ID] = id
+	r.next++
+	r.sent++
+	r.play(c)
+}
+
+// play writes the received frames up to the next recorded command.
+func (r *replayer) play(c *conn) {
+	for ; r.next < len(r.frames); r.next++ {
+		frame := r.frames[r.next]
+		if frame.Direction != chromedebugo.DirectionReceive {
+			return
+		}
+		c.write(r.rewrite(frame.Data))
+	}
+}
+
+// rewrite replaces the ID of a recorded response with the ID of the command
+// received in its place.
+func (r *replayer) rewrite(data json.RawMessage) json.RawMessage {
+	msg := map[string]json.RawMessage{}
+	if err := json.Unmarshal(data, &msg); err != nil {
+		return data
+	}
+	recorded := 0
+	if err := json.Unmarshal(msg["id"], &recorded); err != nil {
+		return data
+	}
+	id, ok := r.ids[recorded]
+	if !ok {
+		return data
+	}
+	msg["id"], _ = json.Marshal(id)
+	rewritten, _ := json.Marshal(msg)
+	return rewritten
+}
+
+func (r *replayer) fail(c *conn, id int, message string) {
+	c.write(map[string]interface{}{
+		"id":    id,
+		"error": chromedebugo.ErrorDetail{Code: -32000, Message: "cdptest: " + message},
+	})
+}
+
+// sameCommand compares commands as sent over the wire, so that missing and
+// empty params are the same.
+func sameCommand(a, b chromedebugo.Command) bool {
+	if a.Method != b.Method || a.SessionID != b.SessionID {
+		return false
+	}
+	if len(a.Params) == 0 && len(b.Params) == 0 {
+		return true
+	}
+	return reflect.DeepEqual(a.Params, b.Params)
+}
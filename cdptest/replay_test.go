@@ -0,0 +1,189 @@
+package cdptest_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/tonyhb/chromedebugo"
+	"github.com/tonyhb/chromedebugo/cdptest"
+)
+
+// record runs a session against a fake chrome, recording it, and returns
+// the recording.
+func record(t *testing.T) []chromedebugo.Frame {
+	t.Helper()
+	srv := cdptest.NewServer()
+	defer srv.Close()
+	srv.Handle("Page.enable", func(chromedebugo.Command) cdptest.Response {
+		srv.Emit("Page.frameResized", nil)
+		return cdptest.Response{}
+	})
+	srv.Handle("Runtime.evaluate", func(cmd chromedebugo.Command) cdptest.Response {
+		return cdptest.Response{Result: map[string]interface{}{
+			"result": map[string]interface{}{"type": "string", "value": cmd.Params["expression"]},
+		}}
+	})
+
+	recording := &bytes.Buffer{}
+	d, err := chromedebugo.NewSync(srv.URL(), chromedebugo.WithRecording(recording))
+	if err != nil {
+		t.Fatal(err)
+	}
+	run(t, d)
+	d.Close()
+
+	frames, err := chromedebugo.ReadRecording(recording)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return frames
+}
+
+// run sends the session's commands, checking chrome's replies.
+func run(t *testing.T, d chromedebugo.SyncDebugger) {
+	t.Helper()
+	events, unsubscribe := d.Subscribe("Page.frameResized")
+	defer unsubscribe()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if _, err := d.SendContext(ctx, chromedebugo.Command{Method: "Page.enable"}); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case <-events:
+	case <-ctx.Done():
+		t.Fatal("event not received")
+	}
+	for _, expr := range []string{"1 + 1", "document.title"} {
+		res, err := d.SendContext(ctx, chromedebugo.Command{
+			Method: "Runtime.evaluate",
+			Params: map[string]interface{}{"expression": expr},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if value := res.Result["result"].(map[string]interface{})["value"]; value != expr {
+			t.Errorf("evaluating %q returned %v", expr, value)
+		}
+	}
+}
+
+func TestRecording(t *testing.T) {
+	frames := record(t)
+	directions := make([]string, len(frames))
+	for i, f := range frames {
+		directions[i] = f.Direction
+		if f.Time.IsZero() {
+			t.Errorf("frame %d has no time", i)
+		}
+	}
+	// the event is sent before Page.enable's response
+	want := "send receive receive send receive send receive"
+	if got := strings.Join(directions, " "); got != want {
+		t.Fatalf("got frames %s, want %s", got, want)
+	}
+	cmd := struct {
+		Method string                 `json:"method"`
+		Params map[string]interface{} `json:"params"`
+	}{}
+	if err := json.Unmarshal(frames[3].Data, &cmd); err != nil {
+		t.Fatal(err)
+	}
+	if cmd.Method != "Runtime.evaluate" || cmd.Params["expression"] != "1 + 1" {
+		t.Errorf("got command %s", frames[3].Data)
+	}
+}
+
+// shiftIDs adds n to the IDs of the commands and responses in frames, as if
+// they were recorded from a program which had sent n commands before.
+func shiftIDs(t *testing.T, frames []chromedebugo.Frame, n int) []chromedebugo.Frame {
+	t.Helper()
+	shifted := make([]chromedebugo.Frame, len(frames))
+	for i, f := range frames {
+		msg := map[string]interface{}{}
+		if err := json.Unmarshal(f.Data, &msg); err != nil {
+			t.Fatal(err)
+		}
+		if id, ok := msg["id"].(float64); ok {
+			msg["id"] = id + float64(n)
+		}
+		data, err := json.Marshal(msg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		f.Data = data
+		shifted[i] = f
+	}
+	return shifted
+}
+
+func TestReplay(t *testing.T) {
+	// responses are only matched to the replaying program's commands if
+	// their IDs are rewritten
+	srv := cdptest.NewReplayServer(shiftIDs(t, record(t), 100))
+	defer srv.Close()
+	d, err := chromedebugo.NewSync(srv.URL())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer d.Close()
+
+	run(t, d)
+	srv.AssertNoMismatches(t)
+}
+
+func TestReplayMismatch(t *testing.T) {
+	srv := cdptest.NewReplayServer(record(t))
+	defer srv.Close()
+	d, err := chromedebugo.NewSync(srv.URL())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer d.Close()
+
+	_, err = d.Send(chromedebugo.Command{Method: "Page.navigate", Params: map[string]interface{}{"url": "about:blank"}})
+	var chromeErr chromedebugo.Error
+	if !errors.As(err, &chromeErr) || !strings.Contains(err.Error(), "replay mismatch") {
+		t.Fatalf("got %v, want a replay mismatch", err)
+	}
+	mismatches := srv.Mismatches()
+	if len(mismatches) != 1 {
+		t.Fatalf("got mismatches %v", mismatches)
+	}
+	m := mismatches[0]
+	if m.Index != 0 || m.Expected.Method != "Page.enable" || m.Got.Method != "Page.navigate" || m.Got.Params["url"] != "about:blank" {
+		t.Errorf("got mismatch %+v", m)
+	}
+
+	// playback waits for the expected command
+	run(t, d)
+	if n := len(srv.Mismatches()); n != 1 {
+		t.Errorf("got %d mismatches, want 1", n)
+	}
+
+	// the recording has ended
+	_, err = d.Send(chromedebugo.Command{Method: "Page.enable"})
+	if !errors.As(err, &chromeErr) {
+		t.Fatalf("got %v, want a replay mismatch", err)
+	}
+	if m := srv.Mismatches()[1]; m.Index != 3 || m.Expected.Method != "" || !strings.Contains(m.String(), "after the recording ended") {
+		t.Errorf("got mismatch %s", m)
+	}
+}
+
+func TestReadRecordingError(t *testing.T) {
+	recording := `{"time":"2024-01-01T00:00:00Z","direction":"send","frame":{"id":1,"method":"Page.enable"}}
+
+not json
+`
+	_, err := chromedebugo.ReadRecording(strings.NewReader(recording))
+	if err == nil || !strings.Contains(err.Error(), "line 3") {
+		t.Errorf("got %v, want an error decoding line 3", err)
+	}
+}
@@ -0,0 +1,360 @@
+// Package cdptest provides a fake chrome for testing code built on the
+// debuggers without a browser.  The Server serves /json/version, /json/list
+// and a websocket endpoint for every target, answers commands as scripted,
+// sends events on demand and records the commands it receives:
+//
+//	srv := cdptest.NewServer()
+//	defer srv.Close()
+//	srv.Handle("Runtime.evaluate", cdptest.Result(map[string]interface{}{
+//		"result": map[string]interface{}{"type": "number", "value": 2},
+//	}))
+//
+//	debugger, err := chromedebugo.NewSync(srv.URL())
+//	...
+//	cmd := srv.AssertReceived(t, "Runtime.evaluate")
+package cdptest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/tonyhb/chromedebugo"
+)
+
+// Response is the server's answer to one command.
+type Response struct {
+	// Result is encoded as the command's result.  A nil Result sends an
+	// empty object.
+	Result interface{}
+	// Error, if set, is sent instead of the result.
+	Error *chromedebugo.ErrorDetail
+	// Delay holds the response back.  Other commands are answered in the
+	// meantime, as chrome does.
+	Delay time.Duration
+}
+
+// Handler answers the commands for a method.
+type Handler func(cmd chromedebugo.Command) Response
+
+// Result returns a Handler which always responds with result.
+func Result(result interface{}) Handler {
+	return func(chromedebugo.Command) Response {
+		return Response{Result: result}
+	}
+}
+
+// Fail returns a Handler which always responds with an error.
+func Fail(code int, message string) Handler {
+	return func(chromedebugo.Command) Response {
+		return Response{Error: &chromedebugo.ErrorDetail{Code: code, Message: message}}
+	}
+}
+
+// Delayed returns a Handler which responds as h does after d.
+func Delayed(d time.Duration, h Handler) Handler {
+	return func(cmd chromedebugo.Command) Response {
+		resp := h(cmd)
+		resp.Delay += d
+		return resp
+	}
+}
+
+// Server is a fake chrome.  Commands without a handler fail with the error
+// chrome gives for unknown methods.  It is safe for concurrent use.
+type Server struct {
+	srv *httptest.Server
+
+	lock     sync.Mutex
+	handlers map[string]Handler
+	targets  []chromedebugo.Info
+	conns    map[*conn]struct{}
+	received []chromedebugo.Command
+	// receivedChan is closed and replaced each time a command is received
+	receivedChan chan struct{}
+
+	// replay, if set, answers every command from a recording
+	replay *replayer
+}
+
+// conn is one websocket connection to the server.
+type conn struct {
+	ws *websocket.Conn
+	// lock guards writes to ws, which only supports one concurrent writer
+	lock sync.Mutex
+}
+
+func (c *conn) write(v interface{}) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.ws.WriteJSON(v)
+}
+
+// NewServer starts a fake chrome with a single page target.
+func NewServer() *Server {
+	s := &Server{
+		handlers:     map[string]Handler{},
+		conns:        map[*conn]struct{}{},
+		receivedChan: make(chan struct{}),
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/json/version", s.serveVersion)
+	mux.HandleFunc("/json/list", s.serveList)
+	mux.HandleFunc("/json", s.serveList)
+	mux.HandleFunc("/devtools/", s.serveWebsocket)
+	s.srv = httptest.NewServer(mux)
+	s.targets = []chromedebugo.Info{{Id: "page-1", Type: "page", Title: "about:blank", URL: "about:blank"}}
+	return s
+}
+
+// URL returns the address of the server's HTTP endpoints, for NewSync and
+// NewAsync.
+func (s *Server) URL() string {
+	return s.srv.URL
+}
+
+// BrowserURL returns the browser target's websocket debugger URL, for
+// NewBrowserSync.
+func (s *Server) BrowserURL() string {
+	return s.websocketURL("browser", "cdptest")
+}
+
+// Close disconnects every client and stops the server.
+func (s *Server) Close() {
+	s.Disconnect()
+	s.srv.Close()
+}
+
+// Handle sets the handler for method, replacing any set before.
+func (s *Server) Handle(method string, h Handler) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.handlers[method] = h
+}
+
+// SetTargets replaces the targets served by /json/list.  Targets without a
+// WebsocketDebuggerURL are given one on this server.
+func (s *Server) SetTargets(targets ...chromedebugo.Info) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.targets = targets
+}
+
+// Emit sends an event to every connected client.
+func (s *Server) Emit(method string, params interface{}) error {
+	return s.EmitTo("", method, params)
+}
+
+// EmitTo sends an event from the session with the given ID, as chrome does
+// for targets attached in flattened mode.
+func (s *Server) EmitTo(sessionID, method string, params interface{}) error {
+	msg := map[string]interface{}{"method": method, "params": params}
+	if params == nil {
+		msg["params"] = map[string]interface{}{}
+	}
+	if sessionID != "" {
+		msg["sessionId"] = sessionID
+	}
+	for _, c := range s.connections() {
+		if err := c.write(msg); err != nil {
+			return fmt.Errorf("error sending event %s: %s", method, err)
+		}
+	}
+	return nil
+}
+
+// Disconnect closes every websocket connection abruptly, as when chrome
+// crashes.  Clients may connect again afterwards.
+func (s *Server) Disconnect() {
+	for _, c := range s.connections() {
+		c.ws.Close()
+	}
+}
+
+// Connections returns the number of connected clients.
+func (s *Server) Connections() int {
+	return len(s.connections())
+}
+
+// Received returns the commands received for method, in order.  The method
+// may be a prefix ending in "*" such as "Page.*", and "*" returns every
+// command.
+func (s *Server) Received(method string) []chromedebugo.Command {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	cmds := []chromedebugo.Command{}
+	for _, cmd := range s.received {
+		if matches(method, cmd.Method) {
+			cmds = append(cmds, cmd)
+		}
+	}
+	return cmds
+}
+
+// WaitForCommand returns the first command received for method, waiting for
+// one if none has been received yet.
+func (s *Server) WaitForCommand(ctx context.Context, method string) (chromedebugo.Command, error) {
+	for {
+		s.lock.Lock()
+		ch := s.receivedChan
+		s.lock.Unlock()
+		if cmds := s.Received(method); len(cmds) > 0 {
+			return cmds[0], nil
+		}
+		select {
+		case <-ch:
+		case <-ctx.Done():
+			return chromedebugo.Command{}, fmt.Errorf("no %s command received: %s", method, ctx.Err())
+		}
+	}
+}
+
+// AssertReceived fails the test unless a command for method has been
+// received, and returns the first such command.
+func (s *Server) AssertReceived(t testing.TB, method string) chromedebugo.Command {
+	t.Helper()
+	cmds := s.Received(method)
+	if len(cmds) == 0 {
+		t.Fatalf("cdptest: no %s command received", method)
+	}
+	return cmds[0]
+}
+
+func (s *Server) connections() []*conn {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	conns := make([]*conn, 0, len(s.conns))
+	for c := range s.conns {
+		conns = append(conns, c)
+	}
+	return conns
+}
+
+func (s *Server) websocketURL(kind, id string) string {
+	return "ws" + strings.TrimPrefix(s.srv.URL, "http") + "/devtools/" + kind + "/" + id
+}
+
+func (s *Server) serveVersion(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, chromedebugo.Version{
+		Browser:              "HeadlessChrome/cdptest",
+		ProtocolVersion:      "1.3",
+		UserAgent:            "Mozilla/5.0 HeadlessChrome/cdptest",
+		WebsocketDebuggerURL: s.BrowserURL(),
+	})
+}
+
+func (s *Server) serveList(w http.ResponseWriter, r *http.Request) {
+	s.lock.Lock()
+	targets := make([]chromedebugo.Info, len(s.targets))
+	copy(targets, s.targets)
+	s.lock.Unlock()
+
+	for i, t := range targets {
+		if t.WebsocketDebuggerURL == "" {
+			targets[i].WebsocketDebuggerURL = s.websocketURL(t.Type, t.Id)
+		}
+	}
+	writeJSON(w, targets)
+}
+
+func (s *Server) serveWebsocket(w http.ResponseWriter, r *http.Request) {
+	ws, err := (&websocket.Upgrader{}).Upgrade(w, r, nil)
+	if err != nil {
+		return
+	}
+	c := &conn{ws: ws}
+	s.lock.Lock()
+	s.conns[c] = struct{}{}
+	s.lock.Unlock()
+	defer func() {
+		s.lock.Lock()
+		delete(s.conns, c)
+		s.lock.Unlock()
+		ws.Close()
+	}()
+
+	for {
+		_, data, err := ws.ReadMessage()
+		if err != nil {
+			return
+		}
+		msg := struct {
+			ID int `json:"id"`
+			chromedebugo.Command
+		}{}
+		if err := json.Unmarshal(data, &msg); err != nil {
+			return
+		}
+		// commands are recorded here rather than by respond so that
+		// they are kept in the order they arrived
+		h := s.record(msg.Command)
+		if s.replay != nil {
+			// replies follow the recording's order, so commands are
+			// answered one at a time
+			s.replay.respond(c, msg.ID, msg.Command)
+			continue
+		}
+		go s.respond(c, msg.ID, msg.Command, h)
+	}
+}
+
+// record adds cmd to the commands received, wakes WaitForCommand and returns
+// the handler for cmd, or nil if there is none.
+func (s *Server) record(cmd chromedebugo.Command) Handler {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.received = append(s.received, cmd)
+	close(s.receivedChan)
+	s.receivedChan = make(chan struct{})
+	return s.handlers[cmd.Method]
+}
+
+// respond sends the response from cmd's handler h, which is nil for methods
+// with no handler.
+func (s *Server) respond(c *conn, id int, cmd chromedebugo.Command, h Handler) {
+	resp := Response{Error: &chromedebugo.ErrorDetail{
+		Code:    -32601,
+		Message: fmt.Sprintf("'%s' wasn't found", cmd.Method),
+	}}
+	if h != nil {
+		resp = h(cmd)
+	}
+	if resp.Delay > 0 {
+		time.Sleep(resp.Delay)
+	}
+
+	msg := map[string]interface{}{"id": id}
+	if cmd.SessionID != "" {
+		msg["sessionId"] = cmd.SessionID
+	}
+	switch {
+	case resp.Error != nil:
+		msg["error"] = resp.Error
+	case resp.Result != nil:
+		msg["result"] = resp.Result
+	default:
+		msg["result"] = map[string]interface{}{}
+	}
+	// a failed write means the client has gone, which its read loop
+	// notices
+	c.write(msg)
+}
+
+func matches(pattern, method string) bool {
+	if strings.HasSuffix(pattern, "*") {
+		return strings.HasPrefix(method, strings.TrimSuffix(pattern, "*"))
+	}
+	return pattern == method
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(v)
+}
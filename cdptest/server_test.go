@@ -0,0 +1,115 @@
+package cdptest_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/tonyhb/chromedebugo"
+	"github.com/tonyhb/chromedebugo/cdptest"
+)
+
+func TestReceivedInArrivalOrder(t *testing.T) {
+	srv := cdptest.NewServer()
+	defer srv.Close()
+	d, err := chromedebugo.NewSync(srv.URL())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer d.Close()
+
+	cmds := make([]chromedebugo.Command, 300)
+	for i := range cmds {
+		cmds[i] = chromedebugo.Command{Method: fmt.Sprintf("M.%d", i)}
+		srv.Handle(cmds[i].Method, cdptest.Result(nil))
+	}
+	if _, err := d.Batch(cmds); err != nil {
+		t.Fatal(err)
+	}
+
+	received := srv.Received("M.*")
+	if len(received) != len(cmds) {
+		t.Fatalf("received %d commands, want %d", len(received), len(cmds))
+	}
+	for i, cmd := range received {
+		if cmd.Method != cmds[i].Method {
+			t.Fatalf("command %d is %s, want %s", i, cmd.Method, cmds[i].Method)
+		}
+	}
+}
+
+func TestUnhandledMethod(t *testing.T) {
+	srv := cdptest.NewServer()
+	defer srv.Close()
+	d, err := chromedebugo.NewSync(srv.URL())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer d.Close()
+
+	_, err = d.Send(chromedebugo.Command{Method: "Nope.nothing"})
+	e, ok := err.(chromedebugo.Error)
+	if !ok {
+		t.Fatalf("got %v, want an Error", err)
+	}
+	if e.ErrorDetail.Code != -32601 {
+		t.Errorf("got code %d, want -32601", e.ErrorDetail.Code)
+	}
+}
+
+func TestWaitForCommand(t *testing.T) {
+	srv := cdptest.NewServer()
+	defer srv.Close()
+	srv.Handle("Page.enable", cdptest.Result(nil))
+	d, err := chromedebugo.NewSync(srv.URL())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer d.Close()
+
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		d.Send(chromedebugo.Command{Method: "Page.enable", Params: map[string]interface{}{"n": 1}})
+		d.Send(chromedebugo.Command{Method: "Page.enable", Params: map[string]interface{}{"n": 2}})
+	}()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	cmd, err := srv.WaitForCommand(ctx, "Page.enable")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cmd.Params["n"] != float64(1) {
+		t.Errorf("got params %v, want the first command's", cmd.Params)
+	}
+
+	ctx, cancel = context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := srv.WaitForCommand(ctx, "Page.reload"); err == nil {
+		t.Error("WaitForCommand returned a command which was never sent")
+	}
+}
+
+func TestEmit(t *testing.T) {
+	srv := cdptest.NewServer()
+	defer srv.Close()
+	d, err := chromedebugo.NewSync(srv.URL())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer d.Close()
+
+	events, unsubscribe := d.Subscribe("Page.loadEventFired")
+	defer unsubscribe()
+	if err := srv.Emit("Page.loadEventFired", map[string]interface{}{"timestamp": 1}); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case ev := <-events:
+		if ev.Params["timestamp"] != float64(1) {
+			t.Errorf("got params %v", ev.Params)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("event not received")
+	}
+}
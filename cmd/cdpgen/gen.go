@@ -0,0 +1,557 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+const docURL = "https://chromedevtools.github.io/devtools-protocol/tot/"
+
+// generator renders Go source for a set of protocol domains.
+type generator struct {
+	// root is the import path of the chromedebugo package
+	root string
+
+	domains []domain
+	// types stores every declared type keyed by "Domain.Type"
+	types map[string]typeDef
+}
+
+func newGenerator(root string, domains []domain) (*generator, error) {
+	g := &generator{
+		root:    root,
+		domains: domains,
+		types:   map[string]typeDef{},
+	}
+	for _, d := range domains {
+		for _, t := range d.Types {
+			key := d.Domain + "." + t.ID
+			if _, ok := g.types[key]; ok {
+				return nil, fmt.Errorf("duplicate type %s", key)
+			}
+			g.types[key] = t
+		}
+	}
+	return g, nil
+}
+
+func (g *generator) protocolPkg() string {
+	return g.root + "/protocol"
+}
+
+func (g *generator) typesPkg() string {
+	return g.root + "/protocol/internal/types"
+}
+
+// file accumulates the body of a single Go source file along with the
+// imports it needs.
+type file struct {
+	pkg     string
+	doc     string
+	imports map[string]bool
+	body    bytes.Buffer
+	// names guards against two declarations rendering to the same Go
+	// identifier
+	names map[string]string
+}
+
+func newFile(pkg, doc string) *file {
+	return &file{
+		pkg:     pkg,
+		doc:     doc,
+		imports: map[string]bool{},
+		names:   map[string]string{},
+	}
+}
+
+func (f *file) printf(format string, args ...interface{}) {
+	fmt.Fprintf(&f.body, format, args...)
+}
+
+// declare records that name is declared by the protocol entity from.
+func (f *file) declare(name, from string) error {
+	if prev, ok := f.names[name]; ok {
+		return fmt.Errorf("%s and %s both render to %s.%s", prev, from, f.pkg, name)
+	}
+	f.names[name] = from
+	return nil
+}
+
+func (f *file) bytes() ([]byte, error) {
+	buf := &bytes.Buffer{}
+	fmt.Fprintf(buf, "// Code generated by cdpgen. DO NOT EDIT.\n\n")
+	if f.doc != "" {
+		buf.WriteString(f.doc)
+	}
+	fmt.Fprintf(buf, "package %s\n\n", f.pkg)
+
+	imports := []string{}
+	for imp := range f.imports {
+		imports = append(imports, imp)
+	}
+	sort.Strings(imports)
+	if len(imports) > 0 {
+		// standard library imports are grouped before the others
+		buf.WriteString("import (\n")
+		std := true
+		for _, imp := range imports {
+			if std && strings.Contains(imp, ".") {
+				std = false
+				if imp != imports[0] {
+					buf.WriteString("\n")
+				}
+			}
+			fmt.Fprintf(buf, "\t%q\n", imp)
+		}
+		buf.WriteString(")\n\n")
+	}
+	buf.Write(f.body.Bytes())
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("formatting package %s: %s", f.pkg, err)
+	}
+	return src, nil
+}
+
+// typeName returns the name of a declared type within the shared types
+// package, eg. "DOMNodeID" for DOM.NodeId.
+func typeName(domain, id string) string {
+	return goName(domain) + goName(id)
+}
+
+// resolve splits a $ref into its domain and type ID.
+func (g *generator) resolve(ref, domain string) (string, string, typeDef, error) {
+	refDomain, id := domain, ref
+	if i := strings.Index(ref, "."); i >= 0 {
+		refDomain, id = ref[:i], ref[i+1:]
+	}
+	t, ok := g.types[refDomain+"."+id]
+	if !ok {
+		return "", "", typeDef{}, fmt.Errorf("unknown type %s referenced from %s", ref, domain)
+	}
+	return refDomain, id, t, nil
+}
+
+// isStruct reports whether the type renders to a Go struct.
+func isStruct(t typeDef) bool {
+	return t.Type == "object" && len(t.Properties) > 0
+}
+
+// goType returns the Go type expression for p.  Within a domain package
+// types of the same domain are referred to by their local alias while types
+// from other domains are qualified with the shared types package; within the
+// types package itself every type is unqualified.
+func (g *generator) goType(f *file, p property, domain string, inTypes bool) (string, error) {
+	if p.Ref != "" {
+		refDomain, id, t, err := g.resolve(p.Ref, domain)
+		if err != nil {
+			return "", err
+		}
+		var name string
+		switch {
+		case inTypes:
+			name = typeName(refDomain, id)
+		case refDomain == domain:
+			name = goName(id)
+		default:
+			f.imports[g.typesPkg()] = true
+			name = "types." + typeName(refDomain, id)
+		}
+		if isStruct(t) && p.Optional {
+			name = "*" + name
+		}
+		return name, nil
+	}
+
+	switch p.Type {
+	case "string", "binary":
+		return "string", nil
+	case "integer":
+		return "int64", nil
+	case "number":
+		return "float64", nil
+	case "boolean":
+		return "bool", nil
+	case "any":
+		f.imports["encoding/json"] = true
+		return "json.RawMessage", nil
+	case "object":
+		return "map[string]interface{}", nil
+	case "array":
+		if p.Items == nil {
+			return "", fmt.Errorf("array %s in %s has no items", p.Name, domain)
+		}
+		item, err := g.goType(f, *p.Items, domain, inTypes)
+		if err != nil {
+			return "", err
+		}
+		return "[]" + item, nil
+	}
+	return "", fmt.Errorf("unknown type %q for %s in %s", p.Type, p.Name, domain)
+}
+
+// fields renders the struct fields for a list of properties.
+func (g *generator) fields(f *file, props []property, domain string, inTypes bool) error {
+	seen := map[string]string{}
+	for _, p := range props {
+		name := goName(p.Name)
+		if prev, ok := seen[name]; ok {
+			return fmt.Errorf("properties %s and %s in %s both render to %s", prev, p.Name, domain, name)
+		}
+		seen[name] = p.Name
+
+		typ, err := g.goType(f, p, domain, inTypes)
+		if err != nil {
+			return err
+		}
+		tag := p.Name
+		if p.Optional {
+			tag += ",omitempty"
+		}
+
+		desc := p.Description
+		if len(p.Enum) > 0 {
+			desc = paragraph(desc, "Allowed values: "+strings.Join(p.Enum, ", ")+".")
+		}
+		f.body.WriteString(comment("\t", "", desc, p.Experimental, p.Deprecated))
+		f.printf("\t%s %s `json:\"%s\"`\n", name, typ, tag)
+	}
+	return nil
+}
+
+// typesFile renders every type declared by d into the shared types package.
+func (g *generator) typesFile(f *file, d domain) error {
+	for _, t := range d.Types {
+		name := typeName(d.Domain, t.ID)
+		if err := f.declare(name, d.Domain+"."+t.ID); err != nil {
+			return err
+		}
+		desc := paragraph(t.Description, "See: "+docURL+d.Domain+"#type-"+t.ID)
+		f.body.WriteString(comment("", name, desc, t.Experimental, t.Deprecated))
+
+		switch {
+		case isStruct(t):
+			f.printf("type %s struct {\n", name)
+			if err := g.fields(f, t.Properties, d.Domain, true); err != nil {
+				return err
+			}
+			f.printf("}\n\n")
+			continue
+		case t.Type == "array":
+			typ, err := g.goType(f, property{Name: t.ID, Type: "array", Items: t.Items}, d.Domain, true)
+			if err != nil {
+				return err
+			}
+			f.printf("type %s %s\n\n", name, typ)
+			continue
+		}
+
+		typ, err := g.goType(f, property{Name: t.ID, Type: t.Type}, d.Domain, true)
+		if err != nil {
+			return err
+		}
+		f.printf("type %s %s\n\n", name, typ)
+
+		if len(t.Enum) == 0 {
+			continue
+		}
+		f.printf("// Values of %s.\n", name)
+		f.printf("const (\n")
+		for _, v := range enumNames(name, t.Enum) {
+			if err := f.declare(v.name, d.Domain+"."+t.ID+"."+v.value); err != nil {
+				return err
+			}
+			f.printf("\t%s %s = %q\n", v.name, name, v.value)
+		}
+		f.printf(")\n\n")
+	}
+	return nil
+}
+
+type enumValue struct {
+	name  string
+	value string
+}
+
+// enumNames returns the constant names used for the values of an enum type.
+func enumNames(typ string, values []string) []enumValue {
+	out := []enumValue{}
+	for _, v := range values {
+		name := goName(v)
+		if name == "" {
+			name = "Empty"
+		}
+		out = append(out, enumValue{name: typ + name, value: v})
+	}
+	return out
+}
+
+// domainFile renders the package for a single domain: aliases for its types,
+// and params, returns and event structs for its commands and events.
+func (g *generator) domainFile(d domain) (*file, error) {
+	pkg := packageName(d.Domain)
+	doc := fmt.Sprintf("Package %s provides typed bindings for the %s domain of the Chrome DevTools protocol.", pkg, d.Domain)
+	doc = paragraph(doc, d.Description)
+	doc = paragraph(doc, "See: "+docURL+d.Domain)
+	f := newFile(pkg, comment("", "", doc, d.Experimental, d.Deprecated))
+
+	if err := g.aliases(f, d); err != nil {
+		return nil, err
+	}
+	if err := g.commands(f, d); err != nil {
+		return nil, err
+	}
+	if err := g.events(f, d); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+func (g *generator) aliases(f *file, d domain) error {
+	if len(d.Types) == 0 {
+		return nil
+	}
+	f.imports[g.typesPkg()] = true
+
+	enums := []string{}
+	for _, t := range d.Types {
+		name := goName(t.ID)
+		if err := f.declare(name, d.Domain+"."+t.ID); err != nil {
+			return err
+		}
+		desc := paragraph(t.Description, "See: "+docURL+d.Domain+"#type-"+t.ID)
+		f.body.WriteString(comment("", name, desc, t.Experimental, t.Deprecated))
+		f.printf("type %s = types.%s\n\n", name, typeName(d.Domain, t.ID))
+
+		for _, v := range enumNames(name, t.Enum) {
+			if err := f.declare(v.name, d.Domain+"."+t.ID+"."+v.value); err != nil {
+				return err
+			}
+			enums = append(enums, fmt.Sprintf("\t%s = types.%s%s\n",
+				v.name, typeName(d.Domain, t.ID), strings.TrimPrefix(v.name, name)))
+		}
+	}
+
+	if len(enums) > 0 {
+		f.printf("// Values of the enumerated types in the %s domain.\n", d.Domain)
+		f.printf("const (\n%s)\n\n", strings.Join(enums, ""))
+	}
+	return nil
+}
+
+func (g *generator) commands(f *file, d domain) error {
+	if len(d.Commands) == 0 {
+		return nil
+	}
+
+	f.printf("// Method names of the commands in the %s domain.\n", d.Domain)
+	f.printf("const (\n")
+	for _, c := range d.Commands {
+		name := "Command" + goName(c.Name)
+		if err := f.declare(name, d.Domain+"."+c.Name); err != nil {
+			return err
+		}
+		f.printf("\t%s = %q\n", name, d.Domain+"."+c.Name)
+	}
+	f.printf(")\n\n")
+
+	f.imports["context"] = true
+	f.imports[g.root] = true
+	f.imports[g.protocolPkg()] = true
+	for _, c := range d.Commands {
+		method := d.Domain + "." + c.Name
+		name := goName(c.Name)
+		params, returns := name+"Params", name+"Returns"
+		if err := f.declare(params, method); err != nil {
+			return err
+		}
+
+		desc := fmt.Sprintf("%s are the parameters of %s.", params, method)
+		desc = paragraph(desc, c.Description)
+		if c.Redirect != "" {
+			desc = paragraph(desc, "Redirects to the "+c.Redirect+" domain.")
+		}
+		desc = paragraph(desc, "See: "+docURL+d.Domain+"#method-"+c.Name)
+		f.body.WriteString(comment("", "", desc, c.Experimental, c.Deprecated))
+		f.printf("type %s struct {\n", params)
+		if err := g.fields(f, c.Parameters, d.Domain, false); err != nil {
+			return err
+		}
+		f.printf("}\n\n")
+
+		f.body.WriteString(comment("", "", "Command returns the "+method+" command for use with AsyncDebugger.Send.", false, false))
+		f.printf("func (p %s) Command() (chromedebugo.Command, error) {\n", params)
+		f.printf("\treturn chromedebugo.NewCommand(Command%s, p)\n}\n\n", name)
+
+		if len(c.Returns) == 0 {
+			f.printf("// Do sends %s to d and waits for it to complete or for ctx to\n// be done.\n", method)
+			f.printf("func (p %s) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {\n", params)
+			f.printf("\treturn protocol.Do(ctx, d, Command%s, p, nil)\n}\n\n", name)
+			continue
+		}
+
+		if err := f.declare(returns, method); err != nil {
+			return err
+		}
+		f.printf("// %s are the values returned by %s.\n", returns, method)
+		f.printf("type %s struct {\n", returns)
+		if err := g.fields(f, c.Returns, d.Domain, false); err != nil {
+			return err
+		}
+		f.printf("}\n\n")
+
+		f.printf("// Do sends %s to d and waits for its result or for ctx to be\n// done.\n", method)
+		f.printf("func (p %s) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*%s, error) {\n", params, returns)
+		f.printf("\tret := &%s{}\n", returns)
+		f.printf("\tif err := protocol.Do(ctx, d, Command%s, p, ret); err != nil {\n", name)
+		f.printf("\t\treturn nil, err\n\t}\n\treturn ret, nil\n}\n\n")
+	}
+	return nil
+}
+
+func (g *generator) events(f *file, d domain) error {
+	if len(d.Events) == 0 {
+		return nil
+	}
+
+	f.printf("// Method names of the events in the %s domain.\n", d.Domain)
+	f.printf("const (\n")
+	for _, e := range d.Events {
+		name := "Event" + goName(e.Name)
+		if err := f.declare(name, d.Domain+"."+e.Name); err != nil {
+			return err
+		}
+		f.printf("\t%s = %q\n", name, d.Domain+"."+e.Name)
+	}
+	f.printf(")\n\n")
+
+	for _, e := range d.Events {
+		method := d.Domain + "." + e.Name
+		name := goName(e.Name) + "Event"
+		if err := f.declare(name, method); err != nil {
+			return err
+		}
+		desc := fmt.Sprintf("%s is the payload of the %s event.", name, method)
+		desc = paragraph(desc, e.Description)
+		desc = paragraph(desc, "See: "+docURL+d.Domain+"#event-"+e.Name)
+		f.body.WriteString(comment("", "", desc, e.Experimental, e.Deprecated))
+		f.printf("type %s struct {\n", name)
+		if err := g.fields(f, e.Parameters, d.Domain, false); err != nil {
+			return err
+		}
+		f.printf("}\n\n")
+	}
+
+	if err := f.declare("ParseEvent", d.Domain); err != nil {
+		return err
+	}
+	f.imports[g.root] = true
+	f.imports["fmt"] = true
+	f.printf("// ParseEvent decodes an event from the %s domain, as received from\n", d.Domain)
+	f.printf("// Subscribe, into a pointer to its typed payload.\n")
+	f.printf("func ParseEvent(cmd chromedebugo.Command) (interface{}, error) {\n")
+	f.printf("\tvar ev interface{}\n\tswitch cmd.Method {\n")
+	for _, e := range d.Events {
+		f.printf("\tcase Event%s:\n\t\tev = &%sEvent{}\n", goName(e.Name), goName(e.Name))
+	}
+	f.printf("\tdefault:\n\t\treturn nil, fmt.Errorf(\"unknown %s event: %%s\", cmd.Method)\n\t}\n", d.Domain)
+	f.printf("\tif err := cmd.Decode(ev); err != nil {\n\t\treturn nil, err\n\t}\n\treturn ev, nil\n}\n")
+	return nil
+}
+
+// paragraph appends text to doc as a new paragraph.
+func paragraph(doc, text string) string {
+	text = strings.TrimSpace(text)
+	switch {
+	case text == "":
+		return doc
+	case doc == "":
+		return text
+	}
+	return doc + "\n\n" + text
+}
+
+// comment renders text as a Go comment wrapped at 80 columns.  If name is
+// given the comment is phrased to start with it, as godoc expects.
+func comment(indent, name, text string, experimental, deprecated bool) string {
+	text = strings.TrimSpace(text)
+	if name != "" {
+		if text == "" || strings.HasPrefix(text, "See: ") {
+			text = paragraph(name+" is a protocol type.", text)
+		} else {
+			text = name + " " + lowerFirst(text)
+		}
+	}
+	if experimental {
+		text = paragraph(text, "This is an experimental part of the protocol.")
+	}
+	if deprecated {
+		text = paragraph(text, "Deprecated: this is deprecated in the protocol.")
+	}
+	if text == "" {
+		return ""
+	}
+
+	width := 77 - len(indent)
+	out := &bytes.Buffer{}
+	for _, line := range unwrap(text) {
+		if line == "" {
+			fmt.Fprintf(out, "%s//\n", indent)
+			continue
+		}
+		if strings.HasPrefix(line, "See: ") {
+			// links are never broken across lines
+			fmt.Fprintf(out, "%s// %s\n", indent, line)
+			continue
+		}
+		cur := ""
+		for _, word := range strings.Fields(line) {
+			if cur != "" && len(cur)+1+len(word) > width {
+				fmt.Fprintf(out, "%s// %s\n", indent, cur)
+				cur = ""
+			}
+			if cur != "" {
+				cur += " "
+			}
+			cur += word
+		}
+		fmt.Fprintf(out, "%s// %s\n", indent, cur)
+	}
+	return out.String()
+}
+
+// unwrap joins the hard wrapped lines of the protocol's descriptions back
+// into paragraphs so they can be rewrapped.  Blank lines and list items are
+// preserved.
+func unwrap(text string) []string {
+	lines := []string{}
+	joinable := false
+	for _, line := range strings.Split(text, "\n") {
+		line = strings.TrimSpace(line)
+		item := strings.HasPrefix(line, "- ") || strings.HasPrefix(line, "* ")
+		if line != "" && !item && joinable {
+			lines[len(lines)-1] += " " + line
+			continue
+		}
+		lines = append(lines, line)
+		joinable = line != ""
+	}
+	return lines
+}
+
+// lowerFirst lower cases the first letter of s unless it starts an acronym
+// such as "DOM".
+func lowerFirst(s string) string {
+	r, n := utf8.DecodeRuneInString(s)
+	next, _ := utf8.DecodeRuneInString(s[n:])
+	if unicode.IsUpper(next) {
+		return s
+	}
+	return string(unicode.ToLower(r)) + s[n:]
+}
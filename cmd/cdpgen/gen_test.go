@@ -0,0 +1,105 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "rewrite the golden files in testdata/golden")
+
+// TestGenerate generates the packages for testdata/protocol.json, a small
+// protocol covering each kind of type, command and event, and compares them
+// with testdata/golden.  Run with -update after changing the generator to
+// rewrite the golden files, and review the diff.
+func TestGenerate(t *testing.T) {
+	data, err := ioutil.ReadFile(filepath.Join("testdata", "protocol.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := protocol{}
+	if err := json.Unmarshal(data, &p); err != nil {
+		t.Fatal(err)
+	}
+	files, err := generate("github.com/tonyhb/chromedebugo", p.Domains)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	goldenDir := filepath.Join("testdata", "golden")
+	if *update {
+		if err := os.RemoveAll(goldenDir); err != nil {
+			t.Fatal(err)
+		}
+		for name, src := range files {
+			path := filepath.Join(goldenDir, name+".golden")
+			if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+				t.Fatal(err)
+			}
+			if err := ioutil.WriteFile(path, src, 0644); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+
+	golden := []string{}
+	err = filepath.Walk(goldenDir, func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+		name, _ := filepath.Rel(goldenDir, path)
+		golden = append(golden, strings.TrimSuffix(name, ".golden"))
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	generated := []string{}
+	for name := range files {
+		generated = append(generated, name)
+	}
+	sort.Strings(generated)
+	if strings.Join(golden, " ") != strings.Join(generated, " ") {
+		t.Fatalf("generated files %v, want %v", generated, golden)
+	}
+
+	for _, name := range golden {
+		want, err := ioutil.ReadFile(filepath.Join(goldenDir, name+".golden"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := files[name]; !bytes.Equal(got, want) {
+			t.Errorf("%s differs from its golden file:\n%s", name, diff(string(want), string(got)))
+		}
+	}
+}
+
+// diff describes the first line which differs between want and got, with
+// the lines following it.
+func diff(want, got string) string {
+	wantLines, gotLines := strings.Split(want, "\n"), strings.Split(got, "\n")
+	i := 0
+	for i < len(wantLines) && i < len(gotLines) && wantLines[i] == gotLines[i] {
+		i++
+	}
+	return fmt.Sprintf("line %d, want:\n%s\ngot:\n%s", i+1, context(wantLines, i), context(gotLines, i))
+}
+
+// context returns up to five lines from i.
+func context(lines []string, i int) string {
+	end := i + 5
+	if end > len(lines) {
+		end = len(lines)
+	}
+	if i > end {
+		i = end
+	}
+	return strings.Join(lines[i:end], "\n")
+}
@@ -0,0 +1,119 @@
+// Command cdpgen generates typed Go bindings for the Chrome DevTools protocol
+// from its JSON definitions (browser_protocol.json and js_protocol.json).
+//
+// Each protocol domain becomes its own package beneath the output directory,
+// containing params, returns and event structs for the domain's commands and
+// events.  The structs are sent with the chromedebugo SyncDebugger and
+// AsyncDebugger, so the generated code is a typed layer over Command and
+// Result rather than a separate client.
+//
+// Domains refer to each other's types in cycles (eg. DOM uses Page.FrameId
+// and Page uses DOM.BackendNodeId), which Go packages cannot.  All types are
+// therefore declared once in the internal/types package and each domain
+// package exposes its own types as aliases.
+//
+// Usage:
+//
+//	cdpgen -root github.com/tonyhb/chromedebugo -out protocol browser_protocol.json js_protocol.json
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+func main() {
+	root := flag.String("root", "github.com/tonyhb/chromedebugo", "import path of the chromedebugo package")
+	out := flag.String("out", ".", "directory to write the generated packages to")
+	flag.Parse()
+
+	if flag.NArg() == 0 {
+		fmt.Fprintln(os.Stderr, "usage: cdpgen [flags] protocol.json...")
+		os.Exit(2)
+	}
+
+	if err := run(*root, *out, flag.Args()); err != nil {
+		fmt.Fprintf(os.Stderr, "cdpgen: %s\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(root, out string, paths []string) error {
+	domains := []domain{}
+	for _, path := range paths {
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		p := protocol{}
+		if err := json.Unmarshal(data, &p); err != nil {
+			return fmt.Errorf("error decoding %s: %s", path, err)
+		}
+		domains = append(domains, p.Domains...)
+	}
+
+	files, err := generate(root, domains)
+	if err != nil {
+		return err
+	}
+
+	for name, src := range files {
+		path := filepath.Join(out, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			return err
+		}
+		if err := ioutil.WriteFile(path, src, 0644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// generate returns the generated source files keyed by their path relative
+// to the output directory.
+func generate(root string, domains []domain) (map[string][]byte, error) {
+	g, err := newGenerator(root, domains)
+	if err != nil {
+		return nil, err
+	}
+
+	files := map[string][]byte{}
+
+	doc := newFile("types", comment("", "", "Package types declares the types of every protocol domain in a single package so that domains may refer to each other's types.  Each domain package re-exports its own types as aliases, which is how they should be used.", false, false))
+	src, err := doc.bytes()
+	if err != nil {
+		return nil, err
+	}
+	files[filepath.Join("internal", "types", "doc.go")] = src
+
+	// types files share one set of names as they make up a single package
+	names := map[string]string{}
+	for _, d := range domains {
+		pkg := packageName(d.Domain)
+
+		tf := newFile("types", "")
+		tf.names = names
+		if err := g.typesFile(tf, d); err != nil {
+			return nil, err
+		}
+		src, err := tf.bytes()
+		if err != nil {
+			return nil, err
+		}
+		files[filepath.Join("internal", "types", pkg+".go")] = src
+
+		df, err := g.domainFile(d)
+		if err != nil {
+			return nil, err
+		}
+		if src, err = df.bytes(); err != nil {
+			return nil, err
+		}
+		files[filepath.Join(pkg, pkg+".go")] = src
+	}
+	return files, nil
+}
@@ -0,0 +1,80 @@
+package main
+
+import (
+	"strings"
+	"unicode"
+)
+
+// initialisms are words which are rendered in upper case when they appear
+// in a generated identifier, following the Go naming conventions.
+var initialisms = map[string]bool{
+	"API":  true,
+	"CPU":  true,
+	"CSS":  true,
+	"DOM":  true,
+	"GPU":  true,
+	"HTML": true,
+	"HTTP": true,
+	"ID":   true,
+	"IP":   true,
+	"JS":   true,
+	"JSON": true,
+	"TLS":  true,
+	"UI":   true,
+	"URI":  true,
+	"URL":  true,
+	"UUID": true,
+	"XML":  true,
+}
+
+// goName converts a protocol identifier such as "frameId", "DOMStorage" or
+// "auto_bookmark" into an exported Go identifier.
+func goName(s string) string {
+	out := ""
+	for _, word := range splitWords(s) {
+		upper := strings.ToUpper(word)
+		if initialisms[upper] {
+			out += upper
+			continue
+		}
+		runes := []rune(word)
+		runes[0] = unicode.ToUpper(runes[0])
+		out += string(runes)
+	}
+	return out
+}
+
+// splitWords splits an identifier into words on punctuation and camel case
+// boundaries.  Runs of upper case letters are kept together, so "DOMStorage"
+// becomes "DOM" and "Storage".
+func splitWords(s string) []string {
+	words := []string{}
+	for _, field := range strings.FieldsFunc(s, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}) {
+		runes := []rune(field)
+		start := 0
+		for i := 1; i < len(runes); i++ {
+			prev, cur := runes[i-1], runes[i]
+			switch {
+			case unicode.IsLower(prev) && unicode.IsUpper(cur):
+				// "frameId" splits before the "I"
+			case unicode.IsDigit(prev) && unicode.IsUpper(cur):
+			case unicode.IsUpper(prev) && unicode.IsUpper(cur) &&
+				i+1 < len(runes) && unicode.IsLower(runes[i+1]):
+				// "DOMStorage" splits before the "S"
+			default:
+				continue
+			}
+			words = append(words, string(runes[start:i]))
+			start = i
+		}
+		words = append(words, string(runes[start:]))
+	}
+	return words
+}
+
+// packageName returns the Go package name used for a protocol domain.
+func packageName(domain string) string {
+	return strings.ToLower(domain)
+}
@@ -0,0 +1,66 @@
+package main
+
+// protocol is the root of a DevTools protocol definition such as
+// browser_protocol.json or js_protocol.json.
+type protocol struct {
+	Version struct {
+		Major string `json:"major"`
+		Minor string `json:"minor"`
+	} `json:"version"`
+	Domains []domain `json:"domains"`
+}
+
+type domain struct {
+	Domain       string    `json:"domain"`
+	Description  string    `json:"description"`
+	Experimental bool      `json:"experimental"`
+	Deprecated   bool      `json:"deprecated"`
+	Dependencies []string  `json:"dependencies"`
+	Types        []typeDef `json:"types"`
+	Commands     []command `json:"commands"`
+	Events       []event   `json:"events"`
+}
+
+// typeDef is a named type declared in a domain's "types" list.
+type typeDef struct {
+	ID           string     `json:"id"`
+	Description  string     `json:"description"`
+	Type         string     `json:"type"`
+	Enum         []string   `json:"enum"`
+	Properties   []property `json:"properties"`
+	Items        *property  `json:"items"`
+	Experimental bool       `json:"experimental"`
+	Deprecated   bool       `json:"deprecated"`
+}
+
+// property describes an object property, a command parameter or return
+// value, an event parameter or the items of an array.
+type property struct {
+	Name         string    `json:"name"`
+	Description  string    `json:"description"`
+	Type         string    `json:"type"`
+	Ref          string    `json:"$ref"`
+	Enum         []string  `json:"enum"`
+	Items        *property `json:"items"`
+	Optional     bool      `json:"optional"`
+	Experimental bool      `json:"experimental"`
+	Deprecated   bool      `json:"deprecated"`
+}
+
+type command struct {
+	Name         string     `json:"name"`
+	Description  string     `json:"description"`
+	Parameters   []property `json:"parameters"`
+	Returns      []property `json:"returns"`
+	Redirect     string     `json:"redirect"`
+	Experimental bool       `json:"experimental"`
+	Deprecated   bool       `json:"deprecated"`
+}
+
+type event struct {
+	Name         string     `json:"name"`
+	Description  string     `json:"description"`
+	Parameters   []property `json:"parameters"`
+	Experimental bool       `json:"experimental"`
+	Deprecated   bool       `json:"deprecated"`
+}
@@ -0,0 +1,291 @@
+package chromedebugo
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// errDetached is the cause of the ErrConnectionClosed returned by a session
+// once chrome has detached it from its target.
+var errDetached = errors.New("session detached from target")
+
+// connection is a connection to chrome over a Transport.  It is shared by the
+// debugger for the target dialed and by any sessions attached to other
+// targets through it, and owns the goroutine which reads from chrome.
+//
+// Command IDs are unique across the connection, so responses are routed to
+// the command waiting for them by ID alone.  Events and the copies of
+// responses sent to the taps are routed to the debugger for their
+// sessionId.
+type connection struct {
+	transport Transport
+
+	// id incremnets with each command we send to the debugger
+	id int
+	// lock guards id and writes to transport, which only supports one
+	// concurrent writer
+	lock sync.Mutex
+
+	// pendingLock guards pending and commands, which are shared with the
+	// goroutine reading responses
+	pendingLock sync.Mutex
+	// pending stores a channel for each command whose sender is waiting for
+	// a response, keyed by ID.  The channel receives the command's Result or
+	// Error.
+	pending map[int]chan interface{}
+	// commands stores a map of all sent comamnds without a response by
+	// their ID
+	commands map[int]Command
+
+	// viewsLock guards views, which stores the debugger for each session
+	// keyed by session ID.  The debugger for the target dialed has the
+	// empty session ID.
+	viewsLock sync.RWMutex
+	views     map[string]*debugger
+
+	// done is closed once the connection has closed, after which err holds
+	// an ErrConnectionClosed describing why
+	done      chan struct{}
+	err       error
+	closeOnce sync.Once
+}
+
+func newConnection(transport Transport, root *debugger) *connection {
+	if root.recording != nil {
+		transport = NewRecordingTransport(transport, root.recording)
+	}
+	c := &connection{
+		transport: transport,
+
+		id:   1,
+		lock: sync.Mutex{},
+
+		pending:  map[int]chan interface{}{},
+		commands: map[int]Command{},
+
+		views: map[string]*debugger{"": root},
+
+		done: make(chan struct{}),
+	}
+	root.conn = c
+	return c
+}
+
+// read dispatches every message from chrome until the connection fails or
+// is closed.  It is run on its own goroutine by NewSync and NewAsync.
+func (c *connection) read() {
+	defer func() {
+		// read is the only sender on the taps, so it closes them
+		c.viewsLock.Lock()
+		for id, view := range c.views {
+			view.closeTaps()
+			delete(c.views, id)
+		}
+		c.viewsLock.Unlock()
+	}()
+
+	for {
+		data, err := c.transport.ReadMessage()
+		if err != nil {
+			c.shutdown(err)
+			return
+		}
+		c.pendingLock.Lock()
+		resp, err := decodeResponse(data, c.commands)
+		c.pendingLock.Unlock()
+		if err != nil {
+			c.shutdown(fmt.Errorf("error decoding message from chrome: %s", err))
+			return
+		}
+
+		switch resp.(type) {
+		case Error:
+			c.hook(resp.(Error).SessionID, resp)
+			c.deliver(resp.(Error).ID, resp)
+			if view := c.view(resp.(Error).SessionID, false); view != nil {
+				view.tapError(resp.(Error))
+			}
+		case Result:
+			c.hook(resp.(Result).SessionID, resp)
+			c.deliver(resp.(Result).ID, resp)
+			if view := c.view(resp.(Result).SessionID, false); view != nil {
+				view.tapResult(resp.(Result))
+			}
+		case Command:
+			cmd := resp.(Command)
+			if cmd.Method == "Target.detachedFromTarget" {
+				c.detached(cmd)
+			}
+			if view := c.view(cmd.SessionID, true); view != nil {
+				view.hook(cmd)
+				view.events.publish(cmd)
+			}
+		}
+	}
+}
+
+// view returns the debugger for a session.  Events for sessions which were
+// not attached through this connection, eg. by Target.setAutoAttach, fall
+// back to the dialed target's debugger.
+func (c *connection) view(sessionID string, fallback bool) *debugger {
+	c.viewsLock.RLock()
+	defer c.viewsLock.RUnlock()
+	if view, ok := c.views[sessionID]; ok {
+		return view
+	}
+	if fallback {
+		return c.views[""]
+	}
+	return nil
+}
+
+// hook calls the frame hooks of the debugger a message is for.
+func (c *connection) hook(sessionID string, resp interface{}) {
+	if view := c.view(sessionID, true); view != nil {
+		view.hook(resp)
+	}
+}
+
+// attach registers the debugger for a newly attached session.
+func (c *connection) attach(view *debugger) error {
+	c.viewsLock.Lock()
+	defer c.viewsLock.Unlock()
+	select {
+	case <-c.done:
+		return c.err
+	default:
+	}
+	c.views[view.sessionID] = view
+	return nil
+}
+
+// detached closes the debugger for a session chrome has detached.  It is
+// only called by read, so closing the session's taps is safe.
+func (c *connection) detached(cmd Command) {
+	sessionID, _ := cmd.Params["sessionId"].(string)
+	if sessionID == "" {
+		return
+	}
+	c.viewsLock.Lock()
+	view, ok := c.views[sessionID]
+	delete(c.views, sessionID)
+	c.viewsLock.Unlock()
+
+	if ok {
+		view.shutdown(errDetached)
+		view.closeTaps()
+	}
+}
+
+// send writes cmd to chrome and returns its ID.  If reply is non-nil it
+// receives the command's response.
+func (c *connection) send(cmd Command, reply chan interface{}) (int, error) {
+	// the lock guards the ID counter and the connection, which only
+	// supports one concurrent writer
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	select {
+	case <-c.done:
+		return 0, c.err
+	default:
+	}
+
+	wrapper := commandWrapper{
+		ID:      c.id,
+		Command: cmd,
+	}
+	c.id++
+
+	// the command must be pending before it is written, as chrome may
+	// respond before WriteMessage returns
+	c.pendingLock.Lock()
+	if reply != nil {
+		c.pending[wrapper.ID] = reply
+	}
+	c.commands[wrapper.ID] = cmd
+	c.pendingLock.Unlock()
+
+	data, err := json.Marshal(wrapper)
+	if err != nil {
+		c.abandon(wrapper.ID)
+		return 0, fmt.Errorf("error encoding command: %s", err)
+	}
+	if err := c.transport.WriteMessage(data); err != nil {
+		// the connection can't be written to again, so it is closed
+		// rather than left for the reader to notice
+		c.shutdown(fmt.Errorf("error sending command to chrome: %s", err))
+		return 0, c.err
+	}
+	return wrapper.ID, nil
+}
+
+// deliver hands a response to the command waiting for it.  Responses to
+// commands which have been abandoned are discarded.
+func (c *connection) deliver(id int, resp interface{}) {
+	c.pendingLock.Lock()
+	reply, ok := c.pending[id]
+	delete(c.pending, id)
+	delete(c.commands, id)
+	c.pendingLock.Unlock()
+
+	if ok {
+		reply <- resp
+	}
+}
+
+// abandon stops waiting for the responses to the given commands, eg. when
+// their context is cancelled.  Any response which arrives later is
+// discarded.
+func (c *connection) abandon(ids ...int) {
+	c.pendingLock.Lock()
+	defer c.pendingLock.Unlock()
+	for _, id := range ids {
+		delete(c.pending, id)
+		delete(c.commands, id)
+	}
+}
+
+// shutdown closes the connection because of cause, failing every pending
+// command and closing every debugger using it.  Only the first call has any
+// effect.
+func (c *connection) shutdown(cause error) error {
+	var err error
+	c.closeOnce.Do(func() {
+		c.err = ErrConnectionClosed{Cause: cause}
+		close(c.done)
+		err = c.transport.Close()
+
+		// senders waiting on pending commands select on their
+		// debugger's done, so forgetting the commands is enough to fail
+		// them
+		c.pendingLock.Lock()
+		c.pending = map[int]chan interface{}{}
+		c.commands = map[int]Command{}
+		c.pendingLock.Unlock()
+
+		c.viewsLock.RLock()
+		for _, view := range c.views {
+			view.shutdown(cause)
+		}
+		c.viewsLock.RUnlock()
+	})
+	return err
+}
+
+// sessionID returns the sessionId from the result of Target.attachToTarget.
+func sessionID(res Result) (string, error) {
+	ret := struct {
+		SessionID string `json:"sessionId"`
+	}{}
+	if err := res.Decode(&ret); err != nil {
+		return "", err
+	}
+	if ret.SessionID == "" {
+		data, _ := json.Marshal(res.Result)
+		return "", fmt.Errorf("no sessionId in response to Target.attachToTarget: %s", data)
+	}
+	return ret.SessionID, nil
+}
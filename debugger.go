@@ -1,83 +1,304 @@
 package chromedebugo
 
 import (
-	"encoding/json"
-	"fmt"
+	"io"
 	"net/http"
 	"sync"
-
-	"github.com/gorilla/websocket"
 )
 
+// debugger is the state shared by the sync and async debuggers for one
+// target: its event subscriptions, response taps and lifecycle.  The
+// connection to chrome itself may be shared with the debuggers for other
+// sessions attached through the same browser connection.
 type debugger struct {
-	conn *websocket.Conn
-	host string
+	conn *connection
+	// sessionID stamps every command sent so that chrome runs it against
+	// an attached target.  It is empty for the target dialed.
+	sessionID string
+
+	// browser is a client for chrome's HTTP endpoints, made with
+	// httpClient if given
+	browser    *Browser
+	httpClient *http.Client
+	// target selects the target to connect to when discovering it, and
+	// browserTarget connects to the browser itself instead
+	target        TargetSelector
+	browserTarget bool
+	// recording, if set, receives a copy of the connection's traffic
+	recording io.Writer
 
-	errChan chan Error
-	resChan chan Result
+	// interceptors wrap each command sent and frameHooks see each message
+	// received
+	interceptors []Interceptor
+	frameHooks   []FrameHook
+
+	// errChan and resChan are only created when the response taps are
+	// enabled with WithResponseTaps
+	errChan     chan Error
+	resChan     chan Result
+	taps        bool
+	tapSize     int
+	tapOverflow OverflowPolicy
+	// cmdChan is a subscription to every event, kept for CommandChan
 	cmdChan chan Command
 
-	// id incremnets with each command we send to the debugger
-	id   int
-	lock sync.Mutex
+	events *eventHub
+
+	// done is closed once the debugger has closed, after which err holds
+	// an ErrConnectionClosed describing why
+	done      chan struct{}
+	err       error
+	closeOnce sync.Once
 }
 
-func newBaseDebugger(host string) (*debugger, error) {
-	// First we must get the websocket URL of the host
-	info, err := info(host)
-	if err != nil {
-		return nil, err
-	}
-	if len(info) != 1 {
-		return nil, fmt.Errorf("error getting chrome info: unexpected number of responses from /info")
+// newBaseDebugger connects to chrome.  The host is either the address of
+// chrome's HTTP endpoints, in which case the target is discovered from
+// /json/list, or a ws:// debugger URL which is dialed directly.
+func newBaseDebugger(host string, opts []Option) (*debugger, error) {
+	d := newView("", opts)
+
+	wsURL := host
+	switch {
+	case isWebsocketURL(host):
+		d.browser = NewBrowser(HTTPHost(host), d.httpClient)
+	case d.browserTarget:
+		d.browser = NewBrowser(host, d.httpClient)
+		version, err := d.browser.Version()
+		if err != nil {
+			return nil, err
+		}
+		wsURL = version.WebsocketDebuggerURL
+	default:
+		d.browser = NewBrowser(host, d.httpClient)
+		// First we must get the websocket URL of the host
+		targets, err := d.browser.List()
+		if err != nil {
+			return nil, err
+		}
+		target, err := selectTarget(targets, d.target)
+		if err != nil {
+			return nil, err
+		}
+		wsURL = target.WebsocketDebuggerURL
 	}
 
-	conn, _, err := new(websocket.Dialer).Dial(info[0].WebsocketDebuggerURL, nil)
+	transport, err := DialWebsocket(wsURL)
 	if err != nil {
 		return nil, err
 	}
+	newConnection(transport, d)
+	return d, nil
+}
+
+// newTransportDebugger creates a debugger speaking to chrome over transport.
+// It has no HTTP endpoints, so Version and Info fail.
+func newTransportDebugger(transport Transport, opts []Option) *debugger {
+	d := newView("", opts)
+	newConnection(transport, d)
+	return d
+}
 
+// newView creates the debugger for a session, without a connection.
+func newView(sessionID string, opts []Option) *debugger {
 	// Remote debugging is async, and there are three classes of messages
 	// that can be sent back.  They are:
 	// - Errors, from failed commands sent to the debugger
 	// - Results, from successful commands sent to the debugger
 	// - Commands, wihch notify clients of commands created by the remote
-	//   debugger
-	errChan := make(chan Error)
-	cmdChan := make(chan Command)
-	resChan := make(chan Result)
-
-	return &debugger{
-		host: host,
-		conn: conn,
-
-		errChan: errChan,
-		cmdChan: cmdChan,
-		resChan: resChan,
-
-		id:   1,
-		lock: sync.Mutex{},
-	}, nil
+	//   debugger.  These are fanned out to subscribers by the event hub.
+	events := newEventHub()
+	all, _ := events.subscribe("*")
+
+	d := &debugger{
+		sessionID: sessionID,
+
+		cmdChan: all.ch,
+
+		events: events,
+
+		done: make(chan struct{}),
+	}
+	for _, opt := range opts {
+		opt(d)
+	}
+	if d.taps {
+		if d.tapSize < 1 {
+			// there must be room for at least the newest response
+			d.tapSize = 1
+		}
+		d.errChan = make(chan Error, d.tapSize)
+		d.resChan = make(chan Result, d.tapSize)
+	}
+	return d
 }
 
-func version(host string) (Version, error) {
-	resp, err := http.Get(host + "/json/version")
-	if err != nil {
-		return Version{}, err
+// send writes cmd to chrome for this debugger's session and returns its ID.
+// If reply is non-nil it receives the command's response.
+func (d *debugger) send(cmd Command, reply chan interface{}) (int, error) {
+	select {
+	case <-d.done:
+		return 0, d.err
+	default:
 	}
-	defer resp.Body.Close()
-	data := Version{}
-	err = json.NewDecoder(resp.Body).Decode(&data)
-	return data, err
+	cmd.SessionID = d.sessionID
+	return d.conn.send(cmd, reply)
 }
 
-func info(host string) ([]Info, error) {
-	resp, err := http.Get(host + "/json/list")
-	if err != nil {
-		return nil, err
+// version returns the chrome version information from /json/version.
+func (d *debugger) version() (Version, error) {
+	if d.browser == nil {
+		return Version{}, ErrNoHTTPEndpoints
+	}
+	return d.browser.Version()
+}
+
+// info returns the targets chrome can debug from /json/list.
+func (d *debugger) info() ([]Info, error) {
+	if d.browser == nil {
+		return nil, ErrNoHTTPEndpoints
+	}
+	return d.browser.List()
+}
+
+// abandon stops waiting for the responses to the given commands.
+func (d *debugger) abandon(ids ...int) {
+	d.conn.abandon(ids...)
+}
+
+// shutdown closes the debugger because of cause, closing every
+// subscription.  Senders waiting for a response select on done, so they
+// fail too.  Only the first call has any effect.
+func (d *debugger) shutdown(cause error) {
+	d.closeOnce.Do(func() {
+		d.err = ErrConnectionClosed{Cause: cause}
+		close(d.done)
+		d.events.close()
+	})
+}
+
+// closeTaps closes the taps.  It must only be called by the goroutine
+// reading from chrome, which is their only sender.
+func (d *debugger) closeTaps() {
+	if d.taps {
+		close(d.errChan)
+		close(d.resChan)
+	}
+}
+
+// Close closes the connection to chrome.  Commands awaiting a response fail
+// with ErrConnectionClosed, event subscriptions are closed and the taps are
+// closed once the goroutine reading from chrome has stopped.
+//
+// Closing a session attached with a browser debugger detaches it from its
+// target and leaves the browser connection open.
+func (d *debugger) Close() error {
+	if d.sessionID == "" {
+		return d.conn.shutdown(nil)
+	}
+
+	select {
+	case <-d.done:
+		return nil
+	default:
+	}
+	// shut down first so that the session's error records that it was
+	// closed rather than detached by chrome
+	d.shutdown(nil)
+
+	reply := make(chan interface{}, 1)
+	_, err := d.conn.send(Command{
+		Method: "Target.detachFromTarget",
+		Params: map[string]interface{}{"sessionId": d.sessionID},
+	}, reply)
+	if err == nil {
+		select {
+		case resp := <-reply:
+			if e, ok := resp.(Error); ok {
+				err = e
+			}
+		case <-d.conn.done:
+		}
+	}
+	return err
+}
+
+// Done returns a channel which is closed once the connection to chrome has
+// closed, either by Close or because it failed.
+func (d *debugger) Done() <-chan struct{} {
+	return d.done
+}
+
+// Err returns nil while the connection is open.  Once Done is closed it
+// returns an ErrConnectionClosed holding the reason.
+func (d *debugger) Err() error {
+	select {
+	case <-d.done:
+		return d.err
+	default:
+		return nil
+	}
+}
+
+// tapResult copies a result to resChan, if enabled, according to the
+// overflow policy.
+func (d *debugger) tapResult(r Result) {
+	if !d.taps {
+		return
+	}
+	switch d.tapOverflow {
+	case Block:
+		select {
+		case d.resChan <- r:
+		case <-d.done:
+		}
+	case DropNewest:
+		select {
+		case d.resChan <- r:
+		default:
+		}
+	default:
+		for {
+			select {
+			case d.resChan <- r:
+				return
+			default:
+			}
+			select {
+			case <-d.resChan:
+			default:
+			}
+		}
+	}
+}
+
+// tapError copies an error to errChan, if enabled, according to the overflow
+// policy.
+func (d *debugger) tapError(e Error) {
+	if !d.taps {
+		return
+	}
+	switch d.tapOverflow {
+	case Block:
+		select {
+		case d.errChan <- e:
+		case <-d.done:
+		}
+	case DropNewest:
+		select {
+		case d.errChan <- e:
+		default:
+		}
+	default:
+		for {
+			select {
+			case d.errChan <- e:
+				return
+			default:
+			}
+			select {
+			case <-d.errChan:
+			default:
+			}
+		}
 	}
-	defer resp.Body.Close()
-	data := []Info{}
-	err = json.NewDecoder(resp.Body).Decode(&data)
-	return data, err
 }
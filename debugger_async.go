@@ -1,84 +1,64 @@
 package chromedebugo
 
-import "fmt"
+import "context"
 
 type asyncDebugger struct {
 	*debugger
-	// responses stores a map of all command responses keyed by their ID
-	responses map[int]interface{}
-	// commands stores a map of all sent comamnds by their ID
-	commands map[int]Command
 }
 
-func NewAsync(host string) (*asyncDebugger, error) {
-	base, err := newBaseDebugger(host)
+// NewAsync connects an async debugger to chrome.  The host is either
+// the address of chrome's HTTP endpoints, such as "http://localhost:9222",
+// from which the target is chosen (see WithTarget), or a target's ws://
+// debugger URL.
+func NewAsync(host string, opts ...Option) (*asyncDebugger, error) {
+	// responses are only delivered through the taps, so they are on by
+	// default, dropping the oldest rather than holding up the connection
+	// when nobody reads them
+	opts = append([]Option{WithResponseTaps(DefaultEventBuffer, DropOldest)}, opts...)
+	base, err := newBaseDebugger(host, opts)
 	if err != nil {
 		return nil, err
 	}
 
 	debugger := &asyncDebugger{
-		debugger:  base,
-		responses: map[int]interface{}{},
-		commands:  map[int]Command{},
+		debugger: base,
 	}
 
-	// Asynchronous debugging is simple: set up a goroutine which listens
-	// for all incoming messages from the websocket connection and dispatch
-	// them to the relevant channels.
+	// Asynchronous debugging is simple: a goroutine listens for all
+	// incoming messages from the websocket connection and dispatches them
+	// to the relevant channels.
 	//
 	// We never block for incoming calls and only communicate this way.
-	go func() {
-		for {
-			_, data, err := base.conn.ReadMessage()
-			if err != nil {
-				return
-			}
-			resp, err := decodeResponse(data, debugger.commands)
-			if err != nil {
-				return
-			}
-			switch resp.(type) {
-			case Error:
-				debugger.responses[resp.(Error).ID] = resp
-				base.errChan <- resp.(Error)
-			case Result:
-				debugger.responses[resp.(Result).ID] = resp
-				base.resChan <- resp.(Result)
-			case Command:
-				base.cmdChan <- resp.(Command)
-			}
-		}
-	}()
+	go base.conn.read()
 
 	return debugger, nil
 }
 
+// NewAsyncTransport returns an async debugger speaking to chrome over
+// transport.  Version and Info return ErrNoHTTPEndpoints.
+func NewAsyncTransport(transport Transport, opts ...Option) *asyncDebugger {
+	opts = append([]Option{WithResponseTaps(DefaultEventBuffer, DropOldest)}, opts...)
+	base := newTransportDebugger(transport, opts)
+	go base.conn.read()
+	return &asyncDebugger{debugger: base}
+}
+
 // Version returns the chrome version inforamation from /json/version
 func (ad asyncDebugger) Version() (Version, error) {
-	return version(ad.host)
+	return ad.version()
 }
 
 // Info returns a slice of browser contexts from /json/list
 func (ad asyncDebugger) Info() ([]Info, error) {
-	return info(ad.host)
+	return ad.info()
 }
 
 func (ad *asyncDebugger) Send(cmd Command) (int, error) {
-	ad.lock.Lock()
-	defer ad.lock.Unlock()
-
-	wrapper := commandWrapper{
-		ID:      ad.id,
-		Command: cmd,
-	}
-	ad.commands[ad.id] = cmd
-
-	if err := ad.conn.WriteJSON(wrapper); err != nil {
-		return 0, fmt.Errorf("error sending command to chrome: %s", err)
-	}
-
-	ad.id++
-	return wrapper.ID, nil
+	res, err := ad.intercept(context.Background(), cmd, func(ctx context.Context, cmd Command) (Result, error) {
+		id, err := ad.send(cmd, nil)
+		return Result{ID: id, SessionID: ad.sessionID}, err
+	})
+	return res.ID, err
 }
 
 func (ad asyncDebugger) ErrorChan() chan Error {
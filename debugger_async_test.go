@@ -0,0 +1,164 @@
+package chromedebugo_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/tonyhb/chromedebugo"
+	"github.com/tonyhb/chromedebugo/cdptest"
+)
+
+func TestAsyncResponses(t *testing.T) {
+	srv := cdptest.NewServer()
+	defer srv.Close()
+	srv.Handle("Page.enable", cdptest.Result(map[string]interface{}{"ok": true}))
+	srv.Handle("Page.navigate", cdptest.Fail(-32000, "invalid URL"))
+	d, err := chromedebugo.NewAsync(srv.URL())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer d.Close()
+
+	id, err := d.Send(chromedebugo.Command{Method: "Page.enable"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case res := <-d.ResultChan():
+		if res.ID != id || res.Result["ok"] != true {
+			t.Errorf("got result %+v for command %d", res, id)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("result not received")
+	}
+
+	id, err = d.Send(chromedebugo.Command{Method: "Page.navigate"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case e := <-d.ErrorChan():
+		if e.ID != id || e.ErrorDetail.Code != -32000 {
+			t.Errorf("got error %+v for command %d", e, id)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("error not received")
+	}
+}
+
+func TestAsyncTapsDoNotBlockByDefault(t *testing.T) {
+	srv := cdptest.NewServer()
+	defer srv.Close()
+	srv.Handle("Page.enable", cdptest.Result(nil))
+	d, err := chromedebugo.NewAsync(srv.URL())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer d.Close()
+
+	// responses nobody reads must not hold up the events behind them
+	for i := 0; i < chromedebugo.DefaultEventBuffer+10; i++ {
+		if _, err := d.Send(chromedebugo.Command{Method: "Page.enable"}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	events, unsubscribe := d.Subscribe("Test.marker")
+	defer unsubscribe()
+	waitForResponses(t, srv, events)
+}
+
+func TestResponseTaps(t *testing.T) {
+	tests := []struct {
+		name   string
+		size   int
+		policy chromedebugo.OverflowPolicy
+		// want is the numbers of the commands whose results are tapped
+		want []float64
+	}{
+		{"drop oldest", 2, chromedebugo.DropOldest, []float64{2, 3}},
+		{"drop newest", 2, chromedebugo.DropNewest, []float64{1, 2}},
+		{"no buffer drop oldest", 0, chromedebugo.DropOldest, []float64{3}},
+		{"negative buffer drop newest", -1, chromedebugo.DropNewest, []float64{1}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			srv := cdptest.NewServer()
+			defer srv.Close()
+			srv.Handle("Echo.params", func(cmd chromedebugo.Command) cdptest.Response {
+				return cdptest.Response{Result: cmd.Params}
+			})
+			d := newSync(t, srv, chromedebugo.WithResponseTaps(test.size, test.policy))
+
+			for n := 1; n <= 3; n++ {
+				_, err := d.Send(chromedebugo.Command{
+					Method: "Echo.params",
+					Params: map[string]interface{}{"n": n},
+				})
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+			events, unsubscribe := d.Subscribe("Test.marker")
+			defer unsubscribe()
+			waitForResponses(t, srv, events)
+
+			got := []float64{}
+			for len(d.ResultChan()) > 0 {
+				got = append(got, (<-d.ResultChan()).Result["n"].(float64))
+			}
+			if len(got) != len(test.want) {
+				t.Fatalf("tapped %v, want %v", got, test.want)
+			}
+			for i := range got {
+				if got[i] != test.want[i] {
+					t.Fatalf("tapped %v, want %v", got, test.want)
+				}
+			}
+		})
+	}
+}
+
+func TestResponseTapsBlock(t *testing.T) {
+	srv := cdptest.NewServer()
+	defer srv.Close()
+	srv.Handle("Page.enable", cdptest.Result(nil))
+	d, err := chromedebugo.NewAsync(srv.URL(), chromedebugo.WithResponseTaps(1, chromedebugo.Block))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer d.Close()
+
+	ids := map[int]bool{}
+	for i := 0; i < 5; i++ {
+		id, err := d.Send(chromedebugo.Command{Method: "Page.enable"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids[id] = true
+	}
+	for len(ids) > 0 {
+		select {
+		case res := <-d.ResultChan():
+			if !ids[res.ID] {
+				t.Fatalf("unexpected result %+v", res)
+			}
+			delete(ids, res.ID)
+		case <-time.After(5 * time.Second):
+			t.Fatalf("results for commands %v dropped", ids)
+		}
+	}
+}
+
+// waitForResponses waits for a Test.marker event sent after the responses
+// read so far, which the debugger reads, and taps, in order.
+func waitForResponses(t *testing.T, srv *cdptest.Server, events <-chan chromedebugo.Command) {
+	t.Helper()
+	if err := srv.Emit("Test.marker", nil); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case <-events:
+	case <-time.After(5 * time.Second):
+		t.Fatal("event behind the responses not received")
+	}
+}
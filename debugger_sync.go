@@ -1,135 +1,144 @@
 package chromedebugo
 
 import (
-	"fmt"
-	"sync"
+	"context"
 )
 
+// syncDebugger may be used from many goroutines at once.  Each command is
+// given its own channel which the goroutine reading from chrome delivers the
+// response to, so commands from different goroutines are pipelined over the
+// one connection.
 type syncDebugger struct {
 	*debugger
-	// lock ensures that we can only send or batch on one goroutine at a
-	// time
-	lock *sync.Mutex
-
-	// outstanding is a waitgroup which stores the remaining number of
-	// commands sent without a response
-	outstanding *sync.WaitGroup
-
-	// responses stores a map of all command responses keyed by their ID
-	responses map[int]interface{}
-	// commands stores a map of all sent comamnds by their ID
-	commands map[int]Command
 }
 
-func NewSync(host string) (*syncDebugger, error) {
-	base, err := newBaseDebugger(host)
+// NewSync connects a sync debugger to chrome.  The host is either
+// the address of chrome's HTTP endpoints, such as "http://localhost:9222",
+// from which the target is chosen (see WithTarget), or a target's ws://
+// debugger URL.
+func NewSync(host string, opts ...Option) (*syncDebugger, error) {
+	base, err := newBaseDebugger(host, opts)
 	if err != nil {
 		return nil, err
 	}
 
 	debugger := &syncDebugger{
-		debugger:    base,
-		lock:        &sync.Mutex{},
-		outstanding: &sync.WaitGroup{},
-		responses:   map[int]interface{}{},
-		commands:    map[int]Command{},
+		debugger: base,
 	}
-
-	go func() {
-		for {
-			_, data, err := debugger.conn.ReadMessage()
-			if err != nil {
-				return
-			}
-			resp, err := decodeResponse(data, debugger.commands)
-			if err != nil {
-				return
-			}
-
-			switch resp.(type) {
-			case Error:
-				debugger.responses[resp.(Error).ID] = resp
-				debugger.outstanding.Done()
-				base.errChan <- resp.(Error)
-			case Result:
-				debugger.responses[resp.(Result).ID] = resp
-				debugger.outstanding.Done()
-				base.resChan <- resp.(Result)
-			case Command:
-				base.cmdChan <- resp.(Command)
-			}
-		}
-	}()
+	go base.conn.read()
 
 	return debugger, nil
 }
 
+// NewSyncTransport returns a sync debugger speaking to chrome over
+// transport, eg. a pipe from NewPipeTransport.  Version and Info return
+// ErrNoHTTPEndpoints.
+func NewSyncTransport(transport Transport, opts ...Option) *syncDebugger {
+	base := newTransportDebugger(transport, opts)
+	go base.conn.read()
+	return &syncDebugger{debugger: base}
+}
+
 // Version returns the chrome version inforamation from /json/version
 func (sd syncDebugger) Version() (Version, error) {
-	return version(sd.host)
+	return sd.version()
 }
 
 // Info returns a slice of browser contexts from /json/list
 func (sd syncDebugger) Info() ([]Info, error) {
-	return info(sd.host)
+	return sd.info()
 }
 
 func (sd syncDebugger) Send(cmd Command) (Result, error) {
-	sd.lock.Lock()
-	defer sd.lock.Unlock()
+	return sd.SendContext(context.Background(), cmd)
+}
 
-	wrapper := commandWrapper{
-		ID:      sd.id,
-		Command: cmd,
+func (sd syncDebugger) SendContext(ctx context.Context, cmd Command) (Result, error) {
+	if err := ctx.Err(); err != nil {
+		return Result{}, err
 	}
-	sd.commands[sd.id] = cmd
+	return sd.intercept(ctx, cmd, sd.roundTrip)
+}
 
-	sd.outstanding.Add(1)
-	if err := sd.conn.WriteJSON(wrapper); err != nil {
-		sd.outstanding.Done()
-		return Result{}, fmt.Errorf("error sending command to chrome: %s", err)
-	}
-	sd.id++
-	sd.outstanding.Wait()
+// roundTrip sends cmd and waits for its response.
+func (sd syncDebugger) roundTrip(ctx context.Context, cmd Command) (Result, error) {
 
-	if err, ok := sd.responses[wrapper.ID].(Error); ok {
-		cmd, ok := sd.commands[err.ID]
-		if ok {
-			err.Request = &cmd
-		}
+	// buffered so that the reader never blocks on delivering a response
+	reply := make(chan interface{}, 1)
+	id, err := sd.send(cmd, reply)
+	if err != nil {
 		return Result{}, err
 	}
 
-	return sd.responses[wrapper.ID].(Result), nil
+	select {
+	case resp := <-reply:
+		if err, ok := resp.(Error); ok {
+			return Result{}, err
+		}
+		return resp.(Result), nil
+	case <-ctx.Done():
+		sd.abandon(id)
+		return Result{}, ctx.Err()
+	case <-sd.done:
+		return Result{}, sd.err
+	}
 }
 
 func (sd syncDebugger) Batch(commands []Command) ([]interface{}, error) {
-	sd.lock.Lock()
-	defer sd.lock.Unlock()
+	return sd.BatchContext(context.Background(), commands)
+}
 
-	startId := sd.id
+func (sd syncDebugger) BatchContext(ctx context.Context, commands []Command) ([]interface{}, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if len(sd.interceptors) > 0 {
+		return sd.batchIntercepted(ctx, commands)
+	}
 
+	ids := make([]int, 0, len(commands))
+	replies := make([]chan interface{}, 0, len(commands))
 	for _, cmd := range commands {
-		wrapper := commandWrapper{
-			ID:      sd.id,
-			Command: cmd,
+		reply := make(chan interface{}, 1)
+		id, err := sd.send(cmd, reply)
+		if err != nil {
+			sd.abandon(ids...)
+			return nil, err
 		}
-		sd.outstanding.Add(1)
-		if err := sd.conn.WriteJSON(wrapper); err != nil {
-			sd.outstanding.Done()
-			return nil, fmt.Errorf("error sending command to chrome: %s", err)
+		ids = append(ids, id)
+		replies = append(replies, reply)
+	}
+
+	responses := make([]interface{}, len(commands), len(commands))
+	for i, reply := range replies {
+		select {
+		case responses[i] = <-reply:
+		case <-ctx.Done():
+			sd.abandon(ids[i:]...)
+			return nil, ctx.Err()
+		case <-sd.done:
+			return nil, sd.err
 		}
-		sd.id++
 	}
 
-	sd.outstanding.Wait()
+	return responses, nil
+}
 
+// batchIntercepted sends commands through the interceptors in order, each
+// once the previous has its response.
+func (sd syncDebugger) batchIntercepted(ctx context.Context, commands []Command) ([]interface{}, error) {
 	responses := make([]interface{}, len(commands), len(commands))
-	for i := 0; i < len(commands); i++ {
-		idx := startId + i
-		responses[i] = sd.responses[idx]
+	for i, cmd := range commands {
+		res, err := sd.SendContext(ctx, cmd)
+		if e, ok := err.(Error); ok {
+			responses[i] = e
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		responses[i] = res
 	}
-
 	return responses, nil
 }
 
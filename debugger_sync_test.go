@@ -0,0 +1,213 @@
+package chromedebugo_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/tonyhb/chromedebugo"
+	"github.com/tonyhb/chromedebugo/cdptest"
+)
+
+func newSync(t *testing.T, srv *cdptest.Server, opts ...chromedebugo.Option) chromedebugo.SyncDebugger {
+	t.Helper()
+	d, err := chromedebugo.NewSync(srv.URL(), opts...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { d.Close() })
+	return d
+}
+
+func TestSend(t *testing.T) {
+	srv := cdptest.NewServer()
+	defer srv.Close()
+	srv.Handle("Runtime.evaluate", cdptest.Result(map[string]interface{}{"value": 2}))
+	d := newSync(t, srv)
+
+	res, err := d.Send(chromedebugo.Command{
+		Method: "Runtime.evaluate",
+		Params: map[string]interface{}{"expression": "1+1"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Result["value"] != float64(2) {
+		t.Errorf("got result %v", res.Result)
+	}
+	cmd := srv.AssertReceived(t, "Runtime.evaluate")
+	if cmd.Params["expression"] != "1+1" {
+		t.Errorf("chrome received params %v", cmd.Params)
+	}
+}
+
+func TestSendError(t *testing.T) {
+	srv := cdptest.NewServer()
+	defer srv.Close()
+	srv.Handle("Page.navigate", cdptest.Fail(-32000, "Cannot navigate to invalid URL"))
+	d := newSync(t, srv)
+
+	_, err := d.Send(chromedebugo.Command{Method: "Page.navigate"})
+	var e chromedebugo.Error
+	if !errors.As(err, &e) {
+		t.Fatalf("got %v, want an Error", err)
+	}
+	if e.ErrorDetail.Code != -32000 || e.ErrorDetail.Message != "Cannot navigate to invalid URL" {
+		t.Errorf("got error detail %+v", e.ErrorDetail)
+	}
+}
+
+func TestSendContextTimeout(t *testing.T) {
+	srv := cdptest.NewServer()
+	defer srv.Close()
+	srv.Handle("Slow.method", cdptest.Delayed(100*time.Millisecond, cdptest.Result(map[string]interface{}{"slow": true})))
+	srv.Handle("Fast.method", cdptest.Delayed(200*time.Millisecond, cdptest.Result(map[string]interface{}{"fast": true})))
+	d := newSync(t, srv)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := d.SendContext(ctx, chromedebugo.Command{Method: "Slow.method"}); err != context.DeadlineExceeded {
+		t.Fatalf("got %v, want %v", err, context.DeadlineExceeded)
+	}
+
+	// the late reply to the abandoned command is discarded rather than
+	// delivered to the next
+	res, err := d.Send(chromedebugo.Command{Method: "Fast.method"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Result["fast"] != true {
+		t.Errorf("got result %v, want the reply to Fast.method", res.Result)
+	}
+}
+
+func TestBatch(t *testing.T) {
+	srv := cdptest.NewServer()
+	defer srv.Close()
+	d := newSync(t, srv)
+
+	cmds := make([]chromedebugo.Command, 10)
+	for i := range cmds {
+		cmds[i] = chromedebugo.Command{Method: fmt.Sprintf("M.%d", i)}
+		n := i
+		// later commands are answered first
+		delay := time.Duration(len(cmds)-i) * time.Millisecond
+		if i == 5 {
+			srv.Handle(cmds[i].Method, cdptest.Delayed(delay, cdptest.Fail(-1, "five")))
+			continue
+		}
+		srv.Handle(cmds[i].Method, cdptest.Delayed(delay, cdptest.Result(map[string]interface{}{"n": n})))
+	}
+
+	resps, err := d.Batch(cmds)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resps) != len(cmds) {
+		t.Fatalf("got %d responses, want %d", len(resps), len(cmds))
+	}
+	for i, resp := range resps {
+		if i == 5 {
+			if _, ok := resp.(chromedebugo.Error); !ok {
+				t.Errorf("response %d is %#v, want an Error", i, resp)
+			}
+			continue
+		}
+		res, ok := resp.(chromedebugo.Result)
+		if !ok || res.Result["n"] != float64(i) {
+			t.Errorf("response %d is %#v", i, resp)
+		}
+	}
+}
+
+func TestSubscribe(t *testing.T) {
+	srv := cdptest.NewServer()
+	defer srv.Close()
+	d := newSync(t, srv)
+
+	events, unsubscribe := d.Subscribe("Network.*")
+	defer unsubscribe()
+	srv.Emit("Page.loadEventFired", nil)
+	srv.Emit("Network.requestWillBeSent", map[string]interface{}{"requestId": "1"})
+
+	select {
+	case ev := <-events:
+		if ev.Method != "Network.requestWillBeSent" || ev.Params["requestId"] != "1" {
+			t.Errorf("got event %+v", ev)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("event not received")
+	}
+}
+
+func TestClose(t *testing.T) {
+	srv := cdptest.NewServer()
+	defer srv.Close()
+	srv.Handle("Slow.method", cdptest.Delayed(time.Second, cdptest.Result(nil)))
+	d, err := chromedebugo.NewSync(srv.URL())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	errs := make(chan error)
+	go func() {
+		_, err := d.Send(chromedebugo.Command{Method: "Slow.method"})
+		errs <- err
+	}()
+	if _, err := srv.WaitForCommand(context.Background(), "Slow.method"); err != nil {
+		t.Fatal(err)
+	}
+	d.Close()
+
+	var closed chromedebugo.ErrConnectionClosed
+	if err := <-errs; !errors.As(err, &closed) {
+		t.Errorf("awaiting command got %v, want ErrConnectionClosed", err)
+	}
+	select {
+	case <-d.Done():
+	case <-time.After(5 * time.Second):
+		t.Fatal("Done not closed")
+	}
+	if !errors.As(d.Err(), &closed) || closed.Cause != nil {
+		t.Errorf("got Err %v, want ErrConnectionClosed with no cause", d.Err())
+	}
+	if _, err := d.Send(chromedebugo.Command{Method: "Page.enable"}); !errors.As(err, &closed) {
+		t.Errorf("command sent after Close got %v, want ErrConnectionClosed", err)
+	}
+}
+
+func TestConcurrentSenders(t *testing.T) {
+	srv := cdptest.NewServer()
+	defer srv.Close()
+	// echo each command's params, after a delay which reorders the replies
+	srv.Handle("Echo.params", func(cmd chromedebugo.Command) cdptest.Response {
+		n := cmd.Params["n"].(float64)
+		return cdptest.Response{
+			Result: cmd.Params,
+			Delay:  time.Duration(int(n)%7) * time.Millisecond,
+		}
+	})
+	d := newSync(t, srv)
+
+	const senders = 500
+	errs := make(chan error, senders)
+	for i := 0; i < senders; i++ {
+		go func(n int) {
+			res, err := d.Send(chromedebugo.Command{
+				Method: "Echo.params",
+				Params: map[string]interface{}{"n": n},
+			})
+			if err == nil && res.Result["n"] != float64(n) {
+				err = fmt.Errorf("sender %d got reply %v", n, res.Result)
+			}
+			errs <- err
+		}(i)
+	}
+	for i := 0; i < senders; i++ {
+		if err := <-errs; err != nil {
+			t.Error(err)
+		}
+	}
+}
@@ -0,0 +1,36 @@
+package device
+
+// User agents of the browsers the built in devices come with.
+const (
+	uaIOS15 = "Mozilla/5.0 (iPhone; CPU iPhone OS 15_0 like Mac OS X) AppleWebKit/605.1.15 " +
+		"(KHTML, like Gecko) Version/15.0 Mobile/15E148 Safari/604.1"
+	uaIPadOS15 = "Mozilla/5.0 (iPad; CPU OS 15_0 like Mac OS X) AppleWebKit/605.1.15 " +
+		"(KHTML, like Gecko) Version/15.0 Mobile/15E148 Safari/604.1"
+	uaPixel5 = "Mozilla/5.0 (Linux; Android 11; Pixel 5) AppleWebKit/537.36 " +
+		"(KHTML, like Gecko) Chrome/90.0.4430.91 Mobile Safari/537.36"
+	uaGalaxyS20 = "Mozilla/5.0 (Linux; Android 10; SM-G981B) AppleWebKit/537.36 " +
+		"(KHTML, like Gecko) Chrome/80.0.3987.162 Mobile Safari/537.36"
+	uaGalaxyTabS4 = "Mozilla/5.0 (Linux; Android 8.1.0; SM-T837A) AppleWebKit/537.36 " +
+		"(KHTML, like Gecko) Chrome/70.0.3538.80 Safari/537.36"
+	uaWindowsChrome = "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 " +
+		"(KHTML, like Gecko) Chrome/120.0.0.0 Safari/537.36"
+	uaMacSafari = "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/605.1.15 " +
+		"(KHTML, like Gecko) Version/17.0 Safari/605.1.15"
+)
+
+// Builtin are common phones, tablets and desktops, held upright.  Use
+// Device.Landscape for them held sideways.
+var Builtin = []Device{
+	{Name: "iPhone SE", Width: 375, Height: 667, DeviceScaleFactor: 2, Mobile: true, Touch: true, UserAgent: uaIOS15},
+	{Name: "iPhone 13", Width: 390, Height: 844, DeviceScaleFactor: 3, Mobile: true, Touch: true, UserAgent: uaIOS15},
+	{Name: "iPhone 13 Pro Max", Width: 428, Height: 926, DeviceScaleFactor: 3, Mobile: true, Touch: true, UserAgent: uaIOS15},
+	{Name: "Pixel 5", Width: 393, Height: 851, DeviceScaleFactor: 2.75, Mobile: true, Touch: true, UserAgent: uaPixel5},
+	{Name: "Galaxy S20", Width: 360, Height: 800, DeviceScaleFactor: 3, Mobile: true, Touch: true, UserAgent: uaGalaxyS20},
+	{Name: "iPad Mini", Width: 768, Height: 1024, DeviceScaleFactor: 2, Mobile: true, Touch: true, UserAgent: uaIPadOS15},
+	{Name: "iPad Pro 11", Width: 834, Height: 1194, DeviceScaleFactor: 2, Mobile: true, Touch: true, UserAgent: uaIPadOS15},
+	{Name: "Galaxy Tab S4", Width: 712, Height: 1138, DeviceScaleFactor: 2.25, Mobile: true, Touch: true, UserAgent: uaGalaxyTabS4},
+	{Name: "Laptop", Width: 1366, Height: 768, DeviceScaleFactor: 1, UserAgent: uaWindowsChrome},
+	{Name: "MacBook Pro 14", Width: 1512, Height: 982, DeviceScaleFactor: 2, UserAgent: uaMacSafari},
+	{Name: "Desktop 1080p", Width: 1920, Height: 1080, DeviceScaleFactor: 1, UserAgent: uaWindowsChrome},
+	{Name: "Desktop 4K", Width: 3840, Height: 2160, DeviceScaleFactor: 1, UserAgent: uaWindowsChrome},
+}
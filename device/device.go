@@ -0,0 +1,147 @@
+// Package device emulates phones, tablets and desktops of given screen
+// sizes, pixel densities and user agents:
+//
+//	iphone, err := device.Lookup("iPhone 13")
+//	if err != nil {
+//		return err
+//	}
+//	err = device.Emulate(ctx, debugger, iphone)
+//	...
+//	err = device.ClearEmulation(ctx, debugger)
+//
+// Devices are described by Device and kept in a Registry, which starts with
+// the Builtin devices and can load more from JSON.
+package device
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tonyhb/chromedebugo"
+	"github.com/tonyhb/chromedebugo/protocol/emulation"
+)
+
+// DefaultMaxTouchPoints is the number of touches at once emulated for
+// devices with touch screens.
+const DefaultMaxTouchPoints = 5
+
+// Device describes a device to emulate.  Its JSON encoding is the format
+// loaded by Registry.Load.
+type Device struct {
+	Name string `json:"name"`
+	// Width and Height are the size of the viewport in CSS pixels
+	Width  int `json:"width"`
+	Height int `json:"height"`
+	// DeviceScaleFactor is the number of device pixels per CSS pixel
+	DeviceScaleFactor float64 `json:"deviceScaleFactor"`
+	// Mobile emulates a mobile browser, with a meta viewport tag and
+	// overlay scrollbars
+	Mobile bool `json:"mobile"`
+	// Touch emulates a touch screen
+	Touch bool `json:"touch"`
+	// UserAgent is sent in requests and returned by navigator.userAgent.
+	// If empty the browser's own is used.
+	UserAgent string `json:"userAgent,omitempty"`
+	// IsLandscape is true for devices held sideways
+	IsLandscape bool `json:"landscape,omitempty"`
+}
+
+// Landscape returns the device held sideways, with its width and height
+// swapped.
+func (d Device) Landscape() Device {
+	if d.IsLandscape {
+		return d
+	}
+	d.Name += " landscape"
+	d.Width, d.Height = d.Height, d.Width
+	d.IsLandscape = true
+	return d
+}
+
+// validate returns an error if the device can't be emulated.
+func (d Device) validate() error {
+	if d.Name == "" {
+		return fmt.Errorf("device has no name")
+	}
+	if d.Width <= 0 || d.Height <= 0 {
+		return fmt.Errorf("device %q has invalid size %dx%d", d.Name, d.Width, d.Height)
+	}
+	if d.DeviceScaleFactor < 0 {
+		return fmt.Errorf("device %q has negative scale factor %g", d.Name, d.DeviceScaleFactor)
+	}
+	return nil
+}
+
+func (d Device) orientation() *emulation.ScreenOrientation {
+	if d.IsLandscape {
+		return &emulation.ScreenOrientation{Type: "landscapePrimary", Angle: 90}
+	}
+	return &emulation.ScreenOrientation{Type: "portraitPrimary", Angle: 0}
+}
+
+// Emulate emulates device in the page d is connected to, overriding its
+// device metrics, user agent and touch support in one batch.  Emulating a
+// device with no user agent clears any user agent override.  The emulation
+// lasts until ClearEmulation or until the page is closed.
+func Emulate(ctx context.Context, d chromedebugo.SyncDebugger, device Device) error {
+	if err := device.validate(); err != nil {
+		return err
+	}
+	maxTouchPoints := 0
+	if device.Touch {
+		maxTouchPoints = DefaultMaxTouchPoints
+	}
+	return batch(ctx, d, "error emulating "+device.Name,
+		emulation.SetDeviceMetricsOverrideParams{
+			Width:             int64(device.Width),
+			Height:            int64(device.Height),
+			DeviceScaleFactor: device.DeviceScaleFactor,
+			Mobile:            device.Mobile,
+			ScreenOrientation: device.orientation(),
+		},
+		emulation.SetUserAgentOverrideParams{UserAgent: device.UserAgent},
+		emulation.SetTouchEmulationEnabledParams{
+			Enabled:        device.Touch,
+			MaxTouchPoints: int64(maxTouchPoints),
+		},
+	)
+}
+
+// ClearEmulation stops emulating a device, restoring the page's own device
+// metrics, user agent and touch support.
+func ClearEmulation(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return batch(ctx, d, "error clearing device emulation",
+		emulation.ClearDeviceMetricsOverrideParams{},
+		// an empty user agent clears the override
+		emulation.SetUserAgentOverrideParams{UserAgent: ""},
+		emulation.SetTouchEmulationEnabledParams{Enabled: false},
+	)
+}
+
+// command is implemented by the params of every protocol command.
+type command interface {
+	Command() (chromedebugo.Command, error)
+}
+
+// batch sends params as a batch and returns the first error chrome replies
+// with, prefixed by msg.
+func batch(ctx context.Context, d chromedebugo.SyncDebugger, msg string, params ...command) error {
+	cmds := make([]chromedebugo.Command, 0, len(params))
+	for _, p := range params {
+		cmd, err := p.Command()
+		if err != nil {
+			return err
+		}
+		cmds = append(cmds, cmd)
+	}
+	resps, err := d.BatchContext(ctx, cmds)
+	if err != nil {
+		return err
+	}
+	for _, resp := range resps {
+		if e, ok := resp.(chromedebugo.Error); ok {
+			return fmt.Errorf("%s: %w", msg, e)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,222 @@
+package device_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/tonyhb/chromedebugo"
+	"github.com/tonyhb/chromedebugo/cdptest"
+	"github.com/tonyhb/chromedebugo/device"
+)
+
+const devicesJSON = `[
+	{"name": "Kiosk", "width": 1080, "height": 1920, "deviceScaleFactor": 1, "touch": true},
+	{"name": "Watch", "width": 198, "height": 242, "deviceScaleFactor": 2, "mobile": true, "userAgent": "Watch/1.0"}
+]`
+
+func TestLoad(t *testing.T) {
+	r, err := device.NewRegistry()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := r.Load(strings.NewReader(devicesJSON)); err != nil {
+		t.Fatal(err)
+	}
+	if names := strings.Join(r.Names(), ", "); names != "Kiosk, Watch" {
+		t.Errorf("got devices %s", names)
+	}
+	watch, err := r.Get("Watch")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := device.Device{Name: "Watch", Width: 198, Height: 242, DeviceScaleFactor: 2, Mobile: true, UserAgent: "Watch/1.0"}
+	if watch != want {
+		t.Errorf("got %+v, want %+v", watch, want)
+	}
+}
+
+func TestLoadFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "devices.json")
+	if err := os.WriteFile(path, []byte(devicesJSON), 0644); err != nil {
+		t.Fatal(err)
+	}
+	r, err := device.NewRegistry(device.Builtin...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := r.LoadFile(path); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := r.Get("Kiosk"); err != nil {
+		t.Error(err)
+	}
+	if _, err := r.Get("iPhone 13"); err != nil {
+		t.Error(err)
+	}
+
+	err = r.LoadFile(filepath.Join(t.TempDir(), "missing.json"))
+	if !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("got %v, want %v", err, os.ErrNotExist)
+	}
+}
+
+func TestLoadInvalid(t *testing.T) {
+	tests := map[string]string{
+		"bad json":       `[{"name": "Kiosk",`,
+		"no name":        `[{"name": "Kiosk", "width": 1080, "height": 1920}, {"width": 1, "height": 1}]`,
+		"no size":        `[{"name": "Kiosk", "width": 1080, "height": 1920}, {"name": "Blank"}]`,
+		"negative scale": `[{"name": "Kiosk", "width": 1080, "height": 1920}, {"name": "Odd", "width": 1, "height": 1, "deviceScaleFactor": -1}]`,
+	}
+	for name, input := range tests {
+		t.Run(name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "devices.json")
+			if err := os.WriteFile(path, []byte(input), 0644); err != nil {
+				t.Fatal(err)
+			}
+			r, err := device.NewRegistry()
+			if err != nil {
+				t.Fatal(err)
+			}
+			err = r.LoadFile(path)
+			if err == nil || !strings.Contains(err.Error(), "error loading "+path) {
+				t.Fatalf("got %v, want an error loading %s", err, path)
+			}
+			// the valid device is not added either
+			if names := r.Names(); len(names) != 0 {
+				t.Errorf("added %v", names)
+			}
+		})
+	}
+}
+
+func TestNewRegistryInvalid(t *testing.T) {
+	r, err := device.NewRegistry(device.Device{Name: "Blank"})
+	if err == nil || r != nil {
+		t.Errorf("got %v, %v, want an error for the invalid device", r, err)
+	}
+}
+
+func TestGet(t *testing.T) {
+	for _, name := range []string{"iPhone 13", "iphone 13", "IPHONE 13"} {
+		d, err := device.Lookup(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if d.Name != "iPhone 13" {
+			t.Errorf("Lookup(%q) returned %s", name, d.Name)
+		}
+	}
+	_, err := device.Lookup("iPhone 1")
+	if !errors.Is(err, device.ErrUnknownDevice) || !strings.Contains(err.Error(), "iPhone 1") {
+		t.Errorf("got %v, want %v", err, device.ErrUnknownDevice)
+	}
+}
+
+func TestLandscape(t *testing.T) {
+	iphone, err := device.Lookup("iPhone 13")
+	if err != nil {
+		t.Fatal(err)
+	}
+	landscape := iphone.Landscape()
+	if landscape.Name != "iPhone 13 landscape" || landscape.Width != 844 || landscape.Height != 390 || !landscape.IsLandscape {
+		t.Errorf("got %+v", landscape)
+	}
+	if again := landscape.Landscape(); again != landscape {
+		t.Errorf("turned sideways twice: %+v", again)
+	}
+	if iphone.Width != 390 || iphone.IsLandscape {
+		t.Errorf("changed the original: %+v", iphone)
+	}
+}
+
+func newPage(t *testing.T) (*cdptest.Server, chromedebugo.SyncDebugger) {
+	t.Helper()
+	srv := cdptest.NewServer()
+	t.Cleanup(srv.Close)
+	srv.Handle("Emulation.setDeviceMetricsOverride", cdptest.Result(nil))
+	srv.Handle("Emulation.clearDeviceMetricsOverride", cdptest.Result(nil))
+	srv.Handle("Emulation.setUserAgentOverride", cdptest.Result(nil))
+	srv.Handle("Emulation.setTouchEmulationEnabled", cdptest.Result(nil))
+	d, err := chromedebugo.NewSync(srv.URL())
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { d.Close() })
+	return srv, d
+}
+
+func TestEmulate(t *testing.T) {
+	srv, d := newPage(t)
+	iphone, err := device.Lookup("iPhone 13")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := device.Emulate(context.Background(), d, iphone.Landscape()); err != nil {
+		t.Fatal(err)
+	}
+
+	metrics := srv.AssertReceived(t, "Emulation.setDeviceMetricsOverride").Params
+	orientation, _ := metrics["screenOrientation"].(map[string]interface{})
+	if metrics["width"] != float64(844) || metrics["height"] != float64(390) || metrics["deviceScaleFactor"] != float64(3) || metrics["mobile"] != true {
+		t.Errorf("got metrics %v", metrics)
+	}
+	if orientation["type"] != "landscapePrimary" || orientation["angle"] != float64(90) {
+		t.Errorf("got orientation %v", orientation)
+	}
+	if ua := srv.AssertReceived(t, "Emulation.setUserAgentOverride").Params; !strings.Contains(ua["userAgent"].(string), "iPhone") {
+		t.Errorf("got user agent %v", ua)
+	}
+	if touch := srv.AssertReceived(t, "Emulation.setTouchEmulationEnabled").Params; touch["enabled"] != true || touch["maxTouchPoints"] != float64(device.DefaultMaxTouchPoints) {
+		t.Errorf("got touch %v", touch)
+	}
+}
+
+func TestEmulateBatch(t *testing.T) {
+	srv, d := newPage(t)
+	srv.Handle("Emulation.setUserAgentOverride", cdptest.Fail(-32000, "Invalid user agent"))
+	laptop, err := device.Lookup("Laptop")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the commands are sent together, so a failure part way through
+	// doesn't stop the rest
+	err = device.Emulate(context.Background(), d, laptop)
+	var chromeErr chromedebugo.Error
+	if !errors.As(err, &chromeErr) || !strings.Contains(err.Error(), "error emulating Laptop") {
+		t.Fatalf("got %v, want chrome's error emulating Laptop", err)
+	}
+	for _, method := range []string{"Emulation.setDeviceMetricsOverride", "Emulation.setUserAgentOverride", "Emulation.setTouchEmulationEnabled"} {
+		if n := len(srv.Received(method)); n != 1 {
+			t.Errorf("%s sent %d times, want once", method, n)
+		}
+	}
+}
+
+func TestEmulateInvalid(t *testing.T) {
+	srv, d := newPage(t)
+	if err := device.Emulate(context.Background(), d, device.Device{Name: "Blank"}); err == nil {
+		t.Fatal("emulated a device with no size")
+	}
+	if n := len(srv.Received("Emulation.*")); n != 0 {
+		t.Errorf("sent %d commands for an invalid device", n)
+	}
+}
+
+func TestClearEmulation(t *testing.T) {
+	srv, d := newPage(t)
+	if err := device.ClearEmulation(context.Background(), d); err != nil {
+		t.Fatal(err)
+	}
+	srv.AssertReceived(t, "Emulation.clearDeviceMetricsOverride")
+	if ua := srv.AssertReceived(t, "Emulation.setUserAgentOverride").Params; ua["userAgent"] != "" {
+		t.Errorf("got user agent %v", ua)
+	}
+	if touch := srv.AssertReceived(t, "Emulation.setTouchEmulationEnabled").Params; touch["enabled"] != false {
+		t.Errorf("got touch %v", touch)
+	}
+}
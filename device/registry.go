@@ -0,0 +1,122 @@
+package device
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// ErrUnknownDevice is returned, wrapped with the name, when a registry has
+// no device with that name.
+var ErrUnknownDevice = errors.New("unknown device")
+
+// Registry is a set of devices by name.  Names are matched ignoring case.
+// It is safe for concurrent use.
+type Registry struct {
+	lock    sync.RWMutex
+	devices map[string]Device
+}
+
+// NewRegistry returns a registry of the given devices, or an error if any
+// is invalid.  Use NewRegistry(Builtin...) to start from the built in
+// devices.
+func NewRegistry(devices ...Device) (*Registry, error) {
+	r := &Registry{devices: map[string]Device{}}
+	if err := r.Add(devices...); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// Add adds devices to the registry, replacing any of the same name.
+func (r *Registry) Add(devices ...Device) error {
+	for _, d := range devices {
+		if err := d.validate(); err != nil {
+			return err
+		}
+	}
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	for _, d := range devices {
+		r.devices[strings.ToLower(d.Name)] = d
+	}
+	return nil
+}
+
+// Get returns the device with the given name, or an error wrapping
+// ErrUnknownDevice.
+func (r *Registry) Get(name string) (Device, error) {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+	d, ok := r.devices[strings.ToLower(name)]
+	if !ok {
+		return Device{}, fmt.Errorf("%w: %s", ErrUnknownDevice, name)
+	}
+	return d, nil
+}
+
+// Names returns the names of the registry's devices, sorted.
+func (r *Registry) Names() []string {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+	names := make([]string, 0, len(r.devices))
+	for _, d := range r.devices {
+		names = append(names, d.Name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Load adds the devices in a JSON array of Devices to the registry, eg.:
+//
+//	[
+//		{
+//			"name": "Kiosk",
+//			"width": 1080,
+//			"height": 1920,
+//			"deviceScaleFactor": 1,
+//			"touch": true
+//		}
+//	]
+//
+// No device is added if any is invalid.
+func (r *Registry) Load(rd io.Reader) error {
+	devices := []Device{}
+	if err := json.NewDecoder(rd).Decode(&devices); err != nil {
+		return fmt.Errorf("error decoding devices: %s", err)
+	}
+	return r.Add(devices...)
+}
+
+// LoadFile adds the devices in a JSON file, as for Load, to the registry.
+func (r *Registry) LoadFile(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	if err := r.Load(f); err != nil {
+		return fmt.Errorf("error loading %s: %s", path, err)
+	}
+	return nil
+}
+
+// builtin is the registry of the Builtin devices used by Lookup.
+var builtin = func() *Registry {
+	r, err := NewRegistry(Builtin...)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}()
+
+// Lookup returns the built in device with the given name, or an error
+// wrapping ErrUnknownDevice.
+func Lookup(name string) (Device, error) {
+	return builtin.Get(name)
+}
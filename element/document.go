@@ -0,0 +1,173 @@
+// Package element finds elements in a page's DOM and reads and changes them:
+//
+//	doc, err := element.New(ctx, debugger)
+//	if err != nil {
+//		return err
+//	}
+//	defer doc.Close()
+//	heading, err := doc.Query(ctx, "h1")
+//	...
+//	text, err := heading.Text(ctx)
+//
+// Elements are identified by DOM node IDs, which chrome forgets whenever the
+// document is replaced, eg. on navigation.  The Document follows
+// DOM.documentUpdated and elements found before an update fail with
+// ErrStaleElement rather than chrome's error for an unknown node.
+package element
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/tonyhb/chromedebugo"
+	"github.com/tonyhb/chromedebugo/protocol/dom"
+)
+
+// ErrNotFound is returned, wrapped with the selector, when Query matches no
+// element.
+var ErrNotFound = errors.New("no element matches")
+
+// ErrStaleElement is returned by an element whose document has been updated
+// since it was found.  It must be found again.
+var ErrStaleElement = errors.New("element is stale: the document was updated since it was found")
+
+// Document is the DOM document of a page.  It is safe for concurrent use.
+type Document struct {
+	d chromedebugo.SyncDebugger
+
+	// lock guards root and generation.  generation counts document
+	// updates, and root is the document's node ID in the current
+	// generation, or zero until it is requested.
+	lock       sync.Mutex
+	root       dom.NodeID
+	generation int
+
+	unsubscribe func()
+}
+
+// New enables the DOM domain of the page d is connected to and returns its
+// document.
+func New(ctx context.Context, d chromedebugo.SyncDebugger) (*Document, error) {
+	doc := &Document{d: d}
+	doc.unsubscribe = d.On(dom.EventDocumentUpdated, func(chromedebugo.Command) {
+		doc.lock.Lock()
+		defer doc.lock.Unlock()
+		doc.root = 0
+		doc.generation++
+	}, chromedebugo.WithOverflow(chromedebugo.Block))
+
+	if err := (dom.EnableParams{}).Do(ctx, d); err != nil {
+		doc.Close()
+		return nil, err
+	}
+	return doc, nil
+}
+
+// Close stops following document updates.
+func (doc *Document) Close() {
+	doc.unsubscribe()
+}
+
+// Query returns the first element matching the CSS selector, or an error
+// wrapping ErrNotFound.
+func (doc *Document) Query(ctx context.Context, selector string) (*Element, error) {
+	root, err := doc.rootElement(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return root.Query(ctx, selector)
+}
+
+// QueryAll returns every element matching the CSS selector, in document
+// order.
+func (doc *Document) QueryAll(ctx context.Context, selector string) ([]*Element, error) {
+	root, err := doc.rootElement(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return root.QueryAll(ctx, selector)
+}
+
+// XPath returns the nodes matching an XPath expression, such as
+// "//a[contains(., 'Next')]", in document order.  Chrome's search also
+// accepts plain text and CSS selectors.
+func (doc *Document) XPath(ctx context.Context, expr string) ([]*Element, error) {
+	// the search only covers nodes known to the client, so the document
+	// must have been requested
+	root, err := doc.rootElement(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	search, err := dom.PerformSearchParams{Query: expr}.Do(ctx, doc.d)
+	if err != nil {
+		return nil, root.wrap(err)
+	}
+	defer dom.DiscardSearchResultsParams{SearchID: search.SearchID}.Do(context.Background(), doc.d)
+	if search.ResultCount == 0 {
+		return []*Element{}, nil
+	}
+
+	ret, err := dom.GetSearchResultsParams{
+		SearchID:  search.SearchID,
+		FromIndex: 0,
+		ToIndex:   search.ResultCount,
+	}.Do(ctx, doc.d)
+	if err != nil {
+		return nil, root.wrap(err)
+	}
+	return root.elements(ret.NodeIds), nil
+}
+
+// rootElement returns the document node, requesting it if this generation
+// of the document has not been requested yet.
+func (doc *Document) rootElement(ctx context.Context) (*Element, error) {
+	doc.lock.Lock()
+	root, generation := doc.root, doc.generation
+	doc.lock.Unlock()
+	if root != 0 {
+		return &Element{doc: doc, id: root, generation: generation}, nil
+	}
+
+	ret, err := dom.GetDocumentParams{}.Do(ctx, doc.d)
+	if err != nil {
+		return nil, err
+	}
+
+	doc.lock.Lock()
+	defer doc.lock.Unlock()
+	if doc.generation != generation {
+		// updated while requesting: the generation the root belongs to
+		// is unclear, so it isn't cached and is treated as stale
+		return &Element{doc: doc, id: ret.Root.NodeID, generation: generation}, nil
+	}
+	doc.root = ret.Root.NodeID
+	return &Element{doc: doc, id: doc.root, generation: doc.generation}, nil
+}
+
+// current reports whether generation is the document's current generation.
+func (doc *Document) current(generation int) bool {
+	doc.lock.Lock()
+	defer doc.lock.Unlock()
+	return doc.generation == generation
+}
+
+// isStaleNodeError reports whether err is chrome's error for a node ID it
+// doesn't know, which it returns for node IDs from a replaced document.
+func isStaleNodeError(err error) bool {
+	e := chromedebugo.Error{}
+	if !errors.As(err, &e) {
+		return false
+	}
+	msg := strings.ToLower(e.ErrorDetail.Message)
+	return strings.Contains(msg, "could not find node with given id") ||
+		strings.Contains(msg, "no node with given id found")
+}
+
+// notFound wraps ErrNotFound with the selector.
+func notFound(selector string) error {
+	return fmt.Errorf("%w: %s", ErrNotFound, selector)
+}
@@ -0,0 +1,215 @@
+package element
+
+import (
+	"context"
+
+	"github.com/tonyhb/chromedebugo/js"
+	"github.com/tonyhb/chromedebugo/protocol/dom"
+)
+
+// Element is a node in a Document, usually an element, found by a query.
+// It is only valid until the document is updated, after which its methods
+// return ErrStaleElement.
+type Element struct {
+	doc        *Document
+	id         dom.NodeID
+	generation int
+}
+
+// NodeID returns the element's DOM node ID.
+func (e *Element) NodeID() dom.NodeID {
+	return e.id
+}
+
+// Query returns the first descendant of the element matching the CSS
+// selector, or an error wrapping ErrNotFound.
+func (e *Element) Query(ctx context.Context, selector string) (*Element, error) {
+	if err := e.check(); err != nil {
+		return nil, err
+	}
+	ret, err := dom.QuerySelectorParams{NodeID: e.id, Selector: selector}.Do(ctx, e.doc.d)
+	if err != nil {
+		return nil, e.wrap(err)
+	}
+	if ret.NodeID == 0 {
+		return nil, notFound(selector)
+	}
+	return e.element(ret.NodeID), nil
+}
+
+// QueryAll returns every descendant of the element matching the CSS
+// selector, in document order.
+func (e *Element) QueryAll(ctx context.Context, selector string) ([]*Element, error) {
+	if err := e.check(); err != nil {
+		return nil, err
+	}
+	ret, err := dom.QuerySelectorAllParams{NodeID: e.id, Selector: selector}.Do(ctx, e.doc.d)
+	if err != nil {
+		return nil, e.wrap(err)
+	}
+	return e.elements(ret.NodeIds), nil
+}
+
+// Children returns the element's child elements, leaving out text and
+// comment nodes.
+func (e *Element) Children(ctx context.Context) ([]*Element, error) {
+	return e.QueryAll(ctx, ":scope > *")
+}
+
+// Text returns the element's textContent.
+func (e *Element) Text(ctx context.Context) (string, error) {
+	text := ""
+	err := e.call(ctx, &text, "function() { return this.textContent }")
+	return text, err
+}
+
+// Visible reports whether the element is rendered and can be seen: it has a
+// non-empty bounding box and its computed visibility isn't hidden.  It may
+// still be covered by other elements or outside the viewport.
+func (e *Element) Visible(ctx context.Context) (bool, error) {
+	visible := false
+	err := e.call(ctx, &visible, `function() {
+		if (!this.isConnected) return false;
+		const style = getComputedStyle(this);
+		const rect = this.getBoundingClientRect();
+		return style.visibility !== 'hidden' && rect.width > 0 && rect.height > 0;
+	}`)
+	return visible, err
+}
+
+// Attribute returns the value of the named attribute, and whether the
+// element has it.
+func (e *Element) Attribute(ctx context.Context, name string) (string, bool, error) {
+	if err := e.check(); err != nil {
+		return "", false, err
+	}
+	ret, err := dom.GetAttributesParams{NodeID: e.id}.Do(ctx, e.doc.d)
+	if err != nil {
+		return "", false, e.wrap(err)
+	}
+	// attributes are given as name, value pairs
+	for i := 0; i+1 < len(ret.Attributes); i += 2 {
+		if ret.Attributes[i] == name {
+			return ret.Attributes[i+1], true, nil
+		}
+	}
+	return "", false, nil
+}
+
+// SetAttribute sets the named attribute's value, adding it if the element
+// doesn't have it.
+func (e *Element) SetAttribute(ctx context.Context, name, value string) error {
+	if err := e.check(); err != nil {
+		return err
+	}
+	err := dom.SetAttributeValueParams{NodeID: e.id, Name: name, Value: value}.Do(ctx, e.doc.d)
+	return e.wrap(err)
+}
+
+// OuterHTML returns the element's HTML, including the element itself.
+func (e *Element) OuterHTML(ctx context.Context) (string, error) {
+	if err := e.check(); err != nil {
+		return "", err
+	}
+	ret, err := dom.GetOuterHTMLParams{NodeID: e.id}.Do(ctx, e.doc.d)
+	if err != nil {
+		return "", e.wrap(err)
+	}
+	return ret.OuterHTML, nil
+}
+
+// Describe returns the element's node, such as its name and attributes,
+// without its descendants.
+func (e *Element) Describe(ctx context.Context) (dom.Node, error) {
+	if err := e.check(); err != nil {
+		return dom.Node{}, err
+	}
+	ret, err := dom.DescribeNodeParams{NodeID: e.id}.Do(ctx, e.doc.d)
+	if err != nil {
+		return dom.Node{}, e.wrap(err)
+	}
+	return ret.Node, nil
+}
+
+// BoxModel returns the element's content, padding, border and margin boxes,
+// in CSS pixels relative to the viewport.  Chrome fails for elements which
+// are not rendered, eg. those with display: none.
+func (e *Element) BoxModel(ctx context.Context) (dom.BoxModel, error) {
+	if err := e.check(); err != nil {
+		return dom.BoxModel{}, err
+	}
+	ret, err := dom.GetBoxModelParams{NodeID: e.id}.Do(ctx, e.doc.d)
+	if err != nil {
+		return dom.BoxModel{}, e.wrap(err)
+	}
+	return ret.Model, nil
+}
+
+// ScrollIntoView scrolls the element's centre into view, unless it is
+// already visible in the viewport.
+func (e *Element) ScrollIntoView(ctx context.Context) error {
+	if err := e.check(); err != nil {
+		return err
+	}
+	err := dom.ScrollIntoViewIfNeededParams{NodeID: e.id}.Do(ctx, e.doc.d)
+	return e.wrap(err)
+}
+
+// Object returns a JavaScript handle to the element in the given object
+// group, which may be empty.  The handle outlives document updates, but must
+// be released.
+func (e *Element) Object(ctx context.Context, group string) (js.RemoteObject, error) {
+	if err := e.check(); err != nil {
+		return js.RemoteObject{}, err
+	}
+	obj, err := js.ResolveNode(ctx, e.doc.d, e.id, group)
+	if err != nil {
+		return js.RemoteObject{}, e.wrap(err)
+	}
+	return obj, nil
+}
+
+// call calls fn on the element and decodes its result into dst.
+func (e *Element) call(ctx context.Context, dst interface{}, fn string, args ...interface{}) error {
+	obj, err := e.Object(ctx, "")
+	if err != nil {
+		return err
+	}
+	defer obj.Release(context.Background())
+	return obj.CallFunctionInto(ctx, dst, fn, args...)
+}
+
+// check returns ErrStaleElement if the document has been updated since the
+// element was found, so that chrome isn't asked about a node ID it may have
+// forgotten.
+func (e *Element) check() error {
+	if !e.doc.current(e.generation) {
+		return ErrStaleElement
+	}
+	return nil
+}
+
+// wrap replaces chrome's errors for unknown node IDs with ErrStaleElement.
+// Chrome may forget a node before the DOM.documentUpdated event arrives, so
+// its errors are checked as well as the generation.
+func (e *Element) wrap(err error) error {
+	if err == nil {
+		return nil
+	}
+	if !e.doc.current(e.generation) || isStaleNodeError(err) {
+		return ErrStaleElement
+	}
+	return err
+}
+
+func (e *Element) element(id dom.NodeID) *Element {
+	return &Element{doc: e.doc, id: id, generation: e.generation}
+}
+
+func (e *Element) elements(ids []dom.NodeID) []*Element {
+	elements := make([]*Element, len(ids))
+	for i, id := range ids {
+		elements[i] = e.element(id)
+	}
+	return elements
+}
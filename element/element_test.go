@@ -0,0 +1,254 @@
+package element_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/tonyhb/chromedebugo"
+	"github.com/tonyhb/chromedebugo/cdptest"
+	"github.com/tonyhb/chromedebugo/element"
+)
+
+// newDocument returns a fake page whose document is node 1, and the
+// document of a debugger connected to it.
+func newDocument(t *testing.T) (*cdptest.Server, *element.Document) {
+	t.Helper()
+	srv := cdptest.NewServer()
+	t.Cleanup(srv.Close)
+	srv.Handle("DOM.enable", cdptest.Result(nil))
+	srv.Handle("DOM.getDocument", cdptest.Result(map[string]interface{}{
+		"root": map[string]interface{}{"nodeId": 1, "nodeName": "#document"},
+	}))
+	srv.Handle("DOM.querySelector", cdptest.Result(map[string]interface{}{"nodeId": 5}))
+	d, err := chromedebugo.NewSync(srv.URL())
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { d.Close() })
+	doc, err := element.New(context.Background(), d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(doc.Close)
+	return srv, doc
+}
+
+func TestQuery(t *testing.T) {
+	srv, doc := newDocument(t)
+	ctx := context.Background()
+	el, err := doc.Query(ctx, "#main")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if el.NodeID() != 5 {
+		t.Errorf("got node %d, want 5", el.NodeID())
+	}
+	cmd := srv.AssertReceived(t, "DOM.querySelector")
+	if cmd.Params["nodeId"] != float64(1) || cmd.Params["selector"] != "#main" {
+		t.Errorf("queried %v", cmd.Params)
+	}
+
+	// the document is only requested once per generation
+	if _, err := doc.Query(ctx, "#main"); err != nil {
+		t.Fatal(err)
+	}
+	if n := len(srv.Received("DOM.getDocument")); n != 1 {
+		t.Errorf("document requested %d times, want once", n)
+	}
+}
+
+func TestQueryNotFound(t *testing.T) {
+	srv, doc := newDocument(t)
+	srv.Handle("DOM.querySelector", cdptest.Result(map[string]interface{}{"nodeId": 0}))
+
+	_, err := doc.Query(context.Background(), "#missing")
+	if !errors.Is(err, element.ErrNotFound) {
+		t.Fatalf("got %v, want %v", err, element.ErrNotFound)
+	}
+	if !strings.Contains(err.Error(), "#missing") {
+		t.Errorf("error %q does not include the selector", err)
+	}
+}
+
+func TestStaleAfterDocumentUpdated(t *testing.T) {
+	srv, doc := newDocument(t)
+	ctx := context.Background()
+	el, err := doc.Query(ctx, "#main")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	srv.Emit("DOM.documentUpdated", nil)
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		_, err := el.Query(ctx, "span")
+		if errors.Is(err, element.ErrStaleElement) {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("element not stale after the document was updated")
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	// the new document is requested again
+	if _, err := doc.Query(ctx, "#main"); err != nil {
+		t.Fatal(err)
+	}
+	if n := len(srv.Received("DOM.getDocument")); n != 2 {
+		t.Errorf("document requested %d times, want 2", n)
+	}
+}
+
+func TestStaleWhileRequestingDocument(t *testing.T) {
+	srv, doc := newDocument(t)
+	lock := sync.Mutex{}
+	calls := 0
+	srv.Handle("DOM.getDocument", func(chromedebugo.Command) cdptest.Response {
+		lock.Lock()
+		defer lock.Unlock()
+		calls++
+		resp := cdptest.Response{Result: map[string]interface{}{"root": map[string]interface{}{"nodeId": calls}}}
+		if calls == 1 {
+			// the document is replaced before the response, which is
+			// delayed until the update has been seen
+			srv.Emit("DOM.documentUpdated", nil)
+			resp.Delay = 200 * time.Millisecond
+		}
+		return resp
+	})
+
+	ctx := context.Background()
+	if _, err := doc.Query(ctx, "#main"); !errors.Is(err, element.ErrStaleElement) {
+		t.Fatalf("got %v, want %v", err, element.ErrStaleElement)
+	}
+	if n := len(srv.Received("DOM.querySelector")); n != 0 {
+		t.Errorf("queried a stale document %d times", n)
+	}
+
+	// the root was not cached, so it is requested again
+	if _, err := doc.Query(ctx, "#main"); err != nil {
+		t.Fatal(err)
+	}
+	if cmd := srv.AssertReceived(t, "DOM.querySelector"); cmd.Params["nodeId"] != float64(2) {
+		t.Errorf("queried %v, want the new document", cmd.Params)
+	}
+}
+
+func TestStaleNodeErrors(t *testing.T) {
+	tests := []struct {
+		message string
+		stale   bool
+	}{
+		{"Could not find node with given id", true},
+		{"No node with given id found", true},
+		{"Node is not an Element", false},
+	}
+	for _, test := range tests {
+		t.Run(test.message, func(t *testing.T) {
+			srv, doc := newDocument(t)
+			srv.Handle("DOM.getAttributes", cdptest.Fail(-32000, test.message))
+			el, err := doc.Query(context.Background(), "#main")
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			_, _, err = el.Attribute(context.Background(), "id")
+			if stale := errors.Is(err, element.ErrStaleElement); stale != test.stale {
+				t.Errorf("got %v, stale %t, want %t", err, stale, test.stale)
+			}
+			var chromeErr chromedebugo.Error
+			if !test.stale && !errors.As(err, &chromeErr) {
+				t.Errorf("got %v, want chrome's error", err)
+			}
+		})
+	}
+}
+
+func TestAttribute(t *testing.T) {
+	srv, doc := newDocument(t)
+	srv.Handle("DOM.getAttributes", cdptest.Result(map[string]interface{}{
+		"attributes": []string{"id", "main", "class", "a b", "hidden", "", "dangling"},
+	}))
+	el, err := doc.Query(context.Background(), "#main")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name  string
+		value string
+		ok    bool
+	}{
+		{"id", "main", true},
+		{"class", "a b", true},
+		{"hidden", "", true},
+		{"main", "", false},
+		// a name without a value is not an attribute
+		{"dangling", "", false},
+		{"missing", "", false},
+	}
+	for _, test := range tests {
+		value, ok, err := el.Attribute(context.Background(), test.name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if value != test.value || ok != test.ok {
+			t.Errorf("Attribute(%q) = %q, %t, want %q, %t", test.name, value, ok, test.value, test.ok)
+		}
+	}
+}
+
+func TestXPath(t *testing.T) {
+	srv, doc := newDocument(t)
+	srv.Handle("DOM.performSearch", cdptest.Result(map[string]interface{}{"searchId": "search-1", "resultCount": 2}))
+	srv.Handle("DOM.getSearchResults", cdptest.Result(map[string]interface{}{"nodeIds": []int{4, 7}}))
+	srv.Handle("DOM.discardSearchResults", cdptest.Result(nil))
+
+	elements, err := doc.XPath(context.Background(), "//a[contains(., 'Next')]")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(elements) != 2 || elements[0].NodeID() != 4 || elements[1].NodeID() != 7 {
+		t.Errorf("got %v, want nodes 4 and 7", elements)
+	}
+
+	// the document is requested first, as the search only covers nodes
+	// known to the client
+	srv.AssertReceived(t, "DOM.getDocument")
+	if cmd := srv.AssertReceived(t, "DOM.performSearch"); cmd.Params["query"] != "//a[contains(., 'Next')]" {
+		t.Errorf("searched with %v", cmd.Params)
+	}
+	cmd := srv.AssertReceived(t, "DOM.getSearchResults")
+	if cmd.Params["searchId"] != "search-1" || cmd.Params["fromIndex"] != float64(0) || cmd.Params["toIndex"] != float64(2) {
+		t.Errorf("got results with %v", cmd.Params)
+	}
+	if cmd := srv.AssertReceived(t, "DOM.discardSearchResults"); cmd.Params["searchId"] != "search-1" {
+		t.Errorf("discarded %v", cmd.Params)
+	}
+}
+
+func TestXPathNoResults(t *testing.T) {
+	srv, doc := newDocument(t)
+	srv.Handle("DOM.performSearch", cdptest.Result(map[string]interface{}{"searchId": "search-1", "resultCount": 0}))
+	srv.Handle("DOM.discardSearchResults", cdptest.Result(nil))
+
+	elements, err := doc.XPath(context.Background(), "//nothing")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if elements == nil || len(elements) != 0 {
+		t.Errorf("got %v, want no elements", elements)
+	}
+	if n := len(srv.Received("DOM.getSearchResults")); n != 0 {
+		t.Errorf("got results %d times for an empty search", n)
+	}
+	srv.AssertReceived(t, "DOM.discardSearchResults")
+}
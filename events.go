@@ -0,0 +1,233 @@
+package chromedebugo
+
+import (
+	"strings"
+	"sync"
+)
+
+// DefaultEventBuffer is the number of events buffered for each subscriber
+// unless WithBuffer is given.
+const DefaultEventBuffer = 128
+
+// OverflowPolicy decides what happens when an event arrives for a subscriber
+// whose buffer is full.
+type OverflowPolicy int
+
+const (
+	// DropOldest discards the oldest buffered event to make room for the
+	// new one.  This is the default.
+	DropOldest OverflowPolicy = iota
+	// DropNewest discards the event which has just arrived.
+	DropNewest
+	// Block waits until the subscriber has room for the event.  Note that
+	// this stalls the connection, including responses to any commands
+	// sent, until the subscriber catches up.
+	Block
+)
+
+// SubscribeOption configures a subscription created with Subscribe or On.
+type SubscribeOption func(*subscription)
+
+// WithBuffer sets the number of events buffered for the subscriber.
+func WithBuffer(size int) SubscribeOption {
+	return func(s *subscription) {
+		s.buffer = size
+	}
+}
+
+// WithOverflow sets the policy used when the subscriber's buffer is full.
+func WithOverflow(policy OverflowPolicy) SubscribeOption {
+	return func(s *subscription) {
+		s.overflow = policy
+	}
+}
+
+type subscription struct {
+	// pattern is an event method such as "Page.loadEventFired", a domain
+	// prefix such as "Network.*" or "*" for every event
+	pattern  string
+	buffer   int
+	overflow OverflowPolicy
+
+	ch chan Command
+	// done is closed on unsubscribing so that a publish blocked on a full
+	// buffer gives up
+	done chan struct{}
+	once sync.Once
+}
+
+func (s *subscription) matches(method string) bool {
+	return matchMethod(s.pattern, method)
+}
+
+// matchMethod reports whether method matches pattern, which is a method name
+// or, ending in "*", a prefix such as "Network.*".
+func matchMethod(pattern, method string) bool {
+	if strings.HasSuffix(pattern, "*") {
+		return strings.HasPrefix(method, strings.TrimSuffix(pattern, "*"))
+	}
+	return pattern == method
+}
+
+// deliver sends cmd to the subscriber.  A blocked delivery gives up when the
+// subscriber unsubscribes or closing is closed.
+func (s *subscription) deliver(cmd Command, closing chan struct{}) {
+	switch s.overflow {
+	case Block:
+		select {
+		case s.ch <- cmd:
+		case <-s.done:
+		case <-closing:
+		}
+	case DropNewest:
+		select {
+		case s.ch <- cmd:
+		default:
+		}
+	default:
+		for {
+			select {
+			case s.ch <- cmd:
+				return
+			default:
+			}
+			// the buffer is full so make room, unless the subscriber
+			// has raced us to it
+			select {
+			case <-s.ch:
+			default:
+			}
+		}
+	}
+}
+
+// eventHub fans events out from the goroutine reading from chrome to each
+// matching subscriber.
+type eventHub struct {
+	lock sync.RWMutex
+	subs map[*subscription]struct{}
+	// closed is set once the connection has closed, after which new
+	// subscriptions are closed immediately
+	closed bool
+	// closing is closed first thing by close, to wake a publish blocked on
+	// a full subscriber while it holds the read lock
+	closing   chan struct{}
+	closeOnce sync.Once
+}
+
+func newEventHub() *eventHub {
+	return &eventHub{
+		subs:    map[*subscription]struct{}{},
+		closing: make(chan struct{}),
+	}
+}
+
+func (h *eventHub) subscribe(pattern string, opts ...SubscribeOption) (*subscription, func()) {
+	sub := &subscription{
+		pattern:  pattern,
+		buffer:   DefaultEventBuffer,
+		overflow: DropOldest,
+		done:     make(chan struct{}),
+	}
+	for _, opt := range opts {
+		opt(sub)
+	}
+	if sub.buffer < 1 && sub.overflow == DropOldest {
+		// there must be room for at least the newest event
+		sub.buffer = 1
+	}
+	if sub.buffer < 0 {
+		sub.buffer = 0
+	}
+	sub.ch = make(chan Command, sub.buffer)
+
+	h.lock.Lock()
+	closed := h.closed
+	if !closed {
+		h.subs[sub] = struct{}{}
+	}
+	h.lock.Unlock()
+
+	if closed {
+		sub.once.Do(func() {
+			close(sub.done)
+			close(sub.ch)
+		})
+	}
+	return sub, func() { h.unsubscribe(sub) }
+}
+
+func (h *eventHub) unsubscribe(sub *subscription) {
+	sub.once.Do(func() {
+		close(sub.done)
+		// publish holds a read lock while delivering, so once we hold
+		// the lock nothing can be sending on the channel
+		h.lock.Lock()
+		delete(h.subs, sub)
+		h.lock.Unlock()
+		close(sub.ch)
+	})
+}
+
+// close closes every subscription.
+func (h *eventHub) close() {
+	h.closeOnce.Do(func() { close(h.closing) })
+	h.lock.Lock()
+	h.closed = true
+	subs := make([]*subscription, 0, len(h.subs))
+	for sub := range h.subs {
+		subs = append(subs, sub)
+	}
+	h.lock.Unlock()
+
+	for _, sub := range subs {
+		h.unsubscribe(sub)
+	}
+}
+
+func (h *eventHub) publish(cmd Command) {
+	h.lock.RLock()
+	defer h.lock.RUnlock()
+	for sub := range h.subs {
+		if sub.matches(cmd.Method) {
+			sub.deliver(cmd, h.closing)
+		}
+	}
+}
+
+// Subscribe returns a channel which receives every event matching method,
+// and a function which unsubscribes and closes the channel.  The channel is
+// also closed when the connection closes.
+//
+// The method may be an exact event name such as "Page.loadEventFired", a
+// prefix ending in "*" such as "Network.*", or "*" for every event.  Events
+// are buffered for each subscriber (see WithBuffer and WithOverflow) so that
+// a slow subscriber does not hold up others or the connection.
+func (d *debugger) Subscribe(method string, opts ...SubscribeOption) (<-chan Command, func()) {
+	sub, unsubscribe := d.events.subscribe(method, opts...)
+	return sub.ch, unsubscribe
+}
+
+// On calls handler, on its own goroutine, with every event matching method.
+// Matching and buffering are as for Subscribe.  The returned function stops
+// further calls to handler.
+func (d *debugger) On(method string, handler func(Command), opts ...SubscribeOption) func() {
+	return d.events.on(method, handler, opts)
+}
+
+// on calls handler with every event matching method on its own goroutine.
+func (h *eventHub) on(method string, handler func(Command), opts []SubscribeOption) func() {
+	sub, unsubscribe := h.subscribe(method, opts...)
+	go func() {
+		for cmd := range sub.ch {
+			select {
+			case <-sub.done:
+				// drop anything still buffered after unsubscribing
+				return
+			default:
+			}
+			handler(cmd)
+		}
+	}()
+	return unsubscribe
+}
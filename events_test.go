@@ -0,0 +1,206 @@
+package chromedebugo
+
+import (
+	"runtime"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func event(n int) Command {
+	return Command{Method: "Test.event", Params: map[string]interface{}{"n": n}}
+}
+
+// received returns the numbers of the events buffered for sub.
+func received(sub *subscription) []int {
+	ns := []int{}
+	for len(sub.ch) > 0 {
+		ns = append(ns, (<-sub.ch).Params["n"].(int))
+	}
+	return ns
+}
+
+func equal(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestOverflowDropOldest(t *testing.T) {
+	h := newEventHub()
+	sub, unsubscribe := h.subscribe("Test.*", WithBuffer(2), WithOverflow(DropOldest))
+	defer unsubscribe()
+	for n := 1; n <= 4; n++ {
+		h.publish(event(n))
+	}
+	if got := received(sub); !equal(got, []int{3, 4}) {
+		t.Errorf("got events %v, want [3 4]", got)
+	}
+}
+
+func TestOverflowDropOldestNoBuffer(t *testing.T) {
+	h := newEventHub()
+	sub, unsubscribe := h.subscribe("Test.*", WithBuffer(0), WithOverflow(DropOldest))
+	defer unsubscribe()
+	for n := 1; n <= 3; n++ {
+		h.publish(event(n))
+	}
+	if got := received(sub); !equal(got, []int{3}) {
+		t.Errorf("got events %v, want [3]", got)
+	}
+}
+
+func TestOverflowDropNewest(t *testing.T) {
+	h := newEventHub()
+	sub, unsubscribe := h.subscribe("Test.*", WithBuffer(2), WithOverflow(DropNewest))
+	defer unsubscribe()
+	for n := 1; n <= 4; n++ {
+		h.publish(event(n))
+	}
+	if got := received(sub); !equal(got, []int{1, 2}) {
+		t.Errorf("got events %v, want [1 2]", got)
+	}
+
+	// a negative buffer holds nothing rather than panicking
+	sub, unsubscribe = h.subscribe("Other.*", WithBuffer(-1), WithOverflow(DropNewest))
+	defer unsubscribe()
+	if cap(sub.ch) != 0 {
+		t.Errorf("got buffer %d, want 0", cap(sub.ch))
+	}
+}
+
+func TestOverflowBlock(t *testing.T) {
+	h := newEventHub()
+	sub, unsubscribe := h.subscribe("Test.*", WithBuffer(2), WithOverflow(Block))
+	defer unsubscribe()
+
+	published := make(chan struct{})
+	go func() {
+		for n := 1; n <= 4; n++ {
+			h.publish(event(n))
+		}
+		close(published)
+	}()
+	select {
+	case <-published:
+		t.Fatal("publish did not block on a full buffer")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	for n := 1; n <= 4; n++ {
+		if got := (<-sub.ch).Params["n"]; got != n {
+			t.Fatalf("got event %v, want %d", got, n)
+		}
+	}
+	<-published
+}
+
+func TestOverflowBlockUnsubscribe(t *testing.T) {
+	h := newEventHub()
+	_, unsubscribe := h.subscribe("Test.*", WithBuffer(1), WithOverflow(Block))
+
+	published := make(chan struct{})
+	go func() {
+		h.publish(event(1))
+		h.publish(event(2))
+		close(published)
+	}()
+	time.Sleep(10 * time.Millisecond)
+	unsubscribe()
+	select {
+	case <-published:
+	case <-time.After(5 * time.Second):
+		t.Fatal("publish still blocked after unsubscribing")
+	}
+}
+
+// waitForGoroutines fails the test unless the number of goroutines falls to
+// baseline.
+func waitForGoroutines(t *testing.T, baseline int) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for runtime.NumGoroutine() > baseline {
+		if time.Now().After(deadline) {
+			buf := make([]byte, 1<<16)
+			t.Fatalf("%d goroutines, want %d:\n%s", runtime.NumGoroutine(), baseline, buf[:runtime.Stack(buf, true)])
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestCloseStopsHandlers(t *testing.T) {
+	baseline := runtime.NumGoroutine()
+
+	h := newEventHub()
+	calls := make(chan int, 1000)
+	for i := 0; i < 100; i++ {
+		overflow := []OverflowPolicy{DropOldest, DropNewest, Block}[i%3]
+		h.on("Test.*", func(cmd Command) { calls <- cmd.Params["n"].(int) }, []SubscribeOption{WithOverflow(overflow)})
+		h.subscribe("Test.event", WithBuffer(1), WithOverflow(overflow))
+	}
+	// a blocked subscriber nobody reads must not hold up close
+	h.subscribe("Test.event", WithBuffer(0), WithOverflow(Block))
+	go h.publish(event(1))
+	time.Sleep(10 * time.Millisecond)
+
+	h.close()
+	waitForGoroutines(t, baseline)
+
+	sub, _ := h.subscribe("Test.event")
+	if _, ok := <-sub.ch; ok {
+		t.Error("subscription made after close is open")
+	}
+}
+
+func TestUnsubscribeStopsHandler(t *testing.T) {
+	baseline := runtime.NumGoroutine()
+
+	h := newEventHub()
+	unsubscribes := []func(){}
+	for i := 0; i < 100; i++ {
+		unsubscribes = append(unsubscribes, h.on("Test."+strconv.Itoa(i), func(Command) {}, nil))
+	}
+	for _, unsubscribe := range unsubscribes {
+		unsubscribe()
+		// unsubscribing twice is harmless
+		unsubscribe()
+	}
+	waitForGoroutines(t, baseline)
+}
+
+func TestDebuggerCloseStopsSubscribers(t *testing.T) {
+	baseline := runtime.NumGoroutine()
+
+	client, chrome := NewMemoryTransport()
+	d := NewSyncTransport(client)
+	for i := 0; i < 10; i++ {
+		overflow := []OverflowPolicy{DropOldest, DropNewest, Block}[i%3]
+		d.On("Page.*", func(Command) {}, WithOverflow(overflow))
+		d.Subscribe("Page.*", WithBuffer(0), WithOverflow(overflow))
+	}
+	// the blocked subscribers hold up the reader, and so these writes,
+	// until the debugger closes
+	written := make(chan struct{})
+	go func() {
+		defer close(written)
+		for i := 0; i < 3; i++ {
+			if err := chrome.WriteMessage([]byte(`{"method": "Page.loadEventFired", "params": {}}`)); err != nil {
+				return
+			}
+		}
+	}()
+	select {
+	case <-written:
+		t.Fatal("events not held up by the blocked subscribers")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	d.Close()
+	waitForGoroutines(t, baseline)
+}
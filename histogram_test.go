@@ -0,0 +1,91 @@
+package chromedebugo
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestLatencyHistogramBuckets(t *testing.T) {
+	// buckets are sorted, whatever order they are given in
+	h := NewLatencyHistogram(100*time.Millisecond, 10*time.Millisecond)
+	for _, d := range []time.Duration{
+		0,
+		10 * time.Millisecond,
+		11 * time.Millisecond,
+		100 * time.Millisecond,
+		101 * time.Millisecond,
+		time.Minute,
+	} {
+		h.observe("Page.navigate", d, nil)
+	}
+	h.observe("Page.navigate", time.Millisecond, errors.New("failed"))
+	h.observe("Page.enable", time.Second, nil)
+
+	snapshot := h.Snapshot()
+	l := snapshot["Page.navigate"]
+	if len(l.Buckets) != 2 || l.Buckets[0] != 10*time.Millisecond || l.Buckets[1] != 100*time.Millisecond {
+		t.Errorf("got buckets %v", l.Buckets)
+	}
+	// bounds are inclusive, and the last count is beyond every bucket
+	if want := []uint64{3, 2, 2}; !equalCounts(l.Counts, want) {
+		t.Errorf("got counts %v, want %v", l.Counts, want)
+	}
+	if l.Count != 7 || l.Errors != 1 {
+		t.Errorf("got %d commands and %d errors, want 7 and 1", l.Count, l.Errors)
+	}
+	if want := time.Minute + 223*time.Millisecond; l.Sum != want {
+		t.Errorf("got sum %s, want %s", l.Sum, want)
+	}
+	if want := []uint64{0, 0, 1}; !equalCounts(snapshot["Page.enable"].Counts, want) {
+		t.Errorf("got counts %v for Page.enable, want %v", snapshot["Page.enable"].Counts, want)
+	}
+}
+
+func TestLatencyHistogramDefaultBuckets(t *testing.T) {
+	h := NewLatencyHistogram()
+	h.observe("Page.enable", 10*time.Second, nil)
+	l := h.Snapshot()["Page.enable"]
+	if len(l.Buckets) != len(DefaultLatencyBuckets) || len(l.Counts) != len(DefaultLatencyBuckets)+1 {
+		t.Fatalf("got %d buckets and %d counts", len(l.Buckets), len(l.Counts))
+	}
+	if l.Counts[len(DefaultLatencyBuckets)] != 1 {
+		t.Errorf("got counts %v", l.Counts)
+	}
+}
+
+func TestLatencyHistogramSnapshot(t *testing.T) {
+	h := NewLatencyHistogram(time.Second)
+	h.observe("Page.enable", time.Millisecond, nil)
+	snapshot := h.Snapshot()
+
+	// changes to the snapshot or the histogram don't affect each other
+	l := snapshot["Page.enable"]
+	l.Counts[0] = 100
+	delete(snapshot, "Page.enable")
+	h.observe("Page.enable", time.Millisecond, nil)
+	h.observe("Page.reload", time.Millisecond, nil)
+
+	after := h.Snapshot()
+	if after["Page.enable"].Counts[0] != 2 || after["Page.enable"].Count != 2 {
+		t.Errorf("got %+v after changing a snapshot", after["Page.enable"])
+	}
+	if l.Count != 1 || len(snapshot) != 0 {
+		t.Errorf("snapshot changed by later commands: %+v", l)
+	}
+	if _, ok := after["Page.reload"]; !ok {
+		t.Error("later method missing from a new snapshot")
+	}
+}
+
+func equalCounts(a, b []uint64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
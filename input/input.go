@@ -0,0 +1,113 @@
+// Package input simulates a user's mouse, keyboard and touch screen:
+//
+//	err := input.Click(ctx, debugger, button)
+//	...
+//	err = input.Type(ctx, debugger, "hello, world")
+//	...
+//	err = input.Chord(ctx, debugger, "Control+Shift+T")
+//	...
+//	err = input.Tap(ctx, debugger, button)
+//
+// Events are dispatched to the page with the Input domain, so they are
+// trusted by the page, as a real user's are, unlike events created in
+// JavaScript.  Keys are those of a US keyboard layout.  Touch gestures need
+// a touch screen, which desktop chrome can emulate with EnableTouch.
+package input
+
+import (
+	"context"
+	"time"
+
+	inputproto "github.com/tonyhb/chromedebugo/protocol/input"
+)
+
+// Modifier is a bit mask of the modifier keys held during an event.
+type Modifier int64
+
+// Modifier keys, as chrome numbers them.
+const (
+	ModifierNone  Modifier = 0
+	ModifierAlt   Modifier = 1
+	ModifierCtrl  Modifier = 2
+	ModifierMeta  Modifier = 4
+	ModifierShift Modifier = 8
+)
+
+type config struct {
+	button    inputproto.MouseButton
+	modifiers Modifier
+	steps     int
+	delay     time.Duration
+	speed     int
+}
+
+// Option configures an input action.
+type Option func(*config)
+
+// WithButton sets the mouse button clicked or dragged with, the left button
+// by default.
+func WithButton(button inputproto.MouseButton) Option {
+	return func(c *config) {
+		c.button = button
+	}
+}
+
+// WithModifiers holds modifier keys, eg. ModifierCtrl|ModifierShift, during
+// a mouse action or key press.  The keys themselves are not pressed, so the
+// page sees no events for them; use Chord for that.
+func WithModifiers(modifiers Modifier) Option {
+	return func(c *config) {
+		c.modifiers = modifiers
+	}
+}
+
+// WithSteps sets the number of mouse moves made on the way to the target of
+// a drag, 1 by default.  Pages which track the mouse during a drag may need
+// several.
+func WithSteps(steps int) Option {
+	return func(c *config) {
+		c.steps = steps
+	}
+}
+
+// WithDelay waits between the presses and releases of clicks and keys, and
+// between the keys typed, as a user would.
+func WithDelay(delay time.Duration) Option {
+	return func(c *config) {
+		c.delay = delay
+	}
+}
+
+// WithSpeed sets the speed of the fingers in synthesized touch gestures, in
+// pixels per second.  Chrome's default is 800.
+func WithSpeed(pixelsPerSecond int) Option {
+	return func(c *config) {
+		c.speed = pixelsPerSecond
+	}
+}
+
+func newConfig(opts []Option) *config {
+	c := &config{button: inputproto.MouseButtonLeft, steps: 1}
+	for _, opt := range opts {
+		opt(c)
+	}
+	if c.steps < 1 {
+		c.steps = 1
+	}
+	return c
+}
+
+// pause waits for the delay set by WithDelay, or until ctx is done.
+func (c *config) pause(ctx context.Context) error {
+	if c.delay <= 0 {
+		return nil
+	}
+	timer := time.NewTimer(c.delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
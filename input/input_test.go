@@ -0,0 +1,321 @@
+package input_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/tonyhb/chromedebugo"
+	"github.com/tonyhb/chromedebugo/cdptest"
+	"github.com/tonyhb/chromedebugo/element"
+	"github.com/tonyhb/chromedebugo/input"
+)
+
+func newPage(t *testing.T) (*cdptest.Server, chromedebugo.SyncDebugger) {
+	t.Helper()
+	srv := cdptest.NewServer()
+	t.Cleanup(srv.Close)
+	srv.Handle("Input.dispatchKeyEvent", cdptest.Result(nil))
+	srv.Handle("Input.dispatchMouseEvent", cdptest.Result(nil))
+	srv.Handle("Input.insertText", cdptest.Result(nil))
+	srv.Handle("Input.dispatchTouchEvent", cdptest.Result(nil))
+	srv.Handle("Input.synthesizeTapGesture", cdptest.Result(nil))
+	srv.Handle("Input.synthesizeScrollGesture", cdptest.Result(nil))
+	srv.Handle("Input.synthesizePinchGesture", cdptest.Result(nil))
+	d, err := chromedebugo.NewSync(srv.URL())
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { d.Close() })
+	return srv, d
+}
+
+func TestType(t *testing.T) {
+	srv, d := newPage(t)
+	if err := input.Type(context.Background(), d, "aB\t\n\r\n\ré"); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []struct {
+		typ, key, text string
+		modifiers      float64
+	}{
+		{"keyDown", "a", "a", 0},
+		{"keyUp", "a", "", 0},
+		{"keyDown", "B", "B", float64(input.ModifierShift)},
+		{"keyUp", "B", "", float64(input.ModifierShift)},
+		{"rawKeyDown", "Tab", "", 0},
+		{"keyUp", "Tab", "", 0},
+		{"keyDown", "Enter", "\r", 0},
+		{"keyUp", "Enter", "", 0},
+		// "\r\n" is one newline
+		{"keyDown", "Enter", "\r", 0},
+		{"keyUp", "Enter", "", 0},
+		{"keyDown", "Enter", "\r", 0},
+		{"keyUp", "Enter", "", 0},
+	}
+	events := srv.Received("Input.dispatchKeyEvent")
+	if len(events) != len(want) {
+		t.Fatalf("got %d key events, want %d", len(events), len(want))
+	}
+	for i, w := range want {
+		p := events[i].Params
+		text, _ := p["text"].(string)
+		modifiers, _ := p["modifiers"].(float64)
+		if p["type"] != w.typ || p["key"] != w.key || text != w.text || modifiers != w.modifiers {
+			t.Errorf("key event %d is %v, want %+v", i, p, w)
+		}
+	}
+
+	inserted := srv.Received("Input.insertText")
+	if len(inserted) != 1 || inserted[0].Params["text"] != "é" {
+		t.Errorf("inserted %v, want é", inserted)
+	}
+}
+
+func TestChord(t *testing.T) {
+	srv, d := newPage(t)
+	if err := input.Chord(context.Background(), d, "Control++"); err != nil {
+		t.Fatal(err)
+	}
+
+	events := srv.Received("Input.dispatchKeyEvent")
+	want := []struct {
+		typ, key  string
+		modifiers float64
+	}{
+		{"rawKeyDown", "Control", float64(input.ModifierCtrl)},
+		{"rawKeyDown", "+", float64(input.ModifierCtrl)},
+		{"keyUp", "+", float64(input.ModifierCtrl)},
+		{"keyUp", "Control", 0},
+	}
+	if len(events) != len(want) {
+		t.Fatalf("got %d key events, want %d", len(events), len(want))
+	}
+	for i, w := range want {
+		p := events[i].Params
+		modifiers, _ := p["modifiers"].(float64)
+		if p["type"] != w.typ || p["key"] != w.key || modifiers != w.modifiers {
+			t.Errorf("key event %d is %v, want %+v", i, p, w)
+		}
+	}
+}
+
+func TestDragAndDrop(t *testing.T) {
+	srv, d := newPage(t)
+	srv.Handle("DOM.enable", cdptest.Result(nil))
+	srv.Handle("DOM.getDocument", cdptest.Result(map[string]interface{}{
+		"root": map[string]interface{}{"nodeId": 1},
+	}))
+	srv.Handle("DOM.querySelector", func(cmd chromedebugo.Command) cdptest.Response {
+		ids := map[string]int{"#from": 2, "#to": 3}
+		return cdptest.Response{Result: map[string]interface{}{"nodeId": ids[cmd.Params["selector"].(string)]}}
+	})
+	// every scroll moves the page, and so both elements, up by 100 pixels
+	lock := sync.Mutex{}
+	scrolled := 0.0
+	srv.Handle("DOM.scrollIntoViewIfNeeded", func(chromedebugo.Command) cdptest.Response {
+		lock.Lock()
+		defer lock.Unlock()
+		scrolled += 100
+		return cdptest.Response{}
+	})
+	srv.Handle("DOM.getBoxModel", func(cmd chromedebugo.Command) cdptest.Response {
+		lock.Lock()
+		defer lock.Unlock()
+		top := map[float64]float64{2: 500, 3: 900}[cmd.Params["nodeId"].(float64)] - scrolled
+		quad := []float64{0, top, 100, top, 100, top + 100, 0, top + 100}
+		return cdptest.Response{Result: map[string]interface{}{"model": map[string]interface{}{
+			"content": quad, "padding": quad, "border": quad, "margin": quad, "width": 100, "height": 100,
+		}}}
+	})
+
+	ctx := context.Background()
+	doc, err := element.New(ctx, d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer doc.Close()
+	from, err := doc.Query(ctx, "#from")
+	if err != nil {
+		t.Fatal(err)
+	}
+	to, err := doc.Query(ctx, "#to")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := input.DragAndDrop(ctx, d, from, to, input.WithSteps(2)); err != nil {
+		t.Fatal(err)
+	}
+
+	// both elements are measured where they are once both are scrolled to
+	want := []struct {
+		typ string
+		y   float64
+	}{
+		{"mouseMoved", 350},
+		{"mousePressed", 350},
+		{"mouseMoved", 550},
+		{"mouseMoved", 750},
+		{"mouseReleased", 750},
+	}
+	events := srv.Received("Input.dispatchMouseEvent")
+	if len(events) != len(want) {
+		t.Fatalf("got %d mouse events, want %d", len(events), len(want))
+	}
+	for i, w := range want {
+		p := events[i].Params
+		if p["type"] != w.typ || p["x"] != float64(50) || p["y"] != w.y {
+			t.Errorf("mouse event %d is %v, want %s at 50, %g", i, p, w.typ, w.y)
+		}
+	}
+}
+
+func TestTap(t *testing.T) {
+	srv, d := newPage(t)
+	srv.Handle("DOM.enable", cdptest.Result(nil))
+	srv.Handle("DOM.getDocument", cdptest.Result(map[string]interface{}{
+		"root": map[string]interface{}{"nodeId": 1},
+	}))
+	srv.Handle("DOM.querySelector", cdptest.Result(map[string]interface{}{"nodeId": 2}))
+	srv.Handle("DOM.scrollIntoViewIfNeeded", cdptest.Result(nil))
+	quad := []float64{0, 100, 100, 100, 100, 200, 0, 200}
+	srv.Handle("DOM.getBoxModel", cdptest.Result(map[string]interface{}{"model": map[string]interface{}{
+		"content": quad, "padding": quad, "border": quad, "margin": quad, "width": 100, "height": 100,
+	}}))
+
+	ctx := context.Background()
+	doc, err := element.New(ctx, d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer doc.Close()
+	button, err := doc.Query(ctx, "button")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := input.Tap(ctx, d, button, input.WithDelay(50*time.Millisecond)); err != nil {
+		t.Fatal(err)
+	}
+
+	srv.AssertReceived(t, "DOM.scrollIntoViewIfNeeded")
+	p := srv.AssertReceived(t, "Input.synthesizeTapGesture").Params
+	if p["x"] != float64(50) || p["y"] != float64(150) || p["duration"] != float64(50) || p["tapCount"] != float64(1) || p["gestureSourceType"] != "touch" {
+		t.Errorf("tapped with %v", p)
+	}
+}
+
+func TestSwipe(t *testing.T) {
+	srv, d := newPage(t)
+	if err := input.Swipe(context.Background(), d, input.Point{X: 200, Y: 600}, 0, -400, input.WithSpeed(1200)); err != nil {
+		t.Fatal(err)
+	}
+	p := srv.AssertReceived(t, "Input.synthesizeScrollGesture").Params
+	if p["x"] != float64(200) || p["y"] != float64(600) || p["yDistance"] != float64(-400) || p["speed"] != float64(1200) {
+		t.Errorf("swiped with %v", p)
+	}
+	if p["preventFling"] != true || p["gestureSourceType"] != "touch" {
+		t.Errorf("swiped with %v, want a touch gesture without fling", p)
+	}
+}
+
+func TestPinch(t *testing.T) {
+	srv, d := newPage(t)
+	if err := input.Pinch(context.Background(), d, input.Point{X: 200, Y: 300}, 0.5); err != nil {
+		t.Fatal(err)
+	}
+	p := srv.AssertReceived(t, "Input.synthesizePinchGesture").Params
+	if p["x"] != float64(200) || p["y"] != float64(300) || p["scaleFactor"] != 0.5 || p["gestureSourceType"] != "touch" {
+		t.Errorf("pinched with %v", p)
+	}
+	// chrome's default speed is used
+	if _, ok := p["relativeSpeed"]; ok {
+		t.Errorf("pinched with %v, want no speed", p)
+	}
+}
+
+// touchEvents describes the touch events received, one per line, as the
+// type followed by each point's id@x,y.
+func touchEvents(srv *cdptest.Server) string {
+	lines := []string{}
+	for _, cmd := range srv.Received("Input.dispatchTouchEvent") {
+		line := cmd.Params["type"].(string)
+		for _, point := range cmd.Params["touchPoints"].([]interface{}) {
+			p := point.(map[string]interface{})
+			line += fmt.Sprintf(" %v@%v,%v", p["id"], p["x"], p["y"])
+		}
+		lines = append(lines, line)
+	}
+	return strings.Join(lines, "\n")
+}
+
+func TestTouches(t *testing.T) {
+	srv, d := newPage(t)
+	ctx := context.Background()
+	touches := input.NewTouches(d)
+
+	if err := touches.Start(ctx, map[int]input.Point{2: {X: 30, Y: 30}, 0: {X: 10, Y: 10}}); err != nil {
+		t.Fatal(err)
+	}
+	if err := touches.Move(ctx, map[int]input.Point{2: {X: 40, Y: 50}}); err != nil {
+		t.Fatal(err)
+	}
+	// lifting some fingers moves the rest
+	if err := touches.End(ctx, 0); err != nil {
+		t.Fatal(err)
+	}
+	if err := touches.Start(ctx, map[int]input.Point{0: {X: 5, Y: 5}}); err != nil {
+		t.Fatal(err)
+	}
+	if err := touches.End(ctx, 0, 2); err != nil {
+		t.Fatal(err)
+	}
+
+	// IDs are offset by one, and points are listed in order of ID
+	want := strings.Join([]string{
+		"touchStart 1@10,10 3@30,30",
+		"touchMove 1@10,10 3@40,50",
+		"touchMove 3@40,50",
+		"touchStart 1@5,5 3@40,50",
+		"touchEnd",
+	}, "\n")
+	if got := touchEvents(srv); got != want {
+		t.Errorf("got touch events\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestTouchesErrors(t *testing.T) {
+	srv, d := newPage(t)
+	ctx := context.Background()
+	touches := input.NewTouches(d)
+
+	if err := touches.Start(ctx, map[int]input.Point{-1: {}}); err == nil {
+		t.Error("started a touch with a negative ID")
+	}
+	if err := touches.Move(ctx, map[int]input.Point{0: {}}); err == nil {
+		t.Error("moved a touch which isn't down")
+	}
+	if err := touches.End(ctx, 0); err == nil {
+		t.Error("ended a touch which isn't down")
+	}
+	if err := touches.Start(ctx, map[int]input.Point{0: {X: 1, Y: 1}}); err != nil {
+		t.Fatal(err)
+	}
+	if err := touches.Start(ctx, map[int]input.Point{0: {X: 2, Y: 2}}); err == nil {
+		t.Error("started a touch which is already down")
+	}
+	if err := touches.Cancel(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if err := touches.Move(ctx, map[int]input.Point{0: {}}); err == nil {
+		t.Error("moved a touch after Cancel")
+	}
+
+	want := "touchStart 1@1,1\ntouchCancel"
+	if got := touchEvents(srv); got != want {
+		t.Errorf("got touch events\n%s\nwant\n%s", got, want)
+	}
+}
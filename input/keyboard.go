@@ -0,0 +1,198 @@
+package input
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/tonyhb/chromedebugo"
+	inputproto "github.com/tonyhb/chromedebugo/protocol/input"
+)
+
+// Key event types of Input.dispatchKeyEvent.  Keys which type text are
+// pressed with keyDown and others with rawKeyDown, as chrome itself does.
+const (
+	keyDown    = "keyDown"
+	rawKeyDown = "rawKeyDown"
+	keyUp      = "keyUp"
+)
+
+// lookup returns the key with the given name, a DOM key value such as "a",
+// "Enter" or "Shift", or one of the aliases such as "Ctrl" and "Esc".
+func lookup(name string) (key, error) {
+	if alias, ok := keyAliases[name]; ok {
+		name = alias
+	}
+	k, ok := keys[name]
+	if !ok {
+		return key{}, fmt.Errorf("unknown key %q", name)
+	}
+	return k, nil
+}
+
+// withShift returns the key typed by k's physical key with shift held, such
+// as "A" for "a".
+func withShift(k key) key {
+	if k.shift {
+		return k
+	}
+	for _, shifted := range keys {
+		if shifted.shift && shifted.code == k.code {
+			return shifted
+		}
+	}
+	return k
+}
+
+// KeyDown presses the named key, such as "a", "Enter" or "Shift", without
+// releasing it.  Modifier keys pressed this way are not held for later
+// events; use WithModifiers or Chord.
+func KeyDown(ctx context.Context, d chromedebugo.SyncDebugger, name string, opts ...Option) error {
+	k, err := lookup(name)
+	if err != nil {
+		return err
+	}
+	return dispatchKey(ctx, d, keyDown, k, newConfig(opts).modifiers)
+}
+
+// KeyUp releases the named key.
+func KeyUp(ctx context.Context, d chromedebugo.SyncDebugger, name string, opts ...Option) error {
+	k, err := lookup(name)
+	if err != nil {
+		return err
+	}
+	return dispatchKey(ctx, d, keyUp, k, newConfig(opts).modifiers)
+}
+
+// Press presses and releases the named key.
+func Press(ctx context.Context, d chromedebugo.SyncDebugger, name string, opts ...Option) error {
+	k, err := lookup(name)
+	if err != nil {
+		return err
+	}
+	c := newConfig(opts)
+	return press(ctx, d, c, k, c.modifiers)
+}
+
+// Type types text into the focused element a key at a time, holding shift
+// for the characters which need it on a US keyboard.  Newlines, "\n", "\r"
+// or "\r\n", press Enter once and tabs press Tab, which moves the focus as
+// a user's would.  Characters with no key on the layout, such as "é", are
+// inserted as if by an input method, with no key events.
+func Type(ctx context.Context, d chromedebugo.SyncDebugger, text string, opts ...Option) error {
+	c := newConfig(opts)
+	prev := rune(0)
+	for i, r := range text {
+		if r == '\n' && prev == '\r' {
+			// the Enter pressed for "\r" covers "\r\n"
+			prev = r
+			continue
+		}
+		prev = r
+		if i > 0 {
+			if err := c.pause(ctx); err != nil {
+				return err
+			}
+		}
+		name := string(r)
+		switch r {
+		case '\n', '\r':
+			name = "Enter"
+		case '\t':
+			name = "Tab"
+		}
+		k, ok := keys[name]
+		if !ok {
+			if err := (inputproto.InsertTextParams{Text: name}).Do(ctx, d); err != nil {
+				return err
+			}
+			continue
+		}
+		modifiers := c.modifiers
+		if k.shift {
+			modifiers |= ModifierShift
+		}
+		if err := press(ctx, d, c, k, modifiers); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Chord presses a combination of keys, such as "Control+Shift+T", in order
+// and releases them in reverse, so that the modifiers are held when the last
+// key is pressed.  Keys are named as for Press; "+" itself is written last,
+// as in "Control++".
+func Chord(ctx context.Context, d chromedebugo.SyncDebugger, chord string, opts ...Option) error {
+	var names []string
+	if strings.HasSuffix(chord, "++") {
+		names = append(strings.Split(strings.TrimSuffix(chord, "++"), "+"), "+")
+	} else {
+		names = strings.Split(chord, "+")
+	}
+
+	c := newConfig(opts)
+	pressed := make([]key, 0, len(names))
+	modifiers := c.modifiers
+	// keys are released whatever happens, so that none is left held
+	defer func() {
+		for i := len(pressed) - 1; i >= 0; i-- {
+			k := pressed[i]
+			modifiers &^= modifierKeys[k.key]
+			dispatchKey(context.Background(), d, keyUp, k, modifiers)
+		}
+	}()
+
+	for _, name := range names {
+		k, err := lookup(name)
+		if err != nil {
+			return fmt.Errorf("error in key chord %q: %s", chord, err)
+		}
+		if modifiers&ModifierShift != 0 {
+			k = withShift(k)
+		}
+		modifiers |= modifierKeys[k.key]
+		if err := dispatchKey(ctx, d, keyDown, k, modifiers); err != nil {
+			return err
+		}
+		pressed = append(pressed, k)
+	}
+	return c.pause(ctx)
+}
+
+// press presses and releases k.
+func press(ctx context.Context, d chromedebugo.SyncDebugger, c *config, k key, modifiers Modifier) error {
+	if err := dispatchKey(ctx, d, keyDown, k, modifiers); err != nil {
+		return err
+	}
+	if err := c.pause(ctx); err != nil {
+		return err
+	}
+	return dispatchKey(ctx, d, keyUp, k, modifiers)
+}
+
+// dispatchKey sends a key event for k.  Keys held with control, alt or meta
+// are shortcuts, which type no text.
+func dispatchKey(ctx context.Context, d chromedebugo.SyncDebugger, typ string, k key, modifiers Modifier) error {
+	params := inputproto.DispatchKeyEventParams{
+		Type:                  typ,
+		Modifiers:             int64(modifiers),
+		Key:                   k.key,
+		Code:                  k.code,
+		WindowsVirtualKeyCode: k.keyCode,
+		NativeVirtualKeyCode:  k.keyCode,
+		Location:              k.location,
+	}
+	if typ != keyUp {
+		text := k.text
+		if modifiers&(ModifierCtrl|ModifierAlt|ModifierMeta) != 0 {
+			text = ""
+		}
+		params.Text = text
+		params.UnmodifiedText = text
+		if text == "" {
+			params.Type = rawKeyDown
+		}
+	}
+	return params.Do(ctx, d)
+}
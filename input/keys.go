@@ -0,0 +1,119 @@
+package input
+
+import "strconv"
+
+// key describes a key of a US keyboard as chrome's key events need it.
+type key struct {
+	// key is the DOM key value, such as "a", "A" or "Enter"
+	key string
+	// code is the DOM code of the physical key, such as "KeyA"
+	code string
+	// keyCode is the Windows virtual key code
+	keyCode int64
+	// text is the text the key types, if any
+	text string
+	// location is 1 for the left and 2 for the right of a pair of keys
+	location int64
+	// shift is true for characters typed with the shift key held
+	shift bool
+}
+
+// keys are the keys of a US keyboard layout, by DOM key value.  Printable
+// characters are also found by their character, as their key value is the
+// character itself.
+var keys = map[string]key{}
+
+func init() {
+	add := func(k key) {
+		keys[k.key] = k
+	}
+
+	for c := 'a'; c <= 'z'; c++ {
+		upper := c - 'a' + 'A'
+		code := "Key" + string(upper)
+		add(key{key: string(c), code: code, keyCode: int64(upper), text: string(c)})
+		add(key{key: string(upper), code: code, keyCode: int64(upper), text: string(upper), shift: true})
+	}
+
+	shiftedDigits := ")!@#$%^&*("
+	for i := 0; i < 10; i++ {
+		digit := string(rune('0' + i))
+		code := "Digit" + digit
+		add(key{key: digit, code: code, keyCode: int64('0' + i), text: digit})
+		shifted := string(shiftedDigits[i])
+		add(key{key: shifted, code: code, keyCode: int64('0' + i), text: shifted, shift: true})
+	}
+
+	// punctuation keys with the character typed with and without shift
+	for _, p := range []struct {
+		plain, shifted string
+		code           string
+		keyCode        int64
+	}{
+		{"`", "~", "Backquote", 192},
+		{"-", "_", "Minus", 189},
+		{"=", "+", "Equal", 187},
+		{"[", "{", "BracketLeft", 219},
+		{"]", "}", "BracketRight", 221},
+		{`\`, "|", "Backslash", 220},
+		{";", ":", "Semicolon", 186},
+		{"'", `"`, "Quote", 222},
+		{",", "<", "Comma", 188},
+		{".", ">", "Period", 190},
+		{"/", "?", "Slash", 191},
+	} {
+		add(key{key: p.plain, code: p.code, keyCode: p.keyCode, text: p.plain})
+		add(key{key: p.shifted, code: p.code, keyCode: p.keyCode, text: p.shifted, shift: true})
+	}
+
+	add(key{key: " ", code: "Space", keyCode: 32, text: " "})
+	add(key{key: "Enter", code: "Enter", keyCode: 13, text: "\r"})
+	add(key{key: "Tab", code: "Tab", keyCode: 9})
+	add(key{key: "Backspace", code: "Backspace", keyCode: 8})
+	add(key{key: "Escape", code: "Escape", keyCode: 27})
+	add(key{key: "Delete", code: "Delete", keyCode: 46})
+	add(key{key: "Insert", code: "Insert", keyCode: 45})
+	add(key{key: "Home", code: "Home", keyCode: 36})
+	add(key{key: "End", code: "End", keyCode: 35})
+	add(key{key: "PageUp", code: "PageUp", keyCode: 33})
+	add(key{key: "PageDown", code: "PageDown", keyCode: 34})
+	add(key{key: "ArrowLeft", code: "ArrowLeft", keyCode: 37})
+	add(key{key: "ArrowUp", code: "ArrowUp", keyCode: 38})
+	add(key{key: "ArrowRight", code: "ArrowRight", keyCode: 39})
+	add(key{key: "ArrowDown", code: "ArrowDown", keyCode: 40})
+	add(key{key: "CapsLock", code: "CapsLock", keyCode: 20})
+	add(key{key: "ContextMenu", code: "ContextMenu", keyCode: 93})
+	for i := 1; i <= 12; i++ {
+		name := "F" + strconv.Itoa(i)
+		add(key{key: name, code: name, keyCode: int64(111 + i)})
+	}
+
+	// modifiers are the left hand keys
+	add(key{key: "Shift", code: "ShiftLeft", keyCode: 16, location: 1})
+	add(key{key: "Control", code: "ControlLeft", keyCode: 17, location: 1})
+	add(key{key: "Alt", code: "AltLeft", keyCode: 18, location: 1})
+	add(key{key: "Meta", code: "MetaLeft", keyCode: 91, location: 1})
+}
+
+// modifierKeys are the modifier bits set while each modifier key is held.
+var modifierKeys = map[string]Modifier{
+	"Shift":   ModifierShift,
+	"Control": ModifierCtrl,
+	"Alt":     ModifierAlt,
+	"Meta":    ModifierMeta,
+}
+
+// keyAliases are other names accepted for keys, as commonly written in
+// shortcuts.
+var keyAliases = map[string]string{
+	"Ctrl":   "Control",
+	"Cmd":    "Meta",
+	"Option": "Alt",
+	"Esc":    "Escape",
+	"Return": "Enter",
+	"Space":  " ",
+	"Left":   "ArrowLeft",
+	"Up":     "ArrowUp",
+	"Right":  "ArrowRight",
+	"Down":   "ArrowDown",
+}
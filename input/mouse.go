@@ -0,0 +1,197 @@
+package input
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tonyhb/chromedebugo"
+	"github.com/tonyhb/chromedebugo/element"
+	inputproto "github.com/tonyhb/chromedebugo/protocol/input"
+)
+
+// Mouse event types of Input.dispatchMouseEvent.
+const (
+	mouseMoved    = "mouseMoved"
+	mousePressed  = "mousePressed"
+	mouseReleased = "mouseReleased"
+)
+
+// buttons is the bit mask of each button for the buttons field of mouse
+// events.
+var buttons = map[inputproto.MouseButton]int64{
+	inputproto.MouseButtonLeft:    1,
+	inputproto.MouseButtonRight:   2,
+	inputproto.MouseButtonMiddle:  4,
+	inputproto.MouseButtonBack:    8,
+	inputproto.MouseButtonForward: 16,
+}
+
+// Point is a position in the viewport, in CSS pixels.
+type Point struct {
+	X, Y float64
+}
+
+// Center scrolls el into view if needed and returns the centre of its
+// content box.
+func Center(ctx context.Context, el *element.Element) (Point, error) {
+	if err := el.ScrollIntoView(ctx); err != nil {
+		return Point{}, err
+	}
+	return center(ctx, el)
+}
+
+// center returns the centre of el's content box where it is now, without
+// scrolling.
+func center(ctx context.Context, el *element.Element) (Point, error) {
+	model, err := el.BoxModel(ctx)
+	if err != nil {
+		return Point{}, err
+	}
+	// the quad is four x, y pairs, which needn't form an axis-aligned
+	// rectangle if the element is transformed
+	quad := model.Content
+	if len(quad) != 8 {
+		return Point{}, fmt.Errorf("error finding element's centre: content quad has %d coordinates", len(quad))
+	}
+	p := Point{}
+	for i := 0; i < 8; i += 2 {
+		p.X += quad[i] / 4
+		p.Y += quad[i+1] / 4
+	}
+	return p, nil
+}
+
+// Click clicks the centre of el, scrolling it into view first.
+func Click(ctx context.Context, d chromedebugo.SyncDebugger, el *element.Element, opts ...Option) error {
+	p, err := Center(ctx, el)
+	if err != nil {
+		return err
+	}
+	return ClickAt(ctx, d, p, opts...)
+}
+
+// DoubleClick double-clicks the centre of el, scrolling it into view first.
+func DoubleClick(ctx context.Context, d chromedebugo.SyncDebugger, el *element.Element, opts ...Option) error {
+	p, err := Center(ctx, el)
+	if err != nil {
+		return err
+	}
+	c := newConfig(opts)
+	if err := move(ctx, d, c, p, 0); err != nil {
+		return err
+	}
+	// the second click of a double click has a click count of two, which
+	// fires dblclick
+	for count := int64(1); count <= 2; count++ {
+		if err := click(ctx, d, c, p, count); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClickAt moves the mouse to p and clicks.
+func ClickAt(ctx context.Context, d chromedebugo.SyncDebugger, p Point, opts ...Option) error {
+	c := newConfig(opts)
+	if err := move(ctx, d, c, p, 0); err != nil {
+		return err
+	}
+	return click(ctx, d, c, p, 1)
+}
+
+// Hover moves the mouse to the centre of el, scrolling it into view first.
+func Hover(ctx context.Context, d chromedebugo.SyncDebugger, el *element.Element, opts ...Option) error {
+	p, err := Center(ctx, el)
+	if err != nil {
+		return err
+	}
+	return MoveTo(ctx, d, p, opts...)
+}
+
+// MoveTo moves the mouse to p.
+func MoveTo(ctx context.Context, d chromedebugo.SyncDebugger, p Point, opts ...Option) error {
+	return move(ctx, d, newConfig(opts), p, 0)
+}
+
+// DragAndDrop presses the mouse button on the centre of from, moves to the
+// centre of to in the steps given by WithSteps, and releases it there.  The
+// elements are scrolled into view before the drag starts, so they should be
+// visible together.
+//
+// The drag is made of mouse events, so it suits pages which implement
+// dragging with them.  HTML drag and drop, with dragstart and drop events,
+// is left to chrome and may not start from synthesized events.
+func DragAndDrop(ctx context.Context, d chromedebugo.SyncDebugger, from, to *element.Element, opts ...Option) error {
+	c := newConfig(opts)
+	// both are scrolled to before either is measured, as each scroll may
+	// move the other
+	if err := to.ScrollIntoView(ctx); err != nil {
+		return err
+	}
+	if err := from.ScrollIntoView(ctx); err != nil {
+		return err
+	}
+	start, err := center(ctx, from)
+	if err != nil {
+		return err
+	}
+	end, err := center(ctx, to)
+	if err != nil {
+		return err
+	}
+
+	if err := move(ctx, d, c, start, 0); err != nil {
+		return err
+	}
+	if err := mouse(ctx, d, c, mousePressed, start, 1, buttons[c.button]); err != nil {
+		return err
+	}
+	for i := 1; i <= c.steps; i++ {
+		f := float64(i) / float64(c.steps)
+		p := Point{X: start.X + (end.X-start.X)*f, Y: start.Y + (end.Y-start.Y)*f}
+		if err := move(ctx, d, c, p, buttons[c.button]); err != nil {
+			return err
+		}
+	}
+	return mouse(ctx, d, c, mouseReleased, end, 1, 0)
+}
+
+// click presses and releases the button at p.
+func click(ctx context.Context, d chromedebugo.SyncDebugger, c *config, p Point, count int64) error {
+	if err := mouse(ctx, d, c, mousePressed, p, count, buttons[c.button]); err != nil {
+		return err
+	}
+	if err := c.pause(ctx); err != nil {
+		return err
+	}
+	return mouse(ctx, d, c, mouseReleased, p, count, 0)
+}
+
+// move moves the mouse to p with the buttons in held pressed.
+func move(ctx context.Context, d chromedebugo.SyncDebugger, c *config, p Point, held int64) error {
+	params := inputproto.DispatchMouseEventParams{
+		Type:      mouseMoved,
+		X:         p.X,
+		Y:         p.Y,
+		Modifiers: int64(c.modifiers),
+		Buttons:   held,
+	}
+	if held != 0 {
+		params.Button = c.button
+	}
+	return params.Do(ctx, d)
+}
+
+// mouse dispatches a press or release of the configured button.  held is
+// the buttons pressed after the event.
+func mouse(ctx context.Context, d chromedebugo.SyncDebugger, c *config, typ string, p Point, count int64, held int64) error {
+	return inputproto.DispatchMouseEventParams{
+		Type:       typ,
+		X:          p.X,
+		Y:          p.Y,
+		Modifiers:  int64(c.modifiers),
+		Button:     c.button,
+		Buttons:    held,
+		ClickCount: count,
+	}.Do(ctx, d)
+}
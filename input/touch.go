@@ -0,0 +1,193 @@
+package input
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/tonyhb/chromedebugo"
+	"github.com/tonyhb/chromedebugo/element"
+	"github.com/tonyhb/chromedebugo/protocol/emulation"
+	inputproto "github.com/tonyhb/chromedebugo/protocol/input"
+)
+
+// Touch event types of Input.dispatchTouchEvent.
+const (
+	touchStart  = "touchStart"
+	touchMove   = "touchMove"
+	touchEnd    = "touchEnd"
+	touchCancel = "touchCancel"
+)
+
+// EnableTouch emulates a touch screen supporting up to maxPoints touches at
+// once, so that the page sees touch support and gestures are synthesized
+// with touch events.
+func EnableTouch(ctx context.Context, d chromedebugo.SyncDebugger, maxPoints int) error {
+	return emulation.SetTouchEmulationEnabledParams{
+		Enabled:        true,
+		MaxTouchPoints: int64(maxPoints),
+	}.Do(ctx, d)
+}
+
+// DisableTouch stops emulating a touch screen.
+func DisableTouch(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return emulation.SetTouchEmulationEnabledParams{Enabled: false}.Do(ctx, d)
+}
+
+// Tap taps the centre of el, scrolling it into view first.
+func Tap(ctx context.Context, d chromedebugo.SyncDebugger, el *element.Element, opts ...Option) error {
+	p, err := Center(ctx, el)
+	if err != nil {
+		return err
+	}
+	return TapAt(ctx, d, p, opts...)
+}
+
+// TapAt taps p with a synthesized tap gesture.  WithDelay sets how long the
+// finger is held down.
+func TapAt(ctx context.Context, d chromedebugo.SyncDebugger, p Point, opts ...Option) error {
+	c := newConfig(opts)
+	return inputproto.SynthesizeTapGestureParams{
+		X:                 p.X,
+		Y:                 p.Y,
+		Duration:          c.delay.Milliseconds(),
+		TapCount:          1,
+		GestureSourceType: inputproto.GestureSourceTypeTouch,
+	}.Do(ctx, d)
+}
+
+// Swipe drags a finger from start by dx, dy with a synthesized scroll
+// gesture, scrolling the page as a user's swipe does: a swipe up, with a
+// negative dy, scrolls down.  WithSpeed sets the finger's speed.
+func Swipe(ctx context.Context, d chromedebugo.SyncDebugger, start Point, dx, dy float64, opts ...Option) error {
+	c := newConfig(opts)
+	return inputproto.SynthesizeScrollGestureParams{
+		X:                 start.X,
+		Y:                 start.Y,
+		XDistance:         dx,
+		YDistance:         dy,
+		Speed:             int64(c.speed),
+		GestureSourceType: inputproto.GestureSourceTypeTouch,
+		// the page would otherwise keep scrolling after the finger lifts
+		PreventFling: true,
+	}.Do(ctx, d)
+}
+
+// Pinch pinches around centre with a synthesized two finger gesture,
+// zooming in by scale if it is more than one and out if it is less.
+// WithSpeed sets the fingers' speed.
+func Pinch(ctx context.Context, d chromedebugo.SyncDebugger, centre Point, scale float64, opts ...Option) error {
+	c := newConfig(opts)
+	return inputproto.SynthesizePinchGestureParams{
+		X:                 centre.X,
+		Y:                 centre.Y,
+		ScaleFactor:       scale,
+		RelativeSpeed:     int64(c.speed),
+		GestureSourceType: inputproto.GestureSourceTypeTouch,
+	}.Do(ctx, d)
+}
+
+// Touches is a sequence of touch events with any number of fingers, each
+// identified by an ID of the caller's choosing, for gestures chrome can't
+// synthesize:
+//
+//	touches := input.NewTouches(debugger)
+//	err := touches.Start(ctx, map[int]input.Point{0: {100, 100}, 1: {200, 100}})
+//	...
+//	err = touches.Move(ctx, map[int]input.Point{0: {100, 200}, 1: {200, 200}})
+//	...
+//	err = touches.End(ctx, 0, 1)
+//
+// Every event lists all the fingers down, and chrome works out which have
+// been added, moved or lifted.  It is not safe for concurrent use.
+type Touches struct {
+	d      chromedebugo.SyncDebugger
+	points map[int]Point
+}
+
+// NewTouches returns a sequence of touches with no fingers down.
+func NewTouches(d chromedebugo.SyncDebugger) *Touches {
+	return &Touches{d: d, points: map[int]Point{}}
+}
+
+// Start puts fingers down at the given points, by ID.  IDs must not be
+// negative.
+func (t *Touches) Start(ctx context.Context, points map[int]Point) error {
+	for id := range points {
+		if id < 0 {
+			return fmt.Errorf("touch point %d has a negative ID", id)
+		}
+		if _, ok := t.points[id]; ok {
+			return fmt.Errorf("touch point %d is already down", id)
+		}
+	}
+	return t.dispatch(ctx, touchStart, points)
+}
+
+// Move moves fingers which are down to the given points, by ID.  Fingers
+// not given stay where they are.
+func (t *Touches) Move(ctx context.Context, points map[int]Point) error {
+	for id := range points {
+		if _, ok := t.points[id]; !ok {
+			return fmt.Errorf("touch point %d is not down", id)
+		}
+	}
+	return t.dispatch(ctx, touchMove, points)
+}
+
+// End lifts the fingers with the given IDs.
+func (t *Touches) End(ctx context.Context, ids ...int) error {
+	remaining := make(map[int]Point, len(t.points))
+	for id, p := range t.points {
+		remaining[id] = p
+	}
+	for _, id := range ids {
+		if _, ok := remaining[id]; !ok {
+			return fmt.Errorf("touch point %d is not down", id)
+		}
+		delete(remaining, id)
+	}
+	t.points = map[int]Point{}
+	if len(remaining) > 0 {
+		// chrome lifts the fingers left out of a move; touchEnd may not
+		// list any
+		return t.dispatch(ctx, touchMove, remaining)
+	}
+	return t.dispatch(ctx, touchEnd, nil)
+}
+
+// Cancel cancels the touches, as when the browser takes over a gesture, and
+// lifts every finger.
+func (t *Touches) Cancel(ctx context.Context) error {
+	t.points = map[int]Point{}
+	return t.dispatch(ctx, touchCancel, nil)
+}
+
+// dispatch updates the fingers down with points and sends an event listing
+// them all, in order of ID.
+func (t *Touches) dispatch(ctx context.Context, typ string, points map[int]Point) error {
+	for id, p := range points {
+		t.points[id] = p
+	}
+	ids := make([]int, 0, len(t.points))
+	for id := range t.points {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+
+	params := inputproto.DispatchTouchEventParams{
+		Type:        typ,
+		TouchPoints: []inputproto.TouchPoint{},
+	}
+	for _, id := range ids {
+		p := t.points[id]
+		params.TouchPoints = append(params.TouchPoints, inputproto.TouchPoint{
+			X: p.X,
+			Y: p.Y,
+			// chrome's IDs are offset by one as an ID of zero would be
+			// left out of the JSON
+			ID: float64(id + 1),
+		})
+	}
+	return params.Do(ctx, t.d)
+}
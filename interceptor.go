@@ -0,0 +1,68 @@
+package chromedebugo
+
+import "context"
+
+// Invoker sends a command to chrome and returns its result.  An Error
+// response from chrome is returned as the error.
+type Invoker func(ctx context.Context, cmd Command) (Result, error)
+
+// Interceptor wraps every command sent by a debugger.  It may inspect or
+// rewrite the command before calling next to send it, and inspect or replace
+// the result, eg. to log, time or authorize commands:
+//
+//	func(ctx context.Context, cmd Command, next Invoker) (Result, error) {
+//		start := time.Now()
+//		res, err := next(ctx, cmd)
+//		log.Printf("%s took %s", cmd.Method, time.Since(start))
+//		return res, err
+//	}
+//
+// Commands sent to a session already have its SessionID set.  On an async
+// debugger next returns as soon as the command is written, with only the
+// Result's ID set; the response arrives on ResultChan or ErrorChan.
+type Interceptor func(ctx context.Context, cmd Command, next Invoker) (Result, error)
+
+// FrameHook is called with every message read from chrome for a debugger,
+// which is a Result, an Error or an event Command, before it is delivered.
+// Hooks run on the goroutine reading from chrome, so they must not block.
+type FrameHook func(frame interface{})
+
+// WithInterceptors adds interceptors around the commands sent by the
+// debugger.  The first interceptor given is outermost, so it sees commands
+// first and results last.
+//
+// A sync debugger's Batch sends its commands one at a time through the
+// interceptors, rather than pipelining them, once any are set.
+func WithInterceptors(interceptors ...Interceptor) Option {
+	return func(d *debugger) {
+		d.interceptors = append(d.interceptors, interceptors...)
+	}
+}
+
+// WithFrameHooks adds hooks called with every message the debugger receives.
+func WithFrameHooks(hooks ...FrameHook) Option {
+	return func(d *debugger) {
+		d.frameHooks = append(d.frameHooks, hooks...)
+	}
+}
+
+// intercept sends cmd with invoke through the debugger's interceptors.  The
+// command is stamped with the debugger's session first, so that
+// interceptors see which session it is for.
+func (d *debugger) intercept(ctx context.Context, cmd Command, invoke Invoker) (Result, error) {
+	cmd.SessionID = d.sessionID
+	for i := len(d.interceptors) - 1; i >= 0; i-- {
+		interceptor, next := d.interceptors[i], invoke
+		invoke = func(ctx context.Context, cmd Command) (Result, error) {
+			return interceptor(ctx, cmd, next)
+		}
+	}
+	return invoke(ctx, cmd)
+}
+
+// hook calls the debugger's frame hooks with a message from chrome.
+func (d *debugger) hook(frame interface{}) {
+	for _, h := range d.frameHooks {
+		h(frame)
+	}
+}
@@ -0,0 +1,130 @@
+package chromedebugo
+
+import (
+	"context"
+	"log/slog"
+	"sort"
+	"sync"
+	"time"
+)
+
+// LoggingInterceptor logs every command sent with logger: successful
+// commands at debug level and failed ones at error level, with the method,
+// session, command ID and duration as attributes.  Params and results are
+// not logged, as they may hold page contents or credentials.
+func LoggingInterceptor(logger *slog.Logger) Interceptor {
+	return func(ctx context.Context, cmd Command, next Invoker) (Result, error) {
+		start := time.Now()
+		res, err := next(ctx, cmd)
+
+		attrs := []slog.Attr{
+			slog.String("method", cmd.Method),
+			slog.Duration("duration", time.Since(start)),
+		}
+		if res.ID != 0 {
+			attrs = append(attrs, slog.Int("id", res.ID))
+		}
+		if cmd.SessionID != "" {
+			attrs = append(attrs, slog.String("session", cmd.SessionID))
+		}
+		if err != nil {
+			attrs = append(attrs, slog.String("error", err.Error()))
+			logger.LogAttrs(ctx, slog.LevelError, "chrome command failed", attrs...)
+		} else {
+			logger.LogAttrs(ctx, slog.LevelDebug, "chrome command", attrs...)
+		}
+		return res, err
+	}
+}
+
+// DefaultLatencyBuckets are the upper bounds of the buckets used by
+// NewLatencyHistogram when none are given.
+var DefaultLatencyBuckets = []time.Duration{
+	time.Millisecond,
+	5 * time.Millisecond,
+	10 * time.Millisecond,
+	50 * time.Millisecond,
+	100 * time.Millisecond,
+	500 * time.Millisecond,
+	time.Second,
+	5 * time.Second,
+}
+
+// LatencyHistogram counts how long commands take, per method.  Its
+// Interceptor records each command sent through it.
+type LatencyHistogram struct {
+	buckets []time.Duration
+
+	lock    sync.Mutex
+	methods map[string]*Latencies
+}
+
+// Latencies is the histogram for one method.  Counts[i] is the number of
+// commands which took at most Buckets[i], and the last count, beyond the
+// buckets, those which took longer.
+type Latencies struct {
+	Buckets []time.Duration
+	Counts  []uint64
+	Count   uint64
+	Sum     time.Duration
+	// Errors counts the commands which failed, which are counted in the
+	// buckets too
+	Errors uint64
+}
+
+// NewLatencyHistogram returns a histogram with buckets bounded by the given
+// durations, or DefaultLatencyBuckets.
+func NewLatencyHistogram(buckets ...time.Duration) *LatencyHistogram {
+	if len(buckets) == 0 {
+		buckets = DefaultLatencyBuckets
+	}
+	buckets = append([]time.Duration(nil), buckets...)
+	sort.Slice(buckets, func(i, j int) bool { return buckets[i] < buckets[j] })
+	return &LatencyHistogram{
+		buckets: buckets,
+		methods: map[string]*Latencies{},
+	}
+}
+
+// Interceptor returns an interceptor recording the latency of each command.
+func (h *LatencyHistogram) Interceptor() Interceptor {
+	return func(ctx context.Context, cmd Command, next Invoker) (Result, error) {
+		start := time.Now()
+		res, err := next(ctx, cmd)
+		h.observe(cmd.Method, time.Since(start), err)
+		return res, err
+	}
+}
+
+func (h *LatencyHistogram) observe(method string, d time.Duration, err error) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	l, ok := h.methods[method]
+	if !ok {
+		l = &Latencies{
+			Buckets: h.buckets,
+			Counts:  make([]uint64, len(h.buckets)+1),
+		}
+		h.methods[method] = l
+	}
+	i := sort.Search(len(h.buckets), func(i int) bool { return d <= h.buckets[i] })
+	l.Counts[i]++
+	l.Count++
+	l.Sum += d
+	if err != nil {
+		l.Errors++
+	}
+}
+
+// Snapshot returns a copy of the histogram for each method seen so far.
+func (h *LatencyHistogram) Snapshot() map[string]Latencies {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	snapshot := make(map[string]Latencies, len(h.methods))
+	for method, l := range h.methods {
+		c := *l
+		c.Counts = append([]uint64(nil), l.Counts...)
+		snapshot[method] = c
+	}
+	return snapshot
+}
@@ -0,0 +1,188 @@
+package chromedebugo_test
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/tonyhb/chromedebugo"
+	"github.com/tonyhb/chromedebugo/cdptest"
+)
+
+func TestLoggingInterceptor(t *testing.T) {
+	srv := cdptest.NewServer()
+	defer srv.Close()
+	srv.Handle("Target.attachToTarget", cdptest.Result(map[string]interface{}{"sessionId": "session-1"}))
+	srv.Handle("Target.detachFromTarget", cdptest.Result(nil))
+	srv.Handle("Page.enable", cdptest.Result(nil))
+
+	browser, err := chromedebugo.NewBrowserSync(srv.BrowserURL())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer browser.Close()
+	logs := &bytes.Buffer{}
+	logger := slog.New(slog.NewTextHandler(logs, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	page, err := browser.AttachSync("page-1", chromedebugo.WithInterceptors(chromedebugo.LoggingInterceptor(logger)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer page.Close()
+
+	if _, err := page.Send(chromedebugo.Command{Method: "Page.enable"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := page.Send(chromedebugo.Command{Method: "Page.navigate"}); err == nil {
+		t.Fatal("Page.navigate succeeded with no handler")
+	}
+
+	lines := strings.Split(strings.TrimSpace(logs.String()), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("got log lines %q, want 2", lines)
+	}
+	for i, want := range []string{"level=DEBUG msg=\"chrome command\" method=Page.enable", "level=ERROR msg=\"chrome command failed\" method=Page.navigate"} {
+		if !strings.Contains(lines[i], want) {
+			t.Errorf("log line %q does not contain %q", lines[i], want)
+		}
+		if !strings.Contains(lines[i], "session=session-1") {
+			t.Errorf("log line %q does not name the session", lines[i])
+		}
+	}
+}
+
+func TestLatencyHistogramInterceptor(t *testing.T) {
+	srv := cdptest.NewServer()
+	defer srv.Close()
+	srv.Handle("Page.enable", cdptest.Result(nil))
+	srv.Handle("Page.reload", cdptest.Delayed(100*time.Millisecond, cdptest.Result(nil)))
+
+	h := chromedebugo.NewLatencyHistogram(50 * time.Millisecond)
+	d := newSync(t, srv, chromedebugo.WithInterceptors(h.Interceptor()))
+	for _, method := range []string{"Page.enable", "Page.reload", "Page.navigate"} {
+		d.Send(chromedebugo.Command{Method: method})
+	}
+
+	snapshot := h.Snapshot()
+	if l := snapshot["Page.reload"]; l.Count != 1 || l.Counts[1] != 1 || l.Sum < 100*time.Millisecond {
+		t.Errorf("got %+v for a slow command, want it beyond the last bucket", l)
+	}
+	if l := snapshot["Page.navigate"]; l.Count != 1 || l.Errors != 1 {
+		t.Errorf("got %+v for a failed command", l)
+	}
+	if l := snapshot["Page.enable"]; l.Count != 1 || l.Errors != 0 {
+		t.Errorf("got %+v for Page.enable", l)
+	}
+}
+
+func TestInterceptorRewritesCommands(t *testing.T) {
+	srv := cdptest.NewServer()
+	defer srv.Close()
+	srv.Handle("Page.navigate", cdptest.Result(nil))
+
+	seen := []string{}
+	referrer := func(ctx context.Context, cmd chromedebugo.Command, next chromedebugo.Invoker) (chromedebugo.Result, error) {
+		seen = append(seen, cmd.Method)
+		if cmd.Method == "Page.navigate" {
+			params := map[string]interface{}{"referrer": "https://example.com/"}
+			for k, v := range cmd.Params {
+				params[k] = v
+			}
+			cmd.Params = params
+		}
+		return next(ctx, cmd)
+	}
+	d := newSync(t, srv, chromedebugo.WithInterceptors(referrer))
+	if _, err := d.Send(chromedebugo.Command{Method: "Page.navigate", Params: map[string]interface{}{"url": "about:blank"}}); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(seen) != 1 || seen[0] != "Page.navigate" {
+		t.Errorf("interceptor saw %v", seen)
+	}
+	cmd := srv.AssertReceived(t, "Page.navigate")
+	if cmd.Params["url"] != "about:blank" || cmd.Params["referrer"] != "https://example.com/" {
+		t.Errorf("chrome received %v", cmd.Params)
+	}
+}
+
+// frames collects the messages seen by a frame hook.
+type frames struct {
+	lock   sync.Mutex
+	frames []interface{}
+}
+
+func (f *frames) hook(frame interface{}) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.frames = append(f.frames, frame)
+}
+
+// wait waits for n frames and returns them.
+func (f *frames) wait(t *testing.T, n int) []interface{} {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		f.lock.Lock()
+		got := append([]interface{}(nil), f.frames...)
+		f.lock.Unlock()
+		if len(got) >= n {
+			return got
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("got frames %v, want %d", got, n)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestFrameHooks(t *testing.T) {
+	srv := cdptest.NewServer()
+	defer srv.Close()
+	srv.Handle("Page.enable", cdptest.Result(map[string]interface{}{"ok": true}))
+
+	seen := &frames{}
+	d := newSync(t, srv, chromedebugo.WithFrameHooks(seen.hook))
+	id := 0
+	if res, err := d.Send(chromedebugo.Command{Method: "Page.enable"}); err != nil {
+		t.Fatal(err)
+	} else {
+		id = res.ID
+	}
+	d.Send(chromedebugo.Command{Method: "Page.navigate"})
+	srv.Emit("Page.loadEventFired", map[string]interface{}{"timestamp": 1})
+
+	got := seen.wait(t, 3)
+	if res, ok := got[0].(chromedebugo.Result); !ok || res.ID != id || res.Result["ok"] != true {
+		t.Errorf("got frame %#v, want Page.enable's Result", got[0])
+	}
+	if e, ok := got[1].(chromedebugo.Error); !ok || e.ErrorDetail.Code != -32601 {
+		t.Errorf("got frame %#v, want Page.navigate's Error", got[1])
+	}
+	if ev, ok := got[2].(chromedebugo.Command); !ok || ev.Method != "Page.loadEventFired" {
+		t.Errorf("got frame %#v, want the event", got[2])
+	}
+}
+
+func TestFrameHooksAsync(t *testing.T) {
+	srv := cdptest.NewServer()
+	defer srv.Close()
+	srv.Handle("Page.enable", cdptest.Result(nil))
+
+	seen := &frames{}
+	d, err := chromedebugo.NewAsync(srv.URL(), chromedebugo.WithFrameHooks(seen.hook))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer d.Close()
+	id, err := d.Send(chromedebugo.Command{Method: "Page.enable"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res, ok := seen.wait(t, 1)[0].(chromedebugo.Result); !ok || res.ID != id {
+		t.Errorf("got frame %#v, want Page.enable's Result", res)
+	}
+}
@@ -1,24 +1,50 @@
 package chromedebugo
 
+import "context"
+
 type AsyncDebugger interface {
 	Version() (Version, error)
 	Info() ([]Info, error)
 
+	Lifecycle
+
 	Send(Command) (int, error)
 
+	// Subscribe returns a buffered channel of the events matching method,
+	// which may be an event name, a domain prefix such as "Network.*" or
+	// "*", and a function to unsubscribe.
+	Subscribe(method string, opts ...SubscribeOption) (<-chan Command, func())
+	// On calls handler with each event matching method until the returned
+	// function is called.
+	On(method string, handler func(Command), opts ...SubscribeOption) func()
+
+	// ErrorChan and ResultChan receive a copy of every response when
+	// enabled with WithResponseTaps, and are nil otherwise.  Send and Batch
+	// return responses regardless.
 	ErrorChan() chan Error
 	ResultChan() chan Result
+	// CommandChan receives every event.  It is buffered and drops the
+	// oldest events when full; prefer Subscribe.
 	CommandChan() chan Command
 }
 
+// SyncDebugger is safe for concurrent use; commands sent from many goroutines
+// are pipelined over the one connection to chrome.
 type SyncDebugger interface {
 	Version() (Version, error)
 	Info() ([]Info, error)
 
+	Lifecycle
+
 	// Send dispatches a command to headless chrome and blocks until chrome
 	// sends us a Result or Error
 	Send(Command) (Result, error)
 
+	// SendContext is like Send but stops waiting for chrome's response when
+	// ctx is done, returning ctx.Err().  A response which arrives after that
+	// is discarded.
+	SendContext(context.Context, Command) (Result, error)
+
 	// Batch dispatches mutiple commands in order to chrome and blocks until
 	// all responses for commands have been receivevd.
 	//
@@ -29,7 +55,38 @@ type SyncDebugger interface {
 	// any of the batched commands to chrome.
 	Batch([]Command) ([]interface{}, error)
 
+	// BatchContext is like Batch but stops waiting for the responses when
+	// ctx is done, returning ctx.Err().
+	BatchContext(context.Context, []Command) ([]interface{}, error)
+
+	// Subscribe returns a buffered channel of the events matching method,
+	// which may be an event name, a domain prefix such as "Network.*" or
+	// "*", and a function to unsubscribe.
+	Subscribe(method string, opts ...SubscribeOption) (<-chan Command, func())
+	// On calls handler with each event matching method until the returned
+	// function is called.
+	On(method string, handler func(Command), opts ...SubscribeOption) func()
+
+	// ErrorChan and ResultChan receive a copy of every response when
+	// enabled with WithResponseTaps, and are nil otherwise.  Send and Batch
+	// return responses regardless.
 	ErrorChan() chan Error
 	ResultChan() chan Result
+	// CommandChan receives every event.  It is buffered and drops the
+	// oldest events when full; prefer Subscribe.
 	CommandChan() chan Command
 }
+
+// Lifecycle is implemented by both debuggers to close and observe the
+// connection to chrome.
+type Lifecycle interface {
+	// Close closes the connection.  Commands awaiting a response fail with
+	// ErrConnectionClosed and every subscription is closed.
+	Close() error
+	// Done is closed once the connection has closed, whether by Close or
+	// because reading from chrome failed.
+	Done() <-chan struct{}
+	// Err returns nil until Done is closed and an ErrConnectionClosed
+	// holding the reason afterwards.
+	Err() error
+}
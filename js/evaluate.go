@@ -0,0 +1,180 @@
+// Package js evaluates JavaScript in a page and decodes the results:
+//
+//	title := ""
+//	if err := js.EvaluateInto(ctx, debugger, "document.title", &title); err != nil {
+//		return err
+//	}
+//
+// Exceptions thrown by the page are returned as *Exception errors, which
+// carry the location and stack trace of the exception.
+package js
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"time"
+
+	"github.com/tonyhb/chromedebugo"
+	"github.com/tonyhb/chromedebugo/protocol/runtime"
+)
+
+// Option configures a call to Evaluate.
+type Option func(*runtime.EvaluateParams)
+
+// WithReturnByValue returns the result's value, serialized as JSON, rather
+// than a reference to the object in the page.
+func WithReturnByValue() Option {
+	return func(p *runtime.EvaluateParams) {
+		p.ReturnByValue = true
+	}
+}
+
+// WithAwaitPromise waits for a promise returned by the expression to settle,
+// and returns its value or rejection instead of the promise.
+func WithAwaitPromise() Option {
+	return func(p *runtime.EvaluateParams) {
+		p.AwaitPromise = true
+	}
+}
+
+// WithContextID evaluates in the given execution context, eg. an iframe's,
+// rather than the page's main context.
+func WithContextID(id runtime.ExecutionContextID) Option {
+	return func(p *runtime.EvaluateParams) {
+		p.ContextID = id
+	}
+}
+
+// WithObjectGroup adds the objects returned to an object group, which can be
+// released at once.
+func WithObjectGroup(group string) Option {
+	return func(p *runtime.EvaluateParams) {
+		p.ObjectGroup = group
+	}
+}
+
+// WithUserGesture evaluates as if the user had interacted with the page,
+// which is needed to eg. open popups or enter fullscreen.
+func WithUserGesture() Option {
+	return func(p *runtime.EvaluateParams) {
+		p.UserGesture = true
+	}
+}
+
+// WithTimeout terminates the evaluation if it runs for longer than d.
+func WithTimeout(d time.Duration) Option {
+	return func(p *runtime.EvaluateParams) {
+		p.Timeout = runtime.TimeDelta(d / time.Millisecond)
+	}
+}
+
+// RemoteObject is the result of an evaluation: a value, when returned by
+// value or primitive, or a handle to an object in the page.  The page keeps
+// the object alive until the handle, or the object group it belongs to, is
+// released.
+type RemoteObject struct {
+	runtime.RemoteObject
+
+	// d is the debugger of the page holding the object, and group the
+	// object group it was created in
+	d     chromedebugo.SyncDebugger
+	group string
+}
+
+// Evaluate evaluates expr in the page.  An exception thrown by expr, or a
+// promise it returns rejecting when awaited, is returned as an *Exception.
+func Evaluate(ctx context.Context, d chromedebugo.SyncDebugger, expr string, opts ...Option) (RemoteObject, error) {
+	params := runtime.EvaluateParams{Expression: expr}
+	for _, opt := range opts {
+		opt(&params)
+	}
+	ret, err := params.Do(ctx, d)
+	if err != nil {
+		return RemoteObject{}, err
+	}
+	if ret.ExceptionDetails != nil {
+		return RemoteObject{}, newException(ret.ExceptionDetails)
+	}
+	return RemoteObject{RemoteObject: ret.Result, d: d, group: params.ObjectGroup}, nil
+}
+
+// EvaluateInto evaluates expr, awaiting any promise it returns, and decodes
+// its value into dst as by json.Unmarshal.  The value must be serializable
+// as JSON; DOM nodes, for example, are not.
+func EvaluateInto(ctx context.Context, d chromedebugo.SyncDebugger, expr string, dst interface{}, opts ...Option) error {
+	opts = append([]Option{WithReturnByValue(), WithAwaitPromise()}, opts...)
+	obj, err := Evaluate(ctx, d, expr, opts...)
+	if err != nil {
+		return err
+	}
+	return obj.Decode(dst)
+}
+
+// Decode decodes the object's value into dst as by json.Unmarshal.  The
+// object must have been returned by value or be a primitive.  Undefined
+// leaves dst unchanged, as null does, and values JSON can't represent, such
+// as NaN, only decode into floats.
+func (o RemoteObject) Decode(dst interface{}) error {
+	if o.UnserializableValue != "" {
+		return decodeUnserializable(string(o.UnserializableValue), dst)
+	}
+	if o.Type == "undefined" || len(o.Value) == 0 {
+		if o.ObjectID != "" {
+			return fmt.Errorf("cannot decode %s: it was not returned by value", o.describe())
+		}
+		return nil
+	}
+	if err := json.Unmarshal(o.Value, dst); err != nil {
+		return fmt.Errorf("error decoding %s: %s", o.describe(), err)
+	}
+	return nil
+}
+
+// describe names the object for errors.
+func (o RemoteObject) describe() string {
+	if o.Description != "" {
+		return o.Description
+	}
+	if o.Subtype != "" {
+		return o.Subtype
+	}
+	return o.Type
+}
+
+// decodeUnserializable decodes NaN, Infinity, -Infinity and -0 into floats.
+func decodeUnserializable(value string, dst interface{}) error {
+	var f float64
+	switch value {
+	case "NaN":
+		f = math.NaN()
+	case "Infinity":
+		f = math.Inf(1)
+	case "-Infinity":
+		f = math.Inf(-1)
+	case "-0":
+		f = math.Copysign(0, -1)
+	default:
+		// bigints, such as 1n
+		return fmt.Errorf("cannot decode %s: it is not representable as JSON", value)
+	}
+
+	v := reflect.ValueOf(dst)
+	if v.Kind() != reflect.Ptr || v.IsNil() {
+		return fmt.Errorf("cannot decode %s into %T", value, dst)
+	}
+	switch e := v.Elem(); e.Kind() {
+	case reflect.Float32, reflect.Float64:
+		e.SetFloat(f)
+	case reflect.Interface:
+		if e.NumMethod() != 0 {
+			return fmt.Errorf("cannot decode %s into %T", value, dst)
+		}
+		e.Set(reflect.ValueOf(f))
+	default:
+		return fmt.Errorf("cannot decode %s into %T", value, dst)
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+package js
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/tonyhb/chromedebugo/protocol/runtime"
+)
+
+// Exception is an exception thrown by JavaScript evaluated in the page.
+type Exception struct {
+	// Message is the exception's message, eg. "ReferenceError: x is not
+	// defined", or the text chrome gives for it, eg. "Uncaught"
+	Message string
+	// URL, Line and Column locate where the exception was thrown.  Line
+	// and Column count from 1.
+	URL    string
+	Line   int64
+	Column int64
+	// Stack holds the call frames the exception was thrown through,
+	// innermost first
+	Stack []runtime.CallFrame
+	// Details holds everything chrome reported about the exception,
+	// including the thrown value
+	Details runtime.ExceptionDetails
+}
+
+func newException(details *runtime.ExceptionDetails) *Exception {
+	e := &Exception{
+		Message: details.Text,
+		URL:     details.URL,
+		Line:    details.LineNumber + 1,
+		Column:  details.ColumnNumber + 1,
+		Details: *details,
+	}
+	if details.Exception != nil && details.Exception.Description != "" {
+		// the description of an Error is its message followed by its
+		// stack, which is kept structured in Stack
+		e.Message = strings.SplitN(details.Exception.Description, "\n", 2)[0]
+	} else if details.Exception != nil && len(details.Exception.Value) > 0 {
+		// a thrown primitive, eg. throw "oops"
+		e.Message = fmt.Sprintf("%s %s", details.Text, details.Exception.Value)
+	}
+	if details.StackTrace != nil {
+		e.Stack = details.StackTrace.CallFrames
+	}
+	return e
+}
+
+func (e *Exception) Error() string {
+	b := &strings.Builder{}
+	fmt.Fprintf(b, "javascript exception: %s", e.Message)
+	if e.URL != "" {
+		fmt.Fprintf(b, " at %s:%d:%d", e.URL, e.Line, e.Column)
+	} else {
+		fmt.Fprintf(b, " at line %d column %d", e.Line, e.Column)
+	}
+	for _, frame := range e.Stack {
+		name := frame.FunctionName
+		if name == "" {
+			name = "<anonymous>"
+		}
+		url := frame.URL
+		if url == "" {
+			url = "<anonymous>"
+		}
+		fmt.Fprintf(b, "\n    at %s (%s:%d:%d)", name, url, frame.LineNumber+1, frame.ColumnNumber+1)
+	}
+	return b.String()
+}
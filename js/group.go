@@ -0,0 +1,57 @@
+package js
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+
+	"github.com/tonyhb/chromedebugo"
+	"github.com/tonyhb/chromedebugo/protocol/dom"
+	"github.com/tonyhb/chromedebugo/protocol/runtime"
+)
+
+// Group is an object group: a scope for the handles returned by its
+// evaluations, and by calls on those handles, which are all released
+// together.  Long-lived pages leak memory unless handles are released, and a
+// group makes that a single deferred call:
+//
+//	g := js.NewGroup(debugger)
+//	defer g.Release(ctx)
+//	body, err := g.Evaluate(ctx, "document.body")
+type Group struct {
+	d    chromedebugo.SyncDebugger
+	name string
+}
+
+// NewGroup returns a new object group with a unique name.
+func NewGroup(d chromedebugo.SyncDebugger) *Group {
+	b := make([]byte, 8)
+	rand.Read(b)
+	return &Group{d: d, name: "chromedebugo-" + hex.EncodeToString(b)}
+}
+
+// Name returns the group's name, for use with protocol commands which take
+// an objectGroup.
+func (g *Group) Name() string {
+	return g.name
+}
+
+// Evaluate evaluates expr as the package's Evaluate does, adding the objects
+// returned to the group.
+func (g *Group) Evaluate(ctx context.Context, expr string, opts ...Option) (RemoteObject, error) {
+	// copied so that the caller's slice is never appended to
+	opts = append(append([]Option(nil), opts...), WithObjectGroup(g.name))
+	return Evaluate(ctx, g.d, expr, opts...)
+}
+
+// ResolveNode returns a handle, in the group, to the DOM node with the given
+// ID.
+func (g *Group) ResolveNode(ctx context.Context, id dom.NodeID) (RemoteObject, error) {
+	return ResolveNode(ctx, g.d, id, g.name)
+}
+
+// Release releases every object in the group.  The group may be used again
+// afterwards.
+func (g *Group) Release(ctx context.Context) error {
+	return runtime.ReleaseObjectGroupParams{ObjectGroup: g.name}.Do(ctx, g.d)
+}
@@ -0,0 +1,265 @@
+package js_test
+
+import (
+	"context"
+	"errors"
+	"math"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/tonyhb/chromedebugo"
+	"github.com/tonyhb/chromedebugo/cdptest"
+	"github.com/tonyhb/chromedebugo/js"
+)
+
+func newPage(t *testing.T) (*cdptest.Server, chromedebugo.SyncDebugger) {
+	t.Helper()
+	srv := cdptest.NewServer()
+	t.Cleanup(srv.Close)
+	srv.Handle("Runtime.evaluate", cdptest.Result(map[string]interface{}{
+		"result": map[string]interface{}{"type": "object", "objectId": "object-1"},
+	}))
+	srv.Handle("Runtime.callFunctionOn", cdptest.Result(map[string]interface{}{
+		"result": map[string]interface{}{"type": "undefined"},
+	}))
+	d, err := chromedebugo.NewSync(srv.URL())
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { d.Close() })
+	return srv, d
+}
+
+func TestGroupEvaluateKeepsOptions(t *testing.T) {
+	srv, d := newPage(t)
+	ctx := context.Background()
+
+	// opts has room for another option, which awaiting shares
+	opts := make([]js.Option, 1, 2)
+	opts[0] = js.WithReturnByValue()
+	awaiting := append(opts, js.WithAwaitPromise())
+
+	if _, err := js.NewGroup(d).Evaluate(ctx, "1", opts...); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := js.Evaluate(ctx, d, "2", awaiting...); err != nil {
+		t.Fatal(err)
+	}
+
+	cmds := srv.Received("Runtime.evaluate")
+	if len(cmds) != 2 {
+		t.Fatalf("got %d evaluations, want 2", len(cmds))
+	}
+	if cmds[0].Params["objectGroup"] == nil {
+		t.Errorf("group's evaluation has no object group: %v", cmds[0].Params)
+	}
+	if cmds[1].Params["objectGroup"] != nil || cmds[1].Params["awaitPromise"] != true {
+		t.Errorf("caller's options were changed by the group: %v", cmds[1].Params)
+	}
+}
+
+func TestCallUnserializableArguments(t *testing.T) {
+	srv, d := newPage(t)
+	ctx := context.Background()
+	obj, err := js.Evaluate(ctx, d, "window")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	args := []interface{}{
+		math.NaN(), math.Inf(-1),
+		float32(math.NaN()), float32(math.Inf(1)), float32(math.Inf(-1)),
+		float32(1.5),
+	}
+	if _, err := obj.CallFunctionOn(ctx, "function() {}", args...); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []interface{}{"NaN", "-Infinity", "NaN", "Infinity", "-Infinity", nil}
+	sent, _ := srv.AssertReceived(t, "Runtime.callFunctionOn").Params["arguments"].([]interface{})
+	if len(sent) != len(want) {
+		t.Fatalf("sent arguments %v", sent)
+	}
+	for i, w := range want {
+		arg := sent[i].(map[string]interface{})
+		if arg["unserializableValue"] != w {
+			t.Errorf("argument %d sent as %v, want %v", i, arg, w)
+		}
+	}
+	if last := sent[len(sent)-1].(map[string]interface{}); last["value"] != 1.5 {
+		t.Errorf("float32 sent as %v", last)
+	}
+}
+
+// evaluating returns a handler for Runtime.evaluate which responds with the
+// result given for each expression.
+func evaluating(results map[string]map[string]interface{}) cdptest.Handler {
+	return func(cmd chromedebugo.Command) cdptest.Response {
+		return cdptest.Response{Result: results[cmd.Params["expression"].(string)]}
+	}
+}
+
+func TestEvaluateException(t *testing.T) {
+	srv, d := newPage(t)
+	srv.Handle("Runtime.evaluate", evaluating(map[string]map[string]interface{}{
+		"f()": {
+			"result": map[string]interface{}{"type": "object", "subtype": "error"},
+			"exceptionDetails": map[string]interface{}{
+				"exceptionId":  1,
+				"text":         "Uncaught",
+				"lineNumber":   2,
+				"columnNumber": 4,
+				"url":          "https://example.com/app.js",
+				"exception": map[string]interface{}{
+					"type":        "object",
+					"subtype":     "error",
+					"className":   "ReferenceError",
+					"description": "ReferenceError: x is not defined\n    at f (https://example.com/app.js:3:5)",
+				},
+				"stackTrace": map[string]interface{}{"callFrames": []map[string]interface{}{
+					{"functionName": "f", "scriptId": "1", "url": "https://example.com/app.js", "lineNumber": 2, "columnNumber": 4},
+					{"functionName": "", "scriptId": "2", "url": "", "lineNumber": 0, "columnNumber": 0},
+				}},
+			},
+		},
+	}))
+
+	_, err := js.Evaluate(context.Background(), d, "f()")
+	var e *js.Exception
+	if !errors.As(err, &e) {
+		t.Fatalf("got %v, want an *Exception", err)
+	}
+	if e.Message != "ReferenceError: x is not defined" {
+		t.Errorf("got message %q", e.Message)
+	}
+	// chrome counts lines and columns from 0
+	if e.URL != "https://example.com/app.js" || e.Line != 3 || e.Column != 5 {
+		t.Errorf("got location %s:%d:%d, want https://example.com/app.js:3:5", e.URL, e.Line, e.Column)
+	}
+	if len(e.Stack) != 2 || e.Stack[0].FunctionName != "f" || e.Details.ExceptionID != 1 {
+		t.Errorf("got stack %+v", e.Stack)
+	}
+	want := "javascript exception: ReferenceError: x is not defined at https://example.com/app.js:3:5" +
+		"\n    at f (https://example.com/app.js:3:5)" +
+		"\n    at <anonymous> (<anonymous>:1:1)"
+	if err.Error() != want {
+		t.Errorf("got error\n%s\nwant\n%s", err, want)
+	}
+}
+
+func TestEvaluateThrownPrimitive(t *testing.T) {
+	srv, d := newPage(t)
+	srv.Handle("Runtime.evaluate", evaluating(map[string]map[string]interface{}{
+		`throw "oops"`: {
+			"result": map[string]interface{}{"type": "string", "value": "oops"},
+			"exceptionDetails": map[string]interface{}{
+				"exceptionId": 1,
+				"text":        "Uncaught",
+				"exception":   map[string]interface{}{"type": "string", "value": "oops"},
+			},
+		},
+	}))
+
+	_, err := js.Evaluate(context.Background(), d, `throw "oops"`)
+	var e *js.Exception
+	if !errors.As(err, &e) {
+		t.Fatalf("got %v, want an *Exception", err)
+	}
+	if e.Message != `Uncaught "oops"` || e.Line != 1 || e.Column != 1 || e.Stack != nil {
+		t.Errorf("got %+v", e)
+	}
+	if err.Error() != `javascript exception: Uncaught "oops" at line 1 column 1` {
+		t.Errorf("got error %q", err)
+	}
+}
+
+func TestEvaluateInto(t *testing.T) {
+	srv, d := newPage(t)
+	srv.Handle("Runtime.evaluate", evaluating(map[string]map[string]interface{}{
+		"page()": {"result": map[string]interface{}{
+			"type":  "object",
+			"value": map[string]interface{}{"title": "Example", "links": []int{1, 2}},
+		}},
+	}))
+
+	page := struct {
+		Title string `json:"title"`
+		Links []int  `json:"links"`
+	}{}
+	err := js.EvaluateInto(context.Background(), d, "page()", &page, js.WithContextID(3), js.WithTimeout(2*time.Second))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if page.Title != "Example" || len(page.Links) != 2 || page.Links[1] != 2 {
+		t.Errorf("got %+v", page)
+	}
+
+	params := srv.AssertReceived(t, "Runtime.evaluate").Params
+	if params["returnByValue"] != true || params["awaitPromise"] != true {
+		t.Errorf("evaluated with %v, want the value of any promise returned", params)
+	}
+	if params["contextId"] != float64(3) || params["timeout"] != float64(2000) {
+		t.Errorf("evaluated with %v, want the options given", params)
+	}
+}
+
+func TestEvaluateIntoValues(t *testing.T) {
+	srv, d := newPage(t)
+	srv.Handle("Runtime.evaluate", evaluating(map[string]map[string]interface{}{
+		"undefined": {"result": map[string]interface{}{"type": "undefined"}},
+		"NaN":       {"result": map[string]interface{}{"type": "number", "unserializableValue": "NaN"}},
+		"-0":        {"result": map[string]interface{}{"type": "number", "unserializableValue": "-0"}},
+		"1n":        {"result": map[string]interface{}{"type": "bigint", "unserializableValue": "1n", "description": "1n"}},
+		"'text'":    {"result": map[string]interface{}{"type": "string", "value": "text"}},
+		"document":  {"result": map[string]interface{}{"type": "object", "subtype": "node", "objectId": "object-1", "description": "#document"}},
+	}))
+	ctx := context.Background()
+
+	n := 42.0
+	if err := js.EvaluateInto(ctx, d, "undefined", &n); err != nil || n != 42 {
+		t.Errorf("undefined decoded into %g, %v, want it unchanged", n, err)
+	}
+	if err := js.EvaluateInto(ctx, d, "NaN", &n); err != nil || !math.IsNaN(n) {
+		t.Errorf("NaN decoded into %g, %v", n, err)
+	}
+	if err := js.EvaluateInto(ctx, d, "-0", &n); err != nil || n != 0 || !math.Signbit(n) {
+		t.Errorf("-0 decoded into %g, %v", n, err)
+	}
+	var v interface{}
+	if err := js.EvaluateInto(ctx, d, "NaN", &v); err != nil || !math.IsNaN(v.(float64)) {
+		t.Errorf("NaN decoded into %v, %v", v, err)
+	}
+
+	i := 0
+	errs := map[string]struct {
+		dst  interface{}
+		want string
+	}{
+		"NaN":      {&i, "cannot decode NaN into *int"},
+		"1n":       {&n, "cannot decode 1n: it is not representable as JSON"},
+		"'text'":   {&i, "error decoding string"},
+		"document": {&v, "cannot decode #document: it was not returned by value"},
+	}
+	for expr, test := range errs {
+		err := js.EvaluateInto(ctx, d, expr, test.dst)
+		if err == nil || !strings.Contains(err.Error(), test.want) {
+			t.Errorf("evaluating %s got %v, want %q", expr, err, test.want)
+		}
+	}
+}
+
+func TestEvaluateProtocolError(t *testing.T) {
+	srv, d := newPage(t)
+	srv.Handle("Runtime.evaluate", cdptest.Fail(-32000, "Cannot find context with specified id"))
+
+	_, err := js.Evaluate(context.Background(), d, "1", js.WithContextID(99))
+	var chromeErr chromedebugo.Error
+	if !errors.As(err, &chromeErr) || chromeErr.ErrorDetail.Message != "Cannot find context with specified id" {
+		t.Errorf("got %v, want chrome's error", err)
+	}
+	var e *js.Exception
+	if errors.As(err, &e) {
+		t.Errorf("got an exception for a protocol error: %v", e)
+	}
+}
@@ -0,0 +1,190 @@
+package js
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+
+	"github.com/tonyhb/chromedebugo"
+	"github.com/tonyhb/chromedebugo/protocol/dom"
+	"github.com/tonyhb/chromedebugo/protocol/runtime"
+)
+
+// CallFunctionOn calls fn, a JavaScript function declaration such as
+// "function(a) { return this.value + a }", with the object as this.  Each
+// arg is passed by value, or as the object itself if it is a RemoteObject
+// from the same page.  The result belongs to the object's object group, and
+// a promise returned by fn is awaited.
+func (o RemoteObject) CallFunctionOn(ctx context.Context, fn string, args ...interface{}) (RemoteObject, error) {
+	params, err := o.callParams(fn, args)
+	if err != nil {
+		return RemoteObject{}, err
+	}
+	return o.call(ctx, params)
+}
+
+// CallFunctionInto calls fn as CallFunctionOn does and decodes its result
+// into dst as by json.Unmarshal.
+func (o RemoteObject) CallFunctionInto(ctx context.Context, dst interface{}, fn string, args ...interface{}) error {
+	params, err := o.callParams(fn, args)
+	if err != nil {
+		return err
+	}
+	params.ReturnByValue = true
+	res, err := o.call(ctx, params)
+	if err != nil {
+		return err
+	}
+	return res.Decode(dst)
+}
+
+func (o RemoteObject) callParams(fn string, args []interface{}) (runtime.CallFunctionOnParams, error) {
+	params := runtime.CallFunctionOnParams{
+		FunctionDeclaration: fn,
+		ObjectID:            o.ObjectID,
+		AwaitPromise:        true,
+		ObjectGroup:         o.group,
+	}
+	if err := o.handle(); err != nil {
+		return params, err
+	}
+	for i, arg := range args {
+		a, err := callArgument(arg)
+		if err != nil {
+			return params, fmt.Errorf("error encoding argument %d: %s", i, err)
+		}
+		params.Arguments = append(params.Arguments, a)
+	}
+	return params, nil
+}
+
+func (o RemoteObject) call(ctx context.Context, params runtime.CallFunctionOnParams) (RemoteObject, error) {
+	ret, err := params.Do(ctx, o.d)
+	if err != nil {
+		return RemoteObject{}, err
+	}
+	if ret.ExceptionDetails != nil {
+		return RemoteObject{}, newException(ret.ExceptionDetails)
+	}
+	return RemoteObject{RemoteObject: ret.Result, d: o.d, group: o.group}, nil
+}
+
+// callArgument encodes a Go value as an argument to a function.
+func callArgument(arg interface{}) (runtime.CallArgument, error) {
+	switch v := arg.(type) {
+	case RemoteObject:
+		if v.ObjectID != "" {
+			return runtime.CallArgument{ObjectID: v.ObjectID}, nil
+		}
+		if v.UnserializableValue != "" {
+			return runtime.CallArgument{UnserializableValue: v.UnserializableValue}, nil
+		}
+		return runtime.CallArgument{Value: v.Value}, nil
+	case float64:
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			return runtime.CallArgument{UnserializableValue: runtime.UnserializableValue(unserializable(v))}, nil
+		}
+	case float32:
+		if f := float64(v); math.IsNaN(f) || math.IsInf(f, 0) {
+			return runtime.CallArgument{UnserializableValue: runtime.UnserializableValue(unserializable(f))}, nil
+		}
+	}
+	data, err := json.Marshal(arg)
+	if err != nil {
+		return runtime.CallArgument{}, err
+	}
+	return runtime.CallArgument{Value: data}, nil
+}
+
+func unserializable(f float64) string {
+	switch {
+	case math.IsNaN(f):
+		return "NaN"
+	case math.IsInf(f, 1):
+		return "Infinity"
+	default:
+		return "-Infinity"
+	}
+}
+
+// GetProperties returns the object's own properties by name.  Accessor
+// properties, whose values would need calling a getter, are left out.
+func (o RemoteObject) GetProperties(ctx context.Context) (map[string]RemoteObject, error) {
+	if err := o.handle(); err != nil {
+		return nil, err
+	}
+	ret, err := runtime.GetPropertiesParams{
+		ObjectID:      o.ObjectID,
+		OwnProperties: true,
+	}.Do(ctx, o.d)
+	if err != nil {
+		return nil, err
+	}
+	if ret.ExceptionDetails != nil {
+		return nil, newException(ret.ExceptionDetails)
+	}
+	props := make(map[string]RemoteObject, len(ret.Result))
+	for _, p := range ret.Result {
+		if p.Value == nil {
+			continue
+		}
+		props[p.Name] = RemoteObject{RemoteObject: *p.Value, d: o.d, group: o.group}
+	}
+	return props, nil
+}
+
+// NodeID returns the ID of the DOM node the object is, pushing the node and
+// its ancestors to the client.  The DOM domain must have been given the
+// document with DOM.getDocument first.
+func (o RemoteObject) NodeID(ctx context.Context) (dom.NodeID, error) {
+	if err := o.handle(); err != nil {
+		return 0, err
+	}
+	ret, err := dom.RequestNodeParams{ObjectID: o.ObjectID}.Do(ctx, o.d)
+	if err != nil {
+		return 0, err
+	}
+	return ret.NodeID, nil
+}
+
+// Node describes the DOM node the object is.  It needs no document, but the
+// node's NodeID is only set if the node has been pushed to the client.
+func (o RemoteObject) Node(ctx context.Context) (dom.Node, error) {
+	if err := o.handle(); err != nil {
+		return dom.Node{}, err
+	}
+	ret, err := dom.DescribeNodeParams{ObjectID: o.ObjectID}.Do(ctx, o.d)
+	if err != nil {
+		return dom.Node{}, err
+	}
+	return ret.Node, nil
+}
+
+// ResolveNode returns a handle to the DOM node with the given ID, in the
+// given object group, which may be empty.
+func ResolveNode(ctx context.Context, d chromedebugo.SyncDebugger, id dom.NodeID, group string) (RemoteObject, error) {
+	ret, err := dom.ResolveNodeParams{NodeID: id, ObjectGroup: group}.Do(ctx, d)
+	if err != nil {
+		return RemoteObject{}, err
+	}
+	return RemoteObject{RemoteObject: ret.Object, d: d, group: group}, nil
+}
+
+// Release lets the page free the object.  The handle must not be used
+// afterwards.  Releasing a value, which has no handle, does nothing.
+func (o RemoteObject) Release(ctx context.Context) error {
+	if o.ObjectID == "" || o.d == nil {
+		return nil
+	}
+	return runtime.ReleaseObjectParams{ObjectID: o.ObjectID}.Do(ctx, o.d)
+}
+
+// handle returns an error unless the object is a handle to an object in a
+// page.
+func (o RemoteObject) handle() error {
+	if o.ObjectID == "" || o.d == nil {
+		return fmt.Errorf("%s is a value, not a handle to an object in the page", o.describe())
+	}
+	return nil
+}
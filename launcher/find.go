@@ -0,0 +1,65 @@
+package launcher
+
+import (
+	"errors"
+	"os"
+	"os/exec"
+	"runtime"
+)
+
+// ErrNotFound is returned by FindExec when no chrome binary can be found.
+var ErrNotFound = errors.New("launcher: could not find a chrome or chromium binary; set CHROME_PATH or use WithExecPath")
+
+// FindExec returns the path of a chrome or chromium binary.  The CHROME_PATH
+// environment variable is used if set, followed by the common binary names
+// on the PATH and the default install locations for the platform.
+func FindExec() (string, error) {
+	if path := os.Getenv("CHROME_PATH"); path != "" {
+		return path, nil
+	}
+
+	for _, name := range []string{
+		"google-chrome",
+		"google-chrome-stable",
+		"chromium",
+		"chromium-browser",
+		"chrome",
+		"chrome-headless-shell",
+		"headless_shell",
+	} {
+		if path, err := exec.LookPath(name); err == nil {
+			return path, nil
+		}
+	}
+
+	for _, path := range installPaths() {
+		if _, err := os.Stat(path); err == nil {
+			return path, nil
+		}
+	}
+	return "", ErrNotFound
+}
+
+func installPaths() []string {
+	switch runtime.GOOS {
+	case "darwin":
+		return []string{
+			"/Applications/Google Chrome.app/Contents/MacOS/Google Chrome",
+			"/Applications/Chromium.app/Contents/MacOS/Chromium",
+		}
+	case "windows":
+		paths := []string{}
+		for _, env := range []string{"ProgramFiles", "ProgramFiles(x86)", "LocalAppData"} {
+			if dir := os.Getenv(env); dir != "" {
+				paths = append(paths, dir+`\Google\Chrome\Application\chrome.exe`)
+			}
+		}
+		return paths
+	}
+	return []string{
+		"/usr/bin/google-chrome",
+		"/usr/bin/chromium",
+		"/usr/bin/chromium-browser",
+		"/snap/bin/chromium",
+	}
+}
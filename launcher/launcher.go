@@ -0,0 +1,481 @@
+// Package launcher starts a chrome process for remote debugging and connects
+// a debugger to it, so that programs need neither a running browser nor a
+// hard-coded debugging port.
+//
+//	chrome, err := launcher.Launch(ctx)
+//	if err != nil {
+//		return err
+//	}
+//	defer chrome.Close()
+//	_, err = page.NavigateParams{URL: "https://example.com"}.Do(ctx, chrome)
+//
+// Chrome is started with --remote-debugging-port=0, so the operating system
+// picks a free port, and the debugging URL is read from the line chrome
+// prints to stderr once it is listening.  WithPipe drives chrome over
+// --remote-debugging-pipe instead, which opens no port at all.
+package launcher
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/tonyhb/chromedebugo"
+)
+
+// DefaultStartTimeout is how long Launch waits for chrome to start listening,
+// and then for it to open its first page, unless the context given has an
+// earlier deadline.
+const DefaultStartTimeout = 30 * time.Second
+
+// listeningPrefix starts the line chrome prints to stderr with the browser's
+// websocket debugger URL.
+const listeningPrefix = "DevTools listening on "
+
+type config struct {
+	execPath    string
+	headless    bool
+	flags       []string
+	userDataDir string
+	startURL    string
+	timeout     time.Duration
+	stderr      io.Writer
+	env         []string
+	pipe        bool
+	debugger    []chromedebugo.Option
+}
+
+// Option configures how Launch starts chrome.
+type Option func(*config)
+
+// WithExecPath sets the chrome binary to run instead of searching for one
+// with FindExec.
+func WithExecPath(path string) Option {
+	return func(c *config) {
+		c.execPath = path
+	}
+}
+
+// WithHeadless sets whether chrome runs headless, which it does by default.
+func WithHeadless(headless bool) Option {
+	return func(c *config) {
+		c.headless = headless
+	}
+}
+
+// WithFlags adds command line flags, such as "--no-sandbox" or
+// "--window-size=1280,720", to those chrome is started with.
+func WithFlags(flags ...string) Option {
+	return func(c *config) {
+		c.flags = append(c.flags, flags...)
+	}
+}
+
+// WithUserDataDir runs chrome with the given profile directory.  Unlike the
+// temporary directory used by default it is not removed on Close.
+func WithUserDataDir(dir string) Option {
+	return func(c *config) {
+		c.userDataDir = dir
+	}
+}
+
+// WithStartURL sets the URL of the page chrome opens at, about:blank by
+// default.
+func WithStartURL(u string) Option {
+	return func(c *config) {
+		c.startURL = u
+	}
+}
+
+// WithStartTimeout sets how long Launch waits for chrome to start listening,
+// and then for it to open its first page.
+func WithStartTimeout(d time.Duration) Option {
+	return func(c *config) {
+		c.timeout = d
+	}
+}
+
+// WithStderr copies chrome's stderr to w, which is useful for diagnosing
+// crashes.  It is discarded by default.
+func WithStderr(w io.Writer) Option {
+	return func(c *config) {
+		c.stderr = w
+	}
+}
+
+// WithEnv sets extra environment variables, in "KEY=value" form, for the
+// chrome process.
+func WithEnv(env ...string) Option {
+	return func(c *config) {
+		c.env = append(c.env, env...)
+	}
+}
+
+// WithPipe drives chrome over --remote-debugging-pipe rather than a TCP
+// port, for sandboxes which forbid listening.  Chrome has no HTTP endpoints
+// then, so BrowserURL and Host are empty and the debugger's Version and Info
+// return chromedebugo.ErrNoHTTPEndpoints.  Pipes are not supported on
+// windows.
+func WithPipe() Option {
+	return func(c *config) {
+		c.pipe = true
+	}
+}
+
+// WithDebuggerOptions sets the options for the debugger connected to
+// chrome's first page.
+func WithDebuggerOptions(opts ...chromedebugo.Option) Option {
+	return func(c *config) {
+		c.debugger = append(c.debugger, opts...)
+	}
+}
+
+// Chrome is a running chrome process with a sync debugger connected to its
+// first page.  Close kills the process and closes the debugger.
+type Chrome struct {
+	chromedebugo.SyncDebugger
+
+	cmd *exec.Cmd
+	// browserURL is the browser target's websocket debugger URL, empty when
+	// using a pipe
+	browserURL string
+	// pipe is the transport to the browser target and browser the debugger
+	// using it, when started WithPipe
+	pipe    chromedebugo.Transport
+	browser chromedebugo.SyncDebugger
+	// dir is the profile directory, removed on Close if temporary
+	dir       string
+	removeDir bool
+
+	// exited is closed once the process has exited, after which waitErr
+	// holds the result of cmd.Wait
+	exited  chan struct{}
+	waitErr error
+
+	closeOnce sync.Once
+	closeErr  error
+}
+
+// Launch starts chrome and connects a debugger to its first page.  The
+// context bounds the start up, but cancelling it afterwards does not stop
+// chrome; use Close.
+func Launch(ctx context.Context, opts ...Option) (*Chrome, error) {
+	c := &config{
+		headless: true,
+		startURL: "about:blank",
+		timeout:  DefaultStartTimeout,
+		stderr:   ioutil.Discard,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	if c.execPath == "" {
+		path, err := FindExec()
+		if err != nil {
+			return nil, err
+		}
+		c.execPath = path
+	}
+
+	chrome := &Chrome{
+		dir:    c.userDataDir,
+		exited: make(chan struct{}),
+	}
+	if chrome.dir == "" {
+		dir, err := ioutil.TempDir("", "chromedebugo")
+		if err != nil {
+			return nil, err
+		}
+		chrome.dir = dir
+		chrome.removeDir = true
+	}
+
+	if err := chrome.start(ctx, c); err != nil {
+		chrome.Close()
+		return nil, err
+	}
+
+	if c.pipe {
+		if err := chrome.attachPipe(ctx, c); err != nil {
+			chrome.Close()
+			return nil, err
+		}
+		return chrome, nil
+	}
+
+	host := chromedebugo.HTTPHost(chrome.browserURL)
+	if err := waitForPage(ctx, host, c); err != nil {
+		chrome.Close()
+		return nil, err
+	}
+	sd, err := chromedebugo.NewSync(host, c.debugger...)
+	if err != nil {
+		chrome.Close()
+		return nil, err
+	}
+	chrome.SyncDebugger = sd
+	return chrome, nil
+}
+
+// attachPipe connects to the browser target over the pipe and attaches a
+// session to chrome's first page, waiting for the page to be created.
+func (chrome *Chrome) attachPipe(ctx context.Context, c *config) error {
+	bd := chromedebugo.NewBrowserSyncTransport(chrome.pipe)
+	chrome.browser = bd
+
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+	for {
+		cmd, _ := chromedebugo.NewCommand("Target.getTargets", nil)
+		res, err := bd.SendContext(ctx, cmd)
+		if err != nil {
+			return fmt.Errorf("error listing chrome's targets: %s", err)
+		}
+		ret := struct {
+			TargetInfos []struct {
+				TargetID string `json:"targetId"`
+				Type     string `json:"type"`
+			} `json:"targetInfos"`
+		}{}
+		if err := res.Decode(&ret); err != nil {
+			return err
+		}
+		for _, t := range ret.TargetInfos {
+			if t.Type == "page" {
+				sd, err := bd.AttachSync(t.TargetID, c.debugger...)
+				if err != nil {
+					return err
+				}
+				chrome.SyncDebugger = sd
+				return nil
+			}
+		}
+
+		select {
+		case <-time.After(50 * time.Millisecond):
+		case <-ctx.Done():
+			return fmt.Errorf("chrome opened no page: %s", ctx.Err())
+		}
+	}
+}
+
+// args returns the command line chrome is started with.
+func (c *config) args(dir string) []string {
+	debugging := "--remote-debugging-port=0"
+	if c.pipe {
+		debugging = "--remote-debugging-pipe"
+	}
+	args := []string{
+		debugging,
+		"--user-data-dir=" + dir,
+		"--no-first-run",
+		"--no-default-browser-check",
+	}
+	if c.headless {
+		args = append(args, "--headless")
+	}
+	args = append(args, c.flags...)
+	return append(args, c.startURL)
+}
+
+// start runs chrome and waits for it to print its debugger URL, or when
+// using a pipe only for it to start.
+func (chrome *Chrome) start(ctx context.Context, c *config) error {
+	cmd := exec.Command(c.execPath, c.args(chrome.dir)...)
+	cmd.Env = append(os.Environ(), c.env...)
+	setProcessGroup(cmd)
+	stderr, err := cmd.StderrPipe()
+	if err != nil {
+		return err
+	}
+
+	// chrome reads commands from fd 3 and writes to fd 4, which are the
+	// first of ExtraFiles
+	var childFiles []*os.File
+	if c.pipe {
+		chromeIn, toChrome, err := os.Pipe()
+		if err != nil {
+			return err
+		}
+		fromChrome, chromeOut, err := os.Pipe()
+		if err != nil {
+			chromeIn.Close()
+			toChrome.Close()
+			return err
+		}
+		childFiles = []*os.File{chromeIn, chromeOut}
+		cmd.ExtraFiles = childFiles
+		chrome.pipe = chromedebugo.NewPipeTransport(fromChrome, toChrome)
+	}
+
+	err = cmd.Start()
+	// chrome has its own copies of its ends of the pipes, and ours must be
+	// closed for reads to see chrome exit
+	for _, f := range childFiles {
+		f.Close()
+	}
+	if err != nil {
+		return fmt.Errorf("error starting chrome: %s", err)
+	}
+	chrome.cmd = cmd
+
+	// found receives the debugger URL, or is closed if chrome's stderr
+	// ends without it
+	found := make(chan string, 1)
+	// output keeps what chrome printed before listening, to explain
+	// failures
+	output := &lockedBuffer{}
+	go func() {
+		scanner := bufio.NewScanner(stderr)
+		for scanner.Scan() {
+			line := scanner.Text()
+			if !c.pipe && strings.HasPrefix(line, listeningPrefix) {
+				found <- strings.TrimSpace(strings.TrimPrefix(line, listeningPrefix))
+				break
+			}
+			output.WriteLine(line)
+			fmt.Fprintln(c.stderr, line)
+		}
+		close(found)
+		// keep draining stderr so that chrome never blocks writing to it
+		io.Copy(c.stderr, stderr)
+		chrome.waitErr = cmd.Wait()
+		close(chrome.exited)
+	}()
+
+	if c.pipe {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+	select {
+	case u, ok := <-found:
+		if !ok {
+			<-chrome.exited
+			return fmt.Errorf("chrome exited before listening (%v): %s", chrome.waitErr, output)
+		}
+		chrome.browserURL = u
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("chrome did not start listening: %s: %s", ctx.Err(), output)
+	}
+}
+
+// BrowserURL returns the browser target's websocket debugger URL, which can
+// be used with chromedebugo.NewBrowserSync to attach to other targets.
+func (chrome *Chrome) BrowserURL() string {
+	return chrome.browserURL
+}
+
+// Host returns the address of chrome's HTTP endpoints, for use with
+// chromedebugo.NewBrowser or to connect further debuggers.
+func (chrome *Chrome) Host() string {
+	if chrome.browserURL == "" {
+		return ""
+	}
+	return chromedebugo.HTTPHost(chrome.browserURL)
+}
+
+// Exited returns a channel which is closed once the chrome process exits.
+func (chrome *Chrome) Exited() <-chan struct{} {
+	return chrome.exited
+}
+
+// Close kills chrome's process group, waits for chrome to exit, closes the
+// debugger and removes its temporary profile directory.
+func (chrome *Chrome) Close() error {
+	chrome.closeOnce.Do(func() {
+		// chrome is killed first, as closing a debugger attached over the
+		// pipe waits for chrome to detach it, which a hung chrome never
+		// does
+		if chrome.cmd != nil {
+			select {
+			case <-chrome.exited:
+			default:
+				if err := killProcessGroup(chrome.cmd); err != nil {
+					chrome.closeErr = fmt.Errorf("error killing chrome: %s", err)
+				}
+			}
+			<-chrome.exited
+		}
+		if chrome.SyncDebugger != nil {
+			chrome.SyncDebugger.Close()
+		}
+		if chrome.browser != nil {
+			chrome.browser.Close()
+		} else if chrome.pipe != nil {
+			chrome.pipe.Close()
+		}
+		if chrome.removeDir {
+			if err := os.RemoveAll(chrome.dir); err != nil && chrome.closeErr == nil {
+				chrome.closeErr = err
+			}
+		}
+	})
+	return chrome.closeErr
+}
+
+// waitForPage polls chrome's targets until it has opened a page.  Chrome
+// starts listening before it opens the start URL, and there is no target
+// for a debugger to connect to until it does.
+func waitForPage(ctx context.Context, host string, c *config) error {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+	browser := chromedebugo.NewBrowser(host, &http.Client{Transport: contextTransport{ctx}})
+	for {
+		targets, err := browser.List()
+		for _, t := range targets {
+			if t.Type == "page" {
+				return nil
+			}
+		}
+
+		select {
+		case <-time.After(50 * time.Millisecond):
+		case <-ctx.Done():
+			if err != nil {
+				return fmt.Errorf("chrome opened no page: %w (last error: %s)", ctx.Err(), err)
+			}
+			return fmt.Errorf("chrome opened no page: %w", ctx.Err())
+		}
+	}
+}
+
+// contextTransport sends HTTP requests with ctx, so that they are cancelled
+// with it.
+type contextTransport struct {
+	ctx context.Context
+}
+
+func (t contextTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	return http.DefaultTransport.RoundTrip(req.WithContext(t.ctx))
+}
+
+// lockedBuffer collects lines from the goroutine reading stderr for use in
+// errors.
+type lockedBuffer struct {
+	lock  sync.Mutex
+	lines []string
+}
+
+func (b *lockedBuffer) WriteLine(line string) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	b.lines = append(b.lines, line)
+}
+
+func (b *lockedBuffer) String() string {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	return strings.Join(b.lines, "\n")
+}
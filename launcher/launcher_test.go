@@ -0,0 +1,197 @@
+//go:build !windows
+// +build !windows
+
+package launcher_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/tonyhb/chromedebugo"
+	"github.com/tonyhb/chromedebugo/cdptest"
+	"github.com/tonyhb/chromedebugo/launcher"
+)
+
+// fakePipeChromeEnv makes the test binary act as chrome started WithPipe.
+const fakePipeChromeEnv = "CHROMEDEBUGO_FAKE_PIPE_CHROME"
+
+func TestMain(m *testing.M) {
+	if os.Getenv(fakePipeChromeEnv) != "" {
+		fakePipeChrome()
+		os.Exit(0)
+	}
+	os.Exit(m.Run())
+}
+
+// fakePipeChrome answers commands on the pipes chrome is given as fds 3 and
+// 4, with one page to attach to.  It never replies to
+// Target.detachFromTarget, as a hung chrome wouldn't.
+func fakePipeChrome() {
+	t := chromedebugo.NewPipeTransport(os.NewFile(3, "in"), os.NewFile(4, "out"))
+	for {
+		msg, err := t.ReadMessage()
+		if err != nil {
+			return
+		}
+		cmd := struct {
+			ID        int    `json:"id"`
+			Method    string `json:"method"`
+			SessionID string `json:"sessionId,omitempty"`
+		}{}
+		if err := json.Unmarshal(msg, &cmd); err != nil {
+			return
+		}
+		result := map[string]interface{}{}
+		switch cmd.Method {
+		case "Target.getTargets":
+			result["targetInfos"] = []map[string]interface{}{{"targetId": "page-1", "type": "page"}}
+		case "Target.attachToTarget":
+			result["sessionId"] = "session-1"
+		case "Target.detachFromTarget":
+			continue
+		}
+		reply, _ := json.Marshal(map[string]interface{}{"id": cmd.ID, "sessionId": cmd.SessionID, "result": result})
+		if err := t.WriteMessage(reply); err != nil {
+			return
+		}
+	}
+}
+
+// fakeChrome writes a script which prints the line chrome prints once it is
+// listening, with srv's browser URL, and then waits to be killed.
+func fakeChrome(t *testing.T, srv *cdptest.Server) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "chrome")
+	script := fmt.Sprintf("#!/bin/sh\necho starting >&2\necho 'DevTools listening on %s' >&2\nexec sleep 60\n", srv.BrowserURL())
+	if err := ioutil.WriteFile(path, []byte(script), 0755); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestLaunch(t *testing.T) {
+	srv := cdptest.NewServer()
+	defer srv.Close()
+	srv.Handle("Page.enable", cdptest.Result(nil))
+	// chrome opens its first page after it starts listening
+	srv.SetTargets()
+	go func() {
+		time.Sleep(200 * time.Millisecond)
+		srv.SetTargets(chromedebugo.Info{Id: "page-1", Type: "page", URL: "about:blank"})
+	}()
+
+	dir := t.TempDir()
+	stderr := &strings.Builder{}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	chrome, err := launcher.Launch(ctx,
+		launcher.WithExecPath(fakeChrome(t, srv)),
+		launcher.WithUserDataDir(dir),
+		launcher.WithStderr(stderr),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer chrome.Close()
+
+	if chrome.BrowserURL() != srv.BrowserURL() {
+		t.Errorf("got browser URL %s, want %s", chrome.BrowserURL(), srv.BrowserURL())
+	}
+	if chrome.Host() != srv.URL() {
+		t.Errorf("got host %s, want %s", chrome.Host(), srv.URL())
+	}
+	if _, err := chrome.Send(chromedebugo.Command{Method: "Page.enable"}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := chrome.Close(); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case <-chrome.Exited():
+	default:
+		t.Error("chrome still running after Close")
+	}
+	if !strings.Contains(stderr.String(), "starting") {
+		t.Errorf("chrome's output %q not copied to stderr", stderr)
+	}
+	if _, err := os.Stat(dir); err != nil {
+		t.Errorf("user data dir given was removed: %s", err)
+	}
+}
+
+func TestLaunchNoPage(t *testing.T) {
+	srv := cdptest.NewServer()
+	defer srv.Close()
+	srv.SetTargets()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	_, err := launcher.Launch(ctx,
+		launcher.WithExecPath(fakeChrome(t, srv)),
+		launcher.WithStartTimeout(200*time.Millisecond),
+	)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("got %v, want %v", err, context.DeadlineExceeded)
+	}
+	if srv.Connections() != 0 {
+		t.Error("connected with no page to connect to")
+	}
+}
+
+func TestLaunchExits(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "chrome")
+	if err := ioutil.WriteFile(path, []byte("#!/bin/sh\necho 'cannot open display' >&2\nexit 1\n"), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err := launcher.Launch(context.Background(), launcher.WithExecPath(path))
+	if err == nil || !strings.Contains(err.Error(), "cannot open display") {
+		t.Fatalf("got %v, want an error with chrome's output", err)
+	}
+}
+
+func TestLaunchPipe(t *testing.T) {
+	exe, err := os.Executable()
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv(fakePipeChromeEnv, "1")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	chrome, err := launcher.Launch(ctx, launcher.WithExecPath(exe), launcher.WithPipe())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if chrome.Host() != "" {
+		t.Errorf("got host %q with no HTTP endpoints", chrome.Host())
+	}
+	if _, err := chrome.Send(chromedebugo.Command{Method: "Page.enable"}); err != nil {
+		t.Fatal(err)
+	}
+
+	closed := make(chan error, 1)
+	go func() { closed <- chrome.Close() }()
+	select {
+	case err := <-closed:
+		if err != nil {
+			t.Fatal(err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Close blocked detaching from a chrome which doesn't reply")
+	}
+	select {
+	case <-chrome.Exited():
+	default:
+		t.Error("chrome still running after Close")
+	}
+}
@@ -0,0 +1,20 @@
+//go:build !windows
+// +build !windows
+
+package launcher
+
+import (
+	"os/exec"
+	"syscall"
+)
+
+// setProcessGroup starts chrome in its own process group so that its helper
+// processes can be killed along with it.
+func setProcessGroup(cmd *exec.Cmd) {
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+}
+
+// killProcessGroup kills chrome and every process in its group.
+func killProcessGroup(cmd *exec.Cmd) error {
+	return syscall.Kill(-cmd.Process.Pid, syscall.SIGKILL)
+}
@@ -0,0 +1,16 @@
+//go:build windows
+// +build windows
+
+package launcher
+
+import (
+	"os/exec"
+)
+
+func setProcessGroup(cmd *exec.Cmd) {}
+
+// killProcessGroup kills chrome.  Its helper processes exit once the browser
+// process has gone.
+func killProcessGroup(cmd *exec.Cmd) error {
+	return cmd.Process.Kill()
+}
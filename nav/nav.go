@@ -0,0 +1,180 @@
+// Package nav navigates pages and waits for them to load:
+//
+//	_, err := nav.Navigate(ctx, debugger, "https://example.com", nav.WaitUntil(nav.NetworkIdle))
+//
+// Waiting follows the page's lifecycle events for the document the
+// navigation loads, correlated by frame and loader ID, so it is not confused
+// by events from the previous document or from other frames.
+package nav
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/tonyhb/chromedebugo"
+	"github.com/tonyhb/chromedebugo/protocol/network"
+	"github.com/tonyhb/chromedebugo/protocol/page"
+)
+
+// Lifecycle is the name of a page lifecycle event to wait for.
+type Lifecycle string
+
+const (
+	// DOMContentLoaded waits for the document to be parsed.
+	DOMContentLoaded Lifecycle = "DOMContentLoaded"
+	// Load waits for the document and its resources, such as images, to
+	// load.  This is the default.
+	Load Lifecycle = "load"
+	// NetworkAlmostIdle waits until there have been at most two network
+	// connections for 500ms.
+	NetworkAlmostIdle Lifecycle = "networkAlmostIdle"
+	// NetworkIdle waits until there have been no network connections for
+	// 500ms.
+	NetworkIdle Lifecycle = "networkIdle"
+)
+
+// ErrNoHistory is returned by GoBack and GoForward when there is no entry to
+// go to.
+var ErrNoHistory = errors.New("no navigation history entry to go to")
+
+// NavigationError is returned when chrome fails to navigate, eg. because the
+// host can't be resolved.
+type NavigationError struct {
+	URL string
+	// ErrorText is chrome's description of the failure, such as
+	// "net::ERR_NAME_NOT_RESOLVED"
+	ErrorText string
+}
+
+func (e NavigationError) Error() string {
+	return fmt.Sprintf("error navigating to %s: %s", e.URL, e.ErrorText)
+}
+
+type config struct {
+	until       Lifecycle
+	referrer    string
+	ignoreCache bool
+}
+
+// Option configures a navigation.
+type Option func(*config)
+
+// WaitUntil sets the lifecycle event which ends the wait for the new
+// document.
+func WaitUntil(event Lifecycle) Option {
+	return func(c *config) {
+		c.until = event
+	}
+}
+
+// WithReferrer sets the referrer URL for Navigate.
+func WithReferrer(referrer string) Option {
+	return func(c *config) {
+		c.referrer = referrer
+	}
+}
+
+// WithIgnoreCache makes Reload bypass the cache, as a shift-reload does.
+func WithIgnoreCache() Option {
+	return func(c *config) {
+		c.ignoreCache = true
+	}
+}
+
+func newConfig(opts []Option) *config {
+	c := &config{until: Load}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// Navigate navigates the page's main frame to url and waits for the new
+// document to reach the lifecycle event given by WaitUntil.  Navigation
+// failures are returned as NavigationError.  Navigating within the document,
+// eg. to a fragment, and downloads return without waiting.
+func Navigate(ctx context.Context, d chromedebugo.SyncDebugger, url string, opts ...Option) (*page.NavigateReturns, error) {
+	c := newConfig(opts)
+	w, err := watch(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+	defer w.close()
+
+	ret, err := page.NavigateParams{URL: url, Referrer: c.referrer}.Do(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+	if ret.ErrorText != "" {
+		return ret, NavigationError{URL: url, ErrorText: ret.ErrorText}
+	}
+	if ret.LoaderID == "" || ret.IsDownload {
+		// no new document is loaded
+		return ret, nil
+	}
+	return ret, w.wait(ctx, ret.FrameID, c.until, func(loaderID network.LoaderID) bool {
+		return loaderID == ret.LoaderID
+	})
+}
+
+// Reload reloads the page and waits for the new document as Navigate does.
+func Reload(ctx context.Context, d chromedebugo.SyncDebugger, opts ...Option) error {
+	c := newConfig(opts)
+	return reloadWith(ctx, d, c, func() error {
+		return page.ReloadParams{IgnoreCache: c.ignoreCache}.Do(ctx, d)
+	})
+}
+
+// GoBack navigates to the previous entry in the page's history and waits as
+// Navigate does.  It returns ErrNoHistory on the first entry.
+func GoBack(ctx context.Context, d chromedebugo.SyncDebugger, opts ...Option) error {
+	return goHistory(ctx, d, -1, opts)
+}
+
+// GoForward navigates to the next entry in the page's history and waits as
+// Navigate does.  It returns ErrNoHistory on the last entry.
+func GoForward(ctx context.Context, d chromedebugo.SyncDebugger, opts ...Option) error {
+	return goHistory(ctx, d, 1, opts)
+}
+
+func goHistory(ctx context.Context, d chromedebugo.SyncDebugger, delta int64, opts []Option) error {
+	history, err := page.GetNavigationHistoryParams{}.Do(ctx, d)
+	if err != nil {
+		return err
+	}
+	i := history.CurrentIndex + delta
+	if i < 0 || i >= int64(len(history.Entries)) {
+		return ErrNoHistory
+	}
+	entry := history.Entries[i]
+	return reloadWith(ctx, d, newConfig(opts), func() error {
+		return page.NavigateToHistoryEntryParams{EntryID: entry.ID}.Do(ctx, d)
+	})
+}
+
+// reloadWith runs a command which replaces the main frame's document without
+// reporting the new loader ID, and waits for the document from any loader
+// but the current one.  Navigations within the document, such as history
+// entries made by pushState, end the wait too.
+func reloadWith(ctx context.Context, d chromedebugo.SyncDebugger, c *config, navigate func() error) error {
+	tree, err := page.GetFrameTreeParams{}.Do(ctx, d)
+	if err != nil {
+		return err
+	}
+	frame := tree.FrameTree.Frame
+
+	w, err := watch(ctx, d)
+	if err != nil {
+		return err
+	}
+	defer w.close()
+
+	if err := navigate(); err != nil {
+		return err
+	}
+	w.withinDocument = true
+	return w.wait(ctx, frame.ID, c.until, func(loaderID network.LoaderID) bool {
+		return loaderID != frame.LoaderID
+	})
+}
@@ -0,0 +1,210 @@
+package nav_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/tonyhb/chromedebugo"
+	"github.com/tonyhb/chromedebugo/cdptest"
+	"github.com/tonyhb/chromedebugo/nav"
+)
+
+// lifecycle returns a lifecycle event's params.
+func lifecycle(frameID, loaderID, name string) map[string]interface{} {
+	return map[string]interface{}{"frameId": frameID, "loaderId": loaderID, "name": name, "timestamp": 1}
+}
+
+// emitting returns a handler which sends events, each a method and its
+// params, before responding with result.
+func emitting(srv *cdptest.Server, result interface{}, events ...interface{}) cdptest.Handler {
+	return func(chromedebugo.Command) cdptest.Response {
+		for i := 0; i < len(events); i += 2 {
+			srv.Emit(events[i].(string), events[i+1])
+		}
+		return cdptest.Response{Result: result}
+	}
+}
+
+// newPage returns a fake page showing a document from loader-1 in frame-1,
+// and a debugger connected to it.
+func newPage(t *testing.T) (*cdptest.Server, chromedebugo.SyncDebugger) {
+	t.Helper()
+	srv := cdptest.NewServer()
+	t.Cleanup(srv.Close)
+	srv.Handle("Page.enable", cdptest.Result(nil))
+	srv.Handle("Page.setLifecycleEventsEnabled", cdptest.Result(nil))
+	srv.Handle("Page.getFrameTree", cdptest.Result(map[string]interface{}{
+		"frameTree": map[string]interface{}{
+			"frame": map[string]interface{}{"id": "frame-1", "loaderId": "loader-1", "url": "https://example.com/"},
+		},
+	}))
+	d, err := chromedebugo.NewSync(srv.URL())
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { d.Close() })
+	return srv, d
+}
+
+func TestNavigate(t *testing.T) {
+	srv, d := newPage(t)
+	srv.Handle("Page.navigate", emitting(srv,
+		map[string]interface{}{"frameId": "frame-1", "loaderId": "loader-2"},
+		// the previous document, and another frame, reaching load don't
+		// end the wait
+		"Page.lifecycleEvent", lifecycle("frame-1", "loader-1", "load"),
+		"Page.lifecycleEvent", lifecycle("frame-2", "loader-2", "load"),
+		"Page.lifecycleEvent", lifecycle("frame-1", "loader-2", "DOMContentLoaded"),
+	))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+	_, err := nav.Navigate(ctx, d, "https://example.com/next")
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("got %v, want %v", err, context.DeadlineExceeded)
+	}
+	if !strings.Contains(err.Error(), "last lifecycle event: DOMContentLoaded") {
+		t.Errorf("error %q does not describe the last lifecycle event", err)
+	}
+
+	srv.AssertReceived(t, "Page.enable")
+	cmd := srv.AssertReceived(t, "Page.setLifecycleEventsEnabled")
+	if cmd.Params["enabled"] != true {
+		t.Errorf("lifecycle events not enabled: %v", cmd.Params)
+	}
+	cmd = srv.AssertReceived(t, "Page.navigate")
+	if cmd.Params["url"] != "https://example.com/next" {
+		t.Errorf("navigated to %v", cmd.Params["url"])
+	}
+}
+
+func TestNavigateWaitUntil(t *testing.T) {
+	srv, d := newPage(t)
+	srv.Handle("Page.navigate", emitting(srv,
+		map[string]interface{}{"frameId": "frame-1", "loaderId": "loader-2"},
+		"Page.lifecycleEvent", lifecycle("frame-1", "loader-2", "DOMContentLoaded"),
+		"Page.lifecycleEvent", lifecycle("frame-1", "loader-2", "load"),
+		"Page.lifecycleEvent", lifecycle("frame-1", "loader-1", "networkIdle"),
+		"Page.lifecycleEvent", lifecycle("frame-1", "loader-2", "networkIdle"),
+	))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	for _, until := range []nav.Lifecycle{nav.DOMContentLoaded, nav.Load, nav.NetworkIdle} {
+		ret, err := nav.Navigate(ctx, d, "https://example.com/next", nav.WaitUntil(until), nav.WithReferrer("https://example.com/"))
+		if err != nil {
+			t.Fatalf("waiting for %s: %s", until, err)
+		}
+		if ret.LoaderID != "loader-2" {
+			t.Errorf("got %+v", ret)
+		}
+	}
+	if cmd := srv.AssertReceived(t, "Page.navigate"); cmd.Params["referrer"] != "https://example.com/" {
+		t.Errorf("got referrer %v", cmd.Params["referrer"])
+	}
+}
+
+func TestNavigateWithoutDocument(t *testing.T) {
+	tests := []struct {
+		name   string
+		result map[string]interface{}
+		err    error
+	}{
+		{"within document", map[string]interface{}{"frameId": "frame-1"}, nil},
+		{"download", map[string]interface{}{"frameId": "frame-1", "loaderId": "loader-2", "isDownload": true}, nil},
+		{"failed", map[string]interface{}{"frameId": "frame-1", "loaderId": "loader-2", "errorText": "net::ERR_NAME_NOT_RESOLVED"},
+			nav.NavigationError{URL: "https://example.invalid/", ErrorText: "net::ERR_NAME_NOT_RESOLVED"}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			srv, d := newPage(t)
+			srv.Handle("Page.navigate", cdptest.Result(test.result))
+
+			// there are no lifecycle events, so this would time out
+			// if it waited
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			_, err := nav.Navigate(ctx, d, "https://example.invalid/")
+			if err != test.err {
+				t.Errorf("got %v, want %v", err, test.err)
+			}
+		})
+	}
+}
+
+func TestReload(t *testing.T) {
+	srv, d := newPage(t)
+	srv.Handle("Page.reload", emitting(srv, nil,
+		"Page.lifecycleEvent", lifecycle("frame-1", "loader-1", "load"),
+		"Page.lifecycleEvent", lifecycle("frame-1", "loader-2", "load"),
+	))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := nav.Reload(ctx, d, nav.WithIgnoreCache()); err != nil {
+		t.Fatal(err)
+	}
+	if cmd := srv.AssertReceived(t, "Page.reload"); cmd.Params["ignoreCache"] != true {
+		t.Errorf("reloaded with %v", cmd.Params)
+	}
+}
+
+func TestReloadIgnoresCurrentDocument(t *testing.T) {
+	srv, d := newPage(t)
+	srv.Handle("Page.reload", emitting(srv, nil,
+		"Page.lifecycleEvent", lifecycle("frame-1", "loader-1", "load"),
+	))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+	if err := nav.Reload(ctx, d); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("got %v, want %v", err, context.DeadlineExceeded)
+	}
+}
+
+func TestGoBackAndForward(t *testing.T) {
+	srv, d := newPage(t)
+	srv.Handle("Page.getNavigationHistory", cdptest.Result(map[string]interface{}{
+		"currentIndex": 0,
+		"entries": []map[string]interface{}{
+			{"id": 1, "url": "https://example.com/"},
+			{"id": 2, "url": "https://example.com/#next"},
+		},
+	}))
+	// the next entry was made by pushState, so going to it stays within
+	// the document
+	srv.Handle("Page.navigateToHistoryEntry", emitting(srv, nil,
+		"Page.navigatedWithinDocument", map[string]interface{}{"frameId": "frame-2", "url": "https://example.com/other"},
+		"Page.navigatedWithinDocument", map[string]interface{}{"frameId": "frame-1", "url": "https://example.com/#next"},
+	))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := nav.GoBack(ctx, d); err != nav.ErrNoHistory {
+		t.Errorf("got %v, want %v", err, nav.ErrNoHistory)
+	}
+	if err := nav.GoForward(ctx, d); err != nil {
+		t.Fatal(err)
+	}
+	if cmd := srv.AssertReceived(t, "Page.navigateToHistoryEntry"); cmd.Params["entryId"] != float64(2) {
+		t.Errorf("went to %v", cmd.Params)
+	}
+}
+
+func TestNavigateCanceled(t *testing.T) {
+	srv, d := newPage(t)
+	srv.Handle("Page.navigate", cdptest.Result(map[string]interface{}{"frameId": "frame-1", "loaderId": "loader-2"}))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(100*time.Millisecond, cancel)
+	_, err := nav.Navigate(ctx, d, "https://example.com/next")
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("got %v, want %v", err, context.Canceled)
+	}
+	if strings.Contains(err.Error(), "timed out") {
+		t.Errorf("error %q says the wait timed out", err)
+	}
+}
@@ -0,0 +1,89 @@
+package nav
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tonyhb/chromedebugo"
+	"github.com/tonyhb/chromedebugo/protocol/network"
+	"github.com/tonyhb/chromedebugo/protocol/page"
+)
+
+// watcher follows the lifecycle of the page's frames.  It subscribes before
+// the navigation is started so that no event is missed.
+type watcher struct {
+	lifecycle   <-chan chromedebugo.Command
+	within      <-chan chromedebugo.Command
+	unsubscribe []func()
+
+	// withinDocument ends the wait on a navigation within the document
+	withinDocument bool
+}
+
+// watch subscribes to lifecycle events and makes sure chrome sends them.
+func watch(ctx context.Context, d chromedebugo.SyncDebugger) (*watcher, error) {
+	w := &watcher{}
+	var unsubscribe func()
+	w.lifecycle, unsubscribe = d.Subscribe(page.EventLifecycleEvent, chromedebugo.WithBuffer(1024))
+	w.unsubscribe = append(w.unsubscribe, unsubscribe)
+	w.within, unsubscribe = d.Subscribe(page.EventNavigatedWithinDocument)
+	w.unsubscribe = append(w.unsubscribe, unsubscribe)
+
+	// both are idempotent, so they are sent for every navigation rather
+	// than tracking whether they have been
+	if err := (page.EnableParams{}).Do(ctx, d); err != nil {
+		w.close()
+		return nil, err
+	}
+	if err := (page.SetLifecycleEventsEnabledParams{Enabled: true}).Do(ctx, d); err != nil {
+		w.close()
+		return nil, err
+	}
+	return w, nil
+}
+
+func (w *watcher) close() {
+	for _, unsubscribe := range w.unsubscribe {
+		unsubscribe()
+	}
+}
+
+// wait waits for the lifecycle event until in the frame with frameID, from a
+// loader accepted by loader.
+func (w *watcher) wait(ctx context.Context, frameID page.FrameID, until Lifecycle, loader func(network.LoaderID) bool) error {
+	// last is the last lifecycle event seen for the new document, to
+	// explain waits ended by ctx
+	last := "none"
+	for {
+		select {
+		case cmd, ok := <-w.lifecycle:
+			if !ok {
+				return fmt.Errorf("error waiting for %s: event subscription closed", until)
+			}
+			ev := page.LifecycleEventEvent{}
+			if err := cmd.Decode(&ev); err != nil {
+				return err
+			}
+			if ev.FrameID != frameID || !loader(ev.LoaderID) {
+				continue
+			}
+			last = ev.Name
+			if ev.Name == string(until) {
+				return nil
+			}
+		case cmd, ok := <-w.within:
+			if !ok {
+				return fmt.Errorf("error waiting for %s: event subscription closed", until)
+			}
+			ev := page.NavigatedWithinDocumentEvent{}
+			if err := cmd.Decode(&ev); err != nil {
+				return err
+			}
+			if w.withinDocument && ev.FrameID == frameID {
+				return nil
+			}
+		case <-ctx.Done():
+			return fmt.Errorf("error waiting for %s (last lifecycle event: %s): %w", until, last, ctx.Err())
+		}
+	}
+}
@@ -0,0 +1,264 @@
+// Package netidle tracks a page's network requests so that callers can wait
+// for the page to settle:
+//
+//	tracker, err := netidle.New(ctx, debugger, netidle.WithIgnoreURL(analytics))
+//	if err != nil {
+//		return err
+//	}
+//	defer tracker.Close()
+//	...
+//	err = tracker.WaitForNetworkIdle(ctx, 0, 500*time.Millisecond)
+//
+// Requests are in flight from Network.requestWillBeSent until
+// Network.loadingFinished or Network.loadingFailed.  Websockets are in flight
+// from Network.webSocketCreated until their handshake completes, as an open
+// websocket would otherwise keep the page from ever being idle.
+package netidle
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sync"
+	"time"
+
+	"github.com/tonyhb/chromedebugo"
+	"github.com/tonyhb/chromedebugo/protocol/network"
+)
+
+type config struct {
+	ignore []func(url string) bool
+}
+
+// Option configures a Tracker.
+type Option func(*config)
+
+// WithIgnoreURL ignores requests whose URL matches re, such as long-polling
+// or analytics requests which never settle.
+func WithIgnoreURL(re *regexp.Regexp) Option {
+	return WithIgnore(re.MatchString)
+}
+
+// WithIgnore ignores requests whose URL ignore returns true for.
+func WithIgnore(ignore func(url string) bool) Option {
+	return func(c *config) {
+		c.ignore = append(c.ignore, ignore)
+	}
+}
+
+// Tracker counts the network requests in flight for a page.
+type Tracker struct {
+	config config
+
+	// lock guards inflight and waiters, which are updated by the goroutine
+	// consuming network events
+	lock     sync.Mutex
+	inflight map[network.RequestID]string
+	waiters  map[*waiter]struct{}
+
+	unsubscribe func()
+	done        chan struct{}
+}
+
+// waiter is a call to WaitForNetworkIdle.
+type waiter struct {
+	max int
+	// idleSince is when the requests in flight last fell to max or fewer,
+	// and zero while there are more
+	idleSince time.Time
+	// wake is signalled on every change
+	wake chan struct{}
+}
+
+// New starts tracking the requests of the page d is connected to, enabling
+// the Network domain.  Only requests made after New returns are tracked.
+func New(ctx context.Context, d chromedebugo.SyncDebugger, opts ...Option) (*Tracker, error) {
+	t := &Tracker{
+		inflight: map[network.RequestID]string{},
+		waiters:  map[*waiter]struct{}{},
+		done:     make(chan struct{}),
+	}
+	for _, opt := range opts {
+		opt(&t.config)
+	}
+
+	// counts are only right if no event is dropped, and handling an
+	// event is quick, so blocking the connection is not a concern
+	events, unsubscribe := d.Subscribe("Network.*", chromedebugo.WithOverflow(chromedebugo.Block))
+	t.unsubscribe = unsubscribe
+	go t.consume(events)
+
+	if err := (network.EnableParams{}).Do(ctx, d); err != nil {
+		t.Close()
+		return nil, err
+	}
+	return t, nil
+}
+
+// Close stops tracking.  Waits in progress fail.
+func (t *Tracker) Close() {
+	t.unsubscribe()
+	<-t.done
+}
+
+// Inflight returns the number of requests in flight.
+func (t *Tracker) Inflight() int {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	return len(t.inflight)
+}
+
+// WaitForNetworkIdle waits until there have been at most maxInflight
+// requests in flight for the quiet period.  The quiet period starts no
+// earlier than the call, so the network must stay quiet for all of it
+// afterwards.  When ctx is done the error lists the requests still in
+// flight.
+func (t *Tracker) WaitForNetworkIdle(ctx context.Context, maxInflight int, quiet time.Duration) error {
+	w := &waiter{max: maxInflight, wake: make(chan struct{}, 1)}
+	t.lock.Lock()
+	if len(t.inflight) <= maxInflight {
+		w.idleSince = time.Now()
+	}
+	t.waiters[w] = struct{}{}
+	t.lock.Unlock()
+	defer func() {
+		t.lock.Lock()
+		delete(t.waiters, w)
+		t.lock.Unlock()
+	}()
+
+	timer := time.NewTimer(quiet)
+	defer timer.Stop()
+	for {
+		t.lock.Lock()
+		idleSince := w.idleSince
+		t.lock.Unlock()
+
+		// the timer fires when the quiet period would end, if nothing
+		// changes meanwhile
+		if !timer.Stop() {
+			select {
+			case <-timer.C:
+			default:
+			}
+		}
+		if !idleSince.IsZero() {
+			remaining := quiet - time.Since(idleSince)
+			if remaining <= 0 {
+				return nil
+			}
+			timer.Reset(remaining)
+		}
+
+		select {
+		case <-w.wake:
+		case <-timer.C:
+		case <-t.done:
+			return fmt.Errorf("error waiting for network idle: tracker closed")
+		case <-ctx.Done():
+			return fmt.Errorf("error waiting for network idle with %s: %w", t.describe(), ctx.Err())
+		}
+	}
+}
+
+// describe lists the requests in flight for errors.
+func (t *Tracker) describe() string {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	if len(t.inflight) == 0 {
+		return "no requests in flight"
+	}
+	urls := make([]string, 0, len(t.inflight))
+	for _, url := range t.inflight {
+		urls = append(urls, url)
+	}
+	return fmt.Sprintf("%d requests in flight: %v", len(urls), urls)
+}
+
+// consume updates the requests in flight from network events until the
+// subscription closes.
+func (t *Tracker) consume(events <-chan chromedebugo.Command) {
+	defer close(t.done)
+	for cmd := range events {
+		ev := struct {
+			RequestID network.RequestID `json:"requestId"`
+			URL       string            `json:"url"`
+			Request   struct {
+				URL string `json:"url"`
+			} `json:"request"`
+		}{}
+		switch cmd.Method {
+		case network.EventRequestWillBeSent:
+			if cmd.Decode(&ev) == nil {
+				t.start(ev.RequestID, ev.Request.URL)
+			}
+		case network.EventWebSocketCreated:
+			if cmd.Decode(&ev) == nil {
+				t.start(ev.RequestID, ev.URL)
+			}
+		case network.EventLoadingFinished,
+			network.EventLoadingFailed,
+			network.EventWebSocketHandshakeResponseReceived,
+			network.EventWebSocketFrameError,
+			network.EventWebSocketClosed:
+			if cmd.Decode(&ev) == nil {
+				t.finish(ev.RequestID)
+			}
+		}
+	}
+}
+
+func (t *Tracker) start(id network.RequestID, url string) {
+	for _, ignore := range t.config.ignore {
+		if ignore(url) {
+			return
+		}
+	}
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	// a redirect reuses the request's ID, so it stays in flight
+	t.inflight[id] = url
+	t.changed()
+}
+
+func (t *Tracker) finish(id network.RequestID) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	if _, ok := t.inflight[id]; !ok {
+		// ignored, or started before tracking
+		return
+	}
+	delete(t.inflight, id)
+	t.changed()
+}
+
+// changed updates the waiters after the requests in flight change.  It must
+// be called with the lock held.
+func (t *Tracker) changed() {
+	now := time.Now()
+	for w := range t.waiters {
+		switch {
+		case len(t.inflight) > w.max:
+			w.idleSince = time.Time{}
+		case w.idleSince.IsZero():
+			w.idleSince = now
+		}
+		select {
+		case w.wake <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// WaitForNetworkIdle tracks the page's requests until the network has been
+// idle, as by Tracker.WaitForNetworkIdle.  Only requests made after it is
+// called are seen, so to wait for the requests made by an action, such as a
+// click, create a Tracker before the action.
+func WaitForNetworkIdle(ctx context.Context, d chromedebugo.SyncDebugger, maxInflight int, quiet time.Duration, opts ...Option) error {
+	t, err := New(ctx, d, opts...)
+	if err != nil {
+		return err
+	}
+	defer t.Close()
+	return t.WaitForNetworkIdle(ctx, maxInflight, quiet)
+}
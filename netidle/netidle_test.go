@@ -0,0 +1,179 @@
+package netidle_test
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/tonyhb/chromedebugo"
+	"github.com/tonyhb/chromedebugo/cdptest"
+	"github.com/tonyhb/chromedebugo/netidle"
+	"github.com/tonyhb/chromedebugo/protocol/network"
+)
+
+// newTracker returns a fake page, a debugger connected to it and a tracker
+// of its requests.
+func newTracker(t *testing.T, opts ...netidle.Option) (*cdptest.Server, chromedebugo.SyncDebugger, *netidle.Tracker) {
+	t.Helper()
+	srv := cdptest.NewServer()
+	t.Cleanup(srv.Close)
+	srv.Handle("Network.enable", cdptest.Result(nil))
+	d, err := chromedebugo.NewSync(srv.URL())
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { d.Close() })
+	tracker, err := netidle.New(context.Background(), d, opts...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(tracker.Close)
+	return srv, d, tracker
+}
+
+func request(id, url string) map[string]interface{} {
+	return map[string]interface{}{"requestId": id, "request": map[string]interface{}{"url": url}}
+}
+
+func websocket(id, url string) map[string]interface{} {
+	return map[string]interface{}{"requestId": id, "url": url}
+}
+
+func finished(id string) map[string]interface{} {
+	return map[string]interface{}{"requestId": id}
+}
+
+// waitForInflight waits for the tracker to count n requests in flight.
+// Events are handled in order, so once the count reflects an event, every
+// event before it has been handled too.
+func waitForInflight(t *testing.T, tracker *netidle.Tracker, n int) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for tracker.Inflight() != n {
+		if time.Now().After(deadline) {
+			t.Fatalf("%d requests in flight, want %d", tracker.Inflight(), n)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestInflight(t *testing.T) {
+	srv, _, tracker := newTracker(t)
+	steps := []struct {
+		method string
+		params map[string]interface{}
+		want   int
+	}{
+		{network.EventRequestWillBeSent, request("1", "https://example.com/"), 1},
+		{network.EventRequestWillBeSent, request("2", "https://example.com/app.js"), 2},
+		// a redirect reuses the request's ID
+		{network.EventRequestWillBeSent, request("2", "https://cdn.example.com/app.js"), 2},
+		{network.EventWebSocketCreated, websocket("3", "wss://example.com/live"), 3},
+		{network.EventLoadingFinished, finished("1"), 2},
+		{network.EventLoadingFailed, finished("2"), 1},
+		{network.EventWebSocketHandshakeResponseReceived, finished("3"), 0},
+		{network.EventWebSocketCreated, websocket("4", "wss://example.com/live"), 1},
+		{network.EventWebSocketFrameError, finished("4"), 0},
+		{network.EventWebSocketCreated, websocket("5", "wss://example.com/live"), 1},
+		{network.EventWebSocketClosed, finished("5"), 0},
+		// requests started before tracking are not counted
+		{network.EventLoadingFinished, finished("0"), 0},
+		{network.EventRequestWillBeSent, request("6", "https://example.com/"), 1},
+	}
+	for _, step := range steps {
+		if err := srv.Emit(step.method, step.params); err != nil {
+			t.Fatal(err)
+		}
+		waitForInflight(t, tracker, step.want)
+	}
+}
+
+func TestIgnoreURL(t *testing.T) {
+	srv, _, tracker := newTracker(t, netidle.WithIgnoreURL(regexp.MustCompile(`/analytics`)))
+	srv.Emit(network.EventRequestWillBeSent, request("1", "https://example.com/analytics?event=load"))
+	srv.Emit(network.EventWebSocketCreated, websocket("2", "wss://example.com/analytics"))
+	srv.Emit(network.EventRequestWillBeSent, request("3", "https://example.com/app.js"))
+	waitForInflight(t, tracker, 1)
+
+	srv.Emit(network.EventLoadingFinished, finished("3"))
+	waitForInflight(t, tracker, 0)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := tracker.WaitForNetworkIdle(ctx, 0, 10*time.Millisecond); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestWaitForNetworkIdleRestartsQuietPeriod(t *testing.T) {
+	const quiet = 200 * time.Millisecond
+	srv, _, tracker := newTracker(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	done := make(chan error, 1)
+	go func() { done <- tracker.WaitForNetworkIdle(ctx, 0, quiet) }()
+
+	// a request part way through the quiet period starts it again once
+	// the request finishes
+	time.Sleep(quiet / 2)
+	srv.Emit(network.EventRequestWillBeSent, request("1", "https://example.com/"))
+	waitForInflight(t, tracker, 1)
+	finishedAt := time.Now()
+	srv.Emit(network.EventLoadingFinished, finished("1"))
+
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+	if elapsed := time.Since(finishedAt); elapsed < quiet {
+		t.Errorf("idle %s after the last request, want at least %s", elapsed, quiet)
+	}
+}
+
+func TestWaitForNetworkIdleMaxInflight(t *testing.T) {
+	srv, _, tracker := newTracker(t)
+	srv.Emit(network.EventRequestWillBeSent, request("1", "https://example.com/poll"))
+	waitForInflight(t, tracker, 1)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := tracker.WaitForNetworkIdle(ctx, 1, 10*time.Millisecond); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestWaitForNetworkIdleCanceled(t *testing.T) {
+	srv, _, tracker := newTracker(t)
+	srv.Emit(network.EventRequestWillBeSent, request("1", "https://example.com/slow"))
+	waitForInflight(t, tracker, 1)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(50*time.Millisecond, cancel)
+	err := tracker.WaitForNetworkIdle(ctx, 0, 10*time.Millisecond)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("got %v, want %v", err, context.Canceled)
+	}
+	if !strings.Contains(err.Error(), "1 requests in flight: [https://example.com/slow]") {
+		t.Errorf("error %q does not list the requests in flight", err)
+	}
+	if strings.Contains(err.Error(), "timed out") {
+		t.Errorf("error %q says the wait timed out", err)
+	}
+}
+
+func TestWaitForNetworkIdleDebuggerClosed(t *testing.T) {
+	srv, d, tracker := newTracker(t)
+	srv.Emit(network.EventRequestWillBeSent, request("1", "https://example.com/slow"))
+	waitForInflight(t, tracker, 1)
+
+	time.AfterFunc(50*time.Millisecond, func() { d.Close() })
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	err := tracker.WaitForNetworkIdle(ctx, 0, 10*time.Millisecond)
+	if err == nil || !strings.Contains(err.Error(), "tracker closed") {
+		t.Fatalf("got %v, want the tracker to be closed", err)
+	}
+}
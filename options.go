@@ -0,0 +1,33 @@
+package chromedebugo
+
+import "net/http"
+
+// Option configures a debugger created with NewSync, NewAsync and the
+// constructors built on them.
+type Option func(*debugger)
+
+// WithResponseTaps enables ResultChan and ErrorChan, which receive a copy of
+// every Result and Error read from chrome.  Each channel buffers up to size
+// responses, at least one, and policy decides what happens once a buffer is
+// full.  Block holds up the connection, and so every other response and
+// event, until the taps are read.
+//
+// The taps are disabled by default for the sync debugger, which returns
+// responses from Send, so that responses nobody reads never hold up the
+// connection.  The async debugger delivers responses through the taps and
+// enables them with DefaultEventBuffer and DropOldest.
+func WithResponseTaps(size int, policy OverflowPolicy) Option {
+	return func(d *debugger) {
+		d.taps = true
+		d.tapSize = size
+		d.tapOverflow = policy
+	}
+}
+
+// WithHTTPClient sets the client used for chrome's HTTP endpoints, such as
+// /json/list when discovering the target to debug.
+func WithHTTPClient(client *http.Client) Option {
+	return func(d *debugger) {
+		d.httpClient = client
+	}
+}
@@ -0,0 +1,495 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+// Package accessibility provides typed bindings for the Accessibility domain of
+// the Chrome DevTools protocol.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Accessibility
+//
+// This is an experimental part of the protocol.
+package accessibility
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tonyhb/chromedebugo"
+	"github.com/tonyhb/chromedebugo/protocol"
+	"github.com/tonyhb/chromedebugo/protocol/internal/types"
+)
+
+// AXNodeID unique accessibility node identifier.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Accessibility#type-AXNodeId
+type AXNodeID = types.AccessibilityAXNodeID
+
+// AXValueType enum of possible property types.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Accessibility#type-AXValueType
+type AXValueType = types.AccessibilityAXValueType
+
+// AXValueSourceType enum of possible property sources.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Accessibility#type-AXValueSourceType
+type AXValueSourceType = types.AccessibilityAXValueSourceType
+
+// AXValueNativeSourceType enum of possible native property sources (as a
+// subtype of a particular AXValueSourceType).
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Accessibility#type-AXValueNativeSourceType
+type AXValueNativeSourceType = types.AccessibilityAXValueNativeSourceType
+
+// AXValueSource a single source for a computed AX property.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Accessibility#type-AXValueSource
+type AXValueSource = types.AccessibilityAXValueSource
+
+// AXRelatedNode is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Accessibility#type-AXRelatedNode
+type AXRelatedNode = types.AccessibilityAXRelatedNode
+
+// AXProperty is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Accessibility#type-AXProperty
+type AXProperty = types.AccessibilityAXProperty
+
+// AXValue a single computed AX property.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Accessibility#type-AXValue
+type AXValue = types.AccessibilityAXValue
+
+// AXPropertyName values of AXProperty name:
+// - from 'busy' to 'roledescription': states which apply to every AX node
+// - from 'live' to 'root': attributes which apply to nodes in live regions
+// - from 'autocomplete' to 'valuetext': attributes which apply to widgets
+// - from 'checked' to 'selected': states which apply to widgets
+// - from 'activedescendant' to 'owns' - relationships between elements other
+// than parent/child/sibling.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Accessibility#type-AXPropertyName
+type AXPropertyName = types.AccessibilityAXPropertyName
+
+// AXNode a node in the accessibility tree.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Accessibility#type-AXNode
+type AXNode = types.AccessibilityAXNode
+
+// Values of the enumerated types in the Accessibility domain.
+const (
+	AXValueTypeBoolean                    = types.AccessibilityAXValueTypeBoolean
+	AXValueTypeTristate                   = types.AccessibilityAXValueTypeTristate
+	AXValueTypeBooleanOrUndefined         = types.AccessibilityAXValueTypeBooleanOrUndefined
+	AXValueTypeIdref                      = types.AccessibilityAXValueTypeIdref
+	AXValueTypeIdrefList                  = types.AccessibilityAXValueTypeIdrefList
+	AXValueTypeInteger                    = types.AccessibilityAXValueTypeInteger
+	AXValueTypeNode                       = types.AccessibilityAXValueTypeNode
+	AXValueTypeNodeList                   = types.AccessibilityAXValueTypeNodeList
+	AXValueTypeNumber                     = types.AccessibilityAXValueTypeNumber
+	AXValueTypeString                     = types.AccessibilityAXValueTypeString
+	AXValueTypeComputedString             = types.AccessibilityAXValueTypeComputedString
+	AXValueTypeToken                      = types.AccessibilityAXValueTypeToken
+	AXValueTypeTokenList                  = types.AccessibilityAXValueTypeTokenList
+	AXValueTypeDOMRelation                = types.AccessibilityAXValueTypeDOMRelation
+	AXValueTypeRole                       = types.AccessibilityAXValueTypeRole
+	AXValueTypeInternalRole               = types.AccessibilityAXValueTypeInternalRole
+	AXValueTypeValueUndefined             = types.AccessibilityAXValueTypeValueUndefined
+	AXValueSourceTypeAttribute            = types.AccessibilityAXValueSourceTypeAttribute
+	AXValueSourceTypeImplicit             = types.AccessibilityAXValueSourceTypeImplicit
+	AXValueSourceTypeStyle                = types.AccessibilityAXValueSourceTypeStyle
+	AXValueSourceTypeContents             = types.AccessibilityAXValueSourceTypeContents
+	AXValueSourceTypePlaceholder          = types.AccessibilityAXValueSourceTypePlaceholder
+	AXValueSourceTypeRelatedElement       = types.AccessibilityAXValueSourceTypeRelatedElement
+	AXValueNativeSourceTypeDescription    = types.AccessibilityAXValueNativeSourceTypeDescription
+	AXValueNativeSourceTypeFigcaption     = types.AccessibilityAXValueNativeSourceTypeFigcaption
+	AXValueNativeSourceTypeLabel          = types.AccessibilityAXValueNativeSourceTypeLabel
+	AXValueNativeSourceTypeLabelfor       = types.AccessibilityAXValueNativeSourceTypeLabelfor
+	AXValueNativeSourceTypeLabelwrapped   = types.AccessibilityAXValueNativeSourceTypeLabelwrapped
+	AXValueNativeSourceTypeLegend         = types.AccessibilityAXValueNativeSourceTypeLegend
+	AXValueNativeSourceTypeRubyannotation = types.AccessibilityAXValueNativeSourceTypeRubyannotation
+	AXValueNativeSourceTypeTablecaption   = types.AccessibilityAXValueNativeSourceTypeTablecaption
+	AXValueNativeSourceTypeTitle          = types.AccessibilityAXValueNativeSourceTypeTitle
+	AXValueNativeSourceTypeOther          = types.AccessibilityAXValueNativeSourceTypeOther
+	AXPropertyNameActions                 = types.AccessibilityAXPropertyNameActions
+	AXPropertyNameBusy                    = types.AccessibilityAXPropertyNameBusy
+	AXPropertyNameDisabled                = types.AccessibilityAXPropertyNameDisabled
+	AXPropertyNameEditable                = types.AccessibilityAXPropertyNameEditable
+	AXPropertyNameFocusable               = types.AccessibilityAXPropertyNameFocusable
+	AXPropertyNameFocused                 = types.AccessibilityAXPropertyNameFocused
+	AXPropertyNameHidden                  = types.AccessibilityAXPropertyNameHidden
+	AXPropertyNameHiddenRoot              = types.AccessibilityAXPropertyNameHiddenRoot
+	AXPropertyNameInvalid                 = types.AccessibilityAXPropertyNameInvalid
+	AXPropertyNameKeyshortcuts            = types.AccessibilityAXPropertyNameKeyshortcuts
+	AXPropertyNameSettable                = types.AccessibilityAXPropertyNameSettable
+	AXPropertyNameRoledescription         = types.AccessibilityAXPropertyNameRoledescription
+	AXPropertyNameLive                    = types.AccessibilityAXPropertyNameLive
+	AXPropertyNameAtomic                  = types.AccessibilityAXPropertyNameAtomic
+	AXPropertyNameRelevant                = types.AccessibilityAXPropertyNameRelevant
+	AXPropertyNameRoot                    = types.AccessibilityAXPropertyNameRoot
+	AXPropertyNameAutocomplete            = types.AccessibilityAXPropertyNameAutocomplete
+	AXPropertyNameHasPopup                = types.AccessibilityAXPropertyNameHasPopup
+	AXPropertyNameLevel                   = types.AccessibilityAXPropertyNameLevel
+	AXPropertyNameMultiselectable         = types.AccessibilityAXPropertyNameMultiselectable
+	AXPropertyNameOrientation             = types.AccessibilityAXPropertyNameOrientation
+	AXPropertyNameMultiline               = types.AccessibilityAXPropertyNameMultiline
+	AXPropertyNameReadonly                = types.AccessibilityAXPropertyNameReadonly
+	AXPropertyNameRequired                = types.AccessibilityAXPropertyNameRequired
+	AXPropertyNameValuemin                = types.AccessibilityAXPropertyNameValuemin
+	AXPropertyNameValuemax                = types.AccessibilityAXPropertyNameValuemax
+	AXPropertyNameValuetext               = types.AccessibilityAXPropertyNameValuetext
+	AXPropertyNameChecked                 = types.AccessibilityAXPropertyNameChecked
+	AXPropertyNameExpanded                = types.AccessibilityAXPropertyNameExpanded
+	AXPropertyNameModal                   = types.AccessibilityAXPropertyNameModal
+	AXPropertyNamePressed                 = types.AccessibilityAXPropertyNamePressed
+	AXPropertyNameSelected                = types.AccessibilityAXPropertyNameSelected
+	AXPropertyNameActivedescendant        = types.AccessibilityAXPropertyNameActivedescendant
+	AXPropertyNameControls                = types.AccessibilityAXPropertyNameControls
+	AXPropertyNameDescribedby             = types.AccessibilityAXPropertyNameDescribedby
+	AXPropertyNameDetails                 = types.AccessibilityAXPropertyNameDetails
+	AXPropertyNameErrormessage            = types.AccessibilityAXPropertyNameErrormessage
+	AXPropertyNameFlowto                  = types.AccessibilityAXPropertyNameFlowto
+	AXPropertyNameLabelledby              = types.AccessibilityAXPropertyNameLabelledby
+	AXPropertyNameOwns                    = types.AccessibilityAXPropertyNameOwns
+	AXPropertyNameURL                     = types.AccessibilityAXPropertyNameURL
+)
+
+// Method names of the commands in the Accessibility domain.
+const (
+	CommandDisable               = "Accessibility.disable"
+	CommandEnable                = "Accessibility.enable"
+	CommandGetPartialAXTree      = "Accessibility.getPartialAXTree"
+	CommandGetFullAXTree         = "Accessibility.getFullAXTree"
+	CommandGetRootAXNode         = "Accessibility.getRootAXNode"
+	CommandGetAXNodeAndAncestors = "Accessibility.getAXNodeAndAncestors"
+	CommandGetChildAXNodes       = "Accessibility.getChildAXNodes"
+	CommandQueryAXTree           = "Accessibility.queryAXTree"
+)
+
+// DisableParams are the parameters of Accessibility.disable.
+//
+// Disables the accessibility domain.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Accessibility#method-disable
+type DisableParams struct {
+}
+
+// Command returns the Accessibility.disable command for use with
+// AsyncDebugger.Send.
+func (p DisableParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandDisable, p)
+}
+
+// Do sends Accessibility.disable to d and waits for it to complete or for ctx to
+// be done.
+func (p DisableParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandDisable, p, nil)
+}
+
+// EnableParams are the parameters of Accessibility.enable.
+//
+// Enables the accessibility domain which causes `AXNodeId`s to remain
+// consistent between method calls. This turns on accessibility for the page,
+// which can impact performance until accessibility is disabled.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Accessibility#method-enable
+type EnableParams struct {
+}
+
+// Command returns the Accessibility.enable command for use with
+// AsyncDebugger.Send.
+func (p EnableParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandEnable, p)
+}
+
+// Do sends Accessibility.enable to d and waits for it to complete or for ctx to
+// be done.
+func (p EnableParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandEnable, p, nil)
+}
+
+// GetPartialAXTreeParams are the parameters of Accessibility.getPartialAXTree.
+//
+// Fetches the accessibility node and partial accessibility tree for this DOM
+// node, if it exists.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Accessibility#method-getPartialAXTree
+//
+// This is an experimental part of the protocol.
+type GetPartialAXTreeParams struct {
+	// Identifier of the node to get the partial accessibility tree for.
+	NodeID types.DOMNodeID `json:"nodeId,omitempty"`
+	// Identifier of the backend node to get the partial accessibility tree for.
+	BackendNodeID types.DOMBackendNodeID `json:"backendNodeId,omitempty"`
+	// JavaScript object id of the node wrapper to get the partial accessibility
+	// tree for.
+	ObjectID types.RuntimeRemoteObjectID `json:"objectId,omitempty"`
+	// Whether to fetch this node's ancestors, siblings and children. Defaults to
+	// true.
+	FetchRelatives bool `json:"fetchRelatives,omitempty"`
+}
+
+// Command returns the Accessibility.getPartialAXTree command for use with
+// AsyncDebugger.Send.
+func (p GetPartialAXTreeParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandGetPartialAXTree, p)
+}
+
+// GetPartialAXTreeReturns are the values returned by Accessibility.getPartialAXTree.
+type GetPartialAXTreeReturns struct {
+	// The `Accessibility.AXNode` for this DOM node, if it exists, plus its
+	// ancestors, siblings and children, if requested.
+	Nodes []AXNode `json:"nodes"`
+}
+
+// Do sends Accessibility.getPartialAXTree to d and waits for its result or for ctx to be
+// done.
+func (p GetPartialAXTreeParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*GetPartialAXTreeReturns, error) {
+	ret := &GetPartialAXTreeReturns{}
+	if err := protocol.Do(ctx, d, CommandGetPartialAXTree, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// GetFullAXTreeParams are the parameters of Accessibility.getFullAXTree.
+//
+// # Fetches the entire accessibility tree for the root Document
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Accessibility#method-getFullAXTree
+//
+// This is an experimental part of the protocol.
+type GetFullAXTreeParams struct {
+	// The maximum depth at which descendants of the root node should be retrieved.
+	// If omitted, the full tree is returned.
+	Depth int64 `json:"depth,omitempty"`
+	// The frame for whose document the AX tree should be retrieved. If omitted,
+	// the root frame is used.
+	FrameID types.PageFrameID `json:"frameId,omitempty"`
+}
+
+// Command returns the Accessibility.getFullAXTree command for use with
+// AsyncDebugger.Send.
+func (p GetFullAXTreeParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandGetFullAXTree, p)
+}
+
+// GetFullAXTreeReturns are the values returned by Accessibility.getFullAXTree.
+type GetFullAXTreeReturns struct {
+	Nodes []AXNode `json:"nodes"`
+}
+
+// Do sends Accessibility.getFullAXTree to d and waits for its result or for ctx to be
+// done.
+func (p GetFullAXTreeParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*GetFullAXTreeReturns, error) {
+	ret := &GetFullAXTreeReturns{}
+	if err := protocol.Do(ctx, d, CommandGetFullAXTree, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// GetRootAXNodeParams are the parameters of Accessibility.getRootAXNode.
+//
+// Fetches the root node. Requires `enable()` to have been called previously.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Accessibility#method-getRootAXNode
+//
+// This is an experimental part of the protocol.
+type GetRootAXNodeParams struct {
+	// The frame in whose document the node resides. If omitted, the root frame is
+	// used.
+	FrameID types.PageFrameID `json:"frameId,omitempty"`
+}
+
+// Command returns the Accessibility.getRootAXNode command for use with
+// AsyncDebugger.Send.
+func (p GetRootAXNodeParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandGetRootAXNode, p)
+}
+
+// GetRootAXNodeReturns are the values returned by Accessibility.getRootAXNode.
+type GetRootAXNodeReturns struct {
+	Node AXNode `json:"node"`
+}
+
+// Do sends Accessibility.getRootAXNode to d and waits for its result or for ctx to be
+// done.
+func (p GetRootAXNodeParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*GetRootAXNodeReturns, error) {
+	ret := &GetRootAXNodeReturns{}
+	if err := protocol.Do(ctx, d, CommandGetRootAXNode, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// GetAXNodeAndAncestorsParams are the parameters of
+// Accessibility.getAXNodeAndAncestors.
+//
+// Fetches a node and all ancestors up to and including the root. Requires
+// `enable()` to have been called previously.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Accessibility#method-getAXNodeAndAncestors
+//
+// This is an experimental part of the protocol.
+type GetAXNodeAndAncestorsParams struct {
+	// Identifier of the node to get.
+	NodeID types.DOMNodeID `json:"nodeId,omitempty"`
+	// Identifier of the backend node to get.
+	BackendNodeID types.DOMBackendNodeID `json:"backendNodeId,omitempty"`
+	// JavaScript object id of the node wrapper to get.
+	ObjectID types.RuntimeRemoteObjectID `json:"objectId,omitempty"`
+}
+
+// Command returns the Accessibility.getAXNodeAndAncestors command for use with
+// AsyncDebugger.Send.
+func (p GetAXNodeAndAncestorsParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandGetAXNodeAndAncestors, p)
+}
+
+// GetAXNodeAndAncestorsReturns are the values returned by Accessibility.getAXNodeAndAncestors.
+type GetAXNodeAndAncestorsReturns struct {
+	Nodes []AXNode `json:"nodes"`
+}
+
+// Do sends Accessibility.getAXNodeAndAncestors to d and waits for its result or for ctx to be
+// done.
+func (p GetAXNodeAndAncestorsParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*GetAXNodeAndAncestorsReturns, error) {
+	ret := &GetAXNodeAndAncestorsReturns{}
+	if err := protocol.Do(ctx, d, CommandGetAXNodeAndAncestors, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// GetChildAXNodesParams are the parameters of Accessibility.getChildAXNodes.
+//
+// Fetches a particular accessibility node by AXNodeId. Requires `enable()` to
+// have been called previously.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Accessibility#method-getChildAXNodes
+//
+// This is an experimental part of the protocol.
+type GetChildAXNodesParams struct {
+	ID AXNodeID `json:"id"`
+	// The frame in whose document the node resides. If omitted, the root frame is
+	// used.
+	FrameID types.PageFrameID `json:"frameId,omitempty"`
+}
+
+// Command returns the Accessibility.getChildAXNodes command for use with
+// AsyncDebugger.Send.
+func (p GetChildAXNodesParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandGetChildAXNodes, p)
+}
+
+// GetChildAXNodesReturns are the values returned by Accessibility.getChildAXNodes.
+type GetChildAXNodesReturns struct {
+	Nodes []AXNode `json:"nodes"`
+}
+
+// Do sends Accessibility.getChildAXNodes to d and waits for its result or for ctx to be
+// done.
+func (p GetChildAXNodesParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*GetChildAXNodesReturns, error) {
+	ret := &GetChildAXNodesReturns{}
+	if err := protocol.Do(ctx, d, CommandGetChildAXNodes, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// QueryAXTreeParams are the parameters of Accessibility.queryAXTree.
+//
+// Query a DOM node's accessibility subtree for accessible name and role. This
+// command computes the name and role for all nodes in the subtree, including
+// those that are ignored for accessibility, and returns those that match the
+// specified name and role. If no DOM node is specified, or the DOM node does
+// not exist, the command returns an error. If neither `accessibleName` or
+// `role` is specified, it returns all the accessibility nodes in the subtree.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Accessibility#method-queryAXTree
+//
+// This is an experimental part of the protocol.
+type QueryAXTreeParams struct {
+	// Identifier of the node for the root to query.
+	NodeID types.DOMNodeID `json:"nodeId,omitempty"`
+	// Identifier of the backend node for the root to query.
+	BackendNodeID types.DOMBackendNodeID `json:"backendNodeId,omitempty"`
+	// JavaScript object id of the node wrapper for the root to query.
+	ObjectID types.RuntimeRemoteObjectID `json:"objectId,omitempty"`
+	// Find nodes with this computed name.
+	AccessibleName string `json:"accessibleName,omitempty"`
+	// Find nodes with this computed role.
+	Role string `json:"role,omitempty"`
+}
+
+// Command returns the Accessibility.queryAXTree command for use with
+// AsyncDebugger.Send.
+func (p QueryAXTreeParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandQueryAXTree, p)
+}
+
+// QueryAXTreeReturns are the values returned by Accessibility.queryAXTree.
+type QueryAXTreeReturns struct {
+	// A list of `Accessibility.AXNode` matching the specified attributes,
+	// including nodes that are ignored for accessibility.
+	Nodes []AXNode `json:"nodes"`
+}
+
+// Do sends Accessibility.queryAXTree to d and waits for its result or for ctx to be
+// done.
+func (p QueryAXTreeParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*QueryAXTreeReturns, error) {
+	ret := &QueryAXTreeReturns{}
+	if err := protocol.Do(ctx, d, CommandQueryAXTree, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// Method names of the events in the Accessibility domain.
+const (
+	EventLoadComplete = "Accessibility.loadComplete"
+	EventNodesUpdated = "Accessibility.nodesUpdated"
+)
+
+// LoadCompleteEvent is the payload of the Accessibility.loadComplete event.
+//
+// The loadComplete event mirrors the load complete event sent by the browser to
+// assistive technology when the web page has finished loading.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Accessibility#event-loadComplete
+//
+// This is an experimental part of the protocol.
+type LoadCompleteEvent struct {
+	// New document root node.
+	Root AXNode `json:"root"`
+}
+
+// NodesUpdatedEvent is the payload of the Accessibility.nodesUpdated event.
+//
+// The nodesUpdated event is sent every time a previously requested node has
+// changed the in tree.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Accessibility#event-nodesUpdated
+//
+// This is an experimental part of the protocol.
+type NodesUpdatedEvent struct {
+	// Updated node data.
+	Nodes []AXNode `json:"nodes"`
+}
+
+// ParseEvent decodes an event from the Accessibility domain, as received from
+// Subscribe, into a pointer to its typed payload.
+func ParseEvent(cmd chromedebugo.Command) (interface{}, error) {
+	var ev interface{}
+	switch cmd.Method {
+	case EventLoadComplete:
+		ev = &LoadCompleteEvent{}
+	case EventNodesUpdated:
+		ev = &NodesUpdatedEvent{}
+	default:
+		return nil, fmt.Errorf("unknown Accessibility event: %s", cmd.Method)
+	}
+	if err := cmd.Decode(ev); err != nil {
+		return nil, err
+	}
+	return ev, nil
+}
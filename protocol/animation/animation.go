@@ -0,0 +1,379 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+// Package animation provides typed bindings for the Animation domain of the
+// Chrome DevTools protocol.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Animation
+//
+// This is an experimental part of the protocol.
+package animation
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tonyhb/chromedebugo"
+	"github.com/tonyhb/chromedebugo/protocol"
+	"github.com/tonyhb/chromedebugo/protocol/internal/types"
+)
+
+// Animation animation instance.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Animation#type-Animation
+type Animation = types.AnimationAnimation
+
+// ViewOrScrollTimeline timeline instance
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Animation#type-ViewOrScrollTimeline
+type ViewOrScrollTimeline = types.AnimationViewOrScrollTimeline
+
+// AnimationEffect animationEffect instance
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Animation#type-AnimationEffect
+type AnimationEffect = types.AnimationAnimationEffect
+
+// KeyframesRule keyframes Rule
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Animation#type-KeyframesRule
+type KeyframesRule = types.AnimationKeyframesRule
+
+// KeyframeStyle keyframe Style
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Animation#type-KeyframeStyle
+type KeyframeStyle = types.AnimationKeyframeStyle
+
+// Method names of the commands in the Animation domain.
+const (
+	CommandDisable           = "Animation.disable"
+	CommandEnable            = "Animation.enable"
+	CommandGetCurrentTime    = "Animation.getCurrentTime"
+	CommandGetPlaybackRate   = "Animation.getPlaybackRate"
+	CommandReleaseAnimations = "Animation.releaseAnimations"
+	CommandResolveAnimation  = "Animation.resolveAnimation"
+	CommandSeekAnimations    = "Animation.seekAnimations"
+	CommandSetPaused         = "Animation.setPaused"
+	CommandSetPlaybackRate   = "Animation.setPlaybackRate"
+	CommandSetTiming         = "Animation.setTiming"
+)
+
+// DisableParams are the parameters of Animation.disable.
+//
+// Disables animation domain notifications.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Animation#method-disable
+type DisableParams struct {
+}
+
+// Command returns the Animation.disable command for use with
+// AsyncDebugger.Send.
+func (p DisableParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandDisable, p)
+}
+
+// Do sends Animation.disable to d and waits for it to complete or for ctx to
+// be done.
+func (p DisableParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandDisable, p, nil)
+}
+
+// EnableParams are the parameters of Animation.enable.
+//
+// Enables animation domain notifications.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Animation#method-enable
+type EnableParams struct {
+}
+
+// Command returns the Animation.enable command for use with AsyncDebugger.Send.
+func (p EnableParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandEnable, p)
+}
+
+// Do sends Animation.enable to d and waits for it to complete or for ctx to
+// be done.
+func (p EnableParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandEnable, p, nil)
+}
+
+// GetCurrentTimeParams are the parameters of Animation.getCurrentTime.
+//
+// Returns the current time of the an animation.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Animation#method-getCurrentTime
+type GetCurrentTimeParams struct {
+	// Id of animation.
+	ID string `json:"id"`
+}
+
+// Command returns the Animation.getCurrentTime command for use with
+// AsyncDebugger.Send.
+func (p GetCurrentTimeParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandGetCurrentTime, p)
+}
+
+// GetCurrentTimeReturns are the values returned by Animation.getCurrentTime.
+type GetCurrentTimeReturns struct {
+	// Current time of the page.
+	CurrentTime float64 `json:"currentTime"`
+}
+
+// Do sends Animation.getCurrentTime to d and waits for its result or for ctx to be
+// done.
+func (p GetCurrentTimeParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*GetCurrentTimeReturns, error) {
+	ret := &GetCurrentTimeReturns{}
+	if err := protocol.Do(ctx, d, CommandGetCurrentTime, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// GetPlaybackRateParams are the parameters of Animation.getPlaybackRate.
+//
+// Gets the playback rate of the document timeline.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Animation#method-getPlaybackRate
+type GetPlaybackRateParams struct {
+}
+
+// Command returns the Animation.getPlaybackRate command for use with
+// AsyncDebugger.Send.
+func (p GetPlaybackRateParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandGetPlaybackRate, p)
+}
+
+// GetPlaybackRateReturns are the values returned by Animation.getPlaybackRate.
+type GetPlaybackRateReturns struct {
+	// Playback rate for animations on page.
+	PlaybackRate float64 `json:"playbackRate"`
+}
+
+// Do sends Animation.getPlaybackRate to d and waits for its result or for ctx to be
+// done.
+func (p GetPlaybackRateParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*GetPlaybackRateReturns, error) {
+	ret := &GetPlaybackRateReturns{}
+	if err := protocol.Do(ctx, d, CommandGetPlaybackRate, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// ReleaseAnimationsParams are the parameters of Animation.releaseAnimations.
+//
+// Releases a set of animations to no longer be manipulated.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Animation#method-releaseAnimations
+type ReleaseAnimationsParams struct {
+	// List of animation ids to seek.
+	Animations []string `json:"animations"`
+}
+
+// Command returns the Animation.releaseAnimations command for use with
+// AsyncDebugger.Send.
+func (p ReleaseAnimationsParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandReleaseAnimations, p)
+}
+
+// Do sends Animation.releaseAnimations to d and waits for it to complete or for ctx to
+// be done.
+func (p ReleaseAnimationsParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandReleaseAnimations, p, nil)
+}
+
+// ResolveAnimationParams are the parameters of Animation.resolveAnimation.
+//
+// Gets the remote object of the Animation.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Animation#method-resolveAnimation
+type ResolveAnimationParams struct {
+	// Animation id.
+	AnimationID string `json:"animationId"`
+}
+
+// Command returns the Animation.resolveAnimation command for use with
+// AsyncDebugger.Send.
+func (p ResolveAnimationParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandResolveAnimation, p)
+}
+
+// ResolveAnimationReturns are the values returned by Animation.resolveAnimation.
+type ResolveAnimationReturns struct {
+	// Corresponding remote object.
+	RemoteObject types.RuntimeRemoteObject `json:"remoteObject"`
+}
+
+// Do sends Animation.resolveAnimation to d and waits for its result or for ctx to be
+// done.
+func (p ResolveAnimationParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*ResolveAnimationReturns, error) {
+	ret := &ResolveAnimationReturns{}
+	if err := protocol.Do(ctx, d, CommandResolveAnimation, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// SeekAnimationsParams are the parameters of Animation.seekAnimations.
+//
+// Seek a set of animations to a particular time within each animation.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Animation#method-seekAnimations
+type SeekAnimationsParams struct {
+	// List of animation ids to seek.
+	Animations []string `json:"animations"`
+	// Set the current time of each animation.
+	CurrentTime float64 `json:"currentTime"`
+}
+
+// Command returns the Animation.seekAnimations command for use with
+// AsyncDebugger.Send.
+func (p SeekAnimationsParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSeekAnimations, p)
+}
+
+// Do sends Animation.seekAnimations to d and waits for it to complete or for ctx to
+// be done.
+func (p SeekAnimationsParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSeekAnimations, p, nil)
+}
+
+// SetPausedParams are the parameters of Animation.setPaused.
+//
+// Sets the paused state of a set of animations.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Animation#method-setPaused
+type SetPausedParams struct {
+	// Animations to set the pause state of.
+	Animations []string `json:"animations"`
+	// Paused state to set to.
+	Paused bool `json:"paused"`
+}
+
+// Command returns the Animation.setPaused command for use with
+// AsyncDebugger.Send.
+func (p SetPausedParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetPaused, p)
+}
+
+// Do sends Animation.setPaused to d and waits for it to complete or for ctx to
+// be done.
+func (p SetPausedParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetPaused, p, nil)
+}
+
+// SetPlaybackRateParams are the parameters of Animation.setPlaybackRate.
+//
+// Sets the playback rate of the document timeline.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Animation#method-setPlaybackRate
+type SetPlaybackRateParams struct {
+	// Playback rate for animations on page
+	PlaybackRate float64 `json:"playbackRate"`
+}
+
+// Command returns the Animation.setPlaybackRate command for use with
+// AsyncDebugger.Send.
+func (p SetPlaybackRateParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetPlaybackRate, p)
+}
+
+// Do sends Animation.setPlaybackRate to d and waits for it to complete or for ctx to
+// be done.
+func (p SetPlaybackRateParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetPlaybackRate, p, nil)
+}
+
+// SetTimingParams are the parameters of Animation.setTiming.
+//
+// Sets the timing of an animation node.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Animation#method-setTiming
+type SetTimingParams struct {
+	// Animation id.
+	AnimationID string `json:"animationId"`
+	// Duration of the animation.
+	Duration float64 `json:"duration"`
+	// Delay of the animation.
+	Delay float64 `json:"delay"`
+}
+
+// Command returns the Animation.setTiming command for use with
+// AsyncDebugger.Send.
+func (p SetTimingParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetTiming, p)
+}
+
+// Do sends Animation.setTiming to d and waits for it to complete or for ctx to
+// be done.
+func (p SetTimingParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetTiming, p, nil)
+}
+
+// Method names of the events in the Animation domain.
+const (
+	EventAnimationCanceled = "Animation.animationCanceled"
+	EventAnimationCreated  = "Animation.animationCreated"
+	EventAnimationStarted  = "Animation.animationStarted"
+	EventAnimationUpdated  = "Animation.animationUpdated"
+)
+
+// AnimationCanceledEvent is the payload of the Animation.animationCanceled
+// event.
+//
+// Event for when an animation has been cancelled.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Animation#event-animationCanceled
+type AnimationCanceledEvent struct {
+	// Id of the animation that was cancelled.
+	ID string `json:"id"`
+}
+
+// AnimationCreatedEvent is the payload of the Animation.animationCreated event.
+//
+// Event for each animation that has been created.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Animation#event-animationCreated
+type AnimationCreatedEvent struct {
+	// Id of the animation that was created.
+	ID string `json:"id"`
+}
+
+// AnimationStartedEvent is the payload of the Animation.animationStarted event.
+//
+// Event for animation that has been started.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Animation#event-animationStarted
+type AnimationStartedEvent struct {
+	// Animation that was started.
+	Animation Animation `json:"animation"`
+}
+
+// AnimationUpdatedEvent is the payload of the Animation.animationUpdated event.
+//
+// Event for animation that has been updated.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Animation#event-animationUpdated
+type AnimationUpdatedEvent struct {
+	// Animation that was updated.
+	Animation Animation `json:"animation"`
+}
+
+// ParseEvent decodes an event from the Animation domain, as received from
+// Subscribe, into a pointer to its typed payload.
+func ParseEvent(cmd chromedebugo.Command) (interface{}, error) {
+	var ev interface{}
+	switch cmd.Method {
+	case EventAnimationCanceled:
+		ev = &AnimationCanceledEvent{}
+	case EventAnimationCreated:
+		ev = &AnimationCreatedEvent{}
+	case EventAnimationStarted:
+		ev = &AnimationStartedEvent{}
+	case EventAnimationUpdated:
+		ev = &AnimationUpdatedEvent{}
+	default:
+		return nil, fmt.Errorf("unknown Animation event: %s", cmd.Method)
+	}
+	if err := cmd.Decode(ev); err != nil {
+		return nil, err
+	}
+	return ev, nil
+}
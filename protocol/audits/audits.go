@@ -0,0 +1,812 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+// Package audits provides typed bindings for the Audits domain of the Chrome
+// DevTools protocol.
+//
+// Audits domain allows investigation of page violations and possible
+// improvements.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Audits
+//
+// This is an experimental part of the protocol.
+package audits
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tonyhb/chromedebugo"
+	"github.com/tonyhb/chromedebugo/protocol"
+	"github.com/tonyhb/chromedebugo/protocol/internal/types"
+)
+
+// AffectedCookie information about a cookie that is affected by an inspector
+// issue.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-AffectedCookie
+type AffectedCookie = types.AuditsAffectedCookie
+
+// AffectedRequest information about a request that is affected by an inspector
+// issue.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-AffectedRequest
+type AffectedRequest = types.AuditsAffectedRequest
+
+// AffectedFrame information about the frame affected by an inspector issue.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-AffectedFrame
+type AffectedFrame = types.AuditsAffectedFrame
+
+// CookieExclusionReason is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-CookieExclusionReason
+type CookieExclusionReason = types.AuditsCookieExclusionReason
+
+// CookieWarningReason is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-CookieWarningReason
+type CookieWarningReason = types.AuditsCookieWarningReason
+
+// CookieOperation is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-CookieOperation
+type CookieOperation = types.AuditsCookieOperation
+
+// InsightType represents the category of insight that a cookie issue falls
+// under.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-InsightType
+type InsightType = types.AuditsInsightType
+
+// CookieIssueInsight information about the suggested solution to a cookie
+// issue.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-CookieIssueInsight
+type CookieIssueInsight = types.AuditsCookieIssueInsight
+
+// CookieIssueDetails this information is currently necessary, as the front-end
+// has a difficult time finding a specific cookie. With this, we can convey
+// specific error information without the cookie.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-CookieIssueDetails
+type CookieIssueDetails = types.AuditsCookieIssueDetails
+
+// MixedContentResolutionStatus is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-MixedContentResolutionStatus
+type MixedContentResolutionStatus = types.AuditsMixedContentResolutionStatus
+
+// MixedContentResourceType is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-MixedContentResourceType
+type MixedContentResourceType = types.AuditsMixedContentResourceType
+
+// MixedContentIssueDetails is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-MixedContentIssueDetails
+type MixedContentIssueDetails = types.AuditsMixedContentIssueDetails
+
+// BlockedByResponseReason enum indicating the reason a response has been
+// blocked. These reasons are refinements of the net error BLOCKED_BY_RESPONSE.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-BlockedByResponseReason
+type BlockedByResponseReason = types.AuditsBlockedByResponseReason
+
+// BlockedByResponseIssueDetails details for a request that has been blocked
+// with the BLOCKED_BY_RESPONSE code. Currently only used for COEP/COOP, but may
+// be extended to include some CSP errors in the future.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-BlockedByResponseIssueDetails
+type BlockedByResponseIssueDetails = types.AuditsBlockedByResponseIssueDetails
+
+// HeavyAdResolutionStatus is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-HeavyAdResolutionStatus
+type HeavyAdResolutionStatus = types.AuditsHeavyAdResolutionStatus
+
+// HeavyAdReason is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-HeavyAdReason
+type HeavyAdReason = types.AuditsHeavyAdReason
+
+// HeavyAdIssueDetails is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-HeavyAdIssueDetails
+type HeavyAdIssueDetails = types.AuditsHeavyAdIssueDetails
+
+// ContentSecurityPolicyViolationType is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-ContentSecurityPolicyViolationType
+type ContentSecurityPolicyViolationType = types.AuditsContentSecurityPolicyViolationType
+
+// SourceCodeLocation is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-SourceCodeLocation
+type SourceCodeLocation = types.AuditsSourceCodeLocation
+
+// ContentSecurityPolicyIssueDetails is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-ContentSecurityPolicyIssueDetails
+type ContentSecurityPolicyIssueDetails = types.AuditsContentSecurityPolicyIssueDetails
+
+// SharedArrayBufferIssueType is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-SharedArrayBufferIssueType
+type SharedArrayBufferIssueType = types.AuditsSharedArrayBufferIssueType
+
+// SharedArrayBufferIssueDetails details for a issue arising from an SAB being
+// instantiated in, or transferred to a context that is not cross-origin
+// isolated.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-SharedArrayBufferIssueDetails
+type SharedArrayBufferIssueDetails = types.AuditsSharedArrayBufferIssueDetails
+
+// LowTextContrastIssueDetails is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-LowTextContrastIssueDetails
+type LowTextContrastIssueDetails = types.AuditsLowTextContrastIssueDetails
+
+// CorsIssueDetails details for a CORS related issue, e.g. a warning or error
+// related to CORS RFC1918 enforcement.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-CorsIssueDetails
+type CorsIssueDetails = types.AuditsCorsIssueDetails
+
+// AttributionReportingIssueType is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-AttributionReportingIssueType
+type AttributionReportingIssueType = types.AuditsAttributionReportingIssueType
+
+// SharedDictionaryError is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-SharedDictionaryError
+type SharedDictionaryError = types.AuditsSharedDictionaryError
+
+// SRIMessageSignatureError is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-SRIMessageSignatureError
+type SRIMessageSignatureError = types.AuditsSRIMessageSignatureError
+
+// UnencodedDigestError is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-UnencodedDigestError
+type UnencodedDigestError = types.AuditsUnencodedDigestError
+
+// AttributionReportingIssueDetails details for issues around "Attribution
+// Reporting API" usage. Explainer:
+// https://github.com/WICG/attribution-reporting-api
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-AttributionReportingIssueDetails
+type AttributionReportingIssueDetails = types.AuditsAttributionReportingIssueDetails
+
+// QuirksModeIssueDetails details for issues about documents in Quirks Mode or
+// Limited Quirks Mode that affects page layouting.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-QuirksModeIssueDetails
+type QuirksModeIssueDetails = types.AuditsQuirksModeIssueDetails
+
+// NavigatorUserAgentIssueDetails is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-NavigatorUserAgentIssueDetails
+//
+// Deprecated: this is deprecated in the protocol.
+type NavigatorUserAgentIssueDetails = types.AuditsNavigatorUserAgentIssueDetails
+
+// SharedDictionaryIssueDetails is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-SharedDictionaryIssueDetails
+type SharedDictionaryIssueDetails = types.AuditsSharedDictionaryIssueDetails
+
+// SRIMessageSignatureIssueDetails is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-SRIMessageSignatureIssueDetails
+type SRIMessageSignatureIssueDetails = types.AuditsSRIMessageSignatureIssueDetails
+
+// UnencodedDigestIssueDetails is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-UnencodedDigestIssueDetails
+type UnencodedDigestIssueDetails = types.AuditsUnencodedDigestIssueDetails
+
+// GenericIssueErrorType is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-GenericIssueErrorType
+type GenericIssueErrorType = types.AuditsGenericIssueErrorType
+
+// GenericIssueDetails depending on the concrete errorType, different properties
+// are set.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-GenericIssueDetails
+type GenericIssueDetails = types.AuditsGenericIssueDetails
+
+// DeprecationIssueDetails this issue tracks information needed to print a
+// deprecation message.
+// https://source.chromium.org/chromium/chromium/src/+/main:third_party/blink/renderer/core/frame/third_party/blink/renderer/core/frame/deprecation/README.md
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-DeprecationIssueDetails
+type DeprecationIssueDetails = types.AuditsDeprecationIssueDetails
+
+// BounceTrackingIssueDetails this issue warns about sites in the redirect chain
+// of a finished navigation that may be flagged as trackers and have their state
+// cleared if they don't receive a user interaction. Note that in this context
+// 'site' means eTLD+1. For example, if the URL `https://example.test:80/bounce`
+// was in the redirect chain, the site reported would be `example.test`.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-BounceTrackingIssueDetails
+type BounceTrackingIssueDetails = types.AuditsBounceTrackingIssueDetails
+
+// CookieDeprecationMetadataIssueDetails this issue warns about third-party
+// sites that are accessing cookies on the current page, and have been permitted
+// due to having a global metadata grant. Note that in this context 'site' means
+// eTLD+1. For example, if the URL `https://example.test:80/web_page` was
+// accessing cookies, the site reported would be `example.test`.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-CookieDeprecationMetadataIssueDetails
+type CookieDeprecationMetadataIssueDetails = types.AuditsCookieDeprecationMetadataIssueDetails
+
+// ClientHintIssueReason is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-ClientHintIssueReason
+type ClientHintIssueReason = types.AuditsClientHintIssueReason
+
+// FederatedAuthRequestIssueDetails is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-FederatedAuthRequestIssueDetails
+type FederatedAuthRequestIssueDetails = types.AuditsFederatedAuthRequestIssueDetails
+
+// FederatedAuthRequestIssueReason represents the failure reason when a
+// federated authentication reason fails. Should be updated alongside
+// RequestIdTokenStatus in
+// third_party/blink/public/mojom/devtools/inspector_issue.mojom to include all
+// cases except for success.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-FederatedAuthRequestIssueReason
+type FederatedAuthRequestIssueReason = types.AuditsFederatedAuthRequestIssueReason
+
+// FederatedAuthUserInfoRequestIssueDetails is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-FederatedAuthUserInfoRequestIssueDetails
+type FederatedAuthUserInfoRequestIssueDetails = types.AuditsFederatedAuthUserInfoRequestIssueDetails
+
+// FederatedAuthUserInfoRequestIssueReason represents the failure reason when a
+// getUserInfo() call fails. Should be updated alongside
+// FederatedAuthUserInfoRequestResult in
+// third_party/blink/public/mojom/devtools/inspector_issue.mojom.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-FederatedAuthUserInfoRequestIssueReason
+type FederatedAuthUserInfoRequestIssueReason = types.AuditsFederatedAuthUserInfoRequestIssueReason
+
+// ClientHintIssueDetails this issue tracks client hints related issues. It's
+// used to deprecate old features, encourage the use of new ones, and provide
+// general guidance.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-ClientHintIssueDetails
+type ClientHintIssueDetails = types.AuditsClientHintIssueDetails
+
+// FailedRequestInfo is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-FailedRequestInfo
+type FailedRequestInfo = types.AuditsFailedRequestInfo
+
+// PartitioningBlobURLInfo is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-PartitioningBlobURLInfo
+type PartitioningBlobURLInfo = types.AuditsPartitioningBlobURLInfo
+
+// PartitioningBlobURLIssueDetails is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-PartitioningBlobURLIssueDetails
+type PartitioningBlobURLIssueDetails = types.AuditsPartitioningBlobURLIssueDetails
+
+// ElementAccessibilityIssueReason is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-ElementAccessibilityIssueReason
+type ElementAccessibilityIssueReason = types.AuditsElementAccessibilityIssueReason
+
+// ElementAccessibilityIssueDetails this issue warns about errors in the select
+// or summary element content model.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-ElementAccessibilityIssueDetails
+type ElementAccessibilityIssueDetails = types.AuditsElementAccessibilityIssueDetails
+
+// StyleSheetLoadingIssueReason is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-StyleSheetLoadingIssueReason
+type StyleSheetLoadingIssueReason = types.AuditsStyleSheetLoadingIssueReason
+
+// StylesheetLoadingIssueDetails this issue warns when a referenced stylesheet
+// couldn't be loaded.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-StylesheetLoadingIssueDetails
+type StylesheetLoadingIssueDetails = types.AuditsStylesheetLoadingIssueDetails
+
+// PropertyRuleIssueReason is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-PropertyRuleIssueReason
+type PropertyRuleIssueReason = types.AuditsPropertyRuleIssueReason
+
+// PropertyRuleIssueDetails this issue warns about errors in property rules that
+// lead to property registrations being ignored.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-PropertyRuleIssueDetails
+type PropertyRuleIssueDetails = types.AuditsPropertyRuleIssueDetails
+
+// UserReidentificationIssueType is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-UserReidentificationIssueType
+type UserReidentificationIssueType = types.AuditsUserReidentificationIssueType
+
+// UserReidentificationIssueDetails this issue warns about uses of APIs that may
+// be considered misuse to re-identify users.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-UserReidentificationIssueDetails
+type UserReidentificationIssueDetails = types.AuditsUserReidentificationIssueDetails
+
+// InspectorIssueCode a unique identifier for the type of issue. Each type may
+// use one of the optional fields in InspectorIssueDetails to convey more
+// specific information about the kind of issue.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-InspectorIssueCode
+type InspectorIssueCode = types.AuditsInspectorIssueCode
+
+// InspectorIssueDetails this struct holds a list of optional fields with
+// additional information specific to the kind of issue. When adding a new issue
+// code, please also add a new optional field to this type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-InspectorIssueDetails
+type InspectorIssueDetails = types.AuditsInspectorIssueDetails
+
+// IssueID a unique id for a DevTools inspector issue. Allows other entities
+// (e.g. exceptions, CDP message, console messages, etc.) to reference an issue.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-IssueId
+type IssueID = types.AuditsIssueID
+
+// InspectorIssue an inspector issue reported from the back-end.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-InspectorIssue
+type InspectorIssue = types.AuditsInspectorIssue
+
+// Values of the enumerated types in the Audits domain.
+const (
+	CookieExclusionReasonExcludeSameSiteUnspecifiedTreatedAsLax                       = types.AuditsCookieExclusionReasonExcludeSameSiteUnspecifiedTreatedAsLax
+	CookieExclusionReasonExcludeSameSiteNoneInsecure                                  = types.AuditsCookieExclusionReasonExcludeSameSiteNoneInsecure
+	CookieExclusionReasonExcludeSameSiteLax                                           = types.AuditsCookieExclusionReasonExcludeSameSiteLax
+	CookieExclusionReasonExcludeSameSiteStrict                                        = types.AuditsCookieExclusionReasonExcludeSameSiteStrict
+	CookieExclusionReasonExcludeInvalidSameParty                                      = types.AuditsCookieExclusionReasonExcludeInvalidSameParty
+	CookieExclusionReasonExcludeSamePartyCrossPartyContext                            = types.AuditsCookieExclusionReasonExcludeSamePartyCrossPartyContext
+	CookieExclusionReasonExcludeDomainNonASCII                                        = types.AuditsCookieExclusionReasonExcludeDomainNonASCII
+	CookieExclusionReasonExcludeThirdPartyCookieBlockedInFirstPartySet                = types.AuditsCookieExclusionReasonExcludeThirdPartyCookieBlockedInFirstPartySet
+	CookieExclusionReasonExcludeThirdPartyPhaseout                                    = types.AuditsCookieExclusionReasonExcludeThirdPartyPhaseout
+	CookieExclusionReasonExcludePortMismatch                                          = types.AuditsCookieExclusionReasonExcludePortMismatch
+	CookieExclusionReasonExcludeSchemeMismatch                                        = types.AuditsCookieExclusionReasonExcludeSchemeMismatch
+	CookieWarningReasonWarnSameSiteUnspecifiedCrossSiteContext                        = types.AuditsCookieWarningReasonWarnSameSiteUnspecifiedCrossSiteContext
+	CookieWarningReasonWarnSameSiteNoneInsecure                                       = types.AuditsCookieWarningReasonWarnSameSiteNoneInsecure
+	CookieWarningReasonWarnSameSiteUnspecifiedLaxAllowUnsafe                          = types.AuditsCookieWarningReasonWarnSameSiteUnspecifiedLaxAllowUnsafe
+	CookieWarningReasonWarnSameSiteStrictLaxDowngradeStrict                           = types.AuditsCookieWarningReasonWarnSameSiteStrictLaxDowngradeStrict
+	CookieWarningReasonWarnSameSiteStrictCrossDowngradeStrict                         = types.AuditsCookieWarningReasonWarnSameSiteStrictCrossDowngradeStrict
+	CookieWarningReasonWarnSameSiteStrictCrossDowngradeLax                            = types.AuditsCookieWarningReasonWarnSameSiteStrictCrossDowngradeLax
+	CookieWarningReasonWarnSameSiteLaxCrossDowngradeStrict                            = types.AuditsCookieWarningReasonWarnSameSiteLaxCrossDowngradeStrict
+	CookieWarningReasonWarnSameSiteLaxCrossDowngradeLax                               = types.AuditsCookieWarningReasonWarnSameSiteLaxCrossDowngradeLax
+	CookieWarningReasonWarnAttributeValueExceedsMaxSize                               = types.AuditsCookieWarningReasonWarnAttributeValueExceedsMaxSize
+	CookieWarningReasonWarnDomainNonASCII                                             = types.AuditsCookieWarningReasonWarnDomainNonASCII
+	CookieWarningReasonWarnThirdPartyPhaseout                                         = types.AuditsCookieWarningReasonWarnThirdPartyPhaseout
+	CookieWarningReasonWarnCrossSiteRedirectDowngradeChangesInclusion                 = types.AuditsCookieWarningReasonWarnCrossSiteRedirectDowngradeChangesInclusion
+	CookieWarningReasonWarnDeprecationTrialMetadata                                   = types.AuditsCookieWarningReasonWarnDeprecationTrialMetadata
+	CookieWarningReasonWarnThirdPartyCookieHeuristic                                  = types.AuditsCookieWarningReasonWarnThirdPartyCookieHeuristic
+	CookieOperationSetCookie                                                          = types.AuditsCookieOperationSetCookie
+	CookieOperationReadCookie                                                         = types.AuditsCookieOperationReadCookie
+	InsightTypeGitHubResource                                                         = types.AuditsInsightTypeGitHubResource
+	InsightTypeGracePeriod                                                            = types.AuditsInsightTypeGracePeriod
+	InsightTypeHeuristics                                                             = types.AuditsInsightTypeHeuristics
+	MixedContentResolutionStatusMixedContentBlocked                                   = types.AuditsMixedContentResolutionStatusMixedContentBlocked
+	MixedContentResolutionStatusMixedContentAutomaticallyUpgraded                     = types.AuditsMixedContentResolutionStatusMixedContentAutomaticallyUpgraded
+	MixedContentResolutionStatusMixedContentWarning                                   = types.AuditsMixedContentResolutionStatusMixedContentWarning
+	MixedContentResourceTypeAttributionSrc                                            = types.AuditsMixedContentResourceTypeAttributionSrc
+	MixedContentResourceTypeAudio                                                     = types.AuditsMixedContentResourceTypeAudio
+	MixedContentResourceTypeBeacon                                                    = types.AuditsMixedContentResourceTypeBeacon
+	MixedContentResourceTypeCSPReport                                                 = types.AuditsMixedContentResourceTypeCSPReport
+	MixedContentResourceTypeDownload                                                  = types.AuditsMixedContentResourceTypeDownload
+	MixedContentResourceTypeEventSource                                               = types.AuditsMixedContentResourceTypeEventSource
+	MixedContentResourceTypeFavicon                                                   = types.AuditsMixedContentResourceTypeFavicon
+	MixedContentResourceTypeFont                                                      = types.AuditsMixedContentResourceTypeFont
+	MixedContentResourceTypeForm                                                      = types.AuditsMixedContentResourceTypeForm
+	MixedContentResourceTypeFrame                                                     = types.AuditsMixedContentResourceTypeFrame
+	MixedContentResourceTypeImage                                                     = types.AuditsMixedContentResourceTypeImage
+	MixedContentResourceTypeImport                                                    = types.AuditsMixedContentResourceTypeImport
+	MixedContentResourceTypeJSON                                                      = types.AuditsMixedContentResourceTypeJSON
+	MixedContentResourceTypeManifest                                                  = types.AuditsMixedContentResourceTypeManifest
+	MixedContentResourceTypePing                                                      = types.AuditsMixedContentResourceTypePing
+	MixedContentResourceTypePluginData                                                = types.AuditsMixedContentResourceTypePluginData
+	MixedContentResourceTypePluginResource                                            = types.AuditsMixedContentResourceTypePluginResource
+	MixedContentResourceTypePrefetch                                                  = types.AuditsMixedContentResourceTypePrefetch
+	MixedContentResourceTypeResource                                                  = types.AuditsMixedContentResourceTypeResource
+	MixedContentResourceTypeScript                                                    = types.AuditsMixedContentResourceTypeScript
+	MixedContentResourceTypeServiceWorker                                             = types.AuditsMixedContentResourceTypeServiceWorker
+	MixedContentResourceTypeSharedWorker                                              = types.AuditsMixedContentResourceTypeSharedWorker
+	MixedContentResourceTypeSpeculationRules                                          = types.AuditsMixedContentResourceTypeSpeculationRules
+	MixedContentResourceTypeStylesheet                                                = types.AuditsMixedContentResourceTypeStylesheet
+	MixedContentResourceTypeTrack                                                     = types.AuditsMixedContentResourceTypeTrack
+	MixedContentResourceTypeVideo                                                     = types.AuditsMixedContentResourceTypeVideo
+	MixedContentResourceTypeWorker                                                    = types.AuditsMixedContentResourceTypeWorker
+	MixedContentResourceTypeXMLHTTPRequest                                            = types.AuditsMixedContentResourceTypeXMLHTTPRequest
+	MixedContentResourceTypeXSLT                                                      = types.AuditsMixedContentResourceTypeXSLT
+	BlockedByResponseReasonCoepFrameResourceNeedsCoepHeader                           = types.AuditsBlockedByResponseReasonCoepFrameResourceNeedsCoepHeader
+	BlockedByResponseReasonCoopSandboxedIFrameCannotNavigateToCoopPage                = types.AuditsBlockedByResponseReasonCoopSandboxedIFrameCannotNavigateToCoopPage
+	BlockedByResponseReasonCorpNotSameOrigin                                          = types.AuditsBlockedByResponseReasonCorpNotSameOrigin
+	BlockedByResponseReasonCorpNotSameOriginAfterDefaultedToSameOriginByCoep          = types.AuditsBlockedByResponseReasonCorpNotSameOriginAfterDefaultedToSameOriginByCoep
+	BlockedByResponseReasonCorpNotSameOriginAfterDefaultedToSameOriginByDip           = types.AuditsBlockedByResponseReasonCorpNotSameOriginAfterDefaultedToSameOriginByDip
+	BlockedByResponseReasonCorpNotSameOriginAfterDefaultedToSameOriginByCoepAndDip    = types.AuditsBlockedByResponseReasonCorpNotSameOriginAfterDefaultedToSameOriginByCoepAndDip
+	BlockedByResponseReasonCorpNotSameSite                                            = types.AuditsBlockedByResponseReasonCorpNotSameSite
+	BlockedByResponseReasonSRIMessageSignatureMismatch                                = types.AuditsBlockedByResponseReasonSRIMessageSignatureMismatch
+	HeavyAdResolutionStatusHeavyAdBlocked                                             = types.AuditsHeavyAdResolutionStatusHeavyAdBlocked
+	HeavyAdResolutionStatusHeavyAdWarning                                             = types.AuditsHeavyAdResolutionStatusHeavyAdWarning
+	HeavyAdReasonNetworkTotalLimit                                                    = types.AuditsHeavyAdReasonNetworkTotalLimit
+	HeavyAdReasonCPUTotalLimit                                                        = types.AuditsHeavyAdReasonCPUTotalLimit
+	HeavyAdReasonCPUPeakLimit                                                         = types.AuditsHeavyAdReasonCPUPeakLimit
+	ContentSecurityPolicyViolationTypeKInlineViolation                                = types.AuditsContentSecurityPolicyViolationTypeKInlineViolation
+	ContentSecurityPolicyViolationTypeKEvalViolation                                  = types.AuditsContentSecurityPolicyViolationTypeKEvalViolation
+	ContentSecurityPolicyViolationTypeKURLViolation                                   = types.AuditsContentSecurityPolicyViolationTypeKURLViolation
+	ContentSecurityPolicyViolationTypeKSRIViolation                                   = types.AuditsContentSecurityPolicyViolationTypeKSRIViolation
+	ContentSecurityPolicyViolationTypeKTrustedTypesSinkViolation                      = types.AuditsContentSecurityPolicyViolationTypeKTrustedTypesSinkViolation
+	ContentSecurityPolicyViolationTypeKTrustedTypesPolicyViolation                    = types.AuditsContentSecurityPolicyViolationTypeKTrustedTypesPolicyViolation
+	ContentSecurityPolicyViolationTypeKWasmEvalViolation                              = types.AuditsContentSecurityPolicyViolationTypeKWasmEvalViolation
+	SharedArrayBufferIssueTypeTransferIssue                                           = types.AuditsSharedArrayBufferIssueTypeTransferIssue
+	SharedArrayBufferIssueTypeCreationIssue                                           = types.AuditsSharedArrayBufferIssueTypeCreationIssue
+	AttributionReportingIssueTypePermissionPolicyDisabled                             = types.AuditsAttributionReportingIssueTypePermissionPolicyDisabled
+	AttributionReportingIssueTypeUntrustworthyReportingOrigin                         = types.AuditsAttributionReportingIssueTypeUntrustworthyReportingOrigin
+	AttributionReportingIssueTypeInsecureContext                                      = types.AuditsAttributionReportingIssueTypeInsecureContext
+	AttributionReportingIssueTypeInvalidHeader                                        = types.AuditsAttributionReportingIssueTypeInvalidHeader
+	AttributionReportingIssueTypeInvalidRegisterTriggerHeader                         = types.AuditsAttributionReportingIssueTypeInvalidRegisterTriggerHeader
+	AttributionReportingIssueTypeSourceAndTriggerHeaders                              = types.AuditsAttributionReportingIssueTypeSourceAndTriggerHeaders
+	AttributionReportingIssueTypeSourceIgnored                                        = types.AuditsAttributionReportingIssueTypeSourceIgnored
+	AttributionReportingIssueTypeTriggerIgnored                                       = types.AuditsAttributionReportingIssueTypeTriggerIgnored
+	AttributionReportingIssueTypeOsSourceIgnored                                      = types.AuditsAttributionReportingIssueTypeOsSourceIgnored
+	AttributionReportingIssueTypeOsTriggerIgnored                                     = types.AuditsAttributionReportingIssueTypeOsTriggerIgnored
+	AttributionReportingIssueTypeInvalidRegisterOsSourceHeader                        = types.AuditsAttributionReportingIssueTypeInvalidRegisterOsSourceHeader
+	AttributionReportingIssueTypeInvalidRegisterOsTriggerHeader                       = types.AuditsAttributionReportingIssueTypeInvalidRegisterOsTriggerHeader
+	AttributionReportingIssueTypeWebAndOsHeaders                                      = types.AuditsAttributionReportingIssueTypeWebAndOsHeaders
+	AttributionReportingIssueTypeNoWebOrOsSupport                                     = types.AuditsAttributionReportingIssueTypeNoWebOrOsSupport
+	AttributionReportingIssueTypeNavigationRegistrationWithoutTransientUserActivation = types.AuditsAttributionReportingIssueTypeNavigationRegistrationWithoutTransientUserActivation
+	AttributionReportingIssueTypeInvalidInfoHeader                                    = types.AuditsAttributionReportingIssueTypeInvalidInfoHeader
+	AttributionReportingIssueTypeNoRegisterSourceHeader                               = types.AuditsAttributionReportingIssueTypeNoRegisterSourceHeader
+	AttributionReportingIssueTypeNoRegisterTriggerHeader                              = types.AuditsAttributionReportingIssueTypeNoRegisterTriggerHeader
+	AttributionReportingIssueTypeNoRegisterOsSourceHeader                             = types.AuditsAttributionReportingIssueTypeNoRegisterOsSourceHeader
+	AttributionReportingIssueTypeNoRegisterOsTriggerHeader                            = types.AuditsAttributionReportingIssueTypeNoRegisterOsTriggerHeader
+	AttributionReportingIssueTypeNavigationRegistrationUniqueScopeAlreadySet          = types.AuditsAttributionReportingIssueTypeNavigationRegistrationUniqueScopeAlreadySet
+	SharedDictionaryErrorUseErrorCrossOriginNoCorsRequest                             = types.AuditsSharedDictionaryErrorUseErrorCrossOriginNoCorsRequest
+	SharedDictionaryErrorUseErrorDictionaryLoadFailure                                = types.AuditsSharedDictionaryErrorUseErrorDictionaryLoadFailure
+	SharedDictionaryErrorUseErrorMatchingDictionaryNotUsed                            = types.AuditsSharedDictionaryErrorUseErrorMatchingDictionaryNotUsed
+	SharedDictionaryErrorUseErrorUnexpectedContentDictionaryHeader                    = types.AuditsSharedDictionaryErrorUseErrorUnexpectedContentDictionaryHeader
+	SharedDictionaryErrorWriteErrorCossOriginNoCorsRequest                            = types.AuditsSharedDictionaryErrorWriteErrorCossOriginNoCorsRequest
+	SharedDictionaryErrorWriteErrorDisallowedBySettings                               = types.AuditsSharedDictionaryErrorWriteErrorDisallowedBySettings
+	SharedDictionaryErrorWriteErrorExpiredResponse                                    = types.AuditsSharedDictionaryErrorWriteErrorExpiredResponse
+	SharedDictionaryErrorWriteErrorFeatureDisabled                                    = types.AuditsSharedDictionaryErrorWriteErrorFeatureDisabled
+	SharedDictionaryErrorWriteErrorInsufficientResources                              = types.AuditsSharedDictionaryErrorWriteErrorInsufficientResources
+	SharedDictionaryErrorWriteErrorInvalidMatchField                                  = types.AuditsSharedDictionaryErrorWriteErrorInvalidMatchField
+	SharedDictionaryErrorWriteErrorInvalidStructuredHeader                            = types.AuditsSharedDictionaryErrorWriteErrorInvalidStructuredHeader
+	SharedDictionaryErrorWriteErrorNavigationRequest                                  = types.AuditsSharedDictionaryErrorWriteErrorNavigationRequest
+	SharedDictionaryErrorWriteErrorNoMatchField                                       = types.AuditsSharedDictionaryErrorWriteErrorNoMatchField
+	SharedDictionaryErrorWriteErrorNonListMatchDestField                              = types.AuditsSharedDictionaryErrorWriteErrorNonListMatchDestField
+	SharedDictionaryErrorWriteErrorNonSecureContext                                   = types.AuditsSharedDictionaryErrorWriteErrorNonSecureContext
+	SharedDictionaryErrorWriteErrorNonStringIDField                                   = types.AuditsSharedDictionaryErrorWriteErrorNonStringIDField
+	SharedDictionaryErrorWriteErrorNonStringInMatchDestList                           = types.AuditsSharedDictionaryErrorWriteErrorNonStringInMatchDestList
+	SharedDictionaryErrorWriteErrorNonStringMatchField                                = types.AuditsSharedDictionaryErrorWriteErrorNonStringMatchField
+	SharedDictionaryErrorWriteErrorNonTokenTypeField                                  = types.AuditsSharedDictionaryErrorWriteErrorNonTokenTypeField
+	SharedDictionaryErrorWriteErrorRequestAborted                                     = types.AuditsSharedDictionaryErrorWriteErrorRequestAborted
+	SharedDictionaryErrorWriteErrorShuttingDown                                       = types.AuditsSharedDictionaryErrorWriteErrorShuttingDown
+	SharedDictionaryErrorWriteErrorTooLongIDField                                     = types.AuditsSharedDictionaryErrorWriteErrorTooLongIDField
+	SharedDictionaryErrorWriteErrorUnsupportedType                                    = types.AuditsSharedDictionaryErrorWriteErrorUnsupportedType
+	SRIMessageSignatureErrorMissingSignatureHeader                                    = types.AuditsSRIMessageSignatureErrorMissingSignatureHeader
+	SRIMessageSignatureErrorMissingSignatureInputHeader                               = types.AuditsSRIMessageSignatureErrorMissingSignatureInputHeader
+	SRIMessageSignatureErrorInvalidSignatureHeader                                    = types.AuditsSRIMessageSignatureErrorInvalidSignatureHeader
+	SRIMessageSignatureErrorInvalidSignatureInputHeader                               = types.AuditsSRIMessageSignatureErrorInvalidSignatureInputHeader
+	SRIMessageSignatureErrorSignatureHeaderValueIsNotByteSequence                     = types.AuditsSRIMessageSignatureErrorSignatureHeaderValueIsNotByteSequence
+	SRIMessageSignatureErrorSignatureHeaderValueIsParameterized                       = types.AuditsSRIMessageSignatureErrorSignatureHeaderValueIsParameterized
+	SRIMessageSignatureErrorSignatureHeaderValueIsIncorrectLength                     = types.AuditsSRIMessageSignatureErrorSignatureHeaderValueIsIncorrectLength
+	SRIMessageSignatureErrorSignatureInputHeaderMissingLabel                          = types.AuditsSRIMessageSignatureErrorSignatureInputHeaderMissingLabel
+	SRIMessageSignatureErrorSignatureInputHeaderValueNotInnerList                     = types.AuditsSRIMessageSignatureErrorSignatureInputHeaderValueNotInnerList
+	SRIMessageSignatureErrorSignatureInputHeaderValueMissingComponents                = types.AuditsSRIMessageSignatureErrorSignatureInputHeaderValueMissingComponents
+	SRIMessageSignatureErrorSignatureInputHeaderInvalidComponentType                  = types.AuditsSRIMessageSignatureErrorSignatureInputHeaderInvalidComponentType
+	SRIMessageSignatureErrorSignatureInputHeaderInvalidComponentName                  = types.AuditsSRIMessageSignatureErrorSignatureInputHeaderInvalidComponentName
+	SRIMessageSignatureErrorSignatureInputHeaderInvalidHeaderComponentParameter       = types.AuditsSRIMessageSignatureErrorSignatureInputHeaderInvalidHeaderComponentParameter
+	SRIMessageSignatureErrorSignatureInputHeaderInvalidDerivedComponentParameter      = types.AuditsSRIMessageSignatureErrorSignatureInputHeaderInvalidDerivedComponentParameter
+	SRIMessageSignatureErrorSignatureInputHeaderKeyIDLength                           = types.AuditsSRIMessageSignatureErrorSignatureInputHeaderKeyIDLength
+	SRIMessageSignatureErrorSignatureInputHeaderInvalidParameter                      = types.AuditsSRIMessageSignatureErrorSignatureInputHeaderInvalidParameter
+	SRIMessageSignatureErrorSignatureInputHeaderMissingRequiredParameters             = types.AuditsSRIMessageSignatureErrorSignatureInputHeaderMissingRequiredParameters
+	SRIMessageSignatureErrorValidationFailedSignatureExpired                          = types.AuditsSRIMessageSignatureErrorValidationFailedSignatureExpired
+	SRIMessageSignatureErrorValidationFailedInvalidLength                             = types.AuditsSRIMessageSignatureErrorValidationFailedInvalidLength
+	SRIMessageSignatureErrorValidationFailedSignatureMismatch                         = types.AuditsSRIMessageSignatureErrorValidationFailedSignatureMismatch
+	SRIMessageSignatureErrorValidationFailedIntegrityMismatch                         = types.AuditsSRIMessageSignatureErrorValidationFailedIntegrityMismatch
+	UnencodedDigestErrorMalformedDictionary                                           = types.AuditsUnencodedDigestErrorMalformedDictionary
+	UnencodedDigestErrorUnknownAlgorithm                                              = types.AuditsUnencodedDigestErrorUnknownAlgorithm
+	UnencodedDigestErrorIncorrectDigestType                                           = types.AuditsUnencodedDigestErrorIncorrectDigestType
+	UnencodedDigestErrorIncorrectDigestLength                                         = types.AuditsUnencodedDigestErrorIncorrectDigestLength
+	GenericIssueErrorTypeFormLabelForNameError                                        = types.AuditsGenericIssueErrorTypeFormLabelForNameError
+	GenericIssueErrorTypeFormDuplicateIDForInputError                                 = types.AuditsGenericIssueErrorTypeFormDuplicateIDForInputError
+	GenericIssueErrorTypeFormInputWithNoLabelError                                    = types.AuditsGenericIssueErrorTypeFormInputWithNoLabelError
+	GenericIssueErrorTypeFormAutocompleteAttributeEmptyError                          = types.AuditsGenericIssueErrorTypeFormAutocompleteAttributeEmptyError
+	GenericIssueErrorTypeFormEmptyIDAndNameAttributesForInputError                    = types.AuditsGenericIssueErrorTypeFormEmptyIDAndNameAttributesForInputError
+	GenericIssueErrorTypeFormAriaLabelledByToNonExistingID                            = types.AuditsGenericIssueErrorTypeFormAriaLabelledByToNonExistingID
+	GenericIssueErrorTypeFormInputAssignedAutocompleteValueToIDOrNameAttributeError   = types.AuditsGenericIssueErrorTypeFormInputAssignedAutocompleteValueToIDOrNameAttributeError
+	GenericIssueErrorTypeFormLabelHasNeitherForNorNestedInput                         = types.AuditsGenericIssueErrorTypeFormLabelHasNeitherForNorNestedInput
+	GenericIssueErrorTypeFormLabelForMatchesNonExistingIDError                        = types.AuditsGenericIssueErrorTypeFormLabelForMatchesNonExistingIDError
+	GenericIssueErrorTypeFormInputHasWrongButWellIntendedAutocompleteValueError       = types.AuditsGenericIssueErrorTypeFormInputHasWrongButWellIntendedAutocompleteValueError
+	GenericIssueErrorTypeResponseWasBlockedByORB                                      = types.AuditsGenericIssueErrorTypeResponseWasBlockedByORB
+	ClientHintIssueReasonMetaTagAllowListInvalidOrigin                                = types.AuditsClientHintIssueReasonMetaTagAllowListInvalidOrigin
+	ClientHintIssueReasonMetaTagModifiedHTML                                          = types.AuditsClientHintIssueReasonMetaTagModifiedHTML
+	FederatedAuthRequestIssueReasonShouldEmbargo                                      = types.AuditsFederatedAuthRequestIssueReasonShouldEmbargo
+	FederatedAuthRequestIssueReasonTooManyRequests                                    = types.AuditsFederatedAuthRequestIssueReasonTooManyRequests
+	FederatedAuthRequestIssueReasonWellKnownHTTPNotFound                              = types.AuditsFederatedAuthRequestIssueReasonWellKnownHTTPNotFound
+	FederatedAuthRequestIssueReasonWellKnownNoResponse                                = types.AuditsFederatedAuthRequestIssueReasonWellKnownNoResponse
+	FederatedAuthRequestIssueReasonWellKnownInvalidResponse                           = types.AuditsFederatedAuthRequestIssueReasonWellKnownInvalidResponse
+	FederatedAuthRequestIssueReasonWellKnownListEmpty                                 = types.AuditsFederatedAuthRequestIssueReasonWellKnownListEmpty
+	FederatedAuthRequestIssueReasonWellKnownInvalidContentType                        = types.AuditsFederatedAuthRequestIssueReasonWellKnownInvalidContentType
+	FederatedAuthRequestIssueReasonConfigNotInWellKnown                               = types.AuditsFederatedAuthRequestIssueReasonConfigNotInWellKnown
+	FederatedAuthRequestIssueReasonWellKnownTooBig                                    = types.AuditsFederatedAuthRequestIssueReasonWellKnownTooBig
+	FederatedAuthRequestIssueReasonConfigHTTPNotFound                                 = types.AuditsFederatedAuthRequestIssueReasonConfigHTTPNotFound
+	FederatedAuthRequestIssueReasonConfigNoResponse                                   = types.AuditsFederatedAuthRequestIssueReasonConfigNoResponse
+	FederatedAuthRequestIssueReasonConfigInvalidResponse                              = types.AuditsFederatedAuthRequestIssueReasonConfigInvalidResponse
+	FederatedAuthRequestIssueReasonConfigInvalidContentType                           = types.AuditsFederatedAuthRequestIssueReasonConfigInvalidContentType
+	FederatedAuthRequestIssueReasonClientMetadataHTTPNotFound                         = types.AuditsFederatedAuthRequestIssueReasonClientMetadataHTTPNotFound
+	FederatedAuthRequestIssueReasonClientMetadataNoResponse                           = types.AuditsFederatedAuthRequestIssueReasonClientMetadataNoResponse
+	FederatedAuthRequestIssueReasonClientMetadataInvalidResponse                      = types.AuditsFederatedAuthRequestIssueReasonClientMetadataInvalidResponse
+	FederatedAuthRequestIssueReasonClientMetadataInvalidContentType                   = types.AuditsFederatedAuthRequestIssueReasonClientMetadataInvalidContentType
+	FederatedAuthRequestIssueReasonIdpNotPotentiallyTrustworthy                       = types.AuditsFederatedAuthRequestIssueReasonIdpNotPotentiallyTrustworthy
+	FederatedAuthRequestIssueReasonDisabledInSettings                                 = types.AuditsFederatedAuthRequestIssueReasonDisabledInSettings
+	FederatedAuthRequestIssueReasonDisabledInFlags                                    = types.AuditsFederatedAuthRequestIssueReasonDisabledInFlags
+	FederatedAuthRequestIssueReasonErrorFetchingSignin                                = types.AuditsFederatedAuthRequestIssueReasonErrorFetchingSignin
+	FederatedAuthRequestIssueReasonInvalidSigninResponse                              = types.AuditsFederatedAuthRequestIssueReasonInvalidSigninResponse
+	FederatedAuthRequestIssueReasonAccountsHTTPNotFound                               = types.AuditsFederatedAuthRequestIssueReasonAccountsHTTPNotFound
+	FederatedAuthRequestIssueReasonAccountsNoResponse                                 = types.AuditsFederatedAuthRequestIssueReasonAccountsNoResponse
+	FederatedAuthRequestIssueReasonAccountsInvalidResponse                            = types.AuditsFederatedAuthRequestIssueReasonAccountsInvalidResponse
+	FederatedAuthRequestIssueReasonAccountsListEmpty                                  = types.AuditsFederatedAuthRequestIssueReasonAccountsListEmpty
+	FederatedAuthRequestIssueReasonAccountsInvalidContentType                         = types.AuditsFederatedAuthRequestIssueReasonAccountsInvalidContentType
+	FederatedAuthRequestIssueReasonIDTokenHTTPNotFound                                = types.AuditsFederatedAuthRequestIssueReasonIDTokenHTTPNotFound
+	FederatedAuthRequestIssueReasonIDTokenNoResponse                                  = types.AuditsFederatedAuthRequestIssueReasonIDTokenNoResponse
+	FederatedAuthRequestIssueReasonIDTokenInvalidResponse                             = types.AuditsFederatedAuthRequestIssueReasonIDTokenInvalidResponse
+	FederatedAuthRequestIssueReasonIDTokenIdpErrorResponse                            = types.AuditsFederatedAuthRequestIssueReasonIDTokenIdpErrorResponse
+	FederatedAuthRequestIssueReasonIDTokenCrossSiteIdpErrorResponse                   = types.AuditsFederatedAuthRequestIssueReasonIDTokenCrossSiteIdpErrorResponse
+	FederatedAuthRequestIssueReasonIDTokenInvalidRequest                              = types.AuditsFederatedAuthRequestIssueReasonIDTokenInvalidRequest
+	FederatedAuthRequestIssueReasonIDTokenInvalidContentType                          = types.AuditsFederatedAuthRequestIssueReasonIDTokenInvalidContentType
+	FederatedAuthRequestIssueReasonErrorIDToken                                       = types.AuditsFederatedAuthRequestIssueReasonErrorIDToken
+	FederatedAuthRequestIssueReasonCanceled                                           = types.AuditsFederatedAuthRequestIssueReasonCanceled
+	FederatedAuthRequestIssueReasonRpPageNotVisible                                   = types.AuditsFederatedAuthRequestIssueReasonRpPageNotVisible
+	FederatedAuthRequestIssueReasonSilentMediationFailure                             = types.AuditsFederatedAuthRequestIssueReasonSilentMediationFailure
+	FederatedAuthRequestIssueReasonThirdPartyCookiesBlocked                           = types.AuditsFederatedAuthRequestIssueReasonThirdPartyCookiesBlocked
+	FederatedAuthRequestIssueReasonNotSignedInWithIdp                                 = types.AuditsFederatedAuthRequestIssueReasonNotSignedInWithIdp
+	FederatedAuthRequestIssueReasonMissingTransientUserActivation                     = types.AuditsFederatedAuthRequestIssueReasonMissingTransientUserActivation
+	FederatedAuthRequestIssueReasonReplacedByActiveMode                               = types.AuditsFederatedAuthRequestIssueReasonReplacedByActiveMode
+	FederatedAuthRequestIssueReasonInvalidFieldsSpecified                             = types.AuditsFederatedAuthRequestIssueReasonInvalidFieldsSpecified
+	FederatedAuthRequestIssueReasonRelyingPartyOriginIsOpaque                         = types.AuditsFederatedAuthRequestIssueReasonRelyingPartyOriginIsOpaque
+	FederatedAuthRequestIssueReasonTypeNotMatching                                    = types.AuditsFederatedAuthRequestIssueReasonTypeNotMatching
+	FederatedAuthRequestIssueReasonUIDismissedNoEmbargo                               = types.AuditsFederatedAuthRequestIssueReasonUIDismissedNoEmbargo
+	FederatedAuthRequestIssueReasonCorsError                                          = types.AuditsFederatedAuthRequestIssueReasonCorsError
+	FederatedAuthRequestIssueReasonSuppressedBySegmentationPlatform                   = types.AuditsFederatedAuthRequestIssueReasonSuppressedBySegmentationPlatform
+	FederatedAuthUserInfoRequestIssueReasonNotSameOrigin                              = types.AuditsFederatedAuthUserInfoRequestIssueReasonNotSameOrigin
+	FederatedAuthUserInfoRequestIssueReasonNotIframe                                  = types.AuditsFederatedAuthUserInfoRequestIssueReasonNotIframe
+	FederatedAuthUserInfoRequestIssueReasonNotPotentiallyTrustworthy                  = types.AuditsFederatedAuthUserInfoRequestIssueReasonNotPotentiallyTrustworthy
+	FederatedAuthUserInfoRequestIssueReasonNoAPIPermission                            = types.AuditsFederatedAuthUserInfoRequestIssueReasonNoAPIPermission
+	FederatedAuthUserInfoRequestIssueReasonNotSignedInWithIdp                         = types.AuditsFederatedAuthUserInfoRequestIssueReasonNotSignedInWithIdp
+	FederatedAuthUserInfoRequestIssueReasonNoAccountSharingPermission                 = types.AuditsFederatedAuthUserInfoRequestIssueReasonNoAccountSharingPermission
+	FederatedAuthUserInfoRequestIssueReasonInvalidConfigOrWellKnown                   = types.AuditsFederatedAuthUserInfoRequestIssueReasonInvalidConfigOrWellKnown
+	FederatedAuthUserInfoRequestIssueReasonInvalidAccountsResponse                    = types.AuditsFederatedAuthUserInfoRequestIssueReasonInvalidAccountsResponse
+	FederatedAuthUserInfoRequestIssueReasonNoReturningUserFromFetchedAccounts         = types.AuditsFederatedAuthUserInfoRequestIssueReasonNoReturningUserFromFetchedAccounts
+	PartitioningBlobURLInfoBlockedCrossPartitionFetching                              = types.AuditsPartitioningBlobURLInfoBlockedCrossPartitionFetching
+	PartitioningBlobURLInfoEnforceNoopenerForNavigation                               = types.AuditsPartitioningBlobURLInfoEnforceNoopenerForNavigation
+	ElementAccessibilityIssueReasonDisallowedSelectChild                              = types.AuditsElementAccessibilityIssueReasonDisallowedSelectChild
+	ElementAccessibilityIssueReasonDisallowedOptGroupChild                            = types.AuditsElementAccessibilityIssueReasonDisallowedOptGroupChild
+	ElementAccessibilityIssueReasonNonPhrasingContentOptionChild                      = types.AuditsElementAccessibilityIssueReasonNonPhrasingContentOptionChild
+	ElementAccessibilityIssueReasonInteractiveContentOptionChild                      = types.AuditsElementAccessibilityIssueReasonInteractiveContentOptionChild
+	ElementAccessibilityIssueReasonInteractiveContentLegendChild                      = types.AuditsElementAccessibilityIssueReasonInteractiveContentLegendChild
+	ElementAccessibilityIssueReasonInteractiveContentSummaryDescendant                = types.AuditsElementAccessibilityIssueReasonInteractiveContentSummaryDescendant
+	StyleSheetLoadingIssueReasonLateImportRule                                        = types.AuditsStyleSheetLoadingIssueReasonLateImportRule
+	StyleSheetLoadingIssueReasonRequestFailed                                         = types.AuditsStyleSheetLoadingIssueReasonRequestFailed
+	PropertyRuleIssueReasonInvalidSyntax                                              = types.AuditsPropertyRuleIssueReasonInvalidSyntax
+	PropertyRuleIssueReasonInvalidInitialValue                                        = types.AuditsPropertyRuleIssueReasonInvalidInitialValue
+	PropertyRuleIssueReasonInvalidInherits                                            = types.AuditsPropertyRuleIssueReasonInvalidInherits
+	PropertyRuleIssueReasonInvalidName                                                = types.AuditsPropertyRuleIssueReasonInvalidName
+	UserReidentificationIssueTypeBlockedFrameNavigation                               = types.AuditsUserReidentificationIssueTypeBlockedFrameNavigation
+	UserReidentificationIssueTypeBlockedSubresource                                   = types.AuditsUserReidentificationIssueTypeBlockedSubresource
+	InspectorIssueCodeCookieIssue                                                     = types.AuditsInspectorIssueCodeCookieIssue
+	InspectorIssueCodeMixedContentIssue                                               = types.AuditsInspectorIssueCodeMixedContentIssue
+	InspectorIssueCodeBlockedByResponseIssue                                          = types.AuditsInspectorIssueCodeBlockedByResponseIssue
+	InspectorIssueCodeHeavyAdIssue                                                    = types.AuditsInspectorIssueCodeHeavyAdIssue
+	InspectorIssueCodeContentSecurityPolicyIssue                                      = types.AuditsInspectorIssueCodeContentSecurityPolicyIssue
+	InspectorIssueCodeSharedArrayBufferIssue                                          = types.AuditsInspectorIssueCodeSharedArrayBufferIssue
+	InspectorIssueCodeLowTextContrastIssue                                            = types.AuditsInspectorIssueCodeLowTextContrastIssue
+	InspectorIssueCodeCorsIssue                                                       = types.AuditsInspectorIssueCodeCorsIssue
+	InspectorIssueCodeAttributionReportingIssue                                       = types.AuditsInspectorIssueCodeAttributionReportingIssue
+	InspectorIssueCodeQuirksModeIssue                                                 = types.AuditsInspectorIssueCodeQuirksModeIssue
+	InspectorIssueCodePartitioningBlobURLIssue                                        = types.AuditsInspectorIssueCodePartitioningBlobURLIssue
+	InspectorIssueCodeNavigatorUserAgentIssue                                         = types.AuditsInspectorIssueCodeNavigatorUserAgentIssue
+	InspectorIssueCodeGenericIssue                                                    = types.AuditsInspectorIssueCodeGenericIssue
+	InspectorIssueCodeDeprecationIssue                                                = types.AuditsInspectorIssueCodeDeprecationIssue
+	InspectorIssueCodeClientHintIssue                                                 = types.AuditsInspectorIssueCodeClientHintIssue
+	InspectorIssueCodeFederatedAuthRequestIssue                                       = types.AuditsInspectorIssueCodeFederatedAuthRequestIssue
+	InspectorIssueCodeBounceTrackingIssue                                             = types.AuditsInspectorIssueCodeBounceTrackingIssue
+	InspectorIssueCodeCookieDeprecationMetadataIssue                                  = types.AuditsInspectorIssueCodeCookieDeprecationMetadataIssue
+	InspectorIssueCodeStylesheetLoadingIssue                                          = types.AuditsInspectorIssueCodeStylesheetLoadingIssue
+	InspectorIssueCodeFederatedAuthUserInfoRequestIssue                               = types.AuditsInspectorIssueCodeFederatedAuthUserInfoRequestIssue
+	InspectorIssueCodePropertyRuleIssue                                               = types.AuditsInspectorIssueCodePropertyRuleIssue
+	InspectorIssueCodeSharedDictionaryIssue                                           = types.AuditsInspectorIssueCodeSharedDictionaryIssue
+	InspectorIssueCodeElementAccessibilityIssue                                       = types.AuditsInspectorIssueCodeElementAccessibilityIssue
+	InspectorIssueCodeSRIMessageSignatureIssue                                        = types.AuditsInspectorIssueCodeSRIMessageSignatureIssue
+	InspectorIssueCodeUnencodedDigestIssue                                            = types.AuditsInspectorIssueCodeUnencodedDigestIssue
+	InspectorIssueCodeUserReidentificationIssue                                       = types.AuditsInspectorIssueCodeUserReidentificationIssue
+)
+
+// Method names of the commands in the Audits domain.
+const (
+	CommandGetEncodedResponse = "Audits.getEncodedResponse"
+	CommandDisable            = "Audits.disable"
+	CommandEnable             = "Audits.enable"
+	CommandCheckContrast      = "Audits.checkContrast"
+	CommandCheckFormsIssues   = "Audits.checkFormsIssues"
+)
+
+// GetEncodedResponseParams are the parameters of Audits.getEncodedResponse.
+//
+// Returns the response body and size if it were re-encoded with the specified
+// settings. Only applies to images.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Audits#method-getEncodedResponse
+type GetEncodedResponseParams struct {
+	// Identifier of the network request to get content for.
+	RequestID types.NetworkRequestID `json:"requestId"`
+	// The encoding to use.
+	//
+	// Allowed values: webp, jpeg, png.
+	Encoding string `json:"encoding"`
+	// The quality of the encoding (0-1). (defaults to 1)
+	Quality float64 `json:"quality,omitempty"`
+	// Whether to only return the size information (defaults to false).
+	SizeOnly bool `json:"sizeOnly,omitempty"`
+}
+
+// Command returns the Audits.getEncodedResponse command for use with
+// AsyncDebugger.Send.
+func (p GetEncodedResponseParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandGetEncodedResponse, p)
+}
+
+// GetEncodedResponseReturns are the values returned by Audits.getEncodedResponse.
+type GetEncodedResponseReturns struct {
+	// The encoded body as a base64 string. Omitted if sizeOnly is true. (Encoded
+	// as a base64 string when passed over JSON)
+	Body string `json:"body,omitempty"`
+	// Size before re-encoding.
+	OriginalSize int64 `json:"originalSize"`
+	// Size after re-encoding.
+	EncodedSize int64 `json:"encodedSize"`
+}
+
+// Do sends Audits.getEncodedResponse to d and waits for its result or for ctx to be
+// done.
+func (p GetEncodedResponseParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*GetEncodedResponseReturns, error) {
+	ret := &GetEncodedResponseReturns{}
+	if err := protocol.Do(ctx, d, CommandGetEncodedResponse, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// DisableParams are the parameters of Audits.disable.
+//
+// Disables issues domain, prevents further issues from being reported to the
+// client.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Audits#method-disable
+type DisableParams struct {
+}
+
+// Command returns the Audits.disable command for use with AsyncDebugger.Send.
+func (p DisableParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandDisable, p)
+}
+
+// Do sends Audits.disable to d and waits for it to complete or for ctx to
+// be done.
+func (p DisableParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandDisable, p, nil)
+}
+
+// EnableParams are the parameters of Audits.enable.
+//
+// Enables issues domain, sends the issues collected so far to the client by
+// means of the `issueAdded` event.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Audits#method-enable
+type EnableParams struct {
+}
+
+// Command returns the Audits.enable command for use with AsyncDebugger.Send.
+func (p EnableParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandEnable, p)
+}
+
+// Do sends Audits.enable to d and waits for it to complete or for ctx to
+// be done.
+func (p EnableParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandEnable, p, nil)
+}
+
+// CheckContrastParams are the parameters of Audits.checkContrast.
+//
+// Runs the contrast check for the target page. Found issues are reported using
+// Audits.issueAdded event.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Audits#method-checkContrast
+type CheckContrastParams struct {
+	// Whether to report WCAG AAA level issues. Default is false.
+	ReportAAA bool `json:"reportAAA,omitempty"`
+}
+
+// Command returns the Audits.checkContrast command for use with
+// AsyncDebugger.Send.
+func (p CheckContrastParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandCheckContrast, p)
+}
+
+// Do sends Audits.checkContrast to d and waits for it to complete or for ctx to
+// be done.
+func (p CheckContrastParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandCheckContrast, p, nil)
+}
+
+// CheckFormsIssuesParams are the parameters of Audits.checkFormsIssues.
+//
+// Runs the form issues check for the target page. Found issues are reported
+// using Audits.issueAdded event.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Audits#method-checkFormsIssues
+type CheckFormsIssuesParams struct {
+}
+
+// Command returns the Audits.checkFormsIssues command for use with
+// AsyncDebugger.Send.
+func (p CheckFormsIssuesParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandCheckFormsIssues, p)
+}
+
+// CheckFormsIssuesReturns are the values returned by Audits.checkFormsIssues.
+type CheckFormsIssuesReturns struct {
+	FormIssues []GenericIssueDetails `json:"formIssues"`
+}
+
+// Do sends Audits.checkFormsIssues to d and waits for its result or for ctx to be
+// done.
+func (p CheckFormsIssuesParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*CheckFormsIssuesReturns, error) {
+	ret := &CheckFormsIssuesReturns{}
+	if err := protocol.Do(ctx, d, CommandCheckFormsIssues, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// Method names of the events in the Audits domain.
+const (
+	EventIssueAdded = "Audits.issueAdded"
+)
+
+// IssueAddedEvent is the payload of the Audits.issueAdded event.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Audits#event-issueAdded
+type IssueAddedEvent struct {
+	Issue InspectorIssue `json:"issue"`
+}
+
+// ParseEvent decodes an event from the Audits domain, as received from
+// Subscribe, into a pointer to its typed payload.
+func ParseEvent(cmd chromedebugo.Command) (interface{}, error) {
+	var ev interface{}
+	switch cmd.Method {
+	case EventIssueAdded:
+		ev = &IssueAddedEvent{}
+	default:
+		return nil, fmt.Errorf("unknown Audits event: %s", cmd.Method)
+	}
+	if err := cmd.Decode(ev); err != nil {
+		return nil, err
+	}
+	return ev, nil
+}
@@ -0,0 +1,196 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+// Package autofill provides typed bindings for the Autofill domain of the
+// Chrome DevTools protocol.
+//
+// Defines commands and events for Autofill.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Autofill
+//
+// This is an experimental part of the protocol.
+package autofill
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tonyhb/chromedebugo"
+	"github.com/tonyhb/chromedebugo/protocol"
+	"github.com/tonyhb/chromedebugo/protocol/internal/types"
+)
+
+// CreditCard is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Autofill#type-CreditCard
+type CreditCard = types.AutofillCreditCard
+
+// AddressField is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Autofill#type-AddressField
+type AddressField = types.AutofillAddressField
+
+// AddressFields a list of address fields.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Autofill#type-AddressFields
+type AddressFields = types.AutofillAddressFields
+
+// Address is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Autofill#type-Address
+type Address = types.AutofillAddress
+
+// AddressUI defines how an address can be displayed like in
+// chrome://settings/addresses. Address UI is a two dimensional array, each
+// inner array is an "address information line", and when rendered in a UI
+// surface should be displayed as such. The following address UI for instance:
+// [[{name: "GIVE_NAME", value: "Jon"}, {name: "FAMILY_NAME", value: "Doe"}],
+// [{name: "CITY", value: "Munich"}, {name: "ZIP", value: "81456"}]] should
+// allow the receiver to render: Jon Doe Munich 81456
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Autofill#type-AddressUI
+type AddressUI = types.AutofillAddressUI
+
+// FillingStrategy specified whether a filled field was done so by using the
+// html autocomplete attribute or autofill heuristics.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Autofill#type-FillingStrategy
+type FillingStrategy = types.AutofillFillingStrategy
+
+// FilledField is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Autofill#type-FilledField
+type FilledField = types.AutofillFilledField
+
+// Values of the enumerated types in the Autofill domain.
+const (
+	FillingStrategyAutocompleteAttribute = types.AutofillFillingStrategyAutocompleteAttribute
+	FillingStrategyAutofillInferred      = types.AutofillFillingStrategyAutofillInferred
+)
+
+// Method names of the commands in the Autofill domain.
+const (
+	CommandTrigger      = "Autofill.trigger"
+	CommandSetAddresses = "Autofill.setAddresses"
+	CommandDisable      = "Autofill.disable"
+	CommandEnable       = "Autofill.enable"
+)
+
+// TriggerParams are the parameters of Autofill.trigger.
+//
+// Trigger autofill on a form identified by the fieldId. If the field and
+// related form cannot be autofilled, returns an error.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Autofill#method-trigger
+type TriggerParams struct {
+	// Identifies a field that serves as an anchor for autofill.
+	FieldID types.DOMBackendNodeID `json:"fieldId"`
+	// Identifies the frame that field belongs to.
+	FrameID types.PageFrameID `json:"frameId,omitempty"`
+	// Credit card information to fill out the form. Credit card data is not saved.
+	Card CreditCard `json:"card"`
+}
+
+// Command returns the Autofill.trigger command for use with AsyncDebugger.Send.
+func (p TriggerParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandTrigger, p)
+}
+
+// Do sends Autofill.trigger to d and waits for it to complete or for ctx to
+// be done.
+func (p TriggerParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandTrigger, p, nil)
+}
+
+// SetAddressesParams are the parameters of Autofill.setAddresses.
+//
+// Set addresses so that developers can verify their forms implementation.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Autofill#method-setAddresses
+type SetAddressesParams struct {
+	Addresses []Address `json:"addresses"`
+}
+
+// Command returns the Autofill.setAddresses command for use with
+// AsyncDebugger.Send.
+func (p SetAddressesParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetAddresses, p)
+}
+
+// Do sends Autofill.setAddresses to d and waits for it to complete or for ctx to
+// be done.
+func (p SetAddressesParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetAddresses, p, nil)
+}
+
+// DisableParams are the parameters of Autofill.disable.
+//
+// Disables autofill domain notifications.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Autofill#method-disable
+type DisableParams struct {
+}
+
+// Command returns the Autofill.disable command for use with AsyncDebugger.Send.
+func (p DisableParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandDisable, p)
+}
+
+// Do sends Autofill.disable to d and waits for it to complete or for ctx to
+// be done.
+func (p DisableParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandDisable, p, nil)
+}
+
+// EnableParams are the parameters of Autofill.enable.
+//
+// Enables autofill domain notifications.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Autofill#method-enable
+type EnableParams struct {
+}
+
+// Command returns the Autofill.enable command for use with AsyncDebugger.Send.
+func (p EnableParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandEnable, p)
+}
+
+// Do sends Autofill.enable to d and waits for it to complete or for ctx to
+// be done.
+func (p EnableParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandEnable, p, nil)
+}
+
+// Method names of the events in the Autofill domain.
+const (
+	EventAddressFormFilled = "Autofill.addressFormFilled"
+)
+
+// AddressFormFilledEvent is the payload of the Autofill.addressFormFilled
+// event.
+//
+// Emitted when an address form is filled.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Autofill#event-addressFormFilled
+type AddressFormFilledEvent struct {
+	// Information about the fields that were filled
+	FilledFields []FilledField `json:"filledFields"`
+	// An UI representation of the address used to fill the form. Consists of a 2D
+	// array where each child represents an address/profile line.
+	AddressUI AddressUI `json:"addressUi"`
+}
+
+// ParseEvent decodes an event from the Autofill domain, as received from
+// Subscribe, into a pointer to its typed payload.
+func ParseEvent(cmd chromedebugo.Command) (interface{}, error) {
+	var ev interface{}
+	switch cmd.Method {
+	case EventAddressFormFilled:
+		ev = &AddressFormFilledEvent{}
+	default:
+		return nil, fmt.Errorf("unknown Autofill event: %s", cmd.Method)
+	}
+	if err := cmd.Decode(ev); err != nil {
+		return nil, err
+	}
+	return ev, nil
+}
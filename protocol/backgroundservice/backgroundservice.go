@@ -0,0 +1,187 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+// Package backgroundservice provides typed bindings for the BackgroundService
+// domain of the Chrome DevTools protocol.
+//
+// Defines events for background web platform features.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/BackgroundService
+//
+// This is an experimental part of the protocol.
+package backgroundservice
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tonyhb/chromedebugo"
+	"github.com/tonyhb/chromedebugo/protocol"
+	"github.com/tonyhb/chromedebugo/protocol/internal/types"
+)
+
+// ServiceName the Background Service that will be associated with the
+// commands/events. Every Background Service operates independently, but they
+// share the same API.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/BackgroundService#type-ServiceName
+type ServiceName = types.BackgroundServiceServiceName
+
+// EventMetadata a key-value pair for additional event information to pass
+// along.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/BackgroundService#type-EventMetadata
+type EventMetadata = types.BackgroundServiceEventMetadata
+
+// BackgroundServiceEvent is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/BackgroundService#type-BackgroundServiceEvent
+type BackgroundServiceEvent = types.BackgroundServiceBackgroundServiceEvent
+
+// Values of the enumerated types in the BackgroundService domain.
+const (
+	ServiceNameBackgroundFetch        = types.BackgroundServiceServiceNameBackgroundFetch
+	ServiceNameBackgroundSync         = types.BackgroundServiceServiceNameBackgroundSync
+	ServiceNamePushMessaging          = types.BackgroundServiceServiceNamePushMessaging
+	ServiceNameNotifications          = types.BackgroundServiceServiceNameNotifications
+	ServiceNamePaymentHandler         = types.BackgroundServiceServiceNamePaymentHandler
+	ServiceNamePeriodicBackgroundSync = types.BackgroundServiceServiceNamePeriodicBackgroundSync
+)
+
+// Method names of the commands in the BackgroundService domain.
+const (
+	CommandStartObserving = "BackgroundService.startObserving"
+	CommandStopObserving  = "BackgroundService.stopObserving"
+	CommandSetRecording   = "BackgroundService.setRecording"
+	CommandClearEvents    = "BackgroundService.clearEvents"
+)
+
+// StartObservingParams are the parameters of BackgroundService.startObserving.
+//
+// Enables event updates for the service.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/BackgroundService#method-startObserving
+type StartObservingParams struct {
+	Service ServiceName `json:"service"`
+}
+
+// Command returns the BackgroundService.startObserving command for use with
+// AsyncDebugger.Send.
+func (p StartObservingParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandStartObserving, p)
+}
+
+// Do sends BackgroundService.startObserving to d and waits for it to complete or for ctx to
+// be done.
+func (p StartObservingParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandStartObserving, p, nil)
+}
+
+// StopObservingParams are the parameters of BackgroundService.stopObserving.
+//
+// Disables event updates for the service.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/BackgroundService#method-stopObserving
+type StopObservingParams struct {
+	Service ServiceName `json:"service"`
+}
+
+// Command returns the BackgroundService.stopObserving command for use with
+// AsyncDebugger.Send.
+func (p StopObservingParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandStopObserving, p)
+}
+
+// Do sends BackgroundService.stopObserving to d and waits for it to complete or for ctx to
+// be done.
+func (p StopObservingParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandStopObserving, p, nil)
+}
+
+// SetRecordingParams are the parameters of BackgroundService.setRecording.
+//
+// Set the recording state for the service.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/BackgroundService#method-setRecording
+type SetRecordingParams struct {
+	ShouldRecord bool        `json:"shouldRecord"`
+	Service      ServiceName `json:"service"`
+}
+
+// Command returns the BackgroundService.setRecording command for use with
+// AsyncDebugger.Send.
+func (p SetRecordingParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetRecording, p)
+}
+
+// Do sends BackgroundService.setRecording to d and waits for it to complete or for ctx to
+// be done.
+func (p SetRecordingParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetRecording, p, nil)
+}
+
+// ClearEventsParams are the parameters of BackgroundService.clearEvents.
+//
+// Clears all stored data for the service.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/BackgroundService#method-clearEvents
+type ClearEventsParams struct {
+	Service ServiceName `json:"service"`
+}
+
+// Command returns the BackgroundService.clearEvents command for use with
+// AsyncDebugger.Send.
+func (p ClearEventsParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandClearEvents, p)
+}
+
+// Do sends BackgroundService.clearEvents to d and waits for it to complete or for ctx to
+// be done.
+func (p ClearEventsParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandClearEvents, p, nil)
+}
+
+// Method names of the events in the BackgroundService domain.
+const (
+	EventRecordingStateChanged          = "BackgroundService.recordingStateChanged"
+	EventBackgroundServiceEventReceived = "BackgroundService.backgroundServiceEventReceived"
+)
+
+// RecordingStateChangedEvent is the payload of the
+// BackgroundService.recordingStateChanged event.
+//
+// Called when the recording state for the service has been updated.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/BackgroundService#event-recordingStateChanged
+type RecordingStateChangedEvent struct {
+	IsRecording bool        `json:"isRecording"`
+	Service     ServiceName `json:"service"`
+}
+
+// BackgroundServiceEventReceivedEvent is the payload of the
+// BackgroundService.backgroundServiceEventReceived event.
+//
+// Called with all existing backgroundServiceEvents when enabled, and all new
+// events afterwards if enabled and recording.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/BackgroundService#event-backgroundServiceEventReceived
+type BackgroundServiceEventReceivedEvent struct {
+	BackgroundServiceEvent BackgroundServiceEvent `json:"backgroundServiceEvent"`
+}
+
+// ParseEvent decodes an event from the BackgroundService domain, as received from
+// Subscribe, into a pointer to its typed payload.
+func ParseEvent(cmd chromedebugo.Command) (interface{}, error) {
+	var ev interface{}
+	switch cmd.Method {
+	case EventRecordingStateChanged:
+		ev = &RecordingStateChangedEvent{}
+	case EventBackgroundServiceEventReceived:
+		ev = &BackgroundServiceEventReceivedEvent{}
+	default:
+		return nil, fmt.Errorf("unknown BackgroundService event: %s", cmd.Method)
+	}
+	if err := cmd.Decode(ev); err != nil {
+		return nil, err
+	}
+	return ev, nil
+}
@@ -0,0 +1,564 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+// Package bluetoothemulation provides typed bindings for the BluetoothEmulation
+// domain of the Chrome DevTools protocol.
+//
+// This domain allows configuring virtual Bluetooth devices to test the
+// web-bluetooth API.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/BluetoothEmulation
+//
+// This is an experimental part of the protocol.
+package bluetoothemulation
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tonyhb/chromedebugo"
+	"github.com/tonyhb/chromedebugo/protocol"
+	"github.com/tonyhb/chromedebugo/protocol/internal/types"
+)
+
+// CentralState indicates the various states of Central.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/BluetoothEmulation#type-CentralState
+type CentralState = types.BluetoothEmulationCentralState
+
+// GATTOperationType indicates the various types of GATT event.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/BluetoothEmulation#type-GATTOperationType
+type GATTOperationType = types.BluetoothEmulationGATTOperationType
+
+// CharacteristicWriteType indicates the various types of characteristic write.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/BluetoothEmulation#type-CharacteristicWriteType
+type CharacteristicWriteType = types.BluetoothEmulationCharacteristicWriteType
+
+// CharacteristicOperationType indicates the various types of characteristic
+// operation.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/BluetoothEmulation#type-CharacteristicOperationType
+type CharacteristicOperationType = types.BluetoothEmulationCharacteristicOperationType
+
+// DescriptorOperationType indicates the various types of descriptor operation.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/BluetoothEmulation#type-DescriptorOperationType
+type DescriptorOperationType = types.BluetoothEmulationDescriptorOperationType
+
+// ManufacturerData stores the manufacturer data
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/BluetoothEmulation#type-ManufacturerData
+type ManufacturerData = types.BluetoothEmulationManufacturerData
+
+// ScanRecord stores the byte data of the advertisement packet sent by a
+// Bluetooth device.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/BluetoothEmulation#type-ScanRecord
+type ScanRecord = types.BluetoothEmulationScanRecord
+
+// ScanEntry stores the advertisement packet information that is sent by a
+// Bluetooth device.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/BluetoothEmulation#type-ScanEntry
+type ScanEntry = types.BluetoothEmulationScanEntry
+
+// CharacteristicProperties describes the properties of a characteristic. This
+// follows Bluetooth Core Specification BT 4.2 Vol 3 Part G 3.3.1.
+// Characteristic Properties.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/BluetoothEmulation#type-CharacteristicProperties
+type CharacteristicProperties = types.BluetoothEmulationCharacteristicProperties
+
+// Values of the enumerated types in the BluetoothEmulation domain.
+const (
+	CentralStateAbsent                                      = types.BluetoothEmulationCentralStateAbsent
+	CentralStatePoweredOff                                  = types.BluetoothEmulationCentralStatePoweredOff
+	CentralStatePoweredOn                                   = types.BluetoothEmulationCentralStatePoweredOn
+	GATTOperationTypeConnection                             = types.BluetoothEmulationGATTOperationTypeConnection
+	GATTOperationTypeDiscovery                              = types.BluetoothEmulationGATTOperationTypeDiscovery
+	CharacteristicWriteTypeWriteDefaultDeprecated           = types.BluetoothEmulationCharacteristicWriteTypeWriteDefaultDeprecated
+	CharacteristicWriteTypeWriteWithResponse                = types.BluetoothEmulationCharacteristicWriteTypeWriteWithResponse
+	CharacteristicWriteTypeWriteWithoutResponse             = types.BluetoothEmulationCharacteristicWriteTypeWriteWithoutResponse
+	CharacteristicOperationTypeRead                         = types.BluetoothEmulationCharacteristicOperationTypeRead
+	CharacteristicOperationTypeWrite                        = types.BluetoothEmulationCharacteristicOperationTypeWrite
+	CharacteristicOperationTypeSubscribeToNotifications     = types.BluetoothEmulationCharacteristicOperationTypeSubscribeToNotifications
+	CharacteristicOperationTypeUnsubscribeFromNotifications = types.BluetoothEmulationCharacteristicOperationTypeUnsubscribeFromNotifications
+	DescriptorOperationTypeRead                             = types.BluetoothEmulationDescriptorOperationTypeRead
+	DescriptorOperationTypeWrite                            = types.BluetoothEmulationDescriptorOperationTypeWrite
+)
+
+// Method names of the commands in the BluetoothEmulation domain.
+const (
+	CommandEnable                                  = "BluetoothEmulation.enable"
+	CommandSetSimulatedCentralState                = "BluetoothEmulation.setSimulatedCentralState"
+	CommandDisable                                 = "BluetoothEmulation.disable"
+	CommandSimulatePreconnectedPeripheral          = "BluetoothEmulation.simulatePreconnectedPeripheral"
+	CommandSimulateAdvertisement                   = "BluetoothEmulation.simulateAdvertisement"
+	CommandSimulateGATTOperationResponse           = "BluetoothEmulation.simulateGATTOperationResponse"
+	CommandSimulateCharacteristicOperationResponse = "BluetoothEmulation.simulateCharacteristicOperationResponse"
+	CommandSimulateDescriptorOperationResponse     = "BluetoothEmulation.simulateDescriptorOperationResponse"
+	CommandAddService                              = "BluetoothEmulation.addService"
+	CommandRemoveService                           = "BluetoothEmulation.removeService"
+	CommandAddCharacteristic                       = "BluetoothEmulation.addCharacteristic"
+	CommandRemoveCharacteristic                    = "BluetoothEmulation.removeCharacteristic"
+	CommandAddDescriptor                           = "BluetoothEmulation.addDescriptor"
+	CommandRemoveDescriptor                        = "BluetoothEmulation.removeDescriptor"
+	CommandSimulateGATTDisconnection               = "BluetoothEmulation.simulateGATTDisconnection"
+)
+
+// EnableParams are the parameters of BluetoothEmulation.enable.
+//
+// Enable the BluetoothEmulation domain.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/BluetoothEmulation#method-enable
+type EnableParams struct {
+	// State of the simulated central.
+	State CentralState `json:"state"`
+	// If the simulated central supports low-energy.
+	LeSupported bool `json:"leSupported"`
+}
+
+// Command returns the BluetoothEmulation.enable command for use with
+// AsyncDebugger.Send.
+func (p EnableParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandEnable, p)
+}
+
+// Do sends BluetoothEmulation.enable to d and waits for it to complete or for ctx to
+// be done.
+func (p EnableParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandEnable, p, nil)
+}
+
+// SetSimulatedCentralStateParams are the parameters of
+// BluetoothEmulation.setSimulatedCentralState.
+//
+// Set the state of the simulated central.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/BluetoothEmulation#method-setSimulatedCentralState
+type SetSimulatedCentralStateParams struct {
+	// State of the simulated central.
+	State CentralState `json:"state"`
+}
+
+// Command returns the BluetoothEmulation.setSimulatedCentralState command for
+// use with AsyncDebugger.Send.
+func (p SetSimulatedCentralStateParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetSimulatedCentralState, p)
+}
+
+// Do sends BluetoothEmulation.setSimulatedCentralState to d and waits for it to complete or for ctx to
+// be done.
+func (p SetSimulatedCentralStateParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetSimulatedCentralState, p, nil)
+}
+
+// DisableParams are the parameters of BluetoothEmulation.disable.
+//
+// Disable the BluetoothEmulation domain.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/BluetoothEmulation#method-disable
+type DisableParams struct {
+}
+
+// Command returns the BluetoothEmulation.disable command for use with
+// AsyncDebugger.Send.
+func (p DisableParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandDisable, p)
+}
+
+// Do sends BluetoothEmulation.disable to d and waits for it to complete or for ctx to
+// be done.
+func (p DisableParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandDisable, p, nil)
+}
+
+// SimulatePreconnectedPeripheralParams are the parameters of
+// BluetoothEmulation.simulatePreconnectedPeripheral.
+//
+// Simulates a peripheral with |address|, |name| and |knownServiceUuids| that
+// has already been connected to the system.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/BluetoothEmulation#method-simulatePreconnectedPeripheral
+type SimulatePreconnectedPeripheralParams struct {
+	Address           string             `json:"address"`
+	Name              string             `json:"name"`
+	ManufacturerData  []ManufacturerData `json:"manufacturerData"`
+	KnownServiceUuids []string           `json:"knownServiceUuids"`
+}
+
+// Command returns the BluetoothEmulation.simulatePreconnectedPeripheral command
+// for use with AsyncDebugger.Send.
+func (p SimulatePreconnectedPeripheralParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSimulatePreconnectedPeripheral, p)
+}
+
+// Do sends BluetoothEmulation.simulatePreconnectedPeripheral to d and waits for it to complete or for ctx to
+// be done.
+func (p SimulatePreconnectedPeripheralParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSimulatePreconnectedPeripheral, p, nil)
+}
+
+// SimulateAdvertisementParams are the parameters of
+// BluetoothEmulation.simulateAdvertisement.
+//
+// Simulates an advertisement packet described in |entry| being received by the
+// central.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/BluetoothEmulation#method-simulateAdvertisement
+type SimulateAdvertisementParams struct {
+	Entry ScanEntry `json:"entry"`
+}
+
+// Command returns the BluetoothEmulation.simulateAdvertisement command for use
+// with AsyncDebugger.Send.
+func (p SimulateAdvertisementParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSimulateAdvertisement, p)
+}
+
+// Do sends BluetoothEmulation.simulateAdvertisement to d and waits for it to complete or for ctx to
+// be done.
+func (p SimulateAdvertisementParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSimulateAdvertisement, p, nil)
+}
+
+// SimulateGATTOperationResponseParams are the parameters of
+// BluetoothEmulation.simulateGATTOperationResponse.
+//
+// Simulates the response code from the peripheral with |address| for a GATT
+// operation of |type|. The |code| value follows the HCI Error Codes from
+// Bluetooth Core Specification Vol 2 Part D 1.3 List Of Error Codes.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/BluetoothEmulation#method-simulateGATTOperationResponse
+type SimulateGATTOperationResponseParams struct {
+	Address string            `json:"address"`
+	Type    GATTOperationType `json:"type"`
+	Code    int64             `json:"code"`
+}
+
+// Command returns the BluetoothEmulation.simulateGATTOperationResponse command
+// for use with AsyncDebugger.Send.
+func (p SimulateGATTOperationResponseParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSimulateGATTOperationResponse, p)
+}
+
+// Do sends BluetoothEmulation.simulateGATTOperationResponse to d and waits for it to complete or for ctx to
+// be done.
+func (p SimulateGATTOperationResponseParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSimulateGATTOperationResponse, p, nil)
+}
+
+// SimulateCharacteristicOperationResponseParams are the parameters of
+// BluetoothEmulation.simulateCharacteristicOperationResponse.
+//
+// Simulates the response from the characteristic with |characteristicId| for a
+// characteristic operation of |type|. The |code| value follows the Error Codes
+// from Bluetooth Core Specification Vol 3 Part F 3.4.1.1 Error Response. The
+// |data| is expected to exist when simulating a successful read operation
+// response.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/BluetoothEmulation#method-simulateCharacteristicOperationResponse
+type SimulateCharacteristicOperationResponseParams struct {
+	CharacteristicID string                      `json:"characteristicId"`
+	Type             CharacteristicOperationType `json:"type"`
+	Code             int64                       `json:"code"`
+	Data             string                      `json:"data,omitempty"`
+}
+
+// Command returns the
+// BluetoothEmulation.simulateCharacteristicOperationResponse command for use
+// with AsyncDebugger.Send.
+func (p SimulateCharacteristicOperationResponseParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSimulateCharacteristicOperationResponse, p)
+}
+
+// Do sends BluetoothEmulation.simulateCharacteristicOperationResponse to d and waits for it to complete or for ctx to
+// be done.
+func (p SimulateCharacteristicOperationResponseParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSimulateCharacteristicOperationResponse, p, nil)
+}
+
+// SimulateDescriptorOperationResponseParams are the parameters of
+// BluetoothEmulation.simulateDescriptorOperationResponse.
+//
+// Simulates the response from the descriptor with |descriptorId| for a
+// descriptor operation of |type|. The |code| value follows the Error Codes from
+// Bluetooth Core Specification Vol 3 Part F 3.4.1.1 Error Response. The |data|
+// is expected to exist when simulating a successful read operation response.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/BluetoothEmulation#method-simulateDescriptorOperationResponse
+type SimulateDescriptorOperationResponseParams struct {
+	DescriptorID string                  `json:"descriptorId"`
+	Type         DescriptorOperationType `json:"type"`
+	Code         int64                   `json:"code"`
+	Data         string                  `json:"data,omitempty"`
+}
+
+// Command returns the BluetoothEmulation.simulateDescriptorOperationResponse
+// command for use with AsyncDebugger.Send.
+func (p SimulateDescriptorOperationResponseParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSimulateDescriptorOperationResponse, p)
+}
+
+// Do sends BluetoothEmulation.simulateDescriptorOperationResponse to d and waits for it to complete or for ctx to
+// be done.
+func (p SimulateDescriptorOperationResponseParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSimulateDescriptorOperationResponse, p, nil)
+}
+
+// AddServiceParams are the parameters of BluetoothEmulation.addService.
+//
+// Adds a service with |serviceUuid| to the peripheral with |address|.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/BluetoothEmulation#method-addService
+type AddServiceParams struct {
+	Address     string `json:"address"`
+	ServiceUUID string `json:"serviceUuid"`
+}
+
+// Command returns the BluetoothEmulation.addService command for use with
+// AsyncDebugger.Send.
+func (p AddServiceParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandAddService, p)
+}
+
+// AddServiceReturns are the values returned by BluetoothEmulation.addService.
+type AddServiceReturns struct {
+	// An identifier that uniquely represents this service.
+	ServiceID string `json:"serviceId"`
+}
+
+// Do sends BluetoothEmulation.addService to d and waits for its result or for ctx to be
+// done.
+func (p AddServiceParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*AddServiceReturns, error) {
+	ret := &AddServiceReturns{}
+	if err := protocol.Do(ctx, d, CommandAddService, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// RemoveServiceParams are the parameters of BluetoothEmulation.removeService.
+//
+// Removes the service respresented by |serviceId| from the simulated central.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/BluetoothEmulation#method-removeService
+type RemoveServiceParams struct {
+	ServiceID string `json:"serviceId"`
+}
+
+// Command returns the BluetoothEmulation.removeService command for use with
+// AsyncDebugger.Send.
+func (p RemoveServiceParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandRemoveService, p)
+}
+
+// Do sends BluetoothEmulation.removeService to d and waits for it to complete or for ctx to
+// be done.
+func (p RemoveServiceParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandRemoveService, p, nil)
+}
+
+// AddCharacteristicParams are the parameters of
+// BluetoothEmulation.addCharacteristic.
+//
+// Adds a characteristic with |characteristicUuid| and |properties| to the
+// service represented by |serviceId|.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/BluetoothEmulation#method-addCharacteristic
+type AddCharacteristicParams struct {
+	ServiceID          string                   `json:"serviceId"`
+	CharacteristicUUID string                   `json:"characteristicUuid"`
+	Properties         CharacteristicProperties `json:"properties"`
+}
+
+// Command returns the BluetoothEmulation.addCharacteristic command for use with
+// AsyncDebugger.Send.
+func (p AddCharacteristicParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandAddCharacteristic, p)
+}
+
+// AddCharacteristicReturns are the values returned by BluetoothEmulation.addCharacteristic.
+type AddCharacteristicReturns struct {
+	// An identifier that uniquely represents this characteristic.
+	CharacteristicID string `json:"characteristicId"`
+}
+
+// Do sends BluetoothEmulation.addCharacteristic to d and waits for its result or for ctx to be
+// done.
+func (p AddCharacteristicParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*AddCharacteristicReturns, error) {
+	ret := &AddCharacteristicReturns{}
+	if err := protocol.Do(ctx, d, CommandAddCharacteristic, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// RemoveCharacteristicParams are the parameters of
+// BluetoothEmulation.removeCharacteristic.
+//
+// Removes the characteristic respresented by |characteristicId| from the
+// simulated central.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/BluetoothEmulation#method-removeCharacteristic
+type RemoveCharacteristicParams struct {
+	CharacteristicID string `json:"characteristicId"`
+}
+
+// Command returns the BluetoothEmulation.removeCharacteristic command for use
+// with AsyncDebugger.Send.
+func (p RemoveCharacteristicParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandRemoveCharacteristic, p)
+}
+
+// Do sends BluetoothEmulation.removeCharacteristic to d and waits for it to complete or for ctx to
+// be done.
+func (p RemoveCharacteristicParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandRemoveCharacteristic, p, nil)
+}
+
+// AddDescriptorParams are the parameters of BluetoothEmulation.addDescriptor.
+//
+// Adds a descriptor with |descriptorUuid| to the characteristic respresented by
+// |characteristicId|.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/BluetoothEmulation#method-addDescriptor
+type AddDescriptorParams struct {
+	CharacteristicID string `json:"characteristicId"`
+	DescriptorUUID   string `json:"descriptorUuid"`
+}
+
+// Command returns the BluetoothEmulation.addDescriptor command for use with
+// AsyncDebugger.Send.
+func (p AddDescriptorParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandAddDescriptor, p)
+}
+
+// AddDescriptorReturns are the values returned by BluetoothEmulation.addDescriptor.
+type AddDescriptorReturns struct {
+	// An identifier that uniquely represents this descriptor.
+	DescriptorID string `json:"descriptorId"`
+}
+
+// Do sends BluetoothEmulation.addDescriptor to d and waits for its result or for ctx to be
+// done.
+func (p AddDescriptorParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*AddDescriptorReturns, error) {
+	ret := &AddDescriptorReturns{}
+	if err := protocol.Do(ctx, d, CommandAddDescriptor, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// RemoveDescriptorParams are the parameters of
+// BluetoothEmulation.removeDescriptor.
+//
+// Removes the descriptor with |descriptorId| from the simulated central.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/BluetoothEmulation#method-removeDescriptor
+type RemoveDescriptorParams struct {
+	DescriptorID string `json:"descriptorId"`
+}
+
+// Command returns the BluetoothEmulation.removeDescriptor command for use with
+// AsyncDebugger.Send.
+func (p RemoveDescriptorParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandRemoveDescriptor, p)
+}
+
+// Do sends BluetoothEmulation.removeDescriptor to d and waits for it to complete or for ctx to
+// be done.
+func (p RemoveDescriptorParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandRemoveDescriptor, p, nil)
+}
+
+// SimulateGATTDisconnectionParams are the parameters of
+// BluetoothEmulation.simulateGATTDisconnection.
+//
+// Simulates a GATT disconnection from the peripheral with |address|.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/BluetoothEmulation#method-simulateGATTDisconnection
+type SimulateGATTDisconnectionParams struct {
+	Address string `json:"address"`
+}
+
+// Command returns the BluetoothEmulation.simulateGATTDisconnection command for
+// use with AsyncDebugger.Send.
+func (p SimulateGATTDisconnectionParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSimulateGATTDisconnection, p)
+}
+
+// Do sends BluetoothEmulation.simulateGATTDisconnection to d and waits for it to complete or for ctx to
+// be done.
+func (p SimulateGATTDisconnectionParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSimulateGATTDisconnection, p, nil)
+}
+
+// Method names of the events in the BluetoothEmulation domain.
+const (
+	EventGattOperationReceived           = "BluetoothEmulation.gattOperationReceived"
+	EventCharacteristicOperationReceived = "BluetoothEmulation.characteristicOperationReceived"
+	EventDescriptorOperationReceived     = "BluetoothEmulation.descriptorOperationReceived"
+)
+
+// GattOperationReceivedEvent is the payload of the
+// BluetoothEmulation.gattOperationReceived event.
+//
+// Event for when a GATT operation of |type| to the peripheral with |address|
+// happened.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/BluetoothEmulation#event-gattOperationReceived
+type GattOperationReceivedEvent struct {
+	Address string            `json:"address"`
+	Type    GATTOperationType `json:"type"`
+}
+
+// CharacteristicOperationReceivedEvent is the payload of the
+// BluetoothEmulation.characteristicOperationReceived event.
+//
+// Event for when a characteristic operation of |type| to the characteristic
+// respresented by |characteristicId| happened. |data| and |writeType| is
+// expected to exist when |type| is write.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/BluetoothEmulation#event-characteristicOperationReceived
+type CharacteristicOperationReceivedEvent struct {
+	CharacteristicID string                      `json:"characteristicId"`
+	Type             CharacteristicOperationType `json:"type"`
+	Data             string                      `json:"data,omitempty"`
+	WriteType        CharacteristicWriteType     `json:"writeType,omitempty"`
+}
+
+// DescriptorOperationReceivedEvent is the payload of the
+// BluetoothEmulation.descriptorOperationReceived event.
+//
+// Event for when a descriptor operation of |type| to the descriptor
+// respresented by |descriptorId| happened. |data| is expected to exist when
+// |type| is write.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/BluetoothEmulation#event-descriptorOperationReceived
+type DescriptorOperationReceivedEvent struct {
+	DescriptorID string                  `json:"descriptorId"`
+	Type         DescriptorOperationType `json:"type"`
+	Data         string                  `json:"data,omitempty"`
+}
+
+// ParseEvent decodes an event from the BluetoothEmulation domain, as received from
+// Subscribe, into a pointer to its typed payload.
+func ParseEvent(cmd chromedebugo.Command) (interface{}, error) {
+	var ev interface{}
+	switch cmd.Method {
+	case EventGattOperationReceived:
+		ev = &GattOperationReceivedEvent{}
+	case EventCharacteristicOperationReceived:
+		ev = &CharacteristicOperationReceivedEvent{}
+	case EventDescriptorOperationReceived:
+		ev = &DescriptorOperationReceivedEvent{}
+	default:
+		return nil, fmt.Errorf("unknown BluetoothEmulation event: %s", cmd.Method)
+	}
+	if err := cmd.Decode(ev); err != nil {
+		return nil, err
+	}
+	return ev, nil
+}
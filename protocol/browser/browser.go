@@ -0,0 +1,827 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+// Package browser provides typed bindings for the Browser domain of the Chrome
+// DevTools protocol.
+//
+// The Browser domain defines methods and events for browser managing.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Browser
+package browser
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tonyhb/chromedebugo"
+	"github.com/tonyhb/chromedebugo/protocol"
+	"github.com/tonyhb/chromedebugo/protocol/internal/types"
+)
+
+// BrowserContextID is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Browser#type-BrowserContextID
+//
+// This is an experimental part of the protocol.
+type BrowserContextID = types.BrowserBrowserContextID
+
+// WindowID is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Browser#type-WindowID
+//
+// This is an experimental part of the protocol.
+type WindowID = types.BrowserWindowID
+
+// WindowState the state of the browser window.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Browser#type-WindowState
+//
+// This is an experimental part of the protocol.
+type WindowState = types.BrowserWindowState
+
+// Bounds browser window bounds information
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Browser#type-Bounds
+//
+// This is an experimental part of the protocol.
+type Bounds = types.BrowserBounds
+
+// PermissionType is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Browser#type-PermissionType
+//
+// This is an experimental part of the protocol.
+type PermissionType = types.BrowserPermissionType
+
+// PermissionSetting is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Browser#type-PermissionSetting
+//
+// This is an experimental part of the protocol.
+type PermissionSetting = types.BrowserPermissionSetting
+
+// PermissionDescriptor definition of PermissionDescriptor defined in the
+// Permissions API: https://w3c.github.io/permissions/#dom-permissiondescriptor.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Browser#type-PermissionDescriptor
+//
+// This is an experimental part of the protocol.
+type PermissionDescriptor = types.BrowserPermissionDescriptor
+
+// BrowserCommandID browser command ids used by executeBrowserCommand.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Browser#type-BrowserCommandId
+//
+// This is an experimental part of the protocol.
+type BrowserCommandID = types.BrowserBrowserCommandID
+
+// Bucket chrome histogram bucket.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Browser#type-Bucket
+//
+// This is an experimental part of the protocol.
+type Bucket = types.BrowserBucket
+
+// Histogram chrome histogram.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Browser#type-Histogram
+//
+// This is an experimental part of the protocol.
+type Histogram = types.BrowserHistogram
+
+// PrivacySandboxAPI is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Browser#type-PrivacySandboxAPI
+//
+// This is an experimental part of the protocol.
+type PrivacySandboxAPI = types.BrowserPrivacySandboxAPI
+
+// Values of the enumerated types in the Browser domain.
+const (
+	WindowStateNormal                          = types.BrowserWindowStateNormal
+	WindowStateMinimized                       = types.BrowserWindowStateMinimized
+	WindowStateMaximized                       = types.BrowserWindowStateMaximized
+	WindowStateFullscreen                      = types.BrowserWindowStateFullscreen
+	PermissionTypeAr                           = types.BrowserPermissionTypeAr
+	PermissionTypeAudioCapture                 = types.BrowserPermissionTypeAudioCapture
+	PermissionTypeAutomaticFullscreen          = types.BrowserPermissionTypeAutomaticFullscreen
+	PermissionTypeBackgroundFetch              = types.BrowserPermissionTypeBackgroundFetch
+	PermissionTypeBackgroundSync               = types.BrowserPermissionTypeBackgroundSync
+	PermissionTypeCameraPanTiltZoom            = types.BrowserPermissionTypeCameraPanTiltZoom
+	PermissionTypeCapturedSurfaceControl       = types.BrowserPermissionTypeCapturedSurfaceControl
+	PermissionTypeClipboardReadWrite           = types.BrowserPermissionTypeClipboardReadWrite
+	PermissionTypeClipboardSanitizedWrite      = types.BrowserPermissionTypeClipboardSanitizedWrite
+	PermissionTypeDisplayCapture               = types.BrowserPermissionTypeDisplayCapture
+	PermissionTypeDurableStorage               = types.BrowserPermissionTypeDurableStorage
+	PermissionTypeGeolocation                  = types.BrowserPermissionTypeGeolocation
+	PermissionTypeHandTracking                 = types.BrowserPermissionTypeHandTracking
+	PermissionTypeIdleDetection                = types.BrowserPermissionTypeIdleDetection
+	PermissionTypeKeyboardLock                 = types.BrowserPermissionTypeKeyboardLock
+	PermissionTypeLocalFonts                   = types.BrowserPermissionTypeLocalFonts
+	PermissionTypeLocalNetworkAccess           = types.BrowserPermissionTypeLocalNetworkAccess
+	PermissionTypeMidi                         = types.BrowserPermissionTypeMidi
+	PermissionTypeMidiSysex                    = types.BrowserPermissionTypeMidiSysex
+	PermissionTypeNfc                          = types.BrowserPermissionTypeNfc
+	PermissionTypeNotifications                = types.BrowserPermissionTypeNotifications
+	PermissionTypePaymentHandler               = types.BrowserPermissionTypePaymentHandler
+	PermissionTypePeriodicBackgroundSync       = types.BrowserPermissionTypePeriodicBackgroundSync
+	PermissionTypePointerLock                  = types.BrowserPermissionTypePointerLock
+	PermissionTypeProtectedMediaIdentifier     = types.BrowserPermissionTypeProtectedMediaIdentifier
+	PermissionTypeSensors                      = types.BrowserPermissionTypeSensors
+	PermissionTypeSmartCard                    = types.BrowserPermissionTypeSmartCard
+	PermissionTypeSpeakerSelection             = types.BrowserPermissionTypeSpeakerSelection
+	PermissionTypeStorageAccess                = types.BrowserPermissionTypeStorageAccess
+	PermissionTypeTopLevelStorageAccess        = types.BrowserPermissionTypeTopLevelStorageAccess
+	PermissionTypeVideoCapture                 = types.BrowserPermissionTypeVideoCapture
+	PermissionTypeVr                           = types.BrowserPermissionTypeVr
+	PermissionTypeWakeLockScreen               = types.BrowserPermissionTypeWakeLockScreen
+	PermissionTypeWakeLockSystem               = types.BrowserPermissionTypeWakeLockSystem
+	PermissionTypeWebAppInstallation           = types.BrowserPermissionTypeWebAppInstallation
+	PermissionTypeWebPrinting                  = types.BrowserPermissionTypeWebPrinting
+	PermissionTypeWindowManagement             = types.BrowserPermissionTypeWindowManagement
+	PermissionSettingGranted                   = types.BrowserPermissionSettingGranted
+	PermissionSettingDenied                    = types.BrowserPermissionSettingDenied
+	PermissionSettingPrompt                    = types.BrowserPermissionSettingPrompt
+	BrowserCommandIDOpenTabSearch              = types.BrowserBrowserCommandIDOpenTabSearch
+	BrowserCommandIDCloseTabSearch             = types.BrowserBrowserCommandIDCloseTabSearch
+	BrowserCommandIDOpenGlic                   = types.BrowserBrowserCommandIDOpenGlic
+	PrivacySandboxAPIBiddingAndAuctionServices = types.BrowserPrivacySandboxAPIBiddingAndAuctionServices
+	PrivacySandboxAPITrustedKeyValue           = types.BrowserPrivacySandboxAPITrustedKeyValue
+)
+
+// Method names of the commands in the Browser domain.
+const (
+	CommandSetPermission                         = "Browser.setPermission"
+	CommandGrantPermissions                      = "Browser.grantPermissions"
+	CommandResetPermissions                      = "Browser.resetPermissions"
+	CommandSetDownloadBehavior                   = "Browser.setDownloadBehavior"
+	CommandCancelDownload                        = "Browser.cancelDownload"
+	CommandClose                                 = "Browser.close"
+	CommandCrash                                 = "Browser.crash"
+	CommandCrashGPUProcess                       = "Browser.crashGpuProcess"
+	CommandGetVersion                            = "Browser.getVersion"
+	CommandGetBrowserCommandLine                 = "Browser.getBrowserCommandLine"
+	CommandGetHistograms                         = "Browser.getHistograms"
+	CommandGetHistogram                          = "Browser.getHistogram"
+	CommandGetWindowBounds                       = "Browser.getWindowBounds"
+	CommandGetWindowForTarget                    = "Browser.getWindowForTarget"
+	CommandSetWindowBounds                       = "Browser.setWindowBounds"
+	CommandSetContentsSize                       = "Browser.setContentsSize"
+	CommandSetDockTile                           = "Browser.setDockTile"
+	CommandExecuteBrowserCommand                 = "Browser.executeBrowserCommand"
+	CommandAddPrivacySandboxEnrollmentOverride   = "Browser.addPrivacySandboxEnrollmentOverride"
+	CommandAddPrivacySandboxCoordinatorKeyConfig = "Browser.addPrivacySandboxCoordinatorKeyConfig"
+)
+
+// SetPermissionParams are the parameters of Browser.setPermission.
+//
+// Set permission settings for given origin.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Browser#method-setPermission
+//
+// This is an experimental part of the protocol.
+type SetPermissionParams struct {
+	// Descriptor of permission to override.
+	Permission PermissionDescriptor `json:"permission"`
+	// Setting of the permission.
+	Setting PermissionSetting `json:"setting"`
+	// Origin the permission applies to, all origins if not specified.
+	Origin string `json:"origin,omitempty"`
+	// Context to override. When omitted, default browser context is used.
+	BrowserContextID BrowserContextID `json:"browserContextId,omitempty"`
+}
+
+// Command returns the Browser.setPermission command for use with
+// AsyncDebugger.Send.
+func (p SetPermissionParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetPermission, p)
+}
+
+// Do sends Browser.setPermission to d and waits for it to complete or for ctx to
+// be done.
+func (p SetPermissionParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetPermission, p, nil)
+}
+
+// GrantPermissionsParams are the parameters of Browser.grantPermissions.
+//
+// Grant specific permissions to the given origin and reject all others.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Browser#method-grantPermissions
+//
+// This is an experimental part of the protocol.
+type GrantPermissionsParams struct {
+	Permissions []PermissionType `json:"permissions"`
+	// Origin the permission applies to, all origins if not specified.
+	Origin string `json:"origin,omitempty"`
+	// BrowserContext to override permissions. When omitted, default browser
+	// context is used.
+	BrowserContextID BrowserContextID `json:"browserContextId,omitempty"`
+}
+
+// Command returns the Browser.grantPermissions command for use with
+// AsyncDebugger.Send.
+func (p GrantPermissionsParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandGrantPermissions, p)
+}
+
+// Do sends Browser.grantPermissions to d and waits for it to complete or for ctx to
+// be done.
+func (p GrantPermissionsParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandGrantPermissions, p, nil)
+}
+
+// ResetPermissionsParams are the parameters of Browser.resetPermissions.
+//
+// Reset all permission management for all origins.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Browser#method-resetPermissions
+type ResetPermissionsParams struct {
+	// BrowserContext to reset permissions. When omitted, default browser context
+	// is used.
+	BrowserContextID BrowserContextID `json:"browserContextId,omitempty"`
+}
+
+// Command returns the Browser.resetPermissions command for use with
+// AsyncDebugger.Send.
+func (p ResetPermissionsParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandResetPermissions, p)
+}
+
+// Do sends Browser.resetPermissions to d and waits for it to complete or for ctx to
+// be done.
+func (p ResetPermissionsParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandResetPermissions, p, nil)
+}
+
+// SetDownloadBehaviorParams are the parameters of Browser.setDownloadBehavior.
+//
+// Set the behavior when downloading a file.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Browser#method-setDownloadBehavior
+//
+// This is an experimental part of the protocol.
+type SetDownloadBehaviorParams struct {
+	// Whether to allow all or deny all download requests, or use default Chrome
+	// behavior if available (otherwise deny). |allowAndName| allows download and
+	// names files according to their download guids.
+	//
+	// Allowed values: deny, allow, allowAndName, default.
+	Behavior string `json:"behavior"`
+	// BrowserContext to set download behavior. When omitted, default browser
+	// context is used.
+	BrowserContextID BrowserContextID `json:"browserContextId,omitempty"`
+	// The default path to save downloaded files to. This is required if behavior
+	// is set to 'allow' or 'allowAndName'.
+	DownloadPath string `json:"downloadPath,omitempty"`
+	// Whether to emit download events (defaults to false).
+	EventsEnabled bool `json:"eventsEnabled,omitempty"`
+}
+
+// Command returns the Browser.setDownloadBehavior command for use with
+// AsyncDebugger.Send.
+func (p SetDownloadBehaviorParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetDownloadBehavior, p)
+}
+
+// Do sends Browser.setDownloadBehavior to d and waits for it to complete or for ctx to
+// be done.
+func (p SetDownloadBehaviorParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetDownloadBehavior, p, nil)
+}
+
+// CancelDownloadParams are the parameters of Browser.cancelDownload.
+//
+// # Cancel a download if in progress
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Browser#method-cancelDownload
+//
+// This is an experimental part of the protocol.
+type CancelDownloadParams struct {
+	// Global unique identifier of the download.
+	Guid string `json:"guid"`
+	// BrowserContext to perform the action in. When omitted, default browser
+	// context is used.
+	BrowserContextID BrowserContextID `json:"browserContextId,omitempty"`
+}
+
+// Command returns the Browser.cancelDownload command for use with
+// AsyncDebugger.Send.
+func (p CancelDownloadParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandCancelDownload, p)
+}
+
+// Do sends Browser.cancelDownload to d and waits for it to complete or for ctx to
+// be done.
+func (p CancelDownloadParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandCancelDownload, p, nil)
+}
+
+// CloseParams are the parameters of Browser.close.
+//
+// Close browser gracefully.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Browser#method-close
+type CloseParams struct {
+}
+
+// Command returns the Browser.close command for use with AsyncDebugger.Send.
+func (p CloseParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandClose, p)
+}
+
+// Do sends Browser.close to d and waits for it to complete or for ctx to
+// be done.
+func (p CloseParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandClose, p, nil)
+}
+
+// CrashParams are the parameters of Browser.crash.
+//
+// Crashes browser on the main thread.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Browser#method-crash
+//
+// This is an experimental part of the protocol.
+type CrashParams struct {
+}
+
+// Command returns the Browser.crash command for use with AsyncDebugger.Send.
+func (p CrashParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandCrash, p)
+}
+
+// Do sends Browser.crash to d and waits for it to complete or for ctx to
+// be done.
+func (p CrashParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandCrash, p, nil)
+}
+
+// CrashGPUProcessParams are the parameters of Browser.crashGpuProcess.
+//
+// Crashes GPU process.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Browser#method-crashGpuProcess
+//
+// This is an experimental part of the protocol.
+type CrashGPUProcessParams struct {
+}
+
+// Command returns the Browser.crashGpuProcess command for use with
+// AsyncDebugger.Send.
+func (p CrashGPUProcessParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandCrashGPUProcess, p)
+}
+
+// Do sends Browser.crashGpuProcess to d and waits for it to complete or for ctx to
+// be done.
+func (p CrashGPUProcessParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandCrashGPUProcess, p, nil)
+}
+
+// GetVersionParams are the parameters of Browser.getVersion.
+//
+// Returns version information.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Browser#method-getVersion
+type GetVersionParams struct {
+}
+
+// Command returns the Browser.getVersion command for use with
+// AsyncDebugger.Send.
+func (p GetVersionParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandGetVersion, p)
+}
+
+// GetVersionReturns are the values returned by Browser.getVersion.
+type GetVersionReturns struct {
+	// Protocol version.
+	ProtocolVersion string `json:"protocolVersion"`
+	// Product name.
+	Product string `json:"product"`
+	// Product revision.
+	Revision string `json:"revision"`
+	// User-Agent.
+	UserAgent string `json:"userAgent"`
+	// V8 version.
+	JSVersion string `json:"jsVersion"`
+}
+
+// Do sends Browser.getVersion to d and waits for its result or for ctx to be
+// done.
+func (p GetVersionParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*GetVersionReturns, error) {
+	ret := &GetVersionReturns{}
+	if err := protocol.Do(ctx, d, CommandGetVersion, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// GetBrowserCommandLineParams are the parameters of
+// Browser.getBrowserCommandLine.
+//
+// Returns the command line switches for the browser process if, and only if
+// --enable-automation is on the commandline.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Browser#method-getBrowserCommandLine
+//
+// This is an experimental part of the protocol.
+type GetBrowserCommandLineParams struct {
+}
+
+// Command returns the Browser.getBrowserCommandLine command for use with
+// AsyncDebugger.Send.
+func (p GetBrowserCommandLineParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandGetBrowserCommandLine, p)
+}
+
+// GetBrowserCommandLineReturns are the values returned by Browser.getBrowserCommandLine.
+type GetBrowserCommandLineReturns struct {
+	// Commandline parameters
+	Arguments []string `json:"arguments"`
+}
+
+// Do sends Browser.getBrowserCommandLine to d and waits for its result or for ctx to be
+// done.
+func (p GetBrowserCommandLineParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*GetBrowserCommandLineReturns, error) {
+	ret := &GetBrowserCommandLineReturns{}
+	if err := protocol.Do(ctx, d, CommandGetBrowserCommandLine, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// GetHistogramsParams are the parameters of Browser.getHistograms.
+//
+// Get Chrome histograms.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Browser#method-getHistograms
+//
+// This is an experimental part of the protocol.
+type GetHistogramsParams struct {
+	// Requested substring in name. Only histograms which have query as a substring
+	// in their name are extracted. An empty or absent query returns all
+	// histograms.
+	Query string `json:"query,omitempty"`
+	// If true, retrieve delta since last delta call.
+	Delta bool `json:"delta,omitempty"`
+}
+
+// Command returns the Browser.getHistograms command for use with
+// AsyncDebugger.Send.
+func (p GetHistogramsParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandGetHistograms, p)
+}
+
+// GetHistogramsReturns are the values returned by Browser.getHistograms.
+type GetHistogramsReturns struct {
+	// Histograms.
+	Histograms []Histogram `json:"histograms"`
+}
+
+// Do sends Browser.getHistograms to d and waits for its result or for ctx to be
+// done.
+func (p GetHistogramsParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*GetHistogramsReturns, error) {
+	ret := &GetHistogramsReturns{}
+	if err := protocol.Do(ctx, d, CommandGetHistograms, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// GetHistogramParams are the parameters of Browser.getHistogram.
+//
+// Get a Chrome histogram by name.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Browser#method-getHistogram
+//
+// This is an experimental part of the protocol.
+type GetHistogramParams struct {
+	// Requested histogram name.
+	Name string `json:"name"`
+	// If true, retrieve delta since last delta call.
+	Delta bool `json:"delta,omitempty"`
+}
+
+// Command returns the Browser.getHistogram command for use with
+// AsyncDebugger.Send.
+func (p GetHistogramParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandGetHistogram, p)
+}
+
+// GetHistogramReturns are the values returned by Browser.getHistogram.
+type GetHistogramReturns struct {
+	// Histogram.
+	Histogram Histogram `json:"histogram"`
+}
+
+// Do sends Browser.getHistogram to d and waits for its result or for ctx to be
+// done.
+func (p GetHistogramParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*GetHistogramReturns, error) {
+	ret := &GetHistogramReturns{}
+	if err := protocol.Do(ctx, d, CommandGetHistogram, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// GetWindowBoundsParams are the parameters of Browser.getWindowBounds.
+//
+// Get position and size of the browser window.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Browser#method-getWindowBounds
+//
+// This is an experimental part of the protocol.
+type GetWindowBoundsParams struct {
+	// Browser window id.
+	WindowID WindowID `json:"windowId"`
+}
+
+// Command returns the Browser.getWindowBounds command for use with
+// AsyncDebugger.Send.
+func (p GetWindowBoundsParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandGetWindowBounds, p)
+}
+
+// GetWindowBoundsReturns are the values returned by Browser.getWindowBounds.
+type GetWindowBoundsReturns struct {
+	// Bounds information of the window. When window state is 'minimized', the
+	// restored window position and size are returned.
+	Bounds Bounds `json:"bounds"`
+}
+
+// Do sends Browser.getWindowBounds to d and waits for its result or for ctx to be
+// done.
+func (p GetWindowBoundsParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*GetWindowBoundsReturns, error) {
+	ret := &GetWindowBoundsReturns{}
+	if err := protocol.Do(ctx, d, CommandGetWindowBounds, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// GetWindowForTargetParams are the parameters of Browser.getWindowForTarget.
+//
+// Get the browser window that contains the devtools target.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Browser#method-getWindowForTarget
+//
+// This is an experimental part of the protocol.
+type GetWindowForTargetParams struct {
+	// Devtools agent host id. If called as a part of the session, associated
+	// targetId is used.
+	TargetID types.TargetTargetID `json:"targetId,omitempty"`
+}
+
+// Command returns the Browser.getWindowForTarget command for use with
+// AsyncDebugger.Send.
+func (p GetWindowForTargetParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandGetWindowForTarget, p)
+}
+
+// GetWindowForTargetReturns are the values returned by Browser.getWindowForTarget.
+type GetWindowForTargetReturns struct {
+	// Browser window id.
+	WindowID WindowID `json:"windowId"`
+	// Bounds information of the window. When window state is 'minimized', the
+	// restored window position and size are returned.
+	Bounds Bounds `json:"bounds"`
+}
+
+// Do sends Browser.getWindowForTarget to d and waits for its result or for ctx to be
+// done.
+func (p GetWindowForTargetParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*GetWindowForTargetReturns, error) {
+	ret := &GetWindowForTargetReturns{}
+	if err := protocol.Do(ctx, d, CommandGetWindowForTarget, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// SetWindowBoundsParams are the parameters of Browser.setWindowBounds.
+//
+// Set position and/or size of the browser window.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Browser#method-setWindowBounds
+//
+// This is an experimental part of the protocol.
+type SetWindowBoundsParams struct {
+	// Browser window id.
+	WindowID WindowID `json:"windowId"`
+	// New window bounds. The 'minimized', 'maximized' and 'fullscreen' states
+	// cannot be combined with 'left', 'top', 'width' or 'height'. Leaves
+	// unspecified fields unchanged.
+	Bounds Bounds `json:"bounds"`
+}
+
+// Command returns the Browser.setWindowBounds command for use with
+// AsyncDebugger.Send.
+func (p SetWindowBoundsParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetWindowBounds, p)
+}
+
+// Do sends Browser.setWindowBounds to d and waits for it to complete or for ctx to
+// be done.
+func (p SetWindowBoundsParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetWindowBounds, p, nil)
+}
+
+// SetContentsSizeParams are the parameters of Browser.setContentsSize.
+//
+// Set size of the browser contents resizing browser window as necessary.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Browser#method-setContentsSize
+//
+// This is an experimental part of the protocol.
+type SetContentsSizeParams struct {
+	// Browser window id.
+	WindowID WindowID `json:"windowId"`
+	// The window contents width in DIP. Assumes current width if omitted. Must be
+	// specified if 'height' is omitted.
+	Width int64 `json:"width,omitempty"`
+	// The window contents height in DIP. Assumes current height if omitted. Must
+	// be specified if 'width' is omitted.
+	Height int64 `json:"height,omitempty"`
+}
+
+// Command returns the Browser.setContentsSize command for use with
+// AsyncDebugger.Send.
+func (p SetContentsSizeParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetContentsSize, p)
+}
+
+// Do sends Browser.setContentsSize to d and waits for it to complete or for ctx to
+// be done.
+func (p SetContentsSizeParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetContentsSize, p, nil)
+}
+
+// SetDockTileParams are the parameters of Browser.setDockTile.
+//
+// Set dock tile details, platform-specific.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Browser#method-setDockTile
+//
+// This is an experimental part of the protocol.
+type SetDockTileParams struct {
+	BadgeLabel string `json:"badgeLabel,omitempty"`
+	// Png encoded image. (Encoded as a base64 string when passed over JSON)
+	Image string `json:"image,omitempty"`
+}
+
+// Command returns the Browser.setDockTile command for use with
+// AsyncDebugger.Send.
+func (p SetDockTileParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetDockTile, p)
+}
+
+// Do sends Browser.setDockTile to d and waits for it to complete or for ctx to
+// be done.
+func (p SetDockTileParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetDockTile, p, nil)
+}
+
+// ExecuteBrowserCommandParams are the parameters of
+// Browser.executeBrowserCommand.
+//
+// Invoke custom browser commands used by telemetry.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Browser#method-executeBrowserCommand
+//
+// This is an experimental part of the protocol.
+type ExecuteBrowserCommandParams struct {
+	CommandID BrowserCommandID `json:"commandId"`
+}
+
+// Command returns the Browser.executeBrowserCommand command for use with
+// AsyncDebugger.Send.
+func (p ExecuteBrowserCommandParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandExecuteBrowserCommand, p)
+}
+
+// Do sends Browser.executeBrowserCommand to d and waits for it to complete or for ctx to
+// be done.
+func (p ExecuteBrowserCommandParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandExecuteBrowserCommand, p, nil)
+}
+
+// AddPrivacySandboxEnrollmentOverrideParams are the parameters of
+// Browser.addPrivacySandboxEnrollmentOverride.
+//
+// Allows a site to use privacy sandbox features that require enrollment without
+// the site actually being enrolled. Only supported on page targets.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Browser#method-addPrivacySandboxEnrollmentOverride
+type AddPrivacySandboxEnrollmentOverrideParams struct {
+	URL string `json:"url"`
+}
+
+// Command returns the Browser.addPrivacySandboxEnrollmentOverride command for
+// use with AsyncDebugger.Send.
+func (p AddPrivacySandboxEnrollmentOverrideParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandAddPrivacySandboxEnrollmentOverride, p)
+}
+
+// Do sends Browser.addPrivacySandboxEnrollmentOverride to d and waits for it to complete or for ctx to
+// be done.
+func (p AddPrivacySandboxEnrollmentOverrideParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandAddPrivacySandboxEnrollmentOverride, p, nil)
+}
+
+// AddPrivacySandboxCoordinatorKeyConfigParams are the parameters of
+// Browser.addPrivacySandboxCoordinatorKeyConfig.
+//
+// Configures encryption keys used with a given privacy sandbox API to talk to a
+// trusted coordinator. Since this is intended for test automation only,
+// coordinatorOrigin must be a .test domain. No existing coordinator
+// configuration for the origin may exist.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Browser#method-addPrivacySandboxCoordinatorKeyConfig
+type AddPrivacySandboxCoordinatorKeyConfigParams struct {
+	API               PrivacySandboxAPI `json:"api"`
+	CoordinatorOrigin string            `json:"coordinatorOrigin"`
+	KeyConfig         string            `json:"keyConfig"`
+	// BrowserContext to perform the action in. When omitted, default browser
+	// context is used.
+	BrowserContextID BrowserContextID `json:"browserContextId,omitempty"`
+}
+
+// Command returns the Browser.addPrivacySandboxCoordinatorKeyConfig command for
+// use with AsyncDebugger.Send.
+func (p AddPrivacySandboxCoordinatorKeyConfigParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandAddPrivacySandboxCoordinatorKeyConfig, p)
+}
+
+// Do sends Browser.addPrivacySandboxCoordinatorKeyConfig to d and waits for it to complete or for ctx to
+// be done.
+func (p AddPrivacySandboxCoordinatorKeyConfigParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandAddPrivacySandboxCoordinatorKeyConfig, p, nil)
+}
+
+// Method names of the events in the Browser domain.
+const (
+	EventDownloadWillBegin = "Browser.downloadWillBegin"
+	EventDownloadProgress  = "Browser.downloadProgress"
+)
+
+// DownloadWillBeginEvent is the payload of the Browser.downloadWillBegin event.
+//
+// Fired when page is about to start a download.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Browser#event-downloadWillBegin
+//
+// This is an experimental part of the protocol.
+type DownloadWillBeginEvent struct {
+	// Id of the frame that caused the download to begin.
+	FrameID types.PageFrameID `json:"frameId"`
+	// Global unique identifier of the download.
+	Guid string `json:"guid"`
+	// URL of the resource being downloaded.
+	URL string `json:"url"`
+	// Suggested file name of the resource (the actual name of the file saved on
+	// disk may differ).
+	SuggestedFilename string `json:"suggestedFilename"`
+}
+
+// DownloadProgressEvent is the payload of the Browser.downloadProgress event.
+//
+// Fired when download makes progress. Last call has |done| == true.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Browser#event-downloadProgress
+//
+// This is an experimental part of the protocol.
+type DownloadProgressEvent struct {
+	// Global unique identifier of the download.
+	Guid string `json:"guid"`
+	// Total expected bytes to download.
+	TotalBytes float64 `json:"totalBytes"`
+	// Total bytes received.
+	ReceivedBytes float64 `json:"receivedBytes"`
+	// Download status.
+	//
+	// Allowed values: inProgress, completed, canceled.
+	State string `json:"state"`
+	// If download is "completed", provides the path of the downloaded file.
+	// Depending on the platform, it is not guaranteed to be set, nor the file is
+	// guaranteed to exist.
+	//
+	// This is an experimental part of the protocol.
+	FilePath string `json:"filePath,omitempty"`
+}
+
+// ParseEvent decodes an event from the Browser domain, as received from
+// Subscribe, into a pointer to its typed payload.
+func ParseEvent(cmd chromedebugo.Command) (interface{}, error) {
+	var ev interface{}
+	switch cmd.Method {
+	case EventDownloadWillBegin:
+		ev = &DownloadWillBeginEvent{}
+	case EventDownloadProgress:
+		ev = &DownloadProgressEvent{}
+	default:
+		return nil, fmt.Errorf("unknown Browser event: %s", cmd.Method)
+	}
+	if err := cmd.Decode(ev); err != nil {
+		return nil, err
+	}
+	return ev, nil
+}
@@ -0,0 +1,227 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+// Package cachestorage provides typed bindings for the CacheStorage domain of
+// the Chrome DevTools protocol.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CacheStorage
+//
+// This is an experimental part of the protocol.
+package cachestorage
+
+import (
+	"context"
+
+	"github.com/tonyhb/chromedebugo"
+	"github.com/tonyhb/chromedebugo/protocol"
+	"github.com/tonyhb/chromedebugo/protocol/internal/types"
+)
+
+// CacheID unique identifier of the Cache object.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CacheStorage#type-CacheId
+type CacheID = types.CacheStorageCacheID
+
+// CachedResponseType type of HTTP response cached
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CacheStorage#type-CachedResponseType
+type CachedResponseType = types.CacheStorageCachedResponseType
+
+// DataEntry data entry.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CacheStorage#type-DataEntry
+type DataEntry = types.CacheStorageDataEntry
+
+// Cache cache identifier.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CacheStorage#type-Cache
+type Cache = types.CacheStorageCache
+
+// Header is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CacheStorage#type-Header
+type Header = types.CacheStorageHeader
+
+// CachedResponse cached response
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CacheStorage#type-CachedResponse
+type CachedResponse = types.CacheStorageCachedResponse
+
+// Values of the enumerated types in the CacheStorage domain.
+const (
+	CachedResponseTypeBasic          = types.CacheStorageCachedResponseTypeBasic
+	CachedResponseTypeCors           = types.CacheStorageCachedResponseTypeCors
+	CachedResponseTypeDefault        = types.CacheStorageCachedResponseTypeDefault
+	CachedResponseTypeError          = types.CacheStorageCachedResponseTypeError
+	CachedResponseTypeOpaqueResponse = types.CacheStorageCachedResponseTypeOpaqueResponse
+	CachedResponseTypeOpaqueRedirect = types.CacheStorageCachedResponseTypeOpaqueRedirect
+)
+
+// Method names of the commands in the CacheStorage domain.
+const (
+	CommandDeleteCache           = "CacheStorage.deleteCache"
+	CommandDeleteEntry           = "CacheStorage.deleteEntry"
+	CommandRequestCacheNames     = "CacheStorage.requestCacheNames"
+	CommandRequestCachedResponse = "CacheStorage.requestCachedResponse"
+	CommandRequestEntries        = "CacheStorage.requestEntries"
+)
+
+// DeleteCacheParams are the parameters of CacheStorage.deleteCache.
+//
+// Deletes a cache.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CacheStorage#method-deleteCache
+type DeleteCacheParams struct {
+	// Id of cache for deletion.
+	CacheID CacheID `json:"cacheId"`
+}
+
+// Command returns the CacheStorage.deleteCache command for use with
+// AsyncDebugger.Send.
+func (p DeleteCacheParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandDeleteCache, p)
+}
+
+// Do sends CacheStorage.deleteCache to d and waits for it to complete or for ctx to
+// be done.
+func (p DeleteCacheParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandDeleteCache, p, nil)
+}
+
+// DeleteEntryParams are the parameters of CacheStorage.deleteEntry.
+//
+// Deletes a cache entry.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CacheStorage#method-deleteEntry
+type DeleteEntryParams struct {
+	// Id of cache where the entry will be deleted.
+	CacheID CacheID `json:"cacheId"`
+	// URL spec of the request.
+	Request string `json:"request"`
+}
+
+// Command returns the CacheStorage.deleteEntry command for use with
+// AsyncDebugger.Send.
+func (p DeleteEntryParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandDeleteEntry, p)
+}
+
+// Do sends CacheStorage.deleteEntry to d and waits for it to complete or for ctx to
+// be done.
+func (p DeleteEntryParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandDeleteEntry, p, nil)
+}
+
+// RequestCacheNamesParams are the parameters of CacheStorage.requestCacheNames.
+//
+// Requests cache names.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CacheStorage#method-requestCacheNames
+type RequestCacheNamesParams struct {
+	// At least and at most one of securityOrigin, storageKey, storageBucket must
+	// be specified. Security origin.
+	SecurityOrigin string `json:"securityOrigin,omitempty"`
+	// Storage key.
+	StorageKey string `json:"storageKey,omitempty"`
+	// Storage bucket. If not specified, it uses the default bucket.
+	StorageBucket *types.StorageStorageBucket `json:"storageBucket,omitempty"`
+}
+
+// Command returns the CacheStorage.requestCacheNames command for use with
+// AsyncDebugger.Send.
+func (p RequestCacheNamesParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandRequestCacheNames, p)
+}
+
+// RequestCacheNamesReturns are the values returned by CacheStorage.requestCacheNames.
+type RequestCacheNamesReturns struct {
+	// Caches for the security origin.
+	Caches []Cache `json:"caches"`
+}
+
+// Do sends CacheStorage.requestCacheNames to d and waits for its result or for ctx to be
+// done.
+func (p RequestCacheNamesParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*RequestCacheNamesReturns, error) {
+	ret := &RequestCacheNamesReturns{}
+	if err := protocol.Do(ctx, d, CommandRequestCacheNames, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// RequestCachedResponseParams are the parameters of
+// CacheStorage.requestCachedResponse.
+//
+// Fetches cache entry.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CacheStorage#method-requestCachedResponse
+type RequestCachedResponseParams struct {
+	// Id of cache that contains the entry.
+	CacheID CacheID `json:"cacheId"`
+	// URL spec of the request.
+	RequestURL string `json:"requestURL"`
+	// headers of the request.
+	RequestHeaders []Header `json:"requestHeaders"`
+}
+
+// Command returns the CacheStorage.requestCachedResponse command for use with
+// AsyncDebugger.Send.
+func (p RequestCachedResponseParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandRequestCachedResponse, p)
+}
+
+// RequestCachedResponseReturns are the values returned by CacheStorage.requestCachedResponse.
+type RequestCachedResponseReturns struct {
+	// Response read from the cache.
+	Response CachedResponse `json:"response"`
+}
+
+// Do sends CacheStorage.requestCachedResponse to d and waits for its result or for ctx to be
+// done.
+func (p RequestCachedResponseParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*RequestCachedResponseReturns, error) {
+	ret := &RequestCachedResponseReturns{}
+	if err := protocol.Do(ctx, d, CommandRequestCachedResponse, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// RequestEntriesParams are the parameters of CacheStorage.requestEntries.
+//
+// Requests data from cache.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CacheStorage#method-requestEntries
+type RequestEntriesParams struct {
+	// ID of cache to get entries from.
+	CacheID CacheID `json:"cacheId"`
+	// Number of records to skip.
+	SkipCount int64 `json:"skipCount,omitempty"`
+	// Number of records to fetch.
+	PageSize int64 `json:"pageSize,omitempty"`
+	// If present, only return the entries containing this substring in the path
+	PathFilter string `json:"pathFilter,omitempty"`
+}
+
+// Command returns the CacheStorage.requestEntries command for use with
+// AsyncDebugger.Send.
+func (p RequestEntriesParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandRequestEntries, p)
+}
+
+// RequestEntriesReturns are the values returned by CacheStorage.requestEntries.
+type RequestEntriesReturns struct {
+	// Array of object store data entries.
+	CacheDataEntries []DataEntry `json:"cacheDataEntries"`
+	// Count of returned entries from this storage. If pathFilter is empty, it is
+	// the count of all entries from this storage.
+	ReturnCount float64 `json:"returnCount"`
+}
+
+// Do sends CacheStorage.requestEntries to d and waits for its result or for ctx to be
+// done.
+func (p RequestEntriesParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*RequestEntriesReturns, error) {
+	ret := &RequestEntriesReturns{}
+	if err := protocol.Do(ctx, d, CommandRequestEntries, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
@@ -0,0 +1,206 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+// Package cast provides typed bindings for the Cast domain of the Chrome
+// DevTools protocol.
+//
+// A domain for interacting with Cast, Presentation API, and Remote Playback API
+// functionalities.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Cast
+//
+// This is an experimental part of the protocol.
+package cast
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tonyhb/chromedebugo"
+	"github.com/tonyhb/chromedebugo/protocol"
+	"github.com/tonyhb/chromedebugo/protocol/internal/types"
+)
+
+// Sink is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Cast#type-Sink
+type Sink = types.CastSink
+
+// Method names of the commands in the Cast domain.
+const (
+	CommandEnable                = "Cast.enable"
+	CommandDisable               = "Cast.disable"
+	CommandSetSinkToUse          = "Cast.setSinkToUse"
+	CommandStartDesktopMirroring = "Cast.startDesktopMirroring"
+	CommandStartTabMirroring     = "Cast.startTabMirroring"
+	CommandStopCasting           = "Cast.stopCasting"
+)
+
+// EnableParams are the parameters of Cast.enable.
+//
+// Starts observing for sinks that can be used for tab mirroring, and if set,
+// sinks compatible with |presentationUrl| as well. When sinks are found, a
+// |sinksUpdated| event is fired. Also starts observing for issue messages. When
+// an issue is added or removed, an |issueUpdated| event is fired.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Cast#method-enable
+type EnableParams struct {
+	PresentationURL string `json:"presentationUrl,omitempty"`
+}
+
+// Command returns the Cast.enable command for use with AsyncDebugger.Send.
+func (p EnableParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandEnable, p)
+}
+
+// Do sends Cast.enable to d and waits for it to complete or for ctx to
+// be done.
+func (p EnableParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandEnable, p, nil)
+}
+
+// DisableParams are the parameters of Cast.disable.
+//
+// Stops observing for sinks and issues.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Cast#method-disable
+type DisableParams struct {
+}
+
+// Command returns the Cast.disable command for use with AsyncDebugger.Send.
+func (p DisableParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandDisable, p)
+}
+
+// Do sends Cast.disable to d and waits for it to complete or for ctx to
+// be done.
+func (p DisableParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandDisable, p, nil)
+}
+
+// SetSinkToUseParams are the parameters of Cast.setSinkToUse.
+//
+// Sets a sink to be used when the web page requests the browser to choose a
+// sink via Presentation API, Remote Playback API, or Cast SDK.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Cast#method-setSinkToUse
+type SetSinkToUseParams struct {
+	SinkName string `json:"sinkName"`
+}
+
+// Command returns the Cast.setSinkToUse command for use with
+// AsyncDebugger.Send.
+func (p SetSinkToUseParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetSinkToUse, p)
+}
+
+// Do sends Cast.setSinkToUse to d and waits for it to complete or for ctx to
+// be done.
+func (p SetSinkToUseParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetSinkToUse, p, nil)
+}
+
+// StartDesktopMirroringParams are the parameters of Cast.startDesktopMirroring.
+//
+// Starts mirroring the desktop to the sink.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Cast#method-startDesktopMirroring
+type StartDesktopMirroringParams struct {
+	SinkName string `json:"sinkName"`
+}
+
+// Command returns the Cast.startDesktopMirroring command for use with
+// AsyncDebugger.Send.
+func (p StartDesktopMirroringParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandStartDesktopMirroring, p)
+}
+
+// Do sends Cast.startDesktopMirroring to d and waits for it to complete or for ctx to
+// be done.
+func (p StartDesktopMirroringParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandStartDesktopMirroring, p, nil)
+}
+
+// StartTabMirroringParams are the parameters of Cast.startTabMirroring.
+//
+// Starts mirroring the tab to the sink.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Cast#method-startTabMirroring
+type StartTabMirroringParams struct {
+	SinkName string `json:"sinkName"`
+}
+
+// Command returns the Cast.startTabMirroring command for use with
+// AsyncDebugger.Send.
+func (p StartTabMirroringParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandStartTabMirroring, p)
+}
+
+// Do sends Cast.startTabMirroring to d and waits for it to complete or for ctx to
+// be done.
+func (p StartTabMirroringParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandStartTabMirroring, p, nil)
+}
+
+// StopCastingParams are the parameters of Cast.stopCasting.
+//
+// Stops the active Cast session on the sink.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Cast#method-stopCasting
+type StopCastingParams struct {
+	SinkName string `json:"sinkName"`
+}
+
+// Command returns the Cast.stopCasting command for use with AsyncDebugger.Send.
+func (p StopCastingParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandStopCasting, p)
+}
+
+// Do sends Cast.stopCasting to d and waits for it to complete or for ctx to
+// be done.
+func (p StopCastingParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandStopCasting, p, nil)
+}
+
+// Method names of the events in the Cast domain.
+const (
+	EventSinksUpdated = "Cast.sinksUpdated"
+	EventIssueUpdated = "Cast.issueUpdated"
+)
+
+// SinksUpdatedEvent is the payload of the Cast.sinksUpdated event.
+//
+// This is fired whenever the list of available sinks changes. A sink is a
+// device or a software surface that you can cast to.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Cast#event-sinksUpdated
+type SinksUpdatedEvent struct {
+	Sinks []Sink `json:"sinks"`
+}
+
+// IssueUpdatedEvent is the payload of the Cast.issueUpdated event.
+//
+// This is fired whenever the outstanding issue/error message changes.
+// |issueMessage| is empty if there is no issue.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Cast#event-issueUpdated
+type IssueUpdatedEvent struct {
+	IssueMessage string `json:"issueMessage"`
+}
+
+// ParseEvent decodes an event from the Cast domain, as received from
+// Subscribe, into a pointer to its typed payload.
+func ParseEvent(cmd chromedebugo.Command) (interface{}, error) {
+	var ev interface{}
+	switch cmd.Method {
+	case EventSinksUpdated:
+		ev = &SinksUpdatedEvent{}
+	case EventIssueUpdated:
+		ev = &IssueUpdatedEvent{}
+	default:
+		return nil, fmt.Errorf("unknown Cast event: %s", cmd.Method)
+	}
+	if err := cmd.Decode(ev); err != nil {
+		return nil, err
+	}
+	return ev, nil
+}
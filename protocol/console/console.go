@@ -0,0 +1,123 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+// Package console provides typed bindings for the Console domain of the Chrome
+// DevTools protocol.
+//
+// This domain is deprecated - use Runtime or Log instead.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Console
+//
+// Deprecated: this is deprecated in the protocol.
+package console
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tonyhb/chromedebugo"
+	"github.com/tonyhb/chromedebugo/protocol"
+	"github.com/tonyhb/chromedebugo/protocol/internal/types"
+)
+
+// ConsoleMessage console message.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Console#type-ConsoleMessage
+type ConsoleMessage = types.ConsoleConsoleMessage
+
+// Method names of the commands in the Console domain.
+const (
+	CommandClearMessages = "Console.clearMessages"
+	CommandDisable       = "Console.disable"
+	CommandEnable        = "Console.enable"
+)
+
+// ClearMessagesParams are the parameters of Console.clearMessages.
+//
+// Does nothing.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Console#method-clearMessages
+type ClearMessagesParams struct {
+}
+
+// Command returns the Console.clearMessages command for use with
+// AsyncDebugger.Send.
+func (p ClearMessagesParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandClearMessages, p)
+}
+
+// Do sends Console.clearMessages to d and waits for it to complete or for ctx to
+// be done.
+func (p ClearMessagesParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandClearMessages, p, nil)
+}
+
+// DisableParams are the parameters of Console.disable.
+//
+// Disables console domain, prevents further console messages from being
+// reported to the client.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Console#method-disable
+type DisableParams struct {
+}
+
+// Command returns the Console.disable command for use with AsyncDebugger.Send.
+func (p DisableParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandDisable, p)
+}
+
+// Do sends Console.disable to d and waits for it to complete or for ctx to
+// be done.
+func (p DisableParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandDisable, p, nil)
+}
+
+// EnableParams are the parameters of Console.enable.
+//
+// Enables console domain, sends the messages collected so far to the client by
+// means of the `messageAdded` notification.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Console#method-enable
+type EnableParams struct {
+}
+
+// Command returns the Console.enable command for use with AsyncDebugger.Send.
+func (p EnableParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandEnable, p)
+}
+
+// Do sends Console.enable to d and waits for it to complete or for ctx to
+// be done.
+func (p EnableParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandEnable, p, nil)
+}
+
+// Method names of the events in the Console domain.
+const (
+	EventMessageAdded = "Console.messageAdded"
+)
+
+// MessageAddedEvent is the payload of the Console.messageAdded event.
+//
+// Issued when new console message is added.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Console#event-messageAdded
+type MessageAddedEvent struct {
+	// Console message that has been added.
+	Message ConsoleMessage `json:"message"`
+}
+
+// ParseEvent decodes an event from the Console domain, as received from
+// Subscribe, into a pointer to its typed payload.
+func ParseEvent(cmd chromedebugo.Command) (interface{}, error) {
+	var ev interface{}
+	switch cmd.Method {
+	case EventMessageAdded:
+		ev = &MessageAddedEvent{}
+	default:
+		return nil, fmt.Errorf("unknown Console event: %s", cmd.Method)
+	}
+	if err := cmd.Decode(ev); err != nil {
+		return nil, err
+	}
+	return ev, nil
+}
@@ -0,0 +1,1655 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+// Package css provides typed bindings for the CSS domain of the Chrome DevTools
+// protocol.
+//
+// This domain exposes CSS read/write operations. All CSS objects (stylesheets,
+// rules, and styles) have an associated `id` used in subsequent operations on
+// the related object. Each object type has a specific `id` structure, and those
+// are not interchangeable between objects of different kinds. CSS objects can
+// be loaded using the `get*ForNode()` calls (which accept a DOM node id). A
+// client can also keep track of stylesheets via the
+// `styleSheetAdded`/`styleSheetRemoved` events and subsequently load the
+// required stylesheet contents using the `getStyleSheet[Text]()` methods.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CSS
+//
+// This is an experimental part of the protocol.
+package css
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tonyhb/chromedebugo"
+	"github.com/tonyhb/chromedebugo/protocol"
+	"github.com/tonyhb/chromedebugo/protocol/internal/types"
+)
+
+// StyleSheetID is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CSS#type-StyleSheetId
+type StyleSheetID = types.CSSStyleSheetID
+
+// StyleSheetOrigin stylesheet type: "injected" for stylesheets injected via
+// extension, "user-agent" for user-agent stylesheets, "inspector" for
+// stylesheets created by the inspector (i.e. those holding the "via inspector"
+// rules), "regular" for regular stylesheets.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CSS#type-StyleSheetOrigin
+type StyleSheetOrigin = types.CSSStyleSheetOrigin
+
+// PseudoElementMatches CSS rule collection for a single pseudo style.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CSS#type-PseudoElementMatches
+type PseudoElementMatches = types.CSSPseudoElementMatches
+
+// CSSAnimationStyle CSS style coming from animations with the name of the
+// animation.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CSS#type-CSSAnimationStyle
+type CSSAnimationStyle = types.CSSCSSAnimationStyle
+
+// InheritedStyleEntry inherited CSS rule collection from ancestor node.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CSS#type-InheritedStyleEntry
+type InheritedStyleEntry = types.CSSInheritedStyleEntry
+
+// InheritedAnimatedStyleEntry inherited CSS style collection for animated
+// styles from ancestor node.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CSS#type-InheritedAnimatedStyleEntry
+type InheritedAnimatedStyleEntry = types.CSSInheritedAnimatedStyleEntry
+
+// InheritedPseudoElementMatches inherited pseudo element matches from pseudos
+// of an ancestor node.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CSS#type-InheritedPseudoElementMatches
+type InheritedPseudoElementMatches = types.CSSInheritedPseudoElementMatches
+
+// RuleMatch match data for a CSS rule.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CSS#type-RuleMatch
+type RuleMatch = types.CSSRuleMatch
+
+// Value data for a simple selector (these are delimited by commas in a selector
+// list).
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CSS#type-Value
+type Value = types.CSSValue
+
+// Specificity specificity:
+// https://drafts.csswg.org/selectors/#specificity-rules
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CSS#type-Specificity
+//
+// This is an experimental part of the protocol.
+type Specificity = types.CSSSpecificity
+
+// SelectorList selector list data.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CSS#type-SelectorList
+type SelectorList = types.CSSSelectorList
+
+// CSSStyleSheetHeader CSS stylesheet metainformation.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CSS#type-CSSStyleSheetHeader
+type CSSStyleSheetHeader = types.CSSCSSStyleSheetHeader
+
+// CSSRule CSS rule representation.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CSS#type-CSSRule
+type CSSRule = types.CSSCSSRule
+
+// CSSRuleType enum indicating the type of a CSS rule, used to represent the
+// order of a style rule's ancestors. This list only contains rule types that
+// are collected during the ancestor rule collection.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CSS#type-CSSRuleType
+//
+// This is an experimental part of the protocol.
+type CSSRuleType = types.CSSCSSRuleType
+
+// RuleUsage CSS coverage information.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CSS#type-RuleUsage
+type RuleUsage = types.CSSRuleUsage
+
+// SourceRange text range within a resource. All numbers are zero-based.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CSS#type-SourceRange
+type SourceRange = types.CSSSourceRange
+
+// ShorthandEntry is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CSS#type-ShorthandEntry
+type ShorthandEntry = types.CSSShorthandEntry
+
+// CSSComputedStyleProperty is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CSS#type-CSSComputedStyleProperty
+type CSSComputedStyleProperty = types.CSSCSSComputedStyleProperty
+
+// CSSStyle CSS style representation.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CSS#type-CSSStyle
+type CSSStyle = types.CSSCSSStyle
+
+// CSSProperty CSS property declaration data.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CSS#type-CSSProperty
+type CSSProperty = types.CSSCSSProperty
+
+// CSSMedia CSS media rule descriptor.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CSS#type-CSSMedia
+type CSSMedia = types.CSSCSSMedia
+
+// MediaQuery media query descriptor.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CSS#type-MediaQuery
+type MediaQuery = types.CSSMediaQuery
+
+// MediaQueryExpression media query expression descriptor.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CSS#type-MediaQueryExpression
+type MediaQueryExpression = types.CSSMediaQueryExpression
+
+// CSSContainerQuery CSS container query rule descriptor.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CSS#type-CSSContainerQuery
+//
+// This is an experimental part of the protocol.
+type CSSContainerQuery = types.CSSCSSContainerQuery
+
+// CSSSupports CSS Supports at-rule descriptor.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CSS#type-CSSSupports
+//
+// This is an experimental part of the protocol.
+type CSSSupports = types.CSSCSSSupports
+
+// CSSScope CSS Scope at-rule descriptor.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CSS#type-CSSScope
+//
+// This is an experimental part of the protocol.
+type CSSScope = types.CSSCSSScope
+
+// CSSLayer CSS Layer at-rule descriptor.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CSS#type-CSSLayer
+//
+// This is an experimental part of the protocol.
+type CSSLayer = types.CSSCSSLayer
+
+// CSSStartingStyle CSS Starting Style at-rule descriptor.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CSS#type-CSSStartingStyle
+//
+// This is an experimental part of the protocol.
+type CSSStartingStyle = types.CSSCSSStartingStyle
+
+// CSSLayerData CSS Layer data.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CSS#type-CSSLayerData
+//
+// This is an experimental part of the protocol.
+type CSSLayerData = types.CSSCSSLayerData
+
+// PlatformFontUsage information about amount of glyphs that were rendered with
+// given font.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CSS#type-PlatformFontUsage
+type PlatformFontUsage = types.CSSPlatformFontUsage
+
+// FontVariationAxis information about font variation axes for variable fonts
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CSS#type-FontVariationAxis
+type FontVariationAxis = types.CSSFontVariationAxis
+
+// FontFace properties of a web font:
+// https://www.w3.org/TR/2008/REC-CSS2-20080411/fonts.html#font-descriptions and
+// additional information such as platformFontFamily and fontVariationAxes.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CSS#type-FontFace
+type FontFace = types.CSSFontFace
+
+// CSSTryRule CSS try rule representation.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CSS#type-CSSTryRule
+type CSSTryRule = types.CSSCSSTryRule
+
+// CSSPositionTryRule CSS @position-try rule representation.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CSS#type-CSSPositionTryRule
+type CSSPositionTryRule = types.CSSCSSPositionTryRule
+
+// CSSKeyframesRule CSS keyframes rule representation.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CSS#type-CSSKeyframesRule
+type CSSKeyframesRule = types.CSSCSSKeyframesRule
+
+// CSSPropertyRegistration representation of a custom property registration
+// through CSS.registerProperty
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CSS#type-CSSPropertyRegistration
+type CSSPropertyRegistration = types.CSSCSSPropertyRegistration
+
+// CSSFontPaletteValuesRule CSS font-palette-values rule representation.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CSS#type-CSSFontPaletteValuesRule
+type CSSFontPaletteValuesRule = types.CSSCSSFontPaletteValuesRule
+
+// CSSPropertyRule CSS property at-rule representation.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CSS#type-CSSPropertyRule
+type CSSPropertyRule = types.CSSCSSPropertyRule
+
+// CSSFunctionParameter CSS function argument representation.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CSS#type-CSSFunctionParameter
+type CSSFunctionParameter = types.CSSCSSFunctionParameter
+
+// CSSFunctionConditionNode CSS function conditional block representation.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CSS#type-CSSFunctionConditionNode
+type CSSFunctionConditionNode = types.CSSCSSFunctionConditionNode
+
+// CSSFunctionNode section of the body of a CSS function rule.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CSS#type-CSSFunctionNode
+type CSSFunctionNode = types.CSSCSSFunctionNode
+
+// CSSFunctionRule CSS function at-rule representation.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CSS#type-CSSFunctionRule
+type CSSFunctionRule = types.CSSCSSFunctionRule
+
+// CSSKeyframeRule CSS keyframe rule representation.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CSS#type-CSSKeyframeRule
+type CSSKeyframeRule = types.CSSCSSKeyframeRule
+
+// StyleDeclarationEdit a descriptor of operation to mutate style declaration
+// text.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CSS#type-StyleDeclarationEdit
+type StyleDeclarationEdit = types.CSSStyleDeclarationEdit
+
+// Values of the enumerated types in the CSS domain.
+const (
+	StyleSheetOriginInjected     = types.CSSStyleSheetOriginInjected
+	StyleSheetOriginUserAgent    = types.CSSStyleSheetOriginUserAgent
+	StyleSheetOriginInspector    = types.CSSStyleSheetOriginInspector
+	StyleSheetOriginRegular      = types.CSSStyleSheetOriginRegular
+	CSSRuleTypeMediaRule         = types.CSSCSSRuleTypeMediaRule
+	CSSRuleTypeSupportsRule      = types.CSSCSSRuleTypeSupportsRule
+	CSSRuleTypeContainerRule     = types.CSSCSSRuleTypeContainerRule
+	CSSRuleTypeLayerRule         = types.CSSCSSRuleTypeLayerRule
+	CSSRuleTypeScopeRule         = types.CSSCSSRuleTypeScopeRule
+	CSSRuleTypeStyleRule         = types.CSSCSSRuleTypeStyleRule
+	CSSRuleTypeStartingStyleRule = types.CSSCSSRuleTypeStartingStyleRule
+)
+
+// Method names of the commands in the CSS domain.
+const (
+	CommandAddRule                          = "CSS.addRule"
+	CommandCollectClassNames                = "CSS.collectClassNames"
+	CommandCreateStyleSheet                 = "CSS.createStyleSheet"
+	CommandDisable                          = "CSS.disable"
+	CommandEnable                           = "CSS.enable"
+	CommandForcePseudoState                 = "CSS.forcePseudoState"
+	CommandForceStartingStyle               = "CSS.forceStartingStyle"
+	CommandGetBackgroundColors              = "CSS.getBackgroundColors"
+	CommandGetComputedStyleForNode          = "CSS.getComputedStyleForNode"
+	CommandResolveValues                    = "CSS.resolveValues"
+	CommandGetLonghandProperties            = "CSS.getLonghandProperties"
+	CommandGetInlineStylesForNode           = "CSS.getInlineStylesForNode"
+	CommandGetAnimatedStylesForNode         = "CSS.getAnimatedStylesForNode"
+	CommandGetMatchedStylesForNode          = "CSS.getMatchedStylesForNode"
+	CommandGetEnvironmentVariables          = "CSS.getEnvironmentVariables"
+	CommandGetMediaQueries                  = "CSS.getMediaQueries"
+	CommandGetPlatformFontsForNode          = "CSS.getPlatformFontsForNode"
+	CommandGetStyleSheetText                = "CSS.getStyleSheetText"
+	CommandGetLayersForNode                 = "CSS.getLayersForNode"
+	CommandGetLocationForSelector           = "CSS.getLocationForSelector"
+	CommandTrackComputedStyleUpdatesForNode = "CSS.trackComputedStyleUpdatesForNode"
+	CommandTrackComputedStyleUpdates        = "CSS.trackComputedStyleUpdates"
+	CommandTakeComputedStyleUpdates         = "CSS.takeComputedStyleUpdates"
+	CommandSetEffectivePropertyValueForNode = "CSS.setEffectivePropertyValueForNode"
+	CommandSetPropertyRulePropertyName      = "CSS.setPropertyRulePropertyName"
+	CommandSetKeyframeKey                   = "CSS.setKeyframeKey"
+	CommandSetMediaText                     = "CSS.setMediaText"
+	CommandSetContainerQueryText            = "CSS.setContainerQueryText"
+	CommandSetSupportsText                  = "CSS.setSupportsText"
+	CommandSetScopeText                     = "CSS.setScopeText"
+	CommandSetRuleSelector                  = "CSS.setRuleSelector"
+	CommandSetStyleSheetText                = "CSS.setStyleSheetText"
+	CommandSetStyleTexts                    = "CSS.setStyleTexts"
+	CommandStartRuleUsageTracking           = "CSS.startRuleUsageTracking"
+	CommandStopRuleUsageTracking            = "CSS.stopRuleUsageTracking"
+	CommandTakeCoverageDelta                = "CSS.takeCoverageDelta"
+	CommandSetLocalFontsEnabled             = "CSS.setLocalFontsEnabled"
+)
+
+// AddRuleParams are the parameters of CSS.addRule.
+//
+// Inserts a new rule with the given `ruleText` in a stylesheet with given
+// `styleSheetId`, at the position specified by `location`.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CSS#method-addRule
+type AddRuleParams struct {
+	// The css style sheet identifier where a new rule should be inserted.
+	StyleSheetID StyleSheetID `json:"styleSheetId"`
+	// The text of a new rule.
+	RuleText string `json:"ruleText"`
+	// Text position of a new rule in the target style sheet.
+	Location SourceRange `json:"location"`
+	// NodeId for the DOM node in whose context custom property declarations for
+	// registered properties should be validated. If omitted, declarations in the
+	// new rule text can only be validated statically, which may produce incorrect
+	// results if the declaration contains a var() for example.
+	//
+	// This is an experimental part of the protocol.
+	NodeForPropertySyntaxValidation types.DOMNodeID `json:"nodeForPropertySyntaxValidation,omitempty"`
+}
+
+// Command returns the CSS.addRule command for use with AsyncDebugger.Send.
+func (p AddRuleParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandAddRule, p)
+}
+
+// AddRuleReturns are the values returned by CSS.addRule.
+type AddRuleReturns struct {
+	// The newly created rule.
+	Rule CSSRule `json:"rule"`
+}
+
+// Do sends CSS.addRule to d and waits for its result or for ctx to be
+// done.
+func (p AddRuleParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*AddRuleReturns, error) {
+	ret := &AddRuleReturns{}
+	if err := protocol.Do(ctx, d, CommandAddRule, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// CollectClassNamesParams are the parameters of CSS.collectClassNames.
+//
+// Returns all class names from specified stylesheet.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CSS#method-collectClassNames
+type CollectClassNamesParams struct {
+	StyleSheetID StyleSheetID `json:"styleSheetId"`
+}
+
+// Command returns the CSS.collectClassNames command for use with
+// AsyncDebugger.Send.
+func (p CollectClassNamesParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandCollectClassNames, p)
+}
+
+// CollectClassNamesReturns are the values returned by CSS.collectClassNames.
+type CollectClassNamesReturns struct {
+	// Class name list.
+	ClassNames []string `json:"classNames"`
+}
+
+// Do sends CSS.collectClassNames to d and waits for its result or for ctx to be
+// done.
+func (p CollectClassNamesParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*CollectClassNamesReturns, error) {
+	ret := &CollectClassNamesReturns{}
+	if err := protocol.Do(ctx, d, CommandCollectClassNames, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// CreateStyleSheetParams are the parameters of CSS.createStyleSheet.
+//
+// Creates a new special "via-inspector" stylesheet in the frame with given
+// `frameId`.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CSS#method-createStyleSheet
+type CreateStyleSheetParams struct {
+	// Identifier of the frame where "via-inspector" stylesheet should be created.
+	FrameID types.PageFrameID `json:"frameId"`
+	// If true, creates a new stylesheet for every call. If false, returns a
+	// stylesheet previously created by a call with force=false for the frame's
+	// document if it exists or creates a new stylesheet (default: false).
+	Force bool `json:"force,omitempty"`
+}
+
+// Command returns the CSS.createStyleSheet command for use with
+// AsyncDebugger.Send.
+func (p CreateStyleSheetParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandCreateStyleSheet, p)
+}
+
+// CreateStyleSheetReturns are the values returned by CSS.createStyleSheet.
+type CreateStyleSheetReturns struct {
+	// Identifier of the created "via-inspector" stylesheet.
+	StyleSheetID StyleSheetID `json:"styleSheetId"`
+}
+
+// Do sends CSS.createStyleSheet to d and waits for its result or for ctx to be
+// done.
+func (p CreateStyleSheetParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*CreateStyleSheetReturns, error) {
+	ret := &CreateStyleSheetReturns{}
+	if err := protocol.Do(ctx, d, CommandCreateStyleSheet, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// DisableParams are the parameters of CSS.disable.
+//
+// Disables the CSS agent for the given page.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CSS#method-disable
+type DisableParams struct {
+}
+
+// Command returns the CSS.disable command for use with AsyncDebugger.Send.
+func (p DisableParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandDisable, p)
+}
+
+// Do sends CSS.disable to d and waits for it to complete or for ctx to
+// be done.
+func (p DisableParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandDisable, p, nil)
+}
+
+// EnableParams are the parameters of CSS.enable.
+//
+// Enables the CSS agent for the given page. Clients should not assume that the
+// CSS agent has been enabled until the result of this command is received.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CSS#method-enable
+type EnableParams struct {
+}
+
+// Command returns the CSS.enable command for use with AsyncDebugger.Send.
+func (p EnableParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandEnable, p)
+}
+
+// Do sends CSS.enable to d and waits for it to complete or for ctx to
+// be done.
+func (p EnableParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandEnable, p, nil)
+}
+
+// ForcePseudoStateParams are the parameters of CSS.forcePseudoState.
+//
+// Ensures that the given node will have specified pseudo-classes whenever its
+// style is computed by the browser.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CSS#method-forcePseudoState
+type ForcePseudoStateParams struct {
+	// The element id for which to force the pseudo state.
+	NodeID types.DOMNodeID `json:"nodeId"`
+	// Element pseudo classes to force when computing the element's style.
+	ForcedPseudoClasses []string `json:"forcedPseudoClasses"`
+}
+
+// Command returns the CSS.forcePseudoState command for use with
+// AsyncDebugger.Send.
+func (p ForcePseudoStateParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandForcePseudoState, p)
+}
+
+// Do sends CSS.forcePseudoState to d and waits for it to complete or for ctx to
+// be done.
+func (p ForcePseudoStateParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandForcePseudoState, p, nil)
+}
+
+// ForceStartingStyleParams are the parameters of CSS.forceStartingStyle.
+//
+// Ensures that the given node is in its starting-style state.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CSS#method-forceStartingStyle
+type ForceStartingStyleParams struct {
+	// The element id for which to force the starting-style state.
+	NodeID types.DOMNodeID `json:"nodeId"`
+	// Boolean indicating if this is on or off.
+	Forced bool `json:"forced"`
+}
+
+// Command returns the CSS.forceStartingStyle command for use with
+// AsyncDebugger.Send.
+func (p ForceStartingStyleParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandForceStartingStyle, p)
+}
+
+// Do sends CSS.forceStartingStyle to d and waits for it to complete or for ctx to
+// be done.
+func (p ForceStartingStyleParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandForceStartingStyle, p, nil)
+}
+
+// GetBackgroundColorsParams are the parameters of CSS.getBackgroundColors.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CSS#method-getBackgroundColors
+type GetBackgroundColorsParams struct {
+	// Id of the node to get background colors for.
+	NodeID types.DOMNodeID `json:"nodeId"`
+}
+
+// Command returns the CSS.getBackgroundColors command for use with
+// AsyncDebugger.Send.
+func (p GetBackgroundColorsParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandGetBackgroundColors, p)
+}
+
+// GetBackgroundColorsReturns are the values returned by CSS.getBackgroundColors.
+type GetBackgroundColorsReturns struct {
+	// The range of background colors behind this element, if it contains any
+	// visible text. If no visible text is present, this will be undefined. In the
+	// case of a flat background color, this will consist of simply that color. In
+	// the case of a gradient, this will consist of each of the color stops. For
+	// anything more complicated, this will be an empty array. Images will be
+	// ignored (as if the image had failed to load).
+	BackgroundColors []string `json:"backgroundColors,omitempty"`
+	// The computed font size for this node, as a CSS computed value string (e.g.
+	// '12px').
+	ComputedFontSize string `json:"computedFontSize,omitempty"`
+	// The computed font weight for this node, as a CSS computed value string (e.g.
+	// 'normal' or '100').
+	ComputedFontWeight string `json:"computedFontWeight,omitempty"`
+}
+
+// Do sends CSS.getBackgroundColors to d and waits for its result or for ctx to be
+// done.
+func (p GetBackgroundColorsParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*GetBackgroundColorsReturns, error) {
+	ret := &GetBackgroundColorsReturns{}
+	if err := protocol.Do(ctx, d, CommandGetBackgroundColors, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// GetComputedStyleForNodeParams are the parameters of
+// CSS.getComputedStyleForNode.
+//
+// Returns the computed style for a DOM node identified by `nodeId`.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CSS#method-getComputedStyleForNode
+type GetComputedStyleForNodeParams struct {
+	NodeID types.DOMNodeID `json:"nodeId"`
+}
+
+// Command returns the CSS.getComputedStyleForNode command for use with
+// AsyncDebugger.Send.
+func (p GetComputedStyleForNodeParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandGetComputedStyleForNode, p)
+}
+
+// GetComputedStyleForNodeReturns are the values returned by CSS.getComputedStyleForNode.
+type GetComputedStyleForNodeReturns struct {
+	// Computed style for the specified DOM node.
+	ComputedStyle []CSSComputedStyleProperty `json:"computedStyle"`
+}
+
+// Do sends CSS.getComputedStyleForNode to d and waits for its result or for ctx to be
+// done.
+func (p GetComputedStyleForNodeParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*GetComputedStyleForNodeReturns, error) {
+	ret := &GetComputedStyleForNodeReturns{}
+	if err := protocol.Do(ctx, d, CommandGetComputedStyleForNode, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// ResolveValuesParams are the parameters of CSS.resolveValues.
+//
+// Resolve the specified values in the context of the provided element. For
+// example, a value of '1em' is evaluated according to the computed 'font-size'
+// of the element and a value 'calc(1px + 2px)' will be resolved to '3px'. If
+// the `propertyName` was specified the `values` are resolved as if they were
+// property's declaration. If a value cannot be parsed according to the provided
+// property syntax, the value is parsed using combined syntax as if null
+// `propertyName` was provided. If the value cannot be resolved even then,
+// return the provided value without any changes.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CSS#method-resolveValues
+//
+// This is an experimental part of the protocol.
+type ResolveValuesParams struct {
+	// Substitution functions (var()/env()/attr()) and cascade-dependent keywords
+	// (revert/revert-layer) do not work.
+	Values []string `json:"values"`
+	// Id of the node in whose context the expression is evaluated
+	NodeID types.DOMNodeID `json:"nodeId"`
+	// Only longhands and custom property names are accepted.
+	PropertyName string `json:"propertyName,omitempty"`
+	// Pseudo element type, only works for pseudo elements that generate elements
+	// in the tree, such as ::before and ::after.
+	PseudoType types.DOMPseudoType `json:"pseudoType,omitempty"`
+	// Pseudo element custom ident.
+	PseudoIdentifier string `json:"pseudoIdentifier,omitempty"`
+}
+
+// Command returns the CSS.resolveValues command for use with
+// AsyncDebugger.Send.
+func (p ResolveValuesParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandResolveValues, p)
+}
+
+// ResolveValuesReturns are the values returned by CSS.resolveValues.
+type ResolveValuesReturns struct {
+	Results []string `json:"results"`
+}
+
+// Do sends CSS.resolveValues to d and waits for its result or for ctx to be
+// done.
+func (p ResolveValuesParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*ResolveValuesReturns, error) {
+	ret := &ResolveValuesReturns{}
+	if err := protocol.Do(ctx, d, CommandResolveValues, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// GetLonghandPropertiesParams are the parameters of CSS.getLonghandProperties.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CSS#method-getLonghandProperties
+//
+// This is an experimental part of the protocol.
+type GetLonghandPropertiesParams struct {
+	ShorthandName string `json:"shorthandName"`
+	Value         string `json:"value"`
+}
+
+// Command returns the CSS.getLonghandProperties command for use with
+// AsyncDebugger.Send.
+func (p GetLonghandPropertiesParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandGetLonghandProperties, p)
+}
+
+// GetLonghandPropertiesReturns are the values returned by CSS.getLonghandProperties.
+type GetLonghandPropertiesReturns struct {
+	LonghandProperties []CSSProperty `json:"longhandProperties"`
+}
+
+// Do sends CSS.getLonghandProperties to d and waits for its result or for ctx to be
+// done.
+func (p GetLonghandPropertiesParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*GetLonghandPropertiesReturns, error) {
+	ret := &GetLonghandPropertiesReturns{}
+	if err := protocol.Do(ctx, d, CommandGetLonghandProperties, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// GetInlineStylesForNodeParams are the parameters of
+// CSS.getInlineStylesForNode.
+//
+// Returns the styles defined inline (explicitly in the "style" attribute and
+// implicitly, using DOM attributes) for a DOM node identified by `nodeId`.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CSS#method-getInlineStylesForNode
+type GetInlineStylesForNodeParams struct {
+	NodeID types.DOMNodeID `json:"nodeId"`
+}
+
+// Command returns the CSS.getInlineStylesForNode command for use with
+// AsyncDebugger.Send.
+func (p GetInlineStylesForNodeParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandGetInlineStylesForNode, p)
+}
+
+// GetInlineStylesForNodeReturns are the values returned by CSS.getInlineStylesForNode.
+type GetInlineStylesForNodeReturns struct {
+	// Inline style for the specified DOM node.
+	InlineStyle *CSSStyle `json:"inlineStyle,omitempty"`
+	// Attribute-defined element style (e.g. resulting from "width=20
+	// height=100%").
+	AttributesStyle *CSSStyle `json:"attributesStyle,omitempty"`
+}
+
+// Do sends CSS.getInlineStylesForNode to d and waits for its result or for ctx to be
+// done.
+func (p GetInlineStylesForNodeParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*GetInlineStylesForNodeReturns, error) {
+	ret := &GetInlineStylesForNodeReturns{}
+	if err := protocol.Do(ctx, d, CommandGetInlineStylesForNode, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// GetAnimatedStylesForNodeParams are the parameters of
+// CSS.getAnimatedStylesForNode.
+//
+// Returns the styles coming from animations & transitions including the
+// animation & transition styles coming from inheritance chain.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CSS#method-getAnimatedStylesForNode
+//
+// This is an experimental part of the protocol.
+type GetAnimatedStylesForNodeParams struct {
+	NodeID types.DOMNodeID `json:"nodeId"`
+}
+
+// Command returns the CSS.getAnimatedStylesForNode command for use with
+// AsyncDebugger.Send.
+func (p GetAnimatedStylesForNodeParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandGetAnimatedStylesForNode, p)
+}
+
+// GetAnimatedStylesForNodeReturns are the values returned by CSS.getAnimatedStylesForNode.
+type GetAnimatedStylesForNodeReturns struct {
+	// Styles coming from animations.
+	AnimationStyles []CSSAnimationStyle `json:"animationStyles,omitempty"`
+	// Style coming from transitions.
+	TransitionsStyle *CSSStyle `json:"transitionsStyle,omitempty"`
+	// Inherited style entries for animationsStyle and transitionsStyle from the
+	// inheritance chain of the element.
+	Inherited []InheritedAnimatedStyleEntry `json:"inherited,omitempty"`
+}
+
+// Do sends CSS.getAnimatedStylesForNode to d and waits for its result or for ctx to be
+// done.
+func (p GetAnimatedStylesForNodeParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*GetAnimatedStylesForNodeReturns, error) {
+	ret := &GetAnimatedStylesForNodeReturns{}
+	if err := protocol.Do(ctx, d, CommandGetAnimatedStylesForNode, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// GetMatchedStylesForNodeParams are the parameters of
+// CSS.getMatchedStylesForNode.
+//
+// Returns requested styles for a DOM node identified by `nodeId`.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CSS#method-getMatchedStylesForNode
+type GetMatchedStylesForNodeParams struct {
+	NodeID types.DOMNodeID `json:"nodeId"`
+}
+
+// Command returns the CSS.getMatchedStylesForNode command for use with
+// AsyncDebugger.Send.
+func (p GetMatchedStylesForNodeParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandGetMatchedStylesForNode, p)
+}
+
+// GetMatchedStylesForNodeReturns are the values returned by CSS.getMatchedStylesForNode.
+type GetMatchedStylesForNodeReturns struct {
+	// Inline style for the specified DOM node.
+	InlineStyle *CSSStyle `json:"inlineStyle,omitempty"`
+	// Attribute-defined element style (e.g. resulting from "width=20
+	// height=100%").
+	AttributesStyle *CSSStyle `json:"attributesStyle,omitempty"`
+	// CSS rules matching this node, from all applicable stylesheets.
+	MatchedCSSRules []RuleMatch `json:"matchedCSSRules,omitempty"`
+	// Pseudo style matches for this node.
+	PseudoElements []PseudoElementMatches `json:"pseudoElements,omitempty"`
+	// A chain of inherited styles (from the immediate node parent up to the DOM
+	// tree root).
+	Inherited []InheritedStyleEntry `json:"inherited,omitempty"`
+	// A chain of inherited pseudo element styles (from the immediate node parent
+	// up to the DOM tree root).
+	InheritedPseudoElements []InheritedPseudoElementMatches `json:"inheritedPseudoElements,omitempty"`
+	// A list of CSS keyframed animations matching this node.
+	CSSKeyframesRules []CSSKeyframesRule `json:"cssKeyframesRules,omitempty"`
+	// A list of CSS @position-try rules matching this node, based on the
+	// position-try-fallbacks property.
+	CSSPositionTryRules []CSSPositionTryRule `json:"cssPositionTryRules,omitempty"`
+	// Index of the active fallback in the applied position-try-fallback property,
+	// will not be set if there is no active position-try fallback.
+	ActivePositionFallbackIndex int64 `json:"activePositionFallbackIndex,omitempty"`
+	// A list of CSS at-property rules matching this node.
+	CSSPropertyRules []CSSPropertyRule `json:"cssPropertyRules,omitempty"`
+	// A list of CSS property registrations matching this node.
+	CSSPropertyRegistrations []CSSPropertyRegistration `json:"cssPropertyRegistrations,omitempty"`
+	// A font-palette-values rule matching this node.
+	CSSFontPaletteValuesRule *CSSFontPaletteValuesRule `json:"cssFontPaletteValuesRule,omitempty"`
+	// Id of the first parent element that does not have display: contents.
+	//
+	// This is an experimental part of the protocol.
+	ParentLayoutNodeID types.DOMNodeID `json:"parentLayoutNodeId,omitempty"`
+	// A list of CSS at-function rules referenced by styles of this node.
+	//
+	// This is an experimental part of the protocol.
+	CSSFunctionRules []CSSFunctionRule `json:"cssFunctionRules,omitempty"`
+}
+
+// Do sends CSS.getMatchedStylesForNode to d and waits for its result or for ctx to be
+// done.
+func (p GetMatchedStylesForNodeParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*GetMatchedStylesForNodeReturns, error) {
+	ret := &GetMatchedStylesForNodeReturns{}
+	if err := protocol.Do(ctx, d, CommandGetMatchedStylesForNode, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// GetEnvironmentVariablesParams are the parameters of
+// CSS.getEnvironmentVariables.
+//
+// Returns the values of the default UA-defined environment variables used in
+// env()
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CSS#method-getEnvironmentVariables
+//
+// This is an experimental part of the protocol.
+type GetEnvironmentVariablesParams struct {
+}
+
+// Command returns the CSS.getEnvironmentVariables command for use with
+// AsyncDebugger.Send.
+func (p GetEnvironmentVariablesParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandGetEnvironmentVariables, p)
+}
+
+// GetEnvironmentVariablesReturns are the values returned by CSS.getEnvironmentVariables.
+type GetEnvironmentVariablesReturns struct {
+	EnvironmentVariables map[string]interface{} `json:"environmentVariables"`
+}
+
+// Do sends CSS.getEnvironmentVariables to d and waits for its result or for ctx to be
+// done.
+func (p GetEnvironmentVariablesParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*GetEnvironmentVariablesReturns, error) {
+	ret := &GetEnvironmentVariablesReturns{}
+	if err := protocol.Do(ctx, d, CommandGetEnvironmentVariables, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// GetMediaQueriesParams are the parameters of CSS.getMediaQueries.
+//
+// Returns all media queries parsed by the rendering engine.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CSS#method-getMediaQueries
+type GetMediaQueriesParams struct {
+}
+
+// Command returns the CSS.getMediaQueries command for use with
+// AsyncDebugger.Send.
+func (p GetMediaQueriesParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandGetMediaQueries, p)
+}
+
+// GetMediaQueriesReturns are the values returned by CSS.getMediaQueries.
+type GetMediaQueriesReturns struct {
+	Medias []CSSMedia `json:"medias"`
+}
+
+// Do sends CSS.getMediaQueries to d and waits for its result or for ctx to be
+// done.
+func (p GetMediaQueriesParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*GetMediaQueriesReturns, error) {
+	ret := &GetMediaQueriesReturns{}
+	if err := protocol.Do(ctx, d, CommandGetMediaQueries, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// GetPlatformFontsForNodeParams are the parameters of
+// CSS.getPlatformFontsForNode.
+//
+// Requests information about platform fonts which we used to render child
+// TextNodes in the given node.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CSS#method-getPlatformFontsForNode
+type GetPlatformFontsForNodeParams struct {
+	NodeID types.DOMNodeID `json:"nodeId"`
+}
+
+// Command returns the CSS.getPlatformFontsForNode command for use with
+// AsyncDebugger.Send.
+func (p GetPlatformFontsForNodeParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandGetPlatformFontsForNode, p)
+}
+
+// GetPlatformFontsForNodeReturns are the values returned by CSS.getPlatformFontsForNode.
+type GetPlatformFontsForNodeReturns struct {
+	// Usage statistics for every employed platform font.
+	Fonts []PlatformFontUsage `json:"fonts"`
+}
+
+// Do sends CSS.getPlatformFontsForNode to d and waits for its result or for ctx to be
+// done.
+func (p GetPlatformFontsForNodeParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*GetPlatformFontsForNodeReturns, error) {
+	ret := &GetPlatformFontsForNodeReturns{}
+	if err := protocol.Do(ctx, d, CommandGetPlatformFontsForNode, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// GetStyleSheetTextParams are the parameters of CSS.getStyleSheetText.
+//
+// Returns the current textual content for a stylesheet.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CSS#method-getStyleSheetText
+type GetStyleSheetTextParams struct {
+	StyleSheetID StyleSheetID `json:"styleSheetId"`
+}
+
+// Command returns the CSS.getStyleSheetText command for use with
+// AsyncDebugger.Send.
+func (p GetStyleSheetTextParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandGetStyleSheetText, p)
+}
+
+// GetStyleSheetTextReturns are the values returned by CSS.getStyleSheetText.
+type GetStyleSheetTextReturns struct {
+	// The stylesheet text.
+	Text string `json:"text"`
+}
+
+// Do sends CSS.getStyleSheetText to d and waits for its result or for ctx to be
+// done.
+func (p GetStyleSheetTextParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*GetStyleSheetTextReturns, error) {
+	ret := &GetStyleSheetTextReturns{}
+	if err := protocol.Do(ctx, d, CommandGetStyleSheetText, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// GetLayersForNodeParams are the parameters of CSS.getLayersForNode.
+//
+// Returns all layers parsed by the rendering engine for the tree scope of a
+// node. Given a DOM element identified by nodeId, getLayersForNode returns the
+// root layer for the nearest ancestor document or shadow root. The layer root
+// contains the full layer tree for the tree scope and their ordering.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CSS#method-getLayersForNode
+//
+// This is an experimental part of the protocol.
+type GetLayersForNodeParams struct {
+	NodeID types.DOMNodeID `json:"nodeId"`
+}
+
+// Command returns the CSS.getLayersForNode command for use with
+// AsyncDebugger.Send.
+func (p GetLayersForNodeParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandGetLayersForNode, p)
+}
+
+// GetLayersForNodeReturns are the values returned by CSS.getLayersForNode.
+type GetLayersForNodeReturns struct {
+	RootLayer CSSLayerData `json:"rootLayer"`
+}
+
+// Do sends CSS.getLayersForNode to d and waits for its result or for ctx to be
+// done.
+func (p GetLayersForNodeParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*GetLayersForNodeReturns, error) {
+	ret := &GetLayersForNodeReturns{}
+	if err := protocol.Do(ctx, d, CommandGetLayersForNode, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// GetLocationForSelectorParams are the parameters of
+// CSS.getLocationForSelector.
+//
+// Given a CSS selector text and a style sheet ID, getLocationForSelector
+// returns an array of locations of the CSS selector in the style sheet.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CSS#method-getLocationForSelector
+//
+// This is an experimental part of the protocol.
+type GetLocationForSelectorParams struct {
+	StyleSheetID StyleSheetID `json:"styleSheetId"`
+	SelectorText string       `json:"selectorText"`
+}
+
+// Command returns the CSS.getLocationForSelector command for use with
+// AsyncDebugger.Send.
+func (p GetLocationForSelectorParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandGetLocationForSelector, p)
+}
+
+// GetLocationForSelectorReturns are the values returned by CSS.getLocationForSelector.
+type GetLocationForSelectorReturns struct {
+	Ranges []SourceRange `json:"ranges"`
+}
+
+// Do sends CSS.getLocationForSelector to d and waits for its result or for ctx to be
+// done.
+func (p GetLocationForSelectorParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*GetLocationForSelectorReturns, error) {
+	ret := &GetLocationForSelectorReturns{}
+	if err := protocol.Do(ctx, d, CommandGetLocationForSelector, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// TrackComputedStyleUpdatesForNodeParams are the parameters of
+// CSS.trackComputedStyleUpdatesForNode.
+//
+// Starts tracking the given node for the computed style updates and whenever
+// the computed style is updated for node, it queues a `computedStyleUpdated`
+// event with throttling. There can only be 1 node tracked for computed style
+// updates so passing a new node id removes tracking from the previous node.
+// Pass `undefined` to disable tracking.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CSS#method-trackComputedStyleUpdatesForNode
+//
+// This is an experimental part of the protocol.
+type TrackComputedStyleUpdatesForNodeParams struct {
+	NodeID types.DOMNodeID `json:"nodeId,omitempty"`
+}
+
+// Command returns the CSS.trackComputedStyleUpdatesForNode command for use with
+// AsyncDebugger.Send.
+func (p TrackComputedStyleUpdatesForNodeParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandTrackComputedStyleUpdatesForNode, p)
+}
+
+// Do sends CSS.trackComputedStyleUpdatesForNode to d and waits for it to complete or for ctx to
+// be done.
+func (p TrackComputedStyleUpdatesForNodeParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandTrackComputedStyleUpdatesForNode, p, nil)
+}
+
+// TrackComputedStyleUpdatesParams are the parameters of
+// CSS.trackComputedStyleUpdates.
+//
+// Starts tracking the given computed styles for updates. The specified array of
+// properties replaces the one previously specified. Pass empty array to disable
+// tracking. Use takeComputedStyleUpdates to retrieve the list of nodes that had
+// properties modified. The changes to computed style properties are only
+// tracked for nodes pushed to the front-end by the DOM agent. If no changes to
+// the tracked properties occur after the node has been pushed to the front-end,
+// no updates will be issued for the node.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CSS#method-trackComputedStyleUpdates
+//
+// This is an experimental part of the protocol.
+type TrackComputedStyleUpdatesParams struct {
+	PropertiesToTrack []CSSComputedStyleProperty `json:"propertiesToTrack"`
+}
+
+// Command returns the CSS.trackComputedStyleUpdates command for use with
+// AsyncDebugger.Send.
+func (p TrackComputedStyleUpdatesParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandTrackComputedStyleUpdates, p)
+}
+
+// Do sends CSS.trackComputedStyleUpdates to d and waits for it to complete or for ctx to
+// be done.
+func (p TrackComputedStyleUpdatesParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandTrackComputedStyleUpdates, p, nil)
+}
+
+// TakeComputedStyleUpdatesParams are the parameters of
+// CSS.takeComputedStyleUpdates.
+//
+// Polls the next batch of computed style updates.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CSS#method-takeComputedStyleUpdates
+//
+// This is an experimental part of the protocol.
+type TakeComputedStyleUpdatesParams struct {
+}
+
+// Command returns the CSS.takeComputedStyleUpdates command for use with
+// AsyncDebugger.Send.
+func (p TakeComputedStyleUpdatesParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandTakeComputedStyleUpdates, p)
+}
+
+// TakeComputedStyleUpdatesReturns are the values returned by CSS.takeComputedStyleUpdates.
+type TakeComputedStyleUpdatesReturns struct {
+	// The list of node Ids that have their tracked computed styles updated.
+	NodeIds []types.DOMNodeID `json:"nodeIds"`
+}
+
+// Do sends CSS.takeComputedStyleUpdates to d and waits for its result or for ctx to be
+// done.
+func (p TakeComputedStyleUpdatesParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*TakeComputedStyleUpdatesReturns, error) {
+	ret := &TakeComputedStyleUpdatesReturns{}
+	if err := protocol.Do(ctx, d, CommandTakeComputedStyleUpdates, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// SetEffectivePropertyValueForNodeParams are the parameters of
+// CSS.setEffectivePropertyValueForNode.
+//
+// Find a rule with the given active property for the given node and set the new
+// value for this property
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CSS#method-setEffectivePropertyValueForNode
+type SetEffectivePropertyValueForNodeParams struct {
+	// The element id for which to set property.
+	NodeID       types.DOMNodeID `json:"nodeId"`
+	PropertyName string          `json:"propertyName"`
+	Value        string          `json:"value"`
+}
+
+// Command returns the CSS.setEffectivePropertyValueForNode command for use with
+// AsyncDebugger.Send.
+func (p SetEffectivePropertyValueForNodeParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetEffectivePropertyValueForNode, p)
+}
+
+// Do sends CSS.setEffectivePropertyValueForNode to d and waits for it to complete or for ctx to
+// be done.
+func (p SetEffectivePropertyValueForNodeParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetEffectivePropertyValueForNode, p, nil)
+}
+
+// SetPropertyRulePropertyNameParams are the parameters of
+// CSS.setPropertyRulePropertyName.
+//
+// Modifies the property rule property name.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CSS#method-setPropertyRulePropertyName
+type SetPropertyRulePropertyNameParams struct {
+	StyleSheetID StyleSheetID `json:"styleSheetId"`
+	Range        SourceRange  `json:"range"`
+	PropertyName string       `json:"propertyName"`
+}
+
+// Command returns the CSS.setPropertyRulePropertyName command for use with
+// AsyncDebugger.Send.
+func (p SetPropertyRulePropertyNameParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetPropertyRulePropertyName, p)
+}
+
+// SetPropertyRulePropertyNameReturns are the values returned by CSS.setPropertyRulePropertyName.
+type SetPropertyRulePropertyNameReturns struct {
+	// The resulting key text after modification.
+	PropertyName Value `json:"propertyName"`
+}
+
+// Do sends CSS.setPropertyRulePropertyName to d and waits for its result or for ctx to be
+// done.
+func (p SetPropertyRulePropertyNameParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*SetPropertyRulePropertyNameReturns, error) {
+	ret := &SetPropertyRulePropertyNameReturns{}
+	if err := protocol.Do(ctx, d, CommandSetPropertyRulePropertyName, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// SetKeyframeKeyParams are the parameters of CSS.setKeyframeKey.
+//
+// Modifies the keyframe rule key text.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CSS#method-setKeyframeKey
+type SetKeyframeKeyParams struct {
+	StyleSheetID StyleSheetID `json:"styleSheetId"`
+	Range        SourceRange  `json:"range"`
+	KeyText      string       `json:"keyText"`
+}
+
+// Command returns the CSS.setKeyframeKey command for use with
+// AsyncDebugger.Send.
+func (p SetKeyframeKeyParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetKeyframeKey, p)
+}
+
+// SetKeyframeKeyReturns are the values returned by CSS.setKeyframeKey.
+type SetKeyframeKeyReturns struct {
+	// The resulting key text after modification.
+	KeyText Value `json:"keyText"`
+}
+
+// Do sends CSS.setKeyframeKey to d and waits for its result or for ctx to be
+// done.
+func (p SetKeyframeKeyParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*SetKeyframeKeyReturns, error) {
+	ret := &SetKeyframeKeyReturns{}
+	if err := protocol.Do(ctx, d, CommandSetKeyframeKey, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// SetMediaTextParams are the parameters of CSS.setMediaText.
+//
+// Modifies the rule selector.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CSS#method-setMediaText
+type SetMediaTextParams struct {
+	StyleSheetID StyleSheetID `json:"styleSheetId"`
+	Range        SourceRange  `json:"range"`
+	Text         string       `json:"text"`
+}
+
+// Command returns the CSS.setMediaText command for use with AsyncDebugger.Send.
+func (p SetMediaTextParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetMediaText, p)
+}
+
+// SetMediaTextReturns are the values returned by CSS.setMediaText.
+type SetMediaTextReturns struct {
+	// The resulting CSS media rule after modification.
+	Media CSSMedia `json:"media"`
+}
+
+// Do sends CSS.setMediaText to d and waits for its result or for ctx to be
+// done.
+func (p SetMediaTextParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*SetMediaTextReturns, error) {
+	ret := &SetMediaTextReturns{}
+	if err := protocol.Do(ctx, d, CommandSetMediaText, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// SetContainerQueryTextParams are the parameters of CSS.setContainerQueryText.
+//
+// Modifies the expression of a container query.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CSS#method-setContainerQueryText
+//
+// This is an experimental part of the protocol.
+type SetContainerQueryTextParams struct {
+	StyleSheetID StyleSheetID `json:"styleSheetId"`
+	Range        SourceRange  `json:"range"`
+	Text         string       `json:"text"`
+}
+
+// Command returns the CSS.setContainerQueryText command for use with
+// AsyncDebugger.Send.
+func (p SetContainerQueryTextParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetContainerQueryText, p)
+}
+
+// SetContainerQueryTextReturns are the values returned by CSS.setContainerQueryText.
+type SetContainerQueryTextReturns struct {
+	// The resulting CSS container query rule after modification.
+	ContainerQuery CSSContainerQuery `json:"containerQuery"`
+}
+
+// Do sends CSS.setContainerQueryText to d and waits for its result or for ctx to be
+// done.
+func (p SetContainerQueryTextParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*SetContainerQueryTextReturns, error) {
+	ret := &SetContainerQueryTextReturns{}
+	if err := protocol.Do(ctx, d, CommandSetContainerQueryText, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// SetSupportsTextParams are the parameters of CSS.setSupportsText.
+//
+// Modifies the expression of a supports at-rule.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CSS#method-setSupportsText
+//
+// This is an experimental part of the protocol.
+type SetSupportsTextParams struct {
+	StyleSheetID StyleSheetID `json:"styleSheetId"`
+	Range        SourceRange  `json:"range"`
+	Text         string       `json:"text"`
+}
+
+// Command returns the CSS.setSupportsText command for use with
+// AsyncDebugger.Send.
+func (p SetSupportsTextParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetSupportsText, p)
+}
+
+// SetSupportsTextReturns are the values returned by CSS.setSupportsText.
+type SetSupportsTextReturns struct {
+	// The resulting CSS Supports rule after modification.
+	Supports CSSSupports `json:"supports"`
+}
+
+// Do sends CSS.setSupportsText to d and waits for its result or for ctx to be
+// done.
+func (p SetSupportsTextParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*SetSupportsTextReturns, error) {
+	ret := &SetSupportsTextReturns{}
+	if err := protocol.Do(ctx, d, CommandSetSupportsText, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// SetScopeTextParams are the parameters of CSS.setScopeText.
+//
+// Modifies the expression of a scope at-rule.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CSS#method-setScopeText
+//
+// This is an experimental part of the protocol.
+type SetScopeTextParams struct {
+	StyleSheetID StyleSheetID `json:"styleSheetId"`
+	Range        SourceRange  `json:"range"`
+	Text         string       `json:"text"`
+}
+
+// Command returns the CSS.setScopeText command for use with AsyncDebugger.Send.
+func (p SetScopeTextParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetScopeText, p)
+}
+
+// SetScopeTextReturns are the values returned by CSS.setScopeText.
+type SetScopeTextReturns struct {
+	// The resulting CSS Scope rule after modification.
+	Scope CSSScope `json:"scope"`
+}
+
+// Do sends CSS.setScopeText to d and waits for its result or for ctx to be
+// done.
+func (p SetScopeTextParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*SetScopeTextReturns, error) {
+	ret := &SetScopeTextReturns{}
+	if err := protocol.Do(ctx, d, CommandSetScopeText, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// SetRuleSelectorParams are the parameters of CSS.setRuleSelector.
+//
+// Modifies the rule selector.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CSS#method-setRuleSelector
+type SetRuleSelectorParams struct {
+	StyleSheetID StyleSheetID `json:"styleSheetId"`
+	Range        SourceRange  `json:"range"`
+	Selector     string       `json:"selector"`
+}
+
+// Command returns the CSS.setRuleSelector command for use with
+// AsyncDebugger.Send.
+func (p SetRuleSelectorParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetRuleSelector, p)
+}
+
+// SetRuleSelectorReturns are the values returned by CSS.setRuleSelector.
+type SetRuleSelectorReturns struct {
+	// The resulting selector list after modification.
+	SelectorList SelectorList `json:"selectorList"`
+}
+
+// Do sends CSS.setRuleSelector to d and waits for its result or for ctx to be
+// done.
+func (p SetRuleSelectorParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*SetRuleSelectorReturns, error) {
+	ret := &SetRuleSelectorReturns{}
+	if err := protocol.Do(ctx, d, CommandSetRuleSelector, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// SetStyleSheetTextParams are the parameters of CSS.setStyleSheetText.
+//
+// Sets the new stylesheet text.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CSS#method-setStyleSheetText
+type SetStyleSheetTextParams struct {
+	StyleSheetID StyleSheetID `json:"styleSheetId"`
+	Text         string       `json:"text"`
+}
+
+// Command returns the CSS.setStyleSheetText command for use with
+// AsyncDebugger.Send.
+func (p SetStyleSheetTextParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetStyleSheetText, p)
+}
+
+// SetStyleSheetTextReturns are the values returned by CSS.setStyleSheetText.
+type SetStyleSheetTextReturns struct {
+	// URL of source map associated with script (if any).
+	SourceMapURL string `json:"sourceMapURL,omitempty"`
+}
+
+// Do sends CSS.setStyleSheetText to d and waits for its result or for ctx to be
+// done.
+func (p SetStyleSheetTextParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*SetStyleSheetTextReturns, error) {
+	ret := &SetStyleSheetTextReturns{}
+	if err := protocol.Do(ctx, d, CommandSetStyleSheetText, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// SetStyleTextsParams are the parameters of CSS.setStyleTexts.
+//
+// Applies specified style edits one after another in the given order.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CSS#method-setStyleTexts
+type SetStyleTextsParams struct {
+	Edits []StyleDeclarationEdit `json:"edits"`
+	// NodeId for the DOM node in whose context custom property declarations for
+	// registered properties should be validated. If omitted, declarations in the
+	// new rule text can only be validated statically, which may produce incorrect
+	// results if the declaration contains a var() for example.
+	//
+	// This is an experimental part of the protocol.
+	NodeForPropertySyntaxValidation types.DOMNodeID `json:"nodeForPropertySyntaxValidation,omitempty"`
+}
+
+// Command returns the CSS.setStyleTexts command for use with
+// AsyncDebugger.Send.
+func (p SetStyleTextsParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetStyleTexts, p)
+}
+
+// SetStyleTextsReturns are the values returned by CSS.setStyleTexts.
+type SetStyleTextsReturns struct {
+	// The resulting styles after modification.
+	Styles []CSSStyle `json:"styles"`
+}
+
+// Do sends CSS.setStyleTexts to d and waits for its result or for ctx to be
+// done.
+func (p SetStyleTextsParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*SetStyleTextsReturns, error) {
+	ret := &SetStyleTextsReturns{}
+	if err := protocol.Do(ctx, d, CommandSetStyleTexts, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// StartRuleUsageTrackingParams are the parameters of
+// CSS.startRuleUsageTracking.
+//
+// Enables the selector recording.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CSS#method-startRuleUsageTracking
+type StartRuleUsageTrackingParams struct {
+}
+
+// Command returns the CSS.startRuleUsageTracking command for use with
+// AsyncDebugger.Send.
+func (p StartRuleUsageTrackingParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandStartRuleUsageTracking, p)
+}
+
+// Do sends CSS.startRuleUsageTracking to d and waits for it to complete or for ctx to
+// be done.
+func (p StartRuleUsageTrackingParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandStartRuleUsageTracking, p, nil)
+}
+
+// StopRuleUsageTrackingParams are the parameters of CSS.stopRuleUsageTracking.
+//
+// Stop tracking rule usage and return the list of rules that were used since
+// last call to `takeCoverageDelta` (or since start of coverage
+// instrumentation).
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CSS#method-stopRuleUsageTracking
+type StopRuleUsageTrackingParams struct {
+}
+
+// Command returns the CSS.stopRuleUsageTracking command for use with
+// AsyncDebugger.Send.
+func (p StopRuleUsageTrackingParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandStopRuleUsageTracking, p)
+}
+
+// StopRuleUsageTrackingReturns are the values returned by CSS.stopRuleUsageTracking.
+type StopRuleUsageTrackingReturns struct {
+	RuleUsage []RuleUsage `json:"ruleUsage"`
+}
+
+// Do sends CSS.stopRuleUsageTracking to d and waits for its result or for ctx to be
+// done.
+func (p StopRuleUsageTrackingParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*StopRuleUsageTrackingReturns, error) {
+	ret := &StopRuleUsageTrackingReturns{}
+	if err := protocol.Do(ctx, d, CommandStopRuleUsageTracking, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// TakeCoverageDeltaParams are the parameters of CSS.takeCoverageDelta.
+//
+// Obtain list of rules that became used since last call to this method (or
+// since start of coverage instrumentation).
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CSS#method-takeCoverageDelta
+type TakeCoverageDeltaParams struct {
+}
+
+// Command returns the CSS.takeCoverageDelta command for use with
+// AsyncDebugger.Send.
+func (p TakeCoverageDeltaParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandTakeCoverageDelta, p)
+}
+
+// TakeCoverageDeltaReturns are the values returned by CSS.takeCoverageDelta.
+type TakeCoverageDeltaReturns struct {
+	Coverage []RuleUsage `json:"coverage"`
+	// Monotonically increasing time, in seconds.
+	Timestamp float64 `json:"timestamp"`
+}
+
+// Do sends CSS.takeCoverageDelta to d and waits for its result or for ctx to be
+// done.
+func (p TakeCoverageDeltaParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*TakeCoverageDeltaReturns, error) {
+	ret := &TakeCoverageDeltaReturns{}
+	if err := protocol.Do(ctx, d, CommandTakeCoverageDelta, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// SetLocalFontsEnabledParams are the parameters of CSS.setLocalFontsEnabled.
+//
+// Enables/disables rendering of local CSS fonts (enabled by default).
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CSS#method-setLocalFontsEnabled
+//
+// This is an experimental part of the protocol.
+type SetLocalFontsEnabledParams struct {
+	// Whether rendering of local fonts is enabled.
+	Enabled bool `json:"enabled"`
+}
+
+// Command returns the CSS.setLocalFontsEnabled command for use with
+// AsyncDebugger.Send.
+func (p SetLocalFontsEnabledParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetLocalFontsEnabled, p)
+}
+
+// Do sends CSS.setLocalFontsEnabled to d and waits for it to complete or for ctx to
+// be done.
+func (p SetLocalFontsEnabledParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetLocalFontsEnabled, p, nil)
+}
+
+// Method names of the events in the CSS domain.
+const (
+	EventFontsUpdated            = "CSS.fontsUpdated"
+	EventMediaQueryResultChanged = "CSS.mediaQueryResultChanged"
+	EventStyleSheetAdded         = "CSS.styleSheetAdded"
+	EventStyleSheetChanged       = "CSS.styleSheetChanged"
+	EventStyleSheetRemoved       = "CSS.styleSheetRemoved"
+	EventComputedStyleUpdated    = "CSS.computedStyleUpdated"
+)
+
+// FontsUpdatedEvent is the payload of the CSS.fontsUpdated event.
+//
+// Fires whenever a web font is updated. A non-empty font parameter indicates a
+// successfully loaded web font.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CSS#event-fontsUpdated
+type FontsUpdatedEvent struct {
+	// The web font that has loaded.
+	Font *FontFace `json:"font,omitempty"`
+}
+
+// MediaQueryResultChangedEvent is the payload of the
+// CSS.mediaQueryResultChanged event.
+//
+// Fires whenever a MediaQuery result changes (for example, after a browser
+// window has been resized.) The current implementation considers only
+// viewport-dependent media features.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CSS#event-mediaQueryResultChanged
+type MediaQueryResultChangedEvent struct {
+}
+
+// StyleSheetAddedEvent is the payload of the CSS.styleSheetAdded event.
+//
+// Fired whenever an active document stylesheet is added.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CSS#event-styleSheetAdded
+type StyleSheetAddedEvent struct {
+	// Added stylesheet metainfo.
+	Header CSSStyleSheetHeader `json:"header"`
+}
+
+// StyleSheetChangedEvent is the payload of the CSS.styleSheetChanged event.
+//
+// Fired whenever a stylesheet is changed as a result of the client operation.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CSS#event-styleSheetChanged
+type StyleSheetChangedEvent struct {
+	StyleSheetID StyleSheetID `json:"styleSheetId"`
+}
+
+// StyleSheetRemovedEvent is the payload of the CSS.styleSheetRemoved event.
+//
+// Fired whenever an active document stylesheet is removed.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CSS#event-styleSheetRemoved
+type StyleSheetRemovedEvent struct {
+	// Identifier of the removed stylesheet.
+	StyleSheetID StyleSheetID `json:"styleSheetId"`
+}
+
+// ComputedStyleUpdatedEvent is the payload of the CSS.computedStyleUpdated
+// event.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CSS#event-computedStyleUpdated
+//
+// This is an experimental part of the protocol.
+type ComputedStyleUpdatedEvent struct {
+	// The node id that has updated computed styles.
+	NodeID types.DOMNodeID `json:"nodeId"`
+}
+
+// ParseEvent decodes an event from the CSS domain, as received from
+// Subscribe, into a pointer to its typed payload.
+func ParseEvent(cmd chromedebugo.Command) (interface{}, error) {
+	var ev interface{}
+	switch cmd.Method {
+	case EventFontsUpdated:
+		ev = &FontsUpdatedEvent{}
+	case EventMediaQueryResultChanged:
+		ev = &MediaQueryResultChangedEvent{}
+	case EventStyleSheetAdded:
+		ev = &StyleSheetAddedEvent{}
+	case EventStyleSheetChanged:
+		ev = &StyleSheetChangedEvent{}
+	case EventStyleSheetRemoved:
+		ev = &StyleSheetRemovedEvent{}
+	case EventComputedStyleUpdated:
+		ev = &ComputedStyleUpdatedEvent{}
+	default:
+		return nil, fmt.Errorf("unknown CSS event: %s", cmd.Method)
+	}
+	if err := cmd.Decode(ev); err != nil {
+		return nil, err
+	}
+	return ev, nil
+}
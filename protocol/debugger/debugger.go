@@ -0,0 +1,1442 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+// Package debugger provides typed bindings for the Debugger domain of the
+// Chrome DevTools protocol.
+//
+// Debugger domain exposes JavaScript debugging capabilities. It allows setting
+// and removing breakpoints, stepping through execution, exploring stack traces,
+// etc.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Debugger
+package debugger
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tonyhb/chromedebugo"
+	"github.com/tonyhb/chromedebugo/protocol"
+	"github.com/tonyhb/chromedebugo/protocol/internal/types"
+)
+
+// BreakpointID breakpoint identifier.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Debugger#type-BreakpointId
+type BreakpointID = types.DebuggerBreakpointID
+
+// CallFrameID call frame identifier.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Debugger#type-CallFrameId
+type CallFrameID = types.DebuggerCallFrameID
+
+// Location location in the source code.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Debugger#type-Location
+type Location = types.DebuggerLocation
+
+// ScriptPosition location in the source code.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Debugger#type-ScriptPosition
+//
+// This is an experimental part of the protocol.
+type ScriptPosition = types.DebuggerScriptPosition
+
+// LocationRange location range within one script.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Debugger#type-LocationRange
+//
+// This is an experimental part of the protocol.
+type LocationRange = types.DebuggerLocationRange
+
+// CallFrame javaScript call frame. Array of call frames form the call stack.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Debugger#type-CallFrame
+type CallFrame = types.DebuggerCallFrame
+
+// Scope scope description.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Debugger#type-Scope
+type Scope = types.DebuggerScope
+
+// SearchMatch search match for resource.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Debugger#type-SearchMatch
+type SearchMatch = types.DebuggerSearchMatch
+
+// BreakLocation is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Debugger#type-BreakLocation
+type BreakLocation = types.DebuggerBreakLocation
+
+// WasmDisassemblyChunk is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Debugger#type-WasmDisassemblyChunk
+//
+// This is an experimental part of the protocol.
+type WasmDisassemblyChunk = types.DebuggerWasmDisassemblyChunk
+
+// ScriptLanguage enum of possible script languages.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Debugger#type-ScriptLanguage
+type ScriptLanguage = types.DebuggerScriptLanguage
+
+// DebugSymbols debug symbols available for a wasm script.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Debugger#type-DebugSymbols
+type DebugSymbols = types.DebuggerDebugSymbols
+
+// ResolvedBreakpoint is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Debugger#type-ResolvedBreakpoint
+type ResolvedBreakpoint = types.DebuggerResolvedBreakpoint
+
+// Values of the enumerated types in the Debugger domain.
+const (
+	ScriptLanguageJavaScript  = types.DebuggerScriptLanguageJavaScript
+	ScriptLanguageWebAssembly = types.DebuggerScriptLanguageWebAssembly
+)
+
+// Method names of the commands in the Debugger domain.
+const (
+	CommandContinueToLocation           = "Debugger.continueToLocation"
+	CommandDisable                      = "Debugger.disable"
+	CommandEnable                       = "Debugger.enable"
+	CommandEvaluateOnCallFrame          = "Debugger.evaluateOnCallFrame"
+	CommandGetPossibleBreakpoints       = "Debugger.getPossibleBreakpoints"
+	CommandGetScriptSource              = "Debugger.getScriptSource"
+	CommandDisassembleWasmModule        = "Debugger.disassembleWasmModule"
+	CommandNextWasmDisassemblyChunk     = "Debugger.nextWasmDisassemblyChunk"
+	CommandGetWasmBytecode              = "Debugger.getWasmBytecode"
+	CommandGetStackTrace                = "Debugger.getStackTrace"
+	CommandPause                        = "Debugger.pause"
+	CommandPauseOnAsyncCall             = "Debugger.pauseOnAsyncCall"
+	CommandRemoveBreakpoint             = "Debugger.removeBreakpoint"
+	CommandRestartFrame                 = "Debugger.restartFrame"
+	CommandResume                       = "Debugger.resume"
+	CommandSearchInContent              = "Debugger.searchInContent"
+	CommandSetAsyncCallStackDepth       = "Debugger.setAsyncCallStackDepth"
+	CommandSetBlackboxExecutionContexts = "Debugger.setBlackboxExecutionContexts"
+	CommandSetBlackboxPatterns          = "Debugger.setBlackboxPatterns"
+	CommandSetBlackboxedRanges          = "Debugger.setBlackboxedRanges"
+	CommandSetBreakpoint                = "Debugger.setBreakpoint"
+	CommandSetInstrumentationBreakpoint = "Debugger.setInstrumentationBreakpoint"
+	CommandSetBreakpointByURL           = "Debugger.setBreakpointByUrl"
+	CommandSetBreakpointOnFunctionCall  = "Debugger.setBreakpointOnFunctionCall"
+	CommandSetBreakpointsActive         = "Debugger.setBreakpointsActive"
+	CommandSetPauseOnExceptions         = "Debugger.setPauseOnExceptions"
+	CommandSetReturnValue               = "Debugger.setReturnValue"
+	CommandSetScriptSource              = "Debugger.setScriptSource"
+	CommandSetSkipAllPauses             = "Debugger.setSkipAllPauses"
+	CommandSetVariableValue             = "Debugger.setVariableValue"
+	CommandStepInto                     = "Debugger.stepInto"
+	CommandStepOut                      = "Debugger.stepOut"
+	CommandStepOver                     = "Debugger.stepOver"
+)
+
+// ContinueToLocationParams are the parameters of Debugger.continueToLocation.
+//
+// Continues execution until specific location is reached.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Debugger#method-continueToLocation
+type ContinueToLocationParams struct {
+	// Location to continue to.
+	Location Location `json:"location"`
+	// Allowed values: any, current.
+	TargetCallFrames string `json:"targetCallFrames,omitempty"`
+}
+
+// Command returns the Debugger.continueToLocation command for use with
+// AsyncDebugger.Send.
+func (p ContinueToLocationParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandContinueToLocation, p)
+}
+
+// Do sends Debugger.continueToLocation to d and waits for it to complete or for ctx to
+// be done.
+func (p ContinueToLocationParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandContinueToLocation, p, nil)
+}
+
+// DisableParams are the parameters of Debugger.disable.
+//
+// Disables debugger for given page.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Debugger#method-disable
+type DisableParams struct {
+}
+
+// Command returns the Debugger.disable command for use with AsyncDebugger.Send.
+func (p DisableParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandDisable, p)
+}
+
+// Do sends Debugger.disable to d and waits for it to complete or for ctx to
+// be done.
+func (p DisableParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandDisable, p, nil)
+}
+
+// EnableParams are the parameters of Debugger.enable.
+//
+// Enables debugger for the given page. Clients should not assume that the
+// debugging has been enabled until the result for this command is received.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Debugger#method-enable
+type EnableParams struct {
+	// The maximum size in bytes of collected scripts (not referenced by other heap
+	// objects) the debugger can hold. Puts no limit if parameter is omitted.
+	//
+	// This is an experimental part of the protocol.
+	MaxScriptsCacheSize float64 `json:"maxScriptsCacheSize,omitempty"`
+}
+
+// Command returns the Debugger.enable command for use with AsyncDebugger.Send.
+func (p EnableParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandEnable, p)
+}
+
+// EnableReturns are the values returned by Debugger.enable.
+type EnableReturns struct {
+	// Unique identifier of the debugger.
+	//
+	// This is an experimental part of the protocol.
+	DebuggerID types.RuntimeUniqueDebuggerID `json:"debuggerId"`
+}
+
+// Do sends Debugger.enable to d and waits for its result or for ctx to be
+// done.
+func (p EnableParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*EnableReturns, error) {
+	ret := &EnableReturns{}
+	if err := protocol.Do(ctx, d, CommandEnable, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// EvaluateOnCallFrameParams are the parameters of Debugger.evaluateOnCallFrame.
+//
+// Evaluates expression on a given call frame.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Debugger#method-evaluateOnCallFrame
+type EvaluateOnCallFrameParams struct {
+	// Call frame identifier to evaluate on.
+	CallFrameID CallFrameID `json:"callFrameId"`
+	// Expression to evaluate.
+	Expression string `json:"expression"`
+	// String object group name to put result into (allows rapid releasing
+	// resulting object handles using `releaseObjectGroup`).
+	ObjectGroup string `json:"objectGroup,omitempty"`
+	// Specifies whether command line API should be available to the evaluated
+	// expression, defaults to false.
+	IncludeCommandLineAPI bool `json:"includeCommandLineAPI,omitempty"`
+	// In silent mode exceptions thrown during evaluation are not reported and do
+	// not pause execution. Overrides `setPauseOnException` state.
+	Silent bool `json:"silent,omitempty"`
+	// Whether the result is expected to be a JSON object that should be sent by
+	// value.
+	ReturnByValue bool `json:"returnByValue,omitempty"`
+	// Whether preview should be generated for the result.
+	//
+	// This is an experimental part of the protocol.
+	GeneratePreview bool `json:"generatePreview,omitempty"`
+	// Whether to throw an exception if side effect cannot be ruled out during
+	// evaluation.
+	ThrowOnSideEffect bool `json:"throwOnSideEffect,omitempty"`
+	// Terminate execution after timing out (number of milliseconds).
+	//
+	// This is an experimental part of the protocol.
+	Timeout types.RuntimeTimeDelta `json:"timeout,omitempty"`
+}
+
+// Command returns the Debugger.evaluateOnCallFrame command for use with
+// AsyncDebugger.Send.
+func (p EvaluateOnCallFrameParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandEvaluateOnCallFrame, p)
+}
+
+// EvaluateOnCallFrameReturns are the values returned by Debugger.evaluateOnCallFrame.
+type EvaluateOnCallFrameReturns struct {
+	// Object wrapper for the evaluation result.
+	Result types.RuntimeRemoteObject `json:"result"`
+	// Exception details.
+	ExceptionDetails *types.RuntimeExceptionDetails `json:"exceptionDetails,omitempty"`
+}
+
+// Do sends Debugger.evaluateOnCallFrame to d and waits for its result or for ctx to be
+// done.
+func (p EvaluateOnCallFrameParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*EvaluateOnCallFrameReturns, error) {
+	ret := &EvaluateOnCallFrameReturns{}
+	if err := protocol.Do(ctx, d, CommandEvaluateOnCallFrame, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// GetPossibleBreakpointsParams are the parameters of
+// Debugger.getPossibleBreakpoints.
+//
+// Returns possible locations for breakpoint. scriptId in start and end range
+// locations should be the same.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Debugger#method-getPossibleBreakpoints
+type GetPossibleBreakpointsParams struct {
+	// Start of range to search possible breakpoint locations in.
+	Start Location `json:"start"`
+	// End of range to search possible breakpoint locations in (excluding). When
+	// not specified, end of scripts is used as end of range.
+	End *Location `json:"end,omitempty"`
+	// Only consider locations which are in the same (non-nested) function as
+	// start.
+	RestrictToFunction bool `json:"restrictToFunction,omitempty"`
+}
+
+// Command returns the Debugger.getPossibleBreakpoints command for use with
+// AsyncDebugger.Send.
+func (p GetPossibleBreakpointsParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandGetPossibleBreakpoints, p)
+}
+
+// GetPossibleBreakpointsReturns are the values returned by Debugger.getPossibleBreakpoints.
+type GetPossibleBreakpointsReturns struct {
+	// List of the possible breakpoint locations.
+	Locations []BreakLocation `json:"locations"`
+}
+
+// Do sends Debugger.getPossibleBreakpoints to d and waits for its result or for ctx to be
+// done.
+func (p GetPossibleBreakpointsParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*GetPossibleBreakpointsReturns, error) {
+	ret := &GetPossibleBreakpointsReturns{}
+	if err := protocol.Do(ctx, d, CommandGetPossibleBreakpoints, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// GetScriptSourceParams are the parameters of Debugger.getScriptSource.
+//
+// Returns source for the script with given id.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Debugger#method-getScriptSource
+type GetScriptSourceParams struct {
+	// Id of the script to get source for.
+	ScriptID types.RuntimeScriptID `json:"scriptId"`
+}
+
+// Command returns the Debugger.getScriptSource command for use with
+// AsyncDebugger.Send.
+func (p GetScriptSourceParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandGetScriptSource, p)
+}
+
+// GetScriptSourceReturns are the values returned by Debugger.getScriptSource.
+type GetScriptSourceReturns struct {
+	// Script source (empty in case of Wasm bytecode).
+	ScriptSource string `json:"scriptSource"`
+	// Wasm bytecode. (Encoded as a base64 string when passed over JSON)
+	Bytecode string `json:"bytecode,omitempty"`
+}
+
+// Do sends Debugger.getScriptSource to d and waits for its result or for ctx to be
+// done.
+func (p GetScriptSourceParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*GetScriptSourceReturns, error) {
+	ret := &GetScriptSourceReturns{}
+	if err := protocol.Do(ctx, d, CommandGetScriptSource, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// DisassembleWasmModuleParams are the parameters of
+// Debugger.disassembleWasmModule.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Debugger#method-disassembleWasmModule
+//
+// This is an experimental part of the protocol.
+type DisassembleWasmModuleParams struct {
+	// Id of the script to disassemble
+	ScriptID types.RuntimeScriptID `json:"scriptId"`
+}
+
+// Command returns the Debugger.disassembleWasmModule command for use with
+// AsyncDebugger.Send.
+func (p DisassembleWasmModuleParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandDisassembleWasmModule, p)
+}
+
+// DisassembleWasmModuleReturns are the values returned by Debugger.disassembleWasmModule.
+type DisassembleWasmModuleReturns struct {
+	// For large modules, return a stream from which additional chunks of
+	// disassembly can be read successively.
+	StreamID string `json:"streamId,omitempty"`
+	// The total number of lines in the disassembly text.
+	TotalNumberOfLines int64 `json:"totalNumberOfLines"`
+	// The offsets of all function bodies, in the format [start1, end1, start2,
+	// end2, ...] where all ends are exclusive.
+	FunctionBodyOffsets []int64 `json:"functionBodyOffsets"`
+	// The first chunk of disassembly.
+	Chunk WasmDisassemblyChunk `json:"chunk"`
+}
+
+// Do sends Debugger.disassembleWasmModule to d and waits for its result or for ctx to be
+// done.
+func (p DisassembleWasmModuleParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*DisassembleWasmModuleReturns, error) {
+	ret := &DisassembleWasmModuleReturns{}
+	if err := protocol.Do(ctx, d, CommandDisassembleWasmModule, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// NextWasmDisassemblyChunkParams are the parameters of
+// Debugger.nextWasmDisassemblyChunk.
+//
+// Disassemble the next chunk of lines for the module corresponding to the
+// stream. If disassembly is complete, this API will invalidate the streamId and
+// return an empty chunk. Any subsequent calls for the now invalid stream will
+// return errors.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Debugger#method-nextWasmDisassemblyChunk
+//
+// This is an experimental part of the protocol.
+type NextWasmDisassemblyChunkParams struct {
+	StreamID string `json:"streamId"`
+}
+
+// Command returns the Debugger.nextWasmDisassemblyChunk command for use with
+// AsyncDebugger.Send.
+func (p NextWasmDisassemblyChunkParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandNextWasmDisassemblyChunk, p)
+}
+
+// NextWasmDisassemblyChunkReturns are the values returned by Debugger.nextWasmDisassemblyChunk.
+type NextWasmDisassemblyChunkReturns struct {
+	// The next chunk of disassembly.
+	Chunk WasmDisassemblyChunk `json:"chunk"`
+}
+
+// Do sends Debugger.nextWasmDisassemblyChunk to d and waits for its result or for ctx to be
+// done.
+func (p NextWasmDisassemblyChunkParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*NextWasmDisassemblyChunkReturns, error) {
+	ret := &NextWasmDisassemblyChunkReturns{}
+	if err := protocol.Do(ctx, d, CommandNextWasmDisassemblyChunk, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// GetWasmBytecodeParams are the parameters of Debugger.getWasmBytecode.
+//
+// This command is deprecated. Use getScriptSource instead.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Debugger#method-getWasmBytecode
+//
+// Deprecated: this is deprecated in the protocol.
+type GetWasmBytecodeParams struct {
+	// Id of the Wasm script to get source for.
+	ScriptID types.RuntimeScriptID `json:"scriptId"`
+}
+
+// Command returns the Debugger.getWasmBytecode command for use with
+// AsyncDebugger.Send.
+func (p GetWasmBytecodeParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandGetWasmBytecode, p)
+}
+
+// GetWasmBytecodeReturns are the values returned by Debugger.getWasmBytecode.
+type GetWasmBytecodeReturns struct {
+	// Script source. (Encoded as a base64 string when passed over JSON)
+	Bytecode string `json:"bytecode"`
+}
+
+// Do sends Debugger.getWasmBytecode to d and waits for its result or for ctx to be
+// done.
+func (p GetWasmBytecodeParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*GetWasmBytecodeReturns, error) {
+	ret := &GetWasmBytecodeReturns{}
+	if err := protocol.Do(ctx, d, CommandGetWasmBytecode, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// GetStackTraceParams are the parameters of Debugger.getStackTrace.
+//
+// Returns stack trace with given `stackTraceId`.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Debugger#method-getStackTrace
+//
+// This is an experimental part of the protocol.
+type GetStackTraceParams struct {
+	StackTraceID types.RuntimeStackTraceID `json:"stackTraceId"`
+}
+
+// Command returns the Debugger.getStackTrace command for use with
+// AsyncDebugger.Send.
+func (p GetStackTraceParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandGetStackTrace, p)
+}
+
+// GetStackTraceReturns are the values returned by Debugger.getStackTrace.
+type GetStackTraceReturns struct {
+	StackTrace types.RuntimeStackTrace `json:"stackTrace"`
+}
+
+// Do sends Debugger.getStackTrace to d and waits for its result or for ctx to be
+// done.
+func (p GetStackTraceParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*GetStackTraceReturns, error) {
+	ret := &GetStackTraceReturns{}
+	if err := protocol.Do(ctx, d, CommandGetStackTrace, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// PauseParams are the parameters of Debugger.pause.
+//
+// Stops on the next JavaScript statement.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Debugger#method-pause
+type PauseParams struct {
+}
+
+// Command returns the Debugger.pause command for use with AsyncDebugger.Send.
+func (p PauseParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandPause, p)
+}
+
+// Do sends Debugger.pause to d and waits for it to complete or for ctx to
+// be done.
+func (p PauseParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandPause, p, nil)
+}
+
+// PauseOnAsyncCallParams are the parameters of Debugger.pauseOnAsyncCall.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Debugger#method-pauseOnAsyncCall
+//
+// This is an experimental part of the protocol.
+//
+// Deprecated: this is deprecated in the protocol.
+type PauseOnAsyncCallParams struct {
+	// Debugger will pause when async call with given stack trace is started.
+	ParentStackTraceID types.RuntimeStackTraceID `json:"parentStackTraceId"`
+}
+
+// Command returns the Debugger.pauseOnAsyncCall command for use with
+// AsyncDebugger.Send.
+func (p PauseOnAsyncCallParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandPauseOnAsyncCall, p)
+}
+
+// Do sends Debugger.pauseOnAsyncCall to d and waits for it to complete or for ctx to
+// be done.
+func (p PauseOnAsyncCallParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandPauseOnAsyncCall, p, nil)
+}
+
+// RemoveBreakpointParams are the parameters of Debugger.removeBreakpoint.
+//
+// Removes JavaScript breakpoint.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Debugger#method-removeBreakpoint
+type RemoveBreakpointParams struct {
+	BreakpointID BreakpointID `json:"breakpointId"`
+}
+
+// Command returns the Debugger.removeBreakpoint command for use with
+// AsyncDebugger.Send.
+func (p RemoveBreakpointParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandRemoveBreakpoint, p)
+}
+
+// Do sends Debugger.removeBreakpoint to d and waits for it to complete or for ctx to
+// be done.
+func (p RemoveBreakpointParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandRemoveBreakpoint, p, nil)
+}
+
+// RestartFrameParams are the parameters of Debugger.restartFrame.
+//
+// Restarts particular call frame from the beginning. The old, deprecated
+// behavior of `restartFrame` is to stay paused and allow further CDP commands
+// after a restart was scheduled. This can cause problems with restarting, so we
+// now continue execution immediatly after it has been scheduled until we reach
+// the beginning of the restarted frame.
+//
+// To stay back-wards compatible, `restartFrame` now expects a `mode` parameter
+// to be present. If the `mode` parameter is missing, `restartFrame` errors out.
+//
+// The various return values are deprecated and `callFrames` is always empty.
+// Use the call frames from the `Debugger#paused` events instead, that fires
+// once V8 pauses at the beginning of the restarted function.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Debugger#method-restartFrame
+type RestartFrameParams struct {
+	// Call frame identifier to evaluate on.
+	CallFrameID CallFrameID `json:"callFrameId"`
+	// The `mode` parameter must be present and set to 'StepInto', otherwise
+	// `restartFrame` will error out.
+	//
+	// Allowed values: StepInto.
+	//
+	// This is an experimental part of the protocol.
+	Mode string `json:"mode,omitempty"`
+}
+
+// Command returns the Debugger.restartFrame command for use with
+// AsyncDebugger.Send.
+func (p RestartFrameParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandRestartFrame, p)
+}
+
+// RestartFrameReturns are the values returned by Debugger.restartFrame.
+type RestartFrameReturns struct {
+	// New stack trace.
+	//
+	// Deprecated: this is deprecated in the protocol.
+	CallFrames []CallFrame `json:"callFrames"`
+	// Async stack trace, if any.
+	//
+	// Deprecated: this is deprecated in the protocol.
+	AsyncStackTrace *types.RuntimeStackTrace `json:"asyncStackTrace,omitempty"`
+	// Async stack trace, if any.
+	//
+	// Deprecated: this is deprecated in the protocol.
+	AsyncStackTraceID *types.RuntimeStackTraceID `json:"asyncStackTraceId,omitempty"`
+}
+
+// Do sends Debugger.restartFrame to d and waits for its result or for ctx to be
+// done.
+func (p RestartFrameParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*RestartFrameReturns, error) {
+	ret := &RestartFrameReturns{}
+	if err := protocol.Do(ctx, d, CommandRestartFrame, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// ResumeParams are the parameters of Debugger.resume.
+//
+// Resumes JavaScript execution.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Debugger#method-resume
+type ResumeParams struct {
+	// Set to true to terminate execution upon resuming execution. In contrast to
+	// Runtime.terminateExecution, this will allows to execute further JavaScript
+	// (i.e. via evaluation) until execution of the paused code is actually
+	// resumed, at which point termination is triggered. If execution is currently
+	// not paused, this parameter has no effect.
+	TerminateOnResume bool `json:"terminateOnResume,omitempty"`
+}
+
+// Command returns the Debugger.resume command for use with AsyncDebugger.Send.
+func (p ResumeParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandResume, p)
+}
+
+// Do sends Debugger.resume to d and waits for it to complete or for ctx to
+// be done.
+func (p ResumeParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandResume, p, nil)
+}
+
+// SearchInContentParams are the parameters of Debugger.searchInContent.
+//
+// Searches for given string in script content.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Debugger#method-searchInContent
+type SearchInContentParams struct {
+	// Id of the script to search in.
+	ScriptID types.RuntimeScriptID `json:"scriptId"`
+	// String to search for.
+	Query string `json:"query"`
+	// If true, search is case sensitive.
+	CaseSensitive bool `json:"caseSensitive,omitempty"`
+	// If true, treats string parameter as regex.
+	IsRegex bool `json:"isRegex,omitempty"`
+}
+
+// Command returns the Debugger.searchInContent command for use with
+// AsyncDebugger.Send.
+func (p SearchInContentParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSearchInContent, p)
+}
+
+// SearchInContentReturns are the values returned by Debugger.searchInContent.
+type SearchInContentReturns struct {
+	// List of search matches.
+	Result []SearchMatch `json:"result"`
+}
+
+// Do sends Debugger.searchInContent to d and waits for its result or for ctx to be
+// done.
+func (p SearchInContentParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*SearchInContentReturns, error) {
+	ret := &SearchInContentReturns{}
+	if err := protocol.Do(ctx, d, CommandSearchInContent, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// SetAsyncCallStackDepthParams are the parameters of
+// Debugger.setAsyncCallStackDepth.
+//
+// Enables or disables async call stacks tracking.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Debugger#method-setAsyncCallStackDepth
+type SetAsyncCallStackDepthParams struct {
+	// Maximum depth of async call stacks. Setting to `0` will effectively disable
+	// collecting async call stacks (default).
+	MaxDepth int64 `json:"maxDepth"`
+}
+
+// Command returns the Debugger.setAsyncCallStackDepth command for use with
+// AsyncDebugger.Send.
+func (p SetAsyncCallStackDepthParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetAsyncCallStackDepth, p)
+}
+
+// Do sends Debugger.setAsyncCallStackDepth to d and waits for it to complete or for ctx to
+// be done.
+func (p SetAsyncCallStackDepthParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetAsyncCallStackDepth, p, nil)
+}
+
+// SetBlackboxExecutionContextsParams are the parameters of
+// Debugger.setBlackboxExecutionContexts.
+//
+// Replace previous blackbox execution contexts with passed ones. Forces backend
+// to skip stepping/pausing in scripts in these execution contexts. VM will try
+// to leave blackboxed script by performing 'step in' several times, finally
+// resorting to 'step out' if unsuccessful.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Debugger#method-setBlackboxExecutionContexts
+//
+// This is an experimental part of the protocol.
+type SetBlackboxExecutionContextsParams struct {
+	// Array of execution context unique ids for the debugger to ignore.
+	UniqueIds []string `json:"uniqueIds"`
+}
+
+// Command returns the Debugger.setBlackboxExecutionContexts command for use
+// with AsyncDebugger.Send.
+func (p SetBlackboxExecutionContextsParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetBlackboxExecutionContexts, p)
+}
+
+// Do sends Debugger.setBlackboxExecutionContexts to d and waits for it to complete or for ctx to
+// be done.
+func (p SetBlackboxExecutionContextsParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetBlackboxExecutionContexts, p, nil)
+}
+
+// SetBlackboxPatternsParams are the parameters of Debugger.setBlackboxPatterns.
+//
+// Replace previous blackbox patterns with passed ones. Forces backend to skip
+// stepping/pausing in scripts with url matching one of the patterns. VM will
+// try to leave blackboxed script by performing 'step in' several times, finally
+// resorting to 'step out' if unsuccessful.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Debugger#method-setBlackboxPatterns
+//
+// This is an experimental part of the protocol.
+type SetBlackboxPatternsParams struct {
+	// Array of regexps that will be used to check script url for blackbox state.
+	Patterns []string `json:"patterns"`
+	// If true, also ignore scripts with no source url.
+	SkipAnonymous bool `json:"skipAnonymous,omitempty"`
+}
+
+// Command returns the Debugger.setBlackboxPatterns command for use with
+// AsyncDebugger.Send.
+func (p SetBlackboxPatternsParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetBlackboxPatterns, p)
+}
+
+// Do sends Debugger.setBlackboxPatterns to d and waits for it to complete or for ctx to
+// be done.
+func (p SetBlackboxPatternsParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetBlackboxPatterns, p, nil)
+}
+
+// SetBlackboxedRangesParams are the parameters of Debugger.setBlackboxedRanges.
+//
+// Makes backend skip steps in the script in blackboxed ranges. VM will try
+// leave blacklisted scripts by performing 'step in' several times, finally
+// resorting to 'step out' if unsuccessful. Positions array contains positions
+// where blackbox state is changed. First interval isn't blackboxed. Array
+// should be sorted.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Debugger#method-setBlackboxedRanges
+//
+// This is an experimental part of the protocol.
+type SetBlackboxedRangesParams struct {
+	// Id of the script.
+	ScriptID  types.RuntimeScriptID `json:"scriptId"`
+	Positions []ScriptPosition      `json:"positions"`
+}
+
+// Command returns the Debugger.setBlackboxedRanges command for use with
+// AsyncDebugger.Send.
+func (p SetBlackboxedRangesParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetBlackboxedRanges, p)
+}
+
+// Do sends Debugger.setBlackboxedRanges to d and waits for it to complete or for ctx to
+// be done.
+func (p SetBlackboxedRangesParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetBlackboxedRanges, p, nil)
+}
+
+// SetBreakpointParams are the parameters of Debugger.setBreakpoint.
+//
+// Sets JavaScript breakpoint at a given location.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Debugger#method-setBreakpoint
+type SetBreakpointParams struct {
+	// Location to set breakpoint in.
+	Location Location `json:"location"`
+	// Expression to use as a breakpoint condition. When specified, debugger will
+	// only stop on the breakpoint if this expression evaluates to true.
+	Condition string `json:"condition,omitempty"`
+}
+
+// Command returns the Debugger.setBreakpoint command for use with
+// AsyncDebugger.Send.
+func (p SetBreakpointParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetBreakpoint, p)
+}
+
+// SetBreakpointReturns are the values returned by Debugger.setBreakpoint.
+type SetBreakpointReturns struct {
+	// Id of the created breakpoint for further reference.
+	BreakpointID BreakpointID `json:"breakpointId"`
+	// Location this breakpoint resolved into.
+	ActualLocation Location `json:"actualLocation"`
+}
+
+// Do sends Debugger.setBreakpoint to d and waits for its result or for ctx to be
+// done.
+func (p SetBreakpointParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*SetBreakpointReturns, error) {
+	ret := &SetBreakpointReturns{}
+	if err := protocol.Do(ctx, d, CommandSetBreakpoint, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// SetInstrumentationBreakpointParams are the parameters of
+// Debugger.setInstrumentationBreakpoint.
+//
+// Sets instrumentation breakpoint.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Debugger#method-setInstrumentationBreakpoint
+type SetInstrumentationBreakpointParams struct {
+	// Instrumentation name.
+	//
+	// Allowed values: beforeScriptExecution, beforeScriptWithSourceMapExecution.
+	Instrumentation string `json:"instrumentation"`
+}
+
+// Command returns the Debugger.setInstrumentationBreakpoint command for use
+// with AsyncDebugger.Send.
+func (p SetInstrumentationBreakpointParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetInstrumentationBreakpoint, p)
+}
+
+// SetInstrumentationBreakpointReturns are the values returned by Debugger.setInstrumentationBreakpoint.
+type SetInstrumentationBreakpointReturns struct {
+	// Id of the created breakpoint for further reference.
+	BreakpointID BreakpointID `json:"breakpointId"`
+}
+
+// Do sends Debugger.setInstrumentationBreakpoint to d and waits for its result or for ctx to be
+// done.
+func (p SetInstrumentationBreakpointParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*SetInstrumentationBreakpointReturns, error) {
+	ret := &SetInstrumentationBreakpointReturns{}
+	if err := protocol.Do(ctx, d, CommandSetInstrumentationBreakpoint, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// SetBreakpointByURLParams are the parameters of Debugger.setBreakpointByUrl.
+//
+// Sets JavaScript breakpoint at given location specified either by URL or URL
+// regex. Once this command is issued, all existing parsed scripts will have
+// breakpoints resolved and returned in `locations` property. Further matching
+// script parsing will result in subsequent `breakpointResolved` events issued.
+// This logical breakpoint will survive page reloads.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Debugger#method-setBreakpointByUrl
+type SetBreakpointByURLParams struct {
+	// Line number to set breakpoint at.
+	LineNumber int64 `json:"lineNumber"`
+	// URL of the resources to set breakpoint on.
+	URL string `json:"url,omitempty"`
+	// Regex pattern for the URLs of the resources to set breakpoints on. Either
+	// `url` or `urlRegex` must be specified.
+	URLRegex string `json:"urlRegex,omitempty"`
+	// Script hash of the resources to set breakpoint on.
+	ScriptHash string `json:"scriptHash,omitempty"`
+	// Offset in the line to set breakpoint at.
+	ColumnNumber int64 `json:"columnNumber,omitempty"`
+	// Expression to use as a breakpoint condition. When specified, debugger will
+	// only stop on the breakpoint if this expression evaluates to true.
+	Condition string `json:"condition,omitempty"`
+}
+
+// Command returns the Debugger.setBreakpointByUrl command for use with
+// AsyncDebugger.Send.
+func (p SetBreakpointByURLParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetBreakpointByURL, p)
+}
+
+// SetBreakpointByURLReturns are the values returned by Debugger.setBreakpointByUrl.
+type SetBreakpointByURLReturns struct {
+	// Id of the created breakpoint for further reference.
+	BreakpointID BreakpointID `json:"breakpointId"`
+	// List of the locations this breakpoint resolved into upon addition.
+	Locations []Location `json:"locations"`
+}
+
+// Do sends Debugger.setBreakpointByUrl to d and waits for its result or for ctx to be
+// done.
+func (p SetBreakpointByURLParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*SetBreakpointByURLReturns, error) {
+	ret := &SetBreakpointByURLReturns{}
+	if err := protocol.Do(ctx, d, CommandSetBreakpointByURL, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// SetBreakpointOnFunctionCallParams are the parameters of
+// Debugger.setBreakpointOnFunctionCall.
+//
+// Sets JavaScript breakpoint before each call to the given function. If another
+// function was created from the same source as a given one, calling it will
+// also trigger the breakpoint.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Debugger#method-setBreakpointOnFunctionCall
+//
+// This is an experimental part of the protocol.
+type SetBreakpointOnFunctionCallParams struct {
+	// Function object id.
+	ObjectID types.RuntimeRemoteObjectID `json:"objectId"`
+	// Expression to use as a breakpoint condition. When specified, debugger will
+	// stop on the breakpoint if this expression evaluates to true.
+	Condition string `json:"condition,omitempty"`
+}
+
+// Command returns the Debugger.setBreakpointOnFunctionCall command for use with
+// AsyncDebugger.Send.
+func (p SetBreakpointOnFunctionCallParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetBreakpointOnFunctionCall, p)
+}
+
+// SetBreakpointOnFunctionCallReturns are the values returned by Debugger.setBreakpointOnFunctionCall.
+type SetBreakpointOnFunctionCallReturns struct {
+	// Id of the created breakpoint for further reference.
+	BreakpointID BreakpointID `json:"breakpointId"`
+}
+
+// Do sends Debugger.setBreakpointOnFunctionCall to d and waits for its result or for ctx to be
+// done.
+func (p SetBreakpointOnFunctionCallParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*SetBreakpointOnFunctionCallReturns, error) {
+	ret := &SetBreakpointOnFunctionCallReturns{}
+	if err := protocol.Do(ctx, d, CommandSetBreakpointOnFunctionCall, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// SetBreakpointsActiveParams are the parameters of
+// Debugger.setBreakpointsActive.
+//
+// Activates / deactivates all breakpoints on the page.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Debugger#method-setBreakpointsActive
+type SetBreakpointsActiveParams struct {
+	// New value for breakpoints active state.
+	Active bool `json:"active"`
+}
+
+// Command returns the Debugger.setBreakpointsActive command for use with
+// AsyncDebugger.Send.
+func (p SetBreakpointsActiveParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetBreakpointsActive, p)
+}
+
+// Do sends Debugger.setBreakpointsActive to d and waits for it to complete or for ctx to
+// be done.
+func (p SetBreakpointsActiveParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetBreakpointsActive, p, nil)
+}
+
+// SetPauseOnExceptionsParams are the parameters of
+// Debugger.setPauseOnExceptions.
+//
+// Defines pause on exceptions state. Can be set to stop on all exceptions,
+// uncaught exceptions, or caught exceptions, no exceptions. Initial pause on
+// exceptions state is `none`.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Debugger#method-setPauseOnExceptions
+type SetPauseOnExceptionsParams struct {
+	// Pause on exceptions mode.
+	//
+	// Allowed values: none, caught, uncaught, all.
+	State string `json:"state"`
+}
+
+// Command returns the Debugger.setPauseOnExceptions command for use with
+// AsyncDebugger.Send.
+func (p SetPauseOnExceptionsParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetPauseOnExceptions, p)
+}
+
+// Do sends Debugger.setPauseOnExceptions to d and waits for it to complete or for ctx to
+// be done.
+func (p SetPauseOnExceptionsParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetPauseOnExceptions, p, nil)
+}
+
+// SetReturnValueParams are the parameters of Debugger.setReturnValue.
+//
+// Changes return value in top frame. Available only at return break position.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Debugger#method-setReturnValue
+//
+// This is an experimental part of the protocol.
+type SetReturnValueParams struct {
+	// New return value.
+	NewValue types.RuntimeCallArgument `json:"newValue"`
+}
+
+// Command returns the Debugger.setReturnValue command for use with
+// AsyncDebugger.Send.
+func (p SetReturnValueParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetReturnValue, p)
+}
+
+// Do sends Debugger.setReturnValue to d and waits for it to complete or for ctx to
+// be done.
+func (p SetReturnValueParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetReturnValue, p, nil)
+}
+
+// SetScriptSourceParams are the parameters of Debugger.setScriptSource.
+//
+// Edits JavaScript source live.
+//
+// In general, functions that are currently on the stack can not be edited with
+// a single exception: If the edited function is the top-most stack frame and
+// that is the only activation of that function on the stack. In this case the
+// live edit will be successful and a `Debugger.restartFrame` for the top-most
+// function is automatically triggered.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Debugger#method-setScriptSource
+type SetScriptSourceParams struct {
+	// Id of the script to edit.
+	ScriptID types.RuntimeScriptID `json:"scriptId"`
+	// New content of the script.
+	ScriptSource string `json:"scriptSource"`
+	// If true the change will not actually be applied. Dry run may be used to get
+	// result description without actually modifying the code.
+	DryRun bool `json:"dryRun,omitempty"`
+	// If true, then `scriptSource` is allowed to change the function on top of the
+	// stack as long as the top-most stack frame is the only activation of that
+	// function.
+	//
+	// This is an experimental part of the protocol.
+	AllowTopFrameEditing bool `json:"allowTopFrameEditing,omitempty"`
+}
+
+// Command returns the Debugger.setScriptSource command for use with
+// AsyncDebugger.Send.
+func (p SetScriptSourceParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetScriptSource, p)
+}
+
+// SetScriptSourceReturns are the values returned by Debugger.setScriptSource.
+type SetScriptSourceReturns struct {
+	// New stack trace in case editing has happened while VM was stopped.
+	//
+	// Deprecated: this is deprecated in the protocol.
+	CallFrames []CallFrame `json:"callFrames,omitempty"`
+	// Whether current call stack was modified after applying the changes.
+	//
+	// Deprecated: this is deprecated in the protocol.
+	StackChanged bool `json:"stackChanged,omitempty"`
+	// Async stack trace, if any.
+	//
+	// Deprecated: this is deprecated in the protocol.
+	AsyncStackTrace *types.RuntimeStackTrace `json:"asyncStackTrace,omitempty"`
+	// Async stack trace, if any.
+	//
+	// Deprecated: this is deprecated in the protocol.
+	AsyncStackTraceID *types.RuntimeStackTraceID `json:"asyncStackTraceId,omitempty"`
+	// Whether the operation was successful or not. Only `Ok` denotes a successful
+	// live edit while the other enum variants denote why the live edit failed.
+	//
+	// Allowed values: Ok, CompileError, BlockedByActiveGenerator,
+	// BlockedByActiveFunction, BlockedByTopLevelEsModuleChange.
+	//
+	// This is an experimental part of the protocol.
+	Status string `json:"status"`
+	// Exception details if any. Only present when `status` is `CompileError`.
+	ExceptionDetails *types.RuntimeExceptionDetails `json:"exceptionDetails,omitempty"`
+}
+
+// Do sends Debugger.setScriptSource to d and waits for its result or for ctx to be
+// done.
+func (p SetScriptSourceParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*SetScriptSourceReturns, error) {
+	ret := &SetScriptSourceReturns{}
+	if err := protocol.Do(ctx, d, CommandSetScriptSource, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// SetSkipAllPausesParams are the parameters of Debugger.setSkipAllPauses.
+//
+// Makes page not interrupt on any pauses (breakpoint, exception, dom exception
+// etc).
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Debugger#method-setSkipAllPauses
+type SetSkipAllPausesParams struct {
+	// New value for skip pauses state.
+	Skip bool `json:"skip"`
+}
+
+// Command returns the Debugger.setSkipAllPauses command for use with
+// AsyncDebugger.Send.
+func (p SetSkipAllPausesParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetSkipAllPauses, p)
+}
+
+// Do sends Debugger.setSkipAllPauses to d and waits for it to complete or for ctx to
+// be done.
+func (p SetSkipAllPausesParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetSkipAllPauses, p, nil)
+}
+
+// SetVariableValueParams are the parameters of Debugger.setVariableValue.
+//
+// Changes value of variable in a callframe. Object-based scopes are not
+// supported and must be mutated manually.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Debugger#method-setVariableValue
+type SetVariableValueParams struct {
+	// 0-based number of scope as was listed in scope chain. Only 'local',
+	// 'closure' and 'catch' scope types are allowed. Other scopes could be
+	// manipulated manually.
+	ScopeNumber int64 `json:"scopeNumber"`
+	// Variable name.
+	VariableName string `json:"variableName"`
+	// New variable value.
+	NewValue types.RuntimeCallArgument `json:"newValue"`
+	// Id of callframe that holds variable.
+	CallFrameID CallFrameID `json:"callFrameId"`
+}
+
+// Command returns the Debugger.setVariableValue command for use with
+// AsyncDebugger.Send.
+func (p SetVariableValueParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetVariableValue, p)
+}
+
+// Do sends Debugger.setVariableValue to d and waits for it to complete or for ctx to
+// be done.
+func (p SetVariableValueParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetVariableValue, p, nil)
+}
+
+// StepIntoParams are the parameters of Debugger.stepInto.
+//
+// Steps into the function call.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Debugger#method-stepInto
+type StepIntoParams struct {
+	// Debugger will pause on the execution of the first async task which was
+	// scheduled before next pause.
+	//
+	// This is an experimental part of the protocol.
+	BreakOnAsyncCall bool `json:"breakOnAsyncCall,omitempty"`
+	// The skipList specifies location ranges that should be skipped on step into.
+	//
+	// This is an experimental part of the protocol.
+	SkipList []LocationRange `json:"skipList,omitempty"`
+}
+
+// Command returns the Debugger.stepInto command for use with
+// AsyncDebugger.Send.
+func (p StepIntoParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandStepInto, p)
+}
+
+// Do sends Debugger.stepInto to d and waits for it to complete or for ctx to
+// be done.
+func (p StepIntoParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandStepInto, p, nil)
+}
+
+// StepOutParams are the parameters of Debugger.stepOut.
+//
+// Steps out of the function call.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Debugger#method-stepOut
+type StepOutParams struct {
+}
+
+// Command returns the Debugger.stepOut command for use with AsyncDebugger.Send.
+func (p StepOutParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandStepOut, p)
+}
+
+// Do sends Debugger.stepOut to d and waits for it to complete or for ctx to
+// be done.
+func (p StepOutParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandStepOut, p, nil)
+}
+
+// StepOverParams are the parameters of Debugger.stepOver.
+//
+// Steps over the statement.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Debugger#method-stepOver
+type StepOverParams struct {
+	// The skipList specifies location ranges that should be skipped on step over.
+	//
+	// This is an experimental part of the protocol.
+	SkipList []LocationRange `json:"skipList,omitempty"`
+}
+
+// Command returns the Debugger.stepOver command for use with
+// AsyncDebugger.Send.
+func (p StepOverParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandStepOver, p)
+}
+
+// Do sends Debugger.stepOver to d and waits for it to complete or for ctx to
+// be done.
+func (p StepOverParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandStepOver, p, nil)
+}
+
+// Method names of the events in the Debugger domain.
+const (
+	EventBreakpointResolved  = "Debugger.breakpointResolved"
+	EventPaused              = "Debugger.paused"
+	EventResumed             = "Debugger.resumed"
+	EventScriptFailedToParse = "Debugger.scriptFailedToParse"
+	EventScriptParsed        = "Debugger.scriptParsed"
+)
+
+// BreakpointResolvedEvent is the payload of the Debugger.breakpointResolved
+// event.
+//
+// Fired when breakpoint is resolved to an actual script and location.
+// Deprecated in favor of `resolvedBreakpoints` in the `scriptParsed` event.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Debugger#event-breakpointResolved
+//
+// Deprecated: this is deprecated in the protocol.
+type BreakpointResolvedEvent struct {
+	// Breakpoint unique identifier.
+	BreakpointID BreakpointID `json:"breakpointId"`
+	// Actual breakpoint location.
+	Location Location `json:"location"`
+}
+
+// PausedEvent is the payload of the Debugger.paused event.
+//
+// Fired when the virtual machine stopped on breakpoint or exception or any
+// other stop criteria.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Debugger#event-paused
+type PausedEvent struct {
+	// Call stack the virtual machine stopped on.
+	CallFrames []CallFrame `json:"callFrames"`
+	// Pause reason.
+	//
+	// Allowed values: ambiguous, assert, CSPViolation, debugCommand, DOM,
+	// EventListener, exception, instrumentation, OOM, other, promiseRejection,
+	// XHR, step.
+	Reason string `json:"reason"`
+	// Object containing break-specific auxiliary properties.
+	Data map[string]interface{} `json:"data,omitempty"`
+	// Hit breakpoints IDs
+	HitBreakpoints []string `json:"hitBreakpoints,omitempty"`
+	// Async stack trace, if any.
+	AsyncStackTrace *types.RuntimeStackTrace `json:"asyncStackTrace,omitempty"`
+	// Async stack trace, if any.
+	//
+	// This is an experimental part of the protocol.
+	AsyncStackTraceID *types.RuntimeStackTraceID `json:"asyncStackTraceId,omitempty"`
+	// Never present, will be removed.
+	//
+	// This is an experimental part of the protocol.
+	//
+	// Deprecated: this is deprecated in the protocol.
+	AsyncCallStackTraceID *types.RuntimeStackTraceID `json:"asyncCallStackTraceId,omitempty"`
+}
+
+// ResumedEvent is the payload of the Debugger.resumed event.
+//
+// Fired when the virtual machine resumed execution.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Debugger#event-resumed
+type ResumedEvent struct {
+}
+
+// ScriptFailedToParseEvent is the payload of the Debugger.scriptFailedToParse
+// event.
+//
+// Fired when virtual machine fails to parse the script.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Debugger#event-scriptFailedToParse
+type ScriptFailedToParseEvent struct {
+	// Identifier of the script parsed.
+	ScriptID types.RuntimeScriptID `json:"scriptId"`
+	// URL or name of the script parsed (if any).
+	URL string `json:"url"`
+	// Line offset of the script within the resource with given URL (for script
+	// tags).
+	StartLine int64 `json:"startLine"`
+	// Column offset of the script within the resource with given URL.
+	StartColumn int64 `json:"startColumn"`
+	// Last line of the script.
+	EndLine int64 `json:"endLine"`
+	// Length of the last line of the script.
+	EndColumn int64 `json:"endColumn"`
+	// Specifies script creation context.
+	ExecutionContextID types.RuntimeExecutionContextID `json:"executionContextId"`
+	// Content hash of the script, SHA-256.
+	Hash string `json:"hash"`
+	// For Wasm modules, the content of the `build_id` custom section. For
+	// JavaScript the `debugId` magic comment.
+	BuildID string `json:"buildId"`
+	// Embedder-specific auxiliary data likely matching {isDefault: boolean, type:
+	// 'default'|'isolated'|'worker', frameId: string}
+	ExecutionContextAuxData map[string]interface{} `json:"executionContextAuxData,omitempty"`
+	// URL of source map associated with script (if any).
+	SourceMapURL string `json:"sourceMapURL,omitempty"`
+	// True, if this script has sourceURL.
+	HasSourceURL bool `json:"hasSourceURL,omitempty"`
+	// True, if this script is ES6 module.
+	IsModule bool `json:"isModule,omitempty"`
+	// This script length.
+	Length int64 `json:"length,omitempty"`
+	// JavaScript top stack frame of where the script parsed event was triggered if
+	// available.
+	//
+	// This is an experimental part of the protocol.
+	StackTrace *types.RuntimeStackTrace `json:"stackTrace,omitempty"`
+	// If the scriptLanguage is WebAssembly, the code section offset in the module.
+	//
+	// This is an experimental part of the protocol.
+	CodeOffset int64 `json:"codeOffset,omitempty"`
+	// The language of the script.
+	//
+	// This is an experimental part of the protocol.
+	ScriptLanguage ScriptLanguage `json:"scriptLanguage,omitempty"`
+	// The name the embedder supplied for this script.
+	//
+	// This is an experimental part of the protocol.
+	EmbedderName string `json:"embedderName,omitempty"`
+}
+
+// ScriptParsedEvent is the payload of the Debugger.scriptParsed event.
+//
+// Fired when virtual machine parses script. This event is also fired for all
+// known and uncollected scripts upon enabling debugger.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Debugger#event-scriptParsed
+type ScriptParsedEvent struct {
+	// Identifier of the script parsed.
+	ScriptID types.RuntimeScriptID `json:"scriptId"`
+	// URL or name of the script parsed (if any).
+	URL string `json:"url"`
+	// Line offset of the script within the resource with given URL (for script
+	// tags).
+	StartLine int64 `json:"startLine"`
+	// Column offset of the script within the resource with given URL.
+	StartColumn int64 `json:"startColumn"`
+	// Last line of the script.
+	EndLine int64 `json:"endLine"`
+	// Length of the last line of the script.
+	EndColumn int64 `json:"endColumn"`
+	// Specifies script creation context.
+	ExecutionContextID types.RuntimeExecutionContextID `json:"executionContextId"`
+	// Content hash of the script, SHA-256.
+	Hash string `json:"hash"`
+	// For Wasm modules, the content of the `build_id` custom section. For
+	// JavaScript the `debugId` magic comment.
+	BuildID string `json:"buildId"`
+	// Embedder-specific auxiliary data likely matching {isDefault: boolean, type:
+	// 'default'|'isolated'|'worker', frameId: string}
+	ExecutionContextAuxData map[string]interface{} `json:"executionContextAuxData,omitempty"`
+	// True, if this script is generated as a result of the live edit operation.
+	//
+	// This is an experimental part of the protocol.
+	IsLiveEdit bool `json:"isLiveEdit,omitempty"`
+	// URL of source map associated with script (if any).
+	SourceMapURL string `json:"sourceMapURL,omitempty"`
+	// True, if this script has sourceURL.
+	HasSourceURL bool `json:"hasSourceURL,omitempty"`
+	// True, if this script is ES6 module.
+	IsModule bool `json:"isModule,omitempty"`
+	// This script length.
+	Length int64 `json:"length,omitempty"`
+	// JavaScript top stack frame of where the script parsed event was triggered if
+	// available.
+	//
+	// This is an experimental part of the protocol.
+	StackTrace *types.RuntimeStackTrace `json:"stackTrace,omitempty"`
+	// If the scriptLanguage is WebAssembly, the code section offset in the module.
+	//
+	// This is an experimental part of the protocol.
+	CodeOffset int64 `json:"codeOffset,omitempty"`
+	// The language of the script.
+	//
+	// This is an experimental part of the protocol.
+	ScriptLanguage ScriptLanguage `json:"scriptLanguage,omitempty"`
+	// If the scriptLanguage is WebAssembly, the source of debug symbols for the
+	// module.
+	//
+	// This is an experimental part of the protocol.
+	DebugSymbols []DebugSymbols `json:"debugSymbols,omitempty"`
+	// The name the embedder supplied for this script.
+	//
+	// This is an experimental part of the protocol.
+	EmbedderName string `json:"embedderName,omitempty"`
+	// The list of set breakpoints in this script if calls to `setBreakpointByUrl`
+	// matches this script's URL or hash. Clients that use this list can ignore the
+	// `breakpointResolved` event. They are equivalent.
+	//
+	// This is an experimental part of the protocol.
+	ResolvedBreakpoints []ResolvedBreakpoint `json:"resolvedBreakpoints,omitempty"`
+}
+
+// ParseEvent decodes an event from the Debugger domain, as received from
+// Subscribe, into a pointer to its typed payload.
+func ParseEvent(cmd chromedebugo.Command) (interface{}, error) {
+	var ev interface{}
+	switch cmd.Method {
+	case EventBreakpointResolved:
+		ev = &BreakpointResolvedEvent{}
+	case EventPaused:
+		ev = &PausedEvent{}
+	case EventResumed:
+		ev = &ResumedEvent{}
+	case EventScriptFailedToParse:
+		ev = &ScriptFailedToParseEvent{}
+	case EventScriptParsed:
+		ev = &ScriptParsedEvent{}
+	default:
+		return nil, fmt.Errorf("unknown Debugger event: %s", cmd.Method)
+	}
+	if err := cmd.Decode(ev); err != nil {
+		return nil, err
+	}
+	return ev, nil
+}
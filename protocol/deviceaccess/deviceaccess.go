@@ -0,0 +1,158 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+// Package deviceaccess provides typed bindings for the DeviceAccess domain of
+// the Chrome DevTools protocol.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DeviceAccess
+//
+// This is an experimental part of the protocol.
+package deviceaccess
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tonyhb/chromedebugo"
+	"github.com/tonyhb/chromedebugo/protocol"
+	"github.com/tonyhb/chromedebugo/protocol/internal/types"
+)
+
+// RequestID device request id.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DeviceAccess#type-RequestId
+type RequestID = types.DeviceAccessRequestID
+
+// DeviceID a device id.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DeviceAccess#type-DeviceId
+type DeviceID = types.DeviceAccessDeviceID
+
+// PromptDevice device information displayed in a user prompt to select a
+// device.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DeviceAccess#type-PromptDevice
+type PromptDevice = types.DeviceAccessPromptDevice
+
+// Method names of the commands in the DeviceAccess domain.
+const (
+	CommandEnable       = "DeviceAccess.enable"
+	CommandDisable      = "DeviceAccess.disable"
+	CommandSelectPrompt = "DeviceAccess.selectPrompt"
+	CommandCancelPrompt = "DeviceAccess.cancelPrompt"
+)
+
+// EnableParams are the parameters of DeviceAccess.enable.
+//
+// Enable events in this domain.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DeviceAccess#method-enable
+type EnableParams struct {
+}
+
+// Command returns the DeviceAccess.enable command for use with
+// AsyncDebugger.Send.
+func (p EnableParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandEnable, p)
+}
+
+// Do sends DeviceAccess.enable to d and waits for it to complete or for ctx to
+// be done.
+func (p EnableParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandEnable, p, nil)
+}
+
+// DisableParams are the parameters of DeviceAccess.disable.
+//
+// Disable events in this domain.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DeviceAccess#method-disable
+type DisableParams struct {
+}
+
+// Command returns the DeviceAccess.disable command for use with
+// AsyncDebugger.Send.
+func (p DisableParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandDisable, p)
+}
+
+// Do sends DeviceAccess.disable to d and waits for it to complete or for ctx to
+// be done.
+func (p DisableParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandDisable, p, nil)
+}
+
+// SelectPromptParams are the parameters of DeviceAccess.selectPrompt.
+//
+// Select a device in response to a DeviceAccess.deviceRequestPrompted event.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DeviceAccess#method-selectPrompt
+type SelectPromptParams struct {
+	ID       RequestID `json:"id"`
+	DeviceID DeviceID  `json:"deviceId"`
+}
+
+// Command returns the DeviceAccess.selectPrompt command for use with
+// AsyncDebugger.Send.
+func (p SelectPromptParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSelectPrompt, p)
+}
+
+// Do sends DeviceAccess.selectPrompt to d and waits for it to complete or for ctx to
+// be done.
+func (p SelectPromptParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSelectPrompt, p, nil)
+}
+
+// CancelPromptParams are the parameters of DeviceAccess.cancelPrompt.
+//
+// Cancel a prompt in response to a DeviceAccess.deviceRequestPrompted event.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DeviceAccess#method-cancelPrompt
+type CancelPromptParams struct {
+	ID RequestID `json:"id"`
+}
+
+// Command returns the DeviceAccess.cancelPrompt command for use with
+// AsyncDebugger.Send.
+func (p CancelPromptParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandCancelPrompt, p)
+}
+
+// Do sends DeviceAccess.cancelPrompt to d and waits for it to complete or for ctx to
+// be done.
+func (p CancelPromptParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandCancelPrompt, p, nil)
+}
+
+// Method names of the events in the DeviceAccess domain.
+const (
+	EventDeviceRequestPrompted = "DeviceAccess.deviceRequestPrompted"
+)
+
+// DeviceRequestPromptedEvent is the payload of the
+// DeviceAccess.deviceRequestPrompted event.
+//
+// A device request opened a user prompt to select a device. Respond with the
+// selectPrompt or cancelPrompt command.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DeviceAccess#event-deviceRequestPrompted
+type DeviceRequestPromptedEvent struct {
+	ID      RequestID      `json:"id"`
+	Devices []PromptDevice `json:"devices"`
+}
+
+// ParseEvent decodes an event from the DeviceAccess domain, as received from
+// Subscribe, into a pointer to its typed payload.
+func ParseEvent(cmd chromedebugo.Command) (interface{}, error) {
+	var ev interface{}
+	switch cmd.Method {
+	case EventDeviceRequestPrompted:
+		ev = &DeviceRequestPromptedEvent{}
+	default:
+		return nil, fmt.Errorf("unknown DeviceAccess event: %s", cmd.Method)
+	}
+	if err := cmd.Decode(ev); err != nil {
+		return nil, err
+	}
+	return ev, nil
+}
@@ -0,0 +1,70 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+// Package deviceorientation provides typed bindings for the DeviceOrientation
+// domain of the Chrome DevTools protocol.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DeviceOrientation
+//
+// This is an experimental part of the protocol.
+package deviceorientation
+
+import (
+	"context"
+
+	"github.com/tonyhb/chromedebugo"
+	"github.com/tonyhb/chromedebugo/protocol"
+)
+
+// Method names of the commands in the DeviceOrientation domain.
+const (
+	CommandClearDeviceOrientationOverride = "DeviceOrientation.clearDeviceOrientationOverride"
+	CommandSetDeviceOrientationOverride   = "DeviceOrientation.setDeviceOrientationOverride"
+)
+
+// ClearDeviceOrientationOverrideParams are the parameters of
+// DeviceOrientation.clearDeviceOrientationOverride.
+//
+// Clears the overridden Device Orientation.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DeviceOrientation#method-clearDeviceOrientationOverride
+type ClearDeviceOrientationOverrideParams struct {
+}
+
+// Command returns the DeviceOrientation.clearDeviceOrientationOverride command
+// for use with AsyncDebugger.Send.
+func (p ClearDeviceOrientationOverrideParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandClearDeviceOrientationOverride, p)
+}
+
+// Do sends DeviceOrientation.clearDeviceOrientationOverride to d and waits for it to complete or for ctx to
+// be done.
+func (p ClearDeviceOrientationOverrideParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandClearDeviceOrientationOverride, p, nil)
+}
+
+// SetDeviceOrientationOverrideParams are the parameters of
+// DeviceOrientation.setDeviceOrientationOverride.
+//
+// Overrides the Device Orientation.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DeviceOrientation#method-setDeviceOrientationOverride
+type SetDeviceOrientationOverrideParams struct {
+	// Mock alpha
+	Alpha float64 `json:"alpha"`
+	// Mock beta
+	Beta float64 `json:"beta"`
+	// Mock gamma
+	Gamma float64 `json:"gamma"`
+}
+
+// Command returns the DeviceOrientation.setDeviceOrientationOverride command
+// for use with AsyncDebugger.Send.
+func (p SetDeviceOrientationOverrideParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetDeviceOrientationOverride, p)
+}
+
+// Do sends DeviceOrientation.setDeviceOrientationOverride to d and waits for it to complete or for ctx to
+// be done.
+func (p SetDeviceOrientationOverrideParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetDeviceOrientationOverride, p, nil)
+}
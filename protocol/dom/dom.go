@@ -0,0 +1,2194 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+// Package dom provides typed bindings for the DOM domain of the Chrome DevTools
+// protocol.
+//
+// This domain exposes DOM read/write operations. Each DOM Node is represented
+// with its mirror object that has an `id`. This `id` can be used to get
+// additional information on the Node, resolve it into the JavaScript object
+// wrapper, etc. It is important that client receives DOM events only for the
+// nodes that are known to the client. Backend keeps track of the nodes that
+// were sent to the client and never sends the same node twice. It is client's
+// responsibility to collect information about the nodes that were sent to the
+// client. Note that `iframe` owner elements will return corresponding document
+// elements as their child nodes.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOM
+package dom
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tonyhb/chromedebugo"
+	"github.com/tonyhb/chromedebugo/protocol"
+	"github.com/tonyhb/chromedebugo/protocol/internal/types"
+)
+
+// NodeID unique DOM node identifier.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOM#type-NodeId
+type NodeID = types.DOMNodeID
+
+// BackendNodeID unique DOM node identifier used to reference a node that may
+// not have been pushed to the front-end.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOM#type-BackendNodeId
+type BackendNodeID = types.DOMBackendNodeID
+
+// BackendNode backend node with a friendly name.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOM#type-BackendNode
+type BackendNode = types.DOMBackendNode
+
+// PseudoType pseudo element type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOM#type-PseudoType
+type PseudoType = types.DOMPseudoType
+
+// ShadowRootType shadow root type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOM#type-ShadowRootType
+type ShadowRootType = types.DOMShadowRootType
+
+// CompatibilityMode document compatibility mode.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOM#type-CompatibilityMode
+type CompatibilityMode = types.DOMCompatibilityMode
+
+// PhysicalAxes containerSelector physical axes
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOM#type-PhysicalAxes
+type PhysicalAxes = types.DOMPhysicalAxes
+
+// LogicalAxes containerSelector logical axes
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOM#type-LogicalAxes
+type LogicalAxes = types.DOMLogicalAxes
+
+// ScrollOrientation physical scroll orientation
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOM#type-ScrollOrientation
+type ScrollOrientation = types.DOMScrollOrientation
+
+// Node DOM interaction is implemented in terms of mirror objects that represent
+// the actual DOM nodes. DOMNode is a base node mirror type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOM#type-Node
+type Node = types.DOMNode
+
+// DetachedElementInfo a structure to hold the top-level node of a detached tree
+// and an array of its retained descendants.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOM#type-DetachedElementInfo
+type DetachedElementInfo = types.DOMDetachedElementInfo
+
+// RGBA a structure holding an RGBA color.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOM#type-RGBA
+type RGBA = types.DOMRGBA
+
+// Quad an array of quad vertices, x immediately followed by y for each point,
+// points clock-wise.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOM#type-Quad
+type Quad = types.DOMQuad
+
+// BoxModel box model.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOM#type-BoxModel
+type BoxModel = types.DOMBoxModel
+
+// ShapeOutsideInfo CSS Shape Outside details.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOM#type-ShapeOutsideInfo
+type ShapeOutsideInfo = types.DOMShapeOutsideInfo
+
+// Rect rectangle.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOM#type-Rect
+type Rect = types.DOMRect
+
+// CSSComputedStyleProperty is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOM#type-CSSComputedStyleProperty
+type CSSComputedStyleProperty = types.DOMCSSComputedStyleProperty
+
+// Values of the enumerated types in the DOM domain.
+const (
+	PseudoTypeFirstLine                   = types.DOMPseudoTypeFirstLine
+	PseudoTypeFirstLetter                 = types.DOMPseudoTypeFirstLetter
+	PseudoTypeCheckmark                   = types.DOMPseudoTypeCheckmark
+	PseudoTypeBefore                      = types.DOMPseudoTypeBefore
+	PseudoTypeAfter                       = types.DOMPseudoTypeAfter
+	PseudoTypePickerIcon                  = types.DOMPseudoTypePickerIcon
+	PseudoTypeMarker                      = types.DOMPseudoTypeMarker
+	PseudoTypeBackdrop                    = types.DOMPseudoTypeBackdrop
+	PseudoTypeColumn                      = types.DOMPseudoTypeColumn
+	PseudoTypeSelection                   = types.DOMPseudoTypeSelection
+	PseudoTypeSearchText                  = types.DOMPseudoTypeSearchText
+	PseudoTypeTargetText                  = types.DOMPseudoTypeTargetText
+	PseudoTypeSpellingError               = types.DOMPseudoTypeSpellingError
+	PseudoTypeGrammarError                = types.DOMPseudoTypeGrammarError
+	PseudoTypeHighlight                   = types.DOMPseudoTypeHighlight
+	PseudoTypeFirstLineInherited          = types.DOMPseudoTypeFirstLineInherited
+	PseudoTypeScrollMarker                = types.DOMPseudoTypeScrollMarker
+	PseudoTypeScrollMarkerGroup           = types.DOMPseudoTypeScrollMarkerGroup
+	PseudoTypeScrollButton                = types.DOMPseudoTypeScrollButton
+	PseudoTypeScrollbar                   = types.DOMPseudoTypeScrollbar
+	PseudoTypeScrollbarThumb              = types.DOMPseudoTypeScrollbarThumb
+	PseudoTypeScrollbarButton             = types.DOMPseudoTypeScrollbarButton
+	PseudoTypeScrollbarTrack              = types.DOMPseudoTypeScrollbarTrack
+	PseudoTypeScrollbarTrackPiece         = types.DOMPseudoTypeScrollbarTrackPiece
+	PseudoTypeScrollbarCorner             = types.DOMPseudoTypeScrollbarCorner
+	PseudoTypeResizer                     = types.DOMPseudoTypeResizer
+	PseudoTypeInputListButton             = types.DOMPseudoTypeInputListButton
+	PseudoTypeViewTransition              = types.DOMPseudoTypeViewTransition
+	PseudoTypeViewTransitionGroup         = types.DOMPseudoTypeViewTransitionGroup
+	PseudoTypeViewTransitionImagePair     = types.DOMPseudoTypeViewTransitionImagePair
+	PseudoTypeViewTransitionGroupChildren = types.DOMPseudoTypeViewTransitionGroupChildren
+	PseudoTypeViewTransitionOld           = types.DOMPseudoTypeViewTransitionOld
+	PseudoTypeViewTransitionNew           = types.DOMPseudoTypeViewTransitionNew
+	PseudoTypePlaceholder                 = types.DOMPseudoTypePlaceholder
+	PseudoTypeFileSelectorButton          = types.DOMPseudoTypeFileSelectorButton
+	PseudoTypeDetailsContent              = types.DOMPseudoTypeDetailsContent
+	PseudoTypePicker                      = types.DOMPseudoTypePicker
+	PseudoTypePermissionIcon              = types.DOMPseudoTypePermissionIcon
+	ShadowRootTypeUserAgent               = types.DOMShadowRootTypeUserAgent
+	ShadowRootTypeOpen                    = types.DOMShadowRootTypeOpen
+	ShadowRootTypeClosed                  = types.DOMShadowRootTypeClosed
+	CompatibilityModeQuirksMode           = types.DOMCompatibilityModeQuirksMode
+	CompatibilityModeLimitedQuirksMode    = types.DOMCompatibilityModeLimitedQuirksMode
+	CompatibilityModeNoQuirksMode         = types.DOMCompatibilityModeNoQuirksMode
+	PhysicalAxesHorizontal                = types.DOMPhysicalAxesHorizontal
+	PhysicalAxesVertical                  = types.DOMPhysicalAxesVertical
+	PhysicalAxesBoth                      = types.DOMPhysicalAxesBoth
+	LogicalAxesInline                     = types.DOMLogicalAxesInline
+	LogicalAxesBlock                      = types.DOMLogicalAxesBlock
+	LogicalAxesBoth                       = types.DOMLogicalAxesBoth
+	ScrollOrientationHorizontal           = types.DOMScrollOrientationHorizontal
+	ScrollOrientationVertical             = types.DOMScrollOrientationVertical
+)
+
+// Method names of the commands in the DOM domain.
+const (
+	CommandCollectClassNamesFromSubtree       = "DOM.collectClassNamesFromSubtree"
+	CommandCopyTo                             = "DOM.copyTo"
+	CommandDescribeNode                       = "DOM.describeNode"
+	CommandScrollIntoViewIfNeeded             = "DOM.scrollIntoViewIfNeeded"
+	CommandDisable                            = "DOM.disable"
+	CommandDiscardSearchResults               = "DOM.discardSearchResults"
+	CommandEnable                             = "DOM.enable"
+	CommandFocus                              = "DOM.focus"
+	CommandGetAttributes                      = "DOM.getAttributes"
+	CommandGetBoxModel                        = "DOM.getBoxModel"
+	CommandGetContentQuads                    = "DOM.getContentQuads"
+	CommandGetDocument                        = "DOM.getDocument"
+	CommandGetFlattenedDocument               = "DOM.getFlattenedDocument"
+	CommandGetNodesForSubtreeByStyle          = "DOM.getNodesForSubtreeByStyle"
+	CommandGetNodeForLocation                 = "DOM.getNodeForLocation"
+	CommandGetOuterHTML                       = "DOM.getOuterHTML"
+	CommandGetRelayoutBoundary                = "DOM.getRelayoutBoundary"
+	CommandGetSearchResults                   = "DOM.getSearchResults"
+	CommandHideHighlight                      = "DOM.hideHighlight"
+	CommandHighlightNode                      = "DOM.highlightNode"
+	CommandHighlightRect                      = "DOM.highlightRect"
+	CommandMarkUndoableState                  = "DOM.markUndoableState"
+	CommandMoveTo                             = "DOM.moveTo"
+	CommandPerformSearch                      = "DOM.performSearch"
+	CommandPushNodeByPathToFrontend           = "DOM.pushNodeByPathToFrontend"
+	CommandPushNodesByBackendIdsToFrontend    = "DOM.pushNodesByBackendIdsToFrontend"
+	CommandQuerySelector                      = "DOM.querySelector"
+	CommandQuerySelectorAll                   = "DOM.querySelectorAll"
+	CommandGetTopLayerElements                = "DOM.getTopLayerElements"
+	CommandGetElementByRelation               = "DOM.getElementByRelation"
+	CommandRedo                               = "DOM.redo"
+	CommandRemoveAttribute                    = "DOM.removeAttribute"
+	CommandRemoveNode                         = "DOM.removeNode"
+	CommandRequestChildNodes                  = "DOM.requestChildNodes"
+	CommandRequestNode                        = "DOM.requestNode"
+	CommandResolveNode                        = "DOM.resolveNode"
+	CommandSetAttributeValue                  = "DOM.setAttributeValue"
+	CommandSetAttributesAsText                = "DOM.setAttributesAsText"
+	CommandSetFileInputFiles                  = "DOM.setFileInputFiles"
+	CommandSetNodeStackTracesEnabled          = "DOM.setNodeStackTracesEnabled"
+	CommandGetNodeStackTraces                 = "DOM.getNodeStackTraces"
+	CommandGetFileInfo                        = "DOM.getFileInfo"
+	CommandGetDetachedDOMNodes                = "DOM.getDetachedDomNodes"
+	CommandSetInspectedNode                   = "DOM.setInspectedNode"
+	CommandSetNodeName                        = "DOM.setNodeName"
+	CommandSetNodeValue                       = "DOM.setNodeValue"
+	CommandSetOuterHTML                       = "DOM.setOuterHTML"
+	CommandUndo                               = "DOM.undo"
+	CommandGetFrameOwner                      = "DOM.getFrameOwner"
+	CommandGetContainerForNode                = "DOM.getContainerForNode"
+	CommandGetQueryingDescendantsForContainer = "DOM.getQueryingDescendantsForContainer"
+	CommandGetAnchorElement                   = "DOM.getAnchorElement"
+	CommandForceShowPopover                   = "DOM.forceShowPopover"
+)
+
+// CollectClassNamesFromSubtreeParams are the parameters of
+// DOM.collectClassNamesFromSubtree.
+//
+// Collects class names for the node with given id and all of it's child nodes.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOM#method-collectClassNamesFromSubtree
+//
+// This is an experimental part of the protocol.
+type CollectClassNamesFromSubtreeParams struct {
+	// Id of the node to collect class names.
+	NodeID NodeID `json:"nodeId"`
+}
+
+// Command returns the DOM.collectClassNamesFromSubtree command for use with
+// AsyncDebugger.Send.
+func (p CollectClassNamesFromSubtreeParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandCollectClassNamesFromSubtree, p)
+}
+
+// CollectClassNamesFromSubtreeReturns are the values returned by DOM.collectClassNamesFromSubtree.
+type CollectClassNamesFromSubtreeReturns struct {
+	// Class name list.
+	ClassNames []string `json:"classNames"`
+}
+
+// Do sends DOM.collectClassNamesFromSubtree to d and waits for its result or for ctx to be
+// done.
+func (p CollectClassNamesFromSubtreeParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*CollectClassNamesFromSubtreeReturns, error) {
+	ret := &CollectClassNamesFromSubtreeReturns{}
+	if err := protocol.Do(ctx, d, CommandCollectClassNamesFromSubtree, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// CopyToParams are the parameters of DOM.copyTo.
+//
+// Creates a deep copy of the specified node and places it into the target
+// container before the given anchor.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOM#method-copyTo
+//
+// This is an experimental part of the protocol.
+type CopyToParams struct {
+	// Id of the node to copy.
+	NodeID NodeID `json:"nodeId"`
+	// Id of the element to drop the copy into.
+	TargetNodeID NodeID `json:"targetNodeId"`
+	// Drop the copy before this node (if absent, the copy becomes the last child
+	// of `targetNodeId`).
+	InsertBeforeNodeID NodeID `json:"insertBeforeNodeId,omitempty"`
+}
+
+// Command returns the DOM.copyTo command for use with AsyncDebugger.Send.
+func (p CopyToParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandCopyTo, p)
+}
+
+// CopyToReturns are the values returned by DOM.copyTo.
+type CopyToReturns struct {
+	// Id of the node clone.
+	NodeID NodeID `json:"nodeId"`
+}
+
+// Do sends DOM.copyTo to d and waits for its result or for ctx to be
+// done.
+func (p CopyToParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*CopyToReturns, error) {
+	ret := &CopyToReturns{}
+	if err := protocol.Do(ctx, d, CommandCopyTo, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// DescribeNodeParams are the parameters of DOM.describeNode.
+//
+// Describes node given its id, does not require domain to be enabled. Does not
+// start tracking any objects, can be used for automation.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOM#method-describeNode
+type DescribeNodeParams struct {
+	// Identifier of the node.
+	NodeID NodeID `json:"nodeId,omitempty"`
+	// Identifier of the backend node.
+	BackendNodeID BackendNodeID `json:"backendNodeId,omitempty"`
+	// JavaScript object id of the node wrapper.
+	ObjectID types.RuntimeRemoteObjectID `json:"objectId,omitempty"`
+	// The maximum depth at which children should be retrieved, defaults to 1. Use
+	// -1 for the entire subtree or provide an integer larger than 0.
+	Depth int64 `json:"depth,omitempty"`
+	// Whether or not iframes and shadow roots should be traversed when returning
+	// the subtree (default is false).
+	Pierce bool `json:"pierce,omitempty"`
+}
+
+// Command returns the DOM.describeNode command for use with AsyncDebugger.Send.
+func (p DescribeNodeParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandDescribeNode, p)
+}
+
+// DescribeNodeReturns are the values returned by DOM.describeNode.
+type DescribeNodeReturns struct {
+	// Node description.
+	Node Node `json:"node"`
+}
+
+// Do sends DOM.describeNode to d and waits for its result or for ctx to be
+// done.
+func (p DescribeNodeParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*DescribeNodeReturns, error) {
+	ret := &DescribeNodeReturns{}
+	if err := protocol.Do(ctx, d, CommandDescribeNode, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// ScrollIntoViewIfNeededParams are the parameters of
+// DOM.scrollIntoViewIfNeeded.
+//
+// Scrolls the specified rect of the given node into view if not already
+// visible. Note: exactly one between nodeId, backendNodeId and objectId should
+// be passed to identify the node.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOM#method-scrollIntoViewIfNeeded
+type ScrollIntoViewIfNeededParams struct {
+	// Identifier of the node.
+	NodeID NodeID `json:"nodeId,omitempty"`
+	// Identifier of the backend node.
+	BackendNodeID BackendNodeID `json:"backendNodeId,omitempty"`
+	// JavaScript object id of the node wrapper.
+	ObjectID types.RuntimeRemoteObjectID `json:"objectId,omitempty"`
+	// The rect to be scrolled into view, relative to the node's border box, in CSS
+	// pixels. When omitted, center of the node will be used, similar to
+	// Element.scrollIntoView.
+	Rect *Rect `json:"rect,omitempty"`
+}
+
+// Command returns the DOM.scrollIntoViewIfNeeded command for use with
+// AsyncDebugger.Send.
+func (p ScrollIntoViewIfNeededParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandScrollIntoViewIfNeeded, p)
+}
+
+// Do sends DOM.scrollIntoViewIfNeeded to d and waits for it to complete or for ctx to
+// be done.
+func (p ScrollIntoViewIfNeededParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandScrollIntoViewIfNeeded, p, nil)
+}
+
+// DisableParams are the parameters of DOM.disable.
+//
+// Disables DOM agent for the given page.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOM#method-disable
+type DisableParams struct {
+}
+
+// Command returns the DOM.disable command for use with AsyncDebugger.Send.
+func (p DisableParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandDisable, p)
+}
+
+// Do sends DOM.disable to d and waits for it to complete or for ctx to
+// be done.
+func (p DisableParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandDisable, p, nil)
+}
+
+// DiscardSearchResultsParams are the parameters of DOM.discardSearchResults.
+//
+// Discards search results from the session with the given id.
+// `getSearchResults` should no longer be called for that search.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOM#method-discardSearchResults
+//
+// This is an experimental part of the protocol.
+type DiscardSearchResultsParams struct {
+	// Unique search session identifier.
+	SearchID string `json:"searchId"`
+}
+
+// Command returns the DOM.discardSearchResults command for use with
+// AsyncDebugger.Send.
+func (p DiscardSearchResultsParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandDiscardSearchResults, p)
+}
+
+// Do sends DOM.discardSearchResults to d and waits for it to complete or for ctx to
+// be done.
+func (p DiscardSearchResultsParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandDiscardSearchResults, p, nil)
+}
+
+// EnableParams are the parameters of DOM.enable.
+//
+// Enables DOM agent for the given page.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOM#method-enable
+type EnableParams struct {
+	// Whether to include whitespaces in the children array of returned Nodes.
+	//
+	// Allowed values: none, all.
+	//
+	// This is an experimental part of the protocol.
+	IncludeWhitespace string `json:"includeWhitespace,omitempty"`
+}
+
+// Command returns the DOM.enable command for use with AsyncDebugger.Send.
+func (p EnableParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandEnable, p)
+}
+
+// Do sends DOM.enable to d and waits for it to complete or for ctx to
+// be done.
+func (p EnableParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandEnable, p, nil)
+}
+
+// FocusParams are the parameters of DOM.focus.
+//
+// Focuses the given element.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOM#method-focus
+type FocusParams struct {
+	// Identifier of the node.
+	NodeID NodeID `json:"nodeId,omitempty"`
+	// Identifier of the backend node.
+	BackendNodeID BackendNodeID `json:"backendNodeId,omitempty"`
+	// JavaScript object id of the node wrapper.
+	ObjectID types.RuntimeRemoteObjectID `json:"objectId,omitempty"`
+}
+
+// Command returns the DOM.focus command for use with AsyncDebugger.Send.
+func (p FocusParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandFocus, p)
+}
+
+// Do sends DOM.focus to d and waits for it to complete or for ctx to
+// be done.
+func (p FocusParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandFocus, p, nil)
+}
+
+// GetAttributesParams are the parameters of DOM.getAttributes.
+//
+// Returns attributes for the specified node.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOM#method-getAttributes
+type GetAttributesParams struct {
+	// Id of the node to retrieve attributes for.
+	NodeID NodeID `json:"nodeId"`
+}
+
+// Command returns the DOM.getAttributes command for use with
+// AsyncDebugger.Send.
+func (p GetAttributesParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandGetAttributes, p)
+}
+
+// GetAttributesReturns are the values returned by DOM.getAttributes.
+type GetAttributesReturns struct {
+	// An interleaved array of node attribute names and values.
+	Attributes []string `json:"attributes"`
+}
+
+// Do sends DOM.getAttributes to d and waits for its result or for ctx to be
+// done.
+func (p GetAttributesParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*GetAttributesReturns, error) {
+	ret := &GetAttributesReturns{}
+	if err := protocol.Do(ctx, d, CommandGetAttributes, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// GetBoxModelParams are the parameters of DOM.getBoxModel.
+//
+// Returns boxes for the given node.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOM#method-getBoxModel
+type GetBoxModelParams struct {
+	// Identifier of the node.
+	NodeID NodeID `json:"nodeId,omitempty"`
+	// Identifier of the backend node.
+	BackendNodeID BackendNodeID `json:"backendNodeId,omitempty"`
+	// JavaScript object id of the node wrapper.
+	ObjectID types.RuntimeRemoteObjectID `json:"objectId,omitempty"`
+}
+
+// Command returns the DOM.getBoxModel command for use with AsyncDebugger.Send.
+func (p GetBoxModelParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandGetBoxModel, p)
+}
+
+// GetBoxModelReturns are the values returned by DOM.getBoxModel.
+type GetBoxModelReturns struct {
+	// Box model for the node.
+	Model BoxModel `json:"model"`
+}
+
+// Do sends DOM.getBoxModel to d and waits for its result or for ctx to be
+// done.
+func (p GetBoxModelParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*GetBoxModelReturns, error) {
+	ret := &GetBoxModelReturns{}
+	if err := protocol.Do(ctx, d, CommandGetBoxModel, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// GetContentQuadsParams are the parameters of DOM.getContentQuads.
+//
+// Returns quads that describe node position on the page. This method might
+// return multiple quads for inline nodes.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOM#method-getContentQuads
+//
+// This is an experimental part of the protocol.
+type GetContentQuadsParams struct {
+	// Identifier of the node.
+	NodeID NodeID `json:"nodeId,omitempty"`
+	// Identifier of the backend node.
+	BackendNodeID BackendNodeID `json:"backendNodeId,omitempty"`
+	// JavaScript object id of the node wrapper.
+	ObjectID types.RuntimeRemoteObjectID `json:"objectId,omitempty"`
+}
+
+// Command returns the DOM.getContentQuads command for use with
+// AsyncDebugger.Send.
+func (p GetContentQuadsParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandGetContentQuads, p)
+}
+
+// GetContentQuadsReturns are the values returned by DOM.getContentQuads.
+type GetContentQuadsReturns struct {
+	// Quads that describe node layout relative to viewport.
+	Quads []Quad `json:"quads"`
+}
+
+// Do sends DOM.getContentQuads to d and waits for its result or for ctx to be
+// done.
+func (p GetContentQuadsParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*GetContentQuadsReturns, error) {
+	ret := &GetContentQuadsReturns{}
+	if err := protocol.Do(ctx, d, CommandGetContentQuads, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// GetDocumentParams are the parameters of DOM.getDocument.
+//
+// Returns the root DOM node (and optionally the subtree) to the caller.
+// Implicitly enables the DOM domain events for the current target.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOM#method-getDocument
+type GetDocumentParams struct {
+	// The maximum depth at which children should be retrieved, defaults to 1. Use
+	// -1 for the entire subtree or provide an integer larger than 0.
+	Depth int64 `json:"depth,omitempty"`
+	// Whether or not iframes and shadow roots should be traversed when returning
+	// the subtree (default is false).
+	Pierce bool `json:"pierce,omitempty"`
+}
+
+// Command returns the DOM.getDocument command for use with AsyncDebugger.Send.
+func (p GetDocumentParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandGetDocument, p)
+}
+
+// GetDocumentReturns are the values returned by DOM.getDocument.
+type GetDocumentReturns struct {
+	// Resulting node.
+	Root Node `json:"root"`
+}
+
+// Do sends DOM.getDocument to d and waits for its result or for ctx to be
+// done.
+func (p GetDocumentParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*GetDocumentReturns, error) {
+	ret := &GetDocumentReturns{}
+	if err := protocol.Do(ctx, d, CommandGetDocument, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// GetFlattenedDocumentParams are the parameters of DOM.getFlattenedDocument.
+//
+// Returns the root DOM node (and optionally the subtree) to the caller.
+// Deprecated, as it is not designed to work well with the rest of the DOM
+// agent. Use DOMSnapshot.captureSnapshot instead.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOM#method-getFlattenedDocument
+//
+// Deprecated: this is deprecated in the protocol.
+type GetFlattenedDocumentParams struct {
+	// The maximum depth at which children should be retrieved, defaults to 1. Use
+	// -1 for the entire subtree or provide an integer larger than 0.
+	Depth int64 `json:"depth,omitempty"`
+	// Whether or not iframes and shadow roots should be traversed when returning
+	// the subtree (default is false).
+	Pierce bool `json:"pierce,omitempty"`
+}
+
+// Command returns the DOM.getFlattenedDocument command for use with
+// AsyncDebugger.Send.
+func (p GetFlattenedDocumentParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandGetFlattenedDocument, p)
+}
+
+// GetFlattenedDocumentReturns are the values returned by DOM.getFlattenedDocument.
+type GetFlattenedDocumentReturns struct {
+	// Resulting node.
+	Nodes []Node `json:"nodes"`
+}
+
+// Do sends DOM.getFlattenedDocument to d and waits for its result or for ctx to be
+// done.
+func (p GetFlattenedDocumentParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*GetFlattenedDocumentReturns, error) {
+	ret := &GetFlattenedDocumentReturns{}
+	if err := protocol.Do(ctx, d, CommandGetFlattenedDocument, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// GetNodesForSubtreeByStyleParams are the parameters of
+// DOM.getNodesForSubtreeByStyle.
+//
+// Finds nodes with a given computed style in a subtree.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOM#method-getNodesForSubtreeByStyle
+//
+// This is an experimental part of the protocol.
+type GetNodesForSubtreeByStyleParams struct {
+	// Node ID pointing to the root of a subtree.
+	NodeID NodeID `json:"nodeId"`
+	// The style to filter nodes by (includes nodes if any of properties matches).
+	ComputedStyles []CSSComputedStyleProperty `json:"computedStyles"`
+	// Whether or not iframes and shadow roots in the same target should be
+	// traversed when returning the results (default is false).
+	Pierce bool `json:"pierce,omitempty"`
+}
+
+// Command returns the DOM.getNodesForSubtreeByStyle command for use with
+// AsyncDebugger.Send.
+func (p GetNodesForSubtreeByStyleParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandGetNodesForSubtreeByStyle, p)
+}
+
+// GetNodesForSubtreeByStyleReturns are the values returned by DOM.getNodesForSubtreeByStyle.
+type GetNodesForSubtreeByStyleReturns struct {
+	// Resulting nodes.
+	NodeIds []NodeID `json:"nodeIds"`
+}
+
+// Do sends DOM.getNodesForSubtreeByStyle to d and waits for its result or for ctx to be
+// done.
+func (p GetNodesForSubtreeByStyleParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*GetNodesForSubtreeByStyleReturns, error) {
+	ret := &GetNodesForSubtreeByStyleReturns{}
+	if err := protocol.Do(ctx, d, CommandGetNodesForSubtreeByStyle, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// GetNodeForLocationParams are the parameters of DOM.getNodeForLocation.
+//
+// Returns node id at given location. Depending on whether DOM domain is
+// enabled, nodeId is either returned or not.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOM#method-getNodeForLocation
+type GetNodeForLocationParams struct {
+	// X coordinate.
+	X int64 `json:"x"`
+	// Y coordinate.
+	Y int64 `json:"y"`
+	// False to skip to the nearest non-UA shadow root ancestor (default: false).
+	IncludeUserAgentShadowDOM bool `json:"includeUserAgentShadowDOM,omitempty"`
+	// Whether to ignore pointer-events: none on elements and hit test them.
+	IgnorePointerEventsNone bool `json:"ignorePointerEventsNone,omitempty"`
+}
+
+// Command returns the DOM.getNodeForLocation command for use with
+// AsyncDebugger.Send.
+func (p GetNodeForLocationParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandGetNodeForLocation, p)
+}
+
+// GetNodeForLocationReturns are the values returned by DOM.getNodeForLocation.
+type GetNodeForLocationReturns struct {
+	// Resulting node.
+	BackendNodeID BackendNodeID `json:"backendNodeId"`
+	// Frame this node belongs to.
+	FrameID types.PageFrameID `json:"frameId"`
+	// Id of the node at given coordinates, only when enabled and requested
+	// document.
+	NodeID NodeID `json:"nodeId,omitempty"`
+}
+
+// Do sends DOM.getNodeForLocation to d and waits for its result or for ctx to be
+// done.
+func (p GetNodeForLocationParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*GetNodeForLocationReturns, error) {
+	ret := &GetNodeForLocationReturns{}
+	if err := protocol.Do(ctx, d, CommandGetNodeForLocation, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// GetOuterHTMLParams are the parameters of DOM.getOuterHTML.
+//
+// Returns node's HTML markup.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOM#method-getOuterHTML
+type GetOuterHTMLParams struct {
+	// Identifier of the node.
+	NodeID NodeID `json:"nodeId,omitempty"`
+	// Identifier of the backend node.
+	BackendNodeID BackendNodeID `json:"backendNodeId,omitempty"`
+	// JavaScript object id of the node wrapper.
+	ObjectID types.RuntimeRemoteObjectID `json:"objectId,omitempty"`
+	// Include all shadow roots. Equals to false if not specified.
+	//
+	// This is an experimental part of the protocol.
+	IncludeShadowDOM bool `json:"includeShadowDOM,omitempty"`
+}
+
+// Command returns the DOM.getOuterHTML command for use with AsyncDebugger.Send.
+func (p GetOuterHTMLParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandGetOuterHTML, p)
+}
+
+// GetOuterHTMLReturns are the values returned by DOM.getOuterHTML.
+type GetOuterHTMLReturns struct {
+	// Outer HTML markup.
+	OuterHTML string `json:"outerHTML"`
+}
+
+// Do sends DOM.getOuterHTML to d and waits for its result or for ctx to be
+// done.
+func (p GetOuterHTMLParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*GetOuterHTMLReturns, error) {
+	ret := &GetOuterHTMLReturns{}
+	if err := protocol.Do(ctx, d, CommandGetOuterHTML, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// GetRelayoutBoundaryParams are the parameters of DOM.getRelayoutBoundary.
+//
+// Returns the id of the nearest ancestor that is a relayout boundary.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOM#method-getRelayoutBoundary
+//
+// This is an experimental part of the protocol.
+type GetRelayoutBoundaryParams struct {
+	// Id of the node.
+	NodeID NodeID `json:"nodeId"`
+}
+
+// Command returns the DOM.getRelayoutBoundary command for use with
+// AsyncDebugger.Send.
+func (p GetRelayoutBoundaryParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandGetRelayoutBoundary, p)
+}
+
+// GetRelayoutBoundaryReturns are the values returned by DOM.getRelayoutBoundary.
+type GetRelayoutBoundaryReturns struct {
+	// Relayout boundary node id for the given node.
+	NodeID NodeID `json:"nodeId"`
+}
+
+// Do sends DOM.getRelayoutBoundary to d and waits for its result or for ctx to be
+// done.
+func (p GetRelayoutBoundaryParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*GetRelayoutBoundaryReturns, error) {
+	ret := &GetRelayoutBoundaryReturns{}
+	if err := protocol.Do(ctx, d, CommandGetRelayoutBoundary, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// GetSearchResultsParams are the parameters of DOM.getSearchResults.
+//
+// Returns search results from given `fromIndex` to given `toIndex` from the
+// search with the given identifier.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOM#method-getSearchResults
+//
+// This is an experimental part of the protocol.
+type GetSearchResultsParams struct {
+	// Unique search session identifier.
+	SearchID string `json:"searchId"`
+	// Start index of the search result to be returned.
+	FromIndex int64 `json:"fromIndex"`
+	// End index of the search result to be returned.
+	ToIndex int64 `json:"toIndex"`
+}
+
+// Command returns the DOM.getSearchResults command for use with
+// AsyncDebugger.Send.
+func (p GetSearchResultsParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandGetSearchResults, p)
+}
+
+// GetSearchResultsReturns are the values returned by DOM.getSearchResults.
+type GetSearchResultsReturns struct {
+	// Ids of the search result nodes.
+	NodeIds []NodeID `json:"nodeIds"`
+}
+
+// Do sends DOM.getSearchResults to d and waits for its result or for ctx to be
+// done.
+func (p GetSearchResultsParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*GetSearchResultsReturns, error) {
+	ret := &GetSearchResultsReturns{}
+	if err := protocol.Do(ctx, d, CommandGetSearchResults, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// HideHighlightParams are the parameters of DOM.hideHighlight.
+//
+// Hides any highlight.
+//
+// Redirects to the Overlay domain.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOM#method-hideHighlight
+type HideHighlightParams struct {
+}
+
+// Command returns the DOM.hideHighlight command for use with
+// AsyncDebugger.Send.
+func (p HideHighlightParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandHideHighlight, p)
+}
+
+// Do sends DOM.hideHighlight to d and waits for it to complete or for ctx to
+// be done.
+func (p HideHighlightParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandHideHighlight, p, nil)
+}
+
+// HighlightNodeParams are the parameters of DOM.highlightNode.
+//
+// Highlights DOM node.
+//
+// Redirects to the Overlay domain.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOM#method-highlightNode
+type HighlightNodeParams struct {
+}
+
+// Command returns the DOM.highlightNode command for use with
+// AsyncDebugger.Send.
+func (p HighlightNodeParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandHighlightNode, p)
+}
+
+// Do sends DOM.highlightNode to d and waits for it to complete or for ctx to
+// be done.
+func (p HighlightNodeParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandHighlightNode, p, nil)
+}
+
+// HighlightRectParams are the parameters of DOM.highlightRect.
+//
+// Highlights given rectangle.
+//
+// Redirects to the Overlay domain.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOM#method-highlightRect
+type HighlightRectParams struct {
+}
+
+// Command returns the DOM.highlightRect command for use with
+// AsyncDebugger.Send.
+func (p HighlightRectParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandHighlightRect, p)
+}
+
+// Do sends DOM.highlightRect to d and waits for it to complete or for ctx to
+// be done.
+func (p HighlightRectParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandHighlightRect, p, nil)
+}
+
+// MarkUndoableStateParams are the parameters of DOM.markUndoableState.
+//
+// Marks last undoable state.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOM#method-markUndoableState
+//
+// This is an experimental part of the protocol.
+type MarkUndoableStateParams struct {
+}
+
+// Command returns the DOM.markUndoableState command for use with
+// AsyncDebugger.Send.
+func (p MarkUndoableStateParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandMarkUndoableState, p)
+}
+
+// Do sends DOM.markUndoableState to d and waits for it to complete or for ctx to
+// be done.
+func (p MarkUndoableStateParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandMarkUndoableState, p, nil)
+}
+
+// MoveToParams are the parameters of DOM.moveTo.
+//
+// Moves node into the new container, places it before the given anchor.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOM#method-moveTo
+type MoveToParams struct {
+	// Id of the node to move.
+	NodeID NodeID `json:"nodeId"`
+	// Id of the element to drop the moved node into.
+	TargetNodeID NodeID `json:"targetNodeId"`
+	// Drop node before this one (if absent, the moved node becomes the last child
+	// of `targetNodeId`).
+	InsertBeforeNodeID NodeID `json:"insertBeforeNodeId,omitempty"`
+}
+
+// Command returns the DOM.moveTo command for use with AsyncDebugger.Send.
+func (p MoveToParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandMoveTo, p)
+}
+
+// MoveToReturns are the values returned by DOM.moveTo.
+type MoveToReturns struct {
+	// New id of the moved node.
+	NodeID NodeID `json:"nodeId"`
+}
+
+// Do sends DOM.moveTo to d and waits for its result or for ctx to be
+// done.
+func (p MoveToParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*MoveToReturns, error) {
+	ret := &MoveToReturns{}
+	if err := protocol.Do(ctx, d, CommandMoveTo, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// PerformSearchParams are the parameters of DOM.performSearch.
+//
+// Searches for a given string in the DOM tree. Use `getSearchResults` to access
+// search results or `cancelSearch` to end this search session.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOM#method-performSearch
+//
+// This is an experimental part of the protocol.
+type PerformSearchParams struct {
+	// Plain text or query selector or XPath search query.
+	Query string `json:"query"`
+	// True to search in user agent shadow DOM.
+	IncludeUserAgentShadowDOM bool `json:"includeUserAgentShadowDOM,omitempty"`
+}
+
+// Command returns the DOM.performSearch command for use with
+// AsyncDebugger.Send.
+func (p PerformSearchParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandPerformSearch, p)
+}
+
+// PerformSearchReturns are the values returned by DOM.performSearch.
+type PerformSearchReturns struct {
+	// Unique search session identifier.
+	SearchID string `json:"searchId"`
+	// Number of search results.
+	ResultCount int64 `json:"resultCount"`
+}
+
+// Do sends DOM.performSearch to d and waits for its result or for ctx to be
+// done.
+func (p PerformSearchParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*PerformSearchReturns, error) {
+	ret := &PerformSearchReturns{}
+	if err := protocol.Do(ctx, d, CommandPerformSearch, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// PushNodeByPathToFrontendParams are the parameters of
+// DOM.pushNodeByPathToFrontend.
+//
+// Requests that the node is sent to the caller given its path. // FIXME, use
+// XPath
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOM#method-pushNodeByPathToFrontend
+//
+// This is an experimental part of the protocol.
+type PushNodeByPathToFrontendParams struct {
+	// Path to node in the proprietary format.
+	Path string `json:"path"`
+}
+
+// Command returns the DOM.pushNodeByPathToFrontend command for use with
+// AsyncDebugger.Send.
+func (p PushNodeByPathToFrontendParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandPushNodeByPathToFrontend, p)
+}
+
+// PushNodeByPathToFrontendReturns are the values returned by DOM.pushNodeByPathToFrontend.
+type PushNodeByPathToFrontendReturns struct {
+	// Id of the node for given path.
+	NodeID NodeID `json:"nodeId"`
+}
+
+// Do sends DOM.pushNodeByPathToFrontend to d and waits for its result or for ctx to be
+// done.
+func (p PushNodeByPathToFrontendParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*PushNodeByPathToFrontendReturns, error) {
+	ret := &PushNodeByPathToFrontendReturns{}
+	if err := protocol.Do(ctx, d, CommandPushNodeByPathToFrontend, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// PushNodesByBackendIdsToFrontendParams are the parameters of
+// DOM.pushNodesByBackendIdsToFrontend.
+//
+// Requests that a batch of nodes is sent to the caller given their backend node
+// ids.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOM#method-pushNodesByBackendIdsToFrontend
+//
+// This is an experimental part of the protocol.
+type PushNodesByBackendIdsToFrontendParams struct {
+	// The array of backend node ids.
+	BackendNodeIds []BackendNodeID `json:"backendNodeIds"`
+}
+
+// Command returns the DOM.pushNodesByBackendIdsToFrontend command for use with
+// AsyncDebugger.Send.
+func (p PushNodesByBackendIdsToFrontendParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandPushNodesByBackendIdsToFrontend, p)
+}
+
+// PushNodesByBackendIdsToFrontendReturns are the values returned by DOM.pushNodesByBackendIdsToFrontend.
+type PushNodesByBackendIdsToFrontendReturns struct {
+	// The array of ids of pushed nodes that correspond to the backend ids
+	// specified in backendNodeIds.
+	NodeIds []NodeID `json:"nodeIds"`
+}
+
+// Do sends DOM.pushNodesByBackendIdsToFrontend to d and waits for its result or for ctx to be
+// done.
+func (p PushNodesByBackendIdsToFrontendParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*PushNodesByBackendIdsToFrontendReturns, error) {
+	ret := &PushNodesByBackendIdsToFrontendReturns{}
+	if err := protocol.Do(ctx, d, CommandPushNodesByBackendIdsToFrontend, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// QuerySelectorParams are the parameters of DOM.querySelector.
+//
+// Executes `querySelector` on a given node.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOM#method-querySelector
+type QuerySelectorParams struct {
+	// Id of the node to query upon.
+	NodeID NodeID `json:"nodeId"`
+	// Selector string.
+	Selector string `json:"selector"`
+}
+
+// Command returns the DOM.querySelector command for use with
+// AsyncDebugger.Send.
+func (p QuerySelectorParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandQuerySelector, p)
+}
+
+// QuerySelectorReturns are the values returned by DOM.querySelector.
+type QuerySelectorReturns struct {
+	// Query selector result.
+	NodeID NodeID `json:"nodeId"`
+}
+
+// Do sends DOM.querySelector to d and waits for its result or for ctx to be
+// done.
+func (p QuerySelectorParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*QuerySelectorReturns, error) {
+	ret := &QuerySelectorReturns{}
+	if err := protocol.Do(ctx, d, CommandQuerySelector, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// QuerySelectorAllParams are the parameters of DOM.querySelectorAll.
+//
+// Executes `querySelectorAll` on a given node.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOM#method-querySelectorAll
+type QuerySelectorAllParams struct {
+	// Id of the node to query upon.
+	NodeID NodeID `json:"nodeId"`
+	// Selector string.
+	Selector string `json:"selector"`
+}
+
+// Command returns the DOM.querySelectorAll command for use with
+// AsyncDebugger.Send.
+func (p QuerySelectorAllParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandQuerySelectorAll, p)
+}
+
+// QuerySelectorAllReturns are the values returned by DOM.querySelectorAll.
+type QuerySelectorAllReturns struct {
+	// Query selector result.
+	NodeIds []NodeID `json:"nodeIds"`
+}
+
+// Do sends DOM.querySelectorAll to d and waits for its result or for ctx to be
+// done.
+func (p QuerySelectorAllParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*QuerySelectorAllReturns, error) {
+	ret := &QuerySelectorAllReturns{}
+	if err := protocol.Do(ctx, d, CommandQuerySelectorAll, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// GetTopLayerElementsParams are the parameters of DOM.getTopLayerElements.
+//
+// Returns NodeIds of current top layer elements. Top layer is rendered closest
+// to the user within a viewport, therefore its elements always appear on top of
+// all other content.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOM#method-getTopLayerElements
+//
+// This is an experimental part of the protocol.
+type GetTopLayerElementsParams struct {
+}
+
+// Command returns the DOM.getTopLayerElements command for use with
+// AsyncDebugger.Send.
+func (p GetTopLayerElementsParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandGetTopLayerElements, p)
+}
+
+// GetTopLayerElementsReturns are the values returned by DOM.getTopLayerElements.
+type GetTopLayerElementsReturns struct {
+	// NodeIds of top layer elements
+	NodeIds []NodeID `json:"nodeIds"`
+}
+
+// Do sends DOM.getTopLayerElements to d and waits for its result or for ctx to be
+// done.
+func (p GetTopLayerElementsParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*GetTopLayerElementsReturns, error) {
+	ret := &GetTopLayerElementsReturns{}
+	if err := protocol.Do(ctx, d, CommandGetTopLayerElements, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// GetElementByRelationParams are the parameters of DOM.getElementByRelation.
+//
+// Returns the NodeId of the matched element according to certain relations.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOM#method-getElementByRelation
+//
+// This is an experimental part of the protocol.
+type GetElementByRelationParams struct {
+	// Id of the node from which to query the relation.
+	NodeID NodeID `json:"nodeId"`
+	// Type of relation to get.
+	//
+	// Allowed values: PopoverTarget, InterestTarget, CommandFor.
+	Relation string `json:"relation"`
+}
+
+// Command returns the DOM.getElementByRelation command for use with
+// AsyncDebugger.Send.
+func (p GetElementByRelationParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandGetElementByRelation, p)
+}
+
+// GetElementByRelationReturns are the values returned by DOM.getElementByRelation.
+type GetElementByRelationReturns struct {
+	// NodeId of the element matching the queried relation.
+	NodeID NodeID `json:"nodeId"`
+}
+
+// Do sends DOM.getElementByRelation to d and waits for its result or for ctx to be
+// done.
+func (p GetElementByRelationParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*GetElementByRelationReturns, error) {
+	ret := &GetElementByRelationReturns{}
+	if err := protocol.Do(ctx, d, CommandGetElementByRelation, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// RedoParams are the parameters of DOM.redo.
+//
+// Re-does the last undone action.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOM#method-redo
+//
+// This is an experimental part of the protocol.
+type RedoParams struct {
+}
+
+// Command returns the DOM.redo command for use with AsyncDebugger.Send.
+func (p RedoParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandRedo, p)
+}
+
+// Do sends DOM.redo to d and waits for it to complete or for ctx to
+// be done.
+func (p RedoParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandRedo, p, nil)
+}
+
+// RemoveAttributeParams are the parameters of DOM.removeAttribute.
+//
+// Removes attribute with given name from an element with given id.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOM#method-removeAttribute
+type RemoveAttributeParams struct {
+	// Id of the element to remove attribute from.
+	NodeID NodeID `json:"nodeId"`
+	// Name of the attribute to remove.
+	Name string `json:"name"`
+}
+
+// Command returns the DOM.removeAttribute command for use with
+// AsyncDebugger.Send.
+func (p RemoveAttributeParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandRemoveAttribute, p)
+}
+
+// Do sends DOM.removeAttribute to d and waits for it to complete or for ctx to
+// be done.
+func (p RemoveAttributeParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandRemoveAttribute, p, nil)
+}
+
+// RemoveNodeParams are the parameters of DOM.removeNode.
+//
+// Removes node with given id.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOM#method-removeNode
+type RemoveNodeParams struct {
+	// Id of the node to remove.
+	NodeID NodeID `json:"nodeId"`
+}
+
+// Command returns the DOM.removeNode command for use with AsyncDebugger.Send.
+func (p RemoveNodeParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandRemoveNode, p)
+}
+
+// Do sends DOM.removeNode to d and waits for it to complete or for ctx to
+// be done.
+func (p RemoveNodeParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandRemoveNode, p, nil)
+}
+
+// RequestChildNodesParams are the parameters of DOM.requestChildNodes.
+//
+// Requests that children of the node with given id are returned to the caller
+// in form of `setChildNodes` events where not only immediate children are
+// retrieved, but all children down to the specified depth.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOM#method-requestChildNodes
+type RequestChildNodesParams struct {
+	// Id of the node to get children for.
+	NodeID NodeID `json:"nodeId"`
+	// The maximum depth at which children should be retrieved, defaults to 1. Use
+	// -1 for the entire subtree or provide an integer larger than 0.
+	Depth int64 `json:"depth,omitempty"`
+	// Whether or not iframes and shadow roots should be traversed when returning
+	// the sub-tree (default is false).
+	Pierce bool `json:"pierce,omitempty"`
+}
+
+// Command returns the DOM.requestChildNodes command for use with
+// AsyncDebugger.Send.
+func (p RequestChildNodesParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandRequestChildNodes, p)
+}
+
+// Do sends DOM.requestChildNodes to d and waits for it to complete or for ctx to
+// be done.
+func (p RequestChildNodesParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandRequestChildNodes, p, nil)
+}
+
+// RequestNodeParams are the parameters of DOM.requestNode.
+//
+// Requests that the node is sent to the caller given the JavaScript node object
+// reference. All nodes that form the path from the node to the root are also
+// sent to the client as a series of `setChildNodes` notifications.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOM#method-requestNode
+type RequestNodeParams struct {
+	// JavaScript object id to convert into node.
+	ObjectID types.RuntimeRemoteObjectID `json:"objectId"`
+}
+
+// Command returns the DOM.requestNode command for use with AsyncDebugger.Send.
+func (p RequestNodeParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandRequestNode, p)
+}
+
+// RequestNodeReturns are the values returned by DOM.requestNode.
+type RequestNodeReturns struct {
+	// Node id for given object.
+	NodeID NodeID `json:"nodeId"`
+}
+
+// Do sends DOM.requestNode to d and waits for its result or for ctx to be
+// done.
+func (p RequestNodeParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*RequestNodeReturns, error) {
+	ret := &RequestNodeReturns{}
+	if err := protocol.Do(ctx, d, CommandRequestNode, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// ResolveNodeParams are the parameters of DOM.resolveNode.
+//
+// Resolves the JavaScript node object for a given NodeId or BackendNodeId.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOM#method-resolveNode
+type ResolveNodeParams struct {
+	// Id of the node to resolve.
+	NodeID NodeID `json:"nodeId,omitempty"`
+	// Backend identifier of the node to resolve.
+	BackendNodeID BackendNodeID `json:"backendNodeId,omitempty"`
+	// Symbolic group name that can be used to release multiple objects.
+	ObjectGroup string `json:"objectGroup,omitempty"`
+	// Execution context in which to resolve the node.
+	ExecutionContextID types.RuntimeExecutionContextID `json:"executionContextId,omitempty"`
+}
+
+// Command returns the DOM.resolveNode command for use with AsyncDebugger.Send.
+func (p ResolveNodeParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandResolveNode, p)
+}
+
+// ResolveNodeReturns are the values returned by DOM.resolveNode.
+type ResolveNodeReturns struct {
+	// JavaScript object wrapper for given node.
+	Object types.RuntimeRemoteObject `json:"object"`
+}
+
+// Do sends DOM.resolveNode to d and waits for its result or for ctx to be
+// done.
+func (p ResolveNodeParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*ResolveNodeReturns, error) {
+	ret := &ResolveNodeReturns{}
+	if err := protocol.Do(ctx, d, CommandResolveNode, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// SetAttributeValueParams are the parameters of DOM.setAttributeValue.
+//
+// Sets attribute for an element with given id.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOM#method-setAttributeValue
+type SetAttributeValueParams struct {
+	// Id of the element to set attribute for.
+	NodeID NodeID `json:"nodeId"`
+	// Attribute name.
+	Name string `json:"name"`
+	// Attribute value.
+	Value string `json:"value"`
+}
+
+// Command returns the DOM.setAttributeValue command for use with
+// AsyncDebugger.Send.
+func (p SetAttributeValueParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetAttributeValue, p)
+}
+
+// Do sends DOM.setAttributeValue to d and waits for it to complete or for ctx to
+// be done.
+func (p SetAttributeValueParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetAttributeValue, p, nil)
+}
+
+// SetAttributesAsTextParams are the parameters of DOM.setAttributesAsText.
+//
+// Sets attributes on element with given id. This method is useful when user
+// edits some existing attribute value and types in several attribute name/value
+// pairs.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOM#method-setAttributesAsText
+type SetAttributesAsTextParams struct {
+	// Id of the element to set attributes for.
+	NodeID NodeID `json:"nodeId"`
+	// Text with a number of attributes. Will parse this text using HTML parser.
+	Text string `json:"text"`
+	// Attribute name to replace with new attributes derived from text in case text
+	// parsed successfully.
+	Name string `json:"name,omitempty"`
+}
+
+// Command returns the DOM.setAttributesAsText command for use with
+// AsyncDebugger.Send.
+func (p SetAttributesAsTextParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetAttributesAsText, p)
+}
+
+// Do sends DOM.setAttributesAsText to d and waits for it to complete or for ctx to
+// be done.
+func (p SetAttributesAsTextParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetAttributesAsText, p, nil)
+}
+
+// SetFileInputFilesParams are the parameters of DOM.setFileInputFiles.
+//
+// Sets files for the given file input element.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOM#method-setFileInputFiles
+type SetFileInputFilesParams struct {
+	// Array of file paths to set.
+	Files []string `json:"files"`
+	// Identifier of the node.
+	NodeID NodeID `json:"nodeId,omitempty"`
+	// Identifier of the backend node.
+	BackendNodeID BackendNodeID `json:"backendNodeId,omitempty"`
+	// JavaScript object id of the node wrapper.
+	ObjectID types.RuntimeRemoteObjectID `json:"objectId,omitempty"`
+}
+
+// Command returns the DOM.setFileInputFiles command for use with
+// AsyncDebugger.Send.
+func (p SetFileInputFilesParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetFileInputFiles, p)
+}
+
+// Do sends DOM.setFileInputFiles to d and waits for it to complete or for ctx to
+// be done.
+func (p SetFileInputFilesParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetFileInputFiles, p, nil)
+}
+
+// SetNodeStackTracesEnabledParams are the parameters of
+// DOM.setNodeStackTracesEnabled.
+//
+// Sets if stack traces should be captured for Nodes. See
+// `Node.getNodeStackTraces`. Default is disabled.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOM#method-setNodeStackTracesEnabled
+//
+// This is an experimental part of the protocol.
+type SetNodeStackTracesEnabledParams struct {
+	// Enable or disable.
+	Enable bool `json:"enable"`
+}
+
+// Command returns the DOM.setNodeStackTracesEnabled command for use with
+// AsyncDebugger.Send.
+func (p SetNodeStackTracesEnabledParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetNodeStackTracesEnabled, p)
+}
+
+// Do sends DOM.setNodeStackTracesEnabled to d and waits for it to complete or for ctx to
+// be done.
+func (p SetNodeStackTracesEnabledParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetNodeStackTracesEnabled, p, nil)
+}
+
+// GetNodeStackTracesParams are the parameters of DOM.getNodeStackTraces.
+//
+// Gets stack traces associated with a Node. As of now, only provides stack
+// trace for Node creation.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOM#method-getNodeStackTraces
+//
+// This is an experimental part of the protocol.
+type GetNodeStackTracesParams struct {
+	// Id of the node to get stack traces for.
+	NodeID NodeID `json:"nodeId"`
+}
+
+// Command returns the DOM.getNodeStackTraces command for use with
+// AsyncDebugger.Send.
+func (p GetNodeStackTracesParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandGetNodeStackTraces, p)
+}
+
+// GetNodeStackTracesReturns are the values returned by DOM.getNodeStackTraces.
+type GetNodeStackTracesReturns struct {
+	// Creation stack trace, if available.
+	Creation *types.RuntimeStackTrace `json:"creation,omitempty"`
+}
+
+// Do sends DOM.getNodeStackTraces to d and waits for its result or for ctx to be
+// done.
+func (p GetNodeStackTracesParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*GetNodeStackTracesReturns, error) {
+	ret := &GetNodeStackTracesReturns{}
+	if err := protocol.Do(ctx, d, CommandGetNodeStackTraces, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// GetFileInfoParams are the parameters of DOM.getFileInfo.
+//
+// Returns file information for the given File wrapper.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOM#method-getFileInfo
+//
+// This is an experimental part of the protocol.
+type GetFileInfoParams struct {
+	// JavaScript object id of the node wrapper.
+	ObjectID types.RuntimeRemoteObjectID `json:"objectId"`
+}
+
+// Command returns the DOM.getFileInfo command for use with AsyncDebugger.Send.
+func (p GetFileInfoParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandGetFileInfo, p)
+}
+
+// GetFileInfoReturns are the values returned by DOM.getFileInfo.
+type GetFileInfoReturns struct {
+	Path string `json:"path"`
+}
+
+// Do sends DOM.getFileInfo to d and waits for its result or for ctx to be
+// done.
+func (p GetFileInfoParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*GetFileInfoReturns, error) {
+	ret := &GetFileInfoReturns{}
+	if err := protocol.Do(ctx, d, CommandGetFileInfo, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// GetDetachedDOMNodesParams are the parameters of DOM.getDetachedDomNodes.
+//
+// # Returns list of detached nodes
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOM#method-getDetachedDomNodes
+//
+// This is an experimental part of the protocol.
+type GetDetachedDOMNodesParams struct {
+}
+
+// Command returns the DOM.getDetachedDomNodes command for use with
+// AsyncDebugger.Send.
+func (p GetDetachedDOMNodesParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandGetDetachedDOMNodes, p)
+}
+
+// GetDetachedDOMNodesReturns are the values returned by DOM.getDetachedDomNodes.
+type GetDetachedDOMNodesReturns struct {
+	// The list of detached nodes
+	DetachedNodes []DetachedElementInfo `json:"detachedNodes"`
+}
+
+// Do sends DOM.getDetachedDomNodes to d and waits for its result or for ctx to be
+// done.
+func (p GetDetachedDOMNodesParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*GetDetachedDOMNodesReturns, error) {
+	ret := &GetDetachedDOMNodesReturns{}
+	if err := protocol.Do(ctx, d, CommandGetDetachedDOMNodes, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// SetInspectedNodeParams are the parameters of DOM.setInspectedNode.
+//
+// Enables console to refer to the node with given id via $x (see Command Line
+// API for more details $x functions).
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOM#method-setInspectedNode
+//
+// This is an experimental part of the protocol.
+type SetInspectedNodeParams struct {
+	// DOM node id to be accessible by means of $x command line API.
+	NodeID NodeID `json:"nodeId"`
+}
+
+// Command returns the DOM.setInspectedNode command for use with
+// AsyncDebugger.Send.
+func (p SetInspectedNodeParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetInspectedNode, p)
+}
+
+// Do sends DOM.setInspectedNode to d and waits for it to complete or for ctx to
+// be done.
+func (p SetInspectedNodeParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetInspectedNode, p, nil)
+}
+
+// SetNodeNameParams are the parameters of DOM.setNodeName.
+//
+// Sets node name for a node with given id.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOM#method-setNodeName
+type SetNodeNameParams struct {
+	// Id of the node to set name for.
+	NodeID NodeID `json:"nodeId"`
+	// New node's name.
+	Name string `json:"name"`
+}
+
+// Command returns the DOM.setNodeName command for use with AsyncDebugger.Send.
+func (p SetNodeNameParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetNodeName, p)
+}
+
+// SetNodeNameReturns are the values returned by DOM.setNodeName.
+type SetNodeNameReturns struct {
+	// New node's id.
+	NodeID NodeID `json:"nodeId"`
+}
+
+// Do sends DOM.setNodeName to d and waits for its result or for ctx to be
+// done.
+func (p SetNodeNameParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*SetNodeNameReturns, error) {
+	ret := &SetNodeNameReturns{}
+	if err := protocol.Do(ctx, d, CommandSetNodeName, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// SetNodeValueParams are the parameters of DOM.setNodeValue.
+//
+// Sets node value for a node with given id.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOM#method-setNodeValue
+type SetNodeValueParams struct {
+	// Id of the node to set value for.
+	NodeID NodeID `json:"nodeId"`
+	// New node's value.
+	Value string `json:"value"`
+}
+
+// Command returns the DOM.setNodeValue command for use with AsyncDebugger.Send.
+func (p SetNodeValueParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetNodeValue, p)
+}
+
+// Do sends DOM.setNodeValue to d and waits for it to complete or for ctx to
+// be done.
+func (p SetNodeValueParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetNodeValue, p, nil)
+}
+
+// SetOuterHTMLParams are the parameters of DOM.setOuterHTML.
+//
+// Sets node HTML markup, returns new node id.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOM#method-setOuterHTML
+type SetOuterHTMLParams struct {
+	// Id of the node to set markup for.
+	NodeID NodeID `json:"nodeId"`
+	// Outer HTML markup to set.
+	OuterHTML string `json:"outerHTML"`
+}
+
+// Command returns the DOM.setOuterHTML command for use with AsyncDebugger.Send.
+func (p SetOuterHTMLParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetOuterHTML, p)
+}
+
+// Do sends DOM.setOuterHTML to d and waits for it to complete or for ctx to
+// be done.
+func (p SetOuterHTMLParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetOuterHTML, p, nil)
+}
+
+// UndoParams are the parameters of DOM.undo.
+//
+// Undoes the last performed action.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOM#method-undo
+//
+// This is an experimental part of the protocol.
+type UndoParams struct {
+}
+
+// Command returns the DOM.undo command for use with AsyncDebugger.Send.
+func (p UndoParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandUndo, p)
+}
+
+// Do sends DOM.undo to d and waits for it to complete or for ctx to
+// be done.
+func (p UndoParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandUndo, p, nil)
+}
+
+// GetFrameOwnerParams are the parameters of DOM.getFrameOwner.
+//
+// Returns iframe node that owns iframe with the given domain.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOM#method-getFrameOwner
+//
+// This is an experimental part of the protocol.
+type GetFrameOwnerParams struct {
+	FrameID types.PageFrameID `json:"frameId"`
+}
+
+// Command returns the DOM.getFrameOwner command for use with
+// AsyncDebugger.Send.
+func (p GetFrameOwnerParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandGetFrameOwner, p)
+}
+
+// GetFrameOwnerReturns are the values returned by DOM.getFrameOwner.
+type GetFrameOwnerReturns struct {
+	// Resulting node.
+	BackendNodeID BackendNodeID `json:"backendNodeId"`
+	// Id of the node at given coordinates, only when enabled and requested
+	// document.
+	NodeID NodeID `json:"nodeId,omitempty"`
+}
+
+// Do sends DOM.getFrameOwner to d and waits for its result or for ctx to be
+// done.
+func (p GetFrameOwnerParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*GetFrameOwnerReturns, error) {
+	ret := &GetFrameOwnerReturns{}
+	if err := protocol.Do(ctx, d, CommandGetFrameOwner, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// GetContainerForNodeParams are the parameters of DOM.getContainerForNode.
+//
+// Returns the query container of the given node based on container query
+// conditions: containerName, physical and logical axes, and whether it queries
+// scroll-state or anchored elements. If no axes are provided and
+// queriesScrollState is false, the style container is returned, which is the
+// direct parent or the closest element with a matching container-name.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOM#method-getContainerForNode
+//
+// This is an experimental part of the protocol.
+type GetContainerForNodeParams struct {
+	NodeID             NodeID       `json:"nodeId"`
+	ContainerName      string       `json:"containerName,omitempty"`
+	PhysicalAxes       PhysicalAxes `json:"physicalAxes,omitempty"`
+	LogicalAxes        LogicalAxes  `json:"logicalAxes,omitempty"`
+	QueriesScrollState bool         `json:"queriesScrollState,omitempty"`
+	QueriesAnchored    bool         `json:"queriesAnchored,omitempty"`
+}
+
+// Command returns the DOM.getContainerForNode command for use with
+// AsyncDebugger.Send.
+func (p GetContainerForNodeParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandGetContainerForNode, p)
+}
+
+// GetContainerForNodeReturns are the values returned by DOM.getContainerForNode.
+type GetContainerForNodeReturns struct {
+	// The container node for the given node, or null if not found.
+	NodeID NodeID `json:"nodeId,omitempty"`
+}
+
+// Do sends DOM.getContainerForNode to d and waits for its result or for ctx to be
+// done.
+func (p GetContainerForNodeParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*GetContainerForNodeReturns, error) {
+	ret := &GetContainerForNodeReturns{}
+	if err := protocol.Do(ctx, d, CommandGetContainerForNode, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// GetQueryingDescendantsForContainerParams are the parameters of
+// DOM.getQueryingDescendantsForContainer.
+//
+// Returns the descendants of a container query container that have container
+// queries against this container.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOM#method-getQueryingDescendantsForContainer
+//
+// This is an experimental part of the protocol.
+type GetQueryingDescendantsForContainerParams struct {
+	// Id of the container node to find querying descendants from.
+	NodeID NodeID `json:"nodeId"`
+}
+
+// Command returns the DOM.getQueryingDescendantsForContainer command for use
+// with AsyncDebugger.Send.
+func (p GetQueryingDescendantsForContainerParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandGetQueryingDescendantsForContainer, p)
+}
+
+// GetQueryingDescendantsForContainerReturns are the values returned by DOM.getQueryingDescendantsForContainer.
+type GetQueryingDescendantsForContainerReturns struct {
+	// Descendant nodes with container queries against the given container.
+	NodeIds []NodeID `json:"nodeIds"`
+}
+
+// Do sends DOM.getQueryingDescendantsForContainer to d and waits for its result or for ctx to be
+// done.
+func (p GetQueryingDescendantsForContainerParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*GetQueryingDescendantsForContainerReturns, error) {
+	ret := &GetQueryingDescendantsForContainerReturns{}
+	if err := protocol.Do(ctx, d, CommandGetQueryingDescendantsForContainer, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// GetAnchorElementParams are the parameters of DOM.getAnchorElement.
+//
+// Returns the target anchor element of the given anchor query according to
+// https://www.w3.org/TR/css-anchor-position-1/#target.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOM#method-getAnchorElement
+//
+// This is an experimental part of the protocol.
+type GetAnchorElementParams struct {
+	// Id of the positioned element from which to find the anchor.
+	NodeID NodeID `json:"nodeId"`
+	// An optional anchor specifier, as defined in
+	// https://www.w3.org/TR/css-anchor-position-1/#anchor-specifier. If not
+	// provided, it will return the implicit anchor element for the given
+	// positioned element.
+	AnchorSpecifier string `json:"anchorSpecifier,omitempty"`
+}
+
+// Command returns the DOM.getAnchorElement command for use with
+// AsyncDebugger.Send.
+func (p GetAnchorElementParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandGetAnchorElement, p)
+}
+
+// GetAnchorElementReturns are the values returned by DOM.getAnchorElement.
+type GetAnchorElementReturns struct {
+	// The anchor element of the given anchor query.
+	NodeID NodeID `json:"nodeId"`
+}
+
+// Do sends DOM.getAnchorElement to d and waits for its result or for ctx to be
+// done.
+func (p GetAnchorElementParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*GetAnchorElementReturns, error) {
+	ret := &GetAnchorElementReturns{}
+	if err := protocol.Do(ctx, d, CommandGetAnchorElement, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// ForceShowPopoverParams are the parameters of DOM.forceShowPopover.
+//
+// When enabling, this API force-opens the popover identified by nodeId and
+// keeps it open until disabled.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOM#method-forceShowPopover
+//
+// This is an experimental part of the protocol.
+type ForceShowPopoverParams struct {
+	// Id of the popover HTMLElement
+	NodeID NodeID `json:"nodeId"`
+	// If true, opens the popover and keeps it open. If false, closes the popover
+	// if it was previously force-opened.
+	Enable bool `json:"enable"`
+}
+
+// Command returns the DOM.forceShowPopover command for use with
+// AsyncDebugger.Send.
+func (p ForceShowPopoverParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandForceShowPopover, p)
+}
+
+// ForceShowPopoverReturns are the values returned by DOM.forceShowPopover.
+type ForceShowPopoverReturns struct {
+	// List of popovers that were closed in order to respect popover stacking
+	// order.
+	NodeIds []NodeID `json:"nodeIds"`
+}
+
+// Do sends DOM.forceShowPopover to d and waits for its result or for ctx to be
+// done.
+func (p ForceShowPopoverParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*ForceShowPopoverReturns, error) {
+	ret := &ForceShowPopoverReturns{}
+	if err := protocol.Do(ctx, d, CommandForceShowPopover, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// Method names of the events in the DOM domain.
+const (
+	EventAttributeModified       = "DOM.attributeModified"
+	EventAttributeRemoved        = "DOM.attributeRemoved"
+	EventCharacterDataModified   = "DOM.characterDataModified"
+	EventChildNodeCountUpdated   = "DOM.childNodeCountUpdated"
+	EventChildNodeInserted       = "DOM.childNodeInserted"
+	EventChildNodeRemoved        = "DOM.childNodeRemoved"
+	EventDistributedNodesUpdated = "DOM.distributedNodesUpdated"
+	EventDocumentUpdated         = "DOM.documentUpdated"
+	EventInlineStyleInvalidated  = "DOM.inlineStyleInvalidated"
+	EventPseudoElementAdded      = "DOM.pseudoElementAdded"
+	EventTopLayerElementsUpdated = "DOM.topLayerElementsUpdated"
+	EventScrollableFlagUpdated   = "DOM.scrollableFlagUpdated"
+	EventPseudoElementRemoved    = "DOM.pseudoElementRemoved"
+	EventSetChildNodes           = "DOM.setChildNodes"
+	EventShadowRootPopped        = "DOM.shadowRootPopped"
+	EventShadowRootPushed        = "DOM.shadowRootPushed"
+)
+
+// AttributeModifiedEvent is the payload of the DOM.attributeModified event.
+//
+// Fired when `Element`'s attribute is modified.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOM#event-attributeModified
+type AttributeModifiedEvent struct {
+	// Id of the node that has changed.
+	NodeID NodeID `json:"nodeId"`
+	// Attribute name.
+	Name string `json:"name"`
+	// Attribute value.
+	Value string `json:"value"`
+}
+
+// AttributeRemovedEvent is the payload of the DOM.attributeRemoved event.
+//
+// Fired when `Element`'s attribute is removed.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOM#event-attributeRemoved
+type AttributeRemovedEvent struct {
+	// Id of the node that has changed.
+	NodeID NodeID `json:"nodeId"`
+	// A ttribute name.
+	Name string `json:"name"`
+}
+
+// CharacterDataModifiedEvent is the payload of the DOM.characterDataModified
+// event.
+//
+// Mirrors `DOMCharacterDataModified` event.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOM#event-characterDataModified
+type CharacterDataModifiedEvent struct {
+	// Id of the node that has changed.
+	NodeID NodeID `json:"nodeId"`
+	// New text value.
+	CharacterData string `json:"characterData"`
+}
+
+// ChildNodeCountUpdatedEvent is the payload of the DOM.childNodeCountUpdated
+// event.
+//
+// Fired when `Container`'s child node count has changed.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOM#event-childNodeCountUpdated
+type ChildNodeCountUpdatedEvent struct {
+	// Id of the node that has changed.
+	NodeID NodeID `json:"nodeId"`
+	// New node count.
+	ChildNodeCount int64 `json:"childNodeCount"`
+}
+
+// ChildNodeInsertedEvent is the payload of the DOM.childNodeInserted event.
+//
+// Mirrors `DOMNodeInserted` event.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOM#event-childNodeInserted
+type ChildNodeInsertedEvent struct {
+	// Id of the node that has changed.
+	ParentNodeID NodeID `json:"parentNodeId"`
+	// Id of the previous sibling.
+	PreviousNodeID NodeID `json:"previousNodeId"`
+	// Inserted node data.
+	Node Node `json:"node"`
+}
+
+// ChildNodeRemovedEvent is the payload of the DOM.childNodeRemoved event.
+//
+// Mirrors `DOMNodeRemoved` event.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOM#event-childNodeRemoved
+type ChildNodeRemovedEvent struct {
+	// Parent id.
+	ParentNodeID NodeID `json:"parentNodeId"`
+	// Id of the node that has been removed.
+	NodeID NodeID `json:"nodeId"`
+}
+
+// DistributedNodesUpdatedEvent is the payload of the
+// DOM.distributedNodesUpdated event.
+//
+// Called when distribution is changed.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOM#event-distributedNodesUpdated
+//
+// This is an experimental part of the protocol.
+type DistributedNodesUpdatedEvent struct {
+	// Insertion point where distributed nodes were updated.
+	InsertionPointID NodeID `json:"insertionPointId"`
+	// Distributed nodes for given insertion point.
+	DistributedNodes []BackendNode `json:"distributedNodes"`
+}
+
+// DocumentUpdatedEvent is the payload of the DOM.documentUpdated event.
+//
+// Fired when `Document` has been totally updated. Node ids are no longer valid.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOM#event-documentUpdated
+type DocumentUpdatedEvent struct {
+}
+
+// InlineStyleInvalidatedEvent is the payload of the DOM.inlineStyleInvalidated
+// event.
+//
+// Fired when `Element`'s inline style is modified via a CSS property
+// modification.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOM#event-inlineStyleInvalidated
+//
+// This is an experimental part of the protocol.
+type InlineStyleInvalidatedEvent struct {
+	// Ids of the nodes for which the inline styles have been invalidated.
+	NodeIds []NodeID `json:"nodeIds"`
+}
+
+// PseudoElementAddedEvent is the payload of the DOM.pseudoElementAdded event.
+//
+// Called when a pseudo element is added to an element.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOM#event-pseudoElementAdded
+//
+// This is an experimental part of the protocol.
+type PseudoElementAddedEvent struct {
+	// Pseudo element's parent element id.
+	ParentID NodeID `json:"parentId"`
+	// The added pseudo element.
+	PseudoElement Node `json:"pseudoElement"`
+}
+
+// TopLayerElementsUpdatedEvent is the payload of the
+// DOM.topLayerElementsUpdated event.
+//
+// Called when top layer elements are changed.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOM#event-topLayerElementsUpdated
+//
+// This is an experimental part of the protocol.
+type TopLayerElementsUpdatedEvent struct {
+}
+
+// ScrollableFlagUpdatedEvent is the payload of the DOM.scrollableFlagUpdated
+// event.
+//
+// Fired when a node's scrollability state changes.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOM#event-scrollableFlagUpdated
+//
+// This is an experimental part of the protocol.
+type ScrollableFlagUpdatedEvent struct {
+	// The id of the node.
+	NodeID NodeID `json:"nodeId"`
+	// If the node is scrollable.
+	IsScrollable bool `json:"isScrollable"`
+}
+
+// PseudoElementRemovedEvent is the payload of the DOM.pseudoElementRemoved
+// event.
+//
+// Called when a pseudo element is removed from an element.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOM#event-pseudoElementRemoved
+//
+// This is an experimental part of the protocol.
+type PseudoElementRemovedEvent struct {
+	// Pseudo element's parent element id.
+	ParentID NodeID `json:"parentId"`
+	// The removed pseudo element id.
+	PseudoElementID NodeID `json:"pseudoElementId"`
+}
+
+// SetChildNodesEvent is the payload of the DOM.setChildNodes event.
+//
+// Fired when backend wants to provide client with the missing DOM structure.
+// This happens upon most of the calls requesting node ids.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOM#event-setChildNodes
+type SetChildNodesEvent struct {
+	// Parent node id to populate with children.
+	ParentID NodeID `json:"parentId"`
+	// Child nodes array.
+	Nodes []Node `json:"nodes"`
+}
+
+// ShadowRootPoppedEvent is the payload of the DOM.shadowRootPopped event.
+//
+// Called when shadow root is popped from the element.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOM#event-shadowRootPopped
+//
+// This is an experimental part of the protocol.
+type ShadowRootPoppedEvent struct {
+	// Host element id.
+	HostID NodeID `json:"hostId"`
+	// Shadow root id.
+	RootID NodeID `json:"rootId"`
+}
+
+// ShadowRootPushedEvent is the payload of the DOM.shadowRootPushed event.
+//
+// Called when shadow root is pushed into the element.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOM#event-shadowRootPushed
+//
+// This is an experimental part of the protocol.
+type ShadowRootPushedEvent struct {
+	// Host element id.
+	HostID NodeID `json:"hostId"`
+	// Shadow root.
+	Root Node `json:"root"`
+}
+
+// ParseEvent decodes an event from the DOM domain, as received from
+// Subscribe, into a pointer to its typed payload.
+func ParseEvent(cmd chromedebugo.Command) (interface{}, error) {
+	var ev interface{}
+	switch cmd.Method {
+	case EventAttributeModified:
+		ev = &AttributeModifiedEvent{}
+	case EventAttributeRemoved:
+		ev = &AttributeRemovedEvent{}
+	case EventCharacterDataModified:
+		ev = &CharacterDataModifiedEvent{}
+	case EventChildNodeCountUpdated:
+		ev = &ChildNodeCountUpdatedEvent{}
+	case EventChildNodeInserted:
+		ev = &ChildNodeInsertedEvent{}
+	case EventChildNodeRemoved:
+		ev = &ChildNodeRemovedEvent{}
+	case EventDistributedNodesUpdated:
+		ev = &DistributedNodesUpdatedEvent{}
+	case EventDocumentUpdated:
+		ev = &DocumentUpdatedEvent{}
+	case EventInlineStyleInvalidated:
+		ev = &InlineStyleInvalidatedEvent{}
+	case EventPseudoElementAdded:
+		ev = &PseudoElementAddedEvent{}
+	case EventTopLayerElementsUpdated:
+		ev = &TopLayerElementsUpdatedEvent{}
+	case EventScrollableFlagUpdated:
+		ev = &ScrollableFlagUpdatedEvent{}
+	case EventPseudoElementRemoved:
+		ev = &PseudoElementRemovedEvent{}
+	case EventSetChildNodes:
+		ev = &SetChildNodesEvent{}
+	case EventShadowRootPopped:
+		ev = &ShadowRootPoppedEvent{}
+	case EventShadowRootPushed:
+		ev = &ShadowRootPushedEvent{}
+	default:
+		return nil, fmt.Errorf("unknown DOM event: %s", cmd.Method)
+	}
+	if err := cmd.Decode(ev); err != nil {
+		return nil, err
+	}
+	return ev, nil
+}
@@ -0,0 +1,331 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+// Package domdebugger provides typed bindings for the DOMDebugger domain of the
+// Chrome DevTools protocol.
+//
+// DOM debugging allows setting breakpoints on particular DOM operations and
+// events. JavaScript execution will stop on these operations as if there was a
+// regular breakpoint set.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOMDebugger
+package domdebugger
+
+import (
+	"context"
+
+	"github.com/tonyhb/chromedebugo"
+	"github.com/tonyhb/chromedebugo/protocol"
+	"github.com/tonyhb/chromedebugo/protocol/internal/types"
+)
+
+// DOMBreakpointType DOM breakpoint type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOMDebugger#type-DOMBreakpointType
+type DOMBreakpointType = types.DOMDebuggerDOMBreakpointType
+
+// CSPViolationType CSP Violation type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOMDebugger#type-CSPViolationType
+//
+// This is an experimental part of the protocol.
+type CSPViolationType = types.DOMDebuggerCSPViolationType
+
+// EventListener object event listener.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOMDebugger#type-EventListener
+type EventListener = types.DOMDebuggerEventListener
+
+// Values of the enumerated types in the DOMDebugger domain.
+const (
+	DOMBreakpointTypeSubtreeModified           = types.DOMDebuggerDOMBreakpointTypeSubtreeModified
+	DOMBreakpointTypeAttributeModified         = types.DOMDebuggerDOMBreakpointTypeAttributeModified
+	DOMBreakpointTypeNodeRemoved               = types.DOMDebuggerDOMBreakpointTypeNodeRemoved
+	CSPViolationTypeTrustedtypeSinkViolation   = types.DOMDebuggerCSPViolationTypeTrustedtypeSinkViolation
+	CSPViolationTypeTrustedtypePolicyViolation = types.DOMDebuggerCSPViolationTypeTrustedtypePolicyViolation
+)
+
+// Method names of the commands in the DOMDebugger domain.
+const (
+	CommandGetEventListeners               = "DOMDebugger.getEventListeners"
+	CommandRemoveDOMBreakpoint             = "DOMDebugger.removeDOMBreakpoint"
+	CommandRemoveEventListenerBreakpoint   = "DOMDebugger.removeEventListenerBreakpoint"
+	CommandRemoveInstrumentationBreakpoint = "DOMDebugger.removeInstrumentationBreakpoint"
+	CommandRemoveXHRBreakpoint             = "DOMDebugger.removeXHRBreakpoint"
+	CommandSetBreakOnCSPViolation          = "DOMDebugger.setBreakOnCSPViolation"
+	CommandSetDOMBreakpoint                = "DOMDebugger.setDOMBreakpoint"
+	CommandSetEventListenerBreakpoint      = "DOMDebugger.setEventListenerBreakpoint"
+	CommandSetInstrumentationBreakpoint    = "DOMDebugger.setInstrumentationBreakpoint"
+	CommandSetXHRBreakpoint                = "DOMDebugger.setXHRBreakpoint"
+)
+
+// GetEventListenersParams are the parameters of DOMDebugger.getEventListeners.
+//
+// Returns event listeners of the given object.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOMDebugger#method-getEventListeners
+type GetEventListenersParams struct {
+	// Identifier of the object to return listeners for.
+	ObjectID types.RuntimeRemoteObjectID `json:"objectId"`
+	// The maximum depth at which Node children should be retrieved, defaults to 1.
+	// Use -1 for the entire subtree or provide an integer larger than 0.
+	Depth int64 `json:"depth,omitempty"`
+	// Whether or not iframes and shadow roots should be traversed when returning
+	// the subtree (default is false). Reports listeners for all contexts if pierce
+	// is enabled.
+	Pierce bool `json:"pierce,omitempty"`
+}
+
+// Command returns the DOMDebugger.getEventListeners command for use with
+// AsyncDebugger.Send.
+func (p GetEventListenersParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandGetEventListeners, p)
+}
+
+// GetEventListenersReturns are the values returned by DOMDebugger.getEventListeners.
+type GetEventListenersReturns struct {
+	// Array of relevant listeners.
+	Listeners []EventListener `json:"listeners"`
+}
+
+// Do sends DOMDebugger.getEventListeners to d and waits for its result or for ctx to be
+// done.
+func (p GetEventListenersParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*GetEventListenersReturns, error) {
+	ret := &GetEventListenersReturns{}
+	if err := protocol.Do(ctx, d, CommandGetEventListeners, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// RemoveDOMBreakpointParams are the parameters of
+// DOMDebugger.removeDOMBreakpoint.
+//
+// Removes DOM breakpoint that was set using `setDOMBreakpoint`.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOMDebugger#method-removeDOMBreakpoint
+type RemoveDOMBreakpointParams struct {
+	// Identifier of the node to remove breakpoint from.
+	NodeID types.DOMNodeID `json:"nodeId"`
+	// Type of the breakpoint to remove.
+	Type DOMBreakpointType `json:"type"`
+}
+
+// Command returns the DOMDebugger.removeDOMBreakpoint command for use with
+// AsyncDebugger.Send.
+func (p RemoveDOMBreakpointParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandRemoveDOMBreakpoint, p)
+}
+
+// Do sends DOMDebugger.removeDOMBreakpoint to d and waits for it to complete or for ctx to
+// be done.
+func (p RemoveDOMBreakpointParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandRemoveDOMBreakpoint, p, nil)
+}
+
+// RemoveEventListenerBreakpointParams are the parameters of
+// DOMDebugger.removeEventListenerBreakpoint.
+//
+// Removes breakpoint on particular DOM event.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOMDebugger#method-removeEventListenerBreakpoint
+type RemoveEventListenerBreakpointParams struct {
+	// Event name.
+	EventName string `json:"eventName"`
+	// EventTarget interface name.
+	//
+	// This is an experimental part of the protocol.
+	TargetName string `json:"targetName,omitempty"`
+}
+
+// Command returns the DOMDebugger.removeEventListenerBreakpoint command for use
+// with AsyncDebugger.Send.
+func (p RemoveEventListenerBreakpointParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandRemoveEventListenerBreakpoint, p)
+}
+
+// Do sends DOMDebugger.removeEventListenerBreakpoint to d and waits for it to complete or for ctx to
+// be done.
+func (p RemoveEventListenerBreakpointParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandRemoveEventListenerBreakpoint, p, nil)
+}
+
+// RemoveInstrumentationBreakpointParams are the parameters of
+// DOMDebugger.removeInstrumentationBreakpoint.
+//
+// Removes breakpoint on particular native event.
+//
+// Redirects to the EventBreakpoints domain.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOMDebugger#method-removeInstrumentationBreakpoint
+//
+// This is an experimental part of the protocol.
+//
+// Deprecated: this is deprecated in the protocol.
+type RemoveInstrumentationBreakpointParams struct {
+	// Instrumentation name to stop on.
+	EventName string `json:"eventName"`
+}
+
+// Command returns the DOMDebugger.removeInstrumentationBreakpoint command for
+// use with AsyncDebugger.Send.
+func (p RemoveInstrumentationBreakpointParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandRemoveInstrumentationBreakpoint, p)
+}
+
+// Do sends DOMDebugger.removeInstrumentationBreakpoint to d and waits for it to complete or for ctx to
+// be done.
+func (p RemoveInstrumentationBreakpointParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandRemoveInstrumentationBreakpoint, p, nil)
+}
+
+// RemoveXHRBreakpointParams are the parameters of
+// DOMDebugger.removeXHRBreakpoint.
+//
+// Removes breakpoint from XMLHttpRequest.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOMDebugger#method-removeXHRBreakpoint
+type RemoveXHRBreakpointParams struct {
+	// Resource URL substring.
+	URL string `json:"url"`
+}
+
+// Command returns the DOMDebugger.removeXHRBreakpoint command for use with
+// AsyncDebugger.Send.
+func (p RemoveXHRBreakpointParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandRemoveXHRBreakpoint, p)
+}
+
+// Do sends DOMDebugger.removeXHRBreakpoint to d and waits for it to complete or for ctx to
+// be done.
+func (p RemoveXHRBreakpointParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandRemoveXHRBreakpoint, p, nil)
+}
+
+// SetBreakOnCSPViolationParams are the parameters of
+// DOMDebugger.setBreakOnCSPViolation.
+//
+// Sets breakpoint on particular CSP violations.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOMDebugger#method-setBreakOnCSPViolation
+//
+// This is an experimental part of the protocol.
+type SetBreakOnCSPViolationParams struct {
+	// CSP Violations to stop upon.
+	ViolationTypes []CSPViolationType `json:"violationTypes"`
+}
+
+// Command returns the DOMDebugger.setBreakOnCSPViolation command for use with
+// AsyncDebugger.Send.
+func (p SetBreakOnCSPViolationParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetBreakOnCSPViolation, p)
+}
+
+// Do sends DOMDebugger.setBreakOnCSPViolation to d and waits for it to complete or for ctx to
+// be done.
+func (p SetBreakOnCSPViolationParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetBreakOnCSPViolation, p, nil)
+}
+
+// SetDOMBreakpointParams are the parameters of DOMDebugger.setDOMBreakpoint.
+//
+// Sets breakpoint on particular operation with DOM.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOMDebugger#method-setDOMBreakpoint
+type SetDOMBreakpointParams struct {
+	// Identifier of the node to set breakpoint on.
+	NodeID types.DOMNodeID `json:"nodeId"`
+	// Type of the operation to stop upon.
+	Type DOMBreakpointType `json:"type"`
+}
+
+// Command returns the DOMDebugger.setDOMBreakpoint command for use with
+// AsyncDebugger.Send.
+func (p SetDOMBreakpointParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetDOMBreakpoint, p)
+}
+
+// Do sends DOMDebugger.setDOMBreakpoint to d and waits for it to complete or for ctx to
+// be done.
+func (p SetDOMBreakpointParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetDOMBreakpoint, p, nil)
+}
+
+// SetEventListenerBreakpointParams are the parameters of
+// DOMDebugger.setEventListenerBreakpoint.
+//
+// Sets breakpoint on particular DOM event.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOMDebugger#method-setEventListenerBreakpoint
+type SetEventListenerBreakpointParams struct {
+	// DOM Event name to stop on (any DOM event will do).
+	EventName string `json:"eventName"`
+	// EventTarget interface name to stop on. If equal to `"*"` or not provided,
+	// will stop on any EventTarget.
+	//
+	// This is an experimental part of the protocol.
+	TargetName string `json:"targetName,omitempty"`
+}
+
+// Command returns the DOMDebugger.setEventListenerBreakpoint command for use
+// with AsyncDebugger.Send.
+func (p SetEventListenerBreakpointParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetEventListenerBreakpoint, p)
+}
+
+// Do sends DOMDebugger.setEventListenerBreakpoint to d and waits for it to complete or for ctx to
+// be done.
+func (p SetEventListenerBreakpointParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetEventListenerBreakpoint, p, nil)
+}
+
+// SetInstrumentationBreakpointParams are the parameters of
+// DOMDebugger.setInstrumentationBreakpoint.
+//
+// Sets breakpoint on particular native event.
+//
+// Redirects to the EventBreakpoints domain.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOMDebugger#method-setInstrumentationBreakpoint
+//
+// This is an experimental part of the protocol.
+//
+// Deprecated: this is deprecated in the protocol.
+type SetInstrumentationBreakpointParams struct {
+	// Instrumentation name to stop on.
+	EventName string `json:"eventName"`
+}
+
+// Command returns the DOMDebugger.setInstrumentationBreakpoint command for use
+// with AsyncDebugger.Send.
+func (p SetInstrumentationBreakpointParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetInstrumentationBreakpoint, p)
+}
+
+// Do sends DOMDebugger.setInstrumentationBreakpoint to d and waits for it to complete or for ctx to
+// be done.
+func (p SetInstrumentationBreakpointParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetInstrumentationBreakpoint, p, nil)
+}
+
+// SetXHRBreakpointParams are the parameters of DOMDebugger.setXHRBreakpoint.
+//
+// Sets breakpoint on XMLHttpRequest.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOMDebugger#method-setXHRBreakpoint
+type SetXHRBreakpointParams struct {
+	// Resource URL substring. All XHRs having this substring in the URL will get
+	// stopped upon.
+	URL string `json:"url"`
+}
+
+// Command returns the DOMDebugger.setXHRBreakpoint command for use with
+// AsyncDebugger.Send.
+func (p SetXHRBreakpointParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetXHRBreakpoint, p)
+}
+
+// Do sends DOMDebugger.setXHRBreakpoint to d and waits for it to complete or for ctx to
+// be done.
+func (p SetXHRBreakpointParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetXHRBreakpoint, p, nil)
+}
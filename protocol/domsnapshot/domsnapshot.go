@@ -0,0 +1,253 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+// Package domsnapshot provides typed bindings for the DOMSnapshot domain of the
+// Chrome DevTools protocol.
+//
+// This domain facilitates obtaining document snapshots with DOM, layout, and
+// style information.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOMSnapshot
+//
+// This is an experimental part of the protocol.
+package domsnapshot
+
+import (
+	"context"
+
+	"github.com/tonyhb/chromedebugo"
+	"github.com/tonyhb/chromedebugo/protocol"
+	"github.com/tonyhb/chromedebugo/protocol/internal/types"
+)
+
+// DOMNode a Node in the DOM tree.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOMSnapshot#type-DOMNode
+type DOMNode = types.DOMSnapshotDOMNode
+
+// InlineTextBox details of post layout rendered text positions. The exact
+// layout should not be regarded as stable and may change between versions.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOMSnapshot#type-InlineTextBox
+type InlineTextBox = types.DOMSnapshotInlineTextBox
+
+// LayoutTreeNode details of an element in the DOM tree with a LayoutObject.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOMSnapshot#type-LayoutTreeNode
+type LayoutTreeNode = types.DOMSnapshotLayoutTreeNode
+
+// ComputedStyle a subset of the full ComputedStyle as defined by the request
+// whitelist.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOMSnapshot#type-ComputedStyle
+type ComputedStyle = types.DOMSnapshotComputedStyle
+
+// NameValue a name/value pair.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOMSnapshot#type-NameValue
+type NameValue = types.DOMSnapshotNameValue
+
+// StringIndex index of the string in the strings table.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOMSnapshot#type-StringIndex
+type StringIndex = types.DOMSnapshotStringIndex
+
+// ArrayOfStrings index of the string in the strings table.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOMSnapshot#type-ArrayOfStrings
+type ArrayOfStrings = types.DOMSnapshotArrayOfStrings
+
+// RareStringData data that is only present on rare nodes.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOMSnapshot#type-RareStringData
+type RareStringData = types.DOMSnapshotRareStringData
+
+// RareBooleanData is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOMSnapshot#type-RareBooleanData
+type RareBooleanData = types.DOMSnapshotRareBooleanData
+
+// RareIntegerData is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOMSnapshot#type-RareIntegerData
+type RareIntegerData = types.DOMSnapshotRareIntegerData
+
+// Rectangle is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOMSnapshot#type-Rectangle
+type Rectangle = types.DOMSnapshotRectangle
+
+// DocumentSnapshot document snapshot.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOMSnapshot#type-DocumentSnapshot
+type DocumentSnapshot = types.DOMSnapshotDocumentSnapshot
+
+// NodeTreeSnapshot table containing nodes.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOMSnapshot#type-NodeTreeSnapshot
+type NodeTreeSnapshot = types.DOMSnapshotNodeTreeSnapshot
+
+// LayoutTreeSnapshot table of details of an element in the DOM tree with a
+// LayoutObject.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOMSnapshot#type-LayoutTreeSnapshot
+type LayoutTreeSnapshot = types.DOMSnapshotLayoutTreeSnapshot
+
+// TextBoxSnapshot table of details of the post layout rendered text positions.
+// The exact layout should not be regarded as stable and may change between
+// versions.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOMSnapshot#type-TextBoxSnapshot
+type TextBoxSnapshot = types.DOMSnapshotTextBoxSnapshot
+
+// Method names of the commands in the DOMSnapshot domain.
+const (
+	CommandDisable         = "DOMSnapshot.disable"
+	CommandEnable          = "DOMSnapshot.enable"
+	CommandGetSnapshot     = "DOMSnapshot.getSnapshot"
+	CommandCaptureSnapshot = "DOMSnapshot.captureSnapshot"
+)
+
+// DisableParams are the parameters of DOMSnapshot.disable.
+//
+// Disables DOM snapshot agent for the given page.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOMSnapshot#method-disable
+type DisableParams struct {
+}
+
+// Command returns the DOMSnapshot.disable command for use with
+// AsyncDebugger.Send.
+func (p DisableParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandDisable, p)
+}
+
+// Do sends DOMSnapshot.disable to d and waits for it to complete or for ctx to
+// be done.
+func (p DisableParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandDisable, p, nil)
+}
+
+// EnableParams are the parameters of DOMSnapshot.enable.
+//
+// Enables DOM snapshot agent for the given page.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOMSnapshot#method-enable
+type EnableParams struct {
+}
+
+// Command returns the DOMSnapshot.enable command for use with
+// AsyncDebugger.Send.
+func (p EnableParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandEnable, p)
+}
+
+// Do sends DOMSnapshot.enable to d and waits for it to complete or for ctx to
+// be done.
+func (p EnableParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandEnable, p, nil)
+}
+
+// GetSnapshotParams are the parameters of DOMSnapshot.getSnapshot.
+//
+// Returns a document snapshot, including the full DOM tree of the root node
+// (including iframes, template contents, and imported documents) in a flattened
+// array, as well as layout and white-listed computed style information for the
+// nodes. Shadow DOM in the returned DOM tree is flattened.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOMSnapshot#method-getSnapshot
+//
+// Deprecated: this is deprecated in the protocol.
+type GetSnapshotParams struct {
+	// Whitelist of computed styles to return.
+	ComputedStyleWhitelist []string `json:"computedStyleWhitelist"`
+	// Whether or not to retrieve details of DOM listeners (default false).
+	IncludeEventListeners bool `json:"includeEventListeners,omitempty"`
+	// Whether to determine and include the paint order index of LayoutTreeNodes
+	// (default false).
+	IncludePaintOrder bool `json:"includePaintOrder,omitempty"`
+	// Whether to include UA shadow tree in the snapshot (default false).
+	IncludeUserAgentShadowTree bool `json:"includeUserAgentShadowTree,omitempty"`
+}
+
+// Command returns the DOMSnapshot.getSnapshot command for use with
+// AsyncDebugger.Send.
+func (p GetSnapshotParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandGetSnapshot, p)
+}
+
+// GetSnapshotReturns are the values returned by DOMSnapshot.getSnapshot.
+type GetSnapshotReturns struct {
+	// The nodes in the DOM tree. The DOMNode at index 0 corresponds to the root
+	// document.
+	DOMNodes []DOMNode `json:"domNodes"`
+	// The nodes in the layout tree.
+	LayoutTreeNodes []LayoutTreeNode `json:"layoutTreeNodes"`
+	// Whitelisted ComputedStyle properties for each node in the layout tree.
+	ComputedStyles []ComputedStyle `json:"computedStyles"`
+}
+
+// Do sends DOMSnapshot.getSnapshot to d and waits for its result or for ctx to be
+// done.
+func (p GetSnapshotParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*GetSnapshotReturns, error) {
+	ret := &GetSnapshotReturns{}
+	if err := protocol.Do(ctx, d, CommandGetSnapshot, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// CaptureSnapshotParams are the parameters of DOMSnapshot.captureSnapshot.
+//
+// Returns a document snapshot, including the full DOM tree of the root node
+// (including iframes, template contents, and imported documents) in a flattened
+// array, as well as layout and white-listed computed style information for the
+// nodes. Shadow DOM in the returned DOM tree is flattened.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOMSnapshot#method-captureSnapshot
+type CaptureSnapshotParams struct {
+	// Whitelist of computed styles to return.
+	ComputedStyles []string `json:"computedStyles"`
+	// Whether to include layout object paint orders into the snapshot.
+	IncludePaintOrder bool `json:"includePaintOrder,omitempty"`
+	// Whether to include DOM rectangles (offsetRects, clientRects, scrollRects)
+	// into the snapshot
+	IncludeDOMRects bool `json:"includeDOMRects,omitempty"`
+	// Whether to include blended background colors in the snapshot (default:
+	// false). Blended background color is achieved by blending background colors
+	// of all elements that overlap with the current element.
+	//
+	// This is an experimental part of the protocol.
+	IncludeBlendedBackgroundColors bool `json:"includeBlendedBackgroundColors,omitempty"`
+	// Whether to include text color opacity in the snapshot (default: false). An
+	// element might have the opacity property set that affects the text color of
+	// the element. The final text color opacity is computed based on the opacity
+	// of all overlapping elements.
+	//
+	// This is an experimental part of the protocol.
+	IncludeTextColorOpacities bool `json:"includeTextColorOpacities,omitempty"`
+}
+
+// Command returns the DOMSnapshot.captureSnapshot command for use with
+// AsyncDebugger.Send.
+func (p CaptureSnapshotParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandCaptureSnapshot, p)
+}
+
+// CaptureSnapshotReturns are the values returned by DOMSnapshot.captureSnapshot.
+type CaptureSnapshotReturns struct {
+	// The nodes in the DOM tree. The DOMNode at index 0 corresponds to the root
+	// document.
+	Documents []DocumentSnapshot `json:"documents"`
+	// Shared string table that all string properties refer to with indexes.
+	Strings []string `json:"strings"`
+}
+
+// Do sends DOMSnapshot.captureSnapshot to d and waits for its result or for ctx to be
+// done.
+func (p CaptureSnapshotParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*CaptureSnapshotReturns, error) {
+	ret := &CaptureSnapshotReturns{}
+	if err := protocol.Do(ctx, d, CommandCaptureSnapshot, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
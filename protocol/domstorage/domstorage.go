@@ -0,0 +1,242 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+// Package domstorage provides typed bindings for the DOMStorage domain of the
+// Chrome DevTools protocol.
+//
+// Query and modify DOM storage.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOMStorage
+//
+// This is an experimental part of the protocol.
+package domstorage
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tonyhb/chromedebugo"
+	"github.com/tonyhb/chromedebugo/protocol"
+	"github.com/tonyhb/chromedebugo/protocol/internal/types"
+)
+
+// SerializedStorageKey is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOMStorage#type-SerializedStorageKey
+type SerializedStorageKey = types.DOMStorageSerializedStorageKey
+
+// StorageID DOM Storage identifier.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOMStorage#type-StorageId
+type StorageID = types.DOMStorageStorageID
+
+// Item DOM Storage item.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOMStorage#type-Item
+type Item = types.DOMStorageItem
+
+// Method names of the commands in the DOMStorage domain.
+const (
+	CommandClear                = "DOMStorage.clear"
+	CommandDisable              = "DOMStorage.disable"
+	CommandEnable               = "DOMStorage.enable"
+	CommandGetDOMStorageItems   = "DOMStorage.getDOMStorageItems"
+	CommandRemoveDOMStorageItem = "DOMStorage.removeDOMStorageItem"
+	CommandSetDOMStorageItem    = "DOMStorage.setDOMStorageItem"
+)
+
+// ClearParams are the parameters of DOMStorage.clear.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOMStorage#method-clear
+type ClearParams struct {
+	StorageID StorageID `json:"storageId"`
+}
+
+// Command returns the DOMStorage.clear command for use with AsyncDebugger.Send.
+func (p ClearParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandClear, p)
+}
+
+// Do sends DOMStorage.clear to d and waits for it to complete or for ctx to
+// be done.
+func (p ClearParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandClear, p, nil)
+}
+
+// DisableParams are the parameters of DOMStorage.disable.
+//
+// Disables storage tracking, prevents storage events from being sent to the
+// client.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOMStorage#method-disable
+type DisableParams struct {
+}
+
+// Command returns the DOMStorage.disable command for use with
+// AsyncDebugger.Send.
+func (p DisableParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandDisable, p)
+}
+
+// Do sends DOMStorage.disable to d and waits for it to complete or for ctx to
+// be done.
+func (p DisableParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandDisable, p, nil)
+}
+
+// EnableParams are the parameters of DOMStorage.enable.
+//
+// Enables storage tracking, storage events will now be delivered to the client.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOMStorage#method-enable
+type EnableParams struct {
+}
+
+// Command returns the DOMStorage.enable command for use with
+// AsyncDebugger.Send.
+func (p EnableParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandEnable, p)
+}
+
+// Do sends DOMStorage.enable to d and waits for it to complete or for ctx to
+// be done.
+func (p EnableParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandEnable, p, nil)
+}
+
+// GetDOMStorageItemsParams are the parameters of DOMStorage.getDOMStorageItems.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOMStorage#method-getDOMStorageItems
+type GetDOMStorageItemsParams struct {
+	StorageID StorageID `json:"storageId"`
+}
+
+// Command returns the DOMStorage.getDOMStorageItems command for use with
+// AsyncDebugger.Send.
+func (p GetDOMStorageItemsParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandGetDOMStorageItems, p)
+}
+
+// GetDOMStorageItemsReturns are the values returned by DOMStorage.getDOMStorageItems.
+type GetDOMStorageItemsReturns struct {
+	Entries []Item `json:"entries"`
+}
+
+// Do sends DOMStorage.getDOMStorageItems to d and waits for its result or for ctx to be
+// done.
+func (p GetDOMStorageItemsParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*GetDOMStorageItemsReturns, error) {
+	ret := &GetDOMStorageItemsReturns{}
+	if err := protocol.Do(ctx, d, CommandGetDOMStorageItems, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// RemoveDOMStorageItemParams are the parameters of
+// DOMStorage.removeDOMStorageItem.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOMStorage#method-removeDOMStorageItem
+type RemoveDOMStorageItemParams struct {
+	StorageID StorageID `json:"storageId"`
+	Key       string    `json:"key"`
+}
+
+// Command returns the DOMStorage.removeDOMStorageItem command for use with
+// AsyncDebugger.Send.
+func (p RemoveDOMStorageItemParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandRemoveDOMStorageItem, p)
+}
+
+// Do sends DOMStorage.removeDOMStorageItem to d and waits for it to complete or for ctx to
+// be done.
+func (p RemoveDOMStorageItemParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandRemoveDOMStorageItem, p, nil)
+}
+
+// SetDOMStorageItemParams are the parameters of DOMStorage.setDOMStorageItem.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOMStorage#method-setDOMStorageItem
+type SetDOMStorageItemParams struct {
+	StorageID StorageID `json:"storageId"`
+	Key       string    `json:"key"`
+	Value     string    `json:"value"`
+}
+
+// Command returns the DOMStorage.setDOMStorageItem command for use with
+// AsyncDebugger.Send.
+func (p SetDOMStorageItemParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetDOMStorageItem, p)
+}
+
+// Do sends DOMStorage.setDOMStorageItem to d and waits for it to complete or for ctx to
+// be done.
+func (p SetDOMStorageItemParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetDOMStorageItem, p, nil)
+}
+
+// Method names of the events in the DOMStorage domain.
+const (
+	EventDOMStorageItemAdded    = "DOMStorage.domStorageItemAdded"
+	EventDOMStorageItemRemoved  = "DOMStorage.domStorageItemRemoved"
+	EventDOMStorageItemUpdated  = "DOMStorage.domStorageItemUpdated"
+	EventDOMStorageItemsCleared = "DOMStorage.domStorageItemsCleared"
+)
+
+// DOMStorageItemAddedEvent is the payload of the DOMStorage.domStorageItemAdded
+// event.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOMStorage#event-domStorageItemAdded
+type DOMStorageItemAddedEvent struct {
+	StorageID StorageID `json:"storageId"`
+	Key       string    `json:"key"`
+	NewValue  string    `json:"newValue"`
+}
+
+// DOMStorageItemRemovedEvent is the payload of the
+// DOMStorage.domStorageItemRemoved event.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOMStorage#event-domStorageItemRemoved
+type DOMStorageItemRemovedEvent struct {
+	StorageID StorageID `json:"storageId"`
+	Key       string    `json:"key"`
+}
+
+// DOMStorageItemUpdatedEvent is the payload of the
+// DOMStorage.domStorageItemUpdated event.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOMStorage#event-domStorageItemUpdated
+type DOMStorageItemUpdatedEvent struct {
+	StorageID StorageID `json:"storageId"`
+	Key       string    `json:"key"`
+	OldValue  string    `json:"oldValue"`
+	NewValue  string    `json:"newValue"`
+}
+
+// DOMStorageItemsClearedEvent is the payload of the
+// DOMStorage.domStorageItemsCleared event.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOMStorage#event-domStorageItemsCleared
+type DOMStorageItemsClearedEvent struct {
+	StorageID StorageID `json:"storageId"`
+}
+
+// ParseEvent decodes an event from the DOMStorage domain, as received from
+// Subscribe, into a pointer to its typed payload.
+func ParseEvent(cmd chromedebugo.Command) (interface{}, error) {
+	var ev interface{}
+	switch cmd.Method {
+	case EventDOMStorageItemAdded:
+		ev = &DOMStorageItemAddedEvent{}
+	case EventDOMStorageItemRemoved:
+		ev = &DOMStorageItemRemovedEvent{}
+	case EventDOMStorageItemUpdated:
+		ev = &DOMStorageItemUpdatedEvent{}
+	case EventDOMStorageItemsCleared:
+		ev = &DOMStorageItemsClearedEvent{}
+	default:
+		return nil, fmt.Errorf("unknown DOMStorage event: %s", cmd.Method)
+	}
+	if err := cmd.Decode(ev); err != nil {
+		return nil, err
+	}
+	return ev, nil
+}
@@ -0,0 +1,1431 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+// Package emulation provides typed bindings for the Emulation domain of the
+// Chrome DevTools protocol.
+//
+// This domain emulates different environments for the page.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Emulation
+package emulation
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tonyhb/chromedebugo"
+	"github.com/tonyhb/chromedebugo/protocol"
+	"github.com/tonyhb/chromedebugo/protocol/internal/types"
+)
+
+// SafeAreaInsets is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Emulation#type-SafeAreaInsets
+//
+// This is an experimental part of the protocol.
+type SafeAreaInsets = types.EmulationSafeAreaInsets
+
+// ScreenOrientation screen orientation.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Emulation#type-ScreenOrientation
+type ScreenOrientation = types.EmulationScreenOrientation
+
+// DisplayFeature is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Emulation#type-DisplayFeature
+type DisplayFeature = types.EmulationDisplayFeature
+
+// DevicePosture is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Emulation#type-DevicePosture
+type DevicePosture = types.EmulationDevicePosture
+
+// MediaFeature is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Emulation#type-MediaFeature
+type MediaFeature = types.EmulationMediaFeature
+
+// VirtualTimePolicy advance: If the scheduler runs out of immediate work, the
+// virtual time base may fast forward to allow the next delayed task (if any) to
+// run; pause: The virtual time base may not advance;
+// pauseIfNetworkFetchesPending: The virtual time base may not advance if there
+// are any pending resource fetches.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Emulation#type-VirtualTimePolicy
+//
+// This is an experimental part of the protocol.
+type VirtualTimePolicy = types.EmulationVirtualTimePolicy
+
+// UserAgentBrandVersion used to specify User Agent Client Hints to emulate. See
+// https://wicg.github.io/ua-client-hints
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Emulation#type-UserAgentBrandVersion
+//
+// This is an experimental part of the protocol.
+type UserAgentBrandVersion = types.EmulationUserAgentBrandVersion
+
+// UserAgentMetadata used to specify User Agent Client Hints to emulate. See
+// https://wicg.github.io/ua-client-hints Missing optional values will be filled
+// in by the target with what it would normally use.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Emulation#type-UserAgentMetadata
+//
+// This is an experimental part of the protocol.
+type UserAgentMetadata = types.EmulationUserAgentMetadata
+
+// SensorType used to specify sensor types to emulate. See
+// https://w3c.github.io/sensors/#automation for more information.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Emulation#type-SensorType
+//
+// This is an experimental part of the protocol.
+type SensorType = types.EmulationSensorType
+
+// SensorMetadata is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Emulation#type-SensorMetadata
+//
+// This is an experimental part of the protocol.
+type SensorMetadata = types.EmulationSensorMetadata
+
+// SensorReadingSingle is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Emulation#type-SensorReadingSingle
+//
+// This is an experimental part of the protocol.
+type SensorReadingSingle = types.EmulationSensorReadingSingle
+
+// SensorReadingXYZ is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Emulation#type-SensorReadingXYZ
+//
+// This is an experimental part of the protocol.
+type SensorReadingXYZ = types.EmulationSensorReadingXYZ
+
+// SensorReadingQuaternion is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Emulation#type-SensorReadingQuaternion
+//
+// This is an experimental part of the protocol.
+type SensorReadingQuaternion = types.EmulationSensorReadingQuaternion
+
+// SensorReading is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Emulation#type-SensorReading
+//
+// This is an experimental part of the protocol.
+type SensorReading = types.EmulationSensorReading
+
+// PressureSource is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Emulation#type-PressureSource
+//
+// This is an experimental part of the protocol.
+type PressureSource = types.EmulationPressureSource
+
+// PressureState is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Emulation#type-PressureState
+//
+// This is an experimental part of the protocol.
+type PressureState = types.EmulationPressureState
+
+// PressureMetadata is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Emulation#type-PressureMetadata
+//
+// This is an experimental part of the protocol.
+type PressureMetadata = types.EmulationPressureMetadata
+
+// DisabledImageType enum of image types that can be disabled.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Emulation#type-DisabledImageType
+//
+// This is an experimental part of the protocol.
+type DisabledImageType = types.EmulationDisabledImageType
+
+// Values of the enumerated types in the Emulation domain.
+const (
+	VirtualTimePolicyAdvance                      = types.EmulationVirtualTimePolicyAdvance
+	VirtualTimePolicyPause                        = types.EmulationVirtualTimePolicyPause
+	VirtualTimePolicyPauseIfNetworkFetchesPending = types.EmulationVirtualTimePolicyPauseIfNetworkFetchesPending
+	SensorTypeAbsoluteOrientation                 = types.EmulationSensorTypeAbsoluteOrientation
+	SensorTypeAccelerometer                       = types.EmulationSensorTypeAccelerometer
+	SensorTypeAmbientLight                        = types.EmulationSensorTypeAmbientLight
+	SensorTypeGravity                             = types.EmulationSensorTypeGravity
+	SensorTypeGyroscope                           = types.EmulationSensorTypeGyroscope
+	SensorTypeLinearAcceleration                  = types.EmulationSensorTypeLinearAcceleration
+	SensorTypeMagnetometer                        = types.EmulationSensorTypeMagnetometer
+	SensorTypeRelativeOrientation                 = types.EmulationSensorTypeRelativeOrientation
+	PressureSourceCPU                             = types.EmulationPressureSourceCPU
+	PressureStateNominal                          = types.EmulationPressureStateNominal
+	PressureStateFair                             = types.EmulationPressureStateFair
+	PressureStateSerious                          = types.EmulationPressureStateSerious
+	PressureStateCritical                         = types.EmulationPressureStateCritical
+	DisabledImageTypeAvif                         = types.EmulationDisabledImageTypeAvif
+	DisabledImageTypeWebp                         = types.EmulationDisabledImageTypeWebp
+)
+
+// Method names of the commands in the Emulation domain.
+const (
+	CommandCanEmulate                               = "Emulation.canEmulate"
+	CommandClearDeviceMetricsOverride               = "Emulation.clearDeviceMetricsOverride"
+	CommandClearGeolocationOverride                 = "Emulation.clearGeolocationOverride"
+	CommandResetPageScaleFactor                     = "Emulation.resetPageScaleFactor"
+	CommandSetFocusEmulationEnabled                 = "Emulation.setFocusEmulationEnabled"
+	CommandSetAutoDarkModeOverride                  = "Emulation.setAutoDarkModeOverride"
+	CommandSetCPUThrottlingRate                     = "Emulation.setCPUThrottlingRate"
+	CommandSetDefaultBackgroundColorOverride        = "Emulation.setDefaultBackgroundColorOverride"
+	CommandSetSafeAreaInsetsOverride                = "Emulation.setSafeAreaInsetsOverride"
+	CommandSetDeviceMetricsOverride                 = "Emulation.setDeviceMetricsOverride"
+	CommandSetDevicePostureOverride                 = "Emulation.setDevicePostureOverride"
+	CommandClearDevicePostureOverride               = "Emulation.clearDevicePostureOverride"
+	CommandSetDisplayFeaturesOverride               = "Emulation.setDisplayFeaturesOverride"
+	CommandClearDisplayFeaturesOverride             = "Emulation.clearDisplayFeaturesOverride"
+	CommandSetScrollbarsHidden                      = "Emulation.setScrollbarsHidden"
+	CommandSetDocumentCookieDisabled                = "Emulation.setDocumentCookieDisabled"
+	CommandSetEmitTouchEventsForMouse               = "Emulation.setEmitTouchEventsForMouse"
+	CommandSetEmulatedMedia                         = "Emulation.setEmulatedMedia"
+	CommandSetEmulatedVisionDeficiency              = "Emulation.setEmulatedVisionDeficiency"
+	CommandSetEmulatedOSTextScale                   = "Emulation.setEmulatedOSTextScale"
+	CommandSetGeolocationOverride                   = "Emulation.setGeolocationOverride"
+	CommandGetOverriddenSensorInformation           = "Emulation.getOverriddenSensorInformation"
+	CommandSetSensorOverrideEnabled                 = "Emulation.setSensorOverrideEnabled"
+	CommandSetSensorOverrideReadings                = "Emulation.setSensorOverrideReadings"
+	CommandSetPressureSourceOverrideEnabled         = "Emulation.setPressureSourceOverrideEnabled"
+	CommandSetPressureStateOverride                 = "Emulation.setPressureStateOverride"
+	CommandSetPressureDataOverride                  = "Emulation.setPressureDataOverride"
+	CommandSetIdleOverride                          = "Emulation.setIdleOverride"
+	CommandClearIdleOverride                        = "Emulation.clearIdleOverride"
+	CommandSetNavigatorOverrides                    = "Emulation.setNavigatorOverrides"
+	CommandSetPageScaleFactor                       = "Emulation.setPageScaleFactor"
+	CommandSetScriptExecutionDisabled               = "Emulation.setScriptExecutionDisabled"
+	CommandSetTouchEmulationEnabled                 = "Emulation.setTouchEmulationEnabled"
+	CommandSetVirtualTimePolicy                     = "Emulation.setVirtualTimePolicy"
+	CommandSetLocaleOverride                        = "Emulation.setLocaleOverride"
+	CommandSetTimezoneOverride                      = "Emulation.setTimezoneOverride"
+	CommandSetVisibleSize                           = "Emulation.setVisibleSize"
+	CommandSetDisabledImageTypes                    = "Emulation.setDisabledImageTypes"
+	CommandSetDataSaverOverride                     = "Emulation.setDataSaverOverride"
+	CommandSetHardwareConcurrencyOverride           = "Emulation.setHardwareConcurrencyOverride"
+	CommandSetUserAgentOverride                     = "Emulation.setUserAgentOverride"
+	CommandSetAutomationOverride                    = "Emulation.setAutomationOverride"
+	CommandSetSmallViewportHeightDifferenceOverride = "Emulation.setSmallViewportHeightDifferenceOverride"
+)
+
+// CanEmulateParams are the parameters of Emulation.canEmulate.
+//
+// Tells whether emulation is supported.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Emulation#method-canEmulate
+//
+// Deprecated: this is deprecated in the protocol.
+type CanEmulateParams struct {
+}
+
+// Command returns the Emulation.canEmulate command for use with
+// AsyncDebugger.Send.
+func (p CanEmulateParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandCanEmulate, p)
+}
+
+// CanEmulateReturns are the values returned by Emulation.canEmulate.
+type CanEmulateReturns struct {
+	// True if emulation is supported.
+	Result bool `json:"result"`
+}
+
+// Do sends Emulation.canEmulate to d and waits for its result or for ctx to be
+// done.
+func (p CanEmulateParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*CanEmulateReturns, error) {
+	ret := &CanEmulateReturns{}
+	if err := protocol.Do(ctx, d, CommandCanEmulate, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// ClearDeviceMetricsOverrideParams are the parameters of
+// Emulation.clearDeviceMetricsOverride.
+//
+// Clears the overridden device metrics.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Emulation#method-clearDeviceMetricsOverride
+type ClearDeviceMetricsOverrideParams struct {
+}
+
+// Command returns the Emulation.clearDeviceMetricsOverride command for use with
+// AsyncDebugger.Send.
+func (p ClearDeviceMetricsOverrideParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandClearDeviceMetricsOverride, p)
+}
+
+// Do sends Emulation.clearDeviceMetricsOverride to d and waits for it to complete or for ctx to
+// be done.
+func (p ClearDeviceMetricsOverrideParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandClearDeviceMetricsOverride, p, nil)
+}
+
+// ClearGeolocationOverrideParams are the parameters of
+// Emulation.clearGeolocationOverride.
+//
+// Clears the overridden Geolocation Position and Error.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Emulation#method-clearGeolocationOverride
+type ClearGeolocationOverrideParams struct {
+}
+
+// Command returns the Emulation.clearGeolocationOverride command for use with
+// AsyncDebugger.Send.
+func (p ClearGeolocationOverrideParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandClearGeolocationOverride, p)
+}
+
+// Do sends Emulation.clearGeolocationOverride to d and waits for it to complete or for ctx to
+// be done.
+func (p ClearGeolocationOverrideParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandClearGeolocationOverride, p, nil)
+}
+
+// ResetPageScaleFactorParams are the parameters of
+// Emulation.resetPageScaleFactor.
+//
+// Requests that page scale factor is reset to initial values.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Emulation#method-resetPageScaleFactor
+//
+// This is an experimental part of the protocol.
+type ResetPageScaleFactorParams struct {
+}
+
+// Command returns the Emulation.resetPageScaleFactor command for use with
+// AsyncDebugger.Send.
+func (p ResetPageScaleFactorParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandResetPageScaleFactor, p)
+}
+
+// Do sends Emulation.resetPageScaleFactor to d and waits for it to complete or for ctx to
+// be done.
+func (p ResetPageScaleFactorParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandResetPageScaleFactor, p, nil)
+}
+
+// SetFocusEmulationEnabledParams are the parameters of
+// Emulation.setFocusEmulationEnabled.
+//
+// Enables or disables simulating a focused and active page.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Emulation#method-setFocusEmulationEnabled
+//
+// This is an experimental part of the protocol.
+type SetFocusEmulationEnabledParams struct {
+	// Whether to enable to disable focus emulation.
+	Enabled bool `json:"enabled"`
+}
+
+// Command returns the Emulation.setFocusEmulationEnabled command for use with
+// AsyncDebugger.Send.
+func (p SetFocusEmulationEnabledParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetFocusEmulationEnabled, p)
+}
+
+// Do sends Emulation.setFocusEmulationEnabled to d and waits for it to complete or for ctx to
+// be done.
+func (p SetFocusEmulationEnabledParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetFocusEmulationEnabled, p, nil)
+}
+
+// SetAutoDarkModeOverrideParams are the parameters of
+// Emulation.setAutoDarkModeOverride.
+//
+// Automatically render all web contents using a dark theme.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Emulation#method-setAutoDarkModeOverride
+//
+// This is an experimental part of the protocol.
+type SetAutoDarkModeOverrideParams struct {
+	// Whether to enable or disable automatic dark mode. If not specified, any
+	// existing override will be cleared.
+	Enabled bool `json:"enabled,omitempty"`
+}
+
+// Command returns the Emulation.setAutoDarkModeOverride command for use with
+// AsyncDebugger.Send.
+func (p SetAutoDarkModeOverrideParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetAutoDarkModeOverride, p)
+}
+
+// Do sends Emulation.setAutoDarkModeOverride to d and waits for it to complete or for ctx to
+// be done.
+func (p SetAutoDarkModeOverrideParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetAutoDarkModeOverride, p, nil)
+}
+
+// SetCPUThrottlingRateParams are the parameters of
+// Emulation.setCPUThrottlingRate.
+//
+// Enables CPU throttling to emulate slow CPUs.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Emulation#method-setCPUThrottlingRate
+type SetCPUThrottlingRateParams struct {
+	// Throttling rate as a slowdown factor (1 is no throttle, 2 is 2x slowdown,
+	// etc).
+	Rate float64 `json:"rate"`
+}
+
+// Command returns the Emulation.setCPUThrottlingRate command for use with
+// AsyncDebugger.Send.
+func (p SetCPUThrottlingRateParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetCPUThrottlingRate, p)
+}
+
+// Do sends Emulation.setCPUThrottlingRate to d and waits for it to complete or for ctx to
+// be done.
+func (p SetCPUThrottlingRateParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetCPUThrottlingRate, p, nil)
+}
+
+// SetDefaultBackgroundColorOverrideParams are the parameters of
+// Emulation.setDefaultBackgroundColorOverride.
+//
+// Sets or clears an override of the default background color of the frame. This
+// override is used if the content does not specify one.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Emulation#method-setDefaultBackgroundColorOverride
+type SetDefaultBackgroundColorOverrideParams struct {
+	// RGBA of the default background color. If not specified, any existing
+	// override will be cleared.
+	Color *types.DOMRGBA `json:"color,omitempty"`
+}
+
+// Command returns the Emulation.setDefaultBackgroundColorOverride command for
+// use with AsyncDebugger.Send.
+func (p SetDefaultBackgroundColorOverrideParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetDefaultBackgroundColorOverride, p)
+}
+
+// Do sends Emulation.setDefaultBackgroundColorOverride to d and waits for it to complete or for ctx to
+// be done.
+func (p SetDefaultBackgroundColorOverrideParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetDefaultBackgroundColorOverride, p, nil)
+}
+
+// SetSafeAreaInsetsOverrideParams are the parameters of
+// Emulation.setSafeAreaInsetsOverride.
+//
+// Overrides the values for env(safe-area-inset-*) and
+// env(safe-area-max-inset-*). Unset values will cause the respective variables
+// to be undefined, even if previously overridden.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Emulation#method-setSafeAreaInsetsOverride
+//
+// This is an experimental part of the protocol.
+type SetSafeAreaInsetsOverrideParams struct {
+	Insets SafeAreaInsets `json:"insets"`
+}
+
+// Command returns the Emulation.setSafeAreaInsetsOverride command for use with
+// AsyncDebugger.Send.
+func (p SetSafeAreaInsetsOverrideParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetSafeAreaInsetsOverride, p)
+}
+
+// Do sends Emulation.setSafeAreaInsetsOverride to d and waits for it to complete or for ctx to
+// be done.
+func (p SetSafeAreaInsetsOverrideParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetSafeAreaInsetsOverride, p, nil)
+}
+
+// SetDeviceMetricsOverrideParams are the parameters of
+// Emulation.setDeviceMetricsOverride.
+//
+// Overrides the values of device screen dimensions (window.screen.width,
+// window.screen.height, window.innerWidth, window.innerHeight, and
+// "device-width"/"device-height"-related CSS media query results).
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Emulation#method-setDeviceMetricsOverride
+type SetDeviceMetricsOverrideParams struct {
+	// Overriding width value in pixels (minimum 0, maximum 10000000). 0 disables
+	// the override.
+	Width int64 `json:"width"`
+	// Overriding height value in pixels (minimum 0, maximum 10000000). 0 disables
+	// the override.
+	Height int64 `json:"height"`
+	// Overriding device scale factor value. 0 disables the override.
+	DeviceScaleFactor float64 `json:"deviceScaleFactor"`
+	// Whether to emulate mobile device. This includes viewport meta tag, overlay
+	// scrollbars, text autosizing and more.
+	Mobile bool `json:"mobile"`
+	// Scale to apply to resulting view image.
+	//
+	// This is an experimental part of the protocol.
+	Scale float64 `json:"scale,omitempty"`
+	// Overriding screen width value in pixels (minimum 0, maximum 10000000).
+	//
+	// This is an experimental part of the protocol.
+	ScreenWidth int64 `json:"screenWidth,omitempty"`
+	// Overriding screen height value in pixels (minimum 0, maximum 10000000).
+	//
+	// This is an experimental part of the protocol.
+	ScreenHeight int64 `json:"screenHeight,omitempty"`
+	// Overriding view X position on screen in pixels (minimum 0, maximum
+	// 10000000).
+	//
+	// This is an experimental part of the protocol.
+	PositionX int64 `json:"positionX,omitempty"`
+	// Overriding view Y position on screen in pixels (minimum 0, maximum
+	// 10000000).
+	//
+	// This is an experimental part of the protocol.
+	PositionY int64 `json:"positionY,omitempty"`
+	// Do not set visible view size, rely upon explicit setVisibleSize call.
+	//
+	// This is an experimental part of the protocol.
+	DontSetVisibleSize bool `json:"dontSetVisibleSize,omitempty"`
+	// Screen orientation override.
+	ScreenOrientation *ScreenOrientation `json:"screenOrientation,omitempty"`
+	// If set, the visible area of the page will be overridden to this viewport.
+	// This viewport change is not observed by the page, e.g. viewport-relative
+	// elements do not change positions.
+	//
+	// This is an experimental part of the protocol.
+	Viewport *types.PageViewport `json:"viewport,omitempty"`
+	// If set, the display feature of a multi-segment screen. If not set,
+	// multi-segment support is turned-off. Deprecated, use
+	// Emulation.setDisplayFeaturesOverride.
+	//
+	// This is an experimental part of the protocol.
+	//
+	// Deprecated: this is deprecated in the protocol.
+	DisplayFeature *DisplayFeature `json:"displayFeature,omitempty"`
+	// If set, the posture of a foldable device. If not set the posture is set to
+	// continuous. Deprecated, use Emulation.setDevicePostureOverride.
+	//
+	// This is an experimental part of the protocol.
+	//
+	// Deprecated: this is deprecated in the protocol.
+	DevicePosture *DevicePosture `json:"devicePosture,omitempty"`
+}
+
+// Command returns the Emulation.setDeviceMetricsOverride command for use with
+// AsyncDebugger.Send.
+func (p SetDeviceMetricsOverrideParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetDeviceMetricsOverride, p)
+}
+
+// Do sends Emulation.setDeviceMetricsOverride to d and waits for it to complete or for ctx to
+// be done.
+func (p SetDeviceMetricsOverrideParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetDeviceMetricsOverride, p, nil)
+}
+
+// SetDevicePostureOverrideParams are the parameters of
+// Emulation.setDevicePostureOverride.
+//
+// Start reporting the given posture value to the Device Posture API. This
+// override can also be set in setDeviceMetricsOverride().
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Emulation#method-setDevicePostureOverride
+//
+// This is an experimental part of the protocol.
+type SetDevicePostureOverrideParams struct {
+	Posture DevicePosture `json:"posture"`
+}
+
+// Command returns the Emulation.setDevicePostureOverride command for use with
+// AsyncDebugger.Send.
+func (p SetDevicePostureOverrideParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetDevicePostureOverride, p)
+}
+
+// Do sends Emulation.setDevicePostureOverride to d and waits for it to complete or for ctx to
+// be done.
+func (p SetDevicePostureOverrideParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetDevicePostureOverride, p, nil)
+}
+
+// ClearDevicePostureOverrideParams are the parameters of
+// Emulation.clearDevicePostureOverride.
+//
+// Clears a device posture override set with either setDeviceMetricsOverride()
+// or setDevicePostureOverride() and starts using posture information from the
+// platform again. Does nothing if no override is set.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Emulation#method-clearDevicePostureOverride
+//
+// This is an experimental part of the protocol.
+type ClearDevicePostureOverrideParams struct {
+}
+
+// Command returns the Emulation.clearDevicePostureOverride command for use with
+// AsyncDebugger.Send.
+func (p ClearDevicePostureOverrideParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandClearDevicePostureOverride, p)
+}
+
+// Do sends Emulation.clearDevicePostureOverride to d and waits for it to complete or for ctx to
+// be done.
+func (p ClearDevicePostureOverrideParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandClearDevicePostureOverride, p, nil)
+}
+
+// SetDisplayFeaturesOverrideParams are the parameters of
+// Emulation.setDisplayFeaturesOverride.
+//
+// Start using the given display features to pupulate the Viewport Segments API.
+// This override can also be set in setDeviceMetricsOverride().
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Emulation#method-setDisplayFeaturesOverride
+//
+// This is an experimental part of the protocol.
+type SetDisplayFeaturesOverrideParams struct {
+	Features []DisplayFeature `json:"features"`
+}
+
+// Command returns the Emulation.setDisplayFeaturesOverride command for use with
+// AsyncDebugger.Send.
+func (p SetDisplayFeaturesOverrideParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetDisplayFeaturesOverride, p)
+}
+
+// Do sends Emulation.setDisplayFeaturesOverride to d and waits for it to complete or for ctx to
+// be done.
+func (p SetDisplayFeaturesOverrideParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetDisplayFeaturesOverride, p, nil)
+}
+
+// ClearDisplayFeaturesOverrideParams are the parameters of
+// Emulation.clearDisplayFeaturesOverride.
+//
+// Clears the display features override set with either
+// setDeviceMetricsOverride() or setDisplayFeaturesOverride() and starts using
+// display features from the platform again. Does nothing if no override is set.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Emulation#method-clearDisplayFeaturesOverride
+//
+// This is an experimental part of the protocol.
+type ClearDisplayFeaturesOverrideParams struct {
+}
+
+// Command returns the Emulation.clearDisplayFeaturesOverride command for use
+// with AsyncDebugger.Send.
+func (p ClearDisplayFeaturesOverrideParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandClearDisplayFeaturesOverride, p)
+}
+
+// Do sends Emulation.clearDisplayFeaturesOverride to d and waits for it to complete or for ctx to
+// be done.
+func (p ClearDisplayFeaturesOverrideParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandClearDisplayFeaturesOverride, p, nil)
+}
+
+// SetScrollbarsHiddenParams are the parameters of
+// Emulation.setScrollbarsHidden.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Emulation#method-setScrollbarsHidden
+//
+// This is an experimental part of the protocol.
+type SetScrollbarsHiddenParams struct {
+	// Whether scrollbars should be always hidden.
+	Hidden bool `json:"hidden"`
+}
+
+// Command returns the Emulation.setScrollbarsHidden command for use with
+// AsyncDebugger.Send.
+func (p SetScrollbarsHiddenParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetScrollbarsHidden, p)
+}
+
+// Do sends Emulation.setScrollbarsHidden to d and waits for it to complete or for ctx to
+// be done.
+func (p SetScrollbarsHiddenParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetScrollbarsHidden, p, nil)
+}
+
+// SetDocumentCookieDisabledParams are the parameters of
+// Emulation.setDocumentCookieDisabled.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Emulation#method-setDocumentCookieDisabled
+//
+// This is an experimental part of the protocol.
+type SetDocumentCookieDisabledParams struct {
+	// Whether document.coookie API should be disabled.
+	Disabled bool `json:"disabled"`
+}
+
+// Command returns the Emulation.setDocumentCookieDisabled command for use with
+// AsyncDebugger.Send.
+func (p SetDocumentCookieDisabledParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetDocumentCookieDisabled, p)
+}
+
+// Do sends Emulation.setDocumentCookieDisabled to d and waits for it to complete or for ctx to
+// be done.
+func (p SetDocumentCookieDisabledParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetDocumentCookieDisabled, p, nil)
+}
+
+// SetEmitTouchEventsForMouseParams are the parameters of
+// Emulation.setEmitTouchEventsForMouse.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Emulation#method-setEmitTouchEventsForMouse
+//
+// This is an experimental part of the protocol.
+type SetEmitTouchEventsForMouseParams struct {
+	// Whether touch emulation based on mouse input should be enabled.
+	Enabled bool `json:"enabled"`
+	// Touch/gesture events configuration. Default: current platform.
+	//
+	// Allowed values: mobile, desktop.
+	Configuration string `json:"configuration,omitempty"`
+}
+
+// Command returns the Emulation.setEmitTouchEventsForMouse command for use with
+// AsyncDebugger.Send.
+func (p SetEmitTouchEventsForMouseParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetEmitTouchEventsForMouse, p)
+}
+
+// Do sends Emulation.setEmitTouchEventsForMouse to d and waits for it to complete or for ctx to
+// be done.
+func (p SetEmitTouchEventsForMouseParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetEmitTouchEventsForMouse, p, nil)
+}
+
+// SetEmulatedMediaParams are the parameters of Emulation.setEmulatedMedia.
+//
+// Emulates the given media type or media feature for CSS media queries.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Emulation#method-setEmulatedMedia
+type SetEmulatedMediaParams struct {
+	// Media type to emulate. Empty string disables the override.
+	Media string `json:"media,omitempty"`
+	// Media features to emulate.
+	Features []MediaFeature `json:"features,omitempty"`
+}
+
+// Command returns the Emulation.setEmulatedMedia command for use with
+// AsyncDebugger.Send.
+func (p SetEmulatedMediaParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetEmulatedMedia, p)
+}
+
+// Do sends Emulation.setEmulatedMedia to d and waits for it to complete or for ctx to
+// be done.
+func (p SetEmulatedMediaParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetEmulatedMedia, p, nil)
+}
+
+// SetEmulatedVisionDeficiencyParams are the parameters of
+// Emulation.setEmulatedVisionDeficiency.
+//
+// Emulates the given vision deficiency.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Emulation#method-setEmulatedVisionDeficiency
+type SetEmulatedVisionDeficiencyParams struct {
+	// Vision deficiency to emulate. Order: best-effort emulations come first,
+	// followed by any physiologically accurate emulations for medically recognized
+	// color vision deficiencies.
+	//
+	// Allowed values: none, blurredVision, reducedContrast, achromatopsia,
+	// deuteranopia, protanopia, tritanopia.
+	Type string `json:"type"`
+}
+
+// Command returns the Emulation.setEmulatedVisionDeficiency command for use
+// with AsyncDebugger.Send.
+func (p SetEmulatedVisionDeficiencyParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetEmulatedVisionDeficiency, p)
+}
+
+// Do sends Emulation.setEmulatedVisionDeficiency to d and waits for it to complete or for ctx to
+// be done.
+func (p SetEmulatedVisionDeficiencyParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetEmulatedVisionDeficiency, p, nil)
+}
+
+// SetEmulatedOSTextScaleParams are the parameters of
+// Emulation.setEmulatedOSTextScale.
+//
+// Emulates the given OS text scale.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Emulation#method-setEmulatedOSTextScale
+type SetEmulatedOSTextScaleParams struct {
+	Scale float64 `json:"scale,omitempty"`
+}
+
+// Command returns the Emulation.setEmulatedOSTextScale command for use with
+// AsyncDebugger.Send.
+func (p SetEmulatedOSTextScaleParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetEmulatedOSTextScale, p)
+}
+
+// Do sends Emulation.setEmulatedOSTextScale to d and waits for it to complete or for ctx to
+// be done.
+func (p SetEmulatedOSTextScaleParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetEmulatedOSTextScale, p, nil)
+}
+
+// SetGeolocationOverrideParams are the parameters of
+// Emulation.setGeolocationOverride.
+//
+// Overrides the Geolocation Position or Error. Omitting latitude, longitude or
+// accuracy emulates position unavailable.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Emulation#method-setGeolocationOverride
+type SetGeolocationOverrideParams struct {
+	// Mock latitude
+	Latitude float64 `json:"latitude,omitempty"`
+	// Mock longitude
+	Longitude float64 `json:"longitude,omitempty"`
+	// Mock accuracy
+	Accuracy float64 `json:"accuracy,omitempty"`
+	// Mock altitude
+	Altitude float64 `json:"altitude,omitempty"`
+	// Mock altitudeAccuracy
+	AltitudeAccuracy float64 `json:"altitudeAccuracy,omitempty"`
+	// Mock heading
+	Heading float64 `json:"heading,omitempty"`
+	// Mock speed
+	Speed float64 `json:"speed,omitempty"`
+}
+
+// Command returns the Emulation.setGeolocationOverride command for use with
+// AsyncDebugger.Send.
+func (p SetGeolocationOverrideParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetGeolocationOverride, p)
+}
+
+// Do sends Emulation.setGeolocationOverride to d and waits for it to complete or for ctx to
+// be done.
+func (p SetGeolocationOverrideParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetGeolocationOverride, p, nil)
+}
+
+// GetOverriddenSensorInformationParams are the parameters of
+// Emulation.getOverriddenSensorInformation.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Emulation#method-getOverriddenSensorInformation
+//
+// This is an experimental part of the protocol.
+type GetOverriddenSensorInformationParams struct {
+	Type SensorType `json:"type"`
+}
+
+// Command returns the Emulation.getOverriddenSensorInformation command for use
+// with AsyncDebugger.Send.
+func (p GetOverriddenSensorInformationParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandGetOverriddenSensorInformation, p)
+}
+
+// GetOverriddenSensorInformationReturns are the values returned by Emulation.getOverriddenSensorInformation.
+type GetOverriddenSensorInformationReturns struct {
+	RequestedSamplingFrequency float64 `json:"requestedSamplingFrequency"`
+}
+
+// Do sends Emulation.getOverriddenSensorInformation to d and waits for its result or for ctx to be
+// done.
+func (p GetOverriddenSensorInformationParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*GetOverriddenSensorInformationReturns, error) {
+	ret := &GetOverriddenSensorInformationReturns{}
+	if err := protocol.Do(ctx, d, CommandGetOverriddenSensorInformation, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// SetSensorOverrideEnabledParams are the parameters of
+// Emulation.setSensorOverrideEnabled.
+//
+// Overrides a platform sensor of a given type. If |enabled| is true, calls to
+// Sensor.start() will use a virtual sensor as backend rather than fetching data
+// from a real hardware sensor. Otherwise, existing virtual sensor-backend
+// Sensor objects will fire an error event and new calls to Sensor.start() will
+// attempt to use a real sensor instead.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Emulation#method-setSensorOverrideEnabled
+//
+// This is an experimental part of the protocol.
+type SetSensorOverrideEnabledParams struct {
+	Enabled  bool            `json:"enabled"`
+	Type     SensorType      `json:"type"`
+	Metadata *SensorMetadata `json:"metadata,omitempty"`
+}
+
+// Command returns the Emulation.setSensorOverrideEnabled command for use with
+// AsyncDebugger.Send.
+func (p SetSensorOverrideEnabledParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetSensorOverrideEnabled, p)
+}
+
+// Do sends Emulation.setSensorOverrideEnabled to d and waits for it to complete or for ctx to
+// be done.
+func (p SetSensorOverrideEnabledParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetSensorOverrideEnabled, p, nil)
+}
+
+// SetSensorOverrideReadingsParams are the parameters of
+// Emulation.setSensorOverrideReadings.
+//
+// Updates the sensor readings reported by a sensor type previously overridden
+// by setSensorOverrideEnabled.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Emulation#method-setSensorOverrideReadings
+//
+// This is an experimental part of the protocol.
+type SetSensorOverrideReadingsParams struct {
+	Type    SensorType    `json:"type"`
+	Reading SensorReading `json:"reading"`
+}
+
+// Command returns the Emulation.setSensorOverrideReadings command for use with
+// AsyncDebugger.Send.
+func (p SetSensorOverrideReadingsParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetSensorOverrideReadings, p)
+}
+
+// Do sends Emulation.setSensorOverrideReadings to d and waits for it to complete or for ctx to
+// be done.
+func (p SetSensorOverrideReadingsParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetSensorOverrideReadings, p, nil)
+}
+
+// SetPressureSourceOverrideEnabledParams are the parameters of
+// Emulation.setPressureSourceOverrideEnabled.
+//
+// Overrides a pressure source of a given type, as used by the Compute Pressure
+// API, so that updates to PressureObserver.observe() are provided via
+// setPressureStateOverride instead of being retrieved from platform-provided
+// telemetry data.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Emulation#method-setPressureSourceOverrideEnabled
+//
+// This is an experimental part of the protocol.
+type SetPressureSourceOverrideEnabledParams struct {
+	Enabled  bool              `json:"enabled"`
+	Source   PressureSource    `json:"source"`
+	Metadata *PressureMetadata `json:"metadata,omitempty"`
+}
+
+// Command returns the Emulation.setPressureSourceOverrideEnabled command for
+// use with AsyncDebugger.Send.
+func (p SetPressureSourceOverrideEnabledParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetPressureSourceOverrideEnabled, p)
+}
+
+// Do sends Emulation.setPressureSourceOverrideEnabled to d and waits for it to complete or for ctx to
+// be done.
+func (p SetPressureSourceOverrideEnabledParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetPressureSourceOverrideEnabled, p, nil)
+}
+
+// SetPressureStateOverrideParams are the parameters of
+// Emulation.setPressureStateOverride.
+//
+// TODO: OBSOLETE: To remove when setPressureDataOverride is merged. Provides a
+// given pressure state that will be processed and eventually be delivered to
+// PressureObserver users. |source| must have been previously overridden by
+// setPressureSourceOverrideEnabled.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Emulation#method-setPressureStateOverride
+//
+// This is an experimental part of the protocol.
+type SetPressureStateOverrideParams struct {
+	Source PressureSource `json:"source"`
+	State  PressureState  `json:"state"`
+}
+
+// Command returns the Emulation.setPressureStateOverride command for use with
+// AsyncDebugger.Send.
+func (p SetPressureStateOverrideParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetPressureStateOverride, p)
+}
+
+// Do sends Emulation.setPressureStateOverride to d and waits for it to complete or for ctx to
+// be done.
+func (p SetPressureStateOverrideParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetPressureStateOverride, p, nil)
+}
+
+// SetPressureDataOverrideParams are the parameters of
+// Emulation.setPressureDataOverride.
+//
+// Provides a given pressure data set that will be processed and eventually be
+// delivered to PressureObserver users. |source| must have been previously
+// overridden by setPressureSourceOverrideEnabled.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Emulation#method-setPressureDataOverride
+//
+// This is an experimental part of the protocol.
+type SetPressureDataOverrideParams struct {
+	Source                  PressureSource `json:"source"`
+	State                   PressureState  `json:"state"`
+	OwnContributionEstimate float64        `json:"ownContributionEstimate,omitempty"`
+}
+
+// Command returns the Emulation.setPressureDataOverride command for use with
+// AsyncDebugger.Send.
+func (p SetPressureDataOverrideParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetPressureDataOverride, p)
+}
+
+// Do sends Emulation.setPressureDataOverride to d and waits for it to complete or for ctx to
+// be done.
+func (p SetPressureDataOverrideParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetPressureDataOverride, p, nil)
+}
+
+// SetIdleOverrideParams are the parameters of Emulation.setIdleOverride.
+//
+// Overrides the Idle state.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Emulation#method-setIdleOverride
+type SetIdleOverrideParams struct {
+	// Mock isUserActive
+	IsUserActive bool `json:"isUserActive"`
+	// Mock isScreenUnlocked
+	IsScreenUnlocked bool `json:"isScreenUnlocked"`
+}
+
+// Command returns the Emulation.setIdleOverride command for use with
+// AsyncDebugger.Send.
+func (p SetIdleOverrideParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetIdleOverride, p)
+}
+
+// Do sends Emulation.setIdleOverride to d and waits for it to complete or for ctx to
+// be done.
+func (p SetIdleOverrideParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetIdleOverride, p, nil)
+}
+
+// ClearIdleOverrideParams are the parameters of Emulation.clearIdleOverride.
+//
+// Clears Idle state overrides.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Emulation#method-clearIdleOverride
+type ClearIdleOverrideParams struct {
+}
+
+// Command returns the Emulation.clearIdleOverride command for use with
+// AsyncDebugger.Send.
+func (p ClearIdleOverrideParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandClearIdleOverride, p)
+}
+
+// Do sends Emulation.clearIdleOverride to d and waits for it to complete or for ctx to
+// be done.
+func (p ClearIdleOverrideParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandClearIdleOverride, p, nil)
+}
+
+// SetNavigatorOverridesParams are the parameters of
+// Emulation.setNavigatorOverrides.
+//
+// Overrides value returned by the javascript navigator object.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Emulation#method-setNavigatorOverrides
+//
+// This is an experimental part of the protocol.
+//
+// Deprecated: this is deprecated in the protocol.
+type SetNavigatorOverridesParams struct {
+	// The platform navigator.platform should return.
+	Platform string `json:"platform"`
+}
+
+// Command returns the Emulation.setNavigatorOverrides command for use with
+// AsyncDebugger.Send.
+func (p SetNavigatorOverridesParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetNavigatorOverrides, p)
+}
+
+// Do sends Emulation.setNavigatorOverrides to d and waits for it to complete or for ctx to
+// be done.
+func (p SetNavigatorOverridesParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetNavigatorOverrides, p, nil)
+}
+
+// SetPageScaleFactorParams are the parameters of Emulation.setPageScaleFactor.
+//
+// Sets a specified page scale factor.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Emulation#method-setPageScaleFactor
+//
+// This is an experimental part of the protocol.
+type SetPageScaleFactorParams struct {
+	// Page scale factor.
+	PageScaleFactor float64 `json:"pageScaleFactor"`
+}
+
+// Command returns the Emulation.setPageScaleFactor command for use with
+// AsyncDebugger.Send.
+func (p SetPageScaleFactorParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetPageScaleFactor, p)
+}
+
+// Do sends Emulation.setPageScaleFactor to d and waits for it to complete or for ctx to
+// be done.
+func (p SetPageScaleFactorParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetPageScaleFactor, p, nil)
+}
+
+// SetScriptExecutionDisabledParams are the parameters of
+// Emulation.setScriptExecutionDisabled.
+//
+// Switches script execution in the page.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Emulation#method-setScriptExecutionDisabled
+type SetScriptExecutionDisabledParams struct {
+	// Whether script execution should be disabled in the page.
+	Value bool `json:"value"`
+}
+
+// Command returns the Emulation.setScriptExecutionDisabled command for use with
+// AsyncDebugger.Send.
+func (p SetScriptExecutionDisabledParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetScriptExecutionDisabled, p)
+}
+
+// Do sends Emulation.setScriptExecutionDisabled to d and waits for it to complete or for ctx to
+// be done.
+func (p SetScriptExecutionDisabledParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetScriptExecutionDisabled, p, nil)
+}
+
+// SetTouchEmulationEnabledParams are the parameters of
+// Emulation.setTouchEmulationEnabled.
+//
+// Enables touch on platforms which do not support them.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Emulation#method-setTouchEmulationEnabled
+type SetTouchEmulationEnabledParams struct {
+	// Whether the touch event emulation should be enabled.
+	Enabled bool `json:"enabled"`
+	// Maximum touch points supported. Defaults to one.
+	MaxTouchPoints int64 `json:"maxTouchPoints,omitempty"`
+}
+
+// Command returns the Emulation.setTouchEmulationEnabled command for use with
+// AsyncDebugger.Send.
+func (p SetTouchEmulationEnabledParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetTouchEmulationEnabled, p)
+}
+
+// Do sends Emulation.setTouchEmulationEnabled to d and waits for it to complete or for ctx to
+// be done.
+func (p SetTouchEmulationEnabledParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetTouchEmulationEnabled, p, nil)
+}
+
+// SetVirtualTimePolicyParams are the parameters of
+// Emulation.setVirtualTimePolicy.
+//
+// Turns on virtual time for all frames (replacing real-time with a synthetic
+// time source) and sets the current virtual time policy. Note this supersedes
+// any previous time budget.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Emulation#method-setVirtualTimePolicy
+//
+// This is an experimental part of the protocol.
+type SetVirtualTimePolicyParams struct {
+	Policy VirtualTimePolicy `json:"policy"`
+	// If set, after this many virtual milliseconds have elapsed virtual time will
+	// be paused and a virtualTimeBudgetExpired event is sent.
+	Budget float64 `json:"budget,omitempty"`
+	// If set this specifies the maximum number of tasks that can be run before
+	// virtual is forced forwards to prevent deadlock.
+	MaxVirtualTimeTaskStarvationCount int64 `json:"maxVirtualTimeTaskStarvationCount,omitempty"`
+	// If set, base::Time::Now will be overridden to initially return this value.
+	InitialVirtualTime types.NetworkTimeSinceEpoch `json:"initialVirtualTime,omitempty"`
+}
+
+// Command returns the Emulation.setVirtualTimePolicy command for use with
+// AsyncDebugger.Send.
+func (p SetVirtualTimePolicyParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetVirtualTimePolicy, p)
+}
+
+// SetVirtualTimePolicyReturns are the values returned by Emulation.setVirtualTimePolicy.
+type SetVirtualTimePolicyReturns struct {
+	// Absolute timestamp at which virtual time was first enabled (up time in
+	// milliseconds).
+	VirtualTimeTicksBase float64 `json:"virtualTimeTicksBase"`
+}
+
+// Do sends Emulation.setVirtualTimePolicy to d and waits for its result or for ctx to be
+// done.
+func (p SetVirtualTimePolicyParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*SetVirtualTimePolicyReturns, error) {
+	ret := &SetVirtualTimePolicyReturns{}
+	if err := protocol.Do(ctx, d, CommandSetVirtualTimePolicy, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// SetLocaleOverrideParams are the parameters of Emulation.setLocaleOverride.
+//
+// Overrides default host system locale with the specified one.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Emulation#method-setLocaleOverride
+//
+// This is an experimental part of the protocol.
+type SetLocaleOverrideParams struct {
+	// ICU style C locale (e.g. "en_US"). If not specified or empty, disables the
+	// override and restores default host system locale.
+	Locale string `json:"locale,omitempty"`
+}
+
+// Command returns the Emulation.setLocaleOverride command for use with
+// AsyncDebugger.Send.
+func (p SetLocaleOverrideParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetLocaleOverride, p)
+}
+
+// Do sends Emulation.setLocaleOverride to d and waits for it to complete or for ctx to
+// be done.
+func (p SetLocaleOverrideParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetLocaleOverride, p, nil)
+}
+
+// SetTimezoneOverrideParams are the parameters of
+// Emulation.setTimezoneOverride.
+//
+// Overrides default host system timezone with the specified one.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Emulation#method-setTimezoneOverride
+type SetTimezoneOverrideParams struct {
+	// The timezone identifier. List of supported timezones:
+	// https://source.chromium.org/chromium/chromium/deps/icu.git/+/faee8bc70570192d82d2978a71e2a615788597d1:source/data/misc/metaZones.txt
+	// If empty, disables the override and restores default host system timezone.
+	TimezoneID string `json:"timezoneId"`
+}
+
+// Command returns the Emulation.setTimezoneOverride command for use with
+// AsyncDebugger.Send.
+func (p SetTimezoneOverrideParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetTimezoneOverride, p)
+}
+
+// Do sends Emulation.setTimezoneOverride to d and waits for it to complete or for ctx to
+// be done.
+func (p SetTimezoneOverrideParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetTimezoneOverride, p, nil)
+}
+
+// SetVisibleSizeParams are the parameters of Emulation.setVisibleSize.
+//
+// Resizes the frame/viewport of the page. Note that this does not affect the
+// frame's container (e.g. browser window). Can be used to produce screenshots
+// of the specified size. Not supported on Android.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Emulation#method-setVisibleSize
+//
+// This is an experimental part of the protocol.
+//
+// Deprecated: this is deprecated in the protocol.
+type SetVisibleSizeParams struct {
+	// Frame width (DIP).
+	Width int64 `json:"width"`
+	// Frame height (DIP).
+	Height int64 `json:"height"`
+}
+
+// Command returns the Emulation.setVisibleSize command for use with
+// AsyncDebugger.Send.
+func (p SetVisibleSizeParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetVisibleSize, p)
+}
+
+// Do sends Emulation.setVisibleSize to d and waits for it to complete or for ctx to
+// be done.
+func (p SetVisibleSizeParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetVisibleSize, p, nil)
+}
+
+// SetDisabledImageTypesParams are the parameters of
+// Emulation.setDisabledImageTypes.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Emulation#method-setDisabledImageTypes
+//
+// This is an experimental part of the protocol.
+type SetDisabledImageTypesParams struct {
+	// Image types to disable.
+	ImageTypes []DisabledImageType `json:"imageTypes"`
+}
+
+// Command returns the Emulation.setDisabledImageTypes command for use with
+// AsyncDebugger.Send.
+func (p SetDisabledImageTypesParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetDisabledImageTypes, p)
+}
+
+// Do sends Emulation.setDisabledImageTypes to d and waits for it to complete or for ctx to
+// be done.
+func (p SetDisabledImageTypesParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetDisabledImageTypes, p, nil)
+}
+
+// SetDataSaverOverrideParams are the parameters of
+// Emulation.setDataSaverOverride.
+//
+// # Override the value of navigator.connection.saveData
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Emulation#method-setDataSaverOverride
+//
+// This is an experimental part of the protocol.
+type SetDataSaverOverrideParams struct {
+	// Override value. Omitting the parameter disables the override.
+	DataSaverEnabled bool `json:"dataSaverEnabled,omitempty"`
+}
+
+// Command returns the Emulation.setDataSaverOverride command for use with
+// AsyncDebugger.Send.
+func (p SetDataSaverOverrideParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetDataSaverOverride, p)
+}
+
+// Do sends Emulation.setDataSaverOverride to d and waits for it to complete or for ctx to
+// be done.
+func (p SetDataSaverOverrideParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetDataSaverOverride, p, nil)
+}
+
+// SetHardwareConcurrencyOverrideParams are the parameters of
+// Emulation.setHardwareConcurrencyOverride.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Emulation#method-setHardwareConcurrencyOverride
+//
+// This is an experimental part of the protocol.
+type SetHardwareConcurrencyOverrideParams struct {
+	// Hardware concurrency to report
+	HardwareConcurrency int64 `json:"hardwareConcurrency"`
+}
+
+// Command returns the Emulation.setHardwareConcurrencyOverride command for use
+// with AsyncDebugger.Send.
+func (p SetHardwareConcurrencyOverrideParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetHardwareConcurrencyOverride, p)
+}
+
+// Do sends Emulation.setHardwareConcurrencyOverride to d and waits for it to complete or for ctx to
+// be done.
+func (p SetHardwareConcurrencyOverrideParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetHardwareConcurrencyOverride, p, nil)
+}
+
+// SetUserAgentOverrideParams are the parameters of
+// Emulation.setUserAgentOverride.
+//
+// Allows overriding user agent with the given string. `userAgentMetadata` must
+// be set for Client Hint headers to be sent.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Emulation#method-setUserAgentOverride
+type SetUserAgentOverrideParams struct {
+	// User agent to use.
+	UserAgent string `json:"userAgent"`
+	// Browser language to emulate.
+	AcceptLanguage string `json:"acceptLanguage,omitempty"`
+	// The platform navigator.platform should return.
+	Platform string `json:"platform,omitempty"`
+	// To be sent in Sec-CH-UA-* headers and returned in navigator.userAgentData
+	//
+	// This is an experimental part of the protocol.
+	UserAgentMetadata *UserAgentMetadata `json:"userAgentMetadata,omitempty"`
+}
+
+// Command returns the Emulation.setUserAgentOverride command for use with
+// AsyncDebugger.Send.
+func (p SetUserAgentOverrideParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetUserAgentOverride, p)
+}
+
+// Do sends Emulation.setUserAgentOverride to d and waits for it to complete or for ctx to
+// be done.
+func (p SetUserAgentOverrideParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetUserAgentOverride, p, nil)
+}
+
+// SetAutomationOverrideParams are the parameters of
+// Emulation.setAutomationOverride.
+//
+// Allows overriding the automation flag.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Emulation#method-setAutomationOverride
+//
+// This is an experimental part of the protocol.
+type SetAutomationOverrideParams struct {
+	// Whether the override should be enabled.
+	Enabled bool `json:"enabled"`
+}
+
+// Command returns the Emulation.setAutomationOverride command for use with
+// AsyncDebugger.Send.
+func (p SetAutomationOverrideParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetAutomationOverride, p)
+}
+
+// Do sends Emulation.setAutomationOverride to d and waits for it to complete or for ctx to
+// be done.
+func (p SetAutomationOverrideParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetAutomationOverride, p, nil)
+}
+
+// SetSmallViewportHeightDifferenceOverrideParams are the parameters of
+// Emulation.setSmallViewportHeightDifferenceOverride.
+//
+// Allows overriding the difference between the small and large viewport sizes,
+// which determine the value of the `svh` and `lvh` unit, respectively. Only
+// supported for top-level frames.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Emulation#method-setSmallViewportHeightDifferenceOverride
+//
+// This is an experimental part of the protocol.
+type SetSmallViewportHeightDifferenceOverrideParams struct {
+	// This will cause an element of size 100svh to be `difference` pixels smaller
+	// than an element of size 100lvh.
+	Difference int64 `json:"difference"`
+}
+
+// Command returns the Emulation.setSmallViewportHeightDifferenceOverride
+// command for use with AsyncDebugger.Send.
+func (p SetSmallViewportHeightDifferenceOverrideParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetSmallViewportHeightDifferenceOverride, p)
+}
+
+// Do sends Emulation.setSmallViewportHeightDifferenceOverride to d and waits for it to complete or for ctx to
+// be done.
+func (p SetSmallViewportHeightDifferenceOverrideParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetSmallViewportHeightDifferenceOverride, p, nil)
+}
+
+// Method names of the events in the Emulation domain.
+const (
+	EventVirtualTimeBudgetExpired = "Emulation.virtualTimeBudgetExpired"
+)
+
+// VirtualTimeBudgetExpiredEvent is the payload of the
+// Emulation.virtualTimeBudgetExpired event.
+//
+// Notification sent after the virtual time budget for the current
+// VirtualTimePolicy has run out.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Emulation#event-virtualTimeBudgetExpired
+//
+// This is an experimental part of the protocol.
+type VirtualTimeBudgetExpiredEvent struct {
+}
+
+// ParseEvent decodes an event from the Emulation domain, as received from
+// Subscribe, into a pointer to its typed payload.
+func ParseEvent(cmd chromedebugo.Command) (interface{}, error) {
+	var ev interface{}
+	switch cmd.Method {
+	case EventVirtualTimeBudgetExpired:
+		ev = &VirtualTimeBudgetExpiredEvent{}
+	default:
+		return nil, fmt.Errorf("unknown Emulation event: %s", cmd.Method)
+	}
+	if err := cmd.Decode(ev); err != nil {
+		return nil, err
+	}
+	return ev, nil
+}
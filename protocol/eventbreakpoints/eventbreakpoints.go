@@ -0,0 +1,94 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+// Package eventbreakpoints provides typed bindings for the EventBreakpoints
+// domain of the Chrome DevTools protocol.
+//
+// EventBreakpoints permits setting JavaScript breakpoints on operations and
+// events occurring in native code invoked from JavaScript. Once breakpoint is
+// hit, it is reported through Debugger domain, similarly to regular breakpoints
+// being hit.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/EventBreakpoints
+//
+// This is an experimental part of the protocol.
+package eventbreakpoints
+
+import (
+	"context"
+
+	"github.com/tonyhb/chromedebugo"
+	"github.com/tonyhb/chromedebugo/protocol"
+)
+
+// Method names of the commands in the EventBreakpoints domain.
+const (
+	CommandSetInstrumentationBreakpoint    = "EventBreakpoints.setInstrumentationBreakpoint"
+	CommandRemoveInstrumentationBreakpoint = "EventBreakpoints.removeInstrumentationBreakpoint"
+	CommandDisable                         = "EventBreakpoints.disable"
+)
+
+// SetInstrumentationBreakpointParams are the parameters of
+// EventBreakpoints.setInstrumentationBreakpoint.
+//
+// Sets breakpoint on particular native event.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/EventBreakpoints#method-setInstrumentationBreakpoint
+type SetInstrumentationBreakpointParams struct {
+	// Instrumentation name to stop on.
+	EventName string `json:"eventName"`
+}
+
+// Command returns the EventBreakpoints.setInstrumentationBreakpoint command for
+// use with AsyncDebugger.Send.
+func (p SetInstrumentationBreakpointParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetInstrumentationBreakpoint, p)
+}
+
+// Do sends EventBreakpoints.setInstrumentationBreakpoint to d and waits for it to complete or for ctx to
+// be done.
+func (p SetInstrumentationBreakpointParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetInstrumentationBreakpoint, p, nil)
+}
+
+// RemoveInstrumentationBreakpointParams are the parameters of
+// EventBreakpoints.removeInstrumentationBreakpoint.
+//
+// Removes breakpoint on particular native event.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/EventBreakpoints#method-removeInstrumentationBreakpoint
+type RemoveInstrumentationBreakpointParams struct {
+	// Instrumentation name to stop on.
+	EventName string `json:"eventName"`
+}
+
+// Command returns the EventBreakpoints.removeInstrumentationBreakpoint command
+// for use with AsyncDebugger.Send.
+func (p RemoveInstrumentationBreakpointParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandRemoveInstrumentationBreakpoint, p)
+}
+
+// Do sends EventBreakpoints.removeInstrumentationBreakpoint to d and waits for it to complete or for ctx to
+// be done.
+func (p RemoveInstrumentationBreakpointParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandRemoveInstrumentationBreakpoint, p, nil)
+}
+
+// DisableParams are the parameters of EventBreakpoints.disable.
+//
+// # Removes all breakpoints
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/EventBreakpoints#method-disable
+type DisableParams struct {
+}
+
+// Command returns the EventBreakpoints.disable command for use with
+// AsyncDebugger.Send.
+func (p DisableParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandDisable, p)
+}
+
+// Do sends EventBreakpoints.disable to d and waits for it to complete or for ctx to
+// be done.
+func (p DisableParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandDisable, p, nil)
+}
@@ -0,0 +1,215 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+// Package extensions provides typed bindings for the Extensions domain of the
+// Chrome DevTools protocol.
+//
+// Defines commands and events for browser extensions.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Extensions
+//
+// This is an experimental part of the protocol.
+package extensions
+
+import (
+	"context"
+
+	"github.com/tonyhb/chromedebugo"
+	"github.com/tonyhb/chromedebugo/protocol"
+	"github.com/tonyhb/chromedebugo/protocol/internal/types"
+)
+
+// StorageArea storage areas.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Extensions#type-StorageArea
+type StorageArea = types.ExtensionsStorageArea
+
+// Values of the enumerated types in the Extensions domain.
+const (
+	StorageAreaSession = types.ExtensionsStorageAreaSession
+	StorageAreaLocal   = types.ExtensionsStorageAreaLocal
+	StorageAreaSync    = types.ExtensionsStorageAreaSync
+	StorageAreaManaged = types.ExtensionsStorageAreaManaged
+)
+
+// Method names of the commands in the Extensions domain.
+const (
+	CommandLoadUnpacked       = "Extensions.loadUnpacked"
+	CommandUninstall          = "Extensions.uninstall"
+	CommandGetStorageItems    = "Extensions.getStorageItems"
+	CommandRemoveStorageItems = "Extensions.removeStorageItems"
+	CommandClearStorageItems  = "Extensions.clearStorageItems"
+	CommandSetStorageItems    = "Extensions.setStorageItems"
+)
+
+// LoadUnpackedParams are the parameters of Extensions.loadUnpacked.
+//
+// Installs an unpacked extension from the filesystem similar to
+// --load-extension CLI flags. Returns extension ID once the extension has been
+// installed. Available if the client is connected using the
+// --remote-debugging-pipe flag and the --enable-unsafe-extension-debugging flag
+// is set.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Extensions#method-loadUnpacked
+type LoadUnpackedParams struct {
+	// Absolute file path.
+	Path string `json:"path"`
+}
+
+// Command returns the Extensions.loadUnpacked command for use with
+// AsyncDebugger.Send.
+func (p LoadUnpackedParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandLoadUnpacked, p)
+}
+
+// LoadUnpackedReturns are the values returned by Extensions.loadUnpacked.
+type LoadUnpackedReturns struct {
+	// Extension id.
+	ID string `json:"id"`
+}
+
+// Do sends Extensions.loadUnpacked to d and waits for its result or for ctx to be
+// done.
+func (p LoadUnpackedParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*LoadUnpackedReturns, error) {
+	ret := &LoadUnpackedReturns{}
+	if err := protocol.Do(ctx, d, CommandLoadUnpacked, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// UninstallParams are the parameters of Extensions.uninstall.
+//
+// Uninstalls an unpacked extension (others not supported) from the profile.
+// Available if the client is connected using the --remote-debugging-pipe flag
+// and the --enable-unsafe-extension-debugging.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Extensions#method-uninstall
+type UninstallParams struct {
+	// Extension id.
+	ID string `json:"id"`
+}
+
+// Command returns the Extensions.uninstall command for use with
+// AsyncDebugger.Send.
+func (p UninstallParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandUninstall, p)
+}
+
+// Do sends Extensions.uninstall to d and waits for it to complete or for ctx to
+// be done.
+func (p UninstallParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandUninstall, p, nil)
+}
+
+// GetStorageItemsParams are the parameters of Extensions.getStorageItems.
+//
+// Gets data from extension storage in the given `storageArea`. If `keys` is
+// specified, these are used to filter the result.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Extensions#method-getStorageItems
+type GetStorageItemsParams struct {
+	// ID of extension.
+	ID string `json:"id"`
+	// StorageArea to retrieve data from.
+	StorageArea StorageArea `json:"storageArea"`
+	// Keys to retrieve.
+	Keys []string `json:"keys,omitempty"`
+}
+
+// Command returns the Extensions.getStorageItems command for use with
+// AsyncDebugger.Send.
+func (p GetStorageItemsParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandGetStorageItems, p)
+}
+
+// GetStorageItemsReturns are the values returned by Extensions.getStorageItems.
+type GetStorageItemsReturns struct {
+	Data map[string]interface{} `json:"data"`
+}
+
+// Do sends Extensions.getStorageItems to d and waits for its result or for ctx to be
+// done.
+func (p GetStorageItemsParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*GetStorageItemsReturns, error) {
+	ret := &GetStorageItemsReturns{}
+	if err := protocol.Do(ctx, d, CommandGetStorageItems, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// RemoveStorageItemsParams are the parameters of Extensions.removeStorageItems.
+//
+// Removes `keys` from extension storage in the given `storageArea`.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Extensions#method-removeStorageItems
+type RemoveStorageItemsParams struct {
+	// ID of extension.
+	ID string `json:"id"`
+	// StorageArea to remove data from.
+	StorageArea StorageArea `json:"storageArea"`
+	// Keys to remove.
+	Keys []string `json:"keys"`
+}
+
+// Command returns the Extensions.removeStorageItems command for use with
+// AsyncDebugger.Send.
+func (p RemoveStorageItemsParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandRemoveStorageItems, p)
+}
+
+// Do sends Extensions.removeStorageItems to d and waits for it to complete or for ctx to
+// be done.
+func (p RemoveStorageItemsParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandRemoveStorageItems, p, nil)
+}
+
+// ClearStorageItemsParams are the parameters of Extensions.clearStorageItems.
+//
+// Clears extension storage in the given `storageArea`.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Extensions#method-clearStorageItems
+type ClearStorageItemsParams struct {
+	// ID of extension.
+	ID string `json:"id"`
+	// StorageArea to remove data from.
+	StorageArea StorageArea `json:"storageArea"`
+}
+
+// Command returns the Extensions.clearStorageItems command for use with
+// AsyncDebugger.Send.
+func (p ClearStorageItemsParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandClearStorageItems, p)
+}
+
+// Do sends Extensions.clearStorageItems to d and waits for it to complete or for ctx to
+// be done.
+func (p ClearStorageItemsParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandClearStorageItems, p, nil)
+}
+
+// SetStorageItemsParams are the parameters of Extensions.setStorageItems.
+//
+// Sets `values` in extension storage in the given `storageArea`. The provided
+// `values` will be merged with existing values in the storage area.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Extensions#method-setStorageItems
+type SetStorageItemsParams struct {
+	// ID of extension.
+	ID string `json:"id"`
+	// StorageArea to set data in.
+	StorageArea StorageArea `json:"storageArea"`
+	// Values to set.
+	Values map[string]interface{} `json:"values"`
+}
+
+// Command returns the Extensions.setStorageItems command for use with
+// AsyncDebugger.Send.
+func (p SetStorageItemsParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetStorageItems, p)
+}
+
+// Do sends Extensions.setStorageItems to d and waits for it to complete or for ctx to
+// be done.
+func (p SetStorageItemsParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetStorageItems, p, nil)
+}
@@ -0,0 +1,258 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+// Package fedcm provides typed bindings for the FedCm domain of the Chrome
+// DevTools protocol.
+//
+// This domain allows interacting with the FedCM dialog.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/FedCm
+//
+// This is an experimental part of the protocol.
+package fedcm
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tonyhb/chromedebugo"
+	"github.com/tonyhb/chromedebugo/protocol"
+	"github.com/tonyhb/chromedebugo/protocol/internal/types"
+)
+
+// LoginState whether this is a sign-up or sign-in action for this account, i.e.
+// whether this account has ever been used to sign in to this RP before.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/FedCm#type-LoginState
+type LoginState = types.FedCmLoginState
+
+// DialogType the types of FedCM dialogs.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/FedCm#type-DialogType
+type DialogType = types.FedCmDialogType
+
+// DialogButton the buttons on the FedCM dialog.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/FedCm#type-DialogButton
+type DialogButton = types.FedCmDialogButton
+
+// AccountURLType the URLs that each account has
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/FedCm#type-AccountUrlType
+type AccountURLType = types.FedCmAccountURLType
+
+// Account corresponds to IdentityRequestAccount
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/FedCm#type-Account
+type Account = types.FedCmAccount
+
+// Values of the enumerated types in the FedCm domain.
+const (
+	LoginStateSignIn                    = types.FedCmLoginStateSignIn
+	LoginStateSignUp                    = types.FedCmLoginStateSignUp
+	DialogTypeAccountChooser            = types.FedCmDialogTypeAccountChooser
+	DialogTypeAutoReauthn               = types.FedCmDialogTypeAutoReauthn
+	DialogTypeConfirmIdpLogin           = types.FedCmDialogTypeConfirmIdpLogin
+	DialogTypeError                     = types.FedCmDialogTypeError
+	DialogButtonConfirmIdpLoginContinue = types.FedCmDialogButtonConfirmIdpLoginContinue
+	DialogButtonErrorGotIt              = types.FedCmDialogButtonErrorGotIt
+	DialogButtonErrorMoreDetails        = types.FedCmDialogButtonErrorMoreDetails
+	AccountURLTypeTermsOfService        = types.FedCmAccountURLTypeTermsOfService
+	AccountURLTypePrivacyPolicy         = types.FedCmAccountURLTypePrivacyPolicy
+)
+
+// Method names of the commands in the FedCm domain.
+const (
+	CommandEnable            = "FedCm.enable"
+	CommandDisable           = "FedCm.disable"
+	CommandSelectAccount     = "FedCm.selectAccount"
+	CommandClickDialogButton = "FedCm.clickDialogButton"
+	CommandOpenURL           = "FedCm.openUrl"
+	CommandDismissDialog     = "FedCm.dismissDialog"
+	CommandResetCooldown     = "FedCm.resetCooldown"
+)
+
+// EnableParams are the parameters of FedCm.enable.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/FedCm#method-enable
+type EnableParams struct {
+	// Allows callers to disable the promise rejection delay that would normally
+	// happen, if this is unimportant to what's being tested. (step 4 of
+	// https://fedidcg.github.io/FedCM/#browser-api-rp-sign-in)
+	DisableRejectionDelay bool `json:"disableRejectionDelay,omitempty"`
+}
+
+// Command returns the FedCm.enable command for use with AsyncDebugger.Send.
+func (p EnableParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandEnable, p)
+}
+
+// Do sends FedCm.enable to d and waits for it to complete or for ctx to
+// be done.
+func (p EnableParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandEnable, p, nil)
+}
+
+// DisableParams are the parameters of FedCm.disable.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/FedCm#method-disable
+type DisableParams struct {
+}
+
+// Command returns the FedCm.disable command for use with AsyncDebugger.Send.
+func (p DisableParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandDisable, p)
+}
+
+// Do sends FedCm.disable to d and waits for it to complete or for ctx to
+// be done.
+func (p DisableParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandDisable, p, nil)
+}
+
+// SelectAccountParams are the parameters of FedCm.selectAccount.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/FedCm#method-selectAccount
+type SelectAccountParams struct {
+	DialogID     string `json:"dialogId"`
+	AccountIndex int64  `json:"accountIndex"`
+}
+
+// Command returns the FedCm.selectAccount command for use with
+// AsyncDebugger.Send.
+func (p SelectAccountParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSelectAccount, p)
+}
+
+// Do sends FedCm.selectAccount to d and waits for it to complete or for ctx to
+// be done.
+func (p SelectAccountParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSelectAccount, p, nil)
+}
+
+// ClickDialogButtonParams are the parameters of FedCm.clickDialogButton.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/FedCm#method-clickDialogButton
+type ClickDialogButtonParams struct {
+	DialogID     string       `json:"dialogId"`
+	DialogButton DialogButton `json:"dialogButton"`
+}
+
+// Command returns the FedCm.clickDialogButton command for use with
+// AsyncDebugger.Send.
+func (p ClickDialogButtonParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandClickDialogButton, p)
+}
+
+// Do sends FedCm.clickDialogButton to d and waits for it to complete or for ctx to
+// be done.
+func (p ClickDialogButtonParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandClickDialogButton, p, nil)
+}
+
+// OpenURLParams are the parameters of FedCm.openUrl.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/FedCm#method-openUrl
+type OpenURLParams struct {
+	DialogID       string         `json:"dialogId"`
+	AccountIndex   int64          `json:"accountIndex"`
+	AccountURLType AccountURLType `json:"accountUrlType"`
+}
+
+// Command returns the FedCm.openUrl command for use with AsyncDebugger.Send.
+func (p OpenURLParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandOpenURL, p)
+}
+
+// Do sends FedCm.openUrl to d and waits for it to complete or for ctx to
+// be done.
+func (p OpenURLParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandOpenURL, p, nil)
+}
+
+// DismissDialogParams are the parameters of FedCm.dismissDialog.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/FedCm#method-dismissDialog
+type DismissDialogParams struct {
+	DialogID        string `json:"dialogId"`
+	TriggerCooldown bool   `json:"triggerCooldown,omitempty"`
+}
+
+// Command returns the FedCm.dismissDialog command for use with
+// AsyncDebugger.Send.
+func (p DismissDialogParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandDismissDialog, p)
+}
+
+// Do sends FedCm.dismissDialog to d and waits for it to complete or for ctx to
+// be done.
+func (p DismissDialogParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandDismissDialog, p, nil)
+}
+
+// ResetCooldownParams are the parameters of FedCm.resetCooldown.
+//
+// Resets the cooldown time, if any, to allow the next FedCM call to show a
+// dialog even if one was recently dismissed by the user.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/FedCm#method-resetCooldown
+type ResetCooldownParams struct {
+}
+
+// Command returns the FedCm.resetCooldown command for use with
+// AsyncDebugger.Send.
+func (p ResetCooldownParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandResetCooldown, p)
+}
+
+// Do sends FedCm.resetCooldown to d and waits for it to complete or for ctx to
+// be done.
+func (p ResetCooldownParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandResetCooldown, p, nil)
+}
+
+// Method names of the events in the FedCm domain.
+const (
+	EventDialogShown  = "FedCm.dialogShown"
+	EventDialogClosed = "FedCm.dialogClosed"
+)
+
+// DialogShownEvent is the payload of the FedCm.dialogShown event.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/FedCm#event-dialogShown
+type DialogShownEvent struct {
+	DialogID   string     `json:"dialogId"`
+	DialogType DialogType `json:"dialogType"`
+	Accounts   []Account  `json:"accounts"`
+	// These exist primarily so that the caller can verify the RP context was used
+	// appropriately.
+	Title    string `json:"title"`
+	Subtitle string `json:"subtitle,omitempty"`
+}
+
+// DialogClosedEvent is the payload of the FedCm.dialogClosed event.
+//
+// Triggered when a dialog is closed, either by user action, JS abort, or a
+// command below.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/FedCm#event-dialogClosed
+type DialogClosedEvent struct {
+	DialogID string `json:"dialogId"`
+}
+
+// ParseEvent decodes an event from the FedCm domain, as received from
+// Subscribe, into a pointer to its typed payload.
+func ParseEvent(cmd chromedebugo.Command) (interface{}, error) {
+	var ev interface{}
+	switch cmd.Method {
+	case EventDialogShown:
+		ev = &DialogShownEvent{}
+	case EventDialogClosed:
+		ev = &DialogClosedEvent{}
+	default:
+		return nil, fmt.Errorf("unknown FedCm event: %s", cmd.Method)
+	}
+	if err := cmd.Decode(ev); err != nil {
+		return nil, err
+	}
+	return ev, nil
+}
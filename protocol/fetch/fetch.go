@@ -0,0 +1,448 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+// Package fetch provides typed bindings for the Fetch domain of the Chrome
+// DevTools protocol.
+//
+// A domain for letting clients substitute browser's network layer with client
+// code.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Fetch
+package fetch
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tonyhb/chromedebugo"
+	"github.com/tonyhb/chromedebugo/protocol"
+	"github.com/tonyhb/chromedebugo/protocol/internal/types"
+)
+
+// RequestID unique request identifier. Note that this does not identify
+// individual HTTP requests that are part of a network request.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Fetch#type-RequestId
+type RequestID = types.FetchRequestID
+
+// RequestStage stages of the request to handle. Request will intercept before
+// the request is sent. Response will intercept after the response is received
+// (but before response body is received).
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Fetch#type-RequestStage
+type RequestStage = types.FetchRequestStage
+
+// RequestPattern is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Fetch#type-RequestPattern
+type RequestPattern = types.FetchRequestPattern
+
+// HeaderEntry response HTTP header entry
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Fetch#type-HeaderEntry
+type HeaderEntry = types.FetchHeaderEntry
+
+// AuthChallenge authorization challenge for HTTP status code 401 or 407.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Fetch#type-AuthChallenge
+type AuthChallenge = types.FetchAuthChallenge
+
+// AuthChallengeResponse response to an AuthChallenge.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Fetch#type-AuthChallengeResponse
+type AuthChallengeResponse = types.FetchAuthChallengeResponse
+
+// Values of the enumerated types in the Fetch domain.
+const (
+	RequestStageRequest  = types.FetchRequestStageRequest
+	RequestStageResponse = types.FetchRequestStageResponse
+)
+
+// Method names of the commands in the Fetch domain.
+const (
+	CommandDisable                  = "Fetch.disable"
+	CommandEnable                   = "Fetch.enable"
+	CommandFailRequest              = "Fetch.failRequest"
+	CommandFulfillRequest           = "Fetch.fulfillRequest"
+	CommandContinueRequest          = "Fetch.continueRequest"
+	CommandContinueWithAuth         = "Fetch.continueWithAuth"
+	CommandContinueResponse         = "Fetch.continueResponse"
+	CommandGetResponseBody          = "Fetch.getResponseBody"
+	CommandTakeResponseBodyAsStream = "Fetch.takeResponseBodyAsStream"
+)
+
+// DisableParams are the parameters of Fetch.disable.
+//
+// Disables the fetch domain.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Fetch#method-disable
+type DisableParams struct {
+}
+
+// Command returns the Fetch.disable command for use with AsyncDebugger.Send.
+func (p DisableParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandDisable, p)
+}
+
+// Do sends Fetch.disable to d and waits for it to complete or for ctx to
+// be done.
+func (p DisableParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandDisable, p, nil)
+}
+
+// EnableParams are the parameters of Fetch.enable.
+//
+// Enables issuing of requestPaused events. A request will be paused until
+// client calls one of failRequest, fulfillRequest or
+// continueRequest/continueWithAuth.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Fetch#method-enable
+type EnableParams struct {
+	// If specified, only requests matching any of these patterns will produce
+	// fetchRequested event and will be paused until clients response. If not set,
+	// all requests will be affected.
+	Patterns []RequestPattern `json:"patterns,omitempty"`
+	// If true, authRequired events will be issued and requests will be paused
+	// expecting a call to continueWithAuth.
+	HandleAuthRequests bool `json:"handleAuthRequests,omitempty"`
+}
+
+// Command returns the Fetch.enable command for use with AsyncDebugger.Send.
+func (p EnableParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandEnable, p)
+}
+
+// Do sends Fetch.enable to d and waits for it to complete or for ctx to
+// be done.
+func (p EnableParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandEnable, p, nil)
+}
+
+// FailRequestParams are the parameters of Fetch.failRequest.
+//
+// Causes the request to fail with specified reason.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Fetch#method-failRequest
+type FailRequestParams struct {
+	// An id the client received in requestPaused event.
+	RequestID RequestID `json:"requestId"`
+	// Causes the request to fail with the given reason.
+	ErrorReason types.NetworkErrorReason `json:"errorReason"`
+}
+
+// Command returns the Fetch.failRequest command for use with
+// AsyncDebugger.Send.
+func (p FailRequestParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandFailRequest, p)
+}
+
+// Do sends Fetch.failRequest to d and waits for it to complete or for ctx to
+// be done.
+func (p FailRequestParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandFailRequest, p, nil)
+}
+
+// FulfillRequestParams are the parameters of Fetch.fulfillRequest.
+//
+// Provides response to the request.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Fetch#method-fulfillRequest
+type FulfillRequestParams struct {
+	// An id the client received in requestPaused event.
+	RequestID RequestID `json:"requestId"`
+	// An HTTP response code.
+	ResponseCode int64 `json:"responseCode"`
+	// Response headers.
+	ResponseHeaders []HeaderEntry `json:"responseHeaders,omitempty"`
+	// Alternative way of specifying response headers as a \0-separated series of
+	// name: value pairs. Prefer the above method unless you need to represent some
+	// non-UTF8 values that can't be transmitted over the protocol as text.
+	// (Encoded as a base64 string when passed over JSON)
+	BinaryResponseHeaders string `json:"binaryResponseHeaders,omitempty"`
+	// A response body. If absent, original response body will be used if the
+	// request is intercepted at the response stage and empty body will be used if
+	// the request is intercepted at the request stage. (Encoded as a base64 string
+	// when passed over JSON)
+	Body string `json:"body,omitempty"`
+	// A textual representation of responseCode. If absent, a standard phrase
+	// matching responseCode is used.
+	ResponsePhrase string `json:"responsePhrase,omitempty"`
+}
+
+// Command returns the Fetch.fulfillRequest command for use with
+// AsyncDebugger.Send.
+func (p FulfillRequestParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandFulfillRequest, p)
+}
+
+// Do sends Fetch.fulfillRequest to d and waits for it to complete or for ctx to
+// be done.
+func (p FulfillRequestParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandFulfillRequest, p, nil)
+}
+
+// ContinueRequestParams are the parameters of Fetch.continueRequest.
+//
+// Continues the request, optionally modifying some of its parameters.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Fetch#method-continueRequest
+type ContinueRequestParams struct {
+	// An id the client received in requestPaused event.
+	RequestID RequestID `json:"requestId"`
+	// If set, the request url will be modified in a way that's not observable by
+	// page.
+	URL string `json:"url,omitempty"`
+	// If set, the request method is overridden.
+	Method string `json:"method,omitempty"`
+	// If set, overrides the post data in the request. (Encoded as a base64 string
+	// when passed over JSON)
+	PostData string `json:"postData,omitempty"`
+	// If set, overrides the request headers. Note that the overrides do not extend
+	// to subsequent redirect hops, if a redirect happens. Another override may be
+	// applied to a different request produced by a redirect.
+	Headers []HeaderEntry `json:"headers,omitempty"`
+	// If set, overrides response interception behavior for this request.
+	//
+	// This is an experimental part of the protocol.
+	InterceptResponse bool `json:"interceptResponse,omitempty"`
+}
+
+// Command returns the Fetch.continueRequest command for use with
+// AsyncDebugger.Send.
+func (p ContinueRequestParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandContinueRequest, p)
+}
+
+// Do sends Fetch.continueRequest to d and waits for it to complete or for ctx to
+// be done.
+func (p ContinueRequestParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandContinueRequest, p, nil)
+}
+
+// ContinueWithAuthParams are the parameters of Fetch.continueWithAuth.
+//
+// Continues a request supplying authChallengeResponse following authRequired
+// event.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Fetch#method-continueWithAuth
+type ContinueWithAuthParams struct {
+	// An id the client received in authRequired event.
+	RequestID RequestID `json:"requestId"`
+	// Response to with an authChallenge.
+	AuthChallengeResponse AuthChallengeResponse `json:"authChallengeResponse"`
+}
+
+// Command returns the Fetch.continueWithAuth command for use with
+// AsyncDebugger.Send.
+func (p ContinueWithAuthParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandContinueWithAuth, p)
+}
+
+// Do sends Fetch.continueWithAuth to d and waits for it to complete or for ctx to
+// be done.
+func (p ContinueWithAuthParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandContinueWithAuth, p, nil)
+}
+
+// ContinueResponseParams are the parameters of Fetch.continueResponse.
+//
+// Continues loading of the paused response, optionally modifying the response
+// headers. If either responseCode or headers are modified, all of them must be
+// present.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Fetch#method-continueResponse
+//
+// This is an experimental part of the protocol.
+type ContinueResponseParams struct {
+	// An id the client received in requestPaused event.
+	RequestID RequestID `json:"requestId"`
+	// An HTTP response code. If absent, original response code will be used.
+	ResponseCode int64 `json:"responseCode,omitempty"`
+	// A textual representation of responseCode. If absent, a standard phrase
+	// matching responseCode is used.
+	ResponsePhrase string `json:"responsePhrase,omitempty"`
+	// Response headers. If absent, original response headers will be used.
+	ResponseHeaders []HeaderEntry `json:"responseHeaders,omitempty"`
+	// Alternative way of specifying response headers as a \0-separated series of
+	// name: value pairs. Prefer the above method unless you need to represent some
+	// non-UTF8 values that can't be transmitted over the protocol as text.
+	// (Encoded as a base64 string when passed over JSON)
+	BinaryResponseHeaders string `json:"binaryResponseHeaders,omitempty"`
+}
+
+// Command returns the Fetch.continueResponse command for use with
+// AsyncDebugger.Send.
+func (p ContinueResponseParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandContinueResponse, p)
+}
+
+// Do sends Fetch.continueResponse to d and waits for it to complete or for ctx to
+// be done.
+func (p ContinueResponseParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandContinueResponse, p, nil)
+}
+
+// GetResponseBodyParams are the parameters of Fetch.getResponseBody.
+//
+// Causes the body of the response to be received from the server and returned
+// as a single string. May only be issued for a request that is paused in the
+// Response stage and is mutually exclusive with
+// takeResponseBodyForInterceptionAsStream. Calling other methods that affect
+// the request or disabling fetch domain before body is received results in an
+// undefined behavior. Note that the response body is not available for
+// redirects. Requests paused in the _redirect received_ state may be
+// differentiated by `responseCode` and presence of `location` response header,
+// see comments to `requestPaused` for details.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Fetch#method-getResponseBody
+type GetResponseBodyParams struct {
+	// Identifier for the intercepted request to get body for.
+	RequestID RequestID `json:"requestId"`
+}
+
+// Command returns the Fetch.getResponseBody command for use with
+// AsyncDebugger.Send.
+func (p GetResponseBodyParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandGetResponseBody, p)
+}
+
+// GetResponseBodyReturns are the values returned by Fetch.getResponseBody.
+type GetResponseBodyReturns struct {
+	// Response body.
+	Body string `json:"body"`
+	// True, if content was sent as base64.
+	Base64Encoded bool `json:"base64Encoded"`
+}
+
+// Do sends Fetch.getResponseBody to d and waits for its result or for ctx to be
+// done.
+func (p GetResponseBodyParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*GetResponseBodyReturns, error) {
+	ret := &GetResponseBodyReturns{}
+	if err := protocol.Do(ctx, d, CommandGetResponseBody, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// TakeResponseBodyAsStreamParams are the parameters of
+// Fetch.takeResponseBodyAsStream.
+//
+// Returns a handle to the stream representing the response body. The request
+// must be paused in the HeadersReceived stage. Note that after this command the
+// request can't be continued as is -- client either needs to cancel it or to
+// provide the response body. The stream only supports sequential read, IO.read
+// will fail if the position is specified. This method is mutually exclusive
+// with getResponseBody. Calling other methods that affect the request or
+// disabling fetch domain before body is received results in an undefined
+// behavior.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Fetch#method-takeResponseBodyAsStream
+type TakeResponseBodyAsStreamParams struct {
+	RequestID RequestID `json:"requestId"`
+}
+
+// Command returns the Fetch.takeResponseBodyAsStream command for use with
+// AsyncDebugger.Send.
+func (p TakeResponseBodyAsStreamParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandTakeResponseBodyAsStream, p)
+}
+
+// TakeResponseBodyAsStreamReturns are the values returned by Fetch.takeResponseBodyAsStream.
+type TakeResponseBodyAsStreamReturns struct {
+	Stream types.IOStreamHandle `json:"stream"`
+}
+
+// Do sends Fetch.takeResponseBodyAsStream to d and waits for its result or for ctx to be
+// done.
+func (p TakeResponseBodyAsStreamParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*TakeResponseBodyAsStreamReturns, error) {
+	ret := &TakeResponseBodyAsStreamReturns{}
+	if err := protocol.Do(ctx, d, CommandTakeResponseBodyAsStream, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// Method names of the events in the Fetch domain.
+const (
+	EventRequestPaused = "Fetch.requestPaused"
+	EventAuthRequired  = "Fetch.authRequired"
+)
+
+// RequestPausedEvent is the payload of the Fetch.requestPaused event.
+//
+// Issued when the domain is enabled and the request URL matches the specified
+// filter. The request is paused until the client responds with one of
+// continueRequest, failRequest or fulfillRequest. The stage of the request can
+// be determined by presence of responseErrorReason and responseStatusCode --
+// the request is at the response stage if either of these fields is present and
+// in the request stage otherwise. Redirect responses and subsequent requests
+// are reported similarly to regular responses and requests. Redirect responses
+// may be distinguished by the value of `responseStatusCode` (which is one of
+// 301, 302, 303, 307, 308) along with presence of the `location` header.
+// Requests resulting from a redirect will have `redirectedRequestId` field set.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Fetch#event-requestPaused
+type RequestPausedEvent struct {
+	// Each request the page makes will have a unique id.
+	RequestID RequestID `json:"requestId"`
+	// The details of the request.
+	Request types.NetworkRequest `json:"request"`
+	// The id of the frame that initiated the request.
+	FrameID types.PageFrameID `json:"frameId"`
+	// How the requested resource will be used.
+	ResourceType types.NetworkResourceType `json:"resourceType"`
+	// Response error if intercepted at response stage.
+	ResponseErrorReason types.NetworkErrorReason `json:"responseErrorReason,omitempty"`
+	// Response code if intercepted at response stage.
+	ResponseStatusCode int64 `json:"responseStatusCode,omitempty"`
+	// Response status text if intercepted at response stage.
+	ResponseStatusText string `json:"responseStatusText,omitempty"`
+	// Response headers if intercepted at the response stage.
+	ResponseHeaders []HeaderEntry `json:"responseHeaders,omitempty"`
+	// If the intercepted request had a corresponding Network.requestWillBeSent
+	// event fired for it, then this networkId will be the same as the requestId
+	// present in the requestWillBeSent event.
+	NetworkID types.NetworkRequestID `json:"networkId,omitempty"`
+	// If the request is due to a redirect response from the server, the id of the
+	// request that has caused the redirect.
+	//
+	// This is an experimental part of the protocol.
+	RedirectedRequestID RequestID `json:"redirectedRequestId,omitempty"`
+}
+
+// AuthRequiredEvent is the payload of the Fetch.authRequired event.
+//
+// Issued when the domain is enabled with handleAuthRequests set to true. The
+// request is paused until client responds with continueWithAuth.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Fetch#event-authRequired
+type AuthRequiredEvent struct {
+	// Each request the page makes will have a unique id.
+	RequestID RequestID `json:"requestId"`
+	// The details of the request.
+	Request types.NetworkRequest `json:"request"`
+	// The id of the frame that initiated the request.
+	FrameID types.PageFrameID `json:"frameId"`
+	// How the requested resource will be used.
+	ResourceType types.NetworkResourceType `json:"resourceType"`
+	// Details of the Authorization Challenge encountered. If this is set, client
+	// should respond with continueRequest that contains AuthChallengeResponse.
+	AuthChallenge AuthChallenge `json:"authChallenge"`
+}
+
+// ParseEvent decodes an event from the Fetch domain, as received from
+// Subscribe, into a pointer to its typed payload.
+func ParseEvent(cmd chromedebugo.Command) (interface{}, error) {
+	var ev interface{}
+	switch cmd.Method {
+	case EventRequestPaused:
+		ev = &RequestPausedEvent{}
+	case EventAuthRequired:
+		ev = &AuthRequiredEvent{}
+	default:
+		return nil, fmt.Errorf("unknown Fetch event: %s", cmd.Method)
+	}
+	if err := cmd.Decode(ev); err != nil {
+		return nil, err
+	}
+	return ev, nil
+}
@@ -0,0 +1,66 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+// Package filesystem provides typed bindings for the FileSystem domain of the
+// Chrome DevTools protocol.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/FileSystem
+//
+// This is an experimental part of the protocol.
+package filesystem
+
+import (
+	"context"
+
+	"github.com/tonyhb/chromedebugo"
+	"github.com/tonyhb/chromedebugo/protocol"
+	"github.com/tonyhb/chromedebugo/protocol/internal/types"
+)
+
+// File is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/FileSystem#type-File
+type File = types.FileSystemFile
+
+// Directory is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/FileSystem#type-Directory
+type Directory = types.FileSystemDirectory
+
+// BucketFileSystemLocator is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/FileSystem#type-BucketFileSystemLocator
+type BucketFileSystemLocator = types.FileSystemBucketFileSystemLocator
+
+// Method names of the commands in the FileSystem domain.
+const (
+	CommandGetDirectory = "FileSystem.getDirectory"
+)
+
+// GetDirectoryParams are the parameters of FileSystem.getDirectory.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/FileSystem#method-getDirectory
+type GetDirectoryParams struct {
+	BucketFileSystemLocator BucketFileSystemLocator `json:"bucketFileSystemLocator"`
+}
+
+// Command returns the FileSystem.getDirectory command for use with
+// AsyncDebugger.Send.
+func (p GetDirectoryParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandGetDirectory, p)
+}
+
+// GetDirectoryReturns are the values returned by FileSystem.getDirectory.
+type GetDirectoryReturns struct {
+	// Returns the directory object at the path.
+	Directory Directory `json:"directory"`
+}
+
+// Do sends FileSystem.getDirectory to d and waits for its result or for ctx to be
+// done.
+func (p GetDirectoryParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*GetDirectoryReturns, error) {
+	ret := &GetDirectoryReturns{}
+	if err := protocol.Do(ctx, d, CommandGetDirectory, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
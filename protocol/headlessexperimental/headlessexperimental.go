@@ -0,0 +1,131 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+// Package headlessexperimental provides typed bindings for the
+// HeadlessExperimental domain of the Chrome DevTools protocol.
+//
+// This domain provides experimental commands only supported in headless mode.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/HeadlessExperimental
+//
+// This is an experimental part of the protocol.
+package headlessexperimental
+
+import (
+	"context"
+
+	"github.com/tonyhb/chromedebugo"
+	"github.com/tonyhb/chromedebugo/protocol"
+	"github.com/tonyhb/chromedebugo/protocol/internal/types"
+)
+
+// ScreenshotParams encoding options for a screenshot.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/HeadlessExperimental#type-ScreenshotParams
+type ScreenshotParams = types.HeadlessExperimentalScreenshotParams
+
+// Method names of the commands in the HeadlessExperimental domain.
+const (
+	CommandBeginFrame = "HeadlessExperimental.beginFrame"
+	CommandDisable    = "HeadlessExperimental.disable"
+	CommandEnable     = "HeadlessExperimental.enable"
+)
+
+// BeginFrameParams are the parameters of HeadlessExperimental.beginFrame.
+//
+// Sends a BeginFrame to the target and returns when the frame was completed.
+// Optionally captures a screenshot from the resulting frame. Requires that the
+// target was created with enabled BeginFrameControl. Designed for use with
+// --run-all-compositor-stages-before-draw, see also
+// https://goo.gle/chrome-headless-rendering for more background.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/HeadlessExperimental#method-beginFrame
+type BeginFrameParams struct {
+	// Timestamp of this BeginFrame in Renderer TimeTicks (milliseconds of uptime).
+	// If not set, the current time will be used.
+	FrameTimeTicks float64 `json:"frameTimeTicks,omitempty"`
+	// The interval between BeginFrames that is reported to the compositor, in
+	// milliseconds. Defaults to a 60 frames/second interval, i.e. about 16.666
+	// milliseconds.
+	Interval float64 `json:"interval,omitempty"`
+	// Whether updates should not be committed and drawn onto the display. False by
+	// default. If true, only side effects of the BeginFrame will be run, such as
+	// layout and animations, but any visual updates may not be visible on the
+	// display or in screenshots.
+	NoDisplayUpdates bool `json:"noDisplayUpdates,omitempty"`
+	// If set, a screenshot of the frame will be captured and returned in the
+	// response. Otherwise, no screenshot will be captured. Note that capturing a
+	// screenshot can fail, for example, during renderer initialization. In such a
+	// case, no screenshot data will be returned.
+	Screenshot *ScreenshotParams `json:"screenshot,omitempty"`
+}
+
+// Command returns the HeadlessExperimental.beginFrame command for use with
+// AsyncDebugger.Send.
+func (p BeginFrameParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandBeginFrame, p)
+}
+
+// BeginFrameReturns are the values returned by HeadlessExperimental.beginFrame.
+type BeginFrameReturns struct {
+	// Whether the BeginFrame resulted in damage and, thus, a new frame was
+	// committed to the display. Reported for diagnostic uses, may be removed in
+	// the future.
+	HasDamage bool `json:"hasDamage"`
+	// Base64-encoded image data of the screenshot, if one was requested and
+	// successfully taken. (Encoded as a base64 string when passed over JSON)
+	ScreenshotData string `json:"screenshotData,omitempty"`
+}
+
+// Do sends HeadlessExperimental.beginFrame to d and waits for its result or for ctx to be
+// done.
+func (p BeginFrameParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*BeginFrameReturns, error) {
+	ret := &BeginFrameReturns{}
+	if err := protocol.Do(ctx, d, CommandBeginFrame, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// DisableParams are the parameters of HeadlessExperimental.disable.
+//
+// Disables headless events for the target.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/HeadlessExperimental#method-disable
+//
+// Deprecated: this is deprecated in the protocol.
+type DisableParams struct {
+}
+
+// Command returns the HeadlessExperimental.disable command for use with
+// AsyncDebugger.Send.
+func (p DisableParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandDisable, p)
+}
+
+// Do sends HeadlessExperimental.disable to d and waits for it to complete or for ctx to
+// be done.
+func (p DisableParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandDisable, p, nil)
+}
+
+// EnableParams are the parameters of HeadlessExperimental.enable.
+//
+// Enables headless events for the target.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/HeadlessExperimental#method-enable
+//
+// Deprecated: this is deprecated in the protocol.
+type EnableParams struct {
+}
+
+// Command returns the HeadlessExperimental.enable command for use with
+// AsyncDebugger.Send.
+func (p EnableParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandEnable, p)
+}
+
+// Do sends HeadlessExperimental.enable to d and waits for it to complete or for ctx to
+// be done.
+func (p EnableParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandEnable, p, nil)
+}
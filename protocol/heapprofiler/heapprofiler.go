@@ -0,0 +1,457 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+// Package heapprofiler provides typed bindings for the HeapProfiler domain of
+// the Chrome DevTools protocol.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/HeapProfiler
+//
+// This is an experimental part of the protocol.
+package heapprofiler
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tonyhb/chromedebugo"
+	"github.com/tonyhb/chromedebugo/protocol"
+	"github.com/tonyhb/chromedebugo/protocol/internal/types"
+)
+
+// HeapSnapshotObjectID heap snapshot object id.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/HeapProfiler#type-HeapSnapshotObjectId
+type HeapSnapshotObjectID = types.HeapProfilerHeapSnapshotObjectID
+
+// SamplingHeapProfileNode sampling Heap Profile node. Holds callsite
+// information, allocation statistics and child nodes.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/HeapProfiler#type-SamplingHeapProfileNode
+type SamplingHeapProfileNode = types.HeapProfilerSamplingHeapProfileNode
+
+// SamplingHeapProfileSample a single sample from a sampling profile.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/HeapProfiler#type-SamplingHeapProfileSample
+type SamplingHeapProfileSample = types.HeapProfilerSamplingHeapProfileSample
+
+// SamplingHeapProfile sampling profile.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/HeapProfiler#type-SamplingHeapProfile
+type SamplingHeapProfile = types.HeapProfilerSamplingHeapProfile
+
+// Method names of the commands in the HeapProfiler domain.
+const (
+	CommandAddInspectedHeapObject   = "HeapProfiler.addInspectedHeapObject"
+	CommandCollectGarbage           = "HeapProfiler.collectGarbage"
+	CommandDisable                  = "HeapProfiler.disable"
+	CommandEnable                   = "HeapProfiler.enable"
+	CommandGetHeapObjectID          = "HeapProfiler.getHeapObjectId"
+	CommandGetObjectByHeapObjectID  = "HeapProfiler.getObjectByHeapObjectId"
+	CommandGetSamplingProfile       = "HeapProfiler.getSamplingProfile"
+	CommandStartSampling            = "HeapProfiler.startSampling"
+	CommandStartTrackingHeapObjects = "HeapProfiler.startTrackingHeapObjects"
+	CommandStopSampling             = "HeapProfiler.stopSampling"
+	CommandStopTrackingHeapObjects  = "HeapProfiler.stopTrackingHeapObjects"
+	CommandTakeHeapSnapshot         = "HeapProfiler.takeHeapSnapshot"
+)
+
+// AddInspectedHeapObjectParams are the parameters of
+// HeapProfiler.addInspectedHeapObject.
+//
+// Enables console to refer to the node with given id via $x (see Command Line
+// API for more details $x functions).
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/HeapProfiler#method-addInspectedHeapObject
+type AddInspectedHeapObjectParams struct {
+	// Heap snapshot object id to be accessible by means of $x command line API.
+	HeapObjectID HeapSnapshotObjectID `json:"heapObjectId"`
+}
+
+// Command returns the HeapProfiler.addInspectedHeapObject command for use with
+// AsyncDebugger.Send.
+func (p AddInspectedHeapObjectParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandAddInspectedHeapObject, p)
+}
+
+// Do sends HeapProfiler.addInspectedHeapObject to d and waits for it to complete or for ctx to
+// be done.
+func (p AddInspectedHeapObjectParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandAddInspectedHeapObject, p, nil)
+}
+
+// CollectGarbageParams are the parameters of HeapProfiler.collectGarbage.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/HeapProfiler#method-collectGarbage
+type CollectGarbageParams struct {
+}
+
+// Command returns the HeapProfiler.collectGarbage command for use with
+// AsyncDebugger.Send.
+func (p CollectGarbageParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandCollectGarbage, p)
+}
+
+// Do sends HeapProfiler.collectGarbage to d and waits for it to complete or for ctx to
+// be done.
+func (p CollectGarbageParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandCollectGarbage, p, nil)
+}
+
+// DisableParams are the parameters of HeapProfiler.disable.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/HeapProfiler#method-disable
+type DisableParams struct {
+}
+
+// Command returns the HeapProfiler.disable command for use with
+// AsyncDebugger.Send.
+func (p DisableParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandDisable, p)
+}
+
+// Do sends HeapProfiler.disable to d and waits for it to complete or for ctx to
+// be done.
+func (p DisableParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandDisable, p, nil)
+}
+
+// EnableParams are the parameters of HeapProfiler.enable.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/HeapProfiler#method-enable
+type EnableParams struct {
+}
+
+// Command returns the HeapProfiler.enable command for use with
+// AsyncDebugger.Send.
+func (p EnableParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandEnable, p)
+}
+
+// Do sends HeapProfiler.enable to d and waits for it to complete or for ctx to
+// be done.
+func (p EnableParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandEnable, p, nil)
+}
+
+// GetHeapObjectIDParams are the parameters of HeapProfiler.getHeapObjectId.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/HeapProfiler#method-getHeapObjectId
+type GetHeapObjectIDParams struct {
+	// Identifier of the object to get heap object id for.
+	ObjectID types.RuntimeRemoteObjectID `json:"objectId"`
+}
+
+// Command returns the HeapProfiler.getHeapObjectId command for use with
+// AsyncDebugger.Send.
+func (p GetHeapObjectIDParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandGetHeapObjectID, p)
+}
+
+// GetHeapObjectIDReturns are the values returned by HeapProfiler.getHeapObjectId.
+type GetHeapObjectIDReturns struct {
+	// Id of the heap snapshot object corresponding to the passed remote object id.
+	HeapSnapshotObjectID HeapSnapshotObjectID `json:"heapSnapshotObjectId"`
+}
+
+// Do sends HeapProfiler.getHeapObjectId to d and waits for its result or for ctx to be
+// done.
+func (p GetHeapObjectIDParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*GetHeapObjectIDReturns, error) {
+	ret := &GetHeapObjectIDReturns{}
+	if err := protocol.Do(ctx, d, CommandGetHeapObjectID, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// GetObjectByHeapObjectIDParams are the parameters of
+// HeapProfiler.getObjectByHeapObjectId.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/HeapProfiler#method-getObjectByHeapObjectId
+type GetObjectByHeapObjectIDParams struct {
+	ObjectID HeapSnapshotObjectID `json:"objectId"`
+	// Symbolic group name that can be used to release multiple objects.
+	ObjectGroup string `json:"objectGroup,omitempty"`
+}
+
+// Command returns the HeapProfiler.getObjectByHeapObjectId command for use with
+// AsyncDebugger.Send.
+func (p GetObjectByHeapObjectIDParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandGetObjectByHeapObjectID, p)
+}
+
+// GetObjectByHeapObjectIDReturns are the values returned by HeapProfiler.getObjectByHeapObjectId.
+type GetObjectByHeapObjectIDReturns struct {
+	// Evaluation result.
+	Result types.RuntimeRemoteObject `json:"result"`
+}
+
+// Do sends HeapProfiler.getObjectByHeapObjectId to d and waits for its result or for ctx to be
+// done.
+func (p GetObjectByHeapObjectIDParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*GetObjectByHeapObjectIDReturns, error) {
+	ret := &GetObjectByHeapObjectIDReturns{}
+	if err := protocol.Do(ctx, d, CommandGetObjectByHeapObjectID, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// GetSamplingProfileParams are the parameters of
+// HeapProfiler.getSamplingProfile.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/HeapProfiler#method-getSamplingProfile
+type GetSamplingProfileParams struct {
+}
+
+// Command returns the HeapProfiler.getSamplingProfile command for use with
+// AsyncDebugger.Send.
+func (p GetSamplingProfileParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandGetSamplingProfile, p)
+}
+
+// GetSamplingProfileReturns are the values returned by HeapProfiler.getSamplingProfile.
+type GetSamplingProfileReturns struct {
+	// Return the sampling profile being collected.
+	Profile SamplingHeapProfile `json:"profile"`
+}
+
+// Do sends HeapProfiler.getSamplingProfile to d and waits for its result or for ctx to be
+// done.
+func (p GetSamplingProfileParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*GetSamplingProfileReturns, error) {
+	ret := &GetSamplingProfileReturns{}
+	if err := protocol.Do(ctx, d, CommandGetSamplingProfile, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// StartSamplingParams are the parameters of HeapProfiler.startSampling.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/HeapProfiler#method-startSampling
+type StartSamplingParams struct {
+	// Average sample interval in bytes. Poisson distribution is used for the
+	// intervals. The default value is 32768 bytes.
+	SamplingInterval float64 `json:"samplingInterval,omitempty"`
+	// By default, the sampling heap profiler reports only objects which are still
+	// alive when the profile is returned via getSamplingProfile or stopSampling,
+	// which is useful for determining what functions contribute the most to
+	// steady-state memory usage. This flag instructs the sampling heap profiler to
+	// also include information about objects discarded by major GC, which will
+	// show which functions cause large temporary memory usage or long GC pauses.
+	IncludeObjectsCollectedByMajorGC bool `json:"includeObjectsCollectedByMajorGC,omitempty"`
+	// By default, the sampling heap profiler reports only objects which are still
+	// alive when the profile is returned via getSamplingProfile or stopSampling,
+	// which is useful for determining what functions contribute the most to
+	// steady-state memory usage. This flag instructs the sampling heap profiler to
+	// also include information about objects discarded by minor GC, which is
+	// useful when tuning a latency-sensitive application for minimal GC activity.
+	IncludeObjectsCollectedByMinorGC bool `json:"includeObjectsCollectedByMinorGC,omitempty"`
+}
+
+// Command returns the HeapProfiler.startSampling command for use with
+// AsyncDebugger.Send.
+func (p StartSamplingParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandStartSampling, p)
+}
+
+// Do sends HeapProfiler.startSampling to d and waits for it to complete or for ctx to
+// be done.
+func (p StartSamplingParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandStartSampling, p, nil)
+}
+
+// StartTrackingHeapObjectsParams are the parameters of
+// HeapProfiler.startTrackingHeapObjects.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/HeapProfiler#method-startTrackingHeapObjects
+type StartTrackingHeapObjectsParams struct {
+	TrackAllocations bool `json:"trackAllocations,omitempty"`
+}
+
+// Command returns the HeapProfiler.startTrackingHeapObjects command for use
+// with AsyncDebugger.Send.
+func (p StartTrackingHeapObjectsParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandStartTrackingHeapObjects, p)
+}
+
+// Do sends HeapProfiler.startTrackingHeapObjects to d and waits for it to complete or for ctx to
+// be done.
+func (p StartTrackingHeapObjectsParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandStartTrackingHeapObjects, p, nil)
+}
+
+// StopSamplingParams are the parameters of HeapProfiler.stopSampling.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/HeapProfiler#method-stopSampling
+type StopSamplingParams struct {
+}
+
+// Command returns the HeapProfiler.stopSampling command for use with
+// AsyncDebugger.Send.
+func (p StopSamplingParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandStopSampling, p)
+}
+
+// StopSamplingReturns are the values returned by HeapProfiler.stopSampling.
+type StopSamplingReturns struct {
+	// Recorded sampling heap profile.
+	Profile SamplingHeapProfile `json:"profile"`
+}
+
+// Do sends HeapProfiler.stopSampling to d and waits for its result or for ctx to be
+// done.
+func (p StopSamplingParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*StopSamplingReturns, error) {
+	ret := &StopSamplingReturns{}
+	if err := protocol.Do(ctx, d, CommandStopSampling, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// StopTrackingHeapObjectsParams are the parameters of
+// HeapProfiler.stopTrackingHeapObjects.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/HeapProfiler#method-stopTrackingHeapObjects
+type StopTrackingHeapObjectsParams struct {
+	// If true 'reportHeapSnapshotProgress' events will be generated while snapshot
+	// is being taken when the tracking is stopped.
+	ReportProgress bool `json:"reportProgress,omitempty"`
+	// Deprecated in favor of `exposeInternals`.
+	//
+	// Deprecated: this is deprecated in the protocol.
+	TreatGlobalObjectsAsRoots bool `json:"treatGlobalObjectsAsRoots,omitempty"`
+	// If true, numerical values are included in the snapshot
+	CaptureNumericValue bool `json:"captureNumericValue,omitempty"`
+	// If true, exposes internals of the snapshot.
+	//
+	// This is an experimental part of the protocol.
+	ExposeInternals bool `json:"exposeInternals,omitempty"`
+}
+
+// Command returns the HeapProfiler.stopTrackingHeapObjects command for use with
+// AsyncDebugger.Send.
+func (p StopTrackingHeapObjectsParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandStopTrackingHeapObjects, p)
+}
+
+// Do sends HeapProfiler.stopTrackingHeapObjects to d and waits for it to complete or for ctx to
+// be done.
+func (p StopTrackingHeapObjectsParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandStopTrackingHeapObjects, p, nil)
+}
+
+// TakeHeapSnapshotParams are the parameters of HeapProfiler.takeHeapSnapshot.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/HeapProfiler#method-takeHeapSnapshot
+type TakeHeapSnapshotParams struct {
+	// If true 'reportHeapSnapshotProgress' events will be generated while snapshot
+	// is being taken.
+	ReportProgress bool `json:"reportProgress,omitempty"`
+	// If true, a raw snapshot without artificial roots will be generated.
+	// Deprecated in favor of `exposeInternals`.
+	//
+	// Deprecated: this is deprecated in the protocol.
+	TreatGlobalObjectsAsRoots bool `json:"treatGlobalObjectsAsRoots,omitempty"`
+	// If true, numerical values are included in the snapshot
+	CaptureNumericValue bool `json:"captureNumericValue,omitempty"`
+	// If true, exposes internals of the snapshot.
+	//
+	// This is an experimental part of the protocol.
+	ExposeInternals bool `json:"exposeInternals,omitempty"`
+}
+
+// Command returns the HeapProfiler.takeHeapSnapshot command for use with
+// AsyncDebugger.Send.
+func (p TakeHeapSnapshotParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandTakeHeapSnapshot, p)
+}
+
+// Do sends HeapProfiler.takeHeapSnapshot to d and waits for it to complete or for ctx to
+// be done.
+func (p TakeHeapSnapshotParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandTakeHeapSnapshot, p, nil)
+}
+
+// Method names of the events in the HeapProfiler domain.
+const (
+	EventAddHeapSnapshotChunk       = "HeapProfiler.addHeapSnapshotChunk"
+	EventHeapStatsUpdate            = "HeapProfiler.heapStatsUpdate"
+	EventLastSeenObjectID           = "HeapProfiler.lastSeenObjectId"
+	EventReportHeapSnapshotProgress = "HeapProfiler.reportHeapSnapshotProgress"
+	EventResetProfiles              = "HeapProfiler.resetProfiles"
+)
+
+// AddHeapSnapshotChunkEvent is the payload of the
+// HeapProfiler.addHeapSnapshotChunk event.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/HeapProfiler#event-addHeapSnapshotChunk
+type AddHeapSnapshotChunkEvent struct {
+	Chunk string `json:"chunk"`
+}
+
+// HeapStatsUpdateEvent is the payload of the HeapProfiler.heapStatsUpdate
+// event.
+//
+// If heap objects tracking has been started then backend may send update for
+// one or more fragments
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/HeapProfiler#event-heapStatsUpdate
+type HeapStatsUpdateEvent struct {
+	// An array of triplets. Each triplet describes a fragment. The first integer
+	// is the fragment index, the second integer is a total count of objects for
+	// the fragment, the third integer is a total size of the objects for the
+	// fragment.
+	StatsUpdate []int64 `json:"statsUpdate"`
+}
+
+// LastSeenObjectIDEvent is the payload of the HeapProfiler.lastSeenObjectId
+// event.
+//
+// If heap objects tracking has been started then backend regularly sends a
+// current value for last seen object id and corresponding timestamp. If the
+// were changes in the heap since last event then one or more heapStatsUpdate
+// events will be sent before a new lastSeenObjectId event.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/HeapProfiler#event-lastSeenObjectId
+type LastSeenObjectIDEvent struct {
+	LastSeenObjectID int64   `json:"lastSeenObjectId"`
+	Timestamp        float64 `json:"timestamp"`
+}
+
+// ReportHeapSnapshotProgressEvent is the payload of the
+// HeapProfiler.reportHeapSnapshotProgress event.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/HeapProfiler#event-reportHeapSnapshotProgress
+type ReportHeapSnapshotProgressEvent struct {
+	Done     int64 `json:"done"`
+	Total    int64 `json:"total"`
+	Finished bool  `json:"finished,omitempty"`
+}
+
+// ResetProfilesEvent is the payload of the HeapProfiler.resetProfiles event.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/HeapProfiler#event-resetProfiles
+type ResetProfilesEvent struct {
+}
+
+// ParseEvent decodes an event from the HeapProfiler domain, as received from
+// Subscribe, into a pointer to its typed payload.
+func ParseEvent(cmd chromedebugo.Command) (interface{}, error) {
+	var ev interface{}
+	switch cmd.Method {
+	case EventAddHeapSnapshotChunk:
+		ev = &AddHeapSnapshotChunkEvent{}
+	case EventHeapStatsUpdate:
+		ev = &HeapStatsUpdateEvent{}
+	case EventLastSeenObjectID:
+		ev = &LastSeenObjectIDEvent{}
+	case EventReportHeapSnapshotProgress:
+		ev = &ReportHeapSnapshotProgressEvent{}
+	case EventResetProfiles:
+		ev = &ResetProfilesEvent{}
+	default:
+		return nil, fmt.Errorf("unknown HeapProfiler event: %s", cmd.Method)
+	}
+	if err := cmd.Decode(ev); err != nil {
+		return nil, err
+	}
+	return ev, nil
+}
@@ -0,0 +1,368 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+// Package indexeddb provides typed bindings for the IndexedDB domain of the
+// Chrome DevTools protocol.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/IndexedDB
+//
+// This is an experimental part of the protocol.
+package indexeddb
+
+import (
+	"context"
+
+	"github.com/tonyhb/chromedebugo"
+	"github.com/tonyhb/chromedebugo/protocol"
+	"github.com/tonyhb/chromedebugo/protocol/internal/types"
+)
+
+// DatabaseWithObjectStores database with an array of object stores.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/IndexedDB#type-DatabaseWithObjectStores
+type DatabaseWithObjectStores = types.IndexedDBDatabaseWithObjectStores
+
+// ObjectStore object store.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/IndexedDB#type-ObjectStore
+type ObjectStore = types.IndexedDBObjectStore
+
+// ObjectStoreIndex object store index.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/IndexedDB#type-ObjectStoreIndex
+type ObjectStoreIndex = types.IndexedDBObjectStoreIndex
+
+// Key key.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/IndexedDB#type-Key
+type Key = types.IndexedDBKey
+
+// KeyRange key range.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/IndexedDB#type-KeyRange
+type KeyRange = types.IndexedDBKeyRange
+
+// DataEntry data entry.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/IndexedDB#type-DataEntry
+type DataEntry = types.IndexedDBDataEntry
+
+// KeyPath key path.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/IndexedDB#type-KeyPath
+type KeyPath = types.IndexedDBKeyPath
+
+// Method names of the commands in the IndexedDB domain.
+const (
+	CommandClearObjectStore         = "IndexedDB.clearObjectStore"
+	CommandDeleteDatabase           = "IndexedDB.deleteDatabase"
+	CommandDeleteObjectStoreEntries = "IndexedDB.deleteObjectStoreEntries"
+	CommandDisable                  = "IndexedDB.disable"
+	CommandEnable                   = "IndexedDB.enable"
+	CommandRequestData              = "IndexedDB.requestData"
+	CommandGetMetadata              = "IndexedDB.getMetadata"
+	CommandRequestDatabase          = "IndexedDB.requestDatabase"
+	CommandRequestDatabaseNames     = "IndexedDB.requestDatabaseNames"
+)
+
+// ClearObjectStoreParams are the parameters of IndexedDB.clearObjectStore.
+//
+// Clears all entries from an object store.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/IndexedDB#method-clearObjectStore
+type ClearObjectStoreParams struct {
+	// At least and at most one of securityOrigin, storageKey, or storageBucket
+	// must be specified. Security origin.
+	SecurityOrigin string `json:"securityOrigin,omitempty"`
+	// Storage key.
+	StorageKey string `json:"storageKey,omitempty"`
+	// Storage bucket. If not specified, it uses the default bucket.
+	StorageBucket *types.StorageStorageBucket `json:"storageBucket,omitempty"`
+	// Database name.
+	DatabaseName string `json:"databaseName"`
+	// Object store name.
+	ObjectStoreName string `json:"objectStoreName"`
+}
+
+// Command returns the IndexedDB.clearObjectStore command for use with
+// AsyncDebugger.Send.
+func (p ClearObjectStoreParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandClearObjectStore, p)
+}
+
+// Do sends IndexedDB.clearObjectStore to d and waits for it to complete or for ctx to
+// be done.
+func (p ClearObjectStoreParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandClearObjectStore, p, nil)
+}
+
+// DeleteDatabaseParams are the parameters of IndexedDB.deleteDatabase.
+//
+// Deletes a database.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/IndexedDB#method-deleteDatabase
+type DeleteDatabaseParams struct {
+	// At least and at most one of securityOrigin, storageKey, or storageBucket
+	// must be specified. Security origin.
+	SecurityOrigin string `json:"securityOrigin,omitempty"`
+	// Storage key.
+	StorageKey string `json:"storageKey,omitempty"`
+	// Storage bucket. If not specified, it uses the default bucket.
+	StorageBucket *types.StorageStorageBucket `json:"storageBucket,omitempty"`
+	// Database name.
+	DatabaseName string `json:"databaseName"`
+}
+
+// Command returns the IndexedDB.deleteDatabase command for use with
+// AsyncDebugger.Send.
+func (p DeleteDatabaseParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandDeleteDatabase, p)
+}
+
+// Do sends IndexedDB.deleteDatabase to d and waits for it to complete or for ctx to
+// be done.
+func (p DeleteDatabaseParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandDeleteDatabase, p, nil)
+}
+
+// DeleteObjectStoreEntriesParams are the parameters of
+// IndexedDB.deleteObjectStoreEntries.
+//
+// # Delete a range of entries from an object store
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/IndexedDB#method-deleteObjectStoreEntries
+type DeleteObjectStoreEntriesParams struct {
+	// At least and at most one of securityOrigin, storageKey, or storageBucket
+	// must be specified. Security origin.
+	SecurityOrigin string `json:"securityOrigin,omitempty"`
+	// Storage key.
+	StorageKey string `json:"storageKey,omitempty"`
+	// Storage bucket. If not specified, it uses the default bucket.
+	StorageBucket   *types.StorageStorageBucket `json:"storageBucket,omitempty"`
+	DatabaseName    string                      `json:"databaseName"`
+	ObjectStoreName string                      `json:"objectStoreName"`
+	// Range of entry keys to delete
+	KeyRange KeyRange `json:"keyRange"`
+}
+
+// Command returns the IndexedDB.deleteObjectStoreEntries command for use with
+// AsyncDebugger.Send.
+func (p DeleteObjectStoreEntriesParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandDeleteObjectStoreEntries, p)
+}
+
+// Do sends IndexedDB.deleteObjectStoreEntries to d and waits for it to complete or for ctx to
+// be done.
+func (p DeleteObjectStoreEntriesParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandDeleteObjectStoreEntries, p, nil)
+}
+
+// DisableParams are the parameters of IndexedDB.disable.
+//
+// Disables events from backend.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/IndexedDB#method-disable
+type DisableParams struct {
+}
+
+// Command returns the IndexedDB.disable command for use with
+// AsyncDebugger.Send.
+func (p DisableParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandDisable, p)
+}
+
+// Do sends IndexedDB.disable to d and waits for it to complete or for ctx to
+// be done.
+func (p DisableParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandDisable, p, nil)
+}
+
+// EnableParams are the parameters of IndexedDB.enable.
+//
+// Enables events from backend.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/IndexedDB#method-enable
+type EnableParams struct {
+}
+
+// Command returns the IndexedDB.enable command for use with AsyncDebugger.Send.
+func (p EnableParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandEnable, p)
+}
+
+// Do sends IndexedDB.enable to d and waits for it to complete or for ctx to
+// be done.
+func (p EnableParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandEnable, p, nil)
+}
+
+// RequestDataParams are the parameters of IndexedDB.requestData.
+//
+// Requests data from object store or index.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/IndexedDB#method-requestData
+type RequestDataParams struct {
+	// At least and at most one of securityOrigin, storageKey, or storageBucket
+	// must be specified. Security origin.
+	SecurityOrigin string `json:"securityOrigin,omitempty"`
+	// Storage key.
+	StorageKey string `json:"storageKey,omitempty"`
+	// Storage bucket. If not specified, it uses the default bucket.
+	StorageBucket *types.StorageStorageBucket `json:"storageBucket,omitempty"`
+	// Database name.
+	DatabaseName string `json:"databaseName"`
+	// Object store name.
+	ObjectStoreName string `json:"objectStoreName"`
+	// Index name, empty string for object store data requests.
+	IndexName string `json:"indexName"`
+	// Number of records to skip.
+	SkipCount int64 `json:"skipCount"`
+	// Number of records to fetch.
+	PageSize int64 `json:"pageSize"`
+	// Key range.
+	KeyRange *KeyRange `json:"keyRange,omitempty"`
+}
+
+// Command returns the IndexedDB.requestData command for use with
+// AsyncDebugger.Send.
+func (p RequestDataParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandRequestData, p)
+}
+
+// RequestDataReturns are the values returned by IndexedDB.requestData.
+type RequestDataReturns struct {
+	// Array of object store data entries.
+	ObjectStoreDataEntries []DataEntry `json:"objectStoreDataEntries"`
+	// If true, there are more entries to fetch in the given range.
+	HasMore bool `json:"hasMore"`
+}
+
+// Do sends IndexedDB.requestData to d and waits for its result or for ctx to be
+// done.
+func (p RequestDataParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*RequestDataReturns, error) {
+	ret := &RequestDataReturns{}
+	if err := protocol.Do(ctx, d, CommandRequestData, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// GetMetadataParams are the parameters of IndexedDB.getMetadata.
+//
+// Gets metadata of an object store.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/IndexedDB#method-getMetadata
+type GetMetadataParams struct {
+	// At least and at most one of securityOrigin, storageKey, or storageBucket
+	// must be specified. Security origin.
+	SecurityOrigin string `json:"securityOrigin,omitempty"`
+	// Storage key.
+	StorageKey string `json:"storageKey,omitempty"`
+	// Storage bucket. If not specified, it uses the default bucket.
+	StorageBucket *types.StorageStorageBucket `json:"storageBucket,omitempty"`
+	// Database name.
+	DatabaseName string `json:"databaseName"`
+	// Object store name.
+	ObjectStoreName string `json:"objectStoreName"`
+}
+
+// Command returns the IndexedDB.getMetadata command for use with
+// AsyncDebugger.Send.
+func (p GetMetadataParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandGetMetadata, p)
+}
+
+// GetMetadataReturns are the values returned by IndexedDB.getMetadata.
+type GetMetadataReturns struct {
+	// the entries count
+	EntriesCount float64 `json:"entriesCount"`
+	// the current value of key generator, to become the next inserted key into the
+	// object store. Valid if objectStore.autoIncrement is true.
+	KeyGeneratorValue float64 `json:"keyGeneratorValue"`
+}
+
+// Do sends IndexedDB.getMetadata to d and waits for its result or for ctx to be
+// done.
+func (p GetMetadataParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*GetMetadataReturns, error) {
+	ret := &GetMetadataReturns{}
+	if err := protocol.Do(ctx, d, CommandGetMetadata, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// RequestDatabaseParams are the parameters of IndexedDB.requestDatabase.
+//
+// Requests database with given name in given frame.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/IndexedDB#method-requestDatabase
+type RequestDatabaseParams struct {
+	// At least and at most one of securityOrigin, storageKey, or storageBucket
+	// must be specified. Security origin.
+	SecurityOrigin string `json:"securityOrigin,omitempty"`
+	// Storage key.
+	StorageKey string `json:"storageKey,omitempty"`
+	// Storage bucket. If not specified, it uses the default bucket.
+	StorageBucket *types.StorageStorageBucket `json:"storageBucket,omitempty"`
+	// Database name.
+	DatabaseName string `json:"databaseName"`
+}
+
+// Command returns the IndexedDB.requestDatabase command for use with
+// AsyncDebugger.Send.
+func (p RequestDatabaseParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandRequestDatabase, p)
+}
+
+// RequestDatabaseReturns are the values returned by IndexedDB.requestDatabase.
+type RequestDatabaseReturns struct {
+	// Database with an array of object stores.
+	DatabaseWithObjectStores DatabaseWithObjectStores `json:"databaseWithObjectStores"`
+}
+
+// Do sends IndexedDB.requestDatabase to d and waits for its result or for ctx to be
+// done.
+func (p RequestDatabaseParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*RequestDatabaseReturns, error) {
+	ret := &RequestDatabaseReturns{}
+	if err := protocol.Do(ctx, d, CommandRequestDatabase, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// RequestDatabaseNamesParams are the parameters of
+// IndexedDB.requestDatabaseNames.
+//
+// Requests database names for given security origin.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/IndexedDB#method-requestDatabaseNames
+type RequestDatabaseNamesParams struct {
+	// At least and at most one of securityOrigin, storageKey, or storageBucket
+	// must be specified. Security origin.
+	SecurityOrigin string `json:"securityOrigin,omitempty"`
+	// Storage key.
+	StorageKey string `json:"storageKey,omitempty"`
+	// Storage bucket. If not specified, it uses the default bucket.
+	StorageBucket *types.StorageStorageBucket `json:"storageBucket,omitempty"`
+}
+
+// Command returns the IndexedDB.requestDatabaseNames command for use with
+// AsyncDebugger.Send.
+func (p RequestDatabaseNamesParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandRequestDatabaseNames, p)
+}
+
+// RequestDatabaseNamesReturns are the values returned by IndexedDB.requestDatabaseNames.
+type RequestDatabaseNamesReturns struct {
+	// Database names for origin.
+	DatabaseNames []string `json:"databaseNames"`
+}
+
+// Do sends IndexedDB.requestDatabaseNames to d and waits for its result or for ctx to be
+// done.
+func (p RequestDatabaseNamesParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*RequestDatabaseNamesReturns, error) {
+	ret := &RequestDatabaseNamesReturns{}
+	if err := protocol.Do(ctx, d, CommandRequestDatabaseNames, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
@@ -0,0 +1,614 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+// Package input provides typed bindings for the Input domain of the Chrome
+// DevTools protocol.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Input
+package input
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tonyhb/chromedebugo"
+	"github.com/tonyhb/chromedebugo/protocol"
+	"github.com/tonyhb/chromedebugo/protocol/internal/types"
+)
+
+// TouchPoint is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Input#type-TouchPoint
+type TouchPoint = types.InputTouchPoint
+
+// GestureSourceType is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Input#type-GestureSourceType
+//
+// This is an experimental part of the protocol.
+type GestureSourceType = types.InputGestureSourceType
+
+// MouseButton is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Input#type-MouseButton
+type MouseButton = types.InputMouseButton
+
+// TimeSinceEpoch UTC time in seconds, counted from January 1, 1970.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Input#type-TimeSinceEpoch
+type TimeSinceEpoch = types.InputTimeSinceEpoch
+
+// DragDataItem is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Input#type-DragDataItem
+//
+// This is an experimental part of the protocol.
+type DragDataItem = types.InputDragDataItem
+
+// DragData is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Input#type-DragData
+//
+// This is an experimental part of the protocol.
+type DragData = types.InputDragData
+
+// Values of the enumerated types in the Input domain.
+const (
+	GestureSourceTypeDefault = types.InputGestureSourceTypeDefault
+	GestureSourceTypeTouch   = types.InputGestureSourceTypeTouch
+	GestureSourceTypeMouse   = types.InputGestureSourceTypeMouse
+	MouseButtonNone          = types.InputMouseButtonNone
+	MouseButtonLeft          = types.InputMouseButtonLeft
+	MouseButtonMiddle        = types.InputMouseButtonMiddle
+	MouseButtonRight         = types.InputMouseButtonRight
+	MouseButtonBack          = types.InputMouseButtonBack
+	MouseButtonForward       = types.InputMouseButtonForward
+)
+
+// Method names of the commands in the Input domain.
+const (
+	CommandDispatchDragEvent          = "Input.dispatchDragEvent"
+	CommandDispatchKeyEvent           = "Input.dispatchKeyEvent"
+	CommandInsertText                 = "Input.insertText"
+	CommandImeSetComposition          = "Input.imeSetComposition"
+	CommandDispatchMouseEvent         = "Input.dispatchMouseEvent"
+	CommandDispatchTouchEvent         = "Input.dispatchTouchEvent"
+	CommandCancelDragging             = "Input.cancelDragging"
+	CommandEmulateTouchFromMouseEvent = "Input.emulateTouchFromMouseEvent"
+	CommandSetIgnoreInputEvents       = "Input.setIgnoreInputEvents"
+	CommandSetInterceptDrags          = "Input.setInterceptDrags"
+	CommandSynthesizePinchGesture     = "Input.synthesizePinchGesture"
+	CommandSynthesizeScrollGesture    = "Input.synthesizeScrollGesture"
+	CommandSynthesizeTapGesture       = "Input.synthesizeTapGesture"
+)
+
+// DispatchDragEventParams are the parameters of Input.dispatchDragEvent.
+//
+// Dispatches a drag event into the page.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Input#method-dispatchDragEvent
+//
+// This is an experimental part of the protocol.
+type DispatchDragEventParams struct {
+	// Type of the drag event.
+	//
+	// Allowed values: dragEnter, dragOver, drop, dragCancel.
+	Type string `json:"type"`
+	// X coordinate of the event relative to the main frame's viewport in CSS
+	// pixels.
+	X float64 `json:"x"`
+	// Y coordinate of the event relative to the main frame's viewport in CSS
+	// pixels. 0 refers to the top of the viewport and Y increases as it proceeds
+	// towards the bottom of the viewport.
+	Y    float64  `json:"y"`
+	Data DragData `json:"data"`
+	// Bit field representing pressed modifier keys. Alt=1, Ctrl=2, Meta/Command=4,
+	// Shift=8 (default: 0).
+	Modifiers int64 `json:"modifiers,omitempty"`
+}
+
+// Command returns the Input.dispatchDragEvent command for use with
+// AsyncDebugger.Send.
+func (p DispatchDragEventParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandDispatchDragEvent, p)
+}
+
+// Do sends Input.dispatchDragEvent to d and waits for it to complete or for ctx to
+// be done.
+func (p DispatchDragEventParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandDispatchDragEvent, p, nil)
+}
+
+// DispatchKeyEventParams are the parameters of Input.dispatchKeyEvent.
+//
+// Dispatches a key event to the page.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Input#method-dispatchKeyEvent
+type DispatchKeyEventParams struct {
+	// Type of the key event.
+	//
+	// Allowed values: keyDown, keyUp, rawKeyDown, char.
+	Type string `json:"type"`
+	// Bit field representing pressed modifier keys. Alt=1, Ctrl=2, Meta/Command=4,
+	// Shift=8 (default: 0).
+	Modifiers int64 `json:"modifiers,omitempty"`
+	// Time at which the event occurred.
+	Timestamp TimeSinceEpoch `json:"timestamp,omitempty"`
+	// Text as generated by processing a virtual key code with a keyboard layout.
+	// Not needed for for `keyUp` and `rawKeyDown` events (default: "")
+	Text string `json:"text,omitempty"`
+	// Text that would have been generated by the keyboard if no modifiers were
+	// pressed (except for shift). Useful for shortcut (accelerator) key handling
+	// (default: "").
+	UnmodifiedText string `json:"unmodifiedText,omitempty"`
+	// Unique key identifier (e.g., 'U+0041') (default: "").
+	KeyIdentifier string `json:"keyIdentifier,omitempty"`
+	// Unique DOM defined string value for each physical key (e.g., 'KeyA')
+	// (default: "").
+	Code string `json:"code,omitempty"`
+	// Unique DOM defined string value describing the meaning of the key in the
+	// context of active modifiers, keyboard layout, etc (e.g., 'AltGr') (default:
+	// "").
+	Key string `json:"key,omitempty"`
+	// Windows virtual key code (default: 0).
+	WindowsVirtualKeyCode int64 `json:"windowsVirtualKeyCode,omitempty"`
+	// Native virtual key code (default: 0).
+	NativeVirtualKeyCode int64 `json:"nativeVirtualKeyCode,omitempty"`
+	// Whether the event was generated from auto repeat (default: false).
+	AutoRepeat bool `json:"autoRepeat,omitempty"`
+	// Whether the event was generated from the keypad (default: false).
+	IsKeypad bool `json:"isKeypad,omitempty"`
+	// Whether the event was a system key event (default: false).
+	IsSystemKey bool `json:"isSystemKey,omitempty"`
+	// Whether the event was from the left or right side of the keyboard. 1=Left,
+	// 2=Right (default: 0).
+	Location int64 `json:"location,omitempty"`
+	// Editing commands to send with the key event (e.g., 'selectAll') (default:
+	// []). These are related to but not equal the command names used in
+	// `document.execCommand` and NSStandardKeyBindingResponding. See
+	// https://source.chromium.org/chromium/chromium/src/+/main:third_party/blink/renderer/core/editing/commands/editor_command_names.h
+	// for valid command names.
+	//
+	// This is an experimental part of the protocol.
+	Commands []string `json:"commands,omitempty"`
+}
+
+// Command returns the Input.dispatchKeyEvent command for use with
+// AsyncDebugger.Send.
+func (p DispatchKeyEventParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandDispatchKeyEvent, p)
+}
+
+// Do sends Input.dispatchKeyEvent to d and waits for it to complete or for ctx to
+// be done.
+func (p DispatchKeyEventParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandDispatchKeyEvent, p, nil)
+}
+
+// InsertTextParams are the parameters of Input.insertText.
+//
+// This method emulates inserting text that doesn't come from a key press, for
+// example an emoji keyboard or an IME.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Input#method-insertText
+//
+// This is an experimental part of the protocol.
+type InsertTextParams struct {
+	// The text to insert.
+	Text string `json:"text"`
+}
+
+// Command returns the Input.insertText command for use with AsyncDebugger.Send.
+func (p InsertTextParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandInsertText, p)
+}
+
+// Do sends Input.insertText to d and waits for it to complete or for ctx to
+// be done.
+func (p InsertTextParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandInsertText, p, nil)
+}
+
+// ImeSetCompositionParams are the parameters of Input.imeSetComposition.
+//
+// This method sets the current candidate text for IME. Use imeCommitComposition
+// to commit the final text. Use imeSetComposition with empty string as text to
+// cancel composition.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Input#method-imeSetComposition
+//
+// This is an experimental part of the protocol.
+type ImeSetCompositionParams struct {
+	// The text to insert
+	Text string `json:"text"`
+	// selection start
+	SelectionStart int64 `json:"selectionStart"`
+	// selection end
+	SelectionEnd int64 `json:"selectionEnd"`
+	// replacement start
+	ReplacementStart int64 `json:"replacementStart,omitempty"`
+	// replacement end
+	ReplacementEnd int64 `json:"replacementEnd,omitempty"`
+}
+
+// Command returns the Input.imeSetComposition command for use with
+// AsyncDebugger.Send.
+func (p ImeSetCompositionParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandImeSetComposition, p)
+}
+
+// Do sends Input.imeSetComposition to d and waits for it to complete or for ctx to
+// be done.
+func (p ImeSetCompositionParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandImeSetComposition, p, nil)
+}
+
+// DispatchMouseEventParams are the parameters of Input.dispatchMouseEvent.
+//
+// Dispatches a mouse event to the page.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Input#method-dispatchMouseEvent
+type DispatchMouseEventParams struct {
+	// Type of the mouse event.
+	//
+	// Allowed values: mousePressed, mouseReleased, mouseMoved, mouseWheel.
+	Type string `json:"type"`
+	// X coordinate of the event relative to the main frame's viewport in CSS
+	// pixels.
+	X float64 `json:"x"`
+	// Y coordinate of the event relative to the main frame's viewport in CSS
+	// pixels. 0 refers to the top of the viewport and Y increases as it proceeds
+	// towards the bottom of the viewport.
+	Y float64 `json:"y"`
+	// Bit field representing pressed modifier keys. Alt=1, Ctrl=2, Meta/Command=4,
+	// Shift=8 (default: 0).
+	Modifiers int64 `json:"modifiers,omitempty"`
+	// Time at which the event occurred.
+	Timestamp TimeSinceEpoch `json:"timestamp,omitempty"`
+	// Mouse button (default: "none").
+	Button MouseButton `json:"button,omitempty"`
+	// A number indicating which buttons are pressed on the mouse when a mouse
+	// event is triggered. Left=1, Right=2, Middle=4, Back=8, Forward=16, None=0.
+	Buttons int64 `json:"buttons,omitempty"`
+	// Number of times the mouse button was clicked (default: 0).
+	ClickCount int64 `json:"clickCount,omitempty"`
+	// The normalized pressure, which has a range of [0,1] (default: 0).
+	//
+	// This is an experimental part of the protocol.
+	Force float64 `json:"force,omitempty"`
+	// The normalized tangential pressure, which has a range of [-1,1] (default:
+	// 0).
+	//
+	// This is an experimental part of the protocol.
+	TangentialPressure float64 `json:"tangentialPressure,omitempty"`
+	// The plane angle between the Y-Z plane and the plane containing both the
+	// stylus axis and the Y axis, in degrees of the range [-90,90], a positive
+	// tiltX is to the right (default: 0).
+	TiltX float64 `json:"tiltX,omitempty"`
+	// The plane angle between the X-Z plane and the plane containing both the
+	// stylus axis and the X axis, in degrees of the range [-90,90], a positive
+	// tiltY is towards the user (default: 0).
+	TiltY float64 `json:"tiltY,omitempty"`
+	// The clockwise rotation of a pen stylus around its own major axis, in degrees
+	// in the range [0,359] (default: 0).
+	//
+	// This is an experimental part of the protocol.
+	Twist int64 `json:"twist,omitempty"`
+	// X delta in CSS pixels for mouse wheel event (default: 0).
+	DeltaX float64 `json:"deltaX,omitempty"`
+	// Y delta in CSS pixels for mouse wheel event (default: 0).
+	DeltaY float64 `json:"deltaY,omitempty"`
+	// Pointer type (default: "mouse").
+	//
+	// Allowed values: mouse, pen.
+	PointerType string `json:"pointerType,omitempty"`
+}
+
+// Command returns the Input.dispatchMouseEvent command for use with
+// AsyncDebugger.Send.
+func (p DispatchMouseEventParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandDispatchMouseEvent, p)
+}
+
+// Do sends Input.dispatchMouseEvent to d and waits for it to complete or for ctx to
+// be done.
+func (p DispatchMouseEventParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandDispatchMouseEvent, p, nil)
+}
+
+// DispatchTouchEventParams are the parameters of Input.dispatchTouchEvent.
+//
+// Dispatches a touch event to the page.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Input#method-dispatchTouchEvent
+type DispatchTouchEventParams struct {
+	// Type of the touch event. TouchEnd and TouchCancel must not contain any touch
+	// points, while TouchStart and TouchMove must contains at least one.
+	//
+	// Allowed values: touchStart, touchEnd, touchMove, touchCancel.
+	Type string `json:"type"`
+	// Active touch points on the touch device. One event per any changed point
+	// (compared to previous touch event in a sequence) is generated, emulating
+	// pressing/moving/releasing points one by one.
+	TouchPoints []TouchPoint `json:"touchPoints"`
+	// Bit field representing pressed modifier keys. Alt=1, Ctrl=2, Meta/Command=4,
+	// Shift=8 (default: 0).
+	Modifiers int64 `json:"modifiers,omitempty"`
+	// Time at which the event occurred.
+	Timestamp TimeSinceEpoch `json:"timestamp,omitempty"`
+}
+
+// Command returns the Input.dispatchTouchEvent command for use with
+// AsyncDebugger.Send.
+func (p DispatchTouchEventParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandDispatchTouchEvent, p)
+}
+
+// Do sends Input.dispatchTouchEvent to d and waits for it to complete or for ctx to
+// be done.
+func (p DispatchTouchEventParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandDispatchTouchEvent, p, nil)
+}
+
+// CancelDraggingParams are the parameters of Input.cancelDragging.
+//
+// Cancels any active dragging in the page.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Input#method-cancelDragging
+type CancelDraggingParams struct {
+}
+
+// Command returns the Input.cancelDragging command for use with
+// AsyncDebugger.Send.
+func (p CancelDraggingParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandCancelDragging, p)
+}
+
+// Do sends Input.cancelDragging to d and waits for it to complete or for ctx to
+// be done.
+func (p CancelDraggingParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandCancelDragging, p, nil)
+}
+
+// EmulateTouchFromMouseEventParams are the parameters of
+// Input.emulateTouchFromMouseEvent.
+//
+// Emulates touch event from the mouse event parameters.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Input#method-emulateTouchFromMouseEvent
+//
+// This is an experimental part of the protocol.
+type EmulateTouchFromMouseEventParams struct {
+	// Type of the mouse event.
+	//
+	// Allowed values: mousePressed, mouseReleased, mouseMoved, mouseWheel.
+	Type string `json:"type"`
+	// X coordinate of the mouse pointer in DIP.
+	X int64 `json:"x"`
+	// Y coordinate of the mouse pointer in DIP.
+	Y int64 `json:"y"`
+	// Mouse button. Only "none", "left", "right" are supported.
+	Button MouseButton `json:"button"`
+	// Time at which the event occurred (default: current time).
+	Timestamp TimeSinceEpoch `json:"timestamp,omitempty"`
+	// X delta in DIP for mouse wheel event (default: 0).
+	DeltaX float64 `json:"deltaX,omitempty"`
+	// Y delta in DIP for mouse wheel event (default: 0).
+	DeltaY float64 `json:"deltaY,omitempty"`
+	// Bit field representing pressed modifier keys. Alt=1, Ctrl=2, Meta/Command=4,
+	// Shift=8 (default: 0).
+	Modifiers int64 `json:"modifiers,omitempty"`
+	// Number of times the mouse button was clicked (default: 0).
+	ClickCount int64 `json:"clickCount,omitempty"`
+}
+
+// Command returns the Input.emulateTouchFromMouseEvent command for use with
+// AsyncDebugger.Send.
+func (p EmulateTouchFromMouseEventParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandEmulateTouchFromMouseEvent, p)
+}
+
+// Do sends Input.emulateTouchFromMouseEvent to d and waits for it to complete or for ctx to
+// be done.
+func (p EmulateTouchFromMouseEventParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandEmulateTouchFromMouseEvent, p, nil)
+}
+
+// SetIgnoreInputEventsParams are the parameters of Input.setIgnoreInputEvents.
+//
+// Ignores input events (useful while auditing page).
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Input#method-setIgnoreInputEvents
+type SetIgnoreInputEventsParams struct {
+	// Ignores input events processing when set to true.
+	Ignore bool `json:"ignore"`
+}
+
+// Command returns the Input.setIgnoreInputEvents command for use with
+// AsyncDebugger.Send.
+func (p SetIgnoreInputEventsParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetIgnoreInputEvents, p)
+}
+
+// Do sends Input.setIgnoreInputEvents to d and waits for it to complete or for ctx to
+// be done.
+func (p SetIgnoreInputEventsParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetIgnoreInputEvents, p, nil)
+}
+
+// SetInterceptDragsParams are the parameters of Input.setInterceptDrags.
+//
+// Prevents default drag and drop behavior and instead emits
+// `Input.dragIntercepted` events. Drag and drop behavior can be directly
+// controlled via `Input.dispatchDragEvent`.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Input#method-setInterceptDrags
+//
+// This is an experimental part of the protocol.
+type SetInterceptDragsParams struct {
+	Enabled bool `json:"enabled"`
+}
+
+// Command returns the Input.setInterceptDrags command for use with
+// AsyncDebugger.Send.
+func (p SetInterceptDragsParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetInterceptDrags, p)
+}
+
+// Do sends Input.setInterceptDrags to d and waits for it to complete or for ctx to
+// be done.
+func (p SetInterceptDragsParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetInterceptDrags, p, nil)
+}
+
+// SynthesizePinchGestureParams are the parameters of
+// Input.synthesizePinchGesture.
+//
+// Synthesizes a pinch gesture over a time period by issuing appropriate touch
+// events.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Input#method-synthesizePinchGesture
+//
+// This is an experimental part of the protocol.
+type SynthesizePinchGestureParams struct {
+	// X coordinate of the start of the gesture in CSS pixels.
+	X float64 `json:"x"`
+	// Y coordinate of the start of the gesture in CSS pixels.
+	Y float64 `json:"y"`
+	// Relative scale factor after zooming (>1.0 zooms in, <1.0 zooms out).
+	ScaleFactor float64 `json:"scaleFactor"`
+	// Relative pointer speed in pixels per second (default: 800).
+	RelativeSpeed int64 `json:"relativeSpeed,omitempty"`
+	// Which type of input events to be generated (default: 'default', which
+	// queries the platform for the preferred input type).
+	GestureSourceType GestureSourceType `json:"gestureSourceType,omitempty"`
+}
+
+// Command returns the Input.synthesizePinchGesture command for use with
+// AsyncDebugger.Send.
+func (p SynthesizePinchGestureParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSynthesizePinchGesture, p)
+}
+
+// Do sends Input.synthesizePinchGesture to d and waits for it to complete or for ctx to
+// be done.
+func (p SynthesizePinchGestureParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSynthesizePinchGesture, p, nil)
+}
+
+// SynthesizeScrollGestureParams are the parameters of
+// Input.synthesizeScrollGesture.
+//
+// Synthesizes a scroll gesture over a time period by issuing appropriate touch
+// events.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Input#method-synthesizeScrollGesture
+//
+// This is an experimental part of the protocol.
+type SynthesizeScrollGestureParams struct {
+	// X coordinate of the start of the gesture in CSS pixels.
+	X float64 `json:"x"`
+	// Y coordinate of the start of the gesture in CSS pixels.
+	Y float64 `json:"y"`
+	// The distance to scroll along the X axis (positive to scroll left).
+	XDistance float64 `json:"xDistance,omitempty"`
+	// The distance to scroll along the Y axis (positive to scroll up).
+	YDistance float64 `json:"yDistance,omitempty"`
+	// The number of additional pixels to scroll back along the X axis, in addition
+	// to the given distance.
+	XOverscroll float64 `json:"xOverscroll,omitempty"`
+	// The number of additional pixels to scroll back along the Y axis, in addition
+	// to the given distance.
+	YOverscroll float64 `json:"yOverscroll,omitempty"`
+	// Prevent fling (default: true).
+	PreventFling bool `json:"preventFling,omitempty"`
+	// Swipe speed in pixels per second (default: 800).
+	Speed int64 `json:"speed,omitempty"`
+	// Which type of input events to be generated (default: 'default', which
+	// queries the platform for the preferred input type).
+	GestureSourceType GestureSourceType `json:"gestureSourceType,omitempty"`
+	// The number of times to repeat the gesture (default: 0).
+	RepeatCount int64 `json:"repeatCount,omitempty"`
+	// The number of milliseconds delay between each repeat. (default: 250).
+	RepeatDelayMs int64 `json:"repeatDelayMs,omitempty"`
+	// The name of the interaction markers to generate, if not empty (default: "").
+	InteractionMarkerName string `json:"interactionMarkerName,omitempty"`
+}
+
+// Command returns the Input.synthesizeScrollGesture command for use with
+// AsyncDebugger.Send.
+func (p SynthesizeScrollGestureParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSynthesizeScrollGesture, p)
+}
+
+// Do sends Input.synthesizeScrollGesture to d and waits for it to complete or for ctx to
+// be done.
+func (p SynthesizeScrollGestureParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSynthesizeScrollGesture, p, nil)
+}
+
+// SynthesizeTapGestureParams are the parameters of Input.synthesizeTapGesture.
+//
+// Synthesizes a tap gesture over a time period by issuing appropriate touch
+// events.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Input#method-synthesizeTapGesture
+//
+// This is an experimental part of the protocol.
+type SynthesizeTapGestureParams struct {
+	// X coordinate of the start of the gesture in CSS pixels.
+	X float64 `json:"x"`
+	// Y coordinate of the start of the gesture in CSS pixels.
+	Y float64 `json:"y"`
+	// Duration between touchdown and touchup events in ms (default: 50).
+	Duration int64 `json:"duration,omitempty"`
+	// Number of times to perform the tap (e.g. 2 for double tap, default: 1).
+	TapCount int64 `json:"tapCount,omitempty"`
+	// Which type of input events to be generated (default: 'default', which
+	// queries the platform for the preferred input type).
+	GestureSourceType GestureSourceType `json:"gestureSourceType,omitempty"`
+}
+
+// Command returns the Input.synthesizeTapGesture command for use with
+// AsyncDebugger.Send.
+func (p SynthesizeTapGestureParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSynthesizeTapGesture, p)
+}
+
+// Do sends Input.synthesizeTapGesture to d and waits for it to complete or for ctx to
+// be done.
+func (p SynthesizeTapGestureParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSynthesizeTapGesture, p, nil)
+}
+
+// Method names of the events in the Input domain.
+const (
+	EventDragIntercepted = "Input.dragIntercepted"
+)
+
+// DragInterceptedEvent is the payload of the Input.dragIntercepted event.
+//
+// Emitted only when `Input.setInterceptDrags` is enabled. Use this data with
+// `Input.dispatchDragEvent` to restore normal drag and drop behavior.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Input#event-dragIntercepted
+//
+// This is an experimental part of the protocol.
+type DragInterceptedEvent struct {
+	Data DragData `json:"data"`
+}
+
+// ParseEvent decodes an event from the Input domain, as received from
+// Subscribe, into a pointer to its typed payload.
+func ParseEvent(cmd chromedebugo.Command) (interface{}, error) {
+	var ev interface{}
+	switch cmd.Method {
+	case EventDragIntercepted:
+		ev = &DragInterceptedEvent{}
+	default:
+		return nil, fmt.Errorf("unknown Input event: %s", cmd.Method)
+	}
+	if err := cmd.Decode(ev); err != nil {
+		return nil, err
+	}
+	return ev, nil
+}
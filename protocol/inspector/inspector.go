@@ -0,0 +1,117 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+// Package inspector provides typed bindings for the Inspector domain of the
+// Chrome DevTools protocol.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Inspector
+//
+// This is an experimental part of the protocol.
+package inspector
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tonyhb/chromedebugo"
+	"github.com/tonyhb/chromedebugo/protocol"
+)
+
+// Method names of the commands in the Inspector domain.
+const (
+	CommandDisable = "Inspector.disable"
+	CommandEnable  = "Inspector.enable"
+)
+
+// DisableParams are the parameters of Inspector.disable.
+//
+// Disables inspector domain notifications.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Inspector#method-disable
+type DisableParams struct {
+}
+
+// Command returns the Inspector.disable command for use with
+// AsyncDebugger.Send.
+func (p DisableParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandDisable, p)
+}
+
+// Do sends Inspector.disable to d and waits for it to complete or for ctx to
+// be done.
+func (p DisableParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandDisable, p, nil)
+}
+
+// EnableParams are the parameters of Inspector.enable.
+//
+// Enables inspector domain notifications.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Inspector#method-enable
+type EnableParams struct {
+}
+
+// Command returns the Inspector.enable command for use with AsyncDebugger.Send.
+func (p EnableParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandEnable, p)
+}
+
+// Do sends Inspector.enable to d and waits for it to complete or for ctx to
+// be done.
+func (p EnableParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandEnable, p, nil)
+}
+
+// Method names of the events in the Inspector domain.
+const (
+	EventDetached                 = "Inspector.detached"
+	EventTargetCrashed            = "Inspector.targetCrashed"
+	EventTargetReloadedAfterCrash = "Inspector.targetReloadedAfterCrash"
+)
+
+// DetachedEvent is the payload of the Inspector.detached event.
+//
+// Fired when remote debugging connection is about to be terminated. Contains
+// detach reason.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Inspector#event-detached
+type DetachedEvent struct {
+	// The reason why connection has been terminated.
+	Reason string `json:"reason"`
+}
+
+// TargetCrashedEvent is the payload of the Inspector.targetCrashed event.
+//
+// # Fired when debugging target has crashed
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Inspector#event-targetCrashed
+type TargetCrashedEvent struct {
+}
+
+// TargetReloadedAfterCrashEvent is the payload of the
+// Inspector.targetReloadedAfterCrash event.
+//
+// # Fired when debugging target has reloaded after crash
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Inspector#event-targetReloadedAfterCrash
+type TargetReloadedAfterCrashEvent struct {
+}
+
+// ParseEvent decodes an event from the Inspector domain, as received from
+// Subscribe, into a pointer to its typed payload.
+func ParseEvent(cmd chromedebugo.Command) (interface{}, error) {
+	var ev interface{}
+	switch cmd.Method {
+	case EventDetached:
+		ev = &DetachedEvent{}
+	case EventTargetCrashed:
+		ev = &TargetCrashedEvent{}
+	case EventTargetReloadedAfterCrash:
+		ev = &TargetReloadedAfterCrashEvent{}
+	default:
+		return nil, fmt.Errorf("unknown Inspector event: %s", cmd.Method)
+	}
+	if err := cmd.Decode(ev); err != nil {
+		return nil, err
+	}
+	return ev, nil
+}
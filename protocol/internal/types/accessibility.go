@@ -0,0 +1,221 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package types
+
+import (
+	"encoding/json"
+)
+
+// AccessibilityAXNodeID unique accessibility node identifier.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Accessibility#type-AXNodeId
+type AccessibilityAXNodeID string
+
+// AccessibilityAXValueType enum of possible property types.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Accessibility#type-AXValueType
+type AccessibilityAXValueType string
+
+// Values of AccessibilityAXValueType.
+const (
+	AccessibilityAXValueTypeBoolean            AccessibilityAXValueType = "boolean"
+	AccessibilityAXValueTypeTristate           AccessibilityAXValueType = "tristate"
+	AccessibilityAXValueTypeBooleanOrUndefined AccessibilityAXValueType = "booleanOrUndefined"
+	AccessibilityAXValueTypeIdref              AccessibilityAXValueType = "idref"
+	AccessibilityAXValueTypeIdrefList          AccessibilityAXValueType = "idrefList"
+	AccessibilityAXValueTypeInteger            AccessibilityAXValueType = "integer"
+	AccessibilityAXValueTypeNode               AccessibilityAXValueType = "node"
+	AccessibilityAXValueTypeNodeList           AccessibilityAXValueType = "nodeList"
+	AccessibilityAXValueTypeNumber             AccessibilityAXValueType = "number"
+	AccessibilityAXValueTypeString             AccessibilityAXValueType = "string"
+	AccessibilityAXValueTypeComputedString     AccessibilityAXValueType = "computedString"
+	AccessibilityAXValueTypeToken              AccessibilityAXValueType = "token"
+	AccessibilityAXValueTypeTokenList          AccessibilityAXValueType = "tokenList"
+	AccessibilityAXValueTypeDOMRelation        AccessibilityAXValueType = "domRelation"
+	AccessibilityAXValueTypeRole               AccessibilityAXValueType = "role"
+	AccessibilityAXValueTypeInternalRole       AccessibilityAXValueType = "internalRole"
+	AccessibilityAXValueTypeValueUndefined     AccessibilityAXValueType = "valueUndefined"
+)
+
+// AccessibilityAXValueSourceType enum of possible property sources.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Accessibility#type-AXValueSourceType
+type AccessibilityAXValueSourceType string
+
+// Values of AccessibilityAXValueSourceType.
+const (
+	AccessibilityAXValueSourceTypeAttribute      AccessibilityAXValueSourceType = "attribute"
+	AccessibilityAXValueSourceTypeImplicit       AccessibilityAXValueSourceType = "implicit"
+	AccessibilityAXValueSourceTypeStyle          AccessibilityAXValueSourceType = "style"
+	AccessibilityAXValueSourceTypeContents       AccessibilityAXValueSourceType = "contents"
+	AccessibilityAXValueSourceTypePlaceholder    AccessibilityAXValueSourceType = "placeholder"
+	AccessibilityAXValueSourceTypeRelatedElement AccessibilityAXValueSourceType = "relatedElement"
+)
+
+// AccessibilityAXValueNativeSourceType enum of possible native property sources
+// (as a subtype of a particular AXValueSourceType).
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Accessibility#type-AXValueNativeSourceType
+type AccessibilityAXValueNativeSourceType string
+
+// Values of AccessibilityAXValueNativeSourceType.
+const (
+	AccessibilityAXValueNativeSourceTypeDescription    AccessibilityAXValueNativeSourceType = "description"
+	AccessibilityAXValueNativeSourceTypeFigcaption     AccessibilityAXValueNativeSourceType = "figcaption"
+	AccessibilityAXValueNativeSourceTypeLabel          AccessibilityAXValueNativeSourceType = "label"
+	AccessibilityAXValueNativeSourceTypeLabelfor       AccessibilityAXValueNativeSourceType = "labelfor"
+	AccessibilityAXValueNativeSourceTypeLabelwrapped   AccessibilityAXValueNativeSourceType = "labelwrapped"
+	AccessibilityAXValueNativeSourceTypeLegend         AccessibilityAXValueNativeSourceType = "legend"
+	AccessibilityAXValueNativeSourceTypeRubyannotation AccessibilityAXValueNativeSourceType = "rubyannotation"
+	AccessibilityAXValueNativeSourceTypeTablecaption   AccessibilityAXValueNativeSourceType = "tablecaption"
+	AccessibilityAXValueNativeSourceTypeTitle          AccessibilityAXValueNativeSourceType = "title"
+	AccessibilityAXValueNativeSourceTypeOther          AccessibilityAXValueNativeSourceType = "other"
+)
+
+// AccessibilityAXValueSource a single source for a computed AX property.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Accessibility#type-AXValueSource
+type AccessibilityAXValueSource struct {
+	// What type of source this is.
+	Type AccessibilityAXValueSourceType `json:"type"`
+	// The value of this property source.
+	Value *AccessibilityAXValue `json:"value,omitempty"`
+	// The name of the relevant attribute, if any.
+	Attribute string `json:"attribute,omitempty"`
+	// The value of the relevant attribute, if any.
+	AttributeValue *AccessibilityAXValue `json:"attributeValue,omitempty"`
+	// Whether this source is superseded by a higher priority source.
+	Superseded bool `json:"superseded,omitempty"`
+	// The native markup source for this value, e.g. a `<label>` element.
+	NativeSource AccessibilityAXValueNativeSourceType `json:"nativeSource,omitempty"`
+	// The value, such as a node or node list, of the native source.
+	NativeSourceValue *AccessibilityAXValue `json:"nativeSourceValue,omitempty"`
+	// Whether the value for this property is invalid.
+	Invalid bool `json:"invalid,omitempty"`
+	// Reason for the value being invalid, if it is.
+	InvalidReason string `json:"invalidReason,omitempty"`
+}
+
+// AccessibilityAXRelatedNode is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Accessibility#type-AXRelatedNode
+type AccessibilityAXRelatedNode struct {
+	// The BackendNodeId of the related DOM node.
+	BackendDOMNodeID DOMBackendNodeID `json:"backendDOMNodeId"`
+	// The IDRef value provided, if any.
+	Idref string `json:"idref,omitempty"`
+	// The text alternative of this node in the current context.
+	Text string `json:"text,omitempty"`
+}
+
+// AccessibilityAXProperty is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Accessibility#type-AXProperty
+type AccessibilityAXProperty struct {
+	// The name of this property.
+	Name AccessibilityAXPropertyName `json:"name"`
+	// The value of this property.
+	Value AccessibilityAXValue `json:"value"`
+}
+
+// AccessibilityAXValue a single computed AX property.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Accessibility#type-AXValue
+type AccessibilityAXValue struct {
+	// The type of this value.
+	Type AccessibilityAXValueType `json:"type"`
+	// The computed value of this property.
+	Value json.RawMessage `json:"value,omitempty"`
+	// One or more related nodes, if applicable.
+	RelatedNodes []AccessibilityAXRelatedNode `json:"relatedNodes,omitempty"`
+	// The sources which contributed to the computation of this property.
+	Sources []AccessibilityAXValueSource `json:"sources,omitempty"`
+}
+
+// AccessibilityAXPropertyName values of AXProperty name:
+// - from 'busy' to 'roledescription': states which apply to every AX node
+// - from 'live' to 'root': attributes which apply to nodes in live regions
+// - from 'autocomplete' to 'valuetext': attributes which apply to widgets
+// - from 'checked' to 'selected': states which apply to widgets
+// - from 'activedescendant' to 'owns' - relationships between elements other
+// than parent/child/sibling.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Accessibility#type-AXPropertyName
+type AccessibilityAXPropertyName string
+
+// Values of AccessibilityAXPropertyName.
+const (
+	AccessibilityAXPropertyNameActions          AccessibilityAXPropertyName = "actions"
+	AccessibilityAXPropertyNameBusy             AccessibilityAXPropertyName = "busy"
+	AccessibilityAXPropertyNameDisabled         AccessibilityAXPropertyName = "disabled"
+	AccessibilityAXPropertyNameEditable         AccessibilityAXPropertyName = "editable"
+	AccessibilityAXPropertyNameFocusable        AccessibilityAXPropertyName = "focusable"
+	AccessibilityAXPropertyNameFocused          AccessibilityAXPropertyName = "focused"
+	AccessibilityAXPropertyNameHidden           AccessibilityAXPropertyName = "hidden"
+	AccessibilityAXPropertyNameHiddenRoot       AccessibilityAXPropertyName = "hiddenRoot"
+	AccessibilityAXPropertyNameInvalid          AccessibilityAXPropertyName = "invalid"
+	AccessibilityAXPropertyNameKeyshortcuts     AccessibilityAXPropertyName = "keyshortcuts"
+	AccessibilityAXPropertyNameSettable         AccessibilityAXPropertyName = "settable"
+	AccessibilityAXPropertyNameRoledescription  AccessibilityAXPropertyName = "roledescription"
+	AccessibilityAXPropertyNameLive             AccessibilityAXPropertyName = "live"
+	AccessibilityAXPropertyNameAtomic           AccessibilityAXPropertyName = "atomic"
+	AccessibilityAXPropertyNameRelevant         AccessibilityAXPropertyName = "relevant"
+	AccessibilityAXPropertyNameRoot             AccessibilityAXPropertyName = "root"
+	AccessibilityAXPropertyNameAutocomplete     AccessibilityAXPropertyName = "autocomplete"
+	AccessibilityAXPropertyNameHasPopup         AccessibilityAXPropertyName = "hasPopup"
+	AccessibilityAXPropertyNameLevel            AccessibilityAXPropertyName = "level"
+	AccessibilityAXPropertyNameMultiselectable  AccessibilityAXPropertyName = "multiselectable"
+	AccessibilityAXPropertyNameOrientation      AccessibilityAXPropertyName = "orientation"
+	AccessibilityAXPropertyNameMultiline        AccessibilityAXPropertyName = "multiline"
+	AccessibilityAXPropertyNameReadonly         AccessibilityAXPropertyName = "readonly"
+	AccessibilityAXPropertyNameRequired         AccessibilityAXPropertyName = "required"
+	AccessibilityAXPropertyNameValuemin         AccessibilityAXPropertyName = "valuemin"
+	AccessibilityAXPropertyNameValuemax         AccessibilityAXPropertyName = "valuemax"
+	AccessibilityAXPropertyNameValuetext        AccessibilityAXPropertyName = "valuetext"
+	AccessibilityAXPropertyNameChecked          AccessibilityAXPropertyName = "checked"
+	AccessibilityAXPropertyNameExpanded         AccessibilityAXPropertyName = "expanded"
+	AccessibilityAXPropertyNameModal            AccessibilityAXPropertyName = "modal"
+	AccessibilityAXPropertyNamePressed          AccessibilityAXPropertyName = "pressed"
+	AccessibilityAXPropertyNameSelected         AccessibilityAXPropertyName = "selected"
+	AccessibilityAXPropertyNameActivedescendant AccessibilityAXPropertyName = "activedescendant"
+	AccessibilityAXPropertyNameControls         AccessibilityAXPropertyName = "controls"
+	AccessibilityAXPropertyNameDescribedby      AccessibilityAXPropertyName = "describedby"
+	AccessibilityAXPropertyNameDetails          AccessibilityAXPropertyName = "details"
+	AccessibilityAXPropertyNameErrormessage     AccessibilityAXPropertyName = "errormessage"
+	AccessibilityAXPropertyNameFlowto           AccessibilityAXPropertyName = "flowto"
+	AccessibilityAXPropertyNameLabelledby       AccessibilityAXPropertyName = "labelledby"
+	AccessibilityAXPropertyNameOwns             AccessibilityAXPropertyName = "owns"
+	AccessibilityAXPropertyNameURL              AccessibilityAXPropertyName = "url"
+)
+
+// AccessibilityAXNode a node in the accessibility tree.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Accessibility#type-AXNode
+type AccessibilityAXNode struct {
+	// Unique identifier for this node.
+	NodeID AccessibilityAXNodeID `json:"nodeId"`
+	// Whether this node is ignored for accessibility
+	Ignored bool `json:"ignored"`
+	// Collection of reasons why this node is hidden.
+	IgnoredReasons []AccessibilityAXProperty `json:"ignoredReasons,omitempty"`
+	// This `Node`'s role, whether explicit or implicit.
+	Role *AccessibilityAXValue `json:"role,omitempty"`
+	// This `Node`'s Chrome raw role.
+	ChromeRole *AccessibilityAXValue `json:"chromeRole,omitempty"`
+	// The accessible name for this `Node`.
+	Name *AccessibilityAXValue `json:"name,omitempty"`
+	// The accessible description for this `Node`.
+	Description *AccessibilityAXValue `json:"description,omitempty"`
+	// The value for this `Node`.
+	Value *AccessibilityAXValue `json:"value,omitempty"`
+	// All other properties
+	Properties []AccessibilityAXProperty `json:"properties,omitempty"`
+	// ID for this node's parent.
+	ParentID AccessibilityAXNodeID `json:"parentId,omitempty"`
+	// IDs for each of this node's child nodes.
+	ChildIds []AccessibilityAXNodeID `json:"childIds,omitempty"`
+	// The backend ID for the associated DOM node, if any.
+	BackendDOMNodeID DOMBackendNodeID `json:"backendDOMNodeId,omitempty"`
+	// The frame ID for the frame associated with this nodes document.
+	FrameID PageFrameID `json:"frameId,omitempty"`
+}
@@ -0,0 +1,103 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package types
+
+// AnimationAnimation animation instance.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Animation#type-Animation
+type AnimationAnimation struct {
+	// `Animation`'s id.
+	ID string `json:"id"`
+	// `Animation`'s name.
+	Name string `json:"name"`
+	// `Animation`'s internal paused state.
+	PausedState bool `json:"pausedState"`
+	// `Animation`'s play state.
+	PlayState string `json:"playState"`
+	// `Animation`'s playback rate.
+	PlaybackRate float64 `json:"playbackRate"`
+	// `Animation`'s start time. Milliseconds for time based animations and
+	// percentage [0 - 100] for scroll driven animations (i.e. when
+	// viewOrScrollTimeline exists).
+	StartTime float64 `json:"startTime"`
+	// `Animation`'s current time.
+	CurrentTime float64 `json:"currentTime"`
+	// Animation type of `Animation`.
+	//
+	// Allowed values: CSSTransition, CSSAnimation, WebAnimation.
+	Type string `json:"type"`
+	// `Animation`'s source animation node.
+	Source *AnimationAnimationEffect `json:"source,omitempty"`
+	// A unique ID for `Animation` representing the sources that triggered this CSS
+	// animation/transition.
+	CSSID string `json:"cssId,omitempty"`
+	// View or scroll timeline
+	ViewOrScrollTimeline *AnimationViewOrScrollTimeline `json:"viewOrScrollTimeline,omitempty"`
+}
+
+// AnimationViewOrScrollTimeline timeline instance
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Animation#type-ViewOrScrollTimeline
+type AnimationViewOrScrollTimeline struct {
+	// Scroll container node
+	SourceNodeID DOMBackendNodeID `json:"sourceNodeId,omitempty"`
+	// Represents the starting scroll position of the timeline as a length offset
+	// in pixels from scroll origin.
+	StartOffset float64 `json:"startOffset,omitempty"`
+	// Represents the ending scroll position of the timeline as a length offset in
+	// pixels from scroll origin.
+	EndOffset float64 `json:"endOffset,omitempty"`
+	// The element whose principal box's visibility in the scrollport defined the
+	// progress of the timeline. Does not exist for animations with ScrollTimeline
+	SubjectNodeID DOMBackendNodeID `json:"subjectNodeId,omitempty"`
+	// Orientation of the scroll
+	Axis DOMScrollOrientation `json:"axis"`
+}
+
+// AnimationAnimationEffect animationEffect instance
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Animation#type-AnimationEffect
+type AnimationAnimationEffect struct {
+	// `AnimationEffect`'s delay.
+	Delay float64 `json:"delay"`
+	// `AnimationEffect`'s end delay.
+	EndDelay float64 `json:"endDelay"`
+	// `AnimationEffect`'s iteration start.
+	IterationStart float64 `json:"iterationStart"`
+	// `AnimationEffect`'s iterations.
+	Iterations float64 `json:"iterations"`
+	// `AnimationEffect`'s iteration duration. Milliseconds for time based
+	// animations and percentage [0 - 100] for scroll driven animations (i.e. when
+	// viewOrScrollTimeline exists).
+	Duration float64 `json:"duration"`
+	// `AnimationEffect`'s playback direction.
+	Direction string `json:"direction"`
+	// `AnimationEffect`'s fill mode.
+	Fill string `json:"fill"`
+	// `AnimationEffect`'s target node.
+	BackendNodeID DOMBackendNodeID `json:"backendNodeId,omitempty"`
+	// `AnimationEffect`'s keyframes.
+	KeyframesRule *AnimationKeyframesRule `json:"keyframesRule,omitempty"`
+	// `AnimationEffect`'s timing function.
+	Easing string `json:"easing"`
+}
+
+// AnimationKeyframesRule keyframes Rule
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Animation#type-KeyframesRule
+type AnimationKeyframesRule struct {
+	// CSS keyframed animation's name.
+	Name string `json:"name,omitempty"`
+	// List of animation keyframes.
+	Keyframes []AnimationKeyframeStyle `json:"keyframes"`
+}
+
+// AnimationKeyframeStyle keyframe Style
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Animation#type-KeyframeStyle
+type AnimationKeyframeStyle struct {
+	// Keyframe's time offset.
+	Offset string `json:"offset"`
+	// `AnimationEffect`'s timing function.
+	Easing string `json:"easing"`
+}
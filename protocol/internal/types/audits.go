@@ -0,0 +1,936 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package types
+
+// AuditsAffectedCookie information about a cookie that is affected by an
+// inspector issue.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-AffectedCookie
+type AuditsAffectedCookie struct {
+	// The following three properties uniquely identify a cookie
+	Name   string `json:"name"`
+	Path   string `json:"path"`
+	Domain string `json:"domain"`
+}
+
+// AuditsAffectedRequest information about a request that is affected by an
+// inspector issue.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-AffectedRequest
+type AuditsAffectedRequest struct {
+	// The unique request id.
+	RequestID NetworkRequestID `json:"requestId,omitempty"`
+	URL       string           `json:"url"`
+}
+
+// AuditsAffectedFrame information about the frame affected by an inspector
+// issue.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-AffectedFrame
+type AuditsAffectedFrame struct {
+	FrameID PageFrameID `json:"frameId"`
+}
+
+// AuditsCookieExclusionReason is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-CookieExclusionReason
+type AuditsCookieExclusionReason string
+
+// Values of AuditsCookieExclusionReason.
+const (
+	AuditsCookieExclusionReasonExcludeSameSiteUnspecifiedTreatedAsLax        AuditsCookieExclusionReason = "ExcludeSameSiteUnspecifiedTreatedAsLax"
+	AuditsCookieExclusionReasonExcludeSameSiteNoneInsecure                   AuditsCookieExclusionReason = "ExcludeSameSiteNoneInsecure"
+	AuditsCookieExclusionReasonExcludeSameSiteLax                            AuditsCookieExclusionReason = "ExcludeSameSiteLax"
+	AuditsCookieExclusionReasonExcludeSameSiteStrict                         AuditsCookieExclusionReason = "ExcludeSameSiteStrict"
+	AuditsCookieExclusionReasonExcludeInvalidSameParty                       AuditsCookieExclusionReason = "ExcludeInvalidSameParty"
+	AuditsCookieExclusionReasonExcludeSamePartyCrossPartyContext             AuditsCookieExclusionReason = "ExcludeSamePartyCrossPartyContext"
+	AuditsCookieExclusionReasonExcludeDomainNonASCII                         AuditsCookieExclusionReason = "ExcludeDomainNonASCII"
+	AuditsCookieExclusionReasonExcludeThirdPartyCookieBlockedInFirstPartySet AuditsCookieExclusionReason = "ExcludeThirdPartyCookieBlockedInFirstPartySet"
+	AuditsCookieExclusionReasonExcludeThirdPartyPhaseout                     AuditsCookieExclusionReason = "ExcludeThirdPartyPhaseout"
+	AuditsCookieExclusionReasonExcludePortMismatch                           AuditsCookieExclusionReason = "ExcludePortMismatch"
+	AuditsCookieExclusionReasonExcludeSchemeMismatch                         AuditsCookieExclusionReason = "ExcludeSchemeMismatch"
+)
+
+// AuditsCookieWarningReason is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-CookieWarningReason
+type AuditsCookieWarningReason string
+
+// Values of AuditsCookieWarningReason.
+const (
+	AuditsCookieWarningReasonWarnSameSiteUnspecifiedCrossSiteContext        AuditsCookieWarningReason = "WarnSameSiteUnspecifiedCrossSiteContext"
+	AuditsCookieWarningReasonWarnSameSiteNoneInsecure                       AuditsCookieWarningReason = "WarnSameSiteNoneInsecure"
+	AuditsCookieWarningReasonWarnSameSiteUnspecifiedLaxAllowUnsafe          AuditsCookieWarningReason = "WarnSameSiteUnspecifiedLaxAllowUnsafe"
+	AuditsCookieWarningReasonWarnSameSiteStrictLaxDowngradeStrict           AuditsCookieWarningReason = "WarnSameSiteStrictLaxDowngradeStrict"
+	AuditsCookieWarningReasonWarnSameSiteStrictCrossDowngradeStrict         AuditsCookieWarningReason = "WarnSameSiteStrictCrossDowngradeStrict"
+	AuditsCookieWarningReasonWarnSameSiteStrictCrossDowngradeLax            AuditsCookieWarningReason = "WarnSameSiteStrictCrossDowngradeLax"
+	AuditsCookieWarningReasonWarnSameSiteLaxCrossDowngradeStrict            AuditsCookieWarningReason = "WarnSameSiteLaxCrossDowngradeStrict"
+	AuditsCookieWarningReasonWarnSameSiteLaxCrossDowngradeLax               AuditsCookieWarningReason = "WarnSameSiteLaxCrossDowngradeLax"
+	AuditsCookieWarningReasonWarnAttributeValueExceedsMaxSize               AuditsCookieWarningReason = "WarnAttributeValueExceedsMaxSize"
+	AuditsCookieWarningReasonWarnDomainNonASCII                             AuditsCookieWarningReason = "WarnDomainNonASCII"
+	AuditsCookieWarningReasonWarnThirdPartyPhaseout                         AuditsCookieWarningReason = "WarnThirdPartyPhaseout"
+	AuditsCookieWarningReasonWarnCrossSiteRedirectDowngradeChangesInclusion AuditsCookieWarningReason = "WarnCrossSiteRedirectDowngradeChangesInclusion"
+	AuditsCookieWarningReasonWarnDeprecationTrialMetadata                   AuditsCookieWarningReason = "WarnDeprecationTrialMetadata"
+	AuditsCookieWarningReasonWarnThirdPartyCookieHeuristic                  AuditsCookieWarningReason = "WarnThirdPartyCookieHeuristic"
+)
+
+// AuditsCookieOperation is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-CookieOperation
+type AuditsCookieOperation string
+
+// Values of AuditsCookieOperation.
+const (
+	AuditsCookieOperationSetCookie  AuditsCookieOperation = "SetCookie"
+	AuditsCookieOperationReadCookie AuditsCookieOperation = "ReadCookie"
+)
+
+// AuditsInsightType represents the category of insight that a cookie issue
+// falls under.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-InsightType
+type AuditsInsightType string
+
+// Values of AuditsInsightType.
+const (
+	AuditsInsightTypeGitHubResource AuditsInsightType = "GitHubResource"
+	AuditsInsightTypeGracePeriod    AuditsInsightType = "GracePeriod"
+	AuditsInsightTypeHeuristics     AuditsInsightType = "Heuristics"
+)
+
+// AuditsCookieIssueInsight information about the suggested solution to a cookie
+// issue.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-CookieIssueInsight
+type AuditsCookieIssueInsight struct {
+	Type AuditsInsightType `json:"type"`
+	// Link to table entry in third-party cookie migration readiness list.
+	TableEntryURL string `json:"tableEntryUrl,omitempty"`
+}
+
+// AuditsCookieIssueDetails this information is currently necessary, as the
+// front-end has a difficult time finding a specific cookie. With this, we can
+// convey specific error information without the cookie.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-CookieIssueDetails
+type AuditsCookieIssueDetails struct {
+	// If AffectedCookie is not set then rawCookieLine contains the raw Set-Cookie
+	// header string. This hints at a problem where the cookie line is
+	// syntactically or semantically malformed in a way that no valid cookie could
+	// be created.
+	Cookie                 *AuditsAffectedCookie         `json:"cookie,omitempty"`
+	RawCookieLine          string                        `json:"rawCookieLine,omitempty"`
+	CookieWarningReasons   []AuditsCookieWarningReason   `json:"cookieWarningReasons"`
+	CookieExclusionReasons []AuditsCookieExclusionReason `json:"cookieExclusionReasons"`
+	// Optionally identifies the site-for-cookies and the cookie url, which may be
+	// used by the front-end as additional context.
+	Operation      AuditsCookieOperation  `json:"operation"`
+	SiteForCookies string                 `json:"siteForCookies,omitempty"`
+	CookieURL      string                 `json:"cookieUrl,omitempty"`
+	Request        *AuditsAffectedRequest `json:"request,omitempty"`
+	// The recommended solution to the issue.
+	Insight *AuditsCookieIssueInsight `json:"insight,omitempty"`
+}
+
+// AuditsMixedContentResolutionStatus is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-MixedContentResolutionStatus
+type AuditsMixedContentResolutionStatus string
+
+// Values of AuditsMixedContentResolutionStatus.
+const (
+	AuditsMixedContentResolutionStatusMixedContentBlocked               AuditsMixedContentResolutionStatus = "MixedContentBlocked"
+	AuditsMixedContentResolutionStatusMixedContentAutomaticallyUpgraded AuditsMixedContentResolutionStatus = "MixedContentAutomaticallyUpgraded"
+	AuditsMixedContentResolutionStatusMixedContentWarning               AuditsMixedContentResolutionStatus = "MixedContentWarning"
+)
+
+// AuditsMixedContentResourceType is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-MixedContentResourceType
+type AuditsMixedContentResourceType string
+
+// Values of AuditsMixedContentResourceType.
+const (
+	AuditsMixedContentResourceTypeAttributionSrc   AuditsMixedContentResourceType = "AttributionSrc"
+	AuditsMixedContentResourceTypeAudio            AuditsMixedContentResourceType = "Audio"
+	AuditsMixedContentResourceTypeBeacon           AuditsMixedContentResourceType = "Beacon"
+	AuditsMixedContentResourceTypeCSPReport        AuditsMixedContentResourceType = "CSPReport"
+	AuditsMixedContentResourceTypeDownload         AuditsMixedContentResourceType = "Download"
+	AuditsMixedContentResourceTypeEventSource      AuditsMixedContentResourceType = "EventSource"
+	AuditsMixedContentResourceTypeFavicon          AuditsMixedContentResourceType = "Favicon"
+	AuditsMixedContentResourceTypeFont             AuditsMixedContentResourceType = "Font"
+	AuditsMixedContentResourceTypeForm             AuditsMixedContentResourceType = "Form"
+	AuditsMixedContentResourceTypeFrame            AuditsMixedContentResourceType = "Frame"
+	AuditsMixedContentResourceTypeImage            AuditsMixedContentResourceType = "Image"
+	AuditsMixedContentResourceTypeImport           AuditsMixedContentResourceType = "Import"
+	AuditsMixedContentResourceTypeJSON             AuditsMixedContentResourceType = "JSON"
+	AuditsMixedContentResourceTypeManifest         AuditsMixedContentResourceType = "Manifest"
+	AuditsMixedContentResourceTypePing             AuditsMixedContentResourceType = "Ping"
+	AuditsMixedContentResourceTypePluginData       AuditsMixedContentResourceType = "PluginData"
+	AuditsMixedContentResourceTypePluginResource   AuditsMixedContentResourceType = "PluginResource"
+	AuditsMixedContentResourceTypePrefetch         AuditsMixedContentResourceType = "Prefetch"
+	AuditsMixedContentResourceTypeResource         AuditsMixedContentResourceType = "Resource"
+	AuditsMixedContentResourceTypeScript           AuditsMixedContentResourceType = "Script"
+	AuditsMixedContentResourceTypeServiceWorker    AuditsMixedContentResourceType = "ServiceWorker"
+	AuditsMixedContentResourceTypeSharedWorker     AuditsMixedContentResourceType = "SharedWorker"
+	AuditsMixedContentResourceTypeSpeculationRules AuditsMixedContentResourceType = "SpeculationRules"
+	AuditsMixedContentResourceTypeStylesheet       AuditsMixedContentResourceType = "Stylesheet"
+	AuditsMixedContentResourceTypeTrack            AuditsMixedContentResourceType = "Track"
+	AuditsMixedContentResourceTypeVideo            AuditsMixedContentResourceType = "Video"
+	AuditsMixedContentResourceTypeWorker           AuditsMixedContentResourceType = "Worker"
+	AuditsMixedContentResourceTypeXMLHTTPRequest   AuditsMixedContentResourceType = "XMLHttpRequest"
+	AuditsMixedContentResourceTypeXSLT             AuditsMixedContentResourceType = "XSLT"
+)
+
+// AuditsMixedContentIssueDetails is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-MixedContentIssueDetails
+type AuditsMixedContentIssueDetails struct {
+	// The type of resource causing the mixed content issue (css, js, iframe,
+	// form,...). Marked as optional because it is mapped to from
+	// blink::mojom::RequestContextType, which will be replaced by
+	// network::mojom::RequestDestination
+	ResourceType AuditsMixedContentResourceType `json:"resourceType,omitempty"`
+	// The way the mixed content issue is being resolved.
+	ResolutionStatus AuditsMixedContentResolutionStatus `json:"resolutionStatus"`
+	// The unsafe http url causing the mixed content issue.
+	InsecureURL string `json:"insecureURL"`
+	// The url responsible for the call to an unsafe url.
+	MainResourceURL string `json:"mainResourceURL"`
+	// The mixed content request. Does not always exist (e.g. for unsafe form
+	// submission urls).
+	Request *AuditsAffectedRequest `json:"request,omitempty"`
+	// Optional because not every mixed content issue is necessarily linked to a
+	// frame.
+	Frame *AuditsAffectedFrame `json:"frame,omitempty"`
+}
+
+// AuditsBlockedByResponseReason enum indicating the reason a response has been
+// blocked. These reasons are refinements of the net error BLOCKED_BY_RESPONSE.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-BlockedByResponseReason
+type AuditsBlockedByResponseReason string
+
+// Values of AuditsBlockedByResponseReason.
+const (
+	AuditsBlockedByResponseReasonCoepFrameResourceNeedsCoepHeader                        AuditsBlockedByResponseReason = "CoepFrameResourceNeedsCoepHeader"
+	AuditsBlockedByResponseReasonCoopSandboxedIFrameCannotNavigateToCoopPage             AuditsBlockedByResponseReason = "CoopSandboxedIFrameCannotNavigateToCoopPage"
+	AuditsBlockedByResponseReasonCorpNotSameOrigin                                       AuditsBlockedByResponseReason = "CorpNotSameOrigin"
+	AuditsBlockedByResponseReasonCorpNotSameOriginAfterDefaultedToSameOriginByCoep       AuditsBlockedByResponseReason = "CorpNotSameOriginAfterDefaultedToSameOriginByCoep"
+	AuditsBlockedByResponseReasonCorpNotSameOriginAfterDefaultedToSameOriginByDip        AuditsBlockedByResponseReason = "CorpNotSameOriginAfterDefaultedToSameOriginByDip"
+	AuditsBlockedByResponseReasonCorpNotSameOriginAfterDefaultedToSameOriginByCoepAndDip AuditsBlockedByResponseReason = "CorpNotSameOriginAfterDefaultedToSameOriginByCoepAndDip"
+	AuditsBlockedByResponseReasonCorpNotSameSite                                         AuditsBlockedByResponseReason = "CorpNotSameSite"
+	AuditsBlockedByResponseReasonSRIMessageSignatureMismatch                             AuditsBlockedByResponseReason = "SRIMessageSignatureMismatch"
+)
+
+// AuditsBlockedByResponseIssueDetails details for a request that has been
+// blocked with the BLOCKED_BY_RESPONSE code. Currently only used for COEP/COOP,
+// but may be extended to include some CSP errors in the future.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-BlockedByResponseIssueDetails
+type AuditsBlockedByResponseIssueDetails struct {
+	Request      AuditsAffectedRequest         `json:"request"`
+	ParentFrame  *AuditsAffectedFrame          `json:"parentFrame,omitempty"`
+	BlockedFrame *AuditsAffectedFrame          `json:"blockedFrame,omitempty"`
+	Reason       AuditsBlockedByResponseReason `json:"reason"`
+}
+
+// AuditsHeavyAdResolutionStatus is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-HeavyAdResolutionStatus
+type AuditsHeavyAdResolutionStatus string
+
+// Values of AuditsHeavyAdResolutionStatus.
+const (
+	AuditsHeavyAdResolutionStatusHeavyAdBlocked AuditsHeavyAdResolutionStatus = "HeavyAdBlocked"
+	AuditsHeavyAdResolutionStatusHeavyAdWarning AuditsHeavyAdResolutionStatus = "HeavyAdWarning"
+)
+
+// AuditsHeavyAdReason is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-HeavyAdReason
+type AuditsHeavyAdReason string
+
+// Values of AuditsHeavyAdReason.
+const (
+	AuditsHeavyAdReasonNetworkTotalLimit AuditsHeavyAdReason = "NetworkTotalLimit"
+	AuditsHeavyAdReasonCPUTotalLimit     AuditsHeavyAdReason = "CpuTotalLimit"
+	AuditsHeavyAdReasonCPUPeakLimit      AuditsHeavyAdReason = "CpuPeakLimit"
+)
+
+// AuditsHeavyAdIssueDetails is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-HeavyAdIssueDetails
+type AuditsHeavyAdIssueDetails struct {
+	// The resolution status, either blocking the content or warning.
+	Resolution AuditsHeavyAdResolutionStatus `json:"resolution"`
+	// The reason the ad was blocked, total network or cpu or peak cpu.
+	Reason AuditsHeavyAdReason `json:"reason"`
+	// The frame that was blocked.
+	Frame AuditsAffectedFrame `json:"frame"`
+}
+
+// AuditsContentSecurityPolicyViolationType is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-ContentSecurityPolicyViolationType
+type AuditsContentSecurityPolicyViolationType string
+
+// Values of AuditsContentSecurityPolicyViolationType.
+const (
+	AuditsContentSecurityPolicyViolationTypeKInlineViolation             AuditsContentSecurityPolicyViolationType = "kInlineViolation"
+	AuditsContentSecurityPolicyViolationTypeKEvalViolation               AuditsContentSecurityPolicyViolationType = "kEvalViolation"
+	AuditsContentSecurityPolicyViolationTypeKURLViolation                AuditsContentSecurityPolicyViolationType = "kURLViolation"
+	AuditsContentSecurityPolicyViolationTypeKSRIViolation                AuditsContentSecurityPolicyViolationType = "kSRIViolation"
+	AuditsContentSecurityPolicyViolationTypeKTrustedTypesSinkViolation   AuditsContentSecurityPolicyViolationType = "kTrustedTypesSinkViolation"
+	AuditsContentSecurityPolicyViolationTypeKTrustedTypesPolicyViolation AuditsContentSecurityPolicyViolationType = "kTrustedTypesPolicyViolation"
+	AuditsContentSecurityPolicyViolationTypeKWasmEvalViolation           AuditsContentSecurityPolicyViolationType = "kWasmEvalViolation"
+)
+
+// AuditsSourceCodeLocation is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-SourceCodeLocation
+type AuditsSourceCodeLocation struct {
+	ScriptID     RuntimeScriptID `json:"scriptId,omitempty"`
+	URL          string          `json:"url"`
+	LineNumber   int64           `json:"lineNumber"`
+	ColumnNumber int64           `json:"columnNumber"`
+}
+
+// AuditsContentSecurityPolicyIssueDetails is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-ContentSecurityPolicyIssueDetails
+type AuditsContentSecurityPolicyIssueDetails struct {
+	// The url not included in allowed sources.
+	BlockedURL string `json:"blockedURL,omitempty"`
+	// Specific directive that is violated, causing the CSP issue.
+	ViolatedDirective                  string                                   `json:"violatedDirective"`
+	IsReportOnly                       bool                                     `json:"isReportOnly"`
+	ContentSecurityPolicyViolationType AuditsContentSecurityPolicyViolationType `json:"contentSecurityPolicyViolationType"`
+	FrameAncestor                      *AuditsAffectedFrame                     `json:"frameAncestor,omitempty"`
+	SourceCodeLocation                 *AuditsSourceCodeLocation                `json:"sourceCodeLocation,omitempty"`
+	ViolatingNodeID                    DOMBackendNodeID                         `json:"violatingNodeId,omitempty"`
+}
+
+// AuditsSharedArrayBufferIssueType is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-SharedArrayBufferIssueType
+type AuditsSharedArrayBufferIssueType string
+
+// Values of AuditsSharedArrayBufferIssueType.
+const (
+	AuditsSharedArrayBufferIssueTypeTransferIssue AuditsSharedArrayBufferIssueType = "TransferIssue"
+	AuditsSharedArrayBufferIssueTypeCreationIssue AuditsSharedArrayBufferIssueType = "CreationIssue"
+)
+
+// AuditsSharedArrayBufferIssueDetails details for a issue arising from an SAB
+// being instantiated in, or transferred to a context that is not cross-origin
+// isolated.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-SharedArrayBufferIssueDetails
+type AuditsSharedArrayBufferIssueDetails struct {
+	SourceCodeLocation AuditsSourceCodeLocation         `json:"sourceCodeLocation"`
+	IsWarning          bool                             `json:"isWarning"`
+	Type               AuditsSharedArrayBufferIssueType `json:"type"`
+}
+
+// AuditsLowTextContrastIssueDetails is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-LowTextContrastIssueDetails
+type AuditsLowTextContrastIssueDetails struct {
+	ViolatingNodeID       DOMBackendNodeID `json:"violatingNodeId"`
+	ViolatingNodeSelector string           `json:"violatingNodeSelector"`
+	ContrastRatio         float64          `json:"contrastRatio"`
+	ThresholdAA           float64          `json:"thresholdAA"`
+	ThresholdAAA          float64          `json:"thresholdAAA"`
+	FontSize              string           `json:"fontSize"`
+	FontWeight            string           `json:"fontWeight"`
+}
+
+// AuditsCorsIssueDetails details for a CORS related issue, e.g. a warning or
+// error related to CORS RFC1918 enforcement.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-CorsIssueDetails
+type AuditsCorsIssueDetails struct {
+	CorsErrorStatus        NetworkCorsErrorStatus      `json:"corsErrorStatus"`
+	IsWarning              bool                        `json:"isWarning"`
+	Request                AuditsAffectedRequest       `json:"request"`
+	Location               *AuditsSourceCodeLocation   `json:"location,omitempty"`
+	InitiatorOrigin        string                      `json:"initiatorOrigin,omitempty"`
+	ResourceIPAddressSpace NetworkIPAddressSpace       `json:"resourceIPAddressSpace,omitempty"`
+	ClientSecurityState    *NetworkClientSecurityState `json:"clientSecurityState,omitempty"`
+}
+
+// AuditsAttributionReportingIssueType is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-AttributionReportingIssueType
+type AuditsAttributionReportingIssueType string
+
+// Values of AuditsAttributionReportingIssueType.
+const (
+	AuditsAttributionReportingIssueTypePermissionPolicyDisabled                             AuditsAttributionReportingIssueType = "PermissionPolicyDisabled"
+	AuditsAttributionReportingIssueTypeUntrustworthyReportingOrigin                         AuditsAttributionReportingIssueType = "UntrustworthyReportingOrigin"
+	AuditsAttributionReportingIssueTypeInsecureContext                                      AuditsAttributionReportingIssueType = "InsecureContext"
+	AuditsAttributionReportingIssueTypeInvalidHeader                                        AuditsAttributionReportingIssueType = "InvalidHeader"
+	AuditsAttributionReportingIssueTypeInvalidRegisterTriggerHeader                         AuditsAttributionReportingIssueType = "InvalidRegisterTriggerHeader"
+	AuditsAttributionReportingIssueTypeSourceAndTriggerHeaders                              AuditsAttributionReportingIssueType = "SourceAndTriggerHeaders"
+	AuditsAttributionReportingIssueTypeSourceIgnored                                        AuditsAttributionReportingIssueType = "SourceIgnored"
+	AuditsAttributionReportingIssueTypeTriggerIgnored                                       AuditsAttributionReportingIssueType = "TriggerIgnored"
+	AuditsAttributionReportingIssueTypeOsSourceIgnored                                      AuditsAttributionReportingIssueType = "OsSourceIgnored"
+	AuditsAttributionReportingIssueTypeOsTriggerIgnored                                     AuditsAttributionReportingIssueType = "OsTriggerIgnored"
+	AuditsAttributionReportingIssueTypeInvalidRegisterOsSourceHeader                        AuditsAttributionReportingIssueType = "InvalidRegisterOsSourceHeader"
+	AuditsAttributionReportingIssueTypeInvalidRegisterOsTriggerHeader                       AuditsAttributionReportingIssueType = "InvalidRegisterOsTriggerHeader"
+	AuditsAttributionReportingIssueTypeWebAndOsHeaders                                      AuditsAttributionReportingIssueType = "WebAndOsHeaders"
+	AuditsAttributionReportingIssueTypeNoWebOrOsSupport                                     AuditsAttributionReportingIssueType = "NoWebOrOsSupport"
+	AuditsAttributionReportingIssueTypeNavigationRegistrationWithoutTransientUserActivation AuditsAttributionReportingIssueType = "NavigationRegistrationWithoutTransientUserActivation"
+	AuditsAttributionReportingIssueTypeInvalidInfoHeader                                    AuditsAttributionReportingIssueType = "InvalidInfoHeader"
+	AuditsAttributionReportingIssueTypeNoRegisterSourceHeader                               AuditsAttributionReportingIssueType = "NoRegisterSourceHeader"
+	AuditsAttributionReportingIssueTypeNoRegisterTriggerHeader                              AuditsAttributionReportingIssueType = "NoRegisterTriggerHeader"
+	AuditsAttributionReportingIssueTypeNoRegisterOsSourceHeader                             AuditsAttributionReportingIssueType = "NoRegisterOsSourceHeader"
+	AuditsAttributionReportingIssueTypeNoRegisterOsTriggerHeader                            AuditsAttributionReportingIssueType = "NoRegisterOsTriggerHeader"
+	AuditsAttributionReportingIssueTypeNavigationRegistrationUniqueScopeAlreadySet          AuditsAttributionReportingIssueType = "NavigationRegistrationUniqueScopeAlreadySet"
+)
+
+// AuditsSharedDictionaryError is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-SharedDictionaryError
+type AuditsSharedDictionaryError string
+
+// Values of AuditsSharedDictionaryError.
+const (
+	AuditsSharedDictionaryErrorUseErrorCrossOriginNoCorsRequest          AuditsSharedDictionaryError = "UseErrorCrossOriginNoCorsRequest"
+	AuditsSharedDictionaryErrorUseErrorDictionaryLoadFailure             AuditsSharedDictionaryError = "UseErrorDictionaryLoadFailure"
+	AuditsSharedDictionaryErrorUseErrorMatchingDictionaryNotUsed         AuditsSharedDictionaryError = "UseErrorMatchingDictionaryNotUsed"
+	AuditsSharedDictionaryErrorUseErrorUnexpectedContentDictionaryHeader AuditsSharedDictionaryError = "UseErrorUnexpectedContentDictionaryHeader"
+	AuditsSharedDictionaryErrorWriteErrorCossOriginNoCorsRequest         AuditsSharedDictionaryError = "WriteErrorCossOriginNoCorsRequest"
+	AuditsSharedDictionaryErrorWriteErrorDisallowedBySettings            AuditsSharedDictionaryError = "WriteErrorDisallowedBySettings"
+	AuditsSharedDictionaryErrorWriteErrorExpiredResponse                 AuditsSharedDictionaryError = "WriteErrorExpiredResponse"
+	AuditsSharedDictionaryErrorWriteErrorFeatureDisabled                 AuditsSharedDictionaryError = "WriteErrorFeatureDisabled"
+	AuditsSharedDictionaryErrorWriteErrorInsufficientResources           AuditsSharedDictionaryError = "WriteErrorInsufficientResources"
+	AuditsSharedDictionaryErrorWriteErrorInvalidMatchField               AuditsSharedDictionaryError = "WriteErrorInvalidMatchField"
+	AuditsSharedDictionaryErrorWriteErrorInvalidStructuredHeader         AuditsSharedDictionaryError = "WriteErrorInvalidStructuredHeader"
+	AuditsSharedDictionaryErrorWriteErrorNavigationRequest               AuditsSharedDictionaryError = "WriteErrorNavigationRequest"
+	AuditsSharedDictionaryErrorWriteErrorNoMatchField                    AuditsSharedDictionaryError = "WriteErrorNoMatchField"
+	AuditsSharedDictionaryErrorWriteErrorNonListMatchDestField           AuditsSharedDictionaryError = "WriteErrorNonListMatchDestField"
+	AuditsSharedDictionaryErrorWriteErrorNonSecureContext                AuditsSharedDictionaryError = "WriteErrorNonSecureContext"
+	AuditsSharedDictionaryErrorWriteErrorNonStringIDField                AuditsSharedDictionaryError = "WriteErrorNonStringIdField"
+	AuditsSharedDictionaryErrorWriteErrorNonStringInMatchDestList        AuditsSharedDictionaryError = "WriteErrorNonStringInMatchDestList"
+	AuditsSharedDictionaryErrorWriteErrorNonStringMatchField             AuditsSharedDictionaryError = "WriteErrorNonStringMatchField"
+	AuditsSharedDictionaryErrorWriteErrorNonTokenTypeField               AuditsSharedDictionaryError = "WriteErrorNonTokenTypeField"
+	AuditsSharedDictionaryErrorWriteErrorRequestAborted                  AuditsSharedDictionaryError = "WriteErrorRequestAborted"
+	AuditsSharedDictionaryErrorWriteErrorShuttingDown                    AuditsSharedDictionaryError = "WriteErrorShuttingDown"
+	AuditsSharedDictionaryErrorWriteErrorTooLongIDField                  AuditsSharedDictionaryError = "WriteErrorTooLongIdField"
+	AuditsSharedDictionaryErrorWriteErrorUnsupportedType                 AuditsSharedDictionaryError = "WriteErrorUnsupportedType"
+)
+
+// AuditsSRIMessageSignatureError is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-SRIMessageSignatureError
+type AuditsSRIMessageSignatureError string
+
+// Values of AuditsSRIMessageSignatureError.
+const (
+	AuditsSRIMessageSignatureErrorMissingSignatureHeader                               AuditsSRIMessageSignatureError = "MissingSignatureHeader"
+	AuditsSRIMessageSignatureErrorMissingSignatureInputHeader                          AuditsSRIMessageSignatureError = "MissingSignatureInputHeader"
+	AuditsSRIMessageSignatureErrorInvalidSignatureHeader                               AuditsSRIMessageSignatureError = "InvalidSignatureHeader"
+	AuditsSRIMessageSignatureErrorInvalidSignatureInputHeader                          AuditsSRIMessageSignatureError = "InvalidSignatureInputHeader"
+	AuditsSRIMessageSignatureErrorSignatureHeaderValueIsNotByteSequence                AuditsSRIMessageSignatureError = "SignatureHeaderValueIsNotByteSequence"
+	AuditsSRIMessageSignatureErrorSignatureHeaderValueIsParameterized                  AuditsSRIMessageSignatureError = "SignatureHeaderValueIsParameterized"
+	AuditsSRIMessageSignatureErrorSignatureHeaderValueIsIncorrectLength                AuditsSRIMessageSignatureError = "SignatureHeaderValueIsIncorrectLength"
+	AuditsSRIMessageSignatureErrorSignatureInputHeaderMissingLabel                     AuditsSRIMessageSignatureError = "SignatureInputHeaderMissingLabel"
+	AuditsSRIMessageSignatureErrorSignatureInputHeaderValueNotInnerList                AuditsSRIMessageSignatureError = "SignatureInputHeaderValueNotInnerList"
+	AuditsSRIMessageSignatureErrorSignatureInputHeaderValueMissingComponents           AuditsSRIMessageSignatureError = "SignatureInputHeaderValueMissingComponents"
+	AuditsSRIMessageSignatureErrorSignatureInputHeaderInvalidComponentType             AuditsSRIMessageSignatureError = "SignatureInputHeaderInvalidComponentType"
+	AuditsSRIMessageSignatureErrorSignatureInputHeaderInvalidComponentName             AuditsSRIMessageSignatureError = "SignatureInputHeaderInvalidComponentName"
+	AuditsSRIMessageSignatureErrorSignatureInputHeaderInvalidHeaderComponentParameter  AuditsSRIMessageSignatureError = "SignatureInputHeaderInvalidHeaderComponentParameter"
+	AuditsSRIMessageSignatureErrorSignatureInputHeaderInvalidDerivedComponentParameter AuditsSRIMessageSignatureError = "SignatureInputHeaderInvalidDerivedComponentParameter"
+	AuditsSRIMessageSignatureErrorSignatureInputHeaderKeyIDLength                      AuditsSRIMessageSignatureError = "SignatureInputHeaderKeyIdLength"
+	AuditsSRIMessageSignatureErrorSignatureInputHeaderInvalidParameter                 AuditsSRIMessageSignatureError = "SignatureInputHeaderInvalidParameter"
+	AuditsSRIMessageSignatureErrorSignatureInputHeaderMissingRequiredParameters        AuditsSRIMessageSignatureError = "SignatureInputHeaderMissingRequiredParameters"
+	AuditsSRIMessageSignatureErrorValidationFailedSignatureExpired                     AuditsSRIMessageSignatureError = "ValidationFailedSignatureExpired"
+	AuditsSRIMessageSignatureErrorValidationFailedInvalidLength                        AuditsSRIMessageSignatureError = "ValidationFailedInvalidLength"
+	AuditsSRIMessageSignatureErrorValidationFailedSignatureMismatch                    AuditsSRIMessageSignatureError = "ValidationFailedSignatureMismatch"
+	AuditsSRIMessageSignatureErrorValidationFailedIntegrityMismatch                    AuditsSRIMessageSignatureError = "ValidationFailedIntegrityMismatch"
+)
+
+// AuditsUnencodedDigestError is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-UnencodedDigestError
+type AuditsUnencodedDigestError string
+
+// Values of AuditsUnencodedDigestError.
+const (
+	AuditsUnencodedDigestErrorMalformedDictionary   AuditsUnencodedDigestError = "MalformedDictionary"
+	AuditsUnencodedDigestErrorUnknownAlgorithm      AuditsUnencodedDigestError = "UnknownAlgorithm"
+	AuditsUnencodedDigestErrorIncorrectDigestType   AuditsUnencodedDigestError = "IncorrectDigestType"
+	AuditsUnencodedDigestErrorIncorrectDigestLength AuditsUnencodedDigestError = "IncorrectDigestLength"
+)
+
+// AuditsAttributionReportingIssueDetails details for issues around "Attribution
+// Reporting API" usage. Explainer:
+// https://github.com/WICG/attribution-reporting-api
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-AttributionReportingIssueDetails
+type AuditsAttributionReportingIssueDetails struct {
+	ViolationType    AuditsAttributionReportingIssueType `json:"violationType"`
+	Request          *AuditsAffectedRequest              `json:"request,omitempty"`
+	ViolatingNodeID  DOMBackendNodeID                    `json:"violatingNodeId,omitempty"`
+	InvalidParameter string                              `json:"invalidParameter,omitempty"`
+}
+
+// AuditsQuirksModeIssueDetails details for issues about documents in Quirks
+// Mode or Limited Quirks Mode that affects page layouting.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-QuirksModeIssueDetails
+type AuditsQuirksModeIssueDetails struct {
+	// If false, it means the document's mode is "quirks" instead of
+	// "limited-quirks".
+	IsLimitedQuirksMode bool             `json:"isLimitedQuirksMode"`
+	DocumentNodeID      DOMBackendNodeID `json:"documentNodeId"`
+	URL                 string           `json:"url"`
+	FrameID             PageFrameID      `json:"frameId"`
+	LoaderID            NetworkLoaderID  `json:"loaderId"`
+}
+
+// AuditsNavigatorUserAgentIssueDetails is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-NavigatorUserAgentIssueDetails
+//
+// Deprecated: this is deprecated in the protocol.
+type AuditsNavigatorUserAgentIssueDetails struct {
+	URL      string                    `json:"url"`
+	Location *AuditsSourceCodeLocation `json:"location,omitempty"`
+}
+
+// AuditsSharedDictionaryIssueDetails is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-SharedDictionaryIssueDetails
+type AuditsSharedDictionaryIssueDetails struct {
+	SharedDictionaryError AuditsSharedDictionaryError `json:"sharedDictionaryError"`
+	Request               AuditsAffectedRequest       `json:"request"`
+}
+
+// AuditsSRIMessageSignatureIssueDetails is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-SRIMessageSignatureIssueDetails
+type AuditsSRIMessageSignatureIssueDetails struct {
+	Error               AuditsSRIMessageSignatureError `json:"error"`
+	SignatureBase       string                         `json:"signatureBase"`
+	IntegrityAssertions []string                       `json:"integrityAssertions"`
+	Request             AuditsAffectedRequest          `json:"request"`
+}
+
+// AuditsUnencodedDigestIssueDetails is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-UnencodedDigestIssueDetails
+type AuditsUnencodedDigestIssueDetails struct {
+	Error   AuditsUnencodedDigestError `json:"error"`
+	Request AuditsAffectedRequest      `json:"request"`
+}
+
+// AuditsGenericIssueErrorType is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-GenericIssueErrorType
+type AuditsGenericIssueErrorType string
+
+// Values of AuditsGenericIssueErrorType.
+const (
+	AuditsGenericIssueErrorTypeFormLabelForNameError                                      AuditsGenericIssueErrorType = "FormLabelForNameError"
+	AuditsGenericIssueErrorTypeFormDuplicateIDForInputError                               AuditsGenericIssueErrorType = "FormDuplicateIdForInputError"
+	AuditsGenericIssueErrorTypeFormInputWithNoLabelError                                  AuditsGenericIssueErrorType = "FormInputWithNoLabelError"
+	AuditsGenericIssueErrorTypeFormAutocompleteAttributeEmptyError                        AuditsGenericIssueErrorType = "FormAutocompleteAttributeEmptyError"
+	AuditsGenericIssueErrorTypeFormEmptyIDAndNameAttributesForInputError                  AuditsGenericIssueErrorType = "FormEmptyIdAndNameAttributesForInputError"
+	AuditsGenericIssueErrorTypeFormAriaLabelledByToNonExistingID                          AuditsGenericIssueErrorType = "FormAriaLabelledByToNonExistingId"
+	AuditsGenericIssueErrorTypeFormInputAssignedAutocompleteValueToIDOrNameAttributeError AuditsGenericIssueErrorType = "FormInputAssignedAutocompleteValueToIdOrNameAttributeError"
+	AuditsGenericIssueErrorTypeFormLabelHasNeitherForNorNestedInput                       AuditsGenericIssueErrorType = "FormLabelHasNeitherForNorNestedInput"
+	AuditsGenericIssueErrorTypeFormLabelForMatchesNonExistingIDError                      AuditsGenericIssueErrorType = "FormLabelForMatchesNonExistingIdError"
+	AuditsGenericIssueErrorTypeFormInputHasWrongButWellIntendedAutocompleteValueError     AuditsGenericIssueErrorType = "FormInputHasWrongButWellIntendedAutocompleteValueError"
+	AuditsGenericIssueErrorTypeResponseWasBlockedByORB                                    AuditsGenericIssueErrorType = "ResponseWasBlockedByORB"
+)
+
+// AuditsGenericIssueDetails depending on the concrete errorType, different
+// properties are set.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-GenericIssueDetails
+type AuditsGenericIssueDetails struct {
+	// Issues with the same errorType are aggregated in the frontend.
+	ErrorType              AuditsGenericIssueErrorType `json:"errorType"`
+	FrameID                PageFrameID                 `json:"frameId,omitempty"`
+	ViolatingNodeID        DOMBackendNodeID            `json:"violatingNodeId,omitempty"`
+	ViolatingNodeAttribute string                      `json:"violatingNodeAttribute,omitempty"`
+	Request                *AuditsAffectedRequest      `json:"request,omitempty"`
+}
+
+// AuditsDeprecationIssueDetails this issue tracks information needed to print a
+// deprecation message.
+// https://source.chromium.org/chromium/chromium/src/+/main:third_party/blink/renderer/core/frame/third_party/blink/renderer/core/frame/deprecation/README.md
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-DeprecationIssueDetails
+type AuditsDeprecationIssueDetails struct {
+	AffectedFrame      *AuditsAffectedFrame     `json:"affectedFrame,omitempty"`
+	SourceCodeLocation AuditsSourceCodeLocation `json:"sourceCodeLocation"`
+	// One of the deprecation names from
+	// third_party/blink/renderer/core/frame/deprecation/deprecation.json5
+	Type string `json:"type"`
+}
+
+// AuditsBounceTrackingIssueDetails this issue warns about sites in the redirect
+// chain of a finished navigation that may be flagged as trackers and have their
+// state cleared if they don't receive a user interaction. Note that in this
+// context 'site' means eTLD+1. For example, if the URL
+// `https://example.test:80/bounce` was in the redirect chain, the site reported
+// would be `example.test`.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-BounceTrackingIssueDetails
+type AuditsBounceTrackingIssueDetails struct {
+	TrackingSites []string `json:"trackingSites"`
+}
+
+// AuditsCookieDeprecationMetadataIssueDetails this issue warns about
+// third-party sites that are accessing cookies on the current page, and have
+// been permitted due to having a global metadata grant. Note that in this
+// context 'site' means eTLD+1. For example, if the URL
+// `https://example.test:80/web_page` was accessing cookies, the site reported
+// would be `example.test`.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-CookieDeprecationMetadataIssueDetails
+type AuditsCookieDeprecationMetadataIssueDetails struct {
+	AllowedSites     []string              `json:"allowedSites"`
+	OptOutPercentage float64               `json:"optOutPercentage"`
+	IsOptOutTopLevel bool                  `json:"isOptOutTopLevel"`
+	Operation        AuditsCookieOperation `json:"operation"`
+}
+
+// AuditsClientHintIssueReason is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-ClientHintIssueReason
+type AuditsClientHintIssueReason string
+
+// Values of AuditsClientHintIssueReason.
+const (
+	AuditsClientHintIssueReasonMetaTagAllowListInvalidOrigin AuditsClientHintIssueReason = "MetaTagAllowListInvalidOrigin"
+	AuditsClientHintIssueReasonMetaTagModifiedHTML           AuditsClientHintIssueReason = "MetaTagModifiedHTML"
+)
+
+// AuditsFederatedAuthRequestIssueDetails is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-FederatedAuthRequestIssueDetails
+type AuditsFederatedAuthRequestIssueDetails struct {
+	FederatedAuthRequestIssueReason AuditsFederatedAuthRequestIssueReason `json:"federatedAuthRequestIssueReason"`
+}
+
+// AuditsFederatedAuthRequestIssueReason represents the failure reason when a
+// federated authentication reason fails. Should be updated alongside
+// RequestIdTokenStatus in
+// third_party/blink/public/mojom/devtools/inspector_issue.mojom to include all
+// cases except for success.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-FederatedAuthRequestIssueReason
+type AuditsFederatedAuthRequestIssueReason string
+
+// Values of AuditsFederatedAuthRequestIssueReason.
+const (
+	AuditsFederatedAuthRequestIssueReasonShouldEmbargo                    AuditsFederatedAuthRequestIssueReason = "ShouldEmbargo"
+	AuditsFederatedAuthRequestIssueReasonTooManyRequests                  AuditsFederatedAuthRequestIssueReason = "TooManyRequests"
+	AuditsFederatedAuthRequestIssueReasonWellKnownHTTPNotFound            AuditsFederatedAuthRequestIssueReason = "WellKnownHttpNotFound"
+	AuditsFederatedAuthRequestIssueReasonWellKnownNoResponse              AuditsFederatedAuthRequestIssueReason = "WellKnownNoResponse"
+	AuditsFederatedAuthRequestIssueReasonWellKnownInvalidResponse         AuditsFederatedAuthRequestIssueReason = "WellKnownInvalidResponse"
+	AuditsFederatedAuthRequestIssueReasonWellKnownListEmpty               AuditsFederatedAuthRequestIssueReason = "WellKnownListEmpty"
+	AuditsFederatedAuthRequestIssueReasonWellKnownInvalidContentType      AuditsFederatedAuthRequestIssueReason = "WellKnownInvalidContentType"
+	AuditsFederatedAuthRequestIssueReasonConfigNotInWellKnown             AuditsFederatedAuthRequestIssueReason = "ConfigNotInWellKnown"
+	AuditsFederatedAuthRequestIssueReasonWellKnownTooBig                  AuditsFederatedAuthRequestIssueReason = "WellKnownTooBig"
+	AuditsFederatedAuthRequestIssueReasonConfigHTTPNotFound               AuditsFederatedAuthRequestIssueReason = "ConfigHttpNotFound"
+	AuditsFederatedAuthRequestIssueReasonConfigNoResponse                 AuditsFederatedAuthRequestIssueReason = "ConfigNoResponse"
+	AuditsFederatedAuthRequestIssueReasonConfigInvalidResponse            AuditsFederatedAuthRequestIssueReason = "ConfigInvalidResponse"
+	AuditsFederatedAuthRequestIssueReasonConfigInvalidContentType         AuditsFederatedAuthRequestIssueReason = "ConfigInvalidContentType"
+	AuditsFederatedAuthRequestIssueReasonClientMetadataHTTPNotFound       AuditsFederatedAuthRequestIssueReason = "ClientMetadataHttpNotFound"
+	AuditsFederatedAuthRequestIssueReasonClientMetadataNoResponse         AuditsFederatedAuthRequestIssueReason = "ClientMetadataNoResponse"
+	AuditsFederatedAuthRequestIssueReasonClientMetadataInvalidResponse    AuditsFederatedAuthRequestIssueReason = "ClientMetadataInvalidResponse"
+	AuditsFederatedAuthRequestIssueReasonClientMetadataInvalidContentType AuditsFederatedAuthRequestIssueReason = "ClientMetadataInvalidContentType"
+	AuditsFederatedAuthRequestIssueReasonIdpNotPotentiallyTrustworthy     AuditsFederatedAuthRequestIssueReason = "IdpNotPotentiallyTrustworthy"
+	AuditsFederatedAuthRequestIssueReasonDisabledInSettings               AuditsFederatedAuthRequestIssueReason = "DisabledInSettings"
+	AuditsFederatedAuthRequestIssueReasonDisabledInFlags                  AuditsFederatedAuthRequestIssueReason = "DisabledInFlags"
+	AuditsFederatedAuthRequestIssueReasonErrorFetchingSignin              AuditsFederatedAuthRequestIssueReason = "ErrorFetchingSignin"
+	AuditsFederatedAuthRequestIssueReasonInvalidSigninResponse            AuditsFederatedAuthRequestIssueReason = "InvalidSigninResponse"
+	AuditsFederatedAuthRequestIssueReasonAccountsHTTPNotFound             AuditsFederatedAuthRequestIssueReason = "AccountsHttpNotFound"
+	AuditsFederatedAuthRequestIssueReasonAccountsNoResponse               AuditsFederatedAuthRequestIssueReason = "AccountsNoResponse"
+	AuditsFederatedAuthRequestIssueReasonAccountsInvalidResponse          AuditsFederatedAuthRequestIssueReason = "AccountsInvalidResponse"
+	AuditsFederatedAuthRequestIssueReasonAccountsListEmpty                AuditsFederatedAuthRequestIssueReason = "AccountsListEmpty"
+	AuditsFederatedAuthRequestIssueReasonAccountsInvalidContentType       AuditsFederatedAuthRequestIssueReason = "AccountsInvalidContentType"
+	AuditsFederatedAuthRequestIssueReasonIDTokenHTTPNotFound              AuditsFederatedAuthRequestIssueReason = "IdTokenHttpNotFound"
+	AuditsFederatedAuthRequestIssueReasonIDTokenNoResponse                AuditsFederatedAuthRequestIssueReason = "IdTokenNoResponse"
+	AuditsFederatedAuthRequestIssueReasonIDTokenInvalidResponse           AuditsFederatedAuthRequestIssueReason = "IdTokenInvalidResponse"
+	AuditsFederatedAuthRequestIssueReasonIDTokenIdpErrorResponse          AuditsFederatedAuthRequestIssueReason = "IdTokenIdpErrorResponse"
+	AuditsFederatedAuthRequestIssueReasonIDTokenCrossSiteIdpErrorResponse AuditsFederatedAuthRequestIssueReason = "IdTokenCrossSiteIdpErrorResponse"
+	AuditsFederatedAuthRequestIssueReasonIDTokenInvalidRequest            AuditsFederatedAuthRequestIssueReason = "IdTokenInvalidRequest"
+	AuditsFederatedAuthRequestIssueReasonIDTokenInvalidContentType        AuditsFederatedAuthRequestIssueReason = "IdTokenInvalidContentType"
+	AuditsFederatedAuthRequestIssueReasonErrorIDToken                     AuditsFederatedAuthRequestIssueReason = "ErrorIdToken"
+	AuditsFederatedAuthRequestIssueReasonCanceled                         AuditsFederatedAuthRequestIssueReason = "Canceled"
+	AuditsFederatedAuthRequestIssueReasonRpPageNotVisible                 AuditsFederatedAuthRequestIssueReason = "RpPageNotVisible"
+	AuditsFederatedAuthRequestIssueReasonSilentMediationFailure           AuditsFederatedAuthRequestIssueReason = "SilentMediationFailure"
+	AuditsFederatedAuthRequestIssueReasonThirdPartyCookiesBlocked         AuditsFederatedAuthRequestIssueReason = "ThirdPartyCookiesBlocked"
+	AuditsFederatedAuthRequestIssueReasonNotSignedInWithIdp               AuditsFederatedAuthRequestIssueReason = "NotSignedInWithIdp"
+	AuditsFederatedAuthRequestIssueReasonMissingTransientUserActivation   AuditsFederatedAuthRequestIssueReason = "MissingTransientUserActivation"
+	AuditsFederatedAuthRequestIssueReasonReplacedByActiveMode             AuditsFederatedAuthRequestIssueReason = "ReplacedByActiveMode"
+	AuditsFederatedAuthRequestIssueReasonInvalidFieldsSpecified           AuditsFederatedAuthRequestIssueReason = "InvalidFieldsSpecified"
+	AuditsFederatedAuthRequestIssueReasonRelyingPartyOriginIsOpaque       AuditsFederatedAuthRequestIssueReason = "RelyingPartyOriginIsOpaque"
+	AuditsFederatedAuthRequestIssueReasonTypeNotMatching                  AuditsFederatedAuthRequestIssueReason = "TypeNotMatching"
+	AuditsFederatedAuthRequestIssueReasonUIDismissedNoEmbargo             AuditsFederatedAuthRequestIssueReason = "UiDismissedNoEmbargo"
+	AuditsFederatedAuthRequestIssueReasonCorsError                        AuditsFederatedAuthRequestIssueReason = "CorsError"
+	AuditsFederatedAuthRequestIssueReasonSuppressedBySegmentationPlatform AuditsFederatedAuthRequestIssueReason = "SuppressedBySegmentationPlatform"
+)
+
+// AuditsFederatedAuthUserInfoRequestIssueDetails is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-FederatedAuthUserInfoRequestIssueDetails
+type AuditsFederatedAuthUserInfoRequestIssueDetails struct {
+	FederatedAuthUserInfoRequestIssueReason AuditsFederatedAuthUserInfoRequestIssueReason `json:"federatedAuthUserInfoRequestIssueReason"`
+}
+
+// AuditsFederatedAuthUserInfoRequestIssueReason represents the failure reason
+// when a getUserInfo() call fails. Should be updated alongside
+// FederatedAuthUserInfoRequestResult in
+// third_party/blink/public/mojom/devtools/inspector_issue.mojom.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-FederatedAuthUserInfoRequestIssueReason
+type AuditsFederatedAuthUserInfoRequestIssueReason string
+
+// Values of AuditsFederatedAuthUserInfoRequestIssueReason.
+const (
+	AuditsFederatedAuthUserInfoRequestIssueReasonNotSameOrigin                      AuditsFederatedAuthUserInfoRequestIssueReason = "NotSameOrigin"
+	AuditsFederatedAuthUserInfoRequestIssueReasonNotIframe                          AuditsFederatedAuthUserInfoRequestIssueReason = "NotIframe"
+	AuditsFederatedAuthUserInfoRequestIssueReasonNotPotentiallyTrustworthy          AuditsFederatedAuthUserInfoRequestIssueReason = "NotPotentiallyTrustworthy"
+	AuditsFederatedAuthUserInfoRequestIssueReasonNoAPIPermission                    AuditsFederatedAuthUserInfoRequestIssueReason = "NoApiPermission"
+	AuditsFederatedAuthUserInfoRequestIssueReasonNotSignedInWithIdp                 AuditsFederatedAuthUserInfoRequestIssueReason = "NotSignedInWithIdp"
+	AuditsFederatedAuthUserInfoRequestIssueReasonNoAccountSharingPermission         AuditsFederatedAuthUserInfoRequestIssueReason = "NoAccountSharingPermission"
+	AuditsFederatedAuthUserInfoRequestIssueReasonInvalidConfigOrWellKnown           AuditsFederatedAuthUserInfoRequestIssueReason = "InvalidConfigOrWellKnown"
+	AuditsFederatedAuthUserInfoRequestIssueReasonInvalidAccountsResponse            AuditsFederatedAuthUserInfoRequestIssueReason = "InvalidAccountsResponse"
+	AuditsFederatedAuthUserInfoRequestIssueReasonNoReturningUserFromFetchedAccounts AuditsFederatedAuthUserInfoRequestIssueReason = "NoReturningUserFromFetchedAccounts"
+)
+
+// AuditsClientHintIssueDetails this issue tracks client hints related issues.
+// It's used to deprecate old features, encourage the use of new ones, and
+// provide general guidance.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-ClientHintIssueDetails
+type AuditsClientHintIssueDetails struct {
+	SourceCodeLocation    AuditsSourceCodeLocation    `json:"sourceCodeLocation"`
+	ClientHintIssueReason AuditsClientHintIssueReason `json:"clientHintIssueReason"`
+}
+
+// AuditsFailedRequestInfo is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-FailedRequestInfo
+type AuditsFailedRequestInfo struct {
+	// The URL that failed to load.
+	URL string `json:"url"`
+	// The failure message for the failed request.
+	FailureMessage string           `json:"failureMessage"`
+	RequestID      NetworkRequestID `json:"requestId,omitempty"`
+}
+
+// AuditsPartitioningBlobURLInfo is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-PartitioningBlobURLInfo
+type AuditsPartitioningBlobURLInfo string
+
+// Values of AuditsPartitioningBlobURLInfo.
+const (
+	AuditsPartitioningBlobURLInfoBlockedCrossPartitionFetching AuditsPartitioningBlobURLInfo = "BlockedCrossPartitionFetching"
+	AuditsPartitioningBlobURLInfoEnforceNoopenerForNavigation  AuditsPartitioningBlobURLInfo = "EnforceNoopenerForNavigation"
+)
+
+// AuditsPartitioningBlobURLIssueDetails is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-PartitioningBlobURLIssueDetails
+type AuditsPartitioningBlobURLIssueDetails struct {
+	// The BlobURL that failed to load.
+	URL string `json:"url"`
+	// Additional information about the Partitioning Blob URL issue.
+	PartitioningBlobURLInfo AuditsPartitioningBlobURLInfo `json:"partitioningBlobURLInfo"`
+}
+
+// AuditsElementAccessibilityIssueReason is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-ElementAccessibilityIssueReason
+type AuditsElementAccessibilityIssueReason string
+
+// Values of AuditsElementAccessibilityIssueReason.
+const (
+	AuditsElementAccessibilityIssueReasonDisallowedSelectChild               AuditsElementAccessibilityIssueReason = "DisallowedSelectChild"
+	AuditsElementAccessibilityIssueReasonDisallowedOptGroupChild             AuditsElementAccessibilityIssueReason = "DisallowedOptGroupChild"
+	AuditsElementAccessibilityIssueReasonNonPhrasingContentOptionChild       AuditsElementAccessibilityIssueReason = "NonPhrasingContentOptionChild"
+	AuditsElementAccessibilityIssueReasonInteractiveContentOptionChild       AuditsElementAccessibilityIssueReason = "InteractiveContentOptionChild"
+	AuditsElementAccessibilityIssueReasonInteractiveContentLegendChild       AuditsElementAccessibilityIssueReason = "InteractiveContentLegendChild"
+	AuditsElementAccessibilityIssueReasonInteractiveContentSummaryDescendant AuditsElementAccessibilityIssueReason = "InteractiveContentSummaryDescendant"
+)
+
+// AuditsElementAccessibilityIssueDetails this issue warns about errors in the
+// select or summary element content model.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-ElementAccessibilityIssueDetails
+type AuditsElementAccessibilityIssueDetails struct {
+	NodeID                          DOMBackendNodeID                      `json:"nodeId"`
+	ElementAccessibilityIssueReason AuditsElementAccessibilityIssueReason `json:"elementAccessibilityIssueReason"`
+	HasDisallowedAttributes         bool                                  `json:"hasDisallowedAttributes"`
+}
+
+// AuditsStyleSheetLoadingIssueReason is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-StyleSheetLoadingIssueReason
+type AuditsStyleSheetLoadingIssueReason string
+
+// Values of AuditsStyleSheetLoadingIssueReason.
+const (
+	AuditsStyleSheetLoadingIssueReasonLateImportRule AuditsStyleSheetLoadingIssueReason = "LateImportRule"
+	AuditsStyleSheetLoadingIssueReasonRequestFailed  AuditsStyleSheetLoadingIssueReason = "RequestFailed"
+)
+
+// AuditsStylesheetLoadingIssueDetails this issue warns when a referenced
+// stylesheet couldn't be loaded.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-StylesheetLoadingIssueDetails
+type AuditsStylesheetLoadingIssueDetails struct {
+	// Source code position that referenced the failing stylesheet.
+	SourceCodeLocation AuditsSourceCodeLocation `json:"sourceCodeLocation"`
+	// Reason why the stylesheet couldn't be loaded.
+	StyleSheetLoadingIssueReason AuditsStyleSheetLoadingIssueReason `json:"styleSheetLoadingIssueReason"`
+	// Contains additional info when the failure was due to a request.
+	FailedRequestInfo *AuditsFailedRequestInfo `json:"failedRequestInfo,omitempty"`
+}
+
+// AuditsPropertyRuleIssueReason is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-PropertyRuleIssueReason
+type AuditsPropertyRuleIssueReason string
+
+// Values of AuditsPropertyRuleIssueReason.
+const (
+	AuditsPropertyRuleIssueReasonInvalidSyntax       AuditsPropertyRuleIssueReason = "InvalidSyntax"
+	AuditsPropertyRuleIssueReasonInvalidInitialValue AuditsPropertyRuleIssueReason = "InvalidInitialValue"
+	AuditsPropertyRuleIssueReasonInvalidInherits     AuditsPropertyRuleIssueReason = "InvalidInherits"
+	AuditsPropertyRuleIssueReasonInvalidName         AuditsPropertyRuleIssueReason = "InvalidName"
+)
+
+// AuditsPropertyRuleIssueDetails this issue warns about errors in property
+// rules that lead to property registrations being ignored.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-PropertyRuleIssueDetails
+type AuditsPropertyRuleIssueDetails struct {
+	// Source code position of the property rule.
+	SourceCodeLocation AuditsSourceCodeLocation `json:"sourceCodeLocation"`
+	// Reason why the property rule was discarded.
+	PropertyRuleIssueReason AuditsPropertyRuleIssueReason `json:"propertyRuleIssueReason"`
+	// The value of the property rule property that failed to parse
+	PropertyValue string `json:"propertyValue,omitempty"`
+}
+
+// AuditsUserReidentificationIssueType is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-UserReidentificationIssueType
+type AuditsUserReidentificationIssueType string
+
+// Values of AuditsUserReidentificationIssueType.
+const (
+	AuditsUserReidentificationIssueTypeBlockedFrameNavigation AuditsUserReidentificationIssueType = "BlockedFrameNavigation"
+	AuditsUserReidentificationIssueTypeBlockedSubresource     AuditsUserReidentificationIssueType = "BlockedSubresource"
+)
+
+// AuditsUserReidentificationIssueDetails this issue warns about uses of APIs
+// that may be considered misuse to re-identify users.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-UserReidentificationIssueDetails
+type AuditsUserReidentificationIssueDetails struct {
+	Type AuditsUserReidentificationIssueType `json:"type"`
+	// Applies to BlockedFrameNavigation and BlockedSubresource issue types.
+	Request *AuditsAffectedRequest `json:"request,omitempty"`
+}
+
+// AuditsInspectorIssueCode a unique identifier for the type of issue. Each type
+// may use one of the optional fields in InspectorIssueDetails to convey more
+// specific information about the kind of issue.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-InspectorIssueCode
+type AuditsInspectorIssueCode string
+
+// Values of AuditsInspectorIssueCode.
+const (
+	AuditsInspectorIssueCodeCookieIssue                       AuditsInspectorIssueCode = "CookieIssue"
+	AuditsInspectorIssueCodeMixedContentIssue                 AuditsInspectorIssueCode = "MixedContentIssue"
+	AuditsInspectorIssueCodeBlockedByResponseIssue            AuditsInspectorIssueCode = "BlockedByResponseIssue"
+	AuditsInspectorIssueCodeHeavyAdIssue                      AuditsInspectorIssueCode = "HeavyAdIssue"
+	AuditsInspectorIssueCodeContentSecurityPolicyIssue        AuditsInspectorIssueCode = "ContentSecurityPolicyIssue"
+	AuditsInspectorIssueCodeSharedArrayBufferIssue            AuditsInspectorIssueCode = "SharedArrayBufferIssue"
+	AuditsInspectorIssueCodeLowTextContrastIssue              AuditsInspectorIssueCode = "LowTextContrastIssue"
+	AuditsInspectorIssueCodeCorsIssue                         AuditsInspectorIssueCode = "CorsIssue"
+	AuditsInspectorIssueCodeAttributionReportingIssue         AuditsInspectorIssueCode = "AttributionReportingIssue"
+	AuditsInspectorIssueCodeQuirksModeIssue                   AuditsInspectorIssueCode = "QuirksModeIssue"
+	AuditsInspectorIssueCodePartitioningBlobURLIssue          AuditsInspectorIssueCode = "PartitioningBlobURLIssue"
+	AuditsInspectorIssueCodeNavigatorUserAgentIssue           AuditsInspectorIssueCode = "NavigatorUserAgentIssue"
+	AuditsInspectorIssueCodeGenericIssue                      AuditsInspectorIssueCode = "GenericIssue"
+	AuditsInspectorIssueCodeDeprecationIssue                  AuditsInspectorIssueCode = "DeprecationIssue"
+	AuditsInspectorIssueCodeClientHintIssue                   AuditsInspectorIssueCode = "ClientHintIssue"
+	AuditsInspectorIssueCodeFederatedAuthRequestIssue         AuditsInspectorIssueCode = "FederatedAuthRequestIssue"
+	AuditsInspectorIssueCodeBounceTrackingIssue               AuditsInspectorIssueCode = "BounceTrackingIssue"
+	AuditsInspectorIssueCodeCookieDeprecationMetadataIssue    AuditsInspectorIssueCode = "CookieDeprecationMetadataIssue"
+	AuditsInspectorIssueCodeStylesheetLoadingIssue            AuditsInspectorIssueCode = "StylesheetLoadingIssue"
+	AuditsInspectorIssueCodeFederatedAuthUserInfoRequestIssue AuditsInspectorIssueCode = "FederatedAuthUserInfoRequestIssue"
+	AuditsInspectorIssueCodePropertyRuleIssue                 AuditsInspectorIssueCode = "PropertyRuleIssue"
+	AuditsInspectorIssueCodeSharedDictionaryIssue             AuditsInspectorIssueCode = "SharedDictionaryIssue"
+	AuditsInspectorIssueCodeElementAccessibilityIssue         AuditsInspectorIssueCode = "ElementAccessibilityIssue"
+	AuditsInspectorIssueCodeSRIMessageSignatureIssue          AuditsInspectorIssueCode = "SRIMessageSignatureIssue"
+	AuditsInspectorIssueCodeUnencodedDigestIssue              AuditsInspectorIssueCode = "UnencodedDigestIssue"
+	AuditsInspectorIssueCodeUserReidentificationIssue         AuditsInspectorIssueCode = "UserReidentificationIssue"
+)
+
+// AuditsInspectorIssueDetails this struct holds a list of optional fields with
+// additional information specific to the kind of issue. When adding a new issue
+// code, please also add a new optional field to this type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-InspectorIssueDetails
+type AuditsInspectorIssueDetails struct {
+	CookieIssueDetails                *AuditsCookieIssueDetails                `json:"cookieIssueDetails,omitempty"`
+	MixedContentIssueDetails          *AuditsMixedContentIssueDetails          `json:"mixedContentIssueDetails,omitempty"`
+	BlockedByResponseIssueDetails     *AuditsBlockedByResponseIssueDetails     `json:"blockedByResponseIssueDetails,omitempty"`
+	HeavyAdIssueDetails               *AuditsHeavyAdIssueDetails               `json:"heavyAdIssueDetails,omitempty"`
+	ContentSecurityPolicyIssueDetails *AuditsContentSecurityPolicyIssueDetails `json:"contentSecurityPolicyIssueDetails,omitempty"`
+	SharedArrayBufferIssueDetails     *AuditsSharedArrayBufferIssueDetails     `json:"sharedArrayBufferIssueDetails,omitempty"`
+	LowTextContrastIssueDetails       *AuditsLowTextContrastIssueDetails       `json:"lowTextContrastIssueDetails,omitempty"`
+	CorsIssueDetails                  *AuditsCorsIssueDetails                  `json:"corsIssueDetails,omitempty"`
+	AttributionReportingIssueDetails  *AuditsAttributionReportingIssueDetails  `json:"attributionReportingIssueDetails,omitempty"`
+	QuirksModeIssueDetails            *AuditsQuirksModeIssueDetails            `json:"quirksModeIssueDetails,omitempty"`
+	PartitioningBlobURLIssueDetails   *AuditsPartitioningBlobURLIssueDetails   `json:"partitioningBlobURLIssueDetails,omitempty"`
+	// Deprecated: this is deprecated in the protocol.
+	NavigatorUserAgentIssueDetails           *AuditsNavigatorUserAgentIssueDetails           `json:"navigatorUserAgentIssueDetails,omitempty"`
+	GenericIssueDetails                      *AuditsGenericIssueDetails                      `json:"genericIssueDetails,omitempty"`
+	DeprecationIssueDetails                  *AuditsDeprecationIssueDetails                  `json:"deprecationIssueDetails,omitempty"`
+	ClientHintIssueDetails                   *AuditsClientHintIssueDetails                   `json:"clientHintIssueDetails,omitempty"`
+	FederatedAuthRequestIssueDetails         *AuditsFederatedAuthRequestIssueDetails         `json:"federatedAuthRequestIssueDetails,omitempty"`
+	BounceTrackingIssueDetails               *AuditsBounceTrackingIssueDetails               `json:"bounceTrackingIssueDetails,omitempty"`
+	CookieDeprecationMetadataIssueDetails    *AuditsCookieDeprecationMetadataIssueDetails    `json:"cookieDeprecationMetadataIssueDetails,omitempty"`
+	StylesheetLoadingIssueDetails            *AuditsStylesheetLoadingIssueDetails            `json:"stylesheetLoadingIssueDetails,omitempty"`
+	PropertyRuleIssueDetails                 *AuditsPropertyRuleIssueDetails                 `json:"propertyRuleIssueDetails,omitempty"`
+	FederatedAuthUserInfoRequestIssueDetails *AuditsFederatedAuthUserInfoRequestIssueDetails `json:"federatedAuthUserInfoRequestIssueDetails,omitempty"`
+	SharedDictionaryIssueDetails             *AuditsSharedDictionaryIssueDetails             `json:"sharedDictionaryIssueDetails,omitempty"`
+	ElementAccessibilityIssueDetails         *AuditsElementAccessibilityIssueDetails         `json:"elementAccessibilityIssueDetails,omitempty"`
+	SriMessageSignatureIssueDetails          *AuditsSRIMessageSignatureIssueDetails          `json:"sriMessageSignatureIssueDetails,omitempty"`
+	UnencodedDigestIssueDetails              *AuditsUnencodedDigestIssueDetails              `json:"unencodedDigestIssueDetails,omitempty"`
+	UserReidentificationIssueDetails         *AuditsUserReidentificationIssueDetails         `json:"userReidentificationIssueDetails,omitempty"`
+}
+
+// AuditsIssueID a unique id for a DevTools inspector issue. Allows other
+// entities (e.g. exceptions, CDP message, console messages, etc.) to reference
+// an issue.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-IssueId
+type AuditsIssueID string
+
+// AuditsInspectorIssue an inspector issue reported from the back-end.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-InspectorIssue
+type AuditsInspectorIssue struct {
+	Code    AuditsInspectorIssueCode    `json:"code"`
+	Details AuditsInspectorIssueDetails `json:"details"`
+	// A unique id for this issue. May be omitted if no other entity (e.g.
+	// exception, CDP message, etc.) is referencing this issue.
+	IssueID AuditsIssueID `json:"issueId,omitempty"`
+}
@@ -0,0 +1,93 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package types
+
+// AutofillCreditCard is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Autofill#type-CreditCard
+type AutofillCreditCard struct {
+	// 16-digit credit card number.
+	Number string `json:"number"`
+	// Name of the credit card owner.
+	Name string `json:"name"`
+	// 2-digit expiry month.
+	ExpiryMonth string `json:"expiryMonth"`
+	// 4-digit expiry year.
+	ExpiryYear string `json:"expiryYear"`
+	// 3-digit card verification code.
+	Cvc string `json:"cvc"`
+}
+
+// AutofillAddressField is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Autofill#type-AddressField
+type AutofillAddressField struct {
+	// address field name, for example GIVEN_NAME.
+	Name string `json:"name"`
+	// address field value, for example Jon Doe.
+	Value string `json:"value"`
+}
+
+// AutofillAddressFields a list of address fields.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Autofill#type-AddressFields
+type AutofillAddressFields struct {
+	Fields []AutofillAddressField `json:"fields"`
+}
+
+// AutofillAddress is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Autofill#type-Address
+type AutofillAddress struct {
+	// fields and values defining an address.
+	Fields []AutofillAddressField `json:"fields"`
+}
+
+// AutofillAddressUI defines how an address can be displayed like in
+// chrome://settings/addresses. Address UI is a two dimensional array, each
+// inner array is an "address information line", and when rendered in a UI
+// surface should be displayed as such. The following address UI for instance:
+// [[{name: "GIVE_NAME", value: "Jon"}, {name: "FAMILY_NAME", value: "Doe"}],
+// [{name: "CITY", value: "Munich"}, {name: "ZIP", value: "81456"}]] should
+// allow the receiver to render: Jon Doe Munich 81456
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Autofill#type-AddressUI
+type AutofillAddressUI struct {
+	// A two dimension array containing the representation of values from an
+	// address profile.
+	AddressFields []AutofillAddressFields `json:"addressFields"`
+}
+
+// AutofillFillingStrategy specified whether a filled field was done so by using
+// the html autocomplete attribute or autofill heuristics.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Autofill#type-FillingStrategy
+type AutofillFillingStrategy string
+
+// Values of AutofillFillingStrategy.
+const (
+	AutofillFillingStrategyAutocompleteAttribute AutofillFillingStrategy = "autocompleteAttribute"
+	AutofillFillingStrategyAutofillInferred      AutofillFillingStrategy = "autofillInferred"
+)
+
+// AutofillFilledField is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Autofill#type-FilledField
+type AutofillFilledField struct {
+	// The type of the field, e.g text, password etc.
+	HTMLType string `json:"htmlType"`
+	// the html id
+	ID string `json:"id"`
+	// the html name
+	Name string `json:"name"`
+	// the field value
+	Value string `json:"value"`
+	// The actual field type, e.g FAMILY_NAME
+	AutofillType string `json:"autofillType"`
+	// The filling strategy
+	FillingStrategy AutofillFillingStrategy `json:"fillingStrategy"`
+	// The frame the field belongs to
+	FrameID PageFrameID `json:"frameId"`
+	// The form field's DOM node
+	FieldID DOMBackendNodeID `json:"fieldId"`
+}
@@ -0,0 +1,51 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package types
+
+// BackgroundServiceServiceName the Background Service that will be associated
+// with the commands/events. Every Background Service operates independently,
+// but they share the same API.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/BackgroundService#type-ServiceName
+type BackgroundServiceServiceName string
+
+// Values of BackgroundServiceServiceName.
+const (
+	BackgroundServiceServiceNameBackgroundFetch        BackgroundServiceServiceName = "backgroundFetch"
+	BackgroundServiceServiceNameBackgroundSync         BackgroundServiceServiceName = "backgroundSync"
+	BackgroundServiceServiceNamePushMessaging          BackgroundServiceServiceName = "pushMessaging"
+	BackgroundServiceServiceNameNotifications          BackgroundServiceServiceName = "notifications"
+	BackgroundServiceServiceNamePaymentHandler         BackgroundServiceServiceName = "paymentHandler"
+	BackgroundServiceServiceNamePeriodicBackgroundSync BackgroundServiceServiceName = "periodicBackgroundSync"
+)
+
+// BackgroundServiceEventMetadata a key-value pair for additional event
+// information to pass along.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/BackgroundService#type-EventMetadata
+type BackgroundServiceEventMetadata struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// BackgroundServiceBackgroundServiceEvent is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/BackgroundService#type-BackgroundServiceEvent
+type BackgroundServiceBackgroundServiceEvent struct {
+	// Timestamp of the event (in seconds).
+	Timestamp NetworkTimeSinceEpoch `json:"timestamp"`
+	// The origin this event belongs to.
+	Origin string `json:"origin"`
+	// The Service Worker ID that initiated the event.
+	ServiceWorkerRegistrationID ServiceWorkerRegistrationID `json:"serviceWorkerRegistrationId"`
+	// The Background Service this event belongs to.
+	Service BackgroundServiceServiceName `json:"service"`
+	// A description of the event.
+	EventName string `json:"eventName"`
+	// An identifier that groups related events together.
+	InstanceID string `json:"instanceId"`
+	// A list of event-specific information.
+	EventMetadata []BackgroundServiceEventMetadata `json:"eventMetadata"`
+	// Storage key this event belongs to.
+	StorageKey string `json:"storageKey"`
+}
@@ -0,0 +1,121 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package types
+
+// BluetoothEmulationCentralState indicates the various states of Central.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/BluetoothEmulation#type-CentralState
+type BluetoothEmulationCentralState string
+
+// Values of BluetoothEmulationCentralState.
+const (
+	BluetoothEmulationCentralStateAbsent     BluetoothEmulationCentralState = "absent"
+	BluetoothEmulationCentralStatePoweredOff BluetoothEmulationCentralState = "powered-off"
+	BluetoothEmulationCentralStatePoweredOn  BluetoothEmulationCentralState = "powered-on"
+)
+
+// BluetoothEmulationGATTOperationType indicates the various types of GATT
+// event.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/BluetoothEmulation#type-GATTOperationType
+type BluetoothEmulationGATTOperationType string
+
+// Values of BluetoothEmulationGATTOperationType.
+const (
+	BluetoothEmulationGATTOperationTypeConnection BluetoothEmulationGATTOperationType = "connection"
+	BluetoothEmulationGATTOperationTypeDiscovery  BluetoothEmulationGATTOperationType = "discovery"
+)
+
+// BluetoothEmulationCharacteristicWriteType indicates the various types of
+// characteristic write.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/BluetoothEmulation#type-CharacteristicWriteType
+type BluetoothEmulationCharacteristicWriteType string
+
+// Values of BluetoothEmulationCharacteristicWriteType.
+const (
+	BluetoothEmulationCharacteristicWriteTypeWriteDefaultDeprecated BluetoothEmulationCharacteristicWriteType = "write-default-deprecated"
+	BluetoothEmulationCharacteristicWriteTypeWriteWithResponse      BluetoothEmulationCharacteristicWriteType = "write-with-response"
+	BluetoothEmulationCharacteristicWriteTypeWriteWithoutResponse   BluetoothEmulationCharacteristicWriteType = "write-without-response"
+)
+
+// BluetoothEmulationCharacteristicOperationType indicates the various types of
+// characteristic operation.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/BluetoothEmulation#type-CharacteristicOperationType
+type BluetoothEmulationCharacteristicOperationType string
+
+// Values of BluetoothEmulationCharacteristicOperationType.
+const (
+	BluetoothEmulationCharacteristicOperationTypeRead                         BluetoothEmulationCharacteristicOperationType = "read"
+	BluetoothEmulationCharacteristicOperationTypeWrite                        BluetoothEmulationCharacteristicOperationType = "write"
+	BluetoothEmulationCharacteristicOperationTypeSubscribeToNotifications     BluetoothEmulationCharacteristicOperationType = "subscribe-to-notifications"
+	BluetoothEmulationCharacteristicOperationTypeUnsubscribeFromNotifications BluetoothEmulationCharacteristicOperationType = "unsubscribe-from-notifications"
+)
+
+// BluetoothEmulationDescriptorOperationType indicates the various types of
+// descriptor operation.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/BluetoothEmulation#type-DescriptorOperationType
+type BluetoothEmulationDescriptorOperationType string
+
+// Values of BluetoothEmulationDescriptorOperationType.
+const (
+	BluetoothEmulationDescriptorOperationTypeRead  BluetoothEmulationDescriptorOperationType = "read"
+	BluetoothEmulationDescriptorOperationTypeWrite BluetoothEmulationDescriptorOperationType = "write"
+)
+
+// BluetoothEmulationManufacturerData stores the manufacturer data
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/BluetoothEmulation#type-ManufacturerData
+type BluetoothEmulationManufacturerData struct {
+	// Company identifier
+	// https://bitbucket.org/bluetooth-SIG/public/src/main/assigned_numbers/company_identifiers/company_identifiers.yaml
+	// https://usb.org/developers
+	Key int64 `json:"key"`
+	// Manufacturer-specific data (Encoded as a base64 string when passed over
+	// JSON)
+	Data string `json:"data"`
+}
+
+// BluetoothEmulationScanRecord stores the byte data of the advertisement packet
+// sent by a Bluetooth device.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/BluetoothEmulation#type-ScanRecord
+type BluetoothEmulationScanRecord struct {
+	Name  string   `json:"name,omitempty"`
+	Uuids []string `json:"uuids,omitempty"`
+	// Stores the external appearance description of the device.
+	Appearance int64 `json:"appearance,omitempty"`
+	// Stores the transmission power of a broadcasting device.
+	TxPower int64 `json:"txPower,omitempty"`
+	// Key is the company identifier and the value is an array of bytes of
+	// manufacturer specific data.
+	ManufacturerData []BluetoothEmulationManufacturerData `json:"manufacturerData,omitempty"`
+}
+
+// BluetoothEmulationScanEntry stores the advertisement packet information that
+// is sent by a Bluetooth device.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/BluetoothEmulation#type-ScanEntry
+type BluetoothEmulationScanEntry struct {
+	DeviceAddress string                       `json:"deviceAddress"`
+	Rssi          int64                        `json:"rssi"`
+	ScanRecord    BluetoothEmulationScanRecord `json:"scanRecord"`
+}
+
+// BluetoothEmulationCharacteristicProperties describes the properties of a
+// characteristic. This follows Bluetooth Core Specification BT 4.2 Vol 3 Part G
+// 3.3.1. Characteristic Properties.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/BluetoothEmulation#type-CharacteristicProperties
+type BluetoothEmulationCharacteristicProperties struct {
+	Broadcast                 bool `json:"broadcast,omitempty"`
+	Read                      bool `json:"read,omitempty"`
+	WriteWithoutResponse      bool `json:"writeWithoutResponse,omitempty"`
+	Write                     bool `json:"write,omitempty"`
+	Notify                    bool `json:"notify,omitempty"`
+	Indicate                  bool `json:"indicate,omitempty"`
+	AuthenticatedSignedWrites bool `json:"authenticatedSignedWrites,omitempty"`
+	ExtendedProperties        bool `json:"extendedProperties,omitempty"`
+}
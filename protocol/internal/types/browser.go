@@ -0,0 +1,193 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package types
+
+// BrowserBrowserContextID is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Browser#type-BrowserContextID
+//
+// This is an experimental part of the protocol.
+type BrowserBrowserContextID string
+
+// BrowserWindowID is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Browser#type-WindowID
+//
+// This is an experimental part of the protocol.
+type BrowserWindowID int64
+
+// BrowserWindowState the state of the browser window.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Browser#type-WindowState
+//
+// This is an experimental part of the protocol.
+type BrowserWindowState string
+
+// Values of BrowserWindowState.
+const (
+	BrowserWindowStateNormal     BrowserWindowState = "normal"
+	BrowserWindowStateMinimized  BrowserWindowState = "minimized"
+	BrowserWindowStateMaximized  BrowserWindowState = "maximized"
+	BrowserWindowStateFullscreen BrowserWindowState = "fullscreen"
+)
+
+// BrowserBounds browser window bounds information
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Browser#type-Bounds
+//
+// This is an experimental part of the protocol.
+type BrowserBounds struct {
+	// The offset from the left edge of the screen to the window in pixels.
+	Left int64 `json:"left,omitempty"`
+	// The offset from the top edge of the screen to the window in pixels.
+	Top int64 `json:"top,omitempty"`
+	// The window width in pixels.
+	Width int64 `json:"width,omitempty"`
+	// The window height in pixels.
+	Height int64 `json:"height,omitempty"`
+	// The window state. Default to normal.
+	WindowState BrowserWindowState `json:"windowState,omitempty"`
+}
+
+// BrowserPermissionType is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Browser#type-PermissionType
+//
+// This is an experimental part of the protocol.
+type BrowserPermissionType string
+
+// Values of BrowserPermissionType.
+const (
+	BrowserPermissionTypeAr                       BrowserPermissionType = "ar"
+	BrowserPermissionTypeAudioCapture             BrowserPermissionType = "audioCapture"
+	BrowserPermissionTypeAutomaticFullscreen      BrowserPermissionType = "automaticFullscreen"
+	BrowserPermissionTypeBackgroundFetch          BrowserPermissionType = "backgroundFetch"
+	BrowserPermissionTypeBackgroundSync           BrowserPermissionType = "backgroundSync"
+	BrowserPermissionTypeCameraPanTiltZoom        BrowserPermissionType = "cameraPanTiltZoom"
+	BrowserPermissionTypeCapturedSurfaceControl   BrowserPermissionType = "capturedSurfaceControl"
+	BrowserPermissionTypeClipboardReadWrite       BrowserPermissionType = "clipboardReadWrite"
+	BrowserPermissionTypeClipboardSanitizedWrite  BrowserPermissionType = "clipboardSanitizedWrite"
+	BrowserPermissionTypeDisplayCapture           BrowserPermissionType = "displayCapture"
+	BrowserPermissionTypeDurableStorage           BrowserPermissionType = "durableStorage"
+	BrowserPermissionTypeGeolocation              BrowserPermissionType = "geolocation"
+	BrowserPermissionTypeHandTracking             BrowserPermissionType = "handTracking"
+	BrowserPermissionTypeIdleDetection            BrowserPermissionType = "idleDetection"
+	BrowserPermissionTypeKeyboardLock             BrowserPermissionType = "keyboardLock"
+	BrowserPermissionTypeLocalFonts               BrowserPermissionType = "localFonts"
+	BrowserPermissionTypeLocalNetworkAccess       BrowserPermissionType = "localNetworkAccess"
+	BrowserPermissionTypeMidi                     BrowserPermissionType = "midi"
+	BrowserPermissionTypeMidiSysex                BrowserPermissionType = "midiSysex"
+	BrowserPermissionTypeNfc                      BrowserPermissionType = "nfc"
+	BrowserPermissionTypeNotifications            BrowserPermissionType = "notifications"
+	BrowserPermissionTypePaymentHandler           BrowserPermissionType = "paymentHandler"
+	BrowserPermissionTypePeriodicBackgroundSync   BrowserPermissionType = "periodicBackgroundSync"
+	BrowserPermissionTypePointerLock              BrowserPermissionType = "pointerLock"
+	BrowserPermissionTypeProtectedMediaIdentifier BrowserPermissionType = "protectedMediaIdentifier"
+	BrowserPermissionTypeSensors                  BrowserPermissionType = "sensors"
+	BrowserPermissionTypeSmartCard                BrowserPermissionType = "smartCard"
+	BrowserPermissionTypeSpeakerSelection         BrowserPermissionType = "speakerSelection"
+	BrowserPermissionTypeStorageAccess            BrowserPermissionType = "storageAccess"
+	BrowserPermissionTypeTopLevelStorageAccess    BrowserPermissionType = "topLevelStorageAccess"
+	BrowserPermissionTypeVideoCapture             BrowserPermissionType = "videoCapture"
+	BrowserPermissionTypeVr                       BrowserPermissionType = "vr"
+	BrowserPermissionTypeWakeLockScreen           BrowserPermissionType = "wakeLockScreen"
+	BrowserPermissionTypeWakeLockSystem           BrowserPermissionType = "wakeLockSystem"
+	BrowserPermissionTypeWebAppInstallation       BrowserPermissionType = "webAppInstallation"
+	BrowserPermissionTypeWebPrinting              BrowserPermissionType = "webPrinting"
+	BrowserPermissionTypeWindowManagement         BrowserPermissionType = "windowManagement"
+)
+
+// BrowserPermissionSetting is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Browser#type-PermissionSetting
+//
+// This is an experimental part of the protocol.
+type BrowserPermissionSetting string
+
+// Values of BrowserPermissionSetting.
+const (
+	BrowserPermissionSettingGranted BrowserPermissionSetting = "granted"
+	BrowserPermissionSettingDenied  BrowserPermissionSetting = "denied"
+	BrowserPermissionSettingPrompt  BrowserPermissionSetting = "prompt"
+)
+
+// BrowserPermissionDescriptor definition of PermissionDescriptor defined in the
+// Permissions API: https://w3c.github.io/permissions/#dom-permissiondescriptor.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Browser#type-PermissionDescriptor
+//
+// This is an experimental part of the protocol.
+type BrowserPermissionDescriptor struct {
+	// Name of permission. See
+	// https://cs.chromium.org/chromium/src/third_party/blink/renderer/modules/permissions/permission_descriptor.idl
+	// for valid permission names.
+	Name string `json:"name"`
+	// For "midi" permission, may also specify sysex control.
+	Sysex bool `json:"sysex,omitempty"`
+	// For "push" permission, may specify userVisibleOnly. Note that
+	// userVisibleOnly = true is the only currently supported type.
+	UserVisibleOnly bool `json:"userVisibleOnly,omitempty"`
+	// For "clipboard" permission, may specify allowWithoutSanitization.
+	AllowWithoutSanitization bool `json:"allowWithoutSanitization,omitempty"`
+	// For "fullscreen" permission, must specify allowWithoutGesture:true.
+	AllowWithoutGesture bool `json:"allowWithoutGesture,omitempty"`
+	// For "camera" permission, may specify panTiltZoom.
+	PanTiltZoom bool `json:"panTiltZoom,omitempty"`
+}
+
+// BrowserBrowserCommandID browser command ids used by executeBrowserCommand.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Browser#type-BrowserCommandId
+//
+// This is an experimental part of the protocol.
+type BrowserBrowserCommandID string
+
+// Values of BrowserBrowserCommandID.
+const (
+	BrowserBrowserCommandIDOpenTabSearch  BrowserBrowserCommandID = "openTabSearch"
+	BrowserBrowserCommandIDCloseTabSearch BrowserBrowserCommandID = "closeTabSearch"
+	BrowserBrowserCommandIDOpenGlic       BrowserBrowserCommandID = "openGlic"
+)
+
+// BrowserBucket chrome histogram bucket.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Browser#type-Bucket
+//
+// This is an experimental part of the protocol.
+type BrowserBucket struct {
+	// Minimum value (inclusive).
+	Low int64 `json:"low"`
+	// Maximum value (exclusive).
+	High int64 `json:"high"`
+	// Number of samples.
+	Count int64 `json:"count"`
+}
+
+// BrowserHistogram chrome histogram.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Browser#type-Histogram
+//
+// This is an experimental part of the protocol.
+type BrowserHistogram struct {
+	// Name.
+	Name string `json:"name"`
+	// Sum of sample values.
+	Sum int64 `json:"sum"`
+	// Total number of samples.
+	Count int64 `json:"count"`
+	// Buckets.
+	Buckets []BrowserBucket `json:"buckets"`
+}
+
+// BrowserPrivacySandboxAPI is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Browser#type-PrivacySandboxAPI
+//
+// This is an experimental part of the protocol.
+type BrowserPrivacySandboxAPI string
+
+// Values of BrowserPrivacySandboxAPI.
+const (
+	BrowserPrivacySandboxAPIBiddingAndAuctionServices BrowserPrivacySandboxAPI = "BiddingAndAuctionServices"
+	BrowserPrivacySandboxAPITrustedKeyValue           BrowserPrivacySandboxAPI = "TrustedKeyValue"
+)
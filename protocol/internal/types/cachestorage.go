@@ -0,0 +1,78 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package types
+
+// CacheStorageCacheID unique identifier of the Cache object.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CacheStorage#type-CacheId
+type CacheStorageCacheID string
+
+// CacheStorageCachedResponseType type of HTTP response cached
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CacheStorage#type-CachedResponseType
+type CacheStorageCachedResponseType string
+
+// Values of CacheStorageCachedResponseType.
+const (
+	CacheStorageCachedResponseTypeBasic          CacheStorageCachedResponseType = "basic"
+	CacheStorageCachedResponseTypeCors           CacheStorageCachedResponseType = "cors"
+	CacheStorageCachedResponseTypeDefault        CacheStorageCachedResponseType = "default"
+	CacheStorageCachedResponseTypeError          CacheStorageCachedResponseType = "error"
+	CacheStorageCachedResponseTypeOpaqueResponse CacheStorageCachedResponseType = "opaqueResponse"
+	CacheStorageCachedResponseTypeOpaqueRedirect CacheStorageCachedResponseType = "opaqueRedirect"
+)
+
+// CacheStorageDataEntry data entry.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CacheStorage#type-DataEntry
+type CacheStorageDataEntry struct {
+	// Request URL.
+	RequestURL string `json:"requestURL"`
+	// Request method.
+	RequestMethod string `json:"requestMethod"`
+	// Request headers
+	RequestHeaders []CacheStorageHeader `json:"requestHeaders"`
+	// Number of seconds since epoch.
+	ResponseTime float64 `json:"responseTime"`
+	// HTTP response status code.
+	ResponseStatus int64 `json:"responseStatus"`
+	// HTTP response status text.
+	ResponseStatusText string `json:"responseStatusText"`
+	// HTTP response type
+	ResponseType CacheStorageCachedResponseType `json:"responseType"`
+	// Response headers
+	ResponseHeaders []CacheStorageHeader `json:"responseHeaders"`
+}
+
+// CacheStorageCache cache identifier.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CacheStorage#type-Cache
+type CacheStorageCache struct {
+	// An opaque unique id of the cache.
+	CacheID CacheStorageCacheID `json:"cacheId"`
+	// Security origin of the cache.
+	SecurityOrigin string `json:"securityOrigin"`
+	// Storage key of the cache.
+	StorageKey string `json:"storageKey"`
+	// Storage bucket of the cache.
+	StorageBucket *StorageStorageBucket `json:"storageBucket,omitempty"`
+	// The name of the cache.
+	CacheName string `json:"cacheName"`
+}
+
+// CacheStorageHeader is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CacheStorage#type-Header
+type CacheStorageHeader struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// CacheStorageCachedResponse cached response
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CacheStorage#type-CachedResponse
+type CacheStorageCachedResponse struct {
+	// Entry content, base64-encoded. (Encoded as a base64 string when passed over
+	// JSON)
+	Body string `json:"body"`
+}
@@ -0,0 +1,26 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package types
+
+// ConsoleConsoleMessage console message.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Console#type-ConsoleMessage
+type ConsoleConsoleMessage struct {
+	// Message source.
+	//
+	// Allowed values: xml, javascript, network, console-api, storage, appcache,
+	// rendering, security, other, deprecation, worker.
+	Source string `json:"source"`
+	// Message severity.
+	//
+	// Allowed values: log, warning, error, debug, info.
+	Level string `json:"level"`
+	// Message text.
+	Text string `json:"text"`
+	// URL of the message origin.
+	URL string `json:"url,omitempty"`
+	// Line number in the resource that generated this message (1-based).
+	Line int64 `json:"line,omitempty"`
+	// Column number in the resource that generated this message (1-based).
+	Column int64 `json:"column,omitempty"`
+}
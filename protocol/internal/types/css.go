@@ -0,0 +1,726 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package types
+
+// CSSStyleSheetID is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CSS#type-StyleSheetId
+type CSSStyleSheetID string
+
+// CSSStyleSheetOrigin stylesheet type: "injected" for stylesheets injected via
+// extension, "user-agent" for user-agent stylesheets, "inspector" for
+// stylesheets created by the inspector (i.e. those holding the "via inspector"
+// rules), "regular" for regular stylesheets.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CSS#type-StyleSheetOrigin
+type CSSStyleSheetOrigin string
+
+// Values of CSSStyleSheetOrigin.
+const (
+	CSSStyleSheetOriginInjected  CSSStyleSheetOrigin = "injected"
+	CSSStyleSheetOriginUserAgent CSSStyleSheetOrigin = "user-agent"
+	CSSStyleSheetOriginInspector CSSStyleSheetOrigin = "inspector"
+	CSSStyleSheetOriginRegular   CSSStyleSheetOrigin = "regular"
+)
+
+// CSSPseudoElementMatches CSS rule collection for a single pseudo style.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CSS#type-PseudoElementMatches
+type CSSPseudoElementMatches struct {
+	// Pseudo element type.
+	PseudoType DOMPseudoType `json:"pseudoType"`
+	// Pseudo element custom ident.
+	PseudoIdentifier string `json:"pseudoIdentifier,omitempty"`
+	// Matches of CSS rules applicable to the pseudo style.
+	Matches []CSSRuleMatch `json:"matches"`
+}
+
+// CSSCSSAnimationStyle CSS style coming from animations with the name of the
+// animation.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CSS#type-CSSAnimationStyle
+type CSSCSSAnimationStyle struct {
+	// The name of the animation.
+	Name string `json:"name,omitempty"`
+	// The style coming from the animation.
+	Style CSSCSSStyle `json:"style"`
+}
+
+// CSSInheritedStyleEntry inherited CSS rule collection from ancestor node.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CSS#type-InheritedStyleEntry
+type CSSInheritedStyleEntry struct {
+	// The ancestor node's inline style, if any, in the style inheritance chain.
+	InlineStyle *CSSCSSStyle `json:"inlineStyle,omitempty"`
+	// Matches of CSS rules matching the ancestor node in the style inheritance
+	// chain.
+	MatchedCSSRules []CSSRuleMatch `json:"matchedCSSRules"`
+}
+
+// CSSInheritedAnimatedStyleEntry inherited CSS style collection for animated
+// styles from ancestor node.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CSS#type-InheritedAnimatedStyleEntry
+type CSSInheritedAnimatedStyleEntry struct {
+	// Styles coming from the animations of the ancestor, if any, in the style
+	// inheritance chain.
+	AnimationStyles []CSSCSSAnimationStyle `json:"animationStyles,omitempty"`
+	// The style coming from the transitions of the ancestor, if any, in the style
+	// inheritance chain.
+	TransitionsStyle *CSSCSSStyle `json:"transitionsStyle,omitempty"`
+}
+
+// CSSInheritedPseudoElementMatches inherited pseudo element matches from
+// pseudos of an ancestor node.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CSS#type-InheritedPseudoElementMatches
+type CSSInheritedPseudoElementMatches struct {
+	// Matches of pseudo styles from the pseudos of an ancestor node.
+	PseudoElements []CSSPseudoElementMatches `json:"pseudoElements"`
+}
+
+// CSSRuleMatch match data for a CSS rule.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CSS#type-RuleMatch
+type CSSRuleMatch struct {
+	// CSS rule in the match.
+	Rule CSSCSSRule `json:"rule"`
+	// Matching selector indices in the rule's selectorList selectors (0-based).
+	MatchingSelectors []int64 `json:"matchingSelectors"`
+}
+
+// CSSValue data for a simple selector (these are delimited by commas in a
+// selector list).
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CSS#type-Value
+type CSSValue struct {
+	// Value text.
+	Text string `json:"text"`
+	// Value range in the underlying resource (if available).
+	Range *CSSSourceRange `json:"range,omitempty"`
+	// Specificity of the selector.
+	//
+	// This is an experimental part of the protocol.
+	Specificity *CSSSpecificity `json:"specificity,omitempty"`
+}
+
+// CSSSpecificity specificity:
+// https://drafts.csswg.org/selectors/#specificity-rules
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CSS#type-Specificity
+//
+// This is an experimental part of the protocol.
+type CSSSpecificity struct {
+	// The a component, which represents the number of ID selectors.
+	A int64 `json:"a"`
+	// The b component, which represents the number of class selectors, attributes
+	// selectors, and pseudo-classes.
+	B int64 `json:"b"`
+	// The c component, which represents the number of type selectors and
+	// pseudo-elements.
+	C int64 `json:"c"`
+}
+
+// CSSSelectorList selector list data.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CSS#type-SelectorList
+type CSSSelectorList struct {
+	// Selectors in the list.
+	Selectors []CSSValue `json:"selectors"`
+	// Rule selector text.
+	Text string `json:"text"`
+}
+
+// CSSCSSStyleSheetHeader CSS stylesheet metainformation.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CSS#type-CSSStyleSheetHeader
+type CSSCSSStyleSheetHeader struct {
+	// The stylesheet identifier.
+	StyleSheetID CSSStyleSheetID `json:"styleSheetId"`
+	// Owner frame identifier.
+	FrameID PageFrameID `json:"frameId"`
+	// Stylesheet resource URL. Empty if this is a constructed stylesheet created
+	// using new CSSStyleSheet() (but non-empty if this is a constructed stylesheet
+	// imported as a CSS module script).
+	SourceURL string `json:"sourceURL"`
+	// URL of source map associated with the stylesheet (if any).
+	SourceMapURL string `json:"sourceMapURL,omitempty"`
+	// Stylesheet origin.
+	Origin CSSStyleSheetOrigin `json:"origin"`
+	// Stylesheet title.
+	Title string `json:"title"`
+	// The backend id for the owner node of the stylesheet.
+	OwnerNode DOMBackendNodeID `json:"ownerNode,omitempty"`
+	// Denotes whether the stylesheet is disabled.
+	Disabled bool `json:"disabled"`
+	// Whether the sourceURL field value comes from the sourceURL comment.
+	HasSourceURL bool `json:"hasSourceURL,omitempty"`
+	// Whether this stylesheet is created for STYLE tag by parser. This flag is not
+	// set for document.written STYLE tags.
+	IsInline bool `json:"isInline"`
+	// Whether this stylesheet is mutable. Inline stylesheets become mutable after
+	// they have been modified via CSSOM API. `<link>` element's stylesheets become
+	// mutable only if DevTools modifies them. Constructed stylesheets (new
+	// CSSStyleSheet()) are mutable immediately after creation.
+	IsMutable bool `json:"isMutable"`
+	// True if this stylesheet is created through new CSSStyleSheet() or imported
+	// as a CSS module script.
+	IsConstructed bool `json:"isConstructed"`
+	// Line offset of the stylesheet within the resource (zero based).
+	StartLine float64 `json:"startLine"`
+	// Column offset of the stylesheet within the resource (zero based).
+	StartColumn float64 `json:"startColumn"`
+	// Size of the content (in characters).
+	Length float64 `json:"length"`
+	// Line offset of the end of the stylesheet within the resource (zero based).
+	EndLine float64 `json:"endLine"`
+	// Column offset of the end of the stylesheet within the resource (zero based).
+	EndColumn float64 `json:"endColumn"`
+	// If the style sheet was loaded from a network resource, this indicates when
+	// the resource failed to load
+	//
+	// This is an experimental part of the protocol.
+	LoadingFailed bool `json:"loadingFailed,omitempty"`
+}
+
+// CSSCSSRule CSS rule representation.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CSS#type-CSSRule
+type CSSCSSRule struct {
+	// The css style sheet identifier (absent for user agent stylesheet and
+	// user-specified stylesheet rules) this rule came from.
+	StyleSheetID CSSStyleSheetID `json:"styleSheetId,omitempty"`
+	// Rule selector data.
+	SelectorList CSSSelectorList `json:"selectorList"`
+	// Array of selectors from ancestor style rules, sorted by distance from the
+	// current rule.
+	//
+	// This is an experimental part of the protocol.
+	NestingSelectors []string `json:"nestingSelectors,omitempty"`
+	// Parent stylesheet's origin.
+	Origin CSSStyleSheetOrigin `json:"origin"`
+	// Associated style declaration.
+	Style CSSCSSStyle `json:"style"`
+	// Media list array (for rules involving media queries). The array enumerates
+	// media queries starting with the innermost one, going outwards.
+	Media []CSSCSSMedia `json:"media,omitempty"`
+	// Container query list array (for rules involving container queries). The
+	// array enumerates container queries starting with the innermost one, going
+	// outwards.
+	//
+	// This is an experimental part of the protocol.
+	ContainerQueries []CSSCSSContainerQuery `json:"containerQueries,omitempty"`
+	// @supports CSS at-rule array. The array enumerates @supports at-rules
+	// starting with the innermost one, going outwards.
+	//
+	// This is an experimental part of the protocol.
+	Supports []CSSCSSSupports `json:"supports,omitempty"`
+	// Cascade layer array. Contains the layer hierarchy that this rule belongs to
+	// starting with the innermost layer and going outwards.
+	//
+	// This is an experimental part of the protocol.
+	Layers []CSSCSSLayer `json:"layers,omitempty"`
+	// @scope CSS at-rule array. The array enumerates @scope at-rules starting with
+	// the innermost one, going outwards.
+	//
+	// This is an experimental part of the protocol.
+	Scopes []CSSCSSScope `json:"scopes,omitempty"`
+	// The array keeps the types of ancestor CSSRules from the innermost going
+	// outwards.
+	//
+	// This is an experimental part of the protocol.
+	RuleTypes []CSSCSSRuleType `json:"ruleTypes,omitempty"`
+	// @starting-style CSS at-rule array. The array enumerates @starting-style
+	// at-rules starting with the innermost one, going outwards.
+	//
+	// This is an experimental part of the protocol.
+	StartingStyles []CSSCSSStartingStyle `json:"startingStyles,omitempty"`
+}
+
+// CSSCSSRuleType enum indicating the type of a CSS rule, used to represent the
+// order of a style rule's ancestors. This list only contains rule types that
+// are collected during the ancestor rule collection.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CSS#type-CSSRuleType
+//
+// This is an experimental part of the protocol.
+type CSSCSSRuleType string
+
+// Values of CSSCSSRuleType.
+const (
+	CSSCSSRuleTypeMediaRule         CSSCSSRuleType = "MediaRule"
+	CSSCSSRuleTypeSupportsRule      CSSCSSRuleType = "SupportsRule"
+	CSSCSSRuleTypeContainerRule     CSSCSSRuleType = "ContainerRule"
+	CSSCSSRuleTypeLayerRule         CSSCSSRuleType = "LayerRule"
+	CSSCSSRuleTypeScopeRule         CSSCSSRuleType = "ScopeRule"
+	CSSCSSRuleTypeStyleRule         CSSCSSRuleType = "StyleRule"
+	CSSCSSRuleTypeStartingStyleRule CSSCSSRuleType = "StartingStyleRule"
+)
+
+// CSSRuleUsage CSS coverage information.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CSS#type-RuleUsage
+type CSSRuleUsage struct {
+	// The css style sheet identifier (absent for user agent stylesheet and
+	// user-specified stylesheet rules) this rule came from.
+	StyleSheetID CSSStyleSheetID `json:"styleSheetId"`
+	// Offset of the start of the rule (including selector) from the beginning of
+	// the stylesheet.
+	StartOffset float64 `json:"startOffset"`
+	// Offset of the end of the rule body from the beginning of the stylesheet.
+	EndOffset float64 `json:"endOffset"`
+	// Indicates whether the rule was actually used by some element in the page.
+	Used bool `json:"used"`
+}
+
+// CSSSourceRange text range within a resource. All numbers are zero-based.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CSS#type-SourceRange
+type CSSSourceRange struct {
+	// Start line of range.
+	StartLine int64 `json:"startLine"`
+	// Start column of range (inclusive).
+	StartColumn int64 `json:"startColumn"`
+	// End line of range
+	EndLine int64 `json:"endLine"`
+	// End column of range (exclusive).
+	EndColumn int64 `json:"endColumn"`
+}
+
+// CSSShorthandEntry is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CSS#type-ShorthandEntry
+type CSSShorthandEntry struct {
+	// Shorthand name.
+	Name string `json:"name"`
+	// Shorthand value.
+	Value string `json:"value"`
+	// Whether the property has "!important" annotation (implies `false` if
+	// absent).
+	Important bool `json:"important,omitempty"`
+}
+
+// CSSCSSComputedStyleProperty is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CSS#type-CSSComputedStyleProperty
+type CSSCSSComputedStyleProperty struct {
+	// Computed style property name.
+	Name string `json:"name"`
+	// Computed style property value.
+	Value string `json:"value"`
+}
+
+// CSSCSSStyle CSS style representation.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CSS#type-CSSStyle
+type CSSCSSStyle struct {
+	// The css style sheet identifier (absent for user agent stylesheet and
+	// user-specified stylesheet rules) this rule came from.
+	StyleSheetID CSSStyleSheetID `json:"styleSheetId,omitempty"`
+	// CSS properties in the style.
+	CSSProperties []CSSCSSProperty `json:"cssProperties"`
+	// Computed values for all shorthands found in the style.
+	ShorthandEntries []CSSShorthandEntry `json:"shorthandEntries"`
+	// Style declaration text (if available).
+	CSSText string `json:"cssText,omitempty"`
+	// Style declaration range in the enclosing stylesheet (if available).
+	Range *CSSSourceRange `json:"range,omitempty"`
+}
+
+// CSSCSSProperty CSS property declaration data.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CSS#type-CSSProperty
+type CSSCSSProperty struct {
+	// The property name.
+	Name string `json:"name"`
+	// The property value.
+	Value string `json:"value"`
+	// Whether the property has "!important" annotation (implies `false` if
+	// absent).
+	Important bool `json:"important,omitempty"`
+	// Whether the property is implicit (implies `false` if absent).
+	Implicit bool `json:"implicit,omitempty"`
+	// The full property text as specified in the style.
+	Text string `json:"text,omitempty"`
+	// Whether the property is understood by the browser (implies `true` if
+	// absent).
+	ParsedOk bool `json:"parsedOk,omitempty"`
+	// Whether the property is disabled by the user (present for source-based
+	// properties only).
+	Disabled bool `json:"disabled,omitempty"`
+	// The entire property range in the enclosing style declaration (if available).
+	Range *CSSSourceRange `json:"range,omitempty"`
+	// Parsed longhand components of this property if it is a shorthand. This field
+	// will be empty if the given property is not a shorthand.
+	//
+	// This is an experimental part of the protocol.
+	LonghandProperties []CSSCSSProperty `json:"longhandProperties,omitempty"`
+}
+
+// CSSCSSMedia CSS media rule descriptor.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CSS#type-CSSMedia
+type CSSCSSMedia struct {
+	// Media query text.
+	Text string `json:"text"`
+	// Source of the media query: "mediaRule" if specified by a @media rule,
+	// "importRule" if specified by an @import rule, "linkedSheet" if specified by
+	// a "media" attribute in a linked stylesheet's LINK tag, "inlineSheet" if
+	// specified by a "media" attribute in an inline stylesheet's STYLE tag.
+	//
+	// Allowed values: mediaRule, importRule, linkedSheet, inlineSheet.
+	Source string `json:"source"`
+	// URL of the document containing the media query description.
+	SourceURL string `json:"sourceURL,omitempty"`
+	// The associated rule (@media or @import) header range in the enclosing
+	// stylesheet (if available).
+	Range *CSSSourceRange `json:"range,omitempty"`
+	// Identifier of the stylesheet containing this object (if exists).
+	StyleSheetID CSSStyleSheetID `json:"styleSheetId,omitempty"`
+	// Array of media queries.
+	MediaList []CSSMediaQuery `json:"mediaList,omitempty"`
+}
+
+// CSSMediaQuery media query descriptor.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CSS#type-MediaQuery
+type CSSMediaQuery struct {
+	// Array of media query expressions.
+	Expressions []CSSMediaQueryExpression `json:"expressions"`
+	// Whether the media query condition is satisfied.
+	Active bool `json:"active"`
+}
+
+// CSSMediaQueryExpression media query expression descriptor.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CSS#type-MediaQueryExpression
+type CSSMediaQueryExpression struct {
+	// Media query expression value.
+	Value float64 `json:"value"`
+	// Media query expression units.
+	Unit string `json:"unit"`
+	// Media query expression feature.
+	Feature string `json:"feature"`
+	// The associated range of the value text in the enclosing stylesheet (if
+	// available).
+	ValueRange *CSSSourceRange `json:"valueRange,omitempty"`
+	// Computed length of media query expression (if applicable).
+	ComputedLength float64 `json:"computedLength,omitempty"`
+}
+
+// CSSCSSContainerQuery CSS container query rule descriptor.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CSS#type-CSSContainerQuery
+//
+// This is an experimental part of the protocol.
+type CSSCSSContainerQuery struct {
+	// Container query text.
+	Text string `json:"text"`
+	// The associated rule header range in the enclosing stylesheet (if available).
+	Range *CSSSourceRange `json:"range,omitempty"`
+	// Identifier of the stylesheet containing this object (if exists).
+	StyleSheetID CSSStyleSheetID `json:"styleSheetId,omitempty"`
+	// Optional name for the container.
+	Name string `json:"name,omitempty"`
+	// Optional physical axes queried for the container.
+	PhysicalAxes DOMPhysicalAxes `json:"physicalAxes,omitempty"`
+	// Optional logical axes queried for the container.
+	LogicalAxes DOMLogicalAxes `json:"logicalAxes,omitempty"`
+	// true if the query contains scroll-state() queries.
+	QueriesScrollState bool `json:"queriesScrollState,omitempty"`
+	// true if the query contains anchored() queries.
+	QueriesAnchored bool `json:"queriesAnchored,omitempty"`
+}
+
+// CSSCSSSupports CSS Supports at-rule descriptor.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CSS#type-CSSSupports
+//
+// This is an experimental part of the protocol.
+type CSSCSSSupports struct {
+	// Supports rule text.
+	Text string `json:"text"`
+	// Whether the supports condition is satisfied.
+	Active bool `json:"active"`
+	// The associated rule header range in the enclosing stylesheet (if available).
+	Range *CSSSourceRange `json:"range,omitempty"`
+	// Identifier of the stylesheet containing this object (if exists).
+	StyleSheetID CSSStyleSheetID `json:"styleSheetId,omitempty"`
+}
+
+// CSSCSSScope CSS Scope at-rule descriptor.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CSS#type-CSSScope
+//
+// This is an experimental part of the protocol.
+type CSSCSSScope struct {
+	// Scope rule text.
+	Text string `json:"text"`
+	// The associated rule header range in the enclosing stylesheet (if available).
+	Range *CSSSourceRange `json:"range,omitempty"`
+	// Identifier of the stylesheet containing this object (if exists).
+	StyleSheetID CSSStyleSheetID `json:"styleSheetId,omitempty"`
+}
+
+// CSSCSSLayer CSS Layer at-rule descriptor.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CSS#type-CSSLayer
+//
+// This is an experimental part of the protocol.
+type CSSCSSLayer struct {
+	// Layer name.
+	Text string `json:"text"`
+	// The associated rule header range in the enclosing stylesheet (if available).
+	Range *CSSSourceRange `json:"range,omitempty"`
+	// Identifier of the stylesheet containing this object (if exists).
+	StyleSheetID CSSStyleSheetID `json:"styleSheetId,omitempty"`
+}
+
+// CSSCSSStartingStyle CSS Starting Style at-rule descriptor.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CSS#type-CSSStartingStyle
+//
+// This is an experimental part of the protocol.
+type CSSCSSStartingStyle struct {
+	// The associated rule header range in the enclosing stylesheet (if available).
+	Range *CSSSourceRange `json:"range,omitempty"`
+	// Identifier of the stylesheet containing this object (if exists).
+	StyleSheetID CSSStyleSheetID `json:"styleSheetId,omitempty"`
+}
+
+// CSSCSSLayerData CSS Layer data.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CSS#type-CSSLayerData
+//
+// This is an experimental part of the protocol.
+type CSSCSSLayerData struct {
+	// Layer name.
+	Name string `json:"name"`
+	// Direct sub-layers
+	SubLayers []CSSCSSLayerData `json:"subLayers,omitempty"`
+	// Layer order. The order determines the order of the layer in the cascade
+	// order. A higher number has higher priority in the cascade order.
+	Order float64 `json:"order"`
+}
+
+// CSSPlatformFontUsage information about amount of glyphs that were rendered
+// with given font.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CSS#type-PlatformFontUsage
+type CSSPlatformFontUsage struct {
+	// Font's family name reported by platform.
+	FamilyName string `json:"familyName"`
+	// Font's PostScript name reported by platform.
+	PostScriptName string `json:"postScriptName"`
+	// Indicates if the font was downloaded or resolved locally.
+	IsCustomFont bool `json:"isCustomFont"`
+	// Amount of glyphs that were rendered with this font.
+	GlyphCount float64 `json:"glyphCount"`
+}
+
+// CSSFontVariationAxis information about font variation axes for variable fonts
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CSS#type-FontVariationAxis
+type CSSFontVariationAxis struct {
+	// The font-variation-setting tag (a.k.a. "axis tag").
+	Tag string `json:"tag"`
+	// Human-readable variation name in the default language (normally, "en").
+	Name string `json:"name"`
+	// The minimum value (inclusive) the font supports for this tag.
+	MinValue float64 `json:"minValue"`
+	// The maximum value (inclusive) the font supports for this tag.
+	MaxValue float64 `json:"maxValue"`
+	// The default value.
+	DefaultValue float64 `json:"defaultValue"`
+}
+
+// CSSFontFace properties of a web font:
+// https://www.w3.org/TR/2008/REC-CSS2-20080411/fonts.html#font-descriptions and
+// additional information such as platformFontFamily and fontVariationAxes.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CSS#type-FontFace
+type CSSFontFace struct {
+	// The font-family.
+	FontFamily string `json:"fontFamily"`
+	// The font-style.
+	FontStyle string `json:"fontStyle"`
+	// The font-variant.
+	FontVariant string `json:"fontVariant"`
+	// The font-weight.
+	FontWeight string `json:"fontWeight"`
+	// The font-stretch.
+	FontStretch string `json:"fontStretch"`
+	// The font-display.
+	FontDisplay string `json:"fontDisplay"`
+	// The unicode-range.
+	UnicodeRange string `json:"unicodeRange"`
+	// The src.
+	Src string `json:"src"`
+	// The resolved platform font family
+	PlatformFontFamily string `json:"platformFontFamily"`
+	// Available variation settings (a.k.a. "axes").
+	FontVariationAxes []CSSFontVariationAxis `json:"fontVariationAxes,omitempty"`
+}
+
+// CSSCSSTryRule CSS try rule representation.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CSS#type-CSSTryRule
+type CSSCSSTryRule struct {
+	// The css style sheet identifier (absent for user agent stylesheet and
+	// user-specified stylesheet rules) this rule came from.
+	StyleSheetID CSSStyleSheetID `json:"styleSheetId,omitempty"`
+	// Parent stylesheet's origin.
+	Origin CSSStyleSheetOrigin `json:"origin"`
+	// Associated style declaration.
+	Style CSSCSSStyle `json:"style"`
+}
+
+// CSSCSSPositionTryRule CSS @position-try rule representation.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CSS#type-CSSPositionTryRule
+type CSSCSSPositionTryRule struct {
+	// The prelude dashed-ident name
+	Name CSSValue `json:"name"`
+	// The css style sheet identifier (absent for user agent stylesheet and
+	// user-specified stylesheet rules) this rule came from.
+	StyleSheetID CSSStyleSheetID `json:"styleSheetId,omitempty"`
+	// Parent stylesheet's origin.
+	Origin CSSStyleSheetOrigin `json:"origin"`
+	// Associated style declaration.
+	Style  CSSCSSStyle `json:"style"`
+	Active bool        `json:"active"`
+}
+
+// CSSCSSKeyframesRule CSS keyframes rule representation.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CSS#type-CSSKeyframesRule
+type CSSCSSKeyframesRule struct {
+	// Animation name.
+	AnimationName CSSValue `json:"animationName"`
+	// List of keyframes.
+	Keyframes []CSSCSSKeyframeRule `json:"keyframes"`
+}
+
+// CSSCSSPropertyRegistration representation of a custom property registration
+// through CSS.registerProperty
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CSS#type-CSSPropertyRegistration
+type CSSCSSPropertyRegistration struct {
+	PropertyName string    `json:"propertyName"`
+	InitialValue *CSSValue `json:"initialValue,omitempty"`
+	Inherits     bool      `json:"inherits"`
+	Syntax       string    `json:"syntax"`
+}
+
+// CSSCSSFontPaletteValuesRule CSS font-palette-values rule representation.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CSS#type-CSSFontPaletteValuesRule
+type CSSCSSFontPaletteValuesRule struct {
+	// The css style sheet identifier (absent for user agent stylesheet and
+	// user-specified stylesheet rules) this rule came from.
+	StyleSheetID CSSStyleSheetID `json:"styleSheetId,omitempty"`
+	// Parent stylesheet's origin.
+	Origin CSSStyleSheetOrigin `json:"origin"`
+	// Associated font palette name.
+	FontPaletteName CSSValue `json:"fontPaletteName"`
+	// Associated style declaration.
+	Style CSSCSSStyle `json:"style"`
+}
+
+// CSSCSSPropertyRule CSS property at-rule representation.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CSS#type-CSSPropertyRule
+type CSSCSSPropertyRule struct {
+	// The css style sheet identifier (absent for user agent stylesheet and
+	// user-specified stylesheet rules) this rule came from.
+	StyleSheetID CSSStyleSheetID `json:"styleSheetId,omitempty"`
+	// Parent stylesheet's origin.
+	Origin CSSStyleSheetOrigin `json:"origin"`
+	// Associated property name.
+	PropertyName CSSValue `json:"propertyName"`
+	// Associated style declaration.
+	Style CSSCSSStyle `json:"style"`
+}
+
+// CSSCSSFunctionParameter CSS function argument representation.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CSS#type-CSSFunctionParameter
+type CSSCSSFunctionParameter struct {
+	// The parameter name.
+	Name string `json:"name"`
+	// The parameter type.
+	Type string `json:"type"`
+}
+
+// CSSCSSFunctionConditionNode CSS function conditional block representation.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CSS#type-CSSFunctionConditionNode
+type CSSCSSFunctionConditionNode struct {
+	// Media query for this conditional block. Only one type of condition should be
+	// set.
+	Media *CSSCSSMedia `json:"media,omitempty"`
+	// Container query for this conditional block. Only one type of condition
+	// should be set.
+	ContainerQueries *CSSCSSContainerQuery `json:"containerQueries,omitempty"`
+	// @supports CSS at-rule condition. Only one type of condition should be set.
+	Supports *CSSCSSSupports `json:"supports,omitempty"`
+	// Block body.
+	Children []CSSCSSFunctionNode `json:"children"`
+	// The condition text.
+	ConditionText string `json:"conditionText"`
+}
+
+// CSSCSSFunctionNode section of the body of a CSS function rule.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CSS#type-CSSFunctionNode
+type CSSCSSFunctionNode struct {
+	// A conditional block. If set, style should not be set.
+	Condition *CSSCSSFunctionConditionNode `json:"condition,omitempty"`
+	// Values set by this node. If set, condition should not be set.
+	Style *CSSCSSStyle `json:"style,omitempty"`
+}
+
+// CSSCSSFunctionRule CSS function at-rule representation.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CSS#type-CSSFunctionRule
+type CSSCSSFunctionRule struct {
+	// Name of the function.
+	Name CSSValue `json:"name"`
+	// The css style sheet identifier (absent for user agent stylesheet and
+	// user-specified stylesheet rules) this rule came from.
+	StyleSheetID CSSStyleSheetID `json:"styleSheetId,omitempty"`
+	// Parent stylesheet's origin.
+	Origin CSSStyleSheetOrigin `json:"origin"`
+	// List of parameters.
+	Parameters []CSSCSSFunctionParameter `json:"parameters"`
+	// Function body.
+	Children []CSSCSSFunctionNode `json:"children"`
+}
+
+// CSSCSSKeyframeRule CSS keyframe rule representation.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CSS#type-CSSKeyframeRule
+type CSSCSSKeyframeRule struct {
+	// The css style sheet identifier (absent for user agent stylesheet and
+	// user-specified stylesheet rules) this rule came from.
+	StyleSheetID CSSStyleSheetID `json:"styleSheetId,omitempty"`
+	// Parent stylesheet's origin.
+	Origin CSSStyleSheetOrigin `json:"origin"`
+	// Associated key text.
+	KeyText CSSValue `json:"keyText"`
+	// Associated style declaration.
+	Style CSSCSSStyle `json:"style"`
+}
+
+// CSSStyleDeclarationEdit a descriptor of operation to mutate style declaration
+// text.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/CSS#type-StyleDeclarationEdit
+type CSSStyleDeclarationEdit struct {
+	// The css style sheet identifier.
+	StyleSheetID CSSStyleSheetID `json:"styleSheetId"`
+	// The range of the style text in the enclosing stylesheet.
+	Range CSSSourceRange `json:"range"`
+	// New style text.
+	Text string `json:"text"`
+}
@@ -0,0 +1,170 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package types
+
+// DebuggerBreakpointID breakpoint identifier.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Debugger#type-BreakpointId
+type DebuggerBreakpointID string
+
+// DebuggerCallFrameID call frame identifier.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Debugger#type-CallFrameId
+type DebuggerCallFrameID string
+
+// DebuggerLocation location in the source code.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Debugger#type-Location
+type DebuggerLocation struct {
+	// Script identifier as reported in the `Debugger.scriptParsed`.
+	ScriptID RuntimeScriptID `json:"scriptId"`
+	// Line number in the script (0-based).
+	LineNumber int64 `json:"lineNumber"`
+	// Column number in the script (0-based).
+	ColumnNumber int64 `json:"columnNumber,omitempty"`
+}
+
+// DebuggerScriptPosition location in the source code.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Debugger#type-ScriptPosition
+//
+// This is an experimental part of the protocol.
+type DebuggerScriptPosition struct {
+	LineNumber   int64 `json:"lineNumber"`
+	ColumnNumber int64 `json:"columnNumber"`
+}
+
+// DebuggerLocationRange location range within one script.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Debugger#type-LocationRange
+//
+// This is an experimental part of the protocol.
+type DebuggerLocationRange struct {
+	ScriptID RuntimeScriptID        `json:"scriptId"`
+	Start    DebuggerScriptPosition `json:"start"`
+	End      DebuggerScriptPosition `json:"end"`
+}
+
+// DebuggerCallFrame javaScript call frame. Array of call frames form the call
+// stack.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Debugger#type-CallFrame
+type DebuggerCallFrame struct {
+	// Call frame identifier. This identifier is only valid while the virtual
+	// machine is paused.
+	CallFrameID DebuggerCallFrameID `json:"callFrameId"`
+	// Name of the JavaScript function called on this call frame.
+	FunctionName string `json:"functionName"`
+	// Location in the source code.
+	FunctionLocation *DebuggerLocation `json:"functionLocation,omitempty"`
+	// Location in the source code.
+	Location DebuggerLocation `json:"location"`
+	// JavaScript script name or url. Deprecated in favor of using the
+	// `location.scriptId` to resolve the URL via a previously sent
+	// `Debugger.scriptParsed` event.
+	//
+	// Deprecated: this is deprecated in the protocol.
+	URL string `json:"url"`
+	// Scope chain for this call frame.
+	ScopeChain []DebuggerScope `json:"scopeChain"`
+	// `this` object for this call frame.
+	This RuntimeRemoteObject `json:"this"`
+	// The value being returned, if the function is at return point.
+	ReturnValue *RuntimeRemoteObject `json:"returnValue,omitempty"`
+	// Valid only while the VM is paused and indicates whether this frame can be
+	// restarted or not. Note that a `true` value here does not guarantee that
+	// Debugger#restartFrame with this CallFrameId will be successful, but it is
+	// very likely.
+	//
+	// This is an experimental part of the protocol.
+	CanBeRestarted bool `json:"canBeRestarted,omitempty"`
+}
+
+// DebuggerScope scope description.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Debugger#type-Scope
+type DebuggerScope struct {
+	// Scope type.
+	//
+	// Allowed values: global, local, with, closure, catch, block, script, eval,
+	// module, wasm-expression-stack.
+	Type string `json:"type"`
+	// Object representing the scope. For `global` and `with` scopes it represents
+	// the actual object; for the rest of the scopes, it is artificial transient
+	// object enumerating scope variables as its properties.
+	Object RuntimeRemoteObject `json:"object"`
+	Name   string              `json:"name,omitempty"`
+	// Location in the source code where scope starts
+	StartLocation *DebuggerLocation `json:"startLocation,omitempty"`
+	// Location in the source code where scope ends
+	EndLocation *DebuggerLocation `json:"endLocation,omitempty"`
+}
+
+// DebuggerSearchMatch search match for resource.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Debugger#type-SearchMatch
+type DebuggerSearchMatch struct {
+	// Line number in resource content.
+	LineNumber float64 `json:"lineNumber"`
+	// Line with match content.
+	LineContent string `json:"lineContent"`
+}
+
+// DebuggerBreakLocation is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Debugger#type-BreakLocation
+type DebuggerBreakLocation struct {
+	// Script identifier as reported in the `Debugger.scriptParsed`.
+	ScriptID RuntimeScriptID `json:"scriptId"`
+	// Line number in the script (0-based).
+	LineNumber int64 `json:"lineNumber"`
+	// Column number in the script (0-based).
+	ColumnNumber int64 `json:"columnNumber,omitempty"`
+	// Allowed values: debuggerStatement, call, return.
+	Type string `json:"type,omitempty"`
+}
+
+// DebuggerWasmDisassemblyChunk is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Debugger#type-WasmDisassemblyChunk
+//
+// This is an experimental part of the protocol.
+type DebuggerWasmDisassemblyChunk struct {
+	// The next chunk of disassembled lines.
+	Lines []string `json:"lines"`
+	// The bytecode offsets describing the start of each line.
+	BytecodeOffsets []int64 `json:"bytecodeOffsets"`
+}
+
+// DebuggerScriptLanguage enum of possible script languages.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Debugger#type-ScriptLanguage
+type DebuggerScriptLanguage string
+
+// Values of DebuggerScriptLanguage.
+const (
+	DebuggerScriptLanguageJavaScript  DebuggerScriptLanguage = "JavaScript"
+	DebuggerScriptLanguageWebAssembly DebuggerScriptLanguage = "WebAssembly"
+)
+
+// DebuggerDebugSymbols debug symbols available for a wasm script.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Debugger#type-DebugSymbols
+type DebuggerDebugSymbols struct {
+	// Type of the debug symbols.
+	//
+	// Allowed values: SourceMap, EmbeddedDWARF, ExternalDWARF.
+	Type string `json:"type"`
+	// URL of the external symbol source.
+	ExternalURL string `json:"externalURL,omitempty"`
+}
+
+// DebuggerResolvedBreakpoint is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Debugger#type-ResolvedBreakpoint
+type DebuggerResolvedBreakpoint struct {
+	// Breakpoint unique identifier.
+	BreakpointID DebuggerBreakpointID `json:"breakpointId"`
+	// Actual breakpoint location.
+	Location DebuggerLocation `json:"location"`
+}
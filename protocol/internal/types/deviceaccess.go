@@ -0,0 +1,23 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package types
+
+// DeviceAccessRequestID device request id.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DeviceAccess#type-RequestId
+type DeviceAccessRequestID string
+
+// DeviceAccessDeviceID a device id.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DeviceAccess#type-DeviceId
+type DeviceAccessDeviceID string
+
+// DeviceAccessPromptDevice device information displayed in a user prompt to
+// select a device.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DeviceAccess#type-PromptDevice
+type DeviceAccessPromptDevice struct {
+	ID DeviceAccessDeviceID `json:"id"`
+	// Display name as it appears in a device request user prompt.
+	Name string `json:"name"`
+}
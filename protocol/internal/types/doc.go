@@ -0,0 +1,6 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+// Package types declares the types of every protocol domain in a single package
+// so that domains may refer to each other's types. Each domain package
+// re-exports its own types as aliases, which is how they should be used.
+package types
@@ -0,0 +1,298 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package types
+
+import (
+	"encoding/json"
+)
+
+// DOMNodeID unique DOM node identifier.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOM#type-NodeId
+type DOMNodeID int64
+
+// DOMBackendNodeID unique DOM node identifier used to reference a node that may
+// not have been pushed to the front-end.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOM#type-BackendNodeId
+type DOMBackendNodeID int64
+
+// DOMBackendNode backend node with a friendly name.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOM#type-BackendNode
+type DOMBackendNode struct {
+	// `Node`'s nodeType.
+	NodeType int64 `json:"nodeType"`
+	// `Node`'s nodeName.
+	NodeName      string           `json:"nodeName"`
+	BackendNodeID DOMBackendNodeID `json:"backendNodeId"`
+}
+
+// DOMPseudoType pseudo element type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOM#type-PseudoType
+type DOMPseudoType string
+
+// Values of DOMPseudoType.
+const (
+	DOMPseudoTypeFirstLine                   DOMPseudoType = "first-line"
+	DOMPseudoTypeFirstLetter                 DOMPseudoType = "first-letter"
+	DOMPseudoTypeCheckmark                   DOMPseudoType = "checkmark"
+	DOMPseudoTypeBefore                      DOMPseudoType = "before"
+	DOMPseudoTypeAfter                       DOMPseudoType = "after"
+	DOMPseudoTypePickerIcon                  DOMPseudoType = "picker-icon"
+	DOMPseudoTypeMarker                      DOMPseudoType = "marker"
+	DOMPseudoTypeBackdrop                    DOMPseudoType = "backdrop"
+	DOMPseudoTypeColumn                      DOMPseudoType = "column"
+	DOMPseudoTypeSelection                   DOMPseudoType = "selection"
+	DOMPseudoTypeSearchText                  DOMPseudoType = "search-text"
+	DOMPseudoTypeTargetText                  DOMPseudoType = "target-text"
+	DOMPseudoTypeSpellingError               DOMPseudoType = "spelling-error"
+	DOMPseudoTypeGrammarError                DOMPseudoType = "grammar-error"
+	DOMPseudoTypeHighlight                   DOMPseudoType = "highlight"
+	DOMPseudoTypeFirstLineInherited          DOMPseudoType = "first-line-inherited"
+	DOMPseudoTypeScrollMarker                DOMPseudoType = "scroll-marker"
+	DOMPseudoTypeScrollMarkerGroup           DOMPseudoType = "scroll-marker-group"
+	DOMPseudoTypeScrollButton                DOMPseudoType = "scroll-button"
+	DOMPseudoTypeScrollbar                   DOMPseudoType = "scrollbar"
+	DOMPseudoTypeScrollbarThumb              DOMPseudoType = "scrollbar-thumb"
+	DOMPseudoTypeScrollbarButton             DOMPseudoType = "scrollbar-button"
+	DOMPseudoTypeScrollbarTrack              DOMPseudoType = "scrollbar-track"
+	DOMPseudoTypeScrollbarTrackPiece         DOMPseudoType = "scrollbar-track-piece"
+	DOMPseudoTypeScrollbarCorner             DOMPseudoType = "scrollbar-corner"
+	DOMPseudoTypeResizer                     DOMPseudoType = "resizer"
+	DOMPseudoTypeInputListButton             DOMPseudoType = "input-list-button"
+	DOMPseudoTypeViewTransition              DOMPseudoType = "view-transition"
+	DOMPseudoTypeViewTransitionGroup         DOMPseudoType = "view-transition-group"
+	DOMPseudoTypeViewTransitionImagePair     DOMPseudoType = "view-transition-image-pair"
+	DOMPseudoTypeViewTransitionGroupChildren DOMPseudoType = "view-transition-group-children"
+	DOMPseudoTypeViewTransitionOld           DOMPseudoType = "view-transition-old"
+	DOMPseudoTypeViewTransitionNew           DOMPseudoType = "view-transition-new"
+	DOMPseudoTypePlaceholder                 DOMPseudoType = "placeholder"
+	DOMPseudoTypeFileSelectorButton          DOMPseudoType = "file-selector-button"
+	DOMPseudoTypeDetailsContent              DOMPseudoType = "details-content"
+	DOMPseudoTypePicker                      DOMPseudoType = "picker"
+	DOMPseudoTypePermissionIcon              DOMPseudoType = "permission-icon"
+)
+
+// DOMShadowRootType shadow root type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOM#type-ShadowRootType
+type DOMShadowRootType string
+
+// Values of DOMShadowRootType.
+const (
+	DOMShadowRootTypeUserAgent DOMShadowRootType = "user-agent"
+	DOMShadowRootTypeOpen      DOMShadowRootType = "open"
+	DOMShadowRootTypeClosed    DOMShadowRootType = "closed"
+)
+
+// DOMCompatibilityMode document compatibility mode.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOM#type-CompatibilityMode
+type DOMCompatibilityMode string
+
+// Values of DOMCompatibilityMode.
+const (
+	DOMCompatibilityModeQuirksMode        DOMCompatibilityMode = "QuirksMode"
+	DOMCompatibilityModeLimitedQuirksMode DOMCompatibilityMode = "LimitedQuirksMode"
+	DOMCompatibilityModeNoQuirksMode      DOMCompatibilityMode = "NoQuirksMode"
+)
+
+// DOMPhysicalAxes containerSelector physical axes
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOM#type-PhysicalAxes
+type DOMPhysicalAxes string
+
+// Values of DOMPhysicalAxes.
+const (
+	DOMPhysicalAxesHorizontal DOMPhysicalAxes = "Horizontal"
+	DOMPhysicalAxesVertical   DOMPhysicalAxes = "Vertical"
+	DOMPhysicalAxesBoth       DOMPhysicalAxes = "Both"
+)
+
+// DOMLogicalAxes containerSelector logical axes
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOM#type-LogicalAxes
+type DOMLogicalAxes string
+
+// Values of DOMLogicalAxes.
+const (
+	DOMLogicalAxesInline DOMLogicalAxes = "Inline"
+	DOMLogicalAxesBlock  DOMLogicalAxes = "Block"
+	DOMLogicalAxesBoth   DOMLogicalAxes = "Both"
+)
+
+// DOMScrollOrientation physical scroll orientation
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOM#type-ScrollOrientation
+type DOMScrollOrientation string
+
+// Values of DOMScrollOrientation.
+const (
+	DOMScrollOrientationHorizontal DOMScrollOrientation = "horizontal"
+	DOMScrollOrientationVertical   DOMScrollOrientation = "vertical"
+)
+
+// DOMNode DOM interaction is implemented in terms of mirror objects that
+// represent the actual DOM nodes. DOMNode is a base node mirror type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOM#type-Node
+type DOMNode struct {
+	// Node identifier that is passed into the rest of the DOM messages as the
+	// `nodeId`. Backend will only push node with given `id` once. It is aware of
+	// all requested nodes and will only fire DOM events for nodes known to the
+	// client.
+	NodeID DOMNodeID `json:"nodeId"`
+	// The id of the parent node if any.
+	ParentID DOMNodeID `json:"parentId,omitempty"`
+	// The BackendNodeId for this node.
+	BackendNodeID DOMBackendNodeID `json:"backendNodeId"`
+	// `Node`'s nodeType.
+	NodeType int64 `json:"nodeType"`
+	// `Node`'s nodeName.
+	NodeName string `json:"nodeName"`
+	// `Node`'s localName.
+	LocalName string `json:"localName"`
+	// `Node`'s nodeValue.
+	NodeValue string `json:"nodeValue"`
+	// Child count for `Container` nodes.
+	ChildNodeCount int64 `json:"childNodeCount,omitempty"`
+	// Child nodes of this node when requested with children.
+	Children []DOMNode `json:"children,omitempty"`
+	// Attributes of the `Element` node in the form of flat array `[name1, value1,
+	// name2, value2]`.
+	Attributes []string `json:"attributes,omitempty"`
+	// Document URL that `Document` or `FrameOwner` node points to.
+	DocumentURL string `json:"documentURL,omitempty"`
+	// Base URL that `Document` or `FrameOwner` node uses for URL completion.
+	BaseURL string `json:"baseURL,omitempty"`
+	// `DocumentType`'s publicId.
+	PublicID string `json:"publicId,omitempty"`
+	// `DocumentType`'s systemId.
+	SystemID string `json:"systemId,omitempty"`
+	// `DocumentType`'s internalSubset.
+	InternalSubset string `json:"internalSubset,omitempty"`
+	// `Document`'s XML version in case of XML documents.
+	XMLVersion string `json:"xmlVersion,omitempty"`
+	// `Attr`'s name.
+	Name string `json:"name,omitempty"`
+	// `Attr`'s value.
+	Value string `json:"value,omitempty"`
+	// Pseudo element type for this node.
+	PseudoType DOMPseudoType `json:"pseudoType,omitempty"`
+	// Pseudo element identifier for this node. Only present if there is a valid
+	// pseudoType.
+	PseudoIdentifier string `json:"pseudoIdentifier,omitempty"`
+	// Shadow root type.
+	ShadowRootType DOMShadowRootType `json:"shadowRootType,omitempty"`
+	// Frame ID for frame owner elements.
+	FrameID PageFrameID `json:"frameId,omitempty"`
+	// Content document for frame owner elements.
+	ContentDocument *DOMNode `json:"contentDocument,omitempty"`
+	// Shadow root list for given element host.
+	ShadowRoots []DOMNode `json:"shadowRoots,omitempty"`
+	// Content document fragment for template elements.
+	TemplateContent *DOMNode `json:"templateContent,omitempty"`
+	// Pseudo elements associated with this node.
+	PseudoElements []DOMNode `json:"pseudoElements,omitempty"`
+	// Deprecated, as the HTML Imports API has been removed (crbug.com/937746).
+	// This property used to return the imported document for the HTMLImport links.
+	// The property is always undefined now.
+	//
+	// Deprecated: this is deprecated in the protocol.
+	ImportedDocument *DOMNode `json:"importedDocument,omitempty"`
+	// Distributed nodes for given insertion point.
+	DistributedNodes []DOMBackendNode `json:"distributedNodes,omitempty"`
+	// Whether the node is SVG.
+	IsSVG             bool                 `json:"isSVG,omitempty"`
+	CompatibilityMode DOMCompatibilityMode `json:"compatibilityMode,omitempty"`
+	AssignedSlot      *DOMBackendNode      `json:"assignedSlot,omitempty"`
+	// This is an experimental part of the protocol.
+	IsScrollable bool `json:"isScrollable,omitempty"`
+}
+
+// DOMDetachedElementInfo a structure to hold the top-level node of a detached
+// tree and an array of its retained descendants.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOM#type-DetachedElementInfo
+type DOMDetachedElementInfo struct {
+	TreeNode        DOMNode     `json:"treeNode"`
+	RetainedNodeIds []DOMNodeID `json:"retainedNodeIds"`
+}
+
+// DOMRGBA a structure holding an RGBA color.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOM#type-RGBA
+type DOMRGBA struct {
+	// The red component, in the [0-255] range.
+	R int64 `json:"r"`
+	// The green component, in the [0-255] range.
+	G int64 `json:"g"`
+	// The blue component, in the [0-255] range.
+	B int64 `json:"b"`
+	// The alpha component, in the [0-1] range (default: 1).
+	A float64 `json:"a,omitempty"`
+}
+
+// DOMQuad an array of quad vertices, x immediately followed by y for each
+// point, points clock-wise.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOM#type-Quad
+type DOMQuad []float64
+
+// DOMBoxModel box model.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOM#type-BoxModel
+type DOMBoxModel struct {
+	// Content box
+	Content DOMQuad `json:"content"`
+	// Padding box
+	Padding DOMQuad `json:"padding"`
+	// Border box
+	Border DOMQuad `json:"border"`
+	// Margin box
+	Margin DOMQuad `json:"margin"`
+	// Node width
+	Width int64 `json:"width"`
+	// Node height
+	Height int64 `json:"height"`
+	// Shape outside coordinates
+	ShapeOutside *DOMShapeOutsideInfo `json:"shapeOutside,omitempty"`
+}
+
+// DOMShapeOutsideInfo CSS Shape Outside details.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOM#type-ShapeOutsideInfo
+type DOMShapeOutsideInfo struct {
+	// Shape bounds
+	Bounds DOMQuad `json:"bounds"`
+	// Shape coordinate details
+	Shape []json.RawMessage `json:"shape"`
+	// Margin shape bounds
+	MarginShape []json.RawMessage `json:"marginShape"`
+}
+
+// DOMRect rectangle.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOM#type-Rect
+type DOMRect struct {
+	// X coordinate
+	X float64 `json:"x"`
+	// Y coordinate
+	Y float64 `json:"y"`
+	// Rectangle width
+	Width float64 `json:"width"`
+	// Rectangle height
+	Height float64 `json:"height"`
+}
+
+// DOMCSSComputedStyleProperty is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOM#type-CSSComputedStyleProperty
+type DOMCSSComputedStyleProperty struct {
+	// Computed style property name.
+	Name string `json:"name"`
+	// Computed style property value.
+	Value string `json:"value"`
+}
@@ -0,0 +1,54 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package types
+
+// DOMDebuggerDOMBreakpointType DOM breakpoint type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOMDebugger#type-DOMBreakpointType
+type DOMDebuggerDOMBreakpointType string
+
+// Values of DOMDebuggerDOMBreakpointType.
+const (
+	DOMDebuggerDOMBreakpointTypeSubtreeModified   DOMDebuggerDOMBreakpointType = "subtree-modified"
+	DOMDebuggerDOMBreakpointTypeAttributeModified DOMDebuggerDOMBreakpointType = "attribute-modified"
+	DOMDebuggerDOMBreakpointTypeNodeRemoved       DOMDebuggerDOMBreakpointType = "node-removed"
+)
+
+// DOMDebuggerCSPViolationType CSP Violation type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOMDebugger#type-CSPViolationType
+//
+// This is an experimental part of the protocol.
+type DOMDebuggerCSPViolationType string
+
+// Values of DOMDebuggerCSPViolationType.
+const (
+	DOMDebuggerCSPViolationTypeTrustedtypeSinkViolation   DOMDebuggerCSPViolationType = "trustedtype-sink-violation"
+	DOMDebuggerCSPViolationTypeTrustedtypePolicyViolation DOMDebuggerCSPViolationType = "trustedtype-policy-violation"
+)
+
+// DOMDebuggerEventListener object event listener.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOMDebugger#type-EventListener
+type DOMDebuggerEventListener struct {
+	// `EventListener`'s type.
+	Type string `json:"type"`
+	// `EventListener`'s useCapture.
+	UseCapture bool `json:"useCapture"`
+	// `EventListener`'s passive flag.
+	Passive bool `json:"passive"`
+	// `EventListener`'s once flag.
+	Once bool `json:"once"`
+	// Script id of the handler code.
+	ScriptID RuntimeScriptID `json:"scriptId"`
+	// Line number in the script (0-based).
+	LineNumber int64 `json:"lineNumber"`
+	// Column number in the script (0-based).
+	ColumnNumber int64 `json:"columnNumber"`
+	// Event handler function value.
+	Handler *RuntimeRemoteObject `json:"handler,omitempty"`
+	// Event original handler function value.
+	OriginalHandler *RuntimeRemoteObject `json:"originalHandler,omitempty"`
+	// Node the listener is added to (if any).
+	BackendNodeID DOMBackendNodeID `json:"backendNodeId,omitempty"`
+}
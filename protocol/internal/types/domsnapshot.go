@@ -0,0 +1,309 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package types
+
+// DOMSnapshotDOMNode a Node in the DOM tree.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOMSnapshot#type-DOMNode
+type DOMSnapshotDOMNode struct {
+	// `Node`'s nodeType.
+	NodeType int64 `json:"nodeType"`
+	// `Node`'s nodeName.
+	NodeName string `json:"nodeName"`
+	// `Node`'s nodeValue.
+	NodeValue string `json:"nodeValue"`
+	// Only set for textarea elements, contains the text value.
+	TextValue string `json:"textValue,omitempty"`
+	// Only set for input elements, contains the input's associated text value.
+	InputValue string `json:"inputValue,omitempty"`
+	// Only set for radio and checkbox input elements, indicates if the element has
+	// been checked
+	InputChecked bool `json:"inputChecked,omitempty"`
+	// Only set for option elements, indicates if the element has been selected
+	OptionSelected bool `json:"optionSelected,omitempty"`
+	// `Node`'s id, corresponds to DOM.Node.backendNodeId.
+	BackendNodeID DOMBackendNodeID `json:"backendNodeId"`
+	// The indexes of the node's child nodes in the `domNodes` array returned by
+	// `getSnapshot`, if any.
+	ChildNodeIndexes []int64 `json:"childNodeIndexes,omitempty"`
+	// Attributes of an `Element` node.
+	Attributes []DOMSnapshotNameValue `json:"attributes,omitempty"`
+	// Indexes of pseudo elements associated with this node in the `domNodes` array
+	// returned by `getSnapshot`, if any.
+	PseudoElementIndexes []int64 `json:"pseudoElementIndexes,omitempty"`
+	// The index of the node's related layout tree node in the `layoutTreeNodes`
+	// array returned by `getSnapshot`, if any.
+	LayoutNodeIndex int64 `json:"layoutNodeIndex,omitempty"`
+	// Document URL that `Document` or `FrameOwner` node points to.
+	DocumentURL string `json:"documentURL,omitempty"`
+	// Base URL that `Document` or `FrameOwner` node uses for URL completion.
+	BaseURL string `json:"baseURL,omitempty"`
+	// Only set for documents, contains the document's content language.
+	ContentLanguage string `json:"contentLanguage,omitempty"`
+	// Only set for documents, contains the document's character set encoding.
+	DocumentEncoding string `json:"documentEncoding,omitempty"`
+	// `DocumentType` node's publicId.
+	PublicID string `json:"publicId,omitempty"`
+	// `DocumentType` node's systemId.
+	SystemID string `json:"systemId,omitempty"`
+	// Frame ID for frame owner elements and also for the document node.
+	FrameID PageFrameID `json:"frameId,omitempty"`
+	// The index of a frame owner element's content document in the `domNodes`
+	// array returned by `getSnapshot`, if any.
+	ContentDocumentIndex int64 `json:"contentDocumentIndex,omitempty"`
+	// Type of a pseudo element node.
+	PseudoType DOMPseudoType `json:"pseudoType,omitempty"`
+	// Shadow root type.
+	ShadowRootType DOMShadowRootType `json:"shadowRootType,omitempty"`
+	// Whether this DOM node responds to mouse clicks. This includes nodes that
+	// have had click event listeners attached via JavaScript as well as anchor
+	// tags that naturally navigate when clicked.
+	IsClickable bool `json:"isClickable,omitempty"`
+	// Details of the node's event listeners, if any.
+	EventListeners []DOMDebuggerEventListener `json:"eventListeners,omitempty"`
+	// The selected url for nodes with a srcset attribute.
+	CurrentSourceURL string `json:"currentSourceURL,omitempty"`
+	// The url of the script (if any) that generates this node.
+	OriginURL string `json:"originURL,omitempty"`
+	// Scroll offsets, set when this node is a Document.
+	ScrollOffsetX float64 `json:"scrollOffsetX,omitempty"`
+	ScrollOffsetY float64 `json:"scrollOffsetY,omitempty"`
+}
+
+// DOMSnapshotInlineTextBox details of post layout rendered text positions. The
+// exact layout should not be regarded as stable and may change between
+// versions.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOMSnapshot#type-InlineTextBox
+type DOMSnapshotInlineTextBox struct {
+	// The bounding box in document coordinates. Note that scroll offset of the
+	// document is ignored.
+	BoundingBox DOMRect `json:"boundingBox"`
+	// The starting index in characters, for this post layout textbox substring.
+	// Characters that would be represented as a surrogate pair in UTF-16 have
+	// length 2.
+	StartCharacterIndex int64 `json:"startCharacterIndex"`
+	// The number of characters in this post layout textbox substring. Characters
+	// that would be represented as a surrogate pair in UTF-16 have length 2.
+	NumCharacters int64 `json:"numCharacters"`
+}
+
+// DOMSnapshotLayoutTreeNode details of an element in the DOM tree with a
+// LayoutObject.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOMSnapshot#type-LayoutTreeNode
+type DOMSnapshotLayoutTreeNode struct {
+	// The index of the related DOM node in the `domNodes` array returned by
+	// `getSnapshot`.
+	DOMNodeIndex int64 `json:"domNodeIndex"`
+	// The bounding box in document coordinates. Note that scroll offset of the
+	// document is ignored.
+	BoundingBox DOMRect `json:"boundingBox"`
+	// Contents of the LayoutText, if any.
+	LayoutText string `json:"layoutText,omitempty"`
+	// The post-layout inline text nodes, if any.
+	InlineTextNodes []DOMSnapshotInlineTextBox `json:"inlineTextNodes,omitempty"`
+	// Index into the `computedStyles` array returned by `getSnapshot`.
+	StyleIndex int64 `json:"styleIndex,omitempty"`
+	// Global paint order index, which is determined by the stacking order of the
+	// nodes. Nodes that are painted together will have the same index. Only
+	// provided if includePaintOrder in getSnapshot was true.
+	PaintOrder int64 `json:"paintOrder,omitempty"`
+	// Set to true to indicate the element begins a new stacking context.
+	IsStackingContext bool `json:"isStackingContext,omitempty"`
+}
+
+// DOMSnapshotComputedStyle a subset of the full ComputedStyle as defined by the
+// request whitelist.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOMSnapshot#type-ComputedStyle
+type DOMSnapshotComputedStyle struct {
+	// Name/value pairs of computed style properties.
+	Properties []DOMSnapshotNameValue `json:"properties"`
+}
+
+// DOMSnapshotNameValue a name/value pair.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOMSnapshot#type-NameValue
+type DOMSnapshotNameValue struct {
+	// Attribute/property name.
+	Name string `json:"name"`
+	// Attribute/property value.
+	Value string `json:"value"`
+}
+
+// DOMSnapshotStringIndex index of the string in the strings table.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOMSnapshot#type-StringIndex
+type DOMSnapshotStringIndex int64
+
+// DOMSnapshotArrayOfStrings index of the string in the strings table.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOMSnapshot#type-ArrayOfStrings
+type DOMSnapshotArrayOfStrings []DOMSnapshotStringIndex
+
+// DOMSnapshotRareStringData data that is only present on rare nodes.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOMSnapshot#type-RareStringData
+type DOMSnapshotRareStringData struct {
+	Index []int64                  `json:"index"`
+	Value []DOMSnapshotStringIndex `json:"value"`
+}
+
+// DOMSnapshotRareBooleanData is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOMSnapshot#type-RareBooleanData
+type DOMSnapshotRareBooleanData struct {
+	Index []int64 `json:"index"`
+}
+
+// DOMSnapshotRareIntegerData is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOMSnapshot#type-RareIntegerData
+type DOMSnapshotRareIntegerData struct {
+	Index []int64 `json:"index"`
+	Value []int64 `json:"value"`
+}
+
+// DOMSnapshotRectangle is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOMSnapshot#type-Rectangle
+type DOMSnapshotRectangle []float64
+
+// DOMSnapshotDocumentSnapshot document snapshot.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOMSnapshot#type-DocumentSnapshot
+type DOMSnapshotDocumentSnapshot struct {
+	// Document URL that `Document` or `FrameOwner` node points to.
+	DocumentURL DOMSnapshotStringIndex `json:"documentURL"`
+	// Document title.
+	Title DOMSnapshotStringIndex `json:"title"`
+	// Base URL that `Document` or `FrameOwner` node uses for URL completion.
+	BaseURL DOMSnapshotStringIndex `json:"baseURL"`
+	// Contains the document's content language.
+	ContentLanguage DOMSnapshotStringIndex `json:"contentLanguage"`
+	// Contains the document's character set encoding.
+	EncodingName DOMSnapshotStringIndex `json:"encodingName"`
+	// `DocumentType` node's publicId.
+	PublicID DOMSnapshotStringIndex `json:"publicId"`
+	// `DocumentType` node's systemId.
+	SystemID DOMSnapshotStringIndex `json:"systemId"`
+	// Frame ID for frame owner elements and also for the document node.
+	FrameID DOMSnapshotStringIndex `json:"frameId"`
+	// A table with dom nodes.
+	Nodes DOMSnapshotNodeTreeSnapshot `json:"nodes"`
+	// The nodes in the layout tree.
+	Layout DOMSnapshotLayoutTreeSnapshot `json:"layout"`
+	// The post-layout inline text nodes.
+	TextBoxes DOMSnapshotTextBoxSnapshot `json:"textBoxes"`
+	// Horizontal scroll offset.
+	ScrollOffsetX float64 `json:"scrollOffsetX,omitempty"`
+	// Vertical scroll offset.
+	ScrollOffsetY float64 `json:"scrollOffsetY,omitempty"`
+	// Document content width.
+	ContentWidth float64 `json:"contentWidth,omitempty"`
+	// Document content height.
+	ContentHeight float64 `json:"contentHeight,omitempty"`
+}
+
+// DOMSnapshotNodeTreeSnapshot table containing nodes.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOMSnapshot#type-NodeTreeSnapshot
+type DOMSnapshotNodeTreeSnapshot struct {
+	// Parent node index.
+	ParentIndex []int64 `json:"parentIndex,omitempty"`
+	// `Node`'s nodeType.
+	NodeType []int64 `json:"nodeType,omitempty"`
+	// Type of the shadow root the `Node` is in. String values are equal to the
+	// `ShadowRootType` enum.
+	ShadowRootType *DOMSnapshotRareStringData `json:"shadowRootType,omitempty"`
+	// `Node`'s nodeName.
+	NodeName []DOMSnapshotStringIndex `json:"nodeName,omitempty"`
+	// `Node`'s nodeValue.
+	NodeValue []DOMSnapshotStringIndex `json:"nodeValue,omitempty"`
+	// `Node`'s id, corresponds to DOM.Node.backendNodeId.
+	BackendNodeID []DOMBackendNodeID `json:"backendNodeId,omitempty"`
+	// Attributes of an `Element` node. Flatten name, value pairs.
+	Attributes []DOMSnapshotArrayOfStrings `json:"attributes,omitempty"`
+	// Only set for textarea elements, contains the text value.
+	TextValue *DOMSnapshotRareStringData `json:"textValue,omitempty"`
+	// Only set for input elements, contains the input's associated text value.
+	InputValue *DOMSnapshotRareStringData `json:"inputValue,omitempty"`
+	// Only set for radio and checkbox input elements, indicates if the element has
+	// been checked
+	InputChecked *DOMSnapshotRareBooleanData `json:"inputChecked,omitempty"`
+	// Only set for option elements, indicates if the element has been selected
+	OptionSelected *DOMSnapshotRareBooleanData `json:"optionSelected,omitempty"`
+	// The index of the document in the list of the snapshot documents.
+	ContentDocumentIndex *DOMSnapshotRareIntegerData `json:"contentDocumentIndex,omitempty"`
+	// Type of a pseudo element node.
+	PseudoType *DOMSnapshotRareStringData `json:"pseudoType,omitempty"`
+	// Pseudo element identifier for this node. Only present if there is a valid
+	// pseudoType.
+	PseudoIdentifier *DOMSnapshotRareStringData `json:"pseudoIdentifier,omitempty"`
+	// Whether this DOM node responds to mouse clicks. This includes nodes that
+	// have had click event listeners attached via JavaScript as well as anchor
+	// tags that naturally navigate when clicked.
+	IsClickable *DOMSnapshotRareBooleanData `json:"isClickable,omitempty"`
+	// The selected url for nodes with a srcset attribute.
+	CurrentSourceURL *DOMSnapshotRareStringData `json:"currentSourceURL,omitempty"`
+	// The url of the script (if any) that generates this node.
+	OriginURL *DOMSnapshotRareStringData `json:"originURL,omitempty"`
+}
+
+// DOMSnapshotLayoutTreeSnapshot table of details of an element in the DOM tree
+// with a LayoutObject.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOMSnapshot#type-LayoutTreeSnapshot
+type DOMSnapshotLayoutTreeSnapshot struct {
+	// Index of the corresponding node in the `NodeTreeSnapshot` array returned by
+	// `captureSnapshot`.
+	NodeIndex []int64 `json:"nodeIndex"`
+	// Array of indexes specifying computed style strings, filtered according to
+	// the `computedStyles` parameter passed to `captureSnapshot`.
+	Styles []DOMSnapshotArrayOfStrings `json:"styles"`
+	// The absolute position bounding box.
+	Bounds []DOMSnapshotRectangle `json:"bounds"`
+	// Contents of the LayoutText, if any.
+	Text []DOMSnapshotStringIndex `json:"text"`
+	// Stacking context information.
+	StackingContexts DOMSnapshotRareBooleanData `json:"stackingContexts"`
+	// Global paint order index, which is determined by the stacking order of the
+	// nodes. Nodes that are painted together will have the same index. Only
+	// provided if includePaintOrder in captureSnapshot was true.
+	PaintOrders []int64 `json:"paintOrders,omitempty"`
+	// The offset rect of nodes. Only available when includeDOMRects is set to true
+	OffsetRects []DOMSnapshotRectangle `json:"offsetRects,omitempty"`
+	// The scroll rect of nodes. Only available when includeDOMRects is set to true
+	ScrollRects []DOMSnapshotRectangle `json:"scrollRects,omitempty"`
+	// The client rect of nodes. Only available when includeDOMRects is set to true
+	ClientRects []DOMSnapshotRectangle `json:"clientRects,omitempty"`
+	// The list of background colors that are blended with colors of overlapping
+	// elements.
+	//
+	// This is an experimental part of the protocol.
+	BlendedBackgroundColors []DOMSnapshotStringIndex `json:"blendedBackgroundColors,omitempty"`
+	// The list of computed text opacities.
+	//
+	// This is an experimental part of the protocol.
+	TextColorOpacities []float64 `json:"textColorOpacities,omitempty"`
+}
+
+// DOMSnapshotTextBoxSnapshot table of details of the post layout rendered text
+// positions. The exact layout should not be regarded as stable and may change
+// between versions.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOMSnapshot#type-TextBoxSnapshot
+type DOMSnapshotTextBoxSnapshot struct {
+	// Index of the layout tree node that owns this box collection.
+	LayoutIndex []int64 `json:"layoutIndex"`
+	// The absolute position bounding box.
+	Bounds []DOMSnapshotRectangle `json:"bounds"`
+	// The starting index in characters, for this post layout textbox substring.
+	// Characters that would be represented as a surrogate pair in UTF-16 have
+	// length 2.
+	Start []int64 `json:"start"`
+	// The number of characters in this post layout textbox substring. Characters
+	// that would be represented as a surrogate pair in UTF-16 have length 2.
+	Length []int64 `json:"length"`
+}
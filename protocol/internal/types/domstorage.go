@@ -0,0 +1,25 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package types
+
+// DOMStorageSerializedStorageKey is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOMStorage#type-SerializedStorageKey
+type DOMStorageSerializedStorageKey string
+
+// DOMStorageStorageID DOM Storage identifier.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOMStorage#type-StorageId
+type DOMStorageStorageID struct {
+	// Security origin for the storage.
+	SecurityOrigin string `json:"securityOrigin,omitempty"`
+	// Represents a key by which DOM Storage keys its CachedStorageAreas
+	StorageKey DOMStorageSerializedStorageKey `json:"storageKey,omitempty"`
+	// Whether the storage is local storage (not session storage).
+	IsLocalStorage bool `json:"isLocalStorage"`
+}
+
+// DOMStorageItem DOM Storage item.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/DOMStorage#type-Item
+type DOMStorageItem []string
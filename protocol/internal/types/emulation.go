@@ -0,0 +1,253 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package types
+
+// EmulationSafeAreaInsets is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Emulation#type-SafeAreaInsets
+//
+// This is an experimental part of the protocol.
+type EmulationSafeAreaInsets struct {
+	// Overrides safe-area-inset-top.
+	Top int64 `json:"top,omitempty"`
+	// Overrides safe-area-max-inset-top.
+	TopMax int64 `json:"topMax,omitempty"`
+	// Overrides safe-area-inset-left.
+	Left int64 `json:"left,omitempty"`
+	// Overrides safe-area-max-inset-left.
+	LeftMax int64 `json:"leftMax,omitempty"`
+	// Overrides safe-area-inset-bottom.
+	Bottom int64 `json:"bottom,omitempty"`
+	// Overrides safe-area-max-inset-bottom.
+	BottomMax int64 `json:"bottomMax,omitempty"`
+	// Overrides safe-area-inset-right.
+	Right int64 `json:"right,omitempty"`
+	// Overrides safe-area-max-inset-right.
+	RightMax int64 `json:"rightMax,omitempty"`
+}
+
+// EmulationScreenOrientation screen orientation.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Emulation#type-ScreenOrientation
+type EmulationScreenOrientation struct {
+	// Orientation type.
+	//
+	// Allowed values: portraitPrimary, portraitSecondary, landscapePrimary,
+	// landscapeSecondary.
+	Type string `json:"type"`
+	// Orientation angle.
+	Angle int64 `json:"angle"`
+}
+
+// EmulationDisplayFeature is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Emulation#type-DisplayFeature
+type EmulationDisplayFeature struct {
+	// Orientation of a display feature in relation to screen
+	//
+	// Allowed values: vertical, horizontal.
+	Orientation string `json:"orientation"`
+	// The offset from the screen origin in either the x (for vertical orientation)
+	// or y (for horizontal orientation) direction.
+	Offset int64 `json:"offset"`
+	// A display feature may mask content such that it is not physically displayed
+	// - this length along with the offset describes this area. A display feature
+	// that only splits content will have a 0 mask_length.
+	MaskLength int64 `json:"maskLength"`
+}
+
+// EmulationDevicePosture is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Emulation#type-DevicePosture
+type EmulationDevicePosture struct {
+	// Current posture of the device
+	//
+	// Allowed values: continuous, folded.
+	Type string `json:"type"`
+}
+
+// EmulationMediaFeature is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Emulation#type-MediaFeature
+type EmulationMediaFeature struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// EmulationVirtualTimePolicy advance: If the scheduler runs out of immediate
+// work, the virtual time base may fast forward to allow the next delayed task
+// (if any) to run; pause: The virtual time base may not advance;
+// pauseIfNetworkFetchesPending: The virtual time base may not advance if there
+// are any pending resource fetches.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Emulation#type-VirtualTimePolicy
+//
+// This is an experimental part of the protocol.
+type EmulationVirtualTimePolicy string
+
+// Values of EmulationVirtualTimePolicy.
+const (
+	EmulationVirtualTimePolicyAdvance                      EmulationVirtualTimePolicy = "advance"
+	EmulationVirtualTimePolicyPause                        EmulationVirtualTimePolicy = "pause"
+	EmulationVirtualTimePolicyPauseIfNetworkFetchesPending EmulationVirtualTimePolicy = "pauseIfNetworkFetchesPending"
+)
+
+// EmulationUserAgentBrandVersion used to specify User Agent Client Hints to
+// emulate. See https://wicg.github.io/ua-client-hints
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Emulation#type-UserAgentBrandVersion
+//
+// This is an experimental part of the protocol.
+type EmulationUserAgentBrandVersion struct {
+	Brand   string `json:"brand"`
+	Version string `json:"version"`
+}
+
+// EmulationUserAgentMetadata used to specify User Agent Client Hints to
+// emulate. See https://wicg.github.io/ua-client-hints Missing optional values
+// will be filled in by the target with what it would normally use.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Emulation#type-UserAgentMetadata
+//
+// This is an experimental part of the protocol.
+type EmulationUserAgentMetadata struct {
+	// Brands appearing in Sec-CH-UA.
+	Brands []EmulationUserAgentBrandVersion `json:"brands,omitempty"`
+	// Brands appearing in Sec-CH-UA-Full-Version-List.
+	FullVersionList []EmulationUserAgentBrandVersion `json:"fullVersionList,omitempty"`
+	// Deprecated: this is deprecated in the protocol.
+	FullVersion     string `json:"fullVersion,omitempty"`
+	Platform        string `json:"platform"`
+	PlatformVersion string `json:"platformVersion"`
+	Architecture    string `json:"architecture"`
+	Model           string `json:"model"`
+	Mobile          bool   `json:"mobile"`
+	Bitness         string `json:"bitness,omitempty"`
+	Wow64           bool   `json:"wow64,omitempty"`
+	// Used to specify User Agent form-factor values. See
+	// https://wicg.github.io/ua-client-hints/#sec-ch-ua-form-factors
+	FormFactors []string `json:"formFactors,omitempty"`
+}
+
+// EmulationSensorType used to specify sensor types to emulate. See
+// https://w3c.github.io/sensors/#automation for more information.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Emulation#type-SensorType
+//
+// This is an experimental part of the protocol.
+type EmulationSensorType string
+
+// Values of EmulationSensorType.
+const (
+	EmulationSensorTypeAbsoluteOrientation EmulationSensorType = "absolute-orientation"
+	EmulationSensorTypeAccelerometer       EmulationSensorType = "accelerometer"
+	EmulationSensorTypeAmbientLight        EmulationSensorType = "ambient-light"
+	EmulationSensorTypeGravity             EmulationSensorType = "gravity"
+	EmulationSensorTypeGyroscope           EmulationSensorType = "gyroscope"
+	EmulationSensorTypeLinearAcceleration  EmulationSensorType = "linear-acceleration"
+	EmulationSensorTypeMagnetometer        EmulationSensorType = "magnetometer"
+	EmulationSensorTypeRelativeOrientation EmulationSensorType = "relative-orientation"
+)
+
+// EmulationSensorMetadata is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Emulation#type-SensorMetadata
+//
+// This is an experimental part of the protocol.
+type EmulationSensorMetadata struct {
+	Available        bool    `json:"available,omitempty"`
+	MinimumFrequency float64 `json:"minimumFrequency,omitempty"`
+	MaximumFrequency float64 `json:"maximumFrequency,omitempty"`
+}
+
+// EmulationSensorReadingSingle is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Emulation#type-SensorReadingSingle
+//
+// This is an experimental part of the protocol.
+type EmulationSensorReadingSingle struct {
+	Value float64 `json:"value"`
+}
+
+// EmulationSensorReadingXYZ is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Emulation#type-SensorReadingXYZ
+//
+// This is an experimental part of the protocol.
+type EmulationSensorReadingXYZ struct {
+	X float64 `json:"x"`
+	Y float64 `json:"y"`
+	Z float64 `json:"z"`
+}
+
+// EmulationSensorReadingQuaternion is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Emulation#type-SensorReadingQuaternion
+//
+// This is an experimental part of the protocol.
+type EmulationSensorReadingQuaternion struct {
+	X float64 `json:"x"`
+	Y float64 `json:"y"`
+	Z float64 `json:"z"`
+	W float64 `json:"w"`
+}
+
+// EmulationSensorReading is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Emulation#type-SensorReading
+//
+// This is an experimental part of the protocol.
+type EmulationSensorReading struct {
+	Single     *EmulationSensorReadingSingle     `json:"single,omitempty"`
+	Xyz        *EmulationSensorReadingXYZ        `json:"xyz,omitempty"`
+	Quaternion *EmulationSensorReadingQuaternion `json:"quaternion,omitempty"`
+}
+
+// EmulationPressureSource is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Emulation#type-PressureSource
+//
+// This is an experimental part of the protocol.
+type EmulationPressureSource string
+
+// Values of EmulationPressureSource.
+const (
+	EmulationPressureSourceCPU EmulationPressureSource = "cpu"
+)
+
+// EmulationPressureState is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Emulation#type-PressureState
+//
+// This is an experimental part of the protocol.
+type EmulationPressureState string
+
+// Values of EmulationPressureState.
+const (
+	EmulationPressureStateNominal  EmulationPressureState = "nominal"
+	EmulationPressureStateFair     EmulationPressureState = "fair"
+	EmulationPressureStateSerious  EmulationPressureState = "serious"
+	EmulationPressureStateCritical EmulationPressureState = "critical"
+)
+
+// EmulationPressureMetadata is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Emulation#type-PressureMetadata
+//
+// This is an experimental part of the protocol.
+type EmulationPressureMetadata struct {
+	Available bool `json:"available,omitempty"`
+}
+
+// EmulationDisabledImageType enum of image types that can be disabled.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Emulation#type-DisabledImageType
+//
+// This is an experimental part of the protocol.
+type EmulationDisabledImageType string
+
+// Values of EmulationDisabledImageType.
+const (
+	EmulationDisabledImageTypeAvif EmulationDisabledImageType = "avif"
+	EmulationDisabledImageTypeWebp EmulationDisabledImageType = "webp"
+)
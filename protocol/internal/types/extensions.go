@@ -0,0 +1,16 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package types
+
+// ExtensionsStorageArea storage areas.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Extensions#type-StorageArea
+type ExtensionsStorageArea string
+
+// Values of ExtensionsStorageArea.
+const (
+	ExtensionsStorageAreaSession ExtensionsStorageArea = "session"
+	ExtensionsStorageAreaLocal   ExtensionsStorageArea = "local"
+	ExtensionsStorageAreaSync    ExtensionsStorageArea = "sync"
+	ExtensionsStorageAreaManaged ExtensionsStorageArea = "managed"
+)
@@ -0,0 +1,68 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package types
+
+// FedCmLoginState whether this is a sign-up or sign-in action for this account,
+// i.e. whether this account has ever been used to sign in to this RP before.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/FedCm#type-LoginState
+type FedCmLoginState string
+
+// Values of FedCmLoginState.
+const (
+	FedCmLoginStateSignIn FedCmLoginState = "SignIn"
+	FedCmLoginStateSignUp FedCmLoginState = "SignUp"
+)
+
+// FedCmDialogType the types of FedCM dialogs.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/FedCm#type-DialogType
+type FedCmDialogType string
+
+// Values of FedCmDialogType.
+const (
+	FedCmDialogTypeAccountChooser  FedCmDialogType = "AccountChooser"
+	FedCmDialogTypeAutoReauthn     FedCmDialogType = "AutoReauthn"
+	FedCmDialogTypeConfirmIdpLogin FedCmDialogType = "ConfirmIdpLogin"
+	FedCmDialogTypeError           FedCmDialogType = "Error"
+)
+
+// FedCmDialogButton the buttons on the FedCM dialog.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/FedCm#type-DialogButton
+type FedCmDialogButton string
+
+// Values of FedCmDialogButton.
+const (
+	FedCmDialogButtonConfirmIdpLoginContinue FedCmDialogButton = "ConfirmIdpLoginContinue"
+	FedCmDialogButtonErrorGotIt              FedCmDialogButton = "ErrorGotIt"
+	FedCmDialogButtonErrorMoreDetails        FedCmDialogButton = "ErrorMoreDetails"
+)
+
+// FedCmAccountURLType the URLs that each account has
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/FedCm#type-AccountUrlType
+type FedCmAccountURLType string
+
+// Values of FedCmAccountURLType.
+const (
+	FedCmAccountURLTypeTermsOfService FedCmAccountURLType = "TermsOfService"
+	FedCmAccountURLTypePrivacyPolicy  FedCmAccountURLType = "PrivacyPolicy"
+)
+
+// FedCmAccount corresponds to IdentityRequestAccount
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/FedCm#type-Account
+type FedCmAccount struct {
+	AccountID    string          `json:"accountId"`
+	Email        string          `json:"email"`
+	Name         string          `json:"name"`
+	GivenName    string          `json:"givenName"`
+	PictureURL   string          `json:"pictureUrl"`
+	IdpConfigURL string          `json:"idpConfigUrl"`
+	IdpLoginURL  string          `json:"idpLoginUrl"`
+	LoginState   FedCmLoginState `json:"loginState"`
+	// These two are only set if the loginState is signUp
+	TermsOfServiceURL string `json:"termsOfServiceUrl,omitempty"`
+	PrivacyPolicyURL  string `json:"privacyPolicyUrl,omitempty"`
+}
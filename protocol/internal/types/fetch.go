@@ -0,0 +1,77 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package types
+
+// FetchRequestID unique request identifier. Note that this does not identify
+// individual HTTP requests that are part of a network request.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Fetch#type-RequestId
+type FetchRequestID string
+
+// FetchRequestStage stages of the request to handle. Request will intercept
+// before the request is sent. Response will intercept after the response is
+// received (but before response body is received).
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Fetch#type-RequestStage
+type FetchRequestStage string
+
+// Values of FetchRequestStage.
+const (
+	FetchRequestStageRequest  FetchRequestStage = "Request"
+	FetchRequestStageResponse FetchRequestStage = "Response"
+)
+
+// FetchRequestPattern is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Fetch#type-RequestPattern
+type FetchRequestPattern struct {
+	// Wildcards (`'*'` -> zero or more, `'?'` -> exactly one) are allowed. Escape
+	// character is backslash. Omitting is equivalent to `"*"`.
+	URLPattern string `json:"urlPattern,omitempty"`
+	// If set, only requests for matching resource types will be intercepted.
+	ResourceType NetworkResourceType `json:"resourceType,omitempty"`
+	// Stage at which to begin intercepting requests. Default is Request.
+	RequestStage FetchRequestStage `json:"requestStage,omitempty"`
+}
+
+// FetchHeaderEntry response HTTP header entry
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Fetch#type-HeaderEntry
+type FetchHeaderEntry struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// FetchAuthChallenge authorization challenge for HTTP status code 401 or 407.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Fetch#type-AuthChallenge
+type FetchAuthChallenge struct {
+	// Source of the authentication challenge.
+	//
+	// Allowed values: Server, Proxy.
+	Source string `json:"source,omitempty"`
+	// Origin of the challenger.
+	Origin string `json:"origin"`
+	// The authentication scheme used, such as basic or digest
+	Scheme string `json:"scheme"`
+	// The realm of the challenge. May be empty.
+	Realm string `json:"realm"`
+}
+
+// FetchAuthChallengeResponse response to an AuthChallenge.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Fetch#type-AuthChallengeResponse
+type FetchAuthChallengeResponse struct {
+	// The decision on what to do in response to the authorization challenge.
+	// Default means deferring to the default behavior of the net stack, which will
+	// likely either the Cancel authentication or display a popup dialog box.
+	//
+	// Allowed values: Default, CancelAuth, ProvideCredentials.
+	Response string `json:"response"`
+	// The username to provide, possibly empty. Should only be set if response is
+	// ProvideCredentials.
+	Username string `json:"username,omitempty"`
+	// The password to provide, possibly empty. Should only be set if response is
+	// ProvideCredentials.
+	Password string `json:"password,omitempty"`
+}
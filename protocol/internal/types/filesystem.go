@@ -0,0 +1,38 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package types
+
+// FileSystemFile is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/FileSystem#type-File
+type FileSystemFile struct {
+	Name string `json:"name"`
+	// Timestamp
+	LastModified NetworkTimeSinceEpoch `json:"lastModified"`
+	// Size in bytes
+	Size float64 `json:"size"`
+	Type string  `json:"type"`
+}
+
+// FileSystemDirectory is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/FileSystem#type-Directory
+type FileSystemDirectory struct {
+	Name              string   `json:"name"`
+	NestedDirectories []string `json:"nestedDirectories"`
+	// Files that are directly nested under this directory.
+	NestedFiles []FileSystemFile `json:"nestedFiles"`
+}
+
+// FileSystemBucketFileSystemLocator is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/FileSystem#type-BucketFileSystemLocator
+type FileSystemBucketFileSystemLocator struct {
+	// Storage key
+	StorageKey StorageSerializedStorageKey `json:"storageKey"`
+	// Bucket name. Not passing a `bucketName` will retrieve the default Bucket.
+	// (https://developer.mozilla.org/en-US/docs/Web/API/Storage_API#storage_buckets)
+	BucketName string `json:"bucketName,omitempty"`
+	// Path to the directory using each path component as an array item.
+	PathComponents []string `json:"pathComponents"`
+}
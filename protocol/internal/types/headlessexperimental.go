@@ -0,0 +1,18 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package types
+
+// HeadlessExperimentalScreenshotParams encoding options for a screenshot.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/HeadlessExperimental#type-ScreenshotParams
+type HeadlessExperimentalScreenshotParams struct {
+	// Image compression format (defaults to png).
+	//
+	// Allowed values: jpeg, png, webp.
+	Format string `json:"format,omitempty"`
+	// Compression quality from range [0..100] (jpeg and webp only).
+	Quality int64 `json:"quality,omitempty"`
+	// Optimize image encoding for speed, not for resulting size (defaults to
+	// false)
+	OptimizeForSpeed bool `json:"optimizeForSpeed,omitempty"`
+}
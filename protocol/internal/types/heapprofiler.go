@@ -0,0 +1,46 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package types
+
+// HeapProfilerHeapSnapshotObjectID heap snapshot object id.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/HeapProfiler#type-HeapSnapshotObjectId
+type HeapProfilerHeapSnapshotObjectID string
+
+// HeapProfilerSamplingHeapProfileNode sampling Heap Profile node. Holds
+// callsite information, allocation statistics and child nodes.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/HeapProfiler#type-SamplingHeapProfileNode
+type HeapProfilerSamplingHeapProfileNode struct {
+	// Function location.
+	CallFrame RuntimeCallFrame `json:"callFrame"`
+	// Allocations size in bytes for the node excluding children.
+	SelfSize float64 `json:"selfSize"`
+	// Node id. Ids are unique across all profiles collected between startSampling
+	// and stopSampling.
+	ID int64 `json:"id"`
+	// Child nodes.
+	Children []HeapProfilerSamplingHeapProfileNode `json:"children"`
+}
+
+// HeapProfilerSamplingHeapProfileSample a single sample from a sampling
+// profile.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/HeapProfiler#type-SamplingHeapProfileSample
+type HeapProfilerSamplingHeapProfileSample struct {
+	// Allocation size in bytes attributed to the sample.
+	Size float64 `json:"size"`
+	// Id of the corresponding profile tree node.
+	NodeID int64 `json:"nodeId"`
+	// Time-ordered sample ordinal number. It is unique across all profiles
+	// retrieved between startSampling and stopSampling.
+	Ordinal float64 `json:"ordinal"`
+}
+
+// HeapProfilerSamplingHeapProfile sampling profile.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/HeapProfiler#type-SamplingHeapProfile
+type HeapProfilerSamplingHeapProfile struct {
+	Head    HeapProfilerSamplingHeapProfileNode     `json:"head"`
+	Samples []HeapProfilerSamplingHeapProfileSample `json:"samples"`
+}
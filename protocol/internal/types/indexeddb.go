@@ -0,0 +1,102 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package types
+
+// IndexedDBDatabaseWithObjectStores database with an array of object stores.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/IndexedDB#type-DatabaseWithObjectStores
+type IndexedDBDatabaseWithObjectStores struct {
+	// Database name.
+	Name string `json:"name"`
+	// Database version (type is not 'integer', as the standard requires the
+	// version number to be 'unsigned long long')
+	Version float64 `json:"version"`
+	// Object stores in this database.
+	ObjectStores []IndexedDBObjectStore `json:"objectStores"`
+}
+
+// IndexedDBObjectStore object store.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/IndexedDB#type-ObjectStore
+type IndexedDBObjectStore struct {
+	// Object store name.
+	Name string `json:"name"`
+	// Object store key path.
+	KeyPath IndexedDBKeyPath `json:"keyPath"`
+	// If true, object store has auto increment flag set.
+	AutoIncrement bool `json:"autoIncrement"`
+	// Indexes in this object store.
+	Indexes []IndexedDBObjectStoreIndex `json:"indexes"`
+}
+
+// IndexedDBObjectStoreIndex object store index.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/IndexedDB#type-ObjectStoreIndex
+type IndexedDBObjectStoreIndex struct {
+	// Index name.
+	Name string `json:"name"`
+	// Index key path.
+	KeyPath IndexedDBKeyPath `json:"keyPath"`
+	// If true, index is unique.
+	Unique bool `json:"unique"`
+	// If true, index allows multiple entries for a key.
+	MultiEntry bool `json:"multiEntry"`
+}
+
+// IndexedDBKey key.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/IndexedDB#type-Key
+type IndexedDBKey struct {
+	// Key type.
+	//
+	// Allowed values: number, string, date, array.
+	Type string `json:"type"`
+	// Number value.
+	Number float64 `json:"number,omitempty"`
+	// String value.
+	String string `json:"string,omitempty"`
+	// Date value.
+	Date float64 `json:"date,omitempty"`
+	// Array value.
+	Array []IndexedDBKey `json:"array,omitempty"`
+}
+
+// IndexedDBKeyRange key range.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/IndexedDB#type-KeyRange
+type IndexedDBKeyRange struct {
+	// Lower bound.
+	Lower *IndexedDBKey `json:"lower,omitempty"`
+	// Upper bound.
+	Upper *IndexedDBKey `json:"upper,omitempty"`
+	// If true lower bound is open.
+	LowerOpen bool `json:"lowerOpen"`
+	// If true upper bound is open.
+	UpperOpen bool `json:"upperOpen"`
+}
+
+// IndexedDBDataEntry data entry.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/IndexedDB#type-DataEntry
+type IndexedDBDataEntry struct {
+	// Key object.
+	Key RuntimeRemoteObject `json:"key"`
+	// Primary key object.
+	PrimaryKey RuntimeRemoteObject `json:"primaryKey"`
+	// Value object.
+	Value RuntimeRemoteObject `json:"value"`
+}
+
+// IndexedDBKeyPath key path.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/IndexedDB#type-KeyPath
+type IndexedDBKeyPath struct {
+	// Key path type.
+	//
+	// Allowed values: null, string, array.
+	Type string `json:"type"`
+	// String value.
+	String string `json:"string,omitempty"`
+	// Array value.
+	Array []string `json:"array,omitempty"`
+}
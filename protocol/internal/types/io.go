@@ -0,0 +1,9 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package types
+
+// IOStreamHandle this is either obtained from another method or specified as
+// `blob:<uuid>` where `<uuid>` is an UUID of a Blob.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/IO#type-StreamHandle
+type IOStreamHandle string
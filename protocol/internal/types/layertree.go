@@ -0,0 +1,97 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package types
+
+// LayerTreeLayerID unique Layer identifier.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/LayerTree#type-LayerId
+type LayerTreeLayerID string
+
+// LayerTreeSnapshotID unique snapshot identifier.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/LayerTree#type-SnapshotId
+type LayerTreeSnapshotID string
+
+// LayerTreeScrollRect rectangle where scrolling happens on the main thread.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/LayerTree#type-ScrollRect
+type LayerTreeScrollRect struct {
+	// Rectangle itself.
+	Rect DOMRect `json:"rect"`
+	// Reason for rectangle to force scrolling on the main thread
+	//
+	// Allowed values: RepaintsOnScroll, TouchEventHandler, WheelEventHandler.
+	Type string `json:"type"`
+}
+
+// LayerTreeStickyPositionConstraint sticky position constraints.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/LayerTree#type-StickyPositionConstraint
+type LayerTreeStickyPositionConstraint struct {
+	// Layout rectangle of the sticky element before being shifted
+	StickyBoxRect DOMRect `json:"stickyBoxRect"`
+	// Layout rectangle of the containing block of the sticky element
+	ContainingBlockRect DOMRect `json:"containingBlockRect"`
+	// The nearest sticky layer that shifts the sticky box
+	NearestLayerShiftingStickyBox LayerTreeLayerID `json:"nearestLayerShiftingStickyBox,omitempty"`
+	// The nearest sticky layer that shifts the containing block
+	NearestLayerShiftingContainingBlock LayerTreeLayerID `json:"nearestLayerShiftingContainingBlock,omitempty"`
+}
+
+// LayerTreePictureTile serialized fragment of layer picture along with its
+// offset within the layer.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/LayerTree#type-PictureTile
+type LayerTreePictureTile struct {
+	// Offset from owning layer left boundary
+	X float64 `json:"x"`
+	// Offset from owning layer top boundary
+	Y float64 `json:"y"`
+	// Base64-encoded snapshot data. (Encoded as a base64 string when passed over
+	// JSON)
+	Picture string `json:"picture"`
+}
+
+// LayerTreeLayer information about a compositing layer.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/LayerTree#type-Layer
+type LayerTreeLayer struct {
+	// The unique id for this layer.
+	LayerID LayerTreeLayerID `json:"layerId"`
+	// The id of parent (not present for root).
+	ParentLayerID LayerTreeLayerID `json:"parentLayerId,omitempty"`
+	// The backend id for the node associated with this layer.
+	BackendNodeID DOMBackendNodeID `json:"backendNodeId,omitempty"`
+	// Offset from parent layer, X coordinate.
+	OffsetX float64 `json:"offsetX"`
+	// Offset from parent layer, Y coordinate.
+	OffsetY float64 `json:"offsetY"`
+	// Layer width.
+	Width float64 `json:"width"`
+	// Layer height.
+	Height float64 `json:"height"`
+	// Transformation matrix for layer, default is identity matrix
+	Transform []float64 `json:"transform,omitempty"`
+	// Transform anchor point X, absent if no transform specified
+	AnchorX float64 `json:"anchorX,omitempty"`
+	// Transform anchor point Y, absent if no transform specified
+	AnchorY float64 `json:"anchorY,omitempty"`
+	// Transform anchor point Z, absent if no transform specified
+	AnchorZ float64 `json:"anchorZ,omitempty"`
+	// Indicates how many time this layer has painted.
+	PaintCount int64 `json:"paintCount"`
+	// Indicates whether this layer hosts any content, rather than being used for
+	// transform/scrolling purposes only.
+	DrawsContent bool `json:"drawsContent"`
+	// Set if layer is not visible.
+	Invisible bool `json:"invisible,omitempty"`
+	// Rectangles scrolling on main thread only.
+	ScrollRects []LayerTreeScrollRect `json:"scrollRects,omitempty"`
+	// Sticky position constraint information
+	StickyPositionConstraint *LayerTreeStickyPositionConstraint `json:"stickyPositionConstraint,omitempty"`
+}
+
+// LayerTreePaintProfile array of timings, one per paint step.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/LayerTree#type-PaintProfile
+type LayerTreePaintProfile []float64
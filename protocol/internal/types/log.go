@@ -0,0 +1,50 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package types
+
+// LogLogEntry log entry.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Log#type-LogEntry
+type LogLogEntry struct {
+	// Log entry source.
+	//
+	// Allowed values: xml, javascript, network, storage, appcache, rendering,
+	// security, deprecation, worker, violation, intervention, recommendation,
+	// other.
+	Source string `json:"source"`
+	// Log entry severity.
+	//
+	// Allowed values: verbose, info, warning, error.
+	Level string `json:"level"`
+	// Logged text.
+	Text string `json:"text"`
+	// Allowed values: cors.
+	Category string `json:"category,omitempty"`
+	// Timestamp when this entry was added.
+	Timestamp RuntimeTimestamp `json:"timestamp"`
+	// URL of the resource if known.
+	URL string `json:"url,omitempty"`
+	// Line number in the resource.
+	LineNumber int64 `json:"lineNumber,omitempty"`
+	// JavaScript stack trace.
+	StackTrace *RuntimeStackTrace `json:"stackTrace,omitempty"`
+	// Identifier of the network request associated with this entry.
+	NetworkRequestID NetworkRequestID `json:"networkRequestId,omitempty"`
+	// Identifier of the worker associated with this entry.
+	WorkerID string `json:"workerId,omitempty"`
+	// Call arguments.
+	Args []RuntimeRemoteObject `json:"args,omitempty"`
+}
+
+// LogViolationSetting violation configuration setting.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Log#type-ViolationSetting
+type LogViolationSetting struct {
+	// Violation type.
+	//
+	// Allowed values: longTask, longLayout, blockedEvent, blockedParser,
+	// discouragedAPIUse, handler, recurringHandler.
+	Name string `json:"name"`
+	// Time threshold to trigger upon.
+	Threshold float64 `json:"threshold"`
+}
@@ -0,0 +1,75 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package types
+
+// MediaPlayerID players will get an ID that is unique within the agent context.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Media#type-PlayerId
+type MediaPlayerID string
+
+// MediaTimestamp is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Media#type-Timestamp
+type MediaTimestamp float64
+
+// MediaPlayerMessage have one type per entry in MediaLogRecord::Type
+// Corresponds to kMessage
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Media#type-PlayerMessage
+type MediaPlayerMessage struct {
+	// Keep in sync with MediaLogMessageLevel We are currently keeping the message
+	// level 'error' separate from the PlayerError type because right now they
+	// represent different things, this one being a DVLOG(ERROR) style log message
+	// that gets printed based on what log level is selected in the UI, and the
+	// other is a representation of a media::PipelineStatus object. Soon however
+	// we're going to be moving away from using PipelineStatus for errors and
+	// introducing a new error type which should hopefully let us integrate the
+	// error log level into the PlayerError type.
+	//
+	// Allowed values: error, warning, info, debug.
+	Level   string `json:"level"`
+	Message string `json:"message"`
+}
+
+// MediaPlayerProperty corresponds to kMediaPropertyChange
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Media#type-PlayerProperty
+type MediaPlayerProperty struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// MediaPlayerEvent corresponds to kMediaEventTriggered
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Media#type-PlayerEvent
+type MediaPlayerEvent struct {
+	Timestamp MediaTimestamp `json:"timestamp"`
+	Value     string         `json:"value"`
+}
+
+// MediaPlayerErrorSourceLocation represents logged source line numbers reported
+// in an error. NOTE: file and line are from chromium c++ implementation code,
+// not js.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Media#type-PlayerErrorSourceLocation
+type MediaPlayerErrorSourceLocation struct {
+	File string `json:"file"`
+	Line int64  `json:"line"`
+}
+
+// MediaPlayerError corresponds to kMediaError
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Media#type-PlayerError
+type MediaPlayerError struct {
+	ErrorType string `json:"errorType"`
+	// Code is the numeric enum entry for a specific set of error codes, such as
+	// PipelineStatusCodes in media/base/pipeline_status.h
+	Code int64 `json:"code"`
+	// A trace of where this error was caused / where it passed through.
+	Stack []MediaPlayerErrorSourceLocation `json:"stack"`
+	// Errors potentially have a root cause error, ie, a DecoderError might be
+	// caused by an WindowsError
+	Cause []MediaPlayerError `json:"cause"`
+	// Extra data attached to an error, such as an HRESULT, Video Codec, etc.
+	Data map[string]interface{} `json:"data"`
+}
@@ -0,0 +1,60 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package types
+
+// MemoryPressureLevel memory pressure level.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Memory#type-PressureLevel
+type MemoryPressureLevel string
+
+// Values of MemoryPressureLevel.
+const (
+	MemoryPressureLevelModerate MemoryPressureLevel = "moderate"
+	MemoryPressureLevelCritical MemoryPressureLevel = "critical"
+)
+
+// MemorySamplingProfileNode heap profile sample.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Memory#type-SamplingProfileNode
+type MemorySamplingProfileNode struct {
+	// Size of the sampled allocation.
+	Size float64 `json:"size"`
+	// Total bytes attributed to this sample.
+	Total float64 `json:"total"`
+	// Execution stack at the point of allocation.
+	Stack []string `json:"stack"`
+}
+
+// MemorySamplingProfile array of heap profile samples.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Memory#type-SamplingProfile
+type MemorySamplingProfile struct {
+	Samples []MemorySamplingProfileNode `json:"samples"`
+	Modules []MemoryModule              `json:"modules"`
+}
+
+// MemoryModule executable module information
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Memory#type-Module
+type MemoryModule struct {
+	// Name of the module.
+	Name string `json:"name"`
+	// UUID of the module.
+	UUID string `json:"uuid"`
+	// Base address where the module is loaded into memory. Encoded as a decimal or
+	// hexadecimal (0x prefixed) string.
+	BaseAddress string `json:"baseAddress"`
+	// Size of the module in bytes.
+	Size float64 `json:"size"`
+}
+
+// MemoryDOMCounter DOM object counter data.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Memory#type-DOMCounter
+type MemoryDOMCounter struct {
+	// Object name. Note: object names should be presumed volatile and clients
+	// should not expect the returned names to be consistent across runs.
+	Name string `json:"name"`
+	// Object count.
+	Count int64 `json:"count"`
+}
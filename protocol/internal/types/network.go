@@ -0,0 +1,1434 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package types
+
+// NetworkResourceType resource type as it was perceived by the rendering
+// engine.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-ResourceType
+type NetworkResourceType string
+
+// Values of NetworkResourceType.
+const (
+	NetworkResourceTypeDocument           NetworkResourceType = "Document"
+	NetworkResourceTypeStylesheet         NetworkResourceType = "Stylesheet"
+	NetworkResourceTypeImage              NetworkResourceType = "Image"
+	NetworkResourceTypeMedia              NetworkResourceType = "Media"
+	NetworkResourceTypeFont               NetworkResourceType = "Font"
+	NetworkResourceTypeScript             NetworkResourceType = "Script"
+	NetworkResourceTypeTextTrack          NetworkResourceType = "TextTrack"
+	NetworkResourceTypeXHR                NetworkResourceType = "XHR"
+	NetworkResourceTypeFetch              NetworkResourceType = "Fetch"
+	NetworkResourceTypePrefetch           NetworkResourceType = "Prefetch"
+	NetworkResourceTypeEventSource        NetworkResourceType = "EventSource"
+	NetworkResourceTypeWebSocket          NetworkResourceType = "WebSocket"
+	NetworkResourceTypeManifest           NetworkResourceType = "Manifest"
+	NetworkResourceTypeSignedExchange     NetworkResourceType = "SignedExchange"
+	NetworkResourceTypePing               NetworkResourceType = "Ping"
+	NetworkResourceTypeCSPViolationReport NetworkResourceType = "CSPViolationReport"
+	NetworkResourceTypePreflight          NetworkResourceType = "Preflight"
+	NetworkResourceTypeFedCM              NetworkResourceType = "FedCM"
+	NetworkResourceTypeOther              NetworkResourceType = "Other"
+)
+
+// NetworkLoaderID unique loader identifier.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-LoaderId
+type NetworkLoaderID string
+
+// NetworkRequestID unique network request identifier. Note that this does not
+// identify individual HTTP requests that are part of a network request.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-RequestId
+type NetworkRequestID string
+
+// NetworkInterceptionID unique intercepted request identifier.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-InterceptionId
+type NetworkInterceptionID string
+
+// NetworkErrorReason network level fetch failure reason.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-ErrorReason
+type NetworkErrorReason string
+
+// Values of NetworkErrorReason.
+const (
+	NetworkErrorReasonFailed               NetworkErrorReason = "Failed"
+	NetworkErrorReasonAborted              NetworkErrorReason = "Aborted"
+	NetworkErrorReasonTimedOut             NetworkErrorReason = "TimedOut"
+	NetworkErrorReasonAccessDenied         NetworkErrorReason = "AccessDenied"
+	NetworkErrorReasonConnectionClosed     NetworkErrorReason = "ConnectionClosed"
+	NetworkErrorReasonConnectionReset      NetworkErrorReason = "ConnectionReset"
+	NetworkErrorReasonConnectionRefused    NetworkErrorReason = "ConnectionRefused"
+	NetworkErrorReasonConnectionAborted    NetworkErrorReason = "ConnectionAborted"
+	NetworkErrorReasonConnectionFailed     NetworkErrorReason = "ConnectionFailed"
+	NetworkErrorReasonNameNotResolved      NetworkErrorReason = "NameNotResolved"
+	NetworkErrorReasonInternetDisconnected NetworkErrorReason = "InternetDisconnected"
+	NetworkErrorReasonAddressUnreachable   NetworkErrorReason = "AddressUnreachable"
+	NetworkErrorReasonBlockedByClient      NetworkErrorReason = "BlockedByClient"
+	NetworkErrorReasonBlockedByResponse    NetworkErrorReason = "BlockedByResponse"
+)
+
+// NetworkTimeSinceEpoch UTC time in seconds, counted from January 1, 1970.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-TimeSinceEpoch
+type NetworkTimeSinceEpoch float64
+
+// NetworkMonotonicTime monotonically increasing time in seconds since an
+// arbitrary point in the past.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-MonotonicTime
+type NetworkMonotonicTime float64
+
+// NetworkHeaders request / response headers as keys / values of JSON object.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-Headers
+type NetworkHeaders map[string]interface{}
+
+// NetworkConnectionType the underlying connection technology that the browser
+// is supposedly using.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-ConnectionType
+type NetworkConnectionType string
+
+// Values of NetworkConnectionType.
+const (
+	NetworkConnectionTypeNone       NetworkConnectionType = "none"
+	NetworkConnectionTypeCellular2g NetworkConnectionType = "cellular2g"
+	NetworkConnectionTypeCellular3g NetworkConnectionType = "cellular3g"
+	NetworkConnectionTypeCellular4g NetworkConnectionType = "cellular4g"
+	NetworkConnectionTypeBluetooth  NetworkConnectionType = "bluetooth"
+	NetworkConnectionTypeEthernet   NetworkConnectionType = "ethernet"
+	NetworkConnectionTypeWifi       NetworkConnectionType = "wifi"
+	NetworkConnectionTypeWimax      NetworkConnectionType = "wimax"
+	NetworkConnectionTypeOther      NetworkConnectionType = "other"
+)
+
+// NetworkCookieSameSite represents the cookie's 'SameSite' status:
+// https://tools.ietf.org/html/draft-west-first-party-cookies
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-CookieSameSite
+type NetworkCookieSameSite string
+
+// Values of NetworkCookieSameSite.
+const (
+	NetworkCookieSameSiteStrict NetworkCookieSameSite = "Strict"
+	NetworkCookieSameSiteLax    NetworkCookieSameSite = "Lax"
+	NetworkCookieSameSiteNone   NetworkCookieSameSite = "None"
+)
+
+// NetworkCookiePriority represents the cookie's 'Priority' status:
+// https://tools.ietf.org/html/draft-west-cookie-priority-00
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-CookiePriority
+//
+// This is an experimental part of the protocol.
+type NetworkCookiePriority string
+
+// Values of NetworkCookiePriority.
+const (
+	NetworkCookiePriorityLow    NetworkCookiePriority = "Low"
+	NetworkCookiePriorityMedium NetworkCookiePriority = "Medium"
+	NetworkCookiePriorityHigh   NetworkCookiePriority = "High"
+)
+
+// NetworkCookieSourceScheme represents the source scheme of the origin that
+// originally set the cookie. A value of "Unset" allows protocol clients to
+// emulate legacy cookie scope for the scheme. This is a temporary ability and
+// it will be removed in the future.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-CookieSourceScheme
+//
+// This is an experimental part of the protocol.
+type NetworkCookieSourceScheme string
+
+// Values of NetworkCookieSourceScheme.
+const (
+	NetworkCookieSourceSchemeUnset     NetworkCookieSourceScheme = "Unset"
+	NetworkCookieSourceSchemeNonSecure NetworkCookieSourceScheme = "NonSecure"
+	NetworkCookieSourceSchemeSecure    NetworkCookieSourceScheme = "Secure"
+)
+
+// NetworkResourceTiming timing information for the request.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-ResourceTiming
+type NetworkResourceTiming struct {
+	// Timing's requestTime is a baseline in seconds, while the other numbers are
+	// ticks in milliseconds relatively to this requestTime.
+	RequestTime float64 `json:"requestTime"`
+	// Started resolving proxy.
+	ProxyStart float64 `json:"proxyStart"`
+	// Finished resolving proxy.
+	ProxyEnd float64 `json:"proxyEnd"`
+	// Started DNS address resolve.
+	DnsStart float64 `json:"dnsStart"`
+	// Finished DNS address resolve.
+	DnsEnd float64 `json:"dnsEnd"`
+	// Started connecting to the remote host.
+	ConnectStart float64 `json:"connectStart"`
+	// Connected to the remote host.
+	ConnectEnd float64 `json:"connectEnd"`
+	// Started SSL handshake.
+	SslStart float64 `json:"sslStart"`
+	// Finished SSL handshake.
+	SslEnd float64 `json:"sslEnd"`
+	// Started running ServiceWorker.
+	//
+	// This is an experimental part of the protocol.
+	WorkerStart float64 `json:"workerStart"`
+	// Finished Starting ServiceWorker.
+	//
+	// This is an experimental part of the protocol.
+	WorkerReady float64 `json:"workerReady"`
+	// Started fetch event.
+	//
+	// This is an experimental part of the protocol.
+	WorkerFetchStart float64 `json:"workerFetchStart"`
+	// Settled fetch event respondWith promise.
+	//
+	// This is an experimental part of the protocol.
+	WorkerRespondWithSettled float64 `json:"workerRespondWithSettled"`
+	// Started ServiceWorker static routing source evaluation.
+	//
+	// This is an experimental part of the protocol.
+	WorkerRouterEvaluationStart float64 `json:"workerRouterEvaluationStart,omitempty"`
+	// Started cache lookup when the source was evaluated to `cache`.
+	//
+	// This is an experimental part of the protocol.
+	WorkerCacheLookupStart float64 `json:"workerCacheLookupStart,omitempty"`
+	// Started sending request.
+	SendStart float64 `json:"sendStart"`
+	// Finished sending request.
+	SendEnd float64 `json:"sendEnd"`
+	// Time the server started pushing request.
+	//
+	// This is an experimental part of the protocol.
+	PushStart float64 `json:"pushStart"`
+	// Time the server finished pushing request.
+	//
+	// This is an experimental part of the protocol.
+	PushEnd float64 `json:"pushEnd"`
+	// Started receiving response headers.
+	//
+	// This is an experimental part of the protocol.
+	ReceiveHeadersStart float64 `json:"receiveHeadersStart"`
+	// Finished receiving response headers.
+	ReceiveHeadersEnd float64 `json:"receiveHeadersEnd"`
+}
+
+// NetworkResourcePriority loading priority of a resource request.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-ResourcePriority
+type NetworkResourcePriority string
+
+// Values of NetworkResourcePriority.
+const (
+	NetworkResourcePriorityVeryLow  NetworkResourcePriority = "VeryLow"
+	NetworkResourcePriorityLow      NetworkResourcePriority = "Low"
+	NetworkResourcePriorityMedium   NetworkResourcePriority = "Medium"
+	NetworkResourcePriorityHigh     NetworkResourcePriority = "High"
+	NetworkResourcePriorityVeryHigh NetworkResourcePriority = "VeryHigh"
+)
+
+// NetworkPostDataEntry post data entry for HTTP request
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-PostDataEntry
+type NetworkPostDataEntry struct {
+	Bytes string `json:"bytes,omitempty"`
+}
+
+// NetworkRequest HTTP request data.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-Request
+type NetworkRequest struct {
+	// Request URL (without fragment).
+	URL string `json:"url"`
+	// Fragment of the requested URL starting with hash, if present.
+	URLFragment string `json:"urlFragment,omitempty"`
+	// HTTP request method.
+	Method string `json:"method"`
+	// HTTP request headers.
+	Headers NetworkHeaders `json:"headers"`
+	// HTTP POST request data. Use postDataEntries instead.
+	//
+	// Deprecated: this is deprecated in the protocol.
+	PostData string `json:"postData,omitempty"`
+	// True when the request has POST data. Note that postData might still be
+	// omitted when this flag is true when the data is too long.
+	HasPostData bool `json:"hasPostData,omitempty"`
+	// Request body elements (post data broken into individual entries).
+	//
+	// This is an experimental part of the protocol.
+	PostDataEntries []NetworkPostDataEntry `json:"postDataEntries,omitempty"`
+	// The mixed content type of the request.
+	MixedContentType SecurityMixedContentType `json:"mixedContentType,omitempty"`
+	// Priority of the resource request at the time request is sent.
+	InitialPriority NetworkResourcePriority `json:"initialPriority"`
+	// The referrer policy of the request, as defined in
+	// https://www.w3.org/TR/referrer-policy/
+	//
+	// Allowed values: unsafe-url, no-referrer-when-downgrade, no-referrer, origin,
+	// origin-when-cross-origin, same-origin, strict-origin,
+	// strict-origin-when-cross-origin.
+	ReferrerPolicy string `json:"referrerPolicy"`
+	// Whether is loaded via link preload.
+	IsLinkPreload bool `json:"isLinkPreload,omitempty"`
+	// Set for requests when the TrustToken API is used. Contains the parameters
+	// passed by the developer (e.g. via "fetch") as understood by the backend.
+	//
+	// This is an experimental part of the protocol.
+	TrustTokenParams *NetworkTrustTokenParams `json:"trustTokenParams,omitempty"`
+	// True if this resource request is considered to be the 'same site' as the
+	// request corresponding to the main frame.
+	//
+	// This is an experimental part of the protocol.
+	IsSameSite bool `json:"isSameSite,omitempty"`
+}
+
+// NetworkSignedCertificateTimestamp details of a signed certificate timestamp
+// (SCT).
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-SignedCertificateTimestamp
+type NetworkSignedCertificateTimestamp struct {
+	// Validation status.
+	Status string `json:"status"`
+	// Origin.
+	Origin string `json:"origin"`
+	// Log name / description.
+	LogDescription string `json:"logDescription"`
+	// Log ID.
+	LogID string `json:"logId"`
+	// Issuance date. Unlike TimeSinceEpoch, this contains the number of
+	// milliseconds since January 1, 1970, UTC, not the number of seconds.
+	Timestamp float64 `json:"timestamp"`
+	// Hash algorithm.
+	HashAlgorithm string `json:"hashAlgorithm"`
+	// Signature algorithm.
+	SignatureAlgorithm string `json:"signatureAlgorithm"`
+	// Signature data.
+	SignatureData string `json:"signatureData"`
+}
+
+// NetworkSecurityDetails security details about a request.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-SecurityDetails
+type NetworkSecurityDetails struct {
+	// Protocol name (e.g. "TLS 1.2" or "QUIC").
+	Protocol string `json:"protocol"`
+	// Key Exchange used by the connection, or the empty string if not applicable.
+	KeyExchange string `json:"keyExchange"`
+	// (EC)DH group used by the connection, if applicable.
+	KeyExchangeGroup string `json:"keyExchangeGroup,omitempty"`
+	// Cipher name.
+	Cipher string `json:"cipher"`
+	// TLS MAC. Note that AEAD ciphers do not have separate MACs.
+	Mac string `json:"mac,omitempty"`
+	// Certificate ID value.
+	CertificateID SecurityCertificateID `json:"certificateId"`
+	// Certificate subject name.
+	SubjectName string `json:"subjectName"`
+	// Subject Alternative Name (SAN) DNS names and IP addresses.
+	SanList []string `json:"sanList"`
+	// Name of the issuing CA.
+	Issuer string `json:"issuer"`
+	// Certificate valid from date.
+	ValidFrom NetworkTimeSinceEpoch `json:"validFrom"`
+	// Certificate valid to (expiration) date
+	ValidTo NetworkTimeSinceEpoch `json:"validTo"`
+	// List of signed certificate timestamps (SCTs).
+	SignedCertificateTimestampList []NetworkSignedCertificateTimestamp `json:"signedCertificateTimestampList"`
+	// Whether the request complied with Certificate Transparency policy
+	CertificateTransparencyCompliance NetworkCertificateTransparencyCompliance `json:"certificateTransparencyCompliance"`
+	// The signature algorithm used by the server in the TLS server signature,
+	// represented as a TLS SignatureScheme code point. Omitted if not applicable
+	// or not known.
+	ServerSignatureAlgorithm int64 `json:"serverSignatureAlgorithm,omitempty"`
+	// Whether the connection used Encrypted ClientHello
+	EncryptedClientHello bool `json:"encryptedClientHello"`
+}
+
+// NetworkCertificateTransparencyCompliance whether the request complied with
+// Certificate Transparency policy.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-CertificateTransparencyCompliance
+type NetworkCertificateTransparencyCompliance string
+
+// Values of NetworkCertificateTransparencyCompliance.
+const (
+	NetworkCertificateTransparencyComplianceUnknown      NetworkCertificateTransparencyCompliance = "unknown"
+	NetworkCertificateTransparencyComplianceNotCompliant NetworkCertificateTransparencyCompliance = "not-compliant"
+	NetworkCertificateTransparencyComplianceCompliant    NetworkCertificateTransparencyCompliance = "compliant"
+)
+
+// NetworkBlockedReason the reason why request was blocked.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-BlockedReason
+type NetworkBlockedReason string
+
+// Values of NetworkBlockedReason.
+const (
+	NetworkBlockedReasonOther                                                   NetworkBlockedReason = "other"
+	NetworkBlockedReasonCsp                                                     NetworkBlockedReason = "csp"
+	NetworkBlockedReasonMixedContent                                            NetworkBlockedReason = "mixed-content"
+	NetworkBlockedReasonOrigin                                                  NetworkBlockedReason = "origin"
+	NetworkBlockedReasonInspector                                               NetworkBlockedReason = "inspector"
+	NetworkBlockedReasonIntegrity                                               NetworkBlockedReason = "integrity"
+	NetworkBlockedReasonSubresourceFilter                                       NetworkBlockedReason = "subresource-filter"
+	NetworkBlockedReasonContentType                                             NetworkBlockedReason = "content-type"
+	NetworkBlockedReasonCoepFrameResourceNeedsCoepHeader                        NetworkBlockedReason = "coep-frame-resource-needs-coep-header"
+	NetworkBlockedReasonCoopSandboxedIframeCannotNavigateToCoopPage             NetworkBlockedReason = "coop-sandboxed-iframe-cannot-navigate-to-coop-page"
+	NetworkBlockedReasonCorpNotSameOrigin                                       NetworkBlockedReason = "corp-not-same-origin"
+	NetworkBlockedReasonCorpNotSameOriginAfterDefaultedToSameOriginByCoep       NetworkBlockedReason = "corp-not-same-origin-after-defaulted-to-same-origin-by-coep"
+	NetworkBlockedReasonCorpNotSameOriginAfterDefaultedToSameOriginByDip        NetworkBlockedReason = "corp-not-same-origin-after-defaulted-to-same-origin-by-dip"
+	NetworkBlockedReasonCorpNotSameOriginAfterDefaultedToSameOriginByCoepAndDip NetworkBlockedReason = "corp-not-same-origin-after-defaulted-to-same-origin-by-coep-and-dip"
+	NetworkBlockedReasonCorpNotSameSite                                         NetworkBlockedReason = "corp-not-same-site"
+	NetworkBlockedReasonSriMessageSignatureMismatch                             NetworkBlockedReason = "sri-message-signature-mismatch"
+)
+
+// NetworkCorsError the reason why request was blocked.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-CorsError
+type NetworkCorsError string
+
+// Values of NetworkCorsError.
+const (
+	NetworkCorsErrorDisallowedByMode                          NetworkCorsError = "DisallowedByMode"
+	NetworkCorsErrorInvalidResponse                           NetworkCorsError = "InvalidResponse"
+	NetworkCorsErrorWildcardOriginNotAllowed                  NetworkCorsError = "WildcardOriginNotAllowed"
+	NetworkCorsErrorMissingAllowOriginHeader                  NetworkCorsError = "MissingAllowOriginHeader"
+	NetworkCorsErrorMultipleAllowOriginValues                 NetworkCorsError = "MultipleAllowOriginValues"
+	NetworkCorsErrorInvalidAllowOriginValue                   NetworkCorsError = "InvalidAllowOriginValue"
+	NetworkCorsErrorAllowOriginMismatch                       NetworkCorsError = "AllowOriginMismatch"
+	NetworkCorsErrorInvalidAllowCredentials                   NetworkCorsError = "InvalidAllowCredentials"
+	NetworkCorsErrorCorsDisabledScheme                        NetworkCorsError = "CorsDisabledScheme"
+	NetworkCorsErrorPreflightInvalidStatus                    NetworkCorsError = "PreflightInvalidStatus"
+	NetworkCorsErrorPreflightDisallowedRedirect               NetworkCorsError = "PreflightDisallowedRedirect"
+	NetworkCorsErrorPreflightWildcardOriginNotAllowed         NetworkCorsError = "PreflightWildcardOriginNotAllowed"
+	NetworkCorsErrorPreflightMissingAllowOriginHeader         NetworkCorsError = "PreflightMissingAllowOriginHeader"
+	NetworkCorsErrorPreflightMultipleAllowOriginValues        NetworkCorsError = "PreflightMultipleAllowOriginValues"
+	NetworkCorsErrorPreflightInvalidAllowOriginValue          NetworkCorsError = "PreflightInvalidAllowOriginValue"
+	NetworkCorsErrorPreflightAllowOriginMismatch              NetworkCorsError = "PreflightAllowOriginMismatch"
+	NetworkCorsErrorPreflightInvalidAllowCredentials          NetworkCorsError = "PreflightInvalidAllowCredentials"
+	NetworkCorsErrorPreflightMissingAllowExternal             NetworkCorsError = "PreflightMissingAllowExternal"
+	NetworkCorsErrorPreflightInvalidAllowExternal             NetworkCorsError = "PreflightInvalidAllowExternal"
+	NetworkCorsErrorPreflightMissingAllowPrivateNetwork       NetworkCorsError = "PreflightMissingAllowPrivateNetwork"
+	NetworkCorsErrorPreflightInvalidAllowPrivateNetwork       NetworkCorsError = "PreflightInvalidAllowPrivateNetwork"
+	NetworkCorsErrorInvalidAllowMethodsPreflightResponse      NetworkCorsError = "InvalidAllowMethodsPreflightResponse"
+	NetworkCorsErrorInvalidAllowHeadersPreflightResponse      NetworkCorsError = "InvalidAllowHeadersPreflightResponse"
+	NetworkCorsErrorMethodDisallowedByPreflightResponse       NetworkCorsError = "MethodDisallowedByPreflightResponse"
+	NetworkCorsErrorHeaderDisallowedByPreflightResponse       NetworkCorsError = "HeaderDisallowedByPreflightResponse"
+	NetworkCorsErrorRedirectContainsCredentials               NetworkCorsError = "RedirectContainsCredentials"
+	NetworkCorsErrorInsecurePrivateNetwork                    NetworkCorsError = "InsecurePrivateNetwork"
+	NetworkCorsErrorInvalidPrivateNetworkAccess               NetworkCorsError = "InvalidPrivateNetworkAccess"
+	NetworkCorsErrorUnexpectedPrivateNetworkAccess            NetworkCorsError = "UnexpectedPrivateNetworkAccess"
+	NetworkCorsErrorNoCorsRedirectModeNotFollow               NetworkCorsError = "NoCorsRedirectModeNotFollow"
+	NetworkCorsErrorPreflightMissingPrivateNetworkAccessID    NetworkCorsError = "PreflightMissingPrivateNetworkAccessId"
+	NetworkCorsErrorPreflightMissingPrivateNetworkAccessName  NetworkCorsError = "PreflightMissingPrivateNetworkAccessName"
+	NetworkCorsErrorPrivateNetworkAccessPermissionUnavailable NetworkCorsError = "PrivateNetworkAccessPermissionUnavailable"
+	NetworkCorsErrorPrivateNetworkAccessPermissionDenied      NetworkCorsError = "PrivateNetworkAccessPermissionDenied"
+	NetworkCorsErrorLocalNetworkAccessPermissionDenied        NetworkCorsError = "LocalNetworkAccessPermissionDenied"
+)
+
+// NetworkCorsErrorStatus is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-CorsErrorStatus
+type NetworkCorsErrorStatus struct {
+	CorsError       NetworkCorsError `json:"corsError"`
+	FailedParameter string           `json:"failedParameter"`
+}
+
+// NetworkServiceWorkerResponseSource source of serviceworker response.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-ServiceWorkerResponseSource
+type NetworkServiceWorkerResponseSource string
+
+// Values of NetworkServiceWorkerResponseSource.
+const (
+	NetworkServiceWorkerResponseSourceCacheStorage NetworkServiceWorkerResponseSource = "cache-storage"
+	NetworkServiceWorkerResponseSourceHTTPCache    NetworkServiceWorkerResponseSource = "http-cache"
+	NetworkServiceWorkerResponseSourceFallbackCode NetworkServiceWorkerResponseSource = "fallback-code"
+	NetworkServiceWorkerResponseSourceNetwork      NetworkServiceWorkerResponseSource = "network"
+)
+
+// NetworkTrustTokenParams determines what type of Trust Token operation is
+// executed and depending on the type, some additional parameters. The values
+// are specified in third_party/blink/renderer/core/fetch/trust_token.idl.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-TrustTokenParams
+//
+// This is an experimental part of the protocol.
+type NetworkTrustTokenParams struct {
+	Operation NetworkTrustTokenOperationType `json:"operation"`
+	// Only set for "token-redemption" operation and determine whether to request a
+	// fresh SRR or use a still valid cached SRR.
+	//
+	// Allowed values: UseCached, Refresh.
+	RefreshPolicy string `json:"refreshPolicy"`
+	// Origins of issuers from whom to request tokens or redemption records.
+	Issuers []string `json:"issuers,omitempty"`
+}
+
+// NetworkTrustTokenOperationType is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-TrustTokenOperationType
+//
+// This is an experimental part of the protocol.
+type NetworkTrustTokenOperationType string
+
+// Values of NetworkTrustTokenOperationType.
+const (
+	NetworkTrustTokenOperationTypeIssuance   NetworkTrustTokenOperationType = "Issuance"
+	NetworkTrustTokenOperationTypeRedemption NetworkTrustTokenOperationType = "Redemption"
+	NetworkTrustTokenOperationTypeSigning    NetworkTrustTokenOperationType = "Signing"
+)
+
+// NetworkAlternateProtocolUsage the reason why Chrome uses a specific transport
+// protocol for HTTP semantics.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-AlternateProtocolUsage
+//
+// This is an experimental part of the protocol.
+type NetworkAlternateProtocolUsage string
+
+// Values of NetworkAlternateProtocolUsage.
+const (
+	NetworkAlternateProtocolUsageAlternativeJobWonWithoutRace NetworkAlternateProtocolUsage = "alternativeJobWonWithoutRace"
+	NetworkAlternateProtocolUsageAlternativeJobWonRace        NetworkAlternateProtocolUsage = "alternativeJobWonRace"
+	NetworkAlternateProtocolUsageMainJobWonRace               NetworkAlternateProtocolUsage = "mainJobWonRace"
+	NetworkAlternateProtocolUsageMappingMissing               NetworkAlternateProtocolUsage = "mappingMissing"
+	NetworkAlternateProtocolUsageBroken                       NetworkAlternateProtocolUsage = "broken"
+	NetworkAlternateProtocolUsageDnsAlpnH3JobWonWithoutRace   NetworkAlternateProtocolUsage = "dnsAlpnH3JobWonWithoutRace"
+	NetworkAlternateProtocolUsageDnsAlpnH3JobWonRace          NetworkAlternateProtocolUsage = "dnsAlpnH3JobWonRace"
+	NetworkAlternateProtocolUsageUnspecifiedReason            NetworkAlternateProtocolUsage = "unspecifiedReason"
+)
+
+// NetworkServiceWorkerRouterSource source of service worker router.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-ServiceWorkerRouterSource
+type NetworkServiceWorkerRouterSource string
+
+// Values of NetworkServiceWorkerRouterSource.
+const (
+	NetworkServiceWorkerRouterSourceNetwork                    NetworkServiceWorkerRouterSource = "network"
+	NetworkServiceWorkerRouterSourceCache                      NetworkServiceWorkerRouterSource = "cache"
+	NetworkServiceWorkerRouterSourceFetchEvent                 NetworkServiceWorkerRouterSource = "fetch-event"
+	NetworkServiceWorkerRouterSourceRaceNetworkAndFetchHandler NetworkServiceWorkerRouterSource = "race-network-and-fetch-handler"
+	NetworkServiceWorkerRouterSourceRaceNetworkAndCache        NetworkServiceWorkerRouterSource = "race-network-and-cache"
+)
+
+// NetworkServiceWorkerRouterInfo is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-ServiceWorkerRouterInfo
+//
+// This is an experimental part of the protocol.
+type NetworkServiceWorkerRouterInfo struct {
+	// ID of the rule matched. If there is a matched rule, this field will be set,
+	// otherwiser no value will be set.
+	RuleIDMatched int64 `json:"ruleIdMatched,omitempty"`
+	// The router source of the matched rule. If there is a matched rule, this
+	// field will be set, otherwise no value will be set.
+	MatchedSourceType NetworkServiceWorkerRouterSource `json:"matchedSourceType,omitempty"`
+	// The actual router source used.
+	ActualSourceType NetworkServiceWorkerRouterSource `json:"actualSourceType,omitempty"`
+}
+
+// NetworkResponse HTTP response data.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-Response
+type NetworkResponse struct {
+	// Response URL. This URL can be different from CachedResource.url in case of
+	// redirect.
+	URL string `json:"url"`
+	// HTTP response status code.
+	Status int64 `json:"status"`
+	// HTTP response status text.
+	StatusText string `json:"statusText"`
+	// HTTP response headers.
+	Headers NetworkHeaders `json:"headers"`
+	// HTTP response headers text. This has been replaced by the headers in
+	// Network.responseReceivedExtraInfo.
+	//
+	// Deprecated: this is deprecated in the protocol.
+	HeadersText string `json:"headersText,omitempty"`
+	// Resource mimeType as determined by the browser.
+	MimeType string `json:"mimeType"`
+	// Resource charset as determined by the browser (if applicable).
+	Charset string `json:"charset"`
+	// Refined HTTP request headers that were actually transmitted over the
+	// network.
+	RequestHeaders NetworkHeaders `json:"requestHeaders,omitempty"`
+	// HTTP request headers text. This has been replaced by the headers in
+	// Network.requestWillBeSentExtraInfo.
+	//
+	// Deprecated: this is deprecated in the protocol.
+	RequestHeadersText string `json:"requestHeadersText,omitempty"`
+	// Specifies whether physical connection was actually reused for this request.
+	ConnectionReused bool `json:"connectionReused"`
+	// Physical connection id that was actually used for this request.
+	ConnectionID float64 `json:"connectionId"`
+	// Remote IP address.
+	RemoteIPAddress string `json:"remoteIPAddress,omitempty"`
+	// Remote port.
+	RemotePort int64 `json:"remotePort,omitempty"`
+	// Specifies that the request was served from the disk cache.
+	FromDiskCache bool `json:"fromDiskCache,omitempty"`
+	// Specifies that the request was served from the ServiceWorker.
+	FromServiceWorker bool `json:"fromServiceWorker,omitempty"`
+	// Specifies that the request was served from the prefetch cache.
+	FromPrefetchCache bool `json:"fromPrefetchCache,omitempty"`
+	// Specifies that the request was served from the prefetch cache.
+	FromEarlyHints bool `json:"fromEarlyHints,omitempty"`
+	// Information about how ServiceWorker Static Router API was used. If this
+	// field is set with `matchedSourceType` field, a matching rule is found. If
+	// this field is set without `matchedSource`, no matching rule is found.
+	// Otherwise, the API is not used.
+	//
+	// This is an experimental part of the protocol.
+	ServiceWorkerRouterInfo *NetworkServiceWorkerRouterInfo `json:"serviceWorkerRouterInfo,omitempty"`
+	// Total number of bytes received for this request so far.
+	EncodedDataLength float64 `json:"encodedDataLength"`
+	// Timing information for the given request.
+	Timing *NetworkResourceTiming `json:"timing,omitempty"`
+	// Response source of response from ServiceWorker.
+	ServiceWorkerResponseSource NetworkServiceWorkerResponseSource `json:"serviceWorkerResponseSource,omitempty"`
+	// The time at which the returned response was generated.
+	ResponseTime NetworkTimeSinceEpoch `json:"responseTime,omitempty"`
+	// Cache Storage Cache Name.
+	CacheStorageCacheName string `json:"cacheStorageCacheName,omitempty"`
+	// Protocol used to fetch this request.
+	Protocol string `json:"protocol,omitempty"`
+	// The reason why Chrome uses a specific transport protocol for HTTP semantics.
+	//
+	// This is an experimental part of the protocol.
+	AlternateProtocolUsage NetworkAlternateProtocolUsage `json:"alternateProtocolUsage,omitempty"`
+	// Security state of the request resource.
+	SecurityState SecuritySecurityState `json:"securityState"`
+	// Security details for the request.
+	SecurityDetails *NetworkSecurityDetails `json:"securityDetails,omitempty"`
+	// Indicates whether the request was sent through IP Protection proxies. If set
+	// to true, the request used the IP Protection privacy feature.
+	//
+	// This is an experimental part of the protocol.
+	IsIPProtectionUsed bool `json:"isIpProtectionUsed,omitempty"`
+}
+
+// NetworkWebSocketRequest webSocket request data.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-WebSocketRequest
+type NetworkWebSocketRequest struct {
+	// HTTP request headers.
+	Headers NetworkHeaders `json:"headers"`
+}
+
+// NetworkWebSocketResponse webSocket response data.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-WebSocketResponse
+type NetworkWebSocketResponse struct {
+	// HTTP response status code.
+	Status int64 `json:"status"`
+	// HTTP response status text.
+	StatusText string `json:"statusText"`
+	// HTTP response headers.
+	Headers NetworkHeaders `json:"headers"`
+	// HTTP response headers text.
+	HeadersText string `json:"headersText,omitempty"`
+	// HTTP request headers.
+	RequestHeaders NetworkHeaders `json:"requestHeaders,omitempty"`
+	// HTTP request headers text.
+	RequestHeadersText string `json:"requestHeadersText,omitempty"`
+}
+
+// NetworkWebSocketFrame webSocket message data. This represents an entire
+// WebSocket message, not just a fragmented frame as the name suggests.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-WebSocketFrame
+type NetworkWebSocketFrame struct {
+	// WebSocket message opcode.
+	Opcode float64 `json:"opcode"`
+	// WebSocket message mask.
+	Mask bool `json:"mask"`
+	// WebSocket message payload data. If the opcode is 1, this is a text message
+	// and payloadData is a UTF-8 string. If the opcode isn't 1, then payloadData
+	// is a base64 encoded string representing binary data.
+	PayloadData string `json:"payloadData"`
+}
+
+// NetworkCachedResource information about the cached resource.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-CachedResource
+type NetworkCachedResource struct {
+	// Resource URL. This is the url of the original network request.
+	URL string `json:"url"`
+	// Type of this resource.
+	Type NetworkResourceType `json:"type"`
+	// Cached response data.
+	Response *NetworkResponse `json:"response,omitempty"`
+	// Cached response body size.
+	BodySize float64 `json:"bodySize"`
+}
+
+// NetworkInitiator information about the request initiator.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-Initiator
+type NetworkInitiator struct {
+	// Type of this initiator.
+	//
+	// Allowed values: parser, script, preload, SignedExchange, preflight, other.
+	Type string `json:"type"`
+	// Initiator JavaScript stack trace, set for Script only. Requires the Debugger
+	// domain to be enabled.
+	Stack *RuntimeStackTrace `json:"stack,omitempty"`
+	// Initiator URL, set for Parser type or for Script type (when script is
+	// importing module) or for SignedExchange type.
+	URL string `json:"url,omitempty"`
+	// Initiator line number, set for Parser type or for Script type (when script
+	// is importing module) (0-based).
+	LineNumber float64 `json:"lineNumber,omitempty"`
+	// Initiator column number, set for Parser type or for Script type (when script
+	// is importing module) (0-based).
+	ColumnNumber float64 `json:"columnNumber,omitempty"`
+	// Set if another request triggered this request (e.g. preflight).
+	RequestID NetworkRequestID `json:"requestId,omitempty"`
+}
+
+// NetworkCookiePartitionKey cookiePartitionKey object The representation of the
+// components of the key that are created by the cookiePartitionKey class
+// contained in net/cookies/cookie_partition_key.h.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-CookiePartitionKey
+//
+// This is an experimental part of the protocol.
+type NetworkCookiePartitionKey struct {
+	// The site of the top-level URL the browser was visiting at the start of the
+	// request to the endpoint that set the cookie.
+	TopLevelSite string `json:"topLevelSite"`
+	// Indicates if the cookie has any ancestors that are cross-site to the
+	// topLevelSite.
+	HasCrossSiteAncestor bool `json:"hasCrossSiteAncestor"`
+}
+
+// NetworkCookie cookie object
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-Cookie
+type NetworkCookie struct {
+	// Cookie name.
+	Name string `json:"name"`
+	// Cookie value.
+	Value string `json:"value"`
+	// Cookie domain.
+	Domain string `json:"domain"`
+	// Cookie path.
+	Path string `json:"path"`
+	// Cookie expiration date as the number of seconds since the UNIX epoch.
+	Expires float64 `json:"expires"`
+	// Cookie size.
+	Size int64 `json:"size"`
+	// True if cookie is http-only.
+	HTTPOnly bool `json:"httpOnly"`
+	// True if cookie is secure.
+	Secure bool `json:"secure"`
+	// True in case of session cookie.
+	Session bool `json:"session"`
+	// Cookie SameSite type.
+	SameSite NetworkCookieSameSite `json:"sameSite,omitempty"`
+	// Cookie Priority
+	//
+	// This is an experimental part of the protocol.
+	Priority NetworkCookiePriority `json:"priority"`
+	// True if cookie is SameParty.
+	//
+	// This is an experimental part of the protocol.
+	//
+	// Deprecated: this is deprecated in the protocol.
+	SameParty bool `json:"sameParty"`
+	// Cookie source scheme type.
+	//
+	// This is an experimental part of the protocol.
+	SourceScheme NetworkCookieSourceScheme `json:"sourceScheme"`
+	// Cookie source port. Valid values are {-1, [1, 65535]}, -1 indicates an
+	// unspecified port. An unspecified port value allows protocol clients to
+	// emulate legacy cookie scope for the port. This is a temporary ability and it
+	// will be removed in the future.
+	//
+	// This is an experimental part of the protocol.
+	SourcePort int64 `json:"sourcePort"`
+	// Cookie partition key.
+	//
+	// This is an experimental part of the protocol.
+	PartitionKey *NetworkCookiePartitionKey `json:"partitionKey,omitempty"`
+	// True if cookie partition key is opaque.
+	//
+	// This is an experimental part of the protocol.
+	PartitionKeyOpaque bool `json:"partitionKeyOpaque,omitempty"`
+}
+
+// NetworkSetCookieBlockedReason types of reasons why a cookie may not be stored
+// from a response.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-SetCookieBlockedReason
+//
+// This is an experimental part of the protocol.
+type NetworkSetCookieBlockedReason string
+
+// Values of NetworkSetCookieBlockedReason.
+const (
+	NetworkSetCookieBlockedReasonSecureOnly                               NetworkSetCookieBlockedReason = "SecureOnly"
+	NetworkSetCookieBlockedReasonSameSiteStrict                           NetworkSetCookieBlockedReason = "SameSiteStrict"
+	NetworkSetCookieBlockedReasonSameSiteLax                              NetworkSetCookieBlockedReason = "SameSiteLax"
+	NetworkSetCookieBlockedReasonSameSiteUnspecifiedTreatedAsLax          NetworkSetCookieBlockedReason = "SameSiteUnspecifiedTreatedAsLax"
+	NetworkSetCookieBlockedReasonSameSiteNoneInsecure                     NetworkSetCookieBlockedReason = "SameSiteNoneInsecure"
+	NetworkSetCookieBlockedReasonUserPreferences                          NetworkSetCookieBlockedReason = "UserPreferences"
+	NetworkSetCookieBlockedReasonThirdPartyPhaseout                       NetworkSetCookieBlockedReason = "ThirdPartyPhaseout"
+	NetworkSetCookieBlockedReasonThirdPartyBlockedInFirstPartySet         NetworkSetCookieBlockedReason = "ThirdPartyBlockedInFirstPartySet"
+	NetworkSetCookieBlockedReasonSyntaxError                              NetworkSetCookieBlockedReason = "SyntaxError"
+	NetworkSetCookieBlockedReasonSchemeNotSupported                       NetworkSetCookieBlockedReason = "SchemeNotSupported"
+	NetworkSetCookieBlockedReasonOverwriteSecure                          NetworkSetCookieBlockedReason = "OverwriteSecure"
+	NetworkSetCookieBlockedReasonInvalidDomain                            NetworkSetCookieBlockedReason = "InvalidDomain"
+	NetworkSetCookieBlockedReasonInvalidPrefix                            NetworkSetCookieBlockedReason = "InvalidPrefix"
+	NetworkSetCookieBlockedReasonUnknownError                             NetworkSetCookieBlockedReason = "UnknownError"
+	NetworkSetCookieBlockedReasonSchemefulSameSiteStrict                  NetworkSetCookieBlockedReason = "SchemefulSameSiteStrict"
+	NetworkSetCookieBlockedReasonSchemefulSameSiteLax                     NetworkSetCookieBlockedReason = "SchemefulSameSiteLax"
+	NetworkSetCookieBlockedReasonSchemefulSameSiteUnspecifiedTreatedAsLax NetworkSetCookieBlockedReason = "SchemefulSameSiteUnspecifiedTreatedAsLax"
+	NetworkSetCookieBlockedReasonSamePartyFromCrossPartyContext           NetworkSetCookieBlockedReason = "SamePartyFromCrossPartyContext"
+	NetworkSetCookieBlockedReasonSamePartyConflictsWithOtherAttributes    NetworkSetCookieBlockedReason = "SamePartyConflictsWithOtherAttributes"
+	NetworkSetCookieBlockedReasonNameValuePairExceedsMaxSize              NetworkSetCookieBlockedReason = "NameValuePairExceedsMaxSize"
+	NetworkSetCookieBlockedReasonDisallowedCharacter                      NetworkSetCookieBlockedReason = "DisallowedCharacter"
+	NetworkSetCookieBlockedReasonNoCookieContent                          NetworkSetCookieBlockedReason = "NoCookieContent"
+)
+
+// NetworkCookieBlockedReason types of reasons why a cookie may not be sent with
+// a request.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-CookieBlockedReason
+//
+// This is an experimental part of the protocol.
+type NetworkCookieBlockedReason string
+
+// Values of NetworkCookieBlockedReason.
+const (
+	NetworkCookieBlockedReasonSecureOnly                               NetworkCookieBlockedReason = "SecureOnly"
+	NetworkCookieBlockedReasonNotOnPath                                NetworkCookieBlockedReason = "NotOnPath"
+	NetworkCookieBlockedReasonDomainMismatch                           NetworkCookieBlockedReason = "DomainMismatch"
+	NetworkCookieBlockedReasonSameSiteStrict                           NetworkCookieBlockedReason = "SameSiteStrict"
+	NetworkCookieBlockedReasonSameSiteLax                              NetworkCookieBlockedReason = "SameSiteLax"
+	NetworkCookieBlockedReasonSameSiteUnspecifiedTreatedAsLax          NetworkCookieBlockedReason = "SameSiteUnspecifiedTreatedAsLax"
+	NetworkCookieBlockedReasonSameSiteNoneInsecure                     NetworkCookieBlockedReason = "SameSiteNoneInsecure"
+	NetworkCookieBlockedReasonUserPreferences                          NetworkCookieBlockedReason = "UserPreferences"
+	NetworkCookieBlockedReasonThirdPartyPhaseout                       NetworkCookieBlockedReason = "ThirdPartyPhaseout"
+	NetworkCookieBlockedReasonThirdPartyBlockedInFirstPartySet         NetworkCookieBlockedReason = "ThirdPartyBlockedInFirstPartySet"
+	NetworkCookieBlockedReasonUnknownError                             NetworkCookieBlockedReason = "UnknownError"
+	NetworkCookieBlockedReasonSchemefulSameSiteStrict                  NetworkCookieBlockedReason = "SchemefulSameSiteStrict"
+	NetworkCookieBlockedReasonSchemefulSameSiteLax                     NetworkCookieBlockedReason = "SchemefulSameSiteLax"
+	NetworkCookieBlockedReasonSchemefulSameSiteUnspecifiedTreatedAsLax NetworkCookieBlockedReason = "SchemefulSameSiteUnspecifiedTreatedAsLax"
+	NetworkCookieBlockedReasonSamePartyFromCrossPartyContext           NetworkCookieBlockedReason = "SamePartyFromCrossPartyContext"
+	NetworkCookieBlockedReasonNameValuePairExceedsMaxSize              NetworkCookieBlockedReason = "NameValuePairExceedsMaxSize"
+	NetworkCookieBlockedReasonPortMismatch                             NetworkCookieBlockedReason = "PortMismatch"
+	NetworkCookieBlockedReasonSchemeMismatch                           NetworkCookieBlockedReason = "SchemeMismatch"
+	NetworkCookieBlockedReasonAnonymousContext                         NetworkCookieBlockedReason = "AnonymousContext"
+)
+
+// NetworkCookieExemptionReason types of reasons why a cookie should have been
+// blocked by 3PCD but is exempted for the request.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-CookieExemptionReason
+//
+// This is an experimental part of the protocol.
+type NetworkCookieExemptionReason string
+
+// Values of NetworkCookieExemptionReason.
+const (
+	NetworkCookieExemptionReasonNone                         NetworkCookieExemptionReason = "None"
+	NetworkCookieExemptionReasonUserSetting                  NetworkCookieExemptionReason = "UserSetting"
+	NetworkCookieExemptionReasonTPCDMetadata                 NetworkCookieExemptionReason = "TPCDMetadata"
+	NetworkCookieExemptionReasonTPCDDeprecationTrial         NetworkCookieExemptionReason = "TPCDDeprecationTrial"
+	NetworkCookieExemptionReasonTopLevelTPCDDeprecationTrial NetworkCookieExemptionReason = "TopLevelTPCDDeprecationTrial"
+	NetworkCookieExemptionReasonTPCDHeuristics               NetworkCookieExemptionReason = "TPCDHeuristics"
+	NetworkCookieExemptionReasonEnterprisePolicy             NetworkCookieExemptionReason = "EnterprisePolicy"
+	NetworkCookieExemptionReasonStorageAccess                NetworkCookieExemptionReason = "StorageAccess"
+	NetworkCookieExemptionReasonTopLevelStorageAccess        NetworkCookieExemptionReason = "TopLevelStorageAccess"
+	NetworkCookieExemptionReasonScheme                       NetworkCookieExemptionReason = "Scheme"
+	NetworkCookieExemptionReasonSameSiteNoneCookiesInSandbox NetworkCookieExemptionReason = "SameSiteNoneCookiesInSandbox"
+)
+
+// NetworkBlockedSetCookieWithReason a cookie which was not stored from a
+// response with the corresponding reason.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-BlockedSetCookieWithReason
+//
+// This is an experimental part of the protocol.
+type NetworkBlockedSetCookieWithReason struct {
+	// The reason(s) this cookie was blocked.
+	BlockedReasons []NetworkSetCookieBlockedReason `json:"blockedReasons"`
+	// The string representing this individual cookie as it would appear in the
+	// header. This is not the entire "cookie" or "set-cookie" header which could
+	// have multiple cookies.
+	CookieLine string `json:"cookieLine"`
+	// The cookie object which represents the cookie which was not stored. It is
+	// optional because sometimes complete cookie information is not available,
+	// such as in the case of parsing errors.
+	Cookie *NetworkCookie `json:"cookie,omitempty"`
+}
+
+// NetworkExemptedSetCookieWithReason a cookie should have been blocked by 3PCD
+// but is exempted and stored from a response with the corresponding reason. A
+// cookie could only have at most one exemption reason.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-ExemptedSetCookieWithReason
+//
+// This is an experimental part of the protocol.
+type NetworkExemptedSetCookieWithReason struct {
+	// The reason the cookie was exempted.
+	ExemptionReason NetworkCookieExemptionReason `json:"exemptionReason"`
+	// The string representing this individual cookie as it would appear in the
+	// header.
+	CookieLine string `json:"cookieLine"`
+	// The cookie object representing the cookie.
+	Cookie NetworkCookie `json:"cookie"`
+}
+
+// NetworkAssociatedCookie a cookie associated with the request which may or may
+// not be sent with it. Includes the cookies itself and reasons for blocking or
+// exemption.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-AssociatedCookie
+//
+// This is an experimental part of the protocol.
+type NetworkAssociatedCookie struct {
+	// The cookie object representing the cookie which was not sent.
+	Cookie NetworkCookie `json:"cookie"`
+	// The reason(s) the cookie was blocked. If empty means the cookie is included.
+	BlockedReasons []NetworkCookieBlockedReason `json:"blockedReasons"`
+	// The reason the cookie should have been blocked by 3PCD but is exempted. A
+	// cookie could only have at most one exemption reason.
+	ExemptionReason NetworkCookieExemptionReason `json:"exemptionReason,omitempty"`
+}
+
+// NetworkCookieParam cookie parameter object
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-CookieParam
+type NetworkCookieParam struct {
+	// Cookie name.
+	Name string `json:"name"`
+	// Cookie value.
+	Value string `json:"value"`
+	// The request-URI to associate with the setting of the cookie. This value can
+	// affect the default domain, path, source port, and source scheme values of
+	// the created cookie.
+	URL string `json:"url,omitempty"`
+	// Cookie domain.
+	Domain string `json:"domain,omitempty"`
+	// Cookie path.
+	Path string `json:"path,omitempty"`
+	// True if cookie is secure.
+	Secure bool `json:"secure,omitempty"`
+	// True if cookie is http-only.
+	HTTPOnly bool `json:"httpOnly,omitempty"`
+	// Cookie SameSite type.
+	SameSite NetworkCookieSameSite `json:"sameSite,omitempty"`
+	// Cookie expiration date, session cookie if not set
+	Expires NetworkTimeSinceEpoch `json:"expires,omitempty"`
+	// Cookie Priority.
+	//
+	// This is an experimental part of the protocol.
+	Priority NetworkCookiePriority `json:"priority,omitempty"`
+	// True if cookie is SameParty.
+	//
+	// This is an experimental part of the protocol.
+	SameParty bool `json:"sameParty,omitempty"`
+	// Cookie source scheme type.
+	//
+	// This is an experimental part of the protocol.
+	SourceScheme NetworkCookieSourceScheme `json:"sourceScheme,omitempty"`
+	// Cookie source port. Valid values are {-1, [1, 65535]}, -1 indicates an
+	// unspecified port. An unspecified port value allows protocol clients to
+	// emulate legacy cookie scope for the port. This is a temporary ability and it
+	// will be removed in the future.
+	//
+	// This is an experimental part of the protocol.
+	SourcePort int64 `json:"sourcePort,omitempty"`
+	// Cookie partition key. If not set, the cookie will be set as not partitioned.
+	//
+	// This is an experimental part of the protocol.
+	PartitionKey *NetworkCookiePartitionKey `json:"partitionKey,omitempty"`
+}
+
+// NetworkAuthChallenge authorization challenge for HTTP status code 401 or 407.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-AuthChallenge
+//
+// This is an experimental part of the protocol.
+type NetworkAuthChallenge struct {
+	// Source of the authentication challenge.
+	//
+	// Allowed values: Server, Proxy.
+	Source string `json:"source,omitempty"`
+	// Origin of the challenger.
+	Origin string `json:"origin"`
+	// The authentication scheme used, such as basic or digest
+	Scheme string `json:"scheme"`
+	// The realm of the challenge. May be empty.
+	Realm string `json:"realm"`
+}
+
+// NetworkAuthChallengeResponse response to an AuthChallenge.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-AuthChallengeResponse
+//
+// This is an experimental part of the protocol.
+type NetworkAuthChallengeResponse struct {
+	// The decision on what to do in response to the authorization challenge.
+	// Default means deferring to the default behavior of the net stack, which will
+	// likely either the Cancel authentication or display a popup dialog box.
+	//
+	// Allowed values: Default, CancelAuth, ProvideCredentials.
+	Response string `json:"response"`
+	// The username to provide, possibly empty. Should only be set if response is
+	// ProvideCredentials.
+	Username string `json:"username,omitempty"`
+	// The password to provide, possibly empty. Should only be set if response is
+	// ProvideCredentials.
+	Password string `json:"password,omitempty"`
+}
+
+// NetworkInterceptionStage stages of the interception to begin intercepting.
+// Request will intercept before the request is sent. Response will intercept
+// after the response is received.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-InterceptionStage
+//
+// This is an experimental part of the protocol.
+type NetworkInterceptionStage string
+
+// Values of NetworkInterceptionStage.
+const (
+	NetworkInterceptionStageRequest         NetworkInterceptionStage = "Request"
+	NetworkInterceptionStageHeadersReceived NetworkInterceptionStage = "HeadersReceived"
+)
+
+// NetworkRequestPattern request pattern for interception.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-RequestPattern
+//
+// This is an experimental part of the protocol.
+type NetworkRequestPattern struct {
+	// Wildcards (`'*'` -> zero or more, `'?'` -> exactly one) are allowed. Escape
+	// character is backslash. Omitting is equivalent to `"*"`.
+	URLPattern string `json:"urlPattern,omitempty"`
+	// If set, only requests for matching resource types will be intercepted.
+	ResourceType NetworkResourceType `json:"resourceType,omitempty"`
+	// Stage at which to begin intercepting requests. Default is Request.
+	InterceptionStage NetworkInterceptionStage `json:"interceptionStage,omitempty"`
+}
+
+// NetworkSignedExchangeSignature information about a signed exchange signature.
+// https://wicg.github.io/webpackage/draft-yasskin-httpbis-origin-signed-exchanges-impl.html#rfc.section.3.1
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-SignedExchangeSignature
+//
+// This is an experimental part of the protocol.
+type NetworkSignedExchangeSignature struct {
+	// Signed exchange signature label.
+	Label string `json:"label"`
+	// The hex string of signed exchange signature.
+	Signature string `json:"signature"`
+	// Signed exchange signature integrity.
+	Integrity string `json:"integrity"`
+	// Signed exchange signature cert Url.
+	CertURL string `json:"certUrl,omitempty"`
+	// The hex string of signed exchange signature cert sha256.
+	CertSha256 string `json:"certSha256,omitempty"`
+	// Signed exchange signature validity Url.
+	ValidityURL string `json:"validityUrl"`
+	// Signed exchange signature date.
+	Date int64 `json:"date"`
+	// Signed exchange signature expires.
+	Expires int64 `json:"expires"`
+	// The encoded certificates.
+	Certificates []string `json:"certificates,omitempty"`
+}
+
+// NetworkSignedExchangeHeader information about a signed exchange header.
+// https://wicg.github.io/webpackage/draft-yasskin-httpbis-origin-signed-exchanges-impl.html#cbor-representation
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-SignedExchangeHeader
+//
+// This is an experimental part of the protocol.
+type NetworkSignedExchangeHeader struct {
+	// Signed exchange request URL.
+	RequestURL string `json:"requestUrl"`
+	// Signed exchange response code.
+	ResponseCode int64 `json:"responseCode"`
+	// Signed exchange response headers.
+	ResponseHeaders NetworkHeaders `json:"responseHeaders"`
+	// Signed exchange response signature.
+	Signatures []NetworkSignedExchangeSignature `json:"signatures"`
+	// Signed exchange header integrity hash in the form of
+	// `sha256-<base64-hash-value>`.
+	HeaderIntegrity string `json:"headerIntegrity"`
+}
+
+// NetworkSignedExchangeErrorField field type for a signed exchange related
+// error.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-SignedExchangeErrorField
+//
+// This is an experimental part of the protocol.
+type NetworkSignedExchangeErrorField string
+
+// Values of NetworkSignedExchangeErrorField.
+const (
+	NetworkSignedExchangeErrorFieldSignatureSig         NetworkSignedExchangeErrorField = "signatureSig"
+	NetworkSignedExchangeErrorFieldSignatureIntegrity   NetworkSignedExchangeErrorField = "signatureIntegrity"
+	NetworkSignedExchangeErrorFieldSignatureCertURL     NetworkSignedExchangeErrorField = "signatureCertUrl"
+	NetworkSignedExchangeErrorFieldSignatureCertSha256  NetworkSignedExchangeErrorField = "signatureCertSha256"
+	NetworkSignedExchangeErrorFieldSignatureValidityURL NetworkSignedExchangeErrorField = "signatureValidityUrl"
+	NetworkSignedExchangeErrorFieldSignatureTimestamps  NetworkSignedExchangeErrorField = "signatureTimestamps"
+)
+
+// NetworkSignedExchangeError information about a signed exchange response.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-SignedExchangeError
+//
+// This is an experimental part of the protocol.
+type NetworkSignedExchangeError struct {
+	// Error message.
+	Message string `json:"message"`
+	// The index of the signature which caused the error.
+	SignatureIndex int64 `json:"signatureIndex,omitempty"`
+	// The field which caused the error.
+	ErrorField NetworkSignedExchangeErrorField `json:"errorField,omitempty"`
+}
+
+// NetworkSignedExchangeInfo information about a signed exchange response.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-SignedExchangeInfo
+//
+// This is an experimental part of the protocol.
+type NetworkSignedExchangeInfo struct {
+	// The outer response of signed HTTP exchange which was received from network.
+	OuterResponse NetworkResponse `json:"outerResponse"`
+	// Whether network response for the signed exchange was accompanied by extra
+	// headers.
+	HasExtraInfo bool `json:"hasExtraInfo"`
+	// Information about the signed exchange header.
+	Header *NetworkSignedExchangeHeader `json:"header,omitempty"`
+	// Security details for the signed exchange header.
+	SecurityDetails *NetworkSecurityDetails `json:"securityDetails,omitempty"`
+	// Errors occurred while handling the signed exchange.
+	Errors []NetworkSignedExchangeError `json:"errors,omitempty"`
+}
+
+// NetworkContentEncoding list of content encodings supported by the backend.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-ContentEncoding
+//
+// This is an experimental part of the protocol.
+type NetworkContentEncoding string
+
+// Values of NetworkContentEncoding.
+const (
+	NetworkContentEncodingDeflate NetworkContentEncoding = "deflate"
+	NetworkContentEncodingGzip    NetworkContentEncoding = "gzip"
+	NetworkContentEncodingBr      NetworkContentEncoding = "br"
+	NetworkContentEncodingZstd    NetworkContentEncoding = "zstd"
+)
+
+// NetworkDirectSocketDnsQueryType is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-DirectSocketDnsQueryType
+//
+// This is an experimental part of the protocol.
+type NetworkDirectSocketDnsQueryType string
+
+// Values of NetworkDirectSocketDnsQueryType.
+const (
+	NetworkDirectSocketDnsQueryTypeIpv4 NetworkDirectSocketDnsQueryType = "ipv4"
+	NetworkDirectSocketDnsQueryTypeIpv6 NetworkDirectSocketDnsQueryType = "ipv6"
+)
+
+// NetworkDirectTCPSocketOptions is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-DirectTCPSocketOptions
+//
+// This is an experimental part of the protocol.
+type NetworkDirectTCPSocketOptions struct {
+	// TCP_NODELAY option
+	NoDelay bool `json:"noDelay"`
+	// Expected to be unsigned integer.
+	KeepAliveDelay float64 `json:"keepAliveDelay,omitempty"`
+	// Expected to be unsigned integer.
+	SendBufferSize float64 `json:"sendBufferSize,omitempty"`
+	// Expected to be unsigned integer.
+	ReceiveBufferSize float64                         `json:"receiveBufferSize,omitempty"`
+	DnsQueryType      NetworkDirectSocketDnsQueryType `json:"dnsQueryType,omitempty"`
+}
+
+// NetworkDirectUDPSocketOptions is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-DirectUDPSocketOptions
+//
+// This is an experimental part of the protocol.
+type NetworkDirectUDPSocketOptions struct {
+	RemoteAddr string `json:"remoteAddr,omitempty"`
+	// Unsigned int 16.
+	RemotePort int64  `json:"remotePort,omitempty"`
+	LocalAddr  string `json:"localAddr,omitempty"`
+	// Unsigned int 16.
+	LocalPort    int64                           `json:"localPort,omitempty"`
+	DnsQueryType NetworkDirectSocketDnsQueryType `json:"dnsQueryType,omitempty"`
+	// Expected to be unsigned integer.
+	SendBufferSize float64 `json:"sendBufferSize,omitempty"`
+	// Expected to be unsigned integer.
+	ReceiveBufferSize float64 `json:"receiveBufferSize,omitempty"`
+}
+
+// NetworkDirectUDPMessage is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-DirectUDPMessage
+//
+// This is an experimental part of the protocol.
+type NetworkDirectUDPMessage struct {
+	Data string `json:"data"`
+	// Null for connected mode.
+	RemoteAddr string `json:"remoteAddr,omitempty"`
+	// Null for connected mode. Expected to be unsigned integer.
+	RemotePort int64 `json:"remotePort,omitempty"`
+}
+
+// NetworkPrivateNetworkRequestPolicy is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-PrivateNetworkRequestPolicy
+//
+// This is an experimental part of the protocol.
+type NetworkPrivateNetworkRequestPolicy string
+
+// Values of NetworkPrivateNetworkRequestPolicy.
+const (
+	NetworkPrivateNetworkRequestPolicyAllow                          NetworkPrivateNetworkRequestPolicy = "Allow"
+	NetworkPrivateNetworkRequestPolicyBlockFromInsecureToMorePrivate NetworkPrivateNetworkRequestPolicy = "BlockFromInsecureToMorePrivate"
+	NetworkPrivateNetworkRequestPolicyWarnFromInsecureToMorePrivate  NetworkPrivateNetworkRequestPolicy = "WarnFromInsecureToMorePrivate"
+	NetworkPrivateNetworkRequestPolicyPreflightBlock                 NetworkPrivateNetworkRequestPolicy = "PreflightBlock"
+	NetworkPrivateNetworkRequestPolicyPreflightWarn                  NetworkPrivateNetworkRequestPolicy = "PreflightWarn"
+	NetworkPrivateNetworkRequestPolicyPermissionBlock                NetworkPrivateNetworkRequestPolicy = "PermissionBlock"
+	NetworkPrivateNetworkRequestPolicyPermissionWarn                 NetworkPrivateNetworkRequestPolicy = "PermissionWarn"
+)
+
+// NetworkIPAddressSpace is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-IPAddressSpace
+//
+// This is an experimental part of the protocol.
+type NetworkIPAddressSpace string
+
+// Values of NetworkIPAddressSpace.
+const (
+	NetworkIPAddressSpaceLoopback NetworkIPAddressSpace = "Loopback"
+	NetworkIPAddressSpaceLocal    NetworkIPAddressSpace = "Local"
+	NetworkIPAddressSpacePublic   NetworkIPAddressSpace = "Public"
+	NetworkIPAddressSpaceUnknown  NetworkIPAddressSpace = "Unknown"
+)
+
+// NetworkConnectTiming is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-ConnectTiming
+//
+// This is an experimental part of the protocol.
+type NetworkConnectTiming struct {
+	// Timing's requestTime is a baseline in seconds, while the other numbers are
+	// ticks in milliseconds relatively to this requestTime. Matches
+	// ResourceTiming's requestTime for the same request (but not for redirected
+	// requests).
+	RequestTime float64 `json:"requestTime"`
+}
+
+// NetworkClientSecurityState is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-ClientSecurityState
+//
+// This is an experimental part of the protocol.
+type NetworkClientSecurityState struct {
+	InitiatorIsSecureContext    bool                               `json:"initiatorIsSecureContext"`
+	InitiatorIPAddressSpace     NetworkIPAddressSpace              `json:"initiatorIPAddressSpace"`
+	PrivateNetworkRequestPolicy NetworkPrivateNetworkRequestPolicy `json:"privateNetworkRequestPolicy"`
+}
+
+// NetworkCrossOriginOpenerPolicyValue is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-CrossOriginOpenerPolicyValue
+//
+// This is an experimental part of the protocol.
+type NetworkCrossOriginOpenerPolicyValue string
+
+// Values of NetworkCrossOriginOpenerPolicyValue.
+const (
+	NetworkCrossOriginOpenerPolicyValueSameOrigin                 NetworkCrossOriginOpenerPolicyValue = "SameOrigin"
+	NetworkCrossOriginOpenerPolicyValueSameOriginAllowPopups      NetworkCrossOriginOpenerPolicyValue = "SameOriginAllowPopups"
+	NetworkCrossOriginOpenerPolicyValueRestrictProperties         NetworkCrossOriginOpenerPolicyValue = "RestrictProperties"
+	NetworkCrossOriginOpenerPolicyValueUnsafeNone                 NetworkCrossOriginOpenerPolicyValue = "UnsafeNone"
+	NetworkCrossOriginOpenerPolicyValueSameOriginPlusCoep         NetworkCrossOriginOpenerPolicyValue = "SameOriginPlusCoep"
+	NetworkCrossOriginOpenerPolicyValueRestrictPropertiesPlusCoep NetworkCrossOriginOpenerPolicyValue = "RestrictPropertiesPlusCoep"
+	NetworkCrossOriginOpenerPolicyValueNoopenerAllowPopups        NetworkCrossOriginOpenerPolicyValue = "NoopenerAllowPopups"
+)
+
+// NetworkCrossOriginOpenerPolicyStatus is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-CrossOriginOpenerPolicyStatus
+//
+// This is an experimental part of the protocol.
+type NetworkCrossOriginOpenerPolicyStatus struct {
+	Value                       NetworkCrossOriginOpenerPolicyValue `json:"value"`
+	ReportOnlyValue             NetworkCrossOriginOpenerPolicyValue `json:"reportOnlyValue"`
+	ReportingEndpoint           string                              `json:"reportingEndpoint,omitempty"`
+	ReportOnlyReportingEndpoint string                              `json:"reportOnlyReportingEndpoint,omitempty"`
+}
+
+// NetworkCrossOriginEmbedderPolicyValue is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-CrossOriginEmbedderPolicyValue
+//
+// This is an experimental part of the protocol.
+type NetworkCrossOriginEmbedderPolicyValue string
+
+// Values of NetworkCrossOriginEmbedderPolicyValue.
+const (
+	NetworkCrossOriginEmbedderPolicyValueNone           NetworkCrossOriginEmbedderPolicyValue = "None"
+	NetworkCrossOriginEmbedderPolicyValueCredentialless NetworkCrossOriginEmbedderPolicyValue = "Credentialless"
+	NetworkCrossOriginEmbedderPolicyValueRequireCorp    NetworkCrossOriginEmbedderPolicyValue = "RequireCorp"
+)
+
+// NetworkCrossOriginEmbedderPolicyStatus is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-CrossOriginEmbedderPolicyStatus
+//
+// This is an experimental part of the protocol.
+type NetworkCrossOriginEmbedderPolicyStatus struct {
+	Value                       NetworkCrossOriginEmbedderPolicyValue `json:"value"`
+	ReportOnlyValue             NetworkCrossOriginEmbedderPolicyValue `json:"reportOnlyValue"`
+	ReportingEndpoint           string                                `json:"reportingEndpoint,omitempty"`
+	ReportOnlyReportingEndpoint string                                `json:"reportOnlyReportingEndpoint,omitempty"`
+}
+
+// NetworkContentSecurityPolicySource is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-ContentSecurityPolicySource
+//
+// This is an experimental part of the protocol.
+type NetworkContentSecurityPolicySource string
+
+// Values of NetworkContentSecurityPolicySource.
+const (
+	NetworkContentSecurityPolicySourceHTTP NetworkContentSecurityPolicySource = "HTTP"
+	NetworkContentSecurityPolicySourceMeta NetworkContentSecurityPolicySource = "Meta"
+)
+
+// NetworkContentSecurityPolicyStatus is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-ContentSecurityPolicyStatus
+//
+// This is an experimental part of the protocol.
+type NetworkContentSecurityPolicyStatus struct {
+	EffectiveDirectives string                             `json:"effectiveDirectives"`
+	IsEnforced          bool                               `json:"isEnforced"`
+	Source              NetworkContentSecurityPolicySource `json:"source"`
+}
+
+// NetworkSecurityIsolationStatus is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-SecurityIsolationStatus
+//
+// This is an experimental part of the protocol.
+type NetworkSecurityIsolationStatus struct {
+	Coop *NetworkCrossOriginOpenerPolicyStatus   `json:"coop,omitempty"`
+	Coep *NetworkCrossOriginEmbedderPolicyStatus `json:"coep,omitempty"`
+	Csp  []NetworkContentSecurityPolicyStatus    `json:"csp,omitempty"`
+}
+
+// NetworkReportStatus the status of a Reporting API report.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-ReportStatus
+//
+// This is an experimental part of the protocol.
+type NetworkReportStatus string
+
+// Values of NetworkReportStatus.
+const (
+	NetworkReportStatusQueued           NetworkReportStatus = "Queued"
+	NetworkReportStatusPending          NetworkReportStatus = "Pending"
+	NetworkReportStatusMarkedForRemoval NetworkReportStatus = "MarkedForRemoval"
+	NetworkReportStatusSuccess          NetworkReportStatus = "Success"
+)
+
+// NetworkReportID is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-ReportId
+//
+// This is an experimental part of the protocol.
+type NetworkReportID string
+
+// NetworkReportingAPIReport an object representing a report generated by the
+// Reporting API.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-ReportingApiReport
+//
+// This is an experimental part of the protocol.
+type NetworkReportingAPIReport struct {
+	ID NetworkReportID `json:"id"`
+	// The URL of the document that triggered the report.
+	InitiatorURL string `json:"initiatorUrl"`
+	// The name of the endpoint group that should be used to deliver the report.
+	Destination string `json:"destination"`
+	// The type of the report (specifies the set of data that is contained in the
+	// report body).
+	Type string `json:"type"`
+	// When the report was generated.
+	Timestamp NetworkTimeSinceEpoch `json:"timestamp"`
+	// How many uploads deep the related request was.
+	Depth int64 `json:"depth"`
+	// The number of delivery attempts made so far, not including an active
+	// attempt.
+	CompletedAttempts int64                  `json:"completedAttempts"`
+	Body              map[string]interface{} `json:"body"`
+	Status            NetworkReportStatus    `json:"status"`
+}
+
+// NetworkReportingAPIEndpoint is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-ReportingApiEndpoint
+//
+// This is an experimental part of the protocol.
+type NetworkReportingAPIEndpoint struct {
+	// The URL of the endpoint to which reports may be delivered.
+	URL string `json:"url"`
+	// Name of the endpoint group.
+	GroupName string `json:"groupName"`
+}
+
+// NetworkLoadNetworkResourcePageResult an object providing the result of a
+// network resource load.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-LoadNetworkResourcePageResult
+//
+// This is an experimental part of the protocol.
+type NetworkLoadNetworkResourcePageResult struct {
+	Success bool `json:"success"`
+	// Optional values used for error reporting.
+	NetError       float64 `json:"netError,omitempty"`
+	NetErrorName   string  `json:"netErrorName,omitempty"`
+	HTTPStatusCode float64 `json:"httpStatusCode,omitempty"`
+	// If successful, one of the following two fields holds the result.
+	Stream IOStreamHandle `json:"stream,omitempty"`
+	// Response headers.
+	Headers NetworkHeaders `json:"headers,omitempty"`
+}
+
+// NetworkLoadNetworkResourceOptions an options object that may be extended
+// later to better support CORS, CORB and streaming.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-LoadNetworkResourceOptions
+//
+// This is an experimental part of the protocol.
+type NetworkLoadNetworkResourceOptions struct {
+	DisableCache       bool `json:"disableCache"`
+	IncludeCredentials bool `json:"includeCredentials"`
+}
@@ -0,0 +1,323 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package types
+
+// OverlaySourceOrderConfig configuration data for drawing the source order of
+// an elements children.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Overlay#type-SourceOrderConfig
+type OverlaySourceOrderConfig struct {
+	// the color to outline the given element in.
+	ParentOutlineColor DOMRGBA `json:"parentOutlineColor"`
+	// the color to outline the child elements in.
+	ChildOutlineColor DOMRGBA `json:"childOutlineColor"`
+}
+
+// OverlayGridHighlightConfig configuration data for the highlighting of Grid
+// elements.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Overlay#type-GridHighlightConfig
+type OverlayGridHighlightConfig struct {
+	// Whether the extension lines from grid cells to the rulers should be shown
+	// (default: false).
+	ShowGridExtensionLines bool `json:"showGridExtensionLines,omitempty"`
+	// Show Positive line number labels (default: false).
+	ShowPositiveLineNumbers bool `json:"showPositiveLineNumbers,omitempty"`
+	// Show Negative line number labels (default: false).
+	ShowNegativeLineNumbers bool `json:"showNegativeLineNumbers,omitempty"`
+	// Show area name labels (default: false).
+	ShowAreaNames bool `json:"showAreaNames,omitempty"`
+	// Show line name labels (default: false).
+	ShowLineNames bool `json:"showLineNames,omitempty"`
+	// Show track size labels (default: false).
+	ShowTrackSizes bool `json:"showTrackSizes,omitempty"`
+	// The grid container border highlight color (default: transparent).
+	GridBorderColor *DOMRGBA `json:"gridBorderColor,omitempty"`
+	// The cell border color (default: transparent). Deprecated, please use
+	// rowLineColor and columnLineColor instead.
+	//
+	// Deprecated: this is deprecated in the protocol.
+	CellBorderColor *DOMRGBA `json:"cellBorderColor,omitempty"`
+	// The row line color (default: transparent).
+	RowLineColor *DOMRGBA `json:"rowLineColor,omitempty"`
+	// The column line color (default: transparent).
+	ColumnLineColor *DOMRGBA `json:"columnLineColor,omitempty"`
+	// Whether the grid border is dashed (default: false).
+	GridBorderDash bool `json:"gridBorderDash,omitempty"`
+	// Whether the cell border is dashed (default: false). Deprecated, please us
+	// rowLineDash and columnLineDash instead.
+	//
+	// Deprecated: this is deprecated in the protocol.
+	CellBorderDash bool `json:"cellBorderDash,omitempty"`
+	// Whether row lines are dashed (default: false).
+	RowLineDash bool `json:"rowLineDash,omitempty"`
+	// Whether column lines are dashed (default: false).
+	ColumnLineDash bool `json:"columnLineDash,omitempty"`
+	// The row gap highlight fill color (default: transparent).
+	RowGapColor *DOMRGBA `json:"rowGapColor,omitempty"`
+	// The row gap hatching fill color (default: transparent).
+	RowHatchColor *DOMRGBA `json:"rowHatchColor,omitempty"`
+	// The column gap highlight fill color (default: transparent).
+	ColumnGapColor *DOMRGBA `json:"columnGapColor,omitempty"`
+	// The column gap hatching fill color (default: transparent).
+	ColumnHatchColor *DOMRGBA `json:"columnHatchColor,omitempty"`
+	// The named grid areas border color (Default: transparent).
+	AreaBorderColor *DOMRGBA `json:"areaBorderColor,omitempty"`
+	// The grid container background color (Default: transparent).
+	GridBackgroundColor *DOMRGBA `json:"gridBackgroundColor,omitempty"`
+}
+
+// OverlayFlexContainerHighlightConfig configuration data for the highlighting
+// of Flex container elements.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Overlay#type-FlexContainerHighlightConfig
+type OverlayFlexContainerHighlightConfig struct {
+	// The style of the container border
+	ContainerBorder *OverlayLineStyle `json:"containerBorder,omitempty"`
+	// The style of the separator between lines
+	LineSeparator *OverlayLineStyle `json:"lineSeparator,omitempty"`
+	// The style of the separator between items
+	ItemSeparator *OverlayLineStyle `json:"itemSeparator,omitempty"`
+	// Style of content-distribution space on the main axis (justify-content).
+	MainDistributedSpace *OverlayBoxStyle `json:"mainDistributedSpace,omitempty"`
+	// Style of content-distribution space on the cross axis (align-content).
+	CrossDistributedSpace *OverlayBoxStyle `json:"crossDistributedSpace,omitempty"`
+	// Style of empty space caused by row gaps (gap/row-gap).
+	RowGapSpace *OverlayBoxStyle `json:"rowGapSpace,omitempty"`
+	// Style of empty space caused by columns gaps (gap/column-gap).
+	ColumnGapSpace *OverlayBoxStyle `json:"columnGapSpace,omitempty"`
+	// Style of the self-alignment line (align-items).
+	CrossAlignment *OverlayLineStyle `json:"crossAlignment,omitempty"`
+}
+
+// OverlayFlexItemHighlightConfig configuration data for the highlighting of
+// Flex item elements.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Overlay#type-FlexItemHighlightConfig
+type OverlayFlexItemHighlightConfig struct {
+	// Style of the box representing the item's base size
+	BaseSizeBox *OverlayBoxStyle `json:"baseSizeBox,omitempty"`
+	// Style of the border around the box representing the item's base size
+	BaseSizeBorder *OverlayLineStyle `json:"baseSizeBorder,omitempty"`
+	// Style of the arrow representing if the item grew or shrank
+	FlexibilityArrow *OverlayLineStyle `json:"flexibilityArrow,omitempty"`
+}
+
+// OverlayLineStyle style information for drawing a line.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Overlay#type-LineStyle
+type OverlayLineStyle struct {
+	// The color of the line (default: transparent)
+	Color *DOMRGBA `json:"color,omitempty"`
+	// The line pattern (default: solid)
+	//
+	// Allowed values: dashed, dotted.
+	Pattern string `json:"pattern,omitempty"`
+}
+
+// OverlayBoxStyle style information for drawing a box.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Overlay#type-BoxStyle
+type OverlayBoxStyle struct {
+	// The background color for the box (default: transparent)
+	FillColor *DOMRGBA `json:"fillColor,omitempty"`
+	// The hatching color for the box (default: transparent)
+	HatchColor *DOMRGBA `json:"hatchColor,omitempty"`
+}
+
+// OverlayContrastAlgorithm is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Overlay#type-ContrastAlgorithm
+type OverlayContrastAlgorithm string
+
+// Values of OverlayContrastAlgorithm.
+const (
+	OverlayContrastAlgorithmAa   OverlayContrastAlgorithm = "aa"
+	OverlayContrastAlgorithmAaa  OverlayContrastAlgorithm = "aaa"
+	OverlayContrastAlgorithmApca OverlayContrastAlgorithm = "apca"
+)
+
+// OverlayHighlightConfig configuration data for the highlighting of page
+// elements.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Overlay#type-HighlightConfig
+type OverlayHighlightConfig struct {
+	// Whether the node info tooltip should be shown (default: false).
+	ShowInfo bool `json:"showInfo,omitempty"`
+	// Whether the node styles in the tooltip (default: false).
+	ShowStyles bool `json:"showStyles,omitempty"`
+	// Whether the rulers should be shown (default: false).
+	ShowRulers bool `json:"showRulers,omitempty"`
+	// Whether the a11y info should be shown (default: true).
+	ShowAccessibilityInfo bool `json:"showAccessibilityInfo,omitempty"`
+	// Whether the extension lines from node to the rulers should be shown
+	// (default: false).
+	ShowExtensionLines bool `json:"showExtensionLines,omitempty"`
+	// The content box highlight fill color (default: transparent).
+	ContentColor *DOMRGBA `json:"contentColor,omitempty"`
+	// The padding highlight fill color (default: transparent).
+	PaddingColor *DOMRGBA `json:"paddingColor,omitempty"`
+	// The border highlight fill color (default: transparent).
+	BorderColor *DOMRGBA `json:"borderColor,omitempty"`
+	// The margin highlight fill color (default: transparent).
+	MarginColor *DOMRGBA `json:"marginColor,omitempty"`
+	// The event target element highlight fill color (default: transparent).
+	EventTargetColor *DOMRGBA `json:"eventTargetColor,omitempty"`
+	// The shape outside fill color (default: transparent).
+	ShapeColor *DOMRGBA `json:"shapeColor,omitempty"`
+	// The shape margin fill color (default: transparent).
+	ShapeMarginColor *DOMRGBA `json:"shapeMarginColor,omitempty"`
+	// The grid layout color (default: transparent).
+	CSSGridColor *DOMRGBA `json:"cssGridColor,omitempty"`
+	// The color format used to format color styles (default: hex).
+	ColorFormat OverlayColorFormat `json:"colorFormat,omitempty"`
+	// The grid layout highlight configuration (default: all transparent).
+	GridHighlightConfig *OverlayGridHighlightConfig `json:"gridHighlightConfig,omitempty"`
+	// The flex container highlight configuration (default: all transparent).
+	FlexContainerHighlightConfig *OverlayFlexContainerHighlightConfig `json:"flexContainerHighlightConfig,omitempty"`
+	// The flex item highlight configuration (default: all transparent).
+	FlexItemHighlightConfig *OverlayFlexItemHighlightConfig `json:"flexItemHighlightConfig,omitempty"`
+	// The contrast algorithm to use for the contrast ratio (default: aa).
+	ContrastAlgorithm OverlayContrastAlgorithm `json:"contrastAlgorithm,omitempty"`
+	// The container query container highlight configuration (default: all
+	// transparent).
+	ContainerQueryContainerHighlightConfig *OverlayContainerQueryContainerHighlightConfig `json:"containerQueryContainerHighlightConfig,omitempty"`
+}
+
+// OverlayColorFormat is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Overlay#type-ColorFormat
+type OverlayColorFormat string
+
+// Values of OverlayColorFormat.
+const (
+	OverlayColorFormatRgb OverlayColorFormat = "rgb"
+	OverlayColorFormatHsl OverlayColorFormat = "hsl"
+	OverlayColorFormatHwb OverlayColorFormat = "hwb"
+	OverlayColorFormatHex OverlayColorFormat = "hex"
+)
+
+// OverlayGridNodeHighlightConfig configurations for Persistent Grid Highlight
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Overlay#type-GridNodeHighlightConfig
+type OverlayGridNodeHighlightConfig struct {
+	// A descriptor for the highlight appearance.
+	GridHighlightConfig OverlayGridHighlightConfig `json:"gridHighlightConfig"`
+	// Identifier of the node to highlight.
+	NodeID DOMNodeID `json:"nodeId"`
+}
+
+// OverlayFlexNodeHighlightConfig is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Overlay#type-FlexNodeHighlightConfig
+type OverlayFlexNodeHighlightConfig struct {
+	// A descriptor for the highlight appearance of flex containers.
+	FlexContainerHighlightConfig OverlayFlexContainerHighlightConfig `json:"flexContainerHighlightConfig"`
+	// Identifier of the node to highlight.
+	NodeID DOMNodeID `json:"nodeId"`
+}
+
+// OverlayScrollSnapContainerHighlightConfig is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Overlay#type-ScrollSnapContainerHighlightConfig
+type OverlayScrollSnapContainerHighlightConfig struct {
+	// The style of the snapport border (default: transparent)
+	SnapportBorder *OverlayLineStyle `json:"snapportBorder,omitempty"`
+	// The style of the snap area border (default: transparent)
+	SnapAreaBorder *OverlayLineStyle `json:"snapAreaBorder,omitempty"`
+	// The margin highlight fill color (default: transparent).
+	ScrollMarginColor *DOMRGBA `json:"scrollMarginColor,omitempty"`
+	// The padding highlight fill color (default: transparent).
+	ScrollPaddingColor *DOMRGBA `json:"scrollPaddingColor,omitempty"`
+}
+
+// OverlayScrollSnapHighlightConfig is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Overlay#type-ScrollSnapHighlightConfig
+type OverlayScrollSnapHighlightConfig struct {
+	// A descriptor for the highlight appearance of scroll snap containers.
+	ScrollSnapContainerHighlightConfig OverlayScrollSnapContainerHighlightConfig `json:"scrollSnapContainerHighlightConfig"`
+	// Identifier of the node to highlight.
+	NodeID DOMNodeID `json:"nodeId"`
+}
+
+// OverlayHingeConfig configuration for dual screen hinge
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Overlay#type-HingeConfig
+type OverlayHingeConfig struct {
+	// A rectangle represent hinge
+	Rect DOMRect `json:"rect"`
+	// The content box highlight fill color (default: a dark color).
+	ContentColor *DOMRGBA `json:"contentColor,omitempty"`
+	// The content box highlight outline color (default: transparent).
+	OutlineColor *DOMRGBA `json:"outlineColor,omitempty"`
+}
+
+// OverlayWindowControlsOverlayConfig configuration for Window Controls Overlay
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Overlay#type-WindowControlsOverlayConfig
+type OverlayWindowControlsOverlayConfig struct {
+	// Whether the title bar CSS should be shown when emulating the Window Controls
+	// Overlay.
+	ShowCSS bool `json:"showCSS"`
+	// Selected platforms to show the overlay.
+	SelectedPlatform string `json:"selectedPlatform"`
+	// The theme color defined in app manifest.
+	ThemeColor string `json:"themeColor"`
+}
+
+// OverlayContainerQueryHighlightConfig is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Overlay#type-ContainerQueryHighlightConfig
+type OverlayContainerQueryHighlightConfig struct {
+	// A descriptor for the highlight appearance of container query containers.
+	ContainerQueryContainerHighlightConfig OverlayContainerQueryContainerHighlightConfig `json:"containerQueryContainerHighlightConfig"`
+	// Identifier of the container node to highlight.
+	NodeID DOMNodeID `json:"nodeId"`
+}
+
+// OverlayContainerQueryContainerHighlightConfig is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Overlay#type-ContainerQueryContainerHighlightConfig
+type OverlayContainerQueryContainerHighlightConfig struct {
+	// The style of the container border.
+	ContainerBorder *OverlayLineStyle `json:"containerBorder,omitempty"`
+	// The style of the descendants' borders.
+	DescendantBorder *OverlayLineStyle `json:"descendantBorder,omitempty"`
+}
+
+// OverlayIsolatedElementHighlightConfig is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Overlay#type-IsolatedElementHighlightConfig
+type OverlayIsolatedElementHighlightConfig struct {
+	// A descriptor for the highlight appearance of an element in isolation mode.
+	IsolationModeHighlightConfig OverlayIsolationModeHighlightConfig `json:"isolationModeHighlightConfig"`
+	// Identifier of the isolated element to highlight.
+	NodeID DOMNodeID `json:"nodeId"`
+}
+
+// OverlayIsolationModeHighlightConfig is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Overlay#type-IsolationModeHighlightConfig
+type OverlayIsolationModeHighlightConfig struct {
+	// The fill color of the resizers (default: transparent).
+	ResizerColor *DOMRGBA `json:"resizerColor,omitempty"`
+	// The fill color for resizer handles (default: transparent).
+	ResizerHandleColor *DOMRGBA `json:"resizerHandleColor,omitempty"`
+	// The fill color for the mask covering non-isolated elements (default:
+	// transparent).
+	MaskColor *DOMRGBA `json:"maskColor,omitempty"`
+}
+
+// OverlayInspectMode is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Overlay#type-InspectMode
+type OverlayInspectMode string
+
+// Values of OverlayInspectMode.
+const (
+	OverlayInspectModeSearchForNode         OverlayInspectMode = "searchForNode"
+	OverlayInspectModeSearchForUAShadowDOM  OverlayInspectMode = "searchForUAShadowDOM"
+	OverlayInspectModeCaptureAreaScreenshot OverlayInspectMode = "captureAreaScreenshot"
+	OverlayInspectModeNone                  OverlayInspectMode = "none"
+)
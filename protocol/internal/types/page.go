@@ -0,0 +1,1170 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package types
+
+// PageFrameID unique frame identifier.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#type-FrameId
+type PageFrameID string
+
+// PageAdFrameType indicates whether a frame has been identified as an ad.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#type-AdFrameType
+//
+// This is an experimental part of the protocol.
+type PageAdFrameType string
+
+// Values of PageAdFrameType.
+const (
+	PageAdFrameTypeNone  PageAdFrameType = "none"
+	PageAdFrameTypeChild PageAdFrameType = "child"
+	PageAdFrameTypeRoot  PageAdFrameType = "root"
+)
+
+// PageAdFrameExplanation is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#type-AdFrameExplanation
+//
+// This is an experimental part of the protocol.
+type PageAdFrameExplanation string
+
+// Values of PageAdFrameExplanation.
+const (
+	PageAdFrameExplanationParentIsAd          PageAdFrameExplanation = "ParentIsAd"
+	PageAdFrameExplanationCreatedByAdScript   PageAdFrameExplanation = "CreatedByAdScript"
+	PageAdFrameExplanationMatchedBlockingRule PageAdFrameExplanation = "MatchedBlockingRule"
+)
+
+// PageAdFrameStatus indicates whether a frame has been identified as an ad and
+// why.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#type-AdFrameStatus
+//
+// This is an experimental part of the protocol.
+type PageAdFrameStatus struct {
+	AdFrameType  PageAdFrameType          `json:"adFrameType"`
+	Explanations []PageAdFrameExplanation `json:"explanations,omitempty"`
+}
+
+// PageAdScriptID identifies the script which caused a script or frame to be
+// labelled as an ad.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#type-AdScriptId
+//
+// This is an experimental part of the protocol.
+type PageAdScriptID struct {
+	// Script Id of the script which caused a script or frame to be labelled as an
+	// ad.
+	ScriptID RuntimeScriptID `json:"scriptId"`
+	// Id of scriptId's debugger.
+	DebuggerID RuntimeUniqueDebuggerID `json:"debuggerId"`
+}
+
+// PageAdScriptAncestry encapsulates the script ancestry and the root script
+// filterlist rule that caused the frame to be labelled as an ad. Only created
+// when `ancestryChain` is not empty.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#type-AdScriptAncestry
+//
+// This is an experimental part of the protocol.
+type PageAdScriptAncestry struct {
+	// A chain of `AdScriptId`s representing the ancestry of an ad script that led
+	// to the creation of a frame. The chain is ordered from the script itself
+	// (lower level) up to its root ancestor that was flagged by filterlist.
+	AncestryChain []PageAdScriptID `json:"ancestryChain"`
+	// The filterlist rule that caused the root (last) script in `ancestryChain` to
+	// be ad-tagged. Only populated if the rule is available.
+	RootScriptFilterlistRule string `json:"rootScriptFilterlistRule,omitempty"`
+}
+
+// PageSecureContextType indicates whether the frame is a secure context and why
+// it is the case.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#type-SecureContextType
+//
+// This is an experimental part of the protocol.
+type PageSecureContextType string
+
+// Values of PageSecureContextType.
+const (
+	PageSecureContextTypeSecure           PageSecureContextType = "Secure"
+	PageSecureContextTypeSecureLocalhost  PageSecureContextType = "SecureLocalhost"
+	PageSecureContextTypeInsecureScheme   PageSecureContextType = "InsecureScheme"
+	PageSecureContextTypeInsecureAncestor PageSecureContextType = "InsecureAncestor"
+)
+
+// PageCrossOriginIsolatedContextType indicates whether the frame is
+// cross-origin isolated and why it is the case.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#type-CrossOriginIsolatedContextType
+//
+// This is an experimental part of the protocol.
+type PageCrossOriginIsolatedContextType string
+
+// Values of PageCrossOriginIsolatedContextType.
+const (
+	PageCrossOriginIsolatedContextTypeIsolated                   PageCrossOriginIsolatedContextType = "Isolated"
+	PageCrossOriginIsolatedContextTypeNotIsolated                PageCrossOriginIsolatedContextType = "NotIsolated"
+	PageCrossOriginIsolatedContextTypeNotIsolatedFeatureDisabled PageCrossOriginIsolatedContextType = "NotIsolatedFeatureDisabled"
+)
+
+// PageGatedAPIFeatures is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#type-GatedAPIFeatures
+//
+// This is an experimental part of the protocol.
+type PageGatedAPIFeatures string
+
+// Values of PageGatedAPIFeatures.
+const (
+	PageGatedAPIFeaturesSharedArrayBuffers                PageGatedAPIFeatures = "SharedArrayBuffers"
+	PageGatedAPIFeaturesSharedArrayBuffersTransferAllowed PageGatedAPIFeatures = "SharedArrayBuffersTransferAllowed"
+	PageGatedAPIFeaturesPerformanceMeasureMemory          PageGatedAPIFeatures = "PerformanceMeasureMemory"
+	PageGatedAPIFeaturesPerformanceProfile                PageGatedAPIFeatures = "PerformanceProfile"
+)
+
+// PagePermissionsPolicyFeature all Permissions Policy features. This enum
+// should match the one defined in
+// services/network/public/cpp/permissions_policy/permissions_policy_features.json5.
+// LINT.IfChange(PermissionsPolicyFeature)
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#type-PermissionsPolicyFeature
+//
+// This is an experimental part of the protocol.
+type PagePermissionsPolicyFeature string
+
+// Values of PagePermissionsPolicyFeature.
+const (
+	PagePermissionsPolicyFeatureAccelerometer                  PagePermissionsPolicyFeature = "accelerometer"
+	PagePermissionsPolicyFeatureAllScreensCapture              PagePermissionsPolicyFeature = "all-screens-capture"
+	PagePermissionsPolicyFeatureAmbientLightSensor             PagePermissionsPolicyFeature = "ambient-light-sensor"
+	PagePermissionsPolicyFeatureAriaNotify                     PagePermissionsPolicyFeature = "aria-notify"
+	PagePermissionsPolicyFeatureAttributionReporting           PagePermissionsPolicyFeature = "attribution-reporting"
+	PagePermissionsPolicyFeatureAutoplay                       PagePermissionsPolicyFeature = "autoplay"
+	PagePermissionsPolicyFeatureBluetooth                      PagePermissionsPolicyFeature = "bluetooth"
+	PagePermissionsPolicyFeatureBrowsingTopics                 PagePermissionsPolicyFeature = "browsing-topics"
+	PagePermissionsPolicyFeatureCamera                         PagePermissionsPolicyFeature = "camera"
+	PagePermissionsPolicyFeatureCapturedSurfaceControl         PagePermissionsPolicyFeature = "captured-surface-control"
+	PagePermissionsPolicyFeatureChDpr                          PagePermissionsPolicyFeature = "ch-dpr"
+	PagePermissionsPolicyFeatureChDeviceMemory                 PagePermissionsPolicyFeature = "ch-device-memory"
+	PagePermissionsPolicyFeatureChDownlink                     PagePermissionsPolicyFeature = "ch-downlink"
+	PagePermissionsPolicyFeatureChEct                          PagePermissionsPolicyFeature = "ch-ect"
+	PagePermissionsPolicyFeatureChPrefersColorScheme           PagePermissionsPolicyFeature = "ch-prefers-color-scheme"
+	PagePermissionsPolicyFeatureChPrefersReducedMotion         PagePermissionsPolicyFeature = "ch-prefers-reduced-motion"
+	PagePermissionsPolicyFeatureChPrefersReducedTransparency   PagePermissionsPolicyFeature = "ch-prefers-reduced-transparency"
+	PagePermissionsPolicyFeatureChRtt                          PagePermissionsPolicyFeature = "ch-rtt"
+	PagePermissionsPolicyFeatureChSaveData                     PagePermissionsPolicyFeature = "ch-save-data"
+	PagePermissionsPolicyFeatureChUa                           PagePermissionsPolicyFeature = "ch-ua"
+	PagePermissionsPolicyFeatureChUaArch                       PagePermissionsPolicyFeature = "ch-ua-arch"
+	PagePermissionsPolicyFeatureChUaBitness                    PagePermissionsPolicyFeature = "ch-ua-bitness"
+	PagePermissionsPolicyFeatureChUaHighEntropyValues          PagePermissionsPolicyFeature = "ch-ua-high-entropy-values"
+	PagePermissionsPolicyFeatureChUaPlatform                   PagePermissionsPolicyFeature = "ch-ua-platform"
+	PagePermissionsPolicyFeatureChUaModel                      PagePermissionsPolicyFeature = "ch-ua-model"
+	PagePermissionsPolicyFeatureChUaMobile                     PagePermissionsPolicyFeature = "ch-ua-mobile"
+	PagePermissionsPolicyFeatureChUaFormFactors                PagePermissionsPolicyFeature = "ch-ua-form-factors"
+	PagePermissionsPolicyFeatureChUaFullVersion                PagePermissionsPolicyFeature = "ch-ua-full-version"
+	PagePermissionsPolicyFeatureChUaFullVersionList            PagePermissionsPolicyFeature = "ch-ua-full-version-list"
+	PagePermissionsPolicyFeatureChUaPlatformVersion            PagePermissionsPolicyFeature = "ch-ua-platform-version"
+	PagePermissionsPolicyFeatureChUaWow64                      PagePermissionsPolicyFeature = "ch-ua-wow64"
+	PagePermissionsPolicyFeatureChViewportHeight               PagePermissionsPolicyFeature = "ch-viewport-height"
+	PagePermissionsPolicyFeatureChViewportWidth                PagePermissionsPolicyFeature = "ch-viewport-width"
+	PagePermissionsPolicyFeatureChWidth                        PagePermissionsPolicyFeature = "ch-width"
+	PagePermissionsPolicyFeatureClipboardRead                  PagePermissionsPolicyFeature = "clipboard-read"
+	PagePermissionsPolicyFeatureClipboardWrite                 PagePermissionsPolicyFeature = "clipboard-write"
+	PagePermissionsPolicyFeatureComputePressure                PagePermissionsPolicyFeature = "compute-pressure"
+	PagePermissionsPolicyFeatureControlledFrame                PagePermissionsPolicyFeature = "controlled-frame"
+	PagePermissionsPolicyFeatureCrossOriginIsolated            PagePermissionsPolicyFeature = "cross-origin-isolated"
+	PagePermissionsPolicyFeatureDeferredFetch                  PagePermissionsPolicyFeature = "deferred-fetch"
+	PagePermissionsPolicyFeatureDeferredFetchMinimal           PagePermissionsPolicyFeature = "deferred-fetch-minimal"
+	PagePermissionsPolicyFeatureDeviceAttributes               PagePermissionsPolicyFeature = "device-attributes"
+	PagePermissionsPolicyFeatureDigitalCredentialsGet          PagePermissionsPolicyFeature = "digital-credentials-get"
+	PagePermissionsPolicyFeatureDirectSockets                  PagePermissionsPolicyFeature = "direct-sockets"
+	PagePermissionsPolicyFeatureDirectSocketsPrivate           PagePermissionsPolicyFeature = "direct-sockets-private"
+	PagePermissionsPolicyFeatureDisplayCapture                 PagePermissionsPolicyFeature = "display-capture"
+	PagePermissionsPolicyFeatureDocumentDomain                 PagePermissionsPolicyFeature = "document-domain"
+	PagePermissionsPolicyFeatureEncryptedMedia                 PagePermissionsPolicyFeature = "encrypted-media"
+	PagePermissionsPolicyFeatureExecutionWhileOutOfViewport    PagePermissionsPolicyFeature = "execution-while-out-of-viewport"
+	PagePermissionsPolicyFeatureExecutionWhileNotRendered      PagePermissionsPolicyFeature = "execution-while-not-rendered"
+	PagePermissionsPolicyFeatureFencedUnpartitionedStorageRead PagePermissionsPolicyFeature = "fenced-unpartitioned-storage-read"
+	PagePermissionsPolicyFeatureFocusWithoutUserActivation     PagePermissionsPolicyFeature = "focus-without-user-activation"
+	PagePermissionsPolicyFeatureFullscreen                     PagePermissionsPolicyFeature = "fullscreen"
+	PagePermissionsPolicyFeatureFrobulate                      PagePermissionsPolicyFeature = "frobulate"
+	PagePermissionsPolicyFeatureGamepad                        PagePermissionsPolicyFeature = "gamepad"
+	PagePermissionsPolicyFeatureGeolocation                    PagePermissionsPolicyFeature = "geolocation"
+	PagePermissionsPolicyFeatureGyroscope                      PagePermissionsPolicyFeature = "gyroscope"
+	PagePermissionsPolicyFeatureHid                            PagePermissionsPolicyFeature = "hid"
+	PagePermissionsPolicyFeatureIdentityCredentialsGet         PagePermissionsPolicyFeature = "identity-credentials-get"
+	PagePermissionsPolicyFeatureIdleDetection                  PagePermissionsPolicyFeature = "idle-detection"
+	PagePermissionsPolicyFeatureInterestCohort                 PagePermissionsPolicyFeature = "interest-cohort"
+	PagePermissionsPolicyFeatureJoinAdInterestGroup            PagePermissionsPolicyFeature = "join-ad-interest-group"
+	PagePermissionsPolicyFeatureKeyboardMap                    PagePermissionsPolicyFeature = "keyboard-map"
+	PagePermissionsPolicyFeatureLanguageDetector               PagePermissionsPolicyFeature = "language-detector"
+	PagePermissionsPolicyFeatureLanguageModel                  PagePermissionsPolicyFeature = "language-model"
+	PagePermissionsPolicyFeatureLocalFonts                     PagePermissionsPolicyFeature = "local-fonts"
+	PagePermissionsPolicyFeatureLocalNetworkAccess             PagePermissionsPolicyFeature = "local-network-access"
+	PagePermissionsPolicyFeatureMagnetometer                   PagePermissionsPolicyFeature = "magnetometer"
+	PagePermissionsPolicyFeatureMediaPlaybackWhileNotVisible   PagePermissionsPolicyFeature = "media-playback-while-not-visible"
+	PagePermissionsPolicyFeatureMicrophone                     PagePermissionsPolicyFeature = "microphone"
+	PagePermissionsPolicyFeatureMidi                           PagePermissionsPolicyFeature = "midi"
+	PagePermissionsPolicyFeatureOnDeviceSpeechRecognition      PagePermissionsPolicyFeature = "on-device-speech-recognition"
+	PagePermissionsPolicyFeatureOtpCredentials                 PagePermissionsPolicyFeature = "otp-credentials"
+	PagePermissionsPolicyFeaturePayment                        PagePermissionsPolicyFeature = "payment"
+	PagePermissionsPolicyFeaturePictureInPicture               PagePermissionsPolicyFeature = "picture-in-picture"
+	PagePermissionsPolicyFeaturePopins                         PagePermissionsPolicyFeature = "popins"
+	PagePermissionsPolicyFeaturePrivateAggregation             PagePermissionsPolicyFeature = "private-aggregation"
+	PagePermissionsPolicyFeaturePrivateStateTokenIssuance      PagePermissionsPolicyFeature = "private-state-token-issuance"
+	PagePermissionsPolicyFeaturePrivateStateTokenRedemption    PagePermissionsPolicyFeature = "private-state-token-redemption"
+	PagePermissionsPolicyFeaturePublickeyCredentialsCreate     PagePermissionsPolicyFeature = "publickey-credentials-create"
+	PagePermissionsPolicyFeaturePublickeyCredentialsGet        PagePermissionsPolicyFeature = "publickey-credentials-get"
+	PagePermissionsPolicyFeatureRecordAdAuctionEvents          PagePermissionsPolicyFeature = "record-ad-auction-events"
+	PagePermissionsPolicyFeatureRewriter                       PagePermissionsPolicyFeature = "rewriter"
+	PagePermissionsPolicyFeatureRunAdAuction                   PagePermissionsPolicyFeature = "run-ad-auction"
+	PagePermissionsPolicyFeatureScreenWakeLock                 PagePermissionsPolicyFeature = "screen-wake-lock"
+	PagePermissionsPolicyFeatureSerial                         PagePermissionsPolicyFeature = "serial"
+	PagePermissionsPolicyFeatureSharedAutofill                 PagePermissionsPolicyFeature = "shared-autofill"
+	PagePermissionsPolicyFeatureSharedStorage                  PagePermissionsPolicyFeature = "shared-storage"
+	PagePermissionsPolicyFeatureSharedStorageSelectURL         PagePermissionsPolicyFeature = "shared-storage-select-url"
+	PagePermissionsPolicyFeatureSmartCard                      PagePermissionsPolicyFeature = "smart-card"
+	PagePermissionsPolicyFeatureSpeakerSelection               PagePermissionsPolicyFeature = "speaker-selection"
+	PagePermissionsPolicyFeatureStorageAccess                  PagePermissionsPolicyFeature = "storage-access"
+	PagePermissionsPolicyFeatureSubApps                        PagePermissionsPolicyFeature = "sub-apps"
+	PagePermissionsPolicyFeatureSummarizer                     PagePermissionsPolicyFeature = "summarizer"
+	PagePermissionsPolicyFeatureSyncXhr                        PagePermissionsPolicyFeature = "sync-xhr"
+	PagePermissionsPolicyFeatureTranslator                     PagePermissionsPolicyFeature = "translator"
+	PagePermissionsPolicyFeatureUnload                         PagePermissionsPolicyFeature = "unload"
+	PagePermissionsPolicyFeatureUsb                            PagePermissionsPolicyFeature = "usb"
+	PagePermissionsPolicyFeatureUsbUnrestricted                PagePermissionsPolicyFeature = "usb-unrestricted"
+	PagePermissionsPolicyFeatureVerticalScroll                 PagePermissionsPolicyFeature = "vertical-scroll"
+	PagePermissionsPolicyFeatureWebAppInstallation             PagePermissionsPolicyFeature = "web-app-installation"
+	PagePermissionsPolicyFeatureWebPrinting                    PagePermissionsPolicyFeature = "web-printing"
+	PagePermissionsPolicyFeatureWebShare                       PagePermissionsPolicyFeature = "web-share"
+	PagePermissionsPolicyFeatureWindowManagement               PagePermissionsPolicyFeature = "window-management"
+	PagePermissionsPolicyFeatureWriter                         PagePermissionsPolicyFeature = "writer"
+	PagePermissionsPolicyFeatureXrSpatialTracking              PagePermissionsPolicyFeature = "xr-spatial-tracking"
+)
+
+// PagePermissionsPolicyBlockReason reason for a permissions policy feature to
+// be disabled.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#type-PermissionsPolicyBlockReason
+//
+// This is an experimental part of the protocol.
+type PagePermissionsPolicyBlockReason string
+
+// Values of PagePermissionsPolicyBlockReason.
+const (
+	PagePermissionsPolicyBlockReasonHeader            PagePermissionsPolicyBlockReason = "Header"
+	PagePermissionsPolicyBlockReasonIframeAttribute   PagePermissionsPolicyBlockReason = "IframeAttribute"
+	PagePermissionsPolicyBlockReasonInFencedFrameTree PagePermissionsPolicyBlockReason = "InFencedFrameTree"
+	PagePermissionsPolicyBlockReasonInIsolatedApp     PagePermissionsPolicyBlockReason = "InIsolatedApp"
+)
+
+// PagePermissionsPolicyBlockLocator is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#type-PermissionsPolicyBlockLocator
+//
+// This is an experimental part of the protocol.
+type PagePermissionsPolicyBlockLocator struct {
+	FrameID     PageFrameID                      `json:"frameId"`
+	BlockReason PagePermissionsPolicyBlockReason `json:"blockReason"`
+}
+
+// PagePermissionsPolicyFeatureState is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#type-PermissionsPolicyFeatureState
+//
+// This is an experimental part of the protocol.
+type PagePermissionsPolicyFeatureState struct {
+	Feature PagePermissionsPolicyFeature       `json:"feature"`
+	Allowed bool                               `json:"allowed"`
+	Locator *PagePermissionsPolicyBlockLocator `json:"locator,omitempty"`
+}
+
+// PageOriginTrialTokenStatus origin
+// Trial(https://www.chromium.org/blink/origin-trials) support. Status for an
+// Origin Trial token.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#type-OriginTrialTokenStatus
+//
+// This is an experimental part of the protocol.
+type PageOriginTrialTokenStatus string
+
+// Values of PageOriginTrialTokenStatus.
+const (
+	PageOriginTrialTokenStatusSuccess                PageOriginTrialTokenStatus = "Success"
+	PageOriginTrialTokenStatusNotSupported           PageOriginTrialTokenStatus = "NotSupported"
+	PageOriginTrialTokenStatusInsecure               PageOriginTrialTokenStatus = "Insecure"
+	PageOriginTrialTokenStatusExpired                PageOriginTrialTokenStatus = "Expired"
+	PageOriginTrialTokenStatusWrongOrigin            PageOriginTrialTokenStatus = "WrongOrigin"
+	PageOriginTrialTokenStatusInvalidSignature       PageOriginTrialTokenStatus = "InvalidSignature"
+	PageOriginTrialTokenStatusMalformed              PageOriginTrialTokenStatus = "Malformed"
+	PageOriginTrialTokenStatusWrongVersion           PageOriginTrialTokenStatus = "WrongVersion"
+	PageOriginTrialTokenStatusFeatureDisabled        PageOriginTrialTokenStatus = "FeatureDisabled"
+	PageOriginTrialTokenStatusTokenDisabled          PageOriginTrialTokenStatus = "TokenDisabled"
+	PageOriginTrialTokenStatusFeatureDisabledForUser PageOriginTrialTokenStatus = "FeatureDisabledForUser"
+	PageOriginTrialTokenStatusUnknownTrial           PageOriginTrialTokenStatus = "UnknownTrial"
+)
+
+// PageOriginTrialStatus status for an Origin Trial.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#type-OriginTrialStatus
+//
+// This is an experimental part of the protocol.
+type PageOriginTrialStatus string
+
+// Values of PageOriginTrialStatus.
+const (
+	PageOriginTrialStatusEnabled               PageOriginTrialStatus = "Enabled"
+	PageOriginTrialStatusValidTokenNotProvided PageOriginTrialStatus = "ValidTokenNotProvided"
+	PageOriginTrialStatusOSNotSupported        PageOriginTrialStatus = "OSNotSupported"
+	PageOriginTrialStatusTrialNotAllowed       PageOriginTrialStatus = "TrialNotAllowed"
+)
+
+// PageOriginTrialUsageRestriction is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#type-OriginTrialUsageRestriction
+//
+// This is an experimental part of the protocol.
+type PageOriginTrialUsageRestriction string
+
+// Values of PageOriginTrialUsageRestriction.
+const (
+	PageOriginTrialUsageRestrictionNone   PageOriginTrialUsageRestriction = "None"
+	PageOriginTrialUsageRestrictionSubset PageOriginTrialUsageRestriction = "Subset"
+)
+
+// PageOriginTrialToken is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#type-OriginTrialToken
+//
+// This is an experimental part of the protocol.
+type PageOriginTrialToken struct {
+	Origin           string                          `json:"origin"`
+	MatchSubDomains  bool                            `json:"matchSubDomains"`
+	TrialName        string                          `json:"trialName"`
+	ExpiryTime       NetworkTimeSinceEpoch           `json:"expiryTime"`
+	IsThirdParty     bool                            `json:"isThirdParty"`
+	UsageRestriction PageOriginTrialUsageRestriction `json:"usageRestriction"`
+}
+
+// PageOriginTrialTokenWithStatus is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#type-OriginTrialTokenWithStatus
+//
+// This is an experimental part of the protocol.
+type PageOriginTrialTokenWithStatus struct {
+	RawTokenText string `json:"rawTokenText"`
+	// `parsedToken` is present only when the token is extractable and parsable.
+	ParsedToken *PageOriginTrialToken      `json:"parsedToken,omitempty"`
+	Status      PageOriginTrialTokenStatus `json:"status"`
+}
+
+// PageOriginTrial is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#type-OriginTrial
+//
+// This is an experimental part of the protocol.
+type PageOriginTrial struct {
+	TrialName        string                           `json:"trialName"`
+	Status           PageOriginTrialStatus            `json:"status"`
+	TokensWithStatus []PageOriginTrialTokenWithStatus `json:"tokensWithStatus"`
+}
+
+// PageSecurityOriginDetails additional information about the frame document's
+// security origin.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#type-SecurityOriginDetails
+//
+// This is an experimental part of the protocol.
+type PageSecurityOriginDetails struct {
+	// Indicates whether the frame document's security origin is one of the local
+	// hostnames (e.g. "localhost") or IP addresses (IPv4 127.0.0.0/8 or IPv6 ::1).
+	IsLocalhost bool `json:"isLocalhost"`
+}
+
+// PageFrame information about the Frame on the page.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#type-Frame
+type PageFrame struct {
+	// Frame unique identifier.
+	ID PageFrameID `json:"id"`
+	// Parent frame identifier.
+	ParentID PageFrameID `json:"parentId,omitempty"`
+	// Identifier of the loader associated with this frame.
+	LoaderID NetworkLoaderID `json:"loaderId"`
+	// Frame's name as specified in the tag.
+	Name string `json:"name,omitempty"`
+	// Frame document's URL without fragment.
+	URL string `json:"url"`
+	// Frame document's URL fragment including the '#'.
+	//
+	// This is an experimental part of the protocol.
+	URLFragment string `json:"urlFragment,omitempty"`
+	// Frame document's registered domain, taking the public suffixes list into
+	// account. Extracted from the Frame's url. Example URLs:
+	// http://www.google.com/file.html -> "google.com" http://a.b.co.uk/file.html
+	// -> "b.co.uk"
+	//
+	// This is an experimental part of the protocol.
+	DomainAndRegistry string `json:"domainAndRegistry"`
+	// Frame document's security origin.
+	SecurityOrigin string `json:"securityOrigin"`
+	// Additional details about the frame document's security origin.
+	//
+	// This is an experimental part of the protocol.
+	SecurityOriginDetails *PageSecurityOriginDetails `json:"securityOriginDetails,omitempty"`
+	// Frame document's mimeType as determined by the browser.
+	MimeType string `json:"mimeType"`
+	// If the frame failed to load, this contains the URL that could not be loaded.
+	// Note that unlike url above, this URL may contain a fragment.
+	//
+	// This is an experimental part of the protocol.
+	UnreachableURL string `json:"unreachableUrl,omitempty"`
+	// Indicates whether this frame was tagged as an ad and why.
+	//
+	// This is an experimental part of the protocol.
+	AdFrameStatus *PageAdFrameStatus `json:"adFrameStatus,omitempty"`
+	// Indicates whether the main document is a secure context and explains why
+	// that is the case.
+	//
+	// This is an experimental part of the protocol.
+	SecureContextType PageSecureContextType `json:"secureContextType"`
+	// Indicates whether this is a cross origin isolated context.
+	//
+	// This is an experimental part of the protocol.
+	CrossOriginIsolatedContextType PageCrossOriginIsolatedContextType `json:"crossOriginIsolatedContextType"`
+	// Indicated which gated APIs / features are available.
+	//
+	// This is an experimental part of the protocol.
+	GatedAPIFeatures []PageGatedAPIFeatures `json:"gatedAPIFeatures"`
+}
+
+// PageFrameResource information about the Resource on the page.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#type-FrameResource
+//
+// This is an experimental part of the protocol.
+type PageFrameResource struct {
+	// Resource URL.
+	URL string `json:"url"`
+	// Type of this resource.
+	Type NetworkResourceType `json:"type"`
+	// Resource mimeType as determined by the browser.
+	MimeType string `json:"mimeType"`
+	// last-modified timestamp as reported by server.
+	LastModified NetworkTimeSinceEpoch `json:"lastModified,omitempty"`
+	// Resource content size.
+	ContentSize float64 `json:"contentSize,omitempty"`
+	// True if the resource failed to load.
+	Failed bool `json:"failed,omitempty"`
+	// True if the resource was canceled during loading.
+	Canceled bool `json:"canceled,omitempty"`
+}
+
+// PageFrameResourceTree information about the Frame hierarchy along with their
+// cached resources.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#type-FrameResourceTree
+//
+// This is an experimental part of the protocol.
+type PageFrameResourceTree struct {
+	// Frame information for this tree item.
+	Frame PageFrame `json:"frame"`
+	// Child frames.
+	ChildFrames []PageFrameResourceTree `json:"childFrames,omitempty"`
+	// Information about frame resources.
+	Resources []PageFrameResource `json:"resources"`
+}
+
+// PageFrameTree information about the Frame hierarchy.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#type-FrameTree
+type PageFrameTree struct {
+	// Frame information for this tree item.
+	Frame PageFrame `json:"frame"`
+	// Child frames.
+	ChildFrames []PageFrameTree `json:"childFrames,omitempty"`
+}
+
+// PageScriptIdentifier unique script identifier.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#type-ScriptIdentifier
+type PageScriptIdentifier string
+
+// PageTransitionType transition type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#type-TransitionType
+type PageTransitionType string
+
+// Values of PageTransitionType.
+const (
+	PageTransitionTypeLink             PageTransitionType = "link"
+	PageTransitionTypeTyped            PageTransitionType = "typed"
+	PageTransitionTypeAddressBar       PageTransitionType = "address_bar"
+	PageTransitionTypeAutoBookmark     PageTransitionType = "auto_bookmark"
+	PageTransitionTypeAutoSubframe     PageTransitionType = "auto_subframe"
+	PageTransitionTypeManualSubframe   PageTransitionType = "manual_subframe"
+	PageTransitionTypeGenerated        PageTransitionType = "generated"
+	PageTransitionTypeAutoToplevel     PageTransitionType = "auto_toplevel"
+	PageTransitionTypeFormSubmit       PageTransitionType = "form_submit"
+	PageTransitionTypeReload           PageTransitionType = "reload"
+	PageTransitionTypeKeyword          PageTransitionType = "keyword"
+	PageTransitionTypeKeywordGenerated PageTransitionType = "keyword_generated"
+	PageTransitionTypeOther            PageTransitionType = "other"
+)
+
+// PageNavigationEntry navigation history entry.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#type-NavigationEntry
+type PageNavigationEntry struct {
+	// Unique id of the navigation history entry.
+	ID int64 `json:"id"`
+	// URL of the navigation history entry.
+	URL string `json:"url"`
+	// URL that the user typed in the url bar.
+	UserTypedURL string `json:"userTypedURL"`
+	// Title of the navigation history entry.
+	Title string `json:"title"`
+	// Transition type.
+	TransitionType PageTransitionType `json:"transitionType"`
+}
+
+// PageScreencastFrameMetadata screencast frame metadata.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#type-ScreencastFrameMetadata
+//
+// This is an experimental part of the protocol.
+type PageScreencastFrameMetadata struct {
+	// Top offset in DIP.
+	OffsetTop float64 `json:"offsetTop"`
+	// Page scale factor.
+	PageScaleFactor float64 `json:"pageScaleFactor"`
+	// Device screen width in DIP.
+	DeviceWidth float64 `json:"deviceWidth"`
+	// Device screen height in DIP.
+	DeviceHeight float64 `json:"deviceHeight"`
+	// Position of horizontal scroll in CSS pixels.
+	ScrollOffsetX float64 `json:"scrollOffsetX"`
+	// Position of vertical scroll in CSS pixels.
+	ScrollOffsetY float64 `json:"scrollOffsetY"`
+	// Frame swap timestamp.
+	Timestamp NetworkTimeSinceEpoch `json:"timestamp,omitempty"`
+}
+
+// PageDialogType javascript dialog type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#type-DialogType
+type PageDialogType string
+
+// Values of PageDialogType.
+const (
+	PageDialogTypeAlert        PageDialogType = "alert"
+	PageDialogTypeConfirm      PageDialogType = "confirm"
+	PageDialogTypePrompt       PageDialogType = "prompt"
+	PageDialogTypeBeforeunload PageDialogType = "beforeunload"
+)
+
+// PageAppManifestError error while paring app manifest.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#type-AppManifestError
+type PageAppManifestError struct {
+	// Error message.
+	Message string `json:"message"`
+	// If critical, this is a non-recoverable parse error.
+	Critical int64 `json:"critical"`
+	// Error line.
+	Line int64 `json:"line"`
+	// Error column.
+	Column int64 `json:"column"`
+}
+
+// PageAppManifestParsedProperties parsed app manifest properties.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#type-AppManifestParsedProperties
+//
+// This is an experimental part of the protocol.
+type PageAppManifestParsedProperties struct {
+	// Computed scope value
+	Scope string `json:"scope"`
+}
+
+// PageLayoutViewport layout viewport position and dimensions.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#type-LayoutViewport
+type PageLayoutViewport struct {
+	// Horizontal offset relative to the document (CSS pixels).
+	PageX int64 `json:"pageX"`
+	// Vertical offset relative to the document (CSS pixels).
+	PageY int64 `json:"pageY"`
+	// Width (CSS pixels), excludes scrollbar if present.
+	ClientWidth int64 `json:"clientWidth"`
+	// Height (CSS pixels), excludes scrollbar if present.
+	ClientHeight int64 `json:"clientHeight"`
+}
+
+// PageVisualViewport visual viewport position, dimensions, and scale.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#type-VisualViewport
+type PageVisualViewport struct {
+	// Horizontal offset relative to the layout viewport (CSS pixels).
+	OffsetX float64 `json:"offsetX"`
+	// Vertical offset relative to the layout viewport (CSS pixels).
+	OffsetY float64 `json:"offsetY"`
+	// Horizontal offset relative to the document (CSS pixels).
+	PageX float64 `json:"pageX"`
+	// Vertical offset relative to the document (CSS pixels).
+	PageY float64 `json:"pageY"`
+	// Width (CSS pixels), excludes scrollbar if present.
+	ClientWidth float64 `json:"clientWidth"`
+	// Height (CSS pixels), excludes scrollbar if present.
+	ClientHeight float64 `json:"clientHeight"`
+	// Scale relative to the ideal viewport (size at width=device-width).
+	Scale float64 `json:"scale"`
+	// Page zoom factor (CSS to device independent pixels ratio).
+	Zoom float64 `json:"zoom,omitempty"`
+}
+
+// PageViewport viewport for capturing screenshot.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#type-Viewport
+type PageViewport struct {
+	// X offset in device independent pixels (dip).
+	X float64 `json:"x"`
+	// Y offset in device independent pixels (dip).
+	Y float64 `json:"y"`
+	// Rectangle width in device independent pixels (dip).
+	Width float64 `json:"width"`
+	// Rectangle height in device independent pixels (dip).
+	Height float64 `json:"height"`
+	// Page scale factor.
+	Scale float64 `json:"scale"`
+}
+
+// PageFontFamilies generic font families collection.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#type-FontFamilies
+//
+// This is an experimental part of the protocol.
+type PageFontFamilies struct {
+	// The standard font-family.
+	Standard string `json:"standard,omitempty"`
+	// The fixed font-family.
+	Fixed string `json:"fixed,omitempty"`
+	// The serif font-family.
+	Serif string `json:"serif,omitempty"`
+	// The sansSerif font-family.
+	SansSerif string `json:"sansSerif,omitempty"`
+	// The cursive font-family.
+	Cursive string `json:"cursive,omitempty"`
+	// The fantasy font-family.
+	Fantasy string `json:"fantasy,omitempty"`
+	// The math font-family.
+	Math string `json:"math,omitempty"`
+}
+
+// PageScriptFontFamilies font families collection for a script.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#type-ScriptFontFamilies
+//
+// This is an experimental part of the protocol.
+type PageScriptFontFamilies struct {
+	// Name of the script which these font families are defined for.
+	Script string `json:"script"`
+	// Generic font families collection for the script.
+	FontFamilies PageFontFamilies `json:"fontFamilies"`
+}
+
+// PageFontSizes default font sizes.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#type-FontSizes
+//
+// This is an experimental part of the protocol.
+type PageFontSizes struct {
+	// Default standard font size.
+	Standard int64 `json:"standard,omitempty"`
+	// Default fixed font size.
+	Fixed int64 `json:"fixed,omitempty"`
+}
+
+// PageClientNavigationReason is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#type-ClientNavigationReason
+//
+// This is an experimental part of the protocol.
+type PageClientNavigationReason string
+
+// Values of PageClientNavigationReason.
+const (
+	PageClientNavigationReasonAnchorClick            PageClientNavigationReason = "anchorClick"
+	PageClientNavigationReasonFormSubmissionGet      PageClientNavigationReason = "formSubmissionGet"
+	PageClientNavigationReasonFormSubmissionPost     PageClientNavigationReason = "formSubmissionPost"
+	PageClientNavigationReasonHTTPHeaderRefresh      PageClientNavigationReason = "httpHeaderRefresh"
+	PageClientNavigationReasonInitialFrameNavigation PageClientNavigationReason = "initialFrameNavigation"
+	PageClientNavigationReasonMetaTagRefresh         PageClientNavigationReason = "metaTagRefresh"
+	PageClientNavigationReasonOther                  PageClientNavigationReason = "other"
+	PageClientNavigationReasonPageBlockInterstitial  PageClientNavigationReason = "pageBlockInterstitial"
+	PageClientNavigationReasonReload                 PageClientNavigationReason = "reload"
+	PageClientNavigationReasonScriptInitiated        PageClientNavigationReason = "scriptInitiated"
+)
+
+// PageClientNavigationDisposition is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#type-ClientNavigationDisposition
+//
+// This is an experimental part of the protocol.
+type PageClientNavigationDisposition string
+
+// Values of PageClientNavigationDisposition.
+const (
+	PageClientNavigationDispositionCurrentTab PageClientNavigationDisposition = "currentTab"
+	PageClientNavigationDispositionNewTab     PageClientNavigationDisposition = "newTab"
+	PageClientNavigationDispositionNewWindow  PageClientNavigationDisposition = "newWindow"
+	PageClientNavigationDispositionDownload   PageClientNavigationDisposition = "download"
+)
+
+// PageInstallabilityErrorArgument is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#type-InstallabilityErrorArgument
+//
+// This is an experimental part of the protocol.
+type PageInstallabilityErrorArgument struct {
+	// Argument name (e.g. name:'minimum-icon-size-in-pixels').
+	Name string `json:"name"`
+	// Argument value (e.g. value:'64').
+	Value string `json:"value"`
+}
+
+// PageInstallabilityError the installability error
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#type-InstallabilityError
+//
+// This is an experimental part of the protocol.
+type PageInstallabilityError struct {
+	// The error id (e.g. 'manifest-missing-suitable-icon').
+	ErrorID string `json:"errorId"`
+	// The list of error arguments (e.g. {name:'minimum-icon-size-in-pixels',
+	// value:'64'}).
+	ErrorArguments []PageInstallabilityErrorArgument `json:"errorArguments"`
+}
+
+// PageReferrerPolicy the referring-policy used for the navigation.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#type-ReferrerPolicy
+//
+// This is an experimental part of the protocol.
+type PageReferrerPolicy string
+
+// Values of PageReferrerPolicy.
+const (
+	PageReferrerPolicyNoReferrer                  PageReferrerPolicy = "noReferrer"
+	PageReferrerPolicyNoReferrerWhenDowngrade     PageReferrerPolicy = "noReferrerWhenDowngrade"
+	PageReferrerPolicyOrigin                      PageReferrerPolicy = "origin"
+	PageReferrerPolicyOriginWhenCrossOrigin       PageReferrerPolicy = "originWhenCrossOrigin"
+	PageReferrerPolicySameOrigin                  PageReferrerPolicy = "sameOrigin"
+	PageReferrerPolicyStrictOrigin                PageReferrerPolicy = "strictOrigin"
+	PageReferrerPolicyStrictOriginWhenCrossOrigin PageReferrerPolicy = "strictOriginWhenCrossOrigin"
+	PageReferrerPolicyUnsafeURL                   PageReferrerPolicy = "unsafeUrl"
+)
+
+// PageCompilationCacheParams per-script compilation cache parameters for
+// `Page.produceCompilationCache`
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#type-CompilationCacheParams
+//
+// This is an experimental part of the protocol.
+type PageCompilationCacheParams struct {
+	// The URL of the script to produce a compilation cache entry for.
+	URL string `json:"url"`
+	// A hint to the backend whether eager compilation is recommended. (the actual
+	// compilation mode used is upon backend discretion).
+	Eager bool `json:"eager,omitempty"`
+}
+
+// PageFileFilter is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#type-FileFilter
+//
+// This is an experimental part of the protocol.
+type PageFileFilter struct {
+	Name    string   `json:"name,omitempty"`
+	Accepts []string `json:"accepts,omitempty"`
+}
+
+// PageFileHandler is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#type-FileHandler
+//
+// This is an experimental part of the protocol.
+type PageFileHandler struct {
+	Action string              `json:"action"`
+	Name   string              `json:"name"`
+	Icons  []PageImageResource `json:"icons,omitempty"`
+	// Mimic a map, name is the key, accepts is the value.
+	Accepts []PageFileFilter `json:"accepts,omitempty"`
+	// Won't repeat the enums, using string for easy comparison. Same as the other
+	// enums below.
+	LaunchType string `json:"launchType"`
+}
+
+// PageImageResource the image definition used in both icon and screenshot.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#type-ImageResource
+//
+// This is an experimental part of the protocol.
+type PageImageResource struct {
+	// The src field in the definition, but changing to url in favor of
+	// consistency.
+	URL   string `json:"url"`
+	Sizes string `json:"sizes,omitempty"`
+	Type  string `json:"type,omitempty"`
+}
+
+// PageLaunchHandler is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#type-LaunchHandler
+//
+// This is an experimental part of the protocol.
+type PageLaunchHandler struct {
+	ClientMode string `json:"clientMode"`
+}
+
+// PageProtocolHandler is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#type-ProtocolHandler
+//
+// This is an experimental part of the protocol.
+type PageProtocolHandler struct {
+	Protocol string `json:"protocol"`
+	URL      string `json:"url"`
+}
+
+// PageRelatedApplication is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#type-RelatedApplication
+//
+// This is an experimental part of the protocol.
+type PageRelatedApplication struct {
+	ID  string `json:"id,omitempty"`
+	URL string `json:"url"`
+}
+
+// PageScopeExtension is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#type-ScopeExtension
+//
+// This is an experimental part of the protocol.
+type PageScopeExtension struct {
+	// Instead of using tuple, this field always returns the serialized string for
+	// easy understanding and comparison.
+	Origin            string `json:"origin"`
+	HasOriginWildcard bool   `json:"hasOriginWildcard"`
+}
+
+// PageScreenshot is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#type-Screenshot
+//
+// This is an experimental part of the protocol.
+type PageScreenshot struct {
+	Image      PageImageResource `json:"image"`
+	FormFactor string            `json:"formFactor"`
+	Label      string            `json:"label,omitempty"`
+}
+
+// PageShareTarget is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#type-ShareTarget
+//
+// This is an experimental part of the protocol.
+type PageShareTarget struct {
+	Action  string `json:"action"`
+	Method  string `json:"method"`
+	Enctype string `json:"enctype"`
+	// Embed the ShareTargetParams
+	Title string           `json:"title,omitempty"`
+	Text  string           `json:"text,omitempty"`
+	URL   string           `json:"url,omitempty"`
+	Files []PageFileFilter `json:"files,omitempty"`
+}
+
+// PageShortcut is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#type-Shortcut
+//
+// This is an experimental part of the protocol.
+type PageShortcut struct {
+	Name string `json:"name"`
+	URL  string `json:"url"`
+}
+
+// PageWebAppManifest is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#type-WebAppManifest
+//
+// This is an experimental part of the protocol.
+type PageWebAppManifest struct {
+	BackgroundColor string `json:"backgroundColor,omitempty"`
+	// The extra description provided by the manifest.
+	Description string `json:"description,omitempty"`
+	Dir         string `json:"dir,omitempty"`
+	Display     string `json:"display,omitempty"`
+	// The overrided display mode controlled by the user.
+	DisplayOverrides []string `json:"displayOverrides,omitempty"`
+	// The handlers to open files.
+	FileHandlers []PageFileHandler   `json:"fileHandlers,omitempty"`
+	Icons        []PageImageResource `json:"icons,omitempty"`
+	ID           string              `json:"id,omitempty"`
+	Lang         string              `json:"lang,omitempty"`
+	// TODO(crbug.com/1231886): This field is non-standard and part of a Chrome
+	// experiment. See:
+	// https://github.com/WICG/web-app-launch/blob/main/launch_handler.md
+	LaunchHandler             *PageLaunchHandler `json:"launchHandler,omitempty"`
+	Name                      string             `json:"name,omitempty"`
+	Orientation               string             `json:"orientation,omitempty"`
+	PreferRelatedApplications bool               `json:"preferRelatedApplications,omitempty"`
+	// The handlers to open protocols.
+	ProtocolHandlers    []PageProtocolHandler    `json:"protocolHandlers,omitempty"`
+	RelatedApplications []PageRelatedApplication `json:"relatedApplications,omitempty"`
+	Scope               string                   `json:"scope,omitempty"`
+	// Non-standard, see
+	// https://github.com/WICG/manifest-incubations/blob/gh-pages/scope_extensions-explainer.md
+	ScopeExtensions []PageScopeExtension `json:"scopeExtensions,omitempty"`
+	// The screenshots used by chromium.
+	Screenshots []PageScreenshot `json:"screenshots,omitempty"`
+	ShareTarget *PageShareTarget `json:"shareTarget,omitempty"`
+	ShortName   string           `json:"shortName,omitempty"`
+	Shortcuts   []PageShortcut   `json:"shortcuts,omitempty"`
+	StartURL    string           `json:"startUrl,omitempty"`
+	ThemeColor  string           `json:"themeColor,omitempty"`
+}
+
+// PageNavigationType the type of a frameNavigated event.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#type-NavigationType
+//
+// This is an experimental part of the protocol.
+type PageNavigationType string
+
+// Values of PageNavigationType.
+const (
+	PageNavigationTypeNavigation              PageNavigationType = "Navigation"
+	PageNavigationTypeBackForwardCacheRestore PageNavigationType = "BackForwardCacheRestore"
+)
+
+// PageBackForwardCacheNotRestoredReason list of not restored reasons for
+// back-forward cache.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#type-BackForwardCacheNotRestoredReason
+//
+// This is an experimental part of the protocol.
+type PageBackForwardCacheNotRestoredReason string
+
+// Values of PageBackForwardCacheNotRestoredReason.
+const (
+	PageBackForwardCacheNotRestoredReasonNotPrimaryMainFrame                                      PageBackForwardCacheNotRestoredReason = "NotPrimaryMainFrame"
+	PageBackForwardCacheNotRestoredReasonBackForwardCacheDisabled                                 PageBackForwardCacheNotRestoredReason = "BackForwardCacheDisabled"
+	PageBackForwardCacheNotRestoredReasonRelatedActiveContentsExist                               PageBackForwardCacheNotRestoredReason = "RelatedActiveContentsExist"
+	PageBackForwardCacheNotRestoredReasonHTTPStatusNotOK                                          PageBackForwardCacheNotRestoredReason = "HTTPStatusNotOK"
+	PageBackForwardCacheNotRestoredReasonSchemeNotHTTPOrHTTPS                                     PageBackForwardCacheNotRestoredReason = "SchemeNotHTTPOrHTTPS"
+	PageBackForwardCacheNotRestoredReasonLoading                                                  PageBackForwardCacheNotRestoredReason = "Loading"
+	PageBackForwardCacheNotRestoredReasonWasGrantedMediaAccess                                    PageBackForwardCacheNotRestoredReason = "WasGrantedMediaAccess"
+	PageBackForwardCacheNotRestoredReasonDisableForRenderFrameHostCalled                          PageBackForwardCacheNotRestoredReason = "DisableForRenderFrameHostCalled"
+	PageBackForwardCacheNotRestoredReasonDomainNotAllowed                                         PageBackForwardCacheNotRestoredReason = "DomainNotAllowed"
+	PageBackForwardCacheNotRestoredReasonHTTPMethodNotGET                                         PageBackForwardCacheNotRestoredReason = "HTTPMethodNotGET"
+	PageBackForwardCacheNotRestoredReasonSubframeIsNavigating                                     PageBackForwardCacheNotRestoredReason = "SubframeIsNavigating"
+	PageBackForwardCacheNotRestoredReasonTimeout                                                  PageBackForwardCacheNotRestoredReason = "Timeout"
+	PageBackForwardCacheNotRestoredReasonCacheLimit                                               PageBackForwardCacheNotRestoredReason = "CacheLimit"
+	PageBackForwardCacheNotRestoredReasonJavaScriptExecution                                      PageBackForwardCacheNotRestoredReason = "JavaScriptExecution"
+	PageBackForwardCacheNotRestoredReasonRendererProcessKilled                                    PageBackForwardCacheNotRestoredReason = "RendererProcessKilled"
+	PageBackForwardCacheNotRestoredReasonRendererProcessCrashed                                   PageBackForwardCacheNotRestoredReason = "RendererProcessCrashed"
+	PageBackForwardCacheNotRestoredReasonSchedulerTrackedFeatureUsed                              PageBackForwardCacheNotRestoredReason = "SchedulerTrackedFeatureUsed"
+	PageBackForwardCacheNotRestoredReasonConflictingBrowsingInstance                              PageBackForwardCacheNotRestoredReason = "ConflictingBrowsingInstance"
+	PageBackForwardCacheNotRestoredReasonCacheFlushed                                             PageBackForwardCacheNotRestoredReason = "CacheFlushed"
+	PageBackForwardCacheNotRestoredReasonServiceWorkerVersionActivation                           PageBackForwardCacheNotRestoredReason = "ServiceWorkerVersionActivation"
+	PageBackForwardCacheNotRestoredReasonSessionRestored                                          PageBackForwardCacheNotRestoredReason = "SessionRestored"
+	PageBackForwardCacheNotRestoredReasonServiceWorkerPostMessage                                 PageBackForwardCacheNotRestoredReason = "ServiceWorkerPostMessage"
+	PageBackForwardCacheNotRestoredReasonEnteredBackForwardCacheBeforeServiceWorkerHostAdded      PageBackForwardCacheNotRestoredReason = "EnteredBackForwardCacheBeforeServiceWorkerHostAdded"
+	PageBackForwardCacheNotRestoredReasonRenderFrameHostReusedSameSite                            PageBackForwardCacheNotRestoredReason = "RenderFrameHostReused_SameSite"
+	PageBackForwardCacheNotRestoredReasonRenderFrameHostReusedCrossSite                           PageBackForwardCacheNotRestoredReason = "RenderFrameHostReused_CrossSite"
+	PageBackForwardCacheNotRestoredReasonServiceWorkerClaim                                       PageBackForwardCacheNotRestoredReason = "ServiceWorkerClaim"
+	PageBackForwardCacheNotRestoredReasonIgnoreEventAndEvict                                      PageBackForwardCacheNotRestoredReason = "IgnoreEventAndEvict"
+	PageBackForwardCacheNotRestoredReasonHaveInnerContents                                        PageBackForwardCacheNotRestoredReason = "HaveInnerContents"
+	PageBackForwardCacheNotRestoredReasonTimeoutPuttingInCache                                    PageBackForwardCacheNotRestoredReason = "TimeoutPuttingInCache"
+	PageBackForwardCacheNotRestoredReasonBackForwardCacheDisabledByLowMemory                      PageBackForwardCacheNotRestoredReason = "BackForwardCacheDisabledByLowMemory"
+	PageBackForwardCacheNotRestoredReasonBackForwardCacheDisabledByCommandLine                    PageBackForwardCacheNotRestoredReason = "BackForwardCacheDisabledByCommandLine"
+	PageBackForwardCacheNotRestoredReasonNetworkRequestDatapipeDrainedAsBytesConsumer             PageBackForwardCacheNotRestoredReason = "NetworkRequestDatapipeDrainedAsBytesConsumer"
+	PageBackForwardCacheNotRestoredReasonNetworkRequestRedirected                                 PageBackForwardCacheNotRestoredReason = "NetworkRequestRedirected"
+	PageBackForwardCacheNotRestoredReasonNetworkRequestTimeout                                    PageBackForwardCacheNotRestoredReason = "NetworkRequestTimeout"
+	PageBackForwardCacheNotRestoredReasonNetworkExceedsBufferLimit                                PageBackForwardCacheNotRestoredReason = "NetworkExceedsBufferLimit"
+	PageBackForwardCacheNotRestoredReasonNavigationCancelledWhileRestoring                        PageBackForwardCacheNotRestoredReason = "NavigationCancelledWhileRestoring"
+	PageBackForwardCacheNotRestoredReasonNotMostRecentNavigationEntry                             PageBackForwardCacheNotRestoredReason = "NotMostRecentNavigationEntry"
+	PageBackForwardCacheNotRestoredReasonBackForwardCacheDisabledForPrerender                     PageBackForwardCacheNotRestoredReason = "BackForwardCacheDisabledForPrerender"
+	PageBackForwardCacheNotRestoredReasonUserAgentOverrideDiffers                                 PageBackForwardCacheNotRestoredReason = "UserAgentOverrideDiffers"
+	PageBackForwardCacheNotRestoredReasonForegroundCacheLimit                                     PageBackForwardCacheNotRestoredReason = "ForegroundCacheLimit"
+	PageBackForwardCacheNotRestoredReasonBrowsingInstanceNotSwapped                               PageBackForwardCacheNotRestoredReason = "BrowsingInstanceNotSwapped"
+	PageBackForwardCacheNotRestoredReasonBackForwardCacheDisabledForDelegate                      PageBackForwardCacheNotRestoredReason = "BackForwardCacheDisabledForDelegate"
+	PageBackForwardCacheNotRestoredReasonUnloadHandlerExistsInMainFrame                           PageBackForwardCacheNotRestoredReason = "UnloadHandlerExistsInMainFrame"
+	PageBackForwardCacheNotRestoredReasonUnloadHandlerExistsInSubFrame                            PageBackForwardCacheNotRestoredReason = "UnloadHandlerExistsInSubFrame"
+	PageBackForwardCacheNotRestoredReasonServiceWorkerUnregistration                              PageBackForwardCacheNotRestoredReason = "ServiceWorkerUnregistration"
+	PageBackForwardCacheNotRestoredReasonCacheControlNoStore                                      PageBackForwardCacheNotRestoredReason = "CacheControlNoStore"
+	PageBackForwardCacheNotRestoredReasonCacheControlNoStoreCookieModified                        PageBackForwardCacheNotRestoredReason = "CacheControlNoStoreCookieModified"
+	PageBackForwardCacheNotRestoredReasonCacheControlNoStoreHTTPOnlyCookieModified                PageBackForwardCacheNotRestoredReason = "CacheControlNoStoreHTTPOnlyCookieModified"
+	PageBackForwardCacheNotRestoredReasonNoResponseHead                                           PageBackForwardCacheNotRestoredReason = "NoResponseHead"
+	PageBackForwardCacheNotRestoredReasonUnknown                                                  PageBackForwardCacheNotRestoredReason = "Unknown"
+	PageBackForwardCacheNotRestoredReasonActivationNavigationsDisallowedForBug1234857             PageBackForwardCacheNotRestoredReason = "ActivationNavigationsDisallowedForBug1234857"
+	PageBackForwardCacheNotRestoredReasonErrorDocument                                            PageBackForwardCacheNotRestoredReason = "ErrorDocument"
+	PageBackForwardCacheNotRestoredReasonFencedFramesEmbedder                                     PageBackForwardCacheNotRestoredReason = "FencedFramesEmbedder"
+	PageBackForwardCacheNotRestoredReasonCookieDisabled                                           PageBackForwardCacheNotRestoredReason = "CookieDisabled"
+	PageBackForwardCacheNotRestoredReasonHTTPAuthRequired                                         PageBackForwardCacheNotRestoredReason = "HTTPAuthRequired"
+	PageBackForwardCacheNotRestoredReasonCookieFlushed                                            PageBackForwardCacheNotRestoredReason = "CookieFlushed"
+	PageBackForwardCacheNotRestoredReasonBroadcastChannelOnMessage                                PageBackForwardCacheNotRestoredReason = "BroadcastChannelOnMessage"
+	PageBackForwardCacheNotRestoredReasonWebViewSettingsChanged                                   PageBackForwardCacheNotRestoredReason = "WebViewSettingsChanged"
+	PageBackForwardCacheNotRestoredReasonWebViewJavaScriptObjectChanged                           PageBackForwardCacheNotRestoredReason = "WebViewJavaScriptObjectChanged"
+	PageBackForwardCacheNotRestoredReasonWebViewMessageListenerInjected                           PageBackForwardCacheNotRestoredReason = "WebViewMessageListenerInjected"
+	PageBackForwardCacheNotRestoredReasonWebViewSafeBrowsingAllowlistChanged                      PageBackForwardCacheNotRestoredReason = "WebViewSafeBrowsingAllowlistChanged"
+	PageBackForwardCacheNotRestoredReasonWebViewDocumentStartJavascriptChanged                    PageBackForwardCacheNotRestoredReason = "WebViewDocumentStartJavascriptChanged"
+	PageBackForwardCacheNotRestoredReasonWebSocket                                                PageBackForwardCacheNotRestoredReason = "WebSocket"
+	PageBackForwardCacheNotRestoredReasonWebTransport                                             PageBackForwardCacheNotRestoredReason = "WebTransport"
+	PageBackForwardCacheNotRestoredReasonWebRTC                                                   PageBackForwardCacheNotRestoredReason = "WebRTC"
+	PageBackForwardCacheNotRestoredReasonMainResourceHasCacheControlNoStore                       PageBackForwardCacheNotRestoredReason = "MainResourceHasCacheControlNoStore"
+	PageBackForwardCacheNotRestoredReasonMainResourceHasCacheControlNoCache                       PageBackForwardCacheNotRestoredReason = "MainResourceHasCacheControlNoCache"
+	PageBackForwardCacheNotRestoredReasonSubresourceHasCacheControlNoStore                        PageBackForwardCacheNotRestoredReason = "SubresourceHasCacheControlNoStore"
+	PageBackForwardCacheNotRestoredReasonSubresourceHasCacheControlNoCache                        PageBackForwardCacheNotRestoredReason = "SubresourceHasCacheControlNoCache"
+	PageBackForwardCacheNotRestoredReasonContainsPlugins                                          PageBackForwardCacheNotRestoredReason = "ContainsPlugins"
+	PageBackForwardCacheNotRestoredReasonDocumentLoaded                                           PageBackForwardCacheNotRestoredReason = "DocumentLoaded"
+	PageBackForwardCacheNotRestoredReasonOutstandingNetworkRequestOthers                          PageBackForwardCacheNotRestoredReason = "OutstandingNetworkRequestOthers"
+	PageBackForwardCacheNotRestoredReasonRequestedMIDIPermission                                  PageBackForwardCacheNotRestoredReason = "RequestedMIDIPermission"
+	PageBackForwardCacheNotRestoredReasonRequestedAudioCapturePermission                          PageBackForwardCacheNotRestoredReason = "RequestedAudioCapturePermission"
+	PageBackForwardCacheNotRestoredReasonRequestedVideoCapturePermission                          PageBackForwardCacheNotRestoredReason = "RequestedVideoCapturePermission"
+	PageBackForwardCacheNotRestoredReasonRequestedBackForwardCacheBlockedSensors                  PageBackForwardCacheNotRestoredReason = "RequestedBackForwardCacheBlockedSensors"
+	PageBackForwardCacheNotRestoredReasonRequestedBackgroundWorkPermission                        PageBackForwardCacheNotRestoredReason = "RequestedBackgroundWorkPermission"
+	PageBackForwardCacheNotRestoredReasonBroadcastChannel                                         PageBackForwardCacheNotRestoredReason = "BroadcastChannel"
+	PageBackForwardCacheNotRestoredReasonWebXR                                                    PageBackForwardCacheNotRestoredReason = "WebXR"
+	PageBackForwardCacheNotRestoredReasonSharedWorker                                             PageBackForwardCacheNotRestoredReason = "SharedWorker"
+	PageBackForwardCacheNotRestoredReasonSharedWorkerMessage                                      PageBackForwardCacheNotRestoredReason = "SharedWorkerMessage"
+	PageBackForwardCacheNotRestoredReasonWebLocks                                                 PageBackForwardCacheNotRestoredReason = "WebLocks"
+	PageBackForwardCacheNotRestoredReasonWebHID                                                   PageBackForwardCacheNotRestoredReason = "WebHID"
+	PageBackForwardCacheNotRestoredReasonWebShare                                                 PageBackForwardCacheNotRestoredReason = "WebShare"
+	PageBackForwardCacheNotRestoredReasonRequestedStorageAccessGrant                              PageBackForwardCacheNotRestoredReason = "RequestedStorageAccessGrant"
+	PageBackForwardCacheNotRestoredReasonWebNfc                                                   PageBackForwardCacheNotRestoredReason = "WebNfc"
+	PageBackForwardCacheNotRestoredReasonOutstandingNetworkRequestFetch                           PageBackForwardCacheNotRestoredReason = "OutstandingNetworkRequestFetch"
+	PageBackForwardCacheNotRestoredReasonOutstandingNetworkRequestXHR                             PageBackForwardCacheNotRestoredReason = "OutstandingNetworkRequestXHR"
+	PageBackForwardCacheNotRestoredReasonAppBanner                                                PageBackForwardCacheNotRestoredReason = "AppBanner"
+	PageBackForwardCacheNotRestoredReasonPrinting                                                 PageBackForwardCacheNotRestoredReason = "Printing"
+	PageBackForwardCacheNotRestoredReasonWebDatabase                                              PageBackForwardCacheNotRestoredReason = "WebDatabase"
+	PageBackForwardCacheNotRestoredReasonPictureInPicture                                         PageBackForwardCacheNotRestoredReason = "PictureInPicture"
+	PageBackForwardCacheNotRestoredReasonSpeechRecognizer                                         PageBackForwardCacheNotRestoredReason = "SpeechRecognizer"
+	PageBackForwardCacheNotRestoredReasonIdleManager                                              PageBackForwardCacheNotRestoredReason = "IdleManager"
+	PageBackForwardCacheNotRestoredReasonPaymentManager                                           PageBackForwardCacheNotRestoredReason = "PaymentManager"
+	PageBackForwardCacheNotRestoredReasonSpeechSynthesis                                          PageBackForwardCacheNotRestoredReason = "SpeechSynthesis"
+	PageBackForwardCacheNotRestoredReasonKeyboardLock                                             PageBackForwardCacheNotRestoredReason = "KeyboardLock"
+	PageBackForwardCacheNotRestoredReasonWebOTPService                                            PageBackForwardCacheNotRestoredReason = "WebOTPService"
+	PageBackForwardCacheNotRestoredReasonOutstandingNetworkRequestDirectSocket                    PageBackForwardCacheNotRestoredReason = "OutstandingNetworkRequestDirectSocket"
+	PageBackForwardCacheNotRestoredReasonInjectedJavascript                                       PageBackForwardCacheNotRestoredReason = "InjectedJavascript"
+	PageBackForwardCacheNotRestoredReasonInjectedStyleSheet                                       PageBackForwardCacheNotRestoredReason = "InjectedStyleSheet"
+	PageBackForwardCacheNotRestoredReasonKeepaliveRequest                                         PageBackForwardCacheNotRestoredReason = "KeepaliveRequest"
+	PageBackForwardCacheNotRestoredReasonIndexedDBEvent                                           PageBackForwardCacheNotRestoredReason = "IndexedDBEvent"
+	PageBackForwardCacheNotRestoredReasonDummy                                                    PageBackForwardCacheNotRestoredReason = "Dummy"
+	PageBackForwardCacheNotRestoredReasonJSNetworkRequestReceivedCacheControlNoStoreResource      PageBackForwardCacheNotRestoredReason = "JsNetworkRequestReceivedCacheControlNoStoreResource"
+	PageBackForwardCacheNotRestoredReasonWebRTCSticky                                             PageBackForwardCacheNotRestoredReason = "WebRTCSticky"
+	PageBackForwardCacheNotRestoredReasonWebTransportSticky                                       PageBackForwardCacheNotRestoredReason = "WebTransportSticky"
+	PageBackForwardCacheNotRestoredReasonWebSocketSticky                                          PageBackForwardCacheNotRestoredReason = "WebSocketSticky"
+	PageBackForwardCacheNotRestoredReasonSmartCard                                                PageBackForwardCacheNotRestoredReason = "SmartCard"
+	PageBackForwardCacheNotRestoredReasonLiveMediaStreamTrack                                     PageBackForwardCacheNotRestoredReason = "LiveMediaStreamTrack"
+	PageBackForwardCacheNotRestoredReasonUnloadHandler                                            PageBackForwardCacheNotRestoredReason = "UnloadHandler"
+	PageBackForwardCacheNotRestoredReasonParserAborted                                            PageBackForwardCacheNotRestoredReason = "ParserAborted"
+	PageBackForwardCacheNotRestoredReasonContentSecurityHandler                                   PageBackForwardCacheNotRestoredReason = "ContentSecurityHandler"
+	PageBackForwardCacheNotRestoredReasonContentWebAuthenticationAPI                              PageBackForwardCacheNotRestoredReason = "ContentWebAuthenticationAPI"
+	PageBackForwardCacheNotRestoredReasonContentFileChooser                                       PageBackForwardCacheNotRestoredReason = "ContentFileChooser"
+	PageBackForwardCacheNotRestoredReasonContentSerial                                            PageBackForwardCacheNotRestoredReason = "ContentSerial"
+	PageBackForwardCacheNotRestoredReasonContentFileSystemAccess                                  PageBackForwardCacheNotRestoredReason = "ContentFileSystemAccess"
+	PageBackForwardCacheNotRestoredReasonContentMediaDevicesDispatcherHost                        PageBackForwardCacheNotRestoredReason = "ContentMediaDevicesDispatcherHost"
+	PageBackForwardCacheNotRestoredReasonContentWebBluetooth                                      PageBackForwardCacheNotRestoredReason = "ContentWebBluetooth"
+	PageBackForwardCacheNotRestoredReasonContentWebUSB                                            PageBackForwardCacheNotRestoredReason = "ContentWebUSB"
+	PageBackForwardCacheNotRestoredReasonContentMediaSessionService                               PageBackForwardCacheNotRestoredReason = "ContentMediaSessionService"
+	PageBackForwardCacheNotRestoredReasonContentScreenReader                                      PageBackForwardCacheNotRestoredReason = "ContentScreenReader"
+	PageBackForwardCacheNotRestoredReasonContentDiscarded                                         PageBackForwardCacheNotRestoredReason = "ContentDiscarded"
+	PageBackForwardCacheNotRestoredReasonEmbedderPopupBlockerTabHelper                            PageBackForwardCacheNotRestoredReason = "EmbedderPopupBlockerTabHelper"
+	PageBackForwardCacheNotRestoredReasonEmbedderSafeBrowsingTriggeredPopupBlocker                PageBackForwardCacheNotRestoredReason = "EmbedderSafeBrowsingTriggeredPopupBlocker"
+	PageBackForwardCacheNotRestoredReasonEmbedderSafeBrowsingThreatDetails                        PageBackForwardCacheNotRestoredReason = "EmbedderSafeBrowsingThreatDetails"
+	PageBackForwardCacheNotRestoredReasonEmbedderAppBannerManager                                 PageBackForwardCacheNotRestoredReason = "EmbedderAppBannerManager"
+	PageBackForwardCacheNotRestoredReasonEmbedderDOMDistillerViewerSource                         PageBackForwardCacheNotRestoredReason = "EmbedderDomDistillerViewerSource"
+	PageBackForwardCacheNotRestoredReasonEmbedderDOMDistillerSelfDeletingRequestDelegate          PageBackForwardCacheNotRestoredReason = "EmbedderDomDistillerSelfDeletingRequestDelegate"
+	PageBackForwardCacheNotRestoredReasonEmbedderOomInterventionTabHelper                         PageBackForwardCacheNotRestoredReason = "EmbedderOomInterventionTabHelper"
+	PageBackForwardCacheNotRestoredReasonEmbedderOfflinePage                                      PageBackForwardCacheNotRestoredReason = "EmbedderOfflinePage"
+	PageBackForwardCacheNotRestoredReasonEmbedderChromePasswordManagerClientBindCredentialManager PageBackForwardCacheNotRestoredReason = "EmbedderChromePasswordManagerClientBindCredentialManager"
+	PageBackForwardCacheNotRestoredReasonEmbedderPermissionRequestManager                         PageBackForwardCacheNotRestoredReason = "EmbedderPermissionRequestManager"
+	PageBackForwardCacheNotRestoredReasonEmbedderModalDialog                                      PageBackForwardCacheNotRestoredReason = "EmbedderModalDialog"
+	PageBackForwardCacheNotRestoredReasonEmbedderExtensions                                       PageBackForwardCacheNotRestoredReason = "EmbedderExtensions"
+	PageBackForwardCacheNotRestoredReasonEmbedderExtensionMessaging                               PageBackForwardCacheNotRestoredReason = "EmbedderExtensionMessaging"
+	PageBackForwardCacheNotRestoredReasonEmbedderExtensionMessagingForOpenPort                    PageBackForwardCacheNotRestoredReason = "EmbedderExtensionMessagingForOpenPort"
+	PageBackForwardCacheNotRestoredReasonEmbedderExtensionSentMessageToCachedFrame                PageBackForwardCacheNotRestoredReason = "EmbedderExtensionSentMessageToCachedFrame"
+	PageBackForwardCacheNotRestoredReasonRequestedByWebViewClient                                 PageBackForwardCacheNotRestoredReason = "RequestedByWebViewClient"
+	PageBackForwardCacheNotRestoredReasonPostMessageByWebViewClient                               PageBackForwardCacheNotRestoredReason = "PostMessageByWebViewClient"
+	PageBackForwardCacheNotRestoredReasonCacheControlNoStoreDeviceBoundSessionTerminated          PageBackForwardCacheNotRestoredReason = "CacheControlNoStoreDeviceBoundSessionTerminated"
+	PageBackForwardCacheNotRestoredReasonCacheLimitPrunedOnModerateMemoryPressure                 PageBackForwardCacheNotRestoredReason = "CacheLimitPrunedOnModerateMemoryPressure"
+	PageBackForwardCacheNotRestoredReasonCacheLimitPrunedOnCriticalMemoryPressure                 PageBackForwardCacheNotRestoredReason = "CacheLimitPrunedOnCriticalMemoryPressure"
+)
+
+// PageBackForwardCacheNotRestoredReasonType types of not restored reasons for
+// back-forward cache.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#type-BackForwardCacheNotRestoredReasonType
+//
+// This is an experimental part of the protocol.
+type PageBackForwardCacheNotRestoredReasonType string
+
+// Values of PageBackForwardCacheNotRestoredReasonType.
+const (
+	PageBackForwardCacheNotRestoredReasonTypeSupportPending    PageBackForwardCacheNotRestoredReasonType = "SupportPending"
+	PageBackForwardCacheNotRestoredReasonTypePageSupportNeeded PageBackForwardCacheNotRestoredReasonType = "PageSupportNeeded"
+	PageBackForwardCacheNotRestoredReasonTypeCircumstantial    PageBackForwardCacheNotRestoredReasonType = "Circumstantial"
+)
+
+// PageBackForwardCacheBlockingDetails is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#type-BackForwardCacheBlockingDetails
+//
+// This is an experimental part of the protocol.
+type PageBackForwardCacheBlockingDetails struct {
+	// Url of the file where blockage happened. Optional because of tests.
+	URL string `json:"url,omitempty"`
+	// Function name where blockage happened. Optional because of anonymous
+	// functions and tests.
+	Function string `json:"function,omitempty"`
+	// Line number in the script (0-based).
+	LineNumber int64 `json:"lineNumber"`
+	// Column number in the script (0-based).
+	ColumnNumber int64 `json:"columnNumber"`
+}
+
+// PageBackForwardCacheNotRestoredExplanation is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#type-BackForwardCacheNotRestoredExplanation
+//
+// This is an experimental part of the protocol.
+type PageBackForwardCacheNotRestoredExplanation struct {
+	// Type of the reason
+	Type PageBackForwardCacheNotRestoredReasonType `json:"type"`
+	// Not restored reason
+	Reason PageBackForwardCacheNotRestoredReason `json:"reason"`
+	// Context associated with the reason. The meaning of this context is dependent
+	// on the reason:
+	// - EmbedderExtensionSentMessageToCachedFrame: the extension ID.
+	Context string                                `json:"context,omitempty"`
+	Details []PageBackForwardCacheBlockingDetails `json:"details,omitempty"`
+}
+
+// PageBackForwardCacheNotRestoredExplanationTree is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#type-BackForwardCacheNotRestoredExplanationTree
+//
+// This is an experimental part of the protocol.
+type PageBackForwardCacheNotRestoredExplanationTree struct {
+	// URL of each frame
+	URL string `json:"url"`
+	// Not restored reasons of each frame
+	Explanations []PageBackForwardCacheNotRestoredExplanation `json:"explanations"`
+	// Array of children frame
+	Children []PageBackForwardCacheNotRestoredExplanationTree `json:"children"`
+}
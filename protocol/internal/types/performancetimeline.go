@@ -0,0 +1,64 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package types
+
+// PerformanceTimelineLargestContentfulPaint see
+// https://github.com/WICG/LargestContentfulPaint and
+// largest_contentful_paint.idl
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/PerformanceTimeline#type-LargestContentfulPaint
+type PerformanceTimelineLargestContentfulPaint struct {
+	RenderTime NetworkTimeSinceEpoch `json:"renderTime"`
+	LoadTime   NetworkTimeSinceEpoch `json:"loadTime"`
+	// The number of pixels being painted.
+	Size float64 `json:"size"`
+	// The id attribute of the element, if available.
+	ElementID string `json:"elementId,omitempty"`
+	// The URL of the image (may be trimmed).
+	URL    string           `json:"url,omitempty"`
+	NodeID DOMBackendNodeID `json:"nodeId,omitempty"`
+}
+
+// PerformanceTimelineLayoutShiftAttribution is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/PerformanceTimeline#type-LayoutShiftAttribution
+type PerformanceTimelineLayoutShiftAttribution struct {
+	PreviousRect DOMRect          `json:"previousRect"`
+	CurrentRect  DOMRect          `json:"currentRect"`
+	NodeID       DOMBackendNodeID `json:"nodeId,omitempty"`
+}
+
+// PerformanceTimelineLayoutShift see
+// https://wicg.github.io/layout-instability/#sec-layout-shift and
+// layout_shift.idl
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/PerformanceTimeline#type-LayoutShift
+type PerformanceTimelineLayoutShift struct {
+	// Score increment produced by this event.
+	Value          float64                                     `json:"value"`
+	HadRecentInput bool                                        `json:"hadRecentInput"`
+	LastInputTime  NetworkTimeSinceEpoch                       `json:"lastInputTime"`
+	Sources        []PerformanceTimelineLayoutShiftAttribution `json:"sources"`
+}
+
+// PerformanceTimelineTimelineEvent is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/PerformanceTimeline#type-TimelineEvent
+type PerformanceTimelineTimelineEvent struct {
+	// Identifies the frame that this event is related to. Empty for non-frame
+	// targets.
+	FrameID PageFrameID `json:"frameId"`
+	// The event type, as specified in
+	// https://w3c.github.io/performance-timeline/#dom-performanceentry-entrytype
+	// This determines which of the optional "details" fields is present.
+	Type string `json:"type"`
+	// Name may be empty depending on the type.
+	Name string `json:"name"`
+	// Time in seconds since Epoch, monotonically increasing within document
+	// lifetime.
+	Time NetworkTimeSinceEpoch `json:"time"`
+	// Event duration, if applicable.
+	Duration           float64                                    `json:"duration,omitempty"`
+	LcpDetails         *PerformanceTimelineLargestContentfulPaint `json:"lcpDetails,omitempty"`
+	LayoutShiftDetails *PerformanceTimelineLayoutShift            `json:"layoutShiftDetails,omitempty"`
+}
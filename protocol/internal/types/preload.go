@@ -0,0 +1,277 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package types
+
+// PreloadRuleSetID unique id
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Preload#type-RuleSetId
+type PreloadRuleSetID string
+
+// PreloadRuleSet corresponds to SpeculationRuleSet
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Preload#type-RuleSet
+type PreloadRuleSet struct {
+	ID PreloadRuleSetID `json:"id"`
+	// Identifies a document which the rule set is associated with.
+	LoaderID NetworkLoaderID `json:"loaderId"`
+	// Source text of JSON representing the rule set. If it comes from `<script>`
+	// tag, it is the textContent of the node. Note that it is a JSON for valid
+	// case.
+	//
+	// See also:
+	// - https://wicg.github.io/nav-speculation/speculation-rules.html
+	// - https://github.com/WICG/nav-speculation/blob/main/triggers.md
+	SourceText string `json:"sourceText"`
+	// A speculation rule set is either added through an inline `<script>` tag or
+	// through an external resource via the 'Speculation-Rules' HTTP header. For
+	// the first case, we include the BackendNodeId of the relevant `<script>` tag.
+	// For the second case, we include the external URL where the rule set was
+	// loaded from, and also RequestId if Network domain is enabled.
+	//
+	// See also:
+	// -
+	// https://wicg.github.io/nav-speculation/speculation-rules.html#speculation-rules-script
+	// -
+	// https://wicg.github.io/nav-speculation/speculation-rules.html#speculation-rules-header
+	BackendNodeID DOMBackendNodeID `json:"backendNodeId,omitempty"`
+	URL           string           `json:"url,omitempty"`
+	RequestID     NetworkRequestID `json:"requestId,omitempty"`
+	// Error information `errorMessage` is null iff `errorType` is null.
+	ErrorType PreloadRuleSetErrorType `json:"errorType,omitempty"`
+	// TODO(https://crbug.com/1425354): Replace this property with structured
+	// error.
+	//
+	// Deprecated: this is deprecated in the protocol.
+	ErrorMessage string `json:"errorMessage,omitempty"`
+}
+
+// PreloadRuleSetErrorType is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Preload#type-RuleSetErrorType
+type PreloadRuleSetErrorType string
+
+// Values of PreloadRuleSetErrorType.
+const (
+	PreloadRuleSetErrorTypeSourceIsNotJSONObject  PreloadRuleSetErrorType = "SourceIsNotJsonObject"
+	PreloadRuleSetErrorTypeInvalidRulesSkipped    PreloadRuleSetErrorType = "InvalidRulesSkipped"
+	PreloadRuleSetErrorTypeInvalidRulesetLevelTag PreloadRuleSetErrorType = "InvalidRulesetLevelTag"
+)
+
+// PreloadSpeculationAction the type of preloading attempted. It corresponds to
+// mojom::SpeculationAction (although PrefetchWithSubresources is omitted as it
+// isn't being used by clients).
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Preload#type-SpeculationAction
+type PreloadSpeculationAction string
+
+// Values of PreloadSpeculationAction.
+const (
+	PreloadSpeculationActionPrefetch  PreloadSpeculationAction = "Prefetch"
+	PreloadSpeculationActionPrerender PreloadSpeculationAction = "Prerender"
+)
+
+// PreloadSpeculationTargetHint corresponds to mojom::SpeculationTargetHint. See
+// https://github.com/WICG/nav-speculation/blob/main/triggers.md#window-name-targeting-hints
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Preload#type-SpeculationTargetHint
+type PreloadSpeculationTargetHint string
+
+// Values of PreloadSpeculationTargetHint.
+const (
+	PreloadSpeculationTargetHintBlank PreloadSpeculationTargetHint = "Blank"
+	PreloadSpeculationTargetHintSelf  PreloadSpeculationTargetHint = "Self"
+)
+
+// PreloadPreloadingAttemptKey a key that identifies a preloading attempt.
+//
+// The url used is the url specified by the trigger (i.e. the initial URL), and
+// not the final url that is navigated to. For example, prerendering allows
+// same-origin main frame navigations during the attempt, but the attempt is
+// still keyed with the initial URL.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Preload#type-PreloadingAttemptKey
+type PreloadPreloadingAttemptKey struct {
+	LoaderID   NetworkLoaderID              `json:"loaderId"`
+	Action     PreloadSpeculationAction     `json:"action"`
+	URL        string                       `json:"url"`
+	TargetHint PreloadSpeculationTargetHint `json:"targetHint,omitempty"`
+}
+
+// PreloadPreloadingAttemptSource lists sources for a preloading attempt,
+// specifically the ids of rule sets that had a speculation rule that triggered
+// the attempt, and the BackendNodeIds of <a href> or <area href> elements that
+// triggered the attempt (in the case of attempts triggered by a document rule).
+// It is possible for multiple rule sets and links to trigger a single attempt.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Preload#type-PreloadingAttemptSource
+type PreloadPreloadingAttemptSource struct {
+	Key        PreloadPreloadingAttemptKey `json:"key"`
+	RuleSetIds []PreloadRuleSetID          `json:"ruleSetIds"`
+	NodeIds    []DOMBackendNodeID          `json:"nodeIds"`
+}
+
+// PreloadPreloadPipelineID chrome manages different types of preloads together
+// using a concept of preloading pipeline. For example, if a site uses a
+// SpeculationRules for prerender, Chrome first starts a prefetch and then
+// upgrades it to prerender.
+//
+// CDP events for them are emitted separately but they share
+// `PreloadPipelineId`.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Preload#type-PreloadPipelineId
+type PreloadPreloadPipelineID string
+
+// PreloadPrerenderFinalStatus list of FinalStatus reasons for Prerender2.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Preload#type-PrerenderFinalStatus
+type PreloadPrerenderFinalStatus string
+
+// Values of PreloadPrerenderFinalStatus.
+const (
+	PreloadPrerenderFinalStatusActivated                                                  PreloadPrerenderFinalStatus = "Activated"
+	PreloadPrerenderFinalStatusDestroyed                                                  PreloadPrerenderFinalStatus = "Destroyed"
+	PreloadPrerenderFinalStatusLowEndDevice                                               PreloadPrerenderFinalStatus = "LowEndDevice"
+	PreloadPrerenderFinalStatusInvalidSchemeRedirect                                      PreloadPrerenderFinalStatus = "InvalidSchemeRedirect"
+	PreloadPrerenderFinalStatusInvalidSchemeNavigation                                    PreloadPrerenderFinalStatus = "InvalidSchemeNavigation"
+	PreloadPrerenderFinalStatusNavigationRequestBlockedByCsp                              PreloadPrerenderFinalStatus = "NavigationRequestBlockedByCsp"
+	PreloadPrerenderFinalStatusMojoBinderPolicy                                           PreloadPrerenderFinalStatus = "MojoBinderPolicy"
+	PreloadPrerenderFinalStatusRendererProcessCrashed                                     PreloadPrerenderFinalStatus = "RendererProcessCrashed"
+	PreloadPrerenderFinalStatusRendererProcessKilled                                      PreloadPrerenderFinalStatus = "RendererProcessKilled"
+	PreloadPrerenderFinalStatusDownload                                                   PreloadPrerenderFinalStatus = "Download"
+	PreloadPrerenderFinalStatusTriggerDestroyed                                           PreloadPrerenderFinalStatus = "TriggerDestroyed"
+	PreloadPrerenderFinalStatusNavigationNotCommitted                                     PreloadPrerenderFinalStatus = "NavigationNotCommitted"
+	PreloadPrerenderFinalStatusNavigationBadHTTPStatus                                    PreloadPrerenderFinalStatus = "NavigationBadHttpStatus"
+	PreloadPrerenderFinalStatusClientCertRequested                                        PreloadPrerenderFinalStatus = "ClientCertRequested"
+	PreloadPrerenderFinalStatusNavigationRequestNetworkError                              PreloadPrerenderFinalStatus = "NavigationRequestNetworkError"
+	PreloadPrerenderFinalStatusCancelAllHostsForTesting                                   PreloadPrerenderFinalStatus = "CancelAllHostsForTesting"
+	PreloadPrerenderFinalStatusDidFailLoad                                                PreloadPrerenderFinalStatus = "DidFailLoad"
+	PreloadPrerenderFinalStatusStop                                                       PreloadPrerenderFinalStatus = "Stop"
+	PreloadPrerenderFinalStatusSslCertificateError                                        PreloadPrerenderFinalStatus = "SslCertificateError"
+	PreloadPrerenderFinalStatusLoginAuthRequested                                         PreloadPrerenderFinalStatus = "LoginAuthRequested"
+	PreloadPrerenderFinalStatusUaChangeRequiresReload                                     PreloadPrerenderFinalStatus = "UaChangeRequiresReload"
+	PreloadPrerenderFinalStatusBlockedByClient                                            PreloadPrerenderFinalStatus = "BlockedByClient"
+	PreloadPrerenderFinalStatusAudioOutputDeviceRequested                                 PreloadPrerenderFinalStatus = "AudioOutputDeviceRequested"
+	PreloadPrerenderFinalStatusMixedContent                                               PreloadPrerenderFinalStatus = "MixedContent"
+	PreloadPrerenderFinalStatusTriggerBackgrounded                                        PreloadPrerenderFinalStatus = "TriggerBackgrounded"
+	PreloadPrerenderFinalStatusMemoryLimitExceeded                                        PreloadPrerenderFinalStatus = "MemoryLimitExceeded"
+	PreloadPrerenderFinalStatusDataSaverEnabled                                           PreloadPrerenderFinalStatus = "DataSaverEnabled"
+	PreloadPrerenderFinalStatusTriggerURLHasEffectiveURL                                  PreloadPrerenderFinalStatus = "TriggerUrlHasEffectiveUrl"
+	PreloadPrerenderFinalStatusActivatedBeforeStarted                                     PreloadPrerenderFinalStatus = "ActivatedBeforeStarted"
+	PreloadPrerenderFinalStatusInactivePageRestriction                                    PreloadPrerenderFinalStatus = "InactivePageRestriction"
+	PreloadPrerenderFinalStatusStartFailed                                                PreloadPrerenderFinalStatus = "StartFailed"
+	PreloadPrerenderFinalStatusTimeoutBackgrounded                                        PreloadPrerenderFinalStatus = "TimeoutBackgrounded"
+	PreloadPrerenderFinalStatusCrossSiteRedirectInInitialNavigation                       PreloadPrerenderFinalStatus = "CrossSiteRedirectInInitialNavigation"
+	PreloadPrerenderFinalStatusCrossSiteNavigationInInitialNavigation                     PreloadPrerenderFinalStatus = "CrossSiteNavigationInInitialNavigation"
+	PreloadPrerenderFinalStatusSameSiteCrossOriginRedirectNotOptInInInitialNavigation     PreloadPrerenderFinalStatus = "SameSiteCrossOriginRedirectNotOptInInInitialNavigation"
+	PreloadPrerenderFinalStatusSameSiteCrossOriginNavigationNotOptInInInitialNavigation   PreloadPrerenderFinalStatus = "SameSiteCrossOriginNavigationNotOptInInInitialNavigation"
+	PreloadPrerenderFinalStatusActivationNavigationParameterMismatch                      PreloadPrerenderFinalStatus = "ActivationNavigationParameterMismatch"
+	PreloadPrerenderFinalStatusActivatedInBackground                                      PreloadPrerenderFinalStatus = "ActivatedInBackground"
+	PreloadPrerenderFinalStatusEmbedderHostDisallowed                                     PreloadPrerenderFinalStatus = "EmbedderHostDisallowed"
+	PreloadPrerenderFinalStatusActivationNavigationDestroyedBeforeSuccess                 PreloadPrerenderFinalStatus = "ActivationNavigationDestroyedBeforeSuccess"
+	PreloadPrerenderFinalStatusTabClosedByUserGesture                                     PreloadPrerenderFinalStatus = "TabClosedByUserGesture"
+	PreloadPrerenderFinalStatusTabClosedWithoutUserGesture                                PreloadPrerenderFinalStatus = "TabClosedWithoutUserGesture"
+	PreloadPrerenderFinalStatusPrimaryMainFrameRendererProcessCrashed                     PreloadPrerenderFinalStatus = "PrimaryMainFrameRendererProcessCrashed"
+	PreloadPrerenderFinalStatusPrimaryMainFrameRendererProcessKilled                      PreloadPrerenderFinalStatus = "PrimaryMainFrameRendererProcessKilled"
+	PreloadPrerenderFinalStatusActivationFramePolicyNotCompatible                         PreloadPrerenderFinalStatus = "ActivationFramePolicyNotCompatible"
+	PreloadPrerenderFinalStatusPreloadingDisabled                                         PreloadPrerenderFinalStatus = "PreloadingDisabled"
+	PreloadPrerenderFinalStatusBatterySaverEnabled                                        PreloadPrerenderFinalStatus = "BatterySaverEnabled"
+	PreloadPrerenderFinalStatusActivatedDuringMainFrameNavigation                         PreloadPrerenderFinalStatus = "ActivatedDuringMainFrameNavigation"
+	PreloadPrerenderFinalStatusPreloadingUnsupportedByWebContents                         PreloadPrerenderFinalStatus = "PreloadingUnsupportedByWebContents"
+	PreloadPrerenderFinalStatusCrossSiteRedirectInMainFrameNavigation                     PreloadPrerenderFinalStatus = "CrossSiteRedirectInMainFrameNavigation"
+	PreloadPrerenderFinalStatusCrossSiteNavigationInMainFrameNavigation                   PreloadPrerenderFinalStatus = "CrossSiteNavigationInMainFrameNavigation"
+	PreloadPrerenderFinalStatusSameSiteCrossOriginRedirectNotOptInInMainFrameNavigation   PreloadPrerenderFinalStatus = "SameSiteCrossOriginRedirectNotOptInInMainFrameNavigation"
+	PreloadPrerenderFinalStatusSameSiteCrossOriginNavigationNotOptInInMainFrameNavigation PreloadPrerenderFinalStatus = "SameSiteCrossOriginNavigationNotOptInInMainFrameNavigation"
+	PreloadPrerenderFinalStatusMemoryPressureOnTrigger                                    PreloadPrerenderFinalStatus = "MemoryPressureOnTrigger"
+	PreloadPrerenderFinalStatusMemoryPressureAfterTriggered                               PreloadPrerenderFinalStatus = "MemoryPressureAfterTriggered"
+	PreloadPrerenderFinalStatusPrerenderingDisabledByDevTools                             PreloadPrerenderFinalStatus = "PrerenderingDisabledByDevTools"
+	PreloadPrerenderFinalStatusSpeculationRuleRemoved                                     PreloadPrerenderFinalStatus = "SpeculationRuleRemoved"
+	PreloadPrerenderFinalStatusActivatedWithAuxiliaryBrowsingContexts                     PreloadPrerenderFinalStatus = "ActivatedWithAuxiliaryBrowsingContexts"
+	PreloadPrerenderFinalStatusMaxNumOfRunningEagerPrerendersExceeded                     PreloadPrerenderFinalStatus = "MaxNumOfRunningEagerPrerendersExceeded"
+	PreloadPrerenderFinalStatusMaxNumOfRunningNonEagerPrerendersExceeded                  PreloadPrerenderFinalStatus = "MaxNumOfRunningNonEagerPrerendersExceeded"
+	PreloadPrerenderFinalStatusMaxNumOfRunningEmbedderPrerendersExceeded                  PreloadPrerenderFinalStatus = "MaxNumOfRunningEmbedderPrerendersExceeded"
+	PreloadPrerenderFinalStatusPrerenderingURLHasEffectiveURL                             PreloadPrerenderFinalStatus = "PrerenderingUrlHasEffectiveUrl"
+	PreloadPrerenderFinalStatusRedirectedPrerenderingURLHasEffectiveURL                   PreloadPrerenderFinalStatus = "RedirectedPrerenderingUrlHasEffectiveUrl"
+	PreloadPrerenderFinalStatusActivationURLHasEffectiveURL                               PreloadPrerenderFinalStatus = "ActivationUrlHasEffectiveUrl"
+	PreloadPrerenderFinalStatusJavaScriptInterfaceAdded                                   PreloadPrerenderFinalStatus = "JavaScriptInterfaceAdded"
+	PreloadPrerenderFinalStatusJavaScriptInterfaceRemoved                                 PreloadPrerenderFinalStatus = "JavaScriptInterfaceRemoved"
+	PreloadPrerenderFinalStatusAllPrerenderingCanceled                                    PreloadPrerenderFinalStatus = "AllPrerenderingCanceled"
+	PreloadPrerenderFinalStatusWindowClosed                                               PreloadPrerenderFinalStatus = "WindowClosed"
+	PreloadPrerenderFinalStatusSlowNetwork                                                PreloadPrerenderFinalStatus = "SlowNetwork"
+	PreloadPrerenderFinalStatusOtherPrerenderedPageActivated                              PreloadPrerenderFinalStatus = "OtherPrerenderedPageActivated"
+	PreloadPrerenderFinalStatusV8OptimizerDisabled                                        PreloadPrerenderFinalStatus = "V8OptimizerDisabled"
+	PreloadPrerenderFinalStatusPrerenderFailedDuringPrefetch                              PreloadPrerenderFinalStatus = "PrerenderFailedDuringPrefetch"
+	PreloadPrerenderFinalStatusBrowsingDataRemoved                                        PreloadPrerenderFinalStatus = "BrowsingDataRemoved"
+	PreloadPrerenderFinalStatusPrerenderHostReused                                        PreloadPrerenderFinalStatus = "PrerenderHostReused"
+)
+
+// PreloadPreloadingStatus preloading status values, see also
+// PreloadingTriggeringOutcome. This status is shared by prefetchStatusUpdated
+// and prerenderStatusUpdated.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Preload#type-PreloadingStatus
+type PreloadPreloadingStatus string
+
+// Values of PreloadPreloadingStatus.
+const (
+	PreloadPreloadingStatusPending      PreloadPreloadingStatus = "Pending"
+	PreloadPreloadingStatusRunning      PreloadPreloadingStatus = "Running"
+	PreloadPreloadingStatusReady        PreloadPreloadingStatus = "Ready"
+	PreloadPreloadingStatusSuccess      PreloadPreloadingStatus = "Success"
+	PreloadPreloadingStatusFailure      PreloadPreloadingStatus = "Failure"
+	PreloadPreloadingStatusNotSupported PreloadPreloadingStatus = "NotSupported"
+)
+
+// PreloadPrefetchStatus TODO(https://crbug.com/1384419): revisit the list of
+// PrefetchStatus and filter out the ones that aren't necessary to the
+// developers.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Preload#type-PrefetchStatus
+type PreloadPrefetchStatus string
+
+// Values of PreloadPrefetchStatus.
+const (
+	PreloadPrefetchStatusPrefetchAllowed                                             PreloadPrefetchStatus = "PrefetchAllowed"
+	PreloadPrefetchStatusPrefetchFailedIneligibleRedirect                            PreloadPrefetchStatus = "PrefetchFailedIneligibleRedirect"
+	PreloadPrefetchStatusPrefetchFailedInvalidRedirect                               PreloadPrefetchStatus = "PrefetchFailedInvalidRedirect"
+	PreloadPrefetchStatusPrefetchFailedMIMENotSupported                              PreloadPrefetchStatus = "PrefetchFailedMIMENotSupported"
+	PreloadPrefetchStatusPrefetchFailedNetError                                      PreloadPrefetchStatus = "PrefetchFailedNetError"
+	PreloadPrefetchStatusPrefetchFailedNon2XX                                        PreloadPrefetchStatus = "PrefetchFailedNon2XX"
+	PreloadPrefetchStatusPrefetchEvictedAfterBrowsingDataRemoved                     PreloadPrefetchStatus = "PrefetchEvictedAfterBrowsingDataRemoved"
+	PreloadPrefetchStatusPrefetchEvictedAfterCandidateRemoved                        PreloadPrefetchStatus = "PrefetchEvictedAfterCandidateRemoved"
+	PreloadPrefetchStatusPrefetchEvictedForNewerPrefetch                             PreloadPrefetchStatus = "PrefetchEvictedForNewerPrefetch"
+	PreloadPrefetchStatusPrefetchHeldback                                            PreloadPrefetchStatus = "PrefetchHeldback"
+	PreloadPrefetchStatusPrefetchIneligibleRetryAfter                                PreloadPrefetchStatus = "PrefetchIneligibleRetryAfter"
+	PreloadPrefetchStatusPrefetchIsPrivacyDecoy                                      PreloadPrefetchStatus = "PrefetchIsPrivacyDecoy"
+	PreloadPrefetchStatusPrefetchIsStale                                             PreloadPrefetchStatus = "PrefetchIsStale"
+	PreloadPrefetchStatusPrefetchNotEligibleBrowserContextOffTheRecord               PreloadPrefetchStatus = "PrefetchNotEligibleBrowserContextOffTheRecord"
+	PreloadPrefetchStatusPrefetchNotEligibleDataSaverEnabled                         PreloadPrefetchStatus = "PrefetchNotEligibleDataSaverEnabled"
+	PreloadPrefetchStatusPrefetchNotEligibleExistingProxy                            PreloadPrefetchStatus = "PrefetchNotEligibleExistingProxy"
+	PreloadPrefetchStatusPrefetchNotEligibleHostIsNonUnique                          PreloadPrefetchStatus = "PrefetchNotEligibleHostIsNonUnique"
+	PreloadPrefetchStatusPrefetchNotEligibleNonDefaultStoragePartition               PreloadPrefetchStatus = "PrefetchNotEligibleNonDefaultStoragePartition"
+	PreloadPrefetchStatusPrefetchNotEligibleSameSiteCrossOriginPrefetchRequiredProxy PreloadPrefetchStatus = "PrefetchNotEligibleSameSiteCrossOriginPrefetchRequiredProxy"
+	PreloadPrefetchStatusPrefetchNotEligibleSchemeIsNotHttps                         PreloadPrefetchStatus = "PrefetchNotEligibleSchemeIsNotHttps"
+	PreloadPrefetchStatusPrefetchNotEligibleUserHasCookies                           PreloadPrefetchStatus = "PrefetchNotEligibleUserHasCookies"
+	PreloadPrefetchStatusPrefetchNotEligibleUserHasServiceWorker                     PreloadPrefetchStatus = "PrefetchNotEligibleUserHasServiceWorker"
+	PreloadPrefetchStatusPrefetchNotEligibleUserHasServiceWorkerNoFetchHandler       PreloadPrefetchStatus = "PrefetchNotEligibleUserHasServiceWorkerNoFetchHandler"
+	PreloadPrefetchStatusPrefetchNotEligibleRedirectFromServiceWorker                PreloadPrefetchStatus = "PrefetchNotEligibleRedirectFromServiceWorker"
+	PreloadPrefetchStatusPrefetchNotEligibleRedirectToServiceWorker                  PreloadPrefetchStatus = "PrefetchNotEligibleRedirectToServiceWorker"
+	PreloadPrefetchStatusPrefetchNotEligibleBatterySaverEnabled                      PreloadPrefetchStatus = "PrefetchNotEligibleBatterySaverEnabled"
+	PreloadPrefetchStatusPrefetchNotEligiblePreloadingDisabled                       PreloadPrefetchStatus = "PrefetchNotEligiblePreloadingDisabled"
+	PreloadPrefetchStatusPrefetchNotFinishedInTime                                   PreloadPrefetchStatus = "PrefetchNotFinishedInTime"
+	PreloadPrefetchStatusPrefetchNotStarted                                          PreloadPrefetchStatus = "PrefetchNotStarted"
+	PreloadPrefetchStatusPrefetchNotUsedCookiesChanged                               PreloadPrefetchStatus = "PrefetchNotUsedCookiesChanged"
+	PreloadPrefetchStatusPrefetchProxyNotAvailable                                   PreloadPrefetchStatus = "PrefetchProxyNotAvailable"
+	PreloadPrefetchStatusPrefetchResponseUsed                                        PreloadPrefetchStatus = "PrefetchResponseUsed"
+	PreloadPrefetchStatusPrefetchSuccessfulButNotUsed                                PreloadPrefetchStatus = "PrefetchSuccessfulButNotUsed"
+	PreloadPrefetchStatusPrefetchNotUsedProbeFailed                                  PreloadPrefetchStatus = "PrefetchNotUsedProbeFailed"
+)
+
+// PreloadPrerenderMismatchedHeaders information of headers to be displayed when
+// the header mismatch occurred.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Preload#type-PrerenderMismatchedHeaders
+type PreloadPrerenderMismatchedHeaders struct {
+	HeaderName      string `json:"headerName"`
+	InitialValue    string `json:"initialValue,omitempty"`
+	ActivationValue string `json:"activationValue,omitempty"`
+}
@@ -0,0 +1,87 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package types
+
+// ProfilerProfileNode profile node. Holds callsite information, execution
+// statistics and child nodes.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Profiler#type-ProfileNode
+type ProfilerProfileNode struct {
+	// Unique id of the node.
+	ID int64 `json:"id"`
+	// Function location.
+	CallFrame RuntimeCallFrame `json:"callFrame"`
+	// Number of samples where this node was on top of the call stack.
+	HitCount int64 `json:"hitCount,omitempty"`
+	// Child node ids.
+	Children []int64 `json:"children,omitempty"`
+	// The reason of being not optimized. The function may be deoptimized or marked
+	// as don't optimize.
+	DeoptReason string `json:"deoptReason,omitempty"`
+	// An array of source position ticks.
+	PositionTicks []ProfilerPositionTickInfo `json:"positionTicks,omitempty"`
+}
+
+// ProfilerProfile profile.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Profiler#type-Profile
+type ProfilerProfile struct {
+	// The list of profile nodes. First item is the root node.
+	Nodes []ProfilerProfileNode `json:"nodes"`
+	// Profiling start timestamp in microseconds.
+	StartTime float64 `json:"startTime"`
+	// Profiling end timestamp in microseconds.
+	EndTime float64 `json:"endTime"`
+	// Ids of samples top nodes.
+	Samples []int64 `json:"samples,omitempty"`
+	// Time intervals between adjacent samples in microseconds. The first delta is
+	// relative to the profile startTime.
+	TimeDeltas []int64 `json:"timeDeltas,omitempty"`
+}
+
+// ProfilerPositionTickInfo specifies a number of samples attributed to a
+// certain source position.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Profiler#type-PositionTickInfo
+type ProfilerPositionTickInfo struct {
+	// Source line number (1-based).
+	Line int64 `json:"line"`
+	// Number of samples attributed to the source line.
+	Ticks int64 `json:"ticks"`
+}
+
+// ProfilerCoverageRange coverage data for a source range.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Profiler#type-CoverageRange
+type ProfilerCoverageRange struct {
+	// JavaScript script source offset for the range start.
+	StartOffset int64 `json:"startOffset"`
+	// JavaScript script source offset for the range end.
+	EndOffset int64 `json:"endOffset"`
+	// Collected execution count of the source range.
+	Count int64 `json:"count"`
+}
+
+// ProfilerFunctionCoverage coverage data for a JavaScript function.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Profiler#type-FunctionCoverage
+type ProfilerFunctionCoverage struct {
+	// JavaScript function name.
+	FunctionName string `json:"functionName"`
+	// Source ranges inside the function with coverage data.
+	Ranges []ProfilerCoverageRange `json:"ranges"`
+	// Whether coverage data for this function has block granularity.
+	IsBlockCoverage bool `json:"isBlockCoverage"`
+}
+
+// ProfilerScriptCoverage coverage data for a JavaScript script.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Profiler#type-ScriptCoverage
+type ProfilerScriptCoverage struct {
+	// JavaScript script id.
+	ScriptID RuntimeScriptID `json:"scriptId"`
+	// JavaScript script name or url.
+	URL string `json:"url"`
+	// Functions contained in the script that has coverage data.
+	Functions []ProfilerFunctionCoverage `json:"functions"`
+}
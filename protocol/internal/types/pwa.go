@@ -0,0 +1,34 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package types
+
+// PWAFileHandlerAccept the following types are the replica of
+// https://crsrc.org/c/chrome/browser/web_applications/proto/web_app_os_integration_state.proto;drc=9910d3be894c8f142c977ba1023f30a656bc13fc;l=67
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/PWA#type-FileHandlerAccept
+type PWAFileHandlerAccept struct {
+	// New name of the mimetype according to
+	// https://www.iana.org/assignments/media-types/media-types.xhtml
+	MediaType      string   `json:"mediaType"`
+	FileExtensions []string `json:"fileExtensions"`
+}
+
+// PWAFileHandler is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/PWA#type-FileHandler
+type PWAFileHandler struct {
+	Action      string                 `json:"action"`
+	Accepts     []PWAFileHandlerAccept `json:"accepts"`
+	DisplayName string                 `json:"displayName"`
+}
+
+// PWADisplayMode if user prefers opening the app in browser or an app window.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/PWA#type-DisplayMode
+type PWADisplayMode string
+
+// Values of PWADisplayMode.
+const (
+	PWADisplayModeStandalone PWADisplayMode = "standalone"
+	PWADisplayModeBrowser    PWADisplayMode = "browser"
+)
@@ -0,0 +1,374 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package types
+
+import (
+	"encoding/json"
+)
+
+// RuntimeScriptID unique script identifier.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Runtime#type-ScriptId
+type RuntimeScriptID string
+
+// RuntimeSerializationOptions represents options for serialization. Overrides
+// `generatePreview` and `returnByValue`.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Runtime#type-SerializationOptions
+type RuntimeSerializationOptions struct {
+	// Allowed values: deep, json, idOnly.
+	Serialization string `json:"serialization"`
+	// Deep serialization depth. Default is full depth. Respected only in `deep`
+	// serialization mode.
+	MaxDepth int64 `json:"maxDepth,omitempty"`
+	// Embedder-specific parameters. For example if connected to V8 in Chrome these
+	// control DOM serialization via `maxNodeDepth: integer` and
+	// `includeShadowTree: "none" | "open" | "all"`. Values can be only of type
+	// string or integer.
+	AdditionalParameters map[string]interface{} `json:"additionalParameters,omitempty"`
+}
+
+// RuntimeDeepSerializedValue represents deep serialized value.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Runtime#type-DeepSerializedValue
+type RuntimeDeepSerializedValue struct {
+	// Allowed values: undefined, null, string, number, boolean, bigint, regexp,
+	// date, symbol, array, object, function, map, set, weakmap, weakset, error,
+	// proxy, promise, typedarray, arraybuffer, node, window, generator.
+	Type     string          `json:"type"`
+	Value    json.RawMessage `json:"value,omitempty"`
+	ObjectID string          `json:"objectId,omitempty"`
+	// Set if value reference met more then once during serialization. In such
+	// case, value is provided only to one of the serialized values. Unique per
+	// value in the scope of one CDP call.
+	WeakLocalObjectReference int64 `json:"weakLocalObjectReference,omitempty"`
+}
+
+// RuntimeRemoteObjectID unique object identifier.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Runtime#type-RemoteObjectId
+type RuntimeRemoteObjectID string
+
+// RuntimeUnserializableValue primitive value which cannot be JSON-stringified.
+// Includes values `-0`, `NaN`, `Infinity`, `-Infinity`, and bigint literals.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Runtime#type-UnserializableValue
+type RuntimeUnserializableValue string
+
+// RuntimeRemoteObject mirror object referencing original JavaScript object.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Runtime#type-RemoteObject
+type RuntimeRemoteObject struct {
+	// Object type.
+	//
+	// Allowed values: object, function, undefined, string, number, boolean,
+	// symbol, bigint.
+	Type string `json:"type"`
+	// Object subtype hint. Specified for `object` type values only. NOTE: If you
+	// change anything here, make sure to also update `subtype` in `ObjectPreview`
+	// and `PropertyPreview` below.
+	//
+	// Allowed values: array, null, node, regexp, date, map, set, weakmap, weakset,
+	// iterator, generator, error, proxy, promise, typedarray, arraybuffer,
+	// dataview, webassemblymemory, wasmvalue.
+	Subtype string `json:"subtype,omitempty"`
+	// Object class (constructor) name. Specified for `object` type values only.
+	ClassName string `json:"className,omitempty"`
+	// Remote object value in case of primitive values or JSON values (if it was
+	// requested).
+	Value json.RawMessage `json:"value,omitempty"`
+	// Primitive value which can not be JSON-stringified does not have `value`, but
+	// gets this property.
+	UnserializableValue RuntimeUnserializableValue `json:"unserializableValue,omitempty"`
+	// String representation of the object.
+	Description string `json:"description,omitempty"`
+	// Deep serialized value.
+	//
+	// This is an experimental part of the protocol.
+	DeepSerializedValue *RuntimeDeepSerializedValue `json:"deepSerializedValue,omitempty"`
+	// Unique object identifier (for non-primitive values).
+	ObjectID RuntimeRemoteObjectID `json:"objectId,omitempty"`
+	// Preview containing abbreviated property values. Specified for `object` type
+	// values only.
+	//
+	// This is an experimental part of the protocol.
+	Preview *RuntimeObjectPreview `json:"preview,omitempty"`
+	// This is an experimental part of the protocol.
+	CustomPreview *RuntimeCustomPreview `json:"customPreview,omitempty"`
+}
+
+// RuntimeCustomPreview is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Runtime#type-CustomPreview
+//
+// This is an experimental part of the protocol.
+type RuntimeCustomPreview struct {
+	// The JSON-stringified result of formatter.header(object, config) call. It
+	// contains json ML array that represents RemoteObject.
+	Header string `json:"header"`
+	// If formatter returns true as a result of formatter.hasBody call then
+	// bodyGetterId will contain RemoteObjectId for the function that returns
+	// result of formatter.body(object, config) call. The result value is json ML
+	// array.
+	BodyGetterID RuntimeRemoteObjectID `json:"bodyGetterId,omitempty"`
+}
+
+// RuntimeObjectPreview object containing abbreviated remote object value.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Runtime#type-ObjectPreview
+//
+// This is an experimental part of the protocol.
+type RuntimeObjectPreview struct {
+	// Object type.
+	//
+	// Allowed values: object, function, undefined, string, number, boolean,
+	// symbol, bigint.
+	Type string `json:"type"`
+	// Object subtype hint. Specified for `object` type values only.
+	//
+	// Allowed values: array, null, node, regexp, date, map, set, weakmap, weakset,
+	// iterator, generator, error, proxy, promise, typedarray, arraybuffer,
+	// dataview, webassemblymemory, wasmvalue.
+	Subtype string `json:"subtype,omitempty"`
+	// String representation of the object.
+	Description string `json:"description,omitempty"`
+	// True iff some of the properties or entries of the original object did not
+	// fit.
+	Overflow bool `json:"overflow"`
+	// List of the properties.
+	Properties []RuntimePropertyPreview `json:"properties"`
+	// List of the entries. Specified for `map` and `set` subtype values only.
+	Entries []RuntimeEntryPreview `json:"entries,omitempty"`
+}
+
+// RuntimePropertyPreview is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Runtime#type-PropertyPreview
+//
+// This is an experimental part of the protocol.
+type RuntimePropertyPreview struct {
+	// Property name.
+	Name string `json:"name"`
+	// Object type. Accessor means that the property itself is an accessor
+	// property.
+	//
+	// Allowed values: object, function, undefined, string, number, boolean,
+	// symbol, accessor, bigint.
+	Type string `json:"type"`
+	// User-friendly property value string.
+	Value string `json:"value,omitempty"`
+	// Nested value preview.
+	ValuePreview *RuntimeObjectPreview `json:"valuePreview,omitempty"`
+	// Object subtype hint. Specified for `object` type values only.
+	//
+	// Allowed values: array, null, node, regexp, date, map, set, weakmap, weakset,
+	// iterator, generator, error, proxy, promise, typedarray, arraybuffer,
+	// dataview, webassemblymemory, wasmvalue.
+	Subtype string `json:"subtype,omitempty"`
+}
+
+// RuntimeEntryPreview is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Runtime#type-EntryPreview
+//
+// This is an experimental part of the protocol.
+type RuntimeEntryPreview struct {
+	// Preview of the key. Specified for map-like collection entries.
+	Key *RuntimeObjectPreview `json:"key,omitempty"`
+	// Preview of the value.
+	Value RuntimeObjectPreview `json:"value"`
+}
+
+// RuntimePropertyDescriptor object property descriptor.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Runtime#type-PropertyDescriptor
+type RuntimePropertyDescriptor struct {
+	// Property name or symbol description.
+	Name string `json:"name"`
+	// The value associated with the property.
+	Value *RuntimeRemoteObject `json:"value,omitempty"`
+	// True if the value associated with the property may be changed (data
+	// descriptors only).
+	Writable bool `json:"writable,omitempty"`
+	// A function which serves as a getter for the property, or `undefined` if
+	// there is no getter (accessor descriptors only).
+	Get *RuntimeRemoteObject `json:"get,omitempty"`
+	// A function which serves as a setter for the property, or `undefined` if
+	// there is no setter (accessor descriptors only).
+	Set *RuntimeRemoteObject `json:"set,omitempty"`
+	// True if the type of this property descriptor may be changed and if the
+	// property may be deleted from the corresponding object.
+	Configurable bool `json:"configurable"`
+	// True if this property shows up during enumeration of the properties on the
+	// corresponding object.
+	Enumerable bool `json:"enumerable"`
+	// True if the result was thrown during the evaluation.
+	WasThrown bool `json:"wasThrown,omitempty"`
+	// True if the property is owned for the object.
+	IsOwn bool `json:"isOwn,omitempty"`
+	// Property symbol object, if the property is of the `symbol` type.
+	Symbol *RuntimeRemoteObject `json:"symbol,omitempty"`
+}
+
+// RuntimeInternalPropertyDescriptor object internal property descriptor. This
+// property isn't normally visible in JavaScript code.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Runtime#type-InternalPropertyDescriptor
+type RuntimeInternalPropertyDescriptor struct {
+	// Conventional property name.
+	Name string `json:"name"`
+	// The value associated with the property.
+	Value *RuntimeRemoteObject `json:"value,omitempty"`
+}
+
+// RuntimePrivatePropertyDescriptor object private field descriptor.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Runtime#type-PrivatePropertyDescriptor
+//
+// This is an experimental part of the protocol.
+type RuntimePrivatePropertyDescriptor struct {
+	// Private property name.
+	Name string `json:"name"`
+	// The value associated with the private property.
+	Value *RuntimeRemoteObject `json:"value,omitempty"`
+	// A function which serves as a getter for the private property, or `undefined`
+	// if there is no getter (accessor descriptors only).
+	Get *RuntimeRemoteObject `json:"get,omitempty"`
+	// A function which serves as a setter for the private property, or `undefined`
+	// if there is no setter (accessor descriptors only).
+	Set *RuntimeRemoteObject `json:"set,omitempty"`
+}
+
+// RuntimeCallArgument represents function call argument. Either remote object
+// id `objectId`, primitive `value`, unserializable primitive value or neither
+// of (for undefined) them should be specified.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Runtime#type-CallArgument
+type RuntimeCallArgument struct {
+	// Primitive value or serializable javascript object.
+	Value json.RawMessage `json:"value,omitempty"`
+	// Primitive value which can not be JSON-stringified.
+	UnserializableValue RuntimeUnserializableValue `json:"unserializableValue,omitempty"`
+	// Remote object handle.
+	ObjectID RuntimeRemoteObjectID `json:"objectId,omitempty"`
+}
+
+// RuntimeExecutionContextID id of an execution context.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Runtime#type-ExecutionContextId
+type RuntimeExecutionContextID int64
+
+// RuntimeExecutionContextDescription description of an isolated world.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Runtime#type-ExecutionContextDescription
+type RuntimeExecutionContextDescription struct {
+	// Unique id of the execution context. It can be used to specify in which
+	// execution context script evaluation should be performed.
+	ID RuntimeExecutionContextID `json:"id"`
+	// Execution context origin.
+	Origin string `json:"origin"`
+	// Human readable name describing given context.
+	Name string `json:"name"`
+	// A system-unique execution context identifier. Unlike the id, this is unique
+	// across multiple processes, so can be reliably used to identify specific
+	// context while backend performs a cross-process navigation.
+	//
+	// This is an experimental part of the protocol.
+	UniqueID string `json:"uniqueId"`
+	// Embedder-specific auxiliary data likely matching {isDefault: boolean, type:
+	// 'default'|'isolated'|'worker', frameId: string}
+	AuxData map[string]interface{} `json:"auxData,omitempty"`
+}
+
+// RuntimeExceptionDetails detailed information about exception (or error) that
+// was thrown during script compilation or execution.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Runtime#type-ExceptionDetails
+type RuntimeExceptionDetails struct {
+	// Exception id.
+	ExceptionID int64 `json:"exceptionId"`
+	// Exception text, which should be used together with exception object when
+	// available.
+	Text string `json:"text"`
+	// Line number of the exception location (0-based).
+	LineNumber int64 `json:"lineNumber"`
+	// Column number of the exception location (0-based).
+	ColumnNumber int64 `json:"columnNumber"`
+	// Script ID of the exception location.
+	ScriptID RuntimeScriptID `json:"scriptId,omitempty"`
+	// URL of the exception location, to be used when the script was not reported.
+	URL string `json:"url,omitempty"`
+	// JavaScript stack trace if available.
+	StackTrace *RuntimeStackTrace `json:"stackTrace,omitempty"`
+	// Exception object if available.
+	Exception *RuntimeRemoteObject `json:"exception,omitempty"`
+	// Identifier of the context where exception happened.
+	ExecutionContextID RuntimeExecutionContextID `json:"executionContextId,omitempty"`
+	// Dictionary with entries of meta data that the client associated with this
+	// exception, such as information about associated network requests, etc.
+	//
+	// This is an experimental part of the protocol.
+	ExceptionMetaData map[string]interface{} `json:"exceptionMetaData,omitempty"`
+}
+
+// RuntimeTimestamp number of milliseconds since epoch.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Runtime#type-Timestamp
+type RuntimeTimestamp float64
+
+// RuntimeTimeDelta number of milliseconds.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Runtime#type-TimeDelta
+type RuntimeTimeDelta float64
+
+// RuntimeCallFrame stack entry for runtime errors and assertions.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Runtime#type-CallFrame
+type RuntimeCallFrame struct {
+	// JavaScript function name.
+	FunctionName string `json:"functionName"`
+	// JavaScript script id.
+	ScriptID RuntimeScriptID `json:"scriptId"`
+	// JavaScript script name or url.
+	URL string `json:"url"`
+	// JavaScript script line number (0-based).
+	LineNumber int64 `json:"lineNumber"`
+	// JavaScript script column number (0-based).
+	ColumnNumber int64 `json:"columnNumber"`
+}
+
+// RuntimeStackTrace call frames for assertions or error messages.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Runtime#type-StackTrace
+type RuntimeStackTrace struct {
+	// String label of this stack trace. For async traces this may be a name of the
+	// function that initiated the async call.
+	Description string `json:"description,omitempty"`
+	// JavaScript function name.
+	CallFrames []RuntimeCallFrame `json:"callFrames"`
+	// Asynchronous JavaScript stack trace that preceded this stack, if available.
+	Parent *RuntimeStackTrace `json:"parent,omitempty"`
+	// Asynchronous JavaScript stack trace that preceded this stack, if available.
+	//
+	// This is an experimental part of the protocol.
+	ParentID *RuntimeStackTraceID `json:"parentId,omitempty"`
+}
+
+// RuntimeUniqueDebuggerID unique identifier of current debugger.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Runtime#type-UniqueDebuggerId
+//
+// This is an experimental part of the protocol.
+type RuntimeUniqueDebuggerID string
+
+// RuntimeStackTraceID if `debuggerId` is set stack trace comes from another
+// debugger and can be resolved there. This allows to track cross-debugger
+// calls. See `Runtime.StackTrace` and `Debugger.paused` for usages.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Runtime#type-StackTraceId
+//
+// This is an experimental part of the protocol.
+type RuntimeStackTraceID struct {
+	ID         string                  `json:"id"`
+	DebuggerID RuntimeUniqueDebuggerID `json:"debuggerId,omitempty"`
+}
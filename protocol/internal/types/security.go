@@ -0,0 +1,181 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package types
+
+// SecurityCertificateID an internal certificate ID value.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Security#type-CertificateId
+type SecurityCertificateID int64
+
+// SecurityMixedContentType a description of mixed content (HTTP resources on
+// HTTPS pages), as defined by https://www.w3.org/TR/mixed-content/#categories
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Security#type-MixedContentType
+type SecurityMixedContentType string
+
+// Values of SecurityMixedContentType.
+const (
+	SecurityMixedContentTypeBlockable           SecurityMixedContentType = "blockable"
+	SecurityMixedContentTypeOptionallyBlockable SecurityMixedContentType = "optionally-blockable"
+	SecurityMixedContentTypeNone                SecurityMixedContentType = "none"
+)
+
+// SecuritySecurityState the security level of a page or resource.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Security#type-SecurityState
+type SecuritySecurityState string
+
+// Values of SecuritySecurityState.
+const (
+	SecuritySecurityStateUnknown        SecuritySecurityState = "unknown"
+	SecuritySecurityStateNeutral        SecuritySecurityState = "neutral"
+	SecuritySecurityStateInsecure       SecuritySecurityState = "insecure"
+	SecuritySecurityStateSecure         SecuritySecurityState = "secure"
+	SecuritySecurityStateInfo           SecuritySecurityState = "info"
+	SecuritySecurityStateInsecureBroken SecuritySecurityState = "insecure-broken"
+)
+
+// SecurityCertificateSecurityState details about the security state of the page
+// certificate.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Security#type-CertificateSecurityState
+//
+// This is an experimental part of the protocol.
+type SecurityCertificateSecurityState struct {
+	// Protocol name (e.g. "TLS 1.2" or "QUIC").
+	Protocol string `json:"protocol"`
+	// Key Exchange used by the connection, or the empty string if not applicable.
+	KeyExchange string `json:"keyExchange"`
+	// (EC)DH group used by the connection, if applicable.
+	KeyExchangeGroup string `json:"keyExchangeGroup,omitempty"`
+	// Cipher name.
+	Cipher string `json:"cipher"`
+	// TLS MAC. Note that AEAD ciphers do not have separate MACs.
+	Mac string `json:"mac,omitempty"`
+	// Page certificate.
+	Certificate []string `json:"certificate"`
+	// Certificate subject name.
+	SubjectName string `json:"subjectName"`
+	// Name of the issuing CA.
+	Issuer string `json:"issuer"`
+	// Certificate valid from date.
+	ValidFrom NetworkTimeSinceEpoch `json:"validFrom"`
+	// Certificate valid to (expiration) date
+	ValidTo NetworkTimeSinceEpoch `json:"validTo"`
+	// The highest priority network error code, if the certificate has an error.
+	CertificateNetworkError string `json:"certificateNetworkError,omitempty"`
+	// True if the certificate uses a weak signature algorithm.
+	CertificateHasWeakSignature bool `json:"certificateHasWeakSignature"`
+	// True if the certificate has a SHA1 signature in the chain.
+	CertificateHasSha1Signature bool `json:"certificateHasSha1Signature"`
+	// True if modern SSL
+	ModernSSL bool `json:"modernSSL"`
+	// True if the connection is using an obsolete SSL protocol.
+	ObsoleteSslProtocol bool `json:"obsoleteSslProtocol"`
+	// True if the connection is using an obsolete SSL key exchange.
+	ObsoleteSslKeyExchange bool `json:"obsoleteSslKeyExchange"`
+	// True if the connection is using an obsolete SSL cipher.
+	ObsoleteSslCipher bool `json:"obsoleteSslCipher"`
+	// True if the connection is using an obsolete SSL signature.
+	ObsoleteSslSignature bool `json:"obsoleteSslSignature"`
+}
+
+// SecuritySafetyTipStatus is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Security#type-SafetyTipStatus
+//
+// This is an experimental part of the protocol.
+type SecuritySafetyTipStatus string
+
+// Values of SecuritySafetyTipStatus.
+const (
+	SecuritySafetyTipStatusBadReputation SecuritySafetyTipStatus = "badReputation"
+	SecuritySafetyTipStatusLookalike     SecuritySafetyTipStatus = "lookalike"
+)
+
+// SecuritySafetyTipInfo is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Security#type-SafetyTipInfo
+//
+// This is an experimental part of the protocol.
+type SecuritySafetyTipInfo struct {
+	// Describes whether the page triggers any safety tips or reputation warnings.
+	// Default is unknown.
+	SafetyTipStatus SecuritySafetyTipStatus `json:"safetyTipStatus"`
+	// The URL the safety tip suggested ("Did you mean?"). Only filled in for
+	// lookalike matches.
+	SafeURL string `json:"safeUrl,omitempty"`
+}
+
+// SecurityVisibleSecurityState security state information about the page.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Security#type-VisibleSecurityState
+//
+// This is an experimental part of the protocol.
+type SecurityVisibleSecurityState struct {
+	// The security level of the page.
+	SecurityState SecuritySecurityState `json:"securityState"`
+	// Security state details about the page certificate.
+	CertificateSecurityState *SecurityCertificateSecurityState `json:"certificateSecurityState,omitempty"`
+	// The type of Safety Tip triggered on the page. Note that this field will be
+	// set even if the Safety Tip UI was not actually shown.
+	SafetyTipInfo *SecuritySafetyTipInfo `json:"safetyTipInfo,omitempty"`
+	// Array of security state issues ids.
+	SecurityStateIssueIds []string `json:"securityStateIssueIds"`
+}
+
+// SecuritySecurityStateExplanation an explanation of an factor contributing to
+// the security state.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Security#type-SecurityStateExplanation
+type SecuritySecurityStateExplanation struct {
+	// Security state representing the severity of the factor being explained.
+	SecurityState SecuritySecurityState `json:"securityState"`
+	// Title describing the type of factor.
+	Title string `json:"title"`
+	// Short phrase describing the type of factor.
+	Summary string `json:"summary"`
+	// Full text explanation of the factor.
+	Description string `json:"description"`
+	// The type of mixed content described by the explanation.
+	MixedContentType SecurityMixedContentType `json:"mixedContentType"`
+	// Page certificate.
+	Certificate []string `json:"certificate"`
+	// Recommendations to fix any issues.
+	Recommendations []string `json:"recommendations,omitempty"`
+}
+
+// SecurityInsecureContentStatus information about insecure content on the page.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Security#type-InsecureContentStatus
+//
+// Deprecated: this is deprecated in the protocol.
+type SecurityInsecureContentStatus struct {
+	// Always false.
+	RanMixedContent bool `json:"ranMixedContent"`
+	// Always false.
+	DisplayedMixedContent bool `json:"displayedMixedContent"`
+	// Always false.
+	ContainedMixedForm bool `json:"containedMixedForm"`
+	// Always false.
+	RanContentWithCertErrors bool `json:"ranContentWithCertErrors"`
+	// Always false.
+	DisplayedContentWithCertErrors bool `json:"displayedContentWithCertErrors"`
+	// Always set to unknown.
+	RanInsecureContentStyle SecuritySecurityState `json:"ranInsecureContentStyle"`
+	// Always set to unknown.
+	DisplayedInsecureContentStyle SecuritySecurityState `json:"displayedInsecureContentStyle"`
+}
+
+// SecurityCertificateErrorAction the action to take when a certificate error
+// occurs. continue will continue processing the request and cancel will cancel
+// the request.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Security#type-CertificateErrorAction
+type SecurityCertificateErrorAction string
+
+// Values of SecurityCertificateErrorAction.
+const (
+	SecurityCertificateErrorActionContinue SecurityCertificateErrorAction = "continue"
+	SecurityCertificateErrorActionCancel   SecurityCertificateErrorAction = "cancel"
+)
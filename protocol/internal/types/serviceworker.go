@@ -0,0 +1,77 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package types
+
+// ServiceWorkerRegistrationID is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/ServiceWorker#type-RegistrationID
+type ServiceWorkerRegistrationID string
+
+// ServiceWorkerServiceWorkerRegistration serviceWorker registration.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/ServiceWorker#type-ServiceWorkerRegistration
+type ServiceWorkerServiceWorkerRegistration struct {
+	RegistrationID ServiceWorkerRegistrationID `json:"registrationId"`
+	ScopeURL       string                      `json:"scopeURL"`
+	IsDeleted      bool                        `json:"isDeleted"`
+}
+
+// ServiceWorkerServiceWorkerVersionRunningStatus is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/ServiceWorker#type-ServiceWorkerVersionRunningStatus
+type ServiceWorkerServiceWorkerVersionRunningStatus string
+
+// Values of ServiceWorkerServiceWorkerVersionRunningStatus.
+const (
+	ServiceWorkerServiceWorkerVersionRunningStatusStopped  ServiceWorkerServiceWorkerVersionRunningStatus = "stopped"
+	ServiceWorkerServiceWorkerVersionRunningStatusStarting ServiceWorkerServiceWorkerVersionRunningStatus = "starting"
+	ServiceWorkerServiceWorkerVersionRunningStatusRunning  ServiceWorkerServiceWorkerVersionRunningStatus = "running"
+	ServiceWorkerServiceWorkerVersionRunningStatusStopping ServiceWorkerServiceWorkerVersionRunningStatus = "stopping"
+)
+
+// ServiceWorkerServiceWorkerVersionStatus is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/ServiceWorker#type-ServiceWorkerVersionStatus
+type ServiceWorkerServiceWorkerVersionStatus string
+
+// Values of ServiceWorkerServiceWorkerVersionStatus.
+const (
+	ServiceWorkerServiceWorkerVersionStatusNew        ServiceWorkerServiceWorkerVersionStatus = "new"
+	ServiceWorkerServiceWorkerVersionStatusInstalling ServiceWorkerServiceWorkerVersionStatus = "installing"
+	ServiceWorkerServiceWorkerVersionStatusInstalled  ServiceWorkerServiceWorkerVersionStatus = "installed"
+	ServiceWorkerServiceWorkerVersionStatusActivating ServiceWorkerServiceWorkerVersionStatus = "activating"
+	ServiceWorkerServiceWorkerVersionStatusActivated  ServiceWorkerServiceWorkerVersionStatus = "activated"
+	ServiceWorkerServiceWorkerVersionStatusRedundant  ServiceWorkerServiceWorkerVersionStatus = "redundant"
+)
+
+// ServiceWorkerServiceWorkerVersion serviceWorker version.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/ServiceWorker#type-ServiceWorkerVersion
+type ServiceWorkerServiceWorkerVersion struct {
+	VersionID      string                                         `json:"versionId"`
+	RegistrationID ServiceWorkerRegistrationID                    `json:"registrationId"`
+	ScriptURL      string                                         `json:"scriptURL"`
+	RunningStatus  ServiceWorkerServiceWorkerVersionRunningStatus `json:"runningStatus"`
+	Status         ServiceWorkerServiceWorkerVersionStatus        `json:"status"`
+	// The Last-Modified header value of the main script.
+	ScriptLastModified float64 `json:"scriptLastModified,omitempty"`
+	// The time at which the response headers of the main script were received from
+	// the server. For cached script it is the last time the cache entry was
+	// validated.
+	ScriptResponseTime float64          `json:"scriptResponseTime,omitempty"`
+	ControlledClients  []TargetTargetID `json:"controlledClients,omitempty"`
+	TargetID           TargetTargetID   `json:"targetId,omitempty"`
+	RouterRules        string           `json:"routerRules,omitempty"`
+}
+
+// ServiceWorkerServiceWorkerErrorMessage serviceWorker error message.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/ServiceWorker#type-ServiceWorkerErrorMessage
+type ServiceWorkerServiceWorkerErrorMessage struct {
+	ErrorMessage   string                      `json:"errorMessage"`
+	RegistrationID ServiceWorkerRegistrationID `json:"registrationId"`
+	VersionID      string                      `json:"versionId"`
+	SourceURL      string                      `json:"sourceURL"`
+	LineNumber     int64                       `json:"lineNumber"`
+	ColumnNumber   int64                       `json:"columnNumber"`
+}
@@ -0,0 +1,692 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package types
+
+// StorageSerializedStorageKey is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-SerializedStorageKey
+type StorageSerializedStorageKey string
+
+// StorageStorageType enum of possible storage types.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-StorageType
+type StorageStorageType string
+
+// Values of StorageStorageType.
+const (
+	StorageStorageTypeCookies        StorageStorageType = "cookies"
+	StorageStorageTypeFileSystems    StorageStorageType = "file_systems"
+	StorageStorageTypeIndexeddb      StorageStorageType = "indexeddb"
+	StorageStorageTypeLocalStorage   StorageStorageType = "local_storage"
+	StorageStorageTypeShaderCache    StorageStorageType = "shader_cache"
+	StorageStorageTypeWebsql         StorageStorageType = "websql"
+	StorageStorageTypeServiceWorkers StorageStorageType = "service_workers"
+	StorageStorageTypeCacheStorage   StorageStorageType = "cache_storage"
+	StorageStorageTypeInterestGroups StorageStorageType = "interest_groups"
+	StorageStorageTypeSharedStorage  StorageStorageType = "shared_storage"
+	StorageStorageTypeStorageBuckets StorageStorageType = "storage_buckets"
+	StorageStorageTypeAll            StorageStorageType = "all"
+	StorageStorageTypeOther          StorageStorageType = "other"
+)
+
+// StorageUsageForType usage for a storage type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-UsageForType
+type StorageUsageForType struct {
+	// Name of storage type.
+	StorageType StorageStorageType `json:"storageType"`
+	// Storage usage (bytes).
+	Usage float64 `json:"usage"`
+}
+
+// StorageTrustTokens pair of issuer origin and number of available (signed, but
+// not used) Trust Tokens from that issuer.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-TrustTokens
+//
+// This is an experimental part of the protocol.
+type StorageTrustTokens struct {
+	IssuerOrigin string  `json:"issuerOrigin"`
+	Count        float64 `json:"count"`
+}
+
+// StorageInterestGroupAuctionID protected audience interest group auction
+// identifier.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-InterestGroupAuctionId
+type StorageInterestGroupAuctionID string
+
+// StorageInterestGroupAccessType enum of interest group access types.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-InterestGroupAccessType
+type StorageInterestGroupAccessType string
+
+// Values of StorageInterestGroupAccessType.
+const (
+	StorageInterestGroupAccessTypeJoin                  StorageInterestGroupAccessType = "join"
+	StorageInterestGroupAccessTypeLeave                 StorageInterestGroupAccessType = "leave"
+	StorageInterestGroupAccessTypeUpdate                StorageInterestGroupAccessType = "update"
+	StorageInterestGroupAccessTypeLoaded                StorageInterestGroupAccessType = "loaded"
+	StorageInterestGroupAccessTypeBid                   StorageInterestGroupAccessType = "bid"
+	StorageInterestGroupAccessTypeWin                   StorageInterestGroupAccessType = "win"
+	StorageInterestGroupAccessTypeAdditionalBid         StorageInterestGroupAccessType = "additionalBid"
+	StorageInterestGroupAccessTypeAdditionalBidWin      StorageInterestGroupAccessType = "additionalBidWin"
+	StorageInterestGroupAccessTypeTopLevelBid           StorageInterestGroupAccessType = "topLevelBid"
+	StorageInterestGroupAccessTypeTopLevelAdditionalBid StorageInterestGroupAccessType = "topLevelAdditionalBid"
+	StorageInterestGroupAccessTypeClear                 StorageInterestGroupAccessType = "clear"
+)
+
+// StorageInterestGroupAuctionEventType enum of auction events.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-InterestGroupAuctionEventType
+type StorageInterestGroupAuctionEventType string
+
+// Values of StorageInterestGroupAuctionEventType.
+const (
+	StorageInterestGroupAuctionEventTypeStarted        StorageInterestGroupAuctionEventType = "started"
+	StorageInterestGroupAuctionEventTypeConfigResolved StorageInterestGroupAuctionEventType = "configResolved"
+)
+
+// StorageInterestGroupAuctionFetchType enum of network fetches auctions can do.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-InterestGroupAuctionFetchType
+type StorageInterestGroupAuctionFetchType string
+
+// Values of StorageInterestGroupAuctionFetchType.
+const (
+	StorageInterestGroupAuctionFetchTypeBidderJS             StorageInterestGroupAuctionFetchType = "bidderJs"
+	StorageInterestGroupAuctionFetchTypeBidderWasm           StorageInterestGroupAuctionFetchType = "bidderWasm"
+	StorageInterestGroupAuctionFetchTypeSellerJS             StorageInterestGroupAuctionFetchType = "sellerJs"
+	StorageInterestGroupAuctionFetchTypeBidderTrustedSignals StorageInterestGroupAuctionFetchType = "bidderTrustedSignals"
+	StorageInterestGroupAuctionFetchTypeSellerTrustedSignals StorageInterestGroupAuctionFetchType = "sellerTrustedSignals"
+)
+
+// StorageSharedStorageAccessScope enum of shared storage access scopes.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-SharedStorageAccessScope
+type StorageSharedStorageAccessScope string
+
+// Values of StorageSharedStorageAccessScope.
+const (
+	StorageSharedStorageAccessScopeWindow                   StorageSharedStorageAccessScope = "window"
+	StorageSharedStorageAccessScopeSharedStorageWorklet     StorageSharedStorageAccessScope = "sharedStorageWorklet"
+	StorageSharedStorageAccessScopeProtectedAudienceWorklet StorageSharedStorageAccessScope = "protectedAudienceWorklet"
+	StorageSharedStorageAccessScopeHeader                   StorageSharedStorageAccessScope = "header"
+)
+
+// StorageSharedStorageAccessMethod enum of shared storage access methods.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-SharedStorageAccessMethod
+type StorageSharedStorageAccessMethod string
+
+// Values of StorageSharedStorageAccessMethod.
+const (
+	StorageSharedStorageAccessMethodAddModule       StorageSharedStorageAccessMethod = "addModule"
+	StorageSharedStorageAccessMethodCreateWorklet   StorageSharedStorageAccessMethod = "createWorklet"
+	StorageSharedStorageAccessMethodSelectURL       StorageSharedStorageAccessMethod = "selectURL"
+	StorageSharedStorageAccessMethodRun             StorageSharedStorageAccessMethod = "run"
+	StorageSharedStorageAccessMethodBatchUpdate     StorageSharedStorageAccessMethod = "batchUpdate"
+	StorageSharedStorageAccessMethodSet             StorageSharedStorageAccessMethod = "set"
+	StorageSharedStorageAccessMethodAppend          StorageSharedStorageAccessMethod = "append"
+	StorageSharedStorageAccessMethodDelete          StorageSharedStorageAccessMethod = "delete"
+	StorageSharedStorageAccessMethodClear           StorageSharedStorageAccessMethod = "clear"
+	StorageSharedStorageAccessMethodGet             StorageSharedStorageAccessMethod = "get"
+	StorageSharedStorageAccessMethodKeys            StorageSharedStorageAccessMethod = "keys"
+	StorageSharedStorageAccessMethodValues          StorageSharedStorageAccessMethod = "values"
+	StorageSharedStorageAccessMethodEntries         StorageSharedStorageAccessMethod = "entries"
+	StorageSharedStorageAccessMethodLength          StorageSharedStorageAccessMethod = "length"
+	StorageSharedStorageAccessMethodRemainingBudget StorageSharedStorageAccessMethod = "remainingBudget"
+)
+
+// StorageSharedStorageEntry struct for a single key-value pair in an origin's
+// shared storage.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-SharedStorageEntry
+type StorageSharedStorageEntry struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// StorageSharedStorageMetadata details for an origin's shared storage.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-SharedStorageMetadata
+type StorageSharedStorageMetadata struct {
+	// Time when the origin's shared storage was last created.
+	CreationTime NetworkTimeSinceEpoch `json:"creationTime"`
+	// Number of key-value pairs stored in origin's shared storage.
+	Length int64 `json:"length"`
+	// Current amount of bits of entropy remaining in the navigation budget.
+	RemainingBudget float64 `json:"remainingBudget"`
+	// Total number of bytes stored as key-value pairs in origin's shared storage.
+	BytesUsed int64 `json:"bytesUsed"`
+}
+
+// StorageSharedStoragePrivateAggregationConfig represents a dictionary object
+// passed in as privateAggregationConfig to run or selectURL.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-SharedStoragePrivateAggregationConfig
+type StorageSharedStoragePrivateAggregationConfig struct {
+	// The chosen aggregation service deployment.
+	AggregationCoordinatorOrigin string `json:"aggregationCoordinatorOrigin,omitempty"`
+	// The context ID provided.
+	ContextID string `json:"contextId,omitempty"`
+	// Configures the maximum size allowed for filtering IDs.
+	FilteringIDMaxBytes int64 `json:"filteringIdMaxBytes"`
+	// The limit on the number of contributions in the final report.
+	MaxContributions int64 `json:"maxContributions,omitempty"`
+}
+
+// StorageSharedStorageReportingMetadata pair of reporting metadata details for
+// a candidate URL for `selectURL()`.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-SharedStorageReportingMetadata
+type StorageSharedStorageReportingMetadata struct {
+	EventType    string `json:"eventType"`
+	ReportingURL string `json:"reportingUrl"`
+}
+
+// StorageSharedStorageURLWithMetadata bundles a candidate URL with its
+// reporting metadata.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-SharedStorageUrlWithMetadata
+type StorageSharedStorageURLWithMetadata struct {
+	// Spec of candidate URL.
+	URL string `json:"url"`
+	// Any associated reporting metadata.
+	ReportingMetadata []StorageSharedStorageReportingMetadata `json:"reportingMetadata"`
+}
+
+// StorageSharedStorageAccessParams bundles the parameters for shared storage
+// access events whose presence/absence can vary according to
+// SharedStorageAccessType.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-SharedStorageAccessParams
+type StorageSharedStorageAccessParams struct {
+	// Spec of the module script URL. Present only for SharedStorageAccessMethods:
+	// addModule and createWorklet.
+	ScriptSourceURL string `json:"scriptSourceUrl,omitempty"`
+	// String denoting "context-origin", "script-origin", or a custom origin to be
+	// used as the worklet's data origin. Present only for
+	// SharedStorageAccessMethod: createWorklet.
+	DataOrigin string `json:"dataOrigin,omitempty"`
+	// Name of the registered operation to be run. Present only for
+	// SharedStorageAccessMethods: run and selectURL.
+	OperationName string `json:"operationName,omitempty"`
+	// ID of the operation call. Present only for SharedStorageAccessMethods: run
+	// and selectURL.
+	OperationID string `json:"operationId,omitempty"`
+	// Whether or not to keep the worket alive for future run or selectURL calls.
+	// Present only for SharedStorageAccessMethods: run and selectURL.
+	KeepAlive bool `json:"keepAlive,omitempty"`
+	// Configures the private aggregation options. Present only for
+	// SharedStorageAccessMethods: run and selectURL.
+	PrivateAggregationConfig *StorageSharedStoragePrivateAggregationConfig `json:"privateAggregationConfig,omitempty"`
+	// The operation's serialized data in bytes (converted to a string). Present
+	// only for SharedStorageAccessMethods: run and selectURL.
+	// TODO(crbug.com/401011862): Consider updating this parameter to binary.
+	SerializedData string `json:"serializedData,omitempty"`
+	// Array of candidate URLs' specs, along with any associated metadata. Present
+	// only for SharedStorageAccessMethod: selectURL.
+	UrlsWithMetadata []StorageSharedStorageURLWithMetadata `json:"urlsWithMetadata,omitempty"`
+	// Spec of the URN:UUID generated for a selectURL call. Present only for
+	// SharedStorageAccessMethod: selectURL.
+	UrnUUID string `json:"urnUuid,omitempty"`
+	// Key for a specific entry in an origin's shared storage. Present only for
+	// SharedStorageAccessMethods: set, append, delete, and get.
+	Key string `json:"key,omitempty"`
+	// Value for a specific entry in an origin's shared storage. Present only for
+	// SharedStorageAccessMethods: set and append.
+	Value string `json:"value,omitempty"`
+	// Whether or not to set an entry for a key if that key is already present.
+	// Present only for SharedStorageAccessMethod: set.
+	IgnoreIfPresent bool `json:"ignoreIfPresent,omitempty"`
+	// A number denoting the (0-based) order of the worklet's creation relative to
+	// all other shared storage worklets created by documents using the current
+	// storage partition. Present only for SharedStorageAccessMethods: addModule,
+	// createWorklet.
+	WorkletOrdinal int64 `json:"workletOrdinal,omitempty"`
+	// Hex representation of the DevTools token used as the TargetID for the
+	// associated shared storage worklet. Present only for
+	// SharedStorageAccessMethods: addModule, createWorklet, run, selectURL, and
+	// any other SharedStorageAccessMethod when the SharedStorageAccessScope is
+	// sharedStorageWorklet.
+	WorkletTargetID TargetTargetID `json:"workletTargetId,omitempty"`
+	// Name of the lock to be acquired, if present. Optionally present only for
+	// SharedStorageAccessMethods: batchUpdate, set, append, delete, and clear.
+	WithLock string `json:"withLock,omitempty"`
+	// If the method has been called as part of a batchUpdate, then this number
+	// identifies the batch to which it belongs. Optionally present only for
+	// SharedStorageAccessMethods: batchUpdate (required), set, append, delete, and
+	// clear.
+	BatchUpdateID string `json:"batchUpdateId,omitempty"`
+	// Number of modifier methods sent in batch. Present only for
+	// SharedStorageAccessMethod: batchUpdate.
+	BatchSize int64 `json:"batchSize,omitempty"`
+}
+
+// StorageStorageBucketsDurability is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-StorageBucketsDurability
+type StorageStorageBucketsDurability string
+
+// Values of StorageStorageBucketsDurability.
+const (
+	StorageStorageBucketsDurabilityRelaxed StorageStorageBucketsDurability = "relaxed"
+	StorageStorageBucketsDurabilityStrict  StorageStorageBucketsDurability = "strict"
+)
+
+// StorageStorageBucket is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-StorageBucket
+type StorageStorageBucket struct {
+	StorageKey StorageSerializedStorageKey `json:"storageKey"`
+	// If not specified, it is the default bucket of the storageKey.
+	Name string `json:"name,omitempty"`
+}
+
+// StorageStorageBucketInfo is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-StorageBucketInfo
+type StorageStorageBucketInfo struct {
+	Bucket     StorageStorageBucket  `json:"bucket"`
+	ID         string                `json:"id"`
+	Expiration NetworkTimeSinceEpoch `json:"expiration"`
+	// Storage quota (bytes).
+	Quota      float64                         `json:"quota"`
+	Persistent bool                            `json:"persistent"`
+	Durability StorageStorageBucketsDurability `json:"durability"`
+}
+
+// StorageAttributionReportingSourceType is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-AttributionReportingSourceType
+//
+// This is an experimental part of the protocol.
+type StorageAttributionReportingSourceType string
+
+// Values of StorageAttributionReportingSourceType.
+const (
+	StorageAttributionReportingSourceTypeNavigation StorageAttributionReportingSourceType = "navigation"
+	StorageAttributionReportingSourceTypeEvent      StorageAttributionReportingSourceType = "event"
+)
+
+// StorageUnsignedInt64AsBase10 is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-UnsignedInt64AsBase10
+//
+// This is an experimental part of the protocol.
+type StorageUnsignedInt64AsBase10 string
+
+// StorageUnsignedInt128AsBase16 is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-UnsignedInt128AsBase16
+//
+// This is an experimental part of the protocol.
+type StorageUnsignedInt128AsBase16 string
+
+// StorageSignedInt64AsBase10 is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-SignedInt64AsBase10
+//
+// This is an experimental part of the protocol.
+type StorageSignedInt64AsBase10 string
+
+// StorageAttributionReportingFilterDataEntry is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-AttributionReportingFilterDataEntry
+//
+// This is an experimental part of the protocol.
+type StorageAttributionReportingFilterDataEntry struct {
+	Key    string   `json:"key"`
+	Values []string `json:"values"`
+}
+
+// StorageAttributionReportingFilterConfig is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-AttributionReportingFilterConfig
+//
+// This is an experimental part of the protocol.
+type StorageAttributionReportingFilterConfig struct {
+	FilterValues []StorageAttributionReportingFilterDataEntry `json:"filterValues"`
+	// duration in seconds
+	LookbackWindow int64 `json:"lookbackWindow,omitempty"`
+}
+
+// StorageAttributionReportingFilterPair is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-AttributionReportingFilterPair
+//
+// This is an experimental part of the protocol.
+type StorageAttributionReportingFilterPair struct {
+	Filters    []StorageAttributionReportingFilterConfig `json:"filters"`
+	NotFilters []StorageAttributionReportingFilterConfig `json:"notFilters"`
+}
+
+// StorageAttributionReportingAggregationKeysEntry is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-AttributionReportingAggregationKeysEntry
+//
+// This is an experimental part of the protocol.
+type StorageAttributionReportingAggregationKeysEntry struct {
+	Key   string                        `json:"key"`
+	Value StorageUnsignedInt128AsBase16 `json:"value"`
+}
+
+// StorageAttributionReportingEventReportWindows is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-AttributionReportingEventReportWindows
+//
+// This is an experimental part of the protocol.
+type StorageAttributionReportingEventReportWindows struct {
+	// duration in seconds
+	Start int64 `json:"start"`
+	// duration in seconds
+	Ends []int64 `json:"ends"`
+}
+
+// StorageAttributionReportingTriggerDataMatching is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-AttributionReportingTriggerDataMatching
+//
+// This is an experimental part of the protocol.
+type StorageAttributionReportingTriggerDataMatching string
+
+// Values of StorageAttributionReportingTriggerDataMatching.
+const (
+	StorageAttributionReportingTriggerDataMatchingExact   StorageAttributionReportingTriggerDataMatching = "exact"
+	StorageAttributionReportingTriggerDataMatchingModulus StorageAttributionReportingTriggerDataMatching = "modulus"
+)
+
+// StorageAttributionReportingAggregatableDebugReportingData is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-AttributionReportingAggregatableDebugReportingData
+//
+// This is an experimental part of the protocol.
+type StorageAttributionReportingAggregatableDebugReportingData struct {
+	KeyPiece StorageUnsignedInt128AsBase16 `json:"keyPiece"`
+	// number instead of integer because not all uint32 can be represented by int
+	Value float64  `json:"value"`
+	Types []string `json:"types"`
+}
+
+// StorageAttributionReportingAggregatableDebugReportingConfig is a protocol
+// type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-AttributionReportingAggregatableDebugReportingConfig
+//
+// This is an experimental part of the protocol.
+type StorageAttributionReportingAggregatableDebugReportingConfig struct {
+	// number instead of integer because not all uint32 can be represented by int,
+	// only present for source registrations
+	Budget                       float64                                                     `json:"budget,omitempty"`
+	KeyPiece                     StorageUnsignedInt128AsBase16                               `json:"keyPiece"`
+	DebugData                    []StorageAttributionReportingAggregatableDebugReportingData `json:"debugData"`
+	AggregationCoordinatorOrigin string                                                      `json:"aggregationCoordinatorOrigin,omitempty"`
+}
+
+// StorageAttributionScopesData is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-AttributionScopesData
+//
+// This is an experimental part of the protocol.
+type StorageAttributionScopesData struct {
+	Values []string `json:"values"`
+	// number instead of integer because not all uint32 can be represented by int
+	Limit          float64 `json:"limit"`
+	MaxEventStates float64 `json:"maxEventStates"`
+}
+
+// StorageAttributionReportingNamedBudgetDef is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-AttributionReportingNamedBudgetDef
+//
+// This is an experimental part of the protocol.
+type StorageAttributionReportingNamedBudgetDef struct {
+	Name   string `json:"name"`
+	Budget int64  `json:"budget"`
+}
+
+// StorageAttributionReportingSourceRegistration is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-AttributionReportingSourceRegistration
+//
+// This is an experimental part of the protocol.
+type StorageAttributionReportingSourceRegistration struct {
+	Time NetworkTimeSinceEpoch `json:"time"`
+	// duration in seconds
+	Expiry int64 `json:"expiry"`
+	// number instead of integer because not all uint32 can be represented by int
+	TriggerData        []float64                                     `json:"triggerData"`
+	EventReportWindows StorageAttributionReportingEventReportWindows `json:"eventReportWindows"`
+	// duration in seconds
+	AggregatableReportWindow         int64                                                       `json:"aggregatableReportWindow"`
+	Type                             StorageAttributionReportingSourceType                       `json:"type"`
+	SourceOrigin                     string                                                      `json:"sourceOrigin"`
+	ReportingOrigin                  string                                                      `json:"reportingOrigin"`
+	DestinationSites                 []string                                                    `json:"destinationSites"`
+	EventID                          StorageUnsignedInt64AsBase10                                `json:"eventId"`
+	Priority                         StorageSignedInt64AsBase10                                  `json:"priority"`
+	FilterData                       []StorageAttributionReportingFilterDataEntry                `json:"filterData"`
+	AggregationKeys                  []StorageAttributionReportingAggregationKeysEntry           `json:"aggregationKeys"`
+	DebugKey                         StorageUnsignedInt64AsBase10                                `json:"debugKey,omitempty"`
+	TriggerDataMatching              StorageAttributionReportingTriggerDataMatching              `json:"triggerDataMatching"`
+	DestinationLimitPriority         StorageSignedInt64AsBase10                                  `json:"destinationLimitPriority"`
+	AggregatableDebugReportingConfig StorageAttributionReportingAggregatableDebugReportingConfig `json:"aggregatableDebugReportingConfig"`
+	ScopesData                       *StorageAttributionScopesData                               `json:"scopesData,omitempty"`
+	MaxEventLevelReports             int64                                                       `json:"maxEventLevelReports"`
+	NamedBudgets                     []StorageAttributionReportingNamedBudgetDef                 `json:"namedBudgets"`
+	DebugReporting                   bool                                                        `json:"debugReporting"`
+	EventLevelEpsilon                float64                                                     `json:"eventLevelEpsilon"`
+}
+
+// StorageAttributionReportingSourceRegistrationResult is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-AttributionReportingSourceRegistrationResult
+//
+// This is an experimental part of the protocol.
+type StorageAttributionReportingSourceRegistrationResult string
+
+// Values of StorageAttributionReportingSourceRegistrationResult.
+const (
+	StorageAttributionReportingSourceRegistrationResultSuccess                                StorageAttributionReportingSourceRegistrationResult = "success"
+	StorageAttributionReportingSourceRegistrationResultInternalError                          StorageAttributionReportingSourceRegistrationResult = "internalError"
+	StorageAttributionReportingSourceRegistrationResultInsufficientSourceCapacity             StorageAttributionReportingSourceRegistrationResult = "insufficientSourceCapacity"
+	StorageAttributionReportingSourceRegistrationResultInsufficientUniqueDestinationCapacity  StorageAttributionReportingSourceRegistrationResult = "insufficientUniqueDestinationCapacity"
+	StorageAttributionReportingSourceRegistrationResultExcessiveReportingOrigins              StorageAttributionReportingSourceRegistrationResult = "excessiveReportingOrigins"
+	StorageAttributionReportingSourceRegistrationResultProhibitedByBrowserPolicy              StorageAttributionReportingSourceRegistrationResult = "prohibitedByBrowserPolicy"
+	StorageAttributionReportingSourceRegistrationResultSuccessNoised                          StorageAttributionReportingSourceRegistrationResult = "successNoised"
+	StorageAttributionReportingSourceRegistrationResultDestinationReportingLimitReached       StorageAttributionReportingSourceRegistrationResult = "destinationReportingLimitReached"
+	StorageAttributionReportingSourceRegistrationResultDestinationGlobalLimitReached          StorageAttributionReportingSourceRegistrationResult = "destinationGlobalLimitReached"
+	StorageAttributionReportingSourceRegistrationResultDestinationBothLimitsReached           StorageAttributionReportingSourceRegistrationResult = "destinationBothLimitsReached"
+	StorageAttributionReportingSourceRegistrationResultReportingOriginsPerSiteLimitReached    StorageAttributionReportingSourceRegistrationResult = "reportingOriginsPerSiteLimitReached"
+	StorageAttributionReportingSourceRegistrationResultExceedsMaxChannelCapacity              StorageAttributionReportingSourceRegistrationResult = "exceedsMaxChannelCapacity"
+	StorageAttributionReportingSourceRegistrationResultExceedsMaxScopesChannelCapacity        StorageAttributionReportingSourceRegistrationResult = "exceedsMaxScopesChannelCapacity"
+	StorageAttributionReportingSourceRegistrationResultExceedsMaxTriggerStateCardinality      StorageAttributionReportingSourceRegistrationResult = "exceedsMaxTriggerStateCardinality"
+	StorageAttributionReportingSourceRegistrationResultExceedsMaxEventStatesLimit             StorageAttributionReportingSourceRegistrationResult = "exceedsMaxEventStatesLimit"
+	StorageAttributionReportingSourceRegistrationResultDestinationPerDayReportingLimitReached StorageAttributionReportingSourceRegistrationResult = "destinationPerDayReportingLimitReached"
+)
+
+// StorageAttributionReportingSourceRegistrationTimeConfig is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-AttributionReportingSourceRegistrationTimeConfig
+//
+// This is an experimental part of the protocol.
+type StorageAttributionReportingSourceRegistrationTimeConfig string
+
+// Values of StorageAttributionReportingSourceRegistrationTimeConfig.
+const (
+	StorageAttributionReportingSourceRegistrationTimeConfigInclude StorageAttributionReportingSourceRegistrationTimeConfig = "include"
+	StorageAttributionReportingSourceRegistrationTimeConfigExclude StorageAttributionReportingSourceRegistrationTimeConfig = "exclude"
+)
+
+// StorageAttributionReportingAggregatableValueDictEntry is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-AttributionReportingAggregatableValueDictEntry
+//
+// This is an experimental part of the protocol.
+type StorageAttributionReportingAggregatableValueDictEntry struct {
+	Key string `json:"key"`
+	// number instead of integer because not all uint32 can be represented by int
+	Value       float64                      `json:"value"`
+	FilteringID StorageUnsignedInt64AsBase10 `json:"filteringId"`
+}
+
+// StorageAttributionReportingAggregatableValueEntry is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-AttributionReportingAggregatableValueEntry
+//
+// This is an experimental part of the protocol.
+type StorageAttributionReportingAggregatableValueEntry struct {
+	Values  []StorageAttributionReportingAggregatableValueDictEntry `json:"values"`
+	Filters StorageAttributionReportingFilterPair                   `json:"filters"`
+}
+
+// StorageAttributionReportingEventTriggerData is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-AttributionReportingEventTriggerData
+//
+// This is an experimental part of the protocol.
+type StorageAttributionReportingEventTriggerData struct {
+	Data     StorageUnsignedInt64AsBase10          `json:"data"`
+	Priority StorageSignedInt64AsBase10            `json:"priority"`
+	DedupKey StorageUnsignedInt64AsBase10          `json:"dedupKey,omitempty"`
+	Filters  StorageAttributionReportingFilterPair `json:"filters"`
+}
+
+// StorageAttributionReportingAggregatableTriggerData is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-AttributionReportingAggregatableTriggerData
+//
+// This is an experimental part of the protocol.
+type StorageAttributionReportingAggregatableTriggerData struct {
+	KeyPiece   StorageUnsignedInt128AsBase16         `json:"keyPiece"`
+	SourceKeys []string                              `json:"sourceKeys"`
+	Filters    StorageAttributionReportingFilterPair `json:"filters"`
+}
+
+// StorageAttributionReportingAggregatableDedupKey is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-AttributionReportingAggregatableDedupKey
+//
+// This is an experimental part of the protocol.
+type StorageAttributionReportingAggregatableDedupKey struct {
+	DedupKey StorageUnsignedInt64AsBase10          `json:"dedupKey,omitempty"`
+	Filters  StorageAttributionReportingFilterPair `json:"filters"`
+}
+
+// StorageAttributionReportingNamedBudgetCandidate is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-AttributionReportingNamedBudgetCandidate
+//
+// This is an experimental part of the protocol.
+type StorageAttributionReportingNamedBudgetCandidate struct {
+	Name    string                                `json:"name,omitempty"`
+	Filters StorageAttributionReportingFilterPair `json:"filters"`
+}
+
+// StorageAttributionReportingTriggerRegistration is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-AttributionReportingTriggerRegistration
+//
+// This is an experimental part of the protocol.
+type StorageAttributionReportingTriggerRegistration struct {
+	Filters                          StorageAttributionReportingFilterPair                       `json:"filters"`
+	DebugKey                         StorageUnsignedInt64AsBase10                                `json:"debugKey,omitempty"`
+	AggregatableDedupKeys            []StorageAttributionReportingAggregatableDedupKey           `json:"aggregatableDedupKeys"`
+	EventTriggerData                 []StorageAttributionReportingEventTriggerData               `json:"eventTriggerData"`
+	AggregatableTriggerData          []StorageAttributionReportingAggregatableTriggerData        `json:"aggregatableTriggerData"`
+	AggregatableValues               []StorageAttributionReportingAggregatableValueEntry         `json:"aggregatableValues"`
+	AggregatableFilteringIDMaxBytes  int64                                                       `json:"aggregatableFilteringIdMaxBytes"`
+	DebugReporting                   bool                                                        `json:"debugReporting"`
+	AggregationCoordinatorOrigin     string                                                      `json:"aggregationCoordinatorOrigin,omitempty"`
+	SourceRegistrationTimeConfig     StorageAttributionReportingSourceRegistrationTimeConfig     `json:"sourceRegistrationTimeConfig"`
+	TriggerContextID                 string                                                      `json:"triggerContextId,omitempty"`
+	AggregatableDebugReportingConfig StorageAttributionReportingAggregatableDebugReportingConfig `json:"aggregatableDebugReportingConfig"`
+	Scopes                           []string                                                    `json:"scopes"`
+	NamedBudgets                     []StorageAttributionReportingNamedBudgetCandidate           `json:"namedBudgets"`
+}
+
+// StorageAttributionReportingEventLevelResult is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-AttributionReportingEventLevelResult
+//
+// This is an experimental part of the protocol.
+type StorageAttributionReportingEventLevelResult string
+
+// Values of StorageAttributionReportingEventLevelResult.
+const (
+	StorageAttributionReportingEventLevelResultSuccess                             StorageAttributionReportingEventLevelResult = "success"
+	StorageAttributionReportingEventLevelResultSuccessDroppedLowerPriority         StorageAttributionReportingEventLevelResult = "successDroppedLowerPriority"
+	StorageAttributionReportingEventLevelResultInternalError                       StorageAttributionReportingEventLevelResult = "internalError"
+	StorageAttributionReportingEventLevelResultNoCapacityForAttributionDestination StorageAttributionReportingEventLevelResult = "noCapacityForAttributionDestination"
+	StorageAttributionReportingEventLevelResultNoMatchingSources                   StorageAttributionReportingEventLevelResult = "noMatchingSources"
+	StorageAttributionReportingEventLevelResultDeduplicated                        StorageAttributionReportingEventLevelResult = "deduplicated"
+	StorageAttributionReportingEventLevelResultExcessiveAttributions               StorageAttributionReportingEventLevelResult = "excessiveAttributions"
+	StorageAttributionReportingEventLevelResultPriorityTooLow                      StorageAttributionReportingEventLevelResult = "priorityTooLow"
+	StorageAttributionReportingEventLevelResultNeverAttributedSource               StorageAttributionReportingEventLevelResult = "neverAttributedSource"
+	StorageAttributionReportingEventLevelResultExcessiveReportingOrigins           StorageAttributionReportingEventLevelResult = "excessiveReportingOrigins"
+	StorageAttributionReportingEventLevelResultNoMatchingSourceFilterData          StorageAttributionReportingEventLevelResult = "noMatchingSourceFilterData"
+	StorageAttributionReportingEventLevelResultProhibitedByBrowserPolicy           StorageAttributionReportingEventLevelResult = "prohibitedByBrowserPolicy"
+	StorageAttributionReportingEventLevelResultNoMatchingConfigurations            StorageAttributionReportingEventLevelResult = "noMatchingConfigurations"
+	StorageAttributionReportingEventLevelResultExcessiveReports                    StorageAttributionReportingEventLevelResult = "excessiveReports"
+	StorageAttributionReportingEventLevelResultFalselyAttributedSource             StorageAttributionReportingEventLevelResult = "falselyAttributedSource"
+	StorageAttributionReportingEventLevelResultReportWindowPassed                  StorageAttributionReportingEventLevelResult = "reportWindowPassed"
+	StorageAttributionReportingEventLevelResultNotRegistered                       StorageAttributionReportingEventLevelResult = "notRegistered"
+	StorageAttributionReportingEventLevelResultReportWindowNotStarted              StorageAttributionReportingEventLevelResult = "reportWindowNotStarted"
+	StorageAttributionReportingEventLevelResultNoMatchingTriggerData               StorageAttributionReportingEventLevelResult = "noMatchingTriggerData"
+)
+
+// StorageAttributionReportingAggregatableResult is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-AttributionReportingAggregatableResult
+//
+// This is an experimental part of the protocol.
+type StorageAttributionReportingAggregatableResult string
+
+// Values of StorageAttributionReportingAggregatableResult.
+const (
+	StorageAttributionReportingAggregatableResultSuccess                             StorageAttributionReportingAggregatableResult = "success"
+	StorageAttributionReportingAggregatableResultInternalError                       StorageAttributionReportingAggregatableResult = "internalError"
+	StorageAttributionReportingAggregatableResultNoCapacityForAttributionDestination StorageAttributionReportingAggregatableResult = "noCapacityForAttributionDestination"
+	StorageAttributionReportingAggregatableResultNoMatchingSources                   StorageAttributionReportingAggregatableResult = "noMatchingSources"
+	StorageAttributionReportingAggregatableResultExcessiveAttributions               StorageAttributionReportingAggregatableResult = "excessiveAttributions"
+	StorageAttributionReportingAggregatableResultExcessiveReportingOrigins           StorageAttributionReportingAggregatableResult = "excessiveReportingOrigins"
+	StorageAttributionReportingAggregatableResultNoHistograms                        StorageAttributionReportingAggregatableResult = "noHistograms"
+	StorageAttributionReportingAggregatableResultInsufficientBudget                  StorageAttributionReportingAggregatableResult = "insufficientBudget"
+	StorageAttributionReportingAggregatableResultInsufficientNamedBudget             StorageAttributionReportingAggregatableResult = "insufficientNamedBudget"
+	StorageAttributionReportingAggregatableResultNoMatchingSourceFilterData          StorageAttributionReportingAggregatableResult = "noMatchingSourceFilterData"
+	StorageAttributionReportingAggregatableResultNotRegistered                       StorageAttributionReportingAggregatableResult = "notRegistered"
+	StorageAttributionReportingAggregatableResultProhibitedByBrowserPolicy           StorageAttributionReportingAggregatableResult = "prohibitedByBrowserPolicy"
+	StorageAttributionReportingAggregatableResultDeduplicated                        StorageAttributionReportingAggregatableResult = "deduplicated"
+	StorageAttributionReportingAggregatableResultReportWindowPassed                  StorageAttributionReportingAggregatableResult = "reportWindowPassed"
+	StorageAttributionReportingAggregatableResultExcessiveReports                    StorageAttributionReportingAggregatableResult = "excessiveReports"
+)
+
+// StorageAttributionReportingReportResult is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-AttributionReportingReportResult
+//
+// This is an experimental part of the protocol.
+type StorageAttributionReportingReportResult string
+
+// Values of StorageAttributionReportingReportResult.
+const (
+	StorageAttributionReportingReportResultSent             StorageAttributionReportingReportResult = "sent"
+	StorageAttributionReportingReportResultProhibited       StorageAttributionReportingReportResult = "prohibited"
+	StorageAttributionReportingReportResultFailedToAssemble StorageAttributionReportingReportResult = "failedToAssemble"
+	StorageAttributionReportingReportResultExpired          StorageAttributionReportingReportResult = "expired"
+)
+
+// StorageRelatedWebsiteSet a single Related Website Set object.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-RelatedWebsiteSet
+//
+// This is an experimental part of the protocol.
+type StorageRelatedWebsiteSet struct {
+	// The primary site of this set, along with the ccTLDs if there is any.
+	PrimarySites []string `json:"primarySites"`
+	// The associated sites of this set, along with the ccTLDs if there is any.
+	AssociatedSites []string `json:"associatedSites"`
+	// The service sites of this set, along with the ccTLDs if there is any.
+	ServiceSites []string `json:"serviceSites"`
+}
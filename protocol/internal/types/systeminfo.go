@@ -0,0 +1,139 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package types
+
+// SystemInfoGPUDevice describes a single graphics processor (GPU).
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/SystemInfo#type-GPUDevice
+type SystemInfoGPUDevice struct {
+	// PCI ID of the GPU vendor, if available; 0 otherwise.
+	VendorID float64 `json:"vendorId"`
+	// PCI ID of the GPU device, if available; 0 otherwise.
+	DeviceID float64 `json:"deviceId"`
+	// Sub sys ID of the GPU, only available on Windows.
+	SubSysID float64 `json:"subSysId,omitempty"`
+	// Revision of the GPU, only available on Windows.
+	Revision float64 `json:"revision,omitempty"`
+	// String description of the GPU vendor, if the PCI ID is not available.
+	VendorString string `json:"vendorString"`
+	// String description of the GPU device, if the PCI ID is not available.
+	DeviceString string `json:"deviceString"`
+	// String description of the GPU driver vendor.
+	DriverVendor string `json:"driverVendor"`
+	// String description of the GPU driver version.
+	DriverVersion string `json:"driverVersion"`
+}
+
+// SystemInfoSize describes the width and height dimensions of an entity.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/SystemInfo#type-Size
+type SystemInfoSize struct {
+	// Width in pixels.
+	Width int64 `json:"width"`
+	// Height in pixels.
+	Height int64 `json:"height"`
+}
+
+// SystemInfoVideoDecodeAcceleratorCapability describes a supported video
+// decoding profile with its associated minimum and maximum resolutions.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/SystemInfo#type-VideoDecodeAcceleratorCapability
+type SystemInfoVideoDecodeAcceleratorCapability struct {
+	// Video codec profile that is supported, e.g. VP9 Profile 2.
+	Profile string `json:"profile"`
+	// Maximum video dimensions in pixels supported for this |profile|.
+	MaxResolution SystemInfoSize `json:"maxResolution"`
+	// Minimum video dimensions in pixels supported for this |profile|.
+	MinResolution SystemInfoSize `json:"minResolution"`
+}
+
+// SystemInfoVideoEncodeAcceleratorCapability describes a supported video
+// encoding profile with its associated maximum resolution and maximum
+// framerate.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/SystemInfo#type-VideoEncodeAcceleratorCapability
+type SystemInfoVideoEncodeAcceleratorCapability struct {
+	// Video codec profile that is supported, e.g H264 Main.
+	Profile string `json:"profile"`
+	// Maximum video dimensions in pixels supported for this |profile|.
+	MaxResolution SystemInfoSize `json:"maxResolution"`
+	// Maximum encoding framerate in frames per second supported for this
+	// |profile|, as fraction's numerator and denominator, e.g. 24/1 fps,
+	// 24000/1001 fps, etc.
+	MaxFramerateNumerator   int64 `json:"maxFramerateNumerator"`
+	MaxFramerateDenominator int64 `json:"maxFramerateDenominator"`
+}
+
+// SystemInfoSubsamplingFormat YUV subsampling type of the pixels of a given
+// image.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/SystemInfo#type-SubsamplingFormat
+type SystemInfoSubsamplingFormat string
+
+// Values of SystemInfoSubsamplingFormat.
+const (
+	SystemInfoSubsamplingFormatYuv420 SystemInfoSubsamplingFormat = "yuv420"
+	SystemInfoSubsamplingFormatYuv422 SystemInfoSubsamplingFormat = "yuv422"
+	SystemInfoSubsamplingFormatYuv444 SystemInfoSubsamplingFormat = "yuv444"
+)
+
+// SystemInfoImageType image format of a given image.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/SystemInfo#type-ImageType
+type SystemInfoImageType string
+
+// Values of SystemInfoImageType.
+const (
+	SystemInfoImageTypeJpeg    SystemInfoImageType = "jpeg"
+	SystemInfoImageTypeWebp    SystemInfoImageType = "webp"
+	SystemInfoImageTypeUnknown SystemInfoImageType = "unknown"
+)
+
+// SystemInfoImageDecodeAcceleratorCapability describes a supported image
+// decoding profile with its associated minimum and maximum resolutions and
+// subsampling.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/SystemInfo#type-ImageDecodeAcceleratorCapability
+type SystemInfoImageDecodeAcceleratorCapability struct {
+	// Image coded, e.g. Jpeg.
+	ImageType SystemInfoImageType `json:"imageType"`
+	// Maximum supported dimensions of the image in pixels.
+	MaxDimensions SystemInfoSize `json:"maxDimensions"`
+	// Minimum supported dimensions of the image in pixels.
+	MinDimensions SystemInfoSize `json:"minDimensions"`
+	// Optional array of supported subsampling formats, e.g. 4:2:0, if known.
+	Subsamplings []SystemInfoSubsamplingFormat `json:"subsamplings"`
+}
+
+// SystemInfoGPUInfo provides information about the GPU(s) on the system.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/SystemInfo#type-GPUInfo
+type SystemInfoGPUInfo struct {
+	// The graphics devices on the system. Element 0 is the primary GPU.
+	Devices []SystemInfoGPUDevice `json:"devices"`
+	// An optional dictionary of additional GPU related attributes.
+	AuxAttributes map[string]interface{} `json:"auxAttributes,omitempty"`
+	// An optional dictionary of graphics features and their status.
+	FeatureStatus map[string]interface{} `json:"featureStatus,omitempty"`
+	// An optional array of GPU driver bug workarounds.
+	DriverBugWorkarounds []string `json:"driverBugWorkarounds"`
+	// Supported accelerated video decoding capabilities.
+	VideoDecoding []SystemInfoVideoDecodeAcceleratorCapability `json:"videoDecoding"`
+	// Supported accelerated video encoding capabilities.
+	VideoEncoding []SystemInfoVideoEncodeAcceleratorCapability `json:"videoEncoding"`
+	// Supported accelerated image decoding capabilities.
+	ImageDecoding []SystemInfoImageDecodeAcceleratorCapability `json:"imageDecoding"`
+}
+
+// SystemInfoProcessInfo represents process info.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/SystemInfo#type-ProcessInfo
+type SystemInfoProcessInfo struct {
+	// Specifies process type.
+	Type string `json:"type"`
+	// Specifies process id.
+	ID int64 `json:"id"`
+	// Specifies cumulative CPU usage in seconds across all threads of the process
+	// since the process start.
+	CPUTime float64 `json:"cpuTime"`
+}
@@ -0,0 +1,94 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package types
+
+// TargetTargetID is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Target#type-TargetID
+type TargetTargetID string
+
+// TargetSessionID unique identifier of attached debugging session.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Target#type-SessionID
+type TargetSessionID string
+
+// TargetTargetInfo is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Target#type-TargetInfo
+type TargetTargetInfo struct {
+	TargetID TargetTargetID `json:"targetId"`
+	// List of types:
+	// https://source.chromium.org/chromium/chromium/src/+/main:content/browser/devtools/devtools_agent_host_impl.cc?ss=chromium&q=f:devtools%20-f:out%20%22::kTypeTab%5B%5D%22
+	Type  string `json:"type"`
+	Title string `json:"title"`
+	URL   string `json:"url"`
+	// Whether the target has an attached client.
+	Attached bool `json:"attached"`
+	// Opener target Id
+	OpenerID TargetTargetID `json:"openerId,omitempty"`
+	// Whether the target has access to the originating window.
+	//
+	// This is an experimental part of the protocol.
+	CanAccessOpener bool `json:"canAccessOpener"`
+	// Frame id of originating window (is only set if target has an opener).
+	//
+	// This is an experimental part of the protocol.
+	OpenerFrameID PageFrameID `json:"openerFrameId,omitempty"`
+	// This is an experimental part of the protocol.
+	BrowserContextID BrowserBrowserContextID `json:"browserContextId,omitempty"`
+	// Provides additional details for specific target types. For example, for the
+	// type of "page", this may be set to "prerender".
+	//
+	// This is an experimental part of the protocol.
+	Subtype string `json:"subtype,omitempty"`
+}
+
+// TargetFilterEntry a filter used by target query/discovery/auto-attach
+// operations.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Target#type-FilterEntry
+//
+// This is an experimental part of the protocol.
+type TargetFilterEntry struct {
+	// If set, causes exclusion of matching targets from the list.
+	Exclude bool `json:"exclude,omitempty"`
+	// If not present, matches any type.
+	Type string `json:"type,omitempty"`
+}
+
+// TargetTargetFilter the entries in TargetFilter are matched sequentially
+// against targets and the first entry that matches determines if the target is
+// included or not, depending on the value of `exclude` field in the entry. If
+// filter is not specified, the one assumed is [{type: "browser", exclude:
+// true}, {type: "tab", exclude: true}, {}] (i.e. include everything but
+// `browser` and `tab`).
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Target#type-TargetFilter
+//
+// This is an experimental part of the protocol.
+type TargetTargetFilter []TargetFilterEntry
+
+// TargetRemoteLocation is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Target#type-RemoteLocation
+//
+// This is an experimental part of the protocol.
+type TargetRemoteLocation struct {
+	Host string `json:"host"`
+	Port int64  `json:"port"`
+}
+
+// TargetWindowState the state of the target window.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Target#type-WindowState
+//
+// This is an experimental part of the protocol.
+type TargetWindowState string
+
+// Values of TargetWindowState.
+const (
+	TargetWindowStateNormal     TargetWindowState = "normal"
+	TargetWindowStateMinimized  TargetWindowState = "minimized"
+	TargetWindowStateMaximized  TargetWindowState = "maximized"
+	TargetWindowStateFullscreen TargetWindowState = "fullscreen"
+)
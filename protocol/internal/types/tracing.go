@@ -0,0 +1,117 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package types
+
+// TracingMemoryDumpConfig configuration for memory dump. Used only when
+// "memory-infra" category is enabled.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Tracing#type-MemoryDumpConfig
+//
+// This is an experimental part of the protocol.
+type TracingMemoryDumpConfig map[string]interface{}
+
+// TracingTraceConfig is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Tracing#type-TraceConfig
+type TracingTraceConfig struct {
+	// Controls how the trace buffer stores data. The default is `recordUntilFull`.
+	//
+	// Allowed values: recordUntilFull, recordContinuously, recordAsMuchAsPossible,
+	// echoToConsole.
+	//
+	// This is an experimental part of the protocol.
+	RecordMode string `json:"recordMode,omitempty"`
+	// Size of the trace buffer in kilobytes. If not specified or zero is passed, a
+	// default value of 200 MB would be used.
+	//
+	// This is an experimental part of the protocol.
+	TraceBufferSizeInKb float64 `json:"traceBufferSizeInKb,omitempty"`
+	// Turns on JavaScript stack sampling.
+	//
+	// This is an experimental part of the protocol.
+	EnableSampling bool `json:"enableSampling,omitempty"`
+	// Turns on system tracing.
+	//
+	// This is an experimental part of the protocol.
+	EnableSystrace bool `json:"enableSystrace,omitempty"`
+	// Turns on argument filter.
+	//
+	// This is an experimental part of the protocol.
+	EnableArgumentFilter bool `json:"enableArgumentFilter,omitempty"`
+	// Included category filters.
+	IncludedCategories []string `json:"includedCategories,omitempty"`
+	// Excluded category filters.
+	ExcludedCategories []string `json:"excludedCategories,omitempty"`
+	// Configuration to synthesize the delays in tracing.
+	//
+	// This is an experimental part of the protocol.
+	SyntheticDelays []string `json:"syntheticDelays,omitempty"`
+	// Configuration for memory dump triggers. Used only when "memory-infra"
+	// category is enabled.
+	//
+	// This is an experimental part of the protocol.
+	MemoryDumpConfig TracingMemoryDumpConfig `json:"memoryDumpConfig,omitempty"`
+}
+
+// TracingStreamFormat data format of a trace. Can be either the legacy JSON
+// format or the protocol buffer format. Note that the JSON format will be
+// deprecated soon.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Tracing#type-StreamFormat
+//
+// This is an experimental part of the protocol.
+type TracingStreamFormat string
+
+// Values of TracingStreamFormat.
+const (
+	TracingStreamFormatJSON  TracingStreamFormat = "json"
+	TracingStreamFormatProto TracingStreamFormat = "proto"
+)
+
+// TracingStreamCompression compression type to use for traces returned via
+// streams.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Tracing#type-StreamCompression
+//
+// This is an experimental part of the protocol.
+type TracingStreamCompression string
+
+// Values of TracingStreamCompression.
+const (
+	TracingStreamCompressionNone TracingStreamCompression = "none"
+	TracingStreamCompressionGzip TracingStreamCompression = "gzip"
+)
+
+// TracingMemoryDumpLevelOfDetail details exposed when memory request explicitly
+// declared. Keep consistent with memory_dump_request_args.h and
+// memory_instrumentation.mojom
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Tracing#type-MemoryDumpLevelOfDetail
+//
+// This is an experimental part of the protocol.
+type TracingMemoryDumpLevelOfDetail string
+
+// Values of TracingMemoryDumpLevelOfDetail.
+const (
+	TracingMemoryDumpLevelOfDetailBackground TracingMemoryDumpLevelOfDetail = "background"
+	TracingMemoryDumpLevelOfDetailLight      TracingMemoryDumpLevelOfDetail = "light"
+	TracingMemoryDumpLevelOfDetailDetailed   TracingMemoryDumpLevelOfDetail = "detailed"
+)
+
+// TracingTracingBackend backend type to use for tracing. `chrome` uses the
+// Chrome-integrated tracing service and is supported on all platforms. `system`
+// is only supported on Chrome OS and uses the Perfetto system tracing service.
+// `auto` chooses `system` when the perfettoConfig provided to Tracing.start
+// specifies at least one non-Chrome data source; otherwise uses `chrome`.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Tracing#type-TracingBackend
+//
+// This is an experimental part of the protocol.
+type TracingTracingBackend string
+
+// Values of TracingTracingBackend.
+const (
+	TracingTracingBackendAuto   TracingTracingBackend = "auto"
+	TracingTracingBackendChrome TracingTracingBackend = "chrome"
+	TracingTracingBackendSystem TracingTracingBackend = "system"
+)
@@ -0,0 +1,146 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package types
+
+// WebAudioGraphObjectID an unique ID for a graph object (AudioContext,
+// AudioNode, AudioParam) in Web Audio API
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/WebAudio#type-GraphObjectId
+type WebAudioGraphObjectID string
+
+// WebAudioContextType enum of BaseAudioContext types
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/WebAudio#type-ContextType
+type WebAudioContextType string
+
+// Values of WebAudioContextType.
+const (
+	WebAudioContextTypeRealtime WebAudioContextType = "realtime"
+	WebAudioContextTypeOffline  WebAudioContextType = "offline"
+)
+
+// WebAudioContextState enum of AudioContextState from the spec
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/WebAudio#type-ContextState
+type WebAudioContextState string
+
+// Values of WebAudioContextState.
+const (
+	WebAudioContextStateSuspended   WebAudioContextState = "suspended"
+	WebAudioContextStateRunning     WebAudioContextState = "running"
+	WebAudioContextStateClosed      WebAudioContextState = "closed"
+	WebAudioContextStateInterrupted WebAudioContextState = "interrupted"
+)
+
+// WebAudioNodeType enum of AudioNode types
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/WebAudio#type-NodeType
+type WebAudioNodeType string
+
+// WebAudioChannelCountMode enum of AudioNode::ChannelCountMode from the spec
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/WebAudio#type-ChannelCountMode
+type WebAudioChannelCountMode string
+
+// Values of WebAudioChannelCountMode.
+const (
+	WebAudioChannelCountModeClampedMax WebAudioChannelCountMode = "clamped-max"
+	WebAudioChannelCountModeExplicit   WebAudioChannelCountMode = "explicit"
+	WebAudioChannelCountModeMax        WebAudioChannelCountMode = "max"
+)
+
+// WebAudioChannelInterpretation enum of AudioNode::ChannelInterpretation from
+// the spec
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/WebAudio#type-ChannelInterpretation
+type WebAudioChannelInterpretation string
+
+// Values of WebAudioChannelInterpretation.
+const (
+	WebAudioChannelInterpretationDiscrete WebAudioChannelInterpretation = "discrete"
+	WebAudioChannelInterpretationSpeakers WebAudioChannelInterpretation = "speakers"
+)
+
+// WebAudioParamType enum of AudioParam types
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/WebAudio#type-ParamType
+type WebAudioParamType string
+
+// WebAudioAutomationRate enum of AudioParam::AutomationRate from the spec
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/WebAudio#type-AutomationRate
+type WebAudioAutomationRate string
+
+// Values of WebAudioAutomationRate.
+const (
+	WebAudioAutomationRateARate WebAudioAutomationRate = "a-rate"
+	WebAudioAutomationRateKRate WebAudioAutomationRate = "k-rate"
+)
+
+// WebAudioContextRealtimeData fields in AudioContext that change in real-time.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/WebAudio#type-ContextRealtimeData
+type WebAudioContextRealtimeData struct {
+	// The current context time in second in BaseAudioContext.
+	CurrentTime float64 `json:"currentTime"`
+	// The time spent on rendering graph divided by render quantum duration, and
+	// multiplied by 100. 100 means the audio renderer reached the full capacity
+	// and glitch may occur.
+	RenderCapacity float64 `json:"renderCapacity"`
+	// A running mean of callback interval.
+	CallbackIntervalMean float64 `json:"callbackIntervalMean"`
+	// A running variance of callback interval.
+	CallbackIntervalVariance float64 `json:"callbackIntervalVariance"`
+}
+
+// WebAudioBaseAudioContext protocol object for BaseAudioContext
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/WebAudio#type-BaseAudioContext
+type WebAudioBaseAudioContext struct {
+	ContextID    WebAudioGraphObjectID        `json:"contextId"`
+	ContextType  WebAudioContextType          `json:"contextType"`
+	ContextState WebAudioContextState         `json:"contextState"`
+	RealtimeData *WebAudioContextRealtimeData `json:"realtimeData,omitempty"`
+	// Platform-dependent callback buffer size.
+	CallbackBufferSize float64 `json:"callbackBufferSize"`
+	// Number of output channels supported by audio hardware in use.
+	MaxOutputChannelCount float64 `json:"maxOutputChannelCount"`
+	// Context sample rate.
+	SampleRate float64 `json:"sampleRate"`
+}
+
+// WebAudioAudioListener protocol object for AudioListener
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/WebAudio#type-AudioListener
+type WebAudioAudioListener struct {
+	ListenerID WebAudioGraphObjectID `json:"listenerId"`
+	ContextID  WebAudioGraphObjectID `json:"contextId"`
+}
+
+// WebAudioAudioNode protocol object for AudioNode
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/WebAudio#type-AudioNode
+type WebAudioAudioNode struct {
+	NodeID                WebAudioGraphObjectID         `json:"nodeId"`
+	ContextID             WebAudioGraphObjectID         `json:"contextId"`
+	NodeType              WebAudioNodeType              `json:"nodeType"`
+	NumberOfInputs        float64                       `json:"numberOfInputs"`
+	NumberOfOutputs       float64                       `json:"numberOfOutputs"`
+	ChannelCount          float64                       `json:"channelCount"`
+	ChannelCountMode      WebAudioChannelCountMode      `json:"channelCountMode"`
+	ChannelInterpretation WebAudioChannelInterpretation `json:"channelInterpretation"`
+}
+
+// WebAudioAudioParam protocol object for AudioParam
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/WebAudio#type-AudioParam
+type WebAudioAudioParam struct {
+	ParamID      WebAudioGraphObjectID  `json:"paramId"`
+	NodeID       WebAudioGraphObjectID  `json:"nodeId"`
+	ContextID    WebAudioGraphObjectID  `json:"contextId"`
+	ParamType    WebAudioParamType      `json:"paramType"`
+	Rate         WebAudioAutomationRate `json:"rate"`
+	DefaultValue float64                `json:"defaultValue"`
+	MinValue     float64                `json:"minValue"`
+	MaxValue     float64                `json:"maxValue"`
+}
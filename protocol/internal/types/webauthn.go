@@ -0,0 +1,124 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package types
+
+// WebAuthnAuthenticatorID is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/WebAuthn#type-AuthenticatorId
+type WebAuthnAuthenticatorID string
+
+// WebAuthnAuthenticatorProtocol is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/WebAuthn#type-AuthenticatorProtocol
+type WebAuthnAuthenticatorProtocol string
+
+// Values of WebAuthnAuthenticatorProtocol.
+const (
+	WebAuthnAuthenticatorProtocolU2f   WebAuthnAuthenticatorProtocol = "u2f"
+	WebAuthnAuthenticatorProtocolCtap2 WebAuthnAuthenticatorProtocol = "ctap2"
+)
+
+// WebAuthnCtap2Version is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/WebAuthn#type-Ctap2Version
+type WebAuthnCtap2Version string
+
+// Values of WebAuthnCtap2Version.
+const (
+	WebAuthnCtap2VersionCtap20 WebAuthnCtap2Version = "ctap2_0"
+	WebAuthnCtap2VersionCtap21 WebAuthnCtap2Version = "ctap2_1"
+)
+
+// WebAuthnAuthenticatorTransport is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/WebAuthn#type-AuthenticatorTransport
+type WebAuthnAuthenticatorTransport string
+
+// Values of WebAuthnAuthenticatorTransport.
+const (
+	WebAuthnAuthenticatorTransportUsb      WebAuthnAuthenticatorTransport = "usb"
+	WebAuthnAuthenticatorTransportNfc      WebAuthnAuthenticatorTransport = "nfc"
+	WebAuthnAuthenticatorTransportBle      WebAuthnAuthenticatorTransport = "ble"
+	WebAuthnAuthenticatorTransportCable    WebAuthnAuthenticatorTransport = "cable"
+	WebAuthnAuthenticatorTransportInternal WebAuthnAuthenticatorTransport = "internal"
+)
+
+// WebAuthnVirtualAuthenticatorOptions is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/WebAuthn#type-VirtualAuthenticatorOptions
+type WebAuthnVirtualAuthenticatorOptions struct {
+	Protocol WebAuthnAuthenticatorProtocol `json:"protocol"`
+	// Defaults to ctap2_0. Ignored if |protocol| == u2f.
+	Ctap2Version WebAuthnCtap2Version           `json:"ctap2Version,omitempty"`
+	Transport    WebAuthnAuthenticatorTransport `json:"transport"`
+	// Defaults to false.
+	HasResidentKey bool `json:"hasResidentKey,omitempty"`
+	// Defaults to false.
+	HasUserVerification bool `json:"hasUserVerification,omitempty"`
+	// If set to true, the authenticator will support the largeBlob extension.
+	// https://w3c.github.io/webauthn#largeBlob Defaults to false.
+	HasLargeBlob bool `json:"hasLargeBlob,omitempty"`
+	// If set to true, the authenticator will support the credBlob extension.
+	// https://fidoalliance.org/specs/fido-v2.1-rd-20201208/fido-client-to-authenticator-protocol-v2.1-rd-20201208.html#sctn-credBlob-extension
+	// Defaults to false.
+	HasCredBlob bool `json:"hasCredBlob,omitempty"`
+	// If set to true, the authenticator will support the minPinLength extension.
+	// https://fidoalliance.org/specs/fido-v2.1-ps-20210615/fido-client-to-authenticator-protocol-v2.1-ps-20210615.html#sctn-minpinlength-extension
+	// Defaults to false.
+	HasMinPinLength bool `json:"hasMinPinLength,omitempty"`
+	// If set to true, the authenticator will support the prf extension.
+	// https://w3c.github.io/webauthn/#prf-extension Defaults to false.
+	HasPrf bool `json:"hasPrf,omitempty"`
+	// If set to true, tests of user presence will succeed immediately. Otherwise,
+	// they will not be resolved. Defaults to true.
+	AutomaticPresenceSimulation bool `json:"automaticPresenceSimulation,omitempty"`
+	// Sets whether User Verification succeeds or fails for an authenticator.
+	// Defaults to false.
+	IsUserVerified bool `json:"isUserVerified,omitempty"`
+	// Credentials created by this authenticator will have the backup eligibility
+	// (BE) flag set to this value. Defaults to false.
+	// https://w3c.github.io/webauthn/#sctn-credential-backup
+	DefaultBackupEligibility bool `json:"defaultBackupEligibility,omitempty"`
+	// Credentials created by this authenticator will have the backup state (BS)
+	// flag set to this value. Defaults to false.
+	// https://w3c.github.io/webauthn/#sctn-credential-backup
+	DefaultBackupState bool `json:"defaultBackupState,omitempty"`
+}
+
+// WebAuthnCredential is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/WebAuthn#type-Credential
+type WebAuthnCredential struct {
+	CredentialID         string `json:"credentialId"`
+	IsResidentCredential bool   `json:"isResidentCredential"`
+	// Relying Party ID the credential is scoped to. Must be set when adding a
+	// credential.
+	RpID string `json:"rpId,omitempty"`
+	// The ECDSA P-256 private key in PKCS#8 format. (Encoded as a base64 string
+	// when passed over JSON)
+	PrivateKey string `json:"privateKey"`
+	// An opaque byte sequence with a maximum size of 64 bytes mapping the
+	// credential to a specific user. (Encoded as a base64 string when passed over
+	// JSON)
+	UserHandle string `json:"userHandle,omitempty"`
+	// Signature counter. This is incremented by one for each successful assertion.
+	// See https://w3c.github.io/webauthn/#signature-counter
+	SignCount int64 `json:"signCount"`
+	// The large blob associated with the credential. See
+	// https://w3c.github.io/webauthn/#sctn-large-blob-extension (Encoded as a
+	// base64 string when passed over JSON)
+	LargeBlob string `json:"largeBlob,omitempty"`
+	// Assertions returned by this credential will have the backup eligibility (BE)
+	// flag set to this value. Defaults to the authenticator's
+	// defaultBackupEligibility value.
+	BackupEligibility bool `json:"backupEligibility,omitempty"`
+	// Assertions returned by this credential will have the backup state (BS) flag
+	// set to this value. Defaults to the authenticator's defaultBackupState value.
+	BackupState bool `json:"backupState,omitempty"`
+	// The credential's user.name property. Equivalent to empty if not set.
+	// https://w3c.github.io/webauthn/#dom-publickeycredentialentity-name
+	UserName string `json:"userName,omitempty"`
+	// The credential's user.displayName property. Equivalent to empty if not set.
+	// https://w3c.github.io/webauthn/#dom-publickeycredentialuserentity-displayname
+	UserDisplayName string `json:"userDisplayName,omitempty"`
+}
@@ -0,0 +1,124 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+// Package io provides typed bindings for the IO domain of the Chrome DevTools
+// protocol.
+//
+// Input/Output operations for streams produced by DevTools.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/IO
+package io
+
+import (
+	"context"
+
+	"github.com/tonyhb/chromedebugo"
+	"github.com/tonyhb/chromedebugo/protocol"
+	"github.com/tonyhb/chromedebugo/protocol/internal/types"
+)
+
+// StreamHandle this is either obtained from another method or specified as
+// `blob:<uuid>` where `<uuid>` is an UUID of a Blob.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/IO#type-StreamHandle
+type StreamHandle = types.IOStreamHandle
+
+// Method names of the commands in the IO domain.
+const (
+	CommandClose       = "IO.close"
+	CommandRead        = "IO.read"
+	CommandResolveBlob = "IO.resolveBlob"
+)
+
+// CloseParams are the parameters of IO.close.
+//
+// Close the stream, discard any temporary backing storage.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/IO#method-close
+type CloseParams struct {
+	// Handle of the stream to close.
+	Handle StreamHandle `json:"handle"`
+}
+
+// Command returns the IO.close command for use with AsyncDebugger.Send.
+func (p CloseParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandClose, p)
+}
+
+// Do sends IO.close to d and waits for it to complete or for ctx to
+// be done.
+func (p CloseParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandClose, p, nil)
+}
+
+// ReadParams are the parameters of IO.read.
+//
+// # Read a chunk of the stream
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/IO#method-read
+type ReadParams struct {
+	// Handle of the stream to read.
+	Handle StreamHandle `json:"handle"`
+	// Seek to the specified offset before reading (if not specified, proceed with
+	// offset following the last read). Some types of streams may only support
+	// sequential reads.
+	Offset int64 `json:"offset,omitempty"`
+	// Maximum number of bytes to read (left upon the agent discretion if not
+	// specified).
+	Size int64 `json:"size,omitempty"`
+}
+
+// Command returns the IO.read command for use with AsyncDebugger.Send.
+func (p ReadParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandRead, p)
+}
+
+// ReadReturns are the values returned by IO.read.
+type ReadReturns struct {
+	// Set if the data is base64-encoded
+	Base64Encoded bool `json:"base64Encoded,omitempty"`
+	// Data that were read.
+	Data string `json:"data"`
+	// Set if the end-of-file condition occurred while reading.
+	Eof bool `json:"eof"`
+}
+
+// Do sends IO.read to d and waits for its result or for ctx to be
+// done.
+func (p ReadParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*ReadReturns, error) {
+	ret := &ReadReturns{}
+	if err := protocol.Do(ctx, d, CommandRead, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// ResolveBlobParams are the parameters of IO.resolveBlob.
+//
+// Return UUID of Blob object specified by a remote object id.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/IO#method-resolveBlob
+type ResolveBlobParams struct {
+	// Object id of a Blob object wrapper.
+	ObjectID types.RuntimeRemoteObjectID `json:"objectId"`
+}
+
+// Command returns the IO.resolveBlob command for use with AsyncDebugger.Send.
+func (p ResolveBlobParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandResolveBlob, p)
+}
+
+// ResolveBlobReturns are the values returned by IO.resolveBlob.
+type ResolveBlobReturns struct {
+	// UUID of the specified Blob.
+	UUID string `json:"uuid"`
+}
+
+// Do sends IO.resolveBlob to d and waits for its result or for ctx to be
+// done.
+func (p ResolveBlobParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*ResolveBlobReturns, error) {
+	ret := &ResolveBlobReturns{}
+	if err := protocol.Do(ctx, d, CommandResolveBlob, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
@@ -0,0 +1,377 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+// Package layertree provides typed bindings for the LayerTree domain of the
+// Chrome DevTools protocol.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/LayerTree
+//
+// This is an experimental part of the protocol.
+package layertree
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tonyhb/chromedebugo"
+	"github.com/tonyhb/chromedebugo/protocol"
+	"github.com/tonyhb/chromedebugo/protocol/internal/types"
+)
+
+// LayerID unique Layer identifier.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/LayerTree#type-LayerId
+type LayerID = types.LayerTreeLayerID
+
+// SnapshotID unique snapshot identifier.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/LayerTree#type-SnapshotId
+type SnapshotID = types.LayerTreeSnapshotID
+
+// ScrollRect rectangle where scrolling happens on the main thread.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/LayerTree#type-ScrollRect
+type ScrollRect = types.LayerTreeScrollRect
+
+// StickyPositionConstraint sticky position constraints.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/LayerTree#type-StickyPositionConstraint
+type StickyPositionConstraint = types.LayerTreeStickyPositionConstraint
+
+// PictureTile serialized fragment of layer picture along with its offset within
+// the layer.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/LayerTree#type-PictureTile
+type PictureTile = types.LayerTreePictureTile
+
+// Layer information about a compositing layer.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/LayerTree#type-Layer
+type Layer = types.LayerTreeLayer
+
+// PaintProfile array of timings, one per paint step.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/LayerTree#type-PaintProfile
+type PaintProfile = types.LayerTreePaintProfile
+
+// Method names of the commands in the LayerTree domain.
+const (
+	CommandCompositingReasons = "LayerTree.compositingReasons"
+	CommandDisable            = "LayerTree.disable"
+	CommandEnable             = "LayerTree.enable"
+	CommandLoadSnapshot       = "LayerTree.loadSnapshot"
+	CommandMakeSnapshot       = "LayerTree.makeSnapshot"
+	CommandProfileSnapshot    = "LayerTree.profileSnapshot"
+	CommandReleaseSnapshot    = "LayerTree.releaseSnapshot"
+	CommandReplaySnapshot     = "LayerTree.replaySnapshot"
+	CommandSnapshotCommandLog = "LayerTree.snapshotCommandLog"
+)
+
+// CompositingReasonsParams are the parameters of LayerTree.compositingReasons.
+//
+// Provides the reasons why the given layer was composited.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/LayerTree#method-compositingReasons
+type CompositingReasonsParams struct {
+	// The id of the layer for which we want to get the reasons it was composited.
+	LayerID LayerID `json:"layerId"`
+}
+
+// Command returns the LayerTree.compositingReasons command for use with
+// AsyncDebugger.Send.
+func (p CompositingReasonsParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandCompositingReasons, p)
+}
+
+// CompositingReasonsReturns are the values returned by LayerTree.compositingReasons.
+type CompositingReasonsReturns struct {
+	// A list of strings specifying reasons for the given layer to become
+	// composited.
+	CompositingReasons []string `json:"compositingReasons"`
+	// A list of strings specifying reason IDs for the given layer to become
+	// composited.
+	CompositingReasonIds []string `json:"compositingReasonIds"`
+}
+
+// Do sends LayerTree.compositingReasons to d and waits for its result or for ctx to be
+// done.
+func (p CompositingReasonsParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*CompositingReasonsReturns, error) {
+	ret := &CompositingReasonsReturns{}
+	if err := protocol.Do(ctx, d, CommandCompositingReasons, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// DisableParams are the parameters of LayerTree.disable.
+//
+// Disables compositing tree inspection.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/LayerTree#method-disable
+type DisableParams struct {
+}
+
+// Command returns the LayerTree.disable command for use with
+// AsyncDebugger.Send.
+func (p DisableParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandDisable, p)
+}
+
+// Do sends LayerTree.disable to d and waits for it to complete or for ctx to
+// be done.
+func (p DisableParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandDisable, p, nil)
+}
+
+// EnableParams are the parameters of LayerTree.enable.
+//
+// Enables compositing tree inspection.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/LayerTree#method-enable
+type EnableParams struct {
+}
+
+// Command returns the LayerTree.enable command for use with AsyncDebugger.Send.
+func (p EnableParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandEnable, p)
+}
+
+// Do sends LayerTree.enable to d and waits for it to complete or for ctx to
+// be done.
+func (p EnableParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandEnable, p, nil)
+}
+
+// LoadSnapshotParams are the parameters of LayerTree.loadSnapshot.
+//
+// Returns the snapshot identifier.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/LayerTree#method-loadSnapshot
+type LoadSnapshotParams struct {
+	// An array of tiles composing the snapshot.
+	Tiles []PictureTile `json:"tiles"`
+}
+
+// Command returns the LayerTree.loadSnapshot command for use with
+// AsyncDebugger.Send.
+func (p LoadSnapshotParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandLoadSnapshot, p)
+}
+
+// LoadSnapshotReturns are the values returned by LayerTree.loadSnapshot.
+type LoadSnapshotReturns struct {
+	// The id of the snapshot.
+	SnapshotID SnapshotID `json:"snapshotId"`
+}
+
+// Do sends LayerTree.loadSnapshot to d and waits for its result or for ctx to be
+// done.
+func (p LoadSnapshotParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*LoadSnapshotReturns, error) {
+	ret := &LoadSnapshotReturns{}
+	if err := protocol.Do(ctx, d, CommandLoadSnapshot, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// MakeSnapshotParams are the parameters of LayerTree.makeSnapshot.
+//
+// Returns the layer snapshot identifier.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/LayerTree#method-makeSnapshot
+type MakeSnapshotParams struct {
+	// The id of the layer.
+	LayerID LayerID `json:"layerId"`
+}
+
+// Command returns the LayerTree.makeSnapshot command for use with
+// AsyncDebugger.Send.
+func (p MakeSnapshotParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandMakeSnapshot, p)
+}
+
+// MakeSnapshotReturns are the values returned by LayerTree.makeSnapshot.
+type MakeSnapshotReturns struct {
+	// The id of the layer snapshot.
+	SnapshotID SnapshotID `json:"snapshotId"`
+}
+
+// Do sends LayerTree.makeSnapshot to d and waits for its result or for ctx to be
+// done.
+func (p MakeSnapshotParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*MakeSnapshotReturns, error) {
+	ret := &MakeSnapshotReturns{}
+	if err := protocol.Do(ctx, d, CommandMakeSnapshot, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// ProfileSnapshotParams are the parameters of LayerTree.profileSnapshot.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/LayerTree#method-profileSnapshot
+type ProfileSnapshotParams struct {
+	// The id of the layer snapshot.
+	SnapshotID SnapshotID `json:"snapshotId"`
+	// The maximum number of times to replay the snapshot (1, if not specified).
+	MinRepeatCount int64 `json:"minRepeatCount,omitempty"`
+	// The minimum duration (in seconds) to replay the snapshot.
+	MinDuration float64 `json:"minDuration,omitempty"`
+	// The clip rectangle to apply when replaying the snapshot.
+	ClipRect *types.DOMRect `json:"clipRect,omitempty"`
+}
+
+// Command returns the LayerTree.profileSnapshot command for use with
+// AsyncDebugger.Send.
+func (p ProfileSnapshotParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandProfileSnapshot, p)
+}
+
+// ProfileSnapshotReturns are the values returned by LayerTree.profileSnapshot.
+type ProfileSnapshotReturns struct {
+	// The array of paint profiles, one per run.
+	Timings []PaintProfile `json:"timings"`
+}
+
+// Do sends LayerTree.profileSnapshot to d and waits for its result or for ctx to be
+// done.
+func (p ProfileSnapshotParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*ProfileSnapshotReturns, error) {
+	ret := &ProfileSnapshotReturns{}
+	if err := protocol.Do(ctx, d, CommandProfileSnapshot, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// ReleaseSnapshotParams are the parameters of LayerTree.releaseSnapshot.
+//
+// Releases layer snapshot captured by the back-end.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/LayerTree#method-releaseSnapshot
+type ReleaseSnapshotParams struct {
+	// The id of the layer snapshot.
+	SnapshotID SnapshotID `json:"snapshotId"`
+}
+
+// Command returns the LayerTree.releaseSnapshot command for use with
+// AsyncDebugger.Send.
+func (p ReleaseSnapshotParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandReleaseSnapshot, p)
+}
+
+// Do sends LayerTree.releaseSnapshot to d and waits for it to complete or for ctx to
+// be done.
+func (p ReleaseSnapshotParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandReleaseSnapshot, p, nil)
+}
+
+// ReplaySnapshotParams are the parameters of LayerTree.replaySnapshot.
+//
+// Replays the layer snapshot and returns the resulting bitmap.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/LayerTree#method-replaySnapshot
+type ReplaySnapshotParams struct {
+	// The id of the layer snapshot.
+	SnapshotID SnapshotID `json:"snapshotId"`
+	// The first step to replay from (replay from the very start if not specified).
+	FromStep int64 `json:"fromStep,omitempty"`
+	// The last step to replay to (replay till the end if not specified).
+	ToStep int64 `json:"toStep,omitempty"`
+	// The scale to apply while replaying (defaults to 1).
+	Scale float64 `json:"scale,omitempty"`
+}
+
+// Command returns the LayerTree.replaySnapshot command for use with
+// AsyncDebugger.Send.
+func (p ReplaySnapshotParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandReplaySnapshot, p)
+}
+
+// ReplaySnapshotReturns are the values returned by LayerTree.replaySnapshot.
+type ReplaySnapshotReturns struct {
+	// A data: URL for resulting image.
+	DataURL string `json:"dataURL"`
+}
+
+// Do sends LayerTree.replaySnapshot to d and waits for its result or for ctx to be
+// done.
+func (p ReplaySnapshotParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*ReplaySnapshotReturns, error) {
+	ret := &ReplaySnapshotReturns{}
+	if err := protocol.Do(ctx, d, CommandReplaySnapshot, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// SnapshotCommandLogParams are the parameters of LayerTree.snapshotCommandLog.
+//
+// Replays the layer snapshot and returns canvas log.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/LayerTree#method-snapshotCommandLog
+type SnapshotCommandLogParams struct {
+	// The id of the layer snapshot.
+	SnapshotID SnapshotID `json:"snapshotId"`
+}
+
+// Command returns the LayerTree.snapshotCommandLog command for use with
+// AsyncDebugger.Send.
+func (p SnapshotCommandLogParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSnapshotCommandLog, p)
+}
+
+// SnapshotCommandLogReturns are the values returned by LayerTree.snapshotCommandLog.
+type SnapshotCommandLogReturns struct {
+	// The array of canvas function calls.
+	CommandLog []map[string]interface{} `json:"commandLog"`
+}
+
+// Do sends LayerTree.snapshotCommandLog to d and waits for its result or for ctx to be
+// done.
+func (p SnapshotCommandLogParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*SnapshotCommandLogReturns, error) {
+	ret := &SnapshotCommandLogReturns{}
+	if err := protocol.Do(ctx, d, CommandSnapshotCommandLog, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// Method names of the events in the LayerTree domain.
+const (
+	EventLayerPainted       = "LayerTree.layerPainted"
+	EventLayerTreeDidChange = "LayerTree.layerTreeDidChange"
+)
+
+// LayerPaintedEvent is the payload of the LayerTree.layerPainted event.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/LayerTree#event-layerPainted
+type LayerPaintedEvent struct {
+	// The id of the painted layer.
+	LayerID LayerID `json:"layerId"`
+	// Clip rectangle.
+	Clip types.DOMRect `json:"clip"`
+}
+
+// LayerTreeDidChangeEvent is the payload of the LayerTree.layerTreeDidChange
+// event.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/LayerTree#event-layerTreeDidChange
+type LayerTreeDidChangeEvent struct {
+	// Layer tree, absent if not in the compositing mode.
+	Layers []Layer `json:"layers,omitempty"`
+}
+
+// ParseEvent decodes an event from the LayerTree domain, as received from
+// Subscribe, into a pointer to its typed payload.
+func ParseEvent(cmd chromedebugo.Command) (interface{}, error) {
+	var ev interface{}
+	switch cmd.Method {
+	case EventLayerPainted:
+		ev = &LayerPaintedEvent{}
+	case EventLayerTreeDidChange:
+		ev = &LayerTreeDidChangeEvent{}
+	default:
+		return nil, fmt.Errorf("unknown LayerTree event: %s", cmd.Method)
+	}
+	if err := cmd.Decode(ev); err != nil {
+		return nil, err
+	}
+	return ev, nil
+}
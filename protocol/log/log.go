@@ -0,0 +1,169 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+// Package log provides typed bindings for the Log domain of the Chrome DevTools
+// protocol.
+//
+// Provides access to log entries.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Log
+package log
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tonyhb/chromedebugo"
+	"github.com/tonyhb/chromedebugo/protocol"
+	"github.com/tonyhb/chromedebugo/protocol/internal/types"
+)
+
+// LogEntry log entry.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Log#type-LogEntry
+type LogEntry = types.LogLogEntry
+
+// ViolationSetting violation configuration setting.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Log#type-ViolationSetting
+type ViolationSetting = types.LogViolationSetting
+
+// Method names of the commands in the Log domain.
+const (
+	CommandClear                 = "Log.clear"
+	CommandDisable               = "Log.disable"
+	CommandEnable                = "Log.enable"
+	CommandStartViolationsReport = "Log.startViolationsReport"
+	CommandStopViolationsReport  = "Log.stopViolationsReport"
+)
+
+// ClearParams are the parameters of Log.clear.
+//
+// Clears the log.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Log#method-clear
+type ClearParams struct {
+}
+
+// Command returns the Log.clear command for use with AsyncDebugger.Send.
+func (p ClearParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandClear, p)
+}
+
+// Do sends Log.clear to d and waits for it to complete or for ctx to
+// be done.
+func (p ClearParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandClear, p, nil)
+}
+
+// DisableParams are the parameters of Log.disable.
+//
+// Disables log domain, prevents further log entries from being reported to the
+// client.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Log#method-disable
+type DisableParams struct {
+}
+
+// Command returns the Log.disable command for use with AsyncDebugger.Send.
+func (p DisableParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandDisable, p)
+}
+
+// Do sends Log.disable to d and waits for it to complete or for ctx to
+// be done.
+func (p DisableParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandDisable, p, nil)
+}
+
+// EnableParams are the parameters of Log.enable.
+//
+// Enables log domain, sends the entries collected so far to the client by means
+// of the `entryAdded` notification.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Log#method-enable
+type EnableParams struct {
+}
+
+// Command returns the Log.enable command for use with AsyncDebugger.Send.
+func (p EnableParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandEnable, p)
+}
+
+// Do sends Log.enable to d and waits for it to complete or for ctx to
+// be done.
+func (p EnableParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandEnable, p, nil)
+}
+
+// StartViolationsReportParams are the parameters of Log.startViolationsReport.
+//
+// start violation reporting.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Log#method-startViolationsReport
+type StartViolationsReportParams struct {
+	// Configuration for violations.
+	Config []ViolationSetting `json:"config"`
+}
+
+// Command returns the Log.startViolationsReport command for use with
+// AsyncDebugger.Send.
+func (p StartViolationsReportParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandStartViolationsReport, p)
+}
+
+// Do sends Log.startViolationsReport to d and waits for it to complete or for ctx to
+// be done.
+func (p StartViolationsReportParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandStartViolationsReport, p, nil)
+}
+
+// StopViolationsReportParams are the parameters of Log.stopViolationsReport.
+//
+// Stop violation reporting.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Log#method-stopViolationsReport
+type StopViolationsReportParams struct {
+}
+
+// Command returns the Log.stopViolationsReport command for use with
+// AsyncDebugger.Send.
+func (p StopViolationsReportParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandStopViolationsReport, p)
+}
+
+// Do sends Log.stopViolationsReport to d and waits for it to complete or for ctx to
+// be done.
+func (p StopViolationsReportParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandStopViolationsReport, p, nil)
+}
+
+// Method names of the events in the Log domain.
+const (
+	EventEntryAdded = "Log.entryAdded"
+)
+
+// EntryAddedEvent is the payload of the Log.entryAdded event.
+//
+// Issued when new message was logged.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Log#event-entryAdded
+type EntryAddedEvent struct {
+	// The entry.
+	Entry LogEntry `json:"entry"`
+}
+
+// ParseEvent decodes an event from the Log domain, as received from
+// Subscribe, into a pointer to its typed payload.
+func ParseEvent(cmd chromedebugo.Command) (interface{}, error) {
+	var ev interface{}
+	switch cmd.Method {
+	case EventEntryAdded:
+		ev = &EntryAddedEvent{}
+	default:
+		return nil, fmt.Errorf("unknown Log event: %s", cmd.Method)
+	}
+	if err := cmd.Decode(ev); err != nil {
+		return nil, err
+	}
+	return ev, nil
+}
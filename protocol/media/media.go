@@ -0,0 +1,190 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+// Package media provides typed bindings for the Media domain of the Chrome
+// DevTools protocol.
+//
+// # This domain allows detailed inspection of media elements
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Media
+//
+// This is an experimental part of the protocol.
+package media
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tonyhb/chromedebugo"
+	"github.com/tonyhb/chromedebugo/protocol"
+	"github.com/tonyhb/chromedebugo/protocol/internal/types"
+)
+
+// PlayerID players will get an ID that is unique within the agent context.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Media#type-PlayerId
+type PlayerID = types.MediaPlayerID
+
+// Timestamp is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Media#type-Timestamp
+type Timestamp = types.MediaTimestamp
+
+// PlayerMessage have one type per entry in MediaLogRecord::Type Corresponds to
+// kMessage
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Media#type-PlayerMessage
+type PlayerMessage = types.MediaPlayerMessage
+
+// PlayerProperty corresponds to kMediaPropertyChange
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Media#type-PlayerProperty
+type PlayerProperty = types.MediaPlayerProperty
+
+// PlayerEvent corresponds to kMediaEventTriggered
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Media#type-PlayerEvent
+type PlayerEvent = types.MediaPlayerEvent
+
+// PlayerErrorSourceLocation represents logged source line numbers reported in
+// an error. NOTE: file and line are from chromium c++ implementation code, not
+// js.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Media#type-PlayerErrorSourceLocation
+type PlayerErrorSourceLocation = types.MediaPlayerErrorSourceLocation
+
+// PlayerError corresponds to kMediaError
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Media#type-PlayerError
+type PlayerError = types.MediaPlayerError
+
+// Method names of the commands in the Media domain.
+const (
+	CommandEnable  = "Media.enable"
+	CommandDisable = "Media.disable"
+)
+
+// EnableParams are the parameters of Media.enable.
+//
+// # Enables the Media domain
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Media#method-enable
+type EnableParams struct {
+}
+
+// Command returns the Media.enable command for use with AsyncDebugger.Send.
+func (p EnableParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandEnable, p)
+}
+
+// Do sends Media.enable to d and waits for it to complete or for ctx to
+// be done.
+func (p EnableParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandEnable, p, nil)
+}
+
+// DisableParams are the parameters of Media.disable.
+//
+// Disables the Media domain.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Media#method-disable
+type DisableParams struct {
+}
+
+// Command returns the Media.disable command for use with AsyncDebugger.Send.
+func (p DisableParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandDisable, p)
+}
+
+// Do sends Media.disable to d and waits for it to complete or for ctx to
+// be done.
+func (p DisableParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandDisable, p, nil)
+}
+
+// Method names of the events in the Media domain.
+const (
+	EventPlayerPropertiesChanged = "Media.playerPropertiesChanged"
+	EventPlayerEventsAdded       = "Media.playerEventsAdded"
+	EventPlayerMessagesLogged    = "Media.playerMessagesLogged"
+	EventPlayerErrorsRaised      = "Media.playerErrorsRaised"
+	EventPlayersCreated          = "Media.playersCreated"
+)
+
+// PlayerPropertiesChangedEvent is the payload of the
+// Media.playerPropertiesChanged event.
+//
+// This can be called multiple times, and can be used to set / override / remove
+// player properties. A null propValue indicates removal.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Media#event-playerPropertiesChanged
+type PlayerPropertiesChangedEvent struct {
+	PlayerID   PlayerID         `json:"playerId"`
+	Properties []PlayerProperty `json:"properties"`
+}
+
+// PlayerEventsAddedEvent is the payload of the Media.playerEventsAdded event.
+//
+// Send events as a list, allowing them to be batched on the browser for less
+// congestion. If batched, events must ALWAYS be in chronological order.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Media#event-playerEventsAdded
+type PlayerEventsAddedEvent struct {
+	PlayerID PlayerID      `json:"playerId"`
+	Events   []PlayerEvent `json:"events"`
+}
+
+// PlayerMessagesLoggedEvent is the payload of the Media.playerMessagesLogged
+// event.
+//
+// Send a list of any messages that need to be delivered.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Media#event-playerMessagesLogged
+type PlayerMessagesLoggedEvent struct {
+	PlayerID PlayerID        `json:"playerId"`
+	Messages []PlayerMessage `json:"messages"`
+}
+
+// PlayerErrorsRaisedEvent is the payload of the Media.playerErrorsRaised event.
+//
+// Send a list of any errors that need to be delivered.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Media#event-playerErrorsRaised
+type PlayerErrorsRaisedEvent struct {
+	PlayerID PlayerID      `json:"playerId"`
+	Errors   []PlayerError `json:"errors"`
+}
+
+// PlayersCreatedEvent is the payload of the Media.playersCreated event.
+//
+// Called whenever a player is created, or when a new agent joins and receives a
+// list of active players. If an agent is restored, it will receive the full
+// list of player ids and all events again.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Media#event-playersCreated
+type PlayersCreatedEvent struct {
+	Players []PlayerID `json:"players"`
+}
+
+// ParseEvent decodes an event from the Media domain, as received from
+// Subscribe, into a pointer to its typed payload.
+func ParseEvent(cmd chromedebugo.Command) (interface{}, error) {
+	var ev interface{}
+	switch cmd.Method {
+	case EventPlayerPropertiesChanged:
+		ev = &PlayerPropertiesChangedEvent{}
+	case EventPlayerEventsAdded:
+		ev = &PlayerEventsAddedEvent{}
+	case EventPlayerMessagesLogged:
+		ev = &PlayerMessagesLoggedEvent{}
+	case EventPlayerErrorsRaised:
+		ev = &PlayerErrorsRaisedEvent{}
+	case EventPlayersCreated:
+		ev = &PlayersCreatedEvent{}
+	default:
+		return nil, fmt.Errorf("unknown Media event: %s", cmd.Method)
+	}
+	if err := cmd.Decode(ev); err != nil {
+		return nil, err
+	}
+	return ev, nil
+}
@@ -0,0 +1,350 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+// Package memory provides typed bindings for the Memory domain of the Chrome
+// DevTools protocol.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Memory
+//
+// This is an experimental part of the protocol.
+package memory
+
+import (
+	"context"
+
+	"github.com/tonyhb/chromedebugo"
+	"github.com/tonyhb/chromedebugo/protocol"
+	"github.com/tonyhb/chromedebugo/protocol/internal/types"
+)
+
+// PressureLevel memory pressure level.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Memory#type-PressureLevel
+type PressureLevel = types.MemoryPressureLevel
+
+// SamplingProfileNode heap profile sample.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Memory#type-SamplingProfileNode
+type SamplingProfileNode = types.MemorySamplingProfileNode
+
+// SamplingProfile array of heap profile samples.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Memory#type-SamplingProfile
+type SamplingProfile = types.MemorySamplingProfile
+
+// Module executable module information
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Memory#type-Module
+type Module = types.MemoryModule
+
+// DOMCounter DOM object counter data.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Memory#type-DOMCounter
+type DOMCounter = types.MemoryDOMCounter
+
+// Values of the enumerated types in the Memory domain.
+const (
+	PressureLevelModerate = types.MemoryPressureLevelModerate
+	PressureLevelCritical = types.MemoryPressureLevelCritical
+)
+
+// Method names of the commands in the Memory domain.
+const (
+	CommandGetDOMCounters                     = "Memory.getDOMCounters"
+	CommandGetDOMCountersForLeakDetection     = "Memory.getDOMCountersForLeakDetection"
+	CommandPrepareForLeakDetection            = "Memory.prepareForLeakDetection"
+	CommandForciblyPurgeJavaScriptMemory      = "Memory.forciblyPurgeJavaScriptMemory"
+	CommandSetPressureNotificationsSuppressed = "Memory.setPressureNotificationsSuppressed"
+	CommandSimulatePressureNotification       = "Memory.simulatePressureNotification"
+	CommandStartSampling                      = "Memory.startSampling"
+	CommandStopSampling                       = "Memory.stopSampling"
+	CommandGetAllTimeSamplingProfile          = "Memory.getAllTimeSamplingProfile"
+	CommandGetBrowserSamplingProfile          = "Memory.getBrowserSamplingProfile"
+	CommandGetSamplingProfile                 = "Memory.getSamplingProfile"
+)
+
+// GetDOMCountersParams are the parameters of Memory.getDOMCounters.
+//
+// Retruns current DOM object counters.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Memory#method-getDOMCounters
+type GetDOMCountersParams struct {
+}
+
+// Command returns the Memory.getDOMCounters command for use with
+// AsyncDebugger.Send.
+func (p GetDOMCountersParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandGetDOMCounters, p)
+}
+
+// GetDOMCountersReturns are the values returned by Memory.getDOMCounters.
+type GetDOMCountersReturns struct {
+	Documents        int64 `json:"documents"`
+	Nodes            int64 `json:"nodes"`
+	JSEventListeners int64 `json:"jsEventListeners"`
+}
+
+// Do sends Memory.getDOMCounters to d and waits for its result or for ctx to be
+// done.
+func (p GetDOMCountersParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*GetDOMCountersReturns, error) {
+	ret := &GetDOMCountersReturns{}
+	if err := protocol.Do(ctx, d, CommandGetDOMCounters, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// GetDOMCountersForLeakDetectionParams are the parameters of
+// Memory.getDOMCountersForLeakDetection.
+//
+// Retruns DOM object counters after preparing renderer for leak detection.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Memory#method-getDOMCountersForLeakDetection
+type GetDOMCountersForLeakDetectionParams struct {
+}
+
+// Command returns the Memory.getDOMCountersForLeakDetection command for use
+// with AsyncDebugger.Send.
+func (p GetDOMCountersForLeakDetectionParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandGetDOMCountersForLeakDetection, p)
+}
+
+// GetDOMCountersForLeakDetectionReturns are the values returned by Memory.getDOMCountersForLeakDetection.
+type GetDOMCountersForLeakDetectionReturns struct {
+	// DOM object counters.
+	Counters []DOMCounter `json:"counters"`
+}
+
+// Do sends Memory.getDOMCountersForLeakDetection to d and waits for its result or for ctx to be
+// done.
+func (p GetDOMCountersForLeakDetectionParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*GetDOMCountersForLeakDetectionReturns, error) {
+	ret := &GetDOMCountersForLeakDetectionReturns{}
+	if err := protocol.Do(ctx, d, CommandGetDOMCountersForLeakDetection, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// PrepareForLeakDetectionParams are the parameters of
+// Memory.prepareForLeakDetection.
+//
+// Prepares for leak detection by terminating workers, stopping spellcheckers,
+// dropping non-essential internal caches, running garbage collections, etc.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Memory#method-prepareForLeakDetection
+type PrepareForLeakDetectionParams struct {
+}
+
+// Command returns the Memory.prepareForLeakDetection command for use with
+// AsyncDebugger.Send.
+func (p PrepareForLeakDetectionParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandPrepareForLeakDetection, p)
+}
+
+// Do sends Memory.prepareForLeakDetection to d and waits for it to complete or for ctx to
+// be done.
+func (p PrepareForLeakDetectionParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandPrepareForLeakDetection, p, nil)
+}
+
+// ForciblyPurgeJavaScriptMemoryParams are the parameters of
+// Memory.forciblyPurgeJavaScriptMemory.
+//
+// Simulate OomIntervention by purging V8 memory.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Memory#method-forciblyPurgeJavaScriptMemory
+type ForciblyPurgeJavaScriptMemoryParams struct {
+}
+
+// Command returns the Memory.forciblyPurgeJavaScriptMemory command for use with
+// AsyncDebugger.Send.
+func (p ForciblyPurgeJavaScriptMemoryParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandForciblyPurgeJavaScriptMemory, p)
+}
+
+// Do sends Memory.forciblyPurgeJavaScriptMemory to d and waits for it to complete or for ctx to
+// be done.
+func (p ForciblyPurgeJavaScriptMemoryParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandForciblyPurgeJavaScriptMemory, p, nil)
+}
+
+// SetPressureNotificationsSuppressedParams are the parameters of
+// Memory.setPressureNotificationsSuppressed.
+//
+// Enable/disable suppressing memory pressure notifications in all processes.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Memory#method-setPressureNotificationsSuppressed
+type SetPressureNotificationsSuppressedParams struct {
+	// If true, memory pressure notifications will be suppressed.
+	Suppressed bool `json:"suppressed"`
+}
+
+// Command returns the Memory.setPressureNotificationsSuppressed command for use
+// with AsyncDebugger.Send.
+func (p SetPressureNotificationsSuppressedParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetPressureNotificationsSuppressed, p)
+}
+
+// Do sends Memory.setPressureNotificationsSuppressed to d and waits for it to complete or for ctx to
+// be done.
+func (p SetPressureNotificationsSuppressedParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetPressureNotificationsSuppressed, p, nil)
+}
+
+// SimulatePressureNotificationParams are the parameters of
+// Memory.simulatePressureNotification.
+//
+// Simulate a memory pressure notification in all processes.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Memory#method-simulatePressureNotification
+type SimulatePressureNotificationParams struct {
+	// Memory pressure level of the notification.
+	Level PressureLevel `json:"level"`
+}
+
+// Command returns the Memory.simulatePressureNotification command for use with
+// AsyncDebugger.Send.
+func (p SimulatePressureNotificationParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSimulatePressureNotification, p)
+}
+
+// Do sends Memory.simulatePressureNotification to d and waits for it to complete or for ctx to
+// be done.
+func (p SimulatePressureNotificationParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSimulatePressureNotification, p, nil)
+}
+
+// StartSamplingParams are the parameters of Memory.startSampling.
+//
+// Start collecting native memory profile.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Memory#method-startSampling
+type StartSamplingParams struct {
+	// Average number of bytes between samples.
+	SamplingInterval int64 `json:"samplingInterval,omitempty"`
+	// Do not randomize intervals between samples.
+	SuppressRandomness bool `json:"suppressRandomness,omitempty"`
+}
+
+// Command returns the Memory.startSampling command for use with
+// AsyncDebugger.Send.
+func (p StartSamplingParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandStartSampling, p)
+}
+
+// Do sends Memory.startSampling to d and waits for it to complete or for ctx to
+// be done.
+func (p StartSamplingParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandStartSampling, p, nil)
+}
+
+// StopSamplingParams are the parameters of Memory.stopSampling.
+//
+// Stop collecting native memory profile.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Memory#method-stopSampling
+type StopSamplingParams struct {
+}
+
+// Command returns the Memory.stopSampling command for use with
+// AsyncDebugger.Send.
+func (p StopSamplingParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandStopSampling, p)
+}
+
+// Do sends Memory.stopSampling to d and waits for it to complete or for ctx to
+// be done.
+func (p StopSamplingParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandStopSampling, p, nil)
+}
+
+// GetAllTimeSamplingProfileParams are the parameters of
+// Memory.getAllTimeSamplingProfile.
+//
+// Retrieve native memory allocations profile collected since renderer process
+// startup.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Memory#method-getAllTimeSamplingProfile
+type GetAllTimeSamplingProfileParams struct {
+}
+
+// Command returns the Memory.getAllTimeSamplingProfile command for use with
+// AsyncDebugger.Send.
+func (p GetAllTimeSamplingProfileParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandGetAllTimeSamplingProfile, p)
+}
+
+// GetAllTimeSamplingProfileReturns are the values returned by Memory.getAllTimeSamplingProfile.
+type GetAllTimeSamplingProfileReturns struct {
+	Profile SamplingProfile `json:"profile"`
+}
+
+// Do sends Memory.getAllTimeSamplingProfile to d and waits for its result or for ctx to be
+// done.
+func (p GetAllTimeSamplingProfileParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*GetAllTimeSamplingProfileReturns, error) {
+	ret := &GetAllTimeSamplingProfileReturns{}
+	if err := protocol.Do(ctx, d, CommandGetAllTimeSamplingProfile, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// GetBrowserSamplingProfileParams are the parameters of
+// Memory.getBrowserSamplingProfile.
+//
+// Retrieve native memory allocations profile collected since browser process
+// startup.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Memory#method-getBrowserSamplingProfile
+type GetBrowserSamplingProfileParams struct {
+}
+
+// Command returns the Memory.getBrowserSamplingProfile command for use with
+// AsyncDebugger.Send.
+func (p GetBrowserSamplingProfileParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandGetBrowserSamplingProfile, p)
+}
+
+// GetBrowserSamplingProfileReturns are the values returned by Memory.getBrowserSamplingProfile.
+type GetBrowserSamplingProfileReturns struct {
+	Profile SamplingProfile `json:"profile"`
+}
+
+// Do sends Memory.getBrowserSamplingProfile to d and waits for its result or for ctx to be
+// done.
+func (p GetBrowserSamplingProfileParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*GetBrowserSamplingProfileReturns, error) {
+	ret := &GetBrowserSamplingProfileReturns{}
+	if err := protocol.Do(ctx, d, CommandGetBrowserSamplingProfile, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// GetSamplingProfileParams are the parameters of Memory.getSamplingProfile.
+//
+// Retrieve native memory allocations profile collected since last
+// `startSampling` call.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Memory#method-getSamplingProfile
+type GetSamplingProfileParams struct {
+}
+
+// Command returns the Memory.getSamplingProfile command for use with
+// AsyncDebugger.Send.
+func (p GetSamplingProfileParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandGetSamplingProfile, p)
+}
+
+// GetSamplingProfileReturns are the values returned by Memory.getSamplingProfile.
+type GetSamplingProfileReturns struct {
+	Profile SamplingProfile `json:"profile"`
+}
+
+// Do sends Memory.getSamplingProfile to d and waits for its result or for ctx to be
+// done.
+func (p GetSamplingProfileParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*GetSamplingProfileReturns, error) {
+	ret := &GetSamplingProfileReturns{}
+	if err := protocol.Do(ctx, d, CommandGetSamplingProfile, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
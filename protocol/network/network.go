@@ -0,0 +1,2862 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+// Package network provides typed bindings for the Network domain of the Chrome
+// DevTools protocol.
+//
+// Network domain allows tracking network activities of the page. It exposes
+// information about http, file, data and other requests and responses, their
+// headers, bodies, timing, etc.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network
+package network
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tonyhb/chromedebugo"
+	"github.com/tonyhb/chromedebugo/protocol"
+	"github.com/tonyhb/chromedebugo/protocol/internal/types"
+)
+
+// ResourceType resource type as it was perceived by the rendering engine.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-ResourceType
+type ResourceType = types.NetworkResourceType
+
+// LoaderID unique loader identifier.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-LoaderId
+type LoaderID = types.NetworkLoaderID
+
+// RequestID unique network request identifier. Note that this does not identify
+// individual HTTP requests that are part of a network request.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-RequestId
+type RequestID = types.NetworkRequestID
+
+// InterceptionID unique intercepted request identifier.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-InterceptionId
+type InterceptionID = types.NetworkInterceptionID
+
+// ErrorReason network level fetch failure reason.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-ErrorReason
+type ErrorReason = types.NetworkErrorReason
+
+// TimeSinceEpoch UTC time in seconds, counted from January 1, 1970.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-TimeSinceEpoch
+type TimeSinceEpoch = types.NetworkTimeSinceEpoch
+
+// MonotonicTime monotonically increasing time in seconds since an arbitrary
+// point in the past.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-MonotonicTime
+type MonotonicTime = types.NetworkMonotonicTime
+
+// Headers request / response headers as keys / values of JSON object.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-Headers
+type Headers = types.NetworkHeaders
+
+// ConnectionType the underlying connection technology that the browser is
+// supposedly using.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-ConnectionType
+type ConnectionType = types.NetworkConnectionType
+
+// CookieSameSite represents the cookie's 'SameSite' status:
+// https://tools.ietf.org/html/draft-west-first-party-cookies
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-CookieSameSite
+type CookieSameSite = types.NetworkCookieSameSite
+
+// CookiePriority represents the cookie's 'Priority' status:
+// https://tools.ietf.org/html/draft-west-cookie-priority-00
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-CookiePriority
+//
+// This is an experimental part of the protocol.
+type CookiePriority = types.NetworkCookiePriority
+
+// CookieSourceScheme represents the source scheme of the origin that originally
+// set the cookie. A value of "Unset" allows protocol clients to emulate legacy
+// cookie scope for the scheme. This is a temporary ability and it will be
+// removed in the future.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-CookieSourceScheme
+//
+// This is an experimental part of the protocol.
+type CookieSourceScheme = types.NetworkCookieSourceScheme
+
+// ResourceTiming timing information for the request.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-ResourceTiming
+type ResourceTiming = types.NetworkResourceTiming
+
+// ResourcePriority loading priority of a resource request.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-ResourcePriority
+type ResourcePriority = types.NetworkResourcePriority
+
+// PostDataEntry post data entry for HTTP request
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-PostDataEntry
+type PostDataEntry = types.NetworkPostDataEntry
+
+// Request HTTP request data.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-Request
+type Request = types.NetworkRequest
+
+// SignedCertificateTimestamp details of a signed certificate timestamp (SCT).
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-SignedCertificateTimestamp
+type SignedCertificateTimestamp = types.NetworkSignedCertificateTimestamp
+
+// SecurityDetails security details about a request.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-SecurityDetails
+type SecurityDetails = types.NetworkSecurityDetails
+
+// CertificateTransparencyCompliance whether the request complied with
+// Certificate Transparency policy.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-CertificateTransparencyCompliance
+type CertificateTransparencyCompliance = types.NetworkCertificateTransparencyCompliance
+
+// BlockedReason the reason why request was blocked.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-BlockedReason
+type BlockedReason = types.NetworkBlockedReason
+
+// CorsError the reason why request was blocked.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-CorsError
+type CorsError = types.NetworkCorsError
+
+// CorsErrorStatus is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-CorsErrorStatus
+type CorsErrorStatus = types.NetworkCorsErrorStatus
+
+// ServiceWorkerResponseSource source of serviceworker response.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-ServiceWorkerResponseSource
+type ServiceWorkerResponseSource = types.NetworkServiceWorkerResponseSource
+
+// TrustTokenParams determines what type of Trust Token operation is executed
+// and depending on the type, some additional parameters. The values are
+// specified in third_party/blink/renderer/core/fetch/trust_token.idl.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-TrustTokenParams
+//
+// This is an experimental part of the protocol.
+type TrustTokenParams = types.NetworkTrustTokenParams
+
+// TrustTokenOperationType is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-TrustTokenOperationType
+//
+// This is an experimental part of the protocol.
+type TrustTokenOperationType = types.NetworkTrustTokenOperationType
+
+// AlternateProtocolUsage the reason why Chrome uses a specific transport
+// protocol for HTTP semantics.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-AlternateProtocolUsage
+//
+// This is an experimental part of the protocol.
+type AlternateProtocolUsage = types.NetworkAlternateProtocolUsage
+
+// ServiceWorkerRouterSource source of service worker router.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-ServiceWorkerRouterSource
+type ServiceWorkerRouterSource = types.NetworkServiceWorkerRouterSource
+
+// ServiceWorkerRouterInfo is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-ServiceWorkerRouterInfo
+//
+// This is an experimental part of the protocol.
+type ServiceWorkerRouterInfo = types.NetworkServiceWorkerRouterInfo
+
+// Response HTTP response data.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-Response
+type Response = types.NetworkResponse
+
+// WebSocketRequest webSocket request data.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-WebSocketRequest
+type WebSocketRequest = types.NetworkWebSocketRequest
+
+// WebSocketResponse webSocket response data.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-WebSocketResponse
+type WebSocketResponse = types.NetworkWebSocketResponse
+
+// WebSocketFrame webSocket message data. This represents an entire WebSocket
+// message, not just a fragmented frame as the name suggests.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-WebSocketFrame
+type WebSocketFrame = types.NetworkWebSocketFrame
+
+// CachedResource information about the cached resource.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-CachedResource
+type CachedResource = types.NetworkCachedResource
+
+// Initiator information about the request initiator.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-Initiator
+type Initiator = types.NetworkInitiator
+
+// CookiePartitionKey cookiePartitionKey object The representation of the
+// components of the key that are created by the cookiePartitionKey class
+// contained in net/cookies/cookie_partition_key.h.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-CookiePartitionKey
+//
+// This is an experimental part of the protocol.
+type CookiePartitionKey = types.NetworkCookiePartitionKey
+
+// Cookie cookie object
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-Cookie
+type Cookie = types.NetworkCookie
+
+// SetCookieBlockedReason types of reasons why a cookie may not be stored from a
+// response.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-SetCookieBlockedReason
+//
+// This is an experimental part of the protocol.
+type SetCookieBlockedReason = types.NetworkSetCookieBlockedReason
+
+// CookieBlockedReason types of reasons why a cookie may not be sent with a
+// request.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-CookieBlockedReason
+//
+// This is an experimental part of the protocol.
+type CookieBlockedReason = types.NetworkCookieBlockedReason
+
+// CookieExemptionReason types of reasons why a cookie should have been blocked
+// by 3PCD but is exempted for the request.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-CookieExemptionReason
+//
+// This is an experimental part of the protocol.
+type CookieExemptionReason = types.NetworkCookieExemptionReason
+
+// BlockedSetCookieWithReason a cookie which was not stored from a response with
+// the corresponding reason.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-BlockedSetCookieWithReason
+//
+// This is an experimental part of the protocol.
+type BlockedSetCookieWithReason = types.NetworkBlockedSetCookieWithReason
+
+// ExemptedSetCookieWithReason a cookie should have been blocked by 3PCD but is
+// exempted and stored from a response with the corresponding reason. A cookie
+// could only have at most one exemption reason.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-ExemptedSetCookieWithReason
+//
+// This is an experimental part of the protocol.
+type ExemptedSetCookieWithReason = types.NetworkExemptedSetCookieWithReason
+
+// AssociatedCookie a cookie associated with the request which may or may not be
+// sent with it. Includes the cookies itself and reasons for blocking or
+// exemption.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-AssociatedCookie
+//
+// This is an experimental part of the protocol.
+type AssociatedCookie = types.NetworkAssociatedCookie
+
+// CookieParam cookie parameter object
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-CookieParam
+type CookieParam = types.NetworkCookieParam
+
+// AuthChallenge authorization challenge for HTTP status code 401 or 407.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-AuthChallenge
+//
+// This is an experimental part of the protocol.
+type AuthChallenge = types.NetworkAuthChallenge
+
+// AuthChallengeResponse response to an AuthChallenge.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-AuthChallengeResponse
+//
+// This is an experimental part of the protocol.
+type AuthChallengeResponse = types.NetworkAuthChallengeResponse
+
+// InterceptionStage stages of the interception to begin intercepting. Request
+// will intercept before the request is sent. Response will intercept after the
+// response is received.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-InterceptionStage
+//
+// This is an experimental part of the protocol.
+type InterceptionStage = types.NetworkInterceptionStage
+
+// RequestPattern request pattern for interception.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-RequestPattern
+//
+// This is an experimental part of the protocol.
+type RequestPattern = types.NetworkRequestPattern
+
+// SignedExchangeSignature information about a signed exchange signature.
+// https://wicg.github.io/webpackage/draft-yasskin-httpbis-origin-signed-exchanges-impl.html#rfc.section.3.1
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-SignedExchangeSignature
+//
+// This is an experimental part of the protocol.
+type SignedExchangeSignature = types.NetworkSignedExchangeSignature
+
+// SignedExchangeHeader information about a signed exchange header.
+// https://wicg.github.io/webpackage/draft-yasskin-httpbis-origin-signed-exchanges-impl.html#cbor-representation
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-SignedExchangeHeader
+//
+// This is an experimental part of the protocol.
+type SignedExchangeHeader = types.NetworkSignedExchangeHeader
+
+// SignedExchangeErrorField field type for a signed exchange related error.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-SignedExchangeErrorField
+//
+// This is an experimental part of the protocol.
+type SignedExchangeErrorField = types.NetworkSignedExchangeErrorField
+
+// SignedExchangeError information about a signed exchange response.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-SignedExchangeError
+//
+// This is an experimental part of the protocol.
+type SignedExchangeError = types.NetworkSignedExchangeError
+
+// SignedExchangeInfo information about a signed exchange response.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-SignedExchangeInfo
+//
+// This is an experimental part of the protocol.
+type SignedExchangeInfo = types.NetworkSignedExchangeInfo
+
+// ContentEncoding list of content encodings supported by the backend.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-ContentEncoding
+//
+// This is an experimental part of the protocol.
+type ContentEncoding = types.NetworkContentEncoding
+
+// DirectSocketDnsQueryType is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-DirectSocketDnsQueryType
+//
+// This is an experimental part of the protocol.
+type DirectSocketDnsQueryType = types.NetworkDirectSocketDnsQueryType
+
+// DirectTCPSocketOptions is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-DirectTCPSocketOptions
+//
+// This is an experimental part of the protocol.
+type DirectTCPSocketOptions = types.NetworkDirectTCPSocketOptions
+
+// DirectUDPSocketOptions is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-DirectUDPSocketOptions
+//
+// This is an experimental part of the protocol.
+type DirectUDPSocketOptions = types.NetworkDirectUDPSocketOptions
+
+// DirectUDPMessage is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-DirectUDPMessage
+//
+// This is an experimental part of the protocol.
+type DirectUDPMessage = types.NetworkDirectUDPMessage
+
+// PrivateNetworkRequestPolicy is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-PrivateNetworkRequestPolicy
+//
+// This is an experimental part of the protocol.
+type PrivateNetworkRequestPolicy = types.NetworkPrivateNetworkRequestPolicy
+
+// IPAddressSpace is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-IPAddressSpace
+//
+// This is an experimental part of the protocol.
+type IPAddressSpace = types.NetworkIPAddressSpace
+
+// ConnectTiming is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-ConnectTiming
+//
+// This is an experimental part of the protocol.
+type ConnectTiming = types.NetworkConnectTiming
+
+// ClientSecurityState is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-ClientSecurityState
+//
+// This is an experimental part of the protocol.
+type ClientSecurityState = types.NetworkClientSecurityState
+
+// CrossOriginOpenerPolicyValue is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-CrossOriginOpenerPolicyValue
+//
+// This is an experimental part of the protocol.
+type CrossOriginOpenerPolicyValue = types.NetworkCrossOriginOpenerPolicyValue
+
+// CrossOriginOpenerPolicyStatus is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-CrossOriginOpenerPolicyStatus
+//
+// This is an experimental part of the protocol.
+type CrossOriginOpenerPolicyStatus = types.NetworkCrossOriginOpenerPolicyStatus
+
+// CrossOriginEmbedderPolicyValue is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-CrossOriginEmbedderPolicyValue
+//
+// This is an experimental part of the protocol.
+type CrossOriginEmbedderPolicyValue = types.NetworkCrossOriginEmbedderPolicyValue
+
+// CrossOriginEmbedderPolicyStatus is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-CrossOriginEmbedderPolicyStatus
+//
+// This is an experimental part of the protocol.
+type CrossOriginEmbedderPolicyStatus = types.NetworkCrossOriginEmbedderPolicyStatus
+
+// ContentSecurityPolicySource is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-ContentSecurityPolicySource
+//
+// This is an experimental part of the protocol.
+type ContentSecurityPolicySource = types.NetworkContentSecurityPolicySource
+
+// ContentSecurityPolicyStatus is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-ContentSecurityPolicyStatus
+//
+// This is an experimental part of the protocol.
+type ContentSecurityPolicyStatus = types.NetworkContentSecurityPolicyStatus
+
+// SecurityIsolationStatus is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-SecurityIsolationStatus
+//
+// This is an experimental part of the protocol.
+type SecurityIsolationStatus = types.NetworkSecurityIsolationStatus
+
+// ReportStatus the status of a Reporting API report.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-ReportStatus
+//
+// This is an experimental part of the protocol.
+type ReportStatus = types.NetworkReportStatus
+
+// ReportID is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-ReportId
+//
+// This is an experimental part of the protocol.
+type ReportID = types.NetworkReportID
+
+// ReportingAPIReport an object representing a report generated by the Reporting
+// API.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-ReportingApiReport
+//
+// This is an experimental part of the protocol.
+type ReportingAPIReport = types.NetworkReportingAPIReport
+
+// ReportingAPIEndpoint is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-ReportingApiEndpoint
+//
+// This is an experimental part of the protocol.
+type ReportingAPIEndpoint = types.NetworkReportingAPIEndpoint
+
+// LoadNetworkResourcePageResult an object providing the result of a network
+// resource load.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-LoadNetworkResourcePageResult
+//
+// This is an experimental part of the protocol.
+type LoadNetworkResourcePageResult = types.NetworkLoadNetworkResourcePageResult
+
+// LoadNetworkResourceOptions an options object that may be extended later to
+// better support CORS, CORB and streaming.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#type-LoadNetworkResourceOptions
+//
+// This is an experimental part of the protocol.
+type LoadNetworkResourceOptions = types.NetworkLoadNetworkResourceOptions
+
+// Values of the enumerated types in the Network domain.
+const (
+	ResourceTypeDocument                                                 = types.NetworkResourceTypeDocument
+	ResourceTypeStylesheet                                               = types.NetworkResourceTypeStylesheet
+	ResourceTypeImage                                                    = types.NetworkResourceTypeImage
+	ResourceTypeMedia                                                    = types.NetworkResourceTypeMedia
+	ResourceTypeFont                                                     = types.NetworkResourceTypeFont
+	ResourceTypeScript                                                   = types.NetworkResourceTypeScript
+	ResourceTypeTextTrack                                                = types.NetworkResourceTypeTextTrack
+	ResourceTypeXHR                                                      = types.NetworkResourceTypeXHR
+	ResourceTypeFetch                                                    = types.NetworkResourceTypeFetch
+	ResourceTypePrefetch                                                 = types.NetworkResourceTypePrefetch
+	ResourceTypeEventSource                                              = types.NetworkResourceTypeEventSource
+	ResourceTypeWebSocket                                                = types.NetworkResourceTypeWebSocket
+	ResourceTypeManifest                                                 = types.NetworkResourceTypeManifest
+	ResourceTypeSignedExchange                                           = types.NetworkResourceTypeSignedExchange
+	ResourceTypePing                                                     = types.NetworkResourceTypePing
+	ResourceTypeCSPViolationReport                                       = types.NetworkResourceTypeCSPViolationReport
+	ResourceTypePreflight                                                = types.NetworkResourceTypePreflight
+	ResourceTypeFedCM                                                    = types.NetworkResourceTypeFedCM
+	ResourceTypeOther                                                    = types.NetworkResourceTypeOther
+	ErrorReasonFailed                                                    = types.NetworkErrorReasonFailed
+	ErrorReasonAborted                                                   = types.NetworkErrorReasonAborted
+	ErrorReasonTimedOut                                                  = types.NetworkErrorReasonTimedOut
+	ErrorReasonAccessDenied                                              = types.NetworkErrorReasonAccessDenied
+	ErrorReasonConnectionClosed                                          = types.NetworkErrorReasonConnectionClosed
+	ErrorReasonConnectionReset                                           = types.NetworkErrorReasonConnectionReset
+	ErrorReasonConnectionRefused                                         = types.NetworkErrorReasonConnectionRefused
+	ErrorReasonConnectionAborted                                         = types.NetworkErrorReasonConnectionAborted
+	ErrorReasonConnectionFailed                                          = types.NetworkErrorReasonConnectionFailed
+	ErrorReasonNameNotResolved                                           = types.NetworkErrorReasonNameNotResolved
+	ErrorReasonInternetDisconnected                                      = types.NetworkErrorReasonInternetDisconnected
+	ErrorReasonAddressUnreachable                                        = types.NetworkErrorReasonAddressUnreachable
+	ErrorReasonBlockedByClient                                           = types.NetworkErrorReasonBlockedByClient
+	ErrorReasonBlockedByResponse                                         = types.NetworkErrorReasonBlockedByResponse
+	ConnectionTypeNone                                                   = types.NetworkConnectionTypeNone
+	ConnectionTypeCellular2g                                             = types.NetworkConnectionTypeCellular2g
+	ConnectionTypeCellular3g                                             = types.NetworkConnectionTypeCellular3g
+	ConnectionTypeCellular4g                                             = types.NetworkConnectionTypeCellular4g
+	ConnectionTypeBluetooth                                              = types.NetworkConnectionTypeBluetooth
+	ConnectionTypeEthernet                                               = types.NetworkConnectionTypeEthernet
+	ConnectionTypeWifi                                                   = types.NetworkConnectionTypeWifi
+	ConnectionTypeWimax                                                  = types.NetworkConnectionTypeWimax
+	ConnectionTypeOther                                                  = types.NetworkConnectionTypeOther
+	CookieSameSiteStrict                                                 = types.NetworkCookieSameSiteStrict
+	CookieSameSiteLax                                                    = types.NetworkCookieSameSiteLax
+	CookieSameSiteNone                                                   = types.NetworkCookieSameSiteNone
+	CookiePriorityLow                                                    = types.NetworkCookiePriorityLow
+	CookiePriorityMedium                                                 = types.NetworkCookiePriorityMedium
+	CookiePriorityHigh                                                   = types.NetworkCookiePriorityHigh
+	CookieSourceSchemeUnset                                              = types.NetworkCookieSourceSchemeUnset
+	CookieSourceSchemeNonSecure                                          = types.NetworkCookieSourceSchemeNonSecure
+	CookieSourceSchemeSecure                                             = types.NetworkCookieSourceSchemeSecure
+	ResourcePriorityVeryLow                                              = types.NetworkResourcePriorityVeryLow
+	ResourcePriorityLow                                                  = types.NetworkResourcePriorityLow
+	ResourcePriorityMedium                                               = types.NetworkResourcePriorityMedium
+	ResourcePriorityHigh                                                 = types.NetworkResourcePriorityHigh
+	ResourcePriorityVeryHigh                                             = types.NetworkResourcePriorityVeryHigh
+	CertificateTransparencyComplianceUnknown                             = types.NetworkCertificateTransparencyComplianceUnknown
+	CertificateTransparencyComplianceNotCompliant                        = types.NetworkCertificateTransparencyComplianceNotCompliant
+	CertificateTransparencyComplianceCompliant                           = types.NetworkCertificateTransparencyComplianceCompliant
+	BlockedReasonOther                                                   = types.NetworkBlockedReasonOther
+	BlockedReasonCsp                                                     = types.NetworkBlockedReasonCsp
+	BlockedReasonMixedContent                                            = types.NetworkBlockedReasonMixedContent
+	BlockedReasonOrigin                                                  = types.NetworkBlockedReasonOrigin
+	BlockedReasonInspector                                               = types.NetworkBlockedReasonInspector
+	BlockedReasonIntegrity                                               = types.NetworkBlockedReasonIntegrity
+	BlockedReasonSubresourceFilter                                       = types.NetworkBlockedReasonSubresourceFilter
+	BlockedReasonContentType                                             = types.NetworkBlockedReasonContentType
+	BlockedReasonCoepFrameResourceNeedsCoepHeader                        = types.NetworkBlockedReasonCoepFrameResourceNeedsCoepHeader
+	BlockedReasonCoopSandboxedIframeCannotNavigateToCoopPage             = types.NetworkBlockedReasonCoopSandboxedIframeCannotNavigateToCoopPage
+	BlockedReasonCorpNotSameOrigin                                       = types.NetworkBlockedReasonCorpNotSameOrigin
+	BlockedReasonCorpNotSameOriginAfterDefaultedToSameOriginByCoep       = types.NetworkBlockedReasonCorpNotSameOriginAfterDefaultedToSameOriginByCoep
+	BlockedReasonCorpNotSameOriginAfterDefaultedToSameOriginByDip        = types.NetworkBlockedReasonCorpNotSameOriginAfterDefaultedToSameOriginByDip
+	BlockedReasonCorpNotSameOriginAfterDefaultedToSameOriginByCoepAndDip = types.NetworkBlockedReasonCorpNotSameOriginAfterDefaultedToSameOriginByCoepAndDip
+	BlockedReasonCorpNotSameSite                                         = types.NetworkBlockedReasonCorpNotSameSite
+	BlockedReasonSriMessageSignatureMismatch                             = types.NetworkBlockedReasonSriMessageSignatureMismatch
+	CorsErrorDisallowedByMode                                            = types.NetworkCorsErrorDisallowedByMode
+	CorsErrorInvalidResponse                                             = types.NetworkCorsErrorInvalidResponse
+	CorsErrorWildcardOriginNotAllowed                                    = types.NetworkCorsErrorWildcardOriginNotAllowed
+	CorsErrorMissingAllowOriginHeader                                    = types.NetworkCorsErrorMissingAllowOriginHeader
+	CorsErrorMultipleAllowOriginValues                                   = types.NetworkCorsErrorMultipleAllowOriginValues
+	CorsErrorInvalidAllowOriginValue                                     = types.NetworkCorsErrorInvalidAllowOriginValue
+	CorsErrorAllowOriginMismatch                                         = types.NetworkCorsErrorAllowOriginMismatch
+	CorsErrorInvalidAllowCredentials                                     = types.NetworkCorsErrorInvalidAllowCredentials
+	CorsErrorCorsDisabledScheme                                          = types.NetworkCorsErrorCorsDisabledScheme
+	CorsErrorPreflightInvalidStatus                                      = types.NetworkCorsErrorPreflightInvalidStatus
+	CorsErrorPreflightDisallowedRedirect                                 = types.NetworkCorsErrorPreflightDisallowedRedirect
+	CorsErrorPreflightWildcardOriginNotAllowed                           = types.NetworkCorsErrorPreflightWildcardOriginNotAllowed
+	CorsErrorPreflightMissingAllowOriginHeader                           = types.NetworkCorsErrorPreflightMissingAllowOriginHeader
+	CorsErrorPreflightMultipleAllowOriginValues                          = types.NetworkCorsErrorPreflightMultipleAllowOriginValues
+	CorsErrorPreflightInvalidAllowOriginValue                            = types.NetworkCorsErrorPreflightInvalidAllowOriginValue
+	CorsErrorPreflightAllowOriginMismatch                                = types.NetworkCorsErrorPreflightAllowOriginMismatch
+	CorsErrorPreflightInvalidAllowCredentials                            = types.NetworkCorsErrorPreflightInvalidAllowCredentials
+	CorsErrorPreflightMissingAllowExternal                               = types.NetworkCorsErrorPreflightMissingAllowExternal
+	CorsErrorPreflightInvalidAllowExternal                               = types.NetworkCorsErrorPreflightInvalidAllowExternal
+	CorsErrorPreflightMissingAllowPrivateNetwork                         = types.NetworkCorsErrorPreflightMissingAllowPrivateNetwork
+	CorsErrorPreflightInvalidAllowPrivateNetwork                         = types.NetworkCorsErrorPreflightInvalidAllowPrivateNetwork
+	CorsErrorInvalidAllowMethodsPreflightResponse                        = types.NetworkCorsErrorInvalidAllowMethodsPreflightResponse
+	CorsErrorInvalidAllowHeadersPreflightResponse                        = types.NetworkCorsErrorInvalidAllowHeadersPreflightResponse
+	CorsErrorMethodDisallowedByPreflightResponse                         = types.NetworkCorsErrorMethodDisallowedByPreflightResponse
+	CorsErrorHeaderDisallowedByPreflightResponse                         = types.NetworkCorsErrorHeaderDisallowedByPreflightResponse
+	CorsErrorRedirectContainsCredentials                                 = types.NetworkCorsErrorRedirectContainsCredentials
+	CorsErrorInsecurePrivateNetwork                                      = types.NetworkCorsErrorInsecurePrivateNetwork
+	CorsErrorInvalidPrivateNetworkAccess                                 = types.NetworkCorsErrorInvalidPrivateNetworkAccess
+	CorsErrorUnexpectedPrivateNetworkAccess                              = types.NetworkCorsErrorUnexpectedPrivateNetworkAccess
+	CorsErrorNoCorsRedirectModeNotFollow                                 = types.NetworkCorsErrorNoCorsRedirectModeNotFollow
+	CorsErrorPreflightMissingPrivateNetworkAccessID                      = types.NetworkCorsErrorPreflightMissingPrivateNetworkAccessID
+	CorsErrorPreflightMissingPrivateNetworkAccessName                    = types.NetworkCorsErrorPreflightMissingPrivateNetworkAccessName
+	CorsErrorPrivateNetworkAccessPermissionUnavailable                   = types.NetworkCorsErrorPrivateNetworkAccessPermissionUnavailable
+	CorsErrorPrivateNetworkAccessPermissionDenied                        = types.NetworkCorsErrorPrivateNetworkAccessPermissionDenied
+	CorsErrorLocalNetworkAccessPermissionDenied                          = types.NetworkCorsErrorLocalNetworkAccessPermissionDenied
+	ServiceWorkerResponseSourceCacheStorage                              = types.NetworkServiceWorkerResponseSourceCacheStorage
+	ServiceWorkerResponseSourceHTTPCache                                 = types.NetworkServiceWorkerResponseSourceHTTPCache
+	ServiceWorkerResponseSourceFallbackCode                              = types.NetworkServiceWorkerResponseSourceFallbackCode
+	ServiceWorkerResponseSourceNetwork                                   = types.NetworkServiceWorkerResponseSourceNetwork
+	TrustTokenOperationTypeIssuance                                      = types.NetworkTrustTokenOperationTypeIssuance
+	TrustTokenOperationTypeRedemption                                    = types.NetworkTrustTokenOperationTypeRedemption
+	TrustTokenOperationTypeSigning                                       = types.NetworkTrustTokenOperationTypeSigning
+	AlternateProtocolUsageAlternativeJobWonWithoutRace                   = types.NetworkAlternateProtocolUsageAlternativeJobWonWithoutRace
+	AlternateProtocolUsageAlternativeJobWonRace                          = types.NetworkAlternateProtocolUsageAlternativeJobWonRace
+	AlternateProtocolUsageMainJobWonRace                                 = types.NetworkAlternateProtocolUsageMainJobWonRace
+	AlternateProtocolUsageMappingMissing                                 = types.NetworkAlternateProtocolUsageMappingMissing
+	AlternateProtocolUsageBroken                                         = types.NetworkAlternateProtocolUsageBroken
+	AlternateProtocolUsageDnsAlpnH3JobWonWithoutRace                     = types.NetworkAlternateProtocolUsageDnsAlpnH3JobWonWithoutRace
+	AlternateProtocolUsageDnsAlpnH3JobWonRace                            = types.NetworkAlternateProtocolUsageDnsAlpnH3JobWonRace
+	AlternateProtocolUsageUnspecifiedReason                              = types.NetworkAlternateProtocolUsageUnspecifiedReason
+	ServiceWorkerRouterSourceNetwork                                     = types.NetworkServiceWorkerRouterSourceNetwork
+	ServiceWorkerRouterSourceCache                                       = types.NetworkServiceWorkerRouterSourceCache
+	ServiceWorkerRouterSourceFetchEvent                                  = types.NetworkServiceWorkerRouterSourceFetchEvent
+	ServiceWorkerRouterSourceRaceNetworkAndFetchHandler                  = types.NetworkServiceWorkerRouterSourceRaceNetworkAndFetchHandler
+	ServiceWorkerRouterSourceRaceNetworkAndCache                         = types.NetworkServiceWorkerRouterSourceRaceNetworkAndCache
+	SetCookieBlockedReasonSecureOnly                                     = types.NetworkSetCookieBlockedReasonSecureOnly
+	SetCookieBlockedReasonSameSiteStrict                                 = types.NetworkSetCookieBlockedReasonSameSiteStrict
+	SetCookieBlockedReasonSameSiteLax                                    = types.NetworkSetCookieBlockedReasonSameSiteLax
+	SetCookieBlockedReasonSameSiteUnspecifiedTreatedAsLax                = types.NetworkSetCookieBlockedReasonSameSiteUnspecifiedTreatedAsLax
+	SetCookieBlockedReasonSameSiteNoneInsecure                           = types.NetworkSetCookieBlockedReasonSameSiteNoneInsecure
+	SetCookieBlockedReasonUserPreferences                                = types.NetworkSetCookieBlockedReasonUserPreferences
+	SetCookieBlockedReasonThirdPartyPhaseout                             = types.NetworkSetCookieBlockedReasonThirdPartyPhaseout
+	SetCookieBlockedReasonThirdPartyBlockedInFirstPartySet               = types.NetworkSetCookieBlockedReasonThirdPartyBlockedInFirstPartySet
+	SetCookieBlockedReasonSyntaxError                                    = types.NetworkSetCookieBlockedReasonSyntaxError
+	SetCookieBlockedReasonSchemeNotSupported                             = types.NetworkSetCookieBlockedReasonSchemeNotSupported
+	SetCookieBlockedReasonOverwriteSecure                                = types.NetworkSetCookieBlockedReasonOverwriteSecure
+	SetCookieBlockedReasonInvalidDomain                                  = types.NetworkSetCookieBlockedReasonInvalidDomain
+	SetCookieBlockedReasonInvalidPrefix                                  = types.NetworkSetCookieBlockedReasonInvalidPrefix
+	SetCookieBlockedReasonUnknownError                                   = types.NetworkSetCookieBlockedReasonUnknownError
+	SetCookieBlockedReasonSchemefulSameSiteStrict                        = types.NetworkSetCookieBlockedReasonSchemefulSameSiteStrict
+	SetCookieBlockedReasonSchemefulSameSiteLax                           = types.NetworkSetCookieBlockedReasonSchemefulSameSiteLax
+	SetCookieBlockedReasonSchemefulSameSiteUnspecifiedTreatedAsLax       = types.NetworkSetCookieBlockedReasonSchemefulSameSiteUnspecifiedTreatedAsLax
+	SetCookieBlockedReasonSamePartyFromCrossPartyContext                 = types.NetworkSetCookieBlockedReasonSamePartyFromCrossPartyContext
+	SetCookieBlockedReasonSamePartyConflictsWithOtherAttributes          = types.NetworkSetCookieBlockedReasonSamePartyConflictsWithOtherAttributes
+	SetCookieBlockedReasonNameValuePairExceedsMaxSize                    = types.NetworkSetCookieBlockedReasonNameValuePairExceedsMaxSize
+	SetCookieBlockedReasonDisallowedCharacter                            = types.NetworkSetCookieBlockedReasonDisallowedCharacter
+	SetCookieBlockedReasonNoCookieContent                                = types.NetworkSetCookieBlockedReasonNoCookieContent
+	CookieBlockedReasonSecureOnly                                        = types.NetworkCookieBlockedReasonSecureOnly
+	CookieBlockedReasonNotOnPath                                         = types.NetworkCookieBlockedReasonNotOnPath
+	CookieBlockedReasonDomainMismatch                                    = types.NetworkCookieBlockedReasonDomainMismatch
+	CookieBlockedReasonSameSiteStrict                                    = types.NetworkCookieBlockedReasonSameSiteStrict
+	CookieBlockedReasonSameSiteLax                                       = types.NetworkCookieBlockedReasonSameSiteLax
+	CookieBlockedReasonSameSiteUnspecifiedTreatedAsLax                   = types.NetworkCookieBlockedReasonSameSiteUnspecifiedTreatedAsLax
+	CookieBlockedReasonSameSiteNoneInsecure                              = types.NetworkCookieBlockedReasonSameSiteNoneInsecure
+	CookieBlockedReasonUserPreferences                                   = types.NetworkCookieBlockedReasonUserPreferences
+	CookieBlockedReasonThirdPartyPhaseout                                = types.NetworkCookieBlockedReasonThirdPartyPhaseout
+	CookieBlockedReasonThirdPartyBlockedInFirstPartySet                  = types.NetworkCookieBlockedReasonThirdPartyBlockedInFirstPartySet
+	CookieBlockedReasonUnknownError                                      = types.NetworkCookieBlockedReasonUnknownError
+	CookieBlockedReasonSchemefulSameSiteStrict                           = types.NetworkCookieBlockedReasonSchemefulSameSiteStrict
+	CookieBlockedReasonSchemefulSameSiteLax                              = types.NetworkCookieBlockedReasonSchemefulSameSiteLax
+	CookieBlockedReasonSchemefulSameSiteUnspecifiedTreatedAsLax          = types.NetworkCookieBlockedReasonSchemefulSameSiteUnspecifiedTreatedAsLax
+	CookieBlockedReasonSamePartyFromCrossPartyContext                    = types.NetworkCookieBlockedReasonSamePartyFromCrossPartyContext
+	CookieBlockedReasonNameValuePairExceedsMaxSize                       = types.NetworkCookieBlockedReasonNameValuePairExceedsMaxSize
+	CookieBlockedReasonPortMismatch                                      = types.NetworkCookieBlockedReasonPortMismatch
+	CookieBlockedReasonSchemeMismatch                                    = types.NetworkCookieBlockedReasonSchemeMismatch
+	CookieBlockedReasonAnonymousContext                                  = types.NetworkCookieBlockedReasonAnonymousContext
+	CookieExemptionReasonNone                                            = types.NetworkCookieExemptionReasonNone
+	CookieExemptionReasonUserSetting                                     = types.NetworkCookieExemptionReasonUserSetting
+	CookieExemptionReasonTPCDMetadata                                    = types.NetworkCookieExemptionReasonTPCDMetadata
+	CookieExemptionReasonTPCDDeprecationTrial                            = types.NetworkCookieExemptionReasonTPCDDeprecationTrial
+	CookieExemptionReasonTopLevelTPCDDeprecationTrial                    = types.NetworkCookieExemptionReasonTopLevelTPCDDeprecationTrial
+	CookieExemptionReasonTPCDHeuristics                                  = types.NetworkCookieExemptionReasonTPCDHeuristics
+	CookieExemptionReasonEnterprisePolicy                                = types.NetworkCookieExemptionReasonEnterprisePolicy
+	CookieExemptionReasonStorageAccess                                   = types.NetworkCookieExemptionReasonStorageAccess
+	CookieExemptionReasonTopLevelStorageAccess                           = types.NetworkCookieExemptionReasonTopLevelStorageAccess
+	CookieExemptionReasonScheme                                          = types.NetworkCookieExemptionReasonScheme
+	CookieExemptionReasonSameSiteNoneCookiesInSandbox                    = types.NetworkCookieExemptionReasonSameSiteNoneCookiesInSandbox
+	InterceptionStageRequest                                             = types.NetworkInterceptionStageRequest
+	InterceptionStageHeadersReceived                                     = types.NetworkInterceptionStageHeadersReceived
+	SignedExchangeErrorFieldSignatureSig                                 = types.NetworkSignedExchangeErrorFieldSignatureSig
+	SignedExchangeErrorFieldSignatureIntegrity                           = types.NetworkSignedExchangeErrorFieldSignatureIntegrity
+	SignedExchangeErrorFieldSignatureCertURL                             = types.NetworkSignedExchangeErrorFieldSignatureCertURL
+	SignedExchangeErrorFieldSignatureCertSha256                          = types.NetworkSignedExchangeErrorFieldSignatureCertSha256
+	SignedExchangeErrorFieldSignatureValidityURL                         = types.NetworkSignedExchangeErrorFieldSignatureValidityURL
+	SignedExchangeErrorFieldSignatureTimestamps                          = types.NetworkSignedExchangeErrorFieldSignatureTimestamps
+	ContentEncodingDeflate                                               = types.NetworkContentEncodingDeflate
+	ContentEncodingGzip                                                  = types.NetworkContentEncodingGzip
+	ContentEncodingBr                                                    = types.NetworkContentEncodingBr
+	ContentEncodingZstd                                                  = types.NetworkContentEncodingZstd
+	DirectSocketDnsQueryTypeIpv4                                         = types.NetworkDirectSocketDnsQueryTypeIpv4
+	DirectSocketDnsQueryTypeIpv6                                         = types.NetworkDirectSocketDnsQueryTypeIpv6
+	PrivateNetworkRequestPolicyAllow                                     = types.NetworkPrivateNetworkRequestPolicyAllow
+	PrivateNetworkRequestPolicyBlockFromInsecureToMorePrivate            = types.NetworkPrivateNetworkRequestPolicyBlockFromInsecureToMorePrivate
+	PrivateNetworkRequestPolicyWarnFromInsecureToMorePrivate             = types.NetworkPrivateNetworkRequestPolicyWarnFromInsecureToMorePrivate
+	PrivateNetworkRequestPolicyPreflightBlock                            = types.NetworkPrivateNetworkRequestPolicyPreflightBlock
+	PrivateNetworkRequestPolicyPreflightWarn                             = types.NetworkPrivateNetworkRequestPolicyPreflightWarn
+	PrivateNetworkRequestPolicyPermissionBlock                           = types.NetworkPrivateNetworkRequestPolicyPermissionBlock
+	PrivateNetworkRequestPolicyPermissionWarn                            = types.NetworkPrivateNetworkRequestPolicyPermissionWarn
+	IPAddressSpaceLoopback                                               = types.NetworkIPAddressSpaceLoopback
+	IPAddressSpaceLocal                                                  = types.NetworkIPAddressSpaceLocal
+	IPAddressSpacePublic                                                 = types.NetworkIPAddressSpacePublic
+	IPAddressSpaceUnknown                                                = types.NetworkIPAddressSpaceUnknown
+	CrossOriginOpenerPolicyValueSameOrigin                               = types.NetworkCrossOriginOpenerPolicyValueSameOrigin
+	CrossOriginOpenerPolicyValueSameOriginAllowPopups                    = types.NetworkCrossOriginOpenerPolicyValueSameOriginAllowPopups
+	CrossOriginOpenerPolicyValueRestrictProperties                       = types.NetworkCrossOriginOpenerPolicyValueRestrictProperties
+	CrossOriginOpenerPolicyValueUnsafeNone                               = types.NetworkCrossOriginOpenerPolicyValueUnsafeNone
+	CrossOriginOpenerPolicyValueSameOriginPlusCoep                       = types.NetworkCrossOriginOpenerPolicyValueSameOriginPlusCoep
+	CrossOriginOpenerPolicyValueRestrictPropertiesPlusCoep               = types.NetworkCrossOriginOpenerPolicyValueRestrictPropertiesPlusCoep
+	CrossOriginOpenerPolicyValueNoopenerAllowPopups                      = types.NetworkCrossOriginOpenerPolicyValueNoopenerAllowPopups
+	CrossOriginEmbedderPolicyValueNone                                   = types.NetworkCrossOriginEmbedderPolicyValueNone
+	CrossOriginEmbedderPolicyValueCredentialless                         = types.NetworkCrossOriginEmbedderPolicyValueCredentialless
+	CrossOriginEmbedderPolicyValueRequireCorp                            = types.NetworkCrossOriginEmbedderPolicyValueRequireCorp
+	ContentSecurityPolicySourceHTTP                                      = types.NetworkContentSecurityPolicySourceHTTP
+	ContentSecurityPolicySourceMeta                                      = types.NetworkContentSecurityPolicySourceMeta
+	ReportStatusQueued                                                   = types.NetworkReportStatusQueued
+	ReportStatusPending                                                  = types.NetworkReportStatusPending
+	ReportStatusMarkedForRemoval                                         = types.NetworkReportStatusMarkedForRemoval
+	ReportStatusSuccess                                                  = types.NetworkReportStatusSuccess
+)
+
+// Method names of the commands in the Network domain.
+const (
+	CommandSetAcceptedEncodings                    = "Network.setAcceptedEncodings"
+	CommandClearAcceptedEncodingsOverride          = "Network.clearAcceptedEncodingsOverride"
+	CommandCanClearBrowserCache                    = "Network.canClearBrowserCache"
+	CommandCanClearBrowserCookies                  = "Network.canClearBrowserCookies"
+	CommandCanEmulateNetworkConditions             = "Network.canEmulateNetworkConditions"
+	CommandClearBrowserCache                       = "Network.clearBrowserCache"
+	CommandClearBrowserCookies                     = "Network.clearBrowserCookies"
+	CommandContinueInterceptedRequest              = "Network.continueInterceptedRequest"
+	CommandDeleteCookies                           = "Network.deleteCookies"
+	CommandDisable                                 = "Network.disable"
+	CommandEmulateNetworkConditions                = "Network.emulateNetworkConditions"
+	CommandEnable                                  = "Network.enable"
+	CommandGetAllCookies                           = "Network.getAllCookies"
+	CommandGetCertificate                          = "Network.getCertificate"
+	CommandGetCookies                              = "Network.getCookies"
+	CommandGetResponseBody                         = "Network.getResponseBody"
+	CommandGetRequestPostData                      = "Network.getRequestPostData"
+	CommandGetResponseBodyForInterception          = "Network.getResponseBodyForInterception"
+	CommandTakeResponseBodyForInterceptionAsStream = "Network.takeResponseBodyForInterceptionAsStream"
+	CommandReplayXHR                               = "Network.replayXHR"
+	CommandSearchInResponseBody                    = "Network.searchInResponseBody"
+	CommandSetBlockedURLs                          = "Network.setBlockedURLs"
+	CommandSetBypassServiceWorker                  = "Network.setBypassServiceWorker"
+	CommandSetCacheDisabled                        = "Network.setCacheDisabled"
+	CommandSetCookie                               = "Network.setCookie"
+	CommandSetCookies                              = "Network.setCookies"
+	CommandSetExtraHTTPHeaders                     = "Network.setExtraHTTPHeaders"
+	CommandSetAttachDebugStack                     = "Network.setAttachDebugStack"
+	CommandSetRequestInterception                  = "Network.setRequestInterception"
+	CommandSetUserAgentOverride                    = "Network.setUserAgentOverride"
+	CommandStreamResourceContent                   = "Network.streamResourceContent"
+	CommandGetSecurityIsolationStatus              = "Network.getSecurityIsolationStatus"
+	CommandEnableReportingAPI                      = "Network.enableReportingApi"
+	CommandLoadNetworkResource                     = "Network.loadNetworkResource"
+	CommandSetCookieControls                       = "Network.setCookieControls"
+)
+
+// SetAcceptedEncodingsParams are the parameters of
+// Network.setAcceptedEncodings.
+//
+// Sets a list of content encodings that will be accepted. Empty list means no
+// encoding is accepted.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#method-setAcceptedEncodings
+//
+// This is an experimental part of the protocol.
+type SetAcceptedEncodingsParams struct {
+	// List of accepted content encodings.
+	Encodings []ContentEncoding `json:"encodings"`
+}
+
+// Command returns the Network.setAcceptedEncodings command for use with
+// AsyncDebugger.Send.
+func (p SetAcceptedEncodingsParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetAcceptedEncodings, p)
+}
+
+// Do sends Network.setAcceptedEncodings to d and waits for it to complete or for ctx to
+// be done.
+func (p SetAcceptedEncodingsParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetAcceptedEncodings, p, nil)
+}
+
+// ClearAcceptedEncodingsOverrideParams are the parameters of
+// Network.clearAcceptedEncodingsOverride.
+//
+// # Clears accepted encodings set by setAcceptedEncodings
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#method-clearAcceptedEncodingsOverride
+//
+// This is an experimental part of the protocol.
+type ClearAcceptedEncodingsOverrideParams struct {
+}
+
+// Command returns the Network.clearAcceptedEncodingsOverride command for use
+// with AsyncDebugger.Send.
+func (p ClearAcceptedEncodingsOverrideParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandClearAcceptedEncodingsOverride, p)
+}
+
+// Do sends Network.clearAcceptedEncodingsOverride to d and waits for it to complete or for ctx to
+// be done.
+func (p ClearAcceptedEncodingsOverrideParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandClearAcceptedEncodingsOverride, p, nil)
+}
+
+// CanClearBrowserCacheParams are the parameters of
+// Network.canClearBrowserCache.
+//
+// Tells whether clearing browser cache is supported.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#method-canClearBrowserCache
+//
+// Deprecated: this is deprecated in the protocol.
+type CanClearBrowserCacheParams struct {
+}
+
+// Command returns the Network.canClearBrowserCache command for use with
+// AsyncDebugger.Send.
+func (p CanClearBrowserCacheParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandCanClearBrowserCache, p)
+}
+
+// CanClearBrowserCacheReturns are the values returned by Network.canClearBrowserCache.
+type CanClearBrowserCacheReturns struct {
+	// True if browser cache can be cleared.
+	Result bool `json:"result"`
+}
+
+// Do sends Network.canClearBrowserCache to d and waits for its result or for ctx to be
+// done.
+func (p CanClearBrowserCacheParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*CanClearBrowserCacheReturns, error) {
+	ret := &CanClearBrowserCacheReturns{}
+	if err := protocol.Do(ctx, d, CommandCanClearBrowserCache, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// CanClearBrowserCookiesParams are the parameters of
+// Network.canClearBrowserCookies.
+//
+// Tells whether clearing browser cookies is supported.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#method-canClearBrowserCookies
+//
+// Deprecated: this is deprecated in the protocol.
+type CanClearBrowserCookiesParams struct {
+}
+
+// Command returns the Network.canClearBrowserCookies command for use with
+// AsyncDebugger.Send.
+func (p CanClearBrowserCookiesParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandCanClearBrowserCookies, p)
+}
+
+// CanClearBrowserCookiesReturns are the values returned by Network.canClearBrowserCookies.
+type CanClearBrowserCookiesReturns struct {
+	// True if browser cookies can be cleared.
+	Result bool `json:"result"`
+}
+
+// Do sends Network.canClearBrowserCookies to d and waits for its result or for ctx to be
+// done.
+func (p CanClearBrowserCookiesParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*CanClearBrowserCookiesReturns, error) {
+	ret := &CanClearBrowserCookiesReturns{}
+	if err := protocol.Do(ctx, d, CommandCanClearBrowserCookies, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// CanEmulateNetworkConditionsParams are the parameters of
+// Network.canEmulateNetworkConditions.
+//
+// Tells whether emulation of network conditions is supported.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#method-canEmulateNetworkConditions
+//
+// Deprecated: this is deprecated in the protocol.
+type CanEmulateNetworkConditionsParams struct {
+}
+
+// Command returns the Network.canEmulateNetworkConditions command for use with
+// AsyncDebugger.Send.
+func (p CanEmulateNetworkConditionsParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandCanEmulateNetworkConditions, p)
+}
+
+// CanEmulateNetworkConditionsReturns are the values returned by Network.canEmulateNetworkConditions.
+type CanEmulateNetworkConditionsReturns struct {
+	// True if emulation of network conditions is supported.
+	Result bool `json:"result"`
+}
+
+// Do sends Network.canEmulateNetworkConditions to d and waits for its result or for ctx to be
+// done.
+func (p CanEmulateNetworkConditionsParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*CanEmulateNetworkConditionsReturns, error) {
+	ret := &CanEmulateNetworkConditionsReturns{}
+	if err := protocol.Do(ctx, d, CommandCanEmulateNetworkConditions, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// ClearBrowserCacheParams are the parameters of Network.clearBrowserCache.
+//
+// Clears browser cache.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#method-clearBrowserCache
+type ClearBrowserCacheParams struct {
+}
+
+// Command returns the Network.clearBrowserCache command for use with
+// AsyncDebugger.Send.
+func (p ClearBrowserCacheParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandClearBrowserCache, p)
+}
+
+// Do sends Network.clearBrowserCache to d and waits for it to complete or for ctx to
+// be done.
+func (p ClearBrowserCacheParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandClearBrowserCache, p, nil)
+}
+
+// ClearBrowserCookiesParams are the parameters of Network.clearBrowserCookies.
+//
+// Clears browser cookies.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#method-clearBrowserCookies
+type ClearBrowserCookiesParams struct {
+}
+
+// Command returns the Network.clearBrowserCookies command for use with
+// AsyncDebugger.Send.
+func (p ClearBrowserCookiesParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandClearBrowserCookies, p)
+}
+
+// Do sends Network.clearBrowserCookies to d and waits for it to complete or for ctx to
+// be done.
+func (p ClearBrowserCookiesParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandClearBrowserCookies, p, nil)
+}
+
+// ContinueInterceptedRequestParams are the parameters of
+// Network.continueInterceptedRequest.
+//
+// Response to Network.requestIntercepted which either modifies the request to
+// continue with any modifications, or blocks it, or completes it with the
+// provided response bytes. If a network fetch occurs as a result which
+// encounters a redirect an additional Network.requestIntercepted event will be
+// sent with the same InterceptionId. Deprecated, use Fetch.continueRequest,
+// Fetch.fulfillRequest and Fetch.failRequest instead.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#method-continueInterceptedRequest
+//
+// This is an experimental part of the protocol.
+//
+// Deprecated: this is deprecated in the protocol.
+type ContinueInterceptedRequestParams struct {
+	InterceptionID InterceptionID `json:"interceptionId"`
+	// If set this causes the request to fail with the given reason. Passing
+	// `Aborted` for requests marked with `isNavigationRequest` also cancels the
+	// navigation. Must not be set in response to an authChallenge.
+	ErrorReason ErrorReason `json:"errorReason,omitempty"`
+	// If set the requests completes using with the provided base64 encoded raw
+	// response, including HTTP status line and headers etc... Must not be set in
+	// response to an authChallenge. (Encoded as a base64 string when passed over
+	// JSON)
+	RawResponse string `json:"rawResponse,omitempty"`
+	// If set the request url will be modified in a way that's not observable by
+	// page. Must not be set in response to an authChallenge.
+	URL string `json:"url,omitempty"`
+	// If set this allows the request method to be overridden. Must not be set in
+	// response to an authChallenge.
+	Method string `json:"method,omitempty"`
+	// If set this allows postData to be set. Must not be set in response to an
+	// authChallenge.
+	PostData string `json:"postData,omitempty"`
+	// If set this allows the request headers to be changed. Must not be set in
+	// response to an authChallenge.
+	Headers Headers `json:"headers,omitempty"`
+	// Response to a requestIntercepted with an authChallenge. Must not be set
+	// otherwise.
+	AuthChallengeResponse *AuthChallengeResponse `json:"authChallengeResponse,omitempty"`
+}
+
+// Command returns the Network.continueInterceptedRequest command for use with
+// AsyncDebugger.Send.
+func (p ContinueInterceptedRequestParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandContinueInterceptedRequest, p)
+}
+
+// Do sends Network.continueInterceptedRequest to d and waits for it to complete or for ctx to
+// be done.
+func (p ContinueInterceptedRequestParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandContinueInterceptedRequest, p, nil)
+}
+
+// DeleteCookiesParams are the parameters of Network.deleteCookies.
+//
+// Deletes browser cookies with matching name and url or
+// domain/path/partitionKey pair.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#method-deleteCookies
+type DeleteCookiesParams struct {
+	// Name of the cookies to remove.
+	Name string `json:"name"`
+	// If specified, deletes all the cookies with the given name where domain and
+	// path match provided URL.
+	URL string `json:"url,omitempty"`
+	// If specified, deletes only cookies with the exact domain.
+	Domain string `json:"domain,omitempty"`
+	// If specified, deletes only cookies with the exact path.
+	Path string `json:"path,omitempty"`
+	// If specified, deletes only cookies with the the given name and partitionKey
+	// where all partition key attributes match the cookie partition key attribute.
+	//
+	// This is an experimental part of the protocol.
+	PartitionKey *CookiePartitionKey `json:"partitionKey,omitempty"`
+}
+
+// Command returns the Network.deleteCookies command for use with
+// AsyncDebugger.Send.
+func (p DeleteCookiesParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandDeleteCookies, p)
+}
+
+// Do sends Network.deleteCookies to d and waits for it to complete or for ctx to
+// be done.
+func (p DeleteCookiesParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandDeleteCookies, p, nil)
+}
+
+// DisableParams are the parameters of Network.disable.
+//
+// Disables network tracking, prevents network events from being sent to the
+// client.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#method-disable
+type DisableParams struct {
+}
+
+// Command returns the Network.disable command for use with AsyncDebugger.Send.
+func (p DisableParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandDisable, p)
+}
+
+// Do sends Network.disable to d and waits for it to complete or for ctx to
+// be done.
+func (p DisableParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandDisable, p, nil)
+}
+
+// EmulateNetworkConditionsParams are the parameters of
+// Network.emulateNetworkConditions.
+//
+// Activates emulation of network conditions.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#method-emulateNetworkConditions
+type EmulateNetworkConditionsParams struct {
+	// True to emulate internet disconnection.
+	Offline bool `json:"offline"`
+	// Minimum latency from request sent to response headers received (ms).
+	Latency float64 `json:"latency"`
+	// Maximal aggregated download throughput (bytes/sec). -1 disables download
+	// throttling.
+	DownloadThroughput float64 `json:"downloadThroughput"`
+	// Maximal aggregated upload throughput (bytes/sec). -1 disables upload
+	// throttling.
+	UploadThroughput float64 `json:"uploadThroughput"`
+	// Connection type if known.
+	ConnectionType ConnectionType `json:"connectionType,omitempty"`
+	// WebRTC packet loss (percent, 0-100). 0 disables packet loss emulation, 100
+	// drops all the packets.
+	//
+	// This is an experimental part of the protocol.
+	PacketLoss float64 `json:"packetLoss,omitempty"`
+	// WebRTC packet queue length (packet). 0 removes any queue length limitations.
+	//
+	// This is an experimental part of the protocol.
+	PacketQueueLength int64 `json:"packetQueueLength,omitempty"`
+	// WebRTC packetReordering feature.
+	//
+	// This is an experimental part of the protocol.
+	PacketReordering bool `json:"packetReordering,omitempty"`
+}
+
+// Command returns the Network.emulateNetworkConditions command for use with
+// AsyncDebugger.Send.
+func (p EmulateNetworkConditionsParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandEmulateNetworkConditions, p)
+}
+
+// Do sends Network.emulateNetworkConditions to d and waits for it to complete or for ctx to
+// be done.
+func (p EmulateNetworkConditionsParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandEmulateNetworkConditions, p, nil)
+}
+
+// EnableParams are the parameters of Network.enable.
+//
+// Enables network tracking, network events will now be delivered to the client.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#method-enable
+type EnableParams struct {
+	// Buffer size in bytes to use when preserving network payloads (XHRs, etc).
+	//
+	// This is an experimental part of the protocol.
+	MaxTotalBufferSize int64 `json:"maxTotalBufferSize,omitempty"`
+	// Per-resource buffer size in bytes to use when preserving network payloads
+	// (XHRs, etc).
+	//
+	// This is an experimental part of the protocol.
+	MaxResourceBufferSize int64 `json:"maxResourceBufferSize,omitempty"`
+	// Longest post body size (in bytes) that would be included in
+	// requestWillBeSent notification
+	MaxPostDataSize int64 `json:"maxPostDataSize,omitempty"`
+	// Whether DirectSocket chunk send/receive events should be reported.
+	//
+	// This is an experimental part of the protocol.
+	ReportDirectSocketTraffic bool `json:"reportDirectSocketTraffic,omitempty"`
+}
+
+// Command returns the Network.enable command for use with AsyncDebugger.Send.
+func (p EnableParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandEnable, p)
+}
+
+// Do sends Network.enable to d and waits for it to complete or for ctx to
+// be done.
+func (p EnableParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandEnable, p, nil)
+}
+
+// GetAllCookiesParams are the parameters of Network.getAllCookies.
+//
+// Returns all browser cookies. Depending on the backend support, will return
+// detailed cookie information in the `cookies` field. Deprecated. Use
+// Storage.getCookies instead.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#method-getAllCookies
+//
+// Deprecated: this is deprecated in the protocol.
+type GetAllCookiesParams struct {
+}
+
+// Command returns the Network.getAllCookies command for use with
+// AsyncDebugger.Send.
+func (p GetAllCookiesParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandGetAllCookies, p)
+}
+
+// GetAllCookiesReturns are the values returned by Network.getAllCookies.
+type GetAllCookiesReturns struct {
+	// Array of cookie objects.
+	Cookies []Cookie `json:"cookies"`
+}
+
+// Do sends Network.getAllCookies to d and waits for its result or for ctx to be
+// done.
+func (p GetAllCookiesParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*GetAllCookiesReturns, error) {
+	ret := &GetAllCookiesReturns{}
+	if err := protocol.Do(ctx, d, CommandGetAllCookies, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// GetCertificateParams are the parameters of Network.getCertificate.
+//
+// Returns the DER-encoded certificate.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#method-getCertificate
+//
+// This is an experimental part of the protocol.
+type GetCertificateParams struct {
+	// Origin to get certificate for.
+	Origin string `json:"origin"`
+}
+
+// Command returns the Network.getCertificate command for use with
+// AsyncDebugger.Send.
+func (p GetCertificateParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandGetCertificate, p)
+}
+
+// GetCertificateReturns are the values returned by Network.getCertificate.
+type GetCertificateReturns struct {
+	TableNames []string `json:"tableNames"`
+}
+
+// Do sends Network.getCertificate to d and waits for its result or for ctx to be
+// done.
+func (p GetCertificateParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*GetCertificateReturns, error) {
+	ret := &GetCertificateReturns{}
+	if err := protocol.Do(ctx, d, CommandGetCertificate, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// GetCookiesParams are the parameters of Network.getCookies.
+//
+// Returns all browser cookies for the current URL. Depending on the backend
+// support, will return detailed cookie information in the `cookies` field.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#method-getCookies
+type GetCookiesParams struct {
+	// The list of URLs for which applicable cookies will be fetched. If not
+	// specified, it's assumed to be set to the list containing the URLs of the
+	// page and all of its subframes.
+	Urls []string `json:"urls,omitempty"`
+}
+
+// Command returns the Network.getCookies command for use with
+// AsyncDebugger.Send.
+func (p GetCookiesParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandGetCookies, p)
+}
+
+// GetCookiesReturns are the values returned by Network.getCookies.
+type GetCookiesReturns struct {
+	// Array of cookie objects.
+	Cookies []Cookie `json:"cookies"`
+}
+
+// Do sends Network.getCookies to d and waits for its result or for ctx to be
+// done.
+func (p GetCookiesParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*GetCookiesReturns, error) {
+	ret := &GetCookiesReturns{}
+	if err := protocol.Do(ctx, d, CommandGetCookies, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// GetResponseBodyParams are the parameters of Network.getResponseBody.
+//
+// Returns content served for the given request.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#method-getResponseBody
+type GetResponseBodyParams struct {
+	// Identifier of the network request to get content for.
+	RequestID RequestID `json:"requestId"`
+}
+
+// Command returns the Network.getResponseBody command for use with
+// AsyncDebugger.Send.
+func (p GetResponseBodyParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandGetResponseBody, p)
+}
+
+// GetResponseBodyReturns are the values returned by Network.getResponseBody.
+type GetResponseBodyReturns struct {
+	// Response body.
+	Body string `json:"body"`
+	// True, if content was sent as base64.
+	Base64Encoded bool `json:"base64Encoded"`
+}
+
+// Do sends Network.getResponseBody to d and waits for its result or for ctx to be
+// done.
+func (p GetResponseBodyParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*GetResponseBodyReturns, error) {
+	ret := &GetResponseBodyReturns{}
+	if err := protocol.Do(ctx, d, CommandGetResponseBody, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// GetRequestPostDataParams are the parameters of Network.getRequestPostData.
+//
+// Returns post data sent with the request. Returns an error when no data was
+// sent with the request.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#method-getRequestPostData
+type GetRequestPostDataParams struct {
+	// Identifier of the network request to get content for.
+	RequestID RequestID `json:"requestId"`
+}
+
+// Command returns the Network.getRequestPostData command for use with
+// AsyncDebugger.Send.
+func (p GetRequestPostDataParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandGetRequestPostData, p)
+}
+
+// GetRequestPostDataReturns are the values returned by Network.getRequestPostData.
+type GetRequestPostDataReturns struct {
+	// Request body string, omitting files from multipart requests
+	PostData string `json:"postData"`
+}
+
+// Do sends Network.getRequestPostData to d and waits for its result or for ctx to be
+// done.
+func (p GetRequestPostDataParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*GetRequestPostDataReturns, error) {
+	ret := &GetRequestPostDataReturns{}
+	if err := protocol.Do(ctx, d, CommandGetRequestPostData, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// GetResponseBodyForInterceptionParams are the parameters of
+// Network.getResponseBodyForInterception.
+//
+// Returns content served for the given currently intercepted request.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#method-getResponseBodyForInterception
+//
+// This is an experimental part of the protocol.
+type GetResponseBodyForInterceptionParams struct {
+	// Identifier for the intercepted request to get body for.
+	InterceptionID InterceptionID `json:"interceptionId"`
+}
+
+// Command returns the Network.getResponseBodyForInterception command for use
+// with AsyncDebugger.Send.
+func (p GetResponseBodyForInterceptionParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandGetResponseBodyForInterception, p)
+}
+
+// GetResponseBodyForInterceptionReturns are the values returned by Network.getResponseBodyForInterception.
+type GetResponseBodyForInterceptionReturns struct {
+	// Response body.
+	Body string `json:"body"`
+	// True, if content was sent as base64.
+	Base64Encoded bool `json:"base64Encoded"`
+}
+
+// Do sends Network.getResponseBodyForInterception to d and waits for its result or for ctx to be
+// done.
+func (p GetResponseBodyForInterceptionParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*GetResponseBodyForInterceptionReturns, error) {
+	ret := &GetResponseBodyForInterceptionReturns{}
+	if err := protocol.Do(ctx, d, CommandGetResponseBodyForInterception, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// TakeResponseBodyForInterceptionAsStreamParams are the parameters of
+// Network.takeResponseBodyForInterceptionAsStream.
+//
+// Returns a handle to the stream representing the response body. Note that
+// after this command, the intercepted request can't be continued as is -- you
+// either need to cancel it or to provide the response body. The stream only
+// supports sequential read, IO.read will fail if the position is specified.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#method-takeResponseBodyForInterceptionAsStream
+//
+// This is an experimental part of the protocol.
+type TakeResponseBodyForInterceptionAsStreamParams struct {
+	InterceptionID InterceptionID `json:"interceptionId"`
+}
+
+// Command returns the Network.takeResponseBodyForInterceptionAsStream command
+// for use with AsyncDebugger.Send.
+func (p TakeResponseBodyForInterceptionAsStreamParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandTakeResponseBodyForInterceptionAsStream, p)
+}
+
+// TakeResponseBodyForInterceptionAsStreamReturns are the values returned by Network.takeResponseBodyForInterceptionAsStream.
+type TakeResponseBodyForInterceptionAsStreamReturns struct {
+	Stream types.IOStreamHandle `json:"stream"`
+}
+
+// Do sends Network.takeResponseBodyForInterceptionAsStream to d and waits for its result or for ctx to be
+// done.
+func (p TakeResponseBodyForInterceptionAsStreamParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*TakeResponseBodyForInterceptionAsStreamReturns, error) {
+	ret := &TakeResponseBodyForInterceptionAsStreamReturns{}
+	if err := protocol.Do(ctx, d, CommandTakeResponseBodyForInterceptionAsStream, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// ReplayXHRParams are the parameters of Network.replayXHR.
+//
+// This method sends a new XMLHttpRequest which is identical to the original
+// one. The following parameters should be identical: method, url, async,
+// request body, extra headers, withCredentials attribute, user, password.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#method-replayXHR
+//
+// This is an experimental part of the protocol.
+type ReplayXHRParams struct {
+	// Identifier of XHR to replay.
+	RequestID RequestID `json:"requestId"`
+}
+
+// Command returns the Network.replayXHR command for use with
+// AsyncDebugger.Send.
+func (p ReplayXHRParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandReplayXHR, p)
+}
+
+// Do sends Network.replayXHR to d and waits for it to complete or for ctx to
+// be done.
+func (p ReplayXHRParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandReplayXHR, p, nil)
+}
+
+// SearchInResponseBodyParams are the parameters of
+// Network.searchInResponseBody.
+//
+// Searches for given string in response content.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#method-searchInResponseBody
+//
+// This is an experimental part of the protocol.
+type SearchInResponseBodyParams struct {
+	// Identifier of the network response to search.
+	RequestID RequestID `json:"requestId"`
+	// String to search for.
+	Query string `json:"query"`
+	// If true, search is case sensitive.
+	CaseSensitive bool `json:"caseSensitive,omitempty"`
+	// If true, treats string parameter as regex.
+	IsRegex bool `json:"isRegex,omitempty"`
+}
+
+// Command returns the Network.searchInResponseBody command for use with
+// AsyncDebugger.Send.
+func (p SearchInResponseBodyParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSearchInResponseBody, p)
+}
+
+// SearchInResponseBodyReturns are the values returned by Network.searchInResponseBody.
+type SearchInResponseBodyReturns struct {
+	// List of search matches.
+	Result []types.DebuggerSearchMatch `json:"result"`
+}
+
+// Do sends Network.searchInResponseBody to d and waits for its result or for ctx to be
+// done.
+func (p SearchInResponseBodyParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*SearchInResponseBodyReturns, error) {
+	ret := &SearchInResponseBodyReturns{}
+	if err := protocol.Do(ctx, d, CommandSearchInResponseBody, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// SetBlockedURLsParams are the parameters of Network.setBlockedURLs.
+//
+// Blocks URLs from loading.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#method-setBlockedURLs
+//
+// This is an experimental part of the protocol.
+type SetBlockedURLsParams struct {
+	// URL patterns to block. Wildcards ('*') are allowed.
+	Urls []string `json:"urls"`
+}
+
+// Command returns the Network.setBlockedURLs command for use with
+// AsyncDebugger.Send.
+func (p SetBlockedURLsParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetBlockedURLs, p)
+}
+
+// Do sends Network.setBlockedURLs to d and waits for it to complete or for ctx to
+// be done.
+func (p SetBlockedURLsParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetBlockedURLs, p, nil)
+}
+
+// SetBypassServiceWorkerParams are the parameters of
+// Network.setBypassServiceWorker.
+//
+// Toggles ignoring of service worker for each request.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#method-setBypassServiceWorker
+type SetBypassServiceWorkerParams struct {
+	// Bypass service worker and load from network.
+	Bypass bool `json:"bypass"`
+}
+
+// Command returns the Network.setBypassServiceWorker command for use with
+// AsyncDebugger.Send.
+func (p SetBypassServiceWorkerParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetBypassServiceWorker, p)
+}
+
+// Do sends Network.setBypassServiceWorker to d and waits for it to complete or for ctx to
+// be done.
+func (p SetBypassServiceWorkerParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetBypassServiceWorker, p, nil)
+}
+
+// SetCacheDisabledParams are the parameters of Network.setCacheDisabled.
+//
+// Toggles ignoring cache for each request. If `true`, cache will not be used.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#method-setCacheDisabled
+type SetCacheDisabledParams struct {
+	// Cache disabled state.
+	CacheDisabled bool `json:"cacheDisabled"`
+}
+
+// Command returns the Network.setCacheDisabled command for use with
+// AsyncDebugger.Send.
+func (p SetCacheDisabledParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetCacheDisabled, p)
+}
+
+// Do sends Network.setCacheDisabled to d and waits for it to complete or for ctx to
+// be done.
+func (p SetCacheDisabledParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetCacheDisabled, p, nil)
+}
+
+// SetCookieParams are the parameters of Network.setCookie.
+//
+// Sets a cookie with the given cookie data; may overwrite equivalent cookies if
+// they exist.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#method-setCookie
+type SetCookieParams struct {
+	// Cookie name.
+	Name string `json:"name"`
+	// Cookie value.
+	Value string `json:"value"`
+	// The request-URI to associate with the setting of the cookie. This value can
+	// affect the default domain, path, source port, and source scheme values of
+	// the created cookie.
+	URL string `json:"url,omitempty"`
+	// Cookie domain.
+	Domain string `json:"domain,omitempty"`
+	// Cookie path.
+	Path string `json:"path,omitempty"`
+	// True if cookie is secure.
+	Secure bool `json:"secure,omitempty"`
+	// True if cookie is http-only.
+	HTTPOnly bool `json:"httpOnly,omitempty"`
+	// Cookie SameSite type.
+	SameSite CookieSameSite `json:"sameSite,omitempty"`
+	// Cookie expiration date, session cookie if not set
+	Expires TimeSinceEpoch `json:"expires,omitempty"`
+	// Cookie Priority type.
+	//
+	// This is an experimental part of the protocol.
+	Priority CookiePriority `json:"priority,omitempty"`
+	// True if cookie is SameParty.
+	//
+	// This is an experimental part of the protocol.
+	SameParty bool `json:"sameParty,omitempty"`
+	// Cookie source scheme type.
+	//
+	// This is an experimental part of the protocol.
+	SourceScheme CookieSourceScheme `json:"sourceScheme,omitempty"`
+	// Cookie source port. Valid values are {-1, [1, 65535]}, -1 indicates an
+	// unspecified port. An unspecified port value allows protocol clients to
+	// emulate legacy cookie scope for the port. This is a temporary ability and it
+	// will be removed in the future.
+	//
+	// This is an experimental part of the protocol.
+	SourcePort int64 `json:"sourcePort,omitempty"`
+	// Cookie partition key. If not set, the cookie will be set as not partitioned.
+	//
+	// This is an experimental part of the protocol.
+	PartitionKey *CookiePartitionKey `json:"partitionKey,omitempty"`
+}
+
+// Command returns the Network.setCookie command for use with
+// AsyncDebugger.Send.
+func (p SetCookieParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetCookie, p)
+}
+
+// SetCookieReturns are the values returned by Network.setCookie.
+type SetCookieReturns struct {
+	// Always set to true. If an error occurs, the response indicates protocol
+	// error.
+	//
+	// Deprecated: this is deprecated in the protocol.
+	Success bool `json:"success"`
+}
+
+// Do sends Network.setCookie to d and waits for its result or for ctx to be
+// done.
+func (p SetCookieParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*SetCookieReturns, error) {
+	ret := &SetCookieReturns{}
+	if err := protocol.Do(ctx, d, CommandSetCookie, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// SetCookiesParams are the parameters of Network.setCookies.
+//
+// Sets given cookies.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#method-setCookies
+type SetCookiesParams struct {
+	// Cookies to be set.
+	Cookies []CookieParam `json:"cookies"`
+}
+
+// Command returns the Network.setCookies command for use with
+// AsyncDebugger.Send.
+func (p SetCookiesParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetCookies, p)
+}
+
+// Do sends Network.setCookies to d and waits for it to complete or for ctx to
+// be done.
+func (p SetCookiesParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetCookies, p, nil)
+}
+
+// SetExtraHTTPHeadersParams are the parameters of Network.setExtraHTTPHeaders.
+//
+// Specifies whether to always send extra HTTP headers with the requests from
+// this page.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#method-setExtraHTTPHeaders
+type SetExtraHTTPHeadersParams struct {
+	// Map with extra HTTP headers.
+	Headers Headers `json:"headers"`
+}
+
+// Command returns the Network.setExtraHTTPHeaders command for use with
+// AsyncDebugger.Send.
+func (p SetExtraHTTPHeadersParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetExtraHTTPHeaders, p)
+}
+
+// Do sends Network.setExtraHTTPHeaders to d and waits for it to complete or for ctx to
+// be done.
+func (p SetExtraHTTPHeadersParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetExtraHTTPHeaders, p, nil)
+}
+
+// SetAttachDebugStackParams are the parameters of Network.setAttachDebugStack.
+//
+// # Specifies whether to attach a page script stack id in requests
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#method-setAttachDebugStack
+//
+// This is an experimental part of the protocol.
+type SetAttachDebugStackParams struct {
+	// Whether to attach a page script stack for debugging purpose.
+	Enabled bool `json:"enabled"`
+}
+
+// Command returns the Network.setAttachDebugStack command for use with
+// AsyncDebugger.Send.
+func (p SetAttachDebugStackParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetAttachDebugStack, p)
+}
+
+// Do sends Network.setAttachDebugStack to d and waits for it to complete or for ctx to
+// be done.
+func (p SetAttachDebugStackParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetAttachDebugStack, p, nil)
+}
+
+// SetRequestInterceptionParams are the parameters of
+// Network.setRequestInterception.
+//
+// Sets the requests to intercept that match the provided patterns and
+// optionally resource types. Deprecated, please use Fetch.enable instead.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#method-setRequestInterception
+//
+// This is an experimental part of the protocol.
+//
+// Deprecated: this is deprecated in the protocol.
+type SetRequestInterceptionParams struct {
+	// Requests matching any of these patterns will be forwarded and wait for the
+	// corresponding continueInterceptedRequest call.
+	Patterns []RequestPattern `json:"patterns"`
+}
+
+// Command returns the Network.setRequestInterception command for use with
+// AsyncDebugger.Send.
+func (p SetRequestInterceptionParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetRequestInterception, p)
+}
+
+// Do sends Network.setRequestInterception to d and waits for it to complete or for ctx to
+// be done.
+func (p SetRequestInterceptionParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetRequestInterception, p, nil)
+}
+
+// SetUserAgentOverrideParams are the parameters of
+// Network.setUserAgentOverride.
+//
+// Allows overriding user agent with the given string.
+//
+// Redirects to the Emulation domain.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#method-setUserAgentOverride
+type SetUserAgentOverrideParams struct {
+	// User agent to use.
+	UserAgent string `json:"userAgent"`
+	// Browser language to emulate.
+	AcceptLanguage string `json:"acceptLanguage,omitempty"`
+	// The platform navigator.platform should return.
+	Platform string `json:"platform,omitempty"`
+	// To be sent in Sec-CH-UA-* headers and returned in navigator.userAgentData
+	//
+	// This is an experimental part of the protocol.
+	UserAgentMetadata *types.EmulationUserAgentMetadata `json:"userAgentMetadata,omitempty"`
+}
+
+// Command returns the Network.setUserAgentOverride command for use with
+// AsyncDebugger.Send.
+func (p SetUserAgentOverrideParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetUserAgentOverride, p)
+}
+
+// Do sends Network.setUserAgentOverride to d and waits for it to complete or for ctx to
+// be done.
+func (p SetUserAgentOverrideParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetUserAgentOverride, p, nil)
+}
+
+// StreamResourceContentParams are the parameters of
+// Network.streamResourceContent.
+//
+// Enables streaming of the response for the given requestId. If enabled, the
+// dataReceived event contains the data that was received during streaming.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#method-streamResourceContent
+//
+// This is an experimental part of the protocol.
+type StreamResourceContentParams struct {
+	// Identifier of the request to stream.
+	RequestID RequestID `json:"requestId"`
+}
+
+// Command returns the Network.streamResourceContent command for use with
+// AsyncDebugger.Send.
+func (p StreamResourceContentParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandStreamResourceContent, p)
+}
+
+// StreamResourceContentReturns are the values returned by Network.streamResourceContent.
+type StreamResourceContentReturns struct {
+	// Data that has been buffered until streaming is enabled. (Encoded as a base64
+	// string when passed over JSON)
+	BufferedData string `json:"bufferedData"`
+}
+
+// Do sends Network.streamResourceContent to d and waits for its result or for ctx to be
+// done.
+func (p StreamResourceContentParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*StreamResourceContentReturns, error) {
+	ret := &StreamResourceContentReturns{}
+	if err := protocol.Do(ctx, d, CommandStreamResourceContent, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// GetSecurityIsolationStatusParams are the parameters of
+// Network.getSecurityIsolationStatus.
+//
+// Returns information about the COEP/COOP isolation status.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#method-getSecurityIsolationStatus
+//
+// This is an experimental part of the protocol.
+type GetSecurityIsolationStatusParams struct {
+	// If no frameId is provided, the status of the target is provided.
+	FrameID types.PageFrameID `json:"frameId,omitempty"`
+}
+
+// Command returns the Network.getSecurityIsolationStatus command for use with
+// AsyncDebugger.Send.
+func (p GetSecurityIsolationStatusParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandGetSecurityIsolationStatus, p)
+}
+
+// GetSecurityIsolationStatusReturns are the values returned by Network.getSecurityIsolationStatus.
+type GetSecurityIsolationStatusReturns struct {
+	Status SecurityIsolationStatus `json:"status"`
+}
+
+// Do sends Network.getSecurityIsolationStatus to d and waits for its result or for ctx to be
+// done.
+func (p GetSecurityIsolationStatusParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*GetSecurityIsolationStatusReturns, error) {
+	ret := &GetSecurityIsolationStatusReturns{}
+	if err := protocol.Do(ctx, d, CommandGetSecurityIsolationStatus, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// EnableReportingAPIParams are the parameters of Network.enableReportingApi.
+//
+// Enables tracking for the Reporting API, events generated by the Reporting API
+// will now be delivered to the client. Enabling triggers
+// 'reportingApiReportAdded' for all existing reports.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#method-enableReportingApi
+//
+// This is an experimental part of the protocol.
+type EnableReportingAPIParams struct {
+	// Whether to enable or disable events for the Reporting API
+	Enable bool `json:"enable"`
+}
+
+// Command returns the Network.enableReportingApi command for use with
+// AsyncDebugger.Send.
+func (p EnableReportingAPIParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandEnableReportingAPI, p)
+}
+
+// Do sends Network.enableReportingApi to d and waits for it to complete or for ctx to
+// be done.
+func (p EnableReportingAPIParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandEnableReportingAPI, p, nil)
+}
+
+// LoadNetworkResourceParams are the parameters of Network.loadNetworkResource.
+//
+// Fetches the resource and returns the content.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#method-loadNetworkResource
+//
+// This is an experimental part of the protocol.
+type LoadNetworkResourceParams struct {
+	// Frame id to get the resource for. Mandatory for frame targets, and should be
+	// omitted for worker targets.
+	FrameID types.PageFrameID `json:"frameId,omitempty"`
+	// URL of the resource to get content for.
+	URL string `json:"url"`
+	// Options for the request.
+	Options LoadNetworkResourceOptions `json:"options"`
+}
+
+// Command returns the Network.loadNetworkResource command for use with
+// AsyncDebugger.Send.
+func (p LoadNetworkResourceParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandLoadNetworkResource, p)
+}
+
+// LoadNetworkResourceReturns are the values returned by Network.loadNetworkResource.
+type LoadNetworkResourceReturns struct {
+	Resource LoadNetworkResourcePageResult `json:"resource"`
+}
+
+// Do sends Network.loadNetworkResource to d and waits for its result or for ctx to be
+// done.
+func (p LoadNetworkResourceParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*LoadNetworkResourceReturns, error) {
+	ret := &LoadNetworkResourceReturns{}
+	if err := protocol.Do(ctx, d, CommandLoadNetworkResource, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// SetCookieControlsParams are the parameters of Network.setCookieControls.
+//
+// Sets Controls for third-party cookie access Page reload is required before
+// the new cookie behavior will be observed
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#method-setCookieControls
+//
+// This is an experimental part of the protocol.
+type SetCookieControlsParams struct {
+	// Whether 3pc restriction is enabled.
+	EnableThirdPartyCookieRestriction bool `json:"enableThirdPartyCookieRestriction"`
+	// Whether 3pc grace period exception should be enabled; false by default.
+	DisableThirdPartyCookieMetadata bool `json:"disableThirdPartyCookieMetadata"`
+	// Whether 3pc heuristics exceptions should be enabled; false by default.
+	DisableThirdPartyCookieHeuristics bool `json:"disableThirdPartyCookieHeuristics"`
+}
+
+// Command returns the Network.setCookieControls command for use with
+// AsyncDebugger.Send.
+func (p SetCookieControlsParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetCookieControls, p)
+}
+
+// Do sends Network.setCookieControls to d and waits for it to complete or for ctx to
+// be done.
+func (p SetCookieControlsParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetCookieControls, p, nil)
+}
+
+// Method names of the events in the Network domain.
+const (
+	EventDataReceived                            = "Network.dataReceived"
+	EventEventSourceMessageReceived              = "Network.eventSourceMessageReceived"
+	EventLoadingFailed                           = "Network.loadingFailed"
+	EventLoadingFinished                         = "Network.loadingFinished"
+	EventRequestIntercepted                      = "Network.requestIntercepted"
+	EventRequestServedFromCache                  = "Network.requestServedFromCache"
+	EventRequestWillBeSent                       = "Network.requestWillBeSent"
+	EventResourceChangedPriority                 = "Network.resourceChangedPriority"
+	EventSignedExchangeReceived                  = "Network.signedExchangeReceived"
+	EventResponseReceived                        = "Network.responseReceived"
+	EventWebSocketClosed                         = "Network.webSocketClosed"
+	EventWebSocketCreated                        = "Network.webSocketCreated"
+	EventWebSocketFrameError                     = "Network.webSocketFrameError"
+	EventWebSocketFrameReceived                  = "Network.webSocketFrameReceived"
+	EventWebSocketFrameSent                      = "Network.webSocketFrameSent"
+	EventWebSocketHandshakeResponseReceived      = "Network.webSocketHandshakeResponseReceived"
+	EventWebSocketWillSendHandshakeRequest       = "Network.webSocketWillSendHandshakeRequest"
+	EventWebTransportCreated                     = "Network.webTransportCreated"
+	EventWebTransportConnectionEstablished       = "Network.webTransportConnectionEstablished"
+	EventWebTransportClosed                      = "Network.webTransportClosed"
+	EventDirectTCPSocketCreated                  = "Network.directTCPSocketCreated"
+	EventDirectTCPSocketOpened                   = "Network.directTCPSocketOpened"
+	EventDirectTCPSocketAborted                  = "Network.directTCPSocketAborted"
+	EventDirectTCPSocketClosed                   = "Network.directTCPSocketClosed"
+	EventDirectTCPSocketChunkSent                = "Network.directTCPSocketChunkSent"
+	EventDirectTCPSocketChunkReceived            = "Network.directTCPSocketChunkReceived"
+	EventDirectUDPSocketCreated                  = "Network.directUDPSocketCreated"
+	EventDirectUDPSocketOpened                   = "Network.directUDPSocketOpened"
+	EventDirectUDPSocketAborted                  = "Network.directUDPSocketAborted"
+	EventDirectUDPSocketClosed                   = "Network.directUDPSocketClosed"
+	EventDirectUDPSocketChunkSent                = "Network.directUDPSocketChunkSent"
+	EventDirectUDPSocketChunkReceived            = "Network.directUDPSocketChunkReceived"
+	EventRequestWillBeSentExtraInfo              = "Network.requestWillBeSentExtraInfo"
+	EventResponseReceivedExtraInfo               = "Network.responseReceivedExtraInfo"
+	EventResponseReceivedEarlyHints              = "Network.responseReceivedEarlyHints"
+	EventTrustTokenOperationDone                 = "Network.trustTokenOperationDone"
+	EventPolicyUpdated                           = "Network.policyUpdated"
+	EventSubresourceWebBundleMetadataReceived    = "Network.subresourceWebBundleMetadataReceived"
+	EventSubresourceWebBundleMetadataError       = "Network.subresourceWebBundleMetadataError"
+	EventSubresourceWebBundleInnerResponseParsed = "Network.subresourceWebBundleInnerResponseParsed"
+	EventSubresourceWebBundleInnerResponseError  = "Network.subresourceWebBundleInnerResponseError"
+	EventReportingAPIReportAdded                 = "Network.reportingApiReportAdded"
+	EventReportingAPIReportUpdated               = "Network.reportingApiReportUpdated"
+	EventReportingAPIEndpointsChangedForOrigin   = "Network.reportingApiEndpointsChangedForOrigin"
+)
+
+// DataReceivedEvent is the payload of the Network.dataReceived event.
+//
+// Fired when data chunk was received over the network.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#event-dataReceived
+type DataReceivedEvent struct {
+	// Request identifier.
+	RequestID RequestID `json:"requestId"`
+	// Timestamp.
+	Timestamp MonotonicTime `json:"timestamp"`
+	// Data chunk length.
+	DataLength int64 `json:"dataLength"`
+	// Actual bytes received (might be less than dataLength for compressed
+	// encodings).
+	EncodedDataLength int64 `json:"encodedDataLength"`
+	// Data that was received. (Encoded as a base64 string when passed over JSON)
+	//
+	// This is an experimental part of the protocol.
+	Data string `json:"data,omitempty"`
+}
+
+// EventSourceMessageReceivedEvent is the payload of the
+// Network.eventSourceMessageReceived event.
+//
+// Fired when EventSource message is received.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#event-eventSourceMessageReceived
+type EventSourceMessageReceivedEvent struct {
+	// Request identifier.
+	RequestID RequestID `json:"requestId"`
+	// Timestamp.
+	Timestamp MonotonicTime `json:"timestamp"`
+	// Message type.
+	EventName string `json:"eventName"`
+	// Message identifier.
+	EventID string `json:"eventId"`
+	// Message content.
+	Data string `json:"data"`
+}
+
+// LoadingFailedEvent is the payload of the Network.loadingFailed event.
+//
+// Fired when HTTP request has failed to load.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#event-loadingFailed
+type LoadingFailedEvent struct {
+	// Request identifier.
+	RequestID RequestID `json:"requestId"`
+	// Timestamp.
+	Timestamp MonotonicTime `json:"timestamp"`
+	// Resource type.
+	Type ResourceType `json:"type"`
+	// Error message. List of network errors:
+	// https://cs.chromium.org/chromium/src/net/base/net_error_list.h
+	ErrorText string `json:"errorText"`
+	// True if loading was canceled.
+	Canceled bool `json:"canceled,omitempty"`
+	// The reason why loading was blocked, if any.
+	BlockedReason BlockedReason `json:"blockedReason,omitempty"`
+	// The reason why loading was blocked by CORS, if any.
+	CorsErrorStatus *CorsErrorStatus `json:"corsErrorStatus,omitempty"`
+}
+
+// LoadingFinishedEvent is the payload of the Network.loadingFinished event.
+//
+// Fired when HTTP request has finished loading.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#event-loadingFinished
+type LoadingFinishedEvent struct {
+	// Request identifier.
+	RequestID RequestID `json:"requestId"`
+	// Timestamp.
+	Timestamp MonotonicTime `json:"timestamp"`
+	// Total number of bytes received for this request.
+	EncodedDataLength float64 `json:"encodedDataLength"`
+}
+
+// RequestInterceptedEvent is the payload of the Network.requestIntercepted
+// event.
+//
+// Details of an intercepted HTTP request, which must be either allowed,
+// blocked, modified or mocked. Deprecated, use Fetch.requestPaused instead.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#event-requestIntercepted
+//
+// This is an experimental part of the protocol.
+//
+// Deprecated: this is deprecated in the protocol.
+type RequestInterceptedEvent struct {
+	// Each request the page makes will have a unique id, however if any redirects
+	// are encountered while processing that fetch, they will be reported with the
+	// same id as the original fetch. Likewise if HTTP authentication is needed
+	// then the same fetch id will be used.
+	InterceptionID InterceptionID `json:"interceptionId"`
+	Request        Request        `json:"request"`
+	// The id of the frame that initiated the request.
+	FrameID types.PageFrameID `json:"frameId"`
+	// How the requested resource will be used.
+	ResourceType ResourceType `json:"resourceType"`
+	// Whether this is a navigation request, which can abort the navigation
+	// completely.
+	IsNavigationRequest bool `json:"isNavigationRequest"`
+	// Set if the request is a navigation that will result in a download. Only
+	// present after response is received from the server (i.e. HeadersReceived
+	// stage).
+	IsDownload bool `json:"isDownload,omitempty"`
+	// Redirect location, only sent if a redirect was intercepted.
+	RedirectURL string `json:"redirectUrl,omitempty"`
+	// Details of the Authorization Challenge encountered. If this is set then
+	// continueInterceptedRequest must contain an authChallengeResponse.
+	AuthChallenge *AuthChallenge `json:"authChallenge,omitempty"`
+	// Response error if intercepted at response stage or if redirect occurred
+	// while intercepting request.
+	ResponseErrorReason ErrorReason `json:"responseErrorReason,omitempty"`
+	// Response code if intercepted at response stage or if redirect occurred while
+	// intercepting request or auth retry occurred.
+	ResponseStatusCode int64 `json:"responseStatusCode,omitempty"`
+	// Response headers if intercepted at the response stage or if redirect
+	// occurred while intercepting request or auth retry occurred.
+	ResponseHeaders Headers `json:"responseHeaders,omitempty"`
+	// If the intercepted request had a corresponding requestWillBeSent event fired
+	// for it, then this requestId will be the same as the requestId present in the
+	// requestWillBeSent event.
+	RequestID RequestID `json:"requestId,omitempty"`
+}
+
+// RequestServedFromCacheEvent is the payload of the
+// Network.requestServedFromCache event.
+//
+// Fired if request ended up loading from cache.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#event-requestServedFromCache
+type RequestServedFromCacheEvent struct {
+	// Request identifier.
+	RequestID RequestID `json:"requestId"`
+}
+
+// RequestWillBeSentEvent is the payload of the Network.requestWillBeSent event.
+//
+// Fired when page is about to send HTTP request.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#event-requestWillBeSent
+type RequestWillBeSentEvent struct {
+	// Request identifier.
+	RequestID RequestID `json:"requestId"`
+	// Loader identifier. Empty string if the request is fetched from worker.
+	LoaderID LoaderID `json:"loaderId"`
+	// URL of the document this request is loaded for.
+	DocumentURL string `json:"documentURL"`
+	// Request data.
+	Request Request `json:"request"`
+	// Timestamp.
+	Timestamp MonotonicTime `json:"timestamp"`
+	// Timestamp.
+	WallTime TimeSinceEpoch `json:"wallTime"`
+	// Request initiator.
+	Initiator Initiator `json:"initiator"`
+	// In the case that redirectResponse is populated, this flag indicates whether
+	// requestWillBeSentExtraInfo and responseReceivedExtraInfo events will be or
+	// were emitted for the request which was just redirected.
+	//
+	// This is an experimental part of the protocol.
+	RedirectHasExtraInfo bool `json:"redirectHasExtraInfo"`
+	// Redirect response data.
+	RedirectResponse *Response `json:"redirectResponse,omitempty"`
+	// Type of this resource.
+	Type ResourceType `json:"type,omitempty"`
+	// Frame identifier.
+	FrameID types.PageFrameID `json:"frameId,omitempty"`
+	// Whether the request is initiated by a user gesture. Defaults to false.
+	HasUserGesture bool `json:"hasUserGesture,omitempty"`
+}
+
+// ResourceChangedPriorityEvent is the payload of the
+// Network.resourceChangedPriority event.
+//
+// # Fired when resource loading priority is changed
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#event-resourceChangedPriority
+//
+// This is an experimental part of the protocol.
+type ResourceChangedPriorityEvent struct {
+	// Request identifier.
+	RequestID RequestID `json:"requestId"`
+	// New priority
+	NewPriority ResourcePriority `json:"newPriority"`
+	// Timestamp.
+	Timestamp MonotonicTime `json:"timestamp"`
+}
+
+// SignedExchangeReceivedEvent is the payload of the
+// Network.signedExchangeReceived event.
+//
+// # Fired when a signed exchange was received over the network
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#event-signedExchangeReceived
+//
+// This is an experimental part of the protocol.
+type SignedExchangeReceivedEvent struct {
+	// Request identifier.
+	RequestID RequestID `json:"requestId"`
+	// Information about the signed exchange response.
+	Info SignedExchangeInfo `json:"info"`
+}
+
+// ResponseReceivedEvent is the payload of the Network.responseReceived event.
+//
+// Fired when HTTP response is available.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#event-responseReceived
+type ResponseReceivedEvent struct {
+	// Request identifier.
+	RequestID RequestID `json:"requestId"`
+	// Loader identifier. Empty string if the request is fetched from worker.
+	LoaderID LoaderID `json:"loaderId"`
+	// Timestamp.
+	Timestamp MonotonicTime `json:"timestamp"`
+	// Resource type.
+	Type ResourceType `json:"type"`
+	// Response data.
+	Response Response `json:"response"`
+	// Indicates whether requestWillBeSentExtraInfo and responseReceivedExtraInfo
+	// events will be or were emitted for this request.
+	//
+	// This is an experimental part of the protocol.
+	HasExtraInfo bool `json:"hasExtraInfo"`
+	// Frame identifier.
+	FrameID types.PageFrameID `json:"frameId,omitempty"`
+}
+
+// WebSocketClosedEvent is the payload of the Network.webSocketClosed event.
+//
+// Fired when WebSocket is closed.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#event-webSocketClosed
+type WebSocketClosedEvent struct {
+	// Request identifier.
+	RequestID RequestID `json:"requestId"`
+	// Timestamp.
+	Timestamp MonotonicTime `json:"timestamp"`
+}
+
+// WebSocketCreatedEvent is the payload of the Network.webSocketCreated event.
+//
+// Fired upon WebSocket creation.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#event-webSocketCreated
+type WebSocketCreatedEvent struct {
+	// Request identifier.
+	RequestID RequestID `json:"requestId"`
+	// WebSocket request URL.
+	URL string `json:"url"`
+	// Request initiator.
+	Initiator *Initiator `json:"initiator,omitempty"`
+}
+
+// WebSocketFrameErrorEvent is the payload of the Network.webSocketFrameError
+// event.
+//
+// Fired when WebSocket message error occurs.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#event-webSocketFrameError
+type WebSocketFrameErrorEvent struct {
+	// Request identifier.
+	RequestID RequestID `json:"requestId"`
+	// Timestamp.
+	Timestamp MonotonicTime `json:"timestamp"`
+	// WebSocket error message.
+	ErrorMessage string `json:"errorMessage"`
+}
+
+// WebSocketFrameReceivedEvent is the payload of the
+// Network.webSocketFrameReceived event.
+//
+// Fired when WebSocket message is received.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#event-webSocketFrameReceived
+type WebSocketFrameReceivedEvent struct {
+	// Request identifier.
+	RequestID RequestID `json:"requestId"`
+	// Timestamp.
+	Timestamp MonotonicTime `json:"timestamp"`
+	// WebSocket response data.
+	Response WebSocketFrame `json:"response"`
+}
+
+// WebSocketFrameSentEvent is the payload of the Network.webSocketFrameSent
+// event.
+//
+// Fired when WebSocket message is sent.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#event-webSocketFrameSent
+type WebSocketFrameSentEvent struct {
+	// Request identifier.
+	RequestID RequestID `json:"requestId"`
+	// Timestamp.
+	Timestamp MonotonicTime `json:"timestamp"`
+	// WebSocket response data.
+	Response WebSocketFrame `json:"response"`
+}
+
+// WebSocketHandshakeResponseReceivedEvent is the payload of the
+// Network.webSocketHandshakeResponseReceived event.
+//
+// Fired when WebSocket handshake response becomes available.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#event-webSocketHandshakeResponseReceived
+type WebSocketHandshakeResponseReceivedEvent struct {
+	// Request identifier.
+	RequestID RequestID `json:"requestId"`
+	// Timestamp.
+	Timestamp MonotonicTime `json:"timestamp"`
+	// WebSocket response data.
+	Response WebSocketResponse `json:"response"`
+}
+
+// WebSocketWillSendHandshakeRequestEvent is the payload of the
+// Network.webSocketWillSendHandshakeRequest event.
+//
+// Fired when WebSocket is about to initiate handshake.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#event-webSocketWillSendHandshakeRequest
+type WebSocketWillSendHandshakeRequestEvent struct {
+	// Request identifier.
+	RequestID RequestID `json:"requestId"`
+	// Timestamp.
+	Timestamp MonotonicTime `json:"timestamp"`
+	// UTC Timestamp.
+	WallTime TimeSinceEpoch `json:"wallTime"`
+	// WebSocket request data.
+	Request WebSocketRequest `json:"request"`
+}
+
+// WebTransportCreatedEvent is the payload of the Network.webTransportCreated
+// event.
+//
+// Fired upon WebTransport creation.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#event-webTransportCreated
+type WebTransportCreatedEvent struct {
+	// WebTransport identifier.
+	TransportID RequestID `json:"transportId"`
+	// WebTransport request URL.
+	URL string `json:"url"`
+	// Timestamp.
+	Timestamp MonotonicTime `json:"timestamp"`
+	// Request initiator.
+	Initiator *Initiator `json:"initiator,omitempty"`
+}
+
+// WebTransportConnectionEstablishedEvent is the payload of the
+// Network.webTransportConnectionEstablished event.
+//
+// Fired when WebTransport handshake is finished.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#event-webTransportConnectionEstablished
+type WebTransportConnectionEstablishedEvent struct {
+	// WebTransport identifier.
+	TransportID RequestID `json:"transportId"`
+	// Timestamp.
+	Timestamp MonotonicTime `json:"timestamp"`
+}
+
+// WebTransportClosedEvent is the payload of the Network.webTransportClosed
+// event.
+//
+// Fired when WebTransport is disposed.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#event-webTransportClosed
+type WebTransportClosedEvent struct {
+	// WebTransport identifier.
+	TransportID RequestID `json:"transportId"`
+	// Timestamp.
+	Timestamp MonotonicTime `json:"timestamp"`
+}
+
+// DirectTCPSocketCreatedEvent is the payload of the
+// Network.directTCPSocketCreated event.
+//
+// Fired upon direct_socket.TCPSocket creation.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#event-directTCPSocketCreated
+//
+// This is an experimental part of the protocol.
+type DirectTCPSocketCreatedEvent struct {
+	Identifier RequestID `json:"identifier"`
+	RemoteAddr string    `json:"remoteAddr"`
+	// Unsigned int 16.
+	RemotePort int64                  `json:"remotePort"`
+	Options    DirectTCPSocketOptions `json:"options"`
+	Timestamp  MonotonicTime          `json:"timestamp"`
+	Initiator  *Initiator             `json:"initiator,omitempty"`
+}
+
+// DirectTCPSocketOpenedEvent is the payload of the
+// Network.directTCPSocketOpened event.
+//
+// Fired when direct_socket.TCPSocket connection is opened.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#event-directTCPSocketOpened
+//
+// This is an experimental part of the protocol.
+type DirectTCPSocketOpenedEvent struct {
+	Identifier RequestID `json:"identifier"`
+	RemoteAddr string    `json:"remoteAddr"`
+	// Expected to be unsigned integer.
+	RemotePort int64         `json:"remotePort"`
+	Timestamp  MonotonicTime `json:"timestamp"`
+	LocalAddr  string        `json:"localAddr,omitempty"`
+	// Expected to be unsigned integer.
+	LocalPort int64 `json:"localPort,omitempty"`
+}
+
+// DirectTCPSocketAbortedEvent is the payload of the
+// Network.directTCPSocketAborted event.
+//
+// Fired when direct_socket.TCPSocket is aborted.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#event-directTCPSocketAborted
+//
+// This is an experimental part of the protocol.
+type DirectTCPSocketAbortedEvent struct {
+	Identifier   RequestID     `json:"identifier"`
+	ErrorMessage string        `json:"errorMessage"`
+	Timestamp    MonotonicTime `json:"timestamp"`
+}
+
+// DirectTCPSocketClosedEvent is the payload of the
+// Network.directTCPSocketClosed event.
+//
+// Fired when direct_socket.TCPSocket is closed.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#event-directTCPSocketClosed
+//
+// This is an experimental part of the protocol.
+type DirectTCPSocketClosedEvent struct {
+	Identifier RequestID     `json:"identifier"`
+	Timestamp  MonotonicTime `json:"timestamp"`
+}
+
+// DirectTCPSocketChunkSentEvent is the payload of the
+// Network.directTCPSocketChunkSent event.
+//
+// Fired when data is sent to tcp direct socket stream.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#event-directTCPSocketChunkSent
+//
+// This is an experimental part of the protocol.
+type DirectTCPSocketChunkSentEvent struct {
+	Identifier RequestID     `json:"identifier"`
+	Data       string        `json:"data"`
+	Timestamp  MonotonicTime `json:"timestamp"`
+}
+
+// DirectTCPSocketChunkReceivedEvent is the payload of the
+// Network.directTCPSocketChunkReceived event.
+//
+// Fired when data is received from tcp direct socket stream.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#event-directTCPSocketChunkReceived
+//
+// This is an experimental part of the protocol.
+type DirectTCPSocketChunkReceivedEvent struct {
+	Identifier RequestID     `json:"identifier"`
+	Data       string        `json:"data"`
+	Timestamp  MonotonicTime `json:"timestamp"`
+}
+
+// DirectUDPSocketCreatedEvent is the payload of the
+// Network.directUDPSocketCreated event.
+//
+// Fired upon direct_socket.UDPSocket creation.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#event-directUDPSocketCreated
+//
+// This is an experimental part of the protocol.
+type DirectUDPSocketCreatedEvent struct {
+	Identifier RequestID              `json:"identifier"`
+	Options    DirectUDPSocketOptions `json:"options"`
+	Timestamp  MonotonicTime          `json:"timestamp"`
+	Initiator  *Initiator             `json:"initiator,omitempty"`
+}
+
+// DirectUDPSocketOpenedEvent is the payload of the
+// Network.directUDPSocketOpened event.
+//
+// Fired when direct_socket.UDPSocket connection is opened.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#event-directUDPSocketOpened
+//
+// This is an experimental part of the protocol.
+type DirectUDPSocketOpenedEvent struct {
+	Identifier RequestID `json:"identifier"`
+	LocalAddr  string    `json:"localAddr"`
+	// Expected to be unsigned integer.
+	LocalPort  int64         `json:"localPort"`
+	Timestamp  MonotonicTime `json:"timestamp"`
+	RemoteAddr string        `json:"remoteAddr,omitempty"`
+	// Expected to be unsigned integer.
+	RemotePort int64 `json:"remotePort,omitempty"`
+}
+
+// DirectUDPSocketAbortedEvent is the payload of the
+// Network.directUDPSocketAborted event.
+//
+// Fired when direct_socket.UDPSocket is aborted.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#event-directUDPSocketAborted
+//
+// This is an experimental part of the protocol.
+type DirectUDPSocketAbortedEvent struct {
+	Identifier   RequestID     `json:"identifier"`
+	ErrorMessage string        `json:"errorMessage"`
+	Timestamp    MonotonicTime `json:"timestamp"`
+}
+
+// DirectUDPSocketClosedEvent is the payload of the
+// Network.directUDPSocketClosed event.
+//
+// Fired when direct_socket.UDPSocket is closed.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#event-directUDPSocketClosed
+//
+// This is an experimental part of the protocol.
+type DirectUDPSocketClosedEvent struct {
+	Identifier RequestID     `json:"identifier"`
+	Timestamp  MonotonicTime `json:"timestamp"`
+}
+
+// DirectUDPSocketChunkSentEvent is the payload of the
+// Network.directUDPSocketChunkSent event.
+//
+// Fired when message is sent to udp direct socket stream.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#event-directUDPSocketChunkSent
+//
+// This is an experimental part of the protocol.
+type DirectUDPSocketChunkSentEvent struct {
+	Identifier RequestID        `json:"identifier"`
+	Message    DirectUDPMessage `json:"message"`
+	Timestamp  MonotonicTime    `json:"timestamp"`
+}
+
+// DirectUDPSocketChunkReceivedEvent is the payload of the
+// Network.directUDPSocketChunkReceived event.
+//
+// Fired when message is received from udp direct socket stream.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#event-directUDPSocketChunkReceived
+//
+// This is an experimental part of the protocol.
+type DirectUDPSocketChunkReceivedEvent struct {
+	Identifier RequestID        `json:"identifier"`
+	Message    DirectUDPMessage `json:"message"`
+	Timestamp  MonotonicTime    `json:"timestamp"`
+}
+
+// RequestWillBeSentExtraInfoEvent is the payload of the
+// Network.requestWillBeSentExtraInfo event.
+//
+// Fired when additional information about a requestWillBeSent event is
+// available from the network stack. Not every requestWillBeSent event will have
+// an additional requestWillBeSentExtraInfo fired for it, and there is no
+// guarantee whether requestWillBeSent or requestWillBeSentExtraInfo will be
+// fired first for the same request.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#event-requestWillBeSentExtraInfo
+//
+// This is an experimental part of the protocol.
+type RequestWillBeSentExtraInfoEvent struct {
+	// Request identifier. Used to match this information to an existing
+	// requestWillBeSent event.
+	RequestID RequestID `json:"requestId"`
+	// A list of cookies potentially associated to the requested URL. This includes
+	// both cookies sent with the request and the ones not sent; the latter are
+	// distinguished by having blockedReasons field set.
+	AssociatedCookies []AssociatedCookie `json:"associatedCookies"`
+	// Raw request headers as they will be sent over the wire.
+	Headers Headers `json:"headers"`
+	// Connection timing information for the request.
+	//
+	// This is an experimental part of the protocol.
+	ConnectTiming ConnectTiming `json:"connectTiming"`
+	// The client security state set for the request.
+	ClientSecurityState *ClientSecurityState `json:"clientSecurityState,omitempty"`
+	// Whether the site has partitioned cookies stored in a partition different
+	// than the current one.
+	SiteHasCookieInOtherPartition bool `json:"siteHasCookieInOtherPartition,omitempty"`
+}
+
+// ResponseReceivedExtraInfoEvent is the payload of the
+// Network.responseReceivedExtraInfo event.
+//
+// Fired when additional information about a responseReceived event is available
+// from the network stack. Not every responseReceived event will have an
+// additional responseReceivedExtraInfo for it, and responseReceivedExtraInfo
+// may be fired before or after responseReceived.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#event-responseReceivedExtraInfo
+//
+// This is an experimental part of the protocol.
+type ResponseReceivedExtraInfoEvent struct {
+	// Request identifier. Used to match this information to another
+	// responseReceived event.
+	RequestID RequestID `json:"requestId"`
+	// A list of cookies which were not stored from the response along with the
+	// corresponding reasons for blocking. The cookies here may not be valid due to
+	// syntax errors, which are represented by the invalid cookie line string
+	// instead of a proper cookie.
+	BlockedCookies []BlockedSetCookieWithReason `json:"blockedCookies"`
+	// Raw response headers as they were received over the wire. Duplicate headers
+	// in the response are represented as a single key with their values
+	// concatentated using `\n` as the separator. See also `headersText` that
+	// contains verbatim text for HTTP/1.*.
+	Headers Headers `json:"headers"`
+	// The IP address space of the resource. The address space can only be
+	// determined once the transport established the connection, so we can't send
+	// it in `requestWillBeSentExtraInfo`.
+	ResourceIPAddressSpace IPAddressSpace `json:"resourceIPAddressSpace"`
+	// The status code of the response. This is useful in cases the request failed
+	// and no responseReceived event is triggered, which is the case for, e.g.,
+	// CORS errors. This is also the correct status code for cached requests, where
+	// the status in responseReceived is a 200 and this will be 304.
+	StatusCode int64 `json:"statusCode"`
+	// Raw response header text as it was received over the wire. The raw text may
+	// not always be available, such as in the case of HTTP/2 or QUIC.
+	HeadersText string `json:"headersText,omitempty"`
+	// The cookie partition key that will be used to store partitioned cookies set
+	// in this response. Only sent when partitioned cookies are enabled.
+	//
+	// This is an experimental part of the protocol.
+	CookiePartitionKey *CookiePartitionKey `json:"cookiePartitionKey,omitempty"`
+	// True if partitioned cookies are enabled, but the partition key is not
+	// serializable to string.
+	CookiePartitionKeyOpaque bool `json:"cookiePartitionKeyOpaque,omitempty"`
+	// A list of cookies which should have been blocked by 3PCD but are exempted
+	// and stored from the response with the corresponding reason.
+	ExemptedCookies []ExemptedSetCookieWithReason `json:"exemptedCookies,omitempty"`
+}
+
+// ResponseReceivedEarlyHintsEvent is the payload of the
+// Network.responseReceivedEarlyHints event.
+//
+// Fired when 103 Early Hints headers is received in addition to the common
+// response. Not every responseReceived event will have an
+// responseReceivedEarlyHints fired. Only one responseReceivedEarlyHints may be
+// fired for eached responseReceived event.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#event-responseReceivedEarlyHints
+//
+// This is an experimental part of the protocol.
+type ResponseReceivedEarlyHintsEvent struct {
+	// Request identifier. Used to match this information to another
+	// responseReceived event.
+	RequestID RequestID `json:"requestId"`
+	// Raw response headers as they were received over the wire. Duplicate headers
+	// in the response are represented as a single key with their values
+	// concatentated using `\n` as the separator. See also `headersText` that
+	// contains verbatim text for HTTP/1.*.
+	Headers Headers `json:"headers"`
+}
+
+// TrustTokenOperationDoneEvent is the payload of the
+// Network.trustTokenOperationDone event.
+//
+// Fired exactly once for each Trust Token operation. Depending on the type of
+// the operation and whether the operation succeeded or failed, the event is
+// fired before the corresponding request was sent or after the response was
+// received.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#event-trustTokenOperationDone
+//
+// This is an experimental part of the protocol.
+type TrustTokenOperationDoneEvent struct {
+	// Detailed success or error status of the operation. 'AlreadyExists' also
+	// signifies a successful operation, as the result of the operation already
+	// exists und thus, the operation was abort preemptively (e.g. a cache hit).
+	//
+	// Allowed values: Ok, InvalidArgument, MissingIssuerKeys, FailedPrecondition,
+	// ResourceExhausted, AlreadyExists, ResourceLimited, Unauthorized,
+	// BadResponse, InternalError, UnknownError, FulfilledLocally, SiteIssuerLimit.
+	Status    string                  `json:"status"`
+	Type      TrustTokenOperationType `json:"type"`
+	RequestID RequestID               `json:"requestId"`
+	// Top level origin. The context in which the operation was attempted.
+	TopLevelOrigin string `json:"topLevelOrigin,omitempty"`
+	// Origin of the issuer in case of a "Issuance" or "Redemption" operation.
+	IssuerOrigin string `json:"issuerOrigin,omitempty"`
+	// The number of obtained Trust Tokens on a successful "Issuance" operation.
+	IssuedTokenCount int64 `json:"issuedTokenCount,omitempty"`
+}
+
+// PolicyUpdatedEvent is the payload of the Network.policyUpdated event.
+//
+// Fired once security policy has been updated.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#event-policyUpdated
+//
+// This is an experimental part of the protocol.
+type PolicyUpdatedEvent struct {
+}
+
+// SubresourceWebBundleMetadataReceivedEvent is the payload of the
+// Network.subresourceWebBundleMetadataReceived event.
+//
+// Fired once when parsing the .wbn file has succeeded. The event contains the
+// information about the web bundle contents.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#event-subresourceWebBundleMetadataReceived
+//
+// This is an experimental part of the protocol.
+type SubresourceWebBundleMetadataReceivedEvent struct {
+	// Request identifier. Used to match this information to another event.
+	RequestID RequestID `json:"requestId"`
+	// A list of URLs of resources in the subresource Web Bundle.
+	Urls []string `json:"urls"`
+}
+
+// SubresourceWebBundleMetadataErrorEvent is the payload of the
+// Network.subresourceWebBundleMetadataError event.
+//
+// Fired once when parsing the .wbn file has failed.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#event-subresourceWebBundleMetadataError
+//
+// This is an experimental part of the protocol.
+type SubresourceWebBundleMetadataErrorEvent struct {
+	// Request identifier. Used to match this information to another event.
+	RequestID RequestID `json:"requestId"`
+	// Error message
+	ErrorMessage string `json:"errorMessage"`
+}
+
+// SubresourceWebBundleInnerResponseParsedEvent is the payload of the
+// Network.subresourceWebBundleInnerResponseParsed event.
+//
+// Fired when handling requests for resources within a .wbn file. Note: this
+// will only be fired for resources that are requested by the webpage.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#event-subresourceWebBundleInnerResponseParsed
+//
+// This is an experimental part of the protocol.
+type SubresourceWebBundleInnerResponseParsedEvent struct {
+	// Request identifier of the subresource request
+	InnerRequestID RequestID `json:"innerRequestId"`
+	// URL of the subresource resource.
+	InnerRequestURL string `json:"innerRequestURL"`
+	// Bundle request identifier. Used to match this information to another event.
+	// This made be absent in case when the instrumentation was enabled only after
+	// webbundle was parsed.
+	BundleRequestID RequestID `json:"bundleRequestId,omitempty"`
+}
+
+// SubresourceWebBundleInnerResponseErrorEvent is the payload of the
+// Network.subresourceWebBundleInnerResponseError event.
+//
+// Fired when request for resources within a .wbn file failed.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#event-subresourceWebBundleInnerResponseError
+//
+// This is an experimental part of the protocol.
+type SubresourceWebBundleInnerResponseErrorEvent struct {
+	// Request identifier of the subresource request
+	InnerRequestID RequestID `json:"innerRequestId"`
+	// URL of the subresource resource.
+	InnerRequestURL string `json:"innerRequestURL"`
+	// Error message
+	ErrorMessage string `json:"errorMessage"`
+	// Bundle request identifier. Used to match this information to another event.
+	// This made be absent in case when the instrumentation was enabled only after
+	// webbundle was parsed.
+	BundleRequestID RequestID `json:"bundleRequestId,omitempty"`
+}
+
+// ReportingAPIReportAddedEvent is the payload of the
+// Network.reportingApiReportAdded event.
+//
+// Is sent whenever a new report is added. And after 'enableReportingApi' for
+// all existing reports.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#event-reportingApiReportAdded
+//
+// This is an experimental part of the protocol.
+type ReportingAPIReportAddedEvent struct {
+	Report ReportingAPIReport `json:"report"`
+}
+
+// ReportingAPIReportUpdatedEvent is the payload of the
+// Network.reportingApiReportUpdated event.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#event-reportingApiReportUpdated
+//
+// This is an experimental part of the protocol.
+type ReportingAPIReportUpdatedEvent struct {
+	Report ReportingAPIReport `json:"report"`
+}
+
+// ReportingAPIEndpointsChangedForOriginEvent is the payload of the
+// Network.reportingApiEndpointsChangedForOrigin event.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Network#event-reportingApiEndpointsChangedForOrigin
+//
+// This is an experimental part of the protocol.
+type ReportingAPIEndpointsChangedForOriginEvent struct {
+	// Origin of the document(s) which configured the endpoints.
+	Origin    string                 `json:"origin"`
+	Endpoints []ReportingAPIEndpoint `json:"endpoints"`
+}
+
+// ParseEvent decodes an event from the Network domain, as received from
+// Subscribe, into a pointer to its typed payload.
+func ParseEvent(cmd chromedebugo.Command) (interface{}, error) {
+	var ev interface{}
+	switch cmd.Method {
+	case EventDataReceived:
+		ev = &DataReceivedEvent{}
+	case EventEventSourceMessageReceived:
+		ev = &EventSourceMessageReceivedEvent{}
+	case EventLoadingFailed:
+		ev = &LoadingFailedEvent{}
+	case EventLoadingFinished:
+		ev = &LoadingFinishedEvent{}
+	case EventRequestIntercepted:
+		ev = &RequestInterceptedEvent{}
+	case EventRequestServedFromCache:
+		ev = &RequestServedFromCacheEvent{}
+	case EventRequestWillBeSent:
+		ev = &RequestWillBeSentEvent{}
+	case EventResourceChangedPriority:
+		ev = &ResourceChangedPriorityEvent{}
+	case EventSignedExchangeReceived:
+		ev = &SignedExchangeReceivedEvent{}
+	case EventResponseReceived:
+		ev = &ResponseReceivedEvent{}
+	case EventWebSocketClosed:
+		ev = &WebSocketClosedEvent{}
+	case EventWebSocketCreated:
+		ev = &WebSocketCreatedEvent{}
+	case EventWebSocketFrameError:
+		ev = &WebSocketFrameErrorEvent{}
+	case EventWebSocketFrameReceived:
+		ev = &WebSocketFrameReceivedEvent{}
+	case EventWebSocketFrameSent:
+		ev = &WebSocketFrameSentEvent{}
+	case EventWebSocketHandshakeResponseReceived:
+		ev = &WebSocketHandshakeResponseReceivedEvent{}
+	case EventWebSocketWillSendHandshakeRequest:
+		ev = &WebSocketWillSendHandshakeRequestEvent{}
+	case EventWebTransportCreated:
+		ev = &WebTransportCreatedEvent{}
+	case EventWebTransportConnectionEstablished:
+		ev = &WebTransportConnectionEstablishedEvent{}
+	case EventWebTransportClosed:
+		ev = &WebTransportClosedEvent{}
+	case EventDirectTCPSocketCreated:
+		ev = &DirectTCPSocketCreatedEvent{}
+	case EventDirectTCPSocketOpened:
+		ev = &DirectTCPSocketOpenedEvent{}
+	case EventDirectTCPSocketAborted:
+		ev = &DirectTCPSocketAbortedEvent{}
+	case EventDirectTCPSocketClosed:
+		ev = &DirectTCPSocketClosedEvent{}
+	case EventDirectTCPSocketChunkSent:
+		ev = &DirectTCPSocketChunkSentEvent{}
+	case EventDirectTCPSocketChunkReceived:
+		ev = &DirectTCPSocketChunkReceivedEvent{}
+	case EventDirectUDPSocketCreated:
+		ev = &DirectUDPSocketCreatedEvent{}
+	case EventDirectUDPSocketOpened:
+		ev = &DirectUDPSocketOpenedEvent{}
+	case EventDirectUDPSocketAborted:
+		ev = &DirectUDPSocketAbortedEvent{}
+	case EventDirectUDPSocketClosed:
+		ev = &DirectUDPSocketClosedEvent{}
+	case EventDirectUDPSocketChunkSent:
+		ev = &DirectUDPSocketChunkSentEvent{}
+	case EventDirectUDPSocketChunkReceived:
+		ev = &DirectUDPSocketChunkReceivedEvent{}
+	case EventRequestWillBeSentExtraInfo:
+		ev = &RequestWillBeSentExtraInfoEvent{}
+	case EventResponseReceivedExtraInfo:
+		ev = &ResponseReceivedExtraInfoEvent{}
+	case EventResponseReceivedEarlyHints:
+		ev = &ResponseReceivedEarlyHintsEvent{}
+	case EventTrustTokenOperationDone:
+		ev = &TrustTokenOperationDoneEvent{}
+	case EventPolicyUpdated:
+		ev = &PolicyUpdatedEvent{}
+	case EventSubresourceWebBundleMetadataReceived:
+		ev = &SubresourceWebBundleMetadataReceivedEvent{}
+	case EventSubresourceWebBundleMetadataError:
+		ev = &SubresourceWebBundleMetadataErrorEvent{}
+	case EventSubresourceWebBundleInnerResponseParsed:
+		ev = &SubresourceWebBundleInnerResponseParsedEvent{}
+	case EventSubresourceWebBundleInnerResponseError:
+		ev = &SubresourceWebBundleInnerResponseErrorEvent{}
+	case EventReportingAPIReportAdded:
+		ev = &ReportingAPIReportAddedEvent{}
+	case EventReportingAPIReportUpdated:
+		ev = &ReportingAPIReportUpdatedEvent{}
+	case EventReportingAPIEndpointsChangedForOrigin:
+		ev = &ReportingAPIEndpointsChangedForOriginEvent{}
+	default:
+		return nil, fmt.Errorf("unknown Network event: %s", cmd.Method)
+	}
+	if err := cmd.Decode(ev); err != nil {
+		return nil, err
+	}
+	return ev, nil
+}
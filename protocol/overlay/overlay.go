@@ -0,0 +1,968 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+// Package overlay provides typed bindings for the Overlay domain of the Chrome
+// DevTools protocol.
+//
+// This domain provides various functionality related to drawing atop the
+// inspected page.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Overlay
+//
+// This is an experimental part of the protocol.
+package overlay
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tonyhb/chromedebugo"
+	"github.com/tonyhb/chromedebugo/protocol"
+	"github.com/tonyhb/chromedebugo/protocol/internal/types"
+)
+
+// SourceOrderConfig configuration data for drawing the source order of an
+// elements children.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Overlay#type-SourceOrderConfig
+type SourceOrderConfig = types.OverlaySourceOrderConfig
+
+// GridHighlightConfig configuration data for the highlighting of Grid elements.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Overlay#type-GridHighlightConfig
+type GridHighlightConfig = types.OverlayGridHighlightConfig
+
+// FlexContainerHighlightConfig configuration data for the highlighting of Flex
+// container elements.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Overlay#type-FlexContainerHighlightConfig
+type FlexContainerHighlightConfig = types.OverlayFlexContainerHighlightConfig
+
+// FlexItemHighlightConfig configuration data for the highlighting of Flex item
+// elements.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Overlay#type-FlexItemHighlightConfig
+type FlexItemHighlightConfig = types.OverlayFlexItemHighlightConfig
+
+// LineStyle style information for drawing a line.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Overlay#type-LineStyle
+type LineStyle = types.OverlayLineStyle
+
+// BoxStyle style information for drawing a box.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Overlay#type-BoxStyle
+type BoxStyle = types.OverlayBoxStyle
+
+// ContrastAlgorithm is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Overlay#type-ContrastAlgorithm
+type ContrastAlgorithm = types.OverlayContrastAlgorithm
+
+// HighlightConfig configuration data for the highlighting of page elements.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Overlay#type-HighlightConfig
+type HighlightConfig = types.OverlayHighlightConfig
+
+// ColorFormat is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Overlay#type-ColorFormat
+type ColorFormat = types.OverlayColorFormat
+
+// GridNodeHighlightConfig configurations for Persistent Grid Highlight
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Overlay#type-GridNodeHighlightConfig
+type GridNodeHighlightConfig = types.OverlayGridNodeHighlightConfig
+
+// FlexNodeHighlightConfig is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Overlay#type-FlexNodeHighlightConfig
+type FlexNodeHighlightConfig = types.OverlayFlexNodeHighlightConfig
+
+// ScrollSnapContainerHighlightConfig is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Overlay#type-ScrollSnapContainerHighlightConfig
+type ScrollSnapContainerHighlightConfig = types.OverlayScrollSnapContainerHighlightConfig
+
+// ScrollSnapHighlightConfig is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Overlay#type-ScrollSnapHighlightConfig
+type ScrollSnapHighlightConfig = types.OverlayScrollSnapHighlightConfig
+
+// HingeConfig configuration for dual screen hinge
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Overlay#type-HingeConfig
+type HingeConfig = types.OverlayHingeConfig
+
+// WindowControlsOverlayConfig configuration for Window Controls Overlay
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Overlay#type-WindowControlsOverlayConfig
+type WindowControlsOverlayConfig = types.OverlayWindowControlsOverlayConfig
+
+// ContainerQueryHighlightConfig is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Overlay#type-ContainerQueryHighlightConfig
+type ContainerQueryHighlightConfig = types.OverlayContainerQueryHighlightConfig
+
+// ContainerQueryContainerHighlightConfig is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Overlay#type-ContainerQueryContainerHighlightConfig
+type ContainerQueryContainerHighlightConfig = types.OverlayContainerQueryContainerHighlightConfig
+
+// IsolatedElementHighlightConfig is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Overlay#type-IsolatedElementHighlightConfig
+type IsolatedElementHighlightConfig = types.OverlayIsolatedElementHighlightConfig
+
+// IsolationModeHighlightConfig is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Overlay#type-IsolationModeHighlightConfig
+type IsolationModeHighlightConfig = types.OverlayIsolationModeHighlightConfig
+
+// InspectMode is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Overlay#type-InspectMode
+type InspectMode = types.OverlayInspectMode
+
+// Values of the enumerated types in the Overlay domain.
+const (
+	ContrastAlgorithmAa              = types.OverlayContrastAlgorithmAa
+	ContrastAlgorithmAaa             = types.OverlayContrastAlgorithmAaa
+	ContrastAlgorithmApca            = types.OverlayContrastAlgorithmApca
+	ColorFormatRgb                   = types.OverlayColorFormatRgb
+	ColorFormatHsl                   = types.OverlayColorFormatHsl
+	ColorFormatHwb                   = types.OverlayColorFormatHwb
+	ColorFormatHex                   = types.OverlayColorFormatHex
+	InspectModeSearchForNode         = types.OverlayInspectModeSearchForNode
+	InspectModeSearchForUAShadowDOM  = types.OverlayInspectModeSearchForUAShadowDOM
+	InspectModeCaptureAreaScreenshot = types.OverlayInspectModeCaptureAreaScreenshot
+	InspectModeNone                  = types.OverlayInspectModeNone
+)
+
+// Method names of the commands in the Overlay domain.
+const (
+	CommandDisable                              = "Overlay.disable"
+	CommandEnable                               = "Overlay.enable"
+	CommandGetHighlightObjectForTest            = "Overlay.getHighlightObjectForTest"
+	CommandGetGridHighlightObjectsForTest       = "Overlay.getGridHighlightObjectsForTest"
+	CommandGetSourceOrderHighlightObjectForTest = "Overlay.getSourceOrderHighlightObjectForTest"
+	CommandHideHighlight                        = "Overlay.hideHighlight"
+	CommandHighlightFrame                       = "Overlay.highlightFrame"
+	CommandHighlightNode                        = "Overlay.highlightNode"
+	CommandHighlightQuad                        = "Overlay.highlightQuad"
+	CommandHighlightRect                        = "Overlay.highlightRect"
+	CommandHighlightSourceOrder                 = "Overlay.highlightSourceOrder"
+	CommandSetInspectMode                       = "Overlay.setInspectMode"
+	CommandSetShowAdHighlights                  = "Overlay.setShowAdHighlights"
+	CommandSetPausedInDebuggerMessage           = "Overlay.setPausedInDebuggerMessage"
+	CommandSetShowDebugBorders                  = "Overlay.setShowDebugBorders"
+	CommandSetShowFPSCounter                    = "Overlay.setShowFPSCounter"
+	CommandSetShowGridOverlays                  = "Overlay.setShowGridOverlays"
+	CommandSetShowFlexOverlays                  = "Overlay.setShowFlexOverlays"
+	CommandSetShowScrollSnapOverlays            = "Overlay.setShowScrollSnapOverlays"
+	CommandSetShowContainerQueryOverlays        = "Overlay.setShowContainerQueryOverlays"
+	CommandSetShowPaintRects                    = "Overlay.setShowPaintRects"
+	CommandSetShowLayoutShiftRegions            = "Overlay.setShowLayoutShiftRegions"
+	CommandSetShowScrollBottleneckRects         = "Overlay.setShowScrollBottleneckRects"
+	CommandSetShowHitTestBorders                = "Overlay.setShowHitTestBorders"
+	CommandSetShowWebVitals                     = "Overlay.setShowWebVitals"
+	CommandSetShowViewportSizeOnResize          = "Overlay.setShowViewportSizeOnResize"
+	CommandSetShowHinge                         = "Overlay.setShowHinge"
+	CommandSetShowIsolatedElements              = "Overlay.setShowIsolatedElements"
+	CommandSetShowWindowControlsOverlay         = "Overlay.setShowWindowControlsOverlay"
+)
+
+// DisableParams are the parameters of Overlay.disable.
+//
+// Disables domain notifications.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Overlay#method-disable
+type DisableParams struct {
+}
+
+// Command returns the Overlay.disable command for use with AsyncDebugger.Send.
+func (p DisableParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandDisable, p)
+}
+
+// Do sends Overlay.disable to d and waits for it to complete or for ctx to
+// be done.
+func (p DisableParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandDisable, p, nil)
+}
+
+// EnableParams are the parameters of Overlay.enable.
+//
+// Enables domain notifications.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Overlay#method-enable
+type EnableParams struct {
+}
+
+// Command returns the Overlay.enable command for use with AsyncDebugger.Send.
+func (p EnableParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandEnable, p)
+}
+
+// Do sends Overlay.enable to d and waits for it to complete or for ctx to
+// be done.
+func (p EnableParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandEnable, p, nil)
+}
+
+// GetHighlightObjectForTestParams are the parameters of
+// Overlay.getHighlightObjectForTest.
+//
+// For testing.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Overlay#method-getHighlightObjectForTest
+type GetHighlightObjectForTestParams struct {
+	// Id of the node to get highlight object for.
+	NodeID types.DOMNodeID `json:"nodeId"`
+	// Whether to include distance info.
+	IncludeDistance bool `json:"includeDistance,omitempty"`
+	// Whether to include style info.
+	IncludeStyle bool `json:"includeStyle,omitempty"`
+	// The color format to get config with (default: hex).
+	ColorFormat ColorFormat `json:"colorFormat,omitempty"`
+	// Whether to show accessibility info (default: true).
+	ShowAccessibilityInfo bool `json:"showAccessibilityInfo,omitempty"`
+}
+
+// Command returns the Overlay.getHighlightObjectForTest command for use with
+// AsyncDebugger.Send.
+func (p GetHighlightObjectForTestParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandGetHighlightObjectForTest, p)
+}
+
+// GetHighlightObjectForTestReturns are the values returned by Overlay.getHighlightObjectForTest.
+type GetHighlightObjectForTestReturns struct {
+	// Highlight data for the node.
+	Highlight map[string]interface{} `json:"highlight"`
+}
+
+// Do sends Overlay.getHighlightObjectForTest to d and waits for its result or for ctx to be
+// done.
+func (p GetHighlightObjectForTestParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*GetHighlightObjectForTestReturns, error) {
+	ret := &GetHighlightObjectForTestReturns{}
+	if err := protocol.Do(ctx, d, CommandGetHighlightObjectForTest, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// GetGridHighlightObjectsForTestParams are the parameters of
+// Overlay.getGridHighlightObjectsForTest.
+//
+// For Persistent Grid testing.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Overlay#method-getGridHighlightObjectsForTest
+type GetGridHighlightObjectsForTestParams struct {
+	// Ids of the node to get highlight object for.
+	NodeIds []types.DOMNodeID `json:"nodeIds"`
+}
+
+// Command returns the Overlay.getGridHighlightObjectsForTest command for use
+// with AsyncDebugger.Send.
+func (p GetGridHighlightObjectsForTestParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandGetGridHighlightObjectsForTest, p)
+}
+
+// GetGridHighlightObjectsForTestReturns are the values returned by Overlay.getGridHighlightObjectsForTest.
+type GetGridHighlightObjectsForTestReturns struct {
+	// Grid Highlight data for the node ids provided.
+	Highlights map[string]interface{} `json:"highlights"`
+}
+
+// Do sends Overlay.getGridHighlightObjectsForTest to d and waits for its result or for ctx to be
+// done.
+func (p GetGridHighlightObjectsForTestParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*GetGridHighlightObjectsForTestReturns, error) {
+	ret := &GetGridHighlightObjectsForTestReturns{}
+	if err := protocol.Do(ctx, d, CommandGetGridHighlightObjectsForTest, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// GetSourceOrderHighlightObjectForTestParams are the parameters of
+// Overlay.getSourceOrderHighlightObjectForTest.
+//
+// For Source Order Viewer testing.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Overlay#method-getSourceOrderHighlightObjectForTest
+type GetSourceOrderHighlightObjectForTestParams struct {
+	// Id of the node to highlight.
+	NodeID types.DOMNodeID `json:"nodeId"`
+}
+
+// Command returns the Overlay.getSourceOrderHighlightObjectForTest command for
+// use with AsyncDebugger.Send.
+func (p GetSourceOrderHighlightObjectForTestParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandGetSourceOrderHighlightObjectForTest, p)
+}
+
+// GetSourceOrderHighlightObjectForTestReturns are the values returned by Overlay.getSourceOrderHighlightObjectForTest.
+type GetSourceOrderHighlightObjectForTestReturns struct {
+	// Source order highlight data for the node id provided.
+	Highlight map[string]interface{} `json:"highlight"`
+}
+
+// Do sends Overlay.getSourceOrderHighlightObjectForTest to d and waits for its result or for ctx to be
+// done.
+func (p GetSourceOrderHighlightObjectForTestParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*GetSourceOrderHighlightObjectForTestReturns, error) {
+	ret := &GetSourceOrderHighlightObjectForTestReturns{}
+	if err := protocol.Do(ctx, d, CommandGetSourceOrderHighlightObjectForTest, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// HideHighlightParams are the parameters of Overlay.hideHighlight.
+//
+// Hides any highlight.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Overlay#method-hideHighlight
+type HideHighlightParams struct {
+}
+
+// Command returns the Overlay.hideHighlight command for use with
+// AsyncDebugger.Send.
+func (p HideHighlightParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandHideHighlight, p)
+}
+
+// Do sends Overlay.hideHighlight to d and waits for it to complete or for ctx to
+// be done.
+func (p HideHighlightParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandHideHighlight, p, nil)
+}
+
+// HighlightFrameParams are the parameters of Overlay.highlightFrame.
+//
+// Highlights owner element of the frame with given id. Deprecated: Doesn't work
+// reliably and cannot be fixed due to process separation (the owner node might
+// be in a different process). Determine the owner node in the client and use
+// highlightNode.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Overlay#method-highlightFrame
+//
+// Deprecated: this is deprecated in the protocol.
+type HighlightFrameParams struct {
+	// Identifier of the frame to highlight.
+	FrameID types.PageFrameID `json:"frameId"`
+	// The content box highlight fill color (default: transparent).
+	ContentColor *types.DOMRGBA `json:"contentColor,omitempty"`
+	// The content box highlight outline color (default: transparent).
+	ContentOutlineColor *types.DOMRGBA `json:"contentOutlineColor,omitempty"`
+}
+
+// Command returns the Overlay.highlightFrame command for use with
+// AsyncDebugger.Send.
+func (p HighlightFrameParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandHighlightFrame, p)
+}
+
+// Do sends Overlay.highlightFrame to d and waits for it to complete or for ctx to
+// be done.
+func (p HighlightFrameParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandHighlightFrame, p, nil)
+}
+
+// HighlightNodeParams are the parameters of Overlay.highlightNode.
+//
+// Highlights DOM node with given id or with the given JavaScript object
+// wrapper. Either nodeId or objectId must be specified.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Overlay#method-highlightNode
+type HighlightNodeParams struct {
+	// A descriptor for the highlight appearance.
+	HighlightConfig HighlightConfig `json:"highlightConfig"`
+	// Identifier of the node to highlight.
+	NodeID types.DOMNodeID `json:"nodeId,omitempty"`
+	// Identifier of the backend node to highlight.
+	BackendNodeID types.DOMBackendNodeID `json:"backendNodeId,omitempty"`
+	// JavaScript object id of the node to be highlighted.
+	ObjectID types.RuntimeRemoteObjectID `json:"objectId,omitempty"`
+	// Selectors to highlight relevant nodes.
+	Selector string `json:"selector,omitempty"`
+}
+
+// Command returns the Overlay.highlightNode command for use with
+// AsyncDebugger.Send.
+func (p HighlightNodeParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandHighlightNode, p)
+}
+
+// Do sends Overlay.highlightNode to d and waits for it to complete or for ctx to
+// be done.
+func (p HighlightNodeParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandHighlightNode, p, nil)
+}
+
+// HighlightQuadParams are the parameters of Overlay.highlightQuad.
+//
+// Highlights given quad. Coordinates are absolute with respect to the main
+// frame viewport.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Overlay#method-highlightQuad
+type HighlightQuadParams struct {
+	// Quad to highlight
+	Quad types.DOMQuad `json:"quad"`
+	// The highlight fill color (default: transparent).
+	Color *types.DOMRGBA `json:"color,omitempty"`
+	// The highlight outline color (default: transparent).
+	OutlineColor *types.DOMRGBA `json:"outlineColor,omitempty"`
+}
+
+// Command returns the Overlay.highlightQuad command for use with
+// AsyncDebugger.Send.
+func (p HighlightQuadParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandHighlightQuad, p)
+}
+
+// Do sends Overlay.highlightQuad to d and waits for it to complete or for ctx to
+// be done.
+func (p HighlightQuadParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandHighlightQuad, p, nil)
+}
+
+// HighlightRectParams are the parameters of Overlay.highlightRect.
+//
+// Highlights given rectangle. Coordinates are absolute with respect to the main
+// frame viewport.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Overlay#method-highlightRect
+type HighlightRectParams struct {
+	// X coordinate
+	X int64 `json:"x"`
+	// Y coordinate
+	Y int64 `json:"y"`
+	// Rectangle width
+	Width int64 `json:"width"`
+	// Rectangle height
+	Height int64 `json:"height"`
+	// The highlight fill color (default: transparent).
+	Color *types.DOMRGBA `json:"color,omitempty"`
+	// The highlight outline color (default: transparent).
+	OutlineColor *types.DOMRGBA `json:"outlineColor,omitempty"`
+}
+
+// Command returns the Overlay.highlightRect command for use with
+// AsyncDebugger.Send.
+func (p HighlightRectParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandHighlightRect, p)
+}
+
+// Do sends Overlay.highlightRect to d and waits for it to complete or for ctx to
+// be done.
+func (p HighlightRectParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandHighlightRect, p, nil)
+}
+
+// HighlightSourceOrderParams are the parameters of
+// Overlay.highlightSourceOrder.
+//
+// Highlights the source order of the children of the DOM node with given id or
+// with the given JavaScript object wrapper. Either nodeId or objectId must be
+// specified.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Overlay#method-highlightSourceOrder
+type HighlightSourceOrderParams struct {
+	// A descriptor for the appearance of the overlay drawing.
+	SourceOrderConfig SourceOrderConfig `json:"sourceOrderConfig"`
+	// Identifier of the node to highlight.
+	NodeID types.DOMNodeID `json:"nodeId,omitempty"`
+	// Identifier of the backend node to highlight.
+	BackendNodeID types.DOMBackendNodeID `json:"backendNodeId,omitempty"`
+	// JavaScript object id of the node to be highlighted.
+	ObjectID types.RuntimeRemoteObjectID `json:"objectId,omitempty"`
+}
+
+// Command returns the Overlay.highlightSourceOrder command for use with
+// AsyncDebugger.Send.
+func (p HighlightSourceOrderParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandHighlightSourceOrder, p)
+}
+
+// Do sends Overlay.highlightSourceOrder to d and waits for it to complete or for ctx to
+// be done.
+func (p HighlightSourceOrderParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandHighlightSourceOrder, p, nil)
+}
+
+// SetInspectModeParams are the parameters of Overlay.setInspectMode.
+//
+// Enters the 'inspect' mode. In this mode, elements that user is hovering over
+// are highlighted. Backend then generates 'inspectNodeRequested' event upon
+// element selection.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Overlay#method-setInspectMode
+type SetInspectModeParams struct {
+	// Set an inspection mode.
+	Mode InspectMode `json:"mode"`
+	// A descriptor for the highlight appearance of hovered-over nodes. May be
+	// omitted if `enabled == false`.
+	HighlightConfig *HighlightConfig `json:"highlightConfig,omitempty"`
+}
+
+// Command returns the Overlay.setInspectMode command for use with
+// AsyncDebugger.Send.
+func (p SetInspectModeParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetInspectMode, p)
+}
+
+// Do sends Overlay.setInspectMode to d and waits for it to complete or for ctx to
+// be done.
+func (p SetInspectModeParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetInspectMode, p, nil)
+}
+
+// SetShowAdHighlightsParams are the parameters of Overlay.setShowAdHighlights.
+//
+// Highlights owner element of all frames detected to be ads.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Overlay#method-setShowAdHighlights
+type SetShowAdHighlightsParams struct {
+	// True for showing ad highlights
+	Show bool `json:"show"`
+}
+
+// Command returns the Overlay.setShowAdHighlights command for use with
+// AsyncDebugger.Send.
+func (p SetShowAdHighlightsParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetShowAdHighlights, p)
+}
+
+// Do sends Overlay.setShowAdHighlights to d and waits for it to complete or for ctx to
+// be done.
+func (p SetShowAdHighlightsParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetShowAdHighlights, p, nil)
+}
+
+// SetPausedInDebuggerMessageParams are the parameters of
+// Overlay.setPausedInDebuggerMessage.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Overlay#method-setPausedInDebuggerMessage
+type SetPausedInDebuggerMessageParams struct {
+	// The message to display, also triggers resume and step over controls.
+	Message string `json:"message,omitempty"`
+}
+
+// Command returns the Overlay.setPausedInDebuggerMessage command for use with
+// AsyncDebugger.Send.
+func (p SetPausedInDebuggerMessageParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetPausedInDebuggerMessage, p)
+}
+
+// Do sends Overlay.setPausedInDebuggerMessage to d and waits for it to complete or for ctx to
+// be done.
+func (p SetPausedInDebuggerMessageParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetPausedInDebuggerMessage, p, nil)
+}
+
+// SetShowDebugBordersParams are the parameters of Overlay.setShowDebugBorders.
+//
+// # Requests that backend shows debug borders on layers
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Overlay#method-setShowDebugBorders
+type SetShowDebugBordersParams struct {
+	// True for showing debug borders
+	Show bool `json:"show"`
+}
+
+// Command returns the Overlay.setShowDebugBorders command for use with
+// AsyncDebugger.Send.
+func (p SetShowDebugBordersParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetShowDebugBorders, p)
+}
+
+// Do sends Overlay.setShowDebugBorders to d and waits for it to complete or for ctx to
+// be done.
+func (p SetShowDebugBordersParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetShowDebugBorders, p, nil)
+}
+
+// SetShowFPSCounterParams are the parameters of Overlay.setShowFPSCounter.
+//
+// # Requests that backend shows the FPS counter
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Overlay#method-setShowFPSCounter
+type SetShowFPSCounterParams struct {
+	// True for showing the FPS counter
+	Show bool `json:"show"`
+}
+
+// Command returns the Overlay.setShowFPSCounter command for use with
+// AsyncDebugger.Send.
+func (p SetShowFPSCounterParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetShowFPSCounter, p)
+}
+
+// Do sends Overlay.setShowFPSCounter to d and waits for it to complete or for ctx to
+// be done.
+func (p SetShowFPSCounterParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetShowFPSCounter, p, nil)
+}
+
+// SetShowGridOverlaysParams are the parameters of Overlay.setShowGridOverlays.
+//
+// Highlight multiple elements with the CSS Grid overlay.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Overlay#method-setShowGridOverlays
+type SetShowGridOverlaysParams struct {
+	// An array of node identifiers and descriptors for the highlight appearance.
+	GridNodeHighlightConfigs []GridNodeHighlightConfig `json:"gridNodeHighlightConfigs"`
+}
+
+// Command returns the Overlay.setShowGridOverlays command for use with
+// AsyncDebugger.Send.
+func (p SetShowGridOverlaysParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetShowGridOverlays, p)
+}
+
+// Do sends Overlay.setShowGridOverlays to d and waits for it to complete or for ctx to
+// be done.
+func (p SetShowGridOverlaysParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetShowGridOverlays, p, nil)
+}
+
+// SetShowFlexOverlaysParams are the parameters of Overlay.setShowFlexOverlays.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Overlay#method-setShowFlexOverlays
+type SetShowFlexOverlaysParams struct {
+	// An array of node identifiers and descriptors for the highlight appearance.
+	FlexNodeHighlightConfigs []FlexNodeHighlightConfig `json:"flexNodeHighlightConfigs"`
+}
+
+// Command returns the Overlay.setShowFlexOverlays command for use with
+// AsyncDebugger.Send.
+func (p SetShowFlexOverlaysParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetShowFlexOverlays, p)
+}
+
+// Do sends Overlay.setShowFlexOverlays to d and waits for it to complete or for ctx to
+// be done.
+func (p SetShowFlexOverlaysParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetShowFlexOverlays, p, nil)
+}
+
+// SetShowScrollSnapOverlaysParams are the parameters of
+// Overlay.setShowScrollSnapOverlays.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Overlay#method-setShowScrollSnapOverlays
+type SetShowScrollSnapOverlaysParams struct {
+	// An array of node identifiers and descriptors for the highlight appearance.
+	ScrollSnapHighlightConfigs []ScrollSnapHighlightConfig `json:"scrollSnapHighlightConfigs"`
+}
+
+// Command returns the Overlay.setShowScrollSnapOverlays command for use with
+// AsyncDebugger.Send.
+func (p SetShowScrollSnapOverlaysParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetShowScrollSnapOverlays, p)
+}
+
+// Do sends Overlay.setShowScrollSnapOverlays to d and waits for it to complete or for ctx to
+// be done.
+func (p SetShowScrollSnapOverlaysParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetShowScrollSnapOverlays, p, nil)
+}
+
+// SetShowContainerQueryOverlaysParams are the parameters of
+// Overlay.setShowContainerQueryOverlays.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Overlay#method-setShowContainerQueryOverlays
+type SetShowContainerQueryOverlaysParams struct {
+	// An array of node identifiers and descriptors for the highlight appearance.
+	ContainerQueryHighlightConfigs []ContainerQueryHighlightConfig `json:"containerQueryHighlightConfigs"`
+}
+
+// Command returns the Overlay.setShowContainerQueryOverlays command for use
+// with AsyncDebugger.Send.
+func (p SetShowContainerQueryOverlaysParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetShowContainerQueryOverlays, p)
+}
+
+// Do sends Overlay.setShowContainerQueryOverlays to d and waits for it to complete or for ctx to
+// be done.
+func (p SetShowContainerQueryOverlaysParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetShowContainerQueryOverlays, p, nil)
+}
+
+// SetShowPaintRectsParams are the parameters of Overlay.setShowPaintRects.
+//
+// # Requests that backend shows paint rectangles
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Overlay#method-setShowPaintRects
+type SetShowPaintRectsParams struct {
+	// True for showing paint rectangles
+	Result bool `json:"result"`
+}
+
+// Command returns the Overlay.setShowPaintRects command for use with
+// AsyncDebugger.Send.
+func (p SetShowPaintRectsParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetShowPaintRects, p)
+}
+
+// Do sends Overlay.setShowPaintRects to d and waits for it to complete or for ctx to
+// be done.
+func (p SetShowPaintRectsParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetShowPaintRects, p, nil)
+}
+
+// SetShowLayoutShiftRegionsParams are the parameters of
+// Overlay.setShowLayoutShiftRegions.
+//
+// # Requests that backend shows layout shift regions
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Overlay#method-setShowLayoutShiftRegions
+type SetShowLayoutShiftRegionsParams struct {
+	// True for showing layout shift regions
+	Result bool `json:"result"`
+}
+
+// Command returns the Overlay.setShowLayoutShiftRegions command for use with
+// AsyncDebugger.Send.
+func (p SetShowLayoutShiftRegionsParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetShowLayoutShiftRegions, p)
+}
+
+// Do sends Overlay.setShowLayoutShiftRegions to d and waits for it to complete or for ctx to
+// be done.
+func (p SetShowLayoutShiftRegionsParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetShowLayoutShiftRegions, p, nil)
+}
+
+// SetShowScrollBottleneckRectsParams are the parameters of
+// Overlay.setShowScrollBottleneckRects.
+//
+// # Requests that backend shows scroll bottleneck rects
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Overlay#method-setShowScrollBottleneckRects
+type SetShowScrollBottleneckRectsParams struct {
+	// True for showing scroll bottleneck rects
+	Show bool `json:"show"`
+}
+
+// Command returns the Overlay.setShowScrollBottleneckRects command for use with
+// AsyncDebugger.Send.
+func (p SetShowScrollBottleneckRectsParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetShowScrollBottleneckRects, p)
+}
+
+// Do sends Overlay.setShowScrollBottleneckRects to d and waits for it to complete or for ctx to
+// be done.
+func (p SetShowScrollBottleneckRectsParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetShowScrollBottleneckRects, p, nil)
+}
+
+// SetShowHitTestBordersParams are the parameters of
+// Overlay.setShowHitTestBorders.
+//
+// Deprecated, no longer has any effect.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Overlay#method-setShowHitTestBorders
+//
+// Deprecated: this is deprecated in the protocol.
+type SetShowHitTestBordersParams struct {
+	// True for showing hit-test borders
+	Show bool `json:"show"`
+}
+
+// Command returns the Overlay.setShowHitTestBorders command for use with
+// AsyncDebugger.Send.
+func (p SetShowHitTestBordersParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetShowHitTestBorders, p)
+}
+
+// Do sends Overlay.setShowHitTestBorders to d and waits for it to complete or for ctx to
+// be done.
+func (p SetShowHitTestBordersParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetShowHitTestBorders, p, nil)
+}
+
+// SetShowWebVitalsParams are the parameters of Overlay.setShowWebVitals.
+//
+// Deprecated, no longer has any effect.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Overlay#method-setShowWebVitals
+//
+// Deprecated: this is deprecated in the protocol.
+type SetShowWebVitalsParams struct {
+	Show bool `json:"show"`
+}
+
+// Command returns the Overlay.setShowWebVitals command for use with
+// AsyncDebugger.Send.
+func (p SetShowWebVitalsParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetShowWebVitals, p)
+}
+
+// Do sends Overlay.setShowWebVitals to d and waits for it to complete or for ctx to
+// be done.
+func (p SetShowWebVitalsParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetShowWebVitals, p, nil)
+}
+
+// SetShowViewportSizeOnResizeParams are the parameters of
+// Overlay.setShowViewportSizeOnResize.
+//
+// Paints viewport size upon main frame resize.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Overlay#method-setShowViewportSizeOnResize
+type SetShowViewportSizeOnResizeParams struct {
+	// Whether to paint size or not.
+	Show bool `json:"show"`
+}
+
+// Command returns the Overlay.setShowViewportSizeOnResize command for use with
+// AsyncDebugger.Send.
+func (p SetShowViewportSizeOnResizeParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetShowViewportSizeOnResize, p)
+}
+
+// Do sends Overlay.setShowViewportSizeOnResize to d and waits for it to complete or for ctx to
+// be done.
+func (p SetShowViewportSizeOnResizeParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetShowViewportSizeOnResize, p, nil)
+}
+
+// SetShowHingeParams are the parameters of Overlay.setShowHinge.
+//
+// # Add a dual screen device hinge
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Overlay#method-setShowHinge
+type SetShowHingeParams struct {
+	// hinge data, null means hideHinge
+	HingeConfig *HingeConfig `json:"hingeConfig,omitempty"`
+}
+
+// Command returns the Overlay.setShowHinge command for use with
+// AsyncDebugger.Send.
+func (p SetShowHingeParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetShowHinge, p)
+}
+
+// Do sends Overlay.setShowHinge to d and waits for it to complete or for ctx to
+// be done.
+func (p SetShowHingeParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetShowHinge, p, nil)
+}
+
+// SetShowIsolatedElementsParams are the parameters of
+// Overlay.setShowIsolatedElements.
+//
+// Show elements in isolation mode with overlays.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Overlay#method-setShowIsolatedElements
+type SetShowIsolatedElementsParams struct {
+	// An array of node identifiers and descriptors for the highlight appearance.
+	IsolatedElementHighlightConfigs []IsolatedElementHighlightConfig `json:"isolatedElementHighlightConfigs"`
+}
+
+// Command returns the Overlay.setShowIsolatedElements command for use with
+// AsyncDebugger.Send.
+func (p SetShowIsolatedElementsParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetShowIsolatedElements, p)
+}
+
+// Do sends Overlay.setShowIsolatedElements to d and waits for it to complete or for ctx to
+// be done.
+func (p SetShowIsolatedElementsParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetShowIsolatedElements, p, nil)
+}
+
+// SetShowWindowControlsOverlayParams are the parameters of
+// Overlay.setShowWindowControlsOverlay.
+//
+// # Show Window Controls Overlay for PWA
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Overlay#method-setShowWindowControlsOverlay
+type SetShowWindowControlsOverlayParams struct {
+	// Window Controls Overlay data, null means hide Window Controls Overlay
+	WindowControlsOverlayConfig *WindowControlsOverlayConfig `json:"windowControlsOverlayConfig,omitempty"`
+}
+
+// Command returns the Overlay.setShowWindowControlsOverlay command for use with
+// AsyncDebugger.Send.
+func (p SetShowWindowControlsOverlayParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetShowWindowControlsOverlay, p)
+}
+
+// Do sends Overlay.setShowWindowControlsOverlay to d and waits for it to complete or for ctx to
+// be done.
+func (p SetShowWindowControlsOverlayParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetShowWindowControlsOverlay, p, nil)
+}
+
+// Method names of the events in the Overlay domain.
+const (
+	EventInspectNodeRequested   = "Overlay.inspectNodeRequested"
+	EventNodeHighlightRequested = "Overlay.nodeHighlightRequested"
+	EventScreenshotRequested    = "Overlay.screenshotRequested"
+	EventInspectModeCanceled    = "Overlay.inspectModeCanceled"
+)
+
+// InspectNodeRequestedEvent is the payload of the Overlay.inspectNodeRequested
+// event.
+//
+// Fired when the node should be inspected. This happens after call to
+// `setInspectMode` or when user manually inspects an element.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Overlay#event-inspectNodeRequested
+type InspectNodeRequestedEvent struct {
+	// Id of the node to inspect.
+	BackendNodeID types.DOMBackendNodeID `json:"backendNodeId"`
+}
+
+// NodeHighlightRequestedEvent is the payload of the
+// Overlay.nodeHighlightRequested event.
+//
+// Fired when the node should be highlighted. This happens after call to
+// `setInspectMode`.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Overlay#event-nodeHighlightRequested
+type NodeHighlightRequestedEvent struct {
+	NodeID types.DOMNodeID `json:"nodeId"`
+}
+
+// ScreenshotRequestedEvent is the payload of the Overlay.screenshotRequested
+// event.
+//
+// Fired when user asks to capture screenshot of some area on the page.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Overlay#event-screenshotRequested
+type ScreenshotRequestedEvent struct {
+	// Viewport to capture, in device independent pixels (dip).
+	Viewport types.PageViewport `json:"viewport"`
+}
+
+// InspectModeCanceledEvent is the payload of the Overlay.inspectModeCanceled
+// event.
+//
+// Fired when user cancels the inspect mode.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Overlay#event-inspectModeCanceled
+type InspectModeCanceledEvent struct {
+}
+
+// ParseEvent decodes an event from the Overlay domain, as received from
+// Subscribe, into a pointer to its typed payload.
+func ParseEvent(cmd chromedebugo.Command) (interface{}, error) {
+	var ev interface{}
+	switch cmd.Method {
+	case EventInspectNodeRequested:
+		ev = &InspectNodeRequestedEvent{}
+	case EventNodeHighlightRequested:
+		ev = &NodeHighlightRequestedEvent{}
+	case EventScreenshotRequested:
+		ev = &ScreenshotRequestedEvent{}
+	case EventInspectModeCanceled:
+		ev = &InspectModeCanceledEvent{}
+	default:
+		return nil, fmt.Errorf("unknown Overlay event: %s", cmd.Method)
+	}
+	if err := cmd.Decode(ev); err != nil {
+		return nil, err
+	}
+	return ev, nil
+}
@@ -0,0 +1,3260 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+// Package page provides typed bindings for the Page domain of the Chrome
+// DevTools protocol.
+//
+// Actions and events related to the inspected page belong to the page domain.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page
+package page
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tonyhb/chromedebugo"
+	"github.com/tonyhb/chromedebugo/protocol"
+	"github.com/tonyhb/chromedebugo/protocol/internal/types"
+)
+
+// FrameID unique frame identifier.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#type-FrameId
+type FrameID = types.PageFrameID
+
+// AdFrameType indicates whether a frame has been identified as an ad.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#type-AdFrameType
+//
+// This is an experimental part of the protocol.
+type AdFrameType = types.PageAdFrameType
+
+// AdFrameExplanation is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#type-AdFrameExplanation
+//
+// This is an experimental part of the protocol.
+type AdFrameExplanation = types.PageAdFrameExplanation
+
+// AdFrameStatus indicates whether a frame has been identified as an ad and why.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#type-AdFrameStatus
+//
+// This is an experimental part of the protocol.
+type AdFrameStatus = types.PageAdFrameStatus
+
+// AdScriptID identifies the script which caused a script or frame to be
+// labelled as an ad.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#type-AdScriptId
+//
+// This is an experimental part of the protocol.
+type AdScriptID = types.PageAdScriptID
+
+// AdScriptAncestry encapsulates the script ancestry and the root script
+// filterlist rule that caused the frame to be labelled as an ad. Only created
+// when `ancestryChain` is not empty.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#type-AdScriptAncestry
+//
+// This is an experimental part of the protocol.
+type AdScriptAncestry = types.PageAdScriptAncestry
+
+// SecureContextType indicates whether the frame is a secure context and why it
+// is the case.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#type-SecureContextType
+//
+// This is an experimental part of the protocol.
+type SecureContextType = types.PageSecureContextType
+
+// CrossOriginIsolatedContextType indicates whether the frame is cross-origin
+// isolated and why it is the case.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#type-CrossOriginIsolatedContextType
+//
+// This is an experimental part of the protocol.
+type CrossOriginIsolatedContextType = types.PageCrossOriginIsolatedContextType
+
+// GatedAPIFeatures is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#type-GatedAPIFeatures
+//
+// This is an experimental part of the protocol.
+type GatedAPIFeatures = types.PageGatedAPIFeatures
+
+// PermissionsPolicyFeature all Permissions Policy features. This enum should
+// match the one defined in
+// services/network/public/cpp/permissions_policy/permissions_policy_features.json5.
+// LINT.IfChange(PermissionsPolicyFeature)
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#type-PermissionsPolicyFeature
+//
+// This is an experimental part of the protocol.
+type PermissionsPolicyFeature = types.PagePermissionsPolicyFeature
+
+// PermissionsPolicyBlockReason reason for a permissions policy feature to be
+// disabled.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#type-PermissionsPolicyBlockReason
+//
+// This is an experimental part of the protocol.
+type PermissionsPolicyBlockReason = types.PagePermissionsPolicyBlockReason
+
+// PermissionsPolicyBlockLocator is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#type-PermissionsPolicyBlockLocator
+//
+// This is an experimental part of the protocol.
+type PermissionsPolicyBlockLocator = types.PagePermissionsPolicyBlockLocator
+
+// PermissionsPolicyFeatureState is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#type-PermissionsPolicyFeatureState
+//
+// This is an experimental part of the protocol.
+type PermissionsPolicyFeatureState = types.PagePermissionsPolicyFeatureState
+
+// OriginTrialTokenStatus origin
+// Trial(https://www.chromium.org/blink/origin-trials) support. Status for an
+// Origin Trial token.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#type-OriginTrialTokenStatus
+//
+// This is an experimental part of the protocol.
+type OriginTrialTokenStatus = types.PageOriginTrialTokenStatus
+
+// OriginTrialStatus status for an Origin Trial.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#type-OriginTrialStatus
+//
+// This is an experimental part of the protocol.
+type OriginTrialStatus = types.PageOriginTrialStatus
+
+// OriginTrialUsageRestriction is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#type-OriginTrialUsageRestriction
+//
+// This is an experimental part of the protocol.
+type OriginTrialUsageRestriction = types.PageOriginTrialUsageRestriction
+
+// OriginTrialToken is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#type-OriginTrialToken
+//
+// This is an experimental part of the protocol.
+type OriginTrialToken = types.PageOriginTrialToken
+
+// OriginTrialTokenWithStatus is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#type-OriginTrialTokenWithStatus
+//
+// This is an experimental part of the protocol.
+type OriginTrialTokenWithStatus = types.PageOriginTrialTokenWithStatus
+
+// OriginTrial is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#type-OriginTrial
+//
+// This is an experimental part of the protocol.
+type OriginTrial = types.PageOriginTrial
+
+// SecurityOriginDetails additional information about the frame document's
+// security origin.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#type-SecurityOriginDetails
+//
+// This is an experimental part of the protocol.
+type SecurityOriginDetails = types.PageSecurityOriginDetails
+
+// Frame information about the Frame on the page.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#type-Frame
+type Frame = types.PageFrame
+
+// FrameResource information about the Resource on the page.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#type-FrameResource
+//
+// This is an experimental part of the protocol.
+type FrameResource = types.PageFrameResource
+
+// FrameResourceTree information about the Frame hierarchy along with their
+// cached resources.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#type-FrameResourceTree
+//
+// This is an experimental part of the protocol.
+type FrameResourceTree = types.PageFrameResourceTree
+
+// FrameTree information about the Frame hierarchy.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#type-FrameTree
+type FrameTree = types.PageFrameTree
+
+// ScriptIdentifier unique script identifier.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#type-ScriptIdentifier
+type ScriptIdentifier = types.PageScriptIdentifier
+
+// TransitionType transition type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#type-TransitionType
+type TransitionType = types.PageTransitionType
+
+// NavigationEntry navigation history entry.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#type-NavigationEntry
+type NavigationEntry = types.PageNavigationEntry
+
+// ScreencastFrameMetadata screencast frame metadata.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#type-ScreencastFrameMetadata
+//
+// This is an experimental part of the protocol.
+type ScreencastFrameMetadata = types.PageScreencastFrameMetadata
+
+// DialogType javascript dialog type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#type-DialogType
+type DialogType = types.PageDialogType
+
+// AppManifestError error while paring app manifest.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#type-AppManifestError
+type AppManifestError = types.PageAppManifestError
+
+// AppManifestParsedProperties parsed app manifest properties.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#type-AppManifestParsedProperties
+//
+// This is an experimental part of the protocol.
+type AppManifestParsedProperties = types.PageAppManifestParsedProperties
+
+// LayoutViewport layout viewport position and dimensions.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#type-LayoutViewport
+type LayoutViewport = types.PageLayoutViewport
+
+// VisualViewport visual viewport position, dimensions, and scale.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#type-VisualViewport
+type VisualViewport = types.PageVisualViewport
+
+// Viewport viewport for capturing screenshot.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#type-Viewport
+type Viewport = types.PageViewport
+
+// FontFamilies generic font families collection.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#type-FontFamilies
+//
+// This is an experimental part of the protocol.
+type FontFamilies = types.PageFontFamilies
+
+// ScriptFontFamilies font families collection for a script.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#type-ScriptFontFamilies
+//
+// This is an experimental part of the protocol.
+type ScriptFontFamilies = types.PageScriptFontFamilies
+
+// FontSizes default font sizes.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#type-FontSizes
+//
+// This is an experimental part of the protocol.
+type FontSizes = types.PageFontSizes
+
+// ClientNavigationReason is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#type-ClientNavigationReason
+//
+// This is an experimental part of the protocol.
+type ClientNavigationReason = types.PageClientNavigationReason
+
+// ClientNavigationDisposition is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#type-ClientNavigationDisposition
+//
+// This is an experimental part of the protocol.
+type ClientNavigationDisposition = types.PageClientNavigationDisposition
+
+// InstallabilityErrorArgument is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#type-InstallabilityErrorArgument
+//
+// This is an experimental part of the protocol.
+type InstallabilityErrorArgument = types.PageInstallabilityErrorArgument
+
+// InstallabilityError the installability error
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#type-InstallabilityError
+//
+// This is an experimental part of the protocol.
+type InstallabilityError = types.PageInstallabilityError
+
+// ReferrerPolicy the referring-policy used for the navigation.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#type-ReferrerPolicy
+//
+// This is an experimental part of the protocol.
+type ReferrerPolicy = types.PageReferrerPolicy
+
+// CompilationCacheParams per-script compilation cache parameters for
+// `Page.produceCompilationCache`
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#type-CompilationCacheParams
+//
+// This is an experimental part of the protocol.
+type CompilationCacheParams = types.PageCompilationCacheParams
+
+// FileFilter is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#type-FileFilter
+//
+// This is an experimental part of the protocol.
+type FileFilter = types.PageFileFilter
+
+// FileHandler is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#type-FileHandler
+//
+// This is an experimental part of the protocol.
+type FileHandler = types.PageFileHandler
+
+// ImageResource the image definition used in both icon and screenshot.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#type-ImageResource
+//
+// This is an experimental part of the protocol.
+type ImageResource = types.PageImageResource
+
+// LaunchHandler is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#type-LaunchHandler
+//
+// This is an experimental part of the protocol.
+type LaunchHandler = types.PageLaunchHandler
+
+// ProtocolHandler is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#type-ProtocolHandler
+//
+// This is an experimental part of the protocol.
+type ProtocolHandler = types.PageProtocolHandler
+
+// RelatedApplication is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#type-RelatedApplication
+//
+// This is an experimental part of the protocol.
+type RelatedApplication = types.PageRelatedApplication
+
+// ScopeExtension is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#type-ScopeExtension
+//
+// This is an experimental part of the protocol.
+type ScopeExtension = types.PageScopeExtension
+
+// Screenshot is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#type-Screenshot
+//
+// This is an experimental part of the protocol.
+type Screenshot = types.PageScreenshot
+
+// ShareTarget is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#type-ShareTarget
+//
+// This is an experimental part of the protocol.
+type ShareTarget = types.PageShareTarget
+
+// Shortcut is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#type-Shortcut
+//
+// This is an experimental part of the protocol.
+type Shortcut = types.PageShortcut
+
+// WebAppManifest is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#type-WebAppManifest
+//
+// This is an experimental part of the protocol.
+type WebAppManifest = types.PageWebAppManifest
+
+// NavigationType the type of a frameNavigated event.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#type-NavigationType
+//
+// This is an experimental part of the protocol.
+type NavigationType = types.PageNavigationType
+
+// BackForwardCacheNotRestoredReason list of not restored reasons for
+// back-forward cache.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#type-BackForwardCacheNotRestoredReason
+//
+// This is an experimental part of the protocol.
+type BackForwardCacheNotRestoredReason = types.PageBackForwardCacheNotRestoredReason
+
+// BackForwardCacheNotRestoredReasonType types of not restored reasons for
+// back-forward cache.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#type-BackForwardCacheNotRestoredReasonType
+//
+// This is an experimental part of the protocol.
+type BackForwardCacheNotRestoredReasonType = types.PageBackForwardCacheNotRestoredReasonType
+
+// BackForwardCacheBlockingDetails is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#type-BackForwardCacheBlockingDetails
+//
+// This is an experimental part of the protocol.
+type BackForwardCacheBlockingDetails = types.PageBackForwardCacheBlockingDetails
+
+// BackForwardCacheNotRestoredExplanation is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#type-BackForwardCacheNotRestoredExplanation
+//
+// This is an experimental part of the protocol.
+type BackForwardCacheNotRestoredExplanation = types.PageBackForwardCacheNotRestoredExplanation
+
+// BackForwardCacheNotRestoredExplanationTree is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#type-BackForwardCacheNotRestoredExplanationTree
+//
+// This is an experimental part of the protocol.
+type BackForwardCacheNotRestoredExplanationTree = types.PageBackForwardCacheNotRestoredExplanationTree
+
+// Values of the enumerated types in the Page domain.
+const (
+	AdFrameTypeNone                                                                           = types.PageAdFrameTypeNone
+	AdFrameTypeChild                                                                          = types.PageAdFrameTypeChild
+	AdFrameTypeRoot                                                                           = types.PageAdFrameTypeRoot
+	AdFrameExplanationParentIsAd                                                              = types.PageAdFrameExplanationParentIsAd
+	AdFrameExplanationCreatedByAdScript                                                       = types.PageAdFrameExplanationCreatedByAdScript
+	AdFrameExplanationMatchedBlockingRule                                                     = types.PageAdFrameExplanationMatchedBlockingRule
+	SecureContextTypeSecure                                                                   = types.PageSecureContextTypeSecure
+	SecureContextTypeSecureLocalhost                                                          = types.PageSecureContextTypeSecureLocalhost
+	SecureContextTypeInsecureScheme                                                           = types.PageSecureContextTypeInsecureScheme
+	SecureContextTypeInsecureAncestor                                                         = types.PageSecureContextTypeInsecureAncestor
+	CrossOriginIsolatedContextTypeIsolated                                                    = types.PageCrossOriginIsolatedContextTypeIsolated
+	CrossOriginIsolatedContextTypeNotIsolated                                                 = types.PageCrossOriginIsolatedContextTypeNotIsolated
+	CrossOriginIsolatedContextTypeNotIsolatedFeatureDisabled                                  = types.PageCrossOriginIsolatedContextTypeNotIsolatedFeatureDisabled
+	GatedAPIFeaturesSharedArrayBuffers                                                        = types.PageGatedAPIFeaturesSharedArrayBuffers
+	GatedAPIFeaturesSharedArrayBuffersTransferAllowed                                         = types.PageGatedAPIFeaturesSharedArrayBuffersTransferAllowed
+	GatedAPIFeaturesPerformanceMeasureMemory                                                  = types.PageGatedAPIFeaturesPerformanceMeasureMemory
+	GatedAPIFeaturesPerformanceProfile                                                        = types.PageGatedAPIFeaturesPerformanceProfile
+	PermissionsPolicyFeatureAccelerometer                                                     = types.PagePermissionsPolicyFeatureAccelerometer
+	PermissionsPolicyFeatureAllScreensCapture                                                 = types.PagePermissionsPolicyFeatureAllScreensCapture
+	PermissionsPolicyFeatureAmbientLightSensor                                                = types.PagePermissionsPolicyFeatureAmbientLightSensor
+	PermissionsPolicyFeatureAriaNotify                                                        = types.PagePermissionsPolicyFeatureAriaNotify
+	PermissionsPolicyFeatureAttributionReporting                                              = types.PagePermissionsPolicyFeatureAttributionReporting
+	PermissionsPolicyFeatureAutoplay                                                          = types.PagePermissionsPolicyFeatureAutoplay
+	PermissionsPolicyFeatureBluetooth                                                         = types.PagePermissionsPolicyFeatureBluetooth
+	PermissionsPolicyFeatureBrowsingTopics                                                    = types.PagePermissionsPolicyFeatureBrowsingTopics
+	PermissionsPolicyFeatureCamera                                                            = types.PagePermissionsPolicyFeatureCamera
+	PermissionsPolicyFeatureCapturedSurfaceControl                                            = types.PagePermissionsPolicyFeatureCapturedSurfaceControl
+	PermissionsPolicyFeatureChDpr                                                             = types.PagePermissionsPolicyFeatureChDpr
+	PermissionsPolicyFeatureChDeviceMemory                                                    = types.PagePermissionsPolicyFeatureChDeviceMemory
+	PermissionsPolicyFeatureChDownlink                                                        = types.PagePermissionsPolicyFeatureChDownlink
+	PermissionsPolicyFeatureChEct                                                             = types.PagePermissionsPolicyFeatureChEct
+	PermissionsPolicyFeatureChPrefersColorScheme                                              = types.PagePermissionsPolicyFeatureChPrefersColorScheme
+	PermissionsPolicyFeatureChPrefersReducedMotion                                            = types.PagePermissionsPolicyFeatureChPrefersReducedMotion
+	PermissionsPolicyFeatureChPrefersReducedTransparency                                      = types.PagePermissionsPolicyFeatureChPrefersReducedTransparency
+	PermissionsPolicyFeatureChRtt                                                             = types.PagePermissionsPolicyFeatureChRtt
+	PermissionsPolicyFeatureChSaveData                                                        = types.PagePermissionsPolicyFeatureChSaveData
+	PermissionsPolicyFeatureChUa                                                              = types.PagePermissionsPolicyFeatureChUa
+	PermissionsPolicyFeatureChUaArch                                                          = types.PagePermissionsPolicyFeatureChUaArch
+	PermissionsPolicyFeatureChUaBitness                                                       = types.PagePermissionsPolicyFeatureChUaBitness
+	PermissionsPolicyFeatureChUaHighEntropyValues                                             = types.PagePermissionsPolicyFeatureChUaHighEntropyValues
+	PermissionsPolicyFeatureChUaPlatform                                                      = types.PagePermissionsPolicyFeatureChUaPlatform
+	PermissionsPolicyFeatureChUaModel                                                         = types.PagePermissionsPolicyFeatureChUaModel
+	PermissionsPolicyFeatureChUaMobile                                                        = types.PagePermissionsPolicyFeatureChUaMobile
+	PermissionsPolicyFeatureChUaFormFactors                                                   = types.PagePermissionsPolicyFeatureChUaFormFactors
+	PermissionsPolicyFeatureChUaFullVersion                                                   = types.PagePermissionsPolicyFeatureChUaFullVersion
+	PermissionsPolicyFeatureChUaFullVersionList                                               = types.PagePermissionsPolicyFeatureChUaFullVersionList
+	PermissionsPolicyFeatureChUaPlatformVersion                                               = types.PagePermissionsPolicyFeatureChUaPlatformVersion
+	PermissionsPolicyFeatureChUaWow64                                                         = types.PagePermissionsPolicyFeatureChUaWow64
+	PermissionsPolicyFeatureChViewportHeight                                                  = types.PagePermissionsPolicyFeatureChViewportHeight
+	PermissionsPolicyFeatureChViewportWidth                                                   = types.PagePermissionsPolicyFeatureChViewportWidth
+	PermissionsPolicyFeatureChWidth                                                           = types.PagePermissionsPolicyFeatureChWidth
+	PermissionsPolicyFeatureClipboardRead                                                     = types.PagePermissionsPolicyFeatureClipboardRead
+	PermissionsPolicyFeatureClipboardWrite                                                    = types.PagePermissionsPolicyFeatureClipboardWrite
+	PermissionsPolicyFeatureComputePressure                                                   = types.PagePermissionsPolicyFeatureComputePressure
+	PermissionsPolicyFeatureControlledFrame                                                   = types.PagePermissionsPolicyFeatureControlledFrame
+	PermissionsPolicyFeatureCrossOriginIsolated                                               = types.PagePermissionsPolicyFeatureCrossOriginIsolated
+	PermissionsPolicyFeatureDeferredFetch                                                     = types.PagePermissionsPolicyFeatureDeferredFetch
+	PermissionsPolicyFeatureDeferredFetchMinimal                                              = types.PagePermissionsPolicyFeatureDeferredFetchMinimal
+	PermissionsPolicyFeatureDeviceAttributes                                                  = types.PagePermissionsPolicyFeatureDeviceAttributes
+	PermissionsPolicyFeatureDigitalCredentialsGet                                             = types.PagePermissionsPolicyFeatureDigitalCredentialsGet
+	PermissionsPolicyFeatureDirectSockets                                                     = types.PagePermissionsPolicyFeatureDirectSockets
+	PermissionsPolicyFeatureDirectSocketsPrivate                                              = types.PagePermissionsPolicyFeatureDirectSocketsPrivate
+	PermissionsPolicyFeatureDisplayCapture                                                    = types.PagePermissionsPolicyFeatureDisplayCapture
+	PermissionsPolicyFeatureDocumentDomain                                                    = types.PagePermissionsPolicyFeatureDocumentDomain
+	PermissionsPolicyFeatureEncryptedMedia                                                    = types.PagePermissionsPolicyFeatureEncryptedMedia
+	PermissionsPolicyFeatureExecutionWhileOutOfViewport                                       = types.PagePermissionsPolicyFeatureExecutionWhileOutOfViewport
+	PermissionsPolicyFeatureExecutionWhileNotRendered                                         = types.PagePermissionsPolicyFeatureExecutionWhileNotRendered
+	PermissionsPolicyFeatureFencedUnpartitionedStorageRead                                    = types.PagePermissionsPolicyFeatureFencedUnpartitionedStorageRead
+	PermissionsPolicyFeatureFocusWithoutUserActivation                                        = types.PagePermissionsPolicyFeatureFocusWithoutUserActivation
+	PermissionsPolicyFeatureFullscreen                                                        = types.PagePermissionsPolicyFeatureFullscreen
+	PermissionsPolicyFeatureFrobulate                                                         = types.PagePermissionsPolicyFeatureFrobulate
+	PermissionsPolicyFeatureGamepad                                                           = types.PagePermissionsPolicyFeatureGamepad
+	PermissionsPolicyFeatureGeolocation                                                       = types.PagePermissionsPolicyFeatureGeolocation
+	PermissionsPolicyFeatureGyroscope                                                         = types.PagePermissionsPolicyFeatureGyroscope
+	PermissionsPolicyFeatureHid                                                               = types.PagePermissionsPolicyFeatureHid
+	PermissionsPolicyFeatureIdentityCredentialsGet                                            = types.PagePermissionsPolicyFeatureIdentityCredentialsGet
+	PermissionsPolicyFeatureIdleDetection                                                     = types.PagePermissionsPolicyFeatureIdleDetection
+	PermissionsPolicyFeatureInterestCohort                                                    = types.PagePermissionsPolicyFeatureInterestCohort
+	PermissionsPolicyFeatureJoinAdInterestGroup                                               = types.PagePermissionsPolicyFeatureJoinAdInterestGroup
+	PermissionsPolicyFeatureKeyboardMap                                                       = types.PagePermissionsPolicyFeatureKeyboardMap
+	PermissionsPolicyFeatureLanguageDetector                                                  = types.PagePermissionsPolicyFeatureLanguageDetector
+	PermissionsPolicyFeatureLanguageModel                                                     = types.PagePermissionsPolicyFeatureLanguageModel
+	PermissionsPolicyFeatureLocalFonts                                                        = types.PagePermissionsPolicyFeatureLocalFonts
+	PermissionsPolicyFeatureLocalNetworkAccess                                                = types.PagePermissionsPolicyFeatureLocalNetworkAccess
+	PermissionsPolicyFeatureMagnetometer                                                      = types.PagePermissionsPolicyFeatureMagnetometer
+	PermissionsPolicyFeatureMediaPlaybackWhileNotVisible                                      = types.PagePermissionsPolicyFeatureMediaPlaybackWhileNotVisible
+	PermissionsPolicyFeatureMicrophone                                                        = types.PagePermissionsPolicyFeatureMicrophone
+	PermissionsPolicyFeatureMidi                                                              = types.PagePermissionsPolicyFeatureMidi
+	PermissionsPolicyFeatureOnDeviceSpeechRecognition                                         = types.PagePermissionsPolicyFeatureOnDeviceSpeechRecognition
+	PermissionsPolicyFeatureOtpCredentials                                                    = types.PagePermissionsPolicyFeatureOtpCredentials
+	PermissionsPolicyFeaturePayment                                                           = types.PagePermissionsPolicyFeaturePayment
+	PermissionsPolicyFeaturePictureInPicture                                                  = types.PagePermissionsPolicyFeaturePictureInPicture
+	PermissionsPolicyFeaturePopins                                                            = types.PagePermissionsPolicyFeaturePopins
+	PermissionsPolicyFeaturePrivateAggregation                                                = types.PagePermissionsPolicyFeaturePrivateAggregation
+	PermissionsPolicyFeaturePrivateStateTokenIssuance                                         = types.PagePermissionsPolicyFeaturePrivateStateTokenIssuance
+	PermissionsPolicyFeaturePrivateStateTokenRedemption                                       = types.PagePermissionsPolicyFeaturePrivateStateTokenRedemption
+	PermissionsPolicyFeaturePublickeyCredentialsCreate                                        = types.PagePermissionsPolicyFeaturePublickeyCredentialsCreate
+	PermissionsPolicyFeaturePublickeyCredentialsGet                                           = types.PagePermissionsPolicyFeaturePublickeyCredentialsGet
+	PermissionsPolicyFeatureRecordAdAuctionEvents                                             = types.PagePermissionsPolicyFeatureRecordAdAuctionEvents
+	PermissionsPolicyFeatureRewriter                                                          = types.PagePermissionsPolicyFeatureRewriter
+	PermissionsPolicyFeatureRunAdAuction                                                      = types.PagePermissionsPolicyFeatureRunAdAuction
+	PermissionsPolicyFeatureScreenWakeLock                                                    = types.PagePermissionsPolicyFeatureScreenWakeLock
+	PermissionsPolicyFeatureSerial                                                            = types.PagePermissionsPolicyFeatureSerial
+	PermissionsPolicyFeatureSharedAutofill                                                    = types.PagePermissionsPolicyFeatureSharedAutofill
+	PermissionsPolicyFeatureSharedStorage                                                     = types.PagePermissionsPolicyFeatureSharedStorage
+	PermissionsPolicyFeatureSharedStorageSelectURL                                            = types.PagePermissionsPolicyFeatureSharedStorageSelectURL
+	PermissionsPolicyFeatureSmartCard                                                         = types.PagePermissionsPolicyFeatureSmartCard
+	PermissionsPolicyFeatureSpeakerSelection                                                  = types.PagePermissionsPolicyFeatureSpeakerSelection
+	PermissionsPolicyFeatureStorageAccess                                                     = types.PagePermissionsPolicyFeatureStorageAccess
+	PermissionsPolicyFeatureSubApps                                                           = types.PagePermissionsPolicyFeatureSubApps
+	PermissionsPolicyFeatureSummarizer                                                        = types.PagePermissionsPolicyFeatureSummarizer
+	PermissionsPolicyFeatureSyncXhr                                                           = types.PagePermissionsPolicyFeatureSyncXhr
+	PermissionsPolicyFeatureTranslator                                                        = types.PagePermissionsPolicyFeatureTranslator
+	PermissionsPolicyFeatureUnload                                                            = types.PagePermissionsPolicyFeatureUnload
+	PermissionsPolicyFeatureUsb                                                               = types.PagePermissionsPolicyFeatureUsb
+	PermissionsPolicyFeatureUsbUnrestricted                                                   = types.PagePermissionsPolicyFeatureUsbUnrestricted
+	PermissionsPolicyFeatureVerticalScroll                                                    = types.PagePermissionsPolicyFeatureVerticalScroll
+	PermissionsPolicyFeatureWebAppInstallation                                                = types.PagePermissionsPolicyFeatureWebAppInstallation
+	PermissionsPolicyFeatureWebPrinting                                                       = types.PagePermissionsPolicyFeatureWebPrinting
+	PermissionsPolicyFeatureWebShare                                                          = types.PagePermissionsPolicyFeatureWebShare
+	PermissionsPolicyFeatureWindowManagement                                                  = types.PagePermissionsPolicyFeatureWindowManagement
+	PermissionsPolicyFeatureWriter                                                            = types.PagePermissionsPolicyFeatureWriter
+	PermissionsPolicyFeatureXrSpatialTracking                                                 = types.PagePermissionsPolicyFeatureXrSpatialTracking
+	PermissionsPolicyBlockReasonHeader                                                        = types.PagePermissionsPolicyBlockReasonHeader
+	PermissionsPolicyBlockReasonIframeAttribute                                               = types.PagePermissionsPolicyBlockReasonIframeAttribute
+	PermissionsPolicyBlockReasonInFencedFrameTree                                             = types.PagePermissionsPolicyBlockReasonInFencedFrameTree
+	PermissionsPolicyBlockReasonInIsolatedApp                                                 = types.PagePermissionsPolicyBlockReasonInIsolatedApp
+	OriginTrialTokenStatusSuccess                                                             = types.PageOriginTrialTokenStatusSuccess
+	OriginTrialTokenStatusNotSupported                                                        = types.PageOriginTrialTokenStatusNotSupported
+	OriginTrialTokenStatusInsecure                                                            = types.PageOriginTrialTokenStatusInsecure
+	OriginTrialTokenStatusExpired                                                             = types.PageOriginTrialTokenStatusExpired
+	OriginTrialTokenStatusWrongOrigin                                                         = types.PageOriginTrialTokenStatusWrongOrigin
+	OriginTrialTokenStatusInvalidSignature                                                    = types.PageOriginTrialTokenStatusInvalidSignature
+	OriginTrialTokenStatusMalformed                                                           = types.PageOriginTrialTokenStatusMalformed
+	OriginTrialTokenStatusWrongVersion                                                        = types.PageOriginTrialTokenStatusWrongVersion
+	OriginTrialTokenStatusFeatureDisabled                                                     = types.PageOriginTrialTokenStatusFeatureDisabled
+	OriginTrialTokenStatusTokenDisabled                                                       = types.PageOriginTrialTokenStatusTokenDisabled
+	OriginTrialTokenStatusFeatureDisabledForUser                                              = types.PageOriginTrialTokenStatusFeatureDisabledForUser
+	OriginTrialTokenStatusUnknownTrial                                                        = types.PageOriginTrialTokenStatusUnknownTrial
+	OriginTrialStatusEnabled                                                                  = types.PageOriginTrialStatusEnabled
+	OriginTrialStatusValidTokenNotProvided                                                    = types.PageOriginTrialStatusValidTokenNotProvided
+	OriginTrialStatusOSNotSupported                                                           = types.PageOriginTrialStatusOSNotSupported
+	OriginTrialStatusTrialNotAllowed                                                          = types.PageOriginTrialStatusTrialNotAllowed
+	OriginTrialUsageRestrictionNone                                                           = types.PageOriginTrialUsageRestrictionNone
+	OriginTrialUsageRestrictionSubset                                                         = types.PageOriginTrialUsageRestrictionSubset
+	TransitionTypeLink                                                                        = types.PageTransitionTypeLink
+	TransitionTypeTyped                                                                       = types.PageTransitionTypeTyped
+	TransitionTypeAddressBar                                                                  = types.PageTransitionTypeAddressBar
+	TransitionTypeAutoBookmark                                                                = types.PageTransitionTypeAutoBookmark
+	TransitionTypeAutoSubframe                                                                = types.PageTransitionTypeAutoSubframe
+	TransitionTypeManualSubframe                                                              = types.PageTransitionTypeManualSubframe
+	TransitionTypeGenerated                                                                   = types.PageTransitionTypeGenerated
+	TransitionTypeAutoToplevel                                                                = types.PageTransitionTypeAutoToplevel
+	TransitionTypeFormSubmit                                                                  = types.PageTransitionTypeFormSubmit
+	TransitionTypeReload                                                                      = types.PageTransitionTypeReload
+	TransitionTypeKeyword                                                                     = types.PageTransitionTypeKeyword
+	TransitionTypeKeywordGenerated                                                            = types.PageTransitionTypeKeywordGenerated
+	TransitionTypeOther                                                                       = types.PageTransitionTypeOther
+	DialogTypeAlert                                                                           = types.PageDialogTypeAlert
+	DialogTypeConfirm                                                                         = types.PageDialogTypeConfirm
+	DialogTypePrompt                                                                          = types.PageDialogTypePrompt
+	DialogTypeBeforeunload                                                                    = types.PageDialogTypeBeforeunload
+	ClientNavigationReasonAnchorClick                                                         = types.PageClientNavigationReasonAnchorClick
+	ClientNavigationReasonFormSubmissionGet                                                   = types.PageClientNavigationReasonFormSubmissionGet
+	ClientNavigationReasonFormSubmissionPost                                                  = types.PageClientNavigationReasonFormSubmissionPost
+	ClientNavigationReasonHTTPHeaderRefresh                                                   = types.PageClientNavigationReasonHTTPHeaderRefresh
+	ClientNavigationReasonInitialFrameNavigation                                              = types.PageClientNavigationReasonInitialFrameNavigation
+	ClientNavigationReasonMetaTagRefresh                                                      = types.PageClientNavigationReasonMetaTagRefresh
+	ClientNavigationReasonOther                                                               = types.PageClientNavigationReasonOther
+	ClientNavigationReasonPageBlockInterstitial                                               = types.PageClientNavigationReasonPageBlockInterstitial
+	ClientNavigationReasonReload                                                              = types.PageClientNavigationReasonReload
+	ClientNavigationReasonScriptInitiated                                                     = types.PageClientNavigationReasonScriptInitiated
+	ClientNavigationDispositionCurrentTab                                                     = types.PageClientNavigationDispositionCurrentTab
+	ClientNavigationDispositionNewTab                                                         = types.PageClientNavigationDispositionNewTab
+	ClientNavigationDispositionNewWindow                                                      = types.PageClientNavigationDispositionNewWindow
+	ClientNavigationDispositionDownload                                                       = types.PageClientNavigationDispositionDownload
+	ReferrerPolicyNoReferrer                                                                  = types.PageReferrerPolicyNoReferrer
+	ReferrerPolicyNoReferrerWhenDowngrade                                                     = types.PageReferrerPolicyNoReferrerWhenDowngrade
+	ReferrerPolicyOrigin                                                                      = types.PageReferrerPolicyOrigin
+	ReferrerPolicyOriginWhenCrossOrigin                                                       = types.PageReferrerPolicyOriginWhenCrossOrigin
+	ReferrerPolicySameOrigin                                                                  = types.PageReferrerPolicySameOrigin
+	ReferrerPolicyStrictOrigin                                                                = types.PageReferrerPolicyStrictOrigin
+	ReferrerPolicyStrictOriginWhenCrossOrigin                                                 = types.PageReferrerPolicyStrictOriginWhenCrossOrigin
+	ReferrerPolicyUnsafeURL                                                                   = types.PageReferrerPolicyUnsafeURL
+	NavigationTypeNavigation                                                                  = types.PageNavigationTypeNavigation
+	NavigationTypeBackForwardCacheRestore                                                     = types.PageNavigationTypeBackForwardCacheRestore
+	BackForwardCacheNotRestoredReasonNotPrimaryMainFrame                                      = types.PageBackForwardCacheNotRestoredReasonNotPrimaryMainFrame
+	BackForwardCacheNotRestoredReasonBackForwardCacheDisabled                                 = types.PageBackForwardCacheNotRestoredReasonBackForwardCacheDisabled
+	BackForwardCacheNotRestoredReasonRelatedActiveContentsExist                               = types.PageBackForwardCacheNotRestoredReasonRelatedActiveContentsExist
+	BackForwardCacheNotRestoredReasonHTTPStatusNotOK                                          = types.PageBackForwardCacheNotRestoredReasonHTTPStatusNotOK
+	BackForwardCacheNotRestoredReasonSchemeNotHTTPOrHTTPS                                     = types.PageBackForwardCacheNotRestoredReasonSchemeNotHTTPOrHTTPS
+	BackForwardCacheNotRestoredReasonLoading                                                  = types.PageBackForwardCacheNotRestoredReasonLoading
+	BackForwardCacheNotRestoredReasonWasGrantedMediaAccess                                    = types.PageBackForwardCacheNotRestoredReasonWasGrantedMediaAccess
+	BackForwardCacheNotRestoredReasonDisableForRenderFrameHostCalled                          = types.PageBackForwardCacheNotRestoredReasonDisableForRenderFrameHostCalled
+	BackForwardCacheNotRestoredReasonDomainNotAllowed                                         = types.PageBackForwardCacheNotRestoredReasonDomainNotAllowed
+	BackForwardCacheNotRestoredReasonHTTPMethodNotGET                                         = types.PageBackForwardCacheNotRestoredReasonHTTPMethodNotGET
+	BackForwardCacheNotRestoredReasonSubframeIsNavigating                                     = types.PageBackForwardCacheNotRestoredReasonSubframeIsNavigating
+	BackForwardCacheNotRestoredReasonTimeout                                                  = types.PageBackForwardCacheNotRestoredReasonTimeout
+	BackForwardCacheNotRestoredReasonCacheLimit                                               = types.PageBackForwardCacheNotRestoredReasonCacheLimit
+	BackForwardCacheNotRestoredReasonJavaScriptExecution                                      = types.PageBackForwardCacheNotRestoredReasonJavaScriptExecution
+	BackForwardCacheNotRestoredReasonRendererProcessKilled                                    = types.PageBackForwardCacheNotRestoredReasonRendererProcessKilled
+	BackForwardCacheNotRestoredReasonRendererProcessCrashed                                   = types.PageBackForwardCacheNotRestoredReasonRendererProcessCrashed
+	BackForwardCacheNotRestoredReasonSchedulerTrackedFeatureUsed                              = types.PageBackForwardCacheNotRestoredReasonSchedulerTrackedFeatureUsed
+	BackForwardCacheNotRestoredReasonConflictingBrowsingInstance                              = types.PageBackForwardCacheNotRestoredReasonConflictingBrowsingInstance
+	BackForwardCacheNotRestoredReasonCacheFlushed                                             = types.PageBackForwardCacheNotRestoredReasonCacheFlushed
+	BackForwardCacheNotRestoredReasonServiceWorkerVersionActivation                           = types.PageBackForwardCacheNotRestoredReasonServiceWorkerVersionActivation
+	BackForwardCacheNotRestoredReasonSessionRestored                                          = types.PageBackForwardCacheNotRestoredReasonSessionRestored
+	BackForwardCacheNotRestoredReasonServiceWorkerPostMessage                                 = types.PageBackForwardCacheNotRestoredReasonServiceWorkerPostMessage
+	BackForwardCacheNotRestoredReasonEnteredBackForwardCacheBeforeServiceWorkerHostAdded      = types.PageBackForwardCacheNotRestoredReasonEnteredBackForwardCacheBeforeServiceWorkerHostAdded
+	BackForwardCacheNotRestoredReasonRenderFrameHostReusedSameSite                            = types.PageBackForwardCacheNotRestoredReasonRenderFrameHostReusedSameSite
+	BackForwardCacheNotRestoredReasonRenderFrameHostReusedCrossSite                           = types.PageBackForwardCacheNotRestoredReasonRenderFrameHostReusedCrossSite
+	BackForwardCacheNotRestoredReasonServiceWorkerClaim                                       = types.PageBackForwardCacheNotRestoredReasonServiceWorkerClaim
+	BackForwardCacheNotRestoredReasonIgnoreEventAndEvict                                      = types.PageBackForwardCacheNotRestoredReasonIgnoreEventAndEvict
+	BackForwardCacheNotRestoredReasonHaveInnerContents                                        = types.PageBackForwardCacheNotRestoredReasonHaveInnerContents
+	BackForwardCacheNotRestoredReasonTimeoutPuttingInCache                                    = types.PageBackForwardCacheNotRestoredReasonTimeoutPuttingInCache
+	BackForwardCacheNotRestoredReasonBackForwardCacheDisabledByLowMemory                      = types.PageBackForwardCacheNotRestoredReasonBackForwardCacheDisabledByLowMemory
+	BackForwardCacheNotRestoredReasonBackForwardCacheDisabledByCommandLine                    = types.PageBackForwardCacheNotRestoredReasonBackForwardCacheDisabledByCommandLine
+	BackForwardCacheNotRestoredReasonNetworkRequestDatapipeDrainedAsBytesConsumer             = types.PageBackForwardCacheNotRestoredReasonNetworkRequestDatapipeDrainedAsBytesConsumer
+	BackForwardCacheNotRestoredReasonNetworkRequestRedirected                                 = types.PageBackForwardCacheNotRestoredReasonNetworkRequestRedirected
+	BackForwardCacheNotRestoredReasonNetworkRequestTimeout                                    = types.PageBackForwardCacheNotRestoredReasonNetworkRequestTimeout
+	BackForwardCacheNotRestoredReasonNetworkExceedsBufferLimit                                = types.PageBackForwardCacheNotRestoredReasonNetworkExceedsBufferLimit
+	BackForwardCacheNotRestoredReasonNavigationCancelledWhileRestoring                        = types.PageBackForwardCacheNotRestoredReasonNavigationCancelledWhileRestoring
+	BackForwardCacheNotRestoredReasonNotMostRecentNavigationEntry                             = types.PageBackForwardCacheNotRestoredReasonNotMostRecentNavigationEntry
+	BackForwardCacheNotRestoredReasonBackForwardCacheDisabledForPrerender                     = types.PageBackForwardCacheNotRestoredReasonBackForwardCacheDisabledForPrerender
+	BackForwardCacheNotRestoredReasonUserAgentOverrideDiffers                                 = types.PageBackForwardCacheNotRestoredReasonUserAgentOverrideDiffers
+	BackForwardCacheNotRestoredReasonForegroundCacheLimit                                     = types.PageBackForwardCacheNotRestoredReasonForegroundCacheLimit
+	BackForwardCacheNotRestoredReasonBrowsingInstanceNotSwapped                               = types.PageBackForwardCacheNotRestoredReasonBrowsingInstanceNotSwapped
+	BackForwardCacheNotRestoredReasonBackForwardCacheDisabledForDelegate                      = types.PageBackForwardCacheNotRestoredReasonBackForwardCacheDisabledForDelegate
+	BackForwardCacheNotRestoredReasonUnloadHandlerExistsInMainFrame                           = types.PageBackForwardCacheNotRestoredReasonUnloadHandlerExistsInMainFrame
+	BackForwardCacheNotRestoredReasonUnloadHandlerExistsInSubFrame                            = types.PageBackForwardCacheNotRestoredReasonUnloadHandlerExistsInSubFrame
+	BackForwardCacheNotRestoredReasonServiceWorkerUnregistration                              = types.PageBackForwardCacheNotRestoredReasonServiceWorkerUnregistration
+	BackForwardCacheNotRestoredReasonCacheControlNoStore                                      = types.PageBackForwardCacheNotRestoredReasonCacheControlNoStore
+	BackForwardCacheNotRestoredReasonCacheControlNoStoreCookieModified                        = types.PageBackForwardCacheNotRestoredReasonCacheControlNoStoreCookieModified
+	BackForwardCacheNotRestoredReasonCacheControlNoStoreHTTPOnlyCookieModified                = types.PageBackForwardCacheNotRestoredReasonCacheControlNoStoreHTTPOnlyCookieModified
+	BackForwardCacheNotRestoredReasonNoResponseHead                                           = types.PageBackForwardCacheNotRestoredReasonNoResponseHead
+	BackForwardCacheNotRestoredReasonUnknown                                                  = types.PageBackForwardCacheNotRestoredReasonUnknown
+	BackForwardCacheNotRestoredReasonActivationNavigationsDisallowedForBug1234857             = types.PageBackForwardCacheNotRestoredReasonActivationNavigationsDisallowedForBug1234857
+	BackForwardCacheNotRestoredReasonErrorDocument                                            = types.PageBackForwardCacheNotRestoredReasonErrorDocument
+	BackForwardCacheNotRestoredReasonFencedFramesEmbedder                                     = types.PageBackForwardCacheNotRestoredReasonFencedFramesEmbedder
+	BackForwardCacheNotRestoredReasonCookieDisabled                                           = types.PageBackForwardCacheNotRestoredReasonCookieDisabled
+	BackForwardCacheNotRestoredReasonHTTPAuthRequired                                         = types.PageBackForwardCacheNotRestoredReasonHTTPAuthRequired
+	BackForwardCacheNotRestoredReasonCookieFlushed                                            = types.PageBackForwardCacheNotRestoredReasonCookieFlushed
+	BackForwardCacheNotRestoredReasonBroadcastChannelOnMessage                                = types.PageBackForwardCacheNotRestoredReasonBroadcastChannelOnMessage
+	BackForwardCacheNotRestoredReasonWebViewSettingsChanged                                   = types.PageBackForwardCacheNotRestoredReasonWebViewSettingsChanged
+	BackForwardCacheNotRestoredReasonWebViewJavaScriptObjectChanged                           = types.PageBackForwardCacheNotRestoredReasonWebViewJavaScriptObjectChanged
+	BackForwardCacheNotRestoredReasonWebViewMessageListenerInjected                           = types.PageBackForwardCacheNotRestoredReasonWebViewMessageListenerInjected
+	BackForwardCacheNotRestoredReasonWebViewSafeBrowsingAllowlistChanged                      = types.PageBackForwardCacheNotRestoredReasonWebViewSafeBrowsingAllowlistChanged
+	BackForwardCacheNotRestoredReasonWebViewDocumentStartJavascriptChanged                    = types.PageBackForwardCacheNotRestoredReasonWebViewDocumentStartJavascriptChanged
+	BackForwardCacheNotRestoredReasonWebSocket                                                = types.PageBackForwardCacheNotRestoredReasonWebSocket
+	BackForwardCacheNotRestoredReasonWebTransport                                             = types.PageBackForwardCacheNotRestoredReasonWebTransport
+	BackForwardCacheNotRestoredReasonWebRTC                                                   = types.PageBackForwardCacheNotRestoredReasonWebRTC
+	BackForwardCacheNotRestoredReasonMainResourceHasCacheControlNoStore                       = types.PageBackForwardCacheNotRestoredReasonMainResourceHasCacheControlNoStore
+	BackForwardCacheNotRestoredReasonMainResourceHasCacheControlNoCache                       = types.PageBackForwardCacheNotRestoredReasonMainResourceHasCacheControlNoCache
+	BackForwardCacheNotRestoredReasonSubresourceHasCacheControlNoStore                        = types.PageBackForwardCacheNotRestoredReasonSubresourceHasCacheControlNoStore
+	BackForwardCacheNotRestoredReasonSubresourceHasCacheControlNoCache                        = types.PageBackForwardCacheNotRestoredReasonSubresourceHasCacheControlNoCache
+	BackForwardCacheNotRestoredReasonContainsPlugins                                          = types.PageBackForwardCacheNotRestoredReasonContainsPlugins
+	BackForwardCacheNotRestoredReasonDocumentLoaded                                           = types.PageBackForwardCacheNotRestoredReasonDocumentLoaded
+	BackForwardCacheNotRestoredReasonOutstandingNetworkRequestOthers                          = types.PageBackForwardCacheNotRestoredReasonOutstandingNetworkRequestOthers
+	BackForwardCacheNotRestoredReasonRequestedMIDIPermission                                  = types.PageBackForwardCacheNotRestoredReasonRequestedMIDIPermission
+	BackForwardCacheNotRestoredReasonRequestedAudioCapturePermission                          = types.PageBackForwardCacheNotRestoredReasonRequestedAudioCapturePermission
+	BackForwardCacheNotRestoredReasonRequestedVideoCapturePermission                          = types.PageBackForwardCacheNotRestoredReasonRequestedVideoCapturePermission
+	BackForwardCacheNotRestoredReasonRequestedBackForwardCacheBlockedSensors                  = types.PageBackForwardCacheNotRestoredReasonRequestedBackForwardCacheBlockedSensors
+	BackForwardCacheNotRestoredReasonRequestedBackgroundWorkPermission                        = types.PageBackForwardCacheNotRestoredReasonRequestedBackgroundWorkPermission
+	BackForwardCacheNotRestoredReasonBroadcastChannel                                         = types.PageBackForwardCacheNotRestoredReasonBroadcastChannel
+	BackForwardCacheNotRestoredReasonWebXR                                                    = types.PageBackForwardCacheNotRestoredReasonWebXR
+	BackForwardCacheNotRestoredReasonSharedWorker                                             = types.PageBackForwardCacheNotRestoredReasonSharedWorker
+	BackForwardCacheNotRestoredReasonSharedWorkerMessage                                      = types.PageBackForwardCacheNotRestoredReasonSharedWorkerMessage
+	BackForwardCacheNotRestoredReasonWebLocks                                                 = types.PageBackForwardCacheNotRestoredReasonWebLocks
+	BackForwardCacheNotRestoredReasonWebHID                                                   = types.PageBackForwardCacheNotRestoredReasonWebHID
+	BackForwardCacheNotRestoredReasonWebShare                                                 = types.PageBackForwardCacheNotRestoredReasonWebShare
+	BackForwardCacheNotRestoredReasonRequestedStorageAccessGrant                              = types.PageBackForwardCacheNotRestoredReasonRequestedStorageAccessGrant
+	BackForwardCacheNotRestoredReasonWebNfc                                                   = types.PageBackForwardCacheNotRestoredReasonWebNfc
+	BackForwardCacheNotRestoredReasonOutstandingNetworkRequestFetch                           = types.PageBackForwardCacheNotRestoredReasonOutstandingNetworkRequestFetch
+	BackForwardCacheNotRestoredReasonOutstandingNetworkRequestXHR                             = types.PageBackForwardCacheNotRestoredReasonOutstandingNetworkRequestXHR
+	BackForwardCacheNotRestoredReasonAppBanner                                                = types.PageBackForwardCacheNotRestoredReasonAppBanner
+	BackForwardCacheNotRestoredReasonPrinting                                                 = types.PageBackForwardCacheNotRestoredReasonPrinting
+	BackForwardCacheNotRestoredReasonWebDatabase                                              = types.PageBackForwardCacheNotRestoredReasonWebDatabase
+	BackForwardCacheNotRestoredReasonPictureInPicture                                         = types.PageBackForwardCacheNotRestoredReasonPictureInPicture
+	BackForwardCacheNotRestoredReasonSpeechRecognizer                                         = types.PageBackForwardCacheNotRestoredReasonSpeechRecognizer
+	BackForwardCacheNotRestoredReasonIdleManager                                              = types.PageBackForwardCacheNotRestoredReasonIdleManager
+	BackForwardCacheNotRestoredReasonPaymentManager                                           = types.PageBackForwardCacheNotRestoredReasonPaymentManager
+	BackForwardCacheNotRestoredReasonSpeechSynthesis                                          = types.PageBackForwardCacheNotRestoredReasonSpeechSynthesis
+	BackForwardCacheNotRestoredReasonKeyboardLock                                             = types.PageBackForwardCacheNotRestoredReasonKeyboardLock
+	BackForwardCacheNotRestoredReasonWebOTPService                                            = types.PageBackForwardCacheNotRestoredReasonWebOTPService
+	BackForwardCacheNotRestoredReasonOutstandingNetworkRequestDirectSocket                    = types.PageBackForwardCacheNotRestoredReasonOutstandingNetworkRequestDirectSocket
+	BackForwardCacheNotRestoredReasonInjectedJavascript                                       = types.PageBackForwardCacheNotRestoredReasonInjectedJavascript
+	BackForwardCacheNotRestoredReasonInjectedStyleSheet                                       = types.PageBackForwardCacheNotRestoredReasonInjectedStyleSheet
+	BackForwardCacheNotRestoredReasonKeepaliveRequest                                         = types.PageBackForwardCacheNotRestoredReasonKeepaliveRequest
+	BackForwardCacheNotRestoredReasonIndexedDBEvent                                           = types.PageBackForwardCacheNotRestoredReasonIndexedDBEvent
+	BackForwardCacheNotRestoredReasonDummy                                                    = types.PageBackForwardCacheNotRestoredReasonDummy
+	BackForwardCacheNotRestoredReasonJSNetworkRequestReceivedCacheControlNoStoreResource      = types.PageBackForwardCacheNotRestoredReasonJSNetworkRequestReceivedCacheControlNoStoreResource
+	BackForwardCacheNotRestoredReasonWebRTCSticky                                             = types.PageBackForwardCacheNotRestoredReasonWebRTCSticky
+	BackForwardCacheNotRestoredReasonWebTransportSticky                                       = types.PageBackForwardCacheNotRestoredReasonWebTransportSticky
+	BackForwardCacheNotRestoredReasonWebSocketSticky                                          = types.PageBackForwardCacheNotRestoredReasonWebSocketSticky
+	BackForwardCacheNotRestoredReasonSmartCard                                                = types.PageBackForwardCacheNotRestoredReasonSmartCard
+	BackForwardCacheNotRestoredReasonLiveMediaStreamTrack                                     = types.PageBackForwardCacheNotRestoredReasonLiveMediaStreamTrack
+	BackForwardCacheNotRestoredReasonUnloadHandler                                            = types.PageBackForwardCacheNotRestoredReasonUnloadHandler
+	BackForwardCacheNotRestoredReasonParserAborted                                            = types.PageBackForwardCacheNotRestoredReasonParserAborted
+	BackForwardCacheNotRestoredReasonContentSecurityHandler                                   = types.PageBackForwardCacheNotRestoredReasonContentSecurityHandler
+	BackForwardCacheNotRestoredReasonContentWebAuthenticationAPI                              = types.PageBackForwardCacheNotRestoredReasonContentWebAuthenticationAPI
+	BackForwardCacheNotRestoredReasonContentFileChooser                                       = types.PageBackForwardCacheNotRestoredReasonContentFileChooser
+	BackForwardCacheNotRestoredReasonContentSerial                                            = types.PageBackForwardCacheNotRestoredReasonContentSerial
+	BackForwardCacheNotRestoredReasonContentFileSystemAccess                                  = types.PageBackForwardCacheNotRestoredReasonContentFileSystemAccess
+	BackForwardCacheNotRestoredReasonContentMediaDevicesDispatcherHost                        = types.PageBackForwardCacheNotRestoredReasonContentMediaDevicesDispatcherHost
+	BackForwardCacheNotRestoredReasonContentWebBluetooth                                      = types.PageBackForwardCacheNotRestoredReasonContentWebBluetooth
+	BackForwardCacheNotRestoredReasonContentWebUSB                                            = types.PageBackForwardCacheNotRestoredReasonContentWebUSB
+	BackForwardCacheNotRestoredReasonContentMediaSessionService                               = types.PageBackForwardCacheNotRestoredReasonContentMediaSessionService
+	BackForwardCacheNotRestoredReasonContentScreenReader                                      = types.PageBackForwardCacheNotRestoredReasonContentScreenReader
+	BackForwardCacheNotRestoredReasonContentDiscarded                                         = types.PageBackForwardCacheNotRestoredReasonContentDiscarded
+	BackForwardCacheNotRestoredReasonEmbedderPopupBlockerTabHelper                            = types.PageBackForwardCacheNotRestoredReasonEmbedderPopupBlockerTabHelper
+	BackForwardCacheNotRestoredReasonEmbedderSafeBrowsingTriggeredPopupBlocker                = types.PageBackForwardCacheNotRestoredReasonEmbedderSafeBrowsingTriggeredPopupBlocker
+	BackForwardCacheNotRestoredReasonEmbedderSafeBrowsingThreatDetails                        = types.PageBackForwardCacheNotRestoredReasonEmbedderSafeBrowsingThreatDetails
+	BackForwardCacheNotRestoredReasonEmbedderAppBannerManager                                 = types.PageBackForwardCacheNotRestoredReasonEmbedderAppBannerManager
+	BackForwardCacheNotRestoredReasonEmbedderDOMDistillerViewerSource                         = types.PageBackForwardCacheNotRestoredReasonEmbedderDOMDistillerViewerSource
+	BackForwardCacheNotRestoredReasonEmbedderDOMDistillerSelfDeletingRequestDelegate          = types.PageBackForwardCacheNotRestoredReasonEmbedderDOMDistillerSelfDeletingRequestDelegate
+	BackForwardCacheNotRestoredReasonEmbedderOomInterventionTabHelper                         = types.PageBackForwardCacheNotRestoredReasonEmbedderOomInterventionTabHelper
+	BackForwardCacheNotRestoredReasonEmbedderOfflinePage                                      = types.PageBackForwardCacheNotRestoredReasonEmbedderOfflinePage
+	BackForwardCacheNotRestoredReasonEmbedderChromePasswordManagerClientBindCredentialManager = types.PageBackForwardCacheNotRestoredReasonEmbedderChromePasswordManagerClientBindCredentialManager
+	BackForwardCacheNotRestoredReasonEmbedderPermissionRequestManager                         = types.PageBackForwardCacheNotRestoredReasonEmbedderPermissionRequestManager
+	BackForwardCacheNotRestoredReasonEmbedderModalDialog                                      = types.PageBackForwardCacheNotRestoredReasonEmbedderModalDialog
+	BackForwardCacheNotRestoredReasonEmbedderExtensions                                       = types.PageBackForwardCacheNotRestoredReasonEmbedderExtensions
+	BackForwardCacheNotRestoredReasonEmbedderExtensionMessaging                               = types.PageBackForwardCacheNotRestoredReasonEmbedderExtensionMessaging
+	BackForwardCacheNotRestoredReasonEmbedderExtensionMessagingForOpenPort                    = types.PageBackForwardCacheNotRestoredReasonEmbedderExtensionMessagingForOpenPort
+	BackForwardCacheNotRestoredReasonEmbedderExtensionSentMessageToCachedFrame                = types.PageBackForwardCacheNotRestoredReasonEmbedderExtensionSentMessageToCachedFrame
+	BackForwardCacheNotRestoredReasonRequestedByWebViewClient                                 = types.PageBackForwardCacheNotRestoredReasonRequestedByWebViewClient
+	BackForwardCacheNotRestoredReasonPostMessageByWebViewClient                               = types.PageBackForwardCacheNotRestoredReasonPostMessageByWebViewClient
+	BackForwardCacheNotRestoredReasonCacheControlNoStoreDeviceBoundSessionTerminated          = types.PageBackForwardCacheNotRestoredReasonCacheControlNoStoreDeviceBoundSessionTerminated
+	BackForwardCacheNotRestoredReasonCacheLimitPrunedOnModerateMemoryPressure                 = types.PageBackForwardCacheNotRestoredReasonCacheLimitPrunedOnModerateMemoryPressure
+	BackForwardCacheNotRestoredReasonCacheLimitPrunedOnCriticalMemoryPressure                 = types.PageBackForwardCacheNotRestoredReasonCacheLimitPrunedOnCriticalMemoryPressure
+	BackForwardCacheNotRestoredReasonTypeSupportPending                                       = types.PageBackForwardCacheNotRestoredReasonTypeSupportPending
+	BackForwardCacheNotRestoredReasonTypePageSupportNeeded                                    = types.PageBackForwardCacheNotRestoredReasonTypePageSupportNeeded
+	BackForwardCacheNotRestoredReasonTypeCircumstantial                                       = types.PageBackForwardCacheNotRestoredReasonTypeCircumstantial
+)
+
+// Method names of the commands in the Page domain.
+const (
+	CommandAddScriptToEvaluateOnLoad           = "Page.addScriptToEvaluateOnLoad"
+	CommandAddScriptToEvaluateOnNewDocument    = "Page.addScriptToEvaluateOnNewDocument"
+	CommandBringToFront                        = "Page.bringToFront"
+	CommandCaptureScreenshot                   = "Page.captureScreenshot"
+	CommandCaptureSnapshot                     = "Page.captureSnapshot"
+	CommandClearDeviceMetricsOverride          = "Page.clearDeviceMetricsOverride"
+	CommandClearDeviceOrientationOverride      = "Page.clearDeviceOrientationOverride"
+	CommandClearGeolocationOverride            = "Page.clearGeolocationOverride"
+	CommandCreateIsolatedWorld                 = "Page.createIsolatedWorld"
+	CommandDeleteCookie                        = "Page.deleteCookie"
+	CommandDisable                             = "Page.disable"
+	CommandEnable                              = "Page.enable"
+	CommandGetAppManifest                      = "Page.getAppManifest"
+	CommandGetInstallabilityErrors             = "Page.getInstallabilityErrors"
+	CommandGetManifestIcons                    = "Page.getManifestIcons"
+	CommandGetAppID                            = "Page.getAppId"
+	CommandGetAdScriptAncestry                 = "Page.getAdScriptAncestry"
+	CommandGetFrameTree                        = "Page.getFrameTree"
+	CommandGetLayoutMetrics                    = "Page.getLayoutMetrics"
+	CommandGetNavigationHistory                = "Page.getNavigationHistory"
+	CommandResetNavigationHistory              = "Page.resetNavigationHistory"
+	CommandGetResourceContent                  = "Page.getResourceContent"
+	CommandGetResourceTree                     = "Page.getResourceTree"
+	CommandHandleJavaScriptDialog              = "Page.handleJavaScriptDialog"
+	CommandNavigate                            = "Page.navigate"
+	CommandNavigateToHistoryEntry              = "Page.navigateToHistoryEntry"
+	CommandPrintToPDF                          = "Page.printToPDF"
+	CommandReload                              = "Page.reload"
+	CommandRemoveScriptToEvaluateOnLoad        = "Page.removeScriptToEvaluateOnLoad"
+	CommandRemoveScriptToEvaluateOnNewDocument = "Page.removeScriptToEvaluateOnNewDocument"
+	CommandScreencastFrameAck                  = "Page.screencastFrameAck"
+	CommandSearchInResource                    = "Page.searchInResource"
+	CommandSetAdBlockingEnabled                = "Page.setAdBlockingEnabled"
+	CommandSetBypassCSP                        = "Page.setBypassCSP"
+	CommandGetPermissionsPolicyState           = "Page.getPermissionsPolicyState"
+	CommandGetOriginTrials                     = "Page.getOriginTrials"
+	CommandSetDeviceMetricsOverride            = "Page.setDeviceMetricsOverride"
+	CommandSetDeviceOrientationOverride        = "Page.setDeviceOrientationOverride"
+	CommandSetFontFamilies                     = "Page.setFontFamilies"
+	CommandSetFontSizes                        = "Page.setFontSizes"
+	CommandSetDocumentContent                  = "Page.setDocumentContent"
+	CommandSetDownloadBehavior                 = "Page.setDownloadBehavior"
+	CommandSetGeolocationOverride              = "Page.setGeolocationOverride"
+	CommandSetLifecycleEventsEnabled           = "Page.setLifecycleEventsEnabled"
+	CommandSetTouchEmulationEnabled            = "Page.setTouchEmulationEnabled"
+	CommandStartScreencast                     = "Page.startScreencast"
+	CommandStopLoading                         = "Page.stopLoading"
+	CommandCrash                               = "Page.crash"
+	CommandClose                               = "Page.close"
+	CommandSetWebLifecycleState                = "Page.setWebLifecycleState"
+	CommandStopScreencast                      = "Page.stopScreencast"
+	CommandProduceCompilationCache             = "Page.produceCompilationCache"
+	CommandAddCompilationCache                 = "Page.addCompilationCache"
+	CommandClearCompilationCache               = "Page.clearCompilationCache"
+	CommandSetSPCTransactionMode               = "Page.setSPCTransactionMode"
+	CommandSetRPHRegistrationMode              = "Page.setRPHRegistrationMode"
+	CommandGenerateTestReport                  = "Page.generateTestReport"
+	CommandWaitForDebugger                     = "Page.waitForDebugger"
+	CommandSetInterceptFileChooserDialog       = "Page.setInterceptFileChooserDialog"
+	CommandSetPrerenderingAllowed              = "Page.setPrerenderingAllowed"
+)
+
+// AddScriptToEvaluateOnLoadParams are the parameters of
+// Page.addScriptToEvaluateOnLoad.
+//
+// Deprecated, please use addScriptToEvaluateOnNewDocument instead.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#method-addScriptToEvaluateOnLoad
+//
+// This is an experimental part of the protocol.
+//
+// Deprecated: this is deprecated in the protocol.
+type AddScriptToEvaluateOnLoadParams struct {
+	ScriptSource string `json:"scriptSource"`
+}
+
+// Command returns the Page.addScriptToEvaluateOnLoad command for use with
+// AsyncDebugger.Send.
+func (p AddScriptToEvaluateOnLoadParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandAddScriptToEvaluateOnLoad, p)
+}
+
+// AddScriptToEvaluateOnLoadReturns are the values returned by Page.addScriptToEvaluateOnLoad.
+type AddScriptToEvaluateOnLoadReturns struct {
+	// Identifier of the added script.
+	Identifier ScriptIdentifier `json:"identifier"`
+}
+
+// Do sends Page.addScriptToEvaluateOnLoad to d and waits for its result or for ctx to be
+// done.
+func (p AddScriptToEvaluateOnLoadParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*AddScriptToEvaluateOnLoadReturns, error) {
+	ret := &AddScriptToEvaluateOnLoadReturns{}
+	if err := protocol.Do(ctx, d, CommandAddScriptToEvaluateOnLoad, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// AddScriptToEvaluateOnNewDocumentParams are the parameters of
+// Page.addScriptToEvaluateOnNewDocument.
+//
+// Evaluates given script in every frame upon creation (before loading frame's
+// scripts).
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#method-addScriptToEvaluateOnNewDocument
+type AddScriptToEvaluateOnNewDocumentParams struct {
+	Source string `json:"source"`
+	// If specified, creates an isolated world with the given name and evaluates
+	// given script in it. This world name will be used as the
+	// ExecutionContextDescription::name when the corresponding event is emitted.
+	//
+	// This is an experimental part of the protocol.
+	WorldName string `json:"worldName,omitempty"`
+	// Specifies whether command line API should be available to the script,
+	// defaults to false.
+	//
+	// This is an experimental part of the protocol.
+	IncludeCommandLineAPI bool `json:"includeCommandLineAPI,omitempty"`
+	// If true, runs the script immediately on existing execution contexts or
+	// worlds. Default: false.
+	//
+	// This is an experimental part of the protocol.
+	RunImmediately bool `json:"runImmediately,omitempty"`
+}
+
+// Command returns the Page.addScriptToEvaluateOnNewDocument command for use
+// with AsyncDebugger.Send.
+func (p AddScriptToEvaluateOnNewDocumentParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandAddScriptToEvaluateOnNewDocument, p)
+}
+
+// AddScriptToEvaluateOnNewDocumentReturns are the values returned by Page.addScriptToEvaluateOnNewDocument.
+type AddScriptToEvaluateOnNewDocumentReturns struct {
+	// Identifier of the added script.
+	Identifier ScriptIdentifier `json:"identifier"`
+}
+
+// Do sends Page.addScriptToEvaluateOnNewDocument to d and waits for its result or for ctx to be
+// done.
+func (p AddScriptToEvaluateOnNewDocumentParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*AddScriptToEvaluateOnNewDocumentReturns, error) {
+	ret := &AddScriptToEvaluateOnNewDocumentReturns{}
+	if err := protocol.Do(ctx, d, CommandAddScriptToEvaluateOnNewDocument, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// BringToFrontParams are the parameters of Page.bringToFront.
+//
+// Brings page to front (activates tab).
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#method-bringToFront
+type BringToFrontParams struct {
+}
+
+// Command returns the Page.bringToFront command for use with
+// AsyncDebugger.Send.
+func (p BringToFrontParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandBringToFront, p)
+}
+
+// Do sends Page.bringToFront to d and waits for it to complete or for ctx to
+// be done.
+func (p BringToFrontParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandBringToFront, p, nil)
+}
+
+// CaptureScreenshotParams are the parameters of Page.captureScreenshot.
+//
+// Capture page screenshot.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#method-captureScreenshot
+type CaptureScreenshotParams struct {
+	// Image compression format (defaults to png).
+	//
+	// Allowed values: jpeg, png, webp.
+	Format string `json:"format,omitempty"`
+	// Compression quality from range [0..100] (jpeg only).
+	Quality int64 `json:"quality,omitempty"`
+	// Capture the screenshot of a given region only.
+	Clip *Viewport `json:"clip,omitempty"`
+	// Capture the screenshot from the surface, rather than the view. Defaults to
+	// true.
+	//
+	// This is an experimental part of the protocol.
+	FromSurface bool `json:"fromSurface,omitempty"`
+	// Capture the screenshot beyond the viewport. Defaults to false.
+	//
+	// This is an experimental part of the protocol.
+	CaptureBeyondViewport bool `json:"captureBeyondViewport,omitempty"`
+	// Optimize image encoding for speed, not for resulting size (defaults to
+	// false)
+	//
+	// This is an experimental part of the protocol.
+	OptimizeForSpeed bool `json:"optimizeForSpeed,omitempty"`
+}
+
+// Command returns the Page.captureScreenshot command for use with
+// AsyncDebugger.Send.
+func (p CaptureScreenshotParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandCaptureScreenshot, p)
+}
+
+// CaptureScreenshotReturns are the values returned by Page.captureScreenshot.
+type CaptureScreenshotReturns struct {
+	// Base64-encoded image data. (Encoded as a base64 string when passed over
+	// JSON)
+	Data string `json:"data"`
+}
+
+// Do sends Page.captureScreenshot to d and waits for its result or for ctx to be
+// done.
+func (p CaptureScreenshotParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*CaptureScreenshotReturns, error) {
+	ret := &CaptureScreenshotReturns{}
+	if err := protocol.Do(ctx, d, CommandCaptureScreenshot, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// CaptureSnapshotParams are the parameters of Page.captureSnapshot.
+//
+// Returns a snapshot of the page as a string. For MHTML format, the
+// serialization includes iframes, shadow DOM, external resources, and
+// element-inline styles.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#method-captureSnapshot
+//
+// This is an experimental part of the protocol.
+type CaptureSnapshotParams struct {
+	// Format (defaults to mhtml).
+	//
+	// Allowed values: mhtml.
+	Format string `json:"format,omitempty"`
+}
+
+// Command returns the Page.captureSnapshot command for use with
+// AsyncDebugger.Send.
+func (p CaptureSnapshotParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandCaptureSnapshot, p)
+}
+
+// CaptureSnapshotReturns are the values returned by Page.captureSnapshot.
+type CaptureSnapshotReturns struct {
+	// Serialized page data.
+	Data string `json:"data"`
+}
+
+// Do sends Page.captureSnapshot to d and waits for its result or for ctx to be
+// done.
+func (p CaptureSnapshotParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*CaptureSnapshotReturns, error) {
+	ret := &CaptureSnapshotReturns{}
+	if err := protocol.Do(ctx, d, CommandCaptureSnapshot, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// ClearDeviceMetricsOverrideParams are the parameters of
+// Page.clearDeviceMetricsOverride.
+//
+// Clears the overridden device metrics.
+//
+// Redirects to the Emulation domain.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#method-clearDeviceMetricsOverride
+//
+// This is an experimental part of the protocol.
+//
+// Deprecated: this is deprecated in the protocol.
+type ClearDeviceMetricsOverrideParams struct {
+}
+
+// Command returns the Page.clearDeviceMetricsOverride command for use with
+// AsyncDebugger.Send.
+func (p ClearDeviceMetricsOverrideParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandClearDeviceMetricsOverride, p)
+}
+
+// Do sends Page.clearDeviceMetricsOverride to d and waits for it to complete or for ctx to
+// be done.
+func (p ClearDeviceMetricsOverrideParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandClearDeviceMetricsOverride, p, nil)
+}
+
+// ClearDeviceOrientationOverrideParams are the parameters of
+// Page.clearDeviceOrientationOverride.
+//
+// Clears the overridden Device Orientation.
+//
+// Redirects to the DeviceOrientation domain.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#method-clearDeviceOrientationOverride
+//
+// This is an experimental part of the protocol.
+//
+// Deprecated: this is deprecated in the protocol.
+type ClearDeviceOrientationOverrideParams struct {
+}
+
+// Command returns the Page.clearDeviceOrientationOverride command for use with
+// AsyncDebugger.Send.
+func (p ClearDeviceOrientationOverrideParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandClearDeviceOrientationOverride, p)
+}
+
+// Do sends Page.clearDeviceOrientationOverride to d and waits for it to complete or for ctx to
+// be done.
+func (p ClearDeviceOrientationOverrideParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandClearDeviceOrientationOverride, p, nil)
+}
+
+// ClearGeolocationOverrideParams are the parameters of
+// Page.clearGeolocationOverride.
+//
+// Clears the overridden Geolocation Position and Error.
+//
+// Redirects to the Emulation domain.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#method-clearGeolocationOverride
+//
+// Deprecated: this is deprecated in the protocol.
+type ClearGeolocationOverrideParams struct {
+}
+
+// Command returns the Page.clearGeolocationOverride command for use with
+// AsyncDebugger.Send.
+func (p ClearGeolocationOverrideParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandClearGeolocationOverride, p)
+}
+
+// Do sends Page.clearGeolocationOverride to d and waits for it to complete or for ctx to
+// be done.
+func (p ClearGeolocationOverrideParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandClearGeolocationOverride, p, nil)
+}
+
+// CreateIsolatedWorldParams are the parameters of Page.createIsolatedWorld.
+//
+// Creates an isolated world for the given frame.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#method-createIsolatedWorld
+type CreateIsolatedWorldParams struct {
+	// Id of the frame in which the isolated world should be created.
+	FrameID FrameID `json:"frameId"`
+	// An optional name which is reported in the Execution Context.
+	WorldName string `json:"worldName,omitempty"`
+	// Whether or not universal access should be granted to the isolated world.
+	// This is a powerful option, use with caution.
+	GrantUniveralAccess bool `json:"grantUniveralAccess,omitempty"`
+}
+
+// Command returns the Page.createIsolatedWorld command for use with
+// AsyncDebugger.Send.
+func (p CreateIsolatedWorldParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandCreateIsolatedWorld, p)
+}
+
+// CreateIsolatedWorldReturns are the values returned by Page.createIsolatedWorld.
+type CreateIsolatedWorldReturns struct {
+	// Execution context of the isolated world.
+	ExecutionContextID types.RuntimeExecutionContextID `json:"executionContextId"`
+}
+
+// Do sends Page.createIsolatedWorld to d and waits for its result or for ctx to be
+// done.
+func (p CreateIsolatedWorldParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*CreateIsolatedWorldReturns, error) {
+	ret := &CreateIsolatedWorldReturns{}
+	if err := protocol.Do(ctx, d, CommandCreateIsolatedWorld, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// DeleteCookieParams are the parameters of Page.deleteCookie.
+//
+// Deletes browser cookie with given name, domain and path.
+//
+// Redirects to the Network domain.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#method-deleteCookie
+//
+// This is an experimental part of the protocol.
+//
+// Deprecated: this is deprecated in the protocol.
+type DeleteCookieParams struct {
+	// Name of the cookie to remove.
+	CookieName string `json:"cookieName"`
+	// URL to match cooke domain and path.
+	URL string `json:"url"`
+}
+
+// Command returns the Page.deleteCookie command for use with
+// AsyncDebugger.Send.
+func (p DeleteCookieParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandDeleteCookie, p)
+}
+
+// Do sends Page.deleteCookie to d and waits for it to complete or for ctx to
+// be done.
+func (p DeleteCookieParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandDeleteCookie, p, nil)
+}
+
+// DisableParams are the parameters of Page.disable.
+//
+// Disables page domain notifications.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#method-disable
+type DisableParams struct {
+}
+
+// Command returns the Page.disable command for use with AsyncDebugger.Send.
+func (p DisableParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandDisable, p)
+}
+
+// Do sends Page.disable to d and waits for it to complete or for ctx to
+// be done.
+func (p DisableParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandDisable, p, nil)
+}
+
+// EnableParams are the parameters of Page.enable.
+//
+// Enables page domain notifications.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#method-enable
+type EnableParams struct {
+	// If true, the `Page.fileChooserOpened` event will be emitted regardless of
+	// the state set by `Page.setInterceptFileChooserDialog` command (default:
+	// false).
+	//
+	// This is an experimental part of the protocol.
+	EnableFileChooserOpenedEvent bool `json:"enableFileChooserOpenedEvent,omitempty"`
+}
+
+// Command returns the Page.enable command for use with AsyncDebugger.Send.
+func (p EnableParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandEnable, p)
+}
+
+// Do sends Page.enable to d and waits for it to complete or for ctx to
+// be done.
+func (p EnableParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandEnable, p, nil)
+}
+
+// GetAppManifestParams are the parameters of Page.getAppManifest.
+//
+// Gets the processed manifest for this current document. This API always waits
+// for the manifest to be loaded. If manifestId is provided, and it does not
+// match the manifest of the current document, this API errors out. If there is
+// not a loaded page, this API errors out immediately.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#method-getAppManifest
+type GetAppManifestParams struct {
+	ManifestID string `json:"manifestId,omitempty"`
+}
+
+// Command returns the Page.getAppManifest command for use with
+// AsyncDebugger.Send.
+func (p GetAppManifestParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandGetAppManifest, p)
+}
+
+// GetAppManifestReturns are the values returned by Page.getAppManifest.
+type GetAppManifestReturns struct {
+	// Manifest location.
+	URL    string             `json:"url"`
+	Errors []AppManifestError `json:"errors"`
+	// Manifest content.
+	Data string `json:"data,omitempty"`
+	// Parsed manifest properties. Deprecated, use manifest instead.
+	//
+	// This is an experimental part of the protocol.
+	//
+	// Deprecated: this is deprecated in the protocol.
+	Parsed *AppManifestParsedProperties `json:"parsed,omitempty"`
+	// This is an experimental part of the protocol.
+	Manifest WebAppManifest `json:"manifest"`
+}
+
+// Do sends Page.getAppManifest to d and waits for its result or for ctx to be
+// done.
+func (p GetAppManifestParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*GetAppManifestReturns, error) {
+	ret := &GetAppManifestReturns{}
+	if err := protocol.Do(ctx, d, CommandGetAppManifest, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// GetInstallabilityErrorsParams are the parameters of
+// Page.getInstallabilityErrors.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#method-getInstallabilityErrors
+//
+// This is an experimental part of the protocol.
+type GetInstallabilityErrorsParams struct {
+}
+
+// Command returns the Page.getInstallabilityErrors command for use with
+// AsyncDebugger.Send.
+func (p GetInstallabilityErrorsParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandGetInstallabilityErrors, p)
+}
+
+// GetInstallabilityErrorsReturns are the values returned by Page.getInstallabilityErrors.
+type GetInstallabilityErrorsReturns struct {
+	InstallabilityErrors []InstallabilityError `json:"installabilityErrors"`
+}
+
+// Do sends Page.getInstallabilityErrors to d and waits for its result or for ctx to be
+// done.
+func (p GetInstallabilityErrorsParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*GetInstallabilityErrorsReturns, error) {
+	ret := &GetInstallabilityErrorsReturns{}
+	if err := protocol.Do(ctx, d, CommandGetInstallabilityErrors, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// GetManifestIconsParams are the parameters of Page.getManifestIcons.
+//
+// Deprecated because it's not guaranteed that the returned icon is in fact the
+// one used for PWA installation.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#method-getManifestIcons
+//
+// This is an experimental part of the protocol.
+//
+// Deprecated: this is deprecated in the protocol.
+type GetManifestIconsParams struct {
+}
+
+// Command returns the Page.getManifestIcons command for use with
+// AsyncDebugger.Send.
+func (p GetManifestIconsParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandGetManifestIcons, p)
+}
+
+// GetManifestIconsReturns are the values returned by Page.getManifestIcons.
+type GetManifestIconsReturns struct {
+	PrimaryIcon string `json:"primaryIcon,omitempty"`
+}
+
+// Do sends Page.getManifestIcons to d and waits for its result or for ctx to be
+// done.
+func (p GetManifestIconsParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*GetManifestIconsReturns, error) {
+	ret := &GetManifestIconsReturns{}
+	if err := protocol.Do(ctx, d, CommandGetManifestIcons, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// GetAppIDParams are the parameters of Page.getAppId.
+//
+// Returns the unique (PWA) app id. Only returns values if the feature flag
+// 'WebAppEnableManifestId' is enabled
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#method-getAppId
+//
+// This is an experimental part of the protocol.
+type GetAppIDParams struct {
+}
+
+// Command returns the Page.getAppId command for use with AsyncDebugger.Send.
+func (p GetAppIDParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandGetAppID, p)
+}
+
+// GetAppIDReturns are the values returned by Page.getAppId.
+type GetAppIDReturns struct {
+	// App id, either from manifest's id attribute or computed from start_url
+	AppID string `json:"appId,omitempty"`
+	// Recommendation for manifest's id attribute to match current id computed from
+	// start_url
+	RecommendedID string `json:"recommendedId,omitempty"`
+}
+
+// Do sends Page.getAppId to d and waits for its result or for ctx to be
+// done.
+func (p GetAppIDParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*GetAppIDReturns, error) {
+	ret := &GetAppIDReturns{}
+	if err := protocol.Do(ctx, d, CommandGetAppID, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// GetAdScriptAncestryParams are the parameters of Page.getAdScriptAncestry.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#method-getAdScriptAncestry
+//
+// This is an experimental part of the protocol.
+type GetAdScriptAncestryParams struct {
+	FrameID FrameID `json:"frameId"`
+}
+
+// Command returns the Page.getAdScriptAncestry command for use with
+// AsyncDebugger.Send.
+func (p GetAdScriptAncestryParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandGetAdScriptAncestry, p)
+}
+
+// GetAdScriptAncestryReturns are the values returned by Page.getAdScriptAncestry.
+type GetAdScriptAncestryReturns struct {
+	// The ancestry chain of ad script identifiers leading to this frame's
+	// creation, along with the root script's filterlist rule. The ancestry chain
+	// is ordered from the most immediate script (in the frame creation stack) to
+	// more distant ancestors (that created the immediately preceding script). Only
+	// sent if frame is labelled as an ad and ids are available.
+	AdScriptAncestry *AdScriptAncestry `json:"adScriptAncestry,omitempty"`
+}
+
+// Do sends Page.getAdScriptAncestry to d and waits for its result or for ctx to be
+// done.
+func (p GetAdScriptAncestryParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*GetAdScriptAncestryReturns, error) {
+	ret := &GetAdScriptAncestryReturns{}
+	if err := protocol.Do(ctx, d, CommandGetAdScriptAncestry, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// GetFrameTreeParams are the parameters of Page.getFrameTree.
+//
+// Returns present frame tree structure.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#method-getFrameTree
+type GetFrameTreeParams struct {
+}
+
+// Command returns the Page.getFrameTree command for use with
+// AsyncDebugger.Send.
+func (p GetFrameTreeParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandGetFrameTree, p)
+}
+
+// GetFrameTreeReturns are the values returned by Page.getFrameTree.
+type GetFrameTreeReturns struct {
+	// Present frame tree structure.
+	FrameTree FrameTree `json:"frameTree"`
+}
+
+// Do sends Page.getFrameTree to d and waits for its result or for ctx to be
+// done.
+func (p GetFrameTreeParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*GetFrameTreeReturns, error) {
+	ret := &GetFrameTreeReturns{}
+	if err := protocol.Do(ctx, d, CommandGetFrameTree, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// GetLayoutMetricsParams are the parameters of Page.getLayoutMetrics.
+//
+// Returns metrics relating to the layouting of the page, such as viewport
+// bounds/scale.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#method-getLayoutMetrics
+type GetLayoutMetricsParams struct {
+}
+
+// Command returns the Page.getLayoutMetrics command for use with
+// AsyncDebugger.Send.
+func (p GetLayoutMetricsParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandGetLayoutMetrics, p)
+}
+
+// GetLayoutMetricsReturns are the values returned by Page.getLayoutMetrics.
+type GetLayoutMetricsReturns struct {
+	// Deprecated metrics relating to the layout viewport. Is in device pixels. Use
+	// `cssLayoutViewport` instead.
+	//
+	// Deprecated: this is deprecated in the protocol.
+	LayoutViewport LayoutViewport `json:"layoutViewport"`
+	// Deprecated metrics relating to the visual viewport. Is in device pixels. Use
+	// `cssVisualViewport` instead.
+	//
+	// Deprecated: this is deprecated in the protocol.
+	VisualViewport VisualViewport `json:"visualViewport"`
+	// Deprecated size of scrollable area. Is in DP. Use `cssContentSize` instead.
+	//
+	// Deprecated: this is deprecated in the protocol.
+	ContentSize types.DOMRect `json:"contentSize"`
+	// Metrics relating to the layout viewport in CSS pixels.
+	CSSLayoutViewport LayoutViewport `json:"cssLayoutViewport"`
+	// Metrics relating to the visual viewport in CSS pixels.
+	CSSVisualViewport VisualViewport `json:"cssVisualViewport"`
+	// Size of scrollable area in CSS pixels.
+	CSSContentSize types.DOMRect `json:"cssContentSize"`
+}
+
+// Do sends Page.getLayoutMetrics to d and waits for its result or for ctx to be
+// done.
+func (p GetLayoutMetricsParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*GetLayoutMetricsReturns, error) {
+	ret := &GetLayoutMetricsReturns{}
+	if err := protocol.Do(ctx, d, CommandGetLayoutMetrics, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// GetNavigationHistoryParams are the parameters of Page.getNavigationHistory.
+//
+// Returns navigation history for the current page.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#method-getNavigationHistory
+type GetNavigationHistoryParams struct {
+}
+
+// Command returns the Page.getNavigationHistory command for use with
+// AsyncDebugger.Send.
+func (p GetNavigationHistoryParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandGetNavigationHistory, p)
+}
+
+// GetNavigationHistoryReturns are the values returned by Page.getNavigationHistory.
+type GetNavigationHistoryReturns struct {
+	// Index of the current navigation history entry.
+	CurrentIndex int64 `json:"currentIndex"`
+	// Array of navigation history entries.
+	Entries []NavigationEntry `json:"entries"`
+}
+
+// Do sends Page.getNavigationHistory to d and waits for its result or for ctx to be
+// done.
+func (p GetNavigationHistoryParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*GetNavigationHistoryReturns, error) {
+	ret := &GetNavigationHistoryReturns{}
+	if err := protocol.Do(ctx, d, CommandGetNavigationHistory, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// ResetNavigationHistoryParams are the parameters of
+// Page.resetNavigationHistory.
+//
+// Resets navigation history for the current page.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#method-resetNavigationHistory
+type ResetNavigationHistoryParams struct {
+}
+
+// Command returns the Page.resetNavigationHistory command for use with
+// AsyncDebugger.Send.
+func (p ResetNavigationHistoryParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandResetNavigationHistory, p)
+}
+
+// Do sends Page.resetNavigationHistory to d and waits for it to complete or for ctx to
+// be done.
+func (p ResetNavigationHistoryParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandResetNavigationHistory, p, nil)
+}
+
+// GetResourceContentParams are the parameters of Page.getResourceContent.
+//
+// Returns content of the given resource.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#method-getResourceContent
+//
+// This is an experimental part of the protocol.
+type GetResourceContentParams struct {
+	// Frame id to get resource for.
+	FrameID FrameID `json:"frameId"`
+	// URL of the resource to get content for.
+	URL string `json:"url"`
+}
+
+// Command returns the Page.getResourceContent command for use with
+// AsyncDebugger.Send.
+func (p GetResourceContentParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandGetResourceContent, p)
+}
+
+// GetResourceContentReturns are the values returned by Page.getResourceContent.
+type GetResourceContentReturns struct {
+	// Resource content.
+	Content string `json:"content"`
+	// True, if content was served as base64.
+	Base64Encoded bool `json:"base64Encoded"`
+}
+
+// Do sends Page.getResourceContent to d and waits for its result or for ctx to be
+// done.
+func (p GetResourceContentParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*GetResourceContentReturns, error) {
+	ret := &GetResourceContentReturns{}
+	if err := protocol.Do(ctx, d, CommandGetResourceContent, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// GetResourceTreeParams are the parameters of Page.getResourceTree.
+//
+// Returns present frame / resource tree structure.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#method-getResourceTree
+//
+// This is an experimental part of the protocol.
+type GetResourceTreeParams struct {
+}
+
+// Command returns the Page.getResourceTree command for use with
+// AsyncDebugger.Send.
+func (p GetResourceTreeParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandGetResourceTree, p)
+}
+
+// GetResourceTreeReturns are the values returned by Page.getResourceTree.
+type GetResourceTreeReturns struct {
+	// Present frame / resource tree structure.
+	FrameTree FrameResourceTree `json:"frameTree"`
+}
+
+// Do sends Page.getResourceTree to d and waits for its result or for ctx to be
+// done.
+func (p GetResourceTreeParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*GetResourceTreeReturns, error) {
+	ret := &GetResourceTreeReturns{}
+	if err := protocol.Do(ctx, d, CommandGetResourceTree, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// HandleJavaScriptDialogParams are the parameters of
+// Page.handleJavaScriptDialog.
+//
+// Accepts or dismisses a JavaScript initiated dialog (alert, confirm, prompt,
+// or onbeforeunload).
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#method-handleJavaScriptDialog
+type HandleJavaScriptDialogParams struct {
+	// Whether to accept or dismiss the dialog.
+	Accept bool `json:"accept"`
+	// The text to enter into the dialog prompt before accepting. Used only if this
+	// is a prompt dialog.
+	PromptText string `json:"promptText,omitempty"`
+}
+
+// Command returns the Page.handleJavaScriptDialog command for use with
+// AsyncDebugger.Send.
+func (p HandleJavaScriptDialogParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandHandleJavaScriptDialog, p)
+}
+
+// Do sends Page.handleJavaScriptDialog to d and waits for it to complete or for ctx to
+// be done.
+func (p HandleJavaScriptDialogParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandHandleJavaScriptDialog, p, nil)
+}
+
+// NavigateParams are the parameters of Page.navigate.
+//
+// Navigates current page to the given URL.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#method-navigate
+type NavigateParams struct {
+	// URL to navigate the page to.
+	URL string `json:"url"`
+	// Referrer URL.
+	Referrer string `json:"referrer,omitempty"`
+	// Intended transition type.
+	TransitionType TransitionType `json:"transitionType,omitempty"`
+	// Frame id to navigate, if not specified navigates the top frame.
+	FrameID FrameID `json:"frameId,omitempty"`
+	// Referrer-policy used for the navigation.
+	//
+	// This is an experimental part of the protocol.
+	ReferrerPolicy ReferrerPolicy `json:"referrerPolicy,omitempty"`
+}
+
+// Command returns the Page.navigate command for use with AsyncDebugger.Send.
+func (p NavigateParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandNavigate, p)
+}
+
+// NavigateReturns are the values returned by Page.navigate.
+type NavigateReturns struct {
+	// Frame id that has navigated (or failed to navigate)
+	FrameID FrameID `json:"frameId"`
+	// Loader identifier. This is omitted in case of same-document navigation, as
+	// the previously committed loaderId would not change.
+	LoaderID types.NetworkLoaderID `json:"loaderId,omitempty"`
+	// User friendly error message, present if and only if navigation has failed.
+	ErrorText string `json:"errorText,omitempty"`
+	// Whether the navigation resulted in a download.
+	//
+	// This is an experimental part of the protocol.
+	IsDownload bool `json:"isDownload,omitempty"`
+}
+
+// Do sends Page.navigate to d and waits for its result or for ctx to be
+// done.
+func (p NavigateParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*NavigateReturns, error) {
+	ret := &NavigateReturns{}
+	if err := protocol.Do(ctx, d, CommandNavigate, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// NavigateToHistoryEntryParams are the parameters of
+// Page.navigateToHistoryEntry.
+//
+// Navigates current page to the given history entry.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#method-navigateToHistoryEntry
+type NavigateToHistoryEntryParams struct {
+	// Unique id of the entry to navigate to.
+	EntryID int64 `json:"entryId"`
+}
+
+// Command returns the Page.navigateToHistoryEntry command for use with
+// AsyncDebugger.Send.
+func (p NavigateToHistoryEntryParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandNavigateToHistoryEntry, p)
+}
+
+// Do sends Page.navigateToHistoryEntry to d and waits for it to complete or for ctx to
+// be done.
+func (p NavigateToHistoryEntryParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandNavigateToHistoryEntry, p, nil)
+}
+
+// PrintToPDFParams are the parameters of Page.printToPDF.
+//
+// Print page as PDF.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#method-printToPDF
+type PrintToPDFParams struct {
+	// Paper orientation. Defaults to false.
+	Landscape bool `json:"landscape,omitempty"`
+	// Display header and footer. Defaults to false.
+	DisplayHeaderFooter bool `json:"displayHeaderFooter,omitempty"`
+	// Print background graphics. Defaults to false.
+	PrintBackground bool `json:"printBackground,omitempty"`
+	// Scale of the webpage rendering. Defaults to 1.
+	Scale float64 `json:"scale,omitempty"`
+	// Paper width in inches. Defaults to 8.5 inches.
+	PaperWidth float64 `json:"paperWidth,omitempty"`
+	// Paper height in inches. Defaults to 11 inches.
+	PaperHeight float64 `json:"paperHeight,omitempty"`
+	// Top margin in inches. Defaults to 1cm (~0.4 inches).
+	MarginTop float64 `json:"marginTop,omitempty"`
+	// Bottom margin in inches. Defaults to 1cm (~0.4 inches).
+	MarginBottom float64 `json:"marginBottom,omitempty"`
+	// Left margin in inches. Defaults to 1cm (~0.4 inches).
+	MarginLeft float64 `json:"marginLeft,omitempty"`
+	// Right margin in inches. Defaults to 1cm (~0.4 inches).
+	MarginRight float64 `json:"marginRight,omitempty"`
+	// Paper ranges to print, one based, e.g., '1-5, 8, 11-13'. Pages are printed
+	// in the document order, not in the order specified, and no more than once.
+	// Defaults to empty string, which implies the entire document is printed. The
+	// page numbers are quietly capped to actual page count of the document, and
+	// ranges beyond the end of the document are ignored. If this results in no
+	// pages to print, an error is reported. It is an error to specify a range with
+	// start greater than end.
+	PageRanges string `json:"pageRanges,omitempty"`
+	// HTML template for the print header. Should be valid HTML markup with
+	// following classes used to inject printing values into them:
+	// - `date`: formatted print date
+	// - `title`: document title
+	// - `url`: document location
+	// - `pageNumber`: current page number
+	// - `totalPages`: total pages in the document
+	//
+	// For example, `<span class=title></span>` would generate span containing the
+	// title.
+	HeaderTemplate string `json:"headerTemplate,omitempty"`
+	// HTML template for the print footer. Should use the same format as the
+	// `headerTemplate`.
+	FooterTemplate string `json:"footerTemplate,omitempty"`
+	// Whether or not to prefer page size as defined by css. Defaults to false, in
+	// which case the content will be scaled to fit the paper size.
+	PreferCSSPageSize bool `json:"preferCSSPageSize,omitempty"`
+	// return as stream
+	//
+	// Allowed values: ReturnAsBase64, ReturnAsStream.
+	//
+	// This is an experimental part of the protocol.
+	TransferMode string `json:"transferMode,omitempty"`
+	// Whether or not to generate tagged (accessible) PDF. Defaults to embedder
+	// choice.
+	//
+	// This is an experimental part of the protocol.
+	GenerateTaggedPDF bool `json:"generateTaggedPDF,omitempty"`
+	// Whether or not to embed the document outline into the PDF.
+	//
+	// This is an experimental part of the protocol.
+	GenerateDocumentOutline bool `json:"generateDocumentOutline,omitempty"`
+}
+
+// Command returns the Page.printToPDF command for use with AsyncDebugger.Send.
+func (p PrintToPDFParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandPrintToPDF, p)
+}
+
+// PrintToPDFReturns are the values returned by Page.printToPDF.
+type PrintToPDFReturns struct {
+	// Base64-encoded pdf data. Empty if |returnAsStream| is specified. (Encoded as
+	// a base64 string when passed over JSON)
+	Data string `json:"data"`
+	// A handle of the stream that holds resulting PDF data.
+	//
+	// This is an experimental part of the protocol.
+	Stream types.IOStreamHandle `json:"stream,omitempty"`
+}
+
+// Do sends Page.printToPDF to d and waits for its result or for ctx to be
+// done.
+func (p PrintToPDFParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*PrintToPDFReturns, error) {
+	ret := &PrintToPDFReturns{}
+	if err := protocol.Do(ctx, d, CommandPrintToPDF, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// ReloadParams are the parameters of Page.reload.
+//
+// Reloads given page optionally ignoring the cache.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#method-reload
+type ReloadParams struct {
+	// If true, browser cache is ignored (as if the user pressed Shift+refresh).
+	IgnoreCache bool `json:"ignoreCache,omitempty"`
+	// If set, the script will be injected into all frames of the inspected page
+	// after reload. Argument will be ignored if reloading dataURL origin.
+	ScriptToEvaluateOnLoad string `json:"scriptToEvaluateOnLoad,omitempty"`
+	// If set, an error will be thrown if the target page's main frame's loader id
+	// does not match the provided id. This prevents accidentally reloading an
+	// unintended target in case there's a racing navigation.
+	//
+	// This is an experimental part of the protocol.
+	LoaderID types.NetworkLoaderID `json:"loaderId,omitempty"`
+}
+
+// Command returns the Page.reload command for use with AsyncDebugger.Send.
+func (p ReloadParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandReload, p)
+}
+
+// Do sends Page.reload to d and waits for it to complete or for ctx to
+// be done.
+func (p ReloadParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandReload, p, nil)
+}
+
+// RemoveScriptToEvaluateOnLoadParams are the parameters of
+// Page.removeScriptToEvaluateOnLoad.
+//
+// Deprecated, please use removeScriptToEvaluateOnNewDocument instead.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#method-removeScriptToEvaluateOnLoad
+//
+// This is an experimental part of the protocol.
+//
+// Deprecated: this is deprecated in the protocol.
+type RemoveScriptToEvaluateOnLoadParams struct {
+	Identifier ScriptIdentifier `json:"identifier"`
+}
+
+// Command returns the Page.removeScriptToEvaluateOnLoad command for use with
+// AsyncDebugger.Send.
+func (p RemoveScriptToEvaluateOnLoadParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandRemoveScriptToEvaluateOnLoad, p)
+}
+
+// Do sends Page.removeScriptToEvaluateOnLoad to d and waits for it to complete or for ctx to
+// be done.
+func (p RemoveScriptToEvaluateOnLoadParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandRemoveScriptToEvaluateOnLoad, p, nil)
+}
+
+// RemoveScriptToEvaluateOnNewDocumentParams are the parameters of
+// Page.removeScriptToEvaluateOnNewDocument.
+//
+// Removes given script from the list.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#method-removeScriptToEvaluateOnNewDocument
+type RemoveScriptToEvaluateOnNewDocumentParams struct {
+	Identifier ScriptIdentifier `json:"identifier"`
+}
+
+// Command returns the Page.removeScriptToEvaluateOnNewDocument command for use
+// with AsyncDebugger.Send.
+func (p RemoveScriptToEvaluateOnNewDocumentParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandRemoveScriptToEvaluateOnNewDocument, p)
+}
+
+// Do sends Page.removeScriptToEvaluateOnNewDocument to d and waits for it to complete or for ctx to
+// be done.
+func (p RemoveScriptToEvaluateOnNewDocumentParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandRemoveScriptToEvaluateOnNewDocument, p, nil)
+}
+
+// ScreencastFrameAckParams are the parameters of Page.screencastFrameAck.
+//
+// Acknowledges that a screencast frame has been received by the frontend.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#method-screencastFrameAck
+//
+// This is an experimental part of the protocol.
+type ScreencastFrameAckParams struct {
+	// Frame number.
+	SessionID int64 `json:"sessionId"`
+}
+
+// Command returns the Page.screencastFrameAck command for use with
+// AsyncDebugger.Send.
+func (p ScreencastFrameAckParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandScreencastFrameAck, p)
+}
+
+// Do sends Page.screencastFrameAck to d and waits for it to complete or for ctx to
+// be done.
+func (p ScreencastFrameAckParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandScreencastFrameAck, p, nil)
+}
+
+// SearchInResourceParams are the parameters of Page.searchInResource.
+//
+// Searches for given string in resource content.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#method-searchInResource
+//
+// This is an experimental part of the protocol.
+type SearchInResourceParams struct {
+	// Frame id for resource to search in.
+	FrameID FrameID `json:"frameId"`
+	// URL of the resource to search in.
+	URL string `json:"url"`
+	// String to search for.
+	Query string `json:"query"`
+	// If true, search is case sensitive.
+	CaseSensitive bool `json:"caseSensitive,omitempty"`
+	// If true, treats string parameter as regex.
+	IsRegex bool `json:"isRegex,omitempty"`
+}
+
+// Command returns the Page.searchInResource command for use with
+// AsyncDebugger.Send.
+func (p SearchInResourceParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSearchInResource, p)
+}
+
+// SearchInResourceReturns are the values returned by Page.searchInResource.
+type SearchInResourceReturns struct {
+	// List of search matches.
+	Result []types.DebuggerSearchMatch `json:"result"`
+}
+
+// Do sends Page.searchInResource to d and waits for its result or for ctx to be
+// done.
+func (p SearchInResourceParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*SearchInResourceReturns, error) {
+	ret := &SearchInResourceReturns{}
+	if err := protocol.Do(ctx, d, CommandSearchInResource, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// SetAdBlockingEnabledParams are the parameters of Page.setAdBlockingEnabled.
+//
+// Enable Chrome's experimental ad filter on all sites.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#method-setAdBlockingEnabled
+//
+// This is an experimental part of the protocol.
+type SetAdBlockingEnabledParams struct {
+	// Whether to block ads.
+	Enabled bool `json:"enabled"`
+}
+
+// Command returns the Page.setAdBlockingEnabled command for use with
+// AsyncDebugger.Send.
+func (p SetAdBlockingEnabledParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetAdBlockingEnabled, p)
+}
+
+// Do sends Page.setAdBlockingEnabled to d and waits for it to complete or for ctx to
+// be done.
+func (p SetAdBlockingEnabledParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetAdBlockingEnabled, p, nil)
+}
+
+// SetBypassCSPParams are the parameters of Page.setBypassCSP.
+//
+// Enable page Content Security Policy by-passing.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#method-setBypassCSP
+type SetBypassCSPParams struct {
+	// Whether to bypass page CSP.
+	Enabled bool `json:"enabled"`
+}
+
+// Command returns the Page.setBypassCSP command for use with
+// AsyncDebugger.Send.
+func (p SetBypassCSPParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetBypassCSP, p)
+}
+
+// Do sends Page.setBypassCSP to d and waits for it to complete or for ctx to
+// be done.
+func (p SetBypassCSPParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetBypassCSP, p, nil)
+}
+
+// GetPermissionsPolicyStateParams are the parameters of
+// Page.getPermissionsPolicyState.
+//
+// Get Permissions Policy state on given frame.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#method-getPermissionsPolicyState
+//
+// This is an experimental part of the protocol.
+type GetPermissionsPolicyStateParams struct {
+	FrameID FrameID `json:"frameId"`
+}
+
+// Command returns the Page.getPermissionsPolicyState command for use with
+// AsyncDebugger.Send.
+func (p GetPermissionsPolicyStateParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandGetPermissionsPolicyState, p)
+}
+
+// GetPermissionsPolicyStateReturns are the values returned by Page.getPermissionsPolicyState.
+type GetPermissionsPolicyStateReturns struct {
+	States []PermissionsPolicyFeatureState `json:"states"`
+}
+
+// Do sends Page.getPermissionsPolicyState to d and waits for its result or for ctx to be
+// done.
+func (p GetPermissionsPolicyStateParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*GetPermissionsPolicyStateReturns, error) {
+	ret := &GetPermissionsPolicyStateReturns{}
+	if err := protocol.Do(ctx, d, CommandGetPermissionsPolicyState, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// GetOriginTrialsParams are the parameters of Page.getOriginTrials.
+//
+// Get Origin Trials on given frame.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#method-getOriginTrials
+//
+// This is an experimental part of the protocol.
+type GetOriginTrialsParams struct {
+	FrameID FrameID `json:"frameId"`
+}
+
+// Command returns the Page.getOriginTrials command for use with
+// AsyncDebugger.Send.
+func (p GetOriginTrialsParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandGetOriginTrials, p)
+}
+
+// GetOriginTrialsReturns are the values returned by Page.getOriginTrials.
+type GetOriginTrialsReturns struct {
+	OriginTrials []OriginTrial `json:"originTrials"`
+}
+
+// Do sends Page.getOriginTrials to d and waits for its result or for ctx to be
+// done.
+func (p GetOriginTrialsParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*GetOriginTrialsReturns, error) {
+	ret := &GetOriginTrialsReturns{}
+	if err := protocol.Do(ctx, d, CommandGetOriginTrials, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// SetDeviceMetricsOverrideParams are the parameters of
+// Page.setDeviceMetricsOverride.
+//
+// Overrides the values of device screen dimensions (window.screen.width,
+// window.screen.height, window.innerWidth, window.innerHeight, and
+// "device-width"/"device-height"-related CSS media query results).
+//
+// Redirects to the Emulation domain.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#method-setDeviceMetricsOverride
+//
+// This is an experimental part of the protocol.
+//
+// Deprecated: this is deprecated in the protocol.
+type SetDeviceMetricsOverrideParams struct {
+	// Overriding width value in pixels (minimum 0, maximum 10000000). 0 disables
+	// the override.
+	Width int64 `json:"width"`
+	// Overriding height value in pixels (minimum 0, maximum 10000000). 0 disables
+	// the override.
+	Height int64 `json:"height"`
+	// Overriding device scale factor value. 0 disables the override.
+	DeviceScaleFactor float64 `json:"deviceScaleFactor"`
+	// Whether to emulate mobile device. This includes viewport meta tag, overlay
+	// scrollbars, text autosizing and more.
+	Mobile bool `json:"mobile"`
+	// Scale to apply to resulting view image.
+	Scale float64 `json:"scale,omitempty"`
+	// Overriding screen width value in pixels (minimum 0, maximum 10000000).
+	ScreenWidth int64 `json:"screenWidth,omitempty"`
+	// Overriding screen height value in pixels (minimum 0, maximum 10000000).
+	ScreenHeight int64 `json:"screenHeight,omitempty"`
+	// Overriding view X position on screen in pixels (minimum 0, maximum
+	// 10000000).
+	PositionX int64 `json:"positionX,omitempty"`
+	// Overriding view Y position on screen in pixels (minimum 0, maximum
+	// 10000000).
+	PositionY int64 `json:"positionY,omitempty"`
+	// Do not set visible view size, rely upon explicit setVisibleSize call.
+	DontSetVisibleSize bool `json:"dontSetVisibleSize,omitempty"`
+	// Screen orientation override.
+	ScreenOrientation *types.EmulationScreenOrientation `json:"screenOrientation,omitempty"`
+	// The viewport dimensions and scale. If not set, the override is cleared.
+	Viewport *Viewport `json:"viewport,omitempty"`
+}
+
+// Command returns the Page.setDeviceMetricsOverride command for use with
+// AsyncDebugger.Send.
+func (p SetDeviceMetricsOverrideParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetDeviceMetricsOverride, p)
+}
+
+// Do sends Page.setDeviceMetricsOverride to d and waits for it to complete or for ctx to
+// be done.
+func (p SetDeviceMetricsOverrideParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetDeviceMetricsOverride, p, nil)
+}
+
+// SetDeviceOrientationOverrideParams are the parameters of
+// Page.setDeviceOrientationOverride.
+//
+// Overrides the Device Orientation.
+//
+// Redirects to the DeviceOrientation domain.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#method-setDeviceOrientationOverride
+//
+// This is an experimental part of the protocol.
+//
+// Deprecated: this is deprecated in the protocol.
+type SetDeviceOrientationOverrideParams struct {
+	// Mock alpha
+	Alpha float64 `json:"alpha"`
+	// Mock beta
+	Beta float64 `json:"beta"`
+	// Mock gamma
+	Gamma float64 `json:"gamma"`
+}
+
+// Command returns the Page.setDeviceOrientationOverride command for use with
+// AsyncDebugger.Send.
+func (p SetDeviceOrientationOverrideParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetDeviceOrientationOverride, p)
+}
+
+// Do sends Page.setDeviceOrientationOverride to d and waits for it to complete or for ctx to
+// be done.
+func (p SetDeviceOrientationOverrideParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetDeviceOrientationOverride, p, nil)
+}
+
+// SetFontFamiliesParams are the parameters of Page.setFontFamilies.
+//
+// Set generic font families.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#method-setFontFamilies
+//
+// This is an experimental part of the protocol.
+type SetFontFamiliesParams struct {
+	// Specifies font families to set. If a font family is not specified, it won't
+	// be changed.
+	FontFamilies FontFamilies `json:"fontFamilies"`
+	// Specifies font families to set for individual scripts.
+	ForScripts []ScriptFontFamilies `json:"forScripts,omitempty"`
+}
+
+// Command returns the Page.setFontFamilies command for use with
+// AsyncDebugger.Send.
+func (p SetFontFamiliesParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetFontFamilies, p)
+}
+
+// Do sends Page.setFontFamilies to d and waits for it to complete or for ctx to
+// be done.
+func (p SetFontFamiliesParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetFontFamilies, p, nil)
+}
+
+// SetFontSizesParams are the parameters of Page.setFontSizes.
+//
+// Set default font sizes.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#method-setFontSizes
+//
+// This is an experimental part of the protocol.
+type SetFontSizesParams struct {
+	// Specifies font sizes to set. If a font size is not specified, it won't be
+	// changed.
+	FontSizes FontSizes `json:"fontSizes"`
+}
+
+// Command returns the Page.setFontSizes command for use with
+// AsyncDebugger.Send.
+func (p SetFontSizesParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetFontSizes, p)
+}
+
+// Do sends Page.setFontSizes to d and waits for it to complete or for ctx to
+// be done.
+func (p SetFontSizesParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetFontSizes, p, nil)
+}
+
+// SetDocumentContentParams are the parameters of Page.setDocumentContent.
+//
+// Sets given markup as the document's HTML.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#method-setDocumentContent
+type SetDocumentContentParams struct {
+	// Frame id to set HTML for.
+	FrameID FrameID `json:"frameId"`
+	// HTML content to set.
+	HTML string `json:"html"`
+}
+
+// Command returns the Page.setDocumentContent command for use with
+// AsyncDebugger.Send.
+func (p SetDocumentContentParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetDocumentContent, p)
+}
+
+// Do sends Page.setDocumentContent to d and waits for it to complete or for ctx to
+// be done.
+func (p SetDocumentContentParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetDocumentContent, p, nil)
+}
+
+// SetDownloadBehaviorParams are the parameters of Page.setDownloadBehavior.
+//
+// Set the behavior when downloading a file.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#method-setDownloadBehavior
+//
+// This is an experimental part of the protocol.
+//
+// Deprecated: this is deprecated in the protocol.
+type SetDownloadBehaviorParams struct {
+	// Whether to allow all or deny all download requests, or use default Chrome
+	// behavior if available (otherwise deny).
+	//
+	// Allowed values: deny, allow, default.
+	Behavior string `json:"behavior"`
+	// The default path to save downloaded files to. This is required if behavior
+	// is set to 'allow'
+	DownloadPath string `json:"downloadPath,omitempty"`
+}
+
+// Command returns the Page.setDownloadBehavior command for use with
+// AsyncDebugger.Send.
+func (p SetDownloadBehaviorParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetDownloadBehavior, p)
+}
+
+// Do sends Page.setDownloadBehavior to d and waits for it to complete or for ctx to
+// be done.
+func (p SetDownloadBehaviorParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetDownloadBehavior, p, nil)
+}
+
+// SetGeolocationOverrideParams are the parameters of
+// Page.setGeolocationOverride.
+//
+// Overrides the Geolocation Position or Error. Omitting any of the parameters
+// emulates position unavailable.
+//
+// Redirects to the Emulation domain.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#method-setGeolocationOverride
+//
+// Deprecated: this is deprecated in the protocol.
+type SetGeolocationOverrideParams struct {
+	// Mock latitude
+	Latitude float64 `json:"latitude,omitempty"`
+	// Mock longitude
+	Longitude float64 `json:"longitude,omitempty"`
+	// Mock accuracy
+	Accuracy float64 `json:"accuracy,omitempty"`
+}
+
+// Command returns the Page.setGeolocationOverride command for use with
+// AsyncDebugger.Send.
+func (p SetGeolocationOverrideParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetGeolocationOverride, p)
+}
+
+// Do sends Page.setGeolocationOverride to d and waits for it to complete or for ctx to
+// be done.
+func (p SetGeolocationOverrideParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetGeolocationOverride, p, nil)
+}
+
+// SetLifecycleEventsEnabledParams are the parameters of
+// Page.setLifecycleEventsEnabled.
+//
+// Controls whether page will emit lifecycle events.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#method-setLifecycleEventsEnabled
+type SetLifecycleEventsEnabledParams struct {
+	// If true, starts emitting lifecycle events.
+	Enabled bool `json:"enabled"`
+}
+
+// Command returns the Page.setLifecycleEventsEnabled command for use with
+// AsyncDebugger.Send.
+func (p SetLifecycleEventsEnabledParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetLifecycleEventsEnabled, p)
+}
+
+// Do sends Page.setLifecycleEventsEnabled to d and waits for it to complete or for ctx to
+// be done.
+func (p SetLifecycleEventsEnabledParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetLifecycleEventsEnabled, p, nil)
+}
+
+// SetTouchEmulationEnabledParams are the parameters of
+// Page.setTouchEmulationEnabled.
+//
+// Toggles mouse event-based touch event emulation.
+//
+// Redirects to the Emulation domain.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#method-setTouchEmulationEnabled
+//
+// This is an experimental part of the protocol.
+//
+// Deprecated: this is deprecated in the protocol.
+type SetTouchEmulationEnabledParams struct {
+	// Whether the touch event emulation should be enabled.
+	Enabled bool `json:"enabled"`
+	// Touch/gesture events configuration. Default: current platform.
+	//
+	// Allowed values: mobile, desktop.
+	Configuration string `json:"configuration,omitempty"`
+}
+
+// Command returns the Page.setTouchEmulationEnabled command for use with
+// AsyncDebugger.Send.
+func (p SetTouchEmulationEnabledParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetTouchEmulationEnabled, p)
+}
+
+// Do sends Page.setTouchEmulationEnabled to d and waits for it to complete or for ctx to
+// be done.
+func (p SetTouchEmulationEnabledParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetTouchEmulationEnabled, p, nil)
+}
+
+// StartScreencastParams are the parameters of Page.startScreencast.
+//
+// Starts sending each frame using the `screencastFrame` event.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#method-startScreencast
+//
+// This is an experimental part of the protocol.
+type StartScreencastParams struct {
+	// Image compression format.
+	//
+	// Allowed values: jpeg, png.
+	Format string `json:"format,omitempty"`
+	// Compression quality from range [0..100].
+	Quality int64 `json:"quality,omitempty"`
+	// Maximum screenshot width.
+	MaxWidth int64 `json:"maxWidth,omitempty"`
+	// Maximum screenshot height.
+	MaxHeight int64 `json:"maxHeight,omitempty"`
+	// Send every n-th frame.
+	EveryNthFrame int64 `json:"everyNthFrame,omitempty"`
+}
+
+// Command returns the Page.startScreencast command for use with
+// AsyncDebugger.Send.
+func (p StartScreencastParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandStartScreencast, p)
+}
+
+// Do sends Page.startScreencast to d and waits for it to complete or for ctx to
+// be done.
+func (p StartScreencastParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandStartScreencast, p, nil)
+}
+
+// StopLoadingParams are the parameters of Page.stopLoading.
+//
+// Force the page stop all navigations and pending resource fetches.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#method-stopLoading
+type StopLoadingParams struct {
+}
+
+// Command returns the Page.stopLoading command for use with AsyncDebugger.Send.
+func (p StopLoadingParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandStopLoading, p)
+}
+
+// Do sends Page.stopLoading to d and waits for it to complete or for ctx to
+// be done.
+func (p StopLoadingParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandStopLoading, p, nil)
+}
+
+// CrashParams are the parameters of Page.crash.
+//
+// Crashes renderer on the IO thread, generates minidumps.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#method-crash
+//
+// This is an experimental part of the protocol.
+type CrashParams struct {
+}
+
+// Command returns the Page.crash command for use with AsyncDebugger.Send.
+func (p CrashParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandCrash, p)
+}
+
+// Do sends Page.crash to d and waits for it to complete or for ctx to
+// be done.
+func (p CrashParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandCrash, p, nil)
+}
+
+// CloseParams are the parameters of Page.close.
+//
+// Tries to close page, running its beforeunload hooks, if any.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#method-close
+type CloseParams struct {
+}
+
+// Command returns the Page.close command for use with AsyncDebugger.Send.
+func (p CloseParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandClose, p)
+}
+
+// Do sends Page.close to d and waits for it to complete or for ctx to
+// be done.
+func (p CloseParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandClose, p, nil)
+}
+
+// SetWebLifecycleStateParams are the parameters of Page.setWebLifecycleState.
+//
+// Tries to update the web lifecycle state of the page. It will transition the
+// page to the given state according to: https://github.com/WICG/web-lifecycle/
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#method-setWebLifecycleState
+//
+// This is an experimental part of the protocol.
+type SetWebLifecycleStateParams struct {
+	// Target lifecycle state
+	//
+	// Allowed values: frozen, active.
+	State string `json:"state"`
+}
+
+// Command returns the Page.setWebLifecycleState command for use with
+// AsyncDebugger.Send.
+func (p SetWebLifecycleStateParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetWebLifecycleState, p)
+}
+
+// Do sends Page.setWebLifecycleState to d and waits for it to complete or for ctx to
+// be done.
+func (p SetWebLifecycleStateParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetWebLifecycleState, p, nil)
+}
+
+// StopScreencastParams are the parameters of Page.stopScreencast.
+//
+// Stops sending each frame in the `screencastFrame`.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#method-stopScreencast
+//
+// This is an experimental part of the protocol.
+type StopScreencastParams struct {
+}
+
+// Command returns the Page.stopScreencast command for use with
+// AsyncDebugger.Send.
+func (p StopScreencastParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandStopScreencast, p)
+}
+
+// Do sends Page.stopScreencast to d and waits for it to complete or for ctx to
+// be done.
+func (p StopScreencastParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandStopScreencast, p, nil)
+}
+
+// ProduceCompilationCacheParams are the parameters of
+// Page.produceCompilationCache.
+//
+// Requests backend to produce compilation cache for the specified scripts.
+// `scripts` are appended to the list of scripts for which the cache would be
+// produced. The list may be reset during page navigation. When script with a
+// matching URL is encountered, the cache is optionally produced upon backend
+// discretion, based on internal heuristics. See also:
+// `Page.compilationCacheProduced`.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#method-produceCompilationCache
+//
+// This is an experimental part of the protocol.
+type ProduceCompilationCacheParams struct {
+	Scripts []CompilationCacheParams `json:"scripts"`
+}
+
+// Command returns the Page.produceCompilationCache command for use with
+// AsyncDebugger.Send.
+func (p ProduceCompilationCacheParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandProduceCompilationCache, p)
+}
+
+// Do sends Page.produceCompilationCache to d and waits for it to complete or for ctx to
+// be done.
+func (p ProduceCompilationCacheParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandProduceCompilationCache, p, nil)
+}
+
+// AddCompilationCacheParams are the parameters of Page.addCompilationCache.
+//
+// Seeds compilation cache for given url. Compilation cache does not survive
+// cross-process navigation.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#method-addCompilationCache
+//
+// This is an experimental part of the protocol.
+type AddCompilationCacheParams struct {
+	URL string `json:"url"`
+	// Base64-encoded data (Encoded as a base64 string when passed over JSON)
+	Data string `json:"data"`
+}
+
+// Command returns the Page.addCompilationCache command for use with
+// AsyncDebugger.Send.
+func (p AddCompilationCacheParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandAddCompilationCache, p)
+}
+
+// Do sends Page.addCompilationCache to d and waits for it to complete or for ctx to
+// be done.
+func (p AddCompilationCacheParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandAddCompilationCache, p, nil)
+}
+
+// ClearCompilationCacheParams are the parameters of Page.clearCompilationCache.
+//
+// Clears seeded compilation cache.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#method-clearCompilationCache
+//
+// This is an experimental part of the protocol.
+type ClearCompilationCacheParams struct {
+}
+
+// Command returns the Page.clearCompilationCache command for use with
+// AsyncDebugger.Send.
+func (p ClearCompilationCacheParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandClearCompilationCache, p)
+}
+
+// Do sends Page.clearCompilationCache to d and waits for it to complete or for ctx to
+// be done.
+func (p ClearCompilationCacheParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandClearCompilationCache, p, nil)
+}
+
+// SetSPCTransactionModeParams are the parameters of Page.setSPCTransactionMode.
+//
+// Sets the Secure Payment Confirmation transaction mode.
+// https://w3c.github.io/secure-payment-confirmation/#sctn-automation-set-spc-transaction-mode
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#method-setSPCTransactionMode
+//
+// This is an experimental part of the protocol.
+type SetSPCTransactionModeParams struct {
+	// Allowed values: none, autoAccept, autoChooseToAuthAnotherWay, autoReject,
+	// autoOptOut.
+	Mode string `json:"mode"`
+}
+
+// Command returns the Page.setSPCTransactionMode command for use with
+// AsyncDebugger.Send.
+func (p SetSPCTransactionModeParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetSPCTransactionMode, p)
+}
+
+// Do sends Page.setSPCTransactionMode to d and waits for it to complete or for ctx to
+// be done.
+func (p SetSPCTransactionModeParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetSPCTransactionMode, p, nil)
+}
+
+// SetRPHRegistrationModeParams are the parameters of
+// Page.setRPHRegistrationMode.
+//
+// Extensions for Custom Handlers API:
+// https://html.spec.whatwg.org/multipage/system-state.html#rph-automation
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#method-setRPHRegistrationMode
+//
+// This is an experimental part of the protocol.
+type SetRPHRegistrationModeParams struct {
+	// Allowed values: none, autoAccept, autoReject.
+	Mode string `json:"mode"`
+}
+
+// Command returns the Page.setRPHRegistrationMode command for use with
+// AsyncDebugger.Send.
+func (p SetRPHRegistrationModeParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetRPHRegistrationMode, p)
+}
+
+// Do sends Page.setRPHRegistrationMode to d and waits for it to complete or for ctx to
+// be done.
+func (p SetRPHRegistrationModeParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetRPHRegistrationMode, p, nil)
+}
+
+// GenerateTestReportParams are the parameters of Page.generateTestReport.
+//
+// Generates a report for testing.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#method-generateTestReport
+//
+// This is an experimental part of the protocol.
+type GenerateTestReportParams struct {
+	// Message to be displayed in the report.
+	Message string `json:"message"`
+	// Specifies the endpoint group to deliver the report to.
+	Group string `json:"group,omitempty"`
+}
+
+// Command returns the Page.generateTestReport command for use with
+// AsyncDebugger.Send.
+func (p GenerateTestReportParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandGenerateTestReport, p)
+}
+
+// Do sends Page.generateTestReport to d and waits for it to complete or for ctx to
+// be done.
+func (p GenerateTestReportParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandGenerateTestReport, p, nil)
+}
+
+// WaitForDebuggerParams are the parameters of Page.waitForDebugger.
+//
+// Pauses page execution. Can be resumed using generic
+// Runtime.runIfWaitingForDebugger.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#method-waitForDebugger
+//
+// This is an experimental part of the protocol.
+type WaitForDebuggerParams struct {
+}
+
+// Command returns the Page.waitForDebugger command for use with
+// AsyncDebugger.Send.
+func (p WaitForDebuggerParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandWaitForDebugger, p)
+}
+
+// Do sends Page.waitForDebugger to d and waits for it to complete or for ctx to
+// be done.
+func (p WaitForDebuggerParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandWaitForDebugger, p, nil)
+}
+
+// SetInterceptFileChooserDialogParams are the parameters of
+// Page.setInterceptFileChooserDialog.
+//
+// Intercept file chooser requests and transfer control to protocol clients.
+// When file chooser interception is enabled, native file chooser dialog is not
+// shown. Instead, a protocol event `Page.fileChooserOpened` is emitted.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#method-setInterceptFileChooserDialog
+type SetInterceptFileChooserDialogParams struct {
+	Enabled bool `json:"enabled"`
+	// If true, cancels the dialog by emitting relevant events (if any) in addition
+	// to not showing it if the interception is enabled (default: false).
+	//
+	// This is an experimental part of the protocol.
+	Cancel bool `json:"cancel,omitempty"`
+}
+
+// Command returns the Page.setInterceptFileChooserDialog command for use with
+// AsyncDebugger.Send.
+func (p SetInterceptFileChooserDialogParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetInterceptFileChooserDialog, p)
+}
+
+// Do sends Page.setInterceptFileChooserDialog to d and waits for it to complete or for ctx to
+// be done.
+func (p SetInterceptFileChooserDialogParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetInterceptFileChooserDialog, p, nil)
+}
+
+// SetPrerenderingAllowedParams are the parameters of
+// Page.setPrerenderingAllowed.
+//
+// Enable/disable prerendering manually.
+//
+// This command is a short-term solution for https://crbug.com/1440085. See
+// https://docs.google.com/document/d/12HVmFxYj5Jc-eJr5OmWsa2bqTJsbgGLKI6ZIyx0_wpA
+// for more details.
+//
+// TODO(https://crbug.com/1440085): Remove this once Puppeteer supports tab
+// targets.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#method-setPrerenderingAllowed
+//
+// This is an experimental part of the protocol.
+type SetPrerenderingAllowedParams struct {
+	IsAllowed bool `json:"isAllowed"`
+}
+
+// Command returns the Page.setPrerenderingAllowed command for use with
+// AsyncDebugger.Send.
+func (p SetPrerenderingAllowedParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetPrerenderingAllowed, p)
+}
+
+// Do sends Page.setPrerenderingAllowed to d and waits for it to complete or for ctx to
+// be done.
+func (p SetPrerenderingAllowedParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetPrerenderingAllowed, p, nil)
+}
+
+// Method names of the events in the Page domain.
+const (
+	EventDOMContentEventFired            = "Page.domContentEventFired"
+	EventFileChooserOpened               = "Page.fileChooserOpened"
+	EventFrameAttached                   = "Page.frameAttached"
+	EventFrameClearedScheduledNavigation = "Page.frameClearedScheduledNavigation"
+	EventFrameDetached                   = "Page.frameDetached"
+	EventFrameSubtreeWillBeDetached      = "Page.frameSubtreeWillBeDetached"
+	EventFrameNavigated                  = "Page.frameNavigated"
+	EventDocumentOpened                  = "Page.documentOpened"
+	EventFrameResized                    = "Page.frameResized"
+	EventFrameStartedNavigating          = "Page.frameStartedNavigating"
+	EventFrameRequestedNavigation        = "Page.frameRequestedNavigation"
+	EventFrameScheduledNavigation        = "Page.frameScheduledNavigation"
+	EventFrameStartedLoading             = "Page.frameStartedLoading"
+	EventFrameStoppedLoading             = "Page.frameStoppedLoading"
+	EventDownloadWillBegin               = "Page.downloadWillBegin"
+	EventDownloadProgress                = "Page.downloadProgress"
+	EventInterstitialHidden              = "Page.interstitialHidden"
+	EventInterstitialShown               = "Page.interstitialShown"
+	EventJavascriptDialogClosed          = "Page.javascriptDialogClosed"
+	EventJavascriptDialogOpening         = "Page.javascriptDialogOpening"
+	EventLifecycleEvent                  = "Page.lifecycleEvent"
+	EventBackForwardCacheNotUsed         = "Page.backForwardCacheNotUsed"
+	EventLoadEventFired                  = "Page.loadEventFired"
+	EventNavigatedWithinDocument         = "Page.navigatedWithinDocument"
+	EventScreencastFrame                 = "Page.screencastFrame"
+	EventScreencastVisibilityChanged     = "Page.screencastVisibilityChanged"
+	EventWindowOpen                      = "Page.windowOpen"
+	EventCompilationCacheProduced        = "Page.compilationCacheProduced"
+)
+
+// DOMContentEventFiredEvent is the payload of the Page.domContentEventFired
+// event.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#event-domContentEventFired
+type DOMContentEventFiredEvent struct {
+	Timestamp types.NetworkMonotonicTime `json:"timestamp"`
+}
+
+// FileChooserOpenedEvent is the payload of the Page.fileChooserOpened event.
+//
+// Emitted only when `page.interceptFileChooser` is enabled.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#event-fileChooserOpened
+type FileChooserOpenedEvent struct {
+	// Id of the frame containing input node.
+	//
+	// This is an experimental part of the protocol.
+	FrameID FrameID `json:"frameId"`
+	// Input mode.
+	//
+	// Allowed values: selectSingle, selectMultiple.
+	Mode string `json:"mode"`
+	// Input node id. Only present for file choosers opened via an `<input
+	// type="file">` element.
+	//
+	// This is an experimental part of the protocol.
+	BackendNodeID types.DOMBackendNodeID `json:"backendNodeId,omitempty"`
+}
+
+// FrameAttachedEvent is the payload of the Page.frameAttached event.
+//
+// Fired when frame has been attached to its parent.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#event-frameAttached
+type FrameAttachedEvent struct {
+	// Id of the frame that has been attached.
+	FrameID FrameID `json:"frameId"`
+	// Parent frame identifier.
+	ParentFrameID FrameID `json:"parentFrameId"`
+	// JavaScript stack trace of when frame was attached, only set if frame
+	// initiated from script.
+	Stack *types.RuntimeStackTrace `json:"stack,omitempty"`
+}
+
+// FrameClearedScheduledNavigationEvent is the payload of the
+// Page.frameClearedScheduledNavigation event.
+//
+// Fired when frame no longer has a scheduled navigation.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#event-frameClearedScheduledNavigation
+//
+// Deprecated: this is deprecated in the protocol.
+type FrameClearedScheduledNavigationEvent struct {
+	// Id of the frame that has cleared its scheduled navigation.
+	FrameID FrameID `json:"frameId"`
+}
+
+// FrameDetachedEvent is the payload of the Page.frameDetached event.
+//
+// Fired when frame has been detached from its parent.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#event-frameDetached
+type FrameDetachedEvent struct {
+	// Id of the frame that has been detached.
+	FrameID FrameID `json:"frameId"`
+	// Allowed values: remove, swap.
+	//
+	// This is an experimental part of the protocol.
+	Reason string `json:"reason"`
+}
+
+// FrameSubtreeWillBeDetachedEvent is the payload of the
+// Page.frameSubtreeWillBeDetached event.
+//
+// Fired before frame subtree is detached. Emitted before any frame of the
+// subtree is actually detached.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#event-frameSubtreeWillBeDetached
+//
+// This is an experimental part of the protocol.
+type FrameSubtreeWillBeDetachedEvent struct {
+	// Id of the frame that is the root of the subtree that will be detached.
+	FrameID FrameID `json:"frameId"`
+}
+
+// FrameNavigatedEvent is the payload of the Page.frameNavigated event.
+//
+// Fired once navigation of the frame has completed. Frame is now associated
+// with the new loader.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#event-frameNavigated
+type FrameNavigatedEvent struct {
+	// Frame object.
+	Frame Frame `json:"frame"`
+	// This is an experimental part of the protocol.
+	Type NavigationType `json:"type"`
+}
+
+// DocumentOpenedEvent is the payload of the Page.documentOpened event.
+//
+// Fired when opening document to write to.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#event-documentOpened
+//
+// This is an experimental part of the protocol.
+type DocumentOpenedEvent struct {
+	// Frame object.
+	Frame Frame `json:"frame"`
+}
+
+// FrameResizedEvent is the payload of the Page.frameResized event.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#event-frameResized
+//
+// This is an experimental part of the protocol.
+type FrameResizedEvent struct {
+}
+
+// FrameStartedNavigatingEvent is the payload of the Page.frameStartedNavigating
+// event.
+//
+// Fired when a navigation starts. This event is fired for both
+// renderer-initiated and browser-initiated navigations. For renderer-initiated
+// navigations, the event is fired after `frameRequestedNavigation`. Navigation
+// may still be cancelled after the event is issued. Multiple events can be
+// fired for a single navigation, for example, when a same-document navigation
+// becomes a cross-document navigation (such as in the case of a frameset).
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#event-frameStartedNavigating
+//
+// This is an experimental part of the protocol.
+type FrameStartedNavigatingEvent struct {
+	// ID of the frame that is being navigated.
+	FrameID FrameID `json:"frameId"`
+	// The URL the navigation started with. The final URL can be different.
+	URL string `json:"url"`
+	// Loader identifier. Even though it is present in case of same-document
+	// navigation, the previously committed loaderId would not change unless the
+	// navigation changes from a same-document to a cross-document navigation.
+	LoaderID types.NetworkLoaderID `json:"loaderId"`
+	// Allowed values: reload, reloadBypassingCache, restore, restoreWithPost,
+	// historySameDocument, historyDifferentDocument, sameDocument,
+	// differentDocument.
+	NavigationType string `json:"navigationType"`
+}
+
+// FrameRequestedNavigationEvent is the payload of the
+// Page.frameRequestedNavigation event.
+//
+// Fired when a renderer-initiated navigation is requested. Navigation may still
+// be cancelled after the event is issued.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#event-frameRequestedNavigation
+//
+// This is an experimental part of the protocol.
+type FrameRequestedNavigationEvent struct {
+	// Id of the frame that is being navigated.
+	FrameID FrameID `json:"frameId"`
+	// The reason for the navigation.
+	Reason ClientNavigationReason `json:"reason"`
+	// The destination URL for the requested navigation.
+	URL string `json:"url"`
+	// The disposition for the navigation.
+	Disposition ClientNavigationDisposition `json:"disposition"`
+}
+
+// FrameScheduledNavigationEvent is the payload of the
+// Page.frameScheduledNavigation event.
+//
+// Fired when frame schedules a potential navigation.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#event-frameScheduledNavigation
+//
+// Deprecated: this is deprecated in the protocol.
+type FrameScheduledNavigationEvent struct {
+	// Id of the frame that has scheduled a navigation.
+	FrameID FrameID `json:"frameId"`
+	// Delay (in seconds) until the navigation is scheduled to begin. The
+	// navigation is not guaranteed to start.
+	Delay float64 `json:"delay"`
+	// The reason for the navigation.
+	Reason ClientNavigationReason `json:"reason"`
+	// The destination URL for the scheduled navigation.
+	URL string `json:"url"`
+}
+
+// FrameStartedLoadingEvent is the payload of the Page.frameStartedLoading
+// event.
+//
+// Fired when frame has started loading.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#event-frameStartedLoading
+//
+// This is an experimental part of the protocol.
+type FrameStartedLoadingEvent struct {
+	// Id of the frame that has started loading.
+	FrameID FrameID `json:"frameId"`
+}
+
+// FrameStoppedLoadingEvent is the payload of the Page.frameStoppedLoading
+// event.
+//
+// Fired when frame has stopped loading.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#event-frameStoppedLoading
+//
+// This is an experimental part of the protocol.
+type FrameStoppedLoadingEvent struct {
+	// Id of the frame that has stopped loading.
+	FrameID FrameID `json:"frameId"`
+}
+
+// DownloadWillBeginEvent is the payload of the Page.downloadWillBegin event.
+//
+// Fired when page is about to start a download. Deprecated. Use
+// Browser.downloadWillBegin instead.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#event-downloadWillBegin
+//
+// This is an experimental part of the protocol.
+//
+// Deprecated: this is deprecated in the protocol.
+type DownloadWillBeginEvent struct {
+	// Id of the frame that caused download to begin.
+	FrameID FrameID `json:"frameId"`
+	// Global unique identifier of the download.
+	Guid string `json:"guid"`
+	// URL of the resource being downloaded.
+	URL string `json:"url"`
+	// Suggested file name of the resource (the actual name of the file saved on
+	// disk may differ).
+	SuggestedFilename string `json:"suggestedFilename"`
+}
+
+// DownloadProgressEvent is the payload of the Page.downloadProgress event.
+//
+// Fired when download makes progress. Last call has |done| == true. Deprecated.
+// Use Browser.downloadProgress instead.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#event-downloadProgress
+//
+// This is an experimental part of the protocol.
+//
+// Deprecated: this is deprecated in the protocol.
+type DownloadProgressEvent struct {
+	// Global unique identifier of the download.
+	Guid string `json:"guid"`
+	// Total expected bytes to download.
+	TotalBytes float64 `json:"totalBytes"`
+	// Total bytes received.
+	ReceivedBytes float64 `json:"receivedBytes"`
+	// Download status.
+	//
+	// Allowed values: inProgress, completed, canceled.
+	State string `json:"state"`
+}
+
+// InterstitialHiddenEvent is the payload of the Page.interstitialHidden event.
+//
+// # Fired when interstitial page was hidden
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#event-interstitialHidden
+type InterstitialHiddenEvent struct {
+}
+
+// InterstitialShownEvent is the payload of the Page.interstitialShown event.
+//
+// # Fired when interstitial page was shown
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#event-interstitialShown
+type InterstitialShownEvent struct {
+}
+
+// JavascriptDialogClosedEvent is the payload of the Page.javascriptDialogClosed
+// event.
+//
+// Fired when a JavaScript initiated dialog (alert, confirm, prompt, or
+// onbeforeunload) has been closed.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#event-javascriptDialogClosed
+type JavascriptDialogClosedEvent struct {
+	// Frame id.
+	//
+	// This is an experimental part of the protocol.
+	FrameID FrameID `json:"frameId"`
+	// Whether dialog was confirmed.
+	Result bool `json:"result"`
+	// User input in case of prompt.
+	UserInput string `json:"userInput"`
+}
+
+// JavascriptDialogOpeningEvent is the payload of the
+// Page.javascriptDialogOpening event.
+//
+// Fired when a JavaScript initiated dialog (alert, confirm, prompt, or
+// onbeforeunload) is about to open.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#event-javascriptDialogOpening
+type JavascriptDialogOpeningEvent struct {
+	// Frame url.
+	URL string `json:"url"`
+	// Frame id.
+	//
+	// This is an experimental part of the protocol.
+	FrameID FrameID `json:"frameId"`
+	// Message that will be displayed by the dialog.
+	Message string `json:"message"`
+	// Dialog type.
+	Type DialogType `json:"type"`
+	// True iff browser is capable showing or acting on the given dialog. When
+	// browser has no dialog handler for given target, calling alert while Page
+	// domain is engaged will stall the page execution. Execution can be resumed
+	// via calling Page.handleJavaScriptDialog.
+	HasBrowserHandler bool `json:"hasBrowserHandler"`
+	// Default dialog prompt.
+	DefaultPrompt string `json:"defaultPrompt,omitempty"`
+}
+
+// LifecycleEventEvent is the payload of the Page.lifecycleEvent event.
+//
+// Fired for lifecycle events (navigation, load, paint, etc) in the current
+// target (including local frames).
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#event-lifecycleEvent
+type LifecycleEventEvent struct {
+	// Id of the frame.
+	FrameID FrameID `json:"frameId"`
+	// Loader identifier. Empty string if the request is fetched from worker.
+	LoaderID  types.NetworkLoaderID      `json:"loaderId"`
+	Name      string                     `json:"name"`
+	Timestamp types.NetworkMonotonicTime `json:"timestamp"`
+}
+
+// BackForwardCacheNotUsedEvent is the payload of the
+// Page.backForwardCacheNotUsed event.
+//
+// Fired for failed bfcache history navigations if BackForwardCache feature is
+// enabled. Do not assume any ordering with the Page.frameNavigated event. This
+// event is fired only for main-frame history navigation where the document
+// changes (non-same-document navigations), when bfcache navigation fails.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#event-backForwardCacheNotUsed
+//
+// This is an experimental part of the protocol.
+type BackForwardCacheNotUsedEvent struct {
+	// The loader id for the associated navigation.
+	LoaderID types.NetworkLoaderID `json:"loaderId"`
+	// The frame id of the associated frame.
+	FrameID FrameID `json:"frameId"`
+	// Array of reasons why the page could not be cached. This must not be empty.
+	NotRestoredExplanations []BackForwardCacheNotRestoredExplanation `json:"notRestoredExplanations"`
+	// Tree structure of reasons why the page could not be cached for each frame.
+	NotRestoredExplanationsTree *BackForwardCacheNotRestoredExplanationTree `json:"notRestoredExplanationsTree,omitempty"`
+}
+
+// LoadEventFiredEvent is the payload of the Page.loadEventFired event.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#event-loadEventFired
+type LoadEventFiredEvent struct {
+	Timestamp types.NetworkMonotonicTime `json:"timestamp"`
+}
+
+// NavigatedWithinDocumentEvent is the payload of the
+// Page.navigatedWithinDocument event.
+//
+// Fired when same-document navigation happens, e.g. due to history API usage or
+// anchor navigation.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#event-navigatedWithinDocument
+//
+// This is an experimental part of the protocol.
+type NavigatedWithinDocumentEvent struct {
+	// Id of the frame.
+	FrameID FrameID `json:"frameId"`
+	// Frame's new url.
+	URL string `json:"url"`
+	// Navigation type
+	//
+	// Allowed values: fragment, historyApi, other.
+	NavigationType string `json:"navigationType"`
+}
+
+// ScreencastFrameEvent is the payload of the Page.screencastFrame event.
+//
+// Compressed image data requested by the `startScreencast`.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#event-screencastFrame
+//
+// This is an experimental part of the protocol.
+type ScreencastFrameEvent struct {
+	// Base64-encoded compressed image. (Encoded as a base64 string when passed
+	// over JSON)
+	Data string `json:"data"`
+	// Screencast frame metadata.
+	Metadata ScreencastFrameMetadata `json:"metadata"`
+	// Frame number.
+	SessionID int64 `json:"sessionId"`
+}
+
+// ScreencastVisibilityChangedEvent is the payload of the
+// Page.screencastVisibilityChanged event.
+//
+// Fired when the page with currently enabled screencast was shown or hidden `.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#event-screencastVisibilityChanged
+//
+// This is an experimental part of the protocol.
+type ScreencastVisibilityChangedEvent struct {
+	// True if the page is visible.
+	Visible bool `json:"visible"`
+}
+
+// WindowOpenEvent is the payload of the Page.windowOpen event.
+//
+// Fired when a new window is going to be opened, via window.open(), link click,
+// form submission, etc.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#event-windowOpen
+type WindowOpenEvent struct {
+	// The URL for the new window.
+	URL string `json:"url"`
+	// Window name.
+	WindowName string `json:"windowName"`
+	// An array of enabled window features.
+	WindowFeatures []string `json:"windowFeatures"`
+	// Whether or not it was triggered by user gesture.
+	UserGesture bool `json:"userGesture"`
+}
+
+// CompilationCacheProducedEvent is the payload of the
+// Page.compilationCacheProduced event.
+//
+// Issued for every compilation cache generated.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Page#event-compilationCacheProduced
+//
+// This is an experimental part of the protocol.
+type CompilationCacheProducedEvent struct {
+	URL string `json:"url"`
+	// Base64-encoded data (Encoded as a base64 string when passed over JSON)
+	Data string `json:"data"`
+}
+
+// ParseEvent decodes an event from the Page domain, as received from
+// Subscribe, into a pointer to its typed payload.
+func ParseEvent(cmd chromedebugo.Command) (interface{}, error) {
+	var ev interface{}
+	switch cmd.Method {
+	case EventDOMContentEventFired:
+		ev = &DOMContentEventFiredEvent{}
+	case EventFileChooserOpened:
+		ev = &FileChooserOpenedEvent{}
+	case EventFrameAttached:
+		ev = &FrameAttachedEvent{}
+	case EventFrameClearedScheduledNavigation:
+		ev = &FrameClearedScheduledNavigationEvent{}
+	case EventFrameDetached:
+		ev = &FrameDetachedEvent{}
+	case EventFrameSubtreeWillBeDetached:
+		ev = &FrameSubtreeWillBeDetachedEvent{}
+	case EventFrameNavigated:
+		ev = &FrameNavigatedEvent{}
+	case EventDocumentOpened:
+		ev = &DocumentOpenedEvent{}
+	case EventFrameResized:
+		ev = &FrameResizedEvent{}
+	case EventFrameStartedNavigating:
+		ev = &FrameStartedNavigatingEvent{}
+	case EventFrameRequestedNavigation:
+		ev = &FrameRequestedNavigationEvent{}
+	case EventFrameScheduledNavigation:
+		ev = &FrameScheduledNavigationEvent{}
+	case EventFrameStartedLoading:
+		ev = &FrameStartedLoadingEvent{}
+	case EventFrameStoppedLoading:
+		ev = &FrameStoppedLoadingEvent{}
+	case EventDownloadWillBegin:
+		ev = &DownloadWillBeginEvent{}
+	case EventDownloadProgress:
+		ev = &DownloadProgressEvent{}
+	case EventInterstitialHidden:
+		ev = &InterstitialHiddenEvent{}
+	case EventInterstitialShown:
+		ev = &InterstitialShownEvent{}
+	case EventJavascriptDialogClosed:
+		ev = &JavascriptDialogClosedEvent{}
+	case EventJavascriptDialogOpening:
+		ev = &JavascriptDialogOpeningEvent{}
+	case EventLifecycleEvent:
+		ev = &LifecycleEventEvent{}
+	case EventBackForwardCacheNotUsed:
+		ev = &BackForwardCacheNotUsedEvent{}
+	case EventLoadEventFired:
+		ev = &LoadEventFiredEvent{}
+	case EventNavigatedWithinDocument:
+		ev = &NavigatedWithinDocumentEvent{}
+	case EventScreencastFrame:
+		ev = &ScreencastFrameEvent{}
+	case EventScreencastVisibilityChanged:
+		ev = &ScreencastVisibilityChangedEvent{}
+	case EventWindowOpen:
+		ev = &WindowOpenEvent{}
+	case EventCompilationCacheProduced:
+		ev = &CompilationCacheProducedEvent{}
+	default:
+		return nil, fmt.Errorf("unknown Page event: %s", cmd.Method)
+	}
+	if err := cmd.Decode(ev); err != nil {
+		return nil, err
+	}
+	return ev, nil
+}
@@ -0,0 +1,166 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+// Package performance provides typed bindings for the Performance domain of the
+// Chrome DevTools protocol.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Performance
+package performance
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tonyhb/chromedebugo"
+	"github.com/tonyhb/chromedebugo/protocol"
+	"github.com/tonyhb/chromedebugo/protocol/internal/types"
+)
+
+// Metric run-time execution metric.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Performance#type-Metric
+type Metric = types.PerformanceMetric
+
+// Method names of the commands in the Performance domain.
+const (
+	CommandDisable       = "Performance.disable"
+	CommandEnable        = "Performance.enable"
+	CommandSetTimeDomain = "Performance.setTimeDomain"
+	CommandGetMetrics    = "Performance.getMetrics"
+)
+
+// DisableParams are the parameters of Performance.disable.
+//
+// Disable collecting and reporting metrics.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Performance#method-disable
+type DisableParams struct {
+}
+
+// Command returns the Performance.disable command for use with
+// AsyncDebugger.Send.
+func (p DisableParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandDisable, p)
+}
+
+// Do sends Performance.disable to d and waits for it to complete or for ctx to
+// be done.
+func (p DisableParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandDisable, p, nil)
+}
+
+// EnableParams are the parameters of Performance.enable.
+//
+// Enable collecting and reporting metrics.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Performance#method-enable
+type EnableParams struct {
+	// Time domain to use for collecting and reporting duration metrics.
+	//
+	// Allowed values: timeTicks, threadTicks.
+	TimeDomain string `json:"timeDomain,omitempty"`
+}
+
+// Command returns the Performance.enable command for use with
+// AsyncDebugger.Send.
+func (p EnableParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandEnable, p)
+}
+
+// Do sends Performance.enable to d and waits for it to complete or for ctx to
+// be done.
+func (p EnableParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandEnable, p, nil)
+}
+
+// SetTimeDomainParams are the parameters of Performance.setTimeDomain.
+//
+// Sets time domain to use for collecting and reporting duration metrics. Note
+// that this must be called before enabling metrics collection. Calling this
+// method while metrics collection is enabled returns an error.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Performance#method-setTimeDomain
+//
+// This is an experimental part of the protocol.
+//
+// Deprecated: this is deprecated in the protocol.
+type SetTimeDomainParams struct {
+	// Time domain
+	//
+	// Allowed values: timeTicks, threadTicks.
+	TimeDomain string `json:"timeDomain"`
+}
+
+// Command returns the Performance.setTimeDomain command for use with
+// AsyncDebugger.Send.
+func (p SetTimeDomainParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetTimeDomain, p)
+}
+
+// Do sends Performance.setTimeDomain to d and waits for it to complete or for ctx to
+// be done.
+func (p SetTimeDomainParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetTimeDomain, p, nil)
+}
+
+// GetMetricsParams are the parameters of Performance.getMetrics.
+//
+// Retrieve current values of run-time metrics.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Performance#method-getMetrics
+type GetMetricsParams struct {
+}
+
+// Command returns the Performance.getMetrics command for use with
+// AsyncDebugger.Send.
+func (p GetMetricsParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandGetMetrics, p)
+}
+
+// GetMetricsReturns are the values returned by Performance.getMetrics.
+type GetMetricsReturns struct {
+	// Current values for run-time metrics.
+	Metrics []Metric `json:"metrics"`
+}
+
+// Do sends Performance.getMetrics to d and waits for its result or for ctx to be
+// done.
+func (p GetMetricsParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*GetMetricsReturns, error) {
+	ret := &GetMetricsReturns{}
+	if err := protocol.Do(ctx, d, CommandGetMetrics, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// Method names of the events in the Performance domain.
+const (
+	EventMetrics = "Performance.metrics"
+)
+
+// MetricsEvent is the payload of the Performance.metrics event.
+//
+// Current values of the metrics.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Performance#event-metrics
+type MetricsEvent struct {
+	// Current values of the metrics.
+	Metrics []Metric `json:"metrics"`
+	// Timestamp title.
+	Title string `json:"title"`
+}
+
+// ParseEvent decodes an event from the Performance domain, as received from
+// Subscribe, into a pointer to its typed payload.
+func ParseEvent(cmd chromedebugo.Command) (interface{}, error) {
+	var ev interface{}
+	switch cmd.Method {
+	case EventMetrics:
+		ev = &MetricsEvent{}
+	default:
+		return nil, fmt.Errorf("unknown Performance event: %s", cmd.Method)
+	}
+	if err := cmd.Decode(ev); err != nil {
+		return nil, err
+	}
+	return ev, nil
+}
@@ -0,0 +1,107 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+// Package performancetimeline provides typed bindings for the
+// PerformanceTimeline domain of the Chrome DevTools protocol.
+//
+// Reporting of performance timeline events, as specified in
+// https://w3c.github.io/performance-timeline/#dom-performanceobserver.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/PerformanceTimeline
+//
+// This is an experimental part of the protocol.
+package performancetimeline
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tonyhb/chromedebugo"
+	"github.com/tonyhb/chromedebugo/protocol"
+	"github.com/tonyhb/chromedebugo/protocol/internal/types"
+)
+
+// LargestContentfulPaint see https://github.com/WICG/LargestContentfulPaint and
+// largest_contentful_paint.idl
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/PerformanceTimeline#type-LargestContentfulPaint
+type LargestContentfulPaint = types.PerformanceTimelineLargestContentfulPaint
+
+// LayoutShiftAttribution is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/PerformanceTimeline#type-LayoutShiftAttribution
+type LayoutShiftAttribution = types.PerformanceTimelineLayoutShiftAttribution
+
+// LayoutShift see https://wicg.github.io/layout-instability/#sec-layout-shift
+// and layout_shift.idl
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/PerformanceTimeline#type-LayoutShift
+type LayoutShift = types.PerformanceTimelineLayoutShift
+
+// TimelineEvent is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/PerformanceTimeline#type-TimelineEvent
+type TimelineEvent = types.PerformanceTimelineTimelineEvent
+
+// Method names of the commands in the PerformanceTimeline domain.
+const (
+	CommandEnable = "PerformanceTimeline.enable"
+)
+
+// EnableParams are the parameters of PerformanceTimeline.enable.
+//
+// Previously buffered events would be reported before method returns. See also:
+// timelineEventAdded
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/PerformanceTimeline#method-enable
+type EnableParams struct {
+	// The types of event to report, as specified in
+	// https://w3c.github.io/performance-timeline/#dom-performanceentry-entrytype
+	// The specified filter overrides any previous filters, passing empty filter
+	// disables recording. Note that not all types exposed to the web platform are
+	// currently supported.
+	EventTypes []string `json:"eventTypes"`
+}
+
+// Command returns the PerformanceTimeline.enable command for use with
+// AsyncDebugger.Send.
+func (p EnableParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandEnable, p)
+}
+
+// Do sends PerformanceTimeline.enable to d and waits for it to complete or for ctx to
+// be done.
+func (p EnableParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandEnable, p, nil)
+}
+
+// Method names of the events in the PerformanceTimeline domain.
+const (
+	EventTimelineEventAdded = "PerformanceTimeline.timelineEventAdded"
+)
+
+// TimelineEventAddedEvent is the payload of the
+// PerformanceTimeline.timelineEventAdded event.
+//
+// Sent when a performance timeline event is added. See
+// reportPerformanceTimeline method.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/PerformanceTimeline#event-timelineEventAdded
+type TimelineEventAddedEvent struct {
+	Event TimelineEvent `json:"event"`
+}
+
+// ParseEvent decodes an event from the PerformanceTimeline domain, as received from
+// Subscribe, into a pointer to its typed payload.
+func ParseEvent(cmd chromedebugo.Command) (interface{}, error) {
+	var ev interface{}
+	switch cmd.Method {
+	case EventTimelineEventAdded:
+		ev = &TimelineEventAddedEvent{}
+	default:
+		return nil, fmt.Errorf("unknown PerformanceTimeline event: %s", cmd.Method)
+	}
+	if err := cmd.Decode(ev); err != nil {
+		return nil, err
+	}
+	return ev, nil
+}
@@ -0,0 +1,378 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+// Package preload provides typed bindings for the Preload domain of the Chrome
+// DevTools protocol.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Preload
+//
+// This is an experimental part of the protocol.
+package preload
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tonyhb/chromedebugo"
+	"github.com/tonyhb/chromedebugo/protocol"
+	"github.com/tonyhb/chromedebugo/protocol/internal/types"
+)
+
+// RuleSetID unique id
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Preload#type-RuleSetId
+type RuleSetID = types.PreloadRuleSetID
+
+// RuleSet corresponds to SpeculationRuleSet
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Preload#type-RuleSet
+type RuleSet = types.PreloadRuleSet
+
+// RuleSetErrorType is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Preload#type-RuleSetErrorType
+type RuleSetErrorType = types.PreloadRuleSetErrorType
+
+// SpeculationAction the type of preloading attempted. It corresponds to
+// mojom::SpeculationAction (although PrefetchWithSubresources is omitted as it
+// isn't being used by clients).
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Preload#type-SpeculationAction
+type SpeculationAction = types.PreloadSpeculationAction
+
+// SpeculationTargetHint corresponds to mojom::SpeculationTargetHint. See
+// https://github.com/WICG/nav-speculation/blob/main/triggers.md#window-name-targeting-hints
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Preload#type-SpeculationTargetHint
+type SpeculationTargetHint = types.PreloadSpeculationTargetHint
+
+// PreloadingAttemptKey a key that identifies a preloading attempt.
+//
+// The url used is the url specified by the trigger (i.e. the initial URL), and
+// not the final url that is navigated to. For example, prerendering allows
+// same-origin main frame navigations during the attempt, but the attempt is
+// still keyed with the initial URL.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Preload#type-PreloadingAttemptKey
+type PreloadingAttemptKey = types.PreloadPreloadingAttemptKey
+
+// PreloadingAttemptSource lists sources for a preloading attempt, specifically
+// the ids of rule sets that had a speculation rule that triggered the attempt,
+// and the BackendNodeIds of <a href> or <area href> elements that triggered the
+// attempt (in the case of attempts triggered by a document rule). It is
+// possible for multiple rule sets and links to trigger a single attempt.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Preload#type-PreloadingAttemptSource
+type PreloadingAttemptSource = types.PreloadPreloadingAttemptSource
+
+// PreloadPipelineID chrome manages different types of preloads together using a
+// concept of preloading pipeline. For example, if a site uses a
+// SpeculationRules for prerender, Chrome first starts a prefetch and then
+// upgrades it to prerender.
+//
+// CDP events for them are emitted separately but they share
+// `PreloadPipelineId`.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Preload#type-PreloadPipelineId
+type PreloadPipelineID = types.PreloadPreloadPipelineID
+
+// PrerenderFinalStatus list of FinalStatus reasons for Prerender2.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Preload#type-PrerenderFinalStatus
+type PrerenderFinalStatus = types.PreloadPrerenderFinalStatus
+
+// PreloadingStatus preloading status values, see also
+// PreloadingTriggeringOutcome. This status is shared by prefetchStatusUpdated
+// and prerenderStatusUpdated.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Preload#type-PreloadingStatus
+type PreloadingStatus = types.PreloadPreloadingStatus
+
+// PrefetchStatus TODO(https://crbug.com/1384419): revisit the list of
+// PrefetchStatus and filter out the ones that aren't necessary to the
+// developers.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Preload#type-PrefetchStatus
+type PrefetchStatus = types.PreloadPrefetchStatus
+
+// PrerenderMismatchedHeaders information of headers to be displayed when the
+// header mismatch occurred.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Preload#type-PrerenderMismatchedHeaders
+type PrerenderMismatchedHeaders = types.PreloadPrerenderMismatchedHeaders
+
+// Values of the enumerated types in the Preload domain.
+const (
+	RuleSetErrorTypeSourceIsNotJSONObject                                          = types.PreloadRuleSetErrorTypeSourceIsNotJSONObject
+	RuleSetErrorTypeInvalidRulesSkipped                                            = types.PreloadRuleSetErrorTypeInvalidRulesSkipped
+	RuleSetErrorTypeInvalidRulesetLevelTag                                         = types.PreloadRuleSetErrorTypeInvalidRulesetLevelTag
+	SpeculationActionPrefetch                                                      = types.PreloadSpeculationActionPrefetch
+	SpeculationActionPrerender                                                     = types.PreloadSpeculationActionPrerender
+	SpeculationTargetHintBlank                                                     = types.PreloadSpeculationTargetHintBlank
+	SpeculationTargetHintSelf                                                      = types.PreloadSpeculationTargetHintSelf
+	PrerenderFinalStatusActivated                                                  = types.PreloadPrerenderFinalStatusActivated
+	PrerenderFinalStatusDestroyed                                                  = types.PreloadPrerenderFinalStatusDestroyed
+	PrerenderFinalStatusLowEndDevice                                               = types.PreloadPrerenderFinalStatusLowEndDevice
+	PrerenderFinalStatusInvalidSchemeRedirect                                      = types.PreloadPrerenderFinalStatusInvalidSchemeRedirect
+	PrerenderFinalStatusInvalidSchemeNavigation                                    = types.PreloadPrerenderFinalStatusInvalidSchemeNavigation
+	PrerenderFinalStatusNavigationRequestBlockedByCsp                              = types.PreloadPrerenderFinalStatusNavigationRequestBlockedByCsp
+	PrerenderFinalStatusMojoBinderPolicy                                           = types.PreloadPrerenderFinalStatusMojoBinderPolicy
+	PrerenderFinalStatusRendererProcessCrashed                                     = types.PreloadPrerenderFinalStatusRendererProcessCrashed
+	PrerenderFinalStatusRendererProcessKilled                                      = types.PreloadPrerenderFinalStatusRendererProcessKilled
+	PrerenderFinalStatusDownload                                                   = types.PreloadPrerenderFinalStatusDownload
+	PrerenderFinalStatusTriggerDestroyed                                           = types.PreloadPrerenderFinalStatusTriggerDestroyed
+	PrerenderFinalStatusNavigationNotCommitted                                     = types.PreloadPrerenderFinalStatusNavigationNotCommitted
+	PrerenderFinalStatusNavigationBadHTTPStatus                                    = types.PreloadPrerenderFinalStatusNavigationBadHTTPStatus
+	PrerenderFinalStatusClientCertRequested                                        = types.PreloadPrerenderFinalStatusClientCertRequested
+	PrerenderFinalStatusNavigationRequestNetworkError                              = types.PreloadPrerenderFinalStatusNavigationRequestNetworkError
+	PrerenderFinalStatusCancelAllHostsForTesting                                   = types.PreloadPrerenderFinalStatusCancelAllHostsForTesting
+	PrerenderFinalStatusDidFailLoad                                                = types.PreloadPrerenderFinalStatusDidFailLoad
+	PrerenderFinalStatusStop                                                       = types.PreloadPrerenderFinalStatusStop
+	PrerenderFinalStatusSslCertificateError                                        = types.PreloadPrerenderFinalStatusSslCertificateError
+	PrerenderFinalStatusLoginAuthRequested                                         = types.PreloadPrerenderFinalStatusLoginAuthRequested
+	PrerenderFinalStatusUaChangeRequiresReload                                     = types.PreloadPrerenderFinalStatusUaChangeRequiresReload
+	PrerenderFinalStatusBlockedByClient                                            = types.PreloadPrerenderFinalStatusBlockedByClient
+	PrerenderFinalStatusAudioOutputDeviceRequested                                 = types.PreloadPrerenderFinalStatusAudioOutputDeviceRequested
+	PrerenderFinalStatusMixedContent                                               = types.PreloadPrerenderFinalStatusMixedContent
+	PrerenderFinalStatusTriggerBackgrounded                                        = types.PreloadPrerenderFinalStatusTriggerBackgrounded
+	PrerenderFinalStatusMemoryLimitExceeded                                        = types.PreloadPrerenderFinalStatusMemoryLimitExceeded
+	PrerenderFinalStatusDataSaverEnabled                                           = types.PreloadPrerenderFinalStatusDataSaverEnabled
+	PrerenderFinalStatusTriggerURLHasEffectiveURL                                  = types.PreloadPrerenderFinalStatusTriggerURLHasEffectiveURL
+	PrerenderFinalStatusActivatedBeforeStarted                                     = types.PreloadPrerenderFinalStatusActivatedBeforeStarted
+	PrerenderFinalStatusInactivePageRestriction                                    = types.PreloadPrerenderFinalStatusInactivePageRestriction
+	PrerenderFinalStatusStartFailed                                                = types.PreloadPrerenderFinalStatusStartFailed
+	PrerenderFinalStatusTimeoutBackgrounded                                        = types.PreloadPrerenderFinalStatusTimeoutBackgrounded
+	PrerenderFinalStatusCrossSiteRedirectInInitialNavigation                       = types.PreloadPrerenderFinalStatusCrossSiteRedirectInInitialNavigation
+	PrerenderFinalStatusCrossSiteNavigationInInitialNavigation                     = types.PreloadPrerenderFinalStatusCrossSiteNavigationInInitialNavigation
+	PrerenderFinalStatusSameSiteCrossOriginRedirectNotOptInInInitialNavigation     = types.PreloadPrerenderFinalStatusSameSiteCrossOriginRedirectNotOptInInInitialNavigation
+	PrerenderFinalStatusSameSiteCrossOriginNavigationNotOptInInInitialNavigation   = types.PreloadPrerenderFinalStatusSameSiteCrossOriginNavigationNotOptInInInitialNavigation
+	PrerenderFinalStatusActivationNavigationParameterMismatch                      = types.PreloadPrerenderFinalStatusActivationNavigationParameterMismatch
+	PrerenderFinalStatusActivatedInBackground                                      = types.PreloadPrerenderFinalStatusActivatedInBackground
+	PrerenderFinalStatusEmbedderHostDisallowed                                     = types.PreloadPrerenderFinalStatusEmbedderHostDisallowed
+	PrerenderFinalStatusActivationNavigationDestroyedBeforeSuccess                 = types.PreloadPrerenderFinalStatusActivationNavigationDestroyedBeforeSuccess
+	PrerenderFinalStatusTabClosedByUserGesture                                     = types.PreloadPrerenderFinalStatusTabClosedByUserGesture
+	PrerenderFinalStatusTabClosedWithoutUserGesture                                = types.PreloadPrerenderFinalStatusTabClosedWithoutUserGesture
+	PrerenderFinalStatusPrimaryMainFrameRendererProcessCrashed                     = types.PreloadPrerenderFinalStatusPrimaryMainFrameRendererProcessCrashed
+	PrerenderFinalStatusPrimaryMainFrameRendererProcessKilled                      = types.PreloadPrerenderFinalStatusPrimaryMainFrameRendererProcessKilled
+	PrerenderFinalStatusActivationFramePolicyNotCompatible                         = types.PreloadPrerenderFinalStatusActivationFramePolicyNotCompatible
+	PrerenderFinalStatusPreloadingDisabled                                         = types.PreloadPrerenderFinalStatusPreloadingDisabled
+	PrerenderFinalStatusBatterySaverEnabled                                        = types.PreloadPrerenderFinalStatusBatterySaverEnabled
+	PrerenderFinalStatusActivatedDuringMainFrameNavigation                         = types.PreloadPrerenderFinalStatusActivatedDuringMainFrameNavigation
+	PrerenderFinalStatusPreloadingUnsupportedByWebContents                         = types.PreloadPrerenderFinalStatusPreloadingUnsupportedByWebContents
+	PrerenderFinalStatusCrossSiteRedirectInMainFrameNavigation                     = types.PreloadPrerenderFinalStatusCrossSiteRedirectInMainFrameNavigation
+	PrerenderFinalStatusCrossSiteNavigationInMainFrameNavigation                   = types.PreloadPrerenderFinalStatusCrossSiteNavigationInMainFrameNavigation
+	PrerenderFinalStatusSameSiteCrossOriginRedirectNotOptInInMainFrameNavigation   = types.PreloadPrerenderFinalStatusSameSiteCrossOriginRedirectNotOptInInMainFrameNavigation
+	PrerenderFinalStatusSameSiteCrossOriginNavigationNotOptInInMainFrameNavigation = types.PreloadPrerenderFinalStatusSameSiteCrossOriginNavigationNotOptInInMainFrameNavigation
+	PrerenderFinalStatusMemoryPressureOnTrigger                                    = types.PreloadPrerenderFinalStatusMemoryPressureOnTrigger
+	PrerenderFinalStatusMemoryPressureAfterTriggered                               = types.PreloadPrerenderFinalStatusMemoryPressureAfterTriggered
+	PrerenderFinalStatusPrerenderingDisabledByDevTools                             = types.PreloadPrerenderFinalStatusPrerenderingDisabledByDevTools
+	PrerenderFinalStatusSpeculationRuleRemoved                                     = types.PreloadPrerenderFinalStatusSpeculationRuleRemoved
+	PrerenderFinalStatusActivatedWithAuxiliaryBrowsingContexts                     = types.PreloadPrerenderFinalStatusActivatedWithAuxiliaryBrowsingContexts
+	PrerenderFinalStatusMaxNumOfRunningEagerPrerendersExceeded                     = types.PreloadPrerenderFinalStatusMaxNumOfRunningEagerPrerendersExceeded
+	PrerenderFinalStatusMaxNumOfRunningNonEagerPrerendersExceeded                  = types.PreloadPrerenderFinalStatusMaxNumOfRunningNonEagerPrerendersExceeded
+	PrerenderFinalStatusMaxNumOfRunningEmbedderPrerendersExceeded                  = types.PreloadPrerenderFinalStatusMaxNumOfRunningEmbedderPrerendersExceeded
+	PrerenderFinalStatusPrerenderingURLHasEffectiveURL                             = types.PreloadPrerenderFinalStatusPrerenderingURLHasEffectiveURL
+	PrerenderFinalStatusRedirectedPrerenderingURLHasEffectiveURL                   = types.PreloadPrerenderFinalStatusRedirectedPrerenderingURLHasEffectiveURL
+	PrerenderFinalStatusActivationURLHasEffectiveURL                               = types.PreloadPrerenderFinalStatusActivationURLHasEffectiveURL
+	PrerenderFinalStatusJavaScriptInterfaceAdded                                   = types.PreloadPrerenderFinalStatusJavaScriptInterfaceAdded
+	PrerenderFinalStatusJavaScriptInterfaceRemoved                                 = types.PreloadPrerenderFinalStatusJavaScriptInterfaceRemoved
+	PrerenderFinalStatusAllPrerenderingCanceled                                    = types.PreloadPrerenderFinalStatusAllPrerenderingCanceled
+	PrerenderFinalStatusWindowClosed                                               = types.PreloadPrerenderFinalStatusWindowClosed
+	PrerenderFinalStatusSlowNetwork                                                = types.PreloadPrerenderFinalStatusSlowNetwork
+	PrerenderFinalStatusOtherPrerenderedPageActivated                              = types.PreloadPrerenderFinalStatusOtherPrerenderedPageActivated
+	PrerenderFinalStatusV8OptimizerDisabled                                        = types.PreloadPrerenderFinalStatusV8OptimizerDisabled
+	PrerenderFinalStatusPrerenderFailedDuringPrefetch                              = types.PreloadPrerenderFinalStatusPrerenderFailedDuringPrefetch
+	PrerenderFinalStatusBrowsingDataRemoved                                        = types.PreloadPrerenderFinalStatusBrowsingDataRemoved
+	PrerenderFinalStatusPrerenderHostReused                                        = types.PreloadPrerenderFinalStatusPrerenderHostReused
+	PreloadingStatusPending                                                        = types.PreloadPreloadingStatusPending
+	PreloadingStatusRunning                                                        = types.PreloadPreloadingStatusRunning
+	PreloadingStatusReady                                                          = types.PreloadPreloadingStatusReady
+	PreloadingStatusSuccess                                                        = types.PreloadPreloadingStatusSuccess
+	PreloadingStatusFailure                                                        = types.PreloadPreloadingStatusFailure
+	PreloadingStatusNotSupported                                                   = types.PreloadPreloadingStatusNotSupported
+	PrefetchStatusPrefetchAllowed                                                  = types.PreloadPrefetchStatusPrefetchAllowed
+	PrefetchStatusPrefetchFailedIneligibleRedirect                                 = types.PreloadPrefetchStatusPrefetchFailedIneligibleRedirect
+	PrefetchStatusPrefetchFailedInvalidRedirect                                    = types.PreloadPrefetchStatusPrefetchFailedInvalidRedirect
+	PrefetchStatusPrefetchFailedMIMENotSupported                                   = types.PreloadPrefetchStatusPrefetchFailedMIMENotSupported
+	PrefetchStatusPrefetchFailedNetError                                           = types.PreloadPrefetchStatusPrefetchFailedNetError
+	PrefetchStatusPrefetchFailedNon2XX                                             = types.PreloadPrefetchStatusPrefetchFailedNon2XX
+	PrefetchStatusPrefetchEvictedAfterBrowsingDataRemoved                          = types.PreloadPrefetchStatusPrefetchEvictedAfterBrowsingDataRemoved
+	PrefetchStatusPrefetchEvictedAfterCandidateRemoved                             = types.PreloadPrefetchStatusPrefetchEvictedAfterCandidateRemoved
+	PrefetchStatusPrefetchEvictedForNewerPrefetch                                  = types.PreloadPrefetchStatusPrefetchEvictedForNewerPrefetch
+	PrefetchStatusPrefetchHeldback                                                 = types.PreloadPrefetchStatusPrefetchHeldback
+	PrefetchStatusPrefetchIneligibleRetryAfter                                     = types.PreloadPrefetchStatusPrefetchIneligibleRetryAfter
+	PrefetchStatusPrefetchIsPrivacyDecoy                                           = types.PreloadPrefetchStatusPrefetchIsPrivacyDecoy
+	PrefetchStatusPrefetchIsStale                                                  = types.PreloadPrefetchStatusPrefetchIsStale
+	PrefetchStatusPrefetchNotEligibleBrowserContextOffTheRecord                    = types.PreloadPrefetchStatusPrefetchNotEligibleBrowserContextOffTheRecord
+	PrefetchStatusPrefetchNotEligibleDataSaverEnabled                              = types.PreloadPrefetchStatusPrefetchNotEligibleDataSaverEnabled
+	PrefetchStatusPrefetchNotEligibleExistingProxy                                 = types.PreloadPrefetchStatusPrefetchNotEligibleExistingProxy
+	PrefetchStatusPrefetchNotEligibleHostIsNonUnique                               = types.PreloadPrefetchStatusPrefetchNotEligibleHostIsNonUnique
+	PrefetchStatusPrefetchNotEligibleNonDefaultStoragePartition                    = types.PreloadPrefetchStatusPrefetchNotEligibleNonDefaultStoragePartition
+	PrefetchStatusPrefetchNotEligibleSameSiteCrossOriginPrefetchRequiredProxy      = types.PreloadPrefetchStatusPrefetchNotEligibleSameSiteCrossOriginPrefetchRequiredProxy
+	PrefetchStatusPrefetchNotEligibleSchemeIsNotHttps                              = types.PreloadPrefetchStatusPrefetchNotEligibleSchemeIsNotHttps
+	PrefetchStatusPrefetchNotEligibleUserHasCookies                                = types.PreloadPrefetchStatusPrefetchNotEligibleUserHasCookies
+	PrefetchStatusPrefetchNotEligibleUserHasServiceWorker                          = types.PreloadPrefetchStatusPrefetchNotEligibleUserHasServiceWorker
+	PrefetchStatusPrefetchNotEligibleUserHasServiceWorkerNoFetchHandler            = types.PreloadPrefetchStatusPrefetchNotEligibleUserHasServiceWorkerNoFetchHandler
+	PrefetchStatusPrefetchNotEligibleRedirectFromServiceWorker                     = types.PreloadPrefetchStatusPrefetchNotEligibleRedirectFromServiceWorker
+	PrefetchStatusPrefetchNotEligibleRedirectToServiceWorker                       = types.PreloadPrefetchStatusPrefetchNotEligibleRedirectToServiceWorker
+	PrefetchStatusPrefetchNotEligibleBatterySaverEnabled                           = types.PreloadPrefetchStatusPrefetchNotEligibleBatterySaverEnabled
+	PrefetchStatusPrefetchNotEligiblePreloadingDisabled                            = types.PreloadPrefetchStatusPrefetchNotEligiblePreloadingDisabled
+	PrefetchStatusPrefetchNotFinishedInTime                                        = types.PreloadPrefetchStatusPrefetchNotFinishedInTime
+	PrefetchStatusPrefetchNotStarted                                               = types.PreloadPrefetchStatusPrefetchNotStarted
+	PrefetchStatusPrefetchNotUsedCookiesChanged                                    = types.PreloadPrefetchStatusPrefetchNotUsedCookiesChanged
+	PrefetchStatusPrefetchProxyNotAvailable                                        = types.PreloadPrefetchStatusPrefetchProxyNotAvailable
+	PrefetchStatusPrefetchResponseUsed                                             = types.PreloadPrefetchStatusPrefetchResponseUsed
+	PrefetchStatusPrefetchSuccessfulButNotUsed                                     = types.PreloadPrefetchStatusPrefetchSuccessfulButNotUsed
+	PrefetchStatusPrefetchNotUsedProbeFailed                                       = types.PreloadPrefetchStatusPrefetchNotUsedProbeFailed
+)
+
+// Method names of the commands in the Preload domain.
+const (
+	CommandEnable  = "Preload.enable"
+	CommandDisable = "Preload.disable"
+)
+
+// EnableParams are the parameters of Preload.enable.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Preload#method-enable
+type EnableParams struct {
+}
+
+// Command returns the Preload.enable command for use with AsyncDebugger.Send.
+func (p EnableParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandEnable, p)
+}
+
+// Do sends Preload.enable to d and waits for it to complete or for ctx to
+// be done.
+func (p EnableParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandEnable, p, nil)
+}
+
+// DisableParams are the parameters of Preload.disable.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Preload#method-disable
+type DisableParams struct {
+}
+
+// Command returns the Preload.disable command for use with AsyncDebugger.Send.
+func (p DisableParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandDisable, p)
+}
+
+// Do sends Preload.disable to d and waits for it to complete or for ctx to
+// be done.
+func (p DisableParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandDisable, p, nil)
+}
+
+// Method names of the events in the Preload domain.
+const (
+	EventRuleSetUpdated                  = "Preload.ruleSetUpdated"
+	EventRuleSetRemoved                  = "Preload.ruleSetRemoved"
+	EventPreloadEnabledStateUpdated      = "Preload.preloadEnabledStateUpdated"
+	EventPrefetchStatusUpdated           = "Preload.prefetchStatusUpdated"
+	EventPrerenderStatusUpdated          = "Preload.prerenderStatusUpdated"
+	EventPreloadingAttemptSourcesUpdated = "Preload.preloadingAttemptSourcesUpdated"
+)
+
+// RuleSetUpdatedEvent is the payload of the Preload.ruleSetUpdated event.
+//
+// Upsert. Currently, it is only emitted when a rule set added.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Preload#event-ruleSetUpdated
+type RuleSetUpdatedEvent struct {
+	RuleSet RuleSet `json:"ruleSet"`
+}
+
+// RuleSetRemovedEvent is the payload of the Preload.ruleSetRemoved event.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Preload#event-ruleSetRemoved
+type RuleSetRemovedEvent struct {
+	ID RuleSetID `json:"id"`
+}
+
+// PreloadEnabledStateUpdatedEvent is the payload of the
+// Preload.preloadEnabledStateUpdated event.
+//
+// Fired when a preload enabled state is updated.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Preload#event-preloadEnabledStateUpdated
+type PreloadEnabledStateUpdatedEvent struct {
+	DisabledByPreference                        bool `json:"disabledByPreference"`
+	DisabledByDataSaver                         bool `json:"disabledByDataSaver"`
+	DisabledByBatterySaver                      bool `json:"disabledByBatterySaver"`
+	DisabledByHoldbackPrefetchSpeculationRules  bool `json:"disabledByHoldbackPrefetchSpeculationRules"`
+	DisabledByHoldbackPrerenderSpeculationRules bool `json:"disabledByHoldbackPrerenderSpeculationRules"`
+}
+
+// PrefetchStatusUpdatedEvent is the payload of the
+// Preload.prefetchStatusUpdated event.
+//
+// Fired when a prefetch attempt is updated.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Preload#event-prefetchStatusUpdated
+type PrefetchStatusUpdatedEvent struct {
+	Key        PreloadingAttemptKey `json:"key"`
+	PipelineID PreloadPipelineID    `json:"pipelineId"`
+	// The frame id of the frame initiating prefetch.
+	InitiatingFrameID types.PageFrameID      `json:"initiatingFrameId"`
+	PrefetchURL       string                 `json:"prefetchUrl"`
+	Status            PreloadingStatus       `json:"status"`
+	PrefetchStatus    PrefetchStatus         `json:"prefetchStatus"`
+	RequestID         types.NetworkRequestID `json:"requestId"`
+}
+
+// PrerenderStatusUpdatedEvent is the payload of the
+// Preload.prerenderStatusUpdated event.
+//
+// Fired when a prerender attempt is updated.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Preload#event-prerenderStatusUpdated
+type PrerenderStatusUpdatedEvent struct {
+	Key             PreloadingAttemptKey `json:"key"`
+	PipelineID      PreloadPipelineID    `json:"pipelineId"`
+	Status          PreloadingStatus     `json:"status"`
+	PrerenderStatus PrerenderFinalStatus `json:"prerenderStatus,omitempty"`
+	// This is used to give users more information about the name of Mojo interface
+	// that is incompatible with prerender and has caused the cancellation of the
+	// attempt.
+	DisallowedMojoInterface string                       `json:"disallowedMojoInterface,omitempty"`
+	MismatchedHeaders       []PrerenderMismatchedHeaders `json:"mismatchedHeaders,omitempty"`
+}
+
+// PreloadingAttemptSourcesUpdatedEvent is the payload of the
+// Preload.preloadingAttemptSourcesUpdated event.
+//
+// Send a list of sources for all preloading attempts in a document.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Preload#event-preloadingAttemptSourcesUpdated
+type PreloadingAttemptSourcesUpdatedEvent struct {
+	LoaderID                 types.NetworkLoaderID     `json:"loaderId"`
+	PreloadingAttemptSources []PreloadingAttemptSource `json:"preloadingAttemptSources"`
+}
+
+// ParseEvent decodes an event from the Preload domain, as received from
+// Subscribe, into a pointer to its typed payload.
+func ParseEvent(cmd chromedebugo.Command) (interface{}, error) {
+	var ev interface{}
+	switch cmd.Method {
+	case EventRuleSetUpdated:
+		ev = &RuleSetUpdatedEvent{}
+	case EventRuleSetRemoved:
+		ev = &RuleSetRemovedEvent{}
+	case EventPreloadEnabledStateUpdated:
+		ev = &PreloadEnabledStateUpdatedEvent{}
+	case EventPrefetchStatusUpdated:
+		ev = &PrefetchStatusUpdatedEvent{}
+	case EventPrerenderStatusUpdated:
+		ev = &PrerenderStatusUpdatedEvent{}
+	case EventPreloadingAttemptSourcesUpdated:
+		ev = &PreloadingAttemptSourcesUpdatedEvent{}
+	default:
+		return nil, fmt.Errorf("unknown Preload event: %s", cmd.Method)
+	}
+	if err := cmd.Decode(ev); err != nil {
+		return nil, err
+	}
+	return ev, nil
+}
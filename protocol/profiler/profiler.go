@@ -0,0 +1,365 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+// Package profiler provides typed bindings for the Profiler domain of the
+// Chrome DevTools protocol.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Profiler
+package profiler
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tonyhb/chromedebugo"
+	"github.com/tonyhb/chromedebugo/protocol"
+	"github.com/tonyhb/chromedebugo/protocol/internal/types"
+)
+
+// ProfileNode profile node. Holds callsite information, execution statistics
+// and child nodes.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Profiler#type-ProfileNode
+type ProfileNode = types.ProfilerProfileNode
+
+// Profile profile.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Profiler#type-Profile
+type Profile = types.ProfilerProfile
+
+// PositionTickInfo specifies a number of samples attributed to a certain source
+// position.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Profiler#type-PositionTickInfo
+type PositionTickInfo = types.ProfilerPositionTickInfo
+
+// CoverageRange coverage data for a source range.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Profiler#type-CoverageRange
+type CoverageRange = types.ProfilerCoverageRange
+
+// FunctionCoverage coverage data for a JavaScript function.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Profiler#type-FunctionCoverage
+type FunctionCoverage = types.ProfilerFunctionCoverage
+
+// ScriptCoverage coverage data for a JavaScript script.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Profiler#type-ScriptCoverage
+type ScriptCoverage = types.ProfilerScriptCoverage
+
+// Method names of the commands in the Profiler domain.
+const (
+	CommandDisable               = "Profiler.disable"
+	CommandEnable                = "Profiler.enable"
+	CommandGetBestEffortCoverage = "Profiler.getBestEffortCoverage"
+	CommandSetSamplingInterval   = "Profiler.setSamplingInterval"
+	CommandStart                 = "Profiler.start"
+	CommandStartPreciseCoverage  = "Profiler.startPreciseCoverage"
+	CommandStop                  = "Profiler.stop"
+	CommandStopPreciseCoverage   = "Profiler.stopPreciseCoverage"
+	CommandTakePreciseCoverage   = "Profiler.takePreciseCoverage"
+)
+
+// DisableParams are the parameters of Profiler.disable.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Profiler#method-disable
+type DisableParams struct {
+}
+
+// Command returns the Profiler.disable command for use with AsyncDebugger.Send.
+func (p DisableParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandDisable, p)
+}
+
+// Do sends Profiler.disable to d and waits for it to complete or for ctx to
+// be done.
+func (p DisableParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandDisable, p, nil)
+}
+
+// EnableParams are the parameters of Profiler.enable.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Profiler#method-enable
+type EnableParams struct {
+}
+
+// Command returns the Profiler.enable command for use with AsyncDebugger.Send.
+func (p EnableParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandEnable, p)
+}
+
+// Do sends Profiler.enable to d and waits for it to complete or for ctx to
+// be done.
+func (p EnableParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandEnable, p, nil)
+}
+
+// GetBestEffortCoverageParams are the parameters of
+// Profiler.getBestEffortCoverage.
+//
+// Collect coverage data for the current isolate. The coverage data may be
+// incomplete due to garbage collection.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Profiler#method-getBestEffortCoverage
+type GetBestEffortCoverageParams struct {
+}
+
+// Command returns the Profiler.getBestEffortCoverage command for use with
+// AsyncDebugger.Send.
+func (p GetBestEffortCoverageParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandGetBestEffortCoverage, p)
+}
+
+// GetBestEffortCoverageReturns are the values returned by Profiler.getBestEffortCoverage.
+type GetBestEffortCoverageReturns struct {
+	// Coverage data for the current isolate.
+	Result []ScriptCoverage `json:"result"`
+}
+
+// Do sends Profiler.getBestEffortCoverage to d and waits for its result or for ctx to be
+// done.
+func (p GetBestEffortCoverageParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*GetBestEffortCoverageReturns, error) {
+	ret := &GetBestEffortCoverageReturns{}
+	if err := protocol.Do(ctx, d, CommandGetBestEffortCoverage, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// SetSamplingIntervalParams are the parameters of Profiler.setSamplingInterval.
+//
+// Changes CPU profiler sampling interval. Must be called before CPU profiles
+// recording started.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Profiler#method-setSamplingInterval
+type SetSamplingIntervalParams struct {
+	// New sampling interval in microseconds.
+	Interval int64 `json:"interval"`
+}
+
+// Command returns the Profiler.setSamplingInterval command for use with
+// AsyncDebugger.Send.
+func (p SetSamplingIntervalParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetSamplingInterval, p)
+}
+
+// Do sends Profiler.setSamplingInterval to d and waits for it to complete or for ctx to
+// be done.
+func (p SetSamplingIntervalParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetSamplingInterval, p, nil)
+}
+
+// StartParams are the parameters of Profiler.start.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Profiler#method-start
+type StartParams struct {
+}
+
+// Command returns the Profiler.start command for use with AsyncDebugger.Send.
+func (p StartParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandStart, p)
+}
+
+// Do sends Profiler.start to d and waits for it to complete or for ctx to
+// be done.
+func (p StartParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandStart, p, nil)
+}
+
+// StartPreciseCoverageParams are the parameters of
+// Profiler.startPreciseCoverage.
+//
+// Enable precise code coverage. Coverage data for JavaScript executed before
+// enabling precise code coverage may be incomplete. Enabling prevents running
+// optimized code and resets execution counters.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Profiler#method-startPreciseCoverage
+type StartPreciseCoverageParams struct {
+	// Collect accurate call counts beyond simple 'covered' or 'not covered'.
+	CallCount bool `json:"callCount,omitempty"`
+	// Collect block-based coverage.
+	Detailed bool `json:"detailed,omitempty"`
+	// Allow the backend to send updates on its own initiative
+	AllowTriggeredUpdates bool `json:"allowTriggeredUpdates,omitempty"`
+}
+
+// Command returns the Profiler.startPreciseCoverage command for use with
+// AsyncDebugger.Send.
+func (p StartPreciseCoverageParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandStartPreciseCoverage, p)
+}
+
+// StartPreciseCoverageReturns are the values returned by Profiler.startPreciseCoverage.
+type StartPreciseCoverageReturns struct {
+	// Monotonically increasing time (in seconds) when the coverage update was
+	// taken in the backend.
+	Timestamp float64 `json:"timestamp"`
+}
+
+// Do sends Profiler.startPreciseCoverage to d and waits for its result or for ctx to be
+// done.
+func (p StartPreciseCoverageParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*StartPreciseCoverageReturns, error) {
+	ret := &StartPreciseCoverageReturns{}
+	if err := protocol.Do(ctx, d, CommandStartPreciseCoverage, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// StopParams are the parameters of Profiler.stop.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Profiler#method-stop
+type StopParams struct {
+}
+
+// Command returns the Profiler.stop command for use with AsyncDebugger.Send.
+func (p StopParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandStop, p)
+}
+
+// StopReturns are the values returned by Profiler.stop.
+type StopReturns struct {
+	// Recorded profile.
+	Profile Profile `json:"profile"`
+}
+
+// Do sends Profiler.stop to d and waits for its result or for ctx to be
+// done.
+func (p StopParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*StopReturns, error) {
+	ret := &StopReturns{}
+	if err := protocol.Do(ctx, d, CommandStop, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// StopPreciseCoverageParams are the parameters of Profiler.stopPreciseCoverage.
+//
+// Disable precise code coverage. Disabling releases unnecessary execution count
+// records and allows executing optimized code.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Profiler#method-stopPreciseCoverage
+type StopPreciseCoverageParams struct {
+}
+
+// Command returns the Profiler.stopPreciseCoverage command for use with
+// AsyncDebugger.Send.
+func (p StopPreciseCoverageParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandStopPreciseCoverage, p)
+}
+
+// Do sends Profiler.stopPreciseCoverage to d and waits for it to complete or for ctx to
+// be done.
+func (p StopPreciseCoverageParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandStopPreciseCoverage, p, nil)
+}
+
+// TakePreciseCoverageParams are the parameters of Profiler.takePreciseCoverage.
+//
+// Collect coverage data for the current isolate, and resets execution counters.
+// Precise code coverage needs to have started.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Profiler#method-takePreciseCoverage
+type TakePreciseCoverageParams struct {
+}
+
+// Command returns the Profiler.takePreciseCoverage command for use with
+// AsyncDebugger.Send.
+func (p TakePreciseCoverageParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandTakePreciseCoverage, p)
+}
+
+// TakePreciseCoverageReturns are the values returned by Profiler.takePreciseCoverage.
+type TakePreciseCoverageReturns struct {
+	// Coverage data for the current isolate.
+	Result []ScriptCoverage `json:"result"`
+	// Monotonically increasing time (in seconds) when the coverage update was
+	// taken in the backend.
+	Timestamp float64 `json:"timestamp"`
+}
+
+// Do sends Profiler.takePreciseCoverage to d and waits for its result or for ctx to be
+// done.
+func (p TakePreciseCoverageParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*TakePreciseCoverageReturns, error) {
+	ret := &TakePreciseCoverageReturns{}
+	if err := protocol.Do(ctx, d, CommandTakePreciseCoverage, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// Method names of the events in the Profiler domain.
+const (
+	EventConsoleProfileFinished     = "Profiler.consoleProfileFinished"
+	EventConsoleProfileStarted      = "Profiler.consoleProfileStarted"
+	EventPreciseCoverageDeltaUpdate = "Profiler.preciseCoverageDeltaUpdate"
+)
+
+// ConsoleProfileFinishedEvent is the payload of the
+// Profiler.consoleProfileFinished event.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Profiler#event-consoleProfileFinished
+type ConsoleProfileFinishedEvent struct {
+	ID string `json:"id"`
+	// Location of console.profileEnd().
+	Location types.DebuggerLocation `json:"location"`
+	Profile  Profile                `json:"profile"`
+	// Profile title passed as an argument to console.profile().
+	Title string `json:"title,omitempty"`
+}
+
+// ConsoleProfileStartedEvent is the payload of the
+// Profiler.consoleProfileStarted event.
+//
+// Sent when new profile recording is started using console.profile() call.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Profiler#event-consoleProfileStarted
+type ConsoleProfileStartedEvent struct {
+	ID string `json:"id"`
+	// Location of console.profile().
+	Location types.DebuggerLocation `json:"location"`
+	// Profile title passed as an argument to console.profile().
+	Title string `json:"title,omitempty"`
+}
+
+// PreciseCoverageDeltaUpdateEvent is the payload of the
+// Profiler.preciseCoverageDeltaUpdate event.
+//
+// Reports coverage delta since the last poll (either from an event like this,
+// or from `takePreciseCoverage` for the current isolate. May only be sent if
+// precise code coverage has been started. This event can be trigged by the
+// embedder to, for example, trigger collection of coverage data immediately at
+// a certain point in time.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Profiler#event-preciseCoverageDeltaUpdate
+//
+// This is an experimental part of the protocol.
+type PreciseCoverageDeltaUpdateEvent struct {
+	// Monotonically increasing time (in seconds) when the coverage update was
+	// taken in the backend.
+	Timestamp float64 `json:"timestamp"`
+	// Identifier for distinguishing coverage events.
+	Occasion string `json:"occasion"`
+	// Coverage data for the current isolate.
+	Result []ScriptCoverage `json:"result"`
+}
+
+// ParseEvent decodes an event from the Profiler domain, as received from
+// Subscribe, into a pointer to its typed payload.
+func ParseEvent(cmd chromedebugo.Command) (interface{}, error) {
+	var ev interface{}
+	switch cmd.Method {
+	case EventConsoleProfileFinished:
+		ev = &ConsoleProfileFinishedEvent{}
+	case EventConsoleProfileStarted:
+		ev = &ConsoleProfileStartedEvent{}
+	case EventPreciseCoverageDeltaUpdate:
+		ev = &PreciseCoverageDeltaUpdateEvent{}
+	default:
+		return nil, fmt.Errorf("unknown Profiler event: %s", cmd.Method)
+	}
+	if err := cmd.Decode(ev); err != nil {
+		return nil, err
+	}
+	return ev, nil
+}
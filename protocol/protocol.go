@@ -0,0 +1,38 @@
+// Package protocol holds the Chrome DevTools protocol definitions which the
+// typed bindings in its subpackages are generated from, along with the
+// helpers those bindings share.
+//
+// Each subpackage covers one protocol domain.  For example, navigating and
+// waiting for the result with a SyncDebugger:
+//
+//	ret, err := page.NavigateParams{URL: "https://example.com"}.Do(ctx, debugger)
+//
+// Params structs can also be turned into a Command for AsyncDebugger.Send,
+// and events received from Subscribe can be decoded with each package's
+// ParseEvent.
+package protocol
+
+import (
+	"context"
+
+	"github.com/tonyhb/chromedebugo"
+)
+
+//go:generate go run ../cmd/cdpgen -root github.com/tonyhb/chromedebugo -out . browser_protocol.json js_protocol.json
+
+// Do sends method with the given params to d and decodes the result into ret,
+// if ret is non-nil.  It stops waiting for the result when ctx is done.
+func Do(ctx context.Context, d chromedebugo.SyncDebugger, method string, params, ret interface{}) error {
+	cmd, err := chromedebugo.NewCommand(method, params)
+	if err != nil {
+		return err
+	}
+	res, err := d.SendContext(ctx, cmd)
+	if err != nil {
+		return err
+	}
+	if ret == nil {
+		return nil
+	}
+	return res.Decode(ret)
+}
@@ -0,0 +1,291 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+// Package pwa provides typed bindings for the PWA domain of the Chrome DevTools
+// protocol.
+//
+// This domain allows interacting with the browser to control PWAs.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/PWA
+//
+// This is an experimental part of the protocol.
+package pwa
+
+import (
+	"context"
+
+	"github.com/tonyhb/chromedebugo"
+	"github.com/tonyhb/chromedebugo/protocol"
+	"github.com/tonyhb/chromedebugo/protocol/internal/types"
+)
+
+// FileHandlerAccept the following types are the replica of
+// https://crsrc.org/c/chrome/browser/web_applications/proto/web_app_os_integration_state.proto;drc=9910d3be894c8f142c977ba1023f30a656bc13fc;l=67
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/PWA#type-FileHandlerAccept
+type FileHandlerAccept = types.PWAFileHandlerAccept
+
+// FileHandler is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/PWA#type-FileHandler
+type FileHandler = types.PWAFileHandler
+
+// DisplayMode if user prefers opening the app in browser or an app window.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/PWA#type-DisplayMode
+type DisplayMode = types.PWADisplayMode
+
+// Values of the enumerated types in the PWA domain.
+const (
+	DisplayModeStandalone = types.PWADisplayModeStandalone
+	DisplayModeBrowser    = types.PWADisplayModeBrowser
+)
+
+// Method names of the commands in the PWA domain.
+const (
+	CommandGetOsAppState         = "PWA.getOsAppState"
+	CommandInstall               = "PWA.install"
+	CommandUninstall             = "PWA.uninstall"
+	CommandLaunch                = "PWA.launch"
+	CommandLaunchFilesInApp      = "PWA.launchFilesInApp"
+	CommandOpenCurrentPageInApp  = "PWA.openCurrentPageInApp"
+	CommandChangeAppUserSettings = "PWA.changeAppUserSettings"
+)
+
+// GetOsAppStateParams are the parameters of PWA.getOsAppState.
+//
+// Returns the following OS state for the given manifest id.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/PWA#method-getOsAppState
+type GetOsAppStateParams struct {
+	// The id from the webapp's manifest file, commonly it's the url of the site
+	// installing the webapp. See https://web.dev/learn/pwa/web-app-manifest.
+	ManifestID string `json:"manifestId"`
+}
+
+// Command returns the PWA.getOsAppState command for use with
+// AsyncDebugger.Send.
+func (p GetOsAppStateParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandGetOsAppState, p)
+}
+
+// GetOsAppStateReturns are the values returned by PWA.getOsAppState.
+type GetOsAppStateReturns struct {
+	BadgeCount   int64         `json:"badgeCount"`
+	FileHandlers []FileHandler `json:"fileHandlers"`
+}
+
+// Do sends PWA.getOsAppState to d and waits for its result or for ctx to be
+// done.
+func (p GetOsAppStateParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*GetOsAppStateReturns, error) {
+	ret := &GetOsAppStateReturns{}
+	if err := protocol.Do(ctx, d, CommandGetOsAppState, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// InstallParams are the parameters of PWA.install.
+//
+// Installs the given manifest identity, optionally using the given
+// installUrlOrBundleUrl
+//
+// IWA-specific install description: manifestId corresponds to isolated-app:// +
+// web_package::SignedWebBundleId
+//
+// File installation mode: The installUrlOrBundleUrl can be either file:// or
+// http(s):// pointing to a signed web bundle (.swbn). In this case
+// SignedWebBundleId must correspond to The .swbn file's signing key.
+//
+// Dev proxy installation mode: installUrlOrBundleUrl must be http(s):// that
+// serves dev mode IWA. web_package::SignedWebBundleId must be of type dev
+// proxy.
+//
+// The advantage of dev proxy mode is that all changes to IWA automatically will
+// be reflected in the running app without reinstallation.
+//
+// To generate bundle id for proxy mode: 1. Generate 32 random bytes. 2. Add a
+// specific suffix 0x00 at the end. 3. Encode the entire sequence using Base32
+// without padding.
+//
+// If Chrome is not in IWA dev mode, the installation will fail, regardless of
+// the state of the allowlist.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/PWA#method-install
+type InstallParams struct {
+	ManifestID string `json:"manifestId"`
+	// The location of the app or bundle overriding the one derived from the
+	// manifestId.
+	InstallURLOrBundleURL string `json:"installUrlOrBundleUrl,omitempty"`
+}
+
+// Command returns the PWA.install command for use with AsyncDebugger.Send.
+func (p InstallParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandInstall, p)
+}
+
+// Do sends PWA.install to d and waits for it to complete or for ctx to
+// be done.
+func (p InstallParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandInstall, p, nil)
+}
+
+// UninstallParams are the parameters of PWA.uninstall.
+//
+// Uninstalls the given manifest_id and closes any opened app windows.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/PWA#method-uninstall
+type UninstallParams struct {
+	ManifestID string `json:"manifestId"`
+}
+
+// Command returns the PWA.uninstall command for use with AsyncDebugger.Send.
+func (p UninstallParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandUninstall, p)
+}
+
+// Do sends PWA.uninstall to d and waits for it to complete or for ctx to
+// be done.
+func (p UninstallParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandUninstall, p, nil)
+}
+
+// LaunchParams are the parameters of PWA.launch.
+//
+// Launches the installed web app, or an url in the same web app instead of the
+// default start url if it is provided. Returns a page Target.TargetID which can
+// be used to attach to via Target.attachToTarget or similar APIs.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/PWA#method-launch
+type LaunchParams struct {
+	ManifestID string `json:"manifestId"`
+	URL        string `json:"url,omitempty"`
+}
+
+// Command returns the PWA.launch command for use with AsyncDebugger.Send.
+func (p LaunchParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandLaunch, p)
+}
+
+// LaunchReturns are the values returned by PWA.launch.
+type LaunchReturns struct {
+	// ID of the tab target created as a result.
+	TargetID types.TargetTargetID `json:"targetId"`
+}
+
+// Do sends PWA.launch to d and waits for its result or for ctx to be
+// done.
+func (p LaunchParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*LaunchReturns, error) {
+	ret := &LaunchReturns{}
+	if err := protocol.Do(ctx, d, CommandLaunch, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// LaunchFilesInAppParams are the parameters of PWA.launchFilesInApp.
+//
+// Opens one or more local files from an installed web app identified by its
+// manifestId. The web app needs to have file handlers registered to process the
+// files. The API returns one or more page Target.TargetIDs which can be used to
+// attach to via Target.attachToTarget or similar APIs. If some files in the
+// parameters cannot be handled by the web app, they will be ignored. If none of
+// the files can be handled, this API returns an error. If no files are provided
+// as the parameter, this API also returns an error.
+//
+// According to the definition of the file handlers in the manifest file, one
+// Target.TargetID may represent a page handling one or more files. The order of
+// the returned Target.TargetIDs is not guaranteed.
+//
+// TODO(crbug.com/339454034): Check the existences of the input files.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/PWA#method-launchFilesInApp
+type LaunchFilesInAppParams struct {
+	ManifestID string   `json:"manifestId"`
+	Files      []string `json:"files"`
+}
+
+// Command returns the PWA.launchFilesInApp command for use with
+// AsyncDebugger.Send.
+func (p LaunchFilesInAppParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandLaunchFilesInApp, p)
+}
+
+// LaunchFilesInAppReturns are the values returned by PWA.launchFilesInApp.
+type LaunchFilesInAppReturns struct {
+	// IDs of the tab targets created as the result.
+	TargetIds []types.TargetTargetID `json:"targetIds"`
+}
+
+// Do sends PWA.launchFilesInApp to d and waits for its result or for ctx to be
+// done.
+func (p LaunchFilesInAppParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*LaunchFilesInAppReturns, error) {
+	ret := &LaunchFilesInAppReturns{}
+	if err := protocol.Do(ctx, d, CommandLaunchFilesInApp, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// OpenCurrentPageInAppParams are the parameters of PWA.openCurrentPageInApp.
+//
+// Opens the current page in its web app identified by the manifest id, needs to
+// be called on a page target. This function returns immediately without waiting
+// for the app to finish loading.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/PWA#method-openCurrentPageInApp
+type OpenCurrentPageInAppParams struct {
+	ManifestID string `json:"manifestId"`
+}
+
+// Command returns the PWA.openCurrentPageInApp command for use with
+// AsyncDebugger.Send.
+func (p OpenCurrentPageInAppParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandOpenCurrentPageInApp, p)
+}
+
+// Do sends PWA.openCurrentPageInApp to d and waits for it to complete or for ctx to
+// be done.
+func (p OpenCurrentPageInAppParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandOpenCurrentPageInApp, p, nil)
+}
+
+// ChangeAppUserSettingsParams are the parameters of PWA.changeAppUserSettings.
+//
+// Changes user settings of the web app identified by its manifestId. If the app
+// was not installed, this command returns an error. Unset parameters will be
+// ignored; unrecognized values will cause an error.
+//
+// Unlike the ones defined in the manifest files of the web apps, these settings
+// are provided by the browser and controlled by the users, they impact the way
+// the browser handling the web apps.
+//
+// See the comment of each parameter.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/PWA#method-changeAppUserSettings
+type ChangeAppUserSettingsParams struct {
+	ManifestID string `json:"manifestId"`
+	// If user allows the links clicked on by the user in the app's scope, or
+	// extended scope if the manifest has scope extensions and the flags
+	// `DesktopPWAsLinkCapturingWithScopeExtensions` and
+	// `WebAppEnableScopeExtensions` are enabled.
+	//
+	// Note, the API does not support resetting the linkCapturing to the initial
+	// value, uninstalling and installing the web app again will reset it.
+	//
+	// TODO(crbug.com/339453269): Setting this value on ChromeOS is not supported
+	// yet.
+	LinkCapturing bool        `json:"linkCapturing,omitempty"`
+	DisplayMode   DisplayMode `json:"displayMode,omitempty"`
+}
+
+// Command returns the PWA.changeAppUserSettings command for use with
+// AsyncDebugger.Send.
+func (p ChangeAppUserSettingsParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandChangeAppUserSettings, p)
+}
+
+// Do sends PWA.changeAppUserSettings to d and waits for it to complete or for ctx to
+// be done.
+func (p ChangeAppUserSettingsParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandChangeAppUserSettings, p, nil)
+}
@@ -0,0 +1,1177 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+// Package runtime provides typed bindings for the Runtime domain of the Chrome
+// DevTools protocol.
+//
+// Runtime domain exposes JavaScript runtime by means of remote evaluation and
+// mirror objects. Evaluation results are returned as mirror object that expose
+// object type, string representation and unique identifier that can be used for
+// further object reference. Original objects are maintained in memory unless
+// they are either explicitly released or are released along with the other
+// objects in their object group.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Runtime
+package runtime
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tonyhb/chromedebugo"
+	"github.com/tonyhb/chromedebugo/protocol"
+	"github.com/tonyhb/chromedebugo/protocol/internal/types"
+)
+
+// ScriptID unique script identifier.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Runtime#type-ScriptId
+type ScriptID = types.RuntimeScriptID
+
+// SerializationOptions represents options for serialization. Overrides
+// `generatePreview` and `returnByValue`.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Runtime#type-SerializationOptions
+type SerializationOptions = types.RuntimeSerializationOptions
+
+// DeepSerializedValue represents deep serialized value.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Runtime#type-DeepSerializedValue
+type DeepSerializedValue = types.RuntimeDeepSerializedValue
+
+// RemoteObjectID unique object identifier.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Runtime#type-RemoteObjectId
+type RemoteObjectID = types.RuntimeRemoteObjectID
+
+// UnserializableValue primitive value which cannot be JSON-stringified.
+// Includes values `-0`, `NaN`, `Infinity`, `-Infinity`, and bigint literals.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Runtime#type-UnserializableValue
+type UnserializableValue = types.RuntimeUnserializableValue
+
+// RemoteObject mirror object referencing original JavaScript object.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Runtime#type-RemoteObject
+type RemoteObject = types.RuntimeRemoteObject
+
+// CustomPreview is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Runtime#type-CustomPreview
+//
+// This is an experimental part of the protocol.
+type CustomPreview = types.RuntimeCustomPreview
+
+// ObjectPreview object containing abbreviated remote object value.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Runtime#type-ObjectPreview
+//
+// This is an experimental part of the protocol.
+type ObjectPreview = types.RuntimeObjectPreview
+
+// PropertyPreview is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Runtime#type-PropertyPreview
+//
+// This is an experimental part of the protocol.
+type PropertyPreview = types.RuntimePropertyPreview
+
+// EntryPreview is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Runtime#type-EntryPreview
+//
+// This is an experimental part of the protocol.
+type EntryPreview = types.RuntimeEntryPreview
+
+// PropertyDescriptor object property descriptor.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Runtime#type-PropertyDescriptor
+type PropertyDescriptor = types.RuntimePropertyDescriptor
+
+// InternalPropertyDescriptor object internal property descriptor. This property
+// isn't normally visible in JavaScript code.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Runtime#type-InternalPropertyDescriptor
+type InternalPropertyDescriptor = types.RuntimeInternalPropertyDescriptor
+
+// PrivatePropertyDescriptor object private field descriptor.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Runtime#type-PrivatePropertyDescriptor
+//
+// This is an experimental part of the protocol.
+type PrivatePropertyDescriptor = types.RuntimePrivatePropertyDescriptor
+
+// CallArgument represents function call argument. Either remote object id
+// `objectId`, primitive `value`, unserializable primitive value or neither of
+// (for undefined) them should be specified.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Runtime#type-CallArgument
+type CallArgument = types.RuntimeCallArgument
+
+// ExecutionContextID id of an execution context.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Runtime#type-ExecutionContextId
+type ExecutionContextID = types.RuntimeExecutionContextID
+
+// ExecutionContextDescription description of an isolated world.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Runtime#type-ExecutionContextDescription
+type ExecutionContextDescription = types.RuntimeExecutionContextDescription
+
+// ExceptionDetails detailed information about exception (or error) that was
+// thrown during script compilation or execution.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Runtime#type-ExceptionDetails
+type ExceptionDetails = types.RuntimeExceptionDetails
+
+// Timestamp number of milliseconds since epoch.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Runtime#type-Timestamp
+type Timestamp = types.RuntimeTimestamp
+
+// TimeDelta number of milliseconds.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Runtime#type-TimeDelta
+type TimeDelta = types.RuntimeTimeDelta
+
+// CallFrame stack entry for runtime errors and assertions.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Runtime#type-CallFrame
+type CallFrame = types.RuntimeCallFrame
+
+// StackTrace call frames for assertions or error messages.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Runtime#type-StackTrace
+type StackTrace = types.RuntimeStackTrace
+
+// UniqueDebuggerID unique identifier of current debugger.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Runtime#type-UniqueDebuggerId
+//
+// This is an experimental part of the protocol.
+type UniqueDebuggerID = types.RuntimeUniqueDebuggerID
+
+// StackTraceID if `debuggerId` is set stack trace comes from another debugger
+// and can be resolved there. This allows to track cross-debugger calls. See
+// `Runtime.StackTrace` and `Debugger.paused` for usages.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Runtime#type-StackTraceId
+//
+// This is an experimental part of the protocol.
+type StackTraceID = types.RuntimeStackTraceID
+
+// Method names of the commands in the Runtime domain.
+const (
+	CommandAwaitPromise                    = "Runtime.awaitPromise"
+	CommandCallFunctionOn                  = "Runtime.callFunctionOn"
+	CommandCompileScript                   = "Runtime.compileScript"
+	CommandDisable                         = "Runtime.disable"
+	CommandDiscardConsoleEntries           = "Runtime.discardConsoleEntries"
+	CommandEnable                          = "Runtime.enable"
+	CommandEvaluate                        = "Runtime.evaluate"
+	CommandGetIsolateID                    = "Runtime.getIsolateId"
+	CommandGetHeapUsage                    = "Runtime.getHeapUsage"
+	CommandGetProperties                   = "Runtime.getProperties"
+	CommandGlobalLexicalScopeNames         = "Runtime.globalLexicalScopeNames"
+	CommandQueryObjects                    = "Runtime.queryObjects"
+	CommandReleaseObject                   = "Runtime.releaseObject"
+	CommandReleaseObjectGroup              = "Runtime.releaseObjectGroup"
+	CommandRunIfWaitingForDebugger         = "Runtime.runIfWaitingForDebugger"
+	CommandRunScript                       = "Runtime.runScript"
+	CommandSetAsyncCallStackDepth          = "Runtime.setAsyncCallStackDepth"
+	CommandSetCustomObjectFormatterEnabled = "Runtime.setCustomObjectFormatterEnabled"
+	CommandSetMaxCallStackSizeToCapture    = "Runtime.setMaxCallStackSizeToCapture"
+	CommandTerminateExecution              = "Runtime.terminateExecution"
+	CommandAddBinding                      = "Runtime.addBinding"
+	CommandRemoveBinding                   = "Runtime.removeBinding"
+	CommandGetExceptionDetails             = "Runtime.getExceptionDetails"
+)
+
+// AwaitPromiseParams are the parameters of Runtime.awaitPromise.
+//
+// Add handler to promise with given promise object id.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Runtime#method-awaitPromise
+type AwaitPromiseParams struct {
+	// Identifier of the promise.
+	PromiseObjectID RemoteObjectID `json:"promiseObjectId"`
+	// Whether the result is expected to be a JSON object that should be sent by
+	// value.
+	ReturnByValue bool `json:"returnByValue,omitempty"`
+	// Whether preview should be generated for the result.
+	GeneratePreview bool `json:"generatePreview,omitempty"`
+}
+
+// Command returns the Runtime.awaitPromise command for use with
+// AsyncDebugger.Send.
+func (p AwaitPromiseParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandAwaitPromise, p)
+}
+
+// AwaitPromiseReturns are the values returned by Runtime.awaitPromise.
+type AwaitPromiseReturns struct {
+	// Promise result. Will contain rejected value if promise was rejected.
+	Result RemoteObject `json:"result"`
+	// Exception details if stack strace is available.
+	ExceptionDetails *ExceptionDetails `json:"exceptionDetails,omitempty"`
+}
+
+// Do sends Runtime.awaitPromise to d and waits for its result or for ctx to be
+// done.
+func (p AwaitPromiseParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*AwaitPromiseReturns, error) {
+	ret := &AwaitPromiseReturns{}
+	if err := protocol.Do(ctx, d, CommandAwaitPromise, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// CallFunctionOnParams are the parameters of Runtime.callFunctionOn.
+//
+// Calls function with given declaration on the given object. Object group of
+// the result is inherited from the target object.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Runtime#method-callFunctionOn
+type CallFunctionOnParams struct {
+	// Declaration of the function to call.
+	FunctionDeclaration string `json:"functionDeclaration"`
+	// Identifier of the object to call function on. Either objectId or
+	// executionContextId should be specified.
+	ObjectID RemoteObjectID `json:"objectId,omitempty"`
+	// Call arguments. All call arguments must belong to the same JavaScript world
+	// as the target object.
+	Arguments []CallArgument `json:"arguments,omitempty"`
+	// In silent mode exceptions thrown during evaluation are not reported and do
+	// not pause execution. Overrides `setPauseOnException` state.
+	Silent bool `json:"silent,omitempty"`
+	// Whether the result is expected to be a JSON object which should be sent by
+	// value. Can be overriden by `serializationOptions`.
+	ReturnByValue bool `json:"returnByValue,omitempty"`
+	// Whether preview should be generated for the result.
+	//
+	// This is an experimental part of the protocol.
+	GeneratePreview bool `json:"generatePreview,omitempty"`
+	// Whether execution should be treated as initiated by user in the UI.
+	UserGesture bool `json:"userGesture,omitempty"`
+	// Whether execution should `await` for resulting value and return once awaited
+	// promise is resolved.
+	AwaitPromise bool `json:"awaitPromise,omitempty"`
+	// Specifies execution context which global object will be used to call
+	// function on. Either executionContextId or objectId should be specified.
+	ExecutionContextID ExecutionContextID `json:"executionContextId,omitempty"`
+	// Symbolic group name that can be used to release multiple objects. If
+	// objectGroup is not specified and objectId is, objectGroup will be inherited
+	// from object.
+	ObjectGroup string `json:"objectGroup,omitempty"`
+	// Whether to throw an exception if side effect cannot be ruled out during
+	// evaluation.
+	//
+	// This is an experimental part of the protocol.
+	ThrowOnSideEffect bool `json:"throwOnSideEffect,omitempty"`
+	// An alternative way to specify the execution context to call function on.
+	// Compared to contextId that may be reused across processes, this is
+	// guaranteed to be system-unique, so it can be used to prevent accidental
+	// function call in context different than intended (e.g. as a result of
+	// navigation across process boundaries). This is mutually exclusive with
+	// `executionContextId`.
+	//
+	// This is an experimental part of the protocol.
+	UniqueContextID string `json:"uniqueContextId,omitempty"`
+	// Specifies the result serialization. If provided, overrides `generatePreview`
+	// and `returnByValue`.
+	//
+	// This is an experimental part of the protocol.
+	SerializationOptions *SerializationOptions `json:"serializationOptions,omitempty"`
+}
+
+// Command returns the Runtime.callFunctionOn command for use with
+// AsyncDebugger.Send.
+func (p CallFunctionOnParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandCallFunctionOn, p)
+}
+
+// CallFunctionOnReturns are the values returned by Runtime.callFunctionOn.
+type CallFunctionOnReturns struct {
+	// Call result.
+	Result RemoteObject `json:"result"`
+	// Exception details.
+	ExceptionDetails *ExceptionDetails `json:"exceptionDetails,omitempty"`
+}
+
+// Do sends Runtime.callFunctionOn to d and waits for its result or for ctx to be
+// done.
+func (p CallFunctionOnParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*CallFunctionOnReturns, error) {
+	ret := &CallFunctionOnReturns{}
+	if err := protocol.Do(ctx, d, CommandCallFunctionOn, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// CompileScriptParams are the parameters of Runtime.compileScript.
+//
+// Compiles expression.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Runtime#method-compileScript
+type CompileScriptParams struct {
+	// Expression to compile.
+	Expression string `json:"expression"`
+	// Source url to be set for the script.
+	SourceURL string `json:"sourceURL"`
+	// Specifies whether the compiled script should be persisted.
+	PersistScript bool `json:"persistScript"`
+	// Specifies in which execution context to perform script run. If the parameter
+	// is omitted the evaluation will be performed in the context of the inspected
+	// page.
+	ExecutionContextID ExecutionContextID `json:"executionContextId,omitempty"`
+}
+
+// Command returns the Runtime.compileScript command for use with
+// AsyncDebugger.Send.
+func (p CompileScriptParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandCompileScript, p)
+}
+
+// CompileScriptReturns are the values returned by Runtime.compileScript.
+type CompileScriptReturns struct {
+	// Id of the script.
+	ScriptID ScriptID `json:"scriptId,omitempty"`
+	// Exception details.
+	ExceptionDetails *ExceptionDetails `json:"exceptionDetails,omitempty"`
+}
+
+// Do sends Runtime.compileScript to d and waits for its result or for ctx to be
+// done.
+func (p CompileScriptParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*CompileScriptReturns, error) {
+	ret := &CompileScriptReturns{}
+	if err := protocol.Do(ctx, d, CommandCompileScript, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// DisableParams are the parameters of Runtime.disable.
+//
+// Disables reporting of execution contexts creation.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Runtime#method-disable
+type DisableParams struct {
+}
+
+// Command returns the Runtime.disable command for use with AsyncDebugger.Send.
+func (p DisableParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandDisable, p)
+}
+
+// Do sends Runtime.disable to d and waits for it to complete or for ctx to
+// be done.
+func (p DisableParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandDisable, p, nil)
+}
+
+// DiscardConsoleEntriesParams are the parameters of
+// Runtime.discardConsoleEntries.
+//
+// Discards collected exceptions and console API calls.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Runtime#method-discardConsoleEntries
+type DiscardConsoleEntriesParams struct {
+}
+
+// Command returns the Runtime.discardConsoleEntries command for use with
+// AsyncDebugger.Send.
+func (p DiscardConsoleEntriesParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandDiscardConsoleEntries, p)
+}
+
+// Do sends Runtime.discardConsoleEntries to d and waits for it to complete or for ctx to
+// be done.
+func (p DiscardConsoleEntriesParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandDiscardConsoleEntries, p, nil)
+}
+
+// EnableParams are the parameters of Runtime.enable.
+//
+// Enables reporting of execution contexts creation by means of
+// `executionContextCreated` event. When the reporting gets enabled the event
+// will be sent immediately for each existing execution context.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Runtime#method-enable
+type EnableParams struct {
+}
+
+// Command returns the Runtime.enable command for use with AsyncDebugger.Send.
+func (p EnableParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandEnable, p)
+}
+
+// Do sends Runtime.enable to d and waits for it to complete or for ctx to
+// be done.
+func (p EnableParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandEnable, p, nil)
+}
+
+// EvaluateParams are the parameters of Runtime.evaluate.
+//
+// Evaluates expression on global object.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Runtime#method-evaluate
+type EvaluateParams struct {
+	// Expression to evaluate.
+	Expression string `json:"expression"`
+	// Symbolic group name that can be used to release multiple objects.
+	ObjectGroup string `json:"objectGroup,omitempty"`
+	// Determines whether Command Line API should be available during the
+	// evaluation.
+	IncludeCommandLineAPI bool `json:"includeCommandLineAPI,omitempty"`
+	// In silent mode exceptions thrown during evaluation are not reported and do
+	// not pause execution. Overrides `setPauseOnException` state.
+	Silent bool `json:"silent,omitempty"`
+	// Specifies in which execution context to perform evaluation. If the parameter
+	// is omitted the evaluation will be performed in the context of the inspected
+	// page. This is mutually exclusive with `uniqueContextId`, which offers an
+	// alternative way to identify the execution context that is more reliable in a
+	// multi-process environment.
+	ContextID ExecutionContextID `json:"contextId,omitempty"`
+	// Whether the result is expected to be a JSON object that should be sent by
+	// value.
+	ReturnByValue bool `json:"returnByValue,omitempty"`
+	// Whether preview should be generated for the result.
+	//
+	// This is an experimental part of the protocol.
+	GeneratePreview bool `json:"generatePreview,omitempty"`
+	// Whether execution should be treated as initiated by user in the UI.
+	UserGesture bool `json:"userGesture,omitempty"`
+	// Whether execution should `await` for resulting value and return once awaited
+	// promise is resolved.
+	AwaitPromise bool `json:"awaitPromise,omitempty"`
+	// Whether to throw an exception if side effect cannot be ruled out during
+	// evaluation. This implies `disableBreaks` below.
+	//
+	// This is an experimental part of the protocol.
+	ThrowOnSideEffect bool `json:"throwOnSideEffect,omitempty"`
+	// Terminate execution after timing out (number of milliseconds).
+	//
+	// This is an experimental part of the protocol.
+	Timeout TimeDelta `json:"timeout,omitempty"`
+	// Disable breakpoints during execution.
+	//
+	// This is an experimental part of the protocol.
+	DisableBreaks bool `json:"disableBreaks,omitempty"`
+	// Setting this flag to true enables `let` re-declaration and top-level
+	// `await`. Note that `let` variables can only be re-declared if they originate
+	// from `replMode` themselves.
+	//
+	// This is an experimental part of the protocol.
+	ReplMode bool `json:"replMode,omitempty"`
+	// The Content Security Policy (CSP) for the target might block 'unsafe-eval'
+	// which includes eval(), Function(), setTimeout() and setInterval() when
+	// called with non-callable arguments. This flag bypasses CSP for this
+	// evaluation and allows unsafe-eval. Defaults to true.
+	//
+	// This is an experimental part of the protocol.
+	AllowUnsafeEvalBlockedByCSP bool `json:"allowUnsafeEvalBlockedByCSP,omitempty"`
+	// An alternative way to specify the execution context to evaluate in. Compared
+	// to contextId that may be reused across processes, this is guaranteed to be
+	// system-unique, so it can be used to prevent accidental evaluation of the
+	// expression in context different than intended (e.g. as a result of
+	// navigation across process boundaries). This is mutually exclusive with
+	// `contextId`.
+	//
+	// This is an experimental part of the protocol.
+	UniqueContextID string `json:"uniqueContextId,omitempty"`
+	// Specifies the result serialization. If provided, overrides `generatePreview`
+	// and `returnByValue`.
+	//
+	// This is an experimental part of the protocol.
+	SerializationOptions *SerializationOptions `json:"serializationOptions,omitempty"`
+}
+
+// Command returns the Runtime.evaluate command for use with AsyncDebugger.Send.
+func (p EvaluateParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandEvaluate, p)
+}
+
+// EvaluateReturns are the values returned by Runtime.evaluate.
+type EvaluateReturns struct {
+	// Evaluation result.
+	Result RemoteObject `json:"result"`
+	// Exception details.
+	ExceptionDetails *ExceptionDetails `json:"exceptionDetails,omitempty"`
+}
+
+// Do sends Runtime.evaluate to d and waits for its result or for ctx to be
+// done.
+func (p EvaluateParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*EvaluateReturns, error) {
+	ret := &EvaluateReturns{}
+	if err := protocol.Do(ctx, d, CommandEvaluate, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// GetIsolateIDParams are the parameters of Runtime.getIsolateId.
+//
+// Returns the isolate id.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Runtime#method-getIsolateId
+//
+// This is an experimental part of the protocol.
+type GetIsolateIDParams struct {
+}
+
+// Command returns the Runtime.getIsolateId command for use with
+// AsyncDebugger.Send.
+func (p GetIsolateIDParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandGetIsolateID, p)
+}
+
+// GetIsolateIDReturns are the values returned by Runtime.getIsolateId.
+type GetIsolateIDReturns struct {
+	// The isolate id.
+	ID string `json:"id"`
+}
+
+// Do sends Runtime.getIsolateId to d and waits for its result or for ctx to be
+// done.
+func (p GetIsolateIDParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*GetIsolateIDReturns, error) {
+	ret := &GetIsolateIDReturns{}
+	if err := protocol.Do(ctx, d, CommandGetIsolateID, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// GetHeapUsageParams are the parameters of Runtime.getHeapUsage.
+//
+// Returns the JavaScript heap usage. It is the total usage of the corresponding
+// isolate not scoped to a particular Runtime.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Runtime#method-getHeapUsage
+//
+// This is an experimental part of the protocol.
+type GetHeapUsageParams struct {
+}
+
+// Command returns the Runtime.getHeapUsage command for use with
+// AsyncDebugger.Send.
+func (p GetHeapUsageParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandGetHeapUsage, p)
+}
+
+// GetHeapUsageReturns are the values returned by Runtime.getHeapUsage.
+type GetHeapUsageReturns struct {
+	// Used JavaScript heap size in bytes.
+	UsedSize float64 `json:"usedSize"`
+	// Allocated JavaScript heap size in bytes.
+	TotalSize float64 `json:"totalSize"`
+	// Used size in bytes in the embedder's garbage-collected heap.
+	EmbedderHeapUsedSize float64 `json:"embedderHeapUsedSize"`
+	// Size in bytes of backing storage for array buffers and external strings.
+	BackingStorageSize float64 `json:"backingStorageSize"`
+}
+
+// Do sends Runtime.getHeapUsage to d and waits for its result or for ctx to be
+// done.
+func (p GetHeapUsageParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*GetHeapUsageReturns, error) {
+	ret := &GetHeapUsageReturns{}
+	if err := protocol.Do(ctx, d, CommandGetHeapUsage, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// GetPropertiesParams are the parameters of Runtime.getProperties.
+//
+// Returns properties of a given object. Object group of the result is inherited
+// from the target object.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Runtime#method-getProperties
+type GetPropertiesParams struct {
+	// Identifier of the object to return properties for.
+	ObjectID RemoteObjectID `json:"objectId"`
+	// If true, returns properties belonging only to the element itself, not to its
+	// prototype chain.
+	OwnProperties bool `json:"ownProperties,omitempty"`
+	// If true, returns accessor properties (with getter/setter) only; internal
+	// properties are not returned either.
+	//
+	// This is an experimental part of the protocol.
+	AccessorPropertiesOnly bool `json:"accessorPropertiesOnly,omitempty"`
+	// Whether preview should be generated for the results.
+	//
+	// This is an experimental part of the protocol.
+	GeneratePreview bool `json:"generatePreview,omitempty"`
+	// If true, returns non-indexed properties only.
+	//
+	// This is an experimental part of the protocol.
+	NonIndexedPropertiesOnly bool `json:"nonIndexedPropertiesOnly,omitempty"`
+}
+
+// Command returns the Runtime.getProperties command for use with
+// AsyncDebugger.Send.
+func (p GetPropertiesParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandGetProperties, p)
+}
+
+// GetPropertiesReturns are the values returned by Runtime.getProperties.
+type GetPropertiesReturns struct {
+	// Object properties.
+	Result []PropertyDescriptor `json:"result"`
+	// Internal object properties (only of the element itself).
+	InternalProperties []InternalPropertyDescriptor `json:"internalProperties,omitempty"`
+	// Object private properties.
+	//
+	// This is an experimental part of the protocol.
+	PrivateProperties []PrivatePropertyDescriptor `json:"privateProperties,omitempty"`
+	// Exception details.
+	ExceptionDetails *ExceptionDetails `json:"exceptionDetails,omitempty"`
+}
+
+// Do sends Runtime.getProperties to d and waits for its result or for ctx to be
+// done.
+func (p GetPropertiesParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*GetPropertiesReturns, error) {
+	ret := &GetPropertiesReturns{}
+	if err := protocol.Do(ctx, d, CommandGetProperties, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// GlobalLexicalScopeNamesParams are the parameters of
+// Runtime.globalLexicalScopeNames.
+//
+// Returns all let, const and class variables from global scope.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Runtime#method-globalLexicalScopeNames
+type GlobalLexicalScopeNamesParams struct {
+	// Specifies in which execution context to lookup global scope variables.
+	ExecutionContextID ExecutionContextID `json:"executionContextId,omitempty"`
+}
+
+// Command returns the Runtime.globalLexicalScopeNames command for use with
+// AsyncDebugger.Send.
+func (p GlobalLexicalScopeNamesParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandGlobalLexicalScopeNames, p)
+}
+
+// GlobalLexicalScopeNamesReturns are the values returned by Runtime.globalLexicalScopeNames.
+type GlobalLexicalScopeNamesReturns struct {
+	Names []string `json:"names"`
+}
+
+// Do sends Runtime.globalLexicalScopeNames to d and waits for its result or for ctx to be
+// done.
+func (p GlobalLexicalScopeNamesParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*GlobalLexicalScopeNamesReturns, error) {
+	ret := &GlobalLexicalScopeNamesReturns{}
+	if err := protocol.Do(ctx, d, CommandGlobalLexicalScopeNames, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// QueryObjectsParams are the parameters of Runtime.queryObjects.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Runtime#method-queryObjects
+type QueryObjectsParams struct {
+	// Identifier of the prototype to return objects for.
+	PrototypeObjectID RemoteObjectID `json:"prototypeObjectId"`
+	// Symbolic group name that can be used to release the results.
+	ObjectGroup string `json:"objectGroup,omitempty"`
+}
+
+// Command returns the Runtime.queryObjects command for use with
+// AsyncDebugger.Send.
+func (p QueryObjectsParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandQueryObjects, p)
+}
+
+// QueryObjectsReturns are the values returned by Runtime.queryObjects.
+type QueryObjectsReturns struct {
+	// Array with objects.
+	Objects RemoteObject `json:"objects"`
+}
+
+// Do sends Runtime.queryObjects to d and waits for its result or for ctx to be
+// done.
+func (p QueryObjectsParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*QueryObjectsReturns, error) {
+	ret := &QueryObjectsReturns{}
+	if err := protocol.Do(ctx, d, CommandQueryObjects, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// ReleaseObjectParams are the parameters of Runtime.releaseObject.
+//
+// Releases remote object with given id.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Runtime#method-releaseObject
+type ReleaseObjectParams struct {
+	// Identifier of the object to release.
+	ObjectID RemoteObjectID `json:"objectId"`
+}
+
+// Command returns the Runtime.releaseObject command for use with
+// AsyncDebugger.Send.
+func (p ReleaseObjectParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandReleaseObject, p)
+}
+
+// Do sends Runtime.releaseObject to d and waits for it to complete or for ctx to
+// be done.
+func (p ReleaseObjectParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandReleaseObject, p, nil)
+}
+
+// ReleaseObjectGroupParams are the parameters of Runtime.releaseObjectGroup.
+//
+// Releases all remote objects that belong to a given group.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Runtime#method-releaseObjectGroup
+type ReleaseObjectGroupParams struct {
+	// Symbolic object group name.
+	ObjectGroup string `json:"objectGroup"`
+}
+
+// Command returns the Runtime.releaseObjectGroup command for use with
+// AsyncDebugger.Send.
+func (p ReleaseObjectGroupParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandReleaseObjectGroup, p)
+}
+
+// Do sends Runtime.releaseObjectGroup to d and waits for it to complete or for ctx to
+// be done.
+func (p ReleaseObjectGroupParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandReleaseObjectGroup, p, nil)
+}
+
+// RunIfWaitingForDebuggerParams are the parameters of
+// Runtime.runIfWaitingForDebugger.
+//
+// Tells inspected instance to run if it was waiting for debugger to attach.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Runtime#method-runIfWaitingForDebugger
+type RunIfWaitingForDebuggerParams struct {
+}
+
+// Command returns the Runtime.runIfWaitingForDebugger command for use with
+// AsyncDebugger.Send.
+func (p RunIfWaitingForDebuggerParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandRunIfWaitingForDebugger, p)
+}
+
+// Do sends Runtime.runIfWaitingForDebugger to d and waits for it to complete or for ctx to
+// be done.
+func (p RunIfWaitingForDebuggerParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandRunIfWaitingForDebugger, p, nil)
+}
+
+// RunScriptParams are the parameters of Runtime.runScript.
+//
+// Runs script with given id in a given context.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Runtime#method-runScript
+type RunScriptParams struct {
+	// Id of the script to run.
+	ScriptID ScriptID `json:"scriptId"`
+	// Specifies in which execution context to perform script run. If the parameter
+	// is omitted the evaluation will be performed in the context of the inspected
+	// page.
+	ExecutionContextID ExecutionContextID `json:"executionContextId,omitempty"`
+	// Symbolic group name that can be used to release multiple objects.
+	ObjectGroup string `json:"objectGroup,omitempty"`
+	// In silent mode exceptions thrown during evaluation are not reported and do
+	// not pause execution. Overrides `setPauseOnException` state.
+	Silent bool `json:"silent,omitempty"`
+	// Determines whether Command Line API should be available during the
+	// evaluation.
+	IncludeCommandLineAPI bool `json:"includeCommandLineAPI,omitempty"`
+	// Whether the result is expected to be a JSON object which should be sent by
+	// value.
+	ReturnByValue bool `json:"returnByValue,omitempty"`
+	// Whether preview should be generated for the result.
+	GeneratePreview bool `json:"generatePreview,omitempty"`
+	// Whether execution should `await` for resulting value and return once awaited
+	// promise is resolved.
+	AwaitPromise bool `json:"awaitPromise,omitempty"`
+}
+
+// Command returns the Runtime.runScript command for use with
+// AsyncDebugger.Send.
+func (p RunScriptParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandRunScript, p)
+}
+
+// RunScriptReturns are the values returned by Runtime.runScript.
+type RunScriptReturns struct {
+	// Run result.
+	Result RemoteObject `json:"result"`
+	// Exception details.
+	ExceptionDetails *ExceptionDetails `json:"exceptionDetails,omitempty"`
+}
+
+// Do sends Runtime.runScript to d and waits for its result or for ctx to be
+// done.
+func (p RunScriptParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*RunScriptReturns, error) {
+	ret := &RunScriptReturns{}
+	if err := protocol.Do(ctx, d, CommandRunScript, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// SetAsyncCallStackDepthParams are the parameters of
+// Runtime.setAsyncCallStackDepth.
+//
+// Enables or disables async call stacks tracking.
+//
+// Redirects to the Debugger domain.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Runtime#method-setAsyncCallStackDepth
+type SetAsyncCallStackDepthParams struct {
+	// Maximum depth of async call stacks. Setting to `0` will effectively disable
+	// collecting async call stacks (default).
+	MaxDepth int64 `json:"maxDepth"`
+}
+
+// Command returns the Runtime.setAsyncCallStackDepth command for use with
+// AsyncDebugger.Send.
+func (p SetAsyncCallStackDepthParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetAsyncCallStackDepth, p)
+}
+
+// Do sends Runtime.setAsyncCallStackDepth to d and waits for it to complete or for ctx to
+// be done.
+func (p SetAsyncCallStackDepthParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetAsyncCallStackDepth, p, nil)
+}
+
+// SetCustomObjectFormatterEnabledParams are the parameters of
+// Runtime.setCustomObjectFormatterEnabled.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Runtime#method-setCustomObjectFormatterEnabled
+//
+// This is an experimental part of the protocol.
+type SetCustomObjectFormatterEnabledParams struct {
+	Enabled bool `json:"enabled"`
+}
+
+// Command returns the Runtime.setCustomObjectFormatterEnabled command for use
+// with AsyncDebugger.Send.
+func (p SetCustomObjectFormatterEnabledParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetCustomObjectFormatterEnabled, p)
+}
+
+// Do sends Runtime.setCustomObjectFormatterEnabled to d and waits for it to complete or for ctx to
+// be done.
+func (p SetCustomObjectFormatterEnabledParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetCustomObjectFormatterEnabled, p, nil)
+}
+
+// SetMaxCallStackSizeToCaptureParams are the parameters of
+// Runtime.setMaxCallStackSizeToCapture.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Runtime#method-setMaxCallStackSizeToCapture
+//
+// This is an experimental part of the protocol.
+type SetMaxCallStackSizeToCaptureParams struct {
+	Size int64 `json:"size"`
+}
+
+// Command returns the Runtime.setMaxCallStackSizeToCapture command for use with
+// AsyncDebugger.Send.
+func (p SetMaxCallStackSizeToCaptureParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetMaxCallStackSizeToCapture, p)
+}
+
+// Do sends Runtime.setMaxCallStackSizeToCapture to d and waits for it to complete or for ctx to
+// be done.
+func (p SetMaxCallStackSizeToCaptureParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetMaxCallStackSizeToCapture, p, nil)
+}
+
+// TerminateExecutionParams are the parameters of Runtime.terminateExecution.
+//
+// Terminate current or next JavaScript execution. Will cancel the termination
+// when the outer-most script execution ends.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Runtime#method-terminateExecution
+//
+// This is an experimental part of the protocol.
+type TerminateExecutionParams struct {
+}
+
+// Command returns the Runtime.terminateExecution command for use with
+// AsyncDebugger.Send.
+func (p TerminateExecutionParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandTerminateExecution, p)
+}
+
+// Do sends Runtime.terminateExecution to d and waits for it to complete or for ctx to
+// be done.
+func (p TerminateExecutionParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandTerminateExecution, p, nil)
+}
+
+// AddBindingParams are the parameters of Runtime.addBinding.
+//
+// If executionContextId is empty, adds binding with the given name on the
+// global objects of all inspected contexts, including those created later,
+// bindings survive reloads. Binding function takes exactly one argument, this
+// argument should be string, in case of any other input, function throws an
+// exception. Each binding function call produces Runtime.bindingCalled
+// notification.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Runtime#method-addBinding
+type AddBindingParams struct {
+	Name string `json:"name"`
+	// If specified, the binding would only be exposed to the specified execution
+	// context. If omitted and `executionContextName` is not set, the binding is
+	// exposed to all execution contexts of the target. This parameter is mutually
+	// exclusive with `executionContextName`. Deprecated in favor of
+	// `executionContextName` due to an unclear use case and bugs in implementation
+	// (crbug.com/1169639). `executionContextId` will be removed in the future.
+	//
+	// This is an experimental part of the protocol.
+	//
+	// Deprecated: this is deprecated in the protocol.
+	ExecutionContextID ExecutionContextID `json:"executionContextId,omitempty"`
+	// If specified, the binding is exposed to the executionContext with matching
+	// name, even for contexts created after the binding is added. See also
+	// `ExecutionContext.name` and `worldName` parameter to
+	// `Page.addScriptToEvaluateOnNewDocument`. This parameter is mutually
+	// exclusive with `executionContextId`.
+	ExecutionContextName string `json:"executionContextName,omitempty"`
+}
+
+// Command returns the Runtime.addBinding command for use with
+// AsyncDebugger.Send.
+func (p AddBindingParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandAddBinding, p)
+}
+
+// Do sends Runtime.addBinding to d and waits for it to complete or for ctx to
+// be done.
+func (p AddBindingParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandAddBinding, p, nil)
+}
+
+// RemoveBindingParams are the parameters of Runtime.removeBinding.
+//
+// This method does not remove binding function from global object but
+// unsubscribes current runtime agent from Runtime.bindingCalled notifications.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Runtime#method-removeBinding
+type RemoveBindingParams struct {
+	Name string `json:"name"`
+}
+
+// Command returns the Runtime.removeBinding command for use with
+// AsyncDebugger.Send.
+func (p RemoveBindingParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandRemoveBinding, p)
+}
+
+// Do sends Runtime.removeBinding to d and waits for it to complete or for ctx to
+// be done.
+func (p RemoveBindingParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandRemoveBinding, p, nil)
+}
+
+// GetExceptionDetailsParams are the parameters of Runtime.getExceptionDetails.
+//
+// This method tries to lookup and populate exception details for a JavaScript
+// Error object. Note that the stackTrace portion of the resulting
+// exceptionDetails will only be populated if the Runtime domain was enabled at
+// the time when the Error was thrown.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Runtime#method-getExceptionDetails
+//
+// This is an experimental part of the protocol.
+type GetExceptionDetailsParams struct {
+	// The error object for which to resolve the exception details.
+	ErrorObjectID RemoteObjectID `json:"errorObjectId"`
+}
+
+// Command returns the Runtime.getExceptionDetails command for use with
+// AsyncDebugger.Send.
+func (p GetExceptionDetailsParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandGetExceptionDetails, p)
+}
+
+// GetExceptionDetailsReturns are the values returned by Runtime.getExceptionDetails.
+type GetExceptionDetailsReturns struct {
+	ExceptionDetails *ExceptionDetails `json:"exceptionDetails,omitempty"`
+}
+
+// Do sends Runtime.getExceptionDetails to d and waits for its result or for ctx to be
+// done.
+func (p GetExceptionDetailsParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*GetExceptionDetailsReturns, error) {
+	ret := &GetExceptionDetailsReturns{}
+	if err := protocol.Do(ctx, d, CommandGetExceptionDetails, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// Method names of the events in the Runtime domain.
+const (
+	EventBindingCalled             = "Runtime.bindingCalled"
+	EventConsoleAPICalled          = "Runtime.consoleAPICalled"
+	EventExceptionRevoked          = "Runtime.exceptionRevoked"
+	EventExceptionThrown           = "Runtime.exceptionThrown"
+	EventExecutionContextCreated   = "Runtime.executionContextCreated"
+	EventExecutionContextDestroyed = "Runtime.executionContextDestroyed"
+	EventExecutionContextsCleared  = "Runtime.executionContextsCleared"
+	EventInspectRequested          = "Runtime.inspectRequested"
+)
+
+// BindingCalledEvent is the payload of the Runtime.bindingCalled event.
+//
+// Notification is issued every time when binding is called.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Runtime#event-bindingCalled
+//
+// This is an experimental part of the protocol.
+type BindingCalledEvent struct {
+	Name    string `json:"name"`
+	Payload string `json:"payload"`
+	// Identifier of the context where the call was made.
+	ExecutionContextID ExecutionContextID `json:"executionContextId"`
+}
+
+// ConsoleAPICalledEvent is the payload of the Runtime.consoleAPICalled event.
+//
+// Issued when console API was called.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Runtime#event-consoleAPICalled
+type ConsoleAPICalledEvent struct {
+	// Type of the call.
+	//
+	// Allowed values: log, debug, info, error, warning, dir, dirxml, table, trace,
+	// clear, startGroup, startGroupCollapsed, endGroup, assert, profile,
+	// profileEnd, count, timeEnd.
+	Type string `json:"type"`
+	// Call arguments.
+	Args []RemoteObject `json:"args"`
+	// Identifier of the context where the call was made.
+	ExecutionContextID ExecutionContextID `json:"executionContextId"`
+	// Call timestamp.
+	Timestamp Timestamp `json:"timestamp"`
+	// Stack trace captured when the call was made. The async stack chain is
+	// automatically reported for the following call types: `assert`, `error`,
+	// `trace`, `warning`. For other types the async call chain can be retrieved
+	// using `Debugger.getStackTrace` and `stackTrace.parentId` field.
+	StackTrace *StackTrace `json:"stackTrace,omitempty"`
+	// Console context descriptor for calls on non-default console context (not
+	// console.*): 'anonymous#unique-logger-id' for call on unnamed context,
+	// 'name#unique-logger-id' for call on named context.
+	//
+	// This is an experimental part of the protocol.
+	Context string `json:"context,omitempty"`
+}
+
+// ExceptionRevokedEvent is the payload of the Runtime.exceptionRevoked event.
+//
+// Issued when unhandled exception was revoked.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Runtime#event-exceptionRevoked
+type ExceptionRevokedEvent struct {
+	// Reason describing why exception was revoked.
+	Reason string `json:"reason"`
+	// The id of revoked exception, as reported in `exceptionThrown`.
+	ExceptionID int64 `json:"exceptionId"`
+}
+
+// ExceptionThrownEvent is the payload of the Runtime.exceptionThrown event.
+//
+// Issued when exception was thrown and unhandled.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Runtime#event-exceptionThrown
+type ExceptionThrownEvent struct {
+	// Timestamp of the exception.
+	Timestamp        Timestamp        `json:"timestamp"`
+	ExceptionDetails ExceptionDetails `json:"exceptionDetails"`
+}
+
+// ExecutionContextCreatedEvent is the payload of the
+// Runtime.executionContextCreated event.
+//
+// Issued when new execution context is created.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Runtime#event-executionContextCreated
+type ExecutionContextCreatedEvent struct {
+	// A newly created execution context.
+	Context ExecutionContextDescription `json:"context"`
+}
+
+// ExecutionContextDestroyedEvent is the payload of the
+// Runtime.executionContextDestroyed event.
+//
+// Issued when execution context is destroyed.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Runtime#event-executionContextDestroyed
+type ExecutionContextDestroyedEvent struct {
+	// Id of the destroyed context
+	//
+	// Deprecated: this is deprecated in the protocol.
+	ExecutionContextID ExecutionContextID `json:"executionContextId"`
+	// Unique Id of the destroyed context
+	//
+	// This is an experimental part of the protocol.
+	ExecutionContextUniqueID string `json:"executionContextUniqueId"`
+}
+
+// ExecutionContextsClearedEvent is the payload of the
+// Runtime.executionContextsCleared event.
+//
+// # Issued when all executionContexts were cleared in browser
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Runtime#event-executionContextsCleared
+type ExecutionContextsClearedEvent struct {
+}
+
+// InspectRequestedEvent is the payload of the Runtime.inspectRequested event.
+//
+// Issued when object should be inspected (for example, as a result of inspect()
+// command line API call).
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Runtime#event-inspectRequested
+type InspectRequestedEvent struct {
+	Object RemoteObject           `json:"object"`
+	Hints  map[string]interface{} `json:"hints"`
+	// Identifier of the context where the call was made.
+	//
+	// This is an experimental part of the protocol.
+	ExecutionContextID ExecutionContextID `json:"executionContextId,omitempty"`
+}
+
+// ParseEvent decodes an event from the Runtime domain, as received from
+// Subscribe, into a pointer to its typed payload.
+func ParseEvent(cmd chromedebugo.Command) (interface{}, error) {
+	var ev interface{}
+	switch cmd.Method {
+	case EventBindingCalled:
+		ev = &BindingCalledEvent{}
+	case EventConsoleAPICalled:
+		ev = &ConsoleAPICalledEvent{}
+	case EventExceptionRevoked:
+		ev = &ExceptionRevokedEvent{}
+	case EventExceptionThrown:
+		ev = &ExceptionThrownEvent{}
+	case EventExecutionContextCreated:
+		ev = &ExecutionContextCreatedEvent{}
+	case EventExecutionContextDestroyed:
+		ev = &ExecutionContextDestroyedEvent{}
+	case EventExecutionContextsCleared:
+		ev = &ExecutionContextsClearedEvent{}
+	case EventInspectRequested:
+		ev = &InspectRequestedEvent{}
+	default:
+		return nil, fmt.Errorf("unknown Runtime event: %s", cmd.Method)
+	}
+	if err := cmd.Decode(ev); err != nil {
+		return nil, err
+	}
+	return ev, nil
+}
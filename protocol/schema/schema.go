@@ -0,0 +1,59 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+// Package schema provides typed bindings for the Schema domain of the Chrome
+// DevTools protocol.
+//
+// This domain is deprecated.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Schema
+//
+// Deprecated: this is deprecated in the protocol.
+package schema
+
+import (
+	"context"
+
+	"github.com/tonyhb/chromedebugo"
+	"github.com/tonyhb/chromedebugo/protocol"
+	"github.com/tonyhb/chromedebugo/protocol/internal/types"
+)
+
+// Domain description of the protocol domain.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Schema#type-Domain
+type Domain = types.SchemaDomain
+
+// Method names of the commands in the Schema domain.
+const (
+	CommandGetDomains = "Schema.getDomains"
+)
+
+// GetDomainsParams are the parameters of Schema.getDomains.
+//
+// Returns supported domains.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Schema#method-getDomains
+type GetDomainsParams struct {
+}
+
+// Command returns the Schema.getDomains command for use with
+// AsyncDebugger.Send.
+func (p GetDomainsParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandGetDomains, p)
+}
+
+// GetDomainsReturns are the values returned by Schema.getDomains.
+type GetDomainsReturns struct {
+	// List of supported domains.
+	Domains []Domain `json:"domains"`
+}
+
+// Do sends Schema.getDomains to d and waits for its result or for ctx to be
+// done.
+func (p GetDomainsParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*GetDomainsReturns, error) {
+	ret := &GetDomainsReturns{}
+	if err := protocol.Do(ctx, d, CommandGetDomains, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
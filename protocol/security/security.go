@@ -0,0 +1,313 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+// Package security provides typed bindings for the Security domain of the
+// Chrome DevTools protocol.
+//
+// # Security
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Security
+package security
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tonyhb/chromedebugo"
+	"github.com/tonyhb/chromedebugo/protocol"
+	"github.com/tonyhb/chromedebugo/protocol/internal/types"
+)
+
+// CertificateID an internal certificate ID value.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Security#type-CertificateId
+type CertificateID = types.SecurityCertificateID
+
+// MixedContentType a description of mixed content (HTTP resources on HTTPS
+// pages), as defined by https://www.w3.org/TR/mixed-content/#categories
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Security#type-MixedContentType
+type MixedContentType = types.SecurityMixedContentType
+
+// SecurityState the security level of a page or resource.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Security#type-SecurityState
+type SecurityState = types.SecuritySecurityState
+
+// CertificateSecurityState details about the security state of the page
+// certificate.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Security#type-CertificateSecurityState
+//
+// This is an experimental part of the protocol.
+type CertificateSecurityState = types.SecurityCertificateSecurityState
+
+// SafetyTipStatus is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Security#type-SafetyTipStatus
+//
+// This is an experimental part of the protocol.
+type SafetyTipStatus = types.SecuritySafetyTipStatus
+
+// SafetyTipInfo is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Security#type-SafetyTipInfo
+//
+// This is an experimental part of the protocol.
+type SafetyTipInfo = types.SecuritySafetyTipInfo
+
+// VisibleSecurityState security state information about the page.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Security#type-VisibleSecurityState
+//
+// This is an experimental part of the protocol.
+type VisibleSecurityState = types.SecurityVisibleSecurityState
+
+// SecurityStateExplanation an explanation of an factor contributing to the
+// security state.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Security#type-SecurityStateExplanation
+type SecurityStateExplanation = types.SecuritySecurityStateExplanation
+
+// InsecureContentStatus information about insecure content on the page.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Security#type-InsecureContentStatus
+//
+// Deprecated: this is deprecated in the protocol.
+type InsecureContentStatus = types.SecurityInsecureContentStatus
+
+// CertificateErrorAction the action to take when a certificate error occurs.
+// continue will continue processing the request and cancel will cancel the
+// request.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Security#type-CertificateErrorAction
+type CertificateErrorAction = types.SecurityCertificateErrorAction
+
+// Values of the enumerated types in the Security domain.
+const (
+	MixedContentTypeBlockable           = types.SecurityMixedContentTypeBlockable
+	MixedContentTypeOptionallyBlockable = types.SecurityMixedContentTypeOptionallyBlockable
+	MixedContentTypeNone                = types.SecurityMixedContentTypeNone
+	SecurityStateUnknown                = types.SecuritySecurityStateUnknown
+	SecurityStateNeutral                = types.SecuritySecurityStateNeutral
+	SecurityStateInsecure               = types.SecuritySecurityStateInsecure
+	SecurityStateSecure                 = types.SecuritySecurityStateSecure
+	SecurityStateInfo                   = types.SecuritySecurityStateInfo
+	SecurityStateInsecureBroken         = types.SecuritySecurityStateInsecureBroken
+	SafetyTipStatusBadReputation        = types.SecuritySafetyTipStatusBadReputation
+	SafetyTipStatusLookalike            = types.SecuritySafetyTipStatusLookalike
+	CertificateErrorActionContinue      = types.SecurityCertificateErrorActionContinue
+	CertificateErrorActionCancel        = types.SecurityCertificateErrorActionCancel
+)
+
+// Method names of the commands in the Security domain.
+const (
+	CommandDisable                      = "Security.disable"
+	CommandEnable                       = "Security.enable"
+	CommandSetIgnoreCertificateErrors   = "Security.setIgnoreCertificateErrors"
+	CommandHandleCertificateError       = "Security.handleCertificateError"
+	CommandSetOverrideCertificateErrors = "Security.setOverrideCertificateErrors"
+)
+
+// DisableParams are the parameters of Security.disable.
+//
+// Disables tracking security state changes.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Security#method-disable
+type DisableParams struct {
+}
+
+// Command returns the Security.disable command for use with AsyncDebugger.Send.
+func (p DisableParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandDisable, p)
+}
+
+// Do sends Security.disable to d and waits for it to complete or for ctx to
+// be done.
+func (p DisableParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandDisable, p, nil)
+}
+
+// EnableParams are the parameters of Security.enable.
+//
+// Enables tracking security state changes.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Security#method-enable
+type EnableParams struct {
+}
+
+// Command returns the Security.enable command for use with AsyncDebugger.Send.
+func (p EnableParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandEnable, p)
+}
+
+// Do sends Security.enable to d and waits for it to complete or for ctx to
+// be done.
+func (p EnableParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandEnable, p, nil)
+}
+
+// SetIgnoreCertificateErrorsParams are the parameters of
+// Security.setIgnoreCertificateErrors.
+//
+// Enable/disable whether all certificate errors should be ignored.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Security#method-setIgnoreCertificateErrors
+type SetIgnoreCertificateErrorsParams struct {
+	// If true, all certificate errors will be ignored.
+	Ignore bool `json:"ignore"`
+}
+
+// Command returns the Security.setIgnoreCertificateErrors command for use with
+// AsyncDebugger.Send.
+func (p SetIgnoreCertificateErrorsParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetIgnoreCertificateErrors, p)
+}
+
+// Do sends Security.setIgnoreCertificateErrors to d and waits for it to complete or for ctx to
+// be done.
+func (p SetIgnoreCertificateErrorsParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetIgnoreCertificateErrors, p, nil)
+}
+
+// HandleCertificateErrorParams are the parameters of
+// Security.handleCertificateError.
+//
+// Handles a certificate error that fired a certificateError event.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Security#method-handleCertificateError
+//
+// Deprecated: this is deprecated in the protocol.
+type HandleCertificateErrorParams struct {
+	// The ID of the event.
+	EventID int64 `json:"eventId"`
+	// The action to take on the certificate error.
+	Action CertificateErrorAction `json:"action"`
+}
+
+// Command returns the Security.handleCertificateError command for use with
+// AsyncDebugger.Send.
+func (p HandleCertificateErrorParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandHandleCertificateError, p)
+}
+
+// Do sends Security.handleCertificateError to d and waits for it to complete or for ctx to
+// be done.
+func (p HandleCertificateErrorParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandHandleCertificateError, p, nil)
+}
+
+// SetOverrideCertificateErrorsParams are the parameters of
+// Security.setOverrideCertificateErrors.
+//
+// Enable/disable overriding certificate errors. If enabled, all certificate
+// error events need to be handled by the DevTools client and should be answered
+// with `handleCertificateError` commands.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Security#method-setOverrideCertificateErrors
+//
+// Deprecated: this is deprecated in the protocol.
+type SetOverrideCertificateErrorsParams struct {
+	// If true, certificate errors will be overridden.
+	Override bool `json:"override"`
+}
+
+// Command returns the Security.setOverrideCertificateErrors command for use
+// with AsyncDebugger.Send.
+func (p SetOverrideCertificateErrorsParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetOverrideCertificateErrors, p)
+}
+
+// Do sends Security.setOverrideCertificateErrors to d and waits for it to complete or for ctx to
+// be done.
+func (p SetOverrideCertificateErrorsParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetOverrideCertificateErrors, p, nil)
+}
+
+// Method names of the events in the Security domain.
+const (
+	EventCertificateError            = "Security.certificateError"
+	EventVisibleSecurityStateChanged = "Security.visibleSecurityStateChanged"
+	EventSecurityStateChanged        = "Security.securityStateChanged"
+)
+
+// CertificateErrorEvent is the payload of the Security.certificateError event.
+//
+// There is a certificate error. If overriding certificate errors is enabled,
+// then it should be handled with the `handleCertificateError` command. Note:
+// this event does not fire if the certificate error has been allowed
+// internally. Only one client per target should override certificate errors at
+// the same time.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Security#event-certificateError
+//
+// Deprecated: this is deprecated in the protocol.
+type CertificateErrorEvent struct {
+	// The ID of the event.
+	EventID int64 `json:"eventId"`
+	// The type of the error.
+	ErrorType string `json:"errorType"`
+	// The url that was requested.
+	RequestURL string `json:"requestURL"`
+}
+
+// VisibleSecurityStateChangedEvent is the payload of the
+// Security.visibleSecurityStateChanged event.
+//
+// The security state of the page changed.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Security#event-visibleSecurityStateChanged
+//
+// This is an experimental part of the protocol.
+type VisibleSecurityStateChangedEvent struct {
+	// Security state information about the page.
+	VisibleSecurityState VisibleSecurityState `json:"visibleSecurityState"`
+}
+
+// SecurityStateChangedEvent is the payload of the Security.securityStateChanged
+// event.
+//
+// The security state of the page changed. No longer being sent.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Security#event-securityStateChanged
+//
+// Deprecated: this is deprecated in the protocol.
+type SecurityStateChangedEvent struct {
+	// Security state.
+	SecurityState SecurityState `json:"securityState"`
+	// True if the page was loaded over cryptographic transport such as HTTPS.
+	//
+	// Deprecated: this is deprecated in the protocol.
+	SchemeIsCryptographic bool `json:"schemeIsCryptographic"`
+	// Previously a list of explanations for the security state. Now always empty.
+	//
+	// Deprecated: this is deprecated in the protocol.
+	Explanations []SecurityStateExplanation `json:"explanations"`
+	// Information about insecure content on the page.
+	//
+	// Deprecated: this is deprecated in the protocol.
+	InsecureContentStatus InsecureContentStatus `json:"insecureContentStatus"`
+	// Overrides user-visible description of the state. Always omitted.
+	//
+	// Deprecated: this is deprecated in the protocol.
+	Summary string `json:"summary,omitempty"`
+}
+
+// ParseEvent decodes an event from the Security domain, as received from
+// Subscribe, into a pointer to its typed payload.
+func ParseEvent(cmd chromedebugo.Command) (interface{}, error) {
+	var ev interface{}
+	switch cmd.Method {
+	case EventCertificateError:
+		ev = &CertificateErrorEvent{}
+	case EventVisibleSecurityStateChanged:
+		ev = &VisibleSecurityStateChangedEvent{}
+	case EventSecurityStateChanged:
+		ev = &SecurityStateChangedEvent{}
+	default:
+		return nil, fmt.Errorf("unknown Security event: %s", cmd.Method)
+	}
+	if err := cmd.Decode(ev); err != nil {
+		return nil, err
+	}
+	return ev, nil
+}
@@ -0,0 +1,366 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+// Package serviceworker provides typed bindings for the ServiceWorker domain of
+// the Chrome DevTools protocol.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/ServiceWorker
+//
+// This is an experimental part of the protocol.
+package serviceworker
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tonyhb/chromedebugo"
+	"github.com/tonyhb/chromedebugo/protocol"
+	"github.com/tonyhb/chromedebugo/protocol/internal/types"
+)
+
+// RegistrationID is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/ServiceWorker#type-RegistrationID
+type RegistrationID = types.ServiceWorkerRegistrationID
+
+// ServiceWorkerRegistration serviceWorker registration.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/ServiceWorker#type-ServiceWorkerRegistration
+type ServiceWorkerRegistration = types.ServiceWorkerServiceWorkerRegistration
+
+// ServiceWorkerVersionRunningStatus is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/ServiceWorker#type-ServiceWorkerVersionRunningStatus
+type ServiceWorkerVersionRunningStatus = types.ServiceWorkerServiceWorkerVersionRunningStatus
+
+// ServiceWorkerVersionStatus is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/ServiceWorker#type-ServiceWorkerVersionStatus
+type ServiceWorkerVersionStatus = types.ServiceWorkerServiceWorkerVersionStatus
+
+// ServiceWorkerVersion serviceWorker version.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/ServiceWorker#type-ServiceWorkerVersion
+type ServiceWorkerVersion = types.ServiceWorkerServiceWorkerVersion
+
+// ServiceWorkerErrorMessage serviceWorker error message.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/ServiceWorker#type-ServiceWorkerErrorMessage
+type ServiceWorkerErrorMessage = types.ServiceWorkerServiceWorkerErrorMessage
+
+// Values of the enumerated types in the ServiceWorker domain.
+const (
+	ServiceWorkerVersionRunningStatusStopped  = types.ServiceWorkerServiceWorkerVersionRunningStatusStopped
+	ServiceWorkerVersionRunningStatusStarting = types.ServiceWorkerServiceWorkerVersionRunningStatusStarting
+	ServiceWorkerVersionRunningStatusRunning  = types.ServiceWorkerServiceWorkerVersionRunningStatusRunning
+	ServiceWorkerVersionRunningStatusStopping = types.ServiceWorkerServiceWorkerVersionRunningStatusStopping
+	ServiceWorkerVersionStatusNew             = types.ServiceWorkerServiceWorkerVersionStatusNew
+	ServiceWorkerVersionStatusInstalling      = types.ServiceWorkerServiceWorkerVersionStatusInstalling
+	ServiceWorkerVersionStatusInstalled       = types.ServiceWorkerServiceWorkerVersionStatusInstalled
+	ServiceWorkerVersionStatusActivating      = types.ServiceWorkerServiceWorkerVersionStatusActivating
+	ServiceWorkerVersionStatusActivated       = types.ServiceWorkerServiceWorkerVersionStatusActivated
+	ServiceWorkerVersionStatusRedundant       = types.ServiceWorkerServiceWorkerVersionStatusRedundant
+)
+
+// Method names of the commands in the ServiceWorker domain.
+const (
+	CommandDeliverPushMessage        = "ServiceWorker.deliverPushMessage"
+	CommandDisable                   = "ServiceWorker.disable"
+	CommandDispatchSyncEvent         = "ServiceWorker.dispatchSyncEvent"
+	CommandDispatchPeriodicSyncEvent = "ServiceWorker.dispatchPeriodicSyncEvent"
+	CommandEnable                    = "ServiceWorker.enable"
+	CommandSetForceUpdateOnPageLoad  = "ServiceWorker.setForceUpdateOnPageLoad"
+	CommandSkipWaiting               = "ServiceWorker.skipWaiting"
+	CommandStartWorker               = "ServiceWorker.startWorker"
+	CommandStopAllWorkers            = "ServiceWorker.stopAllWorkers"
+	CommandStopWorker                = "ServiceWorker.stopWorker"
+	CommandUnregister                = "ServiceWorker.unregister"
+	CommandUpdateRegistration        = "ServiceWorker.updateRegistration"
+)
+
+// DeliverPushMessageParams are the parameters of
+// ServiceWorker.deliverPushMessage.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/ServiceWorker#method-deliverPushMessage
+type DeliverPushMessageParams struct {
+	Origin         string         `json:"origin"`
+	RegistrationID RegistrationID `json:"registrationId"`
+	Data           string         `json:"data"`
+}
+
+// Command returns the ServiceWorker.deliverPushMessage command for use with
+// AsyncDebugger.Send.
+func (p DeliverPushMessageParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandDeliverPushMessage, p)
+}
+
+// Do sends ServiceWorker.deliverPushMessage to d and waits for it to complete or for ctx to
+// be done.
+func (p DeliverPushMessageParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandDeliverPushMessage, p, nil)
+}
+
+// DisableParams are the parameters of ServiceWorker.disable.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/ServiceWorker#method-disable
+type DisableParams struct {
+}
+
+// Command returns the ServiceWorker.disable command for use with
+// AsyncDebugger.Send.
+func (p DisableParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandDisable, p)
+}
+
+// Do sends ServiceWorker.disable to d and waits for it to complete or for ctx to
+// be done.
+func (p DisableParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandDisable, p, nil)
+}
+
+// DispatchSyncEventParams are the parameters of
+// ServiceWorker.dispatchSyncEvent.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/ServiceWorker#method-dispatchSyncEvent
+type DispatchSyncEventParams struct {
+	Origin         string         `json:"origin"`
+	RegistrationID RegistrationID `json:"registrationId"`
+	Tag            string         `json:"tag"`
+	LastChance     bool           `json:"lastChance"`
+}
+
+// Command returns the ServiceWorker.dispatchSyncEvent command for use with
+// AsyncDebugger.Send.
+func (p DispatchSyncEventParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandDispatchSyncEvent, p)
+}
+
+// Do sends ServiceWorker.dispatchSyncEvent to d and waits for it to complete or for ctx to
+// be done.
+func (p DispatchSyncEventParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandDispatchSyncEvent, p, nil)
+}
+
+// DispatchPeriodicSyncEventParams are the parameters of
+// ServiceWorker.dispatchPeriodicSyncEvent.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/ServiceWorker#method-dispatchPeriodicSyncEvent
+type DispatchPeriodicSyncEventParams struct {
+	Origin         string         `json:"origin"`
+	RegistrationID RegistrationID `json:"registrationId"`
+	Tag            string         `json:"tag"`
+}
+
+// Command returns the ServiceWorker.dispatchPeriodicSyncEvent command for use
+// with AsyncDebugger.Send.
+func (p DispatchPeriodicSyncEventParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandDispatchPeriodicSyncEvent, p)
+}
+
+// Do sends ServiceWorker.dispatchPeriodicSyncEvent to d and waits for it to complete or for ctx to
+// be done.
+func (p DispatchPeriodicSyncEventParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandDispatchPeriodicSyncEvent, p, nil)
+}
+
+// EnableParams are the parameters of ServiceWorker.enable.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/ServiceWorker#method-enable
+type EnableParams struct {
+}
+
+// Command returns the ServiceWorker.enable command for use with
+// AsyncDebugger.Send.
+func (p EnableParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandEnable, p)
+}
+
+// Do sends ServiceWorker.enable to d and waits for it to complete or for ctx to
+// be done.
+func (p EnableParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandEnable, p, nil)
+}
+
+// SetForceUpdateOnPageLoadParams are the parameters of
+// ServiceWorker.setForceUpdateOnPageLoad.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/ServiceWorker#method-setForceUpdateOnPageLoad
+type SetForceUpdateOnPageLoadParams struct {
+	ForceUpdateOnPageLoad bool `json:"forceUpdateOnPageLoad"`
+}
+
+// Command returns the ServiceWorker.setForceUpdateOnPageLoad command for use
+// with AsyncDebugger.Send.
+func (p SetForceUpdateOnPageLoadParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetForceUpdateOnPageLoad, p)
+}
+
+// Do sends ServiceWorker.setForceUpdateOnPageLoad to d and waits for it to complete or for ctx to
+// be done.
+func (p SetForceUpdateOnPageLoadParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetForceUpdateOnPageLoad, p, nil)
+}
+
+// SkipWaitingParams are the parameters of ServiceWorker.skipWaiting.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/ServiceWorker#method-skipWaiting
+type SkipWaitingParams struct {
+	ScopeURL string `json:"scopeURL"`
+}
+
+// Command returns the ServiceWorker.skipWaiting command for use with
+// AsyncDebugger.Send.
+func (p SkipWaitingParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSkipWaiting, p)
+}
+
+// Do sends ServiceWorker.skipWaiting to d and waits for it to complete or for ctx to
+// be done.
+func (p SkipWaitingParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSkipWaiting, p, nil)
+}
+
+// StartWorkerParams are the parameters of ServiceWorker.startWorker.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/ServiceWorker#method-startWorker
+type StartWorkerParams struct {
+	ScopeURL string `json:"scopeURL"`
+}
+
+// Command returns the ServiceWorker.startWorker command for use with
+// AsyncDebugger.Send.
+func (p StartWorkerParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandStartWorker, p)
+}
+
+// Do sends ServiceWorker.startWorker to d and waits for it to complete or for ctx to
+// be done.
+func (p StartWorkerParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandStartWorker, p, nil)
+}
+
+// StopAllWorkersParams are the parameters of ServiceWorker.stopAllWorkers.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/ServiceWorker#method-stopAllWorkers
+type StopAllWorkersParams struct {
+}
+
+// Command returns the ServiceWorker.stopAllWorkers command for use with
+// AsyncDebugger.Send.
+func (p StopAllWorkersParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandStopAllWorkers, p)
+}
+
+// Do sends ServiceWorker.stopAllWorkers to d and waits for it to complete or for ctx to
+// be done.
+func (p StopAllWorkersParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandStopAllWorkers, p, nil)
+}
+
+// StopWorkerParams are the parameters of ServiceWorker.stopWorker.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/ServiceWorker#method-stopWorker
+type StopWorkerParams struct {
+	VersionID string `json:"versionId"`
+}
+
+// Command returns the ServiceWorker.stopWorker command for use with
+// AsyncDebugger.Send.
+func (p StopWorkerParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandStopWorker, p)
+}
+
+// Do sends ServiceWorker.stopWorker to d and waits for it to complete or for ctx to
+// be done.
+func (p StopWorkerParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandStopWorker, p, nil)
+}
+
+// UnregisterParams are the parameters of ServiceWorker.unregister.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/ServiceWorker#method-unregister
+type UnregisterParams struct {
+	ScopeURL string `json:"scopeURL"`
+}
+
+// Command returns the ServiceWorker.unregister command for use with
+// AsyncDebugger.Send.
+func (p UnregisterParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandUnregister, p)
+}
+
+// Do sends ServiceWorker.unregister to d and waits for it to complete or for ctx to
+// be done.
+func (p UnregisterParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandUnregister, p, nil)
+}
+
+// UpdateRegistrationParams are the parameters of
+// ServiceWorker.updateRegistration.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/ServiceWorker#method-updateRegistration
+type UpdateRegistrationParams struct {
+	ScopeURL string `json:"scopeURL"`
+}
+
+// Command returns the ServiceWorker.updateRegistration command for use with
+// AsyncDebugger.Send.
+func (p UpdateRegistrationParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandUpdateRegistration, p)
+}
+
+// Do sends ServiceWorker.updateRegistration to d and waits for it to complete or for ctx to
+// be done.
+func (p UpdateRegistrationParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandUpdateRegistration, p, nil)
+}
+
+// Method names of the events in the ServiceWorker domain.
+const (
+	EventWorkerErrorReported       = "ServiceWorker.workerErrorReported"
+	EventWorkerRegistrationUpdated = "ServiceWorker.workerRegistrationUpdated"
+	EventWorkerVersionUpdated      = "ServiceWorker.workerVersionUpdated"
+)
+
+// WorkerErrorReportedEvent is the payload of the
+// ServiceWorker.workerErrorReported event.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/ServiceWorker#event-workerErrorReported
+type WorkerErrorReportedEvent struct {
+	ErrorMessage ServiceWorkerErrorMessage `json:"errorMessage"`
+}
+
+// WorkerRegistrationUpdatedEvent is the payload of the
+// ServiceWorker.workerRegistrationUpdated event.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/ServiceWorker#event-workerRegistrationUpdated
+type WorkerRegistrationUpdatedEvent struct {
+	Registrations []ServiceWorkerRegistration `json:"registrations"`
+}
+
+// WorkerVersionUpdatedEvent is the payload of the
+// ServiceWorker.workerVersionUpdated event.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/ServiceWorker#event-workerVersionUpdated
+type WorkerVersionUpdatedEvent struct {
+	Versions []ServiceWorkerVersion `json:"versions"`
+}
+
+// ParseEvent decodes an event from the ServiceWorker domain, as received from
+// Subscribe, into a pointer to its typed payload.
+func ParseEvent(cmd chromedebugo.Command) (interface{}, error) {
+	var ev interface{}
+	switch cmd.Method {
+	case EventWorkerErrorReported:
+		ev = &WorkerErrorReportedEvent{}
+	case EventWorkerRegistrationUpdated:
+		ev = &WorkerRegistrationUpdatedEvent{}
+	case EventWorkerVersionUpdated:
+		ev = &WorkerVersionUpdatedEvent{}
+	default:
+		return nil, fmt.Errorf("unknown ServiceWorker event: %s", cmd.Method)
+	}
+	if err := cmd.Decode(ev); err != nil {
+		return nil, err
+	}
+	return ev, nil
+}
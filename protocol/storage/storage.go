@@ -0,0 +1,1799 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+// Package storage provides typed bindings for the Storage domain of the Chrome
+// DevTools protocol.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage
+//
+// This is an experimental part of the protocol.
+package storage
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tonyhb/chromedebugo"
+	"github.com/tonyhb/chromedebugo/protocol"
+	"github.com/tonyhb/chromedebugo/protocol/internal/types"
+)
+
+// SerializedStorageKey is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-SerializedStorageKey
+type SerializedStorageKey = types.StorageSerializedStorageKey
+
+// StorageType enum of possible storage types.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-StorageType
+type StorageType = types.StorageStorageType
+
+// UsageForType usage for a storage type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-UsageForType
+type UsageForType = types.StorageUsageForType
+
+// TrustTokens pair of issuer origin and number of available (signed, but not
+// used) Trust Tokens from that issuer.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-TrustTokens
+//
+// This is an experimental part of the protocol.
+type TrustTokens = types.StorageTrustTokens
+
+// InterestGroupAuctionID protected audience interest group auction identifier.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-InterestGroupAuctionId
+type InterestGroupAuctionID = types.StorageInterestGroupAuctionID
+
+// InterestGroupAccessType enum of interest group access types.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-InterestGroupAccessType
+type InterestGroupAccessType = types.StorageInterestGroupAccessType
+
+// InterestGroupAuctionEventType enum of auction events.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-InterestGroupAuctionEventType
+type InterestGroupAuctionEventType = types.StorageInterestGroupAuctionEventType
+
+// InterestGroupAuctionFetchType enum of network fetches auctions can do.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-InterestGroupAuctionFetchType
+type InterestGroupAuctionFetchType = types.StorageInterestGroupAuctionFetchType
+
+// SharedStorageAccessScope enum of shared storage access scopes.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-SharedStorageAccessScope
+type SharedStorageAccessScope = types.StorageSharedStorageAccessScope
+
+// SharedStorageAccessMethod enum of shared storage access methods.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-SharedStorageAccessMethod
+type SharedStorageAccessMethod = types.StorageSharedStorageAccessMethod
+
+// SharedStorageEntry struct for a single key-value pair in an origin's shared
+// storage.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-SharedStorageEntry
+type SharedStorageEntry = types.StorageSharedStorageEntry
+
+// SharedStorageMetadata details for an origin's shared storage.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-SharedStorageMetadata
+type SharedStorageMetadata = types.StorageSharedStorageMetadata
+
+// SharedStoragePrivateAggregationConfig represents a dictionary object passed
+// in as privateAggregationConfig to run or selectURL.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-SharedStoragePrivateAggregationConfig
+type SharedStoragePrivateAggregationConfig = types.StorageSharedStoragePrivateAggregationConfig
+
+// SharedStorageReportingMetadata pair of reporting metadata details for a
+// candidate URL for `selectURL()`.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-SharedStorageReportingMetadata
+type SharedStorageReportingMetadata = types.StorageSharedStorageReportingMetadata
+
+// SharedStorageURLWithMetadata bundles a candidate URL with its reporting
+// metadata.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-SharedStorageUrlWithMetadata
+type SharedStorageURLWithMetadata = types.StorageSharedStorageURLWithMetadata
+
+// SharedStorageAccessParams bundles the parameters for shared storage access
+// events whose presence/absence can vary according to SharedStorageAccessType.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-SharedStorageAccessParams
+type SharedStorageAccessParams = types.StorageSharedStorageAccessParams
+
+// StorageBucketsDurability is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-StorageBucketsDurability
+type StorageBucketsDurability = types.StorageStorageBucketsDurability
+
+// StorageBucket is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-StorageBucket
+type StorageBucket = types.StorageStorageBucket
+
+// StorageBucketInfo is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-StorageBucketInfo
+type StorageBucketInfo = types.StorageStorageBucketInfo
+
+// AttributionReportingSourceType is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-AttributionReportingSourceType
+//
+// This is an experimental part of the protocol.
+type AttributionReportingSourceType = types.StorageAttributionReportingSourceType
+
+// UnsignedInt64AsBase10 is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-UnsignedInt64AsBase10
+//
+// This is an experimental part of the protocol.
+type UnsignedInt64AsBase10 = types.StorageUnsignedInt64AsBase10
+
+// UnsignedInt128AsBase16 is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-UnsignedInt128AsBase16
+//
+// This is an experimental part of the protocol.
+type UnsignedInt128AsBase16 = types.StorageUnsignedInt128AsBase16
+
+// SignedInt64AsBase10 is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-SignedInt64AsBase10
+//
+// This is an experimental part of the protocol.
+type SignedInt64AsBase10 = types.StorageSignedInt64AsBase10
+
+// AttributionReportingFilterDataEntry is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-AttributionReportingFilterDataEntry
+//
+// This is an experimental part of the protocol.
+type AttributionReportingFilterDataEntry = types.StorageAttributionReportingFilterDataEntry
+
+// AttributionReportingFilterConfig is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-AttributionReportingFilterConfig
+//
+// This is an experimental part of the protocol.
+type AttributionReportingFilterConfig = types.StorageAttributionReportingFilterConfig
+
+// AttributionReportingFilterPair is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-AttributionReportingFilterPair
+//
+// This is an experimental part of the protocol.
+type AttributionReportingFilterPair = types.StorageAttributionReportingFilterPair
+
+// AttributionReportingAggregationKeysEntry is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-AttributionReportingAggregationKeysEntry
+//
+// This is an experimental part of the protocol.
+type AttributionReportingAggregationKeysEntry = types.StorageAttributionReportingAggregationKeysEntry
+
+// AttributionReportingEventReportWindows is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-AttributionReportingEventReportWindows
+//
+// This is an experimental part of the protocol.
+type AttributionReportingEventReportWindows = types.StorageAttributionReportingEventReportWindows
+
+// AttributionReportingTriggerDataMatching is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-AttributionReportingTriggerDataMatching
+//
+// This is an experimental part of the protocol.
+type AttributionReportingTriggerDataMatching = types.StorageAttributionReportingTriggerDataMatching
+
+// AttributionReportingAggregatableDebugReportingData is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-AttributionReportingAggregatableDebugReportingData
+//
+// This is an experimental part of the protocol.
+type AttributionReportingAggregatableDebugReportingData = types.StorageAttributionReportingAggregatableDebugReportingData
+
+// AttributionReportingAggregatableDebugReportingConfig is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-AttributionReportingAggregatableDebugReportingConfig
+//
+// This is an experimental part of the protocol.
+type AttributionReportingAggregatableDebugReportingConfig = types.StorageAttributionReportingAggregatableDebugReportingConfig
+
+// AttributionScopesData is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-AttributionScopesData
+//
+// This is an experimental part of the protocol.
+type AttributionScopesData = types.StorageAttributionScopesData
+
+// AttributionReportingNamedBudgetDef is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-AttributionReportingNamedBudgetDef
+//
+// This is an experimental part of the protocol.
+type AttributionReportingNamedBudgetDef = types.StorageAttributionReportingNamedBudgetDef
+
+// AttributionReportingSourceRegistration is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-AttributionReportingSourceRegistration
+//
+// This is an experimental part of the protocol.
+type AttributionReportingSourceRegistration = types.StorageAttributionReportingSourceRegistration
+
+// AttributionReportingSourceRegistrationResult is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-AttributionReportingSourceRegistrationResult
+//
+// This is an experimental part of the protocol.
+type AttributionReportingSourceRegistrationResult = types.StorageAttributionReportingSourceRegistrationResult
+
+// AttributionReportingSourceRegistrationTimeConfig is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-AttributionReportingSourceRegistrationTimeConfig
+//
+// This is an experimental part of the protocol.
+type AttributionReportingSourceRegistrationTimeConfig = types.StorageAttributionReportingSourceRegistrationTimeConfig
+
+// AttributionReportingAggregatableValueDictEntry is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-AttributionReportingAggregatableValueDictEntry
+//
+// This is an experimental part of the protocol.
+type AttributionReportingAggregatableValueDictEntry = types.StorageAttributionReportingAggregatableValueDictEntry
+
+// AttributionReportingAggregatableValueEntry is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-AttributionReportingAggregatableValueEntry
+//
+// This is an experimental part of the protocol.
+type AttributionReportingAggregatableValueEntry = types.StorageAttributionReportingAggregatableValueEntry
+
+// AttributionReportingEventTriggerData is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-AttributionReportingEventTriggerData
+//
+// This is an experimental part of the protocol.
+type AttributionReportingEventTriggerData = types.StorageAttributionReportingEventTriggerData
+
+// AttributionReportingAggregatableTriggerData is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-AttributionReportingAggregatableTriggerData
+//
+// This is an experimental part of the protocol.
+type AttributionReportingAggregatableTriggerData = types.StorageAttributionReportingAggregatableTriggerData
+
+// AttributionReportingAggregatableDedupKey is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-AttributionReportingAggregatableDedupKey
+//
+// This is an experimental part of the protocol.
+type AttributionReportingAggregatableDedupKey = types.StorageAttributionReportingAggregatableDedupKey
+
+// AttributionReportingNamedBudgetCandidate is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-AttributionReportingNamedBudgetCandidate
+//
+// This is an experimental part of the protocol.
+type AttributionReportingNamedBudgetCandidate = types.StorageAttributionReportingNamedBudgetCandidate
+
+// AttributionReportingTriggerRegistration is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-AttributionReportingTriggerRegistration
+//
+// This is an experimental part of the protocol.
+type AttributionReportingTriggerRegistration = types.StorageAttributionReportingTriggerRegistration
+
+// AttributionReportingEventLevelResult is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-AttributionReportingEventLevelResult
+//
+// This is an experimental part of the protocol.
+type AttributionReportingEventLevelResult = types.StorageAttributionReportingEventLevelResult
+
+// AttributionReportingAggregatableResult is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-AttributionReportingAggregatableResult
+//
+// This is an experimental part of the protocol.
+type AttributionReportingAggregatableResult = types.StorageAttributionReportingAggregatableResult
+
+// AttributionReportingReportResult is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-AttributionReportingReportResult
+//
+// This is an experimental part of the protocol.
+type AttributionReportingReportResult = types.StorageAttributionReportingReportResult
+
+// RelatedWebsiteSet a single Related Website Set object.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-RelatedWebsiteSet
+//
+// This is an experimental part of the protocol.
+type RelatedWebsiteSet = types.StorageRelatedWebsiteSet
+
+// Values of the enumerated types in the Storage domain.
+const (
+	StorageTypeCookies                                                                 = types.StorageStorageTypeCookies
+	StorageTypeFileSystems                                                             = types.StorageStorageTypeFileSystems
+	StorageTypeIndexeddb                                                               = types.StorageStorageTypeIndexeddb
+	StorageTypeLocalStorage                                                            = types.StorageStorageTypeLocalStorage
+	StorageTypeShaderCache                                                             = types.StorageStorageTypeShaderCache
+	StorageTypeWebsql                                                                  = types.StorageStorageTypeWebsql
+	StorageTypeServiceWorkers                                                          = types.StorageStorageTypeServiceWorkers
+	StorageTypeCacheStorage                                                            = types.StorageStorageTypeCacheStorage
+	StorageTypeInterestGroups                                                          = types.StorageStorageTypeInterestGroups
+	StorageTypeSharedStorage                                                           = types.StorageStorageTypeSharedStorage
+	StorageTypeStorageBuckets                                                          = types.StorageStorageTypeStorageBuckets
+	StorageTypeAll                                                                     = types.StorageStorageTypeAll
+	StorageTypeOther                                                                   = types.StorageStorageTypeOther
+	InterestGroupAccessTypeJoin                                                        = types.StorageInterestGroupAccessTypeJoin
+	InterestGroupAccessTypeLeave                                                       = types.StorageInterestGroupAccessTypeLeave
+	InterestGroupAccessTypeUpdate                                                      = types.StorageInterestGroupAccessTypeUpdate
+	InterestGroupAccessTypeLoaded                                                      = types.StorageInterestGroupAccessTypeLoaded
+	InterestGroupAccessTypeBid                                                         = types.StorageInterestGroupAccessTypeBid
+	InterestGroupAccessTypeWin                                                         = types.StorageInterestGroupAccessTypeWin
+	InterestGroupAccessTypeAdditionalBid                                               = types.StorageInterestGroupAccessTypeAdditionalBid
+	InterestGroupAccessTypeAdditionalBidWin                                            = types.StorageInterestGroupAccessTypeAdditionalBidWin
+	InterestGroupAccessTypeTopLevelBid                                                 = types.StorageInterestGroupAccessTypeTopLevelBid
+	InterestGroupAccessTypeTopLevelAdditionalBid                                       = types.StorageInterestGroupAccessTypeTopLevelAdditionalBid
+	InterestGroupAccessTypeClear                                                       = types.StorageInterestGroupAccessTypeClear
+	InterestGroupAuctionEventTypeStarted                                               = types.StorageInterestGroupAuctionEventTypeStarted
+	InterestGroupAuctionEventTypeConfigResolved                                        = types.StorageInterestGroupAuctionEventTypeConfigResolved
+	InterestGroupAuctionFetchTypeBidderJS                                              = types.StorageInterestGroupAuctionFetchTypeBidderJS
+	InterestGroupAuctionFetchTypeBidderWasm                                            = types.StorageInterestGroupAuctionFetchTypeBidderWasm
+	InterestGroupAuctionFetchTypeSellerJS                                              = types.StorageInterestGroupAuctionFetchTypeSellerJS
+	InterestGroupAuctionFetchTypeBidderTrustedSignals                                  = types.StorageInterestGroupAuctionFetchTypeBidderTrustedSignals
+	InterestGroupAuctionFetchTypeSellerTrustedSignals                                  = types.StorageInterestGroupAuctionFetchTypeSellerTrustedSignals
+	SharedStorageAccessScopeWindow                                                     = types.StorageSharedStorageAccessScopeWindow
+	SharedStorageAccessScopeSharedStorageWorklet                                       = types.StorageSharedStorageAccessScopeSharedStorageWorklet
+	SharedStorageAccessScopeProtectedAudienceWorklet                                   = types.StorageSharedStorageAccessScopeProtectedAudienceWorklet
+	SharedStorageAccessScopeHeader                                                     = types.StorageSharedStorageAccessScopeHeader
+	SharedStorageAccessMethodAddModule                                                 = types.StorageSharedStorageAccessMethodAddModule
+	SharedStorageAccessMethodCreateWorklet                                             = types.StorageSharedStorageAccessMethodCreateWorklet
+	SharedStorageAccessMethodSelectURL                                                 = types.StorageSharedStorageAccessMethodSelectURL
+	SharedStorageAccessMethodRun                                                       = types.StorageSharedStorageAccessMethodRun
+	SharedStorageAccessMethodBatchUpdate                                               = types.StorageSharedStorageAccessMethodBatchUpdate
+	SharedStorageAccessMethodSet                                                       = types.StorageSharedStorageAccessMethodSet
+	SharedStorageAccessMethodAppend                                                    = types.StorageSharedStorageAccessMethodAppend
+	SharedStorageAccessMethodDelete                                                    = types.StorageSharedStorageAccessMethodDelete
+	SharedStorageAccessMethodClear                                                     = types.StorageSharedStorageAccessMethodClear
+	SharedStorageAccessMethodGet                                                       = types.StorageSharedStorageAccessMethodGet
+	SharedStorageAccessMethodKeys                                                      = types.StorageSharedStorageAccessMethodKeys
+	SharedStorageAccessMethodValues                                                    = types.StorageSharedStorageAccessMethodValues
+	SharedStorageAccessMethodEntries                                                   = types.StorageSharedStorageAccessMethodEntries
+	SharedStorageAccessMethodLength                                                    = types.StorageSharedStorageAccessMethodLength
+	SharedStorageAccessMethodRemainingBudget                                           = types.StorageSharedStorageAccessMethodRemainingBudget
+	StorageBucketsDurabilityRelaxed                                                    = types.StorageStorageBucketsDurabilityRelaxed
+	StorageBucketsDurabilityStrict                                                     = types.StorageStorageBucketsDurabilityStrict
+	AttributionReportingSourceTypeNavigation                                           = types.StorageAttributionReportingSourceTypeNavigation
+	AttributionReportingSourceTypeEvent                                                = types.StorageAttributionReportingSourceTypeEvent
+	AttributionReportingTriggerDataMatchingExact                                       = types.StorageAttributionReportingTriggerDataMatchingExact
+	AttributionReportingTriggerDataMatchingModulus                                     = types.StorageAttributionReportingTriggerDataMatchingModulus
+	AttributionReportingSourceRegistrationResultSuccess                                = types.StorageAttributionReportingSourceRegistrationResultSuccess
+	AttributionReportingSourceRegistrationResultInternalError                          = types.StorageAttributionReportingSourceRegistrationResultInternalError
+	AttributionReportingSourceRegistrationResultInsufficientSourceCapacity             = types.StorageAttributionReportingSourceRegistrationResultInsufficientSourceCapacity
+	AttributionReportingSourceRegistrationResultInsufficientUniqueDestinationCapacity  = types.StorageAttributionReportingSourceRegistrationResultInsufficientUniqueDestinationCapacity
+	AttributionReportingSourceRegistrationResultExcessiveReportingOrigins              = types.StorageAttributionReportingSourceRegistrationResultExcessiveReportingOrigins
+	AttributionReportingSourceRegistrationResultProhibitedByBrowserPolicy              = types.StorageAttributionReportingSourceRegistrationResultProhibitedByBrowserPolicy
+	AttributionReportingSourceRegistrationResultSuccessNoised                          = types.StorageAttributionReportingSourceRegistrationResultSuccessNoised
+	AttributionReportingSourceRegistrationResultDestinationReportingLimitReached       = types.StorageAttributionReportingSourceRegistrationResultDestinationReportingLimitReached
+	AttributionReportingSourceRegistrationResultDestinationGlobalLimitReached          = types.StorageAttributionReportingSourceRegistrationResultDestinationGlobalLimitReached
+	AttributionReportingSourceRegistrationResultDestinationBothLimitsReached           = types.StorageAttributionReportingSourceRegistrationResultDestinationBothLimitsReached
+	AttributionReportingSourceRegistrationResultReportingOriginsPerSiteLimitReached    = types.StorageAttributionReportingSourceRegistrationResultReportingOriginsPerSiteLimitReached
+	AttributionReportingSourceRegistrationResultExceedsMaxChannelCapacity              = types.StorageAttributionReportingSourceRegistrationResultExceedsMaxChannelCapacity
+	AttributionReportingSourceRegistrationResultExceedsMaxScopesChannelCapacity        = types.StorageAttributionReportingSourceRegistrationResultExceedsMaxScopesChannelCapacity
+	AttributionReportingSourceRegistrationResultExceedsMaxTriggerStateCardinality      = types.StorageAttributionReportingSourceRegistrationResultExceedsMaxTriggerStateCardinality
+	AttributionReportingSourceRegistrationResultExceedsMaxEventStatesLimit             = types.StorageAttributionReportingSourceRegistrationResultExceedsMaxEventStatesLimit
+	AttributionReportingSourceRegistrationResultDestinationPerDayReportingLimitReached = types.StorageAttributionReportingSourceRegistrationResultDestinationPerDayReportingLimitReached
+	AttributionReportingSourceRegistrationTimeConfigInclude                            = types.StorageAttributionReportingSourceRegistrationTimeConfigInclude
+	AttributionReportingSourceRegistrationTimeConfigExclude                            = types.StorageAttributionReportingSourceRegistrationTimeConfigExclude
+	AttributionReportingEventLevelResultSuccess                                        = types.StorageAttributionReportingEventLevelResultSuccess
+	AttributionReportingEventLevelResultSuccessDroppedLowerPriority                    = types.StorageAttributionReportingEventLevelResultSuccessDroppedLowerPriority
+	AttributionReportingEventLevelResultInternalError                                  = types.StorageAttributionReportingEventLevelResultInternalError
+	AttributionReportingEventLevelResultNoCapacityForAttributionDestination            = types.StorageAttributionReportingEventLevelResultNoCapacityForAttributionDestination
+	AttributionReportingEventLevelResultNoMatchingSources                              = types.StorageAttributionReportingEventLevelResultNoMatchingSources
+	AttributionReportingEventLevelResultDeduplicated                                   = types.StorageAttributionReportingEventLevelResultDeduplicated
+	AttributionReportingEventLevelResultExcessiveAttributions                          = types.StorageAttributionReportingEventLevelResultExcessiveAttributions
+	AttributionReportingEventLevelResultPriorityTooLow                                 = types.StorageAttributionReportingEventLevelResultPriorityTooLow
+	AttributionReportingEventLevelResultNeverAttributedSource                          = types.StorageAttributionReportingEventLevelResultNeverAttributedSource
+	AttributionReportingEventLevelResultExcessiveReportingOrigins                      = types.StorageAttributionReportingEventLevelResultExcessiveReportingOrigins
+	AttributionReportingEventLevelResultNoMatchingSourceFilterData                     = types.StorageAttributionReportingEventLevelResultNoMatchingSourceFilterData
+	AttributionReportingEventLevelResultProhibitedByBrowserPolicy                      = types.StorageAttributionReportingEventLevelResultProhibitedByBrowserPolicy
+	AttributionReportingEventLevelResultNoMatchingConfigurations                       = types.StorageAttributionReportingEventLevelResultNoMatchingConfigurations
+	AttributionReportingEventLevelResultExcessiveReports                               = types.StorageAttributionReportingEventLevelResultExcessiveReports
+	AttributionReportingEventLevelResultFalselyAttributedSource                        = types.StorageAttributionReportingEventLevelResultFalselyAttributedSource
+	AttributionReportingEventLevelResultReportWindowPassed                             = types.StorageAttributionReportingEventLevelResultReportWindowPassed
+	AttributionReportingEventLevelResultNotRegistered                                  = types.StorageAttributionReportingEventLevelResultNotRegistered
+	AttributionReportingEventLevelResultReportWindowNotStarted                         = types.StorageAttributionReportingEventLevelResultReportWindowNotStarted
+	AttributionReportingEventLevelResultNoMatchingTriggerData                          = types.StorageAttributionReportingEventLevelResultNoMatchingTriggerData
+	AttributionReportingAggregatableResultSuccess                                      = types.StorageAttributionReportingAggregatableResultSuccess
+	AttributionReportingAggregatableResultInternalError                                = types.StorageAttributionReportingAggregatableResultInternalError
+	AttributionReportingAggregatableResultNoCapacityForAttributionDestination          = types.StorageAttributionReportingAggregatableResultNoCapacityForAttributionDestination
+	AttributionReportingAggregatableResultNoMatchingSources                            = types.StorageAttributionReportingAggregatableResultNoMatchingSources
+	AttributionReportingAggregatableResultExcessiveAttributions                        = types.StorageAttributionReportingAggregatableResultExcessiveAttributions
+	AttributionReportingAggregatableResultExcessiveReportingOrigins                    = types.StorageAttributionReportingAggregatableResultExcessiveReportingOrigins
+	AttributionReportingAggregatableResultNoHistograms                                 = types.StorageAttributionReportingAggregatableResultNoHistograms
+	AttributionReportingAggregatableResultInsufficientBudget                           = types.StorageAttributionReportingAggregatableResultInsufficientBudget
+	AttributionReportingAggregatableResultInsufficientNamedBudget                      = types.StorageAttributionReportingAggregatableResultInsufficientNamedBudget
+	AttributionReportingAggregatableResultNoMatchingSourceFilterData                   = types.StorageAttributionReportingAggregatableResultNoMatchingSourceFilterData
+	AttributionReportingAggregatableResultNotRegistered                                = types.StorageAttributionReportingAggregatableResultNotRegistered
+	AttributionReportingAggregatableResultProhibitedByBrowserPolicy                    = types.StorageAttributionReportingAggregatableResultProhibitedByBrowserPolicy
+	AttributionReportingAggregatableResultDeduplicated                                 = types.StorageAttributionReportingAggregatableResultDeduplicated
+	AttributionReportingAggregatableResultReportWindowPassed                           = types.StorageAttributionReportingAggregatableResultReportWindowPassed
+	AttributionReportingAggregatableResultExcessiveReports                             = types.StorageAttributionReportingAggregatableResultExcessiveReports
+	AttributionReportingReportResultSent                                               = types.StorageAttributionReportingReportResultSent
+	AttributionReportingReportResultProhibited                                         = types.StorageAttributionReportingReportResultProhibited
+	AttributionReportingReportResultFailedToAssemble                                   = types.StorageAttributionReportingReportResultFailedToAssemble
+	AttributionReportingReportResultExpired                                            = types.StorageAttributionReportingReportResultExpired
+)
+
+// Method names of the commands in the Storage domain.
+const (
+	CommandGetStorageKeyForFrame                      = "Storage.getStorageKeyForFrame"
+	CommandClearDataForOrigin                         = "Storage.clearDataForOrigin"
+	CommandClearDataForStorageKey                     = "Storage.clearDataForStorageKey"
+	CommandGetCookies                                 = "Storage.getCookies"
+	CommandSetCookies                                 = "Storage.setCookies"
+	CommandClearCookies                               = "Storage.clearCookies"
+	CommandGetUsageAndQuota                           = "Storage.getUsageAndQuota"
+	CommandOverrideQuotaForOrigin                     = "Storage.overrideQuotaForOrigin"
+	CommandTrackCacheStorageForOrigin                 = "Storage.trackCacheStorageForOrigin"
+	CommandTrackCacheStorageForStorageKey             = "Storage.trackCacheStorageForStorageKey"
+	CommandTrackIndexedDBForOrigin                    = "Storage.trackIndexedDBForOrigin"
+	CommandTrackIndexedDBForStorageKey                = "Storage.trackIndexedDBForStorageKey"
+	CommandUntrackCacheStorageForOrigin               = "Storage.untrackCacheStorageForOrigin"
+	CommandUntrackCacheStorageForStorageKey           = "Storage.untrackCacheStorageForStorageKey"
+	CommandUntrackIndexedDBForOrigin                  = "Storage.untrackIndexedDBForOrigin"
+	CommandUntrackIndexedDBForStorageKey              = "Storage.untrackIndexedDBForStorageKey"
+	CommandGetTrustTokens                             = "Storage.getTrustTokens"
+	CommandClearTrustTokens                           = "Storage.clearTrustTokens"
+	CommandGetInterestGroupDetails                    = "Storage.getInterestGroupDetails"
+	CommandSetInterestGroupTracking                   = "Storage.setInterestGroupTracking"
+	CommandSetInterestGroupAuctionTracking            = "Storage.setInterestGroupAuctionTracking"
+	CommandGetSharedStorageMetadata                   = "Storage.getSharedStorageMetadata"
+	CommandGetSharedStorageEntries                    = "Storage.getSharedStorageEntries"
+	CommandSetSharedStorageEntry                      = "Storage.setSharedStorageEntry"
+	CommandDeleteSharedStorageEntry                   = "Storage.deleteSharedStorageEntry"
+	CommandClearSharedStorageEntries                  = "Storage.clearSharedStorageEntries"
+	CommandResetSharedStorageBudget                   = "Storage.resetSharedStorageBudget"
+	CommandSetSharedStorageTracking                   = "Storage.setSharedStorageTracking"
+	CommandSetStorageBucketTracking                   = "Storage.setStorageBucketTracking"
+	CommandDeleteStorageBucket                        = "Storage.deleteStorageBucket"
+	CommandRunBounceTrackingMitigations               = "Storage.runBounceTrackingMitigations"
+	CommandSetAttributionReportingLocalTestingMode    = "Storage.setAttributionReportingLocalTestingMode"
+	CommandSetAttributionReportingTracking            = "Storage.setAttributionReportingTracking"
+	CommandSendPendingAttributionReports              = "Storage.sendPendingAttributionReports"
+	CommandGetRelatedWebsiteSets                      = "Storage.getRelatedWebsiteSets"
+	CommandGetAffectedUrlsForThirdPartyCookieMetadata = "Storage.getAffectedUrlsForThirdPartyCookieMetadata"
+	CommandSetProtectedAudienceKAnonymity             = "Storage.setProtectedAudienceKAnonymity"
+)
+
+// GetStorageKeyForFrameParams are the parameters of
+// Storage.getStorageKeyForFrame.
+//
+// Returns a storage key given a frame id.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#method-getStorageKeyForFrame
+type GetStorageKeyForFrameParams struct {
+	FrameID types.PageFrameID `json:"frameId"`
+}
+
+// Command returns the Storage.getStorageKeyForFrame command for use with
+// AsyncDebugger.Send.
+func (p GetStorageKeyForFrameParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandGetStorageKeyForFrame, p)
+}
+
+// GetStorageKeyForFrameReturns are the values returned by Storage.getStorageKeyForFrame.
+type GetStorageKeyForFrameReturns struct {
+	StorageKey SerializedStorageKey `json:"storageKey"`
+}
+
+// Do sends Storage.getStorageKeyForFrame to d and waits for its result or for ctx to be
+// done.
+func (p GetStorageKeyForFrameParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*GetStorageKeyForFrameReturns, error) {
+	ret := &GetStorageKeyForFrameReturns{}
+	if err := protocol.Do(ctx, d, CommandGetStorageKeyForFrame, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// ClearDataForOriginParams are the parameters of Storage.clearDataForOrigin.
+//
+// Clears storage for origin.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#method-clearDataForOrigin
+type ClearDataForOriginParams struct {
+	// Security origin.
+	Origin string `json:"origin"`
+	// Comma separated list of StorageType to clear.
+	StorageTypes string `json:"storageTypes"`
+}
+
+// Command returns the Storage.clearDataForOrigin command for use with
+// AsyncDebugger.Send.
+func (p ClearDataForOriginParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandClearDataForOrigin, p)
+}
+
+// Do sends Storage.clearDataForOrigin to d and waits for it to complete or for ctx to
+// be done.
+func (p ClearDataForOriginParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandClearDataForOrigin, p, nil)
+}
+
+// ClearDataForStorageKeyParams are the parameters of
+// Storage.clearDataForStorageKey.
+//
+// Clears storage for storage key.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#method-clearDataForStorageKey
+type ClearDataForStorageKeyParams struct {
+	// Storage key.
+	StorageKey string `json:"storageKey"`
+	// Comma separated list of StorageType to clear.
+	StorageTypes string `json:"storageTypes"`
+}
+
+// Command returns the Storage.clearDataForStorageKey command for use with
+// AsyncDebugger.Send.
+func (p ClearDataForStorageKeyParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandClearDataForStorageKey, p)
+}
+
+// Do sends Storage.clearDataForStorageKey to d and waits for it to complete or for ctx to
+// be done.
+func (p ClearDataForStorageKeyParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandClearDataForStorageKey, p, nil)
+}
+
+// GetCookiesParams are the parameters of Storage.getCookies.
+//
+// Returns all browser cookies.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#method-getCookies
+type GetCookiesParams struct {
+	// Browser context to use when called on the browser endpoint.
+	BrowserContextID types.BrowserBrowserContextID `json:"browserContextId,omitempty"`
+}
+
+// Command returns the Storage.getCookies command for use with
+// AsyncDebugger.Send.
+func (p GetCookiesParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandGetCookies, p)
+}
+
+// GetCookiesReturns are the values returned by Storage.getCookies.
+type GetCookiesReturns struct {
+	// Array of cookie objects.
+	Cookies []types.NetworkCookie `json:"cookies"`
+}
+
+// Do sends Storage.getCookies to d and waits for its result or for ctx to be
+// done.
+func (p GetCookiesParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*GetCookiesReturns, error) {
+	ret := &GetCookiesReturns{}
+	if err := protocol.Do(ctx, d, CommandGetCookies, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// SetCookiesParams are the parameters of Storage.setCookies.
+//
+// Sets given cookies.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#method-setCookies
+type SetCookiesParams struct {
+	// Cookies to be set.
+	Cookies []types.NetworkCookieParam `json:"cookies"`
+	// Browser context to use when called on the browser endpoint.
+	BrowserContextID types.BrowserBrowserContextID `json:"browserContextId,omitempty"`
+}
+
+// Command returns the Storage.setCookies command for use with
+// AsyncDebugger.Send.
+func (p SetCookiesParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetCookies, p)
+}
+
+// Do sends Storage.setCookies to d and waits for it to complete or for ctx to
+// be done.
+func (p SetCookiesParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetCookies, p, nil)
+}
+
+// ClearCookiesParams are the parameters of Storage.clearCookies.
+//
+// Clears cookies.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#method-clearCookies
+type ClearCookiesParams struct {
+	// Browser context to use when called on the browser endpoint.
+	BrowserContextID types.BrowserBrowserContextID `json:"browserContextId,omitempty"`
+}
+
+// Command returns the Storage.clearCookies command for use with
+// AsyncDebugger.Send.
+func (p ClearCookiesParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandClearCookies, p)
+}
+
+// Do sends Storage.clearCookies to d and waits for it to complete or for ctx to
+// be done.
+func (p ClearCookiesParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandClearCookies, p, nil)
+}
+
+// GetUsageAndQuotaParams are the parameters of Storage.getUsageAndQuota.
+//
+// Returns usage and quota in bytes.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#method-getUsageAndQuota
+type GetUsageAndQuotaParams struct {
+	// Security origin.
+	Origin string `json:"origin"`
+}
+
+// Command returns the Storage.getUsageAndQuota command for use with
+// AsyncDebugger.Send.
+func (p GetUsageAndQuotaParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandGetUsageAndQuota, p)
+}
+
+// GetUsageAndQuotaReturns are the values returned by Storage.getUsageAndQuota.
+type GetUsageAndQuotaReturns struct {
+	// Storage usage (bytes).
+	Usage float64 `json:"usage"`
+	// Storage quota (bytes).
+	Quota float64 `json:"quota"`
+	// Whether or not the origin has an active storage quota override
+	OverrideActive bool `json:"overrideActive"`
+	// Storage usage per type (bytes).
+	UsageBreakdown []UsageForType `json:"usageBreakdown"`
+}
+
+// Do sends Storage.getUsageAndQuota to d and waits for its result or for ctx to be
+// done.
+func (p GetUsageAndQuotaParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*GetUsageAndQuotaReturns, error) {
+	ret := &GetUsageAndQuotaReturns{}
+	if err := protocol.Do(ctx, d, CommandGetUsageAndQuota, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// OverrideQuotaForOriginParams are the parameters of
+// Storage.overrideQuotaForOrigin.
+//
+// # Override quota for the specified origin
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#method-overrideQuotaForOrigin
+//
+// This is an experimental part of the protocol.
+type OverrideQuotaForOriginParams struct {
+	// Security origin.
+	Origin string `json:"origin"`
+	// The quota size (in bytes) to override the original quota with. If this is
+	// called multiple times, the overridden quota will be equal to the quotaSize
+	// provided in the final call. If this is called without specifying a
+	// quotaSize, the quota will be reset to the default value for the specified
+	// origin. If this is called multiple times with different origins, the
+	// override will be maintained for each origin until it is disabled (called
+	// without a quotaSize).
+	QuotaSize float64 `json:"quotaSize,omitempty"`
+}
+
+// Command returns the Storage.overrideQuotaForOrigin command for use with
+// AsyncDebugger.Send.
+func (p OverrideQuotaForOriginParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandOverrideQuotaForOrigin, p)
+}
+
+// Do sends Storage.overrideQuotaForOrigin to d and waits for it to complete or for ctx to
+// be done.
+func (p OverrideQuotaForOriginParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandOverrideQuotaForOrigin, p, nil)
+}
+
+// TrackCacheStorageForOriginParams are the parameters of
+// Storage.trackCacheStorageForOrigin.
+//
+// Registers origin to be notified when an update occurs to its cache storage
+// list.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#method-trackCacheStorageForOrigin
+type TrackCacheStorageForOriginParams struct {
+	// Security origin.
+	Origin string `json:"origin"`
+}
+
+// Command returns the Storage.trackCacheStorageForOrigin command for use with
+// AsyncDebugger.Send.
+func (p TrackCacheStorageForOriginParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandTrackCacheStorageForOrigin, p)
+}
+
+// Do sends Storage.trackCacheStorageForOrigin to d and waits for it to complete or for ctx to
+// be done.
+func (p TrackCacheStorageForOriginParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandTrackCacheStorageForOrigin, p, nil)
+}
+
+// TrackCacheStorageForStorageKeyParams are the parameters of
+// Storage.trackCacheStorageForStorageKey.
+//
+// Registers storage key to be notified when an update occurs to its cache
+// storage list.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#method-trackCacheStorageForStorageKey
+type TrackCacheStorageForStorageKeyParams struct {
+	// Storage key.
+	StorageKey string `json:"storageKey"`
+}
+
+// Command returns the Storage.trackCacheStorageForStorageKey command for use
+// with AsyncDebugger.Send.
+func (p TrackCacheStorageForStorageKeyParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandTrackCacheStorageForStorageKey, p)
+}
+
+// Do sends Storage.trackCacheStorageForStorageKey to d and waits for it to complete or for ctx to
+// be done.
+func (p TrackCacheStorageForStorageKeyParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandTrackCacheStorageForStorageKey, p, nil)
+}
+
+// TrackIndexedDBForOriginParams are the parameters of
+// Storage.trackIndexedDBForOrigin.
+//
+// Registers origin to be notified when an update occurs to its IndexedDB.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#method-trackIndexedDBForOrigin
+type TrackIndexedDBForOriginParams struct {
+	// Security origin.
+	Origin string `json:"origin"`
+}
+
+// Command returns the Storage.trackIndexedDBForOrigin command for use with
+// AsyncDebugger.Send.
+func (p TrackIndexedDBForOriginParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandTrackIndexedDBForOrigin, p)
+}
+
+// Do sends Storage.trackIndexedDBForOrigin to d and waits for it to complete or for ctx to
+// be done.
+func (p TrackIndexedDBForOriginParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandTrackIndexedDBForOrigin, p, nil)
+}
+
+// TrackIndexedDBForStorageKeyParams are the parameters of
+// Storage.trackIndexedDBForStorageKey.
+//
+// Registers storage key to be notified when an update occurs to its IndexedDB.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#method-trackIndexedDBForStorageKey
+type TrackIndexedDBForStorageKeyParams struct {
+	// Storage key.
+	StorageKey string `json:"storageKey"`
+}
+
+// Command returns the Storage.trackIndexedDBForStorageKey command for use with
+// AsyncDebugger.Send.
+func (p TrackIndexedDBForStorageKeyParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandTrackIndexedDBForStorageKey, p)
+}
+
+// Do sends Storage.trackIndexedDBForStorageKey to d and waits for it to complete or for ctx to
+// be done.
+func (p TrackIndexedDBForStorageKeyParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandTrackIndexedDBForStorageKey, p, nil)
+}
+
+// UntrackCacheStorageForOriginParams are the parameters of
+// Storage.untrackCacheStorageForOrigin.
+//
+// Unregisters origin from receiving notifications for cache storage.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#method-untrackCacheStorageForOrigin
+type UntrackCacheStorageForOriginParams struct {
+	// Security origin.
+	Origin string `json:"origin"`
+}
+
+// Command returns the Storage.untrackCacheStorageForOrigin command for use with
+// AsyncDebugger.Send.
+func (p UntrackCacheStorageForOriginParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandUntrackCacheStorageForOrigin, p)
+}
+
+// Do sends Storage.untrackCacheStorageForOrigin to d and waits for it to complete or for ctx to
+// be done.
+func (p UntrackCacheStorageForOriginParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandUntrackCacheStorageForOrigin, p, nil)
+}
+
+// UntrackCacheStorageForStorageKeyParams are the parameters of
+// Storage.untrackCacheStorageForStorageKey.
+//
+// Unregisters storage key from receiving notifications for cache storage.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#method-untrackCacheStorageForStorageKey
+type UntrackCacheStorageForStorageKeyParams struct {
+	// Storage key.
+	StorageKey string `json:"storageKey"`
+}
+
+// Command returns the Storage.untrackCacheStorageForStorageKey command for use
+// with AsyncDebugger.Send.
+func (p UntrackCacheStorageForStorageKeyParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandUntrackCacheStorageForStorageKey, p)
+}
+
+// Do sends Storage.untrackCacheStorageForStorageKey to d and waits for it to complete or for ctx to
+// be done.
+func (p UntrackCacheStorageForStorageKeyParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandUntrackCacheStorageForStorageKey, p, nil)
+}
+
+// UntrackIndexedDBForOriginParams are the parameters of
+// Storage.untrackIndexedDBForOrigin.
+//
+// Unregisters origin from receiving notifications for IndexedDB.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#method-untrackIndexedDBForOrigin
+type UntrackIndexedDBForOriginParams struct {
+	// Security origin.
+	Origin string `json:"origin"`
+}
+
+// Command returns the Storage.untrackIndexedDBForOrigin command for use with
+// AsyncDebugger.Send.
+func (p UntrackIndexedDBForOriginParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandUntrackIndexedDBForOrigin, p)
+}
+
+// Do sends Storage.untrackIndexedDBForOrigin to d and waits for it to complete or for ctx to
+// be done.
+func (p UntrackIndexedDBForOriginParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandUntrackIndexedDBForOrigin, p, nil)
+}
+
+// UntrackIndexedDBForStorageKeyParams are the parameters of
+// Storage.untrackIndexedDBForStorageKey.
+//
+// Unregisters storage key from receiving notifications for IndexedDB.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#method-untrackIndexedDBForStorageKey
+type UntrackIndexedDBForStorageKeyParams struct {
+	// Storage key.
+	StorageKey string `json:"storageKey"`
+}
+
+// Command returns the Storage.untrackIndexedDBForStorageKey command for use
+// with AsyncDebugger.Send.
+func (p UntrackIndexedDBForStorageKeyParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandUntrackIndexedDBForStorageKey, p)
+}
+
+// Do sends Storage.untrackIndexedDBForStorageKey to d and waits for it to complete or for ctx to
+// be done.
+func (p UntrackIndexedDBForStorageKeyParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandUntrackIndexedDBForStorageKey, p, nil)
+}
+
+// GetTrustTokensParams are the parameters of Storage.getTrustTokens.
+//
+// Returns the number of stored Trust Tokens per issuer for the current browsing
+// context.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#method-getTrustTokens
+//
+// This is an experimental part of the protocol.
+type GetTrustTokensParams struct {
+}
+
+// Command returns the Storage.getTrustTokens command for use with
+// AsyncDebugger.Send.
+func (p GetTrustTokensParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandGetTrustTokens, p)
+}
+
+// GetTrustTokensReturns are the values returned by Storage.getTrustTokens.
+type GetTrustTokensReturns struct {
+	Tokens []TrustTokens `json:"tokens"`
+}
+
+// Do sends Storage.getTrustTokens to d and waits for its result or for ctx to be
+// done.
+func (p GetTrustTokensParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*GetTrustTokensReturns, error) {
+	ret := &GetTrustTokensReturns{}
+	if err := protocol.Do(ctx, d, CommandGetTrustTokens, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// ClearTrustTokensParams are the parameters of Storage.clearTrustTokens.
+//
+// Removes all Trust Tokens issued by the provided issuerOrigin. Leaves other
+// stored data, including the issuer's Redemption Records, intact.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#method-clearTrustTokens
+//
+// This is an experimental part of the protocol.
+type ClearTrustTokensParams struct {
+	IssuerOrigin string `json:"issuerOrigin"`
+}
+
+// Command returns the Storage.clearTrustTokens command for use with
+// AsyncDebugger.Send.
+func (p ClearTrustTokensParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandClearTrustTokens, p)
+}
+
+// ClearTrustTokensReturns are the values returned by Storage.clearTrustTokens.
+type ClearTrustTokensReturns struct {
+	// True if any tokens were deleted, false otherwise.
+	DidDeleteTokens bool `json:"didDeleteTokens"`
+}
+
+// Do sends Storage.clearTrustTokens to d and waits for its result or for ctx to be
+// done.
+func (p ClearTrustTokensParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*ClearTrustTokensReturns, error) {
+	ret := &ClearTrustTokensReturns{}
+	if err := protocol.Do(ctx, d, CommandClearTrustTokens, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// GetInterestGroupDetailsParams are the parameters of
+// Storage.getInterestGroupDetails.
+//
+// Gets details for a named interest group.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#method-getInterestGroupDetails
+//
+// This is an experimental part of the protocol.
+type GetInterestGroupDetailsParams struct {
+	OwnerOrigin string `json:"ownerOrigin"`
+	Name        string `json:"name"`
+}
+
+// Command returns the Storage.getInterestGroupDetails command for use with
+// AsyncDebugger.Send.
+func (p GetInterestGroupDetailsParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandGetInterestGroupDetails, p)
+}
+
+// GetInterestGroupDetailsReturns are the values returned by Storage.getInterestGroupDetails.
+type GetInterestGroupDetailsReturns struct {
+	// This largely corresponds to:
+	// https://wicg.github.io/turtledove/#dictdef-generatebidinterestgroup but has
+	// absolute expirationTime instead of relative lifetimeMs and also adds
+	// joiningOrigin.
+	Details map[string]interface{} `json:"details"`
+}
+
+// Do sends Storage.getInterestGroupDetails to d and waits for its result or for ctx to be
+// done.
+func (p GetInterestGroupDetailsParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*GetInterestGroupDetailsReturns, error) {
+	ret := &GetInterestGroupDetailsReturns{}
+	if err := protocol.Do(ctx, d, CommandGetInterestGroupDetails, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// SetInterestGroupTrackingParams are the parameters of
+// Storage.setInterestGroupTracking.
+//
+// Enables/Disables issuing of interestGroupAccessed events.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#method-setInterestGroupTracking
+//
+// This is an experimental part of the protocol.
+type SetInterestGroupTrackingParams struct {
+	Enable bool `json:"enable"`
+}
+
+// Command returns the Storage.setInterestGroupTracking command for use with
+// AsyncDebugger.Send.
+func (p SetInterestGroupTrackingParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetInterestGroupTracking, p)
+}
+
+// Do sends Storage.setInterestGroupTracking to d and waits for it to complete or for ctx to
+// be done.
+func (p SetInterestGroupTrackingParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetInterestGroupTracking, p, nil)
+}
+
+// SetInterestGroupAuctionTrackingParams are the parameters of
+// Storage.setInterestGroupAuctionTracking.
+//
+// Enables/Disables issuing of interestGroupAuctionEventOccurred and
+// interestGroupAuctionNetworkRequestCreated.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#method-setInterestGroupAuctionTracking
+//
+// This is an experimental part of the protocol.
+type SetInterestGroupAuctionTrackingParams struct {
+	Enable bool `json:"enable"`
+}
+
+// Command returns the Storage.setInterestGroupAuctionTracking command for use
+// with AsyncDebugger.Send.
+func (p SetInterestGroupAuctionTrackingParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetInterestGroupAuctionTracking, p)
+}
+
+// Do sends Storage.setInterestGroupAuctionTracking to d and waits for it to complete or for ctx to
+// be done.
+func (p SetInterestGroupAuctionTrackingParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetInterestGroupAuctionTracking, p, nil)
+}
+
+// GetSharedStorageMetadataParams are the parameters of
+// Storage.getSharedStorageMetadata.
+//
+// Gets metadata for an origin's shared storage.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#method-getSharedStorageMetadata
+//
+// This is an experimental part of the protocol.
+type GetSharedStorageMetadataParams struct {
+	OwnerOrigin string `json:"ownerOrigin"`
+}
+
+// Command returns the Storage.getSharedStorageMetadata command for use with
+// AsyncDebugger.Send.
+func (p GetSharedStorageMetadataParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandGetSharedStorageMetadata, p)
+}
+
+// GetSharedStorageMetadataReturns are the values returned by Storage.getSharedStorageMetadata.
+type GetSharedStorageMetadataReturns struct {
+	Metadata SharedStorageMetadata `json:"metadata"`
+}
+
+// Do sends Storage.getSharedStorageMetadata to d and waits for its result or for ctx to be
+// done.
+func (p GetSharedStorageMetadataParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*GetSharedStorageMetadataReturns, error) {
+	ret := &GetSharedStorageMetadataReturns{}
+	if err := protocol.Do(ctx, d, CommandGetSharedStorageMetadata, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// GetSharedStorageEntriesParams are the parameters of
+// Storage.getSharedStorageEntries.
+//
+// Gets the entries in an given origin's shared storage.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#method-getSharedStorageEntries
+//
+// This is an experimental part of the protocol.
+type GetSharedStorageEntriesParams struct {
+	OwnerOrigin string `json:"ownerOrigin"`
+}
+
+// Command returns the Storage.getSharedStorageEntries command for use with
+// AsyncDebugger.Send.
+func (p GetSharedStorageEntriesParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandGetSharedStorageEntries, p)
+}
+
+// GetSharedStorageEntriesReturns are the values returned by Storage.getSharedStorageEntries.
+type GetSharedStorageEntriesReturns struct {
+	Entries []SharedStorageEntry `json:"entries"`
+}
+
+// Do sends Storage.getSharedStorageEntries to d and waits for its result or for ctx to be
+// done.
+func (p GetSharedStorageEntriesParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*GetSharedStorageEntriesReturns, error) {
+	ret := &GetSharedStorageEntriesReturns{}
+	if err := protocol.Do(ctx, d, CommandGetSharedStorageEntries, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// SetSharedStorageEntryParams are the parameters of
+// Storage.setSharedStorageEntry.
+//
+// Sets entry with `key` and `value` for a given origin's shared storage.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#method-setSharedStorageEntry
+//
+// This is an experimental part of the protocol.
+type SetSharedStorageEntryParams struct {
+	OwnerOrigin string `json:"ownerOrigin"`
+	Key         string `json:"key"`
+	Value       string `json:"value"`
+	// If `ignoreIfPresent` is included and true, then only sets the entry if `key`
+	// doesn't already exist.
+	IgnoreIfPresent bool `json:"ignoreIfPresent,omitempty"`
+}
+
+// Command returns the Storage.setSharedStorageEntry command for use with
+// AsyncDebugger.Send.
+func (p SetSharedStorageEntryParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetSharedStorageEntry, p)
+}
+
+// Do sends Storage.setSharedStorageEntry to d and waits for it to complete or for ctx to
+// be done.
+func (p SetSharedStorageEntryParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetSharedStorageEntry, p, nil)
+}
+
+// DeleteSharedStorageEntryParams are the parameters of
+// Storage.deleteSharedStorageEntry.
+//
+// Deletes entry for `key` (if it exists) for a given origin's shared storage.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#method-deleteSharedStorageEntry
+//
+// This is an experimental part of the protocol.
+type DeleteSharedStorageEntryParams struct {
+	OwnerOrigin string `json:"ownerOrigin"`
+	Key         string `json:"key"`
+}
+
+// Command returns the Storage.deleteSharedStorageEntry command for use with
+// AsyncDebugger.Send.
+func (p DeleteSharedStorageEntryParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandDeleteSharedStorageEntry, p)
+}
+
+// Do sends Storage.deleteSharedStorageEntry to d and waits for it to complete or for ctx to
+// be done.
+func (p DeleteSharedStorageEntryParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandDeleteSharedStorageEntry, p, nil)
+}
+
+// ClearSharedStorageEntriesParams are the parameters of
+// Storage.clearSharedStorageEntries.
+//
+// Clears all entries for a given origin's shared storage.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#method-clearSharedStorageEntries
+//
+// This is an experimental part of the protocol.
+type ClearSharedStorageEntriesParams struct {
+	OwnerOrigin string `json:"ownerOrigin"`
+}
+
+// Command returns the Storage.clearSharedStorageEntries command for use with
+// AsyncDebugger.Send.
+func (p ClearSharedStorageEntriesParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandClearSharedStorageEntries, p)
+}
+
+// Do sends Storage.clearSharedStorageEntries to d and waits for it to complete or for ctx to
+// be done.
+func (p ClearSharedStorageEntriesParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandClearSharedStorageEntries, p, nil)
+}
+
+// ResetSharedStorageBudgetParams are the parameters of
+// Storage.resetSharedStorageBudget.
+//
+// Resets the budget for `ownerOrigin` by clearing all budget withdrawals.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#method-resetSharedStorageBudget
+//
+// This is an experimental part of the protocol.
+type ResetSharedStorageBudgetParams struct {
+	OwnerOrigin string `json:"ownerOrigin"`
+}
+
+// Command returns the Storage.resetSharedStorageBudget command for use with
+// AsyncDebugger.Send.
+func (p ResetSharedStorageBudgetParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandResetSharedStorageBudget, p)
+}
+
+// Do sends Storage.resetSharedStorageBudget to d and waits for it to complete or for ctx to
+// be done.
+func (p ResetSharedStorageBudgetParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandResetSharedStorageBudget, p, nil)
+}
+
+// SetSharedStorageTrackingParams are the parameters of
+// Storage.setSharedStorageTracking.
+//
+// Enables/disables issuing of sharedStorageAccessed events.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#method-setSharedStorageTracking
+//
+// This is an experimental part of the protocol.
+type SetSharedStorageTrackingParams struct {
+	Enable bool `json:"enable"`
+}
+
+// Command returns the Storage.setSharedStorageTracking command for use with
+// AsyncDebugger.Send.
+func (p SetSharedStorageTrackingParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetSharedStorageTracking, p)
+}
+
+// Do sends Storage.setSharedStorageTracking to d and waits for it to complete or for ctx to
+// be done.
+func (p SetSharedStorageTrackingParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetSharedStorageTracking, p, nil)
+}
+
+// SetStorageBucketTrackingParams are the parameters of
+// Storage.setStorageBucketTracking.
+//
+// Set tracking for a storage key's buckets.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#method-setStorageBucketTracking
+//
+// This is an experimental part of the protocol.
+type SetStorageBucketTrackingParams struct {
+	StorageKey string `json:"storageKey"`
+	Enable     bool   `json:"enable"`
+}
+
+// Command returns the Storage.setStorageBucketTracking command for use with
+// AsyncDebugger.Send.
+func (p SetStorageBucketTrackingParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetStorageBucketTracking, p)
+}
+
+// Do sends Storage.setStorageBucketTracking to d and waits for it to complete or for ctx to
+// be done.
+func (p SetStorageBucketTrackingParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetStorageBucketTracking, p, nil)
+}
+
+// DeleteStorageBucketParams are the parameters of Storage.deleteStorageBucket.
+//
+// Deletes the Storage Bucket with the given storage key and bucket name.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#method-deleteStorageBucket
+//
+// This is an experimental part of the protocol.
+type DeleteStorageBucketParams struct {
+	Bucket StorageBucket `json:"bucket"`
+}
+
+// Command returns the Storage.deleteStorageBucket command for use with
+// AsyncDebugger.Send.
+func (p DeleteStorageBucketParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandDeleteStorageBucket, p)
+}
+
+// Do sends Storage.deleteStorageBucket to d and waits for it to complete or for ctx to
+// be done.
+func (p DeleteStorageBucketParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandDeleteStorageBucket, p, nil)
+}
+
+// RunBounceTrackingMitigationsParams are the parameters of
+// Storage.runBounceTrackingMitigations.
+//
+// Deletes state for sites identified as potential bounce trackers, immediately.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#method-runBounceTrackingMitigations
+//
+// This is an experimental part of the protocol.
+type RunBounceTrackingMitigationsParams struct {
+}
+
+// Command returns the Storage.runBounceTrackingMitigations command for use with
+// AsyncDebugger.Send.
+func (p RunBounceTrackingMitigationsParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandRunBounceTrackingMitigations, p)
+}
+
+// RunBounceTrackingMitigationsReturns are the values returned by Storage.runBounceTrackingMitigations.
+type RunBounceTrackingMitigationsReturns struct {
+	DeletedSites []string `json:"deletedSites"`
+}
+
+// Do sends Storage.runBounceTrackingMitigations to d and waits for its result or for ctx to be
+// done.
+func (p RunBounceTrackingMitigationsParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*RunBounceTrackingMitigationsReturns, error) {
+	ret := &RunBounceTrackingMitigationsReturns{}
+	if err := protocol.Do(ctx, d, CommandRunBounceTrackingMitigations, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// SetAttributionReportingLocalTestingModeParams are the parameters of
+// Storage.setAttributionReportingLocalTestingMode.
+//
+// https://wicg.github.io/attribution-reporting-api/
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#method-setAttributionReportingLocalTestingMode
+//
+// This is an experimental part of the protocol.
+type SetAttributionReportingLocalTestingModeParams struct {
+	// If enabled, noise is suppressed and reports are sent immediately.
+	Enabled bool `json:"enabled"`
+}
+
+// Command returns the Storage.setAttributionReportingLocalTestingMode command
+// for use with AsyncDebugger.Send.
+func (p SetAttributionReportingLocalTestingModeParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetAttributionReportingLocalTestingMode, p)
+}
+
+// Do sends Storage.setAttributionReportingLocalTestingMode to d and waits for it to complete or for ctx to
+// be done.
+func (p SetAttributionReportingLocalTestingModeParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetAttributionReportingLocalTestingMode, p, nil)
+}
+
+// SetAttributionReportingTrackingParams are the parameters of
+// Storage.setAttributionReportingTracking.
+//
+// Enables/disables issuing of Attribution Reporting events.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#method-setAttributionReportingTracking
+//
+// This is an experimental part of the protocol.
+type SetAttributionReportingTrackingParams struct {
+	Enable bool `json:"enable"`
+}
+
+// Command returns the Storage.setAttributionReportingTracking command for use
+// with AsyncDebugger.Send.
+func (p SetAttributionReportingTrackingParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetAttributionReportingTracking, p)
+}
+
+// Do sends Storage.setAttributionReportingTracking to d and waits for it to complete or for ctx to
+// be done.
+func (p SetAttributionReportingTrackingParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetAttributionReportingTracking, p, nil)
+}
+
+// SendPendingAttributionReportsParams are the parameters of
+// Storage.sendPendingAttributionReports.
+//
+// Sends all pending Attribution Reports immediately, regardless of their
+// scheduled report time.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#method-sendPendingAttributionReports
+//
+// This is an experimental part of the protocol.
+type SendPendingAttributionReportsParams struct {
+}
+
+// Command returns the Storage.sendPendingAttributionReports command for use
+// with AsyncDebugger.Send.
+func (p SendPendingAttributionReportsParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSendPendingAttributionReports, p)
+}
+
+// SendPendingAttributionReportsReturns are the values returned by Storage.sendPendingAttributionReports.
+type SendPendingAttributionReportsReturns struct {
+	// The number of reports that were sent.
+	NumSent int64 `json:"numSent"`
+}
+
+// Do sends Storage.sendPendingAttributionReports to d and waits for its result or for ctx to be
+// done.
+func (p SendPendingAttributionReportsParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*SendPendingAttributionReportsReturns, error) {
+	ret := &SendPendingAttributionReportsReturns{}
+	if err := protocol.Do(ctx, d, CommandSendPendingAttributionReports, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// GetRelatedWebsiteSetsParams are the parameters of
+// Storage.getRelatedWebsiteSets.
+//
+// Returns the effective Related Website Sets in use by this profile for the
+// browser session. The effective Related Website Sets will not change during a
+// browser session.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#method-getRelatedWebsiteSets
+//
+// This is an experimental part of the protocol.
+type GetRelatedWebsiteSetsParams struct {
+}
+
+// Command returns the Storage.getRelatedWebsiteSets command for use with
+// AsyncDebugger.Send.
+func (p GetRelatedWebsiteSetsParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandGetRelatedWebsiteSets, p)
+}
+
+// GetRelatedWebsiteSetsReturns are the values returned by Storage.getRelatedWebsiteSets.
+type GetRelatedWebsiteSetsReturns struct {
+	Sets []RelatedWebsiteSet `json:"sets"`
+}
+
+// Do sends Storage.getRelatedWebsiteSets to d and waits for its result or for ctx to be
+// done.
+func (p GetRelatedWebsiteSetsParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*GetRelatedWebsiteSetsReturns, error) {
+	ret := &GetRelatedWebsiteSetsReturns{}
+	if err := protocol.Do(ctx, d, CommandGetRelatedWebsiteSets, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// GetAffectedUrlsForThirdPartyCookieMetadataParams are the parameters of
+// Storage.getAffectedUrlsForThirdPartyCookieMetadata.
+//
+// Returns the list of URLs from a page and its embedded resources that match
+// existing grace period URL pattern rules.
+// https://developers.google.com/privacy-sandbox/cookies/temporary-exceptions/grace-period
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#method-getAffectedUrlsForThirdPartyCookieMetadata
+//
+// This is an experimental part of the protocol.
+type GetAffectedUrlsForThirdPartyCookieMetadataParams struct {
+	// The URL of the page currently being visited.
+	FirstPartyURL string `json:"firstPartyUrl"`
+	// The list of embedded resource URLs from the page.
+	ThirdPartyUrls []string `json:"thirdPartyUrls"`
+}
+
+// Command returns the Storage.getAffectedUrlsForThirdPartyCookieMetadata
+// command for use with AsyncDebugger.Send.
+func (p GetAffectedUrlsForThirdPartyCookieMetadataParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandGetAffectedUrlsForThirdPartyCookieMetadata, p)
+}
+
+// GetAffectedUrlsForThirdPartyCookieMetadataReturns are the values returned by Storage.getAffectedUrlsForThirdPartyCookieMetadata.
+type GetAffectedUrlsForThirdPartyCookieMetadataReturns struct {
+	// Array of matching URLs. If there is a primary pattern match for the first-
+	// party URL, only the first-party URL is returned in the array.
+	MatchedUrls []string `json:"matchedUrls"`
+}
+
+// Do sends Storage.getAffectedUrlsForThirdPartyCookieMetadata to d and waits for its result or for ctx to be
+// done.
+func (p GetAffectedUrlsForThirdPartyCookieMetadataParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*GetAffectedUrlsForThirdPartyCookieMetadataReturns, error) {
+	ret := &GetAffectedUrlsForThirdPartyCookieMetadataReturns{}
+	if err := protocol.Do(ctx, d, CommandGetAffectedUrlsForThirdPartyCookieMetadata, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// SetProtectedAudienceKAnonymityParams are the parameters of
+// Storage.setProtectedAudienceKAnonymity.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#method-setProtectedAudienceKAnonymity
+type SetProtectedAudienceKAnonymityParams struct {
+	Owner  string   `json:"owner"`
+	Name   string   `json:"name"`
+	Hashes []string `json:"hashes"`
+}
+
+// Command returns the Storage.setProtectedAudienceKAnonymity command for use
+// with AsyncDebugger.Send.
+func (p SetProtectedAudienceKAnonymityParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetProtectedAudienceKAnonymity, p)
+}
+
+// Do sends Storage.setProtectedAudienceKAnonymity to d and waits for it to complete or for ctx to
+// be done.
+func (p SetProtectedAudienceKAnonymityParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetProtectedAudienceKAnonymity, p, nil)
+}
+
+// Method names of the events in the Storage domain.
+const (
+	EventCacheStorageContentUpdated                     = "Storage.cacheStorageContentUpdated"
+	EventCacheStorageListUpdated                        = "Storage.cacheStorageListUpdated"
+	EventIndexedDBContentUpdated                        = "Storage.indexedDBContentUpdated"
+	EventIndexedDBListUpdated                           = "Storage.indexedDBListUpdated"
+	EventInterestGroupAccessed                          = "Storage.interestGroupAccessed"
+	EventInterestGroupAuctionEventOccurred              = "Storage.interestGroupAuctionEventOccurred"
+	EventInterestGroupAuctionNetworkRequestCreated      = "Storage.interestGroupAuctionNetworkRequestCreated"
+	EventSharedStorageAccessed                          = "Storage.sharedStorageAccessed"
+	EventSharedStorageWorkletOperationExecutionFinished = "Storage.sharedStorageWorkletOperationExecutionFinished"
+	EventStorageBucketCreatedOrUpdated                  = "Storage.storageBucketCreatedOrUpdated"
+	EventStorageBucketDeleted                           = "Storage.storageBucketDeleted"
+	EventAttributionReportingSourceRegistered           = "Storage.attributionReportingSourceRegistered"
+	EventAttributionReportingTriggerRegistered          = "Storage.attributionReportingTriggerRegistered"
+	EventAttributionReportingReportSent                 = "Storage.attributionReportingReportSent"
+	EventAttributionReportingVerboseDebugReportSent     = "Storage.attributionReportingVerboseDebugReportSent"
+)
+
+// CacheStorageContentUpdatedEvent is the payload of the
+// Storage.cacheStorageContentUpdated event.
+//
+// A cache's contents have been modified.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#event-cacheStorageContentUpdated
+type CacheStorageContentUpdatedEvent struct {
+	// Origin to update.
+	Origin string `json:"origin"`
+	// Storage key to update.
+	StorageKey string `json:"storageKey"`
+	// Storage bucket to update.
+	BucketID string `json:"bucketId"`
+	// Name of cache in origin.
+	CacheName string `json:"cacheName"`
+}
+
+// CacheStorageListUpdatedEvent is the payload of the
+// Storage.cacheStorageListUpdated event.
+//
+// A cache has been added/deleted.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#event-cacheStorageListUpdated
+type CacheStorageListUpdatedEvent struct {
+	// Origin to update.
+	Origin string `json:"origin"`
+	// Storage key to update.
+	StorageKey string `json:"storageKey"`
+	// Storage bucket to update.
+	BucketID string `json:"bucketId"`
+}
+
+// IndexedDBContentUpdatedEvent is the payload of the
+// Storage.indexedDBContentUpdated event.
+//
+// The origin's IndexedDB object store has been modified.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#event-indexedDBContentUpdated
+type IndexedDBContentUpdatedEvent struct {
+	// Origin to update.
+	Origin string `json:"origin"`
+	// Storage key to update.
+	StorageKey string `json:"storageKey"`
+	// Storage bucket to update.
+	BucketID string `json:"bucketId"`
+	// Database to update.
+	DatabaseName string `json:"databaseName"`
+	// ObjectStore to update.
+	ObjectStoreName string `json:"objectStoreName"`
+}
+
+// IndexedDBListUpdatedEvent is the payload of the Storage.indexedDBListUpdated
+// event.
+//
+// The origin's IndexedDB database list has been modified.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#event-indexedDBListUpdated
+type IndexedDBListUpdatedEvent struct {
+	// Origin to update.
+	Origin string `json:"origin"`
+	// Storage key to update.
+	StorageKey string `json:"storageKey"`
+	// Storage bucket to update.
+	BucketID string `json:"bucketId"`
+}
+
+// InterestGroupAccessedEvent is the payload of the
+// Storage.interestGroupAccessed event.
+//
+// One of the interest groups was accessed. Note that these events are global to
+// all targets sharing an interest group store.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#event-interestGroupAccessed
+type InterestGroupAccessedEvent struct {
+	AccessTime  types.NetworkTimeSinceEpoch `json:"accessTime"`
+	Type        InterestGroupAccessType     `json:"type"`
+	OwnerOrigin string                      `json:"ownerOrigin"`
+	Name        string                      `json:"name"`
+	// For topLevelBid/topLevelAdditionalBid, and when appropriate, win and
+	// additionalBidWin
+	ComponentSellerOrigin string `json:"componentSellerOrigin,omitempty"`
+	// For bid or somethingBid event, if done locally and not on a server.
+	Bid         float64 `json:"bid,omitempty"`
+	BidCurrency string  `json:"bidCurrency,omitempty"`
+	// For non-global events --- links to interestGroupAuctionEvent
+	UniqueAuctionID InterestGroupAuctionID `json:"uniqueAuctionId,omitempty"`
+}
+
+// InterestGroupAuctionEventOccurredEvent is the payload of the
+// Storage.interestGroupAuctionEventOccurred event.
+//
+// An auction involving interest groups is taking place. These events are
+// target-specific.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#event-interestGroupAuctionEventOccurred
+type InterestGroupAuctionEventOccurredEvent struct {
+	EventTime       types.NetworkTimeSinceEpoch   `json:"eventTime"`
+	Type            InterestGroupAuctionEventType `json:"type"`
+	UniqueAuctionID InterestGroupAuctionID        `json:"uniqueAuctionId"`
+	// Set for child auctions.
+	ParentAuctionID InterestGroupAuctionID `json:"parentAuctionId,omitempty"`
+	// Set for started and configResolved
+	AuctionConfig map[string]interface{} `json:"auctionConfig,omitempty"`
+}
+
+// InterestGroupAuctionNetworkRequestCreatedEvent is the payload of the
+// Storage.interestGroupAuctionNetworkRequestCreated event.
+//
+// Specifies which auctions a particular network fetch may be related to, and in
+// what role. Note that it is not ordered with respect to
+// Network.requestWillBeSent (but will happen before loadingFinished
+// loadingFailed).
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#event-interestGroupAuctionNetworkRequestCreated
+type InterestGroupAuctionNetworkRequestCreatedEvent struct {
+	Type      InterestGroupAuctionFetchType `json:"type"`
+	RequestID types.NetworkRequestID        `json:"requestId"`
+	// This is the set of the auctions using the worklet that issued this request.
+	// In the case of trusted signals, it's possible that only some of them
+	// actually care about the keys being queried.
+	Auctions []InterestGroupAuctionID `json:"auctions"`
+}
+
+// SharedStorageAccessedEvent is the payload of the
+// Storage.sharedStorageAccessed event.
+//
+// Shared storage was accessed by the associated page. The following parameters
+// are included in all events.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#event-sharedStorageAccessed
+type SharedStorageAccessedEvent struct {
+	// Time of the access.
+	AccessTime types.NetworkTimeSinceEpoch `json:"accessTime"`
+	// Enum value indicating the access scope.
+	Scope SharedStorageAccessScope `json:"scope"`
+	// Enum value indicating the Shared Storage API method invoked.
+	Method SharedStorageAccessMethod `json:"method"`
+	// DevTools Frame Token for the primary frame tree's root.
+	MainFrameID types.PageFrameID `json:"mainFrameId"`
+	// Serialization of the origin owning the Shared Storage data.
+	OwnerOrigin string `json:"ownerOrigin"`
+	// Serialization of the site owning the Shared Storage data.
+	OwnerSite string `json:"ownerSite"`
+	// The sub-parameters wrapped by `params` are all optional and their
+	// presence/absence depends on `type`.
+	Params SharedStorageAccessParams `json:"params"`
+}
+
+// SharedStorageWorkletOperationExecutionFinishedEvent is the payload of the
+// Storage.sharedStorageWorkletOperationExecutionFinished event.
+//
+// A shared storage run or selectURL operation finished its execution. The
+// following parameters are included in all events.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#event-sharedStorageWorkletOperationExecutionFinished
+type SharedStorageWorkletOperationExecutionFinishedEvent struct {
+	// Time that the operation finished.
+	FinishedTime types.NetworkTimeSinceEpoch `json:"finishedTime"`
+	// Time, in microseconds, from start of shared storage JS API call until end of
+	// operation execution in the worklet.
+	ExecutionTime int64 `json:"executionTime"`
+	// Enum value indicating the Shared Storage API method invoked.
+	Method SharedStorageAccessMethod `json:"method"`
+	// ID of the operation call.
+	OperationID string `json:"operationId"`
+	// Hex representation of the DevTools token used as the TargetID for the
+	// associated shared storage worklet.
+	WorkletTargetID types.TargetTargetID `json:"workletTargetId"`
+	// DevTools Frame Token for the primary frame tree's root.
+	MainFrameID types.PageFrameID `json:"mainFrameId"`
+	// Serialization of the origin owning the Shared Storage data.
+	OwnerOrigin string `json:"ownerOrigin"`
+}
+
+// StorageBucketCreatedOrUpdatedEvent is the payload of the
+// Storage.storageBucketCreatedOrUpdated event.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#event-storageBucketCreatedOrUpdated
+type StorageBucketCreatedOrUpdatedEvent struct {
+	BucketInfo StorageBucketInfo `json:"bucketInfo"`
+}
+
+// StorageBucketDeletedEvent is the payload of the Storage.storageBucketDeleted
+// event.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#event-storageBucketDeleted
+type StorageBucketDeletedEvent struct {
+	BucketID string `json:"bucketId"`
+}
+
+// AttributionReportingSourceRegisteredEvent is the payload of the
+// Storage.attributionReportingSourceRegistered event.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#event-attributionReportingSourceRegistered
+//
+// This is an experimental part of the protocol.
+type AttributionReportingSourceRegisteredEvent struct {
+	Registration AttributionReportingSourceRegistration       `json:"registration"`
+	Result       AttributionReportingSourceRegistrationResult `json:"result"`
+}
+
+// AttributionReportingTriggerRegisteredEvent is the payload of the
+// Storage.attributionReportingTriggerRegistered event.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#event-attributionReportingTriggerRegistered
+//
+// This is an experimental part of the protocol.
+type AttributionReportingTriggerRegisteredEvent struct {
+	Registration AttributionReportingTriggerRegistration `json:"registration"`
+	EventLevel   AttributionReportingEventLevelResult    `json:"eventLevel"`
+	Aggregatable AttributionReportingAggregatableResult  `json:"aggregatable"`
+}
+
+// AttributionReportingReportSentEvent is the payload of the
+// Storage.attributionReportingReportSent event.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#event-attributionReportingReportSent
+//
+// This is an experimental part of the protocol.
+type AttributionReportingReportSentEvent struct {
+	URL    string                           `json:"url"`
+	Body   map[string]interface{}           `json:"body"`
+	Result AttributionReportingReportResult `json:"result"`
+	// If result is `sent`, populated with net/HTTP status.
+	NetError       int64  `json:"netError,omitempty"`
+	NetErrorName   string `json:"netErrorName,omitempty"`
+	HTTPStatusCode int64  `json:"httpStatusCode,omitempty"`
+}
+
+// AttributionReportingVerboseDebugReportSentEvent is the payload of the
+// Storage.attributionReportingVerboseDebugReportSent event.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Storage#event-attributionReportingVerboseDebugReportSent
+//
+// This is an experimental part of the protocol.
+type AttributionReportingVerboseDebugReportSentEvent struct {
+	URL            string                   `json:"url"`
+	Body           []map[string]interface{} `json:"body,omitempty"`
+	NetError       int64                    `json:"netError,omitempty"`
+	NetErrorName   string                   `json:"netErrorName,omitempty"`
+	HTTPStatusCode int64                    `json:"httpStatusCode,omitempty"`
+}
+
+// ParseEvent decodes an event from the Storage domain, as received from
+// Subscribe, into a pointer to its typed payload.
+func ParseEvent(cmd chromedebugo.Command) (interface{}, error) {
+	var ev interface{}
+	switch cmd.Method {
+	case EventCacheStorageContentUpdated:
+		ev = &CacheStorageContentUpdatedEvent{}
+	case EventCacheStorageListUpdated:
+		ev = &CacheStorageListUpdatedEvent{}
+	case EventIndexedDBContentUpdated:
+		ev = &IndexedDBContentUpdatedEvent{}
+	case EventIndexedDBListUpdated:
+		ev = &IndexedDBListUpdatedEvent{}
+	case EventInterestGroupAccessed:
+		ev = &InterestGroupAccessedEvent{}
+	case EventInterestGroupAuctionEventOccurred:
+		ev = &InterestGroupAuctionEventOccurredEvent{}
+	case EventInterestGroupAuctionNetworkRequestCreated:
+		ev = &InterestGroupAuctionNetworkRequestCreatedEvent{}
+	case EventSharedStorageAccessed:
+		ev = &SharedStorageAccessedEvent{}
+	case EventSharedStorageWorkletOperationExecutionFinished:
+		ev = &SharedStorageWorkletOperationExecutionFinishedEvent{}
+	case EventStorageBucketCreatedOrUpdated:
+		ev = &StorageBucketCreatedOrUpdatedEvent{}
+	case EventStorageBucketDeleted:
+		ev = &StorageBucketDeletedEvent{}
+	case EventAttributionReportingSourceRegistered:
+		ev = &AttributionReportingSourceRegisteredEvent{}
+	case EventAttributionReportingTriggerRegistered:
+		ev = &AttributionReportingTriggerRegisteredEvent{}
+	case EventAttributionReportingReportSent:
+		ev = &AttributionReportingReportSentEvent{}
+	case EventAttributionReportingVerboseDebugReportSent:
+		ev = &AttributionReportingVerboseDebugReportSentEvent{}
+	default:
+		return nil, fmt.Errorf("unknown Storage event: %s", cmd.Method)
+	}
+	if err := cmd.Decode(ev); err != nil {
+		return nil, err
+	}
+	return ev, nil
+}
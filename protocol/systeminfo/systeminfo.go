@@ -0,0 +1,185 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+// Package systeminfo provides typed bindings for the SystemInfo domain of the
+// Chrome DevTools protocol.
+//
+// The SystemInfo domain defines methods and events for querying low-level
+// system information.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/SystemInfo
+//
+// This is an experimental part of the protocol.
+package systeminfo
+
+import (
+	"context"
+
+	"github.com/tonyhb/chromedebugo"
+	"github.com/tonyhb/chromedebugo/protocol"
+	"github.com/tonyhb/chromedebugo/protocol/internal/types"
+)
+
+// GPUDevice describes a single graphics processor (GPU).
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/SystemInfo#type-GPUDevice
+type GPUDevice = types.SystemInfoGPUDevice
+
+// Size describes the width and height dimensions of an entity.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/SystemInfo#type-Size
+type Size = types.SystemInfoSize
+
+// VideoDecodeAcceleratorCapability describes a supported video decoding profile
+// with its associated minimum and maximum resolutions.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/SystemInfo#type-VideoDecodeAcceleratorCapability
+type VideoDecodeAcceleratorCapability = types.SystemInfoVideoDecodeAcceleratorCapability
+
+// VideoEncodeAcceleratorCapability describes a supported video encoding profile
+// with its associated maximum resolution and maximum framerate.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/SystemInfo#type-VideoEncodeAcceleratorCapability
+type VideoEncodeAcceleratorCapability = types.SystemInfoVideoEncodeAcceleratorCapability
+
+// SubsamplingFormat YUV subsampling type of the pixels of a given image.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/SystemInfo#type-SubsamplingFormat
+type SubsamplingFormat = types.SystemInfoSubsamplingFormat
+
+// ImageType image format of a given image.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/SystemInfo#type-ImageType
+type ImageType = types.SystemInfoImageType
+
+// ImageDecodeAcceleratorCapability describes a supported image decoding profile
+// with its associated minimum and maximum resolutions and subsampling.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/SystemInfo#type-ImageDecodeAcceleratorCapability
+type ImageDecodeAcceleratorCapability = types.SystemInfoImageDecodeAcceleratorCapability
+
+// GPUInfo provides information about the GPU(s) on the system.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/SystemInfo#type-GPUInfo
+type GPUInfo = types.SystemInfoGPUInfo
+
+// ProcessInfo represents process info.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/SystemInfo#type-ProcessInfo
+type ProcessInfo = types.SystemInfoProcessInfo
+
+// Values of the enumerated types in the SystemInfo domain.
+const (
+	SubsamplingFormatYuv420 = types.SystemInfoSubsamplingFormatYuv420
+	SubsamplingFormatYuv422 = types.SystemInfoSubsamplingFormatYuv422
+	SubsamplingFormatYuv444 = types.SystemInfoSubsamplingFormatYuv444
+	ImageTypeJpeg           = types.SystemInfoImageTypeJpeg
+	ImageTypeWebp           = types.SystemInfoImageTypeWebp
+	ImageTypeUnknown        = types.SystemInfoImageTypeUnknown
+)
+
+// Method names of the commands in the SystemInfo domain.
+const (
+	CommandGetInfo         = "SystemInfo.getInfo"
+	CommandGetFeatureState = "SystemInfo.getFeatureState"
+	CommandGetProcessInfo  = "SystemInfo.getProcessInfo"
+)
+
+// GetInfoParams are the parameters of SystemInfo.getInfo.
+//
+// Returns information about the system.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/SystemInfo#method-getInfo
+type GetInfoParams struct {
+}
+
+// Command returns the SystemInfo.getInfo command for use with
+// AsyncDebugger.Send.
+func (p GetInfoParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandGetInfo, p)
+}
+
+// GetInfoReturns are the values returned by SystemInfo.getInfo.
+type GetInfoReturns struct {
+	// Information about the GPUs on the system.
+	GPU GPUInfo `json:"gpu"`
+	// A platform-dependent description of the model of the machine. On Mac OS,
+	// this is, for example, 'MacBookPro'. Will be the empty string if not
+	// supported.
+	ModelName string `json:"modelName"`
+	// A platform-dependent description of the version of the machine. On Mac OS,
+	// this is, for example, '10.1'. Will be the empty string if not supported.
+	ModelVersion string `json:"modelVersion"`
+	// The command line string used to launch the browser. Will be the empty string
+	// if not supported.
+	CommandLine string `json:"commandLine"`
+}
+
+// Do sends SystemInfo.getInfo to d and waits for its result or for ctx to be
+// done.
+func (p GetInfoParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*GetInfoReturns, error) {
+	ret := &GetInfoReturns{}
+	if err := protocol.Do(ctx, d, CommandGetInfo, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// GetFeatureStateParams are the parameters of SystemInfo.getFeatureState.
+//
+// Returns information about the feature state.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/SystemInfo#method-getFeatureState
+type GetFeatureStateParams struct {
+	FeatureState string `json:"featureState"`
+}
+
+// Command returns the SystemInfo.getFeatureState command for use with
+// AsyncDebugger.Send.
+func (p GetFeatureStateParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandGetFeatureState, p)
+}
+
+// GetFeatureStateReturns are the values returned by SystemInfo.getFeatureState.
+type GetFeatureStateReturns struct {
+	FeatureEnabled bool `json:"featureEnabled"`
+}
+
+// Do sends SystemInfo.getFeatureState to d and waits for its result or for ctx to be
+// done.
+func (p GetFeatureStateParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*GetFeatureStateReturns, error) {
+	ret := &GetFeatureStateReturns{}
+	if err := protocol.Do(ctx, d, CommandGetFeatureState, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// GetProcessInfoParams are the parameters of SystemInfo.getProcessInfo.
+//
+// Returns information about all running processes.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/SystemInfo#method-getProcessInfo
+type GetProcessInfoParams struct {
+}
+
+// Command returns the SystemInfo.getProcessInfo command for use with
+// AsyncDebugger.Send.
+func (p GetProcessInfoParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandGetProcessInfo, p)
+}
+
+// GetProcessInfoReturns are the values returned by SystemInfo.getProcessInfo.
+type GetProcessInfoReturns struct {
+	// An array of process info blocks.
+	ProcessInfo []ProcessInfo `json:"processInfo"`
+}
+
+// Do sends SystemInfo.getProcessInfo to d and waits for its result or for ctx to be
+// done.
+func (p GetProcessInfoParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*GetProcessInfoReturns, error) {
+	ret := &GetProcessInfoReturns{}
+	if err := protocol.Do(ctx, d, CommandGetProcessInfo, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
@@ -0,0 +1,849 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+// Package target provides typed bindings for the Target domain of the Chrome
+// DevTools protocol.
+//
+// Supports additional targets discovery and allows to attach to them.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Target
+package target
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tonyhb/chromedebugo"
+	"github.com/tonyhb/chromedebugo/protocol"
+	"github.com/tonyhb/chromedebugo/protocol/internal/types"
+)
+
+// TargetID is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Target#type-TargetID
+type TargetID = types.TargetTargetID
+
+// SessionID unique identifier of attached debugging session.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Target#type-SessionID
+type SessionID = types.TargetSessionID
+
+// TargetInfo is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Target#type-TargetInfo
+type TargetInfo = types.TargetTargetInfo
+
+// FilterEntry a filter used by target query/discovery/auto-attach operations.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Target#type-FilterEntry
+//
+// This is an experimental part of the protocol.
+type FilterEntry = types.TargetFilterEntry
+
+// TargetFilter the entries in TargetFilter are matched sequentially against
+// targets and the first entry that matches determines if the target is included
+// or not, depending on the value of `exclude` field in the entry. If filter is
+// not specified, the one assumed is [{type: "browser", exclude: true}, {type:
+// "tab", exclude: true}, {}] (i.e. include everything but `browser` and `tab`).
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Target#type-TargetFilter
+//
+// This is an experimental part of the protocol.
+type TargetFilter = types.TargetTargetFilter
+
+// RemoteLocation is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Target#type-RemoteLocation
+//
+// This is an experimental part of the protocol.
+type RemoteLocation = types.TargetRemoteLocation
+
+// WindowState the state of the target window.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Target#type-WindowState
+//
+// This is an experimental part of the protocol.
+type WindowState = types.TargetWindowState
+
+// Values of the enumerated types in the Target domain.
+const (
+	WindowStateNormal     = types.TargetWindowStateNormal
+	WindowStateMinimized  = types.TargetWindowStateMinimized
+	WindowStateMaximized  = types.TargetWindowStateMaximized
+	WindowStateFullscreen = types.TargetWindowStateFullscreen
+)
+
+// Method names of the commands in the Target domain.
+const (
+	CommandActivateTarget         = "Target.activateTarget"
+	CommandAttachToTarget         = "Target.attachToTarget"
+	CommandAttachToBrowserTarget  = "Target.attachToBrowserTarget"
+	CommandCloseTarget            = "Target.closeTarget"
+	CommandExposeDevToolsProtocol = "Target.exposeDevToolsProtocol"
+	CommandCreateBrowserContext   = "Target.createBrowserContext"
+	CommandGetBrowserContexts     = "Target.getBrowserContexts"
+	CommandCreateTarget           = "Target.createTarget"
+	CommandDetachFromTarget       = "Target.detachFromTarget"
+	CommandDisposeBrowserContext  = "Target.disposeBrowserContext"
+	CommandGetTargetInfo          = "Target.getTargetInfo"
+	CommandGetTargets             = "Target.getTargets"
+	CommandSendMessageToTarget    = "Target.sendMessageToTarget"
+	CommandSetAutoAttach          = "Target.setAutoAttach"
+	CommandAutoAttachRelated      = "Target.autoAttachRelated"
+	CommandSetDiscoverTargets     = "Target.setDiscoverTargets"
+	CommandSetRemoteLocations     = "Target.setRemoteLocations"
+	CommandOpenDevTools           = "Target.openDevTools"
+)
+
+// ActivateTargetParams are the parameters of Target.activateTarget.
+//
+// Activates (focuses) the target.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Target#method-activateTarget
+type ActivateTargetParams struct {
+	TargetID TargetID `json:"targetId"`
+}
+
+// Command returns the Target.activateTarget command for use with
+// AsyncDebugger.Send.
+func (p ActivateTargetParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandActivateTarget, p)
+}
+
+// Do sends Target.activateTarget to d and waits for it to complete or for ctx to
+// be done.
+func (p ActivateTargetParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandActivateTarget, p, nil)
+}
+
+// AttachToTargetParams are the parameters of Target.attachToTarget.
+//
+// Attaches to the target with given id.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Target#method-attachToTarget
+type AttachToTargetParams struct {
+	TargetID TargetID `json:"targetId"`
+	// Enables "flat" access to the session via specifying sessionId attribute in
+	// the commands. We plan to make this the default, deprecate non-flattened
+	// mode, and eventually retire it. See crbug.com/991325.
+	Flatten bool `json:"flatten,omitempty"`
+}
+
+// Command returns the Target.attachToTarget command for use with
+// AsyncDebugger.Send.
+func (p AttachToTargetParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandAttachToTarget, p)
+}
+
+// AttachToTargetReturns are the values returned by Target.attachToTarget.
+type AttachToTargetReturns struct {
+	// Id assigned to the session.
+	SessionID SessionID `json:"sessionId"`
+}
+
+// Do sends Target.attachToTarget to d and waits for its result or for ctx to be
+// done.
+func (p AttachToTargetParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*AttachToTargetReturns, error) {
+	ret := &AttachToTargetReturns{}
+	if err := protocol.Do(ctx, d, CommandAttachToTarget, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// AttachToBrowserTargetParams are the parameters of
+// Target.attachToBrowserTarget.
+//
+// Attaches to the browser target, only uses flat sessionId mode.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Target#method-attachToBrowserTarget
+//
+// This is an experimental part of the protocol.
+type AttachToBrowserTargetParams struct {
+}
+
+// Command returns the Target.attachToBrowserTarget command for use with
+// AsyncDebugger.Send.
+func (p AttachToBrowserTargetParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandAttachToBrowserTarget, p)
+}
+
+// AttachToBrowserTargetReturns are the values returned by Target.attachToBrowserTarget.
+type AttachToBrowserTargetReturns struct {
+	// Id assigned to the session.
+	SessionID SessionID `json:"sessionId"`
+}
+
+// Do sends Target.attachToBrowserTarget to d and waits for its result or for ctx to be
+// done.
+func (p AttachToBrowserTargetParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*AttachToBrowserTargetReturns, error) {
+	ret := &AttachToBrowserTargetReturns{}
+	if err := protocol.Do(ctx, d, CommandAttachToBrowserTarget, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// CloseTargetParams are the parameters of Target.closeTarget.
+//
+// Closes the target. If the target is a page that gets closed too.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Target#method-closeTarget
+type CloseTargetParams struct {
+	TargetID TargetID `json:"targetId"`
+}
+
+// Command returns the Target.closeTarget command for use with
+// AsyncDebugger.Send.
+func (p CloseTargetParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandCloseTarget, p)
+}
+
+// CloseTargetReturns are the values returned by Target.closeTarget.
+type CloseTargetReturns struct {
+	// Always set to true. If an error occurs, the response indicates protocol
+	// error.
+	//
+	// Deprecated: this is deprecated in the protocol.
+	Success bool `json:"success"`
+}
+
+// Do sends Target.closeTarget to d and waits for its result or for ctx to be
+// done.
+func (p CloseTargetParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*CloseTargetReturns, error) {
+	ret := &CloseTargetReturns{}
+	if err := protocol.Do(ctx, d, CommandCloseTarget, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// ExposeDevToolsProtocolParams are the parameters of
+// Target.exposeDevToolsProtocol.
+//
+// Inject object to the target's main frame that provides a communication
+// channel with browser target.
+//
+// Injected object will be available as `window[bindingName]`.
+//
+// The object has the following API:
+// - `binding.send(json)` - a method to send messages over the remote debugging
+// protocol
+// - `binding.onmessage = json => handleMessage(json)` - a callback that will be
+// called for the protocol notifications and command responses.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Target#method-exposeDevToolsProtocol
+//
+// This is an experimental part of the protocol.
+type ExposeDevToolsProtocolParams struct {
+	TargetID TargetID `json:"targetId"`
+	// Binding name, 'cdp' if not specified.
+	BindingName string `json:"bindingName,omitempty"`
+	// If true, inherits the current root session's permissions (default: false).
+	InheritPermissions bool `json:"inheritPermissions,omitempty"`
+}
+
+// Command returns the Target.exposeDevToolsProtocol command for use with
+// AsyncDebugger.Send.
+func (p ExposeDevToolsProtocolParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandExposeDevToolsProtocol, p)
+}
+
+// Do sends Target.exposeDevToolsProtocol to d and waits for it to complete or for ctx to
+// be done.
+func (p ExposeDevToolsProtocolParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandExposeDevToolsProtocol, p, nil)
+}
+
+// CreateBrowserContextParams are the parameters of Target.createBrowserContext.
+//
+// Creates a new empty BrowserContext. Similar to an incognito profile but you
+// can have more than one.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Target#method-createBrowserContext
+type CreateBrowserContextParams struct {
+	// If specified, disposes this context when debugging session disconnects.
+	//
+	// This is an experimental part of the protocol.
+	DisposeOnDetach bool `json:"disposeOnDetach,omitempty"`
+	// Proxy server, similar to the one passed to --proxy-server
+	//
+	// This is an experimental part of the protocol.
+	ProxyServer string `json:"proxyServer,omitempty"`
+	// Proxy bypass list, similar to the one passed to --proxy-bypass-list
+	//
+	// This is an experimental part of the protocol.
+	ProxyBypassList string `json:"proxyBypassList,omitempty"`
+	// An optional list of origins to grant unlimited cross-origin access to. Parts
+	// of the URL other than those constituting origin are ignored.
+	//
+	// This is an experimental part of the protocol.
+	OriginsWithUniversalNetworkAccess []string `json:"originsWithUniversalNetworkAccess,omitempty"`
+}
+
+// Command returns the Target.createBrowserContext command for use with
+// AsyncDebugger.Send.
+func (p CreateBrowserContextParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandCreateBrowserContext, p)
+}
+
+// CreateBrowserContextReturns are the values returned by Target.createBrowserContext.
+type CreateBrowserContextReturns struct {
+	// The id of the context created.
+	BrowserContextID types.BrowserBrowserContextID `json:"browserContextId"`
+}
+
+// Do sends Target.createBrowserContext to d and waits for its result or for ctx to be
+// done.
+func (p CreateBrowserContextParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*CreateBrowserContextReturns, error) {
+	ret := &CreateBrowserContextReturns{}
+	if err := protocol.Do(ctx, d, CommandCreateBrowserContext, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// GetBrowserContextsParams are the parameters of Target.getBrowserContexts.
+//
+// Returns all browser contexts created with `Target.createBrowserContext`
+// method.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Target#method-getBrowserContexts
+type GetBrowserContextsParams struct {
+}
+
+// Command returns the Target.getBrowserContexts command for use with
+// AsyncDebugger.Send.
+func (p GetBrowserContextsParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandGetBrowserContexts, p)
+}
+
+// GetBrowserContextsReturns are the values returned by Target.getBrowserContexts.
+type GetBrowserContextsReturns struct {
+	// An array of browser context ids.
+	BrowserContextIds []types.BrowserBrowserContextID `json:"browserContextIds"`
+}
+
+// Do sends Target.getBrowserContexts to d and waits for its result or for ctx to be
+// done.
+func (p GetBrowserContextsParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*GetBrowserContextsReturns, error) {
+	ret := &GetBrowserContextsReturns{}
+	if err := protocol.Do(ctx, d, CommandGetBrowserContexts, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// CreateTargetParams are the parameters of Target.createTarget.
+//
+// Creates a new page.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Target#method-createTarget
+type CreateTargetParams struct {
+	// The initial URL the page will be navigated to. An empty string indicates
+	// about:blank.
+	URL string `json:"url"`
+	// Frame left origin in DIP (requires newWindow to be true or headless shell).
+	//
+	// This is an experimental part of the protocol.
+	Left int64 `json:"left,omitempty"`
+	// Frame top origin in DIP (requires newWindow to be true or headless shell).
+	//
+	// This is an experimental part of the protocol.
+	Top int64 `json:"top,omitempty"`
+	// Frame width in DIP (requires newWindow to be true or headless shell).
+	Width int64 `json:"width,omitempty"`
+	// Frame height in DIP (requires newWindow to be true or headless shell).
+	Height int64 `json:"height,omitempty"`
+	// Frame window state (requires newWindow to be true or headless shell).
+	// Default is normal.
+	WindowState WindowState `json:"windowState,omitempty"`
+	// The browser context to create the page in.
+	//
+	// This is an experimental part of the protocol.
+	BrowserContextID types.BrowserBrowserContextID `json:"browserContextId,omitempty"`
+	// Whether BeginFrames for this target will be controlled via DevTools
+	// (headless shell only, not supported on MacOS yet, false by default).
+	//
+	// This is an experimental part of the protocol.
+	EnableBeginFrameControl bool `json:"enableBeginFrameControl,omitempty"`
+	// Whether to create a new Window or Tab (false by default, not supported by
+	// headless shell).
+	NewWindow bool `json:"newWindow,omitempty"`
+	// Whether to create the target in background or foreground (false by default,
+	// not supported by headless shell).
+	Background bool `json:"background,omitempty"`
+	// Whether to create the target of type "tab".
+	//
+	// This is an experimental part of the protocol.
+	ForTab bool `json:"forTab,omitempty"`
+	// Whether to create a hidden target. The hidden target is observable via
+	// protocol, but not present in the tab UI strip. Cannot be created with
+	// `forTab: true`, `newWindow: true` or `background: false`. The life-time of
+	// the tab is limited to the life-time of the session.
+	//
+	// This is an experimental part of the protocol.
+	Hidden bool `json:"hidden,omitempty"`
+}
+
+// Command returns the Target.createTarget command for use with
+// AsyncDebugger.Send.
+func (p CreateTargetParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandCreateTarget, p)
+}
+
+// CreateTargetReturns are the values returned by Target.createTarget.
+type CreateTargetReturns struct {
+	// The id of the page opened.
+	TargetID TargetID `json:"targetId"`
+}
+
+// Do sends Target.createTarget to d and waits for its result or for ctx to be
+// done.
+func (p CreateTargetParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*CreateTargetReturns, error) {
+	ret := &CreateTargetReturns{}
+	if err := protocol.Do(ctx, d, CommandCreateTarget, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// DetachFromTargetParams are the parameters of Target.detachFromTarget.
+//
+// Detaches session with given id.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Target#method-detachFromTarget
+type DetachFromTargetParams struct {
+	// Session to detach.
+	SessionID SessionID `json:"sessionId,omitempty"`
+	// Deprecated.
+	//
+	// Deprecated: this is deprecated in the protocol.
+	TargetID TargetID `json:"targetId,omitempty"`
+}
+
+// Command returns the Target.detachFromTarget command for use with
+// AsyncDebugger.Send.
+func (p DetachFromTargetParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandDetachFromTarget, p)
+}
+
+// Do sends Target.detachFromTarget to d and waits for it to complete or for ctx to
+// be done.
+func (p DetachFromTargetParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandDetachFromTarget, p, nil)
+}
+
+// DisposeBrowserContextParams are the parameters of
+// Target.disposeBrowserContext.
+//
+// Deletes a BrowserContext. All the belonging pages will be closed without
+// calling their beforeunload hooks.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Target#method-disposeBrowserContext
+type DisposeBrowserContextParams struct {
+	BrowserContextID types.BrowserBrowserContextID `json:"browserContextId"`
+}
+
+// Command returns the Target.disposeBrowserContext command for use with
+// AsyncDebugger.Send.
+func (p DisposeBrowserContextParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandDisposeBrowserContext, p)
+}
+
+// Do sends Target.disposeBrowserContext to d and waits for it to complete or for ctx to
+// be done.
+func (p DisposeBrowserContextParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandDisposeBrowserContext, p, nil)
+}
+
+// GetTargetInfoParams are the parameters of Target.getTargetInfo.
+//
+// Returns information about a target.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Target#method-getTargetInfo
+//
+// This is an experimental part of the protocol.
+type GetTargetInfoParams struct {
+	TargetID TargetID `json:"targetId,omitempty"`
+}
+
+// Command returns the Target.getTargetInfo command for use with
+// AsyncDebugger.Send.
+func (p GetTargetInfoParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandGetTargetInfo, p)
+}
+
+// GetTargetInfoReturns are the values returned by Target.getTargetInfo.
+type GetTargetInfoReturns struct {
+	TargetInfo TargetInfo `json:"targetInfo"`
+}
+
+// Do sends Target.getTargetInfo to d and waits for its result or for ctx to be
+// done.
+func (p GetTargetInfoParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*GetTargetInfoReturns, error) {
+	ret := &GetTargetInfoReturns{}
+	if err := protocol.Do(ctx, d, CommandGetTargetInfo, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// GetTargetsParams are the parameters of Target.getTargets.
+//
+// Retrieves a list of available targets.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Target#method-getTargets
+type GetTargetsParams struct {
+	// Only targets matching filter will be reported. If filter is not specified
+	// and target discovery is currently enabled, a filter used for target
+	// discovery is used for consistency.
+	//
+	// This is an experimental part of the protocol.
+	Filter TargetFilter `json:"filter,omitempty"`
+}
+
+// Command returns the Target.getTargets command for use with
+// AsyncDebugger.Send.
+func (p GetTargetsParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandGetTargets, p)
+}
+
+// GetTargetsReturns are the values returned by Target.getTargets.
+type GetTargetsReturns struct {
+	// The list of targets.
+	TargetInfos []TargetInfo `json:"targetInfos"`
+}
+
+// Do sends Target.getTargets to d and waits for its result or for ctx to be
+// done.
+func (p GetTargetsParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*GetTargetsReturns, error) {
+	ret := &GetTargetsReturns{}
+	if err := protocol.Do(ctx, d, CommandGetTargets, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// SendMessageToTargetParams are the parameters of Target.sendMessageToTarget.
+//
+// Sends protocol message over session with given id. Consider using flat mode
+// instead; see commands attachToTarget, setAutoAttach, and crbug.com/991325.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Target#method-sendMessageToTarget
+//
+// Deprecated: this is deprecated in the protocol.
+type SendMessageToTargetParams struct {
+	Message string `json:"message"`
+	// Identifier of the session.
+	SessionID SessionID `json:"sessionId,omitempty"`
+	// Deprecated.
+	//
+	// Deprecated: this is deprecated in the protocol.
+	TargetID TargetID `json:"targetId,omitempty"`
+}
+
+// Command returns the Target.sendMessageToTarget command for use with
+// AsyncDebugger.Send.
+func (p SendMessageToTargetParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSendMessageToTarget, p)
+}
+
+// Do sends Target.sendMessageToTarget to d and waits for it to complete or for ctx to
+// be done.
+func (p SendMessageToTargetParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSendMessageToTarget, p, nil)
+}
+
+// SetAutoAttachParams are the parameters of Target.setAutoAttach.
+//
+// Controls whether to automatically attach to new targets which are considered
+// to be directly related to this one (for example, iframes or workers). When
+// turned on, attaches to all existing related targets as well. When turned off,
+// automatically detaches from all currently attached targets. This also clears
+// all targets added by `autoAttachRelated` from the list of targets to watch
+// for creation of related targets. You might want to call this recursively for
+// auto-attached targets to attach to all available targets.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Target#method-setAutoAttach
+type SetAutoAttachParams struct {
+	// Whether to auto-attach to related targets.
+	AutoAttach bool `json:"autoAttach"`
+	// Whether to pause new targets when attaching to them. Use
+	// `Runtime.runIfWaitingForDebugger` to run paused targets.
+	WaitForDebuggerOnStart bool `json:"waitForDebuggerOnStart"`
+	// Enables "flat" access to the session via specifying sessionId attribute in
+	// the commands. We plan to make this the default, deprecate non-flattened
+	// mode, and eventually retire it. See crbug.com/991325.
+	//
+	// This is an experimental part of the protocol.
+	Flatten bool `json:"flatten,omitempty"`
+	// Only targets matching filter will be attached.
+	//
+	// This is an experimental part of the protocol.
+	Filter TargetFilter `json:"filter,omitempty"`
+}
+
+// Command returns the Target.setAutoAttach command for use with
+// AsyncDebugger.Send.
+func (p SetAutoAttachParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetAutoAttach, p)
+}
+
+// Do sends Target.setAutoAttach to d and waits for it to complete or for ctx to
+// be done.
+func (p SetAutoAttachParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetAutoAttach, p, nil)
+}
+
+// AutoAttachRelatedParams are the parameters of Target.autoAttachRelated.
+//
+// Adds the specified target to the list of targets that will be monitored for
+// any related target creation (such as child frames, child workers and new
+// versions of service worker) and reported through `attachedToTarget`. The
+// specified target is also auto-attached. This cancels the effect of any
+// previous `setAutoAttach` and is also cancelled by subsequent `setAutoAttach`.
+// Only available at the Browser target.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Target#method-autoAttachRelated
+//
+// This is an experimental part of the protocol.
+type AutoAttachRelatedParams struct {
+	TargetID TargetID `json:"targetId"`
+	// Whether to pause new targets when attaching to them. Use
+	// `Runtime.runIfWaitingForDebugger` to run paused targets.
+	WaitForDebuggerOnStart bool `json:"waitForDebuggerOnStart"`
+	// Only targets matching filter will be attached.
+	//
+	// This is an experimental part of the protocol.
+	Filter TargetFilter `json:"filter,omitempty"`
+}
+
+// Command returns the Target.autoAttachRelated command for use with
+// AsyncDebugger.Send.
+func (p AutoAttachRelatedParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandAutoAttachRelated, p)
+}
+
+// Do sends Target.autoAttachRelated to d and waits for it to complete or for ctx to
+// be done.
+func (p AutoAttachRelatedParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandAutoAttachRelated, p, nil)
+}
+
+// SetDiscoverTargetsParams are the parameters of Target.setDiscoverTargets.
+//
+// Controls whether to discover available targets and notify via
+// `targetCreated/targetInfoChanged/targetDestroyed` events.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Target#method-setDiscoverTargets
+type SetDiscoverTargetsParams struct {
+	// Whether to discover available targets.
+	Discover bool `json:"discover"`
+	// Only targets matching filter will be attached. If `discover` is false,
+	// `filter` must be omitted or empty.
+	//
+	// This is an experimental part of the protocol.
+	Filter TargetFilter `json:"filter,omitempty"`
+}
+
+// Command returns the Target.setDiscoverTargets command for use with
+// AsyncDebugger.Send.
+func (p SetDiscoverTargetsParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetDiscoverTargets, p)
+}
+
+// Do sends Target.setDiscoverTargets to d and waits for it to complete or for ctx to
+// be done.
+func (p SetDiscoverTargetsParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetDiscoverTargets, p, nil)
+}
+
+// SetRemoteLocationsParams are the parameters of Target.setRemoteLocations.
+//
+// Enables target discovery for the specified locations, when
+// `setDiscoverTargets` was set to `true`.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Target#method-setRemoteLocations
+//
+// This is an experimental part of the protocol.
+type SetRemoteLocationsParams struct {
+	// List of remote locations.
+	Locations []RemoteLocation `json:"locations"`
+}
+
+// Command returns the Target.setRemoteLocations command for use with
+// AsyncDebugger.Send.
+func (p SetRemoteLocationsParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetRemoteLocations, p)
+}
+
+// Do sends Target.setRemoteLocations to d and waits for it to complete or for ctx to
+// be done.
+func (p SetRemoteLocationsParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetRemoteLocations, p, nil)
+}
+
+// OpenDevToolsParams are the parameters of Target.openDevTools.
+//
+// Opens a DevTools window for the target.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Target#method-openDevTools
+//
+// This is an experimental part of the protocol.
+type OpenDevToolsParams struct {
+	// This can be the page or tab target ID.
+	TargetID TargetID `json:"targetId"`
+}
+
+// Command returns the Target.openDevTools command for use with
+// AsyncDebugger.Send.
+func (p OpenDevToolsParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandOpenDevTools, p)
+}
+
+// OpenDevToolsReturns are the values returned by Target.openDevTools.
+type OpenDevToolsReturns struct {
+	// The targetId of DevTools page target.
+	TargetID TargetID `json:"targetId"`
+}
+
+// Do sends Target.openDevTools to d and waits for its result or for ctx to be
+// done.
+func (p OpenDevToolsParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*OpenDevToolsReturns, error) {
+	ret := &OpenDevToolsReturns{}
+	if err := protocol.Do(ctx, d, CommandOpenDevTools, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// Method names of the events in the Target domain.
+const (
+	EventAttachedToTarget          = "Target.attachedToTarget"
+	EventDetachedFromTarget        = "Target.detachedFromTarget"
+	EventReceivedMessageFromTarget = "Target.receivedMessageFromTarget"
+	EventTargetCreated             = "Target.targetCreated"
+	EventTargetDestroyed           = "Target.targetDestroyed"
+	EventTargetCrashed             = "Target.targetCrashed"
+	EventTargetInfoChanged         = "Target.targetInfoChanged"
+)
+
+// AttachedToTargetEvent is the payload of the Target.attachedToTarget event.
+//
+// Issued when attached to target because of auto-attach or `attachToTarget`
+// command.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Target#event-attachedToTarget
+//
+// This is an experimental part of the protocol.
+type AttachedToTargetEvent struct {
+	// Identifier assigned to the session used to send/receive messages.
+	SessionID          SessionID  `json:"sessionId"`
+	TargetInfo         TargetInfo `json:"targetInfo"`
+	WaitingForDebugger bool       `json:"waitingForDebugger"`
+}
+
+// DetachedFromTargetEvent is the payload of the Target.detachedFromTarget
+// event.
+//
+// Issued when detached from target for any reason (including `detachFromTarget`
+// command). Can be issued multiple times per target if multiple sessions have
+// been attached to it.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Target#event-detachedFromTarget
+//
+// This is an experimental part of the protocol.
+type DetachedFromTargetEvent struct {
+	// Detached session identifier.
+	SessionID SessionID `json:"sessionId"`
+	// Deprecated.
+	//
+	// Deprecated: this is deprecated in the protocol.
+	TargetID TargetID `json:"targetId,omitempty"`
+}
+
+// ReceivedMessageFromTargetEvent is the payload of the
+// Target.receivedMessageFromTarget event.
+//
+// Notifies about a new protocol message received from the session (as reported
+// in `attachedToTarget` event).
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Target#event-receivedMessageFromTarget
+type ReceivedMessageFromTargetEvent struct {
+	// Identifier of a session which sends a message.
+	SessionID SessionID `json:"sessionId"`
+	Message   string    `json:"message"`
+	// Deprecated.
+	//
+	// Deprecated: this is deprecated in the protocol.
+	TargetID TargetID `json:"targetId,omitempty"`
+}
+
+// TargetCreatedEvent is the payload of the Target.targetCreated event.
+//
+// Issued when a possible inspection target is created.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Target#event-targetCreated
+type TargetCreatedEvent struct {
+	TargetInfo TargetInfo `json:"targetInfo"`
+}
+
+// TargetDestroyedEvent is the payload of the Target.targetDestroyed event.
+//
+// Issued when a target is destroyed.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Target#event-targetDestroyed
+type TargetDestroyedEvent struct {
+	TargetID TargetID `json:"targetId"`
+}
+
+// TargetCrashedEvent is the payload of the Target.targetCrashed event.
+//
+// Issued when a target has crashed.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Target#event-targetCrashed
+type TargetCrashedEvent struct {
+	TargetID TargetID `json:"targetId"`
+	// Termination status type.
+	Status string `json:"status"`
+	// Termination error code.
+	ErrorCode int64 `json:"errorCode"`
+}
+
+// TargetInfoChangedEvent is the payload of the Target.targetInfoChanged event.
+//
+// Issued when some information about a target has changed. This only happens
+// between `targetCreated` and `targetDestroyed`.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Target#event-targetInfoChanged
+type TargetInfoChangedEvent struct {
+	TargetInfo TargetInfo `json:"targetInfo"`
+}
+
+// ParseEvent decodes an event from the Target domain, as received from
+// Subscribe, into a pointer to its typed payload.
+func ParseEvent(cmd chromedebugo.Command) (interface{}, error) {
+	var ev interface{}
+	switch cmd.Method {
+	case EventAttachedToTarget:
+		ev = &AttachedToTargetEvent{}
+	case EventDetachedFromTarget:
+		ev = &DetachedFromTargetEvent{}
+	case EventReceivedMessageFromTarget:
+		ev = &ReceivedMessageFromTargetEvent{}
+	case EventTargetCreated:
+		ev = &TargetCreatedEvent{}
+	case EventTargetDestroyed:
+		ev = &TargetDestroyedEvent{}
+	case EventTargetCrashed:
+		ev = &TargetCrashedEvent{}
+	case EventTargetInfoChanged:
+		ev = &TargetInfoChangedEvent{}
+	default:
+		return nil, fmt.Errorf("unknown Target event: %s", cmd.Method)
+	}
+	if err := cmd.Decode(ev); err != nil {
+		return nil, err
+	}
+	return ev, nil
+}
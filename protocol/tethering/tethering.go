@@ -0,0 +1,100 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+// Package tethering provides typed bindings for the Tethering domain of the
+// Chrome DevTools protocol.
+//
+// The Tethering domain defines methods and events for browser port binding.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Tethering
+//
+// This is an experimental part of the protocol.
+package tethering
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tonyhb/chromedebugo"
+	"github.com/tonyhb/chromedebugo/protocol"
+)
+
+// Method names of the commands in the Tethering domain.
+const (
+	CommandBind   = "Tethering.bind"
+	CommandUnbind = "Tethering.unbind"
+)
+
+// BindParams are the parameters of Tethering.bind.
+//
+// Request browser port binding.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Tethering#method-bind
+type BindParams struct {
+	// Port number to bind.
+	Port int64 `json:"port"`
+}
+
+// Command returns the Tethering.bind command for use with AsyncDebugger.Send.
+func (p BindParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandBind, p)
+}
+
+// Do sends Tethering.bind to d and waits for it to complete or for ctx to
+// be done.
+func (p BindParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandBind, p, nil)
+}
+
+// UnbindParams are the parameters of Tethering.unbind.
+//
+// Request browser port unbinding.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Tethering#method-unbind
+type UnbindParams struct {
+	// Port number to unbind.
+	Port int64 `json:"port"`
+}
+
+// Command returns the Tethering.unbind command for use with AsyncDebugger.Send.
+func (p UnbindParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandUnbind, p)
+}
+
+// Do sends Tethering.unbind to d and waits for it to complete or for ctx to
+// be done.
+func (p UnbindParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandUnbind, p, nil)
+}
+
+// Method names of the events in the Tethering domain.
+const (
+	EventAccepted = "Tethering.accepted"
+)
+
+// AcceptedEvent is the payload of the Tethering.accepted event.
+//
+// Informs that port was successfully bound and got a specified connection id.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Tethering#event-accepted
+type AcceptedEvent struct {
+	// Port number that was successfully bound.
+	Port int64 `json:"port"`
+	// Connection id to be used.
+	ConnectionID string `json:"connectionId"`
+}
+
+// ParseEvent decodes an event from the Tethering domain, as received from
+// Subscribe, into a pointer to its typed payload.
+func ParseEvent(cmd chromedebugo.Command) (interface{}, error) {
+	var ev interface{}
+	switch cmd.Method {
+	case EventAccepted:
+		ev = &AcceptedEvent{}
+	default:
+		return nil, fmt.Errorf("unknown Tethering event: %s", cmd.Method)
+	}
+	if err := cmd.Decode(ev); err != nil {
+		return nil, err
+	}
+	return ev, nil
+}
@@ -0,0 +1,336 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+// Package tracing provides typed bindings for the Tracing domain of the Chrome
+// DevTools protocol.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Tracing
+package tracing
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tonyhb/chromedebugo"
+	"github.com/tonyhb/chromedebugo/protocol"
+	"github.com/tonyhb/chromedebugo/protocol/internal/types"
+)
+
+// MemoryDumpConfig configuration for memory dump. Used only when "memory-infra"
+// category is enabled.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Tracing#type-MemoryDumpConfig
+//
+// This is an experimental part of the protocol.
+type MemoryDumpConfig = types.TracingMemoryDumpConfig
+
+// TraceConfig is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Tracing#type-TraceConfig
+type TraceConfig = types.TracingTraceConfig
+
+// StreamFormat data format of a trace. Can be either the legacy JSON format or
+// the protocol buffer format. Note that the JSON format will be deprecated
+// soon.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Tracing#type-StreamFormat
+//
+// This is an experimental part of the protocol.
+type StreamFormat = types.TracingStreamFormat
+
+// StreamCompression compression type to use for traces returned via streams.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Tracing#type-StreamCompression
+//
+// This is an experimental part of the protocol.
+type StreamCompression = types.TracingStreamCompression
+
+// MemoryDumpLevelOfDetail details exposed when memory request explicitly
+// declared. Keep consistent with memory_dump_request_args.h and
+// memory_instrumentation.mojom
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Tracing#type-MemoryDumpLevelOfDetail
+//
+// This is an experimental part of the protocol.
+type MemoryDumpLevelOfDetail = types.TracingMemoryDumpLevelOfDetail
+
+// TracingBackend backend type to use for tracing. `chrome` uses the
+// Chrome-integrated tracing service and is supported on all platforms. `system`
+// is only supported on Chrome OS and uses the Perfetto system tracing service.
+// `auto` chooses `system` when the perfettoConfig provided to Tracing.start
+// specifies at least one non-Chrome data source; otherwise uses `chrome`.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Tracing#type-TracingBackend
+//
+// This is an experimental part of the protocol.
+type TracingBackend = types.TracingTracingBackend
+
+// Values of the enumerated types in the Tracing domain.
+const (
+	StreamFormatJSON                  = types.TracingStreamFormatJSON
+	StreamFormatProto                 = types.TracingStreamFormatProto
+	StreamCompressionNone             = types.TracingStreamCompressionNone
+	StreamCompressionGzip             = types.TracingStreamCompressionGzip
+	MemoryDumpLevelOfDetailBackground = types.TracingMemoryDumpLevelOfDetailBackground
+	MemoryDumpLevelOfDetailLight      = types.TracingMemoryDumpLevelOfDetailLight
+	MemoryDumpLevelOfDetailDetailed   = types.TracingMemoryDumpLevelOfDetailDetailed
+	TracingBackendAuto                = types.TracingTracingBackendAuto
+	TracingBackendChrome              = types.TracingTracingBackendChrome
+	TracingBackendSystem              = types.TracingTracingBackendSystem
+)
+
+// Method names of the commands in the Tracing domain.
+const (
+	CommandEnd                   = "Tracing.end"
+	CommandGetCategories         = "Tracing.getCategories"
+	CommandRecordClockSyncMarker = "Tracing.recordClockSyncMarker"
+	CommandRequestMemoryDump     = "Tracing.requestMemoryDump"
+	CommandStart                 = "Tracing.start"
+)
+
+// EndParams are the parameters of Tracing.end.
+//
+// Stop trace events collection.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Tracing#method-end
+type EndParams struct {
+}
+
+// Command returns the Tracing.end command for use with AsyncDebugger.Send.
+func (p EndParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandEnd, p)
+}
+
+// Do sends Tracing.end to d and waits for it to complete or for ctx to
+// be done.
+func (p EndParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandEnd, p, nil)
+}
+
+// GetCategoriesParams are the parameters of Tracing.getCategories.
+//
+// Gets supported tracing categories.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Tracing#method-getCategories
+//
+// This is an experimental part of the protocol.
+type GetCategoriesParams struct {
+}
+
+// Command returns the Tracing.getCategories command for use with
+// AsyncDebugger.Send.
+func (p GetCategoriesParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandGetCategories, p)
+}
+
+// GetCategoriesReturns are the values returned by Tracing.getCategories.
+type GetCategoriesReturns struct {
+	// A list of supported tracing categories.
+	Categories []string `json:"categories"`
+}
+
+// Do sends Tracing.getCategories to d and waits for its result or for ctx to be
+// done.
+func (p GetCategoriesParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*GetCategoriesReturns, error) {
+	ret := &GetCategoriesReturns{}
+	if err := protocol.Do(ctx, d, CommandGetCategories, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// RecordClockSyncMarkerParams are the parameters of
+// Tracing.recordClockSyncMarker.
+//
+// Record a clock sync marker in the trace.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Tracing#method-recordClockSyncMarker
+//
+// This is an experimental part of the protocol.
+type RecordClockSyncMarkerParams struct {
+	// The ID of this clock sync marker
+	SyncID string `json:"syncId"`
+}
+
+// Command returns the Tracing.recordClockSyncMarker command for use with
+// AsyncDebugger.Send.
+func (p RecordClockSyncMarkerParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandRecordClockSyncMarker, p)
+}
+
+// Do sends Tracing.recordClockSyncMarker to d and waits for it to complete or for ctx to
+// be done.
+func (p RecordClockSyncMarkerParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandRecordClockSyncMarker, p, nil)
+}
+
+// RequestMemoryDumpParams are the parameters of Tracing.requestMemoryDump.
+//
+// Request a global memory dump.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Tracing#method-requestMemoryDump
+//
+// This is an experimental part of the protocol.
+type RequestMemoryDumpParams struct {
+	// Enables more deterministic results by forcing garbage collection
+	Deterministic bool `json:"deterministic,omitempty"`
+	// Specifies level of details in memory dump. Defaults to "detailed".
+	LevelOfDetail MemoryDumpLevelOfDetail `json:"levelOfDetail,omitempty"`
+}
+
+// Command returns the Tracing.requestMemoryDump command for use with
+// AsyncDebugger.Send.
+func (p RequestMemoryDumpParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandRequestMemoryDump, p)
+}
+
+// RequestMemoryDumpReturns are the values returned by Tracing.requestMemoryDump.
+type RequestMemoryDumpReturns struct {
+	// GUID of the resulting global memory dump.
+	DumpGuid string `json:"dumpGuid"`
+	// True iff the global memory dump succeeded.
+	Success bool `json:"success"`
+}
+
+// Do sends Tracing.requestMemoryDump to d and waits for its result or for ctx to be
+// done.
+func (p RequestMemoryDumpParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*RequestMemoryDumpReturns, error) {
+	ret := &RequestMemoryDumpReturns{}
+	if err := protocol.Do(ctx, d, CommandRequestMemoryDump, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// StartParams are the parameters of Tracing.start.
+//
+// Start trace events collection.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Tracing#method-start
+type StartParams struct {
+	// Category/tag filter
+	//
+	// This is an experimental part of the protocol.
+	//
+	// Deprecated: this is deprecated in the protocol.
+	Categories string `json:"categories,omitempty"`
+	// Tracing options
+	//
+	// This is an experimental part of the protocol.
+	//
+	// Deprecated: this is deprecated in the protocol.
+	Options string `json:"options,omitempty"`
+	// If set, the agent will issue bufferUsage events at this interval, specified
+	// in milliseconds
+	//
+	// This is an experimental part of the protocol.
+	BufferUsageReportingInterval float64 `json:"bufferUsageReportingInterval,omitempty"`
+	// Whether to report trace events as series of dataCollected events or to save
+	// trace to a stream (defaults to `ReportEvents`).
+	//
+	// Allowed values: ReportEvents, ReturnAsStream.
+	TransferMode string `json:"transferMode,omitempty"`
+	// Trace data format to use. This only applies when using `ReturnAsStream`
+	// transfer mode (defaults to `json`).
+	StreamFormat StreamFormat `json:"streamFormat,omitempty"`
+	// Compression format to use. This only applies when using `ReturnAsStream`
+	// transfer mode (defaults to `none`)
+	//
+	// This is an experimental part of the protocol.
+	StreamCompression StreamCompression `json:"streamCompression,omitempty"`
+	TraceConfig       *TraceConfig      `json:"traceConfig,omitempty"`
+	// Base64-encoded serialized perfetto.protos.TraceConfig protobuf message When
+	// specified, the parameters `categories`, `options`, `traceConfig` are
+	// ignored. (Encoded as a base64 string when passed over JSON)
+	//
+	// This is an experimental part of the protocol.
+	PerfettoConfig string `json:"perfettoConfig,omitempty"`
+	// Backend type (defaults to `auto`)
+	//
+	// This is an experimental part of the protocol.
+	TracingBackend TracingBackend `json:"tracingBackend,omitempty"`
+}
+
+// Command returns the Tracing.start command for use with AsyncDebugger.Send.
+func (p StartParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandStart, p)
+}
+
+// Do sends Tracing.start to d and waits for it to complete or for ctx to
+// be done.
+func (p StartParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandStart, p, nil)
+}
+
+// Method names of the events in the Tracing domain.
+const (
+	EventBufferUsage     = "Tracing.bufferUsage"
+	EventDataCollected   = "Tracing.dataCollected"
+	EventTracingComplete = "Tracing.tracingComplete"
+)
+
+// BufferUsageEvent is the payload of the Tracing.bufferUsage event.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Tracing#event-bufferUsage
+//
+// This is an experimental part of the protocol.
+type BufferUsageEvent struct {
+	// A number in range [0..1] that indicates the used size of event buffer as a
+	// fraction of its total size.
+	PercentFull float64 `json:"percentFull,omitempty"`
+	// An approximate number of events in the trace log.
+	EventCount float64 `json:"eventCount,omitempty"`
+	// A number in range [0..1] that indicates the used size of event buffer as a
+	// fraction of its total size.
+	Value float64 `json:"value,omitempty"`
+}
+
+// DataCollectedEvent is the payload of the Tracing.dataCollected event.
+//
+// Contains a bucket of collected trace events. When tracing is stopped
+// collected events will be sent as a sequence of dataCollected events followed
+// by tracingComplete event.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Tracing#event-dataCollected
+//
+// This is an experimental part of the protocol.
+type DataCollectedEvent struct {
+	Value []map[string]interface{} `json:"value"`
+}
+
+// TracingCompleteEvent is the payload of the Tracing.tracingComplete event.
+//
+// Signals that tracing is stopped and there is no trace buffers pending flush,
+// all data were delivered via dataCollected events.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/Tracing#event-tracingComplete
+type TracingCompleteEvent struct {
+	// Indicates whether some trace data is known to have been lost, e.g. because
+	// the trace ring buffer wrapped around.
+	DataLossOccurred bool `json:"dataLossOccurred"`
+	// A handle of the stream that holds resulting trace data.
+	Stream types.IOStreamHandle `json:"stream,omitempty"`
+	// Trace data format of returned stream.
+	TraceFormat StreamFormat `json:"traceFormat,omitempty"`
+	// Compression format of returned stream.
+	StreamCompression StreamCompression `json:"streamCompression,omitempty"`
+}
+
+// ParseEvent decodes an event from the Tracing domain, as received from
+// Subscribe, into a pointer to its typed payload.
+func ParseEvent(cmd chromedebugo.Command) (interface{}, error) {
+	var ev interface{}
+	switch cmd.Method {
+	case EventBufferUsage:
+		ev = &BufferUsageEvent{}
+	case EventDataCollected:
+		ev = &DataCollectedEvent{}
+	case EventTracingComplete:
+		ev = &TracingCompleteEvent{}
+	default:
+		return nil, fmt.Errorf("unknown Tracing event: %s", cmd.Method)
+	}
+	if err := cmd.Decode(ev); err != nil {
+		return nil, err
+	}
+	return ev, nil
+}
@@ -0,0 +1,382 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+// Package webaudio provides typed bindings for the WebAudio domain of the
+// Chrome DevTools protocol.
+//
+// This domain allows inspection of Web Audio API.
+// https://webaudio.github.io/web-audio-api/
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/WebAudio
+//
+// This is an experimental part of the protocol.
+package webaudio
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tonyhb/chromedebugo"
+	"github.com/tonyhb/chromedebugo/protocol"
+	"github.com/tonyhb/chromedebugo/protocol/internal/types"
+)
+
+// GraphObjectID an unique ID for a graph object (AudioContext, AudioNode,
+// AudioParam) in Web Audio API
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/WebAudio#type-GraphObjectId
+type GraphObjectID = types.WebAudioGraphObjectID
+
+// ContextType enum of BaseAudioContext types
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/WebAudio#type-ContextType
+type ContextType = types.WebAudioContextType
+
+// ContextState enum of AudioContextState from the spec
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/WebAudio#type-ContextState
+type ContextState = types.WebAudioContextState
+
+// NodeType enum of AudioNode types
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/WebAudio#type-NodeType
+type NodeType = types.WebAudioNodeType
+
+// ChannelCountMode enum of AudioNode::ChannelCountMode from the spec
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/WebAudio#type-ChannelCountMode
+type ChannelCountMode = types.WebAudioChannelCountMode
+
+// ChannelInterpretation enum of AudioNode::ChannelInterpretation from the spec
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/WebAudio#type-ChannelInterpretation
+type ChannelInterpretation = types.WebAudioChannelInterpretation
+
+// ParamType enum of AudioParam types
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/WebAudio#type-ParamType
+type ParamType = types.WebAudioParamType
+
+// AutomationRate enum of AudioParam::AutomationRate from the spec
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/WebAudio#type-AutomationRate
+type AutomationRate = types.WebAudioAutomationRate
+
+// ContextRealtimeData fields in AudioContext that change in real-time.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/WebAudio#type-ContextRealtimeData
+type ContextRealtimeData = types.WebAudioContextRealtimeData
+
+// BaseAudioContext protocol object for BaseAudioContext
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/WebAudio#type-BaseAudioContext
+type BaseAudioContext = types.WebAudioBaseAudioContext
+
+// AudioListener protocol object for AudioListener
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/WebAudio#type-AudioListener
+type AudioListener = types.WebAudioAudioListener
+
+// AudioNode protocol object for AudioNode
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/WebAudio#type-AudioNode
+type AudioNode = types.WebAudioAudioNode
+
+// AudioParam protocol object for AudioParam
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/WebAudio#type-AudioParam
+type AudioParam = types.WebAudioAudioParam
+
+// Values of the enumerated types in the WebAudio domain.
+const (
+	ContextTypeRealtime           = types.WebAudioContextTypeRealtime
+	ContextTypeOffline            = types.WebAudioContextTypeOffline
+	ContextStateSuspended         = types.WebAudioContextStateSuspended
+	ContextStateRunning           = types.WebAudioContextStateRunning
+	ContextStateClosed            = types.WebAudioContextStateClosed
+	ContextStateInterrupted       = types.WebAudioContextStateInterrupted
+	ChannelCountModeClampedMax    = types.WebAudioChannelCountModeClampedMax
+	ChannelCountModeExplicit      = types.WebAudioChannelCountModeExplicit
+	ChannelCountModeMax           = types.WebAudioChannelCountModeMax
+	ChannelInterpretationDiscrete = types.WebAudioChannelInterpretationDiscrete
+	ChannelInterpretationSpeakers = types.WebAudioChannelInterpretationSpeakers
+	AutomationRateARate           = types.WebAudioAutomationRateARate
+	AutomationRateKRate           = types.WebAudioAutomationRateKRate
+)
+
+// Method names of the commands in the WebAudio domain.
+const (
+	CommandEnable          = "WebAudio.enable"
+	CommandDisable         = "WebAudio.disable"
+	CommandGetRealtimeData = "WebAudio.getRealtimeData"
+)
+
+// EnableParams are the parameters of WebAudio.enable.
+//
+// Enables the WebAudio domain and starts sending context lifetime events.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/WebAudio#method-enable
+type EnableParams struct {
+}
+
+// Command returns the WebAudio.enable command for use with AsyncDebugger.Send.
+func (p EnableParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandEnable, p)
+}
+
+// Do sends WebAudio.enable to d and waits for it to complete or for ctx to
+// be done.
+func (p EnableParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandEnable, p, nil)
+}
+
+// DisableParams are the parameters of WebAudio.disable.
+//
+// Disables the WebAudio domain.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/WebAudio#method-disable
+type DisableParams struct {
+}
+
+// Command returns the WebAudio.disable command for use with AsyncDebugger.Send.
+func (p DisableParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandDisable, p)
+}
+
+// Do sends WebAudio.disable to d and waits for it to complete or for ctx to
+// be done.
+func (p DisableParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandDisable, p, nil)
+}
+
+// GetRealtimeDataParams are the parameters of WebAudio.getRealtimeData.
+//
+// Fetch the realtime data from the registered contexts.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/WebAudio#method-getRealtimeData
+type GetRealtimeDataParams struct {
+	ContextID GraphObjectID `json:"contextId"`
+}
+
+// Command returns the WebAudio.getRealtimeData command for use with
+// AsyncDebugger.Send.
+func (p GetRealtimeDataParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandGetRealtimeData, p)
+}
+
+// GetRealtimeDataReturns are the values returned by WebAudio.getRealtimeData.
+type GetRealtimeDataReturns struct {
+	RealtimeData ContextRealtimeData `json:"realtimeData"`
+}
+
+// Do sends WebAudio.getRealtimeData to d and waits for its result or for ctx to be
+// done.
+func (p GetRealtimeDataParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*GetRealtimeDataReturns, error) {
+	ret := &GetRealtimeDataReturns{}
+	if err := protocol.Do(ctx, d, CommandGetRealtimeData, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// Method names of the events in the WebAudio domain.
+const (
+	EventContextCreated               = "WebAudio.contextCreated"
+	EventContextWillBeDestroyed       = "WebAudio.contextWillBeDestroyed"
+	EventContextChanged               = "WebAudio.contextChanged"
+	EventAudioListenerCreated         = "WebAudio.audioListenerCreated"
+	EventAudioListenerWillBeDestroyed = "WebAudio.audioListenerWillBeDestroyed"
+	EventAudioNodeCreated             = "WebAudio.audioNodeCreated"
+	EventAudioNodeWillBeDestroyed     = "WebAudio.audioNodeWillBeDestroyed"
+	EventAudioParamCreated            = "WebAudio.audioParamCreated"
+	EventAudioParamWillBeDestroyed    = "WebAudio.audioParamWillBeDestroyed"
+	EventNodesConnected               = "WebAudio.nodesConnected"
+	EventNodesDisconnected            = "WebAudio.nodesDisconnected"
+	EventNodeParamConnected           = "WebAudio.nodeParamConnected"
+	EventNodeParamDisconnected        = "WebAudio.nodeParamDisconnected"
+)
+
+// ContextCreatedEvent is the payload of the WebAudio.contextCreated event.
+//
+// Notifies that a new BaseAudioContext has been created.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/WebAudio#event-contextCreated
+type ContextCreatedEvent struct {
+	Context BaseAudioContext `json:"context"`
+}
+
+// ContextWillBeDestroyedEvent is the payload of the
+// WebAudio.contextWillBeDestroyed event.
+//
+// Notifies that an existing BaseAudioContext will be destroyed.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/WebAudio#event-contextWillBeDestroyed
+type ContextWillBeDestroyedEvent struct {
+	ContextID GraphObjectID `json:"contextId"`
+}
+
+// ContextChangedEvent is the payload of the WebAudio.contextChanged event.
+//
+// Notifies that existing BaseAudioContext has changed some properties (id stays
+// the same)..
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/WebAudio#event-contextChanged
+type ContextChangedEvent struct {
+	Context BaseAudioContext `json:"context"`
+}
+
+// AudioListenerCreatedEvent is the payload of the WebAudio.audioListenerCreated
+// event.
+//
+// Notifies that the construction of an AudioListener has finished.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/WebAudio#event-audioListenerCreated
+type AudioListenerCreatedEvent struct {
+	Listener AudioListener `json:"listener"`
+}
+
+// AudioListenerWillBeDestroyedEvent is the payload of the
+// WebAudio.audioListenerWillBeDestroyed event.
+//
+// Notifies that a new AudioListener has been created.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/WebAudio#event-audioListenerWillBeDestroyed
+type AudioListenerWillBeDestroyedEvent struct {
+	ContextID  GraphObjectID `json:"contextId"`
+	ListenerID GraphObjectID `json:"listenerId"`
+}
+
+// AudioNodeCreatedEvent is the payload of the WebAudio.audioNodeCreated event.
+//
+// Notifies that a new AudioNode has been created.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/WebAudio#event-audioNodeCreated
+type AudioNodeCreatedEvent struct {
+	Node AudioNode `json:"node"`
+}
+
+// AudioNodeWillBeDestroyedEvent is the payload of the
+// WebAudio.audioNodeWillBeDestroyed event.
+//
+// Notifies that an existing AudioNode has been destroyed.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/WebAudio#event-audioNodeWillBeDestroyed
+type AudioNodeWillBeDestroyedEvent struct {
+	ContextID GraphObjectID `json:"contextId"`
+	NodeID    GraphObjectID `json:"nodeId"`
+}
+
+// AudioParamCreatedEvent is the payload of the WebAudio.audioParamCreated
+// event.
+//
+// Notifies that a new AudioParam has been created.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/WebAudio#event-audioParamCreated
+type AudioParamCreatedEvent struct {
+	Param AudioParam `json:"param"`
+}
+
+// AudioParamWillBeDestroyedEvent is the payload of the
+// WebAudio.audioParamWillBeDestroyed event.
+//
+// Notifies that an existing AudioParam has been destroyed.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/WebAudio#event-audioParamWillBeDestroyed
+type AudioParamWillBeDestroyedEvent struct {
+	ContextID GraphObjectID `json:"contextId"`
+	NodeID    GraphObjectID `json:"nodeId"`
+	ParamID   GraphObjectID `json:"paramId"`
+}
+
+// NodesConnectedEvent is the payload of the WebAudio.nodesConnected event.
+//
+// Notifies that two AudioNodes are connected.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/WebAudio#event-nodesConnected
+type NodesConnectedEvent struct {
+	ContextID             GraphObjectID `json:"contextId"`
+	SourceID              GraphObjectID `json:"sourceId"`
+	DestinationID         GraphObjectID `json:"destinationId"`
+	SourceOutputIndex     float64       `json:"sourceOutputIndex,omitempty"`
+	DestinationInputIndex float64       `json:"destinationInputIndex,omitempty"`
+}
+
+// NodesDisconnectedEvent is the payload of the WebAudio.nodesDisconnected
+// event.
+//
+// Notifies that AudioNodes are disconnected. The destination can be null, and
+// it means all the outgoing connections from the source are disconnected.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/WebAudio#event-nodesDisconnected
+type NodesDisconnectedEvent struct {
+	ContextID             GraphObjectID `json:"contextId"`
+	SourceID              GraphObjectID `json:"sourceId"`
+	DestinationID         GraphObjectID `json:"destinationId"`
+	SourceOutputIndex     float64       `json:"sourceOutputIndex,omitempty"`
+	DestinationInputIndex float64       `json:"destinationInputIndex,omitempty"`
+}
+
+// NodeParamConnectedEvent is the payload of the WebAudio.nodeParamConnected
+// event.
+//
+// Notifies that an AudioNode is connected to an AudioParam.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/WebAudio#event-nodeParamConnected
+type NodeParamConnectedEvent struct {
+	ContextID         GraphObjectID `json:"contextId"`
+	SourceID          GraphObjectID `json:"sourceId"`
+	DestinationID     GraphObjectID `json:"destinationId"`
+	SourceOutputIndex float64       `json:"sourceOutputIndex,omitempty"`
+}
+
+// NodeParamDisconnectedEvent is the payload of the
+// WebAudio.nodeParamDisconnected event.
+//
+// Notifies that an AudioNode is disconnected to an AudioParam.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/WebAudio#event-nodeParamDisconnected
+type NodeParamDisconnectedEvent struct {
+	ContextID         GraphObjectID `json:"contextId"`
+	SourceID          GraphObjectID `json:"sourceId"`
+	DestinationID     GraphObjectID `json:"destinationId"`
+	SourceOutputIndex float64       `json:"sourceOutputIndex,omitempty"`
+}
+
+// ParseEvent decodes an event from the WebAudio domain, as received from
+// Subscribe, into a pointer to its typed payload.
+func ParseEvent(cmd chromedebugo.Command) (interface{}, error) {
+	var ev interface{}
+	switch cmd.Method {
+	case EventContextCreated:
+		ev = &ContextCreatedEvent{}
+	case EventContextWillBeDestroyed:
+		ev = &ContextWillBeDestroyedEvent{}
+	case EventContextChanged:
+		ev = &ContextChangedEvent{}
+	case EventAudioListenerCreated:
+		ev = &AudioListenerCreatedEvent{}
+	case EventAudioListenerWillBeDestroyed:
+		ev = &AudioListenerWillBeDestroyedEvent{}
+	case EventAudioNodeCreated:
+		ev = &AudioNodeCreatedEvent{}
+	case EventAudioNodeWillBeDestroyed:
+		ev = &AudioNodeWillBeDestroyedEvent{}
+	case EventAudioParamCreated:
+		ev = &AudioParamCreatedEvent{}
+	case EventAudioParamWillBeDestroyed:
+		ev = &AudioParamWillBeDestroyedEvent{}
+	case EventNodesConnected:
+		ev = &NodesConnectedEvent{}
+	case EventNodesDisconnected:
+		ev = &NodesDisconnectedEvent{}
+	case EventNodeParamConnected:
+		ev = &NodeParamConnectedEvent{}
+	case EventNodeParamDisconnected:
+		ev = &NodeParamDisconnectedEvent{}
+	default:
+		return nil, fmt.Errorf("unknown WebAudio event: %s", cmd.Method)
+	}
+	if err := cmd.Decode(ev); err != nil {
+		return nil, err
+	}
+	return ev, nil
+}
@@ -0,0 +1,485 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+// Package webauthn provides typed bindings for the WebAuthn domain of the
+// Chrome DevTools protocol.
+//
+// This domain allows configuring virtual authenticators to test the WebAuthn
+// API.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/WebAuthn
+//
+// This is an experimental part of the protocol.
+package webauthn
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tonyhb/chromedebugo"
+	"github.com/tonyhb/chromedebugo/protocol"
+	"github.com/tonyhb/chromedebugo/protocol/internal/types"
+)
+
+// AuthenticatorID is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/WebAuthn#type-AuthenticatorId
+type AuthenticatorID = types.WebAuthnAuthenticatorID
+
+// AuthenticatorProtocol is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/WebAuthn#type-AuthenticatorProtocol
+type AuthenticatorProtocol = types.WebAuthnAuthenticatorProtocol
+
+// Ctap2Version is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/WebAuthn#type-Ctap2Version
+type Ctap2Version = types.WebAuthnCtap2Version
+
+// AuthenticatorTransport is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/WebAuthn#type-AuthenticatorTransport
+type AuthenticatorTransport = types.WebAuthnAuthenticatorTransport
+
+// VirtualAuthenticatorOptions is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/WebAuthn#type-VirtualAuthenticatorOptions
+type VirtualAuthenticatorOptions = types.WebAuthnVirtualAuthenticatorOptions
+
+// Credential is a protocol type.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/WebAuthn#type-Credential
+type Credential = types.WebAuthnCredential
+
+// Values of the enumerated types in the WebAuthn domain.
+const (
+	AuthenticatorProtocolU2f       = types.WebAuthnAuthenticatorProtocolU2f
+	AuthenticatorProtocolCtap2     = types.WebAuthnAuthenticatorProtocolCtap2
+	Ctap2VersionCtap20             = types.WebAuthnCtap2VersionCtap20
+	Ctap2VersionCtap21             = types.WebAuthnCtap2VersionCtap21
+	AuthenticatorTransportUsb      = types.WebAuthnAuthenticatorTransportUsb
+	AuthenticatorTransportNfc      = types.WebAuthnAuthenticatorTransportNfc
+	AuthenticatorTransportBle      = types.WebAuthnAuthenticatorTransportBle
+	AuthenticatorTransportCable    = types.WebAuthnAuthenticatorTransportCable
+	AuthenticatorTransportInternal = types.WebAuthnAuthenticatorTransportInternal
+)
+
+// Method names of the commands in the WebAuthn domain.
+const (
+	CommandEnable                         = "WebAuthn.enable"
+	CommandDisable                        = "WebAuthn.disable"
+	CommandAddVirtualAuthenticator        = "WebAuthn.addVirtualAuthenticator"
+	CommandSetResponseOverrideBits        = "WebAuthn.setResponseOverrideBits"
+	CommandRemoveVirtualAuthenticator     = "WebAuthn.removeVirtualAuthenticator"
+	CommandAddCredential                  = "WebAuthn.addCredential"
+	CommandGetCredential                  = "WebAuthn.getCredential"
+	CommandGetCredentials                 = "WebAuthn.getCredentials"
+	CommandRemoveCredential               = "WebAuthn.removeCredential"
+	CommandClearCredentials               = "WebAuthn.clearCredentials"
+	CommandSetUserVerified                = "WebAuthn.setUserVerified"
+	CommandSetAutomaticPresenceSimulation = "WebAuthn.setAutomaticPresenceSimulation"
+	CommandSetCredentialProperties        = "WebAuthn.setCredentialProperties"
+)
+
+// EnableParams are the parameters of WebAuthn.enable.
+//
+// Enable the WebAuthn domain and start intercepting credential storage and
+// retrieval with a virtual authenticator.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/WebAuthn#method-enable
+type EnableParams struct {
+	// Whether to enable the WebAuthn user interface. Enabling the UI is
+	// recommended for debugging and demo purposes, as it is closer to the real
+	// experience. Disabling the UI is recommended for automated testing. Supported
+	// at the embedder's discretion if UI is available. Defaults to false.
+	EnableUI bool `json:"enableUI,omitempty"`
+}
+
+// Command returns the WebAuthn.enable command for use with AsyncDebugger.Send.
+func (p EnableParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandEnable, p)
+}
+
+// Do sends WebAuthn.enable to d and waits for it to complete or for ctx to
+// be done.
+func (p EnableParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandEnable, p, nil)
+}
+
+// DisableParams are the parameters of WebAuthn.disable.
+//
+// Disable the WebAuthn domain.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/WebAuthn#method-disable
+type DisableParams struct {
+}
+
+// Command returns the WebAuthn.disable command for use with AsyncDebugger.Send.
+func (p DisableParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandDisable, p)
+}
+
+// Do sends WebAuthn.disable to d and waits for it to complete or for ctx to
+// be done.
+func (p DisableParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandDisable, p, nil)
+}
+
+// AddVirtualAuthenticatorParams are the parameters of
+// WebAuthn.addVirtualAuthenticator.
+//
+// Creates and adds a virtual authenticator.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/WebAuthn#method-addVirtualAuthenticator
+type AddVirtualAuthenticatorParams struct {
+	Options VirtualAuthenticatorOptions `json:"options"`
+}
+
+// Command returns the WebAuthn.addVirtualAuthenticator command for use with
+// AsyncDebugger.Send.
+func (p AddVirtualAuthenticatorParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandAddVirtualAuthenticator, p)
+}
+
+// AddVirtualAuthenticatorReturns are the values returned by WebAuthn.addVirtualAuthenticator.
+type AddVirtualAuthenticatorReturns struct {
+	AuthenticatorID AuthenticatorID `json:"authenticatorId"`
+}
+
+// Do sends WebAuthn.addVirtualAuthenticator to d and waits for its result or for ctx to be
+// done.
+func (p AddVirtualAuthenticatorParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*AddVirtualAuthenticatorReturns, error) {
+	ret := &AddVirtualAuthenticatorReturns{}
+	if err := protocol.Do(ctx, d, CommandAddVirtualAuthenticator, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// SetResponseOverrideBitsParams are the parameters of
+// WebAuthn.setResponseOverrideBits.
+//
+// Resets parameters isBogusSignature, isBadUV, isBadUP to false if they are not
+// present.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/WebAuthn#method-setResponseOverrideBits
+type SetResponseOverrideBitsParams struct {
+	AuthenticatorID AuthenticatorID `json:"authenticatorId"`
+	// If isBogusSignature is set, overrides the signature in the authenticator
+	// response to be zero. Defaults to false.
+	IsBogusSignature bool `json:"isBogusSignature,omitempty"`
+	// If isBadUV is set, overrides the UV bit in the flags in the authenticator
+	// response to be zero. Defaults to false.
+	IsBadUV bool `json:"isBadUV,omitempty"`
+	// If isBadUP is set, overrides the UP bit in the flags in the authenticator
+	// response to be zero. Defaults to false.
+	IsBadUP bool `json:"isBadUP,omitempty"`
+}
+
+// Command returns the WebAuthn.setResponseOverrideBits command for use with
+// AsyncDebugger.Send.
+func (p SetResponseOverrideBitsParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetResponseOverrideBits, p)
+}
+
+// Do sends WebAuthn.setResponseOverrideBits to d and waits for it to complete or for ctx to
+// be done.
+func (p SetResponseOverrideBitsParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetResponseOverrideBits, p, nil)
+}
+
+// RemoveVirtualAuthenticatorParams are the parameters of
+// WebAuthn.removeVirtualAuthenticator.
+//
+// Removes the given authenticator.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/WebAuthn#method-removeVirtualAuthenticator
+type RemoveVirtualAuthenticatorParams struct {
+	AuthenticatorID AuthenticatorID `json:"authenticatorId"`
+}
+
+// Command returns the WebAuthn.removeVirtualAuthenticator command for use with
+// AsyncDebugger.Send.
+func (p RemoveVirtualAuthenticatorParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandRemoveVirtualAuthenticator, p)
+}
+
+// Do sends WebAuthn.removeVirtualAuthenticator to d and waits for it to complete or for ctx to
+// be done.
+func (p RemoveVirtualAuthenticatorParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandRemoveVirtualAuthenticator, p, nil)
+}
+
+// AddCredentialParams are the parameters of WebAuthn.addCredential.
+//
+// Adds the credential to the specified authenticator.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/WebAuthn#method-addCredential
+type AddCredentialParams struct {
+	AuthenticatorID AuthenticatorID `json:"authenticatorId"`
+	Credential      Credential      `json:"credential"`
+}
+
+// Command returns the WebAuthn.addCredential command for use with
+// AsyncDebugger.Send.
+func (p AddCredentialParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandAddCredential, p)
+}
+
+// Do sends WebAuthn.addCredential to d and waits for it to complete or for ctx to
+// be done.
+func (p AddCredentialParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandAddCredential, p, nil)
+}
+
+// GetCredentialParams are the parameters of WebAuthn.getCredential.
+//
+// Returns a single credential stored in the given virtual authenticator that
+// matches the credential ID.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/WebAuthn#method-getCredential
+type GetCredentialParams struct {
+	AuthenticatorID AuthenticatorID `json:"authenticatorId"`
+	CredentialID    string          `json:"credentialId"`
+}
+
+// Command returns the WebAuthn.getCredential command for use with
+// AsyncDebugger.Send.
+func (p GetCredentialParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandGetCredential, p)
+}
+
+// GetCredentialReturns are the values returned by WebAuthn.getCredential.
+type GetCredentialReturns struct {
+	Credential Credential `json:"credential"`
+}
+
+// Do sends WebAuthn.getCredential to d and waits for its result or for ctx to be
+// done.
+func (p GetCredentialParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*GetCredentialReturns, error) {
+	ret := &GetCredentialReturns{}
+	if err := protocol.Do(ctx, d, CommandGetCredential, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// GetCredentialsParams are the parameters of WebAuthn.getCredentials.
+//
+// Returns all the credentials stored in the given virtual authenticator.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/WebAuthn#method-getCredentials
+type GetCredentialsParams struct {
+	AuthenticatorID AuthenticatorID `json:"authenticatorId"`
+}
+
+// Command returns the WebAuthn.getCredentials command for use with
+// AsyncDebugger.Send.
+func (p GetCredentialsParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandGetCredentials, p)
+}
+
+// GetCredentialsReturns are the values returned by WebAuthn.getCredentials.
+type GetCredentialsReturns struct {
+	Credentials []Credential `json:"credentials"`
+}
+
+// Do sends WebAuthn.getCredentials to d and waits for its result or for ctx to be
+// done.
+func (p GetCredentialsParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) (*GetCredentialsReturns, error) {
+	ret := &GetCredentialsReturns{}
+	if err := protocol.Do(ctx, d, CommandGetCredentials, p, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// RemoveCredentialParams are the parameters of WebAuthn.removeCredential.
+//
+// Removes a credential from the authenticator.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/WebAuthn#method-removeCredential
+type RemoveCredentialParams struct {
+	AuthenticatorID AuthenticatorID `json:"authenticatorId"`
+	CredentialID    string          `json:"credentialId"`
+}
+
+// Command returns the WebAuthn.removeCredential command for use with
+// AsyncDebugger.Send.
+func (p RemoveCredentialParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandRemoveCredential, p)
+}
+
+// Do sends WebAuthn.removeCredential to d and waits for it to complete or for ctx to
+// be done.
+func (p RemoveCredentialParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandRemoveCredential, p, nil)
+}
+
+// ClearCredentialsParams are the parameters of WebAuthn.clearCredentials.
+//
+// Clears all the credentials from the specified device.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/WebAuthn#method-clearCredentials
+type ClearCredentialsParams struct {
+	AuthenticatorID AuthenticatorID `json:"authenticatorId"`
+}
+
+// Command returns the WebAuthn.clearCredentials command for use with
+// AsyncDebugger.Send.
+func (p ClearCredentialsParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandClearCredentials, p)
+}
+
+// Do sends WebAuthn.clearCredentials to d and waits for it to complete or for ctx to
+// be done.
+func (p ClearCredentialsParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandClearCredentials, p, nil)
+}
+
+// SetUserVerifiedParams are the parameters of WebAuthn.setUserVerified.
+//
+// Sets whether User Verification succeeds or fails for an authenticator. The
+// default is true.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/WebAuthn#method-setUserVerified
+type SetUserVerifiedParams struct {
+	AuthenticatorID AuthenticatorID `json:"authenticatorId"`
+	IsUserVerified  bool            `json:"isUserVerified"`
+}
+
+// Command returns the WebAuthn.setUserVerified command for use with
+// AsyncDebugger.Send.
+func (p SetUserVerifiedParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetUserVerified, p)
+}
+
+// Do sends WebAuthn.setUserVerified to d and waits for it to complete or for ctx to
+// be done.
+func (p SetUserVerifiedParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetUserVerified, p, nil)
+}
+
+// SetAutomaticPresenceSimulationParams are the parameters of
+// WebAuthn.setAutomaticPresenceSimulation.
+//
+// Sets whether tests of user presence will succeed immediately (if true) or
+// fail to resolve (if false) for an authenticator. The default is true.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/WebAuthn#method-setAutomaticPresenceSimulation
+type SetAutomaticPresenceSimulationParams struct {
+	AuthenticatorID AuthenticatorID `json:"authenticatorId"`
+	Enabled         bool            `json:"enabled"`
+}
+
+// Command returns the WebAuthn.setAutomaticPresenceSimulation command for use
+// with AsyncDebugger.Send.
+func (p SetAutomaticPresenceSimulationParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetAutomaticPresenceSimulation, p)
+}
+
+// Do sends WebAuthn.setAutomaticPresenceSimulation to d and waits for it to complete or for ctx to
+// be done.
+func (p SetAutomaticPresenceSimulationParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetAutomaticPresenceSimulation, p, nil)
+}
+
+// SetCredentialPropertiesParams are the parameters of
+// WebAuthn.setCredentialProperties.
+//
+// Allows setting credential properties.
+// https://w3c.github.io/webauthn/#sctn-automation-set-credential-properties
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/WebAuthn#method-setCredentialProperties
+type SetCredentialPropertiesParams struct {
+	AuthenticatorID   AuthenticatorID `json:"authenticatorId"`
+	CredentialID      string          `json:"credentialId"`
+	BackupEligibility bool            `json:"backupEligibility,omitempty"`
+	BackupState       bool            `json:"backupState,omitempty"`
+}
+
+// Command returns the WebAuthn.setCredentialProperties command for use with
+// AsyncDebugger.Send.
+func (p SetCredentialPropertiesParams) Command() (chromedebugo.Command, error) {
+	return chromedebugo.NewCommand(CommandSetCredentialProperties, p)
+}
+
+// Do sends WebAuthn.setCredentialProperties to d and waits for it to complete or for ctx to
+// be done.
+func (p SetCredentialPropertiesParams) Do(ctx context.Context, d chromedebugo.SyncDebugger) error {
+	return protocol.Do(ctx, d, CommandSetCredentialProperties, p, nil)
+}
+
+// Method names of the events in the WebAuthn domain.
+const (
+	EventCredentialAdded    = "WebAuthn.credentialAdded"
+	EventCredentialDeleted  = "WebAuthn.credentialDeleted"
+	EventCredentialUpdated  = "WebAuthn.credentialUpdated"
+	EventCredentialAsserted = "WebAuthn.credentialAsserted"
+)
+
+// CredentialAddedEvent is the payload of the WebAuthn.credentialAdded event.
+//
+// Triggered when a credential is added to an authenticator.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/WebAuthn#event-credentialAdded
+type CredentialAddedEvent struct {
+	AuthenticatorID AuthenticatorID `json:"authenticatorId"`
+	Credential      Credential      `json:"credential"`
+}
+
+// CredentialDeletedEvent is the payload of the WebAuthn.credentialDeleted
+// event.
+//
+// Triggered when a credential is deleted, e.g. through
+// PublicKeyCredential.signalUnknownCredential().
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/WebAuthn#event-credentialDeleted
+type CredentialDeletedEvent struct {
+	AuthenticatorID AuthenticatorID `json:"authenticatorId"`
+	CredentialID    string          `json:"credentialId"`
+}
+
+// CredentialUpdatedEvent is the payload of the WebAuthn.credentialUpdated
+// event.
+//
+// Triggered when a credential is updated, e.g. through
+// PublicKeyCredential.signalCurrentUserDetails().
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/WebAuthn#event-credentialUpdated
+type CredentialUpdatedEvent struct {
+	AuthenticatorID AuthenticatorID `json:"authenticatorId"`
+	Credential      Credential      `json:"credential"`
+}
+
+// CredentialAssertedEvent is the payload of the WebAuthn.credentialAsserted
+// event.
+//
+// Triggered when a credential is used in a webauthn assertion.
+//
+// See: https://chromedevtools.github.io/devtools-protocol/tot/WebAuthn#event-credentialAsserted
+type CredentialAssertedEvent struct {
+	AuthenticatorID AuthenticatorID `json:"authenticatorId"`
+	Credential      Credential      `json:"credential"`
+}
+
+// ParseEvent decodes an event from the WebAuthn domain, as received from
+// Subscribe, into a pointer to its typed payload.
+func ParseEvent(cmd chromedebugo.Command) (interface{}, error) {
+	var ev interface{}
+	switch cmd.Method {
+	case EventCredentialAdded:
+		ev = &CredentialAddedEvent{}
+	case EventCredentialDeleted:
+		ev = &CredentialDeletedEvent{}
+	case EventCredentialUpdated:
+		ev = &CredentialUpdatedEvent{}
+	case EventCredentialAsserted:
+		ev = &CredentialAssertedEvent{}
+	default:
+		return nil, fmt.Errorf("unknown WebAuthn event: %s", cmd.Method)
+	}
+	if err := cmd.Decode(ev); err != nil {
+		return nil, err
+	}
+	return ev, nil
+}
@@ -0,0 +1,442 @@
+package chromedebugo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Connection state events are published to the subscribers of a
+// reconnecting debugger as its connection to chrome changes.  Their params
+// hold the "attempt" number, counting from 1 for each outage, and for
+// EventConnectionLost the "error" which ended the connection.
+const (
+	EventConnecting     = "Connection.connecting"
+	EventConnected      = "Connection.connected"
+	EventConnectionLost = "Connection.lost"
+)
+
+// InFlightPolicy decides what a reconnecting debugger does with a command
+// whose connection is lost before chrome responds.
+type InFlightPolicy int
+
+const (
+	// FailInFlight returns the ErrConnectionClosed to the sender.  This is
+	// the default, as chrome may have run the command before the
+	// connection was lost.
+	FailInFlight InFlightPolicy = iota
+	// RetryInFlight waits for the next connection and sends the command
+	// again.  It suits idempotent commands such as DOM.getDocument.
+	RetryInFlight
+)
+
+// Defaults for the delay between reconnection attempts, which doubles after
+// each failed attempt.
+const (
+	DefaultReconnectDelay    = 100 * time.Millisecond
+	DefaultMaxReconnectDelay = 10 * time.Second
+)
+
+// DefaultSetupTimeout is how long the setup commands of each connection may
+// take before the attempt fails.
+const DefaultSetupTimeout = 10 * time.Second
+
+type reconnectConfig struct {
+	delay        time.Duration
+	maxDelay     time.Duration
+	maxAttempts  int
+	setup        []Command
+	setupTimeout time.Duration
+	// policies holds the in-flight policy for each method pattern, checked
+	// in the order given
+	policies []methodPolicy
+	opts     []Option
+}
+
+type methodPolicy struct {
+	pattern string
+	policy  InFlightPolicy
+}
+
+// ReconnectOption configures a debugger created with NewReconnectingSync.
+type ReconnectOption func(*reconnectConfig)
+
+// WithBackoff sets the delay before the first reconnection attempt, which
+// doubles after each failed attempt up to max.
+func WithBackoff(initial, max time.Duration) ReconnectOption {
+	return func(c *reconnectConfig) {
+		c.delay = initial
+		c.maxDelay = max
+	}
+}
+
+// WithMaxAttempts gives up reconnecting, closing the debugger, after n
+// failed attempts in a row.  By default it never gives up.
+func WithMaxAttempts(n int) ReconnectOption {
+	return func(c *reconnectConfig) {
+		c.maxAttempts = n
+	}
+}
+
+// WithSetup sends commands, in order, on every connection before it is used,
+// eg. to enable the domains whose events are subscribed to.  A connection
+// on which a setup command fails counts as a failed attempt.
+func WithSetup(cmds ...Command) ReconnectOption {
+	return func(c *reconnectConfig) {
+		c.setup = append(c.setup, cmds...)
+	}
+}
+
+// WithSetupTimeout sets how long the setup commands of each connection may
+// take, DefaultSetupTimeout by default.  A connection whose setup takes
+// longer counts as a failed attempt.
+func WithSetupTimeout(d time.Duration) ReconnectOption {
+	return func(c *reconnectConfig) {
+		c.setupTimeout = d
+	}
+}
+
+// WithInFlightPolicy sets the policy for commands matching method, which may
+// be a method name or a prefix ending in "*" such as "DOM.*".  The first
+// matching policy given applies.
+func WithInFlightPolicy(method string, policy InFlightPolicy) ReconnectOption {
+	return func(c *reconnectConfig) {
+		c.policies = append(c.policies, methodPolicy{pattern: method, policy: policy})
+	}
+}
+
+// WithDebuggerOptions sets the options for the debugger made on each
+// connection.
+func WithDebuggerOptions(opts ...Option) ReconnectOption {
+	return func(c *reconnectConfig) {
+		c.opts = append(c.opts, opts...)
+	}
+}
+
+// reconnectingDebugger is a sync debugger which survives its connection to
+// chrome.  When the connection is lost it rediscovers the target from host,
+// as NewSync does, and redials with exponential backoff until it succeeds,
+// gives up or is closed.
+//
+// Subscriptions are made on the reconnecting debugger itself, so they last
+// across connections and also receive the connection state events.  The
+// response taps belong to the current connection and are closed with it.
+type reconnectingDebugger struct {
+	host   string
+	config reconnectConfig
+
+	// lock guards current and reconnected, which is closed and replaced
+	// each time a new connection replaces current
+	lock        sync.Mutex
+	current     *syncDebugger
+	reconnected chan struct{}
+
+	events  *eventHub
+	cmdChan chan Command
+
+	// done is closed once the debugger is closed or has given up
+	// reconnecting, after which err holds an ErrConnectionClosed
+	done      chan struct{}
+	err       error
+	closeOnce sync.Once
+}
+
+// NewReconnectingSync connects a sync debugger to chrome which reconnects
+// whenever the connection is lost.  The host is as for NewSync, and the
+// first connection must succeed.
+func NewReconnectingSync(host string, opts ...ReconnectOption) (*reconnectingDebugger, error) {
+	config := reconnectConfig{
+		delay:        DefaultReconnectDelay,
+		maxDelay:     DefaultMaxReconnectDelay,
+		setupTimeout: DefaultSetupTimeout,
+	}
+	for _, opt := range opts {
+		opt(&config)
+	}
+
+	events := newEventHub()
+	all, _ := events.subscribe("*")
+	r := &reconnectingDebugger{
+		host:        host,
+		config:      config,
+		reconnected: make(chan struct{}),
+		events:      events,
+		cmdChan:     all.ch,
+		done:        make(chan struct{}),
+	}
+
+	sd, err := r.connect()
+	if err != nil {
+		return nil, err
+	}
+	r.current = sd
+	go r.supervise()
+	return r, nil
+}
+
+// connect makes a new connection, forwards its events and runs the setup
+// commands on it.
+func (r *reconnectingDebugger) connect() (*syncDebugger, error) {
+	sd, err := NewSync(r.host, r.config.opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	// events are forwarded to our own subscribers, which apply their own
+	// overflow policies
+	ch, _ := sd.Subscribe("*", WithOverflow(Block))
+	go func() {
+		for cmd := range ch {
+			r.events.publish(cmd)
+		}
+	}()
+
+	if len(r.config.setup) == 0 {
+		return sd, nil
+	}
+	// a connection which chrome never answers must not stall reconnecting
+	ctx, cancel := context.WithTimeout(context.Background(), r.config.setupTimeout)
+	defer cancel()
+	resps, err := sd.BatchContext(ctx, r.config.setup)
+	if err != nil {
+		sd.Close()
+		return nil, fmt.Errorf("error running setup commands: %w", err)
+	}
+	for i, resp := range resps {
+		if e, ok := resp.(Error); ok {
+			sd.Close()
+			return nil, fmt.Errorf("error running setup command %s: %s", r.config.setup[i].Method, e)
+		}
+	}
+	return sd, nil
+}
+
+// supervise waits for each connection to be lost and replaces it.
+func (r *reconnectingDebugger) supervise() {
+	for {
+		current, _ := r.connection()
+		select {
+		case <-r.done:
+			return
+		case <-current.Done():
+		}
+		r.state(EventConnectionLost, 0, current.Err())
+
+		sd, attempt, err := r.redial()
+		if err != nil {
+			r.shutdown(err)
+			return
+		}
+
+		r.lock.Lock()
+		select {
+		case <-r.done:
+			// closed while dialing
+			r.lock.Unlock()
+			sd.Close()
+			return
+		default:
+		}
+		r.current = sd
+		close(r.reconnected)
+		r.reconnected = make(chan struct{})
+		r.lock.Unlock()
+
+		r.state(EventConnected, attempt, nil)
+	}
+}
+
+// redial connects again with exponential backoff.
+func (r *reconnectingDebugger) redial() (*syncDebugger, int, error) {
+	delay := r.config.delay
+	var err error
+	for attempt := 1; r.config.maxAttempts <= 0 || attempt <= r.config.maxAttempts; attempt++ {
+		r.state(EventConnecting, attempt, nil)
+
+		select {
+		case <-time.After(delay):
+		case <-r.done:
+			return nil, attempt, r.err
+		}
+		delay *= 2
+		if delay > r.config.maxDelay {
+			delay = r.config.maxDelay
+		}
+
+		var sd *syncDebugger
+		if sd, err = r.connect(); err == nil {
+			return sd, attempt, nil
+		}
+	}
+	return nil, r.config.maxAttempts, fmt.Errorf("error reconnecting after %d attempts: %s", r.config.maxAttempts, err)
+}
+
+// state publishes a connection state event.
+func (r *reconnectingDebugger) state(method string, attempt int, err error) {
+	params := map[string]interface{}{}
+	if attempt > 0 {
+		params["attempt"] = attempt
+	}
+	if err != nil {
+		params["error"] = err.Error()
+	}
+	r.events.publish(Command{Method: method, Params: params})
+}
+
+// connection returns the current connection and a channel closed once it
+// has been replaced.
+func (r *reconnectingDebugger) connection() (*syncDebugger, chan struct{}) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return r.current, r.reconnected
+}
+
+// policy returns the in-flight policy for a method.
+func (r *reconnectingDebugger) policy(method string) InFlightPolicy {
+	for _, p := range r.config.policies {
+		if matchMethod(p.pattern, method) {
+			return p.policy
+		}
+	}
+	return FailInFlight
+}
+
+// retry reports whether to resend after err, waiting for the next connection
+// if so.
+func (r *reconnectingDebugger) retry(ctx context.Context, err error, reconnected chan struct{}, methods ...string) (bool, error) {
+	if !errors.As(err, &ErrConnectionClosed{}) {
+		return false, err
+	}
+	for _, method := range methods {
+		if r.policy(method) != RetryInFlight {
+			return false, err
+		}
+	}
+	select {
+	case <-reconnected:
+		return true, nil
+	case <-ctx.Done():
+		return false, ctx.Err()
+	case <-r.done:
+		return false, r.err
+	}
+}
+
+func (r *reconnectingDebugger) shutdown(cause error) {
+	r.closeOnce.Do(func() {
+		r.lock.Lock()
+		r.err = ErrConnectionClosed{Cause: cause}
+		close(r.done)
+		r.lock.Unlock()
+		r.events.close()
+	})
+}
+
+// Version returns the chrome version inforamation from /json/version
+func (r *reconnectingDebugger) Version() (Version, error) {
+	current, _ := r.connection()
+	return current.Version()
+}
+
+// Info returns a slice of browser contexts from /json/list
+func (r *reconnectingDebugger) Info() ([]Info, error) {
+	current, _ := r.connection()
+	return current.Info()
+}
+
+// Close stops reconnecting and closes the current connection.
+func (r *reconnectingDebugger) Close() error {
+	r.shutdown(nil)
+	current, _ := r.connection()
+	return current.Close()
+}
+
+// Done returns a channel which is closed once the debugger has been closed or
+// has given up reconnecting.  Losing a connection does not close it.
+func (r *reconnectingDebugger) Done() <-chan struct{} {
+	return r.done
+}
+
+// Err returns nil until Done is closed and an ErrConnectionClosed holding the
+// reason afterwards.
+func (r *reconnectingDebugger) Err() error {
+	select {
+	case <-r.done:
+		return r.err
+	default:
+		return nil
+	}
+}
+
+func (r *reconnectingDebugger) Send(cmd Command) (Result, error) {
+	return r.SendContext(context.Background(), cmd)
+}
+
+// SendContext sends cmd on the current connection.  While reconnecting,
+// commands fail with ErrConnectionClosed unless their InFlightPolicy is
+// RetryInFlight, in which case they wait for the next connection.
+func (r *reconnectingDebugger) SendContext(ctx context.Context, cmd Command) (Result, error) {
+	for {
+		current, reconnected := r.connection()
+		res, err := current.SendContext(ctx, cmd)
+		if err == nil {
+			return res, nil
+		}
+		if retry, err := r.retry(ctx, err, reconnected, cmd.Method); !retry {
+			return Result{}, err
+		}
+	}
+}
+
+func (r *reconnectingDebugger) Batch(commands []Command) ([]interface{}, error) {
+	return r.BatchContext(context.Background(), commands)
+}
+
+// BatchContext sends commands on the current connection.  A batch whose
+// connection is lost is only sent again if every command in it has the
+// RetryInFlight policy.
+func (r *reconnectingDebugger) BatchContext(ctx context.Context, commands []Command) ([]interface{}, error) {
+	methods := make([]string, len(commands))
+	for i, cmd := range commands {
+		methods[i] = cmd.Method
+	}
+	for {
+		current, reconnected := r.connection()
+		resps, err := current.BatchContext(ctx, commands)
+		if err == nil {
+			return resps, nil
+		}
+		if retry, err := r.retry(ctx, err, reconnected, methods...); !retry {
+			return resps, err
+		}
+	}
+}
+
+// Subscribe is as for the other debuggers, but the subscription lasts across
+// connections and matches the connection state events too.
+func (r *reconnectingDebugger) Subscribe(method string, opts ...SubscribeOption) (<-chan Command, func()) {
+	sub, unsubscribe := r.events.subscribe(method, opts...)
+	return sub.ch, unsubscribe
+}
+
+func (r *reconnectingDebugger) On(method string, handler func(Command), opts ...SubscribeOption) func() {
+	return r.events.on(method, handler, opts)
+}
+
+// ErrorChan returns the current connection's error tap.
+func (r *reconnectingDebugger) ErrorChan() chan Error {
+	current, _ := r.connection()
+	return current.ErrorChan()
+}
+
+// ResultChan returns the current connection's result tap.
+func (r *reconnectingDebugger) ResultChan() chan Result {
+	current, _ := r.connection()
+	return current.ResultChan()
+}
+
+func (r *reconnectingDebugger) CommandChan() chan Command {
+	return r.cmdChan
+}
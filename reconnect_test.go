@@ -0,0 +1,216 @@
+package chromedebugo_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/tonyhb/chromedebugo"
+	"github.com/tonyhb/chromedebugo/cdptest"
+)
+
+// nextEvent returns the next event from events, failing the test if none
+// arrives.
+func nextEvent(t *testing.T, events <-chan chromedebugo.Command) chromedebugo.Command {
+	t.Helper()
+	select {
+	case ev, ok := <-events:
+		if !ok {
+			t.Fatal("subscription closed")
+		}
+		return ev
+	case <-time.After(5 * time.Second):
+		t.Fatal("no event received")
+	}
+	return chromedebugo.Command{}
+}
+
+func TestReconnect(t *testing.T) {
+	srv := cdptest.NewServer()
+	defer srv.Close()
+	srv.Handle("Page.enable", cdptest.Result(nil))
+	srv.Handle("Runtime.evaluate", cdptest.Result(map[string]interface{}{"ok": true}))
+
+	setup, _ := chromedebugo.NewCommand("Page.enable", nil)
+	d, err := chromedebugo.NewReconnectingSync(srv.URL(),
+		chromedebugo.WithBackoff(time.Millisecond, 10*time.Millisecond),
+		chromedebugo.WithSetup(setup),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer d.Close()
+	states, unsubscribe := d.Subscribe("Connection.*", chromedebugo.WithBuffer(16))
+	defer unsubscribe()
+	pages, unsubscribe := d.Subscribe("Page.*")
+	defer unsubscribe()
+
+	srv.Disconnect()
+	if ev := nextEvent(t, states); ev.Method != chromedebugo.EventConnectionLost {
+		t.Fatalf("got %s, want %s", ev.Method, chromedebugo.EventConnectionLost)
+	}
+	if ev := nextEvent(t, states); ev.Method != chromedebugo.EventConnecting || ev.Params["attempt"] != 1 {
+		t.Fatalf("got %+v, want the first %s", ev, chromedebugo.EventConnecting)
+	}
+	if ev := nextEvent(t, states); ev.Method != chromedebugo.EventConnected {
+		t.Fatalf("got %s, want %s", ev.Method, chromedebugo.EventConnected)
+	}
+
+	if n := len(srv.Received("Page.enable")); n != 2 {
+		t.Errorf("setup sent %d times, want once per connection", n)
+	}
+	res, err := d.Send(chromedebugo.Command{Method: "Runtime.evaluate"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Result["ok"] != true {
+		t.Errorf("got result %v", res.Result)
+	}
+	// subscriptions last across connections
+	srv.Emit("Page.loadEventFired", nil)
+	if ev := nextEvent(t, pages); ev.Method != "Page.loadEventFired" {
+		t.Errorf("got event %+v", ev)
+	}
+}
+
+func TestReconnectInFlight(t *testing.T) {
+	srv := cdptest.NewServer()
+	defer srv.Close()
+	// each method is slow the first time, so that the connection can be
+	// lost while it is in flight
+	slowOnce := func() cdptest.Handler {
+		once := sync.Once{}
+		return func(chromedebugo.Command) cdptest.Response {
+			resp := cdptest.Response{Result: map[string]interface{}{}}
+			once.Do(func() { resp.Delay = time.Second })
+			return resp
+		}
+	}
+	srv.Handle("DOM.getDocument", slowOnce())
+	srv.Handle("Page.navigate", slowOnce())
+
+	d, err := chromedebugo.NewReconnectingSync(srv.URL(),
+		chromedebugo.WithBackoff(time.Millisecond, 10*time.Millisecond),
+		chromedebugo.WithInFlightPolicy("DOM.*", chromedebugo.RetryInFlight),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer d.Close()
+
+	errs := map[string]chan error{"DOM.getDocument": make(chan error, 1), "Page.navigate": make(chan error, 1)}
+	for method, ch := range errs {
+		go func(method string, ch chan error) {
+			_, err := d.Send(chromedebugo.Command{Method: method})
+			ch <- err
+		}(method, ch)
+		if _, err := srv.WaitForCommand(context.Background(), method); err != nil {
+			t.Fatal(err)
+		}
+	}
+	srv.Disconnect()
+
+	if err := <-errs["DOM.getDocument"]; err != nil {
+		t.Errorf("retried command failed: %s", err)
+	}
+	if n := len(srv.Received("DOM.getDocument")); n != 2 {
+		t.Errorf("DOM.getDocument sent %d times, want 2", n)
+	}
+	var closed chromedebugo.ErrConnectionClosed
+	if err := <-errs["Page.navigate"]; !errors.As(err, &closed) {
+		t.Errorf("got %v, want ErrConnectionClosed", err)
+	}
+}
+
+func TestReconnectSetupTimeout(t *testing.T) {
+	srv := cdptest.NewServer()
+	defer srv.Close()
+	srv.Handle("Page.enable", cdptest.Delayed(time.Second, cdptest.Result(nil)))
+
+	setup, _ := chromedebugo.NewCommand("Page.enable", nil)
+	start := time.Now()
+	_, err := chromedebugo.NewReconnectingSync(srv.URL(),
+		chromedebugo.WithSetup(setup),
+		chromedebugo.WithSetupTimeout(50*time.Millisecond),
+	)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("got %v, want %v", err, context.DeadlineExceeded)
+	}
+	if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+		t.Errorf("setup took %s to time out", elapsed)
+	}
+}
+
+func TestReconnectMaxAttempts(t *testing.T) {
+	srv := cdptest.NewServer()
+	defer srv.Close()
+	srv.Handle("Page.enable", cdptest.Result(nil))
+
+	setup, _ := chromedebugo.NewCommand("Page.enable", nil)
+	d, err := chromedebugo.NewReconnectingSync(srv.URL(),
+		chromedebugo.WithBackoff(time.Millisecond, 10*time.Millisecond),
+		chromedebugo.WithMaxAttempts(3),
+		chromedebugo.WithSetup(setup),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer d.Close()
+
+	// chrome comes back, but setup fails on every new connection
+	srv.Handle("Page.enable", cdptest.Fail(-32000, "not ready"))
+	srv.Disconnect()
+	select {
+	case <-d.Done():
+	case <-time.After(5 * time.Second):
+		t.Fatal("still reconnecting")
+	}
+	var closed chromedebugo.ErrConnectionClosed
+	if !errors.As(d.Err(), &closed) || !strings.Contains(d.Err().Error(), "after 3 attempts") {
+		t.Errorf("got Err %v", d.Err())
+	}
+	if n := len(srv.Received("Page.enable")); n != 4 {
+		t.Errorf("setup sent %d times, want the first connection and 3 attempts", n)
+	}
+}
+
+// brokenTransport can't be written to, as a connection whose peer has gone
+// but hasn't been read from since.
+type brokenTransport struct {
+	closed chan struct{}
+	once   sync.Once
+}
+
+func (t *brokenTransport) ReadMessage() ([]byte, error) {
+	<-t.closed
+	return nil, io.EOF
+}
+
+func (t *brokenTransport) WriteMessage([]byte) error {
+	return errors.New("broken pipe")
+}
+
+func (t *brokenTransport) Close() error {
+	t.once.Do(func() { close(t.closed) })
+	return nil
+}
+
+func TestWriteFailureClosesConnection(t *testing.T) {
+	d := chromedebugo.NewSyncTransport(&brokenTransport{closed: make(chan struct{})})
+	defer d.Close()
+
+	_, err := d.Send(chromedebugo.Command{Method: "Page.enable"})
+	var closed chromedebugo.ErrConnectionClosed
+	if !errors.As(err, &closed) || !strings.Contains(err.Error(), "broken pipe") {
+		t.Fatalf("got %v, want ErrConnectionClosed caused by the write", err)
+	}
+	select {
+	case <-d.Done():
+	case <-time.After(5 * time.Second):
+		t.Fatal("connection not closed")
+	}
+}
@@ -0,0 +1,106 @@
+package chromedebugo
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+)
+
+// Directions of the frames in a recording.
+const (
+	// DirectionSend is a command sent to chrome
+	DirectionSend = "send"
+	// DirectionReceive is a response or event received from chrome
+	DirectionReceive = "receive"
+)
+
+// Frame is one message in a recording, written as a line of JSON.
+type Frame struct {
+	Time      time.Time       `json:"time"`
+	Direction string          `json:"direction"`
+	Data      json.RawMessage `json:"frame"`
+}
+
+// recordingTransport copies every message passing through a Transport to a
+// recording.
+type recordingTransport struct {
+	Transport
+
+	// lock guards w, which is written from both the reading and the
+	// sending goroutines
+	lock sync.Mutex
+	w    io.Writer
+}
+
+// NewRecordingTransport returns a Transport which writes every message sent
+// and received over t to w as JSON lines of Frames.  Errors writing the
+// recording are ignored so that they never break the connection.
+func NewRecordingTransport(t Transport, w io.Writer) Transport {
+	return &recordingTransport{Transport: t, w: w}
+}
+
+// WithRecording records the connection's traffic to w, as by
+// NewRecordingTransport.  The recording can be played back with the cdptest
+// package.  It has no effect on sessions attached through a browser
+// debugger, whose traffic is recorded with the browser connection's.
+func WithRecording(w io.Writer) Option {
+	return func(d *debugger) {
+		d.recording = w
+	}
+}
+
+func (t *recordingTransport) ReadMessage() ([]byte, error) {
+	data, err := t.Transport.ReadMessage()
+	if err == nil {
+		t.record(DirectionReceive, data)
+	}
+	return data, err
+}
+
+func (t *recordingTransport) WriteMessage(data []byte) error {
+	t.record(DirectionSend, data)
+	return t.Transport.WriteMessage(data)
+}
+
+func (t *recordingTransport) record(direction string, data []byte) {
+	line, err := json.Marshal(Frame{
+		Time:      time.Now(),
+		Direction: direction,
+		Data:      json.RawMessage(data),
+	})
+	if err != nil {
+		// chrome sent something which isn't JSON, which the reader
+		// reports
+		return
+	}
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	t.w.Write(append(line, '\n'))
+}
+
+// ReadRecording reads the Frames of a recording made with WithRecording or
+// NewRecordingTransport.
+func ReadRecording(r io.Reader) ([]Frame, error) {
+	frames := []Frame{}
+	scanner := bufio.NewScanner(r)
+	// chrome's messages, eg. screenshots, are often larger than the
+	// default limit
+	scanner.Buffer(nil, 256<<20)
+	for line := 1; scanner.Scan(); line++ {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		frame := Frame{}
+		if err := json.Unmarshal(scanner.Bytes(), &frame); err != nil {
+			return nil, fmt.Errorf("error decoding recording line %d: %s", line, err)
+		}
+		frames = append(frames, frame)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("error reading recording: %s", err)
+	}
+	return frames, nil
+}
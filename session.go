@@ -0,0 +1,83 @@
+package chromedebugo
+
+// browserDebugger is a sync debugger connected to the browser target rather
+// than to a page.  Besides sending browser-wide commands such as
+// Target.createTarget it attaches sessions to other targets, each of which
+// is a debugger of its own multiplexed over the one websocket connection.
+//
+// Sessions use the protocol's flattened mode: commands for a session are
+// stamped with its sessionId, and chrome stamps the responses and events it
+// sends back, which route them to the session's debugger.
+type browserDebugger struct {
+	*syncDebugger
+}
+
+// NewBrowserSync connects to the browser target of the chrome instance at
+// host, which is the address of chrome's HTTP endpoints or the browser's
+// ws:// debugger URL from Version.
+func NewBrowserSync(host string, opts ...Option) (*browserDebugger, error) {
+	opts = append([]Option{func(d *debugger) { d.browserTarget = true }}, opts...)
+	sd, err := NewSync(host, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &browserDebugger{syncDebugger: sd}, nil
+}
+
+// NewBrowserSyncTransport returns a browser debugger speaking to chrome over
+// transport.  A pipe from NewPipeTransport always connects to the browser
+// target, so this is how sessions are attached to pages over a pipe.
+func NewBrowserSyncTransport(transport Transport, opts ...Option) *browserDebugger {
+	return &browserDebugger{syncDebugger: NewSyncTransport(transport, opts...)}
+}
+
+// AttachSync attaches to the target with the given ID, as listed by Info or
+// Target.getTargets, and returns a sync debugger for it.  The options apply
+// to the session's debugger; options selecting a target are ignored.
+//
+// Closing the returned debugger detaches from the target.  Events are only
+// routed to the session once AttachSync returns, so domains should be
+// enabled afterwards.
+func (bd *browserDebugger) AttachSync(targetID string, opts ...Option) (*syncDebugger, error) {
+	view, err := bd.attach(targetID, opts)
+	if err != nil {
+		return nil, err
+	}
+	return &syncDebugger{debugger: view}, nil
+}
+
+// AttachAsync attaches to the target with the given ID and returns an async
+// debugger for it.  It is otherwise the same as AttachSync.
+func (bd *browserDebugger) AttachAsync(targetID string, opts ...Option) (*asyncDebugger, error) {
+	opts = append([]Option{WithResponseTaps(DefaultEventBuffer, DropOldest)}, opts...)
+	view, err := bd.attach(targetID, opts)
+	if err != nil {
+		return nil, err
+	}
+	return &asyncDebugger{debugger: view}, nil
+}
+
+func (bd *browserDebugger) attach(targetID string, opts []Option) (*debugger, error) {
+	res, err := bd.Send(Command{
+		Method: "Target.attachToTarget",
+		Params: map[string]interface{}{
+			"targetId": targetID,
+			"flatten":  true,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	id, err := sessionID(res)
+	if err != nil {
+		return nil, err
+	}
+
+	view := newView(id, opts)
+	view.browser = bd.browser
+	view.conn = bd.conn
+	if err := bd.conn.attach(view); err != nil {
+		return nil, err
+	}
+	return view, nil
+}
@@ -0,0 +1,76 @@
+package chromedebugo_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/tonyhb/chromedebugo"
+	"github.com/tonyhb/chromedebugo/cdptest"
+)
+
+func TestAttachSync(t *testing.T) {
+	srv := cdptest.NewServer()
+	defer srv.Close()
+	srv.Handle("Target.attachToTarget", cdptest.Result(map[string]interface{}{"sessionId": "session-1"}))
+	srv.Handle("Target.detachFromTarget", cdptest.Result(nil))
+	srv.Handle("Page.enable", cdptest.Result(nil))
+
+	browser, err := chromedebugo.NewBrowserSync(srv.BrowserURL())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer browser.Close()
+	page, err := browser.AttachSync("page-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	attach := srv.AssertReceived(t, "Target.attachToTarget")
+	if attach.Params["targetId"] != "page-1" || attach.Params["flatten"] != true {
+		t.Errorf("attached with params %v", attach.Params)
+	}
+
+	res, err := page.Send(chromedebugo.Command{Method: "Page.enable"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.SessionID != "session-1" {
+		t.Errorf("got response from session %q", res.SessionID)
+	}
+	if cmd := srv.AssertReceived(t, "Page.enable"); cmd.SessionID != "session-1" {
+		t.Errorf("command sent to session %q, want session-1", cmd.SessionID)
+	}
+
+	pageEvents, unsubscribe := page.Subscribe("Page.*")
+	defer unsubscribe()
+	browserEvents, unsubscribe := browser.Subscribe("Page.*")
+	defer unsubscribe()
+	srv.EmitTo("session-1", "Page.loadEventFired", nil)
+
+	select {
+	case ev := <-pageEvents:
+		if ev.SessionID != "session-1" {
+			t.Errorf("got event from session %q", ev.SessionID)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("event not routed to the session")
+	}
+	select {
+	case ev := <-browserEvents:
+		t.Errorf("session's event %+v routed to the browser", ev)
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	if err := page.Close(); err != nil {
+		t.Fatal(err)
+	}
+	detach := srv.AssertReceived(t, "Target.detachFromTarget")
+	if detach.Params["sessionId"] != "session-1" {
+		t.Errorf("detached with params %v", detach.Params)
+	}
+	select {
+	case <-browser.Done():
+		t.Error("closing the session closed the browser connection")
+	default:
+	}
+}
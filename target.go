@@ -0,0 +1,117 @@
+package chromedebugo
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// TargetSelector reports whether a target listed by /json/list is the one to
+// debug.  Any func(Info) bool may be used as a selector with WithTarget.
+type TargetSelector func(Info) bool
+
+// ByID selects the target with the given ID.
+func ByID(id string) TargetSelector {
+	return func(i Info) bool {
+		return i.Id == id
+	}
+}
+
+// ByType selects targets of the given type, such as "page", "iframe",
+// "service_worker" or "background_page".
+func ByType(typ string) TargetSelector {
+	return func(i Info) bool {
+		return i.Type == typ
+	}
+}
+
+// ByURL selects targets whose URL matches re.
+func ByURL(re *regexp.Regexp) TargetSelector {
+	return func(i Info) bool {
+		return re.MatchString(i.URL)
+	}
+}
+
+// ByTitle selects targets whose title matches re.
+func ByTitle(re *regexp.Regexp) TargetSelector {
+	return func(i Info) bool {
+		return re.MatchString(i.Title)
+	}
+}
+
+// WithTarget connects to the first target listed by /json/list which matches
+// sel.  Without it the debugger connects to the only target, or the first
+// page if chrome has several targets.
+func WithTarget(sel TargetSelector) Option {
+	return func(d *debugger) {
+		d.target = sel
+	}
+}
+
+// NewSyncTarget creates a sync debugger connected to target, such as one
+// returned by Info, without listing chrome's targets again.
+func NewSyncTarget(target Info, opts ...Option) (*syncDebugger, error) {
+	if target.WebsocketDebuggerURL == "" {
+		return nil, noWebsocketURL(target)
+	}
+	return NewSync(target.WebsocketDebuggerURL, opts...)
+}
+
+// NewAsyncTarget creates an async debugger connected to target, such as one
+// returned by Info, without listing chrome's targets again.
+func NewAsyncTarget(target Info, opts ...Option) (*asyncDebugger, error) {
+	if target.WebsocketDebuggerURL == "" {
+		return nil, noWebsocketURL(target)
+	}
+	return NewAsync(target.WebsocketDebuggerURL, opts...)
+}
+
+// selectTarget returns the first target matching sel.  If sel is nil it
+// returns the only target, or the first page if there are several.
+func selectTarget(targets []Info, sel TargetSelector) (Info, error) {
+	if sel == nil {
+		if len(targets) == 1 {
+			return targets[0], nil
+		}
+		sel = ByType("page")
+	}
+	for _, t := range targets {
+		if !sel(t) {
+			continue
+		}
+		if t.WebsocketDebuggerURL == "" {
+			return Info{}, noWebsocketURL(t)
+		}
+		return t, nil
+	}
+	return Info{}, fmt.Errorf("error getting chrome info: none of the %d targets from /json/list matched", len(targets))
+}
+
+func noWebsocketURL(target Info) error {
+	// chrome omits the URL while another client, such as devtools, is
+	// attached to the target
+	return fmt.Errorf("target %s has no websocket debugger URL; is another client attached to it?", target.Id)
+}
+
+// isWebsocketURL reports whether host is a ws:// or wss:// debugger URL
+// rather than the address of chrome's HTTP endpoints.
+func isWebsocketURL(host string) bool {
+	return strings.HasPrefix(host, "ws://") || strings.HasPrefix(host, "wss://")
+}
+
+// HTTPHost returns the address of the HTTP endpoints serving a websocket
+// debugger URL, such as "http://127.0.0.1:9222" for
+// "ws://127.0.0.1:9222/devtools/browser/<id>", for use with NewBrowser.
+// Debuggers dialed directly use it so that Version and Info work.
+func HTTPHost(wsURL string) string {
+	u, err := url.Parse(wsURL)
+	if err != nil {
+		return ""
+	}
+	scheme := "http"
+	if u.Scheme == "wss" {
+		scheme = "https"
+	}
+	return scheme + "://" + u.Host
+}
@@ -0,0 +1,109 @@
+package chromedebugo_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/tonyhb/chromedebugo"
+	"github.com/tonyhb/chromedebugo/cdptest"
+)
+
+func TestHTTPHost(t *testing.T) {
+	tests := map[string]string{
+		"ws://127.0.0.1:9222/devtools/browser/abc": "http://127.0.0.1:9222",
+		"wss://chrome.example.com/devtools/page/1": "https://chrome.example.com",
+		"ws://localhost:9222":                      "http://localhost:9222",
+	}
+	for wsURL, want := range tests {
+		if got := chromedebugo.HTTPHost(wsURL); got != want {
+			t.Errorf("HTTPHost(%q) = %q, want %q", wsURL, got, want)
+		}
+	}
+}
+
+func TestWithTarget(t *testing.T) {
+	worker := chromedebugo.Info{Id: "worker-1", Type: "service_worker", Title: "Service Worker", URL: "https://mail.example.com/sw.js"}
+	blank := chromedebugo.Info{Id: "page-1", Type: "page", Title: "about:blank", URL: "about:blank"}
+	inbox := chromedebugo.Info{Id: "page-2", Type: "page", Title: "Inbox (3)", URL: "https://mail.example.com/inbox"}
+
+	tests := []struct {
+		name    string
+		targets []chromedebugo.Info
+		sel     chromedebugo.TargetSelector
+		want    string
+	}{
+		{"default first page", []chromedebugo.Info{worker, blank, inbox}, nil, "page-1"},
+		{"default only target", []chromedebugo.Info{worker}, nil, "worker-1"},
+		{"ByID", []chromedebugo.Info{worker, blank, inbox}, chromedebugo.ByID("page-2"), "page-2"},
+		{"ByType", []chromedebugo.Info{blank, inbox, worker}, chromedebugo.ByType("service_worker"), "worker-1"},
+		{"ByURL", []chromedebugo.Info{worker, blank, inbox}, chromedebugo.ByURL(regexp.MustCompile(`^https://mail\.example\.com/in`)), "page-2"},
+		{"ByTitle", []chromedebugo.Info{worker, blank, inbox}, chromedebugo.ByTitle(regexp.MustCompile(`^Inbox`)), "page-2"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			srv := cdptest.NewServer()
+			defer srv.Close()
+			// only the wanted target can be dialed; the server gives it
+			// a websocket URL
+			targets := make([]chromedebugo.Info, len(test.targets))
+			for i, target := range test.targets {
+				if target.Id != test.want {
+					target.WebsocketDebuggerURL = "ws://127.0.0.1:1/devtools/page/" + target.Id
+				}
+				targets[i] = target
+			}
+			srv.SetTargets(targets...)
+
+			d, err := chromedebugo.NewSync(srv.URL(), chromedebugo.WithTarget(test.sel))
+			if err != nil {
+				t.Fatalf("got %v, want %s selected", err, test.want)
+			}
+			d.Close()
+		})
+	}
+}
+
+func TestWithTargetNoMatch(t *testing.T) {
+	srv := cdptest.NewServer()
+	defer srv.Close()
+	srv.SetTargets(
+		chromedebugo.Info{Id: "worker-1", Type: "service_worker"},
+		chromedebugo.Info{Id: "worker-2", Type: "service_worker"},
+	)
+
+	// without a selector, several targets and no page is no match too
+	for _, sel := range []chromedebugo.TargetSelector{chromedebugo.ByID("page-1"), nil} {
+		_, err := chromedebugo.NewSync(srv.URL(), chromedebugo.WithTarget(sel))
+		if err == nil || !strings.Contains(err.Error(), "none of the 2 targets") {
+			t.Errorf("got %v, want no target matched", err)
+		}
+	}
+	if n := srv.Connections(); n != 0 {
+		t.Errorf("got %d connections, want none", n)
+	}
+}
+
+func TestWithTargetNoWebsocketURL(t *testing.T) {
+	// chrome omits the URL of a target another client is attached to
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[
+			{"id": "page-1", "type": "page", "title": "about:blank", "url": "about:blank"},
+			{"id": "page-2", "type": "page", "title": "Inbox", "url": "https://mail.example.com/", "webSocketDebuggerUrl": "ws://127.0.0.1:1/devtools/page/page-2"}
+		]`)
+	}))
+	defer srv.Close()
+
+	_, err := chromedebugo.NewSync(srv.URL)
+	if err == nil || !strings.Contains(err.Error(), "target page-1 has no websocket debugger URL") {
+		t.Errorf("got %v, want page-1 to have no websocket URL", err)
+	}
+
+	_, err = chromedebugo.NewSyncTarget(chromedebugo.Info{Id: "page-1", Type: "page"})
+	if err == nil || !strings.Contains(err.Error(), "target page-1 has no websocket debugger URL") {
+		t.Errorf("got %v from NewSyncTarget, want page-1 to have no websocket URL", err)
+	}
+}
@@ -0,0 +1,170 @@
+package chromedebugo
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"sync"
+
+	"github.com/gorilla/websocket"
+)
+
+// Transport carries protocol messages between a debugger and chrome.  Each
+// message is one JSON encoded command, response or event.
+//
+// ReadMessage is only called by the goroutine reading from chrome and
+// WriteMessage is never called concurrently, but Close may be called at any
+// time and must unblock a pending ReadMessage.
+type Transport interface {
+	ReadMessage() ([]byte, error)
+	WriteMessage(data []byte) error
+	Close() error
+}
+
+// ErrNoHTTPEndpoints is returned by Version and Info on debuggers connected
+// over a Transport, which have no HTTP endpoints to query.  The Target and
+// Browser domains provide the same information over the transport.
+var ErrNoHTTPEndpoints = errors.New("debugger has no HTTP endpoints")
+
+// websocketTransport sends each message as a websocket text message, which
+// is how chrome speaks the protocol over --remote-debugging-port.
+type websocketTransport struct {
+	ws *websocket.Conn
+}
+
+// NewWebsocketTransport returns a Transport over an established websocket
+// connection, for use when the connection needs dialing with a custom
+// websocket.Dialer.
+func NewWebsocketTransport(ws *websocket.Conn) Transport {
+	return websocketTransport{ws: ws}
+}
+
+// DialWebsocket dials a ws:// debugger URL and returns a Transport over the
+// connection.
+func DialWebsocket(url string) (Transport, error) {
+	ws, _, err := new(websocket.Dialer).Dial(url, nil)
+	if err != nil {
+		return nil, err
+	}
+	return websocketTransport{ws: ws}, nil
+}
+
+func (t websocketTransport) ReadMessage() ([]byte, error) {
+	_, data, err := t.ws.ReadMessage()
+	return data, err
+}
+
+func (t websocketTransport) WriteMessage(data []byte) error {
+	return t.ws.WriteMessage(websocket.TextMessage, data)
+}
+
+func (t websocketTransport) Close() error {
+	return t.ws.Close()
+}
+
+// pipeTransport speaks the protocol of --remote-debugging-pipe, in which
+// chrome reads commands from file descriptor 3 and writes responses and
+// events to file descriptor 4, each message terminated by a NUL byte.
+type pipeTransport struct {
+	r *bufio.Reader
+	// in and out are chrome's fd 4 and fd 3 respectively
+	in  io.ReadCloser
+	out io.WriteCloser
+
+	// buf is reused by WriteMessage to append the NUL terminator
+	buf       []byte
+	closeOnce sync.Once
+	closeErr  error
+}
+
+// NewPipeTransport returns a Transport over the pipes of a chrome started
+// with --remote-debugging-pipe.  The reader is the other end of chrome's fd
+// 4 and the writer the other end of its fd 3:
+//
+//	toChrome, chromeIn, _ := os.Pipe()   // chrome reads fd 3
+//	chromeOut, fromChrome, _ := os.Pipe() // chrome writes fd 4
+//	cmd.ExtraFiles = []*os.File{toChrome, fromChrome}
+//	t := chromedebugo.NewPipeTransport(chromeOut, chromeIn)
+//
+// The launcher package does this when started WithPipe.
+func NewPipeTransport(r io.ReadCloser, w io.WriteCloser) Transport {
+	return &pipeTransport{
+		r:   bufio.NewReader(r),
+		in:  r,
+		out: w,
+	}
+}
+
+func (t *pipeTransport) ReadMessage() ([]byte, error) {
+	data, err := t.r.ReadBytes(0)
+	if err != nil {
+		// a message without its terminator is incomplete, so it is
+		// dropped
+		return nil, err
+	}
+	return data[:len(data)-1], nil
+}
+
+func (t *pipeTransport) WriteMessage(data []byte) error {
+	t.buf = append(append(t.buf[:0], data...), 0)
+	_, err := t.out.Write(t.buf)
+	return err
+}
+
+func (t *pipeTransport) Close() error {
+	t.closeOnce.Do(func() {
+		t.closeErr = t.out.Close()
+		if err := t.in.Close(); err != nil && t.closeErr == nil {
+			t.closeErr = err
+		}
+	})
+	return t.closeErr
+}
+
+// memoryTransport is one end of a pair connected by channels.
+type memoryTransport struct {
+	in  <-chan []byte
+	out chan<- []byte
+	// done is shared by both ends, so closing either closes the pair
+	done      chan struct{}
+	closeOnce *sync.Once
+}
+
+// NewMemoryTransport returns two connected Transports: messages written to
+// one are read from the other.  It needs no browser, so the chrome end can
+// be scripted to test code built on a debugger.  Closing either end closes
+// both, and reads then fail with io.EOF.
+func NewMemoryTransport() (Transport, Transport) {
+	a, b := make(chan []byte), make(chan []byte)
+	done := make(chan struct{})
+	once := &sync.Once{}
+	return memoryTransport{in: a, out: b, done: done, closeOnce: once},
+		memoryTransport{in: b, out: a, done: done, closeOnce: once}
+}
+
+func (t memoryTransport) ReadMessage() ([]byte, error) {
+	select {
+	case data := <-t.in:
+		return data, nil
+	case <-t.done:
+		return nil, io.EOF
+	}
+}
+
+func (t memoryTransport) WriteMessage(data []byte) error {
+	// the reader owns the message once sent, so it gets a copy
+	msg := append([]byte(nil), data...)
+	select {
+	case t.out <- msg:
+		return nil
+	case <-t.done:
+		return io.ErrClosedPipe
+	}
+}
+
+func (t memoryTransport) Close() error {
+	t.closeOnce.Do(func() {
+		close(t.done)
+	})
+	return nil
+}
@@ -0,0 +1,150 @@
+package chromedebugo_test
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/tonyhb/chromedebugo"
+)
+
+// writeCloser is a bytes.Buffer which records whether it was closed.
+type writeCloser struct {
+	bytes.Buffer
+	closed bool
+}
+
+func (w *writeCloser) Close() error {
+	w.closed = true
+	return nil
+}
+
+func TestPipeTransportFraming(t *testing.T) {
+	out := &writeCloser{}
+	pipe := chromedebugo.NewPipeTransport(ioutil.NopCloser(strings.NewReader("")), out)
+	for _, msg := range []string{`{"id":1,"method":"Page.enable"}`, ``, `{"id":2}`} {
+		if err := pipe.WriteMessage([]byte(msg)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if got, want := out.String(), "{\"id\":1,\"method\":\"Page.enable\"}\x00\x00{\"id\":2}\x00"; got != want {
+		t.Errorf("wrote %q, want %q", got, want)
+	}
+
+	pipe = chromedebugo.NewPipeTransport(ioutil.NopCloser(strings.NewReader("{\"id\":1}\x00\x00{\"method\":\"Page.loadEventFired\"}\x00")), out)
+	for _, want := range []string{`{"id":1}`, ``, `{"method":"Page.loadEventFired"}`} {
+		msg, err := pipe.ReadMessage()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(msg) != want {
+			t.Errorf("read %q, want %q", msg, want)
+		}
+	}
+	if _, err := pipe.ReadMessage(); err != io.EOF {
+		t.Errorf("got %v at the end, want %v", err, io.EOF)
+	}
+}
+
+func TestPipeTransportPartialMessage(t *testing.T) {
+	pipe := chromedebugo.NewPipeTransport(ioutil.NopCloser(strings.NewReader("{\"id\":1}\x00{\"id\":2")), &writeCloser{})
+	if msg, err := pipe.ReadMessage(); err != nil || string(msg) != `{"id":1}` {
+		t.Fatalf("got %q, %v", msg, err)
+	}
+	// chrome exited part way through the message
+	if msg, err := pipe.ReadMessage(); err != io.EOF || msg != nil {
+		t.Errorf("got %q, %v, want the partial message dropped", msg, err)
+	}
+}
+
+func TestPipeTransportClose(t *testing.T) {
+	fromChrome, chromeOut, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer chromeOut.Close()
+	out := &writeCloser{}
+	pipe := chromedebugo.NewPipeTransport(fromChrome, out)
+
+	read := make(chan error, 1)
+	go func() {
+		_, err := pipe.ReadMessage()
+		read <- err
+	}()
+	time.Sleep(10 * time.Millisecond)
+	if err := pipe.Close(); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case err := <-read:
+		if err == nil {
+			t.Error("read a message after Close")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Close did not unblock ReadMessage")
+	}
+	if !out.closed {
+		t.Error("Close did not close the writer")
+	}
+	if err := pipe.Close(); err != nil {
+		t.Errorf("closing again returned %v", err)
+	}
+}
+
+func TestMemoryTransport(t *testing.T) {
+	client, chrome := chromedebugo.NewMemoryTransport()
+	defer client.Close()
+
+	msg := []byte(`{"id":1,"method":"Page.enable"}`)
+	go client.WriteMessage(msg)
+	got, err := chrome.ReadMessage()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the reader gets its own copy
+	msg[0] = 'x'
+	if string(got) != `{"id":1,"method":"Page.enable"}` {
+		t.Errorf("read %q", got)
+	}
+
+	go chrome.WriteMessage([]byte(`{"id":1,"result":{}}`))
+	if got, err := client.ReadMessage(); err != nil || string(got) != `{"id":1,"result":{}}` {
+		t.Errorf("read %q, %v", got, err)
+	}
+}
+
+func TestMemoryTransportClose(t *testing.T) {
+	client, chrome := chromedebugo.NewMemoryTransport()
+
+	read := make(chan error, 1)
+	go func() {
+		_, err := client.ReadMessage()
+		read <- err
+	}()
+	// closing either end closes both
+	if err := chrome.Close(); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case err := <-read:
+		if err != io.EOF {
+			t.Errorf("got %v, want %v", err, io.EOF)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Close did not unblock ReadMessage")
+	}
+
+	if _, err := chrome.ReadMessage(); err != io.EOF {
+		t.Errorf("got %v reading after Close, want %v", err, io.EOF)
+	}
+	if err := client.WriteMessage([]byte(`{}`)); err != io.ErrClosedPipe {
+		t.Errorf("got %v writing after Close, want %v", err, io.ErrClosedPipe)
+	}
+	if err := client.Close(); err != nil {
+		t.Errorf("closing again returned %v", err)
+	}
+}
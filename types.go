@@ -8,18 +8,51 @@ import (
 type Command struct {
 	Method string                 `json:"method"`
 	Params map[string]interface{} `json:"params"`
+	// SessionID is the session an event was sent from, when attached to
+	// targets through a browser debugger.  It is set automatically on
+	// commands sent to a session.
+	SessionID string `json:"sessionId,omitempty"`
+}
+
+// NewCommand creates a Command for method whose params are the JSON encoding
+// of params, typically one of the generated structs in the protocol packages.
+func NewCommand(method string, params interface{}) (Command, error) {
+	cmd := Command{Method: method}
+	data, err := json.Marshal(params)
+	if err != nil {
+		return cmd, fmt.Errorf("error encoding params for %s: %s", method, err)
+	}
+	if err := json.Unmarshal(data, &cmd.Params); err != nil {
+		return cmd, fmt.Errorf("error encoding params for %s: %s", method, err)
+	}
+	return cmd, nil
+}
+
+// Decode unmarshals the command's params into v, which is useful for reading
+// events received from CommandChan into typed structs.
+func (c Command) Decode(v interface{}) error {
+	return remarshal(c.Params, v)
 }
 
 type Result struct {
 	ID     int                    `json:"id"`
 	Result map[string]interface{} `json:"result"`
+	// SessionID is the session the command was sent to, if any
+	SessionID string `json:"sessionId,omitempty"`
 	// If possible, the request that caused this result
 	Request *Command `json:"request,omitempty"`
 }
 
+// Decode unmarshals the result into v.
+func (r Result) Decode(v interface{}) error {
+	return remarshal(r.Result, v)
+}
+
 type Error struct {
 	ErrorDetail ErrorDetail `json:"error"`
 	ID          int         `json:"id"`
+	// SessionID is the session the command was sent to, if any
+	SessionID string `json:"sessionId,omitempty"`
 	// If possible, the request that caused this error
 	Request *Command `json:"request,omitempty"`
 }
@@ -42,6 +75,24 @@ func (e Error) Error() string {
 	)
 }
 
+// ErrConnectionClosed is returned by commands sent to, or awaiting a response
+// from, a debugger whose connection to chrome has closed.  Cause holds the
+// error which closed the connection, and is nil if it was closed with Close.
+type ErrConnectionClosed struct {
+	Cause error
+}
+
+func (e ErrConnectionClosed) Error() string {
+	if e.Cause == nil {
+		return "connection to chrome closed"
+	}
+	return fmt.Sprintf("connection to chrome closed: %s", e.Cause)
+}
+
+func (e ErrConnectionClosed) Unwrap() error {
+	return e.Cause
+}
+
 type ErrorDetail struct {
 	Code    int    `json:"code"`
 	Message string `json:"message"`
@@ -58,6 +109,11 @@ func (c commandWrapper) MarshalJSON() ([]byte, error) {
 		"method": c.Command.Method,
 		"params": c.Command.Params,
 	}
+	if c.Command.SessionID != "" {
+		// flattened sessions are addressed by sessionId alongside the
+		// command rather than with Target.sendMessageToTarget
+		data["sessionId"] = c.Command.SessionID
+	}
 	return json.Marshal(data)
 }
 
@@ -67,6 +123,9 @@ type Version struct {
 	UserAgent       string `json:"User-Agent"`
 	V8Version       string `json:"V8-Version"`
 	WebkitVersion   string `json:"Webkit-Version"`
+	// WebsocketDebuggerURL is the URL of the browser target, which can
+	// debug every other target
+	WebsocketDebuggerURL string `json:"webSocketDebuggerUrl"`
 }
 
 type Info struct {
@@ -78,3 +137,33 @@ type Info struct {
 	URL                  string `json:"url"`
 	WebsocketDebuggerURL string `json:"websocketDebuggerURL"`
 }
+
+// Protocol describes the DevTools protocol spoken by chrome, as served by
+// /json/protocol.  The typed bindings beneath the protocol package are
+// generated from the same description.
+type Protocol struct {
+	Version struct {
+		Major string `json:"major"`
+		Minor string `json:"minor"`
+	} `json:"version"`
+	Domains []ProtocolDomain `json:"domains"`
+}
+
+type ProtocolDomain struct {
+	Domain       string           `json:"domain"`
+	Description  string           `json:"description"`
+	Experimental bool             `json:"experimental"`
+	Deprecated   bool             `json:"deprecated"`
+	Dependencies []string         `json:"dependencies"`
+	Commands     []ProtocolMethod `json:"commands"`
+	Events       []ProtocolMethod `json:"events"`
+}
+
+// ProtocolMethod describes a command or event.  Parameters, return values
+// and types are not decoded.
+type ProtocolMethod struct {
+	Name         string `json:"name"`
+	Description  string `json:"description"`
+	Experimental bool   `json:"experimental"`
+	Deprecated   bool   `json:"deprecated"`
+}
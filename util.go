@@ -7,7 +7,8 @@ import (
 
 func decodeResponse(data []byte, commands map[int]Command) (interface{}, error) {
 	// data can be a JSON marshaled Result, Error
-	// or Command.
+	// or Command.  Each may carry the sessionId of the attached target it
+	// came from, which is decoded into its SessionID.
 	root := map[string]interface{}{}
 	if err := json.Unmarshal(data, &root); err != nil {
 		return nil, err
@@ -51,3 +52,13 @@ func decodeResponse(data []byte, commands map[int]Command) (interface{}, error)
 
 	return nil, fmt.Errorf("unknown response: %s", data)
 }
+
+// remarshal converts decoded JSON such as a Command's params into the typed
+// value v.
+func remarshal(src map[string]interface{}, v interface{}) error {
+	data, err := json.Marshal(src)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
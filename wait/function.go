@@ -0,0 +1,70 @@
+package wait
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/tonyhb/chromedebugo"
+	"github.com/tonyhb/chromedebugo/js"
+)
+
+// WaitForFunction waits for the JavaScript function fn, such as
+// "() => document.title === 'Done'", to return a truthy value, and returns
+// the value as JSON.  A promise returned by fn is awaited.  Exceptions
+// thrown by fn end the wait with a *js.Exception.
+//
+// fn is called on an interval by default, or on animation frames or DOM
+// mutations with WithPolling.  When ctx is done the error includes fn's
+// last value.
+func WaitForFunction(ctx context.Context, d chromedebugo.SyncDebugger, fn string, opts ...Option) (json.RawMessage, error) {
+	c := newConfig(opts)
+
+	// the first check is made at once, and each later one as polling
+	// says, in the page or after sleeping for the interval
+	check := fmt.Sprintf(`(async () => {
+		const value = await (%s)();
+		return {ok: !!value, value: value};
+	})()`, fn)
+	next, interval := check, c.interval
+	switch c.polling {
+	case PollRAF:
+		next = fmt.Sprintf(`new Promise(resolve => requestAnimationFrame(() => resolve(%s)))`, check)
+		interval = 0
+	case PollMutation:
+		next = fmt.Sprintf(`new Promise(resolve => {
+			const observer = new MutationObserver(() => {
+				observer.disconnect();
+				resolve(%s);
+			});
+			observer.observe(document, {childList: true, subtree: true, attributes: true, characterData: true});
+		})`, check)
+		interval = 0
+	case "":
+	default:
+		return nil, fmt.Errorf("unknown polling %q", c.polling)
+	}
+
+	var value json.RawMessage
+	expr := check
+	err := poll(ctx, interval, "function to return a truthy value", func() (bool, string, error) {
+		ret := struct {
+			OK    bool            `json:"ok"`
+			Value json.RawMessage `json:"value"`
+		}{}
+		err := js.EvaluateInto(ctx, d, expr, &ret)
+		expr = next
+		if err != nil {
+			return false, "", err
+		}
+		value = ret.Value
+		if len(value) == 0 {
+			return ret.OK, "returned undefined", nil
+		}
+		return ret.OK, "returned " + string(value), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return value, nil
+}
@@ -0,0 +1,101 @@
+package wait
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/tonyhb/chromedebugo/element"
+)
+
+// State is the state of an element to wait for.
+type State string
+
+const (
+	// Attached waits for an element matching the selector to be in the
+	// document.
+	Attached State = "attached"
+	// Detached waits for no element to match the selector.
+	Detached State = "detached"
+	// Visible waits for an element matching the selector to be in the
+	// document and visible, as by element.Element.Visible.
+	Visible State = "visible"
+	// Hidden waits for no element matching the selector to be visible,
+	// either because there is none or because it is hidden.
+	Hidden State = "hidden"
+
+	// unknown is observed when the document changed during a check, which
+	// neither satisfies nor fails any state
+	unknown State = "unknown, the document changed"
+)
+
+// WaitForSelector waits for the first element matching the CSS selector to
+// reach state, checking on an interval (see WithPollInterval).  It returns
+// the element for Attached and Visible, and nil for Detached and Hidden.
+//
+// The document is queried afresh for each check, so elements replaced by
+// the page, or by navigation, are found again.
+func WaitForSelector(ctx context.Context, doc *element.Document, selector string, state State, opts ...Option) (*element.Element, error) {
+	c := newConfig(opts)
+	var found *element.Element
+	what := fmt.Sprintf("selector %q to be %s", selector, state)
+	err := poll(ctx, c.interval, what, func() (bool, string, error) {
+		el, observed, err := observe(ctx, doc, selector, state)
+		if err != nil {
+			return false, "", err
+		}
+		found = el
+		if observed == unknown {
+			return false, string(observed), nil
+		}
+		switch state {
+		case Attached:
+			return observed != Detached, string(observed), nil
+		case Detached:
+			return observed == Detached, string(observed), nil
+		case Visible:
+			return observed == Visible, string(observed), nil
+		case Hidden:
+			return observed != Visible, string(observed), nil
+		}
+		return false, "", fmt.Errorf("unknown element state %q", state)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if state == Detached || state == Hidden {
+		return nil, nil
+	}
+	return found, nil
+}
+
+// observe returns the first element matching selector and whether it is
+// Detached, Visible or Hidden, or unknown if the document was updated while
+// looking.  Visibility is only checked when it matters to state.
+func observe(ctx context.Context, doc *element.Document, selector string, state State) (*element.Element, State, error) {
+	el, err := doc.Query(ctx, selector)
+	switch {
+	case errors.Is(err, element.ErrNotFound):
+		return nil, Detached, nil
+	case errors.Is(err, element.ErrStaleElement):
+		// the document was replaced mid-query; look again next time
+		return nil, unknown, nil
+	case err != nil:
+		return nil, "", err
+	}
+	if state != Visible && state != Hidden {
+		return el, Attached, nil
+	}
+
+	visible, err := el.Visible(ctx)
+	switch {
+	case errors.Is(err, element.ErrStaleElement):
+		return nil, unknown, nil
+	case err != nil:
+		return nil, "", err
+	case visible:
+		return el, Visible, nil
+	default:
+		return el, Hidden, nil
+	}
+}
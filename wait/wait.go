@@ -0,0 +1,125 @@
+// Package wait waits for conditions in a page rather than sleeping for a
+// guessed duration:
+//
+//	button, err := wait.WaitForSelector(ctx, doc, "#submit", wait.Visible)
+//	...
+//	_, err = wait.WaitForFunction(ctx, debugger, "() => window.ready", wait.WithPolling(wait.PollRAF))
+//
+// Waits end with ctx, and their errors then wrap ctx.Err() and describe
+// the last state observed, such as whether the selector matched.
+package wait
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/tonyhb/chromedebugo"
+)
+
+// DefaultPollInterval is how often conditions are checked when polling on
+// an interval.
+const DefaultPollInterval = 100 * time.Millisecond
+
+// Polling is how WaitForFunction checks its predicate in the page, other
+// than on an interval.
+type Polling string
+
+const (
+	// PollRAF checks the predicate on every animation frame.
+	PollRAF Polling = "raf"
+	// PollMutation checks the predicate whenever the DOM changes.
+	PollMutation Polling = "mutation"
+)
+
+type config struct {
+	interval time.Duration
+	polling  Polling
+}
+
+// Option configures a wait.
+type Option func(*config)
+
+// WithPollInterval sets how often the condition is checked, DefaultPollInterval
+// by default.
+func WithPollInterval(interval time.Duration) Option {
+	return func(c *config) {
+		c.interval = interval
+		c.polling = ""
+	}
+}
+
+// WithPolling makes WaitForFunction check its predicate on animation frames
+// or DOM mutations rather than on an interval.  Other waits ignore it.
+func WithPolling(polling Polling) Option {
+	return func(c *config) {
+		c.polling = polling
+	}
+}
+
+func newConfig(opts []Option) *config {
+	c := &config{interval: DefaultPollInterval}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// poll calls check until it reports done, an error, or ctx is done, sleeping
+// for interval between calls.  The last state check describes is used for
+// the error when ctx is done.  Errors from check after ctx is done are
+// reported the same way, as the command was likely cut short by ctx.
+func poll(ctx context.Context, interval time.Duration, what string, check func() (done bool, state string, err error)) error {
+	last := "not checked"
+	for {
+		done, state, err := check()
+		if ctx.Err() != nil {
+			return fmt.Errorf("error waiting for %s (last state: %s): %w", what, last, ctx.Err())
+		}
+		if err != nil {
+			return err
+		}
+		last = state
+		if done {
+			return nil
+		}
+		select {
+		case <-time.After(interval):
+		case <-ctx.Done():
+			return fmt.Errorf("error waiting for %s (last state: %s): %w", what, last, ctx.Err())
+		}
+	}
+}
+
+// WaitForEvent waits for an event matching method, as for Subscribe, for
+// which matcher returns true.  A nil matcher matches every event.  Only
+// events received after it is called are seen, so to wait for an event
+// caused by an action, start the wait before the action.
+func WaitForEvent(ctx context.Context, d chromedebugo.SyncDebugger, method string, matcher func(chromedebugo.Command) bool) (chromedebugo.Command, error) {
+	events, unsubscribe := d.Subscribe(method, chromedebugo.WithBuffer(1024))
+	defer unsubscribe()
+
+	seen := 0
+	var last *chromedebugo.Command
+	for {
+		select {
+		case cmd, ok := <-events:
+			if !ok {
+				return chromedebugo.Command{}, fmt.Errorf("error waiting for %s: event subscription closed", method)
+			}
+			if matcher == nil || matcher(cmd) {
+				return cmd, nil
+			}
+			seen++
+			last = &cmd
+		case <-ctx.Done():
+			state := "no events"
+			if last != nil {
+				params, _ := json.Marshal(last.Params)
+				state = fmt.Sprintf("%d unmatched events, last %s %s", seen, last.Method, params)
+			}
+			return chromedebugo.Command{}, fmt.Errorf("error waiting for %s (last state: %s): %w", method, state, ctx.Err())
+		}
+	}
+}
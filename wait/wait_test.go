@@ -0,0 +1,262 @@
+package wait_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/tonyhb/chromedebugo"
+	"github.com/tonyhb/chromedebugo/cdptest"
+	"github.com/tonyhb/chromedebugo/element"
+	"github.com/tonyhb/chromedebugo/wait"
+)
+
+const interval = wait.DefaultPollInterval / 20
+
+// sequence returns a handler which responds with each of responses in turn,
+// repeating the last, and a function returning the number of calls.
+func sequence(responses ...cdptest.Response) (cdptest.Handler, func() int) {
+	lock := sync.Mutex{}
+	calls := 0
+	h := func(chromedebugo.Command) cdptest.Response {
+		lock.Lock()
+		defer lock.Unlock()
+		calls++
+		if calls > len(responses) {
+			return responses[len(responses)-1]
+		}
+		return responses[calls-1]
+	}
+	return h, func() int {
+		lock.Lock()
+		defer lock.Unlock()
+		return calls
+	}
+}
+
+func node(id int) cdptest.Response {
+	return cdptest.Response{Result: map[string]interface{}{"nodeId": id}}
+}
+
+func value(v interface{}) cdptest.Response {
+	return cdptest.Response{Result: map[string]interface{}{
+		"result": map[string]interface{}{"type": "object", "value": v},
+	}}
+}
+
+var stale = cdptest.Response{Error: &chromedebugo.ErrorDetail{Code: -32000, Message: "Could not find node with given id"}}
+
+// newPage returns a fake page whose document is node 1, and a debugger
+// connected to it.
+func newPage(t *testing.T) (*cdptest.Server, chromedebugo.SyncDebugger) {
+	t.Helper()
+	srv := cdptest.NewServer()
+	t.Cleanup(srv.Close)
+	srv.Handle("DOM.enable", cdptest.Result(nil))
+	srv.Handle("DOM.getDocument", cdptest.Result(map[string]interface{}{
+		"root": map[string]interface{}{"nodeId": 1, "nodeName": "#document"},
+	}))
+	srv.Handle("DOM.resolveNode", cdptest.Result(map[string]interface{}{
+		"object": map[string]interface{}{"type": "object", "objectId": "object-1"},
+	}))
+	srv.Handle("Runtime.releaseObject", cdptest.Result(nil))
+	d, err := chromedebugo.NewSync(srv.URL())
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { d.Close() })
+	return srv, d
+}
+
+func newDocument(t *testing.T, d chromedebugo.SyncDebugger) *element.Document {
+	t.Helper()
+	doc, err := element.New(context.Background(), d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(doc.Close)
+	return doc
+}
+
+func TestWaitForSelector(t *testing.T) {
+	tests := []struct {
+		name    string
+		state   wait.State
+		queries []cdptest.Response
+		visible []cdptest.Response
+		// want is the node ID of the element returned
+		want int
+	}{
+		{"attached", wait.Attached, []cdptest.Response{node(0), node(0), node(5)}, nil, 5},
+		{"detached", wait.Detached, []cdptest.Response{node(5), node(5), node(0)}, nil, 0},
+		{"visible", wait.Visible, []cdptest.Response{node(0), node(5)}, []cdptest.Response{value(false), value(true)}, 5},
+		{"hidden", wait.Hidden, []cdptest.Response{node(5)}, []cdptest.Response{value(true), value(false)}, 0},
+		{"hidden when detached", wait.Hidden, []cdptest.Response{node(0)}, nil, 0},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			srv, d := newPage(t)
+			queries, _ := sequence(test.queries...)
+			srv.Handle("DOM.querySelector", queries)
+			if test.visible != nil {
+				visible, _ := sequence(test.visible...)
+				srv.Handle("Runtime.callFunctionOn", visible)
+			}
+
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			el, err := wait.WaitForSelector(ctx, newDocument(t, d), "#target", test.state, wait.WithPollInterval(interval))
+			if err != nil {
+				t.Fatal(err)
+			}
+			switch {
+			case test.want == 0 && el != nil:
+				t.Errorf("got element %d, want none", el.NodeID())
+			case test.want != 0 && (el == nil || int(el.NodeID()) != test.want):
+				t.Errorf("got element %v, want %d", el, test.want)
+			}
+		})
+	}
+}
+
+func TestWaitForSelectorStale(t *testing.T) {
+	srv, d := newPage(t)
+	// the document is replaced while querying, which says nothing about
+	// whether the selector matches
+	queries, calls := sequence(stale, stale, node(0))
+	srv.Handle("DOM.querySelector", queries)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if _, err := wait.WaitForSelector(ctx, newDocument(t, d), "#target", wait.Detached, wait.WithPollInterval(interval)); err != nil {
+		t.Fatal(err)
+	}
+	if n := calls(); n != 3 {
+		t.Errorf("queried %d times, want 3", n)
+	}
+}
+
+func TestWaitForSelectorStaleVisibility(t *testing.T) {
+	srv, d := newPage(t)
+	srv.Handle("DOM.querySelector", cdptest.Result(map[string]interface{}{"nodeId": 5}))
+	resolve, calls := sequence(stale, cdptest.Response{Result: map[string]interface{}{
+		"object": map[string]interface{}{"type": "object", "objectId": "object-1"},
+	}})
+	srv.Handle("DOM.resolveNode", resolve)
+	srv.Handle("Runtime.callFunctionOn", cdptest.Result(map[string]interface{}{
+		"result": map[string]interface{}{"type": "boolean", "value": false},
+	}))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if _, err := wait.WaitForSelector(ctx, newDocument(t, d), "#target", wait.Hidden, wait.WithPollInterval(interval)); err != nil {
+		t.Fatal(err)
+	}
+	if n := calls(); n != 2 {
+		t.Errorf("resolved the element %d times, want 2", n)
+	}
+}
+
+func TestWaitForSelectorTimeout(t *testing.T) {
+	srv, d := newPage(t)
+	srv.Handle("DOM.querySelector", cdptest.Result(map[string]interface{}{"nodeId": 0}))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*interval)
+	defer cancel()
+	_, err := wait.WaitForSelector(ctx, newDocument(t, d), "#target", wait.Attached, wait.WithPollInterval(interval))
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("got %v, want %v", err, context.DeadlineExceeded)
+	}
+	if !strings.Contains(err.Error(), "last state: detached") {
+		t.Errorf("error %q does not describe the last state", err)
+	}
+}
+
+func TestWaitForFunction(t *testing.T) {
+	srv, d := newPage(t)
+	evaluate, calls := sequence(
+		value(map[string]interface{}{"ok": false, "value": 0}),
+		value(map[string]interface{}{"ok": false, "value": 0}),
+		value(map[string]interface{}{"ok": true, "value": 42}),
+	)
+	srv.Handle("Runtime.evaluate", evaluate)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	ret, err := wait.WaitForFunction(ctx, d, "() => window.answer", wait.WithPollInterval(interval))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(ret) != "42" {
+		t.Errorf("got %s, want 42", ret)
+	}
+	if n := calls(); n != 3 {
+		t.Errorf("evaluated %d times, want 3", n)
+	}
+	cmd := srv.AssertReceived(t, "Runtime.evaluate")
+	if expr, _ := cmd.Params["expression"].(string); !strings.Contains(expr, "window.answer") {
+		t.Errorf("evaluated %q", expr)
+	}
+}
+
+func TestWaitForFunctionTimeout(t *testing.T) {
+	srv, d := newPage(t)
+	srv.Handle("Runtime.evaluate", cdptest.Result(map[string]interface{}{
+		"result": map[string]interface{}{"type": "object", "value": map[string]interface{}{"ok": false, "value": "loading"}},
+	}))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*interval)
+	defer cancel()
+	_, err := wait.WaitForFunction(ctx, d, "() => document.readyState === 'complete' || document.readyState", wait.WithPollInterval(interval))
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("got %v, want %v", err, context.DeadlineExceeded)
+	}
+	if !strings.Contains(err.Error(), `returned "loading"`) {
+		t.Errorf("error %q does not include the last value", err)
+	}
+}
+
+func TestWaitForEvent(t *testing.T) {
+	srv, d := newPage(t)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		// the wait subscribes when called, so keep sending until it has
+		for i := 0; i < 100; i++ {
+			srv.Emit("Page.frameNavigated", map[string]interface{}{"url": "about:blank"})
+			srv.Emit("Page.frameNavigated", map[string]interface{}{"url": "https://example.com/"})
+			time.Sleep(interval)
+		}
+	}()
+	defer func() { <-done }()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	ev, err := wait.WaitForEvent(ctx, d, "Page.*", func(cmd chromedebugo.Command) bool {
+		return cmd.Params["url"] == "https://example.com/"
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ev.Method != "Page.frameNavigated" {
+		t.Errorf("got event %+v", ev)
+	}
+}
+
+func TestWaitForSelectorCanceled(t *testing.T) {
+	srv, d := newPage(t)
+	srv.Handle("DOM.querySelector", cdptest.Result(map[string]interface{}{"nodeId": 0}))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(5*interval, cancel)
+	_, err := wait.WaitForSelector(ctx, newDocument(t, d), "#target", wait.Attached, wait.WithPollInterval(interval))
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("got %v, want %v", err, context.Canceled)
+	}
+	if strings.Contains(err.Error(), "timed out") {
+		t.Errorf("error %q says the wait timed out", err)
+	}
+}